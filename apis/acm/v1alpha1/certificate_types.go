@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -79,8 +80,9 @@ type CertificateExternalStatus struct {
 
 // An CertificateStatus represents the observed state of an Certificate manager.
 type CertificateStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CertificateExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            CertificateExternalStatus `json:"atProvider,omitempty"`
 }
 
 // ResourceRecord Contains a DNS record value that you can use to validate ownership or control of a domain.
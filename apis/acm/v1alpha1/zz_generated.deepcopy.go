@@ -199,6 +199,7 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,7 +30,8 @@ type CertificateAuthorityPermissionSpec struct {
 
 // An CertificateAuthorityPermissionStatus represents the observed state of an Certificate Authority Permission manager.
 type CertificateAuthorityPermissionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
 }
 
 // CertificateAuthorityPermissionParameters defines the desired state of an AWS CertificateAuthority.
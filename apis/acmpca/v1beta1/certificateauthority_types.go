@@ -19,6 +19,7 @@ package v1beta1
 import (
 	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -185,8 +186,9 @@ type CertificateAuthoritySpec struct {
 
 // An CertificateAuthorityStatus represents the observed state of an CertificateAuthority manager.
 type CertificateAuthorityStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CertificateAuthorityExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            CertificateAuthorityExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// APIKeyParameters define the desired state of an AWS API Gateway API key.
+type APIKeyParameters struct {
+	// Region is the region the API key is created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the API key.
+	Name string `json:"name"`
+
+	// The description of the API key.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Whether requests can be made with the API key. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// An identifier of the customer the API key is issued to, e.g. in AWS
+	// Marketplace.
+	// +optional
+	CustomerID *string `json:"customerId,omitempty"`
+
+	// The tags of the API key.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An APIKeySpec defines the desired state of an APIKey.
+type APIKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       APIKeyParameters `json:"forProvider"`
+}
+
+// APIKeyObservation keeps the state for the external resource
+type APIKeyObservation struct {
+	// The ID of the API key.
+	ID string `json:"id,omitempty"`
+
+	// The time the API key was created.
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+}
+
+// An APIKeyStatus represents the observed state of an APIKey.
+type APIKeyStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            APIKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An APIKey is a managed resource that represents an AWS API Gateway API key,
+// which identifies the clients of REST APIs whose requests are limited by a
+// usage plan. The value of the key is written to the password key of the
+// connection secret. The external name of an APIKey is the ID of the API key.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type APIKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APIKeySpec   `json:"spec"`
+	Status APIKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APIKeyList contains a list of APIKeys
+type APIKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIKey `json:"items"`
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// DeploymentParameters define the desired state of a deployment of an AWS
+// API Gateway REST API.
+type DeploymentParameters struct {
+	// Region is the region of the REST API.
+	// +immutable
+	Region string `json:"region"`
+
+	// The ID of the REST API that is deployed.
+	// +immutable
+	// +optional
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+
+	// The description of the deployment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DefinitionChecksum triggers a new deployment of the REST API whenever
+	// it changes. It is kept in sync with the checksum of the OpenAPI
+	// document of a RestAPI that is referenced by RestAPIIDRef, and can be
+	// set to any value otherwise.
+	// +optional
+	DefinitionChecksum *string `json:"definitionChecksum,omitempty"`
+}
+
+// A DeploymentSpec defines the desired state of a Deployment.
+type DeploymentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DeploymentParameters `json:"forProvider"`
+}
+
+// DeploymentObservation keeps the state for the external resource
+type DeploymentObservation struct {
+	// The ID of the current deployment.
+	ID string `json:"id,omitempty"`
+
+	// The time the current deployment was created.
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+}
+
+// A DeploymentStatus represents the observed state of a Deployment.
+type DeploymentStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            DeploymentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Deployment is a managed resource that represents a deployment, i.e. a
+// snapshot, of an AWS API Gateway REST API that Stages serve. Deployments
+// cannot be changed, so a new one is created once the DefinitionChecksum
+// changes and the previous ones are kept. The external name of a Deployment
+// is the ID of the current deployment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Deployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeploymentSpec   `json:"spec"`
+	Status DeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeploymentList contains a list of Deployments
+type DeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Deployment `json:"items"`
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// A MethodIntegration defines the backend requests to a method are sent to.
+type MethodIntegration struct {
+	// The type of the integration. AWS_PROXY and HTTP_PROXY integrations pass
+	// requests through unchanged, whereas AWS and HTTP integrations transform
+	// them using RequestTemplates.
+	// +kubebuilder:validation:Enum=AWS;AWS_PROXY;HTTP;HTTP_PROXY;MOCK
+	Type string `json:"type"`
+
+	// The HTTP method used to call the backend. Lambda functions are always
+	// called with POST.
+	// +optional
+	IntegrationHTTPMethod *string `json:"integrationHttpMethod,omitempty"`
+
+	// The URI of the backend, e.g. the URL of an HTTP backend or the
+	// invocation ARN of a Lambda function.
+	// +optional
+	URI *string `json:"uri,omitempty"`
+
+	// LambdaFunctionRef is a reference to a Lambda Function whose invocation
+	// ARN is used to set URI.
+	// +optional
+	LambdaFunctionRef *xpv1.Reference `json:"lambdaFunctionRef,omitempty"`
+
+	// LambdaFunctionSelector selects a reference to a Lambda Function whose
+	// invocation ARN is used to set URI.
+	// +optional
+	LambdaFunctionSelector *xpv1.Selector `json:"lambdaFunctionSelector,omitempty"`
+
+	// Whether the backend is reached through the internet (INTERNET) or a
+	// VPC link (VPC_LINK).
+	// +kubebuilder:validation:Enum=INTERNET;VPC_LINK
+	// +optional
+	ConnectionType *string `json:"connectionType,omitempty"`
+
+	// The ID of the VPC link of a VPC_LINK integration.
+	// +optional
+	ConnectionID *string `json:"connectionId,omitempty"`
+
+	// The ARN of the IAM role API Gateway assumes to call the backend.
+	// +optional
+	Credentials *string `json:"credentials,omitempty"`
+
+	// How requests whose content type has no request template are passed
+	// to the backend.
+	// +kubebuilder:validation:Enum=WHEN_NO_MATCH;WHEN_NO_TEMPLATES;NEVER
+	// +optional
+	PassthroughBehavior *string `json:"passthroughBehavior,omitempty"`
+
+	// The parameters of backend requests, keyed by their location and name,
+	// e.g. integration.request.header.x-id, with the method request
+	// parameters or static values they are set to.
+	// +optional
+	RequestParameters map[string]string `json:"requestParameters,omitempty"`
+
+	// The Velocity templates that transform requests, keyed by content type.
+	// +optional
+	RequestTemplates map[string]string `json:"requestTemplates,omitempty"`
+
+	// The timeout of backend requests in milliseconds.
+	// +kubebuilder:validation:Minimum=50
+	// +kubebuilder:validation:Maximum=29000
+	// +optional
+	TimeoutInMillis *int64 `json:"timeoutInMillis,omitempty"`
+}
+
+// MethodParameters define the desired state of a method of an AWS API
+// Gateway REST API.
+type MethodParameters struct {
+	// Region is the region of the REST API.
+	// +immutable
+	Region string `json:"region"`
+
+	// The ID of the REST API the method belongs to.
+	// +immutable
+	// +optional
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+
+	// The ID of the resource the method belongs to.
+	// +immutable
+	// +optional
+	ResourceID *string `json:"resourceId,omitempty"`
+
+	// ResourceIDRef is a reference to a Resource used to set ResourceID.
+	// +optional
+	ResourceIDRef *xpv1.Reference `json:"resourceIdRef,omitempty"`
+
+	// ResourceIDSelector selects a reference to a Resource used to set
+	// ResourceID.
+	// +optional
+	ResourceIDSelector *xpv1.Selector `json:"resourceIdSelector,omitempty"`
+
+	// The HTTP method, or ANY for all of them.
+	// +kubebuilder:validation:Enum=GET;POST;PUT;PATCH;DELETE;HEAD;OPTIONS;ANY
+	// +immutable
+	HTTPMethod string `json:"httpMethod"`
+
+	// The type of the authorization of requests.
+	// +kubebuilder:validation:Enum=NONE;AWS_IAM;CUSTOM;COGNITO_USER_POOLS
+	AuthorizationType string `json:"authorizationType"`
+
+	// The ID of the authorizer of CUSTOM and COGNITO_USER_POOLS methods.
+	// +optional
+	AuthorizerID *string `json:"authorizerId,omitempty"`
+
+	// The OAuth scopes of COGNITO_USER_POOLS methods, one of which an access
+	// token needs to be granted.
+	// +optional
+	AuthorizationScopes []string `json:"authorizationScopes,omitempty"`
+
+	// Whether requests need to include an API key of a usage plan of the
+	// API.
+	// +optional
+	APIKeyRequired *bool `json:"apiKeyRequired,omitempty"`
+
+	// The name of the method in generated SDKs.
+	// +optional
+	OperationName *string `json:"operationName,omitempty"`
+
+	// The parameters of requests, keyed by their location and name, e.g.
+	// method.request.querystring.page, with whether they are required.
+	// +optional
+	RequestParameters map[string]bool `json:"requestParameters,omitempty"`
+
+	// The ID of the validator of requests.
+	// +optional
+	RequestValidatorID *string `json:"requestValidatorId,omitempty"`
+
+	// The integration of the method.
+	// +optional
+	Integration *MethodIntegration `json:"integration,omitempty"`
+}
+
+// A MethodSpec defines the desired state of a Method.
+type MethodSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MethodParameters `json:"forProvider"`
+}
+
+// MethodObservation keeps the state for the external resource
+type MethodObservation struct {
+	// Whether the method has an integration.
+	Integrated bool `json:"integrated,omitempty"`
+}
+
+// A MethodStatus represents the observed state of a Method.
+type MethodStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            MethodObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Method is a managed resource that represents a method of a resource of
+// an AWS API Gateway REST API, along with its integration. The external name
+// of a Method is its HTTP method.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="METHOD",type="string",JSONPath=".spec.forProvider.httpMethod"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Method struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MethodSpec   `json:"spec"`
+	Status MethodStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MethodList contains a list of Methods
+type MethodList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Method `json:"items"`
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lambdav1alpha1 "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+// LambdaFunctionInvocationURI returns the URI API Gateway invokes a Lambda
+// Function at.
+func LambdaFunctionInvocationURI() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		f, ok := mg.(*lambdav1alpha1.Function)
+		if !ok {
+			return ""
+		}
+		arn := reference.FromPtrValue(f.Status.AtProvider.FunctionARN)
+		if arn == "" {
+			return ""
+		}
+		return fmt.Sprintf("arn:aws:apigateway:%s:lambda:path/2015-03-31/functions/%s/invocations", f.Spec.ForProvider.Region, arn)
+	}
+}
+
+// resolveRestAPIID resolves the ID of a REST API from a RestAPI.
+func resolveRestAPIID(ctx context.Context, r *reference.APIResolver, id *string, ref *xpv1.Reference, sel *xpv1.Selector) (reference.ResolutionResponse, error) {
+	return r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Reference:    ref,
+		Selector:     sel,
+		To:           reference.To{Managed: &RestAPI{}, List: &RestAPIList{}},
+		Extract:      reference.ExternalName(),
+	})
+}
+
+// ResolveReferences of this Method
+func (mg *Method) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.restApiId
+	rsp, err := resolveRestAPIID(ctx, r, mg.Spec.ForProvider.RestAPIID, mg.Spec.ForProvider.RestAPIIDRef, mg.Spec.ForProvider.RestAPIIDSelector)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.restApiId")
+	}
+	mg.Spec.ForProvider.RestAPIID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RestAPIIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.resourceId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ResourceID),
+		Reference:    mg.Spec.ForProvider.ResourceIDRef,
+		Selector:     mg.Spec.ForProvider.ResourceIDSelector,
+		To:           reference.To{Managed: &Resource{}, List: &ResourceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourceId")
+	}
+	mg.Spec.ForProvider.ResourceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ResourceIDRef = rsp.ResolvedReference
+
+	in := mg.Spec.ForProvider.Integration
+	if in == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.integration.uri
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.URI),
+		Reference:    in.LambdaFunctionRef,
+		Selector:     in.LambdaFunctionSelector,
+		To:           reference.To{Managed: &lambdav1alpha1.Function{}, List: &lambdav1alpha1.FunctionList{}},
+		Extract:      LambdaFunctionInvocationURI(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.integration.uri")
+	}
+	in.URI = reference.ToPtrValue(rsp.ResolvedValue)
+	in.LambdaFunctionRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Deployment
+func (mg *Deployment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.restApiId
+	rsp, err := resolveRestAPIID(ctx, r, mg.Spec.ForProvider.RestAPIID, mg.Spec.ForProvider.RestAPIIDRef, mg.Spec.ForProvider.RestAPIIDSelector)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.restApiId")
+	}
+	mg.Spec.ForProvider.RestAPIID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RestAPIIDRef = rsp.ResolvedReference
+
+	// The checksum of the OpenAPI document of the referenced RestAPI
+	// changes whenever a new document is imported, so it is not cached.
+	// It's empty for RestAPIs without a document.
+	if mg.Spec.ForProvider.RestAPIIDRef == nil {
+		return nil
+	}
+	api := &RestAPI{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.Spec.ForProvider.RestAPIIDRef.Name}, api); err != nil {
+		return errors.Wrap(err, "spec.forProvider.definitionChecksum")
+	}
+	if cs := api.Status.AtProvider.DefinitionChecksum; cs != "" {
+		mg.Spec.ForProvider.DefinitionChecksum = reference.ToPtrValue(cs)
+	}
+	return nil
+}
+
+// ResolveReferences of this Stage
+func (mg *Stage) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.restApiId
+	rsp, err := resolveRestAPIID(ctx, r, mg.Spec.ForProvider.RestAPIID, mg.Spec.ForProvider.RestAPIIDRef, mg.Spec.ForProvider.RestAPIIDSelector)
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.restApiId")
+	}
+	mg.Spec.ForProvider.RestAPIID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RestAPIIDRef = rsp.ResolvedReference
+
+	// The external name of a Deployment changes whenever it deploys the
+	// REST API again, so the ID of a referenced deployment is not cached.
+	current := reference.FromPtrValue(mg.Spec.ForProvider.DeploymentID)
+	if mg.Spec.ForProvider.DeploymentIDRef != nil {
+		current = ""
+	}
+
+	// Resolve spec.forProvider.deploymentId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: current,
+		Reference:    mg.Spec.ForProvider.DeploymentIDRef,
+		Selector:     mg.Spec.ForProvider.DeploymentIDSelector,
+		To:           reference.To{Managed: &Deployment{}, List: &DeploymentList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.deploymentId")
+	}
+	mg.Spec.ForProvider.DeploymentID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DeploymentIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this UsagePlan
+func (mg *UsagePlan) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.APIStages {
+		s := &mg.Spec.ForProvider.APIStages[i]
+
+		// Resolve spec.forProvider.apiStages[i].restApiId
+		rsp, err := resolveRestAPIID(ctx, r, s.RestAPIID, s.RestAPIIDRef, s.RestAPIIDSelector)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.apiStages[%d].restApiId", i))
+		}
+		s.RestAPIID = reference.ToPtrValue(rsp.ResolvedValue)
+		s.RestAPIIDRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.apiStages[i].stage
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(s.Stage),
+			Reference:    s.StageRef,
+			Selector:     s.StageSelector,
+			To:           reference.To{Managed: &Stage{}, List: &StageList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.apiStages[%d].stage", i))
+		}
+		s.Stage = reference.ToPtrValue(rsp.ResolvedValue)
+		s.StageRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.apiKeyIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.APIKeyIDs,
+		References:    mg.Spec.ForProvider.APIKeyIDRefs,
+		Selector:      mg.Spec.ForProvider.APIKeyIDSelector,
+		To:            reference.To{Managed: &APIKey{}, List: &APIKeyList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.apiKeyIds")
+	}
+	mg.Spec.ForProvider.APIKeyIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.APIKeyIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
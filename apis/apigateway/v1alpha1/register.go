@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "apigateway.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// RestAPI type metadata.
+var (
+	RestAPIKind             = reflect.TypeOf(RestAPI{}).Name()
+	RestAPIGroupKind        = schema.GroupKind{Group: Group, Kind: RestAPIKind}.String()
+	RestAPIKindAPIVersion   = RestAPIKind + "." + SchemeGroupVersion.String()
+	RestAPIGroupVersionKind = SchemeGroupVersion.WithKind(RestAPIKind)
+)
+
+// Resource type metadata.
+var (
+	ResourceKind             = reflect.TypeOf(Resource{}).Name()
+	ResourceGroupKind        = schema.GroupKind{Group: Group, Kind: ResourceKind}.String()
+	ResourceKindAPIVersion   = ResourceKind + "." + SchemeGroupVersion.String()
+	ResourceGroupVersionKind = SchemeGroupVersion.WithKind(ResourceKind)
+)
+
+// Method type metadata.
+var (
+	MethodKind             = reflect.TypeOf(Method{}).Name()
+	MethodGroupKind        = schema.GroupKind{Group: Group, Kind: MethodKind}.String()
+	MethodKindAPIVersion   = MethodKind + "." + SchemeGroupVersion.String()
+	MethodGroupVersionKind = SchemeGroupVersion.WithKind(MethodKind)
+)
+
+// Deployment type metadata.
+var (
+	DeploymentKind             = reflect.TypeOf(Deployment{}).Name()
+	DeploymentGroupKind        = schema.GroupKind{Group: Group, Kind: DeploymentKind}.String()
+	DeploymentKindAPIVersion   = DeploymentKind + "." + SchemeGroupVersion.String()
+	DeploymentGroupVersionKind = SchemeGroupVersion.WithKind(DeploymentKind)
+)
+
+// Stage type metadata.
+var (
+	StageKind             = reflect.TypeOf(Stage{}).Name()
+	StageGroupKind        = schema.GroupKind{Group: Group, Kind: StageKind}.String()
+	StageKindAPIVersion   = StageKind + "." + SchemeGroupVersion.String()
+	StageGroupVersionKind = SchemeGroupVersion.WithKind(StageKind)
+)
+
+// UsagePlan type metadata.
+var (
+	UsagePlanKind             = reflect.TypeOf(UsagePlan{}).Name()
+	UsagePlanGroupKind        = schema.GroupKind{Group: Group, Kind: UsagePlanKind}.String()
+	UsagePlanKindAPIVersion   = UsagePlanKind + "." + SchemeGroupVersion.String()
+	UsagePlanGroupVersionKind = SchemeGroupVersion.WithKind(UsagePlanKind)
+)
+
+// APIKey type metadata.
+var (
+	APIKeyKind             = reflect.TypeOf(APIKey{}).Name()
+	APIKeyGroupKind        = schema.GroupKind{Group: Group, Kind: APIKeyKind}.String()
+	APIKeyKindAPIVersion   = APIKeyKind + "." + SchemeGroupVersion.String()
+	APIKeyGroupVersionKind = SchemeGroupVersion.WithKind(APIKeyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RestAPI{}, &RestAPIList{})
+	SchemeBuilder.Register(&Resource{}, &ResourceList{})
+	SchemeBuilder.Register(&Method{}, &MethodList{})
+	SchemeBuilder.Register(&Deployment{}, &DeploymentList{})
+	SchemeBuilder.Register(&Stage{}, &StageList{})
+	SchemeBuilder.Register(&UsagePlan{}, &UsagePlanList{})
+	SchemeBuilder.Register(&APIKey{}, &APIKeyList{})
+}
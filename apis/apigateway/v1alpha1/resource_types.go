@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ResourceParameters define the desired state of a resource, i.e. a path
+// segment, of an AWS API Gateway REST API.
+type ResourceParameters struct {
+	// Region is the region of the REST API.
+	// +immutable
+	Region string `json:"region"`
+
+	// The ID of the REST API the resource belongs to.
+	// +crossplane:generate:reference:type=RestAPI
+	// +immutable
+	// +optional
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+
+	// The ID of the parent resource. Defaults to the root resource of the
+	// REST API.
+	// +crossplane:generate:reference:type=Resource
+	// +optional
+	ParentID *string `json:"parentId,omitempty"`
+
+	// ParentIDRef is a reference to a Resource used to set ParentID.
+	// +optional
+	ParentIDRef *xpv1.Reference `json:"parentIdRef,omitempty"`
+
+	// ParentIDSelector selects a reference to a Resource used to set
+	// ParentID.
+	// +optional
+	ParentIDSelector *xpv1.Selector `json:"parentIdSelector,omitempty"`
+
+	// The last path segment of the resource, e.g. users or {id}. A path
+	// segment of {proxy+} matches any number of path segments.
+	PathPart string `json:"pathPart"`
+}
+
+// A ResourceSpec defines the desired state of a Resource.
+type ResourceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ResourceParameters `json:"forProvider"`
+}
+
+// ResourceObservation keeps the state for the external resource
+type ResourceObservation struct {
+	// The full path of the resource.
+	Path string `json:"path,omitempty"`
+}
+
+// A ResourceStatus represents the observed state of a Resource.
+type ResourceStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ResourceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Resource is a managed resource that represents a resource of an AWS API
+// Gateway REST API, which is a path the methods of the API are served on.
+// The external name of a Resource is the ID of the resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PATH",type="string",JSONPath=".status.atProvider.path"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Resource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceSpec   `json:"spec"`
+	Status ResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceList contains a list of Resources
+type ResourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Resource `json:"items"`
+}
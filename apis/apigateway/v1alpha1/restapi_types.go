@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Modes of importing an OpenAPI definition into an existing REST API.
+const (
+	DefinitionModeOverwrite = "overwrite"
+	DefinitionModeMerge     = "merge"
+)
+
+// An EndpointConfiguration defines how the endpoint of a REST API is exposed.
+type EndpointConfiguration struct {
+	// The type of the endpoint. EDGE APIs are served through CloudFront,
+	// REGIONAL APIs from the region they are created in and PRIVATE APIs
+	// only through VPC endpoints.
+	// +kubebuilder:validation:Enum=EDGE;REGIONAL;PRIVATE
+	Type string `json:"type"`
+
+	// The IDs of the VPC endpoints a PRIVATE API is exposed through.
+	// +optional
+	VPCEndpointIDs []string `json:"vpcEndpointIds,omitempty"`
+}
+
+// An APIDefinition is an OpenAPI document, including the API Gateway
+// extensions, that defines the resources and methods of a REST API.
+type APIDefinition struct {
+	// Body is the OpenAPI document in JSON or YAML format.
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// BodyConfigMapRef selects a key of a ConfigMap that holds the OpenAPI
+	// document. It takes precedence over Body.
+	// +optional
+	BodyConfigMapRef *awsv1beta1.ConfigMapKeySelector `json:"bodyConfigMapRef,omitempty"`
+
+	// Mode determines whether a changed document overwrites the REST API or
+	// is merged into it. Resources and methods that are not part of the
+	// document are only kept if the document is merged.
+	// +kubebuilder:validation:Enum=overwrite;merge
+	// +kubebuilder:default=overwrite
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// FailOnWarnings rolls back the import of a document if it causes any
+	// warnings.
+	// +optional
+	FailOnWarnings *bool `json:"failOnWarnings,omitempty"`
+}
+
+// RestAPIParameters define the desired state of an AWS API Gateway REST API.
+type RestAPIParameters struct {
+	// Region is the region the REST API is created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the REST API.
+	Name string `json:"name"`
+
+	// The description of the REST API.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The source of the API keys used to meter requests, which is either
+	// the X-API-Key header of a request (HEADER) or the usage identifier key
+	// returned by a Lambda authorizer (AUTHORIZER).
+	// +kubebuilder:validation:Enum=HEADER;AUTHORIZER
+	// +optional
+	APIKeySource *string `json:"apiKeySource,omitempty"`
+
+	// The media types, e.g. image/png, that are handled as binary payloads.
+	// +optional
+	BinaryMediaTypes []string `json:"binaryMediaTypes,omitempty"`
+
+	// The smallest size in bytes of a response payload that is compressed.
+	// Compression is disabled if it is not set.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10485760
+	// +optional
+	MinimumCompressionSize *int64 `json:"minimumCompressionSize,omitempty"`
+
+	// Whether clients are prevented from invoking the API through its
+	// default execute-api endpoint, so that it can only be invoked through
+	// a custom domain name.
+	// +optional
+	DisableExecuteAPIEndpoint *bool `json:"disableExecuteApiEndpoint,omitempty"`
+
+	// The configuration of the endpoint of the REST API. Defaults to an EDGE
+	// endpoint.
+	// +optional
+	EndpointConfiguration *EndpointConfiguration `json:"endpointConfiguration,omitempty"`
+
+	// The resource policy of the REST API in JSON format.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// Definition is an OpenAPI document that is imported into the REST API
+	// whenever it changes. Resources and Methods are not needed for APIs
+	// that are defined by a document.
+	// +optional
+	Definition *APIDefinition `json:"definition,omitempty"`
+
+	// The tags of the REST API.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A RestAPISpec defines the desired state of a RestAPI.
+type RestAPISpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RestAPIParameters `json:"forProvider"`
+}
+
+// RestAPIObservation keeps the state for the external resource
+type RestAPIObservation struct {
+	// The ID of the REST API.
+	ID string `json:"id,omitempty"`
+
+	// The time the REST API was created.
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+
+	// The SHA-256 checksum of the OpenAPI document that was imported into
+	// the REST API last. Deployments use it to deploy the REST API again
+	// once a changed document is imported.
+	DefinitionChecksum string `json:"definitionChecksum,omitempty"`
+
+	// The warnings that were reported when the REST API was created.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// A RestAPIStatus represents the observed state of a RestAPI.
+type RestAPIStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            RestAPIObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RestAPI is a managed resource that represents an AWS API Gateway REST
+// API. The external name of a RestAPI is the ID of the REST API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RestAPI struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RestAPISpec   `json:"spec"`
+	Status RestAPIStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RestAPIList contains a list of RestAPIs
+type RestAPIList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RestAPI `json:"items"`
+}
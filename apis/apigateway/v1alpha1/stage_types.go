@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// StageParameters define the desired state of a stage of an AWS API Gateway
+// REST API.
+type StageParameters struct {
+	// Region is the region of the REST API.
+	// +immutable
+	Region string `json:"region"`
+
+	// The ID of the REST API the stage belongs to.
+	// +immutable
+	// +optional
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+
+	// The ID of the deployment the stage serves.
+	// +optional
+	DeploymentID *string `json:"deploymentId,omitempty"`
+
+	// DeploymentIDRef is a reference to a Deployment used to set
+	// DeploymentID. Unlike other references it is resolved again on every
+	// reconcile, so that the stage serves the current deployment of the
+	// Deployment.
+	// +optional
+	DeploymentIDRef *xpv1.Reference `json:"deploymentIdRef,omitempty"`
+
+	// DeploymentIDSelector selects a reference to a Deployment used to set
+	// DeploymentID.
+	// +optional
+	DeploymentIDSelector *xpv1.Selector `json:"deploymentIdSelector,omitempty"`
+
+	// The description of the stage.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Whether a cache cluster is provisioned for the stage.
+	// +optional
+	CacheClusterEnabled *bool `json:"cacheClusterEnabled,omitempty"`
+
+	// The size of the cache cluster of the stage in GB, which is one of 0.5,
+	// 1.6, 6.1, 13.5, 28.4, 58.2, 118 and 237.
+	// +optional
+	CacheClusterSize *string `json:"cacheClusterSize,omitempty"`
+
+	// Whether requests are traced with AWS X-Ray.
+	// +optional
+	TracingEnabled *bool `json:"tracingEnabled,omitempty"`
+
+	// The stage variables that are available to the methods of the stage.
+	// +optional
+	Variables map[string]string `json:"variables,omitempty"`
+
+	// The tags of the stage.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A StageSpec defines the desired state of a Stage.
+type StageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StageParameters `json:"forProvider"`
+}
+
+// StageObservation keeps the state for the external resource
+type StageObservation struct {
+	// The URL the stage is invoked at.
+	InvokeURL string `json:"invokeUrl,omitempty"`
+
+	// The status of the cache cluster of the stage.
+	CacheClusterStatus string `json:"cacheClusterStatus,omitempty"`
+
+	// The time the stage was created.
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+
+	// The time the stage was updated last.
+	LastUpdatedDate *metav1.Time `json:"lastUpdatedDate,omitempty"`
+}
+
+// A StageStatus represents the observed state of a Stage.
+type StageStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            StageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Stage is a managed resource that represents a stage of an AWS API Gateway
+// REST API, which serves a deployment of the API. The external name of a
+// Stage is the name of the stage.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.invokeUrl"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Stage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StageSpec   `json:"spec"`
+	Status StageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StageList contains a list of Stages
+type StageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stage `json:"items"`
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// A UsagePlanAPIStage is a stage of a REST API that a usage plan applies to.
+type UsagePlanAPIStage struct {
+	// The ID of the REST API.
+	// +optional
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+
+	// The name of the stage.
+	// +optional
+	Stage *string `json:"stage,omitempty"`
+
+	// StageRef is a reference to a Stage used to set Stage.
+	// +optional
+	StageRef *xpv1.Reference `json:"stageRef,omitempty"`
+
+	// StageSelector selects a reference to a Stage used to set Stage.
+	// +optional
+	StageSelector *xpv1.Selector `json:"stageSelector,omitempty"`
+}
+
+// ThrottleSettings limit the rate of requests.
+type ThrottleSettings struct {
+	// The number of requests that may be made at once.
+	// +optional
+	BurstLimit *int64 `json:"burstLimit,omitempty"`
+
+	// The number of requests that may be made per second.
+	// +optional
+	RateLimit *float64 `json:"rateLimit,omitempty"`
+}
+
+// QuotaSettings limit the number of requests in a period.
+type QuotaSettings struct {
+	// The number of requests that may be made in a period.
+	Limit int64 `json:"limit"`
+
+	// The number of requests subtracted from the limit in the first period.
+	// +optional
+	Offset *int64 `json:"offset,omitempty"`
+
+	// The period the limit applies to.
+	// +kubebuilder:validation:Enum=DAY;WEEK;MONTH
+	Period string `json:"period"`
+}
+
+// UsagePlanParameters define the desired state of an AWS API Gateway usage
+// plan.
+type UsagePlanParameters struct {
+	// Region is the region the usage plan is created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the usage plan.
+	Name string `json:"name"`
+
+	// The description of the usage plan.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The stages of REST APIs the usage plan applies to.
+	// +optional
+	APIStages []UsagePlanAPIStage `json:"apiStages,omitempty"`
+
+	// The rate limit of requests made with each API key.
+	// +optional
+	Throttle *ThrottleSettings `json:"throttle,omitempty"`
+
+	// The quota of requests made with each API key.
+	// +optional
+	Quota *QuotaSettings `json:"quota,omitempty"`
+
+	// The IDs of the API keys the usage plan applies to.
+	// +optional
+	APIKeyIDs []string `json:"apiKeyIds,omitempty"`
+
+	// APIKeyIDRefs are references to APIKeys used to set APIKeyIDs.
+	// +optional
+	APIKeyIDRefs []xpv1.Reference `json:"apiKeyIdRefs,omitempty"`
+
+	// APIKeyIDSelector selects references to APIKeys used to set APIKeyIDs.
+	// +optional
+	APIKeyIDSelector *xpv1.Selector `json:"apiKeyIdSelector,omitempty"`
+
+	// The tags of the usage plan.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A UsagePlanSpec defines the desired state of a UsagePlan.
+type UsagePlanSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UsagePlanParameters `json:"forProvider"`
+}
+
+// UsagePlanObservation keeps the state for the external resource
+type UsagePlanObservation struct {
+	// The ID of the usage plan.
+	ID string `json:"id,omitempty"`
+}
+
+// A UsagePlanStatus represents the observed state of a UsagePlan.
+type UsagePlanStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            UsagePlanObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UsagePlan is a managed resource that represents an AWS API Gateway usage
+// plan, which limits the requests made to stages of REST APIs with a set of
+// API keys. The external name of a UsagePlan is the ID of the usage plan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type UsagePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UsagePlanSpec   `json:"spec"`
+	Status UsagePlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UsagePlanList contains a list of UsagePlans
+type UsagePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UsagePlan `json:"items"`
+}
@@ -114,7 +114,17 @@ type CustomDeploymentParameters struct {
 }
 
 // CustomDomainNameParameters includes the custom fields.
-type CustomDomainNameParameters struct{}
+type CustomDomainNameParameters struct {
+	// CertificateARNRef is a reference to an ACM Certificate used to set the
+	// CertificateARN of the first domain name configuration.
+	// +optional
+	CertificateARNRef *xpv1.Reference `json:"certificateArnRef,omitempty"`
+
+	// CertificateARNSelector selects a reference to an ACM Certificate used
+	// to set the CertificateARN of the first domain name configuration.
+	// +optional
+	CertificateARNSelector *xpv1.Selector `json:"certificateArnSelector,omitempty"`
+}
 
 // ResponseParameters is a map of status codes and transform operations on each
 // of them.
@@ -171,6 +181,16 @@ type CustomIntegrationParameters struct {
 	// to set the APIID.
 	// +optional
 	APIIDSelector *xpv1.Selector `json:"apiIdSelector,omitempty"`
+
+	// IntegrationURIRef is a reference to a Lambda Function used to set
+	// the IntegrationURI.
+	// +optional
+	IntegrationURIRef *xpv1.Reference `json:"integrationURIRef,omitempty"`
+
+	// IntegrationURISelector selects references to a Lambda Function used
+	// to set the IntegrationURI.
+	// +optional
+	IntegrationURISelector *xpv1.Selector `json:"integrationURISelector,omitempty"`
 }
 
 // CustomIntegrationResponseParameters includes the custom fields.
@@ -246,6 +266,15 @@ type CustomRouteParameters struct {
 	// to set the AuthorizerID.
 	// +optional
 	AuthorizerIDSelector *xpv1.Selector `json:"authorizerIDSelector,omitempty"`
+
+	// TargetRef is a reference to an Integration used to set the Target.
+	// +optional
+	TargetRef *xpv1.Reference `json:"targetRef,omitempty"`
+
+	// TargetSelector selects references to Integration used to set the
+	// Target.
+	// +optional
+	TargetSelector *xpv1.Selector `json:"targetSelector,omitempty"`
 }
 
 // CustomRouteResponseParameters includes the custom fields.
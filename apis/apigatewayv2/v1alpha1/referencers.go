@@ -19,13 +19,53 @@ package v1alpha1
 import (
 	"context"
 
+	acm "github.com/crossplane/provider-aws/apis/acm/v1beta1"
 	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	lambda "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// DomainNameAPIGatewayDomainName returns the domain name that API Gateway
+// assigned to the first configuration of a DomainName.
+func DomainNameAPIGatewayDomainName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*DomainName)
+		if !ok || len(r.Spec.ForProvider.DomainNameConfigurations) == 0 || r.Spec.ForProvider.DomainNameConfigurations[0] == nil {
+			return ""
+		}
+		return reference.FromPtrValue(r.Spec.ForProvider.DomainNameConfigurations[0].APIGatewayDomainName)
+	}
+}
+
+// DomainNameHostedZoneID returns the ID of the Route 53 hosted zone of the
+// first configuration of a DomainName.
+func DomainNameHostedZoneID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*DomainName)
+		if !ok || len(r.Spec.ForProvider.DomainNameConfigurations) == 0 || r.Spec.ForProvider.DomainNameConfigurations[0] == nil {
+			return ""
+		}
+		return reference.FromPtrValue(r.Spec.ForProvider.DomainNameConfigurations[0].HostedZoneID)
+	}
+}
+
+// IntegrationTarget returns the route target of an Integration, which is the
+// ID of the integration prefixed with "integrations/".
+func IntegrationTarget() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Integration)
+		if !ok || meta.GetExternalName(r) == "" {
+			return ""
+		}
+		return "integrations/" + meta.GetExternalName(r)
+	}
+}
+
 // ResolveReferences of this Stage
 func (mg *Stage) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -78,6 +118,20 @@ func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.AuthorizerID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.AuthorizerIDRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.target
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Target),
+		Reference:    mg.Spec.ForProvider.TargetRef,
+		Selector:     mg.Spec.ForProvider.TargetSelector,
+		To:           reference.To{Managed: &Integration{}, List: &IntegrationList{}},
+		Extract:      IntegrationTarget(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.target")
+	}
+	mg.Spec.ForProvider.Target = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -153,6 +207,53 @@ func (mg *Integration) ResolveReferences(ctx context.Context, c client.Reader) e
 	}
 	mg.Spec.ForProvider.APIID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.APIIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.integrationURI
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IntegrationURI),
+		Reference:    mg.Spec.ForProvider.IntegrationURIRef,
+		Selector:     mg.Spec.ForProvider.IntegrationURISelector,
+		To:           reference.To{Managed: &lambda.Function{}, List: &lambda.FunctionList{}},
+		Extract:      lambda.FunctionARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.integrationURI")
+	}
+	mg.Spec.ForProvider.IntegrationURI = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IntegrationURIRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this DomainName
+func (mg *DomainName) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.CertificateARNRef == nil && mg.Spec.ForProvider.CertificateARNSelector == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// The certificate is set on the first domain name configuration, which
+	// is the only one API Gateway currently supports.
+	if len(mg.Spec.ForProvider.DomainNameConfigurations) == 0 {
+		mg.Spec.ForProvider.DomainNameConfigurations = []*DomainNameConfiguration{{}}
+	}
+	if mg.Spec.ForProvider.DomainNameConfigurations[0] == nil {
+		mg.Spec.ForProvider.DomainNameConfigurations[0] = &DomainNameConfiguration{}
+	}
+	cfg := mg.Spec.ForProvider.DomainNameConfigurations[0]
+
+	// Resolve spec.forProvider.domainNameConfigurations[0].certificateARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(cfg.CertificateARN),
+		Reference:    mg.Spec.ForProvider.CertificateARNRef,
+		Selector:     mg.Spec.ForProvider.CertificateARNSelector,
+		To:           reference.To{Managed: &acm.Certificate{}, List: &acm.CertificateList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.domainNameConfigurations[0].certificateARN")
+	}
+	cfg.CertificateARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CertificateARNRef = rsp.ResolvedReference
 	return nil
 }
 
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -37,6 +38,7 @@ type AuthorizerParameters struct {
 	AuthorizerResultTtlInSeconds *int64 `json:"authorizerResultTtlInSeconds,omitempty"`
 
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=REQUEST;JWT
 	AuthorizerType *string `json:"authorizerType"`
 
 	AuthorizerURI *string `json:"authorizerURI,omitempty"`
@@ -68,8 +70,9 @@ type AuthorizerObservation struct {
 
 // AuthorizerStatus defines the observed state of Authorizer.
 type AuthorizerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AuthorizerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            AuthorizerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
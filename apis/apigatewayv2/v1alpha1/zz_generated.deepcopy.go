@@ -216,6 +216,7 @@ func (in *APIMappingSpec) DeepCopy() *APIMappingSpec {
 func (in *APIMappingStatus) DeepCopyInto(out *APIMappingStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -432,6 +433,7 @@ func (in *APISpec) DeepCopy() *APISpec {
 func (in *APIStatus) DeepCopyInto(out *APIStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -758,6 +760,7 @@ func (in *AuthorizerSpec) DeepCopy() *AuthorizerSpec {
 func (in *AuthorizerStatus) DeepCopyInto(out *AuthorizerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1064,6 +1067,16 @@ func (in *CustomDeploymentParameters) DeepCopy() *CustomDeploymentParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomDomainNameParameters) DeepCopyInto(out *CustomDomainNameParameters) {
 	*out = *in
+	if in.CertificateARNRef != nil {
+		in, out := &in.CertificateARNRef, &out.CertificateARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CertificateARNSelector != nil {
+		in, out := &in.CertificateARNSelector, &out.CertificateARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainNameParameters.
@@ -1101,6 +1114,16 @@ func (in *CustomIntegrationParameters) DeepCopyInto(out *CustomIntegrationParame
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IntegrationURIRef != nil {
+		in, out := &in.IntegrationURIRef, &out.IntegrationURIRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IntegrationURISelector != nil {
+		in, out := &in.IntegrationURISelector, &out.IntegrationURISelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomIntegrationParameters.
@@ -1216,6 +1239,16 @@ func (in *CustomRouteParameters) DeepCopyInto(out *CustomRouteParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRouteParameters.
@@ -1493,6 +1526,7 @@ func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
 func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1727,7 +1761,7 @@ func (in *DomainNameParameters) DeepCopyInto(out *DomainNameParameters) {
 			(*out)[key] = outVal
 		}
 	}
-	out.CustomDomainNameParameters = in.CustomDomainNameParameters
+	in.CustomDomainNameParameters.DeepCopyInto(&out.CustomDomainNameParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainNameParameters.
@@ -1761,6 +1795,7 @@ func (in *DomainNameSpec) DeepCopy() *DomainNameSpec {
 func (in *DomainNameStatus) DeepCopyInto(out *DomainNameStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2211,6 +2246,7 @@ func (in *IntegrationResponseSpec) DeepCopy() *IntegrationResponseSpec {
 func (in *IntegrationResponseStatus) DeepCopyInto(out *IntegrationResponseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2310,6 +2346,7 @@ func (in *IntegrationSpec) DeepCopy() *IntegrationSpec {
 func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2645,6 +2682,7 @@ func (in *ModelSpec) DeepCopy() *ModelSpec {
 func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3162,6 +3200,7 @@ func (in *RouteResponseSpec) DeepCopy() *RouteResponseSpec {
 func (in *RouteResponseStatus) DeepCopyInto(out *RouteResponseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3296,6 +3335,7 @@ func (in *RouteSpec) DeepCopy() *RouteSpec {
 func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3624,6 +3664,7 @@ func (in *StageSpec) DeepCopy() *StageSpec {
 func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3967,6 +4008,7 @@ func (in *VPCLinkSpec) DeepCopy() *VPCLinkSpec {
 func (in *VPCLinkStatus) DeepCopyInto(out *VPCLinkStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -32,8 +33,10 @@ type IntegrationParameters struct {
 
 	ConnectionID *string `json:"connectionID,omitempty"`
 
+	// +kubebuilder:validation:Enum=INTERNET;VPC_LINK
 	ConnectionType *string `json:"connectionType,omitempty"`
 
+	// +kubebuilder:validation:Enum=CONVERT_TO_BINARY;CONVERT_TO_TEXT
 	ContentHandlingStrategy *string `json:"contentHandlingStrategy,omitempty"`
 
 	CredentialsARN *string `json:"credentialsARN,omitempty"`
@@ -45,10 +48,12 @@ type IntegrationParameters struct {
 	IntegrationSubtype *string `json:"integrationSubtype,omitempty"`
 
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=AWS;HTTP;MOCK;HTTP_PROXY;AWS_PROXY
 	IntegrationType *string `json:"integrationType"`
 
 	IntegrationURI *string `json:"integrationURI,omitempty"`
 
+	// +kubebuilder:validation:Enum=WHEN_NO_MATCH;NEVER;WHEN_NO_TEMPLATES
 	PassthroughBehavior *string `json:"passthroughBehavior,omitempty"`
 
 	PayloadFormatVersion *string `json:"payloadFormatVersion,omitempty"`
@@ -82,8 +87,9 @@ type IntegrationObservation struct {
 
 // IntegrationStatus defines the observed state of Integration.
 type IntegrationStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          IntegrationObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            IntegrationObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
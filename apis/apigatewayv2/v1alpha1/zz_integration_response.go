@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -30,6 +31,7 @@ type IntegrationResponseParameters struct {
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
 
+	// +kubebuilder:validation:Enum=CONVERT_TO_BINARY;CONVERT_TO_TEXT
 	ContentHandlingStrategy *string `json:"contentHandlingStrategy,omitempty"`
 
 	// +kubebuilder:validation:Required
@@ -56,8 +58,9 @@ type IntegrationResponseObservation struct {
 
 // IntegrationResponseStatus defines the observed state of IntegrationResponse.
 type IntegrationResponseStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          IntegrationResponseObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            IntegrationResponseObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
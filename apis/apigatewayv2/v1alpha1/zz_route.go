@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -34,6 +35,7 @@ type RouteParameters struct {
 
 	AuthorizationScopes []*string `json:"authorizationScopes,omitempty"`
 
+	// +kubebuilder:validation:Enum=NONE;AWS_IAM;CUSTOM;JWT
 	AuthorizationType *string `json:"authorizationType,omitempty"`
 
 	AuthorizerID *string `json:"authorizerID,omitempty"`
@@ -70,8 +72,9 @@ type RouteObservation struct {
 
 // RouteStatus defines the observed state of Route.
 type RouteStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RouteObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            RouteObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
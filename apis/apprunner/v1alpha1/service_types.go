@@ -0,0 +1,308 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Service states.
+const (
+	ServiceStatusRunning             = "RUNNING"
+	ServiceStatusOperationInProgress = "OPERATION_IN_PROGRESS"
+	ServiceStatusCreateFailed        = "CREATE_FAILED"
+	ServiceStatusDeleteFailed        = "DELETE_FAILED"
+	ServiceStatusPaused              = "PAUSED"
+	ServiceStatusDeleted             = "DELETED"
+)
+
+// ImageConfiguration configures how the image of a service is run.
+type ImageConfiguration struct {
+	// The port the application listens on. Defaults to 8080.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// The environment variables available to the running application.
+	// +optional
+	RuntimeEnvironmentVariables map[string]string `json:"runtimeEnvironmentVariables,omitempty"`
+
+	// The command the application is started with, overriding the default
+	// command of the image.
+	// +optional
+	StartCommand *string `json:"startCommand,omitempty"`
+}
+
+// ImageRepository is the image repository the service is deployed from.
+type ImageRepository struct {
+	// The URI of the repository the image is pulled from.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ecr/v1beta1.Repository
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/ecr/v1beta1.RepositoryURI()
+	// +optional
+	RepositoryURI *string `json:"repositoryUri,omitempty"`
+
+	// RepositoryURIRef is a reference to a Repository used to set
+	// RepositoryURI.
+	// +optional
+	RepositoryURIRef *xpv1.Reference `json:"repositoryUriRef,omitempty"`
+
+	// RepositoryURISelector selects a reference to a Repository used to set
+	// RepositoryURI.
+	// +optional
+	RepositoryURISelector *xpv1.Selector `json:"repositoryUriSelector,omitempty"`
+
+	// The tag of the image that is deployed.
+	// +kubebuilder:default=latest
+	// +optional
+	ImageTag string `json:"imageTag,omitempty"`
+
+	// The type of the repository, which is ECR for private and ECR_PUBLIC
+	// for public repositories.
+	// +kubebuilder:validation:Enum=ECR;ECR_PUBLIC
+	// +kubebuilder:default=ECR
+	// +optional
+	ImageRepositoryType string `json:"imageRepositoryType,omitempty"`
+
+	// The configuration the image is run with.
+	// +optional
+	ImageConfiguration *ImageConfiguration `json:"imageConfiguration,omitempty"`
+}
+
+// SourceConfiguration is the source the service is deployed from.
+type SourceConfiguration struct {
+	// The image repository the service is deployed from.
+	ImageRepository ImageRepository `json:"imageRepository"`
+
+	// Whether a new image pushed to the repository is deployed
+	// automatically.
+	// +optional
+	AutoDeploymentsEnabled *bool `json:"autoDeploymentsEnabled,omitempty"`
+
+	// The ARN of the IAM role App Runner assumes to pull images from a
+	// private repository.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +optional
+	AccessRoleARN *string `json:"accessRoleArn,omitempty"`
+
+	// AccessRoleARNRef is a reference to a Role used to set AccessRoleARN.
+	// +optional
+	AccessRoleARNRef *xpv1.Reference `json:"accessRoleArnRef,omitempty"`
+
+	// AccessRoleARNSelector selects a reference to a Role used to set
+	// AccessRoleARN.
+	// +optional
+	AccessRoleARNSelector *xpv1.Selector `json:"accessRoleArnSelector,omitempty"`
+}
+
+// InstanceConfiguration configures the instances the service runs on.
+type InstanceConfiguration struct {
+	// The number of CPU units reserved for each instance.
+	// +kubebuilder:validation:Enum="256";"512";"1024";"2048";"4096"
+	// +optional
+	CPU *string `json:"cpu,omitempty"`
+
+	// The amount of memory, in MiB, reserved for each instance.
+	// +kubebuilder:validation:Enum="512";"1024";"2048";"3072";"4096";"6144";"8192";"10240";"12288"
+	// +optional
+	Memory *string `json:"memory,omitempty"`
+
+	// The ARN of the IAM role the application assumes to call AWS APIs.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +optional
+	InstanceRoleARN *string `json:"instanceRoleArn,omitempty"`
+
+	// InstanceRoleARNRef is a reference to a Role used to set
+	// InstanceRoleARN.
+	// +optional
+	InstanceRoleARNRef *xpv1.Reference `json:"instanceRoleArnRef,omitempty"`
+
+	// InstanceRoleARNSelector selects a reference to a Role used to set
+	// InstanceRoleARN.
+	// +optional
+	InstanceRoleARNSelector *xpv1.Selector `json:"instanceRoleArnSelector,omitempty"`
+}
+
+// HealthCheckConfiguration configures how the health of the instances is
+// checked.
+type HealthCheckConfiguration struct {
+	// The protocol used for health checks.
+	// +kubebuilder:validation:Enum=TCP;HTTP
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The URL path HTTP health checks are sent to.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The time, in seconds, between health checks.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	Interval *int64 `json:"interval,omitempty"`
+
+	// The time, in seconds, a health check waits for a response.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty"`
+
+	// The number of consecutive successful checks after which an instance
+	// is considered healthy.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	HealthyThreshold *int64 `json:"healthyThreshold,omitempty"`
+
+	// The number of consecutive failed checks after which an instance is
+	// considered unhealthy.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	UnhealthyThreshold *int64 `json:"unhealthyThreshold,omitempty"`
+}
+
+// EgressConfiguration configures the outgoing traffic of the service.
+type EgressConfiguration struct {
+	// Whether outgoing traffic is sent to the internet (DEFAULT) or through
+	// a VPC connector into a VPC (VPC).
+	// +kubebuilder:validation:Enum=DEFAULT;VPC
+	// +optional
+	EgressType *string `json:"egressType,omitempty"`
+
+	// The ARN of the VPC connector, required when the egress type is VPC.
+	// +optional
+	VPCConnectorARN *string `json:"vpcConnectorArn,omitempty"`
+}
+
+// IngressConfiguration configures the incoming traffic of the service.
+type IngressConfiguration struct {
+	// Whether the service can be reached from the internet.
+	// +optional
+	IsPubliclyAccessible *bool `json:"isPubliclyAccessible,omitempty"`
+}
+
+// NetworkConfiguration configures the network traffic of the service.
+type NetworkConfiguration struct {
+	// The configuration of the outgoing traffic.
+	// +optional
+	EgressConfiguration *EgressConfiguration `json:"egressConfiguration,omitempty"`
+
+	// The configuration of the incoming traffic.
+	// +optional
+	IngressConfiguration *IngressConfiguration `json:"ingressConfiguration,omitempty"`
+}
+
+// ServiceParameters define the desired state of an App Runner service.
+type ServiceParameters struct {
+	// Region is the region you'd like your Service to be created in.
+	Region string `json:"region"`
+
+	// The name of the service.
+	// +immutable
+	ServiceName string `json:"serviceName"`
+
+	// The source the service is deployed from.
+	SourceConfiguration SourceConfiguration `json:"sourceConfiguration"`
+
+	// The configuration of the instances the service runs on.
+	// +optional
+	InstanceConfiguration *InstanceConfiguration `json:"instanceConfiguration,omitempty"`
+
+	// The ARN of the auto scaling configuration of the service. The default
+	// configuration of the account is used when omitted.
+	// +optional
+	AutoScalingConfigurationARN *string `json:"autoScalingConfigurationArn,omitempty"`
+
+	// The health check configuration of the service.
+	// +optional
+	HealthCheckConfiguration *HealthCheckConfiguration `json:"healthCheckConfiguration,omitempty"`
+
+	// The network configuration of the service.
+	// +optional
+	NetworkConfiguration *NetworkConfiguration `json:"networkConfiguration,omitempty"`
+
+	// The ARN of the KMS key the source and the logs of the service are
+	// encrypted with. An AWS managed key is used when omitted.
+	// +immutable
+	// +optional
+	KMSKey *string `json:"kmsKey,omitempty"`
+
+	// The tags of the service.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ServiceSpec defines the desired state of a Service.
+type ServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceParameters `json:"forProvider"`
+}
+
+// ServiceObservation keeps the state for the external resource
+type ServiceObservation struct {
+	// The Amazon Resource Name (ARN) of the service.
+	ServiceARN string `json:"serviceArn,omitempty"`
+
+	// The ID of the service.
+	ServiceID string `json:"serviceId,omitempty"`
+
+	// The domain name the service can be reached at.
+	ServiceURL string `json:"serviceUrl,omitempty"`
+
+	// The status of the service.
+	Status string `json:"status,omitempty"`
+}
+
+// A ServiceStatus represents the observed state of a Service.
+type ServiceStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents an App Runner service,
+// which runs a container image without a cluster to manage. The external
+// name of a Service is the ARN of the App Runner service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.serviceUrl"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Services
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
@@ -610,6 +610,7 @@ func (in *WorkGroupSpec) DeepCopy() *WorkGroupSpec {
 func (in *WorkGroupStatus) DeepCopyInto(out *WorkGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
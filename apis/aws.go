@@ -24,13 +24,16 @@ import (
 	acmv1beta1 "github.com/crossplane/provider-aws/apis/acm/v1beta1"
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 	acmpcav1beta1 "github.com/crossplane/provider-aws/apis/acmpca/v1beta1"
+	apigatewayv1alpha1 "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
 	apigatewayv2 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	apprunnerv1alpha1 "github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
 	athenav1alpha1 "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
 	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	cloudfrontv1alpha1 "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	cloudwatchlogsv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
 	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	daxv1alpha1 "github.com/crossplane/provider-aws/apis/dax/v1alpha1"
 	docdbv1alpha1 "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
 	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	ec2manualv1alpha1 "github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
@@ -38,6 +41,7 @@ import (
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	ecrv1alpha1 "github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
 	ecrv1beta1 "github.com/crossplane/provider-aws/apis/ecr/v1beta1"
+	ecsv1alpha1 "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
 	efsv1alpha1 "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
 	eksmanualv1alpha1 "github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
 	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
@@ -45,8 +49,11 @@ import (
 	elasticachev1alpha1 "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
 	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
 	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	globalacceleratorv1alpha1 "github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
 	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	imagebuilderv1alpha1 "github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
 	iotv1alpha1 "github.com/crossplane/provider-aws/apis/iot/v1alpha1"
 	kafkav1alpha1 "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
 	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
@@ -63,6 +70,7 @@ import (
 	route53resolverv1alpha1 "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
 	s3v1alpha2 "github.com/crossplane/provider-aws/apis/s3/v1alpha3"
 	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	s3controlv1alpha1 "github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
 	secretsmanagerv1alpha1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
 	servicediscoveryv1alpha1 "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
 	sfnv1alpha1 "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
@@ -82,6 +90,7 @@ func init() {
 		docdbv1alpha1.AddToScheme,
 		elasticloadbalancingv1alpha1.SchemeBuilder.AddToScheme,
 		iamv1beta1.SchemeBuilder.AddToScheme,
+		iamv1alpha1.SchemeBuilder.AddToScheme,
 		elasticachev1alpha1.SchemeBuilder.AddToScheme,
 		elbv2v1alpha1.SchemeBuilder.AddToScheme,
 		route53v1alpha1.SchemeBuilder.AddToScheme,
@@ -93,6 +102,7 @@ func init() {
 		acmv1beta1.SchemeBuilder.AddToScheme,
 		s3v1alpha2.SchemeBuilder.AddToScheme,
 		s3v1beta1.SchemeBuilder.AddToScheme,
+		s3controlv1alpha1.SchemeBuilder.AddToScheme,
 		secretsmanagerv1alpha1.SchemeBuilder.AddToScheme,
 		servicediscoveryv1alpha1.SchemeBuilder.AddToScheme,
 		acmpcav1alpha1.SchemeBuilder.AddToScheme,
@@ -104,9 +114,13 @@ func init() {
 		eksmanualv1alpha1.SchemeBuilder.AddToScheme,
 		ecrv1alpha1.SchemeBuilder.AddToScheme,
 		ecrv1beta1.SchemeBuilder.AddToScheme,
+		ecsv1alpha1.SchemeBuilder.AddToScheme,
 		apigatewayv2.SchemeBuilder.AddToScheme,
+		apigatewayv1alpha1.SchemeBuilder.AddToScheme,
+		apprunnerv1alpha1.SchemeBuilder.AddToScheme,
 		sfnv1alpha1.SchemeBuilder.AddToScheme,
 		dynamodbv1alpha1.SchemeBuilder.AddToScheme,
+		daxv1alpha1.SchemeBuilder.AddToScheme,
 		kmsv1alpha1.SchemeBuilder.AddToScheme,
 		efsv1alpha1.SchemeBuilder.AddToScheme,
 		rdsv1alpha1.SchemeBuilder.AddToScheme,
@@ -127,6 +141,8 @@ func init() {
 		kinesisv1alpha1.SchemeBuilder.AddToScheme,
 		neptunev1alpha1.SchemeBuilder.AddToScheme,
 		snsv1beta1.SchemeBuilder.AddToScheme,
+		imagebuilderv1alpha1.SchemeBuilder.AddToScheme,
+		globalacceleratorv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
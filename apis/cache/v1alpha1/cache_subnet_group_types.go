@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -57,8 +58,9 @@ type CacheSubnetGroupExternalStatus struct {
 
 // A CacheSubnetGroupStatus represents the observed state of a Subnet Group.
 type CacheSubnetGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CacheSubnetGroupExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            CacheSubnetGroupExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
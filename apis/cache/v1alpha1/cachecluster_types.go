@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // CacheCluster states.
@@ -313,8 +315,9 @@ type CacheClusterSpec struct {
 
 // A CacheClusterStatus defines the observed state of a CacheCluster.
 type CacheClusterStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CacheClusterObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            CacheClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -279,6 +279,7 @@ func (in *CacheClusterSpec) DeepCopy() *CacheClusterSpec {
 func (in *CacheClusterStatus) DeepCopyInto(out *CacheClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -462,6 +463,7 @@ func (in *CacheSubnetGroupSpec) DeepCopy() *CacheSubnetGroupSpec {
 func (in *CacheSubnetGroupStatus) DeepCopyInto(out *CacheSubnetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
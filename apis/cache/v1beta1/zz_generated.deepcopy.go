@@ -420,6 +420,7 @@ func (in *ReplicationGroupSpec) DeepCopy() *ReplicationGroupSpec {
 func (in *ReplicationGroupStatus) DeepCopyInto(out *ReplicationGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -16,8 +16,118 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
 // CustomDistributionParameters includes the custom fields of Distribution.
-type CustomDistributionParameters struct{}
+type CustomDistributionParameters struct {
+	// CachePolicyRefs set the cache policy of cache behaviors of the
+	// distribution by referencing CachePolicies.
+	// +optional
+	CachePolicyRefs []CachePolicyReference `json:"cachePolicyRefs,omitempty"`
+
+	// FunctionAssociations set the CloudFront functions of cache behaviors of
+	// the distribution. Cache behaviors that are not listed have no
+	// functions.
+	// +optional
+	FunctionAssociations []CacheBehaviorFunctionAssociation `json:"functionAssociations,omitempty"`
+
+	// OriginAccessControls set the origin access control of origins of the
+	// distribution. Origins that are not listed have no origin access
+	// control.
+	// +optional
+	OriginAccessControls []OriginAccessControlAssociation `json:"originAccessControls,omitempty"`
+
+	// ResponseHeadersPolicies set the response headers policy of cache
+	// behaviors of the distribution. Cache behaviors that are not listed have
+	// no response headers policy.
+	// +optional
+	ResponseHeadersPolicies []ResponseHeadersPolicyAssociation `json:"responseHeadersPolicies,omitempty"`
+}
+
+// A CachePolicyReference sets the cache policy ID of a cache behavior of a
+// Distribution to the ID of a CachePolicy.
+type CachePolicyReference struct {
+	// PathPattern of the cache behavior in distributionConfig.cacheBehaviors
+	// whose cache policy is set. The default cache behavior is used if it is
+	// omitted.
+	// +optional
+	PathPattern *string `json:"pathPattern,omitempty"`
+
+	// CachePolicyIDRef references a CachePolicy to retrieve its ID.
+	// +optional
+	CachePolicyIDRef *xpv1.Reference `json:"cachePolicyIDRef,omitempty"`
+
+	// CachePolicyIDSelector selects a reference to a CachePolicy to retrieve
+	// its ID.
+	// +optional
+	CachePolicyIDSelector *xpv1.Selector `json:"cachePolicyIDSelector,omitempty"`
+}
+
+// A CacheBehaviorFunctionAssociation associates a CloudFront function with
+// a cache behavior of a Distribution. The function must be published to the
+// LIVE stage.
+type CacheBehaviorFunctionAssociation struct {
+	// PathPattern of the cache behavior in distributionConfig.cacheBehaviors
+	// the function is associated with. The default cache behavior is used if
+	// it is omitted.
+	// +optional
+	PathPattern *string `json:"pathPattern,omitempty"`
+
+	// EventType that triggers the function.
+	// +kubebuilder:validation:Enum=viewer-request;viewer-response
+	EventType string `json:"eventType"`
+
+	// FunctionARN is the ARN of the function.
+	// +optional
+	FunctionARN *string `json:"functionARN,omitempty"`
+
+	// FunctionARNRef references a Function to retrieve its ARN.
+	// +optional
+	FunctionARNRef *xpv1.Reference `json:"functionARNRef,omitempty"`
+
+	// FunctionARNSelector selects a reference to a Function to retrieve its
+	// ARN.
+	// +optional
+	FunctionARNSelector *xpv1.Selector `json:"functionARNSelector,omitempty"`
+}
+
+// An OriginAccessControlAssociation sets the origin access control of an
+// origin of a Distribution.
+type OriginAccessControlAssociation struct {
+	// OriginID is the ID of the origin in distributionConfig.origins whose
+	// origin access control is set.
+	OriginID string `json:"originID"`
+
+	// OriginAccessControlID is the ID of the origin access control.
+	// +optional
+	OriginAccessControlID *string `json:"originAccessControlID,omitempty"`
+
+	// OriginAccessControlIDRef references an OriginAccessControl to retrieve
+	// its ID.
+	// +optional
+	OriginAccessControlIDRef *xpv1.Reference `json:"originAccessControlIDRef,omitempty"`
+
+	// OriginAccessControlIDSelector selects a reference to an
+	// OriginAccessControl to retrieve its ID.
+	// +optional
+	OriginAccessControlIDSelector *xpv1.Selector `json:"originAccessControlIDSelector,omitempty"`
+}
+
+// A ResponseHeadersPolicyAssociation sets the response headers policy of a
+// cache behavior of a Distribution. Response headers policies are not managed
+// by this provider, so they are set by ID.
+type ResponseHeadersPolicyAssociation struct {
+	// PathPattern of the cache behavior in distributionConfig.cacheBehaviors
+	// whose response headers policy is set. The default cache behavior is
+	// used if it is omitted.
+	// +optional
+	PathPattern *string `json:"pathPattern,omitempty"`
+
+	// ResponseHeadersPolicyID is the ID of the response headers policy.
+	ResponseHeadersPolicyID string `json:"responseHeadersPolicyID"`
+}
 
 // CustomCachePolicyParameters includes the custom fields of CachePolicy.
 type CustomCachePolicyParameters struct{}
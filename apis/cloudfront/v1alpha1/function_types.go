@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// FunctionParameters define the desired state of a CloudFront function. The
+// external name of the function is its name.
+type FunctionParameters struct {
+	// Region is the region the API calls are made to. CloudFront is a global
+	// service, so this is usually us-east-1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Comment describes the function.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// Runtime of the function. A function needs the cloudfront-js-2.0
+	// runtime to read from a key value store.
+	// +optional
+	// +kubebuilder:validation:Enum=cloudfront-js-1.0;cloudfront-js-2.0
+	// +kubebuilder:default=cloudfront-js-1.0
+	Runtime string `json:"runtime,omitempty"`
+
+	// Code is the JavaScript code of the function.
+	// +optional
+	Code *string `json:"code,omitempty"`
+
+	// CodeConfigMapRef selects a key of a ConfigMap that holds the code of the
+	// function. It takes precedence over Code.
+	// +optional
+	CodeConfigMapRef *awsv1beta1.ConfigMapKeySelector `json:"codeConfigMapRef,omitempty"`
+
+	// KeyValueStoreARN is the ARN of the key value store the function reads
+	// from. A function can be associated with a single key value store.
+	// +optional
+	KeyValueStoreARN *string `json:"keyValueStoreArn,omitempty"`
+
+	// KeyValueStoreARNRef is a reference to a KeyValueStore used to set
+	// KeyValueStoreARN.
+	// +optional
+	KeyValueStoreARNRef *xpv1.Reference `json:"keyValueStoreArnRef,omitempty"`
+
+	// KeyValueStoreARNSelector selects a reference to a KeyValueStore used to
+	// set KeyValueStoreARN.
+	// +optional
+	KeyValueStoreARNSelector *xpv1.Selector `json:"keyValueStoreArnSelector,omitempty"`
+
+	// Publish copies the DEVELOPMENT stage of the function to its LIVE stage
+	// whenever the code or configuration of the function changes. Only the
+	// LIVE stage is run by the distributions the function is associated
+	// with.
+	// +optional
+	Publish *bool `json:"publish,omitempty"`
+}
+
+// A FunctionSpec defines the desired state of a Function.
+type FunctionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FunctionParameters `json:"forProvider"`
+}
+
+// FunctionObservation keeps the state for the external resource
+type FunctionObservation struct {
+	// FunctionARN is the ARN of the function. It is the same for both stages.
+	FunctionARN string `json:"functionArn,omitempty"`
+
+	// Status of the DEVELOPMENT stage of the function.
+	Status string `json:"status,omitempty"`
+
+	// ETag is the version of the DEVELOPMENT stage of the function. It is
+	// required to update, publish or delete it.
+	ETag string `json:"eTag,omitempty"`
+
+	// Published is true if the LIVE stage of the function runs the desired
+	// code and configuration.
+	Published bool `json:"published,omitempty"`
+}
+
+// A FunctionStatus represents the observed state of a Function.
+type FunctionStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            FunctionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Function is a managed resource that represents an AWS CloudFront
+// function, lightweight JavaScript code that is run at the edge locations
+// for the cache behaviors of a Distribution it is associated with.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PUBLISHED",type="string",JSONPath=".status.atProvider.published"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Function struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionSpec   `json:"spec"`
+	Status FunctionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FunctionList contains a list of Functions
+type FunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Function `json:"items"`
+}
+
+// Function type metadata.
+var (
+	FunctionKind             = "Function"
+	FunctionGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: FunctionKind}.String()
+	FunctionKindAPIVersion   = FunctionKind + "." + GroupVersion.String()
+	FunctionGroupVersionKind = GroupVersion.WithKind(FunctionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Function{}, &FunctionList{})
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Statuses of a key value store.
+const (
+	KeyValueStoreStatusProvisioning = "PROVISIONING"
+	KeyValueStoreStatusReady        = "READY"
+	KeyValueStoreStatusFailed       = "FAILED"
+)
+
+// ImportSource is the source the initial key value pairs of a key value
+// store are imported from.
+type ImportSource struct {
+	// SourceType is the type of the source.
+	// +kubebuilder:validation:Enum=S3
+	SourceType string `json:"sourceType"`
+
+	// SourceARN is the ARN of the S3 object that holds the key value pairs.
+	SourceARN string `json:"sourceArn"`
+}
+
+// KeyValueStoreParameters define the desired state of a CloudFront key value
+// store. The external name of the key value store is its name.
+type KeyValueStoreParameters struct {
+	// Region is the region the API calls are made to. CloudFront is a global
+	// service, so this is usually us-east-1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Comment describes the key value store.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// ImportSource is imported into the key value store when it is created.
+	// Later changes to the key value pairs are not managed by the resource.
+	// +optional
+	// +immutable
+	ImportSource *ImportSource `json:"importSource,omitempty"`
+}
+
+// A KeyValueStoreSpec defines the desired state of a KeyValueStore.
+type KeyValueStoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyValueStoreParameters `json:"forProvider"`
+}
+
+// KeyValueStoreObservation keeps the state for the external resource
+type KeyValueStoreObservation struct {
+	// ARN of the key value store, which functions are associated with.
+	ARN string `json:"arn,omitempty"`
+
+	// ID of the key value store, which the key value pairs are read and
+	// written with.
+	ID string `json:"id,omitempty"`
+
+	// Status of the key value store.
+	Status string `json:"status,omitempty"`
+
+	// ETag is the version of the key value store. It is required to update
+	// or delete it.
+	ETag string `json:"eTag,omitempty"`
+}
+
+// A KeyValueStoreStatus represents the observed state of a KeyValueStore.
+type KeyValueStoreStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            KeyValueStoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyValueStore is a managed resource that represents an AWS CloudFront
+// key value store, a global data store of key value pairs that is read by the
+// Functions it is associated with.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type KeyValueStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyValueStoreSpec   `json:"spec"`
+	Status KeyValueStoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyValueStoreList contains a list of KeyValueStores
+type KeyValueStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyValueStore `json:"items"`
+}
+
+// KeyValueStore type metadata.
+var (
+	KeyValueStoreKind             = "KeyValueStore"
+	KeyValueStoreGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: KeyValueStoreKind}.String()
+	KeyValueStoreKindAPIVersion   = KeyValueStoreKind + "." + GroupVersion.String()
+	KeyValueStoreGroupVersionKind = GroupVersion.WithKind(KeyValueStoreKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KeyValueStore{}, &KeyValueStoreList{})
+}
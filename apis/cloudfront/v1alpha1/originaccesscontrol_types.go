@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// OriginAccessControlParameters define the desired state of a CloudFront
+// origin access control. The external name of the origin access control is
+// the identifier assigned by CloudFront.
+type OriginAccessControlParameters struct {
+	// Region is the region the API calls are made to. CloudFront is a global
+	// service, so this is usually us-east-1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Name identifies the origin access control. It must be unique within the
+	// account.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Description of the origin access control.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OriginType is the type of origin the origin access control is for.
+	// +optional
+	// +kubebuilder:validation:Enum=s3
+	// +kubebuilder:default=s3
+	OriginType string `json:"originType,omitempty"`
+
+	// SigningBehavior specifies which requests CloudFront signs: always signs
+	// all origin requests, never turns off signing and no-override only signs
+	// requests without an Authorization header.
+	// +optional
+	// +kubebuilder:validation:Enum=always;never;no-override
+	// +kubebuilder:default=always
+	SigningBehavior string `json:"signingBehavior,omitempty"`
+
+	// SigningProtocol determines how CloudFront signs origin requests.
+	// +optional
+	// +kubebuilder:validation:Enum=sigv4
+	// +kubebuilder:default=sigv4
+	SigningProtocol string `json:"signingProtocol,omitempty"`
+}
+
+// An OriginAccessControlSpec defines the desired state of an
+// OriginAccessControl.
+type OriginAccessControlSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OriginAccessControlParameters `json:"forProvider"`
+}
+
+// OriginAccessControlObservation keeps the state for the external resource
+type OriginAccessControlObservation struct {
+	// ETag is the version of the origin access control. It is required to
+	// update or delete it.
+	ETag string `json:"eTag,omitempty"`
+}
+
+// An OriginAccessControlStatus represents the observed state of an
+// OriginAccessControl.
+type OriginAccessControlStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            OriginAccessControlObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OriginAccessControl is a managed resource that represents an AWS
+// CloudFront origin access control, which lets the origins of a Distribution
+// only accept requests signed by CloudFront.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OriginAccessControl struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OriginAccessControlSpec   `json:"spec"`
+	Status OriginAccessControlStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OriginAccessControlList contains a list of OriginAccessControls
+type OriginAccessControlList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OriginAccessControl `json:"items"`
+}
+
+// OriginAccessControl type metadata.
+var (
+	OriginAccessControlKind             = "OriginAccessControl"
+	OriginAccessControlGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: OriginAccessControlKind}.String()
+	OriginAccessControlKindAPIVersion   = OriginAccessControlKind + "." + GroupVersion.String()
+	OriginAccessControlGroupVersionKind = GroupVersion.WithKind(OriginAccessControlKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OriginAccessControl{}, &OriginAccessControlList{})
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FunctionARN returns the ARN of a Function.
+func FunctionARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Function)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.FunctionARN
+	}
+}
+
+// KeyValueStoreARN returns the ARN of a KeyValueStore.
+func KeyValueStoreARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*KeyValueStore)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
+// DistributionDomainName returns the domain name CloudFront assigned to a
+// Distribution.
+func DistributionDomainName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Distribution)
+		if !ok || r.Status.AtProvider.Distribution == nil {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.Distribution.DomainName)
+	}
+}
+
+// DistributionHostedZoneID returns the ID of the Route 53 hosted zone of a
+// Distribution, which is the same for all distributions.
+func DistributionHostedZoneID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if _, ok := mg.(*Distribution); !ok {
+			return ""
+		}
+		return "Z2FDTNDATAQYW2"
+	}
+}
+
+// ResolveReferences of this Distribution
+func (mg *Distribution) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	cfg := mg.Spec.ForProvider.DistributionConfig
+
+	// Resolve spec.forProvider.cachePolicyRefs
+	for i, ref := range mg.Spec.ForProvider.CachePolicyRefs {
+		path := fmt.Sprintf("spec.forProvider.cachePolicyRefs[%d]", i)
+		id, err := cachePolicyID(cfg, ref.PathPattern)
+		if err != nil {
+			return errors.Wrap(err, path)
+		}
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(*id),
+			Reference:    ref.CachePolicyIDRef,
+			Selector:     ref.CachePolicyIDSelector,
+			To:           reference.To{Managed: &CachePolicy{}, List: &CachePolicyList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, path)
+		}
+		*id = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CachePolicyRefs[i].CachePolicyIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.functionAssociations
+	for i, a := range mg.Spec.ForProvider.FunctionAssociations {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.FunctionARN),
+			Reference:    a.FunctionARNRef,
+			Selector:     a.FunctionARNSelector,
+			To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+			Extract:      FunctionARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.functionAssociations[%d]", i))
+		}
+		mg.Spec.ForProvider.FunctionAssociations[i].FunctionARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.FunctionAssociations[i].FunctionARNRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.originAccessControls
+	for i, a := range mg.Spec.ForProvider.OriginAccessControls {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.OriginAccessControlID),
+			Reference:    a.OriginAccessControlIDRef,
+			Selector:     a.OriginAccessControlIDSelector,
+			To:           reference.To{Managed: &OriginAccessControl{}, List: &OriginAccessControlList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.originAccessControls[%d]", i))
+		}
+		mg.Spec.ForProvider.OriginAccessControls[i].OriginAccessControlID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.OriginAccessControls[i].OriginAccessControlIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Function
+func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.keyValueStoreArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KeyValueStoreARN),
+		Reference:    mg.Spec.ForProvider.KeyValueStoreARNRef,
+		Selector:     mg.Spec.ForProvider.KeyValueStoreARNSelector,
+		To:           reference.To{Managed: &KeyValueStore{}, List: &KeyValueStoreList{}},
+		Extract:      KeyValueStoreARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.keyValueStoreArn")
+	}
+	mg.Spec.ForProvider.KeyValueStoreARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KeyValueStoreARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// cachePolicyID returns the cache policy ID field of the cache behavior with
+// the supplied path pattern, or of the default cache behavior if it is nil.
+func cachePolicyID(cfg *DistributionConfig, pathPattern *string) (**string, error) {
+	if pathPattern == nil {
+		if cfg == nil || cfg.DefaultCacheBehavior == nil {
+			return nil, errors.New("no default cache behavior")
+		}
+		return &cfg.DefaultCacheBehavior.CachePolicyID, nil
+	}
+	if cfg != nil && cfg.CacheBehaviors != nil {
+		for _, b := range cfg.CacheBehaviors.Items {
+			if b != nil && b.PathPattern != nil && *b.PathPattern == *pathPattern {
+				return &b.CachePolicyID, nil
+			}
+		}
+	}
+	return nil, errors.Errorf("no cache behavior with path pattern %q", *pathPattern)
+}
@@ -22,6 +22,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -289,6 +291,41 @@ func (in *CacheBehavior) DeepCopy() *CacheBehavior {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheBehaviorFunctionAssociation) DeepCopyInto(out *CacheBehaviorFunctionAssociation) {
+	*out = *in
+	if in.PathPattern != nil {
+		in, out := &in.PathPattern, &out.PathPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionARN != nil {
+		in, out := &in.FunctionARN, &out.FunctionARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionARNRef != nil {
+		in, out := &in.FunctionARNRef, &out.FunctionARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionARNSelector != nil {
+		in, out := &in.FunctionARNSelector, &out.FunctionARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheBehaviorFunctionAssociation.
+func (in *CacheBehaviorFunctionAssociation) DeepCopy() *CacheBehaviorFunctionAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheBehaviorFunctionAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheBehaviors) DeepCopyInto(out *CacheBehaviors) {
 	*out = *in
@@ -591,6 +628,36 @@ func (in *CachePolicyQueryStringsConfig) DeepCopy() *CachePolicyQueryStringsConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicyReference) DeepCopyInto(out *CachePolicyReference) {
+	*out = *in
+	if in.PathPattern != nil {
+		in, out := &in.PathPattern, &out.PathPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.CachePolicyIDRef != nil {
+		in, out := &in.CachePolicyIDRef, &out.CachePolicyIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CachePolicyIDSelector != nil {
+		in, out := &in.CachePolicyIDSelector, &out.CachePolicyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicyReference.
+func (in *CachePolicyReference) DeepCopy() *CachePolicyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CachePolicySpec) DeepCopyInto(out *CachePolicySpec) {
 	*out = *in
@@ -612,6 +679,7 @@ func (in *CachePolicySpec) DeepCopy() *CachePolicySpec {
 func (in *CachePolicyStatus) DeepCopyInto(out *CachePolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -841,6 +909,7 @@ func (in *CloudFrontOriginAccessIdentitySpec) DeepCopy() *CloudFrontOriginAccess
 func (in *CloudFrontOriginAccessIdentityStatus) DeepCopyInto(out *CloudFrontOriginAccessIdentityStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1008,6 +1077,34 @@ func (in *CustomCloudFrontOriginAccessIdentityParameters) DeepCopy() *CustomClou
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomDistributionParameters) DeepCopyInto(out *CustomDistributionParameters) {
 	*out = *in
+	if in.CachePolicyRefs != nil {
+		in, out := &in.CachePolicyRefs, &out.CachePolicyRefs
+		*out = make([]CachePolicyReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FunctionAssociations != nil {
+		in, out := &in.FunctionAssociations, &out.FunctionAssociations
+		*out = make([]CacheBehaviorFunctionAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OriginAccessControls != nil {
+		in, out := &in.OriginAccessControls, &out.OriginAccessControls
+		*out = make([]OriginAccessControlAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResponseHeadersPolicies != nil {
+		in, out := &in.ResponseHeadersPolicies, &out.ResponseHeadersPolicies
+		*out = make([]ResponseHeadersPolicyAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDistributionParameters.
@@ -1560,7 +1657,7 @@ func (in *DistributionParameters) DeepCopyInto(out *DistributionParameters) {
 		*out = new(DistributionConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	out.CustomDistributionParameters = in.CustomDistributionParameters
+	in.CustomDistributionParameters.DeepCopyInto(&out.CustomDistributionParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionParameters.
@@ -1594,6 +1691,7 @@ func (in *DistributionSpec) DeepCopy() *DistributionSpec {
 func (in *DistributionStatus) DeepCopyInto(out *DistributionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2143,6 +2241,165 @@ func (in *ForwardedValues) DeepCopy() *ForwardedValues {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Function) DeepCopyInto(out *Function) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Function.
+func (in *Function) DeepCopy() *Function {
+	if in == nil {
+		return nil
+	}
+	out := new(Function)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Function) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionList) DeepCopyInto(out *FunctionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Function, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionList.
+func (in *FunctionList) DeepCopy() *FunctionList {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FunctionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionObservation) DeepCopyInto(out *FunctionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionObservation.
+func (in *FunctionObservation) DeepCopy() *FunctionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionParameters) DeepCopyInto(out *FunctionParameters) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(string)
+		**out = **in
+	}
+	if in.CodeConfigMapRef != nil {
+		in, out := &in.CodeConfigMapRef, &out.CodeConfigMapRef
+		*out = new(v1beta1.ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.KeyValueStoreARN != nil {
+		in, out := &in.KeyValueStoreARN, &out.KeyValueStoreARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyValueStoreARNRef != nil {
+		in, out := &in.KeyValueStoreARNRef, &out.KeyValueStoreARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KeyValueStoreARNSelector != nil {
+		in, out := &in.KeyValueStoreARNSelector, &out.KeyValueStoreARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Publish != nil {
+		in, out := &in.Publish, &out.Publish
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionParameters.
+func (in *FunctionParameters) DeepCopy() *FunctionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionSpec) DeepCopyInto(out *FunctionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionSpec.
+func (in *FunctionSpec) DeepCopy() *FunctionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionStatus) DeepCopyInto(out *FunctionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionStatus.
+func (in *FunctionStatus) DeepCopy() *FunctionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GeoRestriction) DeepCopyInto(out *GeoRestriction) {
 	*out = *in
@@ -2210,6 +2467,21 @@ func (in *Headers) DeepCopy() *Headers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportSource) DeepCopyInto(out *ImportSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportSource.
+func (in *ImportSource) DeepCopy() *ImportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Invalidation) DeepCopyInto(out *Invalidation) {
 	*out = *in
@@ -2463,6 +2735,140 @@ func (in *KeyPairIDs) DeepCopy() *KeyPairIDs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyValueStore) DeepCopyInto(out *KeyValueStore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyValueStore.
+func (in *KeyValueStore) DeepCopy() *KeyValueStore {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyValueStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyValueStore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyValueStoreList) DeepCopyInto(out *KeyValueStoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyValueStore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyValueStoreList.
+func (in *KeyValueStoreList) DeepCopy() *KeyValueStoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyValueStoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyValueStoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyValueStoreObservation) DeepCopyInto(out *KeyValueStoreObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyValueStoreObservation.
+func (in *KeyValueStoreObservation) DeepCopy() *KeyValueStoreObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyValueStoreObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyValueStoreParameters) DeepCopyInto(out *KeyValueStoreParameters) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportSource != nil {
+		in, out := &in.ImportSource, &out.ImportSource
+		*out = new(ImportSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyValueStoreParameters.
+func (in *KeyValueStoreParameters) DeepCopy() *KeyValueStoreParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyValueStoreParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyValueStoreSpec) DeepCopyInto(out *KeyValueStoreSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyValueStoreSpec.
+func (in *KeyValueStoreSpec) DeepCopy() *KeyValueStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyValueStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyValueStoreStatus) DeepCopyInto(out *KeyValueStoreStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyValueStoreStatus.
+func (in *KeyValueStoreStatus) DeepCopy() *KeyValueStoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyValueStoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KinesisStreamConfig) DeepCopyInto(out *KinesisStreamConfig) {
 	*out = *in
@@ -2644,6 +3050,165 @@ func (in *Origin) DeepCopy() *Origin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginAccessControl) DeepCopyInto(out *OriginAccessControl) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginAccessControl.
+func (in *OriginAccessControl) DeepCopy() *OriginAccessControl {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginAccessControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OriginAccessControl) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginAccessControlAssociation) DeepCopyInto(out *OriginAccessControlAssociation) {
+	*out = *in
+	if in.OriginAccessControlID != nil {
+		in, out := &in.OriginAccessControlID, &out.OriginAccessControlID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OriginAccessControlIDRef != nil {
+		in, out := &in.OriginAccessControlIDRef, &out.OriginAccessControlIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OriginAccessControlIDSelector != nil {
+		in, out := &in.OriginAccessControlIDSelector, &out.OriginAccessControlIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginAccessControlAssociation.
+func (in *OriginAccessControlAssociation) DeepCopy() *OriginAccessControlAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginAccessControlAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginAccessControlList) DeepCopyInto(out *OriginAccessControlList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OriginAccessControl, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginAccessControlList.
+func (in *OriginAccessControlList) DeepCopy() *OriginAccessControlList {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginAccessControlList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OriginAccessControlList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginAccessControlObservation) DeepCopyInto(out *OriginAccessControlObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginAccessControlObservation.
+func (in *OriginAccessControlObservation) DeepCopy() *OriginAccessControlObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginAccessControlObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginAccessControlParameters) DeepCopyInto(out *OriginAccessControlParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginAccessControlParameters.
+func (in *OriginAccessControlParameters) DeepCopy() *OriginAccessControlParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginAccessControlParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginAccessControlSpec) DeepCopyInto(out *OriginAccessControlSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginAccessControlSpec.
+func (in *OriginAccessControlSpec) DeepCopy() *OriginAccessControlSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginAccessControlSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginAccessControlStatus) DeepCopyInto(out *OriginAccessControlStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginAccessControlStatus.
+func (in *OriginAccessControlStatus) DeepCopy() *OriginAccessControlStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginAccessControlStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OriginAccessIdentity) DeepCopyInto(out *OriginAccessIdentity) {
 	*out = *in
@@ -3533,6 +4098,26 @@ func (in *RealtimeLogConfigs) DeepCopy() *RealtimeLogConfigs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseHeadersPolicyAssociation) DeepCopyInto(out *ResponseHeadersPolicyAssociation) {
+	*out = *in
+	if in.PathPattern != nil {
+		in, out := &in.PathPattern, &out.PathPattern
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseHeadersPolicyAssociation.
+func (in *ResponseHeadersPolicyAssociation) DeepCopy() *ResponseHeadersPolicyAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseHeadersPolicyAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Restrictions) DeepCopyInto(out *Restrictions) {
 	*out = *in
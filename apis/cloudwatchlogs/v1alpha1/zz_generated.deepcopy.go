@@ -317,6 +317,7 @@ func (in *LogGroupSpec) DeepCopy() *LogGroupSpec {
 func (in *LogGroupStatus) DeepCopyInto(out *LogGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -49,8 +50,9 @@ type LogGroupObservation struct {
 
 // LogGroupStatus defines the observed state of LogGroup.
 type LogGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          LogGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            LogGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
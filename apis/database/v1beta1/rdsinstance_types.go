@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // SQL database engines.
@@ -873,12 +875,13 @@ const (
 
 // DBParameterGroupStatus is the status of the DB parameter group.
 // This data type is used as a response element in the following actions:
-//    * CreateDBInstance
-//    * CreateDBInstanceReadReplica
-//    * DeleteDBInstance
-//    * ModifyDBInstance
-//    * RebootDBInstance
-//    * RestoreDBInstanceFromDBSnapshot
+//   - CreateDBInstance
+//   - CreateDBInstanceReadReplica
+//   - DeleteDBInstance
+//   - ModifyDBInstance
+//   - RebootDBInstance
+//   - RestoreDBInstanceFromDBSnapshot
+//
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/DBParameterGroupStatus
 type DBParameterGroupStatus struct {
 	// DBParameterGroupName is the name of the DP parameter group.
@@ -889,10 +892,11 @@ type DBParameterGroupStatus struct {
 }
 
 // DBSecurityGroupMembership is used as a response element in the following actions:
-//    * ModifyDBInstance
-//    * RebootDBInstance
-//    * RestoreDBInstanceFromDBSnapshot
-//    * RestoreDBInstanceToPointInTime
+//   - ModifyDBInstance
+//   - RebootDBInstance
+//   - RestoreDBInstanceFromDBSnapshot
+//   - RestoreDBInstanceToPointInTime
+//
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/DBSecurityGroupMembership
 type DBSecurityGroupMembership struct {
 	// DBSecurityGroupName is the name of the DB security group.
@@ -904,7 +908,8 @@ type DBSecurityGroupMembership struct {
 
 // AvailabilityZone contains Availability Zone information.
 // This data type is used as an element in the following data type:
-//    * OrderableDBInstanceOption
+//   - OrderableDBInstanceOption
+//
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/AvailabilityZone
 type AvailabilityZone struct {
 	// Name of the Availability Zone.
@@ -971,9 +976,10 @@ type DomainMembership struct {
 }
 
 // Endpoint is used as a response element in the following actions:
-//    * CreateDBInstance
-//    * DescribeDBInstances
-//    * DeleteDBInstance
+//   - CreateDBInstance
+//   - DescribeDBInstances
+//   - DeleteDBInstance
+//
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/Endpoint
 type Endpoint struct {
 	// Address specifies the DNS address of the DB instance.
@@ -1184,8 +1190,9 @@ type RDSInstanceObservation struct {
 
 // An RDSInstanceStatus represents the observed state of an RDSInstance.
 type RDSInstanceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RDSInstanceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            RDSInstanceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -271,6 +271,7 @@ func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
 func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -846,6 +847,7 @@ func (in *RDSInstanceSpec) DeepCopy() *RDSInstanceSpec {
 func (in *RDSInstanceStatus) DeepCopyInto(out *RDSInstanceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
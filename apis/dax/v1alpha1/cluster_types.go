@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Cluster states.
+const (
+	ClusterStatusAvailable = "available"
+	ClusterStatusCreating  = "creating"
+	ClusterStatusDeleting  = "deleting"
+	ClusterStatusModifying = "modifying"
+)
+
+// SSESpecification configures the encryption at rest of a cluster.
+type SSESpecification struct {
+	// Enabled indicates whether the data of the cluster is encrypted at
+	// rest.
+	Enabled bool `json:"enabled"`
+}
+
+// ClusterParameters define the desired state of an AWS DAX cluster. The
+// external name of the cluster is its name.
+type ClusterParameters struct {
+	// Region is the region the cluster is created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the cluster.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// NodeType is the compute and memory capacity of the nodes of the
+	// cluster, e.g. dax.r5.large.
+	// +immutable
+	NodeType string `json:"nodeType"`
+
+	// ReplicationFactor is the number of nodes of the cluster, including its
+	// primary node. Nodes are added and removed to match it.
+	// +kubebuilder:validation:Minimum=1
+	ReplicationFactor int64 `json:"replicationFactor"`
+
+	// AvailabilityZones the nodes of the cluster are created in. The nodes
+	// are spread across the availability zones of the subnet group if
+	// omitted.
+	// +optional
+	// +immutable
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// IAMRoleARN is the ARN of the role the cluster assumes to access
+	// DynamoDB tables on behalf of the application.
+	// +optional
+	// +immutable
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+
+	// IAMRoleARNRef references a Role to retrieve its ARN.
+	// +optional
+	IAMRoleARNRef *xpv1.Reference `json:"iamRoleArnRef,omitempty"`
+
+	// IAMRoleARNSelector selects a reference to a Role to retrieve its ARN.
+	// +optional
+	IAMRoleARNSelector *xpv1.Selector `json:"iamRoleArnSelector,omitempty"`
+
+	// SubnetGroupName is the name of the subnet group of the cluster.
+	// +optional
+	// +immutable
+	SubnetGroupName *string `json:"subnetGroupName,omitempty"`
+
+	// SubnetGroupNameRef references a SubnetGroup to retrieve its name.
+	// +optional
+	SubnetGroupNameRef *xpv1.Reference `json:"subnetGroupNameRef,omitempty"`
+
+	// SubnetGroupNameSelector selects a reference to a SubnetGroup to
+	// retrieve its name.
+	// +optional
+	SubnetGroupNameSelector *xpv1.Selector `json:"subnetGroupNameSelector,omitempty"`
+
+	// ParameterGroupName is the name of the parameter group of the cluster.
+	// +optional
+	ParameterGroupName *string `json:"parameterGroupName,omitempty"`
+
+	// ParameterGroupNameRef references a ParameterGroup to retrieve its
+	// name.
+	// +optional
+	ParameterGroupNameRef *xpv1.Reference `json:"parameterGroupNameRef,omitempty"`
+
+	// ParameterGroupNameSelector selects a reference to a ParameterGroup to
+	// retrieve its name.
+	// +optional
+	ParameterGroupNameSelector *xpv1.Selector `json:"parameterGroupNameSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the VPC security groups of the
+	// cluster.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set the
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// NotificationTopicARN is the ARN of the SNS topic that events of the
+	// cluster are sent to.
+	// +optional
+	NotificationTopicARN *string `json:"notificationTopicArn,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly time range in UTC during
+	// which maintenance of the cluster is performed, e.g.
+	// sun:05:00-sun:09:00.
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// SSESpecification configures the encryption at rest of the cluster.
+	// +optional
+	// +immutable
+	SSESpecification *SSESpecification `json:"sseSpecification,omitempty"`
+
+	// ClusterEndpointEncryptionType is the type of encryption of the
+	// endpoint of the cluster.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=NONE;TLS
+	ClusterEndpointEncryptionType *string `json:"clusterEndpointEncryptionType,omitempty"`
+
+	// Tags of the cluster.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterParameters `json:"forProvider"`
+}
+
+// ClusterObservation keeps the state for the external resource
+type ClusterObservation struct {
+	// ClusterARN is the ARN of the cluster.
+	ClusterARN string `json:"clusterArn,omitempty"`
+
+	// Status of the cluster.
+	Status string `json:"status,omitempty"`
+
+	// ClusterDiscoveryEndpoint is the address of the configuration endpoint
+	// of the cluster that clients connect to.
+	ClusterDiscoveryEndpoint string `json:"clusterDiscoveryEndpoint,omitempty"`
+
+	// TotalNodes is the number of nodes of the cluster.
+	TotalNodes int64 `json:"totalNodes,omitempty"`
+
+	// ActiveNodes is the number of nodes of the cluster that are available.
+	ActiveNodes int64 `json:"activeNodes,omitempty"`
+}
+
+// A ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is a managed resource that represents an AWS DynamoDB Accelerator
+// (DAX) cluster, an in-memory cache in front of DynamoDB tables.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="NODES",type="integer",JSONPath=".spec.forProvider.replicationFactor"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Clusters
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
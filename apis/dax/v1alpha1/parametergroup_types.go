@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ParameterNameValue is the value of a parameter of a parameter group.
+type ParameterNameValue struct {
+	// ParameterName is the name of the parameter, e.g. query-ttl-millis or
+	// record-ttl-millis.
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue"`
+}
+
+// ParameterGroupParameters define the desired state of an AWS DAX parameter
+// group. The external name of the parameter group is its name.
+type ParameterGroupParameters struct {
+	// Region is the region the parameter group is created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the parameter group.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// ParameterNameValues are the parameters of the group that are set to
+	// a value other than their default. Parameters that are omitted are left
+	// untouched.
+	// +optional
+	ParameterNameValues []ParameterNameValue `json:"parameterNameValues,omitempty"`
+}
+
+// A ParameterGroupSpec defines the desired state of a ParameterGroup.
+type ParameterGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ParameterGroupParameters `json:"forProvider"`
+}
+
+// ParameterGroupObservation keeps the state for the external resource
+type ParameterGroupObservation struct {
+	// ParameterGroupName is the name of the parameter group.
+	ParameterGroupName string `json:"parameterGroupName,omitempty"`
+}
+
+// A ParameterGroupStatus represents the observed state of a ParameterGroup.
+type ParameterGroupStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ParameterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ParameterGroup is a managed resource that represents the time to live
+// parameters of the caches of AWS DAX clusters.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ParameterGroupSpec   `json:"spec"`
+	Status ParameterGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ParameterGroupList contains a list of ParameterGroups
+type ParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ParameterGroup `json:"items"`
+}
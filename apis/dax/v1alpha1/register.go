@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "dax.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Cluster type metadata.
+var (
+	ClusterKind             = reflect.TypeOf(Cluster{}).Name()
+	ClusterGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterKind}.String()
+	ClusterKindAPIVersion   = ClusterKind + "." + SchemeGroupVersion.String()
+	ClusterGroupVersionKind = SchemeGroupVersion.WithKind(ClusterKind)
+)
+
+// ParameterGroup type metadata.
+var (
+	ParameterGroupKind             = reflect.TypeOf(ParameterGroup{}).Name()
+	ParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: ParameterGroupKind}.String()
+	ParameterGroupKindAPIVersion   = ParameterGroupKind + "." + SchemeGroupVersion.String()
+	ParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(ParameterGroupKind)
+)
+
+// SubnetGroup type metadata.
+var (
+	SubnetGroupKind             = reflect.TypeOf(SubnetGroup{}).Name()
+	SubnetGroupGroupKind        = schema.GroupKind{Group: Group, Kind: SubnetGroupKind}.String()
+	SubnetGroupKindAPIVersion   = SubnetGroupKind + "." + SchemeGroupVersion.String()
+	SubnetGroupGroupVersionKind = SchemeGroupVersion.WithKind(SubnetGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Cluster{}, &ClusterList{})
+	SchemeBuilder.Register(&ParameterGroup{}, &ParameterGroupList{})
+	SchemeBuilder.Register(&SubnetGroup{}, &SubnetGroupList{})
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// SubnetGroupParameters define the desired state of an AWS DAX subnet group.
+// The external name of the subnet group is its name.
+type SubnetGroupParameters struct {
+	// Region is the region the subnet group is created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description of the subnet group.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// SubnetIDs are the IDs of the VPC subnets the nodes of a cluster are
+	// placed in.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to Subnets used to set the SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+}
+
+// A SubnetGroupSpec defines the desired state of a SubnetGroup.
+type SubnetGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SubnetGroupParameters `json:"forProvider"`
+}
+
+// SubnetGroupObservation keeps the state for the external resource
+type SubnetGroupObservation struct {
+	// VPCID is the ID of the VPC the subnets of the subnet group belong to.
+	VPCID string `json:"vpcId,omitempty"`
+}
+
+// A SubnetGroupStatus represents the observed state of a SubnetGroup.
+type SubnetGroupStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            SubnetGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SubnetGroup is a managed resource that represents the VPC subnets an AWS
+// DAX cluster places its nodes in.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".status.atProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SubnetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetGroupSpec   `json:"spec"`
+	Status SubnetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubnetGroupList contains a list of SubnetGroups
+type SubnetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SubnetGroup `json:"items"`
+}
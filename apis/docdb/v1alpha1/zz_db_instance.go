@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -140,8 +141,9 @@ type DBInstanceObservation struct {
 
 // DBInstanceStatus defines the observed state of DBInstance.
 type DBInstanceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DBInstanceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            DBInstanceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
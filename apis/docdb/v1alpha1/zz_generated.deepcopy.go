@@ -665,6 +665,7 @@ func (in *DBClusterParameterGroupSpec) DeepCopy() *DBClusterParameterGroupSpec {
 func (in *DBClusterParameterGroupStatus) DeepCopyInto(out *DBClusterParameterGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1028,6 +1029,7 @@ func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
 func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1552,6 +1554,7 @@ func (in *DBInstanceSpec) DeepCopy() *DBInstanceSpec {
 func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1919,6 +1922,7 @@ func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
 func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -18,6 +18,18 @@ package v1alpha1
 
 import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+// TypeTransitionCooldown tables can't switch their billing mode or table class
+// yet, because AWS limits how often they can be switched.
+const TypeTransitionCooldown xpv1.ConditionType = "TransitionCooldown"
+
+// Reasons a table is, or is not, waiting to switch its billing mode or table
+// class.
+const (
+	ReasonBillingModeCooldown xpv1.ConditionReason = "BillingModeCooldown"
+	ReasonTableClassCooldown  xpv1.ConditionReason = "TableClassCooldown"
+	ReasonTransitionAllowed   xpv1.ConditionReason = "TransitionAllowed"
+)
+
 // CustomBackupParameters are custom parameters for Backup.
 type CustomBackupParameters struct {
 	// TableName is the name of the Table whose backup will be taken.
@@ -32,7 +44,155 @@ type CustomBackupParameters struct {
 }
 
 // CustomTableParameters are custom parameters for Table.
-type CustomTableParameters struct{}
+type CustomTableParameters struct {
+	// Replicas are the other regions the table is replicated to, making it a
+	// global table (version 2019.11.21). Replication requires DynamoDB Streams
+	// to be enabled with the NEW_AND_OLD_IMAGES stream view type. Replicas are
+	// created, updated and deleted one at a time, and the progress of each is
+	// reported in status.atProvider.replicas.
+	// +optional
+	Replicas []*TableReplica `json:"replicas,omitempty"`
+
+	// TableClass is the class of the table. AWS limits how often the billing
+	// mode and the class of a table can be switched; a switch that has to
+	// wait is reported by the TransitionCooldown condition and made once it
+	// is allowed. The class is left untouched when omitted.
+	// +optional
+	// +kubebuilder:validation:Enum=STANDARD;STANDARD_INFREQUENT_ACCESS
+	TableClass *string `json:"tableClass,omitempty"`
+
+	// AutoScaling registers the read and write capacity of the table and of
+	// its global secondary indexes with Application Auto Scaling. Once a
+	// capacity is scaled, its provisioned throughput is only used to create
+	// the table or index and left to the scaling policy afterwards. Capacities
+	// that are omitted are left untouched, and the scalable targets are
+	// deregistered when the table is deleted.
+	// +optional
+	AutoScaling *TableAutoScaling `json:"autoScaling,omitempty"`
+
+	// TimeToLive configures the attribute that holds the expiry time of the
+	// items of the table. It is left untouched when omitted.
+	// +optional
+	TimeToLive *TimeToLive `json:"timeToLive,omitempty"`
+
+	// PointInTimeRecoveryEnabled indicates whether point in time recovery is
+	// enabled for the table. It is left untouched when omitted.
+	// +optional
+	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+
+	// KinesisStreamARN is the ARN of a Kinesis data stream that item-level
+	// changes of the table are captured to. Streaming to any other Kinesis
+	// data stream is disabled. Streaming is left untouched when omitted.
+	// +optional
+	KinesisStreamARN *string `json:"kinesisStreamARN,omitempty"`
+
+	// KinesisStreamARNRef references a Kinesis Stream to retrieve its ARN.
+	// +optional
+	KinesisStreamARNRef *xpv1.Reference `json:"kinesisStreamARNRef,omitempty"`
+
+	// KinesisStreamARNSelector selects a reference to a Kinesis Stream to
+	// retrieve its ARN.
+	// +optional
+	KinesisStreamARNSelector *xpv1.Selector `json:"kinesisStreamARNSelector,omitempty"`
+}
+
+// TimeToLive configures the expiry of the items of a Table.
+type TimeToLive struct {
+	// AttributeName is the name of the attribute that holds the expiry time
+	// of an item, in seconds since the epoch. The attribute can only be
+	// changed once time to live has been disabled.
+	AttributeName string `json:"attributeName"`
+
+	// Enabled indicates whether items expire.
+	Enabled bool `json:"enabled"`
+}
+
+// TableAutoScaling configures the Application Auto Scaling of a Table whose
+// billing mode is PROVISIONED.
+type TableAutoScaling struct {
+	// ReadCapacity scales the read capacity units of the table.
+	// +optional
+	ReadCapacity *CapacityAutoScaling `json:"readCapacity,omitempty"`
+
+	// WriteCapacity scales the write capacity units of the table.
+	// +optional
+	WriteCapacity *CapacityAutoScaling `json:"writeCapacity,omitempty"`
+
+	// GlobalSecondaryIndexes scale the capacity of global secondary indexes
+	// of the table.
+	// +optional
+	GlobalSecondaryIndexes []*IndexAutoScaling `json:"globalSecondaryIndexes,omitempty"`
+}
+
+// IndexAutoScaling configures the Application Auto Scaling of a global
+// secondary index.
+type IndexAutoScaling struct {
+	// IndexName is the name of the global secondary index.
+	IndexName string `json:"indexName"`
+
+	// ReadCapacity scales the read capacity units of the index.
+	// +optional
+	ReadCapacity *CapacityAutoScaling `json:"readCapacity,omitempty"`
+
+	// WriteCapacity scales the write capacity units of the index.
+	// +optional
+	WriteCapacity *CapacityAutoScaling `json:"writeCapacity,omitempty"`
+}
+
+// CapacityAutoScaling registers a capacity as a scalable target and scales
+// it with a target tracking policy on its utilization.
+type CapacityAutoScaling struct {
+	// MinCapacity is the lower limit of the capacity units.
+	// +kubebuilder:validation:Minimum=1
+	MinCapacity int64 `json:"minCapacity"`
+
+	// MaxCapacity is the upper limit of the capacity units.
+	// +kubebuilder:validation:Minimum=1
+	MaxCapacity int64 `json:"maxCapacity"`
+
+	// TargetUtilization is the percentage of the consumed capacity to the
+	// provisioned capacity that the policy keeps the capacity at.
+	// +kubebuilder:validation:Minimum=20
+	// +kubebuilder:validation:Maximum=90
+	TargetUtilization float64 `json:"targetUtilization"`
+
+	// ScaleInCooldown is the time in seconds after a scale-in activity before
+	// another one can start.
+	// +optional
+	ScaleInCooldown *int64 `json:"scaleInCooldown,omitempty"`
+
+	// ScaleOutCooldown is the time in seconds after a scale-out activity
+	// before another one can start.
+	// +optional
+	ScaleOutCooldown *int64 `json:"scaleOutCooldown,omitempty"`
+
+	// DisableScaleIn indicates whether the policy only scales out.
+	// +optional
+	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+}
+
+// TableReplica is a replica of a Table in another region.
+type TableReplica struct {
+	// RegionName is the region the table is replicated to.
+	RegionName string `json:"regionName"`
+
+	// KMSMasterKeyID is the ID or ARN of the KMS key in the replica region that
+	// encrypts the replica, if it should differ from the default DynamoDB KMS
+	// key.
+	// +optional
+	KMSMasterKeyID *string `json:"kmsMasterKeyID,omitempty"`
+
+	// ProvisionedThroughputOverride overrides the read capacity of the table
+	// for this replica. The replica uses the capacity of the table if it is
+	// not set.
+	// +optional
+	ProvisionedThroughputOverride *ProvisionedThroughputOverride `json:"provisionedThroughputOverride,omitempty"`
+
+	// GlobalSecondaryIndexes override the read capacity of the global
+	// secondary indexes of the table for this replica.
+	// +optional
+	GlobalSecondaryIndexes []*ReplicaGlobalSecondaryIndex `json:"globalSecondaryIndexes,omitempty"`
+}
 
 // CustomGlobalTableParameters are custom parameters for GlobalTable.
 type CustomGlobalTableParameters struct{}
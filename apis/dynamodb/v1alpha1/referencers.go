@@ -23,8 +23,25 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
 )
 
+// LatestStreamARN returns the status.atProvider.latestStreamARN of a Table.
+func LatestStreamARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Table)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.LatestStreamARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.LatestStreamARN
+	}
+}
+
 // ResolveReferences of this Backup
 func (mg *Backup) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -44,3 +61,23 @@ func (mg *Backup) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.TableNameRef = rsp.ResolvedReference
 	return nil
 }
+
+// ResolveReferences of this Table
+func (mg *Table) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.kinesisStreamARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KinesisStreamARN),
+		Reference:    mg.Spec.ForProvider.KinesisStreamARNRef,
+		Selector:     mg.Spec.ForProvider.KinesisStreamARNSelector,
+		To:           reference.To{Managed: &kinesisv1alpha1.Stream{}, List: &kinesisv1alpha1.StreamList{}},
+		Extract:      kinesisv1alpha1.StreamARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kinesisStreamARN")
+	}
+	mg.Spec.ForProvider.KinesisStreamARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KinesisStreamARNRef = rsp.ResolvedReference
+	return nil
+}
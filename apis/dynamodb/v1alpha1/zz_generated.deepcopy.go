@@ -357,6 +357,7 @@ func (in *BackupSpec) DeepCopy() *BackupSpec {
 func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -497,6 +498,36 @@ func (in *BillingModeSummary) DeepCopy() *BillingModeSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityAutoScaling) DeepCopyInto(out *CapacityAutoScaling) {
+	*out = *in
+	if in.ScaleInCooldown != nil {
+		in, out := &in.ScaleInCooldown, &out.ScaleInCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScaleOutCooldown != nil {
+		in, out := &in.ScaleOutCooldown, &out.ScaleOutCooldown
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DisableScaleIn != nil {
+		in, out := &in.DisableScaleIn, &out.DisableScaleIn
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityAutoScaling.
+func (in *CapacityAutoScaling) DeepCopy() *CapacityAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionCheck) DeepCopyInto(out *ConditionCheck) {
 	*out = *in
@@ -707,6 +738,52 @@ func (in *CustomGlobalTableParameters) DeepCopy() *CustomGlobalTableParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTableParameters) DeepCopyInto(out *CustomTableParameters) {
 	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]*TableReplica, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TableReplica)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TableClass != nil {
+		in, out := &in.TableClass, &out.TableClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoScaling != nil {
+		in, out := &in.AutoScaling, &out.AutoScaling
+		*out = new(TableAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeToLive != nil {
+		in, out := &in.TimeToLive, &out.TimeToLive
+		*out = new(TimeToLive)
+		**out = **in
+	}
+	if in.PointInTimeRecoveryEnabled != nil {
+		in, out := &in.PointInTimeRecoveryEnabled, &out.PointInTimeRecoveryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KinesisStreamARN != nil {
+		in, out := &in.KinesisStreamARN, &out.KinesisStreamARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.KinesisStreamARNRef != nil {
+		in, out := &in.KinesisStreamARNRef, &out.KinesisStreamARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KinesisStreamARNSelector != nil {
+		in, out := &in.KinesisStreamARNSelector, &out.KinesisStreamARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTableParameters.
@@ -1283,6 +1360,7 @@ func (in *GlobalTableSpec) DeepCopy() *GlobalTableSpec {
 func (in *GlobalTableStatus) DeepCopyInto(out *GlobalTableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1327,6 +1405,31 @@ func (in *GlobalTable_SDK) DeepCopy() *GlobalTable_SDK {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IndexAutoScaling) DeepCopyInto(out *IndexAutoScaling) {
+	*out = *in
+	if in.ReadCapacity != nil {
+		in, out := &in.ReadCapacity, &out.ReadCapacity
+		*out = new(CapacityAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteCapacity != nil {
+		in, out := &in.WriteCapacity, &out.WriteCapacity
+		*out = new(CapacityAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexAutoScaling.
+func (in *IndexAutoScaling) DeepCopy() *IndexAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(IndexAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeySchemaElement) DeepCopyInto(out *KeySchemaElement) {
 	*out = *in
@@ -2324,6 +2427,42 @@ func (in *Table) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableAutoScaling) DeepCopyInto(out *TableAutoScaling) {
+	*out = *in
+	if in.ReadCapacity != nil {
+		in, out := &in.ReadCapacity, &out.ReadCapacity
+		*out = new(CapacityAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteCapacity != nil {
+		in, out := &in.WriteCapacity, &out.WriteCapacity
+		*out = new(CapacityAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalSecondaryIndexes != nil {
+		in, out := &in.GlobalSecondaryIndexes, &out.GlobalSecondaryIndexes
+		*out = make([]*IndexAutoScaling, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IndexAutoScaling)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableAutoScaling.
+func (in *TableAutoScaling) DeepCopy() *TableAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(TableAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TableAutoScalingDescription) DeepCopyInto(out *TableAutoScalingDescription) {
 	*out = *in
@@ -2703,7 +2842,7 @@ func (in *TableParameters) DeepCopyInto(out *TableParameters) {
 			}
 		}
 	}
-	out.CustomTableParameters = in.CustomTableParameters
+	in.CustomTableParameters.DeepCopyInto(&out.CustomTableParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableParameters.
@@ -2716,6 +2855,42 @@ func (in *TableParameters) DeepCopy() *TableParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableReplica) DeepCopyInto(out *TableReplica) {
+	*out = *in
+	if in.KMSMasterKeyID != nil {
+		in, out := &in.KMSMasterKeyID, &out.KMSMasterKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProvisionedThroughputOverride != nil {
+		in, out := &in.ProvisionedThroughputOverride, &out.ProvisionedThroughputOverride
+		*out = new(ProvisionedThroughputOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalSecondaryIndexes != nil {
+		in, out := &in.GlobalSecondaryIndexes, &out.GlobalSecondaryIndexes
+		*out = make([]*ReplicaGlobalSecondaryIndex, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicaGlobalSecondaryIndex)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableReplica.
+func (in *TableReplica) DeepCopy() *TableReplica {
+	if in == nil {
+		return nil
+	}
+	out := new(TableReplica)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TableSpec) DeepCopyInto(out *TableSpec) {
 	*out = *in
@@ -2737,6 +2912,7 @@ func (in *TableSpec) DeepCopy() *TableSpec {
 func (in *TableStatus) DeepCopyInto(out *TableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2775,6 +2951,21 @@ func (in *Tag) DeepCopy() *Tag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeToLive) DeepCopyInto(out *TimeToLive) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeToLive.
+func (in *TimeToLive) DeepCopy() *TimeToLive {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeToLive)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeToLiveDescription) DeepCopyInto(out *TimeToLiveDescription) {
 	*out = *in
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -40,6 +41,7 @@ type TableParameters struct {
 	//
 	//    * PAY_PER_REQUEST - We recommend using PAY_PER_REQUEST for unpredictable
 	//    workloads. PAY_PER_REQUEST sets the billing mode to On-Demand Mode (https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/HowItWorks.ReadWriteCapacityMode.html#HowItWorks.OnDemand).
+	// +kubebuilder:validation:Enum=PROVISIONED;PAY_PER_REQUEST
 	BillingMode *string `json:"billingMode,omitempty"`
 	// One or more global secondary indexes (the maximum is 20) to be created on
 	// the table. Each global secondary index in the array includes the following:
@@ -235,8 +237,9 @@ type TableObservation struct {
 
 // TableStatus defines the observed state of Table.
 type TableStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TableObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            TableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// CapacityReservationParameters define the desired state of an EC2 On-Demand
+// Capacity Reservation.
+type CapacityReservationParameters struct {
+	// Region is the region you'd like your CapacityReservation to be created
+	// in.
+	Region string `json:"region"`
+
+	// The Availability Zone in which to reserve capacity.
+	// +immutable
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// The instance type for which to reserve capacity.
+	// +immutable
+	InstanceType string `json:"instanceType"`
+
+	// The type of operating system for which to reserve capacity, for example
+	// Linux/UNIX or Windows.
+	// +immutable
+	InstancePlatform string `json:"instancePlatform"`
+
+	// The number of instances for which to reserve capacity.
+	// +kubebuilder:validation:Minimum=1
+	InstanceCount int32 `json:"instanceCount"`
+
+	// Indicates whether the reservation supports EBS-optimized instances.
+	// +optional
+	// +immutable
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// Indicates whether the reservation supports instances with temporary,
+	// block-level storage.
+	// +optional
+	// +immutable
+	EphemeralStorage *bool `json:"ephemeralStorage,omitempty"`
+
+	// Indicates the way in which the reservation ends. A limited reservation
+	// is automatically cancelled at the EndDate, an unlimited one remains
+	// active until it is deleted.
+	// +kubebuilder:validation:Enum=unlimited;limited
+	// +optional
+	EndDateType *string `json:"endDateType,omitempty"`
+
+	// The date and time at which the reservation expires. Required when
+	// EndDateType is limited.
+	// +optional
+	EndDate *metav1.Time `json:"endDate,omitempty"`
+
+	// Indicates the type of instance launches that the reservation accepts.
+	// An open reservation is used by any matching instance, a targeted one only
+	// by instances that explicitly target it.
+	// +kubebuilder:validation:Enum=open;targeted
+	// +optional
+	// +immutable
+	InstanceMatchCriteria *string `json:"instanceMatchCriteria,omitempty"`
+
+	// Indicates the tenancy of the reservation.
+	// +kubebuilder:validation:Enum=default;dedicated
+	// +optional
+	// +immutable
+	Tenancy *string `json:"tenancy,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A CapacityReservationSpec defines the desired state of a
+// CapacityReservation.
+type CapacityReservationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CapacityReservationParameters `json:"forProvider"`
+}
+
+// CapacityReservationObservation keeps the state for the external resource
+type CapacityReservationObservation struct {
+	// The ID of the Capacity Reservation.
+	CapacityReservationID string `json:"capacityReservationId,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the Capacity Reservation.
+	CapacityReservationARN string `json:"capacityReservationArn,omitempty"`
+
+	// The ID of the AWS account that owns the Capacity Reservation.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The state of the Capacity Reservation.
+	State string `json:"state,omitempty"`
+
+	// The number of instances that can still be launched into the
+	// reservation.
+	AvailableInstanceCount *int32 `json:"availableInstanceCount,omitempty"`
+
+	// The total number of instances the reservation reserves capacity for.
+	TotalInstanceCount *int32 `json:"totalInstanceCount,omitempty"`
+
+	// The date and time at which the reservation was started.
+	StartDate *metav1.Time `json:"startDate,omitempty"`
+}
+
+// A CapacityReservationStatus represents the observed state of a
+// CapacityReservation.
+type CapacityReservationStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            CapacityReservationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CapacityReservation is a managed resource that represents an EC2
+// On-Demand Capacity Reservation.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.instanceType"
+// +kubebuilder:printcolumn:name="COUNT",type="integer",JSONPath=".spec.forProvider.instanceCount"
+// +kubebuilder:printcolumn:name="AVAILABLE",type="integer",JSONPath=".status.atProvider.availableInstanceCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CapacityReservation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CapacityReservationSpec   `json:"spec"`
+	Status CapacityReservationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CapacityReservationList contains a list of CapacityReservations
+type CapacityReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CapacityReservation `json:"items"`
+}
@@ -0,0 +1,388 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ClientVPNAuthentication describes how clients authenticate with a Client
+// VPN endpoint.
+type ClientVPNAuthentication struct {
+	// The type of client authentication to be used.
+	// +kubebuilder:validation:Enum=certificate-authentication;directory-service-authentication;federated-authentication
+	Type string `json:"type"`
+
+	// The ID of the Active Directory to be used for authentication. Required
+	// for directory-service-authentication.
+	// +optional
+	ActiveDirectoryID *string `json:"activeDirectoryId,omitempty"`
+
+	// The ARN of the client certificate in ACM whose certificate authority
+	// issued the client certificates. Required for
+	// certificate-authentication.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/acm/v1beta1.Certificate
+	ClientRootCertificateChainARN *string `json:"clientRootCertificateChainArn,omitempty"`
+
+	// ClientRootCertificateChainARNRef is a reference to a Certificate used
+	// to set the ClientRootCertificateChainARN.
+	// +optional
+	ClientRootCertificateChainARNRef *xpv1.Reference `json:"clientRootCertificateChainArnRef,omitempty"`
+
+	// ClientRootCertificateChainARNSelector selects a reference to a
+	// Certificate used to set the ClientRootCertificateChainARN.
+	// +optional
+	ClientRootCertificateChainARNSelector *xpv1.Selector `json:"clientRootCertificateChainArnSelector,omitempty"`
+
+	// The ARN of the IAM SAML identity provider. Required for
+	// federated-authentication.
+	// +optional
+	SAMLProviderARN *string `json:"samlProviderArn,omitempty"`
+
+	// The ARN of the IAM SAML identity provider for the self-service portal.
+	// +optional
+	SelfServiceSAMLProviderARN *string `json:"selfServiceSamlProviderArn,omitempty"`
+}
+
+// ClientVPNConnectionLogOptions describe the client connection logging of a
+// Client VPN endpoint.
+type ClientVPNConnectionLogOptions struct {
+	// Indicates whether connection logging is enabled.
+	Enabled bool `json:"enabled"`
+
+	// The name of the CloudWatch Logs log group. Required if connection
+	// logging is enabled.
+	// +optional
+	CloudWatchLogGroup *string `json:"cloudWatchLogGroup,omitempty"`
+
+	// The name of the CloudWatch Logs log stream to which the connection
+	// data is published.
+	// +optional
+	CloudWatchLogStream *string `json:"cloudWatchLogStream,omitempty"`
+}
+
+// ClientVPNNetworkAssociation associates a subnet with a Client VPN endpoint,
+// so that clients can establish VPN sessions.
+type ClientVPNNetworkAssociation struct {
+	// The ID of the subnet to associate with the Client VPN endpoint.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef is a reference to a Subnet used to set the SubnetID.
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet used to set the
+	// SubnetID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+}
+
+// ClientVPNAuthorizationRule grants clients access to a network.
+type ClientVPNAuthorizationRule struct {
+	// The IPv4 address range, in CIDR notation, of the network for which
+	// access is being authorized.
+	TargetNetworkCIDR string `json:"targetNetworkCidr"`
+
+	// The ID of the group to grant access to, for example, the Active
+	// Directory group or identity provider group. Required unless
+	// AuthorizeAllGroups is true.
+	// +optional
+	AccessGroupID *string `json:"accessGroupId,omitempty"`
+
+	// Indicates whether to grant access to all clients.
+	// +optional
+	AuthorizeAllGroups *bool `json:"authorizeAllGroups,omitempty"`
+
+	// A brief description of the authorization rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// ClientVPNRoute routes client traffic to a network through one of the
+// associated subnets.
+type ClientVPNRoute struct {
+	// The IPv4 address range, in CIDR notation, of the route destination.
+	DestinationCIDRBlock string `json:"destinationCidrBlock"`
+
+	// The ID of the associated subnet through which to route traffic.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	TargetSubnetID *string `json:"targetSubnetId,omitempty"`
+
+	// TargetSubnetIDRef is a reference to a Subnet used to set the
+	// TargetSubnetID.
+	// +optional
+	TargetSubnetIDRef *xpv1.Reference `json:"targetSubnetIdRef,omitempty"`
+
+	// TargetSubnetIDSelector selects a reference to a Subnet used to set the
+	// TargetSubnetID.
+	// +optional
+	TargetSubnetIDSelector *xpv1.Selector `json:"targetSubnetIdSelector,omitempty"`
+
+	// A brief description of the route.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// ClientVPNEndpointParameters define the desired state of an AWS Client VPN
+// endpoint.
+type ClientVPNEndpointParameters struct {
+	// Region is the region you'd like your ClientVPNEndpoint to be created in.
+	Region string `json:"region"`
+
+	// A brief description of the Client VPN endpoint.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The IPv4 address range, in CIDR notation, from which to assign client
+	// IP addresses. The block must be between /12 and /22.
+	// +immutable
+	ClientCIDRBlock string `json:"clientCidrBlock"`
+
+	// The ARN of the server certificate in ACM.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/acm/v1beta1.Certificate
+	ServerCertificateARN *string `json:"serverCertificateArn,omitempty"`
+
+	// ServerCertificateARNRef is a reference to a Certificate used to set
+	// the ServerCertificateARN.
+	// +optional
+	ServerCertificateARNRef *xpv1.Reference `json:"serverCertificateArnRef,omitempty"`
+
+	// ServerCertificateARNSelector selects a reference to a Certificate used
+	// to set the ServerCertificateARN.
+	// +optional
+	ServerCertificateARNSelector *xpv1.Selector `json:"serverCertificateArnSelector,omitempty"`
+
+	// The authentication methods clients use. Up to two methods can be
+	// combined, one of which must be certificate-authentication or
+	// directory-service-authentication.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	// +immutable
+	AuthenticationOptions []ClientVPNAuthentication `json:"authenticationOptions"`
+
+	// The client connection logging options. Logging is disabled if omitted.
+	// +optional
+	ConnectionLogOptions *ClientVPNConnectionLogOptions `json:"connectionLogOptions,omitempty"`
+
+	// The IP addresses of up to two DNS servers that resolve the DNS
+	// requests of the clients. The DNS server of the VPC is used if omitted.
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+
+	// The ID of the VPC to associate with the Client VPN endpoint, which
+	// determines the security groups that can be applied.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// The IDs of the security groups to apply to the associated subnets.
+	// The default security group of the VPC is applied if omitted.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	// +crossplane:generate:reference:refFieldName=SecurityGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SecurityGroupIDSelector
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a list of references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Indicates whether the self-service portal for the endpoint is enabled.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	// +optional
+	SelfServicePortal *string `json:"selfServicePortal,omitempty"`
+
+	// Indicates whether split-tunnel is enabled, in which case only traffic
+	// destined to the routes of the endpoint is sent through the tunnel.
+	// +optional
+	SplitTunnel *bool `json:"splitTunnel,omitempty"`
+
+	// The transport protocol to be used by the VPN session.
+	// +kubebuilder:validation:Enum=tcp;udp
+	// +optional
+	// +immutable
+	TransportProtocol *string `json:"transportProtocol,omitempty"`
+
+	// The port number to assign to the Client VPN endpoint for TCP and UDP
+	// traffic.
+	// +kubebuilder:validation:Enum=443;1194
+	// +optional
+	VPNPort *int32 `json:"vpnPort,omitempty"`
+
+	// The subnets associated with the Client VPN endpoint. Clients can only
+	// connect once at least one subnet is associated.
+	// +optional
+	NetworkAssociations []ClientVPNNetworkAssociation `json:"networkAssociations,omitempty"`
+
+	// The rules that authorize clients to access networks.
+	// +optional
+	AuthorizationRules []ClientVPNAuthorizationRule `json:"authorizationRules,omitempty"`
+
+	// The routes of the Client VPN endpoint. The route to the VPC of each
+	// associated subnet is added by AWS and does not need to be specified.
+	// +optional
+	Routes []ClientVPNRoute `json:"routes,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A ClientVPNEndpointSpec defines the desired state of a ClientVPNEndpoint.
+type ClientVPNEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClientVPNEndpointParameters `json:"forProvider"`
+}
+
+// ClientVPNNetworkAssociationObservation describes an observed association
+// between a subnet and a Client VPN endpoint.
+type ClientVPNNetworkAssociationObservation struct {
+	// The ID of the association.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The ID of the associated subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// The ID of the VPC of the associated subnet.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// The state of the association.
+	Status string `json:"status,omitempty"`
+}
+
+// ClientVPNAuthorizationRuleObservation describes an observed authorization
+// rule of a Client VPN endpoint.
+type ClientVPNAuthorizationRuleObservation struct {
+	// The network the rule grants access to.
+	DestinationCIDR string `json:"destinationCidr,omitempty"`
+
+	// The ID of the group the rule grants access to.
+	GroupID string `json:"groupId,omitempty"`
+
+	// Indicates whether the rule grants access to all clients.
+	AccessAll bool `json:"accessAll,omitempty"`
+
+	// The state of the authorization rule.
+	Status string `json:"status,omitempty"`
+}
+
+// ClientVPNRouteObservation describes an observed route of a Client VPN
+// endpoint.
+type ClientVPNRouteObservation struct {
+	// The destination of the route.
+	DestinationCIDR string `json:"destinationCidr,omitempty"`
+
+	// The ID of the subnet through which traffic is routed.
+	TargetSubnet string `json:"targetSubnet,omitempty"`
+
+	// Indicates how the route was associated with the endpoint. associate
+	// indicates that the route was added by AWS when a subnet was
+	// associated, add-route that it was added manually.
+	Origin string `json:"origin,omitempty"`
+
+	// The state of the route.
+	Status string `json:"status,omitempty"`
+}
+
+// ClientVPNEndpointObservation keeps the state for the external resource
+type ClientVPNEndpointObservation struct {
+	// The ID of the Client VPN endpoint.
+	ClientVPNEndpointID string `json:"clientVpnEndpointId,omitempty"`
+
+	// The state of the Client VPN endpoint.
+	Status string `json:"status,omitempty"`
+
+	// A message about the state of the Client VPN endpoint.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// The DNS name clients use to connect to the Client VPN endpoint.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// The URL of the self-service portal.
+	SelfServicePortalURL string `json:"selfServicePortalUrl,omitempty"`
+
+	// The subnets associated with the Client VPN endpoint.
+	NetworkAssociations []ClientVPNNetworkAssociationObservation `json:"networkAssociations,omitempty"`
+
+	// The authorization rules of the Client VPN endpoint.
+	AuthorizationRules []ClientVPNAuthorizationRuleObservation `json:"authorizationRules,omitempty"`
+
+	// The routes of the Client VPN endpoint.
+	Routes []ClientVPNRouteObservation `json:"routes,omitempty"`
+}
+
+// A ClientVPNEndpointStatus represents the observed state of a
+// ClientVPNEndpoint.
+type ClientVPNEndpointStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ClientVPNEndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClientVPNEndpoint is a managed resource that represents an AWS Client VPN
+// endpoint, together with its network associations, authorization rules and
+// routes. The client configuration file of the endpoint is published as a
+// connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DNS",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ClientVPNEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClientVPNEndpointSpec   `json:"spec"`
+	Status ClientVPNEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClientVPNEndpointList contains a list of ClientVPNEndpoints
+type ClientVPNEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClientVPNEndpoint `json:"items"`
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
@@ -127,6 +129,7 @@ type CreditSpecificationRequest struct {
 	// standard and unlimited.
 	//
 	// CPUCredits is a required field
+	// +kubebuilder:validation:Enum=standard;unlimited
 	CPUCredits *string `json:"cpuCredits"`
 }
 
@@ -311,8 +314,18 @@ type IAMInstanceProfileSpecification struct {
 	ARN *string `json:"arn,omitempty"`
 
 	// The name of the instance profile.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1alpha1.InstanceProfile
 	// +optional
 	Name *string `json:"name,omitempty"`
+
+	// NameRef is a reference to an InstanceProfile used to set the Name.
+	// +optional
+	NameRef *xpv1.Reference `json:"nameRef,omitempty"`
+
+	// NameSelector selects a reference to an InstanceProfile used to set the
+	// Name.
+	// +optional
+	NameSelector *xpv1.Selector `json:"nameSelector,omitempty"`
 }
 
 // InstanceBlockDeviceMapping describes a block device mapping.
@@ -648,7 +661,19 @@ type Placement struct {
 	AvailabilityZone *string `json:"availabilityZone,omitempty"`
 
 	// The name of the placement group the instance is in.
-	GroupName *string `json:"groupName"`
+	// +crossplane:generate:reference:type=PlacementGroup
+	// +optional
+	GroupName *string `json:"groupName,omitempty"`
+
+	// GroupNameRef is a reference to a PlacementGroup used to set the
+	// GroupName.
+	// +optional
+	GroupNameRef *xpv1.Reference `json:"groupNameRef,omitempty"`
+
+	// GroupNameSelector selects a reference to a PlacementGroup used to set
+	// the GroupName.
+	// +optional
+	GroupNameSelector *xpv1.Selector `json:"groupNameSelector,omitempty"`
 
 	// The ID of the Dedicated Host on which the instance resides. This parameter
 	// is not supported for the ImportInstance
@@ -693,6 +718,42 @@ type Placement struct {
 	// This parameter is not supported by CreateFleet
 	// (https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateFleet).
 	// +optional
+	// +kubebuilder:validation:Enum=default;dedicated;host
+	Tenancy string `json:"tenancy,omitempty"`
+}
+
+// PlacementObservation describes the observed placement of an instance.
+type PlacementObservation struct {
+	// The affinity setting for the instance on the Dedicated Host.
+	// +optional
+	Affinity *string `json:"affinity,omitempty"`
+
+	// The Availability Zone of the instance.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// The name of the placement group the instance is in.
+	// +optional
+	GroupName *string `json:"groupName,omitempty"`
+
+	// The ID of the Dedicated Host on which the instance resides.
+	// +optional
+	HostID *string `json:"hostId,omitempty"`
+
+	// The ARN of the host resource group in which the instance was launched.
+	// +optional
+	HostResourceGroupARN *string `json:"hostResourceGroupArn,omitempty"`
+
+	// The number of the partition the instance is in.
+	// +optional
+	PartitionNumber *int32 `json:"partitionNumber,omitempty"`
+
+	// Reserved for future use.
+	// +optional
+	SpreadDomain *string `json:"spreadDomain,omitempty"`
+
+	// The tenancy of the instance.
+	// +optional
 	Tenancy string `json:"tenancy,omitempty"`
 }
 
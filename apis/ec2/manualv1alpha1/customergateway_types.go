@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// CustomerGatewayParameters define the desired state of an AWS customer
+// gateway, which represents the customer side of a site-to-site VPN
+// connection.
+type CustomerGatewayParameters struct {
+	// Region is the region you'd like your CustomerGateway to be created in.
+	Region string `json:"region"`
+
+	// The type of VPN connection that this customer gateway supports.
+	// +kubebuilder:validation:Enum=ipsec.1
+	// +kubebuilder:default=ipsec.1
+	// +optional
+	// +immutable
+	Type string `json:"type,omitempty"`
+
+	// For devices that support BGP, the customer gateway's BGP ASN.
+	// +kubebuilder:default=65000
+	// +optional
+	// +immutable
+	BGPASN *int32 `json:"bgpAsn,omitempty"`
+
+	// The Internet-routable IP address of the customer gateway's outside
+	// interface. The address must be static.
+	// +optional
+	// +immutable
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the private certificate the customer
+	// gateway authenticates with, instead of an IP address.
+	// +optional
+	// +immutable
+	CertificateARN *string `json:"certificateArn,omitempty"`
+
+	// A name for the customer gateway device.
+	// +optional
+	// +immutable
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A CustomerGatewaySpec defines the desired state of a CustomerGateway.
+type CustomerGatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomerGatewayParameters `json:"forProvider"`
+}
+
+// CustomerGatewayObservation keeps the state for the external resource
+type CustomerGatewayObservation struct {
+	// The ID of the customer gateway.
+	CustomerGatewayID string `json:"customerGatewayId,omitempty"`
+
+	// The current state of the customer gateway.
+	State string `json:"state,omitempty"`
+}
+
+// A CustomerGatewayStatus represents the observed state of a CustomerGateway.
+type CustomerGatewayStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            CustomerGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CustomerGateway is a managed resource that represents an AWS customer
+// gateway.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".spec.forProvider.ipAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CustomerGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomerGatewaySpec   `json:"spec"`
+	Status CustomerGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomerGatewayList contains a list of CustomerGateways
+type CustomerGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomerGateway `json:"items"`
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// DHCPOptionsParameters define the desired state of an AWS DHCP options set.
+// The options of a set cannot be changed after it has been created.
+type DHCPOptionsParameters struct {
+	// Region is the region you'd like your DHCPOptions to be created in.
+	Region string `json:"region"`
+
+	// The domain name that instances in the VPC use to complete unqualified
+	// DNS hostnames.
+	// +optional
+	// +immutable
+	DomainName *string `json:"domainName,omitempty"`
+
+	// The IP addresses of up to four domain name servers, or
+	// AmazonProvidedDNS.
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	// +immutable
+	DomainNameServers []string `json:"domainNameServers,omitempty"`
+
+	// The IP addresses of up to four Network Time Protocol (NTP) servers.
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	// +immutable
+	NTPServers []string `json:"ntpServers,omitempty"`
+
+	// The IP addresses of up to four NetBIOS name servers.
+	// +kubebuilder:validation:MaxItems=4
+	// +optional
+	// +immutable
+	NetBIOSNameServers []string `json:"netbiosNameServers,omitempty"`
+
+	// The NetBIOS node type. AWS recommends 2, as broadcast and multicast are
+	// not supported.
+	// +kubebuilder:validation:Enum="1";"2";"4";"8"
+	// +optional
+	// +immutable
+	NetBIOSNodeType *string `json:"netbiosNodeType,omitempty"`
+
+	// VPCID is the ID of the VPC the options are associated with. Any other
+	// VPC that uses the options is associated with the default options of its
+	// region instead, so removing it dissociates the options.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DHCPOptionsSpec defines the desired state of a DHCPOptions.
+type DHCPOptionsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DHCPOptionsParameters `json:"forProvider"`
+}
+
+// DHCPOptionsObservation keeps the state for the external resource
+type DHCPOptionsObservation struct {
+	// The ID of the set of DHCP options.
+	DHCPOptionsID string `json:"dhcpOptionsId,omitempty"`
+
+	// The ID of the AWS account that owns the DHCP options set.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The IDs of the VPCs the options are associated with.
+	VPCIDs []string `json:"vpcIds,omitempty"`
+}
+
+// A DHCPOptionsStatus represents the observed state of a DHCPOptions.
+type DHCPOptionsStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            DHCPOptionsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DHCPOptions is a managed resource that represents an AWS DHCP options set
+// and its association with a VPC.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DHCPOptions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DHCPOptionsSpec   `json:"spec"`
+	Status DHCPOptionsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DHCPOptionsList contains a list of DHCPOptions
+type DHCPOptionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DHCPOptions `json:"items"`
+}
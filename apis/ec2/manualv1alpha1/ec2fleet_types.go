@@ -0,0 +1,368 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// EC2FleetLaunchTemplateSpecification identifies the launch template the
+// instances of an EC2 Fleet are launched from. Specify either the ID or the
+// name of the launch template, but not both.
+type EC2FleetLaunchTemplateSpecification struct {
+	// The ID of the launch template.
+	// +optional
+	LaunchTemplateID *string `json:"launchTemplateId,omitempty"`
+
+	// The name of the launch template.
+	// +optional
+	LaunchTemplateName *string `json:"launchTemplateName,omitempty"`
+
+	// The launch template version number, $Latest, or $Default.
+	Version string `json:"version"`
+}
+
+// EC2FleetLaunchTemplateOverrides overrides the parameters of a launch
+// template for a pool of instances of an EC2 Fleet.
+type EC2FleetLaunchTemplateOverrides struct {
+	// The Availability Zone in which to launch the instances.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// The instance type.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// The maximum price per unit hour that you are willing to pay for a Spot
+	// Instance.
+	// +optional
+	MaxPrice *string `json:"maxPrice,omitempty"`
+
+	// The priority of the override when the prioritized on-demand or the
+	// capacity-optimized-prioritized spot allocation strategy is used. The
+	// lower the number, the higher the priority.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// The ID of the subnet in which to launch the instances.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef is a reference to a Subnet used to set the SubnetID.
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet used to set the
+	// SubnetID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The number of units provided by the instance type towards the target
+	// capacity of the fleet.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WeightedCapacity *int32 `json:"weightedCapacity,omitempty"`
+}
+
+// EC2FleetLaunchTemplateConfig is a launch template and the overrides that
+// are applied to it.
+type EC2FleetLaunchTemplateConfig struct {
+	// The launch template to use.
+	LaunchTemplateSpecification EC2FleetLaunchTemplateSpecification `json:"launchTemplateSpecification"`
+
+	// Any parameters that you specify override the same parameters in the
+	// launch template. Each override describes a separate pool of instances
+	// the fleet can launch into.
+	// +optional
+	Overrides []EC2FleetLaunchTemplateOverrides `json:"overrides,omitempty"`
+}
+
+// EC2FleetTargetCapacitySpecification describes the number of units to
+// request from an EC2 Fleet.
+type EC2FleetTargetCapacitySpecification struct {
+	// The number of units to request, filled using the default target
+	// capacity type.
+	// +kubebuilder:validation:Minimum=0
+	TotalTargetCapacity int32 `json:"totalTargetCapacity"`
+
+	// The number of On-Demand units to request.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OnDemandTargetCapacity *int32 `json:"onDemandTargetCapacity,omitempty"`
+
+	// The number of Spot units to request.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SpotTargetCapacity *int32 `json:"spotTargetCapacity,omitempty"`
+
+	// The type of capacity that fills the part of the total target capacity
+	// that is neither requested as On-Demand nor as Spot capacity.
+	// +kubebuilder:validation:Enum=spot;on-demand
+	// +optional
+	DefaultTargetCapacityType *string `json:"defaultTargetCapacityType,omitempty"`
+}
+
+// EC2FleetSpotCapacityRebalance describes how an EC2 Fleet replaces Spot
+// Instances that are at an elevated risk of being interrupted.
+type EC2FleetSpotCapacityRebalance struct {
+	// The replacement strategy to use. With launch, the fleet launches a
+	// replacement instance but does not terminate the instance at risk. With
+	// launch-before-terminate, the instance at risk is terminated after
+	// TerminationDelay once the replacement was launched.
+	// +kubebuilder:validation:Enum=launch;launch-before-terminate
+	ReplacementStrategy string `json:"replacementStrategy"`
+
+	// The number of seconds to wait before terminating the instance at risk
+	// after its replacement was launched. Only valid with the
+	// launch-before-terminate strategy.
+	// +kubebuilder:validation:Minimum=120
+	// +kubebuilder:validation:Maximum=7200
+	// +optional
+	TerminationDelay *int32 `json:"terminationDelay,omitempty"`
+}
+
+// EC2FleetSpotMaintenanceStrategies describes the strategies for managing
+// Spot Instances that are at an elevated risk of being interrupted.
+type EC2FleetSpotMaintenanceStrategies struct {
+	// CapacityRebalance enables capacity rebalancing for the fleet.
+	// +optional
+	CapacityRebalance *EC2FleetSpotCapacityRebalance `json:"capacityRebalance,omitempty"`
+}
+
+// EC2FleetSpotOptions describes the configuration of the Spot Instances of
+// an EC2 Fleet.
+type EC2FleetSpotOptions struct {
+	// The strategy that determines how to allocate the Spot capacity across
+	// the Spot pools specified by the fleet.
+	// +kubebuilder:validation:Enum=lowest-price;diversified;capacity-optimized;capacity-optimized-prioritized
+	// +optional
+	AllocationStrategy *string `json:"allocationStrategy,omitempty"`
+
+	// The behavior when a Spot Instance is interrupted.
+	// +kubebuilder:validation:Enum=hibernate;stop;terminate
+	// +optional
+	InstanceInterruptionBehavior *string `json:"instanceInterruptionBehavior,omitempty"`
+
+	// The number of Spot pools across which to allocate the Spot capacity.
+	// Only valid with the lowest-price allocation strategy.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	InstancePoolsToUseCount *int32 `json:"instancePoolsToUseCount,omitempty"`
+
+	// The strategies for managing Spot Instances that are at an elevated
+	// risk of being interrupted.
+	// +optional
+	MaintenanceStrategies *EC2FleetSpotMaintenanceStrategies `json:"maintenanceStrategies,omitempty"`
+
+	// The maximum amount per hour for Spot Instances that you're willing to
+	// pay.
+	// +optional
+	MaxTotalPrice *string `json:"maxTotalPrice,omitempty"`
+
+	// Indicates that the fleet launches all Spot Instances into a single
+	// Availability Zone.
+	// +optional
+	SingleAvailabilityZone *bool `json:"singleAvailabilityZone,omitempty"`
+
+	// Indicates that the fleet uses a single instance type to launch all
+	// Spot Instances.
+	// +optional
+	SingleInstanceType *bool `json:"singleInstanceType,omitempty"`
+}
+
+// EC2FleetOnDemandOptions describes the configuration of the On-Demand
+// Instances of an EC2 Fleet.
+type EC2FleetOnDemandOptions struct {
+	// The order of the launch template overrides to use in fulfilling
+	// On-Demand capacity.
+	// +kubebuilder:validation:Enum=lowest-price;prioritized
+	// +optional
+	AllocationStrategy *string `json:"allocationStrategy,omitempty"`
+
+	// The maximum amount per hour for On-Demand Instances that you're willing
+	// to pay.
+	// +optional
+	MaxTotalPrice *string `json:"maxTotalPrice,omitempty"`
+
+	// Indicates that the fleet launches all On-Demand Instances into a
+	// single Availability Zone.
+	// +optional
+	SingleAvailabilityZone *bool `json:"singleAvailabilityZone,omitempty"`
+
+	// Indicates that the fleet uses a single instance type to launch all
+	// On-Demand Instances.
+	// +optional
+	SingleInstanceType *bool `json:"singleInstanceType,omitempty"`
+}
+
+// EC2FleetParameters define the desired state of an EC2 Fleet.
+type EC2FleetParameters struct {
+	// Region is the region you'd like your EC2Fleet to be created in.
+	Region string `json:"region"`
+
+	// The type of the fleet. A maintain fleet replaces interrupted and
+	// terminated instances to keep its target capacity, a request fleet only
+	// places a one-time request for it. The launch templates and the target
+	// capacity can only be modified for maintain fleets. Instant fleets are
+	// not supported.
+	// +kubebuilder:validation:Enum=maintain;request
+	// +optional
+	// +immutable
+	Type *string `json:"type,omitempty"`
+
+	// The launch templates and overrides the fleet launches instances from.
+	// +kubebuilder:validation:MinItems=1
+	LaunchTemplateConfigs []EC2FleetLaunchTemplateConfig `json:"launchTemplateConfigs"`
+
+	// The number of units to request.
+	TargetCapacitySpecification EC2FleetTargetCapacitySpecification `json:"targetCapacitySpecification"`
+
+	// The configuration of the Spot Instances of the fleet.
+	// +optional
+	// +immutable
+	SpotOptions *EC2FleetSpotOptions `json:"spotOptions,omitempty"`
+
+	// The configuration of the On-Demand Instances of the fleet.
+	// +optional
+	// +immutable
+	OnDemandOptions *EC2FleetOnDemandOptions `json:"onDemandOptions,omitempty"`
+
+	// Indicates whether running instances are terminated if the total target
+	// capacity of the fleet is decreased below its current size.
+	// +kubebuilder:validation:Enum=no-termination;termination
+	// +optional
+	ExcessCapacityTerminationPolicy *string `json:"excessCapacityTerminationPolicy,omitempty"`
+
+	// Indicates whether the fleet replaces unhealthy instances. Only valid
+	// for fleets of type maintain.
+	// +optional
+	// +immutable
+	ReplaceUnhealthyInstances *bool `json:"replaceUnhealthyInstances,omitempty"`
+
+	// Indicates whether running instances are terminated when the fleet
+	// expires.
+	// +optional
+	// +immutable
+	TerminateInstancesWithExpiration *bool `json:"terminateInstancesWithExpiration,omitempty"`
+
+	// The start date and time of the request. Defaults to starting the
+	// request immediately.
+	// +optional
+	// +immutable
+	ValidFrom *metav1.Time `json:"validFrom,omitempty"`
+
+	// The end date and time of the request. Defaults to a request that
+	// remains active until it is deleted.
+	// +optional
+	// +immutable
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+
+	// TerminateInstancesOnDeletion indicates whether the instances of the
+	// fleet are terminated when the fleet is deleted. Defaults to true.
+	// +optional
+	TerminateInstancesOnDeletion *bool `json:"terminateInstancesOnDeletion,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An EC2FleetSpec defines the desired state of an EC2Fleet.
+type EC2FleetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EC2FleetParameters `json:"forProvider"`
+}
+
+// EC2FleetError describes an error that occurred while the fleet launched
+// instances.
+type EC2FleetError struct {
+	// The error code.
+	ErrorCode string `json:"errorCode,omitempty"`
+
+	// The error message.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// EC2FleetObservation keeps the state for the external resource
+type EC2FleetObservation struct {
+	// The ID of the EC2 Fleet.
+	FleetID string `json:"fleetId,omitempty"`
+
+	// The state of the EC2 Fleet.
+	FleetState string `json:"fleetState,omitempty"`
+
+	// The progress of the fleet towards its target capacity.
+	ActivityStatus string `json:"activityStatus,omitempty"`
+
+	// The number of units fulfilled by the fleet, rounded down.
+	FulfilledCapacity int32 `json:"fulfilledCapacity,omitempty"`
+
+	// The number of units fulfilled by On-Demand Instances, rounded down.
+	FulfilledOnDemandCapacity int32 `json:"fulfilledOnDemandCapacity,omitempty"`
+
+	// The IDs of the instances launched by the fleet.
+	InstanceIDs []string `json:"instanceIds,omitempty"`
+
+	// The errors that occurred while launching instances.
+	Errors []EC2FleetError `json:"errors,omitempty"`
+
+	// The date and time the fleet was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+}
+
+// An EC2FleetStatus represents the observed state of an EC2Fleet.
+type EC2FleetStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            EC2FleetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EC2Fleet is a managed resource that represents an EC2 Fleet, which
+// launches a mix of On-Demand and Spot Instances across instance types and
+// Availability Zones.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TARGET",type="integer",JSONPath=".spec.forProvider.targetCapacitySpecification.totalTargetCapacity"
+// +kubebuilder:printcolumn:name="FULFILLED",type="integer",JSONPath=".status.atProvider.fulfilledCapacity"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws},path=ec2fleets
+type EC2Fleet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EC2FleetSpec   `json:"spec"`
+	Status EC2FleetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EC2FleetList contains a list of EC2Fleets
+type EC2FleetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EC2Fleet `json:"items"`
+}
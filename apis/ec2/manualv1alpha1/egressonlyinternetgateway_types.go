@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// EgressOnlyInternetGatewayParameters define the desired state of an AWS VPC
+// Egress-Only Internet Gateway.
+type EgressOnlyInternetGatewayParameters struct {
+	// Region is the region you'd like your egress-only internet gateway to be
+	// created in.
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC for which to create the egress-only internet
+	// gateway.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An EgressOnlyInternetGatewaySpec defines the desired state of an
+// EgressOnlyInternetGateway.
+type EgressOnlyInternetGatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EgressOnlyInternetGatewayParameters `json:"forProvider"`
+}
+
+// EgressOnlyInternetGatewayObservation keeps the state for the external
+// resource
+type EgressOnlyInternetGatewayObservation struct {
+	// The VPC attached to the egress-only internet gateway.
+	Attachments []EgressOnlyInternetGatewayAttachment `json:"attachments,omitempty"`
+
+	// The ID of the egress-only internet gateway.
+	EgressOnlyInternetGatewayID string `json:"egressOnlyInternetGatewayId,omitempty"`
+}
+
+// EgressOnlyInternetGatewayAttachment describes the attachment of a VPC to an
+// egress-only internet gateway.
+type EgressOnlyInternetGatewayAttachment struct {
+	// The current state of the attachment.
+	// +kubebuilder:validation:Enum=available;attaching;attached;detaching;detached
+	AttachmentStatus string `json:"attachmentStatus"`
+
+	// VPCID is the ID of the attached VPC.
+	VPCID string `json:"vpcId"`
+}
+
+// An EgressOnlyInternetGatewayStatus represents the observed state of an
+// EgressOnlyInternetGateway.
+type EgressOnlyInternetGatewayStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            EgressOnlyInternetGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EgressOnlyInternetGateway is a managed resource that represents an AWS
+// VPC Egress-Only Internet Gateway, which allows outbound IPv6 traffic from a
+// VPC while preventing inbound IPv6 connections.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+// +kubebuilder:storageversion
+type EgressOnlyInternetGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EgressOnlyInternetGatewaySpec   `json:"spec"`
+	Status EgressOnlyInternetGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EgressOnlyInternetGatewayList contains a list of EgressOnlyInternetGateways
+type EgressOnlyInternetGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EgressOnlyInternetGateway `json:"items"`
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ENIAttachmentParameters define the desired state of an attachment of an
+// Elastic Network Interface to an Instance.
+type ENIAttachmentParameters struct {
+	// Region is the region you'd like your ENIAttachment to be created in.
+	Region string `json:"region"`
+
+	// The index of the device for the network interface attachment. The
+	// primary network interface of an instance has the index 0.
+	// +immutable
+	DeviceIndex int32 `json:"deviceIndex"`
+
+	// The index of the network card. Some instance types support multiple
+	// network cards.
+	// +optional
+	// +immutable
+	NetworkCardIndex *int32 `json:"networkCardIndex,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface to attach.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=NetworkInterface
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+
+	// NetworkInterfaceIDRef references a NetworkInterface to retrieve its ID.
+	// +optional
+	NetworkInterfaceIDRef *xpv1.Reference `json:"networkInterfaceIdRef,omitempty"`
+
+	// NetworkInterfaceIDSelector selects a reference to a NetworkInterface
+	// to retrieve its ID.
+	// +optional
+	NetworkInterfaceIDSelector *xpv1.Selector `json:"networkInterfaceIdSelector,omitempty"`
+
+	// InstanceID is the ID of the instance the network interface is attached
+	// to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=Instance
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references an Instance to retrieve its ID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects a reference to an Instance to retrieve its
+	// ID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// Indicates whether the network interface is deleted when the instance
+	// is terminated.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+
+	// ForceDetach forces the detachment of the network interface when the
+	// ENIAttachment is deleted.
+	// +optional
+	ForceDetach *bool `json:"forceDetach,omitempty"`
+}
+
+// An ENIAttachmentSpec defines the desired state of an ENIAttachment.
+type ENIAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ENIAttachmentParameters `json:"forProvider"`
+}
+
+// ENIAttachmentObservation keeps the state for the external resource.
+type ENIAttachmentObservation struct {
+	// The ID of the attachment.
+	AttachmentID string `json:"attachmentId,omitempty"`
+
+	// The attachment state.
+	Status string `json:"status,omitempty"`
+
+	// The time stamp when the attachment initiated.
+	AttachTime *metav1.Time `json:"attachTime,omitempty"`
+}
+
+// An ENIAttachmentStatus represents the observed state of an ENIAttachment.
+type ENIAttachmentStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ENIAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ENIAttachment is a managed resource that represents the
+// attachment of an Elastic Network Interface to an Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INTERFACE",type="string",JSONPath=".spec.forProvider.networkInterfaceId"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instanceId"
+// +kubebuilder:printcolumn:name="INDEX",type="integer",JSONPath=".spec.forProvider.deviceIndex"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ENIAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ENIAttachmentSpec   `json:"spec"`
+	Status ENIAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ENIAttachmentList contains a list of ENIAttachments
+type ENIAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ENIAttachment `json:"items"`
+}
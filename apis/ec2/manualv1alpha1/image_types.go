@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ImageLaunchPermissions define who besides the owner may launch instances
+// from an image.
+type ImageLaunchPermissions struct {
+	// The IDs of the AWS accounts the image is shared with.
+	// +optional
+	UserIDs []string `json:"userIds,omitempty"`
+
+	// The ARNs of the organizations the image is shared with.
+	// +optional
+	OrganizationARNs []string `json:"organizationArns,omitempty"`
+
+	// The ARNs of the organizational units the image is shared with.
+	// +optional
+	OrganizationalUnitARNs []string `json:"organizationalUnitArns,omitempty"`
+}
+
+// ImageParameters define the desired state of an AMI. The image is either
+// created from an instance, or copied from an existing image, possibly in
+// another region.
+type ImageParameters struct {
+	// Region is the region you'd like your Image to be created in.
+	Region string `json:"region"`
+
+	// The name of the image.
+	// +immutable
+	Name string `json:"name"`
+
+	// A description for the image.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// The ID of the image that is copied. Exactly one of SourceImageID and
+	// InstanceID has to be set.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=Image
+	SourceImageID *string `json:"sourceImageId,omitempty"`
+
+	// SourceImageIDRef references an Image to retrieve its ID.
+	// +optional
+	SourceImageIDRef *xpv1.Reference `json:"sourceImageIdRef,omitempty"`
+
+	// SourceImageIDSelector selects a reference to an Image to retrieve its
+	// ID.
+	// +optional
+	SourceImageIDSelector *xpv1.Selector `json:"sourceImageIdSelector,omitempty"`
+
+	// The region of the image that is copied. Defaults to the region of this
+	// Image.
+	// +optional
+	// +immutable
+	SourceRegion *string `json:"sourceRegion,omitempty"`
+
+	// Indicates whether the snapshots of the copied image are encrypted.
+	// +optional
+	// +immutable
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// The ID of the KMS key used to encrypt the snapshots of the copied
+	// image. Defaults to the default EBS key of the account.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kms/v1alpha1.Key
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/kms/v1alpha1.KMSKeyARN()
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef references a KMS Key to retrieve its ARN.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a KMS Key to retrieve its ARN.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
+	// The ID of the instance the image is created from. Exactly one of
+	// SourceImageID and InstanceID has to be set.
+	// +optional
+	// +immutable
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// Indicates whether the instance is not shut down before the image is
+	// created from it. The file system integrity of the image is not
+	// guaranteed if it isn't.
+	// +optional
+	// +immutable
+	NoReboot *bool `json:"noReboot,omitempty"`
+
+	// The accounts, organizations and organizational units the image is
+	// shared with.
+	// +optional
+	LaunchPermissions *ImageLaunchPermissions `json:"launchPermissions,omitempty"`
+
+	// The time at which the image is deprecated. Deprecated images are hidden
+	// from image listings but can still be launched. Seconds are rounded to
+	// the nearest minute.
+	// +optional
+	DeprecateAt *metav1.Time `json:"deprecateAt,omitempty"`
+
+	// Indicates whether the EBS snapshots backing the image are deleted when
+	// the image is deregistered. Defaults to false.
+	// +optional
+	DeleteSnapshotsOnDeletion *bool `json:"deleteSnapshotsOnDeletion,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An ImageSpec defines the desired state of an Image.
+type ImageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImageParameters `json:"forProvider"`
+}
+
+// ImageObservation keeps the state for the external resource
+type ImageObservation struct {
+	// The ID of the image.
+	ImageID string `json:"imageId,omitempty"`
+
+	// The ID of the AWS account that owns the image.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The state of the image.
+	State string `json:"state,omitempty"`
+
+	// The architecture of the image.
+	Architecture string `json:"architecture,omitempty"`
+
+	// The date and time the image was created.
+	CreationDate string `json:"creationDate,omitempty"`
+
+	// The date and time the image is deprecated at.
+	DeprecationTime string `json:"deprecationTime,omitempty"`
+
+	// Indicates whether the image is public.
+	Public bool `json:"public,omitempty"`
+
+	// The IDs of the EBS snapshots backing the image.
+	SnapshotIDs []string `json:"snapshotIds,omitempty"`
+}
+
+// An ImageStatus represents the observed state of an Image.
+type ImageStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ImageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Image is a managed resource that represents an Amazon Machine Image
+// (AMI).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Image struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageSpec   `json:"spec"`
+	Status ImageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageList contains a list of Images
+type ImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Image `json:"items"`
+}
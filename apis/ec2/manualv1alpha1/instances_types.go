@@ -18,9 +18,17 @@ package manualv1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// Desired power states of an Instance.
+const (
+	InstanceStateRunning    = "running"
+	InstanceStateStopped    = "stopped"
+	InstanceStateHibernated = "hibernated"
+)
+
 // InstanceParameters define the desired state of the Instances
 type InstanceParameters struct {
 	// The block device mapping entries.
@@ -114,6 +122,7 @@ type InstanceParameters struct {
 	//
 	// Default: stop
 	// +optional
+	// +kubebuilder:validation:Enum=stop;terminate
 	InstanceInitiatedShutdownBehavior string `json:"instanceInitiatedShutdownBehavior,omitempty"`
 
 	// The market (purchasing) option for the instances.
@@ -244,6 +253,13 @@ type InstanceParameters struct {
 	// +optional
 	SecurityGroupSelector *xpv1.Selector `json:"securityGroupSelector,omitempty"`
 
+	// State is the desired power state of the instance. Stopping an instance
+	// with hibernated requires it to be launched with hibernation enabled. An
+	// instance is left in whatever state it is in if no State is set.
+	// +optional
+	// +kubebuilder:validation:Enum=running;stopped;hibernated
+	State *string `json:"state,omitempty"`
+
 	// [EC2-VPC] The ID of the subnet to launch the instance into.
 	//
 	// If you specify a network interface, you must specify any subnets as part
@@ -281,6 +297,18 @@ type InstanceParameters struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`
 	UserData *string `json:"userData,omitempty"`
+
+	// UserDataSecretRef selects a key of a Secret that holds the plain text
+	// user data of the instance, which is base64-encoded before it is sent.
+	// It takes precedence over UserData and UserDataConfigMapRef.
+	// +optional
+	UserDataSecretRef *xpv1.SecretKeySelector `json:"userDataSecretRef,omitempty"`
+
+	// UserDataConfigMapRef selects a key of a ConfigMap that holds the plain
+	// text user data of the instance, which is base64-encoded before it is
+	// sent. It takes precedence over UserData.
+	// +optional
+	UserDataConfigMapRef *awsv1beta1.ConfigMapKeySelector `json:"userDataConfigMapRef,omitempty"`
 }
 
 // An InstanceSpec defines the desired state of Instances.
@@ -291,8 +319,9 @@ type InstanceSpec struct {
 
 // An InstanceStatus represents the observed state of Instances.
 type InstanceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            InstanceObservation `json:"atProvider,omitempty"`
 }
 
 // InstanceObservation keeps the state for the external resource. The below fields
@@ -349,8 +378,8 @@ type InstanceObservation struct {
 	// +optional
 	OutpostARN *string `json:"outpostArn,omitempty"`
 	// +optional
-	Placement *Placement `json:"placement,omitempty"`
-	Platform  string     `json:"platform"`
+	Placement *PlacementObservation `json:"placement,omitempty"`
+	Platform  string                `json:"platform"`
 	// +optional
 	PrivateDNSName *string `json:"privateDnsName,omitempty"`
 	// +optional
@@ -386,6 +415,9 @@ type InstanceObservation struct {
 	VirtualizationType string `json:"virualizationType"`
 	// +optional
 	VPCID *string `json:"vpcId,omitempty"`
+	// UserDataHash is the SHA-256 hash of the user data of the instance.
+	// +optional
+	UserDataHash string `json:"userDataHash,omitempty"`
 }
 
 // +kubebuilder:object:root=true
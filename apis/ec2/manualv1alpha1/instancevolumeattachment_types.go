@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// InstanceVolumeAttachmentParameters define the desired state of an
+// attachment of an EBS volume to an Instance.
+type InstanceVolumeAttachmentParameters struct {
+	// Region is the region you'd like your InstanceVolumeAttachment to be
+	// created in.
+	Region string `json:"region"`
+
+	// The device name under which the volume is exposed to the instance (for
+	// example, /dev/sdh or xvdh).
+	// +immutable
+	Device string `json:"device"`
+
+	// The ID of the EBS volume. The volume and instance must be within the
+	// same Availability Zone.
+	// +immutable
+	VolumeID string `json:"volumeId"`
+
+	// InstanceID is the ID of the instance the volume is attached to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=Instance
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references an Instance to retrieve its ID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects a reference to an Instance to retrieve its
+	// ID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// ForceDetach forces the detachment of the volume when the
+	// InstanceVolumeAttachment is deleted. Use this only as a last resort,
+	// since the instance doesn't get the chance to flush file system caches
+	// or metadata.
+	// +optional
+	ForceDetach *bool `json:"forceDetach,omitempty"`
+}
+
+// An InstanceVolumeAttachmentSpec defines the desired state of an
+// InstanceVolumeAttachment.
+type InstanceVolumeAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceVolumeAttachmentParameters `json:"forProvider"`
+}
+
+// InstanceVolumeAttachmentObservation keeps the state for the external resource
+type InstanceVolumeAttachmentObservation struct {
+	// The attachment state of the volume.
+	State string `json:"state,omitempty"`
+
+	// The time stamp when the attachment initiated.
+	AttachTime *metav1.Time `json:"attachTime,omitempty"`
+
+	// Indicates whether the volume is deleted on instance termination.
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+}
+
+// An InstanceVolumeAttachmentStatus represents the observed state of an
+// InstanceVolumeAttachment.
+type InstanceVolumeAttachmentStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            InstanceVolumeAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceVolumeAttachment is a managed resource that represents the
+// attachment of an EBS volume to an Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VOLUME",type="string",JSONPath=".spec.forProvider.volumeId"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instanceId"
+// +kubebuilder:printcolumn:name="DEVICE",type="string",JSONPath=".spec.forProvider.device"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type InstanceVolumeAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceVolumeAttachmentSpec   `json:"spec"`
+	Status InstanceVolumeAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceVolumeAttachmentList contains a list of InstanceVolumeAttachments
+type InstanceVolumeAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceVolumeAttachment `json:"items"`
+}
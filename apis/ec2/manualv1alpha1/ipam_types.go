@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// IPAMParameters define the desired state of an AWS VPC IP Address Manager.
+type IPAMParameters struct {
+	// Region is the region you'd like your IPAM to be created in. This is
+	// the home region of the IPAM.
+	Region string `json:"region"`
+
+	// A description for the IPAM.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The regions in which the IPAM discovers resources and where pools can
+	// be created. The home region of the IPAM should be included.
+	// +optional
+	OperatingRegions []string `json:"operatingRegions,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An IPAMSpec defines the desired state of an IPAM.
+type IPAMSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPAMParameters `json:"forProvider"`
+}
+
+// IPAMObservation keeps the state for the external resource
+type IPAMObservation struct {
+	// The ID of the IPAM.
+	IPAMID string `json:"ipamId,omitempty"`
+
+	// The ARN of the IPAM.
+	IPAMARN string `json:"ipamArn,omitempty"`
+
+	// The home region of the IPAM.
+	IPAMRegion string `json:"ipamRegion,omitempty"`
+
+	// The ID of the AWS account that owns the IPAM.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The ID of the default private scope of the IPAM, in which pools for
+	// private address space are created.
+	PrivateDefaultScopeID string `json:"privateDefaultScopeId,omitempty"`
+
+	// The ID of the default public scope of the IPAM, in which pools for
+	// public address space are created.
+	PublicDefaultScopeID string `json:"publicDefaultScopeId,omitempty"`
+
+	// The number of scopes in the IPAM.
+	ScopeCount int32 `json:"scopeCount,omitempty"`
+
+	// The current state of the IPAM.
+	State string `json:"state,omitempty"`
+}
+
+// An IPAMStatus represents the observed state of an IPAM.
+type IPAMStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            IPAMObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPAM is a managed resource that represents an AWS VPC IP Address
+// Manager, used to plan and track IP addresses across regions.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPAM struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAMSpec   `json:"spec"`
+	Status IPAMStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAMList contains a list of IPAMs
+type IPAMList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAM `json:"items"`
+}
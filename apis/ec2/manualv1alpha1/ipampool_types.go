@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// IPAMPoolParameters define the desired state of an AWS IPAM pool.
+type IPAMPoolParameters struct {
+	// Region is the region you'd like your IPAMPool to be created in. This
+	// must be the home region of the IPAM.
+	Region string `json:"region"`
+
+	// IPAMScopeID is the ID of the IPAM scope the pool is created in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=IPAM
+	// +crossplane:generate:reference:extractor=IPAMPrivateDefaultScopeID()
+	IPAMScopeID *string `json:"ipamScopeId,omitempty"`
+
+	// IPAMScopeIDRef references an IPAM to retrieve the ID of its default
+	// private scope.
+	// +optional
+	IPAMScopeIDRef *xpv1.Reference `json:"ipamScopeIdRef,omitempty"`
+
+	// IPAMScopeIDSelector selects a reference to an IPAM to retrieve the ID
+	// of its default private scope.
+	// +optional
+	IPAMScopeIDSelector *xpv1.Selector `json:"ipamScopeIdSelector,omitempty"`
+
+	// The IP protocol of the pool.
+	// +kubebuilder:validation:Enum=ipv4;ipv6
+	// +immutable
+	AddressFamily string `json:"addressFamily"`
+
+	// The region where the pool's CIDRs can be allocated. Only resources in
+	// this region can use the pool. Omit it for pools that are sources of
+	// other pools.
+	// +optional
+	// +immutable
+	Locale *string `json:"locale,omitempty"`
+
+	// SourceIPAMPoolID is the ID of the pool whose address space this pool
+	// is allocated from.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=IPAMPool
+	SourceIPAMPoolID *string `json:"sourceIpamPoolId,omitempty"`
+
+	// SourceIPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	SourceIPAMPoolIDRef *xpv1.Reference `json:"sourceIpamPoolIdRef,omitempty"`
+
+	// SourceIPAMPoolIDSelector selects a reference to an IPAMPool to
+	// retrieve its ID.
+	// +optional
+	SourceIPAMPoolIDSelector *xpv1.Selector `json:"sourceIpamPoolIdSelector,omitempty"`
+
+	// A description for the pool.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Whether IPAM imports CIDRs of existing resources in the locale that
+	// fall within the pool's range.
+	// +optional
+	AutoImport *bool `json:"autoImport,omitempty"`
+
+	// Whether the pool's public IPv6 CIDRs are advertised to the internet.
+	// Only applies to IPv6 pools in the public scope.
+	// +optional
+	// +immutable
+	PubliclyAdvertisable *bool `json:"publiclyAdvertisable,omitempty"`
+
+	// The service the pool's CIDRs are used by.
+	// +kubebuilder:validation:Enum=ec2
+	// +optional
+	// +immutable
+	AWSService *string `json:"awsService,omitempty"`
+
+	// The default netmask length of allocations from the pool, used when a
+	// netmask length is not requested.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	AllocationDefaultNetmaskLength *int32 `json:"allocationDefaultNetmaskLength,omitempty"`
+
+	// The smallest netmask length, i.e. the largest allocation, allowed from
+	// the pool.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	AllocationMinNetmaskLength *int32 `json:"allocationMinNetmaskLength,omitempty"`
+
+	// The largest netmask length, i.e. the smallest allocation, allowed from
+	// the pool.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	AllocationMaxNetmaskLength *int32 `json:"allocationMaxNetmaskLength,omitempty"`
+
+	// Tags that resources must have to be allowed to allocate from the
+	// pool. Resources without them are reported as noncompliant.
+	// +optional
+	AllocationResourceTags []Tag `json:"allocationResourceTags,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An IPAMPoolSpec defines the desired state of an IPAMPool.
+type IPAMPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPAMPoolParameters `json:"forProvider"`
+}
+
+// IPAMPoolObservation keeps the state for the external resource
+type IPAMPoolObservation struct {
+	// The ID of the pool.
+	IPAMPoolID string `json:"ipamPoolId,omitempty"`
+
+	// The ARN of the pool.
+	IPAMPoolARN string `json:"ipamPoolArn,omitempty"`
+
+	// The ARN of the IPAM the pool belongs to.
+	IPAMARN string `json:"ipamArn,omitempty"`
+
+	// The type of the scope the pool is created in, public or private.
+	IPAMScopeType string `json:"ipamScopeType,omitempty"`
+
+	// The depth of the pool in its hierarchy of source pools.
+	PoolDepth int32 `json:"poolDepth,omitempty"`
+
+	// The current state of the pool.
+	State string `json:"state,omitempty"`
+
+	// A message about the state of the pool, if applicable.
+	StateMessage string `json:"stateMessage,omitempty"`
+}
+
+// An IPAMPoolStatus represents the observed state of an IPAMPool.
+type IPAMPoolStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            IPAMPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPAMPool is a managed resource that represents a pool of IP address
+// space in an AWS IPAM scope.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.addressFamily"
+// +kubebuilder:printcolumn:name="LOCALE",type="string",JSONPath=".spec.forProvider.locale"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPAMPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAMPoolSpec   `json:"spec"`
+	Status IPAMPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAMPoolList contains a list of IPAMPools
+type IPAMPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAMPool `json:"items"`
+}
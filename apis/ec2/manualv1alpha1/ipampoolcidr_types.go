@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// IPAMCIDRAuthorizationContext proves ownership of a public CIDR that is
+// brought to AWS.
+type IPAMCIDRAuthorizationContext struct {
+	// The plain-text authorization message for the prefix and account.
+	Message string `json:"message"`
+
+	// The signed authorization message for the prefix and account.
+	Signature string `json:"signature"`
+}
+
+// IPAMPoolCIDRParameters define the desired state of a CIDR provisioned to
+// an AWS IPAM pool.
+type IPAMPoolCIDRParameters struct {
+	// Region is the region of the IPAMPool.
+	Region string `json:"region"`
+
+	// IPAMPoolID is the ID of the pool the CIDR is provisioned to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=IPAMPool
+	IPAMPoolID *string `json:"ipamPoolId,omitempty"`
+
+	// IPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	IPAMPoolIDRef *xpv1.Reference `json:"ipamPoolIdRef,omitempty"`
+
+	// IPAMPoolIDSelector selects a reference to an IPAMPool to retrieve its
+	// ID.
+	// +optional
+	IPAMPoolIDSelector *xpv1.Selector `json:"ipamPoolIdSelector,omitempty"`
+
+	// The CIDR to provision to the pool. It must be within the range of the
+	// source pool, if the pool has one.
+	// +immutable
+	CIDR string `json:"cidr"`
+
+	// The authorization context for bringing a public IPv4 or IPv6 CIDR to
+	// the pool.
+	// +optional
+	// +immutable
+	CIDRAuthorizationContext *IPAMCIDRAuthorizationContext `json:"cidrAuthorizationContext,omitempty"`
+}
+
+// An IPAMPoolCIDRSpec defines the desired state of an IPAMPoolCIDR.
+type IPAMPoolCIDRSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPAMPoolCIDRParameters `json:"forProvider"`
+}
+
+// IPAMPoolCIDRObservation keeps the state for the external resource
+type IPAMPoolCIDRObservation struct {
+	// The current state of the CIDR.
+	State string `json:"state,omitempty"`
+
+	// The reason provisioning or deprovisioning the CIDR failed, if
+	// applicable.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// An IPAMPoolCIDRStatus represents the observed state of an IPAMPoolCIDR.
+type IPAMPoolCIDRStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            IPAMPoolCIDRObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPAMPoolCIDR is a managed resource that represents a CIDR provisioned
+// to an AWS IPAM pool. Its external name is the CIDR.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="POOL",type="string",JSONPath=".spec.forProvider.ipamPoolId"
+// +kubebuilder:printcolumn:name="CIDR",type="string",JSONPath=".spec.forProvider.cidr"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPAMPoolCIDR struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAMPoolCIDRSpec   `json:"spec"`
+	Status IPAMPoolCIDRStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAMPoolCIDRList contains a list of IPAMPoolCIDRs
+type IPAMPoolCIDRList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAMPoolCIDR `json:"items"`
+}
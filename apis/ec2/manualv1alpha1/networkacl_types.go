@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// NetworkACLPortRange describes a range of ports.
+type NetworkACLPortRange struct {
+	// The first port in the range.
+	From int32 `json:"from"`
+
+	// The last port in the range.
+	To int32 `json:"to"`
+}
+
+// NetworkACLICMPTypeCode describes the ICMP type and code.
+type NetworkACLICMPTypeCode struct {
+	// The ICMP code. A value of -1 means all codes for the specified ICMP
+	// type.
+	Code int32 `json:"code"`
+
+	// The ICMP type. A value of -1 means all types.
+	Type int32 `json:"type"`
+}
+
+// NetworkACLEntry describes an entry in a network ACL. Entries are identified
+// by their rule number and direction; any entry of the network ACL that is
+// not listed, other than the default deny-all entries, is removed.
+type NetworkACLEntry struct {
+	// The rule number for the entry. Entries are processed in ascending order
+	// by rule number.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int32 `json:"ruleNumber"`
+
+	// Indicates whether this is an egress rule (rule is applied to traffic
+	// leaving the subnet).
+	// +optional
+	Egress *bool `json:"egress,omitempty"`
+
+	// The protocol number, or one of tcp, udp, icmp and icmpv6. A value of -1
+	// means all protocols.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation.
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// The range of ports the rule applies to. Required for the TCP and UDP
+	// protocols.
+	// +optional
+	PortRange *NetworkACLPortRange `json:"portRange,omitempty"`
+
+	// The ICMP type and code. Required for the ICMP and ICMPv6 protocols.
+	// +optional
+	ICMPTypeCode *NetworkACLICMPTypeCode `json:"icmpTypeCode,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// Region is the region you'd like your network ACL to be created in.
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC the network ACL is created in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Entries are the ingress and egress rules of the network ACL.
+	// +optional
+	Entries []NetworkACLEntry `json:"entries,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLParameters `json:"forProvider"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and a
+// subnet.
+type NetworkACLAssociation struct {
+	// The ID of the association between a network ACL and a subnet.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// The ID of the network ACL.
+	NetworkACLID string `json:"networkAclId,omitempty"`
+
+	// Indicates whether this is the default network ACL for the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The ID of the AWS account that owns the network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The subnets associated with the network ACL.
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            NetworkACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+// +kubebuilder:storageversion
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// NetworkInterfaceParameters define the desired state of an AWS Elastic
+// Network Interface.
+type NetworkInterfaceParameters struct {
+	// Region is the region you'd like your NetworkInterface to be created in.
+	Region string `json:"region"`
+
+	// A description for the network interface.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The type of network interface. By default a standard interface is
+	// created.
+	// +kubebuilder:validation:Enum=efa;branch;trunk
+	// +optional
+	// +immutable
+	InterfaceType *string `json:"interfaceType,omitempty"`
+
+	// The primary private IPv4 address of the network interface. If it is
+	// not set, AWS selects one from the IPv4 CIDR range of the subnet.
+	// +optional
+	// +immutable
+	PrivateIPAddress *string `json:"privateIpAddress,omitempty"`
+
+	// The secondary private IPv4 addresses assigned to the network interface.
+	// It can't be combined with SecondaryPrivateIPAddressCount.
+	// +optional
+	SecondaryPrivateIPAddresses []string `json:"secondaryPrivateIpAddresses,omitempty"`
+
+	// The number of secondary private IPv4 addresses AWS selects from the
+	// subnet for the network interface. It can't be combined with
+	// SecondaryPrivateIPAddresses.
+	// +optional
+	SecondaryPrivateIPAddressCount *int32 `json:"secondaryPrivateIpAddressCount,omitempty"`
+
+	// Indicates whether source/destination checking is enabled. It has to be
+	// disabled for interfaces of NAT instances and other network appliances
+	// that forward traffic.
+	// +optional
+	SourceDestCheck *bool `json:"sourceDestCheck,omitempty"`
+
+	// SubnetID is the ID of the subnet the network interface is created in.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its SubnetID.
+	// +optional
+	// +immutable
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its
+	// SubnetID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The IDs of the security groups of the network interface. If it is not
+	// set, the default security group of the VPC is used.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	// +crossplane:generate:reference:refFieldName=SecurityGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SecurityGroupIDSelector
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a list of references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NetworkInterfaceSpec defines the desired state of a NetworkInterface.
+type NetworkInterfaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkInterfaceParameters `json:"forProvider"`
+}
+
+// NetworkInterfaceAttachmentObservation describes the attachment of a
+// network interface to an instance.
+type NetworkInterfaceAttachmentObservation struct {
+	// The ID of the attachment.
+	AttachmentID string `json:"attachmentId,omitempty"`
+
+	// The ID of the instance the network interface is attached to.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// The device index of the network interface on the instance.
+	DeviceIndex int32 `json:"deviceIndex,omitempty"`
+
+	// The attachment state.
+	Status string `json:"status,omitempty"`
+}
+
+// NetworkInterfaceObservation keeps the state for the external resource.
+type NetworkInterfaceObservation struct {
+	// The ID of the network interface.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+
+	// The ID of the AWS account that owns the network interface.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The Availability Zone of the network interface.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// The ID of the VPC of the network interface.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// The MAC address of the network interface.
+	MACAddress string `json:"macAddress,omitempty"`
+
+	// The primary private IPv4 address of the network interface.
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+
+	// The secondary private IPv4 addresses of the network interface.
+	SecondaryPrivateIPAddresses []string `json:"secondaryPrivateIpAddresses,omitempty"`
+
+	// The status of the network interface.
+	Status string `json:"status,omitempty"`
+
+	// The attachment of the network interface, if it is attached to an
+	// instance.
+	Attachment *NetworkInterfaceAttachmentObservation `json:"attachment,omitempty"`
+}
+
+// A NetworkInterfaceStatus represents the observed state of a
+// NetworkInterface.
+type NetworkInterfaceStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            NetworkInterfaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkInterface is a managed resource that represents an AWS Elastic
+// Network Interface.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.privateIpAddress"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkInterface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkInterfaceSpec   `json:"spec"`
+	Status NetworkInterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkInterfaceList contains a list of NetworkInterfaces
+type NetworkInterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkInterface `json:"items"`
+}
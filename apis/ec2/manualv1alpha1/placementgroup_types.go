@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// PlacementGroupParameters define the desired state of an EC2 placement
+// group.
+type PlacementGroupParameters struct {
+	// Region is the region you'd like your PlacementGroup to be created in.
+	Region string `json:"region"`
+
+	// The placement strategy. A cluster group packs instances close together
+	// inside an Availability Zone, a partition group spreads them across
+	// logical partitions that do not share racks, and a spread group places
+	// each instance on distinct hardware.
+	// +kubebuilder:validation:Enum=cluster;partition;spread
+	// +immutable
+	Strategy string `json:"strategy"`
+
+	// The number of partitions. Valid only when the strategy is partition.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=7
+	// +optional
+	// +immutable
+	PartitionCount *int32 `json:"partitionCount,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A PlacementGroupSpec defines the desired state of a PlacementGroup.
+type PlacementGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PlacementGroupParameters `json:"forProvider"`
+}
+
+// PlacementGroupObservation keeps the state for the external resource
+type PlacementGroupObservation struct {
+	// The ID of the placement group.
+	GroupID string `json:"groupId,omitempty"`
+
+	// The state of the placement group.
+	State string `json:"state,omitempty"`
+}
+
+// A PlacementGroupStatus represents the observed state of a PlacementGroup.
+type PlacementGroupStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            PlacementGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PlacementGroup is a managed resource that represents an EC2 placement
+// group, which influences how instances are placed on the underlying
+// hardware.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STRATEGY",type="string",JSONPath=".spec.forProvider.strategy"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PlacementGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PlacementGroupSpec   `json:"spec"`
+	Status PlacementGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PlacementGroupList contains a list of PlacementGroups
+type PlacementGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PlacementGroup `json:"items"`
+}
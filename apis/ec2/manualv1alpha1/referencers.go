@@ -0,0 +1,34 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// IPAMPrivateDefaultScopeID returns the status.atProvider.privateDefaultScopeId
+// of an IPAM.
+func IPAMPrivateDefaultScopeID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		ipam, ok := mg.(*IPAM)
+		if !ok {
+			return ""
+		}
+		return ipam.Status.AtProvider.PrivateDefaultScopeID
+	}
+}
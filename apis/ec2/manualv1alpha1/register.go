@@ -56,7 +56,205 @@ var (
 	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
 )
 
+// InstanceVolumeAttachment type metadata.
+var (
+	InstanceVolumeAttachmentKind             = reflect.TypeOf(InstanceVolumeAttachment{}).Name()
+	InstanceVolumeAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceVolumeAttachmentKind}.String()
+	InstanceVolumeAttachmentKindAPIVersion   = InstanceVolumeAttachmentKind + "." + SchemeGroupVersion.String()
+	InstanceVolumeAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(InstanceVolumeAttachmentKind)
+)
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+// ENIAttachment type metadata.
+var (
+	ENIAttachmentKind             = reflect.TypeOf(ENIAttachment{}).Name()
+	ENIAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: ENIAttachmentKind}.String()
+	ENIAttachmentKindAPIVersion   = ENIAttachmentKind + "." + SchemeGroupVersion.String()
+	ENIAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(ENIAttachmentKind)
+)
+
+// NetworkACL type metadata.
+var (
+	NetworkACLKind             = reflect.TypeOf(NetworkACL{}).Name()
+	NetworkACLGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLKind}.String()
+	NetworkACLKindAPIVersion   = NetworkACLKind + "." + SchemeGroupVersion.String()
+	NetworkACLGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLKind)
+)
+
+// SecurityGroupRule type metadata.
+var (
+	SecurityGroupRuleKind             = reflect.TypeOf(SecurityGroupRule{}).Name()
+	SecurityGroupRuleGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityGroupRuleKind}.String()
+	SecurityGroupRuleKindAPIVersion   = SecurityGroupRuleKind + "." + SchemeGroupVersion.String()
+	SecurityGroupRuleGroupVersionKind = SchemeGroupVersion.WithKind(SecurityGroupRuleKind)
+)
+
+// PlacementGroup type metadata.
+var (
+	PlacementGroupKind             = reflect.TypeOf(PlacementGroup{}).Name()
+	PlacementGroupGroupKind        = schema.GroupKind{Group: Group, Kind: PlacementGroupKind}.String()
+	PlacementGroupKindAPIVersion   = PlacementGroupKind + "." + SchemeGroupVersion.String()
+	PlacementGroupGroupVersionKind = SchemeGroupVersion.WithKind(PlacementGroupKind)
+)
+
+// CapacityReservation type metadata.
+var (
+	CapacityReservationKind             = reflect.TypeOf(CapacityReservation{}).Name()
+	CapacityReservationGroupKind        = schema.GroupKind{Group: Group, Kind: CapacityReservationKind}.String()
+	CapacityReservationKindAPIVersion   = CapacityReservationKind + "." + SchemeGroupVersion.String()
+	CapacityReservationGroupVersionKind = SchemeGroupVersion.WithKind(CapacityReservationKind)
+)
+
+// DHCPOptions type metadata.
+var (
+	DHCPOptionsKind             = reflect.TypeOf(DHCPOptions{}).Name()
+	DHCPOptionsGroupKind        = schema.GroupKind{Group: Group, Kind: DHCPOptionsKind}.String()
+	DHCPOptionsKindAPIVersion   = DHCPOptionsKind + "." + SchemeGroupVersion.String()
+	DHCPOptionsGroupVersionKind = SchemeGroupVersion.WithKind(DHCPOptionsKind)
+)
+
+// CustomerGateway type metadata.
+var (
+	CustomerGatewayKind             = reflect.TypeOf(CustomerGateway{}).Name()
+	CustomerGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: CustomerGatewayKind}.String()
+	CustomerGatewayKindAPIVersion   = CustomerGatewayKind + "." + SchemeGroupVersion.String()
+	CustomerGatewayGroupVersionKind = SchemeGroupVersion.WithKind(CustomerGatewayKind)
+)
+
+// VPNGateway type metadata.
+var (
+	VPNGatewayKind             = reflect.TypeOf(VPNGateway{}).Name()
+	VPNGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: VPNGatewayKind}.String()
+	VPNGatewayKindAPIVersion   = VPNGatewayKind + "." + SchemeGroupVersion.String()
+	VPNGatewayGroupVersionKind = SchemeGroupVersion.WithKind(VPNGatewayKind)
+)
+
+// VPNConnection type metadata.
+var (
+	VPNConnectionKind             = reflect.TypeOf(VPNConnection{}).Name()
+	VPNConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: VPNConnectionKind}.String()
+	VPNConnectionKindAPIVersion   = VPNConnectionKind + "." + SchemeGroupVersion.String()
+	VPNConnectionGroupVersionKind = SchemeGroupVersion.WithKind(VPNConnectionKind)
+)
+
+// ClientVPNEndpoint type metadata.
+var (
+	ClientVPNEndpointKind             = reflect.TypeOf(ClientVPNEndpoint{}).Name()
+	ClientVPNEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: ClientVPNEndpointKind}.String()
+	ClientVPNEndpointKindAPIVersion   = ClientVPNEndpointKind + "." + SchemeGroupVersion.String()
+	ClientVPNEndpointGroupVersionKind = SchemeGroupVersion.WithKind(ClientVPNEndpointKind)
+)
+
+// IPAM type metadata.
+var (
+	IPAMKind             = reflect.TypeOf(IPAM{}).Name()
+	IPAMGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMKind}.String()
+	IPAMKindAPIVersion   = IPAMKind + "." + SchemeGroupVersion.String()
+	IPAMGroupVersionKind = SchemeGroupVersion.WithKind(IPAMKind)
+)
+
+// IPAMPool type metadata.
+var (
+	IPAMPoolKind             = reflect.TypeOf(IPAMPool{}).Name()
+	IPAMPoolGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMPoolKind}.String()
+	IPAMPoolKindAPIVersion   = IPAMPoolKind + "." + SchemeGroupVersion.String()
+	IPAMPoolGroupVersionKind = SchemeGroupVersion.WithKind(IPAMPoolKind)
+)
+
+// IPAMPoolCIDR type metadata.
+var (
+	IPAMPoolCIDRKind             = reflect.TypeOf(IPAMPoolCIDR{}).Name()
+	IPAMPoolCIDRGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMPoolCIDRKind}.String()
+	IPAMPoolCIDRKindAPIVersion   = IPAMPoolCIDRKind + "." + SchemeGroupVersion.String()
+	IPAMPoolCIDRGroupVersionKind = SchemeGroupVersion.WithKind(IPAMPoolCIDRKind)
+)
+
+// EC2Fleet type metadata.
+var (
+	EC2FleetKind             = reflect.TypeOf(EC2Fleet{}).Name()
+	EC2FleetGroupKind        = schema.GroupKind{Group: Group, Kind: EC2FleetKind}.String()
+	EC2FleetKindAPIVersion   = EC2FleetKind + "." + SchemeGroupVersion.String()
+	EC2FleetGroupVersionKind = SchemeGroupVersion.WithKind(EC2FleetKind)
+)
+
+// Image type metadata.
+var (
+	ImageKind             = reflect.TypeOf(Image{}).Name()
+	ImageGroupKind        = schema.GroupKind{Group: Group, Kind: ImageKind}.String()
+	ImageKindAPIVersion   = ImageKind + "." + SchemeGroupVersion.String()
+	ImageGroupVersionKind = SchemeGroupVersion.WithKind(ImageKind)
+)
+
+// NetworkInterface type metadata.
+var (
+	NetworkInterfaceKind             = reflect.TypeOf(NetworkInterface{}).Name()
+	NetworkInterfaceGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkInterfaceKind}.String()
+	NetworkInterfaceKindAPIVersion   = NetworkInterfaceKind + "." + SchemeGroupVersion.String()
+	NetworkInterfaceGroupVersionKind = SchemeGroupVersion.WithKind(NetworkInterfaceKind)
+)
+
+// TrafficMirrorTarget type metadata.
+var (
+	TrafficMirrorTargetKind             = reflect.TypeOf(TrafficMirrorTarget{}).Name()
+	TrafficMirrorTargetGroupKind        = schema.GroupKind{Group: Group, Kind: TrafficMirrorTargetKind}.String()
+	TrafficMirrorTargetKindAPIVersion   = TrafficMirrorTargetKind + "." + SchemeGroupVersion.String()
+	TrafficMirrorTargetGroupVersionKind = SchemeGroupVersion.WithKind(TrafficMirrorTargetKind)
+)
+
+// TrafficMirrorFilter type metadata.
+var (
+	TrafficMirrorFilterKind             = reflect.TypeOf(TrafficMirrorFilter{}).Name()
+	TrafficMirrorFilterGroupKind        = schema.GroupKind{Group: Group, Kind: TrafficMirrorFilterKind}.String()
+	TrafficMirrorFilterKindAPIVersion   = TrafficMirrorFilterKind + "." + SchemeGroupVersion.String()
+	TrafficMirrorFilterGroupVersionKind = SchemeGroupVersion.WithKind(TrafficMirrorFilterKind)
+)
+
+// TrafficMirrorSession type metadata.
+var (
+	TrafficMirrorSessionKind             = reflect.TypeOf(TrafficMirrorSession{}).Name()
+	TrafficMirrorSessionGroupKind        = schema.GroupKind{Group: Group, Kind: TrafficMirrorSessionKind}.String()
+	TrafficMirrorSessionKindAPIVersion   = TrafficMirrorSessionKind + "." + SchemeGroupVersion.String()
+	TrafficMirrorSessionGroupVersionKind = SchemeGroupVersion.WithKind(TrafficMirrorSessionKind)
+)
+
+// EgressOnlyInternetGateway type metadata.
+var (
+	EgressOnlyInternetGatewayKind             = reflect.TypeOf(EgressOnlyInternetGateway{}).Name()
+	EgressOnlyInternetGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: EgressOnlyInternetGatewayKind}.String()
+	EgressOnlyInternetGatewayKindAPIVersion   = EgressOnlyInternetGatewayKind + "." + SchemeGroupVersion.String()
+	EgressOnlyInternetGatewayGroupVersionKind = SchemeGroupVersion.WithKind(EgressOnlyInternetGatewayKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPCCIDRBlock{}, &VPCCIDRBlockList{})
 	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&InstanceVolumeAttachment{}, &InstanceVolumeAttachmentList{})
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+	SchemeBuilder.Register(&ENIAttachment{}, &ENIAttachmentList{})
+	SchemeBuilder.Register(&NetworkACL{}, &NetworkACLList{})
+	SchemeBuilder.Register(&SecurityGroupRule{}, &SecurityGroupRuleList{})
+	SchemeBuilder.Register(&PlacementGroup{}, &PlacementGroupList{})
+	SchemeBuilder.Register(&CapacityReservation{}, &CapacityReservationList{})
+	SchemeBuilder.Register(&DHCPOptions{}, &DHCPOptionsList{})
+	SchemeBuilder.Register(&CustomerGateway{}, &CustomerGatewayList{})
+	SchemeBuilder.Register(&VPNGateway{}, &VPNGatewayList{})
+	SchemeBuilder.Register(&VPNConnection{}, &VPNConnectionList{})
+	SchemeBuilder.Register(&ClientVPNEndpoint{}, &ClientVPNEndpointList{})
+	SchemeBuilder.Register(&IPAM{}, &IPAMList{})
+	SchemeBuilder.Register(&IPAMPool{}, &IPAMPoolList{})
+	SchemeBuilder.Register(&IPAMPoolCIDR{}, &IPAMPoolCIDRList{})
+	SchemeBuilder.Register(&EC2Fleet{}, &EC2FleetList{})
+	SchemeBuilder.Register(&Image{}, &ImageList{})
+	SchemeBuilder.Register(&NetworkInterface{}, &NetworkInterfaceList{})
+	SchemeBuilder.Register(&TrafficMirrorTarget{}, &TrafficMirrorTargetList{})
+	SchemeBuilder.Register(&TrafficMirrorFilter{}, &TrafficMirrorFilterList{})
+	SchemeBuilder.Register(&TrafficMirrorSession{}, &TrafficMirrorSessionList{})
+	SchemeBuilder.Register(&EgressOnlyInternetGateway{}, &EgressOnlyInternetGatewayList{})
 }
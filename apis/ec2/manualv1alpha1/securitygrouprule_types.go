@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Types of security group rules.
+const (
+	SecurityGroupRuleTypeIngress = "ingress"
+	SecurityGroupRuleTypeEgress  = "egress"
+)
+
+// SecurityGroupRuleParameters define the desired state of a single rule of an
+// AWS VPC Security Group.
+type SecurityGroupRuleParameters struct {
+	// Region is the region you'd like your SecurityGroupRule to be created in.
+	Region string `json:"region"`
+
+	// SecurityGroupID is the ID of the security group the rule belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	SecurityGroupID *string `json:"securityGroupId,omitempty"`
+
+	// SecurityGroupIDRef references a security group to retrieve its ID.
+	// +optional
+	SecurityGroupIDRef *xpv1.Reference `json:"securityGroupIdRef,omitempty"`
+
+	// SecurityGroupIDSelector selects a reference to a security group to
+	// retrieve its ID.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Type of the rule, either ingress or egress.
+	// +immutable
+	// +kubebuilder:validation:Enum=ingress;egress
+	Type string `json:"type"`
+
+	// The IP protocol name (tcp, udp, icmp, icmpv6) or number. Use -1 to
+	// specify all protocols.
+	Protocol string `json:"protocol"`
+
+	// The start of the port range for the TCP and UDP protocols, or an
+	// ICMP/ICMPv6 type. A value of -1 indicates all ICMP/ICMPv6 types.
+	// +optional
+	FromPort *int32 `json:"fromPort,omitempty"`
+
+	// The end of the port range for the TCP and UDP protocols, or an
+	// ICMP/ICMPv6 code. A value of -1 indicates all ICMP/ICMPv6 codes.
+	// +optional
+	ToPort *int32 `json:"toPort,omitempty"`
+
+	// The IPv4 CIDR range the rule applies to.
+	// +optional
+	CIDRIPv4 *string `json:"cidrIpv4,omitempty"`
+
+	// The IPv6 CIDR range the rule applies to.
+	// +optional
+	CIDRIPv6 *string `json:"cidrIpv6,omitempty"`
+
+	// The ID of the prefix list the rule applies to.
+	// +optional
+	PrefixListID *string `json:"prefixListId,omitempty"`
+
+	// ReferencedSecurityGroupID is the ID of the security group the rule
+	// applies to, which can be in the same VPC or in a peered VPC.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	ReferencedSecurityGroupID *string `json:"referencedSecurityGroupId,omitempty"`
+
+	// ReferencedSecurityGroupIDRef references a security group to retrieve
+	// its ID.
+	// +optional
+	ReferencedSecurityGroupIDRef *xpv1.Reference `json:"referencedSecurityGroupIdRef,omitempty"`
+
+	// ReferencedSecurityGroupIDSelector selects a reference to a security
+	// group to retrieve its ID.
+	// +optional
+	ReferencedSecurityGroupIDSelector *xpv1.Selector `json:"referencedSecurityGroupIdSelector,omitempty"`
+
+	// The ID of the AWS account that owns the referenced security group,
+	// required if it belongs to a peered VPC in another account.
+	// +optional
+	// +immutable
+	ReferencedSecurityGroupOwnerID *string `json:"referencedSecurityGroupOwnerId,omitempty"`
+
+	// The ID of the VPC peering connection through which the referenced
+	// security group of a peered VPC is reached.
+	// +optional
+	// +immutable
+	VPCPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// A description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A SecurityGroupRuleSpec defines the desired state of a SecurityGroupRule.
+type SecurityGroupRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityGroupRuleParameters `json:"forProvider"`
+}
+
+// SecurityGroupRuleObservation keeps the state for the external resource
+type SecurityGroupRuleObservation struct {
+	// The ID of the security group rule.
+	SecurityGroupRuleID string `json:"securityGroupRuleId,omitempty"`
+
+	// The ID of the AWS account that owns the security group.
+	SecurityGroupOwnerID string `json:"securityGroupOwnerId,omitempty"`
+}
+
+// A SecurityGroupRuleStatus represents the observed state of a
+// SecurityGroupRule.
+type SecurityGroupRuleStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            SecurityGroupRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityGroupRule is a managed resource that represents a single rule of
+// an AWS VPC Security Group. Rules of a SecurityGroup that ignores its ingress
+// or egress rules can be owned by SecurityGroupRules instead.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.forProvider.securityGroupId"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+// +kubebuilder:storageversion
+type SecurityGroupRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityGroupRuleSpec   `json:"spec"`
+	Status SecurityGroupRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupRuleList contains a list of SecurityGroupRules
+type SecurityGroupRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroupRule `json:"items"`
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// SnapshotParameters define the desired state of an EBS snapshot.
+type SnapshotParameters struct {
+	// Region is the region you'd like your Snapshot to be created in.
+	Region string `json:"region"`
+
+	// The ID of the EBS volume the snapshot is taken of.
+	// +immutable
+	VolumeID string `json:"volumeId"`
+
+	// A description for the snapshot.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// The Availability Zones in which fast snapshot restore is enabled for
+	// the snapshot. Volumes created from the snapshot in these zones are fully
+	// initialized at creation.
+	// +optional
+	FastSnapshotRestoreAvailabilityZones []string `json:"fastSnapshotRestoreAvailabilityZones,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotParameters `json:"forProvider"`
+}
+
+// FastSnapshotRestoreObservation describes the state of fast snapshot restore
+// in an Availability Zone.
+type FastSnapshotRestoreObservation struct {
+	// The Availability Zone.
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// The state of fast snapshot restore.
+	State string `json:"state"`
+}
+
+// SnapshotObservation keeps the state for the external resource
+type SnapshotObservation struct {
+	// The ID of the snapshot.
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// The ID of the AWS account that owns the snapshot.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The state of the snapshot.
+	State string `json:"state,omitempty"`
+
+	// The progress of the snapshot, as a percentage.
+	Progress string `json:"progress,omitempty"`
+
+	// The size of the volume, in GiB.
+	VolumeSize *int32 `json:"volumeSize,omitempty"`
+
+	// Indicates whether the snapshot is encrypted.
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// The time stamp when the snapshot was initiated.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// The state of fast snapshot restore in the Availability Zones it is
+	// enabled in.
+	FastSnapshotRestores []FastSnapshotRestoreObservation `json:"fastSnapshotRestores,omitempty"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snapshot is a managed resource that represents a point-in-time snapshot
+// of an EBS volume.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VOLUME",type="string",JSONPath=".spec.forProvider.volumeId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshots
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// TrafficMirrorPortRange describes a range of ports.
+type TrafficMirrorPortRange struct {
+	// The start of the port range.
+	FromPort int32 `json:"fromPort"`
+
+	// The end of the port range.
+	ToPort int32 `json:"toPort"`
+}
+
+// TrafficMirrorFilterRule describes a rule of a Traffic Mirror filter. Rules
+// of the same direction are identified by their rule number.
+type TrafficMirrorFilterRule struct {
+	// The number of the rule. Rules are evaluated in ascending order of
+	// their number.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int32 `json:"ruleNumber"`
+
+	// The action to take on the filtered traffic.
+	// +kubebuilder:validation:Enum=accept;reject
+	RuleAction string `json:"ruleAction"`
+
+	// The protocol, for example UDP, to assign to the rule. If it is not
+	// set, all protocols are matched.
+	// +optional
+	Protocol *int32 `json:"protocol,omitempty"`
+
+	// The destination CIDR block to assign to the rule.
+	DestinationCIDRBlock string `json:"destinationCidrBlock"`
+
+	// The source CIDR block to assign to the rule.
+	SourceCIDRBlock string `json:"sourceCidrBlock"`
+
+	// The destination port range.
+	// +optional
+	DestinationPortRange *TrafficMirrorPortRange `json:"destinationPortRange,omitempty"`
+
+	// The source port range.
+	// +optional
+	SourcePortRange *TrafficMirrorPortRange `json:"sourcePortRange,omitempty"`
+
+	// The description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// TrafficMirrorFilterParameters define the desired state of an AWS Traffic
+// Mirror Filter.
+type TrafficMirrorFilterParameters struct {
+	// Region is the region you'd like your TrafficMirrorFilter to be created
+	// in.
+	Region string `json:"region"`
+
+	// The description of the Traffic Mirror filter.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// The network services that are mirrored. Traffic of these services is
+	// mirrored regardless of the rules of the filter.
+	// +optional
+	NetworkServices []string `json:"networkServices,omitempty"`
+
+	// The rules for inbound traffic.
+	// +optional
+	IngressRules []TrafficMirrorFilterRule `json:"ingressRules,omitempty"`
+
+	// The rules for outbound traffic.
+	// +optional
+	EgressRules []TrafficMirrorFilterRule `json:"egressRules,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TrafficMirrorFilterSpec defines the desired state of a
+// TrafficMirrorFilter.
+type TrafficMirrorFilterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TrafficMirrorFilterParameters `json:"forProvider"`
+}
+
+// TrafficMirrorFilterObservation keeps the state for the external resource.
+type TrafficMirrorFilterObservation struct {
+	// The ID of the Traffic Mirror filter.
+	TrafficMirrorFilterID string `json:"trafficMirrorFilterId,omitempty"`
+}
+
+// A TrafficMirrorFilterStatus represents the observed state of a
+// TrafficMirrorFilter.
+type TrafficMirrorFilterStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            TrafficMirrorFilterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrafficMirrorFilter is a managed resource that represents the rules which
+// decide what traffic is mirrored.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TrafficMirrorFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrafficMirrorFilterSpec   `json:"spec"`
+	Status TrafficMirrorFilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrafficMirrorFilterList contains a list of TrafficMirrorFilters
+type TrafficMirrorFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrafficMirrorFilter `json:"items"`
+}
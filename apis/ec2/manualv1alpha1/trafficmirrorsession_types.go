@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// TrafficMirrorSessionParameters define the desired state of an AWS Traffic
+// Mirror Session.
+type TrafficMirrorSessionParameters struct {
+	// Region is the region you'd like your TrafficMirrorSession to be created
+	// in.
+	Region string `json:"region"`
+
+	// The description of the Traffic Mirror session.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// NetworkInterfaceID is the ID of the source network interface whose
+	// traffic is mirrored.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=NetworkInterface
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+
+	// NetworkInterfaceIDRef references a NetworkInterface to retrieve its ID.
+	// +optional
+	NetworkInterfaceIDRef *xpv1.Reference `json:"networkInterfaceIdRef,omitempty"`
+
+	// NetworkInterfaceIDSelector selects a reference to a NetworkInterface
+	// to retrieve its ID.
+	// +optional
+	NetworkInterfaceIDSelector *xpv1.Selector `json:"networkInterfaceIdSelector,omitempty"`
+
+	// TrafficMirrorTargetID is the ID of the Traffic Mirror target.
+	// +optional
+	// +crossplane:generate:reference:type=TrafficMirrorTarget
+	TrafficMirrorTargetID *string `json:"trafficMirrorTargetId,omitempty"`
+
+	// TrafficMirrorTargetIDRef references a TrafficMirrorTarget to retrieve
+	// its ID.
+	// +optional
+	TrafficMirrorTargetIDRef *xpv1.Reference `json:"trafficMirrorTargetIdRef,omitempty"`
+
+	// TrafficMirrorTargetIDSelector selects a reference to a
+	// TrafficMirrorTarget to retrieve its ID.
+	// +optional
+	TrafficMirrorTargetIDSelector *xpv1.Selector `json:"trafficMirrorTargetIdSelector,omitempty"`
+
+	// TrafficMirrorFilterID is the ID of the Traffic Mirror filter.
+	// +optional
+	// +crossplane:generate:reference:type=TrafficMirrorFilter
+	TrafficMirrorFilterID *string `json:"trafficMirrorFilterId,omitempty"`
+
+	// TrafficMirrorFilterIDRef references a TrafficMirrorFilter to retrieve
+	// its ID.
+	// +optional
+	TrafficMirrorFilterIDRef *xpv1.Reference `json:"trafficMirrorFilterIdRef,omitempty"`
+
+	// TrafficMirrorFilterIDSelector selects a reference to a
+	// TrafficMirrorFilter to retrieve its ID.
+	// +optional
+	TrafficMirrorFilterIDSelector *xpv1.Selector `json:"trafficMirrorFilterIdSelector,omitempty"`
+
+	// The session number determines the order in which sessions are
+	// evaluated when an interface is used by multiple sessions.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	SessionNumber int32 `json:"sessionNumber"`
+
+	// The number of bytes in each packet to mirror. If it is not set, the
+	// entire packet is mirrored.
+	// +optional
+	PacketLength *int32 `json:"packetLength,omitempty"`
+
+	// The VXLAN ID for the Traffic Mirror session. If it is not set, AWS
+	// assigns a random unique ID.
+	// +optional
+	VirtualNetworkID *int32 `json:"virtualNetworkId,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TrafficMirrorSessionSpec defines the desired state of a
+// TrafficMirrorSession.
+type TrafficMirrorSessionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TrafficMirrorSessionParameters `json:"forProvider"`
+}
+
+// TrafficMirrorSessionObservation keeps the state for the external resource.
+type TrafficMirrorSessionObservation struct {
+	// The ID of the Traffic Mirror session.
+	TrafficMirrorSessionID string `json:"trafficMirrorSessionId,omitempty"`
+
+	// The ID of the AWS account that owns the Traffic Mirror session.
+	OwnerID string `json:"ownerId,omitempty"`
+}
+
+// A TrafficMirrorSessionStatus represents the observed state of a
+// TrafficMirrorSession.
+type TrafficMirrorSessionStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            TrafficMirrorSessionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrafficMirrorSession is a managed resource that mirrors the traffic of a
+// network interface to a Traffic Mirror target.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="INTERFACE",type="string",JSONPath=".spec.forProvider.networkInterfaceId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TrafficMirrorSession struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrafficMirrorSessionSpec   `json:"spec"`
+	Status TrafficMirrorSessionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrafficMirrorSessionList contains a list of TrafficMirrorSessions
+type TrafficMirrorSessionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrafficMirrorSession `json:"items"`
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// TrafficMirrorTargetParameters define the desired state of an AWS Traffic
+// Mirror Target.
+type TrafficMirrorTargetParameters struct {
+	// Region is the region you'd like your TrafficMirrorTarget to be created
+	// in.
+	Region string `json:"region"`
+
+	// The description of the Traffic Mirror target.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface mirrored traffic
+	// is sent to. Either a network interface or a Network Load Balancer has
+	// to be set.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=NetworkInterface
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+
+	// NetworkInterfaceIDRef references a NetworkInterface to retrieve its ID.
+	// +optional
+	NetworkInterfaceIDRef *xpv1.Reference `json:"networkInterfaceIdRef,omitempty"`
+
+	// NetworkInterfaceIDSelector selects a reference to a NetworkInterface
+	// to retrieve its ID.
+	// +optional
+	NetworkInterfaceIDSelector *xpv1.Selector `json:"networkInterfaceIdSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the Network Load Balancer mirrored
+	// traffic is sent to.
+	// +optional
+	// +immutable
+	NetworkLoadBalancerARN *string `json:"networkLoadBalancerArn,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TrafficMirrorTargetSpec defines the desired state of a
+// TrafficMirrorTarget.
+type TrafficMirrorTargetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TrafficMirrorTargetParameters `json:"forProvider"`
+}
+
+// TrafficMirrorTargetObservation keeps the state for the external resource.
+type TrafficMirrorTargetObservation struct {
+	// The ID of the Traffic Mirror target.
+	TrafficMirrorTargetID string `json:"trafficMirrorTargetId,omitempty"`
+
+	// The ID of the AWS account that owns the Traffic Mirror target.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The type of the Traffic Mirror target.
+	Type string `json:"type,omitempty"`
+}
+
+// A TrafficMirrorTargetStatus represents the observed state of a
+// TrafficMirrorTarget.
+type TrafficMirrorTargetStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            TrafficMirrorTargetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TrafficMirrorTarget is a managed resource that represents the destination
+// of mirrored traffic.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TrafficMirrorTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrafficMirrorTargetSpec   `json:"spec"`
+	Status TrafficMirrorTargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrafficMirrorTargetList contains a list of TrafficMirrorTargets
+type TrafficMirrorTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrafficMirrorTarget `json:"items"`
+}
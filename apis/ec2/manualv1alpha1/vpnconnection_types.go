@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// VPNTunnelOptions configure one of the two tunnels of a VPN connection.
+// Options that are omitted are late-initialized with the values chosen by
+// AWS.
+type VPNTunnelOptions struct {
+	// The range of inside IPv4 addresses for the tunnel, a size /30 CIDR
+	// block from the 169.254.0.0/16 range.
+	// +optional
+	// +immutable
+	TunnelInsideCIDR *string `json:"tunnelInsideCidr,omitempty"`
+
+	// The range of inside IPv6 addresses for the tunnel, a size /126 CIDR
+	// block from the local fd00::/8 range.
+	// +optional
+	// +immutable
+	TunnelInsideIPv6CIDR *string `json:"tunnelInsideIpv6Cidr,omitempty"`
+
+	// PreSharedKeySecretRef references the key of a Secret that holds the
+	// pre-shared key used to establish the IKE security association. AWS
+	// generates a key if it is omitted.
+	// +optional
+	// +immutable
+	PreSharedKeySecretRef *xpv1.SecretKeySelector `json:"preSharedKeySecretRef,omitempty"`
+
+	// The action to take after a dead peer detection (DPD) timeout occurs.
+	// +kubebuilder:validation:Enum=clear;none;restart
+	// +optional
+	DPDTimeoutAction *string `json:"dpdTimeoutAction,omitempty"`
+
+	// The number of seconds after which a DPD timeout occurs.
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	DPDTimeoutSeconds *int32 `json:"dpdTimeoutSeconds,omitempty"`
+
+	// The IKE versions that are permitted for the tunnel.
+	// +optional
+	IKEVersions []string `json:"ikeVersions,omitempty"`
+
+	// The Diffie-Hellman group numbers that are permitted for the tunnel
+	// for phase 1 IKE negotiations.
+	// +optional
+	Phase1DHGroupNumbers []int32 `json:"phase1DHGroupNumbers,omitempty"`
+
+	// The encryption algorithms that are permitted for the tunnel for phase
+	// 1 IKE negotiations.
+	// +optional
+	Phase1EncryptionAlgorithms []string `json:"phase1EncryptionAlgorithms,omitempty"`
+
+	// The integrity algorithms that are permitted for the tunnel for phase 1
+	// IKE negotiations.
+	// +optional
+	Phase1IntegrityAlgorithms []string `json:"phase1IntegrityAlgorithms,omitempty"`
+
+	// The lifetime for phase 1 of the IKE negotiation, in seconds.
+	// +kubebuilder:validation:Minimum=900
+	// +kubebuilder:validation:Maximum=28800
+	// +optional
+	Phase1LifetimeSeconds *int32 `json:"phase1LifetimeSeconds,omitempty"`
+
+	// The Diffie-Hellman group numbers that are permitted for the tunnel
+	// for phase 2 IKE negotiations.
+	// +optional
+	Phase2DHGroupNumbers []int32 `json:"phase2DHGroupNumbers,omitempty"`
+
+	// The encryption algorithms that are permitted for the tunnel for phase
+	// 2 IKE negotiations.
+	// +optional
+	Phase2EncryptionAlgorithms []string `json:"phase2EncryptionAlgorithms,omitempty"`
+
+	// The integrity algorithms that are permitted for the tunnel for phase 2
+	// IKE negotiations.
+	// +optional
+	Phase2IntegrityAlgorithms []string `json:"phase2IntegrityAlgorithms,omitempty"`
+
+	// The lifetime for phase 2 of the IKE negotiation, in seconds.
+	// +kubebuilder:validation:Minimum=900
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	Phase2LifetimeSeconds *int32 `json:"phase2LifetimeSeconds,omitempty"`
+
+	// The percentage of the rekey window during which the rekey time is
+	// randomly selected.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	RekeyFuzzPercentage *int32 `json:"rekeyFuzzPercentage,omitempty"`
+
+	// The margin time, in seconds, before the phase 2 lifetime expires,
+	// during which the AWS side of the connection performs an IKE rekey.
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	RekeyMarginTimeSeconds *int32 `json:"rekeyMarginTimeSeconds,omitempty"`
+
+	// The number of packets in an IKE replay window.
+	// +kubebuilder:validation:Minimum=64
+	// +kubebuilder:validation:Maximum=2048
+	// +optional
+	ReplayWindowSize *int32 `json:"replayWindowSize,omitempty"`
+
+	// The action to take when establishing the tunnel. Specify start for AWS
+	// to initiate the IKE negotiation.
+	// +kubebuilder:validation:Enum=add;start
+	// +optional
+	StartupAction *string `json:"startupAction,omitempty"`
+}
+
+// VPNConnectionParameters define the desired state of an AWS site-to-site
+// VPN connection.
+type VPNConnectionParameters struct {
+	// Region is the region you'd like your VPNConnection to be created in.
+	Region string `json:"region"`
+
+	// The type of VPN connection.
+	// +kubebuilder:validation:Enum=ipsec.1
+	// +kubebuilder:default=ipsec.1
+	// +optional
+	// +immutable
+	Type string `json:"type,omitempty"`
+
+	// The ID of the customer gateway.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=CustomerGateway
+	CustomerGatewayID *string `json:"customerGatewayId,omitempty"`
+
+	// CustomerGatewayIDRef references a CustomerGateway to retrieve its ID
+	// +optional
+	CustomerGatewayIDRef *xpv1.Reference `json:"customerGatewayIdRef,omitempty"`
+
+	// CustomerGatewayIDSelector selects a reference to a CustomerGateway to
+	// retrieve its ID
+	// +optional
+	CustomerGatewayIDSelector *xpv1.Selector `json:"customerGatewayIdSelector,omitempty"`
+
+	// The ID of the virtual private gateway. Either a virtual private gateway
+	// or a transit gateway must be specified.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=VPNGateway
+	VPNGatewayID *string `json:"vpnGatewayId,omitempty"`
+
+	// VPNGatewayIDRef references a VPNGateway to retrieve its ID
+	// +optional
+	VPNGatewayIDRef *xpv1.Reference `json:"vpnGatewayIdRef,omitempty"`
+
+	// VPNGatewayIDSelector selects a reference to a VPNGateway to retrieve
+	// its ID
+	// +optional
+	VPNGatewayIDSelector *xpv1.Selector `json:"vpnGatewayIdSelector,omitempty"`
+
+	// The ID of the transit gateway. Either a virtual private gateway or a
+	// transit gateway must be specified.
+	// +optional
+	// +immutable
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// Indicates whether the connection uses static routes only. Devices that
+	// don't support BGP must use static routes.
+	// +optional
+	// +immutable
+	StaticRoutesOnly *bool `json:"staticRoutesOnly,omitempty"`
+
+	// The destination CIDR blocks of the static routes that are used to
+	// route traffic from the virtual private gateway to the customer gateway.
+	// Only supported for connections that use static routes.
+	// +optional
+	Routes []string `json:"routes,omitempty"`
+
+	// Indicates whether to enable acceleration for the connection. Only
+	// supported for connections to transit gateways.
+	// +optional
+	// +immutable
+	EnableAcceleration *bool `json:"enableAcceleration,omitempty"`
+
+	// The IPv4 CIDR on the customer gateway side of the connection.
+	// +optional
+	// +immutable
+	LocalIPv4NetworkCIDR *string `json:"localIpv4NetworkCidr,omitempty"`
+
+	// The IPv4 CIDR on the AWS side of the connection.
+	// +optional
+	// +immutable
+	RemoteIPv4NetworkCIDR *string `json:"remoteIpv4NetworkCidr,omitempty"`
+
+	// Indicates whether the tunnels carry IPv4 or IPv6 traffic.
+	// +kubebuilder:validation:Enum=ipv4;ipv6
+	// +optional
+	// +immutable
+	TunnelInsideIPVersion *string `json:"tunnelInsideIpVersion,omitempty"`
+
+	// The options of the two tunnels of the connection, in the order of
+	// their outside IP addresses.
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	TunnelOptions []VPNTunnelOptions `json:"tunnelOptions,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPNConnectionSpec defines the desired state of a VPNConnection.
+type VPNConnectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPNConnectionParameters `json:"forProvider"`
+}
+
+// VPNStaticRoute describes a static route of a VPN connection.
+type VPNStaticRoute struct {
+	// The CIDR block associated with the local subnet of the customer data
+	// center.
+	DestinationCIDRBlock string `json:"destinationCidrBlock,omitempty"`
+
+	// Indicates how the routes were provided.
+	Source string `json:"source,omitempty"`
+
+	// The current state of the static route.
+	State string `json:"state,omitempty"`
+}
+
+// VGWTelemetry describes the state of one of the tunnels of a VPN
+// connection.
+type VGWTelemetry struct {
+	// The Internet-routable IP address of the virtual private gateway's
+	// outside interface.
+	OutsideIPAddress string `json:"outsideIpAddress,omitempty"`
+
+	// The status of the VPN tunnel.
+	Status string `json:"status,omitempty"`
+
+	// If an error occurs, a description of the error.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// The number of accepted routes.
+	AcceptedRouteCount int32 `json:"acceptedRouteCount,omitempty"`
+
+	// The date and time of the last change in status.
+	LastStatusChange *metav1.Time `json:"lastStatusChange,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the VPN tunnel endpoint certificate.
+	CertificateARN string `json:"certificateArn,omitempty"`
+}
+
+// VPNConnectionObservation keeps the state for the external resource
+type VPNConnectionObservation struct {
+	// The ID of the VPN connection.
+	VPNConnectionID string `json:"vpnConnectionId,omitempty"`
+
+	// The current state of the VPN connection.
+	State string `json:"state,omitempty"`
+
+	// The category of the VPN connection. VPN indicates an Amazon VPN
+	// connection, VPN-Classic an AWS Classic VPN connection.
+	Category string `json:"category,omitempty"`
+
+	// The current state of the gateway association.
+	GatewayAssociationState string `json:"gatewayAssociationState,omitempty"`
+
+	// The static routes associated with the VPN connection.
+	Routes []VPNStaticRoute `json:"routes,omitempty"`
+
+	// Information about the tunnels of the VPN connection.
+	VGWTelemetry []VGWTelemetry `json:"vgwTelemetry,omitempty"`
+}
+
+// A VPNConnectionStatus represents the observed state of a VPNConnection.
+type VPNConnectionStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            VPNConnectionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPNConnection is a managed resource that represents an AWS site-to-site
+// VPN connection. The configuration of the customer gateway, including the
+// pre-shared keys of the tunnels, is published as a connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPNConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPNConnectionSpec   `json:"spec"`
+	Status VPNConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPNConnectionList contains a list of VPNConnections
+type VPNConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPNConnection `json:"items"`
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// VPNGatewayParameters define the desired state of an AWS virtual private
+// gateway, the VPN concentrator on the Amazon side of a site-to-site VPN
+// connection.
+type VPNGatewayParameters struct {
+	// Region is the region you'd like your VPNGateway to be created in.
+	Region string `json:"region"`
+
+	// The type of VPN connection this virtual private gateway supports.
+	// +kubebuilder:validation:Enum=ipsec.1
+	// +kubebuilder:default=ipsec.1
+	// +optional
+	// +immutable
+	Type string `json:"type,omitempty"`
+
+	// A private Autonomous System Number (ASN) for the Amazon side of a BGP
+	// session. If omitted, the default ASN of the region is used.
+	// +optional
+	// +immutable
+	AmazonSideASN *int64 `json:"amazonSideAsn,omitempty"`
+
+	// The Availability Zone for the virtual private gateway.
+	// +optional
+	// +immutable
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// VPCID is the ID of the VPC the virtual private gateway is attached to.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to and retrieves its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPNGatewaySpec defines the desired state of a VPNGateway.
+type VPNGatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPNGatewayParameters `json:"forProvider"`
+}
+
+// VPCAttachment describes an attachment between a virtual private gateway
+// and a VPC.
+type VPCAttachment struct {
+	// The ID of the VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// The current state of the attachment.
+	State string `json:"state,omitempty"`
+}
+
+// VPNGatewayObservation keeps the state for the external resource
+type VPNGatewayObservation struct {
+	// The ID of the virtual private gateway.
+	VPNGatewayID string `json:"vpnGatewayId,omitempty"`
+
+	// The current state of the virtual private gateway.
+	State string `json:"state,omitempty"`
+
+	// The VPCs the virtual private gateway is attached to, including
+	// attachments that are being removed.
+	VPCAttachments []VPCAttachment `json:"vpcAttachments,omitempty"`
+}
+
+// A VPNGatewayStatus represents the observed state of a VPNGateway.
+type VPNGatewayStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            VPNGatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPNGateway is a managed resource that represents an AWS virtual private
+// gateway and its attachment to a VPC.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPNGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPNGatewaySpec   `json:"spec"`
+	Status VPNGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPNGatewayList contains a list of VPNGateways
+type VPNGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPNGateway `json:"items"`
+}
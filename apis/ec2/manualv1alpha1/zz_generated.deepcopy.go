@@ -23,6 +23,7 @@ package manualv1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -87,360 +88,354 @@ func (in *CPUOptionsRequest) DeepCopy() *CPUOptionsRequest {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CapacityReservationSpecification) DeepCopyInto(out *CapacityReservationSpecification) {
+func (in *CapacityReservation) DeepCopyInto(out *CapacityReservation) {
 	*out = *in
-	if in.CapacityReservationTarget != nil {
-		in, out := &in.CapacityReservationTarget, &out.CapacityReservationTarget
-		*out = new(CapacityReservationTarget)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationSpecification.
-func (in *CapacityReservationSpecification) DeepCopy() *CapacityReservationSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservation.
+func (in *CapacityReservation) DeepCopy() *CapacityReservation {
 	if in == nil {
 		return nil
 	}
-	out := new(CapacityReservationSpecification)
+	out := new(CapacityReservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CapacityReservationSpecificationResponse) DeepCopyInto(out *CapacityReservationSpecificationResponse) {
-	*out = *in
-	if in.CapacityReservationTarget != nil {
-		in, out := &in.CapacityReservationTarget, &out.CapacityReservationTarget
-		*out = new(CapacityReservationTarget)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationSpecificationResponse.
-func (in *CapacityReservationSpecificationResponse) DeepCopy() *CapacityReservationSpecificationResponse {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CapacityReservation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(CapacityReservationSpecificationResponse)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CapacityReservationTarget) DeepCopyInto(out *CapacityReservationTarget) {
+func (in *CapacityReservationList) DeepCopyInto(out *CapacityReservationList) {
 	*out = *in
-	if in.CapacityReservationID != nil {
-		in, out := &in.CapacityReservationID, &out.CapacityReservationID
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CapacityReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationTarget.
-func (in *CapacityReservationTarget) DeepCopy() *CapacityReservationTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationList.
+func (in *CapacityReservationList) DeepCopy() *CapacityReservationList {
 	if in == nil {
 		return nil
 	}
-	out := new(CapacityReservationTarget)
+	out := new(CapacityReservationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CapacityReservationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CreditSpecificationRequest) DeepCopyInto(out *CreditSpecificationRequest) {
+func (in *CapacityReservationObservation) DeepCopyInto(out *CapacityReservationObservation) {
 	*out = *in
-	if in.CPUCredits != nil {
-		in, out := &in.CPUCredits, &out.CPUCredits
-		*out = new(string)
+	if in.AvailableInstanceCount != nil {
+		in, out := &in.AvailableInstanceCount, &out.AvailableInstanceCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TotalInstanceCount != nil {
+		in, out := &in.TotalInstanceCount, &out.TotalInstanceCount
+		*out = new(int32)
 		**out = **in
 	}
+	if in.StartDate != nil {
+		in, out := &in.StartDate, &out.StartDate
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreditSpecificationRequest.
-func (in *CreditSpecificationRequest) DeepCopy() *CreditSpecificationRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationObservation.
+func (in *CapacityReservationObservation) DeepCopy() *CapacityReservationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(CreditSpecificationRequest)
+	out := new(CapacityReservationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EBSBlockDevice) DeepCopyInto(out *EBSBlockDevice) {
+func (in *CapacityReservationParameters) DeepCopyInto(out *CapacityReservationParameters) {
 	*out = *in
-	if in.DeleteOnTermination != nil {
-		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Encrypted != nil {
-		in, out := &in.Encrypted, &out.Encrypted
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
 		*out = new(bool)
 		**out = **in
 	}
-	if in.IOps != nil {
-		in, out := &in.IOps, &out.IOps
-		*out = new(int32)
+	if in.EndDateType != nil {
+		in, out := &in.EndDateType, &out.EndDateType
+		*out = new(string)
 		**out = **in
 	}
-	if in.KmsKeyID != nil {
-		in, out := &in.KmsKeyID, &out.KmsKeyID
+	if in.EndDate != nil {
+		in, out := &in.EndDate, &out.EndDate
+		*out = (*in).DeepCopy()
+	}
+	if in.InstanceMatchCriteria != nil {
+		in, out := &in.InstanceMatchCriteria, &out.InstanceMatchCriteria
 		*out = new(string)
 		**out = **in
 	}
-	if in.SnapshotID != nil {
-		in, out := &in.SnapshotID, &out.SnapshotID
+	if in.Tenancy != nil {
+		in, out := &in.Tenancy, &out.Tenancy
 		*out = new(string)
 		**out = **in
 	}
-	if in.VolumeSize != nil {
-		in, out := &in.VolumeSize, &out.VolumeSize
-		*out = new(int32)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSBlockDevice.
-func (in *EBSBlockDevice) DeepCopy() *EBSBlockDevice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationParameters.
+func (in *CapacityReservationParameters) DeepCopy() *CapacityReservationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(EBSBlockDevice)
+	out := new(CapacityReservationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EBSInstanceBlockDevice) DeepCopyInto(out *EBSInstanceBlockDevice) {
+func (in *CapacityReservationSpec) DeepCopyInto(out *CapacityReservationSpec) {
 	*out = *in
-	if in.AttachTime != nil {
-		in, out := &in.AttachTime, &out.AttachTime
-		*out = (*in).DeepCopy()
-	}
-	if in.DeleteOnTermination != nil {
-		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
-		*out = new(bool)
-		**out = **in
-	}
-	if in.VolumeID != nil {
-		in, out := &in.VolumeID, &out.VolumeID
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSInstanceBlockDevice.
-func (in *EBSInstanceBlockDevice) DeepCopy() *EBSInstanceBlockDevice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationSpec.
+func (in *CapacityReservationSpec) DeepCopy() *CapacityReservationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EBSInstanceBlockDevice)
+	out := new(CapacityReservationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticGPUAssociation) DeepCopyInto(out *ElasticGPUAssociation) {
+func (in *CapacityReservationSpecification) DeepCopyInto(out *CapacityReservationSpecification) {
 	*out = *in
-	if in.ElasticGPUAssociationID != nil {
-		in, out := &in.ElasticGPUAssociationID, &out.ElasticGPUAssociationID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticGPUAssociationState != nil {
-		in, out := &in.ElasticGPUAssociationState, &out.ElasticGPUAssociationState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticGPUAssociationTime != nil {
-		in, out := &in.ElasticGPUAssociationTime, &out.ElasticGPUAssociationTime
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticGPUID != nil {
-		in, out := &in.ElasticGPUID, &out.ElasticGPUID
-		*out = new(string)
-		**out = **in
+	if in.CapacityReservationTarget != nil {
+		in, out := &in.CapacityReservationTarget, &out.CapacityReservationTarget
+		*out = new(CapacityReservationTarget)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticGPUAssociation.
-func (in *ElasticGPUAssociation) DeepCopy() *ElasticGPUAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationSpecification.
+func (in *CapacityReservationSpecification) DeepCopy() *CapacityReservationSpecification {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticGPUAssociation)
+	out := new(CapacityReservationSpecification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticGPUSpecification) DeepCopyInto(out *ElasticGPUSpecification) {
+func (in *CapacityReservationSpecificationResponse) DeepCopyInto(out *CapacityReservationSpecificationResponse) {
 	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
+	if in.CapacityReservationTarget != nil {
+		in, out := &in.CapacityReservationTarget, &out.CapacityReservationTarget
+		*out = new(CapacityReservationTarget)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticGPUSpecification.
-func (in *ElasticGPUSpecification) DeepCopy() *ElasticGPUSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationSpecificationResponse.
+func (in *CapacityReservationSpecificationResponse) DeepCopy() *CapacityReservationSpecificationResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticGPUSpecification)
+	out := new(CapacityReservationSpecificationResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticInferenceAccelerator) DeepCopyInto(out *ElasticInferenceAccelerator) {
+func (in *CapacityReservationStatus) DeepCopyInto(out *CapacityReservationStatus) {
 	*out = *in
-	if in.Count != nil {
-		in, out := &in.Count, &out.Count
-		*out = new(int32)
-		**out = **in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationStatus.
+func (in *CapacityReservationStatus) DeepCopy() *CapacityReservationStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	out := new(CapacityReservationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityReservationTarget) DeepCopyInto(out *CapacityReservationTarget) {
+	*out = *in
+	if in.CapacityReservationID != nil {
+		in, out := &in.CapacityReservationID, &out.CapacityReservationID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticInferenceAccelerator.
-func (in *ElasticInferenceAccelerator) DeepCopy() *ElasticInferenceAccelerator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationTarget.
+func (in *CapacityReservationTarget) DeepCopy() *CapacityReservationTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticInferenceAccelerator)
+	out := new(CapacityReservationTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticInferenceAcceleratorAssociation) DeepCopyInto(out *ElasticInferenceAcceleratorAssociation) {
+func (in *ClientVPNAuthentication) DeepCopyInto(out *ClientVPNAuthentication) {
 	*out = *in
-	if in.ElasticInferenceAcceleratorARN != nil {
-		in, out := &in.ElasticInferenceAcceleratorARN, &out.ElasticInferenceAcceleratorARN
+	if in.ActiveDirectoryID != nil {
+		in, out := &in.ActiveDirectoryID, &out.ActiveDirectoryID
 		*out = new(string)
 		**out = **in
 	}
-	if in.ElasticInferenceAcceleratorAssociationID != nil {
-		in, out := &in.ElasticInferenceAcceleratorAssociationID, &out.ElasticInferenceAcceleratorAssociationID
+	if in.ClientRootCertificateChainARN != nil {
+		in, out := &in.ClientRootCertificateChainARN, &out.ClientRootCertificateChainARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ElasticInferenceAcceleratorAssociationState != nil {
-		in, out := &in.ElasticInferenceAcceleratorAssociationState, &out.ElasticInferenceAcceleratorAssociationState
+	if in.ClientRootCertificateChainARNRef != nil {
+		in, out := &in.ClientRootCertificateChainARNRef, &out.ClientRootCertificateChainARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClientRootCertificateChainARNSelector != nil {
+		in, out := &in.ClientRootCertificateChainARNSelector, &out.ClientRootCertificateChainARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SAMLProviderARN != nil {
+		in, out := &in.SAMLProviderARN, &out.SAMLProviderARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ElasticInferenceAcceleratorAssociationTime != nil {
-		in, out := &in.ElasticInferenceAcceleratorAssociationTime, &out.ElasticInferenceAcceleratorAssociationTime
-		*out = (*in).DeepCopy()
+	if in.SelfServiceSAMLProviderARN != nil {
+		in, out := &in.SelfServiceSAMLProviderARN, &out.SelfServiceSAMLProviderARN
+		*out = new(string)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticInferenceAcceleratorAssociation.
-func (in *ElasticInferenceAcceleratorAssociation) DeepCopy() *ElasticInferenceAcceleratorAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNAuthentication.
+func (in *ClientVPNAuthentication) DeepCopy() *ClientVPNAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticInferenceAcceleratorAssociation)
+	out := new(ClientVPNAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupIdentifier) DeepCopyInto(out *GroupIdentifier) {
+func (in *ClientVPNAuthorizationRule) DeepCopyInto(out *ClientVPNAuthorizationRule) {
 	*out = *in
+	if in.AccessGroupID != nil {
+		in, out := &in.AccessGroupID, &out.AccessGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AuthorizeAllGroups != nil {
+		in, out := &in.AuthorizeAllGroups, &out.AuthorizeAllGroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupIdentifier.
-func (in *GroupIdentifier) DeepCopy() *GroupIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNAuthorizationRule.
+func (in *ClientVPNAuthorizationRule) DeepCopy() *ClientVPNAuthorizationRule {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupIdentifier)
+	out := new(ClientVPNAuthorizationRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HibernationOptionsRequest) DeepCopyInto(out *HibernationOptionsRequest) {
+func (in *ClientVPNAuthorizationRuleObservation) DeepCopyInto(out *ClientVPNAuthorizationRuleObservation) {
 	*out = *in
-	if in.Configured != nil {
-		in, out := &in.Configured, &out.Configured
-		*out = new(bool)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationOptionsRequest.
-func (in *HibernationOptionsRequest) DeepCopy() *HibernationOptionsRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNAuthorizationRuleObservation.
+func (in *ClientVPNAuthorizationRuleObservation) DeepCopy() *ClientVPNAuthorizationRuleObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(HibernationOptionsRequest)
+	out := new(ClientVPNAuthorizationRuleObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMInstanceProfile) DeepCopyInto(out *IAMInstanceProfile) {
+func (in *ClientVPNConnectionLogOptions) DeepCopyInto(out *ClientVPNConnectionLogOptions) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
+	if in.CloudWatchLogGroup != nil {
+		in, out := &in.CloudWatchLogGroup, &out.CloudWatchLogGroup
 		*out = new(string)
 		**out = **in
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	if in.CloudWatchLogStream != nil {
+		in, out := &in.CloudWatchLogStream, &out.CloudWatchLogStream
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfile.
-func (in *IAMInstanceProfile) DeepCopy() *IAMInstanceProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNConnectionLogOptions.
+func (in *ClientVPNConnectionLogOptions) DeepCopy() *ClientVPNConnectionLogOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMInstanceProfile)
+	out := new(ClientVPNConnectionLogOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMInstanceProfileSpecification) DeepCopyInto(out *IAMInstanceProfileSpecification) {
-	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileSpecification.
-func (in *IAMInstanceProfileSpecification) DeepCopy() *IAMInstanceProfileSpecification {
-	if in == nil {
-		return nil
-	}
-	out := new(IAMInstanceProfileSpecification)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Instance) DeepCopyInto(out *Instance) {
+func (in *ClientVPNEndpoint) DeepCopyInto(out *ClientVPNEndpoint) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -448,18 +443,18 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
-func (in *Instance) DeepCopy() *Instance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNEndpoint.
+func (in *ClientVPNEndpoint) DeepCopy() *ClientVPNEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(Instance)
+	out := new(ClientVPNEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Instance) DeepCopyObject() runtime.Object {
+func (in *ClientVPNEndpoint) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -467,76 +462,31 @@ func (in *Instance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceBlockDeviceMapping) DeepCopyInto(out *InstanceBlockDeviceMapping) {
-	*out = *in
-	if in.DeviceName != nil {
-		in, out := &in.DeviceName, &out.DeviceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.EBS != nil {
-		in, out := &in.EBS, &out.EBS
-		*out = new(EBSInstanceBlockDevice)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceBlockDeviceMapping.
-func (in *InstanceBlockDeviceMapping) DeepCopy() *InstanceBlockDeviceMapping {
-	if in == nil {
-		return nil
-	}
-	out := new(InstanceBlockDeviceMapping)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceIPv6Address) DeepCopyInto(out *InstanceIPv6Address) {
-	*out = *in
-	if in.IPv6Address != nil {
-		in, out := &in.IPv6Address, &out.IPv6Address
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIPv6Address.
-func (in *InstanceIPv6Address) DeepCopy() *InstanceIPv6Address {
-	if in == nil {
-		return nil
-	}
-	out := new(InstanceIPv6Address)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceList) DeepCopyInto(out *InstanceList) {
+func (in *ClientVPNEndpointList) DeepCopyInto(out *ClientVPNEndpointList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Instance, len(*in))
+		*out = make([]ClientVPNEndpoint, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceList.
-func (in *InstanceList) DeepCopy() *InstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNEndpointList.
+func (in *ClientVPNEndpointList) DeepCopy() *ClientVPNEndpointList {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceList)
+	out := new(ClientVPNEndpointList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InstanceList) DeepCopyObject() runtime.Object {
+func (in *ClientVPNEndpointList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -544,478 +494,4777 @@ func (in *InstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceMarketOptionsRequest) DeepCopyInto(out *InstanceMarketOptionsRequest) {
+func (in *ClientVPNEndpointObservation) DeepCopyInto(out *ClientVPNEndpointObservation) {
 	*out = *in
-	if in.SpotOptions != nil {
-		in, out := &in.SpotOptions, &out.SpotOptions
-		*out = new(SpotMarketOptions)
-		(*in).DeepCopyInto(*out)
+	if in.NetworkAssociations != nil {
+		in, out := &in.NetworkAssociations, &out.NetworkAssociations
+		*out = make([]ClientVPNNetworkAssociationObservation, len(*in))
+		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMarketOptionsRequest.
-func (in *InstanceMarketOptionsRequest) DeepCopy() *InstanceMarketOptionsRequest {
-	if in == nil {
-		return nil
+	if in.AuthorizationRules != nil {
+		in, out := &in.AuthorizationRules, &out.AuthorizationRules
+		*out = make([]ClientVPNAuthorizationRuleObservation, len(*in))
+		copy(*out, *in)
 	}
-	out := new(InstanceMarketOptionsRequest)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceMetadataOptionsRequest) DeepCopyInto(out *InstanceMetadataOptionsRequest) {
-	*out = *in
-	if in.HTTPPutResponseHopLimit != nil {
-		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
-		*out = new(int32)
-		**out = **in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]ClientVPNRouteObservation, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptionsRequest.
-func (in *InstanceMetadataOptionsRequest) DeepCopy() *InstanceMetadataOptionsRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNEndpointObservation.
+func (in *ClientVPNEndpointObservation) DeepCopy() *ClientVPNEndpointObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceMetadataOptionsRequest)
+	out := new(ClientVPNEndpointObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceNetworkInterface) DeepCopyInto(out *InstanceNetworkInterface) {
+func (in *ClientVPNEndpointParameters) DeepCopyInto(out *ClientVPNEndpointParameters) {
 	*out = *in
-	if in.Association != nil {
-		in, out := &in.Association, &out.Association
-		*out = new(InstanceNetworkInterfaceAssociation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Attachment != nil {
-		in, out := &in.Attachment, &out.Attachment
-		*out = new(InstanceNetworkInterfaceAttachment)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]GroupIdentifier, len(*in))
-		copy(*out, *in)
-	}
-	if in.InterfaceType != nil {
-		in, out := &in.InterfaceType, &out.InterfaceType
+	if in.ServerCertificateARN != nil {
+		in, out := &in.ServerCertificateARN, &out.ServerCertificateARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPv6Addresses != nil {
-		in, out := &in.IPv6Addresses, &out.IPv6Addresses
-		*out = make([]InstanceIPv6Address, len(*in))
+	if in.ServerCertificateARNRef != nil {
+		in, out := &in.ServerCertificateARNRef, &out.ServerCertificateARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServerCertificateARNSelector != nil {
+		in, out := &in.ServerCertificateARNSelector, &out.ServerCertificateARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthenticationOptions != nil {
+		in, out := &in.AuthenticationOptions, &out.AuthenticationOptions
+		*out = make([]ClientVPNAuthentication, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
+	if in.ConnectionLogOptions != nil {
+		in, out := &in.ConnectionLogOptions, &out.ConnectionLogOptions
+		*out = new(ClientVPNConnectionLogOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
-	if in.NetworkInterfaceID != nil {
-		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
-		*out = new(string)
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SelfServicePortal != nil {
+		in, out := &in.SelfServicePortal, &out.SelfServicePortal
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateDNSName != nil {
-		in, out := &in.PrivateDNSName, &out.PrivateDNSName
-		*out = new(string)
+	if in.SplitTunnel != nil {
+		in, out := &in.SplitTunnel, &out.SplitTunnel
+		*out = new(bool)
 		**out = **in
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+	if in.TransportProtocol != nil {
+		in, out := &in.TransportProtocol, &out.TransportProtocol
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateIPAddresses != nil {
-		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
-		*out = make([]InstancePrivateIPAddress, len(*in))
+	if in.VPNPort != nil {
+		in, out := &in.VPNPort, &out.VPNPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NetworkAssociations != nil {
+		in, out := &in.NetworkAssociations, &out.NetworkAssociations
+		*out = make([]ClientVPNNetworkAssociation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.SourceDestCheck != nil {
-		in, out := &in.SourceDestCheck, &out.SourceDestCheck
-		*out = new(bool)
-		**out = **in
+	if in.AuthorizationRules != nil {
+		in, out := &in.AuthorizationRules, &out.AuthorizationRules
+		*out = make([]ClientVPNAuthorizationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
-		*out = new(string)
-		**out = **in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]ClientVPNRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterface.
-func (in *InstanceNetworkInterface) DeepCopy() *InstanceNetworkInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNEndpointParameters.
+func (in *ClientVPNEndpointParameters) DeepCopy() *ClientVPNEndpointParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceNetworkInterface)
+	out := new(ClientVPNEndpointParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceNetworkInterfaceAssociation) DeepCopyInto(out *InstanceNetworkInterfaceAssociation) {
+func (in *ClientVPNEndpointSpec) DeepCopyInto(out *ClientVPNEndpointSpec) {
 	*out = *in
-	if in.IPOwnerID != nil {
-		in, out := &in.IPOwnerID, &out.IPOwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PublicDNSName != nil {
-		in, out := &in.PublicDNSName, &out.PublicDNSName
-		*out = new(string)
-		**out = **in
-	}
-	if in.PublicIP != nil {
-		in, out := &in.PublicIP, &out.PublicIP
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceAssociation.
-func (in *InstanceNetworkInterfaceAssociation) DeepCopy() *InstanceNetworkInterfaceAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNEndpointSpec.
+func (in *ClientVPNEndpointSpec) DeepCopy() *ClientVPNEndpointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceNetworkInterfaceAssociation)
+	out := new(ClientVPNEndpointSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceNetworkInterfaceAttachment) DeepCopyInto(out *InstanceNetworkInterfaceAttachment) {
+func (in *ClientVPNEndpointStatus) DeepCopyInto(out *ClientVPNEndpointStatus) {
 	*out = *in
-	if in.AttachTime != nil {
-		in, out := &in.AttachTime, &out.AttachTime
-		*out = (*in).DeepCopy()
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNEndpointStatus.
+func (in *ClientVPNEndpointStatus) DeepCopy() *ClientVPNEndpointStatus {
+	if in == nil {
+		return nil
 	}
-	if in.AttachmentID != nil {
-		in, out := &in.AttachmentID, &out.AttachmentID
+	out := new(ClientVPNEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientVPNNetworkAssociation) DeepCopyInto(out *ClientVPNNetworkAssociation) {
+	*out = *in
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
 		*out = new(string)
 		**out = **in
 	}
-	if in.DeleteOnTermination != nil {
-		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
-		*out = new(bool)
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.DeviceIndex != nil {
-		in, out := &in.DeviceIndex, &out.DeviceIndex
-		*out = new(int32)
-		**out = **in
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceAttachment.
-func (in *InstanceNetworkInterfaceAttachment) DeepCopy() *InstanceNetworkInterfaceAttachment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNNetworkAssociation.
+func (in *ClientVPNNetworkAssociation) DeepCopy() *ClientVPNNetworkAssociation {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceNetworkInterfaceAttachment)
+	out := new(ClientVPNNetworkAssociation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceNetworkInterfaceSpecification) DeepCopyInto(out *InstanceNetworkInterfaceSpecification) {
+func (in *ClientVPNNetworkAssociationObservation) DeepCopyInto(out *ClientVPNNetworkAssociationObservation) {
 	*out = *in
-	if in.AssociatePublicIPAddress != nil {
-		in, out := &in.AssociatePublicIPAddress, &out.AssociatePublicIPAddress
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNNetworkAssociationObservation.
+func (in *ClientVPNNetworkAssociationObservation) DeepCopy() *ClientVPNNetworkAssociationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientVPNNetworkAssociationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientVPNRoute) DeepCopyInto(out *ClientVPNRoute) {
+	*out = *in
+	if in.TargetSubnetID != nil {
+		in, out := &in.TargetSubnetID, &out.TargetSubnetID
+		*out = new(string)
 		**out = **in
 	}
-	if in.DeleteOnTermination != nil {
-		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
-		*out = new(bool)
+	if in.TargetSubnetIDRef != nil {
+		in, out := &in.TargetSubnetIDRef, &out.TargetSubnetIDRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
+	if in.TargetSubnetIDSelector != nil {
+		in, out := &in.TargetSubnetIDSelector, &out.TargetSubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.DeviceIndex != nil {
-		in, out := &in.DeviceIndex, &out.DeviceIndex
-		*out = new(int32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNRoute.
+func (in *ClientVPNRoute) DeepCopy() *ClientVPNRoute {
+	if in == nil {
+		return nil
 	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(ClientVPNRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientVPNRouteObservation) DeepCopyInto(out *ClientVPNRouteObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientVPNRouteObservation.
+func (in *ClientVPNRouteObservation) DeepCopy() *ClientVPNRouteObservation {
+	if in == nil {
+		return nil
 	}
-	if in.InterfaceType != nil {
-		in, out := &in.InterfaceType, &out.InterfaceType
+	out := new(ClientVPNRouteObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CreditSpecificationRequest) DeepCopyInto(out *CreditSpecificationRequest) {
+	*out = *in
+	if in.CPUCredits != nil {
+		in, out := &in.CPUCredits, &out.CPUCredits
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPv6AddressCount != nil {
-		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
-		*out = new(int32)
-		**out = **in
-	}
-	if in.IPv6Addresses != nil {
-		in, out := &in.IPv6Addresses, &out.IPv6Addresses
-		*out = make([]InstanceIPv6Address, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreditSpecificationRequest.
+func (in *CreditSpecificationRequest) DeepCopy() *CreditSpecificationRequest {
+	if in == nil {
+		return nil
 	}
-	if in.NetworkInterfaceID != nil {
-		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
-		*out = new(string)
-		**out = **in
+	out := new(CreditSpecificationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGateway) DeepCopyInto(out *CustomerGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGateway.
+func (in *CustomerGateway) DeepCopy() *CustomerGateway {
+	if in == nil {
+		return nil
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
+	out := new(CustomerGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomerGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.PrivateIPAddresses != nil {
-		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
-		*out = make([]PrivateIPAddressSpecification, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayList) DeepCopyInto(out *CustomerGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomerGateway, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.SecondaryPrivateIPAddressCount != nil {
-		in, out := &in.SecondaryPrivateIPAddressCount, &out.SecondaryPrivateIPAddressCount
-		*out = new(int32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayList.
+func (in *CustomerGatewayList) DeepCopy() *CustomerGatewayList {
+	if in == nil {
+		return nil
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
-		*out = new(string)
-		**out = **in
+	out := new(CustomerGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomerGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceSpecification.
-func (in *InstanceNetworkInterfaceSpecification) DeepCopy() *InstanceNetworkInterfaceSpecification {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayObservation) DeepCopyInto(out *CustomerGatewayObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayObservation.
+func (in *CustomerGatewayObservation) DeepCopy() *CustomerGatewayObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceNetworkInterfaceSpecification)
+	out := new(CustomerGatewayObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
+func (in *CustomerGatewayParameters) DeepCopyInto(out *CustomerGatewayParameters) {
 	*out = *in
-	if in.AmiLaunchIndex != nil {
-		in, out := &in.AmiLaunchIndex, &out.AmiLaunchIndex
+	if in.BGPASN != nil {
+		in, out := &in.BGPASN, &out.BGPASN
 		*out = new(int32)
 		**out = **in
 	}
-	if in.BlockDeviceMapping != nil {
-		in, out := &in.BlockDeviceMapping, &out.BlockDeviceMapping
-		*out = make([]InstanceBlockDeviceMapping, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.CapacityReservationID != nil {
-		in, out := &in.CapacityReservationID, &out.CapacityReservationID
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
 		*out = new(string)
 		**out = **in
 	}
-	if in.CapacityReservationSpecification != nil {
-		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
-		*out = new(CapacityReservationSpecificationResponse)
-		(*in).DeepCopyInto(*out)
+	if in.CertificateARN != nil {
+		in, out := &in.CertificateARN, &out.CertificateARN
+		*out = new(string)
+		**out = **in
 	}
-	if in.ClientToken != nil {
-		in, out := &in.ClientToken, &out.ClientToken
+	if in.DeviceName != nil {
+		in, out := &in.DeviceName, &out.DeviceName
 		*out = new(string)
 		**out = **in
 	}
-	if in.CPUOptons != nil {
-		in, out := &in.CPUOptons, &out.CPUOptons
-		*out = new(CPUOptionsRequest)
-		(*in).DeepCopyInto(*out)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
-	if in.EBSOptimized != nil {
-		in, out := &in.EBSOptimized, &out.EBSOptimized
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayParameters.
+func (in *CustomerGatewayParameters) DeepCopy() *CustomerGatewayParameters {
+	if in == nil {
+		return nil
 	}
-	if in.ElasticGPUAssociations != nil {
-		in, out := &in.ElasticGPUAssociations, &out.ElasticGPUAssociations
-		*out = make([]ElasticGPUAssociation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(CustomerGatewayParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewaySpec) DeepCopyInto(out *CustomerGatewaySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewaySpec.
+func (in *CustomerGatewaySpec) DeepCopy() *CustomerGatewaySpec {
+	if in == nil {
+		return nil
 	}
-	if in.ElasticInferenceAcceleratorAssociations != nil {
-		in, out := &in.ElasticInferenceAcceleratorAssociations, &out.ElasticInferenceAcceleratorAssociations
-		*out = make([]ElasticInferenceAcceleratorAssociation, len(*in))
+	out := new(CustomerGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomerGatewayStatus) DeepCopyInto(out *CustomerGatewayStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomerGatewayStatus.
+func (in *CustomerGatewayStatus) DeepCopy() *CustomerGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomerGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptions.
+func (in *DHCPOptions) DeepCopy() *DHCPOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsList) DeepCopyInto(out *DHCPOptionsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DHCPOptions, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.EnaSupport != nil {
-		in, out := &in.EnaSupport, &out.EnaSupport
-		*out = new(bool)
-		**out = **in
-	}
-	if in.HibernationOptions != nil {
-		in, out := &in.HibernationOptions, &out.HibernationOptions
-		*out = new(HibernationOptionsRequest)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsList.
+func (in *DHCPOptionsList) DeepCopy() *DHCPOptionsList {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptionsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsObservation) DeepCopyInto(out *DHCPOptionsObservation) {
+	*out = *in
+	if in.VPCIDs != nil {
+		in, out := &in.VPCIDs, &out.VPCIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsObservation.
+func (in *DHCPOptionsObservation) DeepCopy() *DHCPOptionsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsParameters) DeepCopyInto(out *DHCPOptionsParameters) {
+	*out = *in
+	if in.DomainName != nil {
+		in, out := &in.DomainName, &out.DomainName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DomainNameServers != nil {
+		in, out := &in.DomainNameServers, &out.DomainNameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetBIOSNameServers != nil {
+		in, out := &in.NetBIOSNameServers, &out.NetBIOSNameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetBIOSNodeType != nil {
+		in, out := &in.NetBIOSNodeType, &out.NetBIOSNodeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsParameters.
+func (in *DHCPOptionsParameters) DeepCopy() *DHCPOptionsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsSpec) DeepCopyInto(out *DHCPOptionsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsSpec.
+func (in *DHCPOptionsSpec) DeepCopy() *DHCPOptionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsStatus) DeepCopyInto(out *DHCPOptionsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsStatus.
+func (in *DHCPOptionsStatus) DeepCopy() *DHCPOptionsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptionsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSBlockDevice) DeepCopyInto(out *EBSBlockDevice) {
+	*out = *in
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IOps != nil {
+		in, out := &in.IOps, &out.IOps
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KmsKeyID != nil {
+		in, out := &in.KmsKeyID, &out.KmsKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeSize != nil {
+		in, out := &in.VolumeSize, &out.VolumeSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSBlockDevice.
+func (in *EBSBlockDevice) DeepCopy() *EBSBlockDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSBlockDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EBSInstanceBlockDevice) DeepCopyInto(out *EBSInstanceBlockDevice) {
+	*out = *in
+	if in.AttachTime != nil {
+		in, out := &in.AttachTime, &out.AttachTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSInstanceBlockDevice.
+func (in *EBSInstanceBlockDevice) DeepCopy() *EBSInstanceBlockDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(EBSInstanceBlockDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2Fleet) DeepCopyInto(out *EC2Fleet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2Fleet.
+func (in *EC2Fleet) DeepCopy() *EC2Fleet {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2Fleet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EC2Fleet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetError) DeepCopyInto(out *EC2FleetError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetError.
+func (in *EC2FleetError) DeepCopy() *EC2FleetError {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetLaunchTemplateConfig) DeepCopyInto(out *EC2FleetLaunchTemplateConfig) {
+	*out = *in
+	in.LaunchTemplateSpecification.DeepCopyInto(&out.LaunchTemplateSpecification)
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]EC2FleetLaunchTemplateOverrides, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetLaunchTemplateConfig.
+func (in *EC2FleetLaunchTemplateConfig) DeepCopy() *EC2FleetLaunchTemplateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetLaunchTemplateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetLaunchTemplateOverrides) DeepCopyInto(out *EC2FleetLaunchTemplateOverrides) {
+	*out = *in
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceType != nil {
+		in, out := &in.InstanceType, &out.InstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxPrice != nil {
+		in, out := &in.MaxPrice, &out.MaxPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WeightedCapacity != nil {
+		in, out := &in.WeightedCapacity, &out.WeightedCapacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetLaunchTemplateOverrides.
+func (in *EC2FleetLaunchTemplateOverrides) DeepCopy() *EC2FleetLaunchTemplateOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetLaunchTemplateOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetLaunchTemplateSpecification) DeepCopyInto(out *EC2FleetLaunchTemplateSpecification) {
+	*out = *in
+	if in.LaunchTemplateID != nil {
+		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplateName != nil {
+		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetLaunchTemplateSpecification.
+func (in *EC2FleetLaunchTemplateSpecification) DeepCopy() *EC2FleetLaunchTemplateSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetLaunchTemplateSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetList) DeepCopyInto(out *EC2FleetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EC2Fleet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetList.
+func (in *EC2FleetList) DeepCopy() *EC2FleetList {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EC2FleetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetObservation) DeepCopyInto(out *EC2FleetObservation) {
+	*out = *in
+	if in.InstanceIDs != nil {
+		in, out := &in.InstanceIDs, &out.InstanceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]EC2FleetError, len(*in))
+		copy(*out, *in)
+	}
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetObservation.
+func (in *EC2FleetObservation) DeepCopy() *EC2FleetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetOnDemandOptions) DeepCopyInto(out *EC2FleetOnDemandOptions) {
+	*out = *in
+	if in.AllocationStrategy != nil {
+		in, out := &in.AllocationStrategy, &out.AllocationStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxTotalPrice != nil {
+		in, out := &in.MaxTotalPrice, &out.MaxTotalPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.SingleAvailabilityZone != nil {
+		in, out := &in.SingleAvailabilityZone, &out.SingleAvailabilityZone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SingleInstanceType != nil {
+		in, out := &in.SingleInstanceType, &out.SingleInstanceType
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetOnDemandOptions.
+func (in *EC2FleetOnDemandOptions) DeepCopy() *EC2FleetOnDemandOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetOnDemandOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetParameters) DeepCopyInto(out *EC2FleetParameters) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplateConfigs != nil {
+		in, out := &in.LaunchTemplateConfigs, &out.LaunchTemplateConfigs
+		*out = make([]EC2FleetLaunchTemplateConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.TargetCapacitySpecification.DeepCopyInto(&out.TargetCapacitySpecification)
+	if in.SpotOptions != nil {
+		in, out := &in.SpotOptions, &out.SpotOptions
+		*out = new(EC2FleetSpotOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnDemandOptions != nil {
+		in, out := &in.OnDemandOptions, &out.OnDemandOptions
+		*out = new(EC2FleetOnDemandOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcessCapacityTerminationPolicy != nil {
+		in, out := &in.ExcessCapacityTerminationPolicy, &out.ExcessCapacityTerminationPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplaceUnhealthyInstances != nil {
+		in, out := &in.ReplaceUnhealthyInstances, &out.ReplaceUnhealthyInstances
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TerminateInstancesWithExpiration != nil {
+		in, out := &in.TerminateInstancesWithExpiration, &out.TerminateInstancesWithExpiration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ValidFrom != nil {
+		in, out := &in.ValidFrom, &out.ValidFrom
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.TerminateInstancesOnDeletion != nil {
+		in, out := &in.TerminateInstancesOnDeletion, &out.TerminateInstancesOnDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetParameters.
+func (in *EC2FleetParameters) DeepCopy() *EC2FleetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetSpec) DeepCopyInto(out *EC2FleetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetSpec.
+func (in *EC2FleetSpec) DeepCopy() *EC2FleetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetSpotCapacityRebalance) DeepCopyInto(out *EC2FleetSpotCapacityRebalance) {
+	*out = *in
+	if in.TerminationDelay != nil {
+		in, out := &in.TerminationDelay, &out.TerminationDelay
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetSpotCapacityRebalance.
+func (in *EC2FleetSpotCapacityRebalance) DeepCopy() *EC2FleetSpotCapacityRebalance {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetSpotCapacityRebalance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetSpotMaintenanceStrategies) DeepCopyInto(out *EC2FleetSpotMaintenanceStrategies) {
+	*out = *in
+	if in.CapacityRebalance != nil {
+		in, out := &in.CapacityRebalance, &out.CapacityRebalance
+		*out = new(EC2FleetSpotCapacityRebalance)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetSpotMaintenanceStrategies.
+func (in *EC2FleetSpotMaintenanceStrategies) DeepCopy() *EC2FleetSpotMaintenanceStrategies {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetSpotMaintenanceStrategies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetSpotOptions) DeepCopyInto(out *EC2FleetSpotOptions) {
+	*out = *in
+	if in.AllocationStrategy != nil {
+		in, out := &in.AllocationStrategy, &out.AllocationStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceInterruptionBehavior != nil {
+		in, out := &in.InstanceInterruptionBehavior, &out.InstanceInterruptionBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstancePoolsToUseCount != nil {
+		in, out := &in.InstancePoolsToUseCount, &out.InstancePoolsToUseCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaintenanceStrategies != nil {
+		in, out := &in.MaintenanceStrategies, &out.MaintenanceStrategies
+		*out = new(EC2FleetSpotMaintenanceStrategies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxTotalPrice != nil {
+		in, out := &in.MaxTotalPrice, &out.MaxTotalPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.SingleAvailabilityZone != nil {
+		in, out := &in.SingleAvailabilityZone, &out.SingleAvailabilityZone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SingleInstanceType != nil {
+		in, out := &in.SingleInstanceType, &out.SingleInstanceType
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetSpotOptions.
+func (in *EC2FleetSpotOptions) DeepCopy() *EC2FleetSpotOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetSpotOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetStatus) DeepCopyInto(out *EC2FleetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetStatus.
+func (in *EC2FleetStatus) DeepCopy() *EC2FleetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EC2FleetTargetCapacitySpecification) DeepCopyInto(out *EC2FleetTargetCapacitySpecification) {
+	*out = *in
+	if in.OnDemandTargetCapacity != nil {
+		in, out := &in.OnDemandTargetCapacity, &out.OnDemandTargetCapacity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpotTargetCapacity != nil {
+		in, out := &in.SpotTargetCapacity, &out.SpotTargetCapacity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DefaultTargetCapacityType != nil {
+		in, out := &in.DefaultTargetCapacityType, &out.DefaultTargetCapacityType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2FleetTargetCapacitySpecification.
+func (in *EC2FleetTargetCapacitySpecification) DeepCopy() *EC2FleetTargetCapacitySpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(EC2FleetTargetCapacitySpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENIAttachment) DeepCopyInto(out *ENIAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENIAttachment.
+func (in *ENIAttachment) DeepCopy() *ENIAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(ENIAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ENIAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENIAttachmentList) DeepCopyInto(out *ENIAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ENIAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENIAttachmentList.
+func (in *ENIAttachmentList) DeepCopy() *ENIAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(ENIAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ENIAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENIAttachmentObservation) DeepCopyInto(out *ENIAttachmentObservation) {
+	*out = *in
+	if in.AttachTime != nil {
+		in, out := &in.AttachTime, &out.AttachTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENIAttachmentObservation.
+func (in *ENIAttachmentObservation) DeepCopy() *ENIAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ENIAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENIAttachmentParameters) DeepCopyInto(out *ENIAttachmentParameters) {
+	*out = *in
+	if in.NetworkCardIndex != nil {
+		in, out := &in.NetworkCardIndex, &out.NetworkCardIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceIDRef != nil {
+		in, out := &in.NetworkInterfaceIDRef, &out.NetworkInterfaceIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.NetworkInterfaceIDSelector != nil {
+		in, out := &in.NetworkInterfaceIDSelector, &out.NetworkInterfaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceIDRef != nil {
+		in, out := &in.InstanceIDRef, &out.InstanceIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.InstanceIDSelector != nil {
+		in, out := &in.InstanceIDSelector, &out.InstanceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForceDetach != nil {
+		in, out := &in.ForceDetach, &out.ForceDetach
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENIAttachmentParameters.
+func (in *ENIAttachmentParameters) DeepCopy() *ENIAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ENIAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENIAttachmentSpec) DeepCopyInto(out *ENIAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENIAttachmentSpec.
+func (in *ENIAttachmentSpec) DeepCopy() *ENIAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ENIAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENIAttachmentStatus) DeepCopyInto(out *ENIAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENIAttachmentStatus.
+func (in *ENIAttachmentStatus) DeepCopy() *ENIAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ENIAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressOnlyInternetGateway) DeepCopyInto(out *EgressOnlyInternetGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressOnlyInternetGateway.
+func (in *EgressOnlyInternetGateway) DeepCopy() *EgressOnlyInternetGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressOnlyInternetGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressOnlyInternetGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressOnlyInternetGatewayAttachment) DeepCopyInto(out *EgressOnlyInternetGatewayAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressOnlyInternetGatewayAttachment.
+func (in *EgressOnlyInternetGatewayAttachment) DeepCopy() *EgressOnlyInternetGatewayAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressOnlyInternetGatewayAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressOnlyInternetGatewayList) DeepCopyInto(out *EgressOnlyInternetGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EgressOnlyInternetGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressOnlyInternetGatewayList.
+func (in *EgressOnlyInternetGatewayList) DeepCopy() *EgressOnlyInternetGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressOnlyInternetGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressOnlyInternetGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressOnlyInternetGatewayObservation) DeepCopyInto(out *EgressOnlyInternetGatewayObservation) {
+	*out = *in
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]EgressOnlyInternetGatewayAttachment, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressOnlyInternetGatewayObservation.
+func (in *EgressOnlyInternetGatewayObservation) DeepCopy() *EgressOnlyInternetGatewayObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressOnlyInternetGatewayObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressOnlyInternetGatewayParameters) DeepCopyInto(out *EgressOnlyInternetGatewayParameters) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressOnlyInternetGatewayParameters.
+func (in *EgressOnlyInternetGatewayParameters) DeepCopy() *EgressOnlyInternetGatewayParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressOnlyInternetGatewayParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressOnlyInternetGatewaySpec) DeepCopyInto(out *EgressOnlyInternetGatewaySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressOnlyInternetGatewaySpec.
+func (in *EgressOnlyInternetGatewaySpec) DeepCopy() *EgressOnlyInternetGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressOnlyInternetGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressOnlyInternetGatewayStatus) DeepCopyInto(out *EgressOnlyInternetGatewayStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressOnlyInternetGatewayStatus.
+func (in *EgressOnlyInternetGatewayStatus) DeepCopy() *EgressOnlyInternetGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressOnlyInternetGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticGPUAssociation) DeepCopyInto(out *ElasticGPUAssociation) {
+	*out = *in
+	if in.ElasticGPUAssociationID != nil {
+		in, out := &in.ElasticGPUAssociationID, &out.ElasticGPUAssociationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ElasticGPUAssociationState != nil {
+		in, out := &in.ElasticGPUAssociationState, &out.ElasticGPUAssociationState
+		*out = new(string)
+		**out = **in
+	}
+	if in.ElasticGPUAssociationTime != nil {
+		in, out := &in.ElasticGPUAssociationTime, &out.ElasticGPUAssociationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.ElasticGPUID != nil {
+		in, out := &in.ElasticGPUID, &out.ElasticGPUID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticGPUAssociation.
+func (in *ElasticGPUAssociation) DeepCopy() *ElasticGPUAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticGPUAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticGPUSpecification) DeepCopyInto(out *ElasticGPUSpecification) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticGPUSpecification.
+func (in *ElasticGPUSpecification) DeepCopy() *ElasticGPUSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticGPUSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticInferenceAccelerator) DeepCopyInto(out *ElasticInferenceAccelerator) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticInferenceAccelerator.
+func (in *ElasticInferenceAccelerator) DeepCopy() *ElasticInferenceAccelerator {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticInferenceAccelerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticInferenceAcceleratorAssociation) DeepCopyInto(out *ElasticInferenceAcceleratorAssociation) {
+	*out = *in
+	if in.ElasticInferenceAcceleratorARN != nil {
+		in, out := &in.ElasticInferenceAcceleratorARN, &out.ElasticInferenceAcceleratorARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ElasticInferenceAcceleratorAssociationID != nil {
+		in, out := &in.ElasticInferenceAcceleratorAssociationID, &out.ElasticInferenceAcceleratorAssociationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ElasticInferenceAcceleratorAssociationState != nil {
+		in, out := &in.ElasticInferenceAcceleratorAssociationState, &out.ElasticInferenceAcceleratorAssociationState
+		*out = new(string)
+		**out = **in
+	}
+	if in.ElasticInferenceAcceleratorAssociationTime != nil {
+		in, out := &in.ElasticInferenceAcceleratorAssociationTime, &out.ElasticInferenceAcceleratorAssociationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticInferenceAcceleratorAssociation.
+func (in *ElasticInferenceAcceleratorAssociation) DeepCopy() *ElasticInferenceAcceleratorAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticInferenceAcceleratorAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FastSnapshotRestoreObservation) DeepCopyInto(out *FastSnapshotRestoreObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FastSnapshotRestoreObservation.
+func (in *FastSnapshotRestoreObservation) DeepCopy() *FastSnapshotRestoreObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FastSnapshotRestoreObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupIdentifier) DeepCopyInto(out *GroupIdentifier) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupIdentifier.
+func (in *GroupIdentifier) DeepCopy() *GroupIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationOptionsRequest) DeepCopyInto(out *HibernationOptionsRequest) {
+	*out = *in
+	if in.Configured != nil {
+		in, out := &in.Configured, &out.Configured
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationOptionsRequest.
+func (in *HibernationOptionsRequest) DeepCopy() *HibernationOptionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfile) DeepCopyInto(out *IAMInstanceProfile) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfile.
+func (in *IAMInstanceProfile) DeepCopy() *IAMInstanceProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfileSpecification) DeepCopyInto(out *IAMInstanceProfileSpecification) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.NameRef != nil {
+		in, out := &in.NameRef, &out.NameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.NameSelector != nil {
+		in, out := &in.NameSelector, &out.NameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileSpecification.
+func (in *IAMInstanceProfileSpecification) DeepCopy() *IAMInstanceProfileSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMInstanceProfileSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAM) DeepCopyInto(out *IPAM) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAM.
+func (in *IPAM) DeepCopy() *IPAM {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAM) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMCIDRAuthorizationContext) DeepCopyInto(out *IPAMCIDRAuthorizationContext) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMCIDRAuthorizationContext.
+func (in *IPAMCIDRAuthorizationContext) DeepCopy() *IPAMCIDRAuthorizationContext {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMCIDRAuthorizationContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMList) DeepCopyInto(out *IPAMList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAM, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMList.
+func (in *IPAMList) DeepCopy() *IPAMList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMObservation) DeepCopyInto(out *IPAMObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMObservation.
+func (in *IPAMObservation) DeepCopy() *IPAMObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMParameters) DeepCopyInto(out *IPAMParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperatingRegions != nil {
+		in, out := &in.OperatingRegions, &out.OperatingRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMParameters.
+func (in *IPAMParameters) DeepCopy() *IPAMParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
+func (in *IPAMPool) DeepCopy() *IPAMPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolCIDR) DeepCopyInto(out *IPAMPoolCIDR) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolCIDR.
+func (in *IPAMPoolCIDR) DeepCopy() *IPAMPoolCIDR {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolCIDR)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMPoolCIDR) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolCIDRList) DeepCopyInto(out *IPAMPoolCIDRList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAMPoolCIDR, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolCIDRList.
+func (in *IPAMPoolCIDRList) DeepCopy() *IPAMPoolCIDRList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolCIDRList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMPoolCIDRList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolCIDRObservation) DeepCopyInto(out *IPAMPoolCIDRObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolCIDRObservation.
+func (in *IPAMPoolCIDRObservation) DeepCopy() *IPAMPoolCIDRObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolCIDRObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolCIDRParameters) DeepCopyInto(out *IPAMPoolCIDRParameters) {
+	*out = *in
+	if in.IPAMPoolID != nil {
+		in, out := &in.IPAMPoolID, &out.IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMPoolIDRef != nil {
+		in, out := &in.IPAMPoolIDRef, &out.IPAMPoolIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IPAMPoolIDSelector != nil {
+		in, out := &in.IPAMPoolIDSelector, &out.IPAMPoolIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CIDRAuthorizationContext != nil {
+		in, out := &in.CIDRAuthorizationContext, &out.CIDRAuthorizationContext
+		*out = new(IPAMCIDRAuthorizationContext)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolCIDRParameters.
+func (in *IPAMPoolCIDRParameters) DeepCopy() *IPAMPoolCIDRParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolCIDRParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolCIDRSpec) DeepCopyInto(out *IPAMPoolCIDRSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolCIDRSpec.
+func (in *IPAMPoolCIDRSpec) DeepCopy() *IPAMPoolCIDRSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolCIDRSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolCIDRStatus) DeepCopyInto(out *IPAMPoolCIDRStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolCIDRStatus.
+func (in *IPAMPoolCIDRStatus) DeepCopy() *IPAMPoolCIDRStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolCIDRStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolList) DeepCopyInto(out *IPAMPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAMPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolList.
+func (in *IPAMPoolList) DeepCopy() *IPAMPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolObservation) DeepCopyInto(out *IPAMPoolObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolObservation.
+func (in *IPAMPoolObservation) DeepCopy() *IPAMPoolObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolParameters) DeepCopyInto(out *IPAMPoolParameters) {
+	*out = *in
+	if in.IPAMScopeID != nil {
+		in, out := &in.IPAMScopeID, &out.IPAMScopeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAMScopeIDRef != nil {
+		in, out := &in.IPAMScopeIDRef, &out.IPAMScopeIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IPAMScopeIDSelector != nil {
+		in, out := &in.IPAMScopeIDSelector, &out.IPAMScopeIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Locale != nil {
+		in, out := &in.Locale, &out.Locale
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceIPAMPoolID != nil {
+		in, out := &in.SourceIPAMPoolID, &out.SourceIPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceIPAMPoolIDRef != nil {
+		in, out := &in.SourceIPAMPoolIDRef, &out.SourceIPAMPoolIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SourceIPAMPoolIDSelector != nil {
+		in, out := &in.SourceIPAMPoolIDSelector, &out.SourceIPAMPoolIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutoImport != nil {
+		in, out := &in.AutoImport, &out.AutoImport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PubliclyAdvertisable != nil {
+		in, out := &in.PubliclyAdvertisable, &out.PubliclyAdvertisable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AWSService != nil {
+		in, out := &in.AWSService, &out.AWSService
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllocationDefaultNetmaskLength != nil {
+		in, out := &in.AllocationDefaultNetmaskLength, &out.AllocationDefaultNetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllocationMinNetmaskLength != nil {
+		in, out := &in.AllocationMinNetmaskLength, &out.AllocationMinNetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllocationMaxNetmaskLength != nil {
+		in, out := &in.AllocationMaxNetmaskLength, &out.AllocationMaxNetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllocationResourceTags != nil {
+		in, out := &in.AllocationResourceTags, &out.AllocationResourceTags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolParameters.
+func (in *IPAMPoolParameters) DeepCopy() *IPAMPoolParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolSpec) DeepCopyInto(out *IPAMPoolSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolSpec.
+func (in *IPAMPoolSpec) DeepCopy() *IPAMPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolStatus) DeepCopyInto(out *IPAMPoolStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolStatus.
+func (in *IPAMPoolStatus) DeepCopy() *IPAMPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSpec.
+func (in *IPAMSpec) DeepCopy() *IPAMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMStatus) DeepCopyInto(out *IPAMStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMStatus.
+func (in *IPAMStatus) DeepCopy() *IPAMStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
+	if in == nil {
+		return nil
+	}
+	out := new(Image)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Image) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageLaunchPermissions) DeepCopyInto(out *ImageLaunchPermissions) {
+	*out = *in
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrganizationARNs != nil {
+		in, out := &in.OrganizationARNs, &out.OrganizationARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrganizationalUnitARNs != nil {
+		in, out := &in.OrganizationalUnitARNs, &out.OrganizationalUnitARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageLaunchPermissions.
+func (in *ImageLaunchPermissions) DeepCopy() *ImageLaunchPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageLaunchPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageList) DeepCopyInto(out *ImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Image, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageList.
+func (in *ImageList) DeepCopy() *ImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageObservation) DeepCopyInto(out *ImageObservation) {
+	*out = *in
+	if in.SnapshotIDs != nil {
+		in, out := &in.SnapshotIDs, &out.SnapshotIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageObservation.
+func (in *ImageObservation) DeepCopy() *ImageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageParameters) DeepCopyInto(out *ImageParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceImageID != nil {
+		in, out := &in.SourceImageID, &out.SourceImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceImageIDRef != nil {
+		in, out := &in.SourceImageIDRef, &out.SourceImageIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SourceImageIDSelector != nil {
+		in, out := &in.SourceImageIDSelector, &out.SourceImageIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceRegion != nil {
+		in, out := &in.SourceRegion, &out.SourceRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyIDRef != nil {
+		in, out := &in.KMSKeyIDRef, &out.KMSKeyIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KMSKeyIDSelector != nil {
+		in, out := &in.KMSKeyIDSelector, &out.KMSKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoReboot != nil {
+		in, out := &in.NoReboot, &out.NoReboot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LaunchPermissions != nil {
+		in, out := &in.LaunchPermissions, &out.LaunchPermissions
+		*out = new(ImageLaunchPermissions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeprecateAt != nil {
+		in, out := &in.DeprecateAt, &out.DeprecateAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DeleteSnapshotsOnDeletion != nil {
+		in, out := &in.DeleteSnapshotsOnDeletion, &out.DeleteSnapshotsOnDeletion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageParameters.
+func (in *ImageParameters) DeepCopy() *ImageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatus.
+func (in *ImageStatus) DeepCopy() *ImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Instance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceBlockDeviceMapping) DeepCopyInto(out *InstanceBlockDeviceMapping) {
+	*out = *in
+	if in.DeviceName != nil {
+		in, out := &in.DeviceName, &out.DeviceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EBS != nil {
+		in, out := &in.EBS, &out.EBS
+		*out = new(EBSInstanceBlockDevice)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceBlockDeviceMapping.
+func (in *InstanceBlockDeviceMapping) DeepCopy() *InstanceBlockDeviceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceBlockDeviceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIPv6Address) DeepCopyInto(out *InstanceIPv6Address) {
+	*out = *in
+	if in.IPv6Address != nil {
+		in, out := &in.IPv6Address, &out.IPv6Address
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIPv6Address.
+func (in *InstanceIPv6Address) DeepCopy() *InstanceIPv6Address {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIPv6Address)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceList) DeepCopyInto(out *InstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Instance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceList.
+func (in *InstanceList) DeepCopy() *InstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMarketOptionsRequest) DeepCopyInto(out *InstanceMarketOptionsRequest) {
+	*out = *in
+	if in.SpotOptions != nil {
+		in, out := &in.SpotOptions, &out.SpotOptions
+		*out = new(SpotMarketOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMarketOptionsRequest.
+func (in *InstanceMarketOptionsRequest) DeepCopy() *InstanceMarketOptionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMarketOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMetadataOptionsRequest) DeepCopyInto(out *InstanceMetadataOptionsRequest) {
+	*out = *in
+	if in.HTTPPutResponseHopLimit != nil {
+		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptionsRequest.
+func (in *InstanceMetadataOptionsRequest) DeepCopy() *InstanceMetadataOptionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMetadataOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceNetworkInterface) DeepCopyInto(out *InstanceNetworkInterface) {
+	*out = *in
+	if in.Association != nil {
+		in, out := &in.Association, &out.Association
+		*out = new(InstanceNetworkInterfaceAssociation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attachment != nil {
+		in, out := &in.Attachment, &out.Attachment
+		*out = new(InstanceNetworkInterfaceAttachment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]GroupIdentifier, len(*in))
+		copy(*out, *in)
+	}
+	if in.InterfaceType != nil {
+		in, out := &in.InterfaceType, &out.InterfaceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]InstanceIPv6Address, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddresses != nil {
+		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
+		*out = make([]InstancePrivateIPAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterface.
+func (in *InstanceNetworkInterface) DeepCopy() *InstanceNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceNetworkInterfaceAssociation) DeepCopyInto(out *InstanceNetworkInterfaceAssociation) {
+	*out = *in
+	if in.IPOwnerID != nil {
+		in, out := &in.IPOwnerID, &out.IPOwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicDNSName != nil {
+		in, out := &in.PublicDNSName, &out.PublicDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceAssociation.
+func (in *InstanceNetworkInterfaceAssociation) DeepCopy() *InstanceNetworkInterfaceAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceNetworkInterfaceAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceNetworkInterfaceAttachment) DeepCopyInto(out *InstanceNetworkInterfaceAttachment) {
+	*out = *in
+	if in.AttachTime != nil {
+		in, out := &in.AttachTime, &out.AttachTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AttachmentID != nil {
+		in, out := &in.AttachmentID, &out.AttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeviceIndex != nil {
+		in, out := &in.DeviceIndex, &out.DeviceIndex
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceAttachment.
+func (in *InstanceNetworkInterfaceAttachment) DeepCopy() *InstanceNetworkInterfaceAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceNetworkInterfaceAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceNetworkInterfaceSpecification) DeepCopyInto(out *InstanceNetworkInterfaceSpecification) {
+	*out = *in
+	if in.AssociatePublicIPAddress != nil {
+		in, out := &in.AssociatePublicIPAddress, &out.AssociatePublicIPAddress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceIndex != nil {
+		in, out := &in.DeviceIndex, &out.DeviceIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InterfaceType != nil {
+		in, out := &in.InterfaceType, &out.InterfaceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6AddressCount != nil {
+		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]InstanceIPv6Address, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddresses != nil {
+		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
+		*out = make([]PrivateIPAddressSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecondaryPrivateIPAddressCount != nil {
+		in, out := &in.SecondaryPrivateIPAddressCount, &out.SecondaryPrivateIPAddressCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceSpecification.
+func (in *InstanceNetworkInterfaceSpecification) DeepCopy() *InstanceNetworkInterfaceSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceNetworkInterfaceSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
+	*out = *in
+	if in.AmiLaunchIndex != nil {
+		in, out := &in.AmiLaunchIndex, &out.AmiLaunchIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BlockDeviceMapping != nil {
+		in, out := &in.BlockDeviceMapping, &out.BlockDeviceMapping
+		*out = make([]InstanceBlockDeviceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CapacityReservationID != nil {
+		in, out := &in.CapacityReservationID, &out.CapacityReservationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CapacityReservationSpecification != nil {
+		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
+		*out = new(CapacityReservationSpecificationResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientToken != nil {
+		in, out := &in.ClientToken, &out.ClientToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUOptons != nil {
+		in, out := &in.CPUOptons, &out.CPUOptons
+		*out = new(CPUOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ElasticGPUAssociations != nil {
+		in, out := &in.ElasticGPUAssociations, &out.ElasticGPUAssociations
+		*out = make([]ElasticGPUAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ElasticInferenceAcceleratorAssociations != nil {
+		in, out := &in.ElasticInferenceAcceleratorAssociations, &out.ElasticInferenceAcceleratorAssociations
+		*out = make([]ElasticInferenceAcceleratorAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnaSupport != nil {
+		in, out := &in.EnaSupport, &out.EnaSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(HibernationOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAMInstanceProfile != nil {
+		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
+		*out = new(IAMInstanceProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KernelID != nil {
+		in, out := &in.KernelID, &out.KernelID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTime != nil {
+		in, out := &in.LaunchTime, &out.LaunchTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Licenses != nil {
+		in, out := &in.Licenses, &out.Licenses
+		*out = make([]LicenseConfigurationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetadataOptions != nil {
+		in, out := &in.MetadataOptions, &out.MetadataOptions
+		*out = new(InstanceMetadataOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		**out = **in
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]InstanceNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(PlacementObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProductCodes != nil {
+		in, out := &in.ProductCodes, &out.ProductCodes
+		*out = make([]ProductCode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicDNSName != nil {
+		in, out := &in.PublicDNSName, &out.PublicDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPAddress != nil {
+		in, out := &in.PublicIPAddress, &out.PublicIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.RAMDiskID != nil {
+		in, out := &in.RAMDiskID, &out.RAMDiskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RootDeviceName != nil {
+		in, out := &in.RootDeviceName, &out.RootDeviceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]GroupIdentifier, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SpotInstanceRequestID != nil {
+		in, out := &in.SpotInstanceRequestID, &out.SpotInstanceRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SriovNetSupport != nil {
+		in, out := &in.SriovNetSupport, &out.SriovNetSupport
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateReason != nil {
+		in, out := &in.StateReason, &out.StateReason
+		*out = new(StateReason)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StateTransitionReason != nil {
+		in, out := &in.StateTransitionReason, &out.StateTransitionReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceObservation.
+func (in *InstanceObservation) DeepCopy() *InstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
+	*out = *in
+	if in.BlockDeviceMappings != nil {
+		in, out := &in.BlockDeviceMappings, &out.BlockDeviceMappings
+		*out = make([]BlockDeviceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CapacityReservationSpecification != nil {
+		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
+		*out = new(CapacityReservationSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientToken != nil {
+		in, out := &in.ClientToken, &out.ClientToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreditSpecification != nil {
+		in, out := &in.CreditSpecification, &out.CreditSpecification
+		*out = new(CreditSpecificationRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ElasticGPUSpecification != nil {
+		in, out := &in.ElasticGPUSpecification, &out.ElasticGPUSpecification
+		*out = make([]ElasticGPUSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ElasticInferenceAccelerators != nil {
+		in, out := &in.ElasticInferenceAccelerators, &out.ElasticInferenceAccelerators
+		*out = make([]ElasticInferenceAccelerator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(HibernationOptionsRequest)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.IAMInstanceProfile != nil {
 		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
-		*out = new(IAMInstanceProfile)
+		*out = new(IAMInstanceProfileSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceMarketOptions != nil {
+		in, out := &in.InstanceMarketOptions, &out.InstanceMarketOptions
+		*out = new(InstanceMarketOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv6AddressCount != nil {
+		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]InstanceIPv6Address, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KernelID != nil {
+		in, out := &in.KernelID, &out.KernelID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplate != nil {
+		in, out := &in.LaunchTemplate, &out.LaunchTemplate
+		*out = new(LaunchTemplateSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LicenseSpecifications != nil {
+		in, out := &in.LicenseSpecifications, &out.LicenseSpecifications
+		*out = make([]LicenseConfigurationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetadataOptions != nil {
+		in, out := &in.MetadataOptions, &out.MetadataOptions
+		*out = new(InstanceMetadataOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(RunInstancesMonitoringEnabled)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]InstanceNetworkInterfaceSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.RAMDiskID != nil {
+		in, out := &in.RAMDiskID, &out.RAMDiskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupSelector != nil {
+		in, out := &in.SecurityGroupSelector, &out.SecurityGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.TagSpecifications != nil {
+		in, out := &in.TagSpecifications, &out.TagSpecifications
+		*out = make([]TagSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserDataSecretRef != nil {
+		in, out := &in.UserDataSecretRef, &out.UserDataSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.UserDataConfigMapRef != nil {
+		in, out := &in.UserDataConfigMapRef, &out.UserDataConfigMapRef
+		*out = new(v1beta1.ConfigMapKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
+func (in *InstanceParameters) DeepCopy() *InstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancePrivateIPAddress) DeepCopyInto(out *InstancePrivateIPAddress) {
+	*out = *in
+	if in.Association != nil {
+		in, out := &in.Association, &out.Association
+		*out = new(InstanceNetworkInterfaceAssociation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancePrivateIPAddress.
+func (in *InstancePrivateIPAddress) DeepCopy() *InstancePrivateIPAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancePrivateIPAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
+func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
+func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceVolumeAttachment) DeepCopyInto(out *InstanceVolumeAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceVolumeAttachment.
+func (in *InstanceVolumeAttachment) DeepCopy() *InstanceVolumeAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceVolumeAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceVolumeAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceVolumeAttachmentList) DeepCopyInto(out *InstanceVolumeAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstanceVolumeAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceVolumeAttachmentList.
+func (in *InstanceVolumeAttachmentList) DeepCopy() *InstanceVolumeAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceVolumeAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceVolumeAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceVolumeAttachmentObservation) DeepCopyInto(out *InstanceVolumeAttachmentObservation) {
+	*out = *in
+	if in.AttachTime != nil {
+		in, out := &in.AttachTime, &out.AttachTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceVolumeAttachmentObservation.
+func (in *InstanceVolumeAttachmentObservation) DeepCopy() *InstanceVolumeAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceVolumeAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceVolumeAttachmentParameters) DeepCopyInto(out *InstanceVolumeAttachmentParameters) {
+	*out = *in
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceIDRef != nil {
+		in, out := &in.InstanceIDRef, &out.InstanceIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.InstanceIDSelector != nil {
+		in, out := &in.InstanceIDSelector, &out.InstanceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForceDetach != nil {
+		in, out := &in.ForceDetach, &out.ForceDetach
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceVolumeAttachmentParameters.
+func (in *InstanceVolumeAttachmentParameters) DeepCopy() *InstanceVolumeAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceVolumeAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceVolumeAttachmentSpec) DeepCopyInto(out *InstanceVolumeAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceVolumeAttachmentSpec.
+func (in *InstanceVolumeAttachmentSpec) DeepCopy() *InstanceVolumeAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceVolumeAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceVolumeAttachmentStatus) DeepCopyInto(out *InstanceVolumeAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceVolumeAttachmentStatus.
+func (in *InstanceVolumeAttachmentStatus) DeepCopy() *InstanceVolumeAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceVolumeAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
+	*out = *in
+	if in.LaunchTemplateID != nil {
+		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplateName != nil {
+		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpecification.
+func (in *LaunchTemplateSpecification) DeepCopy() *LaunchTemplateSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseConfigurationRequest) DeepCopyInto(out *LicenseConfigurationRequest) {
+	*out = *in
+	if in.LicenseConfigurationARN != nil {
+		in, out := &in.LicenseConfigurationARN, &out.LicenseConfigurationARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseConfigurationRequest.
+func (in *LicenseConfigurationRequest) DeepCopy() *LicenseConfigurationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseConfigurationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociation.
+func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
+	*out = *in
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.PortRange != nil {
+		in, out := &in.PortRange, &out.PortRange
+		*out = new(NetworkACLPortRange)
+		**out = **in
+	}
+	if in.ICMPTypeCode != nil {
+		in, out := &in.ICMPTypeCode, &out.ICMPTypeCode
+		*out = new(NetworkACLICMPTypeCode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
+func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLICMPTypeCode) DeepCopyInto(out *NetworkACLICMPTypeCode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLICMPTypeCode.
+func (in *NetworkACLICMPTypeCode) DeepCopy() *NetworkACLICMPTypeCode {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLICMPTypeCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLList) DeepCopyInto(out *NetworkACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLList.
+func (in *NetworkACLList) DeepCopy() *NetworkACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLObservation) DeepCopyInto(out *NetworkACLObservation) {
+	*out = *in
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLObservation.
+func (in *NetworkACLObservation) DeepCopy() *NetworkACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLParameters) DeepCopyInto(out *NetworkACLParameters) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLParameters.
+func (in *NetworkACLParameters) DeepCopy() *NetworkACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLPortRange) DeepCopyInto(out *NetworkACLPortRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLPortRange.
+func (in *NetworkACLPortRange) DeepCopy() *NetworkACLPortRange {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLPortRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLSpec) DeepCopyInto(out *NetworkACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLSpec.
+func (in *NetworkACLSpec) DeepCopy() *NetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLStatus) DeepCopyInto(out *NetworkACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLStatus.
+func (in *NetworkACLStatus) DeepCopy() *NetworkACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceAttachmentObservation) DeepCopyInto(out *NetworkInterfaceAttachmentObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceAttachmentObservation.
+func (in *NetworkInterfaceAttachmentObservation) DeepCopy() *NetworkInterfaceAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceList) DeepCopyInto(out *NetworkInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceList.
+func (in *NetworkInterfaceList) DeepCopy() *NetworkInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceObservation) DeepCopyInto(out *NetworkInterfaceObservation) {
+	*out = *in
+	if in.SecondaryPrivateIPAddresses != nil {
+		in, out := &in.SecondaryPrivateIPAddresses, &out.SecondaryPrivateIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attachment != nil {
+		in, out := &in.Attachment, &out.Attachment
+		*out = new(NetworkInterfaceAttachmentObservation)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceObservation.
+func (in *NetworkInterfaceObservation) DeepCopy() *NetworkInterfaceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceParameters) DeepCopyInto(out *NetworkInterfaceParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.InterfaceType != nil {
+		in, out := &in.InterfaceType, &out.InterfaceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryPrivateIPAddresses != nil {
+		in, out := &in.SecondaryPrivateIPAddresses, &out.SecondaryPrivateIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecondaryPrivateIPAddressCount != nil {
+		in, out := &in.SecondaryPrivateIPAddressCount, &out.SecondaryPrivateIPAddressCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceParameters.
+func (in *NetworkInterfaceParameters) DeepCopy() *NetworkInterfaceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpec.
+func (in *NetworkInterfaceSpec) DeepCopy() *NetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceStatus.
+func (in *NetworkInterfaceStatus) DeepCopy() *NetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Placement) DeepCopyInto(out *Placement) {
+	*out = *in
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupNameRef != nil {
+		in, out := &in.GroupNameRef, &out.GroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.GroupNameSelector != nil {
+		in, out := &in.GroupNameSelector, &out.GroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostResourceGroupARN != nil {
+		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartitionNumber != nil {
+		in, out := &in.PartitionNumber, &out.PartitionNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpreadDomain != nil {
+		in, out := &in.SpreadDomain, &out.SpreadDomain
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
+func (in *Placement) DeepCopy() *Placement {
+	if in == nil {
+		return nil
+	}
+	out := new(Placement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroup) DeepCopyInto(out *PlacementGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroup.
+func (in *PlacementGroup) DeepCopy() *PlacementGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupList) DeepCopyInto(out *PlacementGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlacementGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupList.
+func (in *PlacementGroupList) DeepCopy() *PlacementGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupObservation) DeepCopyInto(out *PlacementGroupObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupObservation.
+func (in *PlacementGroupObservation) DeepCopy() *PlacementGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupParameters) DeepCopyInto(out *PlacementGroupParameters) {
+	*out = *in
+	if in.PartitionCount != nil {
+		in, out := &in.PartitionCount, &out.PartitionCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupParameters.
+func (in *PlacementGroupParameters) DeepCopy() *PlacementGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupSpec) DeepCopyInto(out *PlacementGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupSpec.
+func (in *PlacementGroupSpec) DeepCopy() *PlacementGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupStatus) DeepCopyInto(out *PlacementGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupStatus.
+func (in *PlacementGroupStatus) DeepCopy() *PlacementGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementObservation) DeepCopyInto(out *PlacementObservation) {
+	*out = *in
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostResourceGroupARN != nil {
+		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartitionNumber != nil {
+		in, out := &in.PartitionNumber, &out.PartitionNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpreadDomain != nil {
+		in, out := &in.SpreadDomain, &out.SpreadDomain
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementObservation.
+func (in *PlacementObservation) DeepCopy() *PlacementObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateIPAddressSpecification) DeepCopyInto(out *PrivateIPAddressSpecification) {
+	*out = *in
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateIPAddressSpecification.
+func (in *PrivateIPAddressSpecification) DeepCopy() *PrivateIPAddressSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateIPAddressSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductCode) DeepCopyInto(out *ProductCode) {
+	*out = *in
+	if in.ProductCodeID != nil {
+		in, out := &in.ProductCodeID, &out.ProductCodeID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductCode.
+func (in *ProductCode) DeepCopy() *ProductCode {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunInstancesMonitoringEnabled) DeepCopyInto(out *RunInstancesMonitoringEnabled) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunInstancesMonitoringEnabled.
+func (in *RunInstancesMonitoringEnabled) DeepCopy() *RunInstancesMonitoringEnabled {
+	if in == nil {
+		return nil
+	}
+	out := new(RunInstancesMonitoringEnabled)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleList) DeepCopyInto(out *SecurityGroupRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleList.
+func (in *SecurityGroupRuleList) DeepCopy() *SecurityGroupRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleObservation) DeepCopyInto(out *SecurityGroupRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleObservation.
+func (in *SecurityGroupRuleObservation) DeepCopy() *SecurityGroupRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleParameters) DeepCopyInto(out *SecurityGroupRuleParameters) {
+	*out = *in
+	if in.SecurityGroupID != nil {
+		in, out := &in.SecurityGroupID, &out.SecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDRef != nil {
+		in, out := &in.SecurityGroupIDRef, &out.SecurityGroupIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CIDRIPv4 != nil {
+		in, out := &in.CIDRIPv4, &out.CIDRIPv4
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDRIPv6 != nil {
+		in, out := &in.CIDRIPv6, &out.CIDRIPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListID != nil {
+		in, out := &in.PrefixListID, &out.PrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferencedSecurityGroupID != nil {
+		in, out := &in.ReferencedSecurityGroupID, &out.ReferencedSecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferencedSecurityGroupIDRef != nil {
+		in, out := &in.ReferencedSecurityGroupIDRef, &out.ReferencedSecurityGroupIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ReferencedSecurityGroupIDSelector != nil {
+		in, out := &in.ReferencedSecurityGroupIDSelector, &out.ReferencedSecurityGroupIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ImageID != nil {
-		in, out := &in.ImageID, &out.ImageID
+	if in.ReferencedSecurityGroupOwnerID != nil {
+		in, out := &in.ReferencedSecurityGroupOwnerID, &out.ReferencedSecurityGroupOwnerID
 		*out = new(string)
 		**out = **in
 	}
-	if in.InstanceID != nil {
-		in, out := &in.InstanceID, &out.InstanceID
+	if in.VPCPeeringConnectionID != nil {
+		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
 		*out = new(string)
 		**out = **in
 	}
-	if in.KernelID != nil {
-		in, out := &in.KernelID, &out.KernelID
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.LaunchTime != nil {
-		in, out := &in.LaunchTime, &out.LaunchTime
-		*out = (*in).DeepCopy()
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
-	if in.Licenses != nil {
-		in, out := &in.Licenses, &out.Licenses
-		*out = make([]LicenseConfigurationRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleParameters.
+func (in *SecurityGroupRuleParameters) DeepCopy() *SecurityGroupRuleParameters {
+	if in == nil {
+		return nil
 	}
-	if in.MetadataOptions != nil {
-		in, out := &in.MetadataOptions, &out.MetadataOptions
-		*out = new(InstanceMetadataOptionsRequest)
-		(*in).DeepCopyInto(*out)
+	out := new(SecurityGroupRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleSpec) DeepCopyInto(out *SecurityGroupRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleSpec.
+func (in *SecurityGroupRuleSpec) DeepCopy() *SecurityGroupRuleSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Monitoring != nil {
-		in, out := &in.Monitoring, &out.Monitoring
-		*out = new(Monitoring)
-		**out = **in
+	out := new(SecurityGroupRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleStatus) DeepCopyInto(out *SecurityGroupRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleStatus.
+func (in *SecurityGroupRuleStatus) DeepCopy() *SecurityGroupRuleStatus {
+	if in == nil {
+		return nil
 	}
-	if in.NetworkInterfaces != nil {
-		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]InstanceNetworkInterface, len(*in))
+	out := new(SecurityGroupRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snapshot) DeepCopyInto(out *Snapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot.
+func (in *Snapshot) DeepCopy() *Snapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(Snapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Snapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Snapshot, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.OutpostARN != nil {
-		in, out := &in.OutpostARN, &out.OutpostARN
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotList.
+func (in *SnapshotList) DeepCopy() *SnapshotList {
+	if in == nil {
+		return nil
 	}
-	if in.Placement != nil {
-		in, out := &in.Placement, &out.Placement
-		*out = new(Placement)
-		(*in).DeepCopyInto(*out)
+	out := new(SnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.PrivateDNSName != nil {
-		in, out := &in.PrivateDNSName, &out.PrivateDNSName
-		*out = new(string)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotObservation) DeepCopyInto(out *SnapshotObservation) {
+	*out = *in
+	if in.VolumeSize != nil {
+		in, out := &in.VolumeSize, &out.VolumeSize
+		*out = new(int32)
 		**out = **in
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ProductCodes != nil {
-		in, out := &in.ProductCodes, &out.ProductCodes
-		*out = make([]ProductCode, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.PublicDNSName != nil {
-		in, out := &in.PublicDNSName, &out.PublicDNSName
-		*out = new(string)
-		**out = **in
+	if in.FastSnapshotRestores != nil {
+		in, out := &in.FastSnapshotRestores, &out.FastSnapshotRestores
+		*out = make([]FastSnapshotRestoreObservation, len(*in))
+		copy(*out, *in)
 	}
-	if in.PublicIPAddress != nil {
-		in, out := &in.PublicIPAddress, &out.PublicIPAddress
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotObservation.
+func (in *SnapshotObservation) DeepCopy() *SnapshotObservation {
+	if in == nil {
+		return nil
 	}
-	if in.RAMDiskID != nil {
-		in, out := &in.RAMDiskID, &out.RAMDiskID
+	out := new(SnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotParameters) DeepCopyInto(out *SnapshotParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.RootDeviceName != nil {
-		in, out := &in.RootDeviceName, &out.RootDeviceName
-		*out = new(string)
-		**out = **in
+	if in.FastSnapshotRestoreAvailabilityZones != nil {
+		in, out := &in.FastSnapshotRestoreAvailabilityZones, &out.FastSnapshotRestoreAvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.SecurityGroups != nil {
-		in, out := &in.SecurityGroups, &out.SecurityGroups
-		*out = make([]GroupIdentifier, len(*in))
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
-	if in.SourceDestCheck != nil {
-		in, out := &in.SourceDestCheck, &out.SourceDestCheck
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotParameters.
+func (in *SnapshotParameters) DeepCopy() *SnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSpec.
+func (in *SnapshotSpec) DeepCopy() *SnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotStatus.
+func (in *SnapshotStatus) DeepCopy() *SnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotMarketOptions) DeepCopyInto(out *SpotMarketOptions) {
+	*out = *in
+	if in.BlockDurationMinutes != nil {
+		in, out := &in.BlockDurationMinutes, &out.BlockDurationMinutes
+		*out = new(int32)
 		**out = **in
 	}
-	if in.SpotInstanceRequestID != nil {
-		in, out := &in.SpotInstanceRequestID, &out.SpotInstanceRequestID
+	if in.MaxPrice != nil {
+		in, out := &in.MaxPrice, &out.MaxPrice
 		*out = new(string)
 		**out = **in
 	}
-	if in.SriovNetSupport != nil {
-		in, out := &in.SriovNetSupport, &out.SriovNetSupport
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotMarketOptions.
+func (in *SpotMarketOptions) DeepCopy() *SpotMarketOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotMarketOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateReason) DeepCopyInto(out *StateReason) {
+	*out = *in
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
 		*out = new(string)
 		**out = **in
 	}
-	if in.StateReason != nil {
-		in, out := &in.StateReason, &out.StateReason
-		*out = new(StateReason)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.StateTransitionReason != nil {
-		in, out := &in.StateTransitionReason, &out.StateTransitionReason
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateReason.
+func (in *StateReason) DeepCopy() *StateReason {
+	if in == nil {
+		return nil
+	}
+	out := new(StateReason)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSpecification) DeepCopyInto(out *TagSpecification) {
+	*out = *in
+	if in.ResourceType != nil {
+		in, out := &in.ResourceType, &out.ResourceType
 		*out = new(string)
 		**out = **in
 	}
@@ -1024,572 +5273,1037 @@ func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSpecification.
+func (in *TagSpecification) DeepCopy() *TagSpecification {
+	if in == nil {
+		return nil
 	}
+	out := new(TagSpecification)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceObservation.
-func (in *InstanceObservation) DeepCopy() *InstanceObservation {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorFilter) DeepCopyInto(out *TrafficMirrorFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorFilter.
+func (in *TrafficMirrorFilter) DeepCopy() *TrafficMirrorFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceObservation)
+	out := new(TrafficMirrorFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirrorFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
+func (in *TrafficMirrorFilterList) DeepCopyInto(out *TrafficMirrorFilterList) {
 	*out = *in
-	if in.BlockDeviceMappings != nil {
-		in, out := &in.BlockDeviceMappings, &out.BlockDeviceMappings
-		*out = make([]BlockDeviceMapping, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficMirrorFilter, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.CapacityReservationSpecification != nil {
-		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
-		*out = new(CapacityReservationSpecification)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ClientToken != nil {
-		in, out := &in.ClientToken, &out.ClientToken
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorFilterList.
+func (in *TrafficMirrorFilterList) DeepCopy() *TrafficMirrorFilterList {
+	if in == nil {
+		return nil
 	}
-	if in.CPUOptions != nil {
-		in, out := &in.CPUOptions, &out.CPUOptions
-		*out = new(CPUOptionsRequest)
-		(*in).DeepCopyInto(*out)
+	out := new(TrafficMirrorFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirrorFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.CreditSpecification != nil {
-		in, out := &in.CreditSpecification, &out.CreditSpecification
-		*out = new(CreditSpecificationRequest)
-		(*in).DeepCopyInto(*out)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorFilterObservation) DeepCopyInto(out *TrafficMirrorFilterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorFilterObservation.
+func (in *TrafficMirrorFilterObservation) DeepCopy() *TrafficMirrorFilterObservation {
+	if in == nil {
+		return nil
 	}
-	if in.DisableAPITermination != nil {
-		in, out := &in.DisableAPITermination, &out.DisableAPITermination
-		*out = new(bool)
+	out := new(TrafficMirrorFilterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorFilterParameters) DeepCopyInto(out *TrafficMirrorFilterParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.EBSOptimized != nil {
-		in, out := &in.EBSOptimized, &out.EBSOptimized
-		*out = new(bool)
-		**out = **in
+	if in.NetworkServices != nil {
+		in, out := &in.NetworkServices, &out.NetworkServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.ElasticGPUSpecification != nil {
-		in, out := &in.ElasticGPUSpecification, &out.ElasticGPUSpecification
-		*out = make([]ElasticGPUSpecification, len(*in))
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make([]TrafficMirrorFilterRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.ElasticInferenceAccelerators != nil {
-		in, out := &in.ElasticInferenceAccelerators, &out.ElasticInferenceAccelerators
-		*out = make([]ElasticInferenceAccelerator, len(*in))
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make([]TrafficMirrorFilterRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.HibernationOptions != nil {
-		in, out := &in.HibernationOptions, &out.HibernationOptions
-		*out = new(HibernationOptionsRequest)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IAMInstanceProfile != nil {
-		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
-		*out = new(IAMInstanceProfileSpecification)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ImageID != nil {
-		in, out := &in.ImageID, &out.ImageID
-		*out = new(string)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
-	if in.InstanceMarketOptions != nil {
-		in, out := &in.InstanceMarketOptions, &out.InstanceMarketOptions
-		*out = new(InstanceMarketOptionsRequest)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorFilterParameters.
+func (in *TrafficMirrorFilterParameters) DeepCopy() *TrafficMirrorFilterParameters {
+	if in == nil {
+		return nil
 	}
-	if in.IPv6AddressCount != nil {
-		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
+	out := new(TrafficMirrorFilterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorFilterRule) DeepCopyInto(out *TrafficMirrorFilterRule) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
 		*out = new(int32)
 		**out = **in
 	}
-	if in.IPv6Addresses != nil {
-		in, out := &in.IPv6Addresses, &out.IPv6Addresses
-		*out = make([]InstanceIPv6Address, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.DestinationPortRange != nil {
+		in, out := &in.DestinationPortRange, &out.DestinationPortRange
+		*out = new(TrafficMirrorPortRange)
+		**out = **in
 	}
-	if in.KernelID != nil {
-		in, out := &in.KernelID, &out.KernelID
-		*out = new(string)
+	if in.SourcePortRange != nil {
+		in, out := &in.SourcePortRange, &out.SourcePortRange
+		*out = new(TrafficMirrorPortRange)
 		**out = **in
 	}
-	if in.KeyName != nil {
-		in, out := &in.KeyName, &out.KeyName
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.LaunchTemplate != nil {
-		in, out := &in.LaunchTemplate, &out.LaunchTemplate
-		*out = new(LaunchTemplateSpecification)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorFilterRule.
+func (in *TrafficMirrorFilterRule) DeepCopy() *TrafficMirrorFilterRule {
+	if in == nil {
+		return nil
 	}
-	if in.LicenseSpecifications != nil {
-		in, out := &in.LicenseSpecifications, &out.LicenseSpecifications
-		*out = make([]LicenseConfigurationRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(TrafficMirrorFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorFilterSpec) DeepCopyInto(out *TrafficMirrorFilterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorFilterSpec.
+func (in *TrafficMirrorFilterSpec) DeepCopy() *TrafficMirrorFilterSpec {
+	if in == nil {
+		return nil
 	}
-	if in.MetadataOptions != nil {
-		in, out := &in.MetadataOptions, &out.MetadataOptions
-		*out = new(InstanceMetadataOptionsRequest)
-		(*in).DeepCopyInto(*out)
+	out := new(TrafficMirrorFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorFilterStatus) DeepCopyInto(out *TrafficMirrorFilterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorFilterStatus.
+func (in *TrafficMirrorFilterStatus) DeepCopy() *TrafficMirrorFilterStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Monitoring != nil {
-		in, out := &in.Monitoring, &out.Monitoring
-		*out = new(RunInstancesMonitoringEnabled)
-		(*in).DeepCopyInto(*out)
+	out := new(TrafficMirrorFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorPortRange) DeepCopyInto(out *TrafficMirrorPortRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorPortRange.
+func (in *TrafficMirrorPortRange) DeepCopy() *TrafficMirrorPortRange {
+	if in == nil {
+		return nil
 	}
-	if in.NetworkInterfaces != nil {
-		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]InstanceNetworkInterfaceSpecification, len(*in))
+	out := new(TrafficMirrorPortRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSession) DeepCopyInto(out *TrafficMirrorSession) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSession.
+func (in *TrafficMirrorSession) DeepCopy() *TrafficMirrorSession {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirrorSession) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSessionList) DeepCopyInto(out *TrafficMirrorSessionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficMirrorSession, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Placement != nil {
-		in, out := &in.Placement, &out.Placement
-		*out = new(Placement)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSessionList.
+func (in *TrafficMirrorSessionList) DeepCopy() *TrafficMirrorSessionList {
+	if in == nil {
+		return nil
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+	out := new(TrafficMirrorSessionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirrorSessionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSessionObservation) DeepCopyInto(out *TrafficMirrorSessionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSessionObservation.
+func (in *TrafficMirrorSessionObservation) DeepCopy() *TrafficMirrorSessionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorSessionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSessionParameters) DeepCopyInto(out *TrafficMirrorSessionParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.RAMDiskID != nil {
-		in, out := &in.RAMDiskID, &out.RAMDiskID
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
-		*out = new(string)
+	if in.NetworkInterfaceIDRef != nil {
+		in, out := &in.NetworkInterfaceIDRef, &out.NetworkInterfaceIDRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.SecurityGroupIDs != nil {
-		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.NetworkInterfaceIDSelector != nil {
+		in, out := &in.NetworkInterfaceIDSelector, &out.NetworkInterfaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.SecurityGroupRefs != nil {
-		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
-		*out = make([]v1.Reference, len(*in))
-		copy(*out, *in)
+	if in.TrafficMirrorTargetID != nil {
+		in, out := &in.TrafficMirrorTargetID, &out.TrafficMirrorTargetID
+		*out = new(string)
+		**out = **in
 	}
-	if in.SecurityGroupSelector != nil {
-		in, out := &in.SecurityGroupSelector, &out.SecurityGroupSelector
+	if in.TrafficMirrorTargetIDRef != nil {
+		in, out := &in.TrafficMirrorTargetIDRef, &out.TrafficMirrorTargetIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TrafficMirrorTargetIDSelector != nil {
+		in, out := &in.TrafficMirrorTargetIDSelector, &out.TrafficMirrorTargetIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
+	if in.TrafficMirrorFilterID != nil {
+		in, out := &in.TrafficMirrorFilterID, &out.TrafficMirrorFilterID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetIDRef != nil {
-		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+	if in.TrafficMirrorFilterIDRef != nil {
+		in, out := &in.TrafficMirrorFilterIDRef, &out.TrafficMirrorFilterIDRef
 		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.SubnetIDSelector != nil {
-		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+	if in.TrafficMirrorFilterIDSelector != nil {
+		in, out := &in.TrafficMirrorFilterIDSelector, &out.TrafficMirrorFilterIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PacketLength != nil {
+		in, out := &in.PacketLength, &out.PacketLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VirtualNetworkID != nil {
+		in, out := &in.VirtualNetworkID, &out.VirtualNetworkID
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
-	if in.TagSpecifications != nil {
-		in, out := &in.TagSpecifications, &out.TagSpecifications
-		*out = make([]TagSpecification, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSessionParameters.
+func (in *TrafficMirrorSessionParameters) DeepCopy() *TrafficMirrorSessionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorSessionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSessionSpec) DeepCopyInto(out *TrafficMirrorSessionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSessionSpec.
+func (in *TrafficMirrorSessionSpec) DeepCopy() *TrafficMirrorSessionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorSessionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSessionStatus) DeepCopyInto(out *TrafficMirrorSessionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSessionStatus.
+func (in *TrafficMirrorSessionStatus) DeepCopy() *TrafficMirrorSessionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorSessionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorTarget) DeepCopyInto(out *TrafficMirrorTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorTarget.
+func (in *TrafficMirrorTarget) DeepCopy() *TrafficMirrorTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirrorTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorTargetList) DeepCopyInto(out *TrafficMirrorTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficMirrorTarget, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.UserData != nil {
-		in, out := &in.UserData, &out.UserData
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
-func (in *InstanceParameters) DeepCopy() *InstanceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorTargetList.
+func (in *TrafficMirrorTargetList) DeepCopy() *TrafficMirrorTargetList {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceParameters)
+	out := new(TrafficMirrorTargetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirrorTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstancePrivateIPAddress) DeepCopyInto(out *InstancePrivateIPAddress) {
+func (in *TrafficMirrorTargetObservation) DeepCopyInto(out *TrafficMirrorTargetObservation) {
 	*out = *in
-	if in.Association != nil {
-		in, out := &in.Association, &out.Association
-		*out = new(InstanceNetworkInterfaceAssociation)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorTargetObservation.
+func (in *TrafficMirrorTargetObservation) DeepCopy() *TrafficMirrorTargetObservation {
+	if in == nil {
+		return nil
 	}
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
-		*out = new(bool)
+	out := new(TrafficMirrorTargetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorTargetParameters) DeepCopyInto(out *TrafficMirrorTargetParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateDNSName != nil {
-		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+	if in.NetworkInterfaceIDRef != nil {
+		in, out := &in.NetworkInterfaceIDRef, &out.NetworkInterfaceIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.NetworkInterfaceIDSelector != nil {
+		in, out := &in.NetworkInterfaceIDSelector, &out.NetworkInterfaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkLoadBalancerARN != nil {
+		in, out := &in.NetworkLoadBalancerARN, &out.NetworkLoadBalancerARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancePrivateIPAddress.
-func (in *InstancePrivateIPAddress) DeepCopy() *InstancePrivateIPAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorTargetParameters.
+func (in *TrafficMirrorTargetParameters) DeepCopy() *TrafficMirrorTargetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InstancePrivateIPAddress)
+	out := new(TrafficMirrorTargetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+func (in *TrafficMirrorTargetSpec) DeepCopyInto(out *TrafficMirrorTargetSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
-func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorTargetSpec.
+func (in *TrafficMirrorTargetSpec) DeepCopy() *TrafficMirrorTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorTargetStatus) DeepCopyInto(out *TrafficMirrorTargetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorTargetStatus.
+func (in *TrafficMirrorTargetStatus) DeepCopy() *TrafficMirrorTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VGWTelemetry) DeepCopyInto(out *VGWTelemetry) {
+	*out = *in
+	if in.LastStatusChange != nil {
+		in, out := &in.LastStatusChange, &out.LastStatusChange
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VGWTelemetry.
+func (in *VGWTelemetry) DeepCopy() *VGWTelemetry {
+	if in == nil {
+		return nil
+	}
+	out := new(VGWTelemetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCAttachment) DeepCopyInto(out *VPCAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCAttachment.
+func (in *VPCAttachment) DeepCopy() *VPCAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCIDRBlock) DeepCopyInto(out *VPCCIDRBlock) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlock.
+func (in *VPCCIDRBlock) DeepCopy() *VPCCIDRBlock {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceSpec)
+	out := new(VPCCIDRBlock)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCCIDRBlock) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+func (in *VPCCIDRBlockList) DeepCopyInto(out *VPCCIDRBlockList) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCCIDRBlock, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
-func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockList.
+func (in *VPCCIDRBlockList) DeepCopy() *VPCCIDRBlockList {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceStatus)
+	out := new(VPCCIDRBlockList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCCIDRBlockList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
+func (in *VPCCIDRBlockObservation) DeepCopyInto(out *VPCCIDRBlockObservation) {
 	*out = *in
-	if in.LaunchTemplateID != nil {
-		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+	if in.AssociationID != nil {
+		in, out := &in.AssociationID, &out.AssociationID
 		*out = new(string)
 		**out = **in
 	}
-	if in.LaunchTemplateName != nil {
-		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
 		*out = new(string)
 		**out = **in
 	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlockState != nil {
+		in, out := &in.IPv6CIDRBlockState, &out.IPv6CIDRBlockState
+		*out = new(VPCCIDRBlockState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv6Pool != nil {
+		in, out := &in.IPv6Pool, &out.IPv6Pool
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkBorderGroup != nil {
+		in, out := &in.NetworkBorderGroup, &out.NetworkBorderGroup
 		*out = new(string)
 		**out = **in
 	}
+	if in.CIDRBlockState != nil {
+		in, out := &in.CIDRBlockState, &out.CIDRBlockState
+		*out = new(VPCCIDRBlockState)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpecification.
-func (in *LaunchTemplateSpecification) DeepCopy() *LaunchTemplateSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockObservation.
+func (in *VPCCIDRBlockObservation) DeepCopy() *VPCCIDRBlockObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(LaunchTemplateSpecification)
+	out := new(VPCCIDRBlockObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LicenseConfigurationRequest) DeepCopyInto(out *LicenseConfigurationRequest) {
+func (in *VPCCIDRBlockParameters) DeepCopyInto(out *VPCCIDRBlockParameters) {
 	*out = *in
-	if in.LicenseConfigurationARN != nil {
-		in, out := &in.LicenseConfigurationARN, &out.LicenseConfigurationARN
+	if in.AmazonProvidedIPv6CIDRBlock != nil {
+		in, out := &in.AmazonProvidedIPv6CIDRBlock, &out.AmazonProvidedIPv6CIDRBlock
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlockNetworkBorderGroup != nil {
+		in, out := &in.IPv6CIDRBlockNetworkBorderGroup, &out.IPv6CIDRBlockNetworkBorderGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6Pool != nil {
+		in, out := &in.IPv6Pool, &out.IPv6Pool
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
 		*out = new(string)
 		**out = **in
 	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseConfigurationRequest.
-func (in *LicenseConfigurationRequest) DeepCopy() *LicenseConfigurationRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockParameters.
+func (in *VPCCIDRBlockParameters) DeepCopy() *VPCCIDRBlockParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(LicenseConfigurationRequest)
+	out := new(VPCCIDRBlockParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+func (in *VPCCIDRBlockSpec) DeepCopyInto(out *VPCCIDRBlockSpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
-func (in *Monitoring) DeepCopy() *Monitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockSpec.
+func (in *VPCCIDRBlockSpec) DeepCopy() *VPCCIDRBlockSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitoring)
+	out := new(VPCCIDRBlockSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Placement) DeepCopyInto(out *Placement) {
+func (in *VPCCIDRBlockState) DeepCopyInto(out *VPCCIDRBlockState) {
 	*out = *in
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(string)
-		**out = **in
-	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupName != nil {
-		in, out := &in.GroupName, &out.GroupName
-		*out = new(string)
-		**out = **in
-	}
-	if in.HostID != nil {
-		in, out := &in.HostID, &out.HostID
-		*out = new(string)
-		**out = **in
-	}
-	if in.HostResourceGroupARN != nil {
-		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
+	if in.State != nil {
+		in, out := &in.State, &out.State
 		*out = new(string)
 		**out = **in
 	}
-	if in.PartitionNumber != nil {
-		in, out := &in.PartitionNumber, &out.PartitionNumber
-		*out = new(int32)
-		**out = **in
-	}
-	if in.SpreadDomain != nil {
-		in, out := &in.SpreadDomain, &out.SpreadDomain
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
-func (in *Placement) DeepCopy() *Placement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockState.
+func (in *VPCCIDRBlockState) DeepCopy() *VPCCIDRBlockState {
 	if in == nil {
 		return nil
 	}
-	out := new(Placement)
+	out := new(VPCCIDRBlockState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrivateIPAddressSpecification) DeepCopyInto(out *PrivateIPAddressSpecification) {
+func (in *VPCCIDRBlockStatus) DeepCopyInto(out *VPCCIDRBlockStatus) {
 	*out = *in
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
-		*out = new(bool)
-		**out = **in
-	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockStatus.
+func (in *VPCCIDRBlockStatus) DeepCopy() *VPCCIDRBlockStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(VPCCIDRBlockStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateIPAddressSpecification.
-func (in *PrivateIPAddressSpecification) DeepCopy() *PrivateIPAddressSpecification {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNConnection) DeepCopyInto(out *VPNConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnection.
+func (in *VPNConnection) DeepCopy() *VPNConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(PrivateIPAddressSpecification)
+	out := new(VPNConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProductCode) DeepCopyInto(out *ProductCode) {
+func (in *VPNConnectionList) DeepCopyInto(out *VPNConnectionList) {
 	*out = *in
-	if in.ProductCodeID != nil {
-		in, out := &in.ProductCodeID, &out.ProductCodeID
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPNConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductCode.
-func (in *ProductCode) DeepCopy() *ProductCode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionList.
+func (in *VPNConnectionList) DeepCopy() *VPNConnectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProductCode)
+	out := new(VPNConnectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPNConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunInstancesMonitoringEnabled) DeepCopyInto(out *RunInstancesMonitoringEnabled) {
+func (in *VPNConnectionObservation) DeepCopyInto(out *VPNConnectionObservation) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]VPNStaticRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.VGWTelemetry != nil {
+		in, out := &in.VGWTelemetry, &out.VGWTelemetry
+		*out = make([]VGWTelemetry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunInstancesMonitoringEnabled.
-func (in *RunInstancesMonitoringEnabled) DeepCopy() *RunInstancesMonitoringEnabled {
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionObservation.
+func (in *VPNConnectionObservation) DeepCopy() *VPNConnectionObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RunInstancesMonitoringEnabled)
+	out := new(VPNConnectionObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpotMarketOptions) DeepCopyInto(out *SpotMarketOptions) {
+func (in *VPNConnectionParameters) DeepCopyInto(out *VPNConnectionParameters) {
 	*out = *in
-	if in.BlockDurationMinutes != nil {
-		in, out := &in.BlockDurationMinutes, &out.BlockDurationMinutes
-		*out = new(int32)
+	if in.CustomerGatewayID != nil {
+		in, out := &in.CustomerGatewayID, &out.CustomerGatewayID
+		*out = new(string)
 		**out = **in
 	}
-	if in.MaxPrice != nil {
-		in, out := &in.MaxPrice, &out.MaxPrice
+	if in.CustomerGatewayIDRef != nil {
+		in, out := &in.CustomerGatewayIDRef, &out.CustomerGatewayIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CustomerGatewayIDSelector != nil {
+		in, out := &in.CustomerGatewayIDSelector, &out.CustomerGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPNGatewayID != nil {
+		in, out := &in.VPNGatewayID, &out.VPNGatewayID
 		*out = new(string)
 		**out = **in
 	}
-	if in.ValidUntil != nil {
-		in, out := &in.ValidUntil, &out.ValidUntil
-		*out = (*in).DeepCopy()
+	if in.VPNGatewayIDRef != nil {
+		in, out := &in.VPNGatewayIDRef, &out.VPNGatewayIDRef
+		*out = new(v1.Reference)
+		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotMarketOptions.
-func (in *SpotMarketOptions) DeepCopy() *SpotMarketOptions {
-	if in == nil {
-		return nil
+	if in.VPNGatewayIDSelector != nil {
+		in, out := &in.VPNGatewayIDSelector, &out.VPNGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(SpotMarketOptions)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StateReason) DeepCopyInto(out *StateReason) {
-	*out = *in
-	if in.Code != nil {
-		in, out := &in.Code, &out.Code
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
+	if in.StaticRoutesOnly != nil {
+		in, out := &in.StaticRoutesOnly, &out.StaticRoutesOnly
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnableAcceleration != nil {
+		in, out := &in.EnableAcceleration, &out.EnableAcceleration
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LocalIPv4NetworkCIDR != nil {
+		in, out := &in.LocalIPv4NetworkCIDR, &out.LocalIPv4NetworkCIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.RemoteIPv4NetworkCIDR != nil {
+		in, out := &in.RemoteIPv4NetworkCIDR, &out.RemoteIPv4NetworkCIDR
 		*out = new(string)
 		**out = **in
 	}
+	if in.TunnelInsideIPVersion != nil {
+		in, out := &in.TunnelInsideIPVersion, &out.TunnelInsideIPVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TunnelOptions != nil {
+		in, out := &in.TunnelOptions, &out.TunnelOptions
+		*out = make([]VPNTunnelOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateReason.
-func (in *StateReason) DeepCopy() *StateReason {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionParameters.
+func (in *VPNConnectionParameters) DeepCopy() *VPNConnectionParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(StateReason)
+	out := new(VPNConnectionParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tag) DeepCopyInto(out *Tag) {
+func (in *VPNConnectionSpec) DeepCopyInto(out *VPNConnectionSpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
-func (in *Tag) DeepCopy() *Tag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionSpec.
+func (in *VPNConnectionSpec) DeepCopy() *VPNConnectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Tag)
+	out := new(VPNConnectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TagSpecification) DeepCopyInto(out *TagSpecification) {
+func (in *VPNConnectionStatus) DeepCopyInto(out *VPNConnectionStatus) {
 	*out = *in
-	if in.ResourceType != nil {
-		in, out := &in.ResourceType, &out.ResourceType
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSpecification.
-func (in *TagSpecification) DeepCopy() *TagSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNConnectionStatus.
+func (in *VPNConnectionStatus) DeepCopy() *VPNConnectionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TagSpecification)
+	out := new(VPNConnectionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlock) DeepCopyInto(out *VPCCIDRBlock) {
+func (in *VPNGateway) DeepCopyInto(out *VPNGateway) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1597,18 +6311,18 @@ func (in *VPCCIDRBlock) DeepCopyInto(out *VPCCIDRBlock) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlock.
-func (in *VPCCIDRBlock) DeepCopy() *VPCCIDRBlock {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGateway.
+func (in *VPNGateway) DeepCopy() *VPNGateway {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlock)
+	out := new(VPNGateway)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VPCCIDRBlock) DeepCopyObject() runtime.Object {
+func (in *VPNGateway) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1616,31 +6330,31 @@ func (in *VPCCIDRBlock) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockList) DeepCopyInto(out *VPCCIDRBlockList) {
+func (in *VPNGatewayList) DeepCopyInto(out *VPNGatewayList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]VPCCIDRBlock, len(*in))
+		*out = make([]VPNGateway, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockList.
-func (in *VPCCIDRBlockList) DeepCopy() *VPCCIDRBlockList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayList.
+func (in *VPNGatewayList) DeepCopy() *VPNGatewayList {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockList)
+	out := new(VPNGatewayList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VPCCIDRBlockList) DeepCopyObject() runtime.Object {
+func (in *VPNGatewayList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1648,80 +6362,35 @@ func (in *VPCCIDRBlockList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockObservation) DeepCopyInto(out *VPCCIDRBlockObservation) {
+func (in *VPNGatewayObservation) DeepCopyInto(out *VPNGatewayObservation) {
 	*out = *in
-	if in.AssociationID != nil {
-		in, out := &in.AssociationID, &out.AssociationID
-		*out = new(string)
-		**out = **in
-	}
-	if in.CIDRBlock != nil {
-		in, out := &in.CIDRBlock, &out.CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6CIDRBlock != nil {
-		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6CIDRBlockState != nil {
-		in, out := &in.IPv6CIDRBlockState, &out.IPv6CIDRBlockState
-		*out = new(VPCCIDRBlockState)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IPv6Pool != nil {
-		in, out := &in.IPv6Pool, &out.IPv6Pool
-		*out = new(string)
-		**out = **in
-	}
-	if in.NetworkBorderGroup != nil {
-		in, out := &in.NetworkBorderGroup, &out.NetworkBorderGroup
-		*out = new(string)
-		**out = **in
-	}
-	if in.CIDRBlockState != nil {
-		in, out := &in.CIDRBlockState, &out.CIDRBlockState
-		*out = new(VPCCIDRBlockState)
-		(*in).DeepCopyInto(*out)
+	if in.VPCAttachments != nil {
+		in, out := &in.VPCAttachments, &out.VPCAttachments
+		*out = make([]VPCAttachment, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockObservation.
-func (in *VPCCIDRBlockObservation) DeepCopy() *VPCCIDRBlockObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayObservation.
+func (in *VPNGatewayObservation) DeepCopy() *VPNGatewayObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockObservation)
+	out := new(VPNGatewayObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockParameters) DeepCopyInto(out *VPCCIDRBlockParameters) {
+func (in *VPNGatewayParameters) DeepCopyInto(out *VPNGatewayParameters) {
 	*out = *in
-	if in.AmazonProvidedIPv6CIDRBlock != nil {
-		in, out := &in.AmazonProvidedIPv6CIDRBlock, &out.AmazonProvidedIPv6CIDRBlock
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CIDRBlock != nil {
-		in, out := &in.CIDRBlock, &out.CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6CIDRBlock != nil {
-		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6CIDRBlockNetworkBorderGroup != nil {
-		in, out := &in.IPv6CIDRBlockNetworkBorderGroup, &out.IPv6CIDRBlockNetworkBorderGroup
-		*out = new(string)
+	if in.AmazonSideASN != nil {
+		in, out := &in.AmazonSideASN, &out.AmazonSideASN
+		*out = new(int64)
 		**out = **in
 	}
-	if in.IPv6Pool != nil {
-		in, out := &in.IPv6Pool, &out.IPv6Pool
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
 		*out = new(string)
 		**out = **in
 	}
@@ -1740,73 +6409,174 @@ func (in *VPCCIDRBlockParameters) DeepCopyInto(out *VPCCIDRBlockParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockParameters.
-func (in *VPCCIDRBlockParameters) DeepCopy() *VPCCIDRBlockParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayParameters.
+func (in *VPNGatewayParameters) DeepCopy() *VPNGatewayParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockParameters)
+	out := new(VPNGatewayParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockSpec) DeepCopyInto(out *VPCCIDRBlockSpec) {
+func (in *VPNGatewaySpec) DeepCopyInto(out *VPNGatewaySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockSpec.
-func (in *VPCCIDRBlockSpec) DeepCopy() *VPCCIDRBlockSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewaySpec.
+func (in *VPNGatewaySpec) DeepCopy() *VPNGatewaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockSpec)
+	out := new(VPNGatewaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockState) DeepCopyInto(out *VPCCIDRBlockState) {
+func (in *VPNGatewayStatus) DeepCopyInto(out *VPNGatewayStatus) {
 	*out = *in
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(string)
-		**out = **in
-	}
-	if in.StatusMessage != nil {
-		in, out := &in.StatusMessage, &out.StatusMessage
-		*out = new(string)
-		**out = **in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNGatewayStatus.
+func (in *VPNGatewayStatus) DeepCopy() *VPNGatewayStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(VPNGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockState.
-func (in *VPCCIDRBlockState) DeepCopy() *VPCCIDRBlockState {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNStaticRoute) DeepCopyInto(out *VPNStaticRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNStaticRoute.
+func (in *VPNStaticRoute) DeepCopy() *VPNStaticRoute {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockState)
+	out := new(VPNStaticRoute)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCCIDRBlockStatus) DeepCopyInto(out *VPCCIDRBlockStatus) {
+func (in *VPNTunnelOptions) DeepCopyInto(out *VPNTunnelOptions) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.TunnelInsideCIDR != nil {
+		in, out := &in.TunnelInsideCIDR, &out.TunnelInsideCIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.TunnelInsideIPv6CIDR != nil {
+		in, out := &in.TunnelInsideIPv6CIDR, &out.TunnelInsideIPv6CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreSharedKeySecretRef != nil {
+		in, out := &in.PreSharedKeySecretRef, &out.PreSharedKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.DPDTimeoutAction != nil {
+		in, out := &in.DPDTimeoutAction, &out.DPDTimeoutAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.DPDTimeoutSeconds != nil {
+		in, out := &in.DPDTimeoutSeconds, &out.DPDTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IKEVersions != nil {
+		in, out := &in.IKEVersions, &out.IKEVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phase1DHGroupNumbers != nil {
+		in, out := &in.Phase1DHGroupNumbers, &out.Phase1DHGroupNumbers
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phase1EncryptionAlgorithms != nil {
+		in, out := &in.Phase1EncryptionAlgorithms, &out.Phase1EncryptionAlgorithms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phase1IntegrityAlgorithms != nil {
+		in, out := &in.Phase1IntegrityAlgorithms, &out.Phase1IntegrityAlgorithms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phase1LifetimeSeconds != nil {
+		in, out := &in.Phase1LifetimeSeconds, &out.Phase1LifetimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Phase2DHGroupNumbers != nil {
+		in, out := &in.Phase2DHGroupNumbers, &out.Phase2DHGroupNumbers
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phase2EncryptionAlgorithms != nil {
+		in, out := &in.Phase2EncryptionAlgorithms, &out.Phase2EncryptionAlgorithms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phase2IntegrityAlgorithms != nil {
+		in, out := &in.Phase2IntegrityAlgorithms, &out.Phase2IntegrityAlgorithms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Phase2LifetimeSeconds != nil {
+		in, out := &in.Phase2LifetimeSeconds, &out.Phase2LifetimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RekeyFuzzPercentage != nil {
+		in, out := &in.RekeyFuzzPercentage, &out.RekeyFuzzPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RekeyMarginTimeSeconds != nil {
+		in, out := &in.RekeyMarginTimeSeconds, &out.RekeyMarginTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplayWindowSize != nil {
+		in, out := &in.ReplayWindowSize, &out.ReplayWindowSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StartupAction != nil {
+		in, out := &in.StartupAction, &out.StartupAction
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockStatus.
-func (in *VPCCIDRBlockStatus) DeepCopy() *VPCCIDRBlockStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNTunnelOptions.
+func (in *VPNTunnelOptions) DeepCopy() *VPNTunnelOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(VPCCIDRBlockStatus)
+	out := new(VPNTunnelOptions)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -25,6 +25,41 @@ type CustomLaunchTemplateParameters struct {
 	// Metadata tagging key value pairs
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// UpdateDefaultVersion makes the version that is created for a change of
+	// the launch template data the default version of the launch template.
+	// Versions are only created for changes of the launch template data if
+	// either this or VersionRetentionCount is set, and the data is only
+	// compared with the versions that were created for it, not with those
+	// created by LaunchTemplateVersions.
+	// +optional
+	UpdateDefaultVersion *bool `json:"updateDefaultVersion,omitempty"`
+
+	// VersionRetentionCount is the number of the most recent versions created
+	// for changes of the launch template data that are kept. Older ones are
+	// deleted, except for the default version. Other versions, e.g. those of
+	// LaunchTemplateVersions, are never deleted. All versions are kept if it
+	// is not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	VersionRetentionCount *int64 `json:"versionRetentionCount,omitempty"`
+
+	// IAMInstanceProfileName is the name of the IAM instance profile that is
+	// set in the launch template data. It takes precedence over
+	// LaunchTemplateData.IAMInstanceProfile.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1alpha1.InstanceProfile
+	// +optional
+	IAMInstanceProfileName *string `json:"iamInstanceProfileName,omitempty"`
+
+	// IAMInstanceProfileNameRef is a reference to an InstanceProfile used to
+	// set the IAMInstanceProfileName.
+	// +optional
+	IAMInstanceProfileNameRef *xpv1.Reference `json:"iamInstanceProfileNameRef,omitempty"`
+
+	// IAMInstanceProfileNameSelector selects a reference to an
+	// InstanceProfile used to set the IAMInstanceProfileName.
+	// +optional
+	IAMInstanceProfileNameSelector *xpv1.Selector `json:"iamInstanceProfileNameSelector,omitempty"`
 }
 
 // CustomVPCEndpointServiceConfigurationParameters contains the additional fields
@@ -66,6 +101,19 @@ type CustomVPCEndpointServiceConfigurationParameters struct {
 	// to set the NetworkLoadBalancerARNs.
 	// +optional
 	NetworkLoadBalancerARNSelector *xpv1.Selector `json:"networkLoadBalancerARNSelector,omitempty"`
+
+	// The Amazon Resource Names (ARNs) of the principals that are allowed to
+	// create endpoints for the service, e.g. arn:aws:iam::123456789012:root.
+	// Use * to allow all principals. Principals are not managed if omitted.
+	// +optional
+	AllowedPrincipals []*string `json:"allowedPrincipals,omitempty"`
+
+	// AutoAcceptAllowedPrincipals accepts endpoint connection requests that
+	// are pending acceptance if the endpoint is owned by an account of one of
+	// the AllowedPrincipals. It only has an effect if AcceptanceRequired is
+	// true.
+	// +optional
+	AutoAcceptAllowedPrincipals *bool `json:"autoAcceptAllowedPrincipals,omitempty"`
 }
 
 // CustomLaunchTemplateVersionParameters includes the custom fields of LaunchTemplateVersion.
@@ -129,6 +177,16 @@ type CustomVolumeParameters struct {
 	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
 }
 
+// VPCPeeringConnectionOptions are the options of one side of a VPC peering
+// connection.
+type VPCPeeringConnectionOptions struct {
+	// AllowDNSResolutionFromRemoteVPC allows the VPC to resolve public DNS
+	// hostnames to private IP addresses when queried from instances in the
+	// peer VPC.
+	// +optional
+	AllowDNSResolutionFromRemoteVPC *bool `json:"allowDnsResolutionFromRemoteVpc,omitempty"`
+}
+
 // CustomVPCPeeringConnectionParameters are custom parameters for VPCPeeringConnection
 type CustomVPCPeeringConnectionParameters struct {
 	// The ID of the requester VPC. You must specify this parameter in the request.
@@ -155,9 +213,27 @@ type CustomVPCPeeringConnectionParameters struct {
 	PeerVPCIDSelector *xpv1.Selector `json:"peerVPCIDSelector,omitempty"`
 	// Automatically accepts the peering connection. If this is not set, the peering connection
 	// will be created, but will be in pending-acceptance state. This will only lead to an active
-	// connection if both VPCs are in the same tenant.
+	// connection if both VPCs are in the same tenant, or if accepterProviderConfigRef refers to
+	// the account of the accepter VPC.
 	AcceptRequest bool `json:"acceptRequest,omitempty"`
 
+	// AccepterProviderConfigRef references the ProviderConfig whose
+	// credentials are used to accept the peering connection and to manage the
+	// peering options of the accepter VPC, when it is owned by another account
+	// than the requester VPC. Calls are made in peerRegion, if set.
+	// +optional
+	AccepterProviderConfigRef *xpv1.Reference `json:"accepterProviderConfigRef,omitempty"`
+
+	// RequesterPeeringOptions are the peering options of the requester VPC.
+	// They are set once the peering connection is active.
+	// +optional
+	RequesterPeeringOptions *VPCPeeringConnectionOptions `json:"requesterPeeringOptions,omitempty"`
+
+	// AccepterPeeringOptions are the peering options of the accepter VPC.
+	// They are set once the peering connection is active.
+	// +optional
+	AccepterPeeringOptions *VPCPeeringConnectionOptions `json:"accepterPeeringOptions,omitempty"`
+
 	// Metadata tagging key value pairs
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -276,12 +352,38 @@ type CustomRouteParameters struct {
 	// The ID of the route table for the route.
 	// provider-aws currently provides both a standalone Route resource
 	// and a RouteTable resource with routes defined in-line.
-	// At this time you cannot use a Route Table with in-line routes
-	// in conjunction with any Route resources.
-	// Doing so will cause a conflict of rule settings and will overwrite rules.
+	// Route resources can only be used with a RouteTable that sets
+	// ignoreRoutes, otherwise the RouteTable removes the routes it doesn't
+	// define in-line.
 	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.RouteTable
 	RouteTableID *string `json:"routeTableId,omitempty"`
 
+	// RouteTableIDRef is a reference to an API used to set
+	// the RouteTableID.
+	// +optional
+	RouteTableIDRef *xpv1.Reference `json:"routeTableIdRef,omitempty"`
+
+	// RouteTableIDSelector selects references to API used
+	// to set the RouteTableID.
+	// +optional
+	RouteTableIDSelector *xpv1.Selector `json:"routeTableIdSelector,omitempty"`
+
+	// The ID of a network interface.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1.NetworkInterface
+	NetworkInterfaceID *string `json:"networkInterfaceID,omitempty"`
+
+	// NetworkInterfaceIDRef is a reference to an API used to set
+	// the NetworkInterfaceID.
+	// +optional
+	NetworkInterfaceIDRef *xpv1.Reference `json:"networkInterfaceIDRef,omitempty"`
+
+	// NetworkInterfaceIDSelector selects references to API used
+	// to set the NetworkInterfaceID.
+	// +optional
+	NetworkInterfaceIDSelector *xpv1.Selector `json:"networkInterfaceIDSelector,omitempty"`
+
 	// The ID of a NAT instance in your VPC. The operation fails if you specify
 	// an instance ID unless exactly one network interface is attached.
 	// +optional
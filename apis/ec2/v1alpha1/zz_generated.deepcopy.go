@@ -2195,6 +2195,31 @@ func (in *CustomLaunchTemplateParameters) DeepCopyInto(out *CustomLaunchTemplate
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UpdateDefaultVersion != nil {
+		in, out := &in.UpdateDefaultVersion, &out.UpdateDefaultVersion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VersionRetentionCount != nil {
+		in, out := &in.VersionRetentionCount, &out.VersionRetentionCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IAMInstanceProfileName != nil {
+		in, out := &in.IAMInstanceProfileName, &out.IAMInstanceProfileName
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMInstanceProfileNameRef != nil {
+		in, out := &in.IAMInstanceProfileNameRef, &out.IAMInstanceProfileNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IAMInstanceProfileNameSelector != nil {
+		in, out := &in.IAMInstanceProfileNameSelector, &out.IAMInstanceProfileNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLaunchTemplateParameters.
@@ -2305,6 +2330,31 @@ func (in *CustomRouteParameters) DeepCopyInto(out *CustomRouteParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RouteTableIDRef != nil {
+		in, out := &in.RouteTableIDRef, &out.RouteTableIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RouteTableIDSelector != nil {
+		in, out := &in.RouteTableIDSelector, &out.RouteTableIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceIDRef != nil {
+		in, out := &in.NetworkInterfaceIDRef, &out.NetworkInterfaceIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.NetworkInterfaceIDSelector != nil {
+		in, out := &in.NetworkInterfaceIDSelector, &out.NetworkInterfaceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InstanceID != nil {
 		in, out := &in.InstanceID, &out.InstanceID
 		*out = new(string)
@@ -2669,6 +2719,22 @@ func (in *CustomVPCEndpointServiceConfigurationParameters) DeepCopyInto(out *Cus
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AllowedPrincipals != nil {
+		in, out := &in.AllowedPrincipals, &out.AllowedPrincipals
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.AutoAcceptAllowedPrincipals != nil {
+		in, out := &in.AutoAcceptAllowedPrincipals, &out.AutoAcceptAllowedPrincipals
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomVPCEndpointServiceConfigurationParameters.
@@ -2714,6 +2780,21 @@ func (in *CustomVPCPeeringConnectionParameters) DeepCopyInto(out *CustomVPCPeeri
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AccepterProviderConfigRef != nil {
+		in, out := &in.AccepterProviderConfigRef, &out.AccepterProviderConfigRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RequesterPeeringOptions != nil {
+		in, out := &in.RequesterPeeringOptions, &out.RequesterPeeringOptions
+		*out = new(VPCPeeringConnectionOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccepterPeeringOptions != nil {
+		in, out := &in.AccepterPeeringOptions, &out.AccepterPeeringOptions
+		*out = new(VPCPeeringConnectionOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -8095,6 +8176,7 @@ func (in *LaunchTemplateSpotMarketOptionsRequest) DeepCopy() *LaunchTemplateSpot
 func (in *LaunchTemplateStatus) DeepCopyInto(out *LaunchTemplateStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -8306,6 +8388,7 @@ func (in *LaunchTemplateVersionSpec) DeepCopy() *LaunchTemplateVersionSpec {
 func (in *LaunchTemplateVersionStatus) DeepCopyInto(out *LaunchTemplateVersionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -11895,11 +11978,6 @@ func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.NetworkInterfaceID != nil {
-		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
-		*out = new(string)
-		**out = **in
-	}
 	if in.VPCEndpointID != nil {
 		in, out := &in.VPCEndpointID, &out.VPCEndpointID
 		*out = new(string)
@@ -11939,6 +12017,7 @@ func (in *RouteSpec) DeepCopy() *RouteSpec {
 func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -15964,6 +16043,7 @@ func (in *TransitGatewayRouteSpec) DeepCopy() *TransitGatewayRouteSpec {
 func (in *TransitGatewayRouteStatus) DeepCopyInto(out *TransitGatewayRouteStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -16199,6 +16279,7 @@ func (in *TransitGatewayRouteTableSpec) DeepCopy() *TransitGatewayRouteTableSpec
 func (in *TransitGatewayRouteTableStatus) DeepCopyInto(out *TransitGatewayRouteTableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -16334,6 +16415,7 @@ func (in *TransitGatewaySpec) DeepCopy() *TransitGatewaySpec {
 func (in *TransitGatewayStatus) DeepCopyInto(out *TransitGatewayStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -16555,6 +16637,7 @@ func (in *TransitGatewayVPCAttachmentSpec) DeepCopy() *TransitGatewayVPCAttachme
 func (in *TransitGatewayVPCAttachmentStatus) DeepCopyInto(out *TransitGatewayVPCAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -17520,6 +17603,7 @@ func (in *VPCEndpointServiceConfigurationSpec) DeepCopy() *VPCEndpointServiceCon
 func (in *VPCEndpointServiceConfigurationStatus) DeepCopyInto(out *VPCEndpointServiceConfigurationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -17554,6 +17638,7 @@ func (in *VPCEndpointSpec) DeepCopy() *VPCEndpointSpec {
 func (in *VPCEndpointStatus) DeepCopyInto(out *VPCEndpointStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -17846,6 +17931,26 @@ func (in *VPCPeeringConnectionObservation) DeepCopy() *VPCPeeringConnectionObser
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionOptions) DeepCopyInto(out *VPCPeeringConnectionOptions) {
+	*out = *in
+	if in.AllowDNSResolutionFromRemoteVPC != nil {
+		in, out := &in.AllowDNSResolutionFromRemoteVPC, &out.AllowDNSResolutionFromRemoteVPC
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionOptions.
+func (in *VPCPeeringConnectionOptions) DeepCopy() *VPCPeeringConnectionOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCPeeringConnectionOptionsDescription) DeepCopyInto(out *VPCPeeringConnectionOptionsDescription) {
 	*out = *in
@@ -17959,6 +18064,7 @@ func (in *VPCPeeringConnectionStateReason) DeepCopy() *VPCPeeringConnectionState
 func (in *VPCPeeringConnectionStatus) DeepCopyInto(out *VPCPeeringConnectionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -18782,6 +18888,7 @@ func (in *VolumeSpec) DeepCopy() *VolumeSpec {
 func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -24,11 +24,38 @@ import (
 	manualv1alpha1 "github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
 	v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	v1alpha12 "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
 	v1alpha11 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this LaunchTemplate.
+func (mg *LaunchTemplate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomLaunchTemplateParameters.IAMInstanceProfileName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomLaunchTemplateParameters.IAMInstanceProfileNameRef,
+		Selector:     mg.Spec.ForProvider.CustomLaunchTemplateParameters.IAMInstanceProfileNameSelector,
+		To: reference.To{
+			List:    &v1alpha12.InstanceProfileList{},
+			Managed: &v1alpha12.InstanceProfile{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomLaunchTemplateParameters.IAMInstanceProfileName")
+	}
+	mg.Spec.ForProvider.CustomLaunchTemplateParameters.IAMInstanceProfileName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomLaunchTemplateParameters.IAMInstanceProfileNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this LaunchTemplateVersion.
 func (mg *LaunchTemplateVersion) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -126,6 +153,38 @@ func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.CustomRouteParameters.VPCPeeringConnectionID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CustomRouteParameters.VPCPeeringConnectionIDRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomRouteParameters.RouteTableID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomRouteParameters.RouteTableIDRef,
+		Selector:     mg.Spec.ForProvider.CustomRouteParameters.RouteTableIDSelector,
+		To: reference.To{
+			List:    &v1beta1.RouteTableList{},
+			Managed: &v1beta1.RouteTable{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomRouteParameters.RouteTableID")
+	}
+	mg.Spec.ForProvider.CustomRouteParameters.RouteTableID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomRouteParameters.RouteTableIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomRouteParameters.NetworkInterfaceID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomRouteParameters.NetworkInterfaceIDRef,
+		Selector:     mg.Spec.ForProvider.CustomRouteParameters.NetworkInterfaceIDSelector,
+		To: reference.To{
+			List:    &manualv1alpha1.NetworkInterfaceList{},
+			Managed: &manualv1alpha1.NetworkInterface{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomRouteParameters.NetworkInterfaceID")
+	}
+	mg.Spec.ForProvider.CustomRouteParameters.NetworkInterfaceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomRouteParameters.NetworkInterfaceIDRef = rsp.ResolvedReference
+
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomRouteParameters.InstanceID),
 		Extract:      reference.ExternalName(),
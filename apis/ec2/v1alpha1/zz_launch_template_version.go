@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -61,8 +62,9 @@ type LaunchTemplateVersionObservation struct {
 
 // LaunchTemplateVersionStatus defines the observed state of LaunchTemplateVersion.
 type LaunchTemplateVersionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          LaunchTemplateVersionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            LaunchTemplateVersionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -48,8 +49,6 @@ type RouteParameters struct {
 	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayID,omitempty"`
 	// The ID of the local gateway.
 	LocalGatewayID *string `json:"localGatewayID,omitempty"`
-	// The ID of a network interface.
-	NetworkInterfaceID *string `json:"networkInterfaceID,omitempty"`
 	// The ID of a VPC endpoint. Supported for Gateway Load Balancer endpoints only.
 	VPCEndpointID         *string `json:"vpcEndpointID,omitempty"`
 	CustomRouteParameters `json:",inline"`
@@ -69,8 +68,9 @@ type RouteObservation struct {
 
 // RouteStatus defines the observed state of Route.
 type RouteStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RouteObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            RouteObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
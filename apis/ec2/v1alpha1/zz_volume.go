@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -113,6 +114,7 @@ type VolumeParameters struct {
 	// in the Amazon Elastic Compute Cloud User Guide.
 	//
 	// Default: gp2
+	// +kubebuilder:validation:Enum=standard;io1;io2;gp2;sc1;st1;gp3
 	VolumeType             *string `json:"volumeType,omitempty"`
 	CustomVolumeParameters `json:",inline"`
 }
@@ -145,8 +147,9 @@ type VolumeObservation struct {
 
 // VolumeStatus defines the observed state of Volume.
 type VolumeStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          VolumeObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            VolumeObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
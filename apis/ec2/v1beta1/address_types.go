@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // AddressParameters define the desired state of an AWS Elastic IP
@@ -67,6 +69,10 @@ type AddressParameters struct {
 	// The ID of an address pool that you own. Use this parameter to let Amazon
 	// EC2 select an address from the address pool. To specify a specific address
 	// from the address pool, use the Address parameter instead.
+	//
+	// Pools are created by bringing your own IP address range (BYOIP) to AWS.
+	// Addresses allocated from them do not count towards the Elastic IP
+	// service quota. Defaults to amazon, the pool of Amazon's addresses.
 	// +optional
 	// +immutable
 	PublicIPv4Pool *string `json:"publicIpv4Pool,omitempty"`
@@ -125,8 +131,9 @@ type AddressObservation struct {
 
 // An AddressStatus represents the observed state of an Address.
 type AddressStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AddressObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            AddressObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
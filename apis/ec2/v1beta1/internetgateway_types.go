@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // AWS returns 'available` hence ec2.AttachmentStatusAttached doesn't work
@@ -93,8 +95,9 @@ type InternetGatewayObservation struct {
 
 // An InternetGatewayStatus represents the observed state of an InternetGateway.
 type InternetGatewayStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          InternetGatewayObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            InternetGatewayObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
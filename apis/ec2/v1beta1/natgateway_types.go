@@ -4,6 +4,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // Defines the states of NatGateway
@@ -57,7 +59,9 @@ type NATGatewayParameters struct {
 	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
 
 	// Indicates whether the NAT gateway supports public or private connectivity. The
-	// default is public connectivity.
+	// default is public connectivity. A private NAT gateway must not have an
+	// Elastic IP allocation.
+	// +immutable
 	// +optional
 	// +kubebuilder:validation:Enum=public;private
 	ConnectivityType string `json:"connectivityType,omitempty"`
@@ -75,6 +79,7 @@ type NATGatewaySpec struct {
 
 // NATGatewayObservation keeps the state for the CR
 type NATGatewayObservation struct {
+	ConnectivityType    string              `json:"connectivityType,omitempty"`
 	CreateTime          *metav1.Time        `json:"createTime,omitempty"`
 	DeleteTime          *metav1.Time        `json:"deleteTime,omitempty"`
 	FailureCode         string              `json:"failureCode,omitempty"`
@@ -95,8 +100,9 @@ type NATGatewayAddress struct {
 
 // NATGatewayStatus describes the observed state
 type NATGatewayStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          NATGatewayObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            NATGatewayObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
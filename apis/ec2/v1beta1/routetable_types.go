@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // ToDo (haarchri): changed Route to RouteBeta otherwise we got error "CRD for Route.ec2.aws.crossplane.io has no storage version"
@@ -27,9 +29,8 @@ import (
 // RouteBeta describes a route in a route table.
 // provider-aws currently provides both a standalone Route resource
 // and a RouteTable resource with routes defined in-line.
-// At this time you cannot use a Route Table with in-line routes
-// in conjunction with any Route resources.
-// Doing so will cause a conflict of rule settings and will overwrite rules.
+// A Route Table with in-line routes cannot be used in conjunction with
+// Route resources unless it sets ignoreRoutes, otherwise it overwrites them.
 type RouteBeta struct {
 	// The IPv4 CIDR address block used for the destination match. Routing
 	// decisions are based on the most specific match.
@@ -42,8 +43,20 @@ type RouteBeta struct {
 	DestinationIPV6CIDRBlock *string `json:"destinationIpv6CidrBlock,omitempty"`
 
 	// [IPv6 traffic only] The ID of an egress-only internet gateway.
+	// +optional
 	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayId,omitempty"`
 
+	// A referencer to retrieve the ID of an egress-only internet gateway. It
+	// references an ec2.aws.crossplane.io/v1alpha1 EgressOnlyInternetGateway.
+	// +optional
+	EgressOnlyInternetGatewayIDRef *xpv1.Reference `json:"egressOnlyInternetGatewayIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of an egress-only
+	// internet gateway. It selects an ec2.aws.crossplane.io/v1alpha1
+	// EgressOnlyInternetGateway.
+	// +optional
+	EgressOnlyInternetGatewayIDSelector *xpv1.Selector `json:"egressOnlyInternetGatewayIdSelector,omitempty"`
+
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
 	// +optional
@@ -87,6 +100,8 @@ func (r *RouteBeta) ClearRefSelectors() {
 	r.GatewayIDSelector = nil
 	r.NatGatewayIDSelector = nil
 	r.NatGatewayIDRef = nil
+	r.EgressOnlyInternetGatewayIDRef = nil
+	r.EgressOnlyInternetGatewayIDSelector = nil
 }
 
 // RouteState describes a route state in the route table.
@@ -104,6 +119,9 @@ type RouteState struct {
 	// decisions are based on the most specific match.
 	DestinationIPV6CIDRBlock string `json:"destinationIpv6CidrBlock,omitempty"`
 
+	// The ID of the egress-only internet gateway.
+	EgressOnlyInternetGatewayID string `json:"egressOnlyInternetGatewayId,omitempty"`
+
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
 	GatewayID string `json:"gatewayId,omitempty"`
@@ -175,7 +193,14 @@ type RouteTableParameters struct {
 	Associations []Association `json:"associations"`
 
 	// the routes in the route table
-	Routes []RouteBeta `json:"routes"`
+	// +optional
+	Routes []RouteBeta `json:"routes,omitempty"`
+
+	// IgnoreRoutes makes the RouteTable leave its routes alone, so that they
+	// can be managed by standalone Route resources instead. Routes defined
+	// in-line are not reconciled when this is set.
+	// +optional
+	IgnoreRoutes *bool `json:"ignoreRoutes,omitempty"`
 
 	// Tags represents to current ec2 tags.
 	// +optional
@@ -219,8 +244,9 @@ type RouteTableObservation struct {
 
 // A RouteTableStatus represents the observed state of a RouteTable.
 type RouteTableStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RouteTableObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            RouteTableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // SecurityGroupParameters define the desired state of an AWS VPC Security
@@ -49,6 +51,18 @@ type SecurityGroupParameters struct {
 	// +optional
 	Egress []IPPermission `json:"egress,omitempty"`
 
+	// IgnoreIngress stops the inbound rules of the security group from being
+	// managed, so that they can be managed by SecurityGroupRules instead.
+	// Ingress must be empty if it is set.
+	// +optional
+	IgnoreIngress *bool `json:"ignoreIngress,omitempty"`
+
+	// IgnoreEgress stops the outbound rules of the security group from being
+	// managed, so that they can be managed by SecurityGroupRules instead.
+	// Egress must be empty if it is set.
+	// +optional
+	IgnoreEgress *bool `json:"ignoreEgress,omitempty"`
+
 	// Tags represents to current ec2 tags.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -248,8 +262,9 @@ type SecurityGroupObservation struct {
 
 // A SecurityGroupStatus represents the observed state of a SecurityGroup.
 type SecurityGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SecurityGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            SecurityGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // SubnetParameters define the desired state of an AWS VPC Subnet.
@@ -55,9 +57,10 @@ type SubnetParameters struct {
 	AssignIPv6AddressOnCreation *bool `json:"assignIpv6AddressOnCreation,omitempty"`
 
 	// The IPv6 network range for the subnet, in CIDR notation. The subnet size
-	// must use a /64 prefix length.
+	// must use a /64 prefix length. If set on an existing subnet without an
+	// IPv6 CIDR block, it is associated with it. If changed, the previous
+	// block is disassociated and the new one associated.
 	// +optional
-	// +immutable
 	IPv6CIDRBlock *string `json:"ipv6CIDRBlock,omitempty"`
 
 	// Indicates whether instances launched in this subnet receive a public IPv4
@@ -108,8 +111,9 @@ type SubnetObservation struct {
 
 // A SubnetStatus represents the observed state of a Subnet.
 type SubnetStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SubnetObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            SubnetObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
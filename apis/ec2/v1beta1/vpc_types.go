@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // VPCCIDRBlockState represents the state of a CIDR Block
@@ -76,10 +78,34 @@ type VPCParameters struct {
 	Region *string `json:"region,omitempty"`
 
 	// CIDRBlock is the IPv4 network range for the VPC, in CIDR notation. For
-	// example, 10.0.0.0/16.
-	// +kubebuilder:validation:Required
+	// example, 10.0.0.0/16. Required unless the range is allocated from an
+	// IPAM pool using Ipv4IPAMPoolID.
+	// +optional
+	// +immutable
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// Ipv4IPAMPoolID is the ID of the IPAM pool the IPv4 network range of
+	// the VPC is allocated from.
+	// +optional
 	// +immutable
-	CIDRBlock string `json:"cidrBlock"`
+	Ipv4IPAMPoolID *string `json:"ipv4IpamPoolId,omitempty"`
+
+	// The netmask length of the IPv4 network range to allocate from the IPAM
+	// pool. Defaults to the allocation default netmask length of the pool.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	// +optional
+	// +immutable
+	Ipv4NetmaskLength *int32 `json:"ipv4NetmaskLength,omitempty"`
+
+	// AdditionalCIDRBlocks are secondary IPv4 network ranges to associate
+	// with the VPC, in CIDR notation. For example, 100.64.0.0/16. Secondary
+	// CIDR blocks that are not listed are disassociated from the VPC, so this
+	// field should not be used together with VPCCIDRBlock resources that
+	// reference the same VPC. Secondary CIDR blocks are not managed if this
+	// field is omitted.
+	// +optional
+	AdditionalCIDRBlocks []string `json:"additionalCidrBlocks,omitempty"`
 
 	// The IPv6 CIDR block from the IPv6 address pool. You must also specify Ipv6Pool
 	// in the request. To let Amazon choose the IPv6 CIDR block for you, omit this
@@ -90,9 +116,9 @@ type VPCParameters struct {
 
 	// Requests an Amazon-provided IPv6 CIDR block with a /56 prefix length for the
 	// VPC. You cannot specify the range of IP addresses, or the size of the CIDR
-	// block.
+	// block. If set on an existing VPC without an IPv6 CIDR block, one is
+	// associated with it.
 	// +optional
-	// +immutable
 	AmazonProvidedIpv6CIDRBlock *bool `json:"amazonProvidedIpv6CidrBlock,omitempty"`
 
 	// The ID of an IPv6 address pool from which to allocate the IPv6 CIDR block.
@@ -114,6 +140,7 @@ type VPCParameters struct {
 
 	// The allowed tenancy of instances launched into the VPC.
 	// +optional
+	// +kubebuilder:validation:Enum=default;dedicated;host
 	InstanceTenancy *string `json:"instanceTenancy,omitempty"`
 }
 
@@ -146,8 +173,9 @@ type VPCObservation struct {
 
 // A VPCStatus represents the observed state of a VPC.
 type VPCStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          VPCObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            VPCObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
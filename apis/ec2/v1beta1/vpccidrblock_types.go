@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // VPCCIDRBlockParameters define the desired state of an VPC CIDR Block
@@ -108,8 +110,9 @@ type VPCCIDRBlockObservation struct {
 
 // A VPCCIDRBlockStatus represents the observed state of a ElasticIP.
 type VPCCIDRBlockStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          VPCCIDRBlockObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            VPCCIDRBlockObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
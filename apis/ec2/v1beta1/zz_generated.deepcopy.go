@@ -166,6 +166,7 @@ func (in *AddressSpec) DeepCopy() *AddressSpec {
 func (in *AddressStatus) DeepCopyInto(out *AddressStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -477,6 +478,7 @@ func (in *InternetGatewaySpec) DeepCopy() *InternetGatewaySpec {
 func (in *InternetGatewayStatus) DeepCopyInto(out *InternetGatewayStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -663,6 +665,7 @@ func (in *NATGatewaySpec) DeepCopy() *NATGatewaySpec {
 func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -714,6 +717,16 @@ func (in *RouteBeta) DeepCopyInto(out *RouteBeta) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.EgressOnlyInternetGatewayIDRef != nil {
+		in, out := &in.EgressOnlyInternetGatewayIDRef, &out.EgressOnlyInternetGatewayIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.EgressOnlyInternetGatewayIDSelector != nil {
+		in, out := &in.EgressOnlyInternetGatewayIDSelector, &out.EgressOnlyInternetGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.GatewayID != nil {
 		in, out := &in.GatewayID, &out.GatewayID
 		*out = new(string)
@@ -899,6 +912,11 @@ func (in *RouteTableParameters) DeepCopyInto(out *RouteTableParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.IgnoreRoutes != nil {
+		in, out := &in.IgnoreRoutes, &out.IgnoreRoutes
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -952,6 +970,7 @@ func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
 func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1061,6 +1080,16 @@ func (in *SecurityGroupParameters) DeepCopyInto(out *SecurityGroupParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.IgnoreIngress != nil {
+		in, out := &in.IgnoreIngress, &out.IgnoreIngress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnoreEgress != nil {
+		in, out := &in.IgnoreEgress, &out.IgnoreEgress
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -1114,6 +1143,7 @@ func (in *SecurityGroupSpec) DeepCopy() *SecurityGroupSpec {
 func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1287,6 +1317,7 @@ func (in *SubnetSpec) DeepCopy() *SubnetSpec {
 func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1590,6 +1621,7 @@ func (in *VPCCIDRBlockState) DeepCopy() *VPCCIDRBlockState {
 func (in *VPCCIDRBlockStatus) DeepCopyInto(out *VPCCIDRBlockStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1684,6 +1716,21 @@ func (in *VPCParameters) DeepCopyInto(out *VPCParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Ipv4IPAMPoolID != nil {
+		in, out := &in.Ipv4IPAMPoolID, &out.Ipv4IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv4NetmaskLength != nil {
+		in, out := &in.Ipv4NetmaskLength, &out.Ipv4NetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AdditionalCIDRBlocks != nil {
+		in, out := &in.AdditionalCIDRBlocks, &out.AdditionalCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Ipv6CIDRBlock != nil {
 		in, out := &in.Ipv6CIDRBlock, &out.Ipv6CIDRBlock
 		*out = new(string)
@@ -1752,6 +1799,7 @@ func (in *VPCSpec) DeepCopy() *VPCSpec {
 func (in *VPCStatus) DeepCopyInto(out *VPCStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
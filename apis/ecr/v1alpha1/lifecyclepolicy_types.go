@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// LifecyclePolicyParameters define the desired state of an AWS Elastic
+// Container Repository Lifecycle Policy.
+type LifecyclePolicyParameters struct {
+	// Region is the region you'd like your LifecyclePolicy to be created in.
+	Region string `json:"region"`
+
+	// Policy is a well defined type which can be parsed into a JSON lifecycle
+	// policy. Either policy or rawPolicy must be specified.
+	// +optional
+	Policy *LifecyclePolicyBody `json:"policy,omitempty"`
+
+	// RawPolicy is the stringified JSON lifecycle policy. Either policy or
+	// rawPolicy must be specified.
+	// +optional
+	RawPolicy *string `json:"rawPolicy,omitempty"`
+
+	// The AWS account ID associated with the registry that contains the repository.
+	// If you do not specify a registry, the default registry is assumed.
+	// +optional
+	// +immutable
+	RegistryID *string `json:"registryId,omitempty"`
+
+	// The name of the repository to receive the lifecycle policy.
+	//
+	// One of RepositoryName, RepositoryNameRef, or RepositoryNameSelector is required.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ecr/v1beta1.Repository
+	RepositoryName *string `json:"repositoryName,omitempty"`
+
+	// A Reference to a named object.
+	//
+	// One of RepositoryName, RepositoryNameRef, or RepositoryNameSelector is required.
+	// +optional
+	RepositoryNameRef *xpv1.Reference `json:"repositoryNameRef,omitempty"`
+
+	// A Selector selects an object.
+	//
+	// One of RepositoryName, RepositoryNameRef, or RepositoryNameSelector is required.
+	// +optional
+	RepositoryNameSelector *xpv1.Selector `json:"repositoryNameSelector,omitempty"`
+}
+
+// LifecyclePolicyBody represents an ECR lifecycle policy document. The JSON
+// field names match the document format expected by the ECR API.
+type LifecyclePolicyBody struct {
+	// Rules are evaluated by ECR in ascending order of their rule priority.
+	// +kubebuilder:validation:MinItems=1
+	Rules []LifecyclePolicyRule `json:"rules"`
+}
+
+// LifecyclePolicyRule is a single image retention rule.
+type LifecyclePolicyRule struct {
+	// RulePriority sets the order in which rules are applied, lowest to
+	// highest. Each rule must have a unique priority.
+	// +kubebuilder:validation:Minimum=1
+	RulePriority int64 `json:"rulePriority"`
+
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Selection determines the images the rule applies to.
+	Selection LifecyclePolicySelection `json:"selection"`
+
+	// Action to take on the selected images.
+	Action LifecyclePolicyAction `json:"action"`
+}
+
+// LifecyclePolicySelection determines which images a lifecycle policy rule
+// applies to.
+type LifecyclePolicySelection struct {
+	// TagStatus selects tagged, untagged or any images.
+	// +kubebuilder:validation:Enum=tagged;untagged;any
+	TagStatus string `json:"tagStatus"`
+
+	// TagPrefixList selects tagged images whose tag begins with one of the
+	// given prefixes. Only valid when tagStatus is tagged.
+	// +optional
+	TagPrefixList []string `json:"tagPrefixList,omitempty"`
+
+	// TagPatternList selects tagged images whose tag matches one of the
+	// given wildcard patterns. Only valid when tagStatus is tagged.
+	// +optional
+	TagPatternList []string `json:"tagPatternList,omitempty"`
+
+	// CountType selects images either by their number or by their age.
+	// +kubebuilder:validation:Enum=imageCountMoreThan;sinceImagePushed
+	CountType string `json:"countType"`
+
+	// CountUnit is the unit of countNumber. Only valid, and required, when
+	// countType is sinceImagePushed.
+	// +optional
+	// +kubebuilder:validation:Enum=days
+	CountUnit *string `json:"countUnit,omitempty"`
+
+	// CountNumber is the image count or age limit, depending on countType.
+	// +kubebuilder:validation:Minimum=1
+	CountNumber int64 `json:"countNumber"`
+}
+
+// LifecyclePolicyAction is the action a lifecycle policy rule takes on the
+// images it selects.
+type LifecyclePolicyAction struct {
+	// Type of the action.
+	// +kubebuilder:validation:Enum=expire
+	Type string `json:"type"`
+}
+
+// A LifecyclePolicySpec defines the desired state of an Elastic Container
+// Repository Lifecycle Policy.
+type LifecyclePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LifecyclePolicyParameters `json:"forProvider"`
+}
+
+// LifecyclePolicyObservation keeps the state for the external resource
+type LifecyclePolicyObservation struct {
+	// LastEvaluatedAt is the time the lifecycle policy last ran.
+	LastEvaluatedAt *metav1.Time `json:"lastEvaluatedAt,omitempty"`
+}
+
+// A LifecyclePolicyStatus represents the observed state of a lifecycle policy
+type LifecyclePolicyStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            LifecyclePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LifecyclePolicy is a managed resource that represents an Elastic
+// Container Repository Lifecycle Policy, which expires images according to
+// their age or count.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REPOSITORY",type="string",JSONPath=".spec.forProvider.repositoryName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LifecyclePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LifecyclePolicySpec   `json:"spec"`
+	Status LifecyclePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LifecyclePolicyList contains a list of LifecyclePolicies
+type LifecyclePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LifecyclePolicy `json:"items"`
+}
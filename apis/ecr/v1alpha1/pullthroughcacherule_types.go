@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// PullThroughCacheRuleParameters define the desired state of an AWS Elastic
+// Container Registry pull through cache rule. The external name of the rule
+// is the repository prefix images of the upstream registry are cached under.
+type PullThroughCacheRuleParameters struct {
+	// Region is the region you'd like your PullThroughCacheRule to be created in.
+	Region string `json:"region"`
+
+	// The AWS account ID associated with the registry to create the rule in.
+	// If you do not specify a registry, the default registry is assumed.
+	// +optional
+	// +immutable
+	RegistryID *string `json:"registryId,omitempty"`
+
+	// UpstreamRegistryURL is the URL of the public registry to cache, e.g.
+	// public.ecr.aws or quay.io.
+	// +immutable
+	UpstreamRegistryURL string `json:"upstreamRegistryUrl"`
+}
+
+// A PullThroughCacheRuleSpec defines the desired state of an Elastic
+// Container Registry pull through cache rule.
+type PullThroughCacheRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PullThroughCacheRuleParameters `json:"forProvider"`
+}
+
+// PullThroughCacheRuleObservation keeps the state for the external resource
+type PullThroughCacheRuleObservation struct {
+	// CreatedAt is the time the rule was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// A PullThroughCacheRuleStatus represents the observed state of a pull
+// through cache rule.
+type PullThroughCacheRuleStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            PullThroughCacheRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PullThroughCacheRule is a managed resource that caches the images of an
+// upstream public registry in the Elastic Container Registry.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PREFIX",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="UPSTREAM",type="string",JSONPath=".spec.forProvider.upstreamRegistryUrl"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PullThroughCacheRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PullThroughCacheRuleSpec   `json:"spec"`
+	Status PullThroughCacheRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PullThroughCacheRuleList contains a list of PullThroughCacheRules
+type PullThroughCacheRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PullThroughCacheRule `json:"items"`
+}
@@ -56,7 +56,43 @@ var (
 	RepositoryPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryPolicyKind)
 )
 
+// LifecyclePolicy type metadata.
+var (
+	LifecyclePolicyKind             = reflect.TypeOf(LifecyclePolicy{}).Name()
+	LifecyclePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: LifecyclePolicyKind}.String()
+	LifecyclePolicyKindAPIVersion   = LifecyclePolicyKind + "." + SchemeGroupVersion.String()
+	LifecyclePolicyGroupVersionKind = SchemeGroupVersion.WithKind(LifecyclePolicyKind)
+)
+
+// ReplicationConfiguration type metadata.
+var (
+	ReplicationConfigurationKind             = reflect.TypeOf(ReplicationConfiguration{}).Name()
+	ReplicationConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: ReplicationConfigurationKind}.String()
+	ReplicationConfigurationKindAPIVersion   = ReplicationConfigurationKind + "." + SchemeGroupVersion.String()
+	ReplicationConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(ReplicationConfigurationKind)
+)
+
+// PullThroughCacheRule type metadata.
+var (
+	PullThroughCacheRuleKind             = reflect.TypeOf(PullThroughCacheRule{}).Name()
+	PullThroughCacheRuleGroupKind        = schema.GroupKind{Group: Group, Kind: PullThroughCacheRuleKind}.String()
+	PullThroughCacheRuleKindAPIVersion   = PullThroughCacheRuleKind + "." + SchemeGroupVersion.String()
+	PullThroughCacheRuleGroupVersionKind = SchemeGroupVersion.WithKind(PullThroughCacheRuleKind)
+)
+
+// RegistryScanningConfiguration type metadata.
+var (
+	RegistryScanningConfigurationKind             = reflect.TypeOf(RegistryScanningConfiguration{}).Name()
+	RegistryScanningConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: RegistryScanningConfigurationKind}.String()
+	RegistryScanningConfigurationKindAPIVersion   = RegistryScanningConfigurationKind + "." + SchemeGroupVersion.String()
+	RegistryScanningConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(RegistryScanningConfigurationKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Repository{}, &RepositoryList{})
 	SchemeBuilder.Register(&RepositoryPolicy{}, &RepositoryPolicyList{})
+	SchemeBuilder.Register(&LifecyclePolicy{}, &LifecyclePolicyList{})
+	SchemeBuilder.Register(&ReplicationConfiguration{}, &ReplicationConfigurationList{})
+	SchemeBuilder.Register(&PullThroughCacheRule{}, &PullThroughCacheRuleList{})
+	SchemeBuilder.Register(&RegistryScanningConfiguration{}, &RegistryScanningConfigurationList{})
 }
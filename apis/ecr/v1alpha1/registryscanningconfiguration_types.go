@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Scan types of a registry.
+const (
+	ScanTypeBasic    = "BASIC"
+	ScanTypeEnhanced = "ENHANCED"
+)
+
+// RegistryScanningConfigurationParameters define the desired state of the
+// image scanning configuration of an AWS Elastic Container Registry.
+type RegistryScanningConfigurationParameters struct {
+	// Region is the region of the registry whose images are scanned.
+	Region string `json:"region"`
+
+	// ScanType of the registry. BASIC scanning uses the open source Clair
+	// project, ENHANCED scanning uses Amazon Inspector.
+	// +kubebuilder:validation:Enum=BASIC;ENHANCED
+	// +kubebuilder:default=BASIC
+	ScanType string `json:"scanType"`
+
+	// Rules determine the repositories whose images are scanned and how
+	// often. Repositories that match no rule are not scanned.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	Rules []RegistryScanningRule `json:"rules"`
+}
+
+// RegistryScanningRule determines how often the images of the matching
+// repositories are scanned.
+type RegistryScanningRule struct {
+	// ScanFrequency of the matching repositories. CONTINUOUS_SCAN is only
+	// supported by ENHANCED scanning, and MANUAL only by BASIC scanning.
+	// +kubebuilder:validation:Enum=SCAN_ON_PUSH;CONTINUOUS_SCAN;MANUAL
+	ScanFrequency string `json:"scanFrequency"`
+
+	// RepositoryFilters select the repositories the rule applies to.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	RepositoryFilters []ScanningRepositoryFilter `json:"repositoryFilters"`
+}
+
+// ScanningRepositoryFilter selects the repositories a scanning rule applies
+// to.
+type ScanningRepositoryFilter struct {
+	// Filter is the repository name to match, where * matches any
+	// characters.
+	Filter string `json:"filter"`
+
+	// FilterType is the type of the filter.
+	// +kubebuilder:validation:Enum=WILDCARD
+	// +kubebuilder:default=WILDCARD
+	FilterType string `json:"filterType"`
+}
+
+// A RegistryScanningConfigurationSpec defines the desired state of an
+// Elastic Container Registry scanning configuration.
+type RegistryScanningConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RegistryScanningConfigurationParameters `json:"forProvider"`
+}
+
+// RegistryScanningConfigurationObservation keeps the state for the external
+// resource
+type RegistryScanningConfigurationObservation struct {
+	// RegistryID is the AWS account ID of the scanned registry.
+	RegistryID string `json:"registryId,omitempty"`
+}
+
+// A RegistryScanningConfigurationStatus represents the observed state of a
+// registry scanning configuration.
+type RegistryScanningConfigurationStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            RegistryScanningConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RegistryScanningConfiguration is a managed resource that represents the
+// image scanning configuration of the Elastic Container Registry of an account
+// in a region. There can be only one per region. It takes precedence over the
+// imageScanningConfiguration of repositories.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SCAN-TYPE",type="string",JSONPath=".spec.forProvider.scanType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RegistryScanningConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegistryScanningConfigurationSpec   `json:"spec"`
+	Status RegistryScanningConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegistryScanningConfigurationList contains a list of
+// RegistryScanningConfigurations
+type RegistryScanningConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistryScanningConfiguration `json:"items"`
+}
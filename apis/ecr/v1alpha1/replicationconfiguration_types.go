@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ReplicationConfigurationParameters define the desired state of the
+// replication configuration of an AWS Elastic Container Registry.
+type ReplicationConfigurationParameters struct {
+	// Region is the region of the registry whose images are replicated.
+	Region string `json:"region"`
+
+	// Rules to replicate the images of the registry with. Images pushed to
+	// repositories matching a rule are copied to all of its destinations.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	Rules []ReplicationRule `json:"rules"`
+}
+
+// ReplicationRule is a set of destinations and the repositories whose images
+// are replicated to them.
+type ReplicationRule struct {
+	// Destinations to replicate the images to.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=25
+	Destinations []ReplicationDestination `json:"destinations"`
+
+	// RepositoryFilters restrict the rule to the matching repositories. All
+	// repositories are replicated if no filter is given.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	RepositoryFilters []RepositoryFilter `json:"repositoryFilters,omitempty"`
+}
+
+// ReplicationDestination is a registry images are replicated to.
+type ReplicationDestination struct {
+	// Region of the destination registry.
+	Region string `json:"region"`
+
+	// RegistryID is the AWS account ID of the destination registry. Use the
+	// account ID of the source registry for cross-region replication.
+	RegistryID string `json:"registryId"`
+}
+
+// RepositoryFilter selects the repositories a replication rule applies to.
+type RepositoryFilter struct {
+	// Filter is the repository name prefix to match.
+	Filter string `json:"filter"`
+
+	// FilterType is the type of the filter.
+	// +kubebuilder:validation:Enum=PREFIX_MATCH
+	// +kubebuilder:default=PREFIX_MATCH
+	FilterType string `json:"filterType"`
+}
+
+// A ReplicationConfigurationSpec defines the desired state of an Elastic
+// Container Registry replication configuration.
+type ReplicationConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReplicationConfigurationParameters `json:"forProvider"`
+}
+
+// ReplicationConfigurationObservation keeps the state for the external resource
+type ReplicationConfigurationObservation struct {
+	// RegistryID is the AWS account ID of the replicated registry.
+	RegistryID string `json:"registryId,omitempty"`
+}
+
+// A ReplicationConfigurationStatus represents the observed state of a
+// replication configuration.
+type ReplicationConfigurationStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ReplicationConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ReplicationConfiguration is a managed resource that represents the
+// cross-region and cross-account replication configuration of the Elastic
+// Container Registry of an account in a region. There can be only one per
+// region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ReplicationConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicationConfigurationSpec   `json:"spec"`
+	Status ReplicationConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationConfigurationList contains a list of ReplicationConfigurations
+type ReplicationConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationConfiguration `json:"items"`
+}
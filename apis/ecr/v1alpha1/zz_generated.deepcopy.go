@@ -152,6 +152,708 @@ func (in *ImageScanningConfiguration) DeepCopy() *ImageScanningConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicy) DeepCopyInto(out *LifecyclePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicy.
+func (in *LifecyclePolicy) DeepCopy() *LifecyclePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LifecyclePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyAction) DeepCopyInto(out *LifecyclePolicyAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyAction.
+func (in *LifecyclePolicyAction) DeepCopy() *LifecyclePolicyAction {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyBody) DeepCopyInto(out *LifecyclePolicyBody) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]LifecyclePolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyBody.
+func (in *LifecyclePolicyBody) DeepCopy() *LifecyclePolicyBody {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyList) DeepCopyInto(out *LifecyclePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LifecyclePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyList.
+func (in *LifecyclePolicyList) DeepCopy() *LifecyclePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LifecyclePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyObservation) DeepCopyInto(out *LifecyclePolicyObservation) {
+	*out = *in
+	if in.LastEvaluatedAt != nil {
+		in, out := &in.LastEvaluatedAt, &out.LastEvaluatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyObservation.
+func (in *LifecyclePolicyObservation) DeepCopy() *LifecyclePolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyParameters) DeepCopyInto(out *LifecyclePolicyParameters) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(LifecyclePolicyBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RawPolicy != nil {
+		in, out := &in.RawPolicy, &out.RawPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegistryID != nil {
+		in, out := &in.RegistryID, &out.RegistryID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepositoryName != nil {
+		in, out := &in.RepositoryName, &out.RepositoryName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepositoryNameRef != nil {
+		in, out := &in.RepositoryNameRef, &out.RepositoryNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RepositoryNameSelector != nil {
+		in, out := &in.RepositoryNameSelector, &out.RepositoryNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyParameters.
+func (in *LifecyclePolicyParameters) DeepCopy() *LifecyclePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyRule) DeepCopyInto(out *LifecyclePolicyRule) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.Selection.DeepCopyInto(&out.Selection)
+	out.Action = in.Action
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyRule.
+func (in *LifecyclePolicyRule) DeepCopy() *LifecyclePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicySelection) DeepCopyInto(out *LifecyclePolicySelection) {
+	*out = *in
+	if in.TagPrefixList != nil {
+		in, out := &in.TagPrefixList, &out.TagPrefixList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TagPatternList != nil {
+		in, out := &in.TagPatternList, &out.TagPatternList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CountUnit != nil {
+		in, out := &in.CountUnit, &out.CountUnit
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicySelection.
+func (in *LifecyclePolicySelection) DeepCopy() *LifecyclePolicySelection {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicySelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicySpec) DeepCopyInto(out *LifecyclePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicySpec.
+func (in *LifecyclePolicySpec) DeepCopy() *LifecyclePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecyclePolicyStatus) DeepCopyInto(out *LifecyclePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicyStatus.
+func (in *LifecyclePolicyStatus) DeepCopy() *LifecyclePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecyclePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullThroughCacheRule) DeepCopyInto(out *PullThroughCacheRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullThroughCacheRule.
+func (in *PullThroughCacheRule) DeepCopy() *PullThroughCacheRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PullThroughCacheRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PullThroughCacheRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullThroughCacheRuleList) DeepCopyInto(out *PullThroughCacheRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PullThroughCacheRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullThroughCacheRuleList.
+func (in *PullThroughCacheRuleList) DeepCopy() *PullThroughCacheRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(PullThroughCacheRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PullThroughCacheRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullThroughCacheRuleObservation) DeepCopyInto(out *PullThroughCacheRuleObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullThroughCacheRuleObservation.
+func (in *PullThroughCacheRuleObservation) DeepCopy() *PullThroughCacheRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PullThroughCacheRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullThroughCacheRuleParameters) DeepCopyInto(out *PullThroughCacheRuleParameters) {
+	*out = *in
+	if in.RegistryID != nil {
+		in, out := &in.RegistryID, &out.RegistryID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullThroughCacheRuleParameters.
+func (in *PullThroughCacheRuleParameters) DeepCopy() *PullThroughCacheRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PullThroughCacheRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullThroughCacheRuleSpec) DeepCopyInto(out *PullThroughCacheRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullThroughCacheRuleSpec.
+func (in *PullThroughCacheRuleSpec) DeepCopy() *PullThroughCacheRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PullThroughCacheRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullThroughCacheRuleStatus) DeepCopyInto(out *PullThroughCacheRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullThroughCacheRuleStatus.
+func (in *PullThroughCacheRuleStatus) DeepCopy() *PullThroughCacheRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PullThroughCacheRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryScanningConfiguration) DeepCopyInto(out *RegistryScanningConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryScanningConfiguration.
+func (in *RegistryScanningConfiguration) DeepCopy() *RegistryScanningConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryScanningConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistryScanningConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryScanningConfigurationList) DeepCopyInto(out *RegistryScanningConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RegistryScanningConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryScanningConfigurationList.
+func (in *RegistryScanningConfigurationList) DeepCopy() *RegistryScanningConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryScanningConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistryScanningConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryScanningConfigurationObservation) DeepCopyInto(out *RegistryScanningConfigurationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryScanningConfigurationObservation.
+func (in *RegistryScanningConfigurationObservation) DeepCopy() *RegistryScanningConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryScanningConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryScanningConfigurationParameters) DeepCopyInto(out *RegistryScanningConfigurationParameters) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RegistryScanningRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryScanningConfigurationParameters.
+func (in *RegistryScanningConfigurationParameters) DeepCopy() *RegistryScanningConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryScanningConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryScanningConfigurationSpec) DeepCopyInto(out *RegistryScanningConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryScanningConfigurationSpec.
+func (in *RegistryScanningConfigurationSpec) DeepCopy() *RegistryScanningConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryScanningConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryScanningConfigurationStatus) DeepCopyInto(out *RegistryScanningConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryScanningConfigurationStatus.
+func (in *RegistryScanningConfigurationStatus) DeepCopy() *RegistryScanningConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryScanningConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryScanningRule) DeepCopyInto(out *RegistryScanningRule) {
+	*out = *in
+	if in.RepositoryFilters != nil {
+		in, out := &in.RepositoryFilters, &out.RepositoryFilters
+		*out = make([]ScanningRepositoryFilter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryScanningRule.
+func (in *RegistryScanningRule) DeepCopy() *RegistryScanningRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryScanningRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationConfiguration) DeepCopyInto(out *ReplicationConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfiguration.
+func (in *ReplicationConfiguration) DeepCopy() *ReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationConfigurationList) DeepCopyInto(out *ReplicationConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfigurationList.
+func (in *ReplicationConfigurationList) DeepCopy() *ReplicationConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationConfigurationObservation) DeepCopyInto(out *ReplicationConfigurationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfigurationObservation.
+func (in *ReplicationConfigurationObservation) DeepCopy() *ReplicationConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationConfigurationParameters) DeepCopyInto(out *ReplicationConfigurationParameters) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ReplicationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfigurationParameters.
+func (in *ReplicationConfigurationParameters) DeepCopy() *ReplicationConfigurationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationConfigurationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationConfigurationSpec) DeepCopyInto(out *ReplicationConfigurationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfigurationSpec.
+func (in *ReplicationConfigurationSpec) DeepCopy() *ReplicationConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationConfigurationStatus) DeepCopyInto(out *ReplicationConfigurationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfigurationStatus.
+func (in *ReplicationConfigurationStatus) DeepCopy() *ReplicationConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestination) DeepCopyInto(out *ReplicationDestination) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestination.
+func (in *ReplicationDestination) DeepCopy() *ReplicationDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationRule) DeepCopyInto(out *ReplicationRule) {
+	*out = *in
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]ReplicationDestination, len(*in))
+		copy(*out, *in)
+	}
+	if in.RepositoryFilters != nil {
+		in, out := &in.RepositoryFilters, &out.RepositoryFilters
+		*out = make([]RepositoryFilter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationRule.
+func (in *ReplicationRule) DeepCopy() *ReplicationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Repository) DeepCopyInto(out *Repository) {
 	*out = *in
@@ -179,6 +881,21 @@ func (in *Repository) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryFilter) DeepCopyInto(out *RepositoryFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryFilter.
+func (in *RepositoryFilter) DeepCopy() *RepositoryFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
 	*out = *in
@@ -494,6 +1211,7 @@ func (in *RepositoryPolicyStatement) DeepCopy() *RepositoryPolicyStatement {
 func (in *RepositoryPolicyStatus) DeepCopyInto(out *RepositoryPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -565,6 +1283,7 @@ func (in *RepositorySpec) DeepCopy() *RepositorySpec {
 func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -578,6 +1297,21 @@ func (in *RepositoryStatus) DeepCopy() *RepositoryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScanningRepositoryFilter) DeepCopyInto(out *ScanningRepositoryFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScanningRepositoryFilter.
+func (in *ScanningRepositoryFilter) DeepCopy() *ScanningRepositoryFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ScanningRepositoryFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
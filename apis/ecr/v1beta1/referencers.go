@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
 )
@@ -100,3 +101,15 @@ func ResolvePrincipal(ctx context.Context, r *reference.APIResolver, principal *
 	}
 	return nil
 }
+
+// RepositoryURI returns a function that returns the URI of the given
+// repository, which images are pulled from.
+func RepositoryURI() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Repository)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.RepositoryURI
+	}
+}
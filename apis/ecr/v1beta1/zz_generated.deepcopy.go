@@ -494,6 +494,7 @@ func (in *RepositoryPolicyStatement) DeepCopy() *RepositoryPolicyStatement {
 func (in *RepositoryPolicyStatus) DeepCopyInto(out *RepositoryPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -565,6 +566,7 @@ func (in *RepositorySpec) DeepCopy() *RepositorySpec {
 func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Capacity provider states.
+const (
+	CapacityProviderStatusActive   = "ACTIVE"
+	CapacityProviderStatusInactive = "INACTIVE"
+)
+
+// Capacity provider update states.
+const (
+	CapacityProviderUpdateStatusDeleteInProgress = "DELETE_IN_PROGRESS"
+	CapacityProviderUpdateStatusDeleteFailed     = "DELETE_FAILED"
+	CapacityProviderUpdateStatusUpdateInProgress = "UPDATE_IN_PROGRESS"
+	CapacityProviderUpdateStatusUpdateFailed     = "UPDATE_FAILED"
+)
+
+// ManagedScaling configures how ECS scales the Auto Scaling group of a
+// capacity provider in and out.
+type ManagedScaling struct {
+	// Whether managed scaling is enabled for the capacity provider.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// The target utilization, in percent, of the Auto Scaling group.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TargetCapacity *int64 `json:"targetCapacity,omitempty"`
+
+	// The minimum number of instances ECS scales in or out at a time.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	MinimumScalingStepSize *int64 `json:"minimumScalingStepSize,omitempty"`
+
+	// The maximum number of instances ECS scales in or out at a time.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	MaximumScalingStepSize *int64 `json:"maximumScalingStepSize,omitempty"`
+
+	// The period, in seconds, after which a newly launched instance
+	// contributes to the CloudWatch metrics of the Auto Scaling group.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	InstanceWarmupPeriod *int64 `json:"instanceWarmupPeriod,omitempty"`
+}
+
+// AutoScalingGroupProvider backs a capacity provider with an Auto Scaling
+// group.
+type AutoScalingGroupProvider struct {
+	// The Amazon Resource Name (ARN) of the Auto Scaling group.
+	// +immutable
+	AutoScalingGroupARN string `json:"autoScalingGroupArn"`
+
+	// The managed scaling settings of the capacity provider.
+	// +optional
+	ManagedScaling *ManagedScaling `json:"managedScaling,omitempty"`
+
+	// Whether ECS protects the instances of the Auto Scaling group that run
+	// tasks from being terminated during scale-in. Requires managed scaling
+	// and instance scale-in protection on the Auto Scaling group.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	ManagedTerminationProtection *string `json:"managedTerminationProtection,omitempty"`
+}
+
+// CapacityProviderParameters define the desired state of an ECS capacity
+// provider.
+type CapacityProviderParameters struct {
+	// Region is the region you'd like your CapacityProvider to be created in.
+	Region string `json:"region"`
+
+	// The Auto Scaling group the capacity provider launches instances in.
+	AutoScalingGroupProvider AutoScalingGroupProvider `json:"autoScalingGroupProvider"`
+
+	// The tags of the capacity provider.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A CapacityProviderSpec defines the desired state of a CapacityProvider.
+type CapacityProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CapacityProviderParameters `json:"forProvider"`
+}
+
+// CapacityProviderObservation keeps the state for the external resource
+type CapacityProviderObservation struct {
+	// The Amazon Resource Name (ARN) of the capacity provider.
+	CapacityProviderARN string `json:"capacityProviderArn,omitempty"`
+
+	// The status of the capacity provider.
+	Status string `json:"status,omitempty"`
+
+	// The status of the latest update or deletion of the capacity provider.
+	UpdateStatus string `json:"updateStatus,omitempty"`
+
+	// The reason for the update status of the capacity provider.
+	UpdateStatusReason string `json:"updateStatusReason,omitempty"`
+}
+
+// A CapacityProviderStatus represents the observed state of a
+// CapacityProvider.
+type CapacityProviderStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            CapacityProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CapacityProvider is a managed resource that represents an ECS capacity
+// provider backed by an Auto Scaling group. The external name of a
+// CapacityProvider is the name of the capacity provider.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CapacityProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CapacityProviderSpec   `json:"spec"`
+	Status CapacityProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CapacityProviderList contains a list of CapacityProviders
+type CapacityProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CapacityProvider `json:"items"`
+}
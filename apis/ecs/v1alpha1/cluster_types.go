@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Cluster states.
+const (
+	ClusterStatusActive         = "ACTIVE"
+	ClusterStatusProvisioning   = "PROVISIONING"
+	ClusterStatusDeprovisioning = "DEPROVISIONING"
+	ClusterStatusFailed         = "FAILED"
+	ClusterStatusInactive       = "INACTIVE"
+)
+
+// ClusterSetting is a setting of a cluster.
+type ClusterSetting struct {
+	// The name of the setting. Only containerInsights is supported.
+	// +kubebuilder:validation:Enum=containerInsights
+	Name string `json:"name"`
+
+	// The value of the setting, either enabled or disabled.
+	// +kubebuilder:validation:Enum=enabled;disabled
+	Value string `json:"value"`
+}
+
+// ExecuteCommandLogConfiguration configures where the output of ECS Exec
+// sessions is logged to.
+type ExecuteCommandLogConfiguration struct {
+	// The name of the CloudWatch log group the output is sent to.
+	// +optional
+	CloudWatchLogGroupName *string `json:"cloudWatchLogGroupName,omitempty"`
+
+	// Indicates whether the CloudWatch log group is encrypted.
+	// +optional
+	CloudWatchEncryptionEnabled *bool `json:"cloudWatchEncryptionEnabled,omitempty"`
+
+	// The name of the S3 bucket the output is sent to.
+	// +optional
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+
+	// Indicates whether the S3 bucket is encrypted.
+	// +optional
+	S3EncryptionEnabled *bool `json:"s3EncryptionEnabled,omitempty"`
+
+	// The key prefix of the objects written to the S3 bucket.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+}
+
+// ExecuteCommandConfiguration configures ECS Exec for the cluster.
+type ExecuteCommandConfiguration struct {
+	// The ID of the KMS key the data between the client and the container
+	// is encrypted with.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// The log setting of the ECS Exec sessions.
+	// +kubebuilder:validation:Enum=NONE;DEFAULT;OVERRIDE
+	// +optional
+	Logging *string `json:"logging,omitempty"`
+
+	// The log configuration, required when logging is OVERRIDE.
+	// +optional
+	LogConfiguration *ExecuteCommandLogConfiguration `json:"logConfiguration,omitempty"`
+}
+
+// ClusterConfiguration is the configuration of a cluster.
+type ClusterConfiguration struct {
+	// The ECS Exec configuration of the cluster.
+	// +optional
+	ExecuteCommandConfiguration *ExecuteCommandConfiguration `json:"executeCommandConfiguration,omitempty"`
+}
+
+// CapacityProviderStrategyItem determines how tasks are spread across a
+// capacity provider.
+type CapacityProviderStrategyItem struct {
+	// The name of the capacity provider, which is either the name of a
+	// CapacityProvider or one of FARGATE and FARGATE_SPOT.
+	CapacityProvider string `json:"capacityProvider"`
+
+	// The number of tasks that at least run on the capacity provider. Only
+	// one item of a strategy may have a base.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100000
+	// +optional
+	Base *int64 `json:"base,omitempty"`
+
+	// The relative share of the tasks, beyond the base, that run on the
+	// capacity provider.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// ClusterParameters define the desired state of an ECS cluster.
+type ClusterParameters struct {
+	// Region is the region you'd like your Cluster to be created in.
+	Region string `json:"region"`
+
+	// The settings of the cluster, such as whether CloudWatch Container
+	// Insights is enabled.
+	// +optional
+	Settings []ClusterSetting `json:"settings,omitempty"`
+
+	// The configuration of the cluster.
+	// +optional
+	Configuration *ClusterConfiguration `json:"configuration,omitempty"`
+
+	// The names of the capacity providers associated with the cluster.
+	// Besides CapacityProviders this may include FARGATE and FARGATE_SPOT.
+	// +crossplane:generate:reference:type=CapacityProvider
+	// +crossplane:generate:reference:refFieldName=CapacityProviderRefs
+	// +crossplane:generate:reference:selectorFieldName=CapacityProviderSelector
+	// +optional
+	CapacityProviders []string `json:"capacityProviders,omitempty"`
+
+	// CapacityProviderRefs are references to CapacityProviders used to set
+	// CapacityProviders.
+	// +optional
+	CapacityProviderRefs []xpv1.Reference `json:"capacityProviderRefs,omitempty"`
+
+	// CapacityProviderSelector selects references to CapacityProviders used
+	// to set CapacityProviders.
+	// +optional
+	CapacityProviderSelector *xpv1.Selector `json:"capacityProviderSelector,omitempty"`
+
+	// The capacity provider strategy used by tasks and services that are
+	// run without a launch type or a strategy of their own. Every capacity
+	// provider of the strategy must be associated with the cluster.
+	// +optional
+	DefaultCapacityProviderStrategy []CapacityProviderStrategyItem `json:"defaultCapacityProviderStrategy,omitempty"`
+
+	// The tags of the cluster.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterParameters `json:"forProvider"`
+}
+
+// ClusterObservation keeps the state for the external resource
+type ClusterObservation struct {
+	// The Amazon Resource Name (ARN) of the cluster.
+	ClusterARN string `json:"clusterArn,omitempty"`
+
+	// The status of the cluster.
+	Status string `json:"status,omitempty"`
+
+	// The number of container instances registered to the cluster.
+	RegisteredContainerInstancesCount int64 `json:"registeredContainerInstancesCount,omitempty"`
+
+	// The number of tasks in the cluster that are in the RUNNING state.
+	RunningTasksCount int64 `json:"runningTasksCount,omitempty"`
+
+	// The number of tasks in the cluster that are in the PENDING state.
+	PendingTasksCount int64 `json:"pendingTasksCount,omitempty"`
+
+	// The number of services in the cluster that are in the ACTIVE state.
+	ActiveServicesCount int64 `json:"activeServicesCount,omitempty"`
+}
+
+// A ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is a managed resource that represents an ECS cluster, a logical
+// grouping of the tasks and services that run on it. The external name of a
+// Cluster is the name of the ECS cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Clusters
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
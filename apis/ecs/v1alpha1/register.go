@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "ecs.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Cluster type metadata.
+var (
+	ClusterKind             = reflect.TypeOf(Cluster{}).Name()
+	ClusterGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterKind}.String()
+	ClusterKindAPIVersion   = ClusterKind + "." + SchemeGroupVersion.String()
+	ClusterGroupVersionKind = SchemeGroupVersion.WithKind(ClusterKind)
+)
+
+// TaskDefinition type metadata.
+var (
+	TaskDefinitionKind             = reflect.TypeOf(TaskDefinition{}).Name()
+	TaskDefinitionGroupKind        = schema.GroupKind{Group: Group, Kind: TaskDefinitionKind}.String()
+	TaskDefinitionKindAPIVersion   = TaskDefinitionKind + "." + SchemeGroupVersion.String()
+	TaskDefinitionGroupVersionKind = SchemeGroupVersion.WithKind(TaskDefinitionKind)
+)
+
+// Service type metadata.
+var (
+	ServiceKind             = reflect.TypeOf(Service{}).Name()
+	ServiceGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceKind}.String()
+	ServiceKindAPIVersion   = ServiceKind + "." + SchemeGroupVersion.String()
+	ServiceGroupVersionKind = SchemeGroupVersion.WithKind(ServiceKind)
+)
+
+// CapacityProvider type metadata.
+var (
+	CapacityProviderKind             = reflect.TypeOf(CapacityProvider{}).Name()
+	CapacityProviderGroupKind        = schema.GroupKind{Group: Group, Kind: CapacityProviderKind}.String()
+	CapacityProviderKindAPIVersion   = CapacityProviderKind + "." + SchemeGroupVersion.String()
+	CapacityProviderGroupVersionKind = SchemeGroupVersion.WithKind(CapacityProviderKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Cluster{}, &ClusterList{})
+	SchemeBuilder.Register(&CapacityProvider{}, &CapacityProviderList{})
+	SchemeBuilder.Register(&TaskDefinition{}, &TaskDefinitionList{})
+	SchemeBuilder.Register(&Service{}, &ServiceList{})
+}
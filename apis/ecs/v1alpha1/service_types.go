@@ -0,0 +1,379 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Service states.
+const (
+	ServiceStatusActive   = "ACTIVE"
+	ServiceStatusDraining = "DRAINING"
+	ServiceStatusInactive = "INACTIVE"
+)
+
+// TypeRolledOut services have completed the rollout of their primary
+// deployment.
+const TypeRolledOut xpv1.ConditionType = "RolledOut"
+
+// Reasons a service has, or has not, rolled out.
+const (
+	ReasonRolloutCompleted  xpv1.ConditionReason = "RolloutCompleted"
+	ReasonRolloutInProgress xpv1.ConditionReason = "RolloutInProgress"
+	ReasonRolloutFailed     xpv1.ConditionReason = "RolloutFailed"
+)
+
+// DeploymentConfiguration controls how many tasks run during a deployment.
+type DeploymentConfiguration struct {
+	// The upper limit of running tasks during a deployment, as a percentage
+	// of the desired count.
+	// +optional
+	MaximumPercent *int64 `json:"maximumPercent,omitempty"`
+
+	// The lower limit of running and healthy tasks during a deployment, as a
+	// percentage of the desired count.
+	// +optional
+	MinimumHealthyPercent *int64 `json:"minimumHealthyPercent,omitempty"`
+
+	// The deployment circuit breaker, which fails deployments whose tasks
+	// don't reach a steady state.
+	// +optional
+	DeploymentCircuitBreaker *DeploymentCircuitBreaker `json:"deploymentCircuitBreaker,omitempty"`
+}
+
+// DeploymentCircuitBreaker fails deployments that can't reach a steady state
+// and optionally rolls them back.
+type DeploymentCircuitBreaker struct {
+	// Indicates whether the circuit breaker is enabled.
+	Enable bool `json:"enable"`
+
+	// Indicates whether a failed deployment is rolled back to the last
+	// deployment that completed successfully.
+	Rollback bool `json:"rollback"`
+}
+
+// Predefined metrics the tasks of a service are scaled on.
+const (
+	MetricTypeCPUUtilization        = "ECSServiceAverageCPUUtilization"
+	MetricTypeMemoryUtilization     = "ECSServiceAverageMemoryUtilization"
+	MetricTypeRequestCountPerTarget = "ALBRequestCountPerTarget"
+)
+
+// TargetTrackingPolicy scales the tasks of a service to keep a metric at a
+// target value.
+type TargetTrackingPolicy struct {
+	// The name of the scaling policy, unique per service.
+	PolicyName string `json:"policyName"`
+
+	// The metric the policy tracks.
+	// +kubebuilder:validation:Enum=ECSServiceAverageCPUUtilization;ECSServiceAverageMemoryUtilization;ALBRequestCountPerTarget
+	PredefinedMetricType string `json:"predefinedMetricType"`
+
+	// Identifies the target group of ALBRequestCountPerTarget, in the form
+	// app/<load-balancer-name>/<load-balancer-id>/targetgroup/<target-group-name>/<target-group-id>.
+	// +optional
+	ResourceLabel *string `json:"resourceLabel,omitempty"`
+
+	// The value the metric is kept at.
+	TargetValue float64 `json:"targetValue"`
+
+	// The time in seconds after a scale-in activity before another one can
+	// start.
+	// +optional
+	ScaleInCooldown *int64 `json:"scaleInCooldown,omitempty"`
+
+	// The time in seconds after a scale-out activity before another one can
+	// start.
+	// +optional
+	ScaleOutCooldown *int64 `json:"scaleOutCooldown,omitempty"`
+
+	// Indicates whether the policy only scales out.
+	// +optional
+	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+}
+
+// ServiceAutoScaling registers the desired count of a service as an
+// Application Auto Scaling target.
+type ServiceAutoScaling struct {
+	// The lower limit of the desired count.
+	// +kubebuilder:validation:Minimum=0
+	MinCapacity int64 `json:"minCapacity"`
+
+	// The upper limit of the desired count.
+	// +kubebuilder:validation:Minimum=0
+	MaxCapacity int64 `json:"maxCapacity"`
+
+	// The target tracking policies that scale the service. Policies of the
+	// scalable target that aren't listed are deleted.
+	// +optional
+	TargetTrackingPolicies []TargetTrackingPolicy `json:"targetTrackingPolicies,omitempty"`
+}
+
+// LoadBalancer registers a container of the service with a load balancer.
+type LoadBalancer struct {
+	// The ARN of the target group the tasks are registered with.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/elbv2/v1alpha1.TargetGroup
+	// +optional
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// TargetGroupARNRef is a reference to a TargetGroup used to set
+	// TargetGroupARN.
+	// +optional
+	TargetGroupARNRef *xpv1.Reference `json:"targetGroupArnRef,omitempty"`
+
+	// TargetGroupARNSelector selects a reference to a TargetGroup used to
+	// set TargetGroupARN.
+	// +optional
+	TargetGroupARNSelector *xpv1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// The name of the Classic Load Balancer the tasks are registered with.
+	// +optional
+	LoadBalancerName *string `json:"loadBalancerName,omitempty"`
+
+	// The name of the container that is registered.
+	ContainerName string `json:"containerName"`
+
+	// The port of the container that is registered.
+	ContainerPort int64 `json:"containerPort"`
+}
+
+// AWSVPCConfiguration configures the network of tasks that use the awsvpc
+// network mode.
+type AWSVPCConfiguration struct {
+	// The IDs of the subnets the tasks are launched into.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	// +crossplane:generate:reference:refFieldName=SubnetRefs
+	// +crossplane:generate:reference:selectorFieldName=SubnetSelector
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SubnetRefs is a list of references to Subnets used to set the
+	// Subnets.
+	// +optional
+	SubnetRefs []xpv1.Reference `json:"subnetRefs,omitempty"`
+
+	// SubnetSelector selects references to Subnets used to set the Subnets.
+	// +optional
+	SubnetSelector *xpv1.Selector `json:"subnetSelector,omitempty"`
+
+	// The IDs of the security groups of the tasks.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	// +crossplane:generate:reference:refFieldName=SecurityGroupRefs
+	// +crossplane:generate:reference:selectorFieldName=SecurityGroupSelector
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// SecurityGroupRefs is a list of references to SecurityGroups used to
+	// set the SecurityGroups.
+	// +optional
+	SecurityGroupRefs []xpv1.Reference `json:"securityGroupRefs,omitempty"`
+
+	// SecurityGroupSelector selects references to SecurityGroups used to
+	// set the SecurityGroups.
+	// +optional
+	SecurityGroupSelector *xpv1.Selector `json:"securityGroupSelector,omitempty"`
+
+	// Indicates whether the elastic network interfaces of the tasks get a
+	// public IP address.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	AssignPublicIP *string `json:"assignPublicIp,omitempty"`
+}
+
+// NetworkConfiguration is the network configuration of a service.
+type NetworkConfiguration struct {
+	// The VPC configuration of the tasks, required for the awsvpc network
+	// mode.
+	// +optional
+	AWSVPCConfiguration *AWSVPCConfiguration `json:"awsvpcConfiguration,omitempty"`
+}
+
+// ServiceParameters define the desired state of an ECS service.
+type ServiceParameters struct {
+	// Region is the region you'd like your Service to be created in.
+	Region string `json:"region"`
+
+	// The name or ARN of the cluster the service runs on. The default
+	// cluster is used when omitted.
+	// +crossplane:generate:reference:type=Cluster
+	// +immutable
+	// +optional
+	Cluster *string `json:"cluster,omitempty"`
+
+	// ClusterRef is a reference to a Cluster used to set Cluster.
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to a Cluster used to set Cluster.
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// The task definition the tasks of the service are started from, either
+	// a family, in which case the latest ACTIVE revision of the family is
+	// used, or the ARN of a specific revision.
+	// +crossplane:generate:reference:type=TaskDefinition
+	// +crossplane:generate:reference:extractor=TaskDefinitionFamily()
+	// +optional
+	TaskDefinition *string `json:"taskDefinition,omitempty"`
+
+	// TaskDefinitionRef is a reference to a TaskDefinition used to set
+	// TaskDefinition to its family.
+	// +optional
+	TaskDefinitionRef *xpv1.Reference `json:"taskDefinitionRef,omitempty"`
+
+	// TaskDefinitionSelector selects a reference to a TaskDefinition used
+	// to set TaskDefinition to its family.
+	// +optional
+	TaskDefinitionSelector *xpv1.Selector `json:"taskDefinitionSelector,omitempty"`
+
+	// The number of tasks the service keeps running.
+	// +optional
+	DesiredCount *int64 `json:"desiredCount,omitempty"`
+
+	// The launch type the tasks run on.
+	// +kubebuilder:validation:Enum=EC2;FARGATE;EXTERNAL
+	// +immutable
+	// +optional
+	LaunchType *string `json:"launchType,omitempty"`
+
+	// The Fargate platform version the tasks run on.
+	// +optional
+	PlatformVersion *string `json:"platformVersion,omitempty"`
+
+	// The scheduling strategy of the service.
+	// +kubebuilder:validation:Enum=REPLICA;DAEMON
+	// +immutable
+	// +optional
+	SchedulingStrategy *string `json:"schedulingStrategy,omitempty"`
+
+	// The deployment configuration of the service.
+	// +optional
+	DeploymentConfiguration *DeploymentConfiguration `json:"deploymentConfiguration,omitempty"`
+
+	// The Application Auto Scaling configuration of the service. Once it is
+	// configured, the desired count is only used to create the service and
+	// left to the scaling policies afterwards. The scalable target is left
+	// untouched when omitted, and deregistered when the service is deleted.
+	// +optional
+	AutoScaling *ServiceAutoScaling `json:"autoScaling,omitempty"`
+
+	// The load balancers the tasks are registered with.
+	// +optional
+	LoadBalancers []LoadBalancer `json:"loadBalancers,omitempty"`
+
+	// The network configuration of the service.
+	// +optional
+	NetworkConfiguration *NetworkConfiguration `json:"networkConfiguration,omitempty"`
+
+	// The time in seconds during which failing load balancer health checks
+	// of newly started tasks are ignored.
+	// +optional
+	HealthCheckGracePeriodSeconds *int64 `json:"healthCheckGracePeriodSeconds,omitempty"`
+
+	// Indicates whether ECS Exec is enabled for the tasks.
+	// +optional
+	EnableExecuteCommand *bool `json:"enableExecuteCommand,omitempty"`
+
+	// Indicates whether the tasks are tagged with ECS managed tags.
+	// +optional
+	EnableECSManagedTags *bool `json:"enableECSManagedTags,omitempty"`
+
+	// The resource the tags of the tasks are propagated from.
+	// +kubebuilder:validation:Enum=TASK_DEFINITION;SERVICE;NONE
+	// +optional
+	PropagateTags *string `json:"propagateTags,omitempty"`
+
+	// The tags of the service.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ServiceSpec defines the desired state of a Service.
+type ServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceParameters `json:"forProvider"`
+}
+
+// ServiceObservation keeps the state for the external resource
+type ServiceObservation struct {
+	// The Amazon Resource Name (ARN) of the service.
+	ServiceARN string `json:"serviceArn,omitempty"`
+
+	// The status of the service.
+	Status string `json:"status,omitempty"`
+
+	// The ARN of the task definition revision of the primary deployment.
+	TaskDefinition string `json:"taskDefinition,omitempty"`
+
+	// The number of tasks of the service in the RUNNING state.
+	RunningCount int64 `json:"runningCount,omitempty"`
+
+	// The number of tasks of the service in the PENDING state.
+	PendingCount int64 `json:"pendingCount,omitempty"`
+
+	// The rollout state of the primary deployment.
+	RolloutState string `json:"rolloutState,omitempty"`
+
+	// The reason of the rollout state of the primary deployment.
+	RolloutStateReason string `json:"rolloutStateReason,omitempty"`
+
+	// The desired count of the service.
+	DesiredCount int64 `json:"desiredCount,omitempty"`
+
+	// The ARNs of the scaling policies of the service.
+	ScalingPolicyARNs []string `json:"scalingPolicyArns,omitempty"`
+}
+
+// A ServiceStatus represents the observed state of a Service.
+type ServiceStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents an ECS service, which keeps
+// a number of tasks of a task definition running on a cluster. The external
+// name of a Service is the name of the ECS service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RUNNING",type="integer",JSONPath=".status.atProvider.runningCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Services
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
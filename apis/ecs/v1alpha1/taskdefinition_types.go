@@ -0,0 +1,402 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Task definition states.
+const (
+	TaskDefinitionStatusActive   = "ACTIVE"
+	TaskDefinitionStatusInactive = "INACTIVE"
+)
+
+// KeyValuePair is an environment variable of a container.
+type KeyValuePair struct {
+	// The name of the environment variable.
+	Name string `json:"name"`
+
+	// The value of the environment variable.
+	Value string `json:"value"`
+}
+
+// Secret exposes a secret from Secrets Manager or the SSM Parameter Store
+// to a container.
+type Secret struct {
+	// The name of the environment variable or log option the secret is
+	// exposed as.
+	Name string `json:"name"`
+
+	// The ARN of the secret or of the parameter.
+	ValueFrom string `json:"valueFrom"`
+}
+
+// PortMapping maps a container port to a host port.
+type PortMapping struct {
+	// The port the container listens on.
+	ContainerPort int64 `json:"containerPort"`
+
+	// The port on the host the container port is mapped to. In the awsvpc
+	// network mode it is either omitted or equal to the container port.
+	// +optional
+	HostPort *int64 `json:"hostPort,omitempty"`
+
+	// The protocol of the port mapping.
+	// +kubebuilder:validation:Enum=tcp;udp
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The name of the port mapping, used by Service Connect.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The application protocol of the port mapping, used by Service
+	// Connect.
+	// +kubebuilder:validation:Enum=http;http2;grpc
+	// +optional
+	AppProtocol *string `json:"appProtocol,omitempty"`
+}
+
+// LogConfiguration configures the log driver of a container.
+type LogConfiguration struct {
+	// The log driver of the container, for example awslogs.
+	LogDriver string `json:"logDriver"`
+
+	// The options passed to the log driver.
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+
+	// The secrets passed to the log driver.
+	// +optional
+	SecretOptions []Secret `json:"secretOptions,omitempty"`
+}
+
+// HealthCheck is the health check of a container.
+type HealthCheck struct {
+	// The command that is run to determine whether the container is
+	// healthy, for example ["CMD-SHELL", "curl -f http://localhost/"].
+	Command []string `json:"command"`
+
+	// The time in seconds between health checks.
+	// +optional
+	Interval *int64 `json:"interval,omitempty"`
+
+	// The number of consecutive failures after which the container is
+	// considered unhealthy.
+	// +optional
+	Retries *int64 `json:"retries,omitempty"`
+
+	// The grace period in seconds during which failed health checks don't
+	// count towards the retries.
+	// +optional
+	StartPeriod *int64 `json:"startPeriod,omitempty"`
+
+	// The time in seconds a health check may take before it is considered
+	// failed.
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty"`
+}
+
+// MountPoint mounts a volume of the task into a container.
+type MountPoint struct {
+	// The name of the volume.
+	SourceVolume string `json:"sourceVolume"`
+
+	// The path in the container the volume is mounted at.
+	ContainerPath string `json:"containerPath"`
+
+	// Indicates whether the volume is mounted read-only.
+	// +optional
+	ReadOnly *bool `json:"readOnly,omitempty"`
+}
+
+// ContainerDependency makes the start of a container depend on the state of
+// another container of the task.
+type ContainerDependency struct {
+	// The name of the container that is depended on.
+	ContainerName string `json:"containerName"`
+
+	// The state the container has to reach.
+	// +kubebuilder:validation:Enum=START;COMPLETE;SUCCESS;HEALTHY
+	Condition string `json:"condition"`
+}
+
+// ContainerDefinition defines a container of a task.
+type ContainerDefinition struct {
+	// The name of the container.
+	Name string `json:"name"`
+
+	// The image the container is started from.
+	Image string `json:"image"`
+
+	// The number of CPU units reserved for the container.
+	// +optional
+	CPU *int64 `json:"cpu,omitempty"`
+
+	// The hard limit in MiB of the memory of the container.
+	// +optional
+	Memory *int64 `json:"memory,omitempty"`
+
+	// The soft limit in MiB of the memory of the container.
+	// +optional
+	MemoryReservation *int64 `json:"memoryReservation,omitempty"`
+
+	// Indicates whether the task stops when the container stops. Defaults
+	// to true.
+	// +optional
+	Essential *bool `json:"essential,omitempty"`
+
+	// The command passed to the container.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// The entry point of the container.
+	// +optional
+	EntryPoint []string `json:"entryPoint,omitempty"`
+
+	// The working directory the command is run in.
+	// +optional
+	WorkingDirectory *string `json:"workingDirectory,omitempty"`
+
+	// The user the command is run as.
+	// +optional
+	User *string `json:"user,omitempty"`
+
+	// The environment variables of the container.
+	// +optional
+	Environment []KeyValuePair `json:"environment,omitempty"`
+
+	// The secrets exposed to the container as environment variables.
+	// +optional
+	Secrets []Secret `json:"secrets,omitempty"`
+
+	// The port mappings of the container.
+	// +optional
+	PortMappings []PortMapping `json:"portMappings,omitempty"`
+
+	// The log configuration of the container.
+	// +optional
+	LogConfiguration *LogConfiguration `json:"logConfiguration,omitempty"`
+
+	// The health check of the container.
+	// +optional
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+
+	// The volumes mounted into the container.
+	// +optional
+	MountPoints []MountPoint `json:"mountPoints,omitempty"`
+
+	// The containers this container depends on.
+	// +optional
+	DependsOn []ContainerDependency `json:"dependsOn,omitempty"`
+
+	// Indicates whether the root file system of the container is
+	// read-only.
+	// +optional
+	ReadonlyRootFilesystem *bool `json:"readonlyRootFilesystem,omitempty"`
+}
+
+// EFSVolumeConfiguration configures an EFS file system as a volume.
+type EFSVolumeConfiguration struct {
+	// The ID of the EFS file system.
+	FileSystemID string `json:"fileSystemId"`
+
+	// The directory of the file system that is mounted as the root of the
+	// volume.
+	// +optional
+	RootDirectory *string `json:"rootDirectory,omitempty"`
+
+	// Indicates whether the data is encrypted in transit.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	TransitEncryption *string `json:"transitEncryption,omitempty"`
+}
+
+// Volume is a volume of a task that its containers can mount.
+type Volume struct {
+	// The name of the volume.
+	Name string `json:"name"`
+
+	// The EFS file system backing the volume. When omitted, the volume is
+	// an ephemeral bind mount.
+	// +optional
+	EFSVolumeConfiguration *EFSVolumeConfiguration `json:"efsVolumeConfiguration,omitempty"`
+}
+
+// RuntimePlatform is the platform the task runs on.
+type RuntimePlatform struct {
+	// The CPU architecture of the task.
+	// +kubebuilder:validation:Enum=X86_64;ARM64
+	// +optional
+	CPUArchitecture *string `json:"cpuArchitecture,omitempty"`
+
+	// The operating system family of the task.
+	// +optional
+	OperatingSystemFamily *string `json:"operatingSystemFamily,omitempty"`
+}
+
+// EphemeralStorage is the ephemeral storage of a Fargate task.
+type EphemeralStorage struct {
+	// The size of the ephemeral storage in GiB.
+	// +kubebuilder:validation:Minimum=21
+	// +kubebuilder:validation:Maximum=200
+	SizeInGiB int64 `json:"sizeInGiB"`
+}
+
+// TaskDefinitionParameters define the desired state of an ECS task
+// definition. Task definition revisions are immutable, so a change of any
+// parameter other than the tags registers a new revision.
+type TaskDefinitionParameters struct {
+	// Region is the region you'd like your TaskDefinition to be created in.
+	Region string `json:"region"`
+
+	// The family of the task definition. Every revision of the task
+	// definition is registered in this family.
+	// +immutable
+	Family string `json:"family"`
+
+	// The containers of the task.
+	// +kubebuilder:validation:MinItems=1
+	ContainerDefinitions []ContainerDefinition `json:"containerDefinitions"`
+
+	// The number of CPU units of the task, required for Fargate.
+	// +optional
+	CPU *string `json:"cpu,omitempty"`
+
+	// The amount of memory in MiB of the task, required for Fargate.
+	// +optional
+	Memory *string `json:"memory,omitempty"`
+
+	// The network mode of the containers of the task. Fargate requires
+	// awsvpc.
+	// +kubebuilder:validation:Enum=bridge;host;awsvpc;none
+	// +optional
+	NetworkMode *string `json:"networkMode,omitempty"`
+
+	// The launch types the task definition is validated against.
+	// +optional
+	RequiresCompatibilities []string `json:"requiresCompatibilities,omitempty"`
+
+	// The ARN of the role the ECS agent uses to pull images and publish
+	// logs.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +optional
+	ExecutionRoleARN *string `json:"executionRoleArn,omitempty"`
+
+	// ExecutionRoleARNRef is a reference to a Role used to set
+	// ExecutionRoleARN.
+	// +optional
+	ExecutionRoleARNRef *xpv1.Reference `json:"executionRoleArnRef,omitempty"`
+
+	// ExecutionRoleARNSelector selects a reference to a Role used to set
+	// ExecutionRoleARN.
+	// +optional
+	ExecutionRoleARNSelector *xpv1.Selector `json:"executionRoleArnSelector,omitempty"`
+
+	// The ARN of the role the containers of the task assume.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +optional
+	TaskRoleARN *string `json:"taskRoleArn,omitempty"`
+
+	// TaskRoleARNRef is a reference to a Role used to set TaskRoleARN.
+	// +optional
+	TaskRoleARNRef *xpv1.Reference `json:"taskRoleArnRef,omitempty"`
+
+	// TaskRoleARNSelector selects a reference to a Role used to set
+	// TaskRoleARN.
+	// +optional
+	TaskRoleARNSelector *xpv1.Selector `json:"taskRoleArnSelector,omitempty"`
+
+	// The volumes of the task.
+	// +optional
+	Volumes []Volume `json:"volumes,omitempty"`
+
+	// The platform the task runs on.
+	// +optional
+	RuntimePlatform *RuntimePlatform `json:"runtimePlatform,omitempty"`
+
+	// The ephemeral storage of the task on Fargate.
+	// +optional
+	EphemeralStorage *EphemeralStorage `json:"ephemeralStorage,omitempty"`
+
+	// The tags of the task definition.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A TaskDefinitionSpec defines the desired state of a TaskDefinition.
+type TaskDefinitionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaskDefinitionParameters `json:"forProvider"`
+}
+
+// TaskDefinitionObservation keeps the state for the external resource
+type TaskDefinitionObservation struct {
+	// The Amazon Resource Name (ARN) of the current revision of the task
+	// definition.
+	TaskDefinitionARN string `json:"taskDefinitionArn,omitempty"`
+
+	// The current revision of the task definition.
+	Revision int64 `json:"revision,omitempty"`
+
+	// The status of the current revision.
+	Status string `json:"status,omitempty"`
+}
+
+// A TaskDefinitionStatus represents the observed state of a TaskDefinition.
+type TaskDefinitionStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            TaskDefinitionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TaskDefinition is a managed resource that represents an ECS task
+// definition family. The external name of a TaskDefinition is the ARN of its
+// current revision; previous revisions are deregistered once a new one has
+// been registered.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.family"
+// +kubebuilder:printcolumn:name="REVISION",type="integer",JSONPath=".status.atProvider.revision"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TaskDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaskDefinitionSpec   `json:"spec"`
+	Status TaskDefinitionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskDefinitionList contains a list of TaskDefinitions
+type TaskDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TaskDefinition `json:"items"`
+}
@@ -26,6 +26,7 @@ import (
 
 	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // ResolveReferences of this FileSystem
@@ -38,7 +39,7 @@ func (mg *FileSystem) ResolveReferences(ctx context.Context, c client.Reader) er
 		Reference:    mg.Spec.ForProvider.KMSKeyIDRef,
 		Selector:     mg.Spec.ForProvider.KMSKeyIDSelector,
 		To:           reference.To{Managed: &kms.Key{}, List: &kms.KeyList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      awsv1beta1.CrossAccount(mg, reference.ExternalName(), kms.KMSKeyARN()),
 	})
 	if err != nil {
 		return errors.Wrap(err, "spec.forProvider.kmsKeyId")
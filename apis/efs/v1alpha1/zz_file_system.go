@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -60,6 +61,7 @@ type FileSystemParameters struct {
 	// can scale to higher levels of aggregate throughput and operations per second
 	// with a tradeoff of slightly higher latencies for most file operations. The
 	// performance mode can't be changed after the file system has been created.
+	// +kubebuilder:validation:Enum=generalPurpose;maxIO
 	PerformanceMode *string `json:"performanceMode,omitempty"`
 	// A value that specifies to create one or more tags associated with the file
 	// system. Each tag is a user-defined key-value pair. Name your file system
@@ -73,6 +75,7 @@ type FileSystemParameters struct {
 	// 24 hours since the last decrease or throughput mode change. For more, see
 	// Specifying Throughput with Provisioned Mode (https://docs.aws.amazon.com/efs/latest/ug/performance.html#provisioned-throughput)
 	// in the Amazon EFS User Guide.
+	// +kubebuilder:validation:Enum=bursting;provisioned;elastic
 	ThroughputMode             *string `json:"throughputMode,omitempty"`
 	CustomFileSystemParameters `json:",inline"`
 }
@@ -120,8 +123,9 @@ type FileSystemObservation struct {
 
 // FileSystemStatus defines the observed state of FileSystem.
 type FileSystemStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          FileSystemObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            FileSystemObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
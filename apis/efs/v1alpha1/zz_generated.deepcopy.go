@@ -472,6 +472,7 @@ func (in *FileSystemSpec) DeepCopy() *FileSystemSpec {
 func (in *FileSystemStatus) DeepCopyInto(out *FileSystemStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -711,6 +712,7 @@ func (in *MountTargetSpec) DeepCopy() *MountTargetSpec {
 func (in *MountTargetStatus) DeepCopyInto(out *MountTargetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
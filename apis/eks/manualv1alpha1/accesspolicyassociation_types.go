@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AccessScope describes the scope of an access policy association.
+type AccessScope struct {
+	// The scope type of the access policy. With cluster, the permissions
+	// apply to the whole cluster, with namespace only to the given namespaces.
+	// +kubebuilder:validation:Enum=cluster;namespace
+	Type string `json:"type"`
+
+	// The Kubernetes namespaces that the permissions apply to when the type
+	// is namespace. Wildcards such as dev-* are supported.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// AccessPolicyAssociationParameters define the desired state of an AWS
+// Elastic Kubernetes Service access policy association.
+type AccessPolicyAssociationParameters struct {
+	// Region is the region of the cluster.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the cluster of the access entry.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/eks/v1beta1.Cluster
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set
+	// the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used
+	// to set the ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// The ARN of the IAM principal of the access entry to associate the
+	// access policy with.
+	// +immutable
+	// +crossplane:generate:reference:type=AccessEntry
+	PrincipalARN string `json:"principalArn,omitempty"`
+
+	// PrincipalARNRef is a reference to an AccessEntry used to set
+	// the PrincipalARN.
+	// +immutable
+	// +optional
+	PrincipalARNRef *xpv1.Reference `json:"principalArnRef,omitempty"`
+
+	// PrincipalARNSelector selects references to an AccessEntry used
+	// to set the PrincipalARN.
+	// +optional
+	PrincipalARNSelector *xpv1.Selector `json:"principalArnSelector,omitempty"`
+
+	// The ARN of the access policy to associate, e.g.
+	// arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy.
+	// +immutable
+	PolicyARN string `json:"policyArn"`
+
+	// The scope of the access policy. Changing it updates the association in
+	// place.
+	AccessScope AccessScope `json:"accessScope"`
+}
+
+// AccessPolicyAssociationObservation is the observed state of an access policy
+// association.
+type AccessPolicyAssociationObservation struct {
+	// The date and time the access policy was associated.
+	AssociatedAt *metav1.Time `json:"associatedAt,omitempty"`
+
+	// The date and time the association was last modified.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// An AccessPolicyAssociationSpec defines the desired state of an EKS access
+// policy association.
+type AccessPolicyAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPolicyAssociationParameters `json:"forProvider"`
+}
+
+// An AccessPolicyAssociationStatus represents the observed state of an EKS
+// access policy association.
+type AccessPolicyAssociationStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            AccessPolicyAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPolicyAssociation is a managed resource that associates an AWS
+// Elastic Kubernetes Service access policy with an AccessEntry.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="POLICY",type="string",JSONPath=".spec.forProvider.policyArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPolicyAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPolicyAssociationSpec   `json:"spec"`
+	Status AccessPolicyAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPolicyAssociationList contains a list of AccessPolicyAssociation items
+type AccessPolicyAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPolicyAssociation `json:"items"`
+}
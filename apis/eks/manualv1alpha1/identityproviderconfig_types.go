@@ -18,6 +18,7 @@ package manualv1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -138,6 +139,14 @@ type IdentityProviderConfigObservation struct {
 	Status IdentityProviderConfigStatusType `json:"status,omitempty"`
 
 	IdentityProviderConfigArn string `json:"identityProviderConfigArn,omitempty"`
+
+	// The ID of the cluster update that associated the identity provider
+	// config with the cluster.
+	AssociationUpdateID string `json:"associationUpdateId,omitempty"`
+
+	// The status of the cluster update that associated the identity provider
+	// config with the cluster, e.g. InProgress, Failed or Successful.
+	AssociationUpdateStatus string `json:"associationUpdateStatus,omitempty"`
 }
 
 // A IdentityProviderConfigSpec defines the desired state of an EKS identity provider.
@@ -148,8 +157,9 @@ type IdentityProviderConfigSpec struct {
 
 // An IdentityProviderConfigStatus represents the observed state of an EKS associated identity provider.
 type IdentityProviderConfigStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          IdentityProviderConfigObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            IdentityProviderConfigObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // NodeGroupStatusType is a type of NodeGroup status.
@@ -56,6 +58,7 @@ type NodeGroupParameters struct {
 	//
 	// +immutable
 	// +optional
+	// +kubebuilder:validation:Enum=AL2_x86_64;AL2_x86_64_GPU;AL2_ARM_64;CUSTOM;BOTTLEROCKET_ARM_64;BOTTLEROCKET_x86_64;BOTTLEROCKET_ARM_64_NVIDIA;BOTTLEROCKET_x86_64_NVIDIA;WINDOWS_CORE_2019_x86_64;WINDOWS_FULL_2019_x86_64;WINDOWS_CORE_2022_x86_64;WINDOWS_FULL_2022_x86_64
 	AMIType *string `json:"amiType,omitempty"`
 
 	// The name of the cluster to create the node group in.
@@ -134,8 +137,11 @@ type NodeGroupParameters struct {
 	// By default, the latest available AMI version for the node group's current
 	// Kubernetes version is used. For more information, see Amazon EKS-Optimized
 	// Linux AMI Versions (https://docs.aws.amazon.com/eks/latest/userguide/eks-linux-ami-versions.html)
-	// in the Amazon EKS User Guide.
-	// +immutable
+	// in the Amazon EKS User Guide. Changing it rolls the nodes to the given AMI
+	// release version, which has to match the Kubernetes version of the node
+	// group. The release version in use is reported in the status. An Amazon
+	// Linux release version that was built for another Kubernetes version than
+	// the desired one is ignored.
 	// +optional
 	ReleaseVersion *string `json:"releaseVersion,omitempty"`
 
@@ -239,13 +245,13 @@ type LaunchTemplateSpecification struct {
 	NameSelector *xpv1.Selector `json:"nameSelector,omitempty"`
 
 	// The version of the launch template to use. If no version is specified, then the
-	// template's default version is used.
+	// template's default version is used. Changing it rolls the nodes to the
+	// new launch template version.
 	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1alpha1.LaunchTemplateVersion
 	Version *string `json:"version,omitempty"`
 
 	// VersionRef is a reference to a LaunchTemplateVersion used to set
 	// the Version.
-	// +immutable
 	// +optional
 	VersionRef *xpv1.Reference `json:"versionRef,omitempty"`
 
@@ -328,6 +334,9 @@ type NodeGroupObservation struct {
 	// The Amazon Resource Name (ARN) associated with the managed node group.
 	NodeGroupArn string `json:"nodeGroupArn,omitempty"`
 
+	// The AMI release version of the node group.
+	ReleaseVersion string `json:"releaseVersion,omitempty"`
+
 	// The resources associated with the node group, such as Auto Scaling groups
 	// and security groups for remote access.
 	Resources NodeGroupResources `json:"resources,omitempty"`
@@ -434,8 +443,9 @@ type NodeGroupSpec struct {
 
 // A NodeGroupStatus represents the observed state of an EKS NodeGroup.
 type NodeGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          NodeGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            NodeGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
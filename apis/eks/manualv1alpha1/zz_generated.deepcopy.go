@@ -26,6 +26,353 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntry) DeepCopyInto(out *AccessEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntry.
+func (in *AccessEntry) DeepCopy() *AccessEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryList) DeepCopyInto(out *AccessEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryList.
+func (in *AccessEntryList) DeepCopy() *AccessEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryObservation) DeepCopyInto(out *AccessEntryObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryObservation.
+func (in *AccessEntryObservation) DeepCopy() *AccessEntryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryParameters) DeepCopyInto(out *AccessEntryParameters) {
+	*out = *in
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterNameSelector != nil {
+		in, out := &in.ClusterNameSelector, &out.ClusterNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrincipalARNRef != nil {
+		in, out := &in.PrincipalARNRef, &out.PrincipalARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PrincipalARNSelector != nil {
+		in, out := &in.PrincipalARNSelector, &out.PrincipalARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesGroups != nil {
+		in, out := &in.KubernetesGroups, &out.KubernetesGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryParameters.
+func (in *AccessEntryParameters) DeepCopy() *AccessEntryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntrySpec) DeepCopyInto(out *AccessEntrySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntrySpec.
+func (in *AccessEntrySpec) DeepCopy() *AccessEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryStatus) DeepCopyInto(out *AccessEntryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryStatus.
+func (in *AccessEntryStatus) DeepCopy() *AccessEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociation) DeepCopyInto(out *AccessPolicyAssociation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociation.
+func (in *AccessPolicyAssociation) DeepCopy() *AccessPolicyAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPolicyAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationList) DeepCopyInto(out *AccessPolicyAssociationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessPolicyAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationList.
+func (in *AccessPolicyAssociationList) DeepCopy() *AccessPolicyAssociationList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPolicyAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationObservation) DeepCopyInto(out *AccessPolicyAssociationObservation) {
+	*out = *in
+	if in.AssociatedAt != nil {
+		in, out := &in.AssociatedAt, &out.AssociatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationObservation.
+func (in *AccessPolicyAssociationObservation) DeepCopy() *AccessPolicyAssociationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationParameters) DeepCopyInto(out *AccessPolicyAssociationParameters) {
+	*out = *in
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterNameSelector != nil {
+		in, out := &in.ClusterNameSelector, &out.ClusterNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrincipalARNRef != nil {
+		in, out := &in.PrincipalARNRef, &out.PrincipalARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PrincipalARNSelector != nil {
+		in, out := &in.PrincipalARNSelector, &out.PrincipalARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.AccessScope.DeepCopyInto(&out.AccessScope)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationParameters.
+func (in *AccessPolicyAssociationParameters) DeepCopy() *AccessPolicyAssociationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationSpec) DeepCopyInto(out *AccessPolicyAssociationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationSpec.
+func (in *AccessPolicyAssociationSpec) DeepCopy() *AccessPolicyAssociationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyAssociationStatus) DeepCopyInto(out *AccessPolicyAssociationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyAssociationStatus.
+func (in *AccessPolicyAssociationStatus) DeepCopy() *AccessPolicyAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScope) DeepCopyInto(out *AccessScope) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScope.
+func (in *AccessScope) DeepCopy() *AccessScope {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoScalingGroup) DeepCopyInto(out *AutoScalingGroup) {
 	*out = *in
@@ -231,6 +578,7 @@ func (in *FargateProfileSpec) DeepCopy() *FargateProfileSpec {
 func (in *FargateProfileStatus) DeepCopyInto(out *FargateProfileStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -376,6 +724,7 @@ func (in *IdentityProviderConfigSpec) DeepCopy() *IdentityProviderConfigSpec {
 func (in *IdentityProviderConfigStatus) DeepCopyInto(out *IdentityProviderConfigStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -773,6 +1122,7 @@ func (in *NodeGroupSpec) DeepCopy() *NodeGroupSpec {
 func (in *NodeGroupStatus) DeepCopyInto(out *NodeGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -29,6 +29,90 @@ import (
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this AccessEntry.
+func (mg *AccessEntry) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ClusterName,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.ClusterNameRef,
+		Selector:     mg.Spec.ForProvider.ClusterNameSelector,
+		To: reference.To{
+			List:    &v1beta1.ClusterList{},
+			Managed: &v1beta1.Cluster{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ClusterName")
+	}
+	mg.Spec.ForProvider.ClusterName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ClusterNameRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrincipalARN,
+		Extract:      v1beta11.RoleARN(),
+		Reference:    mg.Spec.ForProvider.PrincipalARNRef,
+		Selector:     mg.Spec.ForProvider.PrincipalARNSelector,
+		To: reference.To{
+			List:    &v1beta11.RoleList{},
+			Managed: &v1beta11.Role{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.PrincipalARN")
+	}
+	mg.Spec.ForProvider.PrincipalARN = rsp.ResolvedValue
+	mg.Spec.ForProvider.PrincipalARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this AccessPolicyAssociation.
+func (mg *AccessPolicyAssociation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ClusterName,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.ClusterNameRef,
+		Selector:     mg.Spec.ForProvider.ClusterNameSelector,
+		To: reference.To{
+			List:    &v1beta1.ClusterList{},
+			Managed: &v1beta1.Cluster{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ClusterName")
+	}
+	mg.Spec.ForProvider.ClusterName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ClusterNameRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrincipalARN,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.PrincipalARNRef,
+		Selector:     mg.Spec.ForProvider.PrincipalARNSelector,
+		To: reference.To{
+			List:    &AccessEntryList{},
+			Managed: &AccessEntry{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.PrincipalARN")
+	}
+	mg.Spec.ForProvider.PrincipalARN = rsp.ResolvedValue
+	mg.Spec.ForProvider.PrincipalARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this IdentityProviderConfig.
 func (mg *IdentityProviderConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -41,4 +41,32 @@ type CustomAddonParameters struct {
 	// +immutable
 	// +optional
 	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of an existing IAM role to bind to the
+	// add-on's service account. The role must be assigned the IAM permissions
+	// required by the add-on. If you don't specify an existing IAM role, then
+	// the add-on uses the permissions assigned to the node IAM role.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +crossplane:generate:reference:refFieldName=ServiceAccountRoleARNRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceAccountRoleARNSelector
+	// +optional
+	ServiceAccountRoleARN *string `json:"serviceAccountRoleARN,omitempty"`
+
+	// ServiceAccountRoleARNRef is a reference to an IAM Role used to set
+	// the ServiceAccountRoleARN.
+	// +optional
+	ServiceAccountRoleARNRef *xpv1.Reference `json:"serviceAccountRoleARNRef,omitempty"`
+
+	// ServiceAccountRoleARNSelector selects references to an IAM Role used
+	// to set the ServiceAccountRoleARN.
+	// +optional
+	ServiceAccountRoleARNSelector *xpv1.Selector `json:"serviceAccountRoleARNSelector,omitempty"`
+
+	// The set of configuration values for the add-on. The values must be a
+	// JSON or YAML string that conforms to the schema returned by
+	// DescribeAddonConfiguration. Values are compared semantically, so
+	// reformatting them does not trigger an update.
+	// +optional
+	ConfigurationValues *string `json:"configurationValues,omitempty"`
 }
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -38,19 +39,8 @@ type AddonParameters struct {
 	AddonVersion *string `json:"addonVersion,omitempty"`
 	// How to resolve parameter value conflicts when migrating an existing add-on
 	// to an Amazon EKS add-on.
+	// +kubebuilder:validation:Enum=OVERWRITE;NONE;PRESERVE
 	ResolveConflicts *string `json:"resolveConflicts,omitempty"`
-	// The Amazon Resource Name (ARN) of an existing IAM role to bind to the add-on's
-	// service account. The role must be assigned the IAM permissions required by
-	// the add-on. If you don't specify an existing IAM role, then the add-on uses
-	// the permissions assigned to the node IAM role. For more information, see
-	// Amazon EKS node IAM role (https://docs.aws.amazon.com/eks/latest/userguide/create-node-role.html)
-	// in the Amazon EKS User Guide.
-	//
-	// To specify an existing IAM role, you must have an IAM OpenID Connect (OIDC)
-	// provider created for your cluster. For more information, see Enabling IAM
-	// roles for service accounts on your cluster (https://docs.aws.amazon.com/eks/latest/userguide/enable-iam-roles-for-service-accounts.html)
-	// in the Amazon EKS User Guide.
-	ServiceAccountRoleARN *string `json:"serviceAccountRoleARN,omitempty"`
 	// The metadata to apply to the cluster to assist with categorization and organization.
 	// Each tag consists of a key and an optional value, both of which you define.
 	Tags                  map[string]*string `json:"tags,omitempty"`
@@ -81,8 +71,9 @@ type AddonObservation struct {
 
 // AddonStatus defines the observed state of Addon.
 type AddonStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AddonObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            AddonObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -233,11 +233,6 @@ func (in *AddonParameters) DeepCopyInto(out *AddonParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ServiceAccountRoleARN != nil {
-		in, out := &in.ServiceAccountRoleARN, &out.ServiceAccountRoleARN
-		*out = new(string)
-		**out = **in
-	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]*string, len(*in))
@@ -287,6 +282,7 @@ func (in *AddonSpec) DeepCopy() *AddonSpec {
 func (in *AddonStatus) DeepCopyInto(out *AddonStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -493,6 +489,26 @@ func (in *CustomAddonParameters) DeepCopyInto(out *CustomAddonParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountRoleARN != nil {
+		in, out := &in.ServiceAccountRoleARN, &out.ServiceAccountRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountRoleARNRef != nil {
+		in, out := &in.ServiceAccountRoleARNRef, &out.ServiceAccountRoleARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServiceAccountRoleARNSelector != nil {
+		in, out := &in.ServiceAccountRoleARNSelector, &out.ServiceAccountRoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigurationValues != nil {
+		in, out := &in.ConfigurationValues, &out.ConfigurationValues
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAddonParameters.
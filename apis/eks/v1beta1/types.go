@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // ClusterStatusType is the status of an EKS cluster.
@@ -35,6 +37,7 @@ const (
 )
 
 // LogType is a type of logging.
+// +kubebuilder:validation:Enum=api;audit;authenticator;controllerManager;scheduler
 type LogType string
 
 // Log types.
@@ -57,8 +60,8 @@ type ClusterParameters struct {
 	// +optional
 	Region *string `json:"region,omitempty"`
 
-	// The encryption configuration for the cluster.
-	// +immutable
+	// The encryption configuration for the cluster. Encryption can be enabled
+	// for an existing cluster, but it cannot be changed or disabled afterwards.
 	// +optional
 	EncryptionConfig []EncryptionConfig `json:"encryptionConfig,omitempty"`
 
@@ -71,9 +74,23 @@ type ClusterParameters struct {
 	// CloudWatch Logs ingestion, archive storage, and data scanning rates apply
 	// to exported control plane logs. For more information, see Amazon CloudWatch
 	// Pricing (http://aws.amazon.com/cloudwatch/pricing/).
+	//
+	// Log types that are not enabled are disabled when the logging is updated.
 	// +optional
 	Logging *Logging `json:"logging,omitempty"`
 
+	// Kubeconfig configures the kubeconfig written to the connection secret
+	// of the cluster.
+	// +optional
+	Kubeconfig *KubeconfigConfig `json:"kubeconfig,omitempty"`
+
+	// OpenIDConnectProvider configures an IAM OpenID Connect provider for the
+	// OIDC issuer of the cluster, which is required to use IAM roles for
+	// service accounts. The provider is created once the cluster is active and
+	// is deleted together with the cluster.
+	// +optional
+	OpenIDConnectProvider *OpenIDConnectProviderConfig `json:"openIDConnectProvider,omitempty"`
+
 	// The VPC configuration used by the cluster control plane. Amazon EKS VPC resources
 	// have specific requirements to work properly with Kubernetes. For more information,
 	// see Cluster VPC Considerations (https://docs.aws.amazon.com/eks/latest/userguide/network_reqs.html)
@@ -119,6 +136,42 @@ type ClusterParameters struct {
 	Version *string `json:"version,omitempty"`
 }
 
+// OpenIDConnectProviderConfig is the configuration of the IAM OpenID Connect
+// provider of a cluster.
+type OpenIDConnectProviderConfig struct {
+	// The client IDs (audiences) that can use the provider. Defaults to
+	// sts.amazonaws.com, the audience of service account tokens exchanged
+	// for IAM role credentials.
+	// +kubebuilder:default={"sts.amazonaws.com"}
+	// +optional
+	ClientIDList []string `json:"clientIDList,omitempty"`
+}
+
+// Ways a kubeconfig can authenticate to a cluster.
+const (
+	KubeconfigAuthenticationToken = "Token"
+	KubeconfigAuthenticationExec  = "Exec"
+)
+
+// KubeconfigConfig is the configuration of the kubeconfig written to the
+// connection secret of a cluster.
+type KubeconfigConfig struct {
+	// Authentication determines how the kubeconfig authenticates to the
+	// cluster. Token embeds a token that expires after 15 minutes, which the
+	// controller refreshes at least every 10 minutes. Exec runs
+	// `aws eks get-token` whenever a token is needed instead, which requires
+	// the AWS CLI and credentials wherever the kubeconfig is used.
+	// +kubebuilder:validation:Enum=Token;Exec
+	// +kubebuilder:default=Token
+	// +optional
+	Authentication string `json:"authentication,omitempty"`
+
+	// RoleARN is the ARN of the IAM role `aws eks get-token` assumes to get a
+	// token. Only used when authentication is Exec.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+}
+
 // EncryptionConfig is the encryption configuration for a cluster.
 type EncryptionConfig struct {
 
@@ -278,6 +331,10 @@ type Identity struct {
 type OIDC struct {
 	// The issuer URL for the OpenID Connect identity provider.
 	Issuer string `json:"issuer,omitempty"`
+
+	// The ARN of the IAM OpenID Connect provider of the issuer, if it is
+	// managed as part of the cluster.
+	OpenIDConnectProviderArn string `json:"openIDConnectProviderArn,omitempty"`
 }
 
 // VpcConfigResponse is the observed VPC configuration for a cluster.
@@ -299,8 +356,9 @@ type ClusterSpec struct {
 
 // A ClusterStatus represents the observed state of an EKS Cluster.
 type ClusterStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
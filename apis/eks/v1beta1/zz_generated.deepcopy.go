@@ -126,6 +126,16 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(Logging)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(KubeconfigConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenIDConnectProvider != nil {
+		in, out := &in.OpenIDConnectProvider, &out.OpenIDConnectProvider
+		*out = new(OpenIDConnectProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ResourcesVpcConfig.DeepCopyInto(&out.ResourcesVpcConfig)
 	if in.RoleArnRef != nil {
 		in, out := &in.RoleArnRef, &out.RoleArnRef
@@ -182,6 +192,7 @@ func (in *ClusterSpec) DeepCopy() *ClusterSpec {
 func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -406,6 +417,7 @@ func (in *FargateProfileSpec) DeepCopy() *FargateProfileSpec {
 func (in *FargateProfileStatus) DeepCopyInto(out *FargateProfileStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -435,6 +447,26 @@ func (in *Identity) DeepCopy() *Identity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigConfig) DeepCopyInto(out *KubeconfigConfig) {
+	*out = *in
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigConfig.
+func (in *KubeconfigConfig) DeepCopy() *KubeconfigConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogSetup) DeepCopyInto(out *LogSetup) {
 	*out = *in
@@ -497,6 +529,26 @@ func (in *OIDC) DeepCopy() *OIDC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDConnectProviderConfig) DeepCopyInto(out *OpenIDConnectProviderConfig) {
+	*out = *in
+	if in.ClientIDList != nil {
+		in, out := &in.ClientIDList, &out.ClientIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderConfig.
+func (in *OpenIDConnectProviderConfig) DeepCopy() *OpenIDConnectProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDConnectProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
@@ -476,6 +476,7 @@ func (in *CacheParameterGroupSpec) DeepCopy() *CacheParameterGroupSpec {
 func (in *CacheParameterGroupStatus) DeepCopyInto(out *CacheParameterGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
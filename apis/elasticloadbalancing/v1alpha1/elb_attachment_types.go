@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -58,8 +59,9 @@ type ELBAttachmentObservation struct {
 
 // An ELBAttachmentStatus represents the observed state of an ELBAttachmentAttachment.
 type ELBAttachmentStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ELBAttachmentObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ELBAttachmentObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -23,10 +23,34 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 )
 
+// ELBDNSName returns the DNS name of an ELB.
+func ELBDNSName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*ELB)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.DNSName
+	}
+}
+
+// ELBCanonicalHostedZoneNameID returns the ID of the Route 53 hosted zone of
+// an ELB.
+func ELBCanonicalHostedZoneNameID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*ELB)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.CanonicalHostedZoneNameID
+	}
+}
+
 // ResolveReferences of this ELB
 func (mg *ELB) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -193,6 +193,7 @@ func (in *ELBAttachmentSpec) DeepCopy() *ELBAttachmentSpec {
 func (in *ELBAttachmentStatus) DeepCopyInto(out *ELBAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -355,6 +356,7 @@ func (in *ELBSpec) DeepCopy() *ELBSpec {
 func (in *ELBStatus) DeepCopyInto(out *ELBStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
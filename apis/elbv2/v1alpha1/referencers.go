@@ -18,12 +18,36 @@ import (
 	"fmt"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 )
 
+// LoadBalancerDNSName returns the DNS name of a LoadBalancer.
+func LoadBalancerDNSName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LoadBalancer)
+		if !ok || len(r.Status.AtProvider.LoadBalancers) == 0 {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.LoadBalancers[0].DNSName)
+	}
+}
+
+// LoadBalancerCanonicalHostedZoneID returns the ID of the Route 53 hosted
+// zone of a LoadBalancer.
+func LoadBalancerCanonicalHostedZoneID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LoadBalancer)
+		if !ok || len(r.Status.AtProvider.LoadBalancers) == 0 {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.LoadBalancers[0].CanonicalHostedZoneID)
+	}
+}
+
 // ResolveReferences resolves references for Listeners
 func (mg *Listener) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -756,6 +756,7 @@ func (in *ListenerSpec) DeepCopy() *ListenerSpec {
 func (in *ListenerStatus) DeepCopyInto(out *ListenerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1088,6 +1089,7 @@ func (in *LoadBalancerState) DeepCopy() *LoadBalancerState {
 func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1627,6 +1629,7 @@ func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 func (in *TargetGroupStatus) DeepCopyInto(out *TargetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -57,6 +58,7 @@ type ListenerParameters struct {
 	// Balancers, the supported protocols are TCP, TLS, UDP, and TCP_UDP. You can’t
 	// specify the UDP or TCP_UDP protocol if dual-stack mode is enabled. You cannot
 	// specify a protocol for a Gateway Load Balancer.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP;GENEVE
 	Protocol *string `json:"protocol,omitempty"`
 	// [HTTPS and TLS listeners] The security policy that defines which protocols
 	// and ciphers are supported.
@@ -84,8 +86,9 @@ type ListenerObservation struct {
 
 // ListenerStatus defines the observed state of Listener.
 type ListenerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ListenerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ListenerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -35,6 +36,7 @@ type LoadBalancerParameters struct {
 	// The type of IP addresses used by the subnets for your load balancer. The
 	// possible values are ipv4 (for IPv4 addresses) and dualstack (for IPv4 and
 	// IPv6 addresses). Internal load balancers must use ipv4.
+	// +kubebuilder:validation:Enum=ipv4;dualstack
 	IPAddressType *string `json:"ipAddressType,omitempty"`
 	// The name of the load balancer.
 	//
@@ -56,6 +58,7 @@ type LoadBalancerParameters struct {
 	// The default is an Internet-facing load balancer.
 	//
 	// You cannot specify a scheme for a Gateway Load Balancer.
+	// +kubebuilder:validation:Enum=internet-facing;internal
 	Scheme *string `json:"scheme,omitempty"`
 	// [Application Load Balancers] The IDs of the security groups for the load
 	// balancer.
@@ -117,8 +120,9 @@ type LoadBalancerObservation struct {
 
 // LoadBalancerStatus defines the observed state of LoadBalancer.
 type LoadBalancerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          LoadBalancerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            LoadBalancerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
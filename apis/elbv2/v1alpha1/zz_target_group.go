@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -56,6 +57,7 @@ type TargetGroupParameters struct {
 	// and Gateway Load Balancers, the default is TCP. The TCP protocol is not supported
 	// for health checks if the protocol of the target group is HTTP or HTTPS. The
 	// GENEVE, TLS, UDP, and TCP_UDP protocols are not supported for health checks.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP;GENEVE
 	HealthCheckProtocol *string `json:"healthCheckProtocol,omitempty"`
 	// The amount of time, in seconds, during which no response from a target means
 	// a failed health check. For target groups with a protocol of HTTP, HTTPS,
@@ -90,6 +92,7 @@ type TargetGroupParameters struct {
 	// the supported protocol is GENEVE. A TCP_UDP listener must be associated with
 	// a TCP_UDP target group. If the target is a Lambda function, this parameter
 	// does not apply.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP;GENEVE
 	Protocol *string `json:"protocol,omitempty"`
 	// [HTTP/HTTPS protocol] The protocol version. Specify GRPC to send requests
 	// to targets using gRPC. Specify HTTP2 to send requests to targets using HTTP/2.
@@ -110,6 +113,7 @@ type TargetGroupParameters struct {
 	//    addresses.
 	//
 	//    * lambda - Register a single Lambda function as a target.
+	// +kubebuilder:validation:Enum=instance;ip;lambda;alb
 	TargetType *string `json:"targetType,omitempty"`
 	// The number of consecutive health check failures required before considering
 	// a target unhealthy. If the target group protocol is HTTP or HTTPS, the default
@@ -137,8 +141,9 @@ type TargetGroupObservation struct {
 
 // TargetGroupStatus defines the observed state of TargetGroup.
 type TargetGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TargetGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            TargetGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
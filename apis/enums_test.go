@@ -0,0 +1,424 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis
+
+import (
+	"encoding/json"
+	"go/build"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	awsapigatewayv2 "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	awscloudfront "github.com/aws/aws-sdk-go/service/cloudfront"
+	awsdynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	awsefs "github.com/aws/aws-sdk-go/service/efs"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awselbv2 "github.com/aws/aws-sdk-go/service/elbv2"
+	awsglue "github.com/aws/aws-sdk-go/service/glue"
+	awskafka "github.com/aws/aws-sdk-go/service/kafka"
+	awskms "github.com/aws/aws-sdk-go/service/kms"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	awsmq "github.com/aws/aws-sdk-go/service/mq"
+	awsroute53resolver "github.com/aws/aws-sdk-go/service/route53resolver"
+	awstransfer "github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/google/go-cmp/cmp"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const crdDir = "../package/crds"
+
+var code = regexp.MustCompile(`<code>([^<]+)</code>`)
+
+// enumValues converts the result of the Values method of an SDK enum type,
+// which is a slice of a string kind, to a slice of strings.
+func enumValues(vals interface{}) []string {
+	v := reflect.ValueOf(vals)
+	out := make([]string, v.Len())
+	for i := range out {
+		out[i] = v.Index(i).String()
+	}
+	return out
+}
+
+// schemaAt returns the schema of the property at the supplied dot separated
+// path. Array items are traversed transparently.
+func schemaAt(s extv1.JSONSchemaProps, path string) (extv1.JSONSchemaProps, bool) {
+	for _, p := range strings.Split(path, ".") {
+		if s.Type == "array" && s.Items != nil && s.Items.Schema != nil {
+			s = *s.Items.Schema
+		}
+		next, ok := s.Properties[p]
+		if !ok {
+			return extv1.JSONSchemaProps{}, false
+		}
+		s = next
+	}
+	if s.Type == "array" && s.Items != nil && s.Items.Schema != nil {
+		s = *s.Items.Schema
+	}
+	return s, true
+}
+
+// documentedValues returns the valid values that the documentation of the AWS
+// API model of the supplied service lists for a member it declares as a plain
+// string, such as CreateDBInstanceMessage$Engine.
+func documentedValues(t *testing.T, service, member string) []string {
+	t.Helper()
+	pkg, err := build.Import("github.com/aws/aws-sdk-go/aws", ".", build.FindOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(pkg.Dir), "models", "apis", service, "*", "docs-2.json"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no API model documentation for %s", service)
+	}
+	sort.Strings(files)
+	b, err := os.ReadFile(files[len(files)-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs := struct {
+		Shapes map[string]struct {
+			Refs map[string]string `json:"refs"`
+		} `json:"shapes"`
+	}{}
+	if err := json.Unmarshal(b, &docs); err != nil {
+		t.Fatal(err)
+	}
+	doc := ""
+	for _, s := range docs.Shapes {
+		if d, ok := s.Refs[member]; ok {
+			doc = d
+		}
+	}
+	_, doc, ok := strings.Cut(doc, "Valid Values:")
+	if !ok {
+		t.Fatalf("%s documents no valid values", member)
+	}
+	// The values are either listed in the same paragraph or in the list that
+	// follows it.
+	p, rest, _ := strings.Cut(doc, "</p>")
+	if !strings.Contains(p, "<code>") {
+		p, _, _ = strings.Cut(rest, "</ul>")
+	}
+	var vals []string
+	for _, c := range code.FindAllStringSubmatch(p, -1) {
+		for _, v := range strings.Split(c[1], "|") {
+			vals = append(vals, strings.TrimSpace(v))
+		}
+	}
+	return vals
+}
+
+// TestEnumsMatchAWSModels ensures that the enum markers of manually declared
+// fields, and the ones hack/enums adds to generated fields, are kept in sync
+// with the AWS API models. The SDK exposes the enums of the models through the
+// Values method or function of its enum types. Some members that the models
+// declare as plain strings only list their valid values in the documentation.
+func TestEnumsMatchAWSModels(t *testing.T) {
+	cases := map[string]struct {
+		crd  string
+		path string
+		want []string
+	}{
+		"VPCInstanceTenancy": {
+			crd:  "ec2.aws.crossplane.io_vpcs.yaml",
+			path: "spec.forProvider.instanceTenancy",
+			want: enumValues(ec2types.Tenancy("").Values()),
+		},
+		"InstanceShutdownBehavior": {
+			crd:  "ec2.aws.crossplane.io_instances.yaml",
+			path: "spec.forProvider.instanceInitiatedShutdownBehavior",
+			want: enumValues(ec2types.ShutdownBehavior("").Values()),
+		},
+		"InstancePlacementTenancy": {
+			crd:  "ec2.aws.crossplane.io_instances.yaml",
+			path: "spec.forProvider.placement.tenancy",
+			want: enumValues(ec2types.Tenancy("").Values()),
+		},
+		"InstanceHTTPTokens": {
+			crd:  "ec2.aws.crossplane.io_instances.yaml",
+			path: "spec.forProvider.metadataOptions.httpTokens",
+			want: enumValues(ec2types.HttpTokensState("").Values()),
+		},
+		"ClusterLogTypes": {
+			crd:  "eks.aws.crossplane.io_clusters.yaml",
+			path: "spec.forProvider.logging.clusterLogging.types",
+			want: enumValues(ekstypes.LogType("").Values()),
+		},
+		"NodeGroupAMIType": {
+			crd:  "eks.aws.crossplane.io_nodegroups.yaml",
+			path: "spec.forProvider.amiType",
+			want: enumValues(ekstypes.AMITypes("").Values()),
+		},
+		"NodeGroupCapacityType": {
+			crd:  "eks.aws.crossplane.io_nodegroups.yaml",
+			path: "spec.forProvider.capacityType",
+			want: enumValues(ekstypes.CapacityTypes("").Values()),
+		},
+		"ResourceRecordSetType": {
+			crd:  "route53.aws.crossplane.io_resourcerecordsets.yaml",
+			path: "spec.forProvider.type",
+			want: enumValues(route53types.RRType("").Values()),
+		},
+		"ResourceRecordSetFailover": {
+			crd:  "route53.aws.crossplane.io_resourcerecordsets.yaml",
+			path: "spec.forProvider.failover",
+			want: enumValues(route53types.ResourceRecordSetFailover("").Values()),
+		},
+		"FunctionRuntime": {
+			crd:  "cloudfront.aws.crossplane.io_functions.yaml",
+			path: "spec.forProvider.runtime",
+			want: awscloudfront.FunctionRuntime_Values(),
+		},
+		"KeyValueStoreImportSourceType": {
+			crd:  "cloudfront.aws.crossplane.io_keyvaluestores.yaml",
+			path: "spec.forProvider.importSource.sourceType",
+			want: awscloudfront.ImportSourceType_Values(),
+		},
+		"AuthorizerType": {
+			crd:  "apigatewayv2.aws.crossplane.io_authorizers.yaml",
+			path: "spec.forProvider.authorizerType",
+			want: awsapigatewayv2.AuthorizerType_Values(),
+		},
+		"IntegrationConnectionType": {
+			crd:  "apigatewayv2.aws.crossplane.io_integrations.yaml",
+			path: "spec.forProvider.connectionType",
+			want: awsapigatewayv2.ConnectionType_Values(),
+		},
+		"IntegrationContentHandlingStrategy": {
+			crd:  "apigatewayv2.aws.crossplane.io_integrations.yaml",
+			path: "spec.forProvider.contentHandlingStrategy",
+			want: awsapigatewayv2.ContentHandlingStrategy_Values(),
+		},
+		"IntegrationType": {
+			crd:  "apigatewayv2.aws.crossplane.io_integrations.yaml",
+			path: "spec.forProvider.integrationType",
+			want: awsapigatewayv2.IntegrationType_Values(),
+		},
+		"IntegrationPassthroughBehavior": {
+			crd:  "apigatewayv2.aws.crossplane.io_integrations.yaml",
+			path: "spec.forProvider.passthroughBehavior",
+			want: awsapigatewayv2.PassthroughBehavior_Values(),
+		},
+		"IntegrationResponseContentHandlingStrategy": {
+			crd:  "apigatewayv2.aws.crossplane.io_integrationresponses.yaml",
+			path: "spec.forProvider.contentHandlingStrategy",
+			want: awsapigatewayv2.ContentHandlingStrategy_Values(),
+		},
+		"RouteAuthorizationType": {
+			crd:  "apigatewayv2.aws.crossplane.io_routes.yaml",
+			path: "spec.forProvider.authorizationType",
+			want: awsapigatewayv2.AuthorizationType_Values(),
+		},
+		"TableBillingMode": {
+			crd:  "dynamodb.aws.crossplane.io_tables.yaml",
+			path: "spec.forProvider.billingMode",
+			want: awsdynamodb.BillingMode_Values(),
+		},
+		"VolumeType": {
+			crd:  "ec2.aws.crossplane.io_volumes.yaml",
+			path: "spec.forProvider.volumeType",
+			want: awsec2.VolumeType_Values(),
+		},
+		"FileSystemPerformanceMode": {
+			crd:  "efs.aws.crossplane.io_filesystems.yaml",
+			path: "spec.forProvider.performanceMode",
+			want: awsefs.PerformanceMode_Values(),
+		},
+		"FileSystemThroughputMode": {
+			crd:  "efs.aws.crossplane.io_filesystems.yaml",
+			path: "spec.forProvider.throughputMode",
+			want: awsefs.ThroughputMode_Values(),
+		},
+		"AddonResolveConflicts": {
+			crd:  "eks.aws.crossplane.io_addons.yaml",
+			path: "spec.forProvider.resolveConflicts",
+			want: awseks.ResolveConflicts_Values(),
+		},
+		"ListenerProtocol": {
+			crd:  "elbv2.aws.crossplane.io_listeners.yaml",
+			path: "spec.forProvider.protocol",
+			want: awselbv2.ProtocolEnum_Values(),
+		},
+		"LoadBalancerIPAddressType": {
+			crd:  "elbv2.aws.crossplane.io_loadbalancers.yaml",
+			path: "spec.forProvider.ipAddressType",
+			want: awselbv2.IpAddressType_Values(),
+		},
+		"LoadBalancerScheme": {
+			crd:  "elbv2.aws.crossplane.io_loadbalancers.yaml",
+			path: "spec.forProvider.scheme",
+			want: awselbv2.LoadBalancerSchemeEnum_Values(),
+		},
+		"TargetGroupHealthCheckProtocol": {
+			crd:  "elbv2.aws.crossplane.io_targetgroups.yaml",
+			path: "spec.forProvider.healthCheckProtocol",
+			want: awselbv2.ProtocolEnum_Values(),
+		},
+		"TargetGroupProtocol": {
+			crd:  "elbv2.aws.crossplane.io_targetgroups.yaml",
+			path: "spec.forProvider.protocol",
+			want: awselbv2.ProtocolEnum_Values(),
+		},
+		"TargetGroupTargetType": {
+			crd:  "elbv2.aws.crossplane.io_targetgroups.yaml",
+			path: "spec.forProvider.targetType",
+			want: awselbv2.TargetTypeEnum_Values(),
+		},
+		"JobWorkerType": {
+			crd:  "glue.aws.crossplane.io_jobs.yaml",
+			path: "spec.forProvider.workerType",
+			want: awsglue.WorkerType_Values(),
+		},
+		"ClusterEnhancedMonitoring": {
+			crd:  "kafka.aws.crossplane.io_clusters.yaml",
+			path: "spec.forProvider.enhancedMonitoring",
+			want: awskafka.EnhancedMonitoring_Values(),
+		},
+		"KeyCustomerMasterKeySpec": {
+			crd:  "kms.aws.crossplane.io_keys.yaml",
+			path: "spec.forProvider.customerMasterKeySpec",
+			want: awskms.CustomerMasterKeySpec_Values(),
+		},
+		"KeyUsage": {
+			crd:  "kms.aws.crossplane.io_keys.yaml",
+			path: "spec.forProvider.keyUsage",
+			want: awskms.KeyUsageType_Values(),
+		},
+		"KeyOrigin": {
+			crd:  "kms.aws.crossplane.io_keys.yaml",
+			path: "spec.forProvider.origin",
+			want: awskms.OriginType_Values(),
+		},
+		"FunctionPackageType": {
+			crd:  "lambda.aws.crossplane.io_functions.yaml",
+			path: "spec.forProvider.packageType",
+			want: awslambda.PackageType_Values(),
+		},
+		"LambdaFunctionRuntime": {
+			crd:  "lambda.aws.crossplane.io_functions.yaml",
+			path: "spec.forProvider.runtime",
+			want: awslambda.Runtime_Values(),
+		},
+		"BrokerAuthenticationStrategy": {
+			crd:  "mq.aws.crossplane.io_brokers.yaml",
+			path: "spec.forProvider.authenticationStrategy",
+			want: awsmq.AuthenticationStrategy_Values(),
+		},
+		"BrokerDeploymentMode": {
+			crd:  "mq.aws.crossplane.io_brokers.yaml",
+			path: "spec.forProvider.deploymentMode",
+			want: awsmq.DeploymentMode_Values(),
+		},
+		"BrokerStorageType": {
+			crd:  "mq.aws.crossplane.io_brokers.yaml",
+			path: "spec.forProvider.storageType",
+			want: awsmq.BrokerStorageType_Values(),
+		},
+		"DBClusterEngine": {
+			crd:  "rds.aws.crossplane.io_dbclusters.yaml",
+			path: "spec.forProvider.engine",
+			want: documentedValues(t, "rds", "CreateDBClusterMessage$Engine"),
+		},
+		"DBInstanceEngine": {
+			crd:  "rds.aws.crossplane.io_dbinstances.yaml",
+			path: "spec.forProvider.engine",
+			want: documentedValues(t, "rds", "CreateDBInstanceMessage$Engine"),
+		},
+		"DBInstanceStorageType": {
+			crd:  "rds.aws.crossplane.io_dbinstances.yaml",
+			path: "spec.forProvider.storageType",
+			want: documentedValues(t, "rds", "CreateDBInstanceMessage$StorageType"),
+		},
+		"GlobalClusterEngine": {
+			crd:  "rds.aws.crossplane.io_globalclusters.yaml",
+			path: "spec.forProvider.engine",
+			want: documentedValues(t, "rds", "CreateGlobalClusterMessage$Engine"),
+		},
+		"ResolverEndpointDirection": {
+			crd:  "route53resolver.aws.crossplane.io_resolverendpoints.yaml",
+			path: "spec.forProvider.direction",
+			want: awsroute53resolver.ResolverEndpointDirection_Values(),
+		},
+		"ResolverRuleType": {
+			crd:  "route53resolver.aws.crossplane.io_resolverrules.yaml",
+			path: "spec.forProvider.ruleType",
+			want: awsroute53resolver.RuleTypeOption_Values(),
+		},
+		"ServerDomain": {
+			crd:  "transfer.aws.crossplane.io_servers.yaml",
+			path: "spec.forProvider.domain",
+			want: awstransfer.Domain_Values(),
+		},
+		"ServerEndpointType": {
+			crd:  "transfer.aws.crossplane.io_servers.yaml",
+			path: "spec.forProvider.endpointType",
+			want: awstransfer.EndpointType_Values(),
+		},
+		"ServerIdentityProviderType": {
+			crd:  "transfer.aws.crossplane.io_servers.yaml",
+			path: "spec.forProvider.identityProviderType",
+			want: awstransfer.IdentityProviderType_Values(),
+		},
+		"UserHomeDirectoryType": {
+			crd:  "transfer.aws.crossplane.io_users.yaml",
+			path: "spec.forProvider.homeDirectoryType",
+			want: awstransfer.HomeDirectoryType_Values(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b, err := os.ReadFile(filepath.Join(crdDir, tc.crd))
+			if err != nil {
+				t.Fatal(err)
+			}
+			crd := &extv1.CustomResourceDefinition{}
+			if err := yaml.Unmarshal(b, crd); err != nil {
+				t.Fatal(err)
+			}
+			for _, v := range crd.Spec.Versions {
+				s, ok := schemaAt(*v.Schema.OpenAPIV3Schema, tc.path)
+				if !ok {
+					t.Fatalf("%s: no property at %s", v.Name, tc.path)
+				}
+				got := make([]string, len(s.Enum))
+				for i, e := range s.Enum {
+					if err := json.Unmarshal(e.Raw, &got[i]); err != nil {
+						t.Fatal(err)
+					}
+				}
+				sort.Strings(got)
+				want := append([]string{}, tc.want...)
+				sort.Strings(want)
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("%s %s: -want, +got:\n%s", v.Name, tc.path, diff)
+				}
+			}
+		})
+	}
+}
@@ -1,3 +1,4 @@
+//go:build generate
 // +build generate
 
 /*
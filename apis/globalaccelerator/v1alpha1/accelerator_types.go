@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Accelerator states.
+const (
+	AcceleratorStatusDeployed   = "DEPLOYED"
+	AcceleratorStatusInProgress = "IN_PROGRESS"
+)
+
+// AcceleratorParameters define the desired state of an AWS Global
+// Accelerator accelerator.
+type AcceleratorParameters struct {
+	// The name of the accelerator. It can contain only alphanumeric
+	// characters, periods and hyphens.
+	Name string `json:"name"`
+
+	// The IP address type of the accelerator, which is either IPV4 or
+	// DUAL_STACK for both IPv4 and IPv6 addresses.
+	// +kubebuilder:validation:Enum=IPV4;DUAL_STACK
+	// +optional
+	IPAddressType *string `json:"ipAddressType,omitempty"`
+
+	// Up to two IPv4 addresses from your own address pools (BYOIP) that
+	// the accelerator uses instead of static IP addresses provided by AWS.
+	// +kubebuilder:validation:MaxItems=2
+	// +immutable
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// Whether the accelerator is enabled. An accelerator is disabled
+	// before it is deleted.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The tags of the accelerator.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An AcceleratorSpec defines the desired state of an Accelerator.
+type AcceleratorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AcceleratorParameters `json:"forProvider"`
+}
+
+// An IPSet is a set of static IP addresses of an accelerator.
+type IPSet struct {
+	// The family of the IP addresses, which is IPv4 or IPv6.
+	IPAddressFamily string `json:"ipAddressFamily,omitempty"`
+
+	// The static IP addresses.
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// AcceleratorObservation keeps the state for the external resource
+type AcceleratorObservation struct {
+	// The Amazon Resource Name (ARN) of the accelerator.
+	AcceleratorARN string `json:"acceleratorArn,omitempty"`
+
+	// The domain name that routes to the IPv4 addresses of the accelerator.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// The domain name that routes to both the IPv4 and the IPv6 addresses
+	// of a dual-stack accelerator.
+	DualStackDNSName string `json:"dualStackDnsName,omitempty"`
+
+	// The static IP addresses of the accelerator.
+	IPSets []IPSet `json:"ipSets,omitempty"`
+
+	// The status of the accelerator, which is IN_PROGRESS while changes are
+	// being deployed and DEPLOYED afterwards.
+	Status string `json:"status,omitempty"`
+}
+
+// An AcceleratorStatus represents the observed state of an Accelerator.
+type AcceleratorStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            AcceleratorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Accelerator is a managed resource that represents an AWS Global
+// Accelerator accelerator, which routes traffic arriving at its static
+// anycast IP addresses to endpoints in one or more regions. The external name
+// of an Accelerator is the ARN of the accelerator.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DNS",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Accelerator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AcceleratorSpec   `json:"spec"`
+	Status AcceleratorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AcceleratorList contains a list of Accelerators
+type AcceleratorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Accelerator `json:"items"`
+}
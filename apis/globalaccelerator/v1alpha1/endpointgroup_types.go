@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// An EndpointConfiguration is an endpoint traffic is routed to.
+type EndpointConfiguration struct {
+	// The ID of the endpoint, which is the ARN of an Application or Network
+	// Load Balancer, the allocation ID of an Elastic IP address or the ID of
+	// an EC2 instance.
+	// +optional
+	EndpointID *string `json:"endpointId,omitempty"`
+
+	// LoadBalancerRef is a reference to an Application or Network Load
+	// Balancer used to set EndpointID.
+	// +optional
+	LoadBalancerRef *xpv1.Reference `json:"loadBalancerRef,omitempty"`
+
+	// LoadBalancerSelector selects a reference to an Application or Network
+	// Load Balancer used to set EndpointID.
+	// +optional
+	LoadBalancerSelector *xpv1.Selector `json:"loadBalancerSelector,omitempty"`
+
+	// AddressRef is a reference to an Elastic IP address used to set
+	// EndpointID.
+	// +optional
+	AddressRef *xpv1.Reference `json:"addressRef,omitempty"`
+
+	// AddressSelector selects a reference to an Elastic IP address used to
+	// set EndpointID.
+	// +optional
+	AddressSelector *xpv1.Selector `json:"addressSelector,omitempty"`
+
+	// The share of the traffic of the endpoint group that is routed to the
+	// endpoint, relative to the weights of the other endpoints. Defaults to
+	// 128.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+
+	// Whether the IP address of the client is preserved when traffic is
+	// routed to an Application Load Balancer or EC2 instance endpoint.
+	// +optional
+	ClientIPPreservationEnabled *bool `json:"clientIPPreservationEnabled,omitempty"`
+}
+
+// A PortOverride routes traffic arriving at a listener port to a different
+// port on the endpoints.
+type PortOverride struct {
+	// The port of the listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	ListenerPort int64 `json:"listenerPort"`
+
+	// The port of the endpoints traffic is routed to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	EndpointPort int64 `json:"endpointPort"`
+}
+
+// EndpointGroupParameters define the desired state of an AWS Global
+// Accelerator endpoint group.
+type EndpointGroupParameters struct {
+	// The ARN of the listener the endpoint group belongs to.
+	// +immutable
+	// +optional
+	ListenerARN *string `json:"listenerArn,omitempty"`
+
+	// ListenerARNRef is a reference to a Listener used to set ListenerARN.
+	// +optional
+	ListenerARNRef *xpv1.Reference `json:"listenerArnRef,omitempty"`
+
+	// ListenerARNSelector selects a reference to a Listener used to set
+	// ListenerARN.
+	// +optional
+	ListenerARNSelector *xpv1.Selector `json:"listenerArnSelector,omitempty"`
+
+	// The region the endpoints of the endpoint group are in.
+	// +immutable
+	EndpointGroupRegion string `json:"endpointGroupRegion"`
+
+	// The endpoints traffic is routed to.
+	// +optional
+	EndpointConfigurations []EndpointConfiguration `json:"endpointConfigurations,omitempty"`
+
+	// The percentage of the traffic of the listener that is routed to the
+	// endpoint group. Defaults to 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TrafficDialPercentage *float64 `json:"trafficDialPercentage,omitempty"`
+
+	// The port used to check the health of the endpoints. Defaults to the
+	// port of the listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	HealthCheckPort *int64 `json:"healthCheckPort,omitempty"`
+
+	// The protocol used to check the health of the endpoints. Defaults to
+	// TCP.
+	// +kubebuilder:validation:Enum=TCP;HTTP;HTTPS
+	// +optional
+	HealthCheckProtocol *string `json:"healthCheckProtocol,omitempty"`
+
+	// The path requested by HTTP and HTTPS health checks. Defaults to /.
+	// +optional
+	HealthCheckPath *string `json:"healthCheckPath,omitempty"`
+
+	// The number of seconds between two health checks of an endpoint.
+	// Defaults to 30.
+	// +kubebuilder:validation:Enum=10;30
+	// +optional
+	HealthCheckIntervalSeconds *int64 `json:"healthCheckIntervalSeconds,omitempty"`
+
+	// The number of consecutive health checks that an endpoint must pass
+	// or fail to change its health state. Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	ThresholdCount *int64 `json:"thresholdCount,omitempty"`
+
+	// The overrides of the ports traffic is routed to on the endpoints.
+	// +optional
+	PortOverrides []PortOverride `json:"portOverrides,omitempty"`
+}
+
+// An EndpointGroupSpec defines the desired state of an EndpointGroup.
+type EndpointGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EndpointGroupParameters `json:"forProvider"`
+}
+
+// An EndpointDescription is the observed state of an endpoint.
+type EndpointDescription struct {
+	// The ID of the endpoint.
+	EndpointID string `json:"endpointId,omitempty"`
+
+	// The health state of the endpoint, which is INITIAL, HEALTHY or
+	// UNHEALTHY.
+	HealthState string `json:"healthState,omitempty"`
+
+	// The reason the endpoint is not healthy.
+	HealthReason string `json:"healthReason,omitempty"`
+}
+
+// EndpointGroupObservation keeps the state for the external resource
+type EndpointGroupObservation struct {
+	// The Amazon Resource Name (ARN) of the endpoint group.
+	EndpointGroupARN string `json:"endpointGroupArn,omitempty"`
+
+	// The observed state of the endpoints.
+	EndpointDescriptions []EndpointDescription `json:"endpointDescriptions,omitempty"`
+}
+
+// An EndpointGroupStatus represents the observed state of an EndpointGroup.
+type EndpointGroupStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            EndpointGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EndpointGroup is a managed resource that represents an AWS Global
+// Accelerator endpoint group, which routes the traffic of a listener to
+// endpoints in a single region. The external name of an EndpointGroup is the
+// ARN of the endpoint group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.endpointGroupRegion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EndpointGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointGroupSpec   `json:"spec"`
+	Status EndpointGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointGroupList contains a list of EndpointGroups
+type EndpointGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EndpointGroup `json:"items"`
+}
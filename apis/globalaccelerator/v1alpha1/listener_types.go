@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// A PortRange is a range of ports a listener accepts traffic on.
+type PortRange struct {
+	// The first port of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	FromPort int64 `json:"fromPort"`
+
+	// The last port of the range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	ToPort int64 `json:"toPort"`
+}
+
+// ListenerParameters define the desired state of an AWS Global Accelerator
+// listener.
+type ListenerParameters struct {
+	// The ARN of the accelerator the listener belongs to.
+	// +crossplane:generate:reference:type=Accelerator
+	// +immutable
+	// +optional
+	AcceleratorARN *string `json:"acceleratorArn,omitempty"`
+
+	// AcceleratorARNRef is a reference to an Accelerator used to set
+	// AcceleratorARN.
+	// +optional
+	AcceleratorARNRef *xpv1.Reference `json:"acceleratorArnRef,omitempty"`
+
+	// AcceleratorARNSelector selects a reference to an Accelerator used to
+	// set AcceleratorARN.
+	// +optional
+	AcceleratorARNSelector *xpv1.Selector `json:"acceleratorArnSelector,omitempty"`
+
+	// The ranges of ports the listener accepts traffic on.
+	// +kubebuilder:validation:MinItems=1
+	PortRanges []PortRange `json:"portRanges"`
+
+	// The protocol of the traffic the listener accepts.
+	// +kubebuilder:validation:Enum=TCP;UDP
+	Protocol string `json:"protocol"`
+
+	// Whether the requests of a client are always routed to the same
+	// endpoint (SOURCE_IP) or not (NONE). Defaults to NONE.
+	// +kubebuilder:validation:Enum=NONE;SOURCE_IP
+	// +optional
+	ClientAffinity *string `json:"clientAffinity,omitempty"`
+}
+
+// A ListenerSpec defines the desired state of a Listener.
+type ListenerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ListenerParameters `json:"forProvider"`
+}
+
+// ListenerObservation keeps the state for the external resource
+type ListenerObservation struct {
+	// The Amazon Resource Name (ARN) of the listener.
+	ListenerARN string `json:"listenerArn,omitempty"`
+}
+
+// A ListenerStatus represents the observed state of a Listener.
+type ListenerStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ListenerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Listener is a managed resource that represents an AWS Global Accelerator
+// listener, which processes the connections arriving at an accelerator on a
+// set of ports. The external name of a Listener is the ARN of the listener.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerSpec   `json:"spec"`
+	Status ListenerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerList contains a list of Listeners
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Listener `json:"items"`
+}
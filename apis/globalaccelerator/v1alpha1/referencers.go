@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+// ResolveReferences of this EndpointGroup
+func (mg *EndpointGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.listenerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ListenerARN),
+		Reference:    mg.Spec.ForProvider.ListenerARNRef,
+		Selector:     mg.Spec.ForProvider.ListenerARNSelector,
+		To:           reference.To{Managed: &Listener{}, List: &ListenerList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.listenerArn")
+	}
+	mg.Spec.ForProvider.ListenerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ListenerARNRef = rsp.ResolvedReference
+
+	// The ID of an endpoint is either resolved from a load balancer, whose
+	// external name is its ARN, or from an Elastic IP address, whose external
+	// name is its allocation ID.
+	for i := range mg.Spec.ForProvider.EndpointConfigurations {
+		ec := &mg.Spec.ForProvider.EndpointConfigurations[i]
+
+		// Resolve spec.forProvider.endpointConfigurations[i].endpointId
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(ec.EndpointID),
+			Reference:    ec.LoadBalancerRef,
+			Selector:     ec.LoadBalancerSelector,
+			To:           reference.To{Managed: &elbv2v1alpha1.LoadBalancer{}, List: &elbv2v1alpha1.LoadBalancerList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.endpointConfigurations[%d].endpointId", i))
+		}
+		ec.EndpointID = reference.ToPtrValue(rsp.ResolvedValue)
+		ec.LoadBalancerRef = rsp.ResolvedReference
+
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(ec.EndpointID),
+			Reference:    ec.AddressRef,
+			Selector:     ec.AddressSelector,
+			To:           reference.To{Managed: &ec2v1beta1.Address{}, List: &ec2v1beta1.AddressList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.endpointConfigurations[%d].endpointId", i))
+		}
+		ec.EndpointID = reference.ToPtrValue(rsp.ResolvedValue)
+		ec.AddressRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
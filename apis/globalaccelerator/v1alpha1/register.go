@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "globalaccelerator.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Accelerator type metadata.
+var (
+	AcceleratorKind             = reflect.TypeOf(Accelerator{}).Name()
+	AcceleratorGroupKind        = schema.GroupKind{Group: Group, Kind: AcceleratorKind}.String()
+	AcceleratorKindAPIVersion   = AcceleratorKind + "." + SchemeGroupVersion.String()
+	AcceleratorGroupVersionKind = SchemeGroupVersion.WithKind(AcceleratorKind)
+)
+
+// Listener type metadata.
+var (
+	ListenerKind             = reflect.TypeOf(Listener{}).Name()
+	ListenerGroupKind        = schema.GroupKind{Group: Group, Kind: ListenerKind}.String()
+	ListenerKindAPIVersion   = ListenerKind + "." + SchemeGroupVersion.String()
+	ListenerGroupVersionKind = SchemeGroupVersion.WithKind(ListenerKind)
+)
+
+// EndpointGroup type metadata.
+var (
+	EndpointGroupKind             = reflect.TypeOf(EndpointGroup{}).Name()
+	EndpointGroupGroupKind        = schema.GroupKind{Group: Group, Kind: EndpointGroupKind}.String()
+	EndpointGroupKindAPIVersion   = EndpointGroupKind + "." + SchemeGroupVersion.String()
+	EndpointGroupGroupVersionKind = SchemeGroupVersion.WithKind(EndpointGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Accelerator{}, &AcceleratorList{})
+	SchemeBuilder.Register(&Listener{}, &ListenerList{})
+	SchemeBuilder.Register(&EndpointGroup{}, &EndpointGroupList{})
+}
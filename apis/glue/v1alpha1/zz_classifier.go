@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -44,8 +45,9 @@ type ClassifierObservation struct {
 
 // ClassifierStatus defines the observed state of Classifier.
 type ClassifierStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ClassifierObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ClassifierObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -317,6 +317,7 @@ func (in *ClassifierSpec) DeepCopy() *ClassifierSpec {
 func (in *ClassifierStatus) DeepCopyInto(out *ClassifierStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -736,6 +737,7 @@ func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
 func (in *ConnectionStatus) DeepCopyInto(out *ConnectionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1099,6 +1101,7 @@ func (in *CrawlerSpec) DeepCopy() *CrawlerSpec {
 func (in *CrawlerStatus) DeepCopyInto(out *CrawlerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -2245,6 +2248,7 @@ func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
 func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -3319,6 +3323,7 @@ func (in *JobSpec) DeepCopy() *JobSpec {
 func (in *JobStatus) DeepCopyInto(out *JobStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -4425,6 +4430,7 @@ func (in *SecurityConfigurationSpec) DeepCopy() *SecurityConfigurationSpec {
 func (in *SecurityConfigurationStatus) DeepCopyInto(out *SecurityConfigurationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
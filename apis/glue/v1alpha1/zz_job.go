@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -125,6 +126,7 @@ type JobParameters struct {
 	//    * For the G.2X worker type, each worker maps to 2 DPU (8 vCPU, 32 GB of
 	//    memory, 128 GB disk), and provides 1 executor per worker. We recommend
 	//    this worker type for memory-intensive jobs.
+	// +kubebuilder:validation:Enum=Standard;G.1X;G.2X;G.025X;G.4X;G.8X;Z.2X
 	WorkerType          *string `json:"workerType,omitempty"`
 	CustomJobParameters `json:",inline"`
 }
@@ -143,8 +145,9 @@ type JobObservation struct {
 
 // JobStatus defines the observed state of Job.
 type JobStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          JobObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            JobObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
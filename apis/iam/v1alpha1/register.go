@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// +kubebuilder:object:generate=true
+// +groupName=iam.aws.crossplane.io
+// +versionName=v1alpha1
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	CRDGroup   = "iam.aws.crossplane.io"
+	CRDVersion = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: CRDGroup, Version: CRDVersion}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// RolePolicy type metadata.
+var (
+	RolePolicyKind             = reflect.TypeOf(RolePolicy{}).Name()
+	RolePolicyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: RolePolicyKind}.String()
+	RolePolicyKindAPIVersion   = RolePolicyKind + "." + SchemeGroupVersion.String()
+	RolePolicyGroupVersionKind = SchemeGroupVersion.WithKind(RolePolicyKind)
+)
+
+// InstanceProfile type metadata.
+var (
+	InstanceProfileKind             = reflect.TypeOf(InstanceProfile{}).Name()
+	InstanceProfileGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: InstanceProfileKind}.String()
+	InstanceProfileKindAPIVersion   = InstanceProfileKind + "." + SchemeGroupVersion.String()
+	InstanceProfileGroupVersionKind = SchemeGroupVersion.WithKind(InstanceProfileKind)
+)
+
+// ServiceLinkedRole type metadata.
+var (
+	ServiceLinkedRoleKind             = reflect.TypeOf(ServiceLinkedRole{}).Name()
+	ServiceLinkedRoleGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceLinkedRoleKind}.String()
+	ServiceLinkedRoleKindAPIVersion   = ServiceLinkedRoleKind + "." + SchemeGroupVersion.String()
+	ServiceLinkedRoleGroupVersionKind = SchemeGroupVersion.WithKind(ServiceLinkedRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RolePolicy{}, &RolePolicyList{})
+	SchemeBuilder.Register(&InstanceProfile{}, &InstanceProfileList{})
+	SchemeBuilder.Register(&ServiceLinkedRole{}, &ServiceLinkedRoleList{})
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// RolePolicyParameters define the desired state of an AWS IAM Role inline
+// policy.
+type RolePolicyParameters struct {
+	// RoleName is the name of the IAM role the policy is embedded in.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	RoleName string `json:"roleName,omitempty"`
+
+	// RoleNameRef references a Role to retrieve its Name.
+	// +optional
+	RoleNameRef *xpv1.Reference `json:"roleNameRef,omitempty"`
+
+	// RoleNameSelector selects a reference to a Role to retrieve its Name.
+	// +optional
+	RoleNameSelector *xpv1.Selector `json:"roleNameSelector,omitempty"`
+
+	// Document is the JSON policy document of the inline policy.
+	Document string `json:"document"`
+}
+
+// A RolePolicySpec defines the desired state of a RolePolicy.
+type RolePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RolePolicyParameters `json:"forProvider"`
+}
+
+// A RolePolicyStatus represents the observed state of a RolePolicy.
+type RolePolicyStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RolePolicy is a managed resource that represents an inline policy of an
+// AWS IAM Role. The external name of a RolePolicy is the name of the policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLENAME",type="string",JSONPath=".spec.forProvider.roleName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RolePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RolePolicySpec   `json:"spec"`
+	Status RolePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RolePolicyList contains a list of RolePolicies
+type RolePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RolePolicy `json:"items"`
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ServiceLinkedRoleParameters define the desired state of an AWS IAM
+// service-linked role.
+type ServiceLinkedRoleParameters struct {
+	// AWSServiceName is the service principal for the AWS service to which
+	// this role is attached, for example elasticbeanstalk.amazonaws.com.
+	// +immutable
+	AWSServiceName string `json:"awsServiceName"`
+
+	// CustomSuffix is a string that is appended to the service-provided
+	// prefix to form the complete role name. Only some services accept a
+	// custom suffix.
+	// +immutable
+	// +optional
+	CustomSuffix *string `json:"customSuffix,omitempty"`
+
+	// Description of the role.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// A ServiceLinkedRoleSpec defines the desired state of a ServiceLinkedRole.
+type ServiceLinkedRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceLinkedRoleParameters `json:"forProvider"`
+}
+
+// ServiceLinkedRoleObservation keeps the state for the external resource.
+type ServiceLinkedRoleObservation struct {
+	// ARN is the Amazon Resource Name (ARN) specifying the role.
+	ARN string `json:"arn,omitempty"`
+
+	// RoleID is the stable and unique string identifying the role.
+	RoleID string `json:"roleID,omitempty"`
+
+	// Path to the role.
+	Path string `json:"path,omitempty"`
+
+	// DeletionTaskID is the identifier of the pending deletion of the role.
+	// IAM deletes service-linked roles asynchronously, after the linked
+	// service has confirmed that the role is no longer in use.
+	DeletionTaskID string `json:"deletionTaskID,omitempty"`
+}
+
+// A ServiceLinkedRoleStatus represents the observed state of a
+// ServiceLinkedRole.
+type ServiceLinkedRoleStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ServiceLinkedRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceLinkedRole is a managed resource that represents an AWS IAM
+// service-linked role. The external name of a ServiceLinkedRole is the name
+// of the role, which is assigned by AWS.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.awsServiceName"
+// +kubebuilder:printcolumn:name="ROLENAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ServiceLinkedRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceLinkedRoleSpec   `json:"spec"`
+	Status ServiceLinkedRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceLinkedRoleList contains a list of ServiceLinkedRoles
+type ServiceLinkedRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceLinkedRole `json:"items"`
+}
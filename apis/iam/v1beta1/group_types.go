@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // GroupParameters define the desired state of an AWS IAM Group.
@@ -46,8 +48,9 @@ type GroupObservation struct {
 
 // An GroupStatus represents the observed state of an IAM Group.
 type GroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          GroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            GroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
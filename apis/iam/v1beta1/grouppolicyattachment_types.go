@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // GroupPolicyAttachmentParameters define the desired state of an AWS GroupPolicyAttachment.
@@ -72,8 +74,9 @@ type GroupPolicyAttachmentObservation struct {
 // An GroupPolicyAttachmentStatus represents the observed state of an
 // GroupPolicyAttachment.
 type GroupPolicyAttachmentStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          GroupPolicyAttachmentObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            GroupPolicyAttachmentObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -48,7 +49,10 @@ type OpenIDConnectProviderParameters struct {
 	// the X.509 certificate used by the domain where the OpenID Connect provider
 	// makes its keys available. It is always a 40-character string.
 	//
-	// You must provide at least one thumbprint when creating an IAM OIDC provider.
+	// When omitted, the thumbprint of the top intermediate certificate authority
+	// served by the provider URL is computed and kept up to date as the
+	// provider rotates its certificates.
+	//
 	// For example, assume that the OIDC provider is server.example.com and the
 	// provider stores its keys at https://keys.server.example.com/openid-connect.
 	// In that case, the thumbprint string would be the hex-encoded SHA-1 hash value
@@ -57,9 +61,9 @@ type OpenIDConnectProviderParameters struct {
 	// For more information about obtaining the OIDC provider's thumbprint, see
 	// Obtaining the Thumbprint for an OpenID Connect Provider (https://docs.aws.amazon.com/IAM/latest/UserGuide/identity-providers-oidc-obtain-thumbprint.html)
 	// in the IAM User Guide.
-	// +kubebuilder:validation:MinItems:=1
 	// +kubebuilder:validation:MaxItems:=5
-	ThumbprintList []string `json:"thumbprintList"`
+	// +optional
+	ThumbprintList []string `json:"thumbprintList,omitempty"`
 
 	// The URL of the identity provider. The URL must begin with https:// and should
 	// correspond to the iss claim in the provider's OpenID Connect ID tokens. Per
@@ -84,12 +88,17 @@ type OpenIDConnectProviderObservation struct {
 	// The date and time when the IAM OIDC provider resource object was created
 	// in the AWS account.
 	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// The list of server certificate thumbprints currently registered for the
+	// IAM OIDC provider.
+	ThumbprintList []string `json:"thumbprintList,omitempty"`
 }
 
 // OpenIDConnectProviderStatus defines the observed state of OpenIDConnectProvider.
 type OpenIDConnectProviderStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          OpenIDConnectProviderObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            OpenIDConnectProviderObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
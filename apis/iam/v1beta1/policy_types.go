@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // PolicyParameters define the desired state of an AWS IAM Policy.
@@ -77,8 +79,9 @@ type PolicyObservation struct {
 
 // An PolicyStatus represents the observed state of an Policy.
 type PolicyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          PolicyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            PolicyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // Tag represents user-provided metadata that can be associated
@@ -50,7 +52,6 @@ type RoleParameters struct {
 
 	// AssumeRolePolicyDocument is the the trust relationship policy document
 	// that grants an entity permission to assume the role.
-	// +immutable
 	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument"`
 
 	// Description is a description of the role.
@@ -70,10 +71,21 @@ type RoleParameters struct {
 	Path *string `json:"path,omitempty"`
 
 	// PermissionsBoundary is the ARN of the policy that is used to set the permissions boundary for the role.
-	// +immutable
+	// The permissions boundary of the role is removed if this is not set.
 	// +optional
+	// +crossplane:generate:reference:type=Policy
+	// +crossplane:generate:reference:extractor=PolicyARN()
 	PermissionsBoundary *string `json:"permissionsBoundary,omitempty"`
 
+	// PermissionsBoundaryRef references a Policy to retrieve its ARN.
+	// +optional
+	PermissionsBoundaryRef *xpv1.Reference `json:"permissionsBoundaryRef,omitempty"`
+
+	// PermissionsBoundarySelector selects a reference to a Policy to retrieve
+	// its ARN.
+	// +optional
+	PermissionsBoundarySelector *xpv1.Selector `json:"permissionsBoundarySelector,omitempty"`
+
 	// Tags. For more information about
 	// tagging, see Tagging IAM Identities (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html)
 	// in the IAM User Guide.
@@ -103,8 +115,9 @@ type RoleExternalStatus struct {
 
 // An RoleStatus represents the observed state of an Role.
 type RoleStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RoleExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            RoleExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
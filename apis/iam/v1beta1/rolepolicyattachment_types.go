@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // RolePolicyAttachmentParameters define the desired state of an AWS IAM
@@ -73,8 +75,9 @@ type RolePolicyAttachmentExternalStatus struct {
 // An RolePolicyAttachmentStatus represents the observed state of an
 // RolePolicyAttachment.
 type RolePolicyAttachmentStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RolePolicyAttachmentExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            RolePolicyAttachmentExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // UserParameters define the desired state of an AWS IAM User.
@@ -29,10 +31,21 @@ type UserParameters struct {
 	Path *string `json:"path,omitempty"`
 
 	// The ARN of the policy that is used to set the permissions boundary for the
-	// user.
+	// user. The permissions boundary of the user is removed if this is not set.
 	// +optional
+	// +crossplane:generate:reference:type=Policy
+	// +crossplane:generate:reference:extractor=PolicyARN()
 	PermissionsBoundary *string `json:"permissionsBoundary,omitempty"`
 
+	// PermissionsBoundaryRef references a Policy to retrieve its ARN.
+	// +optional
+	PermissionsBoundaryRef *xpv1.Reference `json:"permissionsBoundaryRef,omitempty"`
+
+	// PermissionsBoundarySelector selects a reference to a Policy to retrieve
+	// its ARN.
+	// +optional
+	PermissionsBoundarySelector *xpv1.Selector `json:"permissionsBoundarySelector,omitempty"`
+
 	// A list of tags that you want to attach to the newly created user.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -55,8 +68,9 @@ type UserObservation struct {
 
 // An UserStatus represents the observed state of an IAM User.
 type UserStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          UserObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            UserObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
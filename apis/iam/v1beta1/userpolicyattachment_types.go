@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // UserPolicyAttachmentParameters define the desired state of an AWS UserPolicyAttachment.
@@ -72,8 +74,9 @@ type UserPolicyAttachmentObservation struct {
 // An UserPolicyAttachmentStatus represents the observed state of an
 // UserPolicyAttachment.
 type UserPolicyAttachmentStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          UserPolicyAttachmentObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            UserPolicyAttachmentObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
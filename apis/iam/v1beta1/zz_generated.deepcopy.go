@@ -131,6 +131,7 @@ func (in *AccessKeySpec) DeepCopy() *AccessKeySpec {
 func (in *AccessKeyStatus) DeepCopyInto(out *AccessKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyStatus.
@@ -367,6 +368,7 @@ func (in *GroupPolicyAttachmentSpec) DeepCopy() *GroupPolicyAttachmentSpec {
 func (in *GroupPolicyAttachmentStatus) DeepCopyInto(out *GroupPolicyAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -401,6 +403,7 @@ func (in *GroupSpec) DeepCopy() *GroupSpec {
 func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -544,6 +547,7 @@ func (in *GroupUserMembershipSpec) DeepCopy() *GroupUserMembershipSpec {
 func (in *GroupUserMembershipStatus) DeepCopyInto(out *GroupUserMembershipStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -623,6 +627,11 @@ func (in *OpenIDConnectProviderObservation) DeepCopyInto(out *OpenIDConnectProvi
 		in, out := &in.CreateDate, &out.CreateDate
 		*out = (*in).DeepCopy()
 	}
+	if in.ThumbprintList != nil {
+		in, out := &in.ThumbprintList, &out.ThumbprintList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderObservation.
@@ -681,6 +690,7 @@ func (in *OpenIDConnectProviderSpec) DeepCopy() *OpenIDConnectProviderSpec {
 func (in *OpenIDConnectProviderStatus) DeepCopyInto(out *OpenIDConnectProviderStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -819,6 +829,7 @@ func (in *PolicySpec) DeepCopy() *PolicySpec {
 func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -929,6 +940,16 @@ func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PermissionsBoundaryRef != nil {
+		in, out := &in.PermissionsBoundaryRef, &out.PermissionsBoundaryRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PermissionsBoundarySelector != nil {
+		in, out := &in.PermissionsBoundarySelector, &out.PermissionsBoundarySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -1076,6 +1097,7 @@ func (in *RolePolicyAttachmentSpec) DeepCopy() *RolePolicyAttachmentSpec {
 func (in *RolePolicyAttachmentStatus) DeepCopyInto(out *RolePolicyAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1110,6 +1132,7 @@ func (in *RoleSpec) DeepCopy() *RoleSpec {
 func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1225,6 +1248,16 @@ func (in *UserParameters) DeepCopyInto(out *UserParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PermissionsBoundaryRef != nil {
+		in, out := &in.PermissionsBoundaryRef, &out.PermissionsBoundaryRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PermissionsBoundarySelector != nil {
+		in, out := &in.PermissionsBoundarySelector, &out.PermissionsBoundarySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -1372,6 +1405,7 @@ func (in *UserPolicyAttachmentSpec) DeepCopy() *UserPolicyAttachmentSpec {
 func (in *UserPolicyAttachmentStatus) DeepCopyInto(out *UserPolicyAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1406,6 +1440,7 @@ func (in *UserSpec) DeepCopy() *UserSpec {
 func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
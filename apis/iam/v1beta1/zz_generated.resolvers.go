@@ -135,6 +135,32 @@ func (mg *GroupUserMembership) ResolveReferences(ctx context.Context, c client.R
 	return nil
 }
 
+// ResolveReferences of this Role.
+func (mg *Role) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PermissionsBoundary),
+		Extract:      PolicyARN(),
+		Reference:    mg.Spec.ForProvider.PermissionsBoundaryRef,
+		Selector:     mg.Spec.ForProvider.PermissionsBoundarySelector,
+		To: reference.To{
+			List:    &PolicyList{},
+			Managed: &Policy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.PermissionsBoundary")
+	}
+	mg.Spec.ForProvider.PermissionsBoundary = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PermissionsBoundaryRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this RolePolicyAttachment.
 func (mg *RolePolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -177,6 +203,32 @@ func (mg *RolePolicyAttachment) ResolveReferences(ctx context.Context, c client.
 	return nil
 }
 
+// ResolveReferences of this User.
+func (mg *User) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PermissionsBoundary),
+		Extract:      PolicyARN(),
+		Reference:    mg.Spec.ForProvider.PermissionsBoundaryRef,
+		Selector:     mg.Spec.ForProvider.PermissionsBoundarySelector,
+		To: reference.To{
+			List:    &PolicyList{},
+			Managed: &Policy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.PermissionsBoundary")
+	}
+	mg.Spec.ForProvider.PermissionsBoundary = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PermissionsBoundaryRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this UserPolicyAttachment.
 func (mg *UserPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
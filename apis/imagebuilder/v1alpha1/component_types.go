@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ComponentParameters define the desired state of an Image Builder
+// component. Component versions cannot be changed once they are created, so
+// every field but the tags is immutable. Create a component with a new
+// SemanticVersion to change its document.
+type ComponentParameters struct {
+	// Region is the region you'd like your Component to be created in.
+	Region string `json:"region"`
+
+	// The name of the component.
+	// +immutable
+	Name string `json:"name"`
+
+	// The semantic version of the component, in the format
+	// <major>.<minor>.<patch>.
+	// +immutable
+	SemanticVersion string `json:"semanticVersion"`
+
+	// The operating system platform of the component.
+	// +kubebuilder:validation:Enum=Windows;Linux
+	// +immutable
+	Platform string `json:"platform"`
+
+	// The YAML document of the component. Specify either Data or URI, but
+	// not both.
+	// +optional
+	// +immutable
+	Data *string `json:"data,omitempty"`
+
+	// The S3 URI of the YAML document of the component. Specify either Data
+	// or URI, but not both.
+	// +optional
+	// +immutable
+	URI *string `json:"uri,omitempty"`
+
+	// The change description of the component version.
+	// +optional
+	// +immutable
+	ChangeDescription *string `json:"changeDescription,omitempty"`
+
+	// The description of the component.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// The ARN of the KMS key that is used to encrypt the component.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kms/v1alpha1.Key
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/kms/v1alpha1.KMSKeyARN()
+	// +optional
+	// +immutable
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef is a reference to a KMS Key used to set KMSKeyID.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a KMS Key used to set
+	// KMSKeyID.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
+	// The operating system versions supported by the component, for example
+	// "Amazon Linux 2".
+	// +optional
+	// +immutable
+	SupportedOSVersions []string `json:"supportedOsVersions,omitempty"`
+
+	// The tags of the component.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A ComponentSpec defines the desired state of a Component.
+type ComponentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ComponentParameters `json:"forProvider"`
+}
+
+// ComponentObservation keeps the state for the external resource
+type ComponentObservation struct {
+	// The Amazon Resource Name (ARN) of the component build version.
+	ARN string `json:"arn,omitempty"`
+
+	// The owner of the component.
+	Owner string `json:"owner,omitempty"`
+
+	// The type of the component, either BUILD or TEST.
+	Type string `json:"type,omitempty"`
+
+	// Indicates whether the component is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+
+	// The date the component was created.
+	DateCreated string `json:"dateCreated,omitempty"`
+
+	// The status of the component.
+	Status string `json:"status,omitempty"`
+}
+
+// A ComponentStatus represents the observed state of a Component.
+type ComponentStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ComponentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Component is a managed resource that represents a version of an EC2
+// Image Builder component, a document that defines the steps to customize
+// or test an image.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.semanticVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Component struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ComponentSpec   `json:"spec"`
+	Status ComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComponentList contains a list of Components
+type ComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Component `json:"items"`
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ImageTestsConfiguration configures the tests run on the images built by a
+// pipeline.
+type ImageTestsConfiguration struct {
+	// Indicates whether the images are tested.
+	// +optional
+	ImageTestsEnabled *bool `json:"imageTestsEnabled,omitempty"`
+
+	// The maximum time in minutes the tests may run.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=1440
+	// +optional
+	TimeoutMinutes *int32 `json:"timeoutMinutes,omitempty"`
+}
+
+// Schedule configures when a pipeline builds new images.
+type Schedule struct {
+	// The cron expression that determines how often the pipeline runs, for
+	// example cron(0 0 * * ? *).
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// The condition under which a scheduled run starts a build. With
+	// EXPRESSION_MATCH_AND_DEPENDENCY_UPDATES_AVAILABLE, images are only built
+	// if newer versions of the parent image or components are available.
+	// +kubebuilder:validation:Enum=EXPRESSION_MATCH_ONLY;EXPRESSION_MATCH_AND_DEPENDENCY_UPDATES_AVAILABLE
+	// +optional
+	PipelineExecutionStartCondition *string `json:"pipelineExecutionStartCondition,omitempty"`
+
+	// The timezone of the schedule expression, in IANA format. Defaults to
+	// UTC.
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// ImagePipelineParameters define the desired state of an Image Builder image
+// pipeline.
+type ImagePipelineParameters struct {
+	// Region is the region you'd like your ImagePipeline to be created in.
+	Region string `json:"region"`
+
+	// The name of the image pipeline.
+	// +immutable
+	Name string `json:"name"`
+
+	// The ARN of the image recipe the pipeline builds.
+	// +crossplane:generate:reference:type=ImageRecipe
+	// +optional
+	ImageRecipeARN *string `json:"imageRecipeArn,omitempty"`
+
+	// ImageRecipeARNRef is a reference to an ImageRecipe used to set
+	// ImageRecipeARN.
+	// +optional
+	ImageRecipeARNRef *xpv1.Reference `json:"imageRecipeArnRef,omitempty"`
+
+	// ImageRecipeARNSelector selects a reference to an ImageRecipe used to
+	// set ImageRecipeARN.
+	// +optional
+	ImageRecipeARNSelector *xpv1.Selector `json:"imageRecipeArnSelector,omitempty"`
+
+	// The ARN of the infrastructure configuration the images are built with.
+	// +crossplane:generate:reference:type=InfrastructureConfiguration
+	// +optional
+	InfrastructureConfigurationARN *string `json:"infrastructureConfigurationArn,omitempty"`
+
+	// InfrastructureConfigurationARNRef is a reference to an
+	// InfrastructureConfiguration used to set InfrastructureConfigurationARN.
+	// +optional
+	InfrastructureConfigurationARNRef *xpv1.Reference `json:"infrastructureConfigurationArnRef,omitempty"`
+
+	// InfrastructureConfigurationARNSelector selects a reference to an
+	// InfrastructureConfiguration used to set InfrastructureConfigurationARN.
+	// +optional
+	InfrastructureConfigurationARNSelector *xpv1.Selector `json:"infrastructureConfigurationArnSelector,omitempty"`
+
+	// The ARN of the distribution configuration that determines where the
+	// images are distributed to.
+	// +optional
+	DistributionConfigurationARN *string `json:"distributionConfigurationArn,omitempty"`
+
+	// The description of the image pipeline.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Indicates whether additional information about the images, such as the
+	// installed packages, is collected.
+	// +optional
+	EnhancedImageMetadataEnabled *bool `json:"enhancedImageMetadataEnabled,omitempty"`
+
+	// The configuration of the image tests.
+	// +optional
+	ImageTestsConfiguration *ImageTestsConfiguration `json:"imageTestsConfiguration,omitempty"`
+
+	// The schedule of the pipeline. Pipelines without a schedule only build
+	// images when they are started manually.
+	// +optional
+	Schedule *Schedule `json:"schedule,omitempty"`
+
+	// Indicates whether the pipeline is enabled.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// The tags of the image pipeline.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An ImagePipelineSpec defines the desired state of an ImagePipeline.
+type ImagePipelineSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImagePipelineParameters `json:"forProvider"`
+}
+
+// ImagePipelineObservation keeps the state for the external resource
+type ImagePipelineObservation struct {
+	// The Amazon Resource Name (ARN) of the image pipeline.
+	ARN string `json:"arn,omitempty"`
+
+	// The operating system platform of the image pipeline.
+	Platform string `json:"platform,omitempty"`
+
+	// The date the image pipeline was created.
+	DateCreated string `json:"dateCreated,omitempty"`
+
+	// The date the image pipeline was last updated.
+	DateUpdated string `json:"dateUpdated,omitempty"`
+
+	// The date the image pipeline last ran.
+	DateLastRun string `json:"dateLastRun,omitempty"`
+
+	// The date the image pipeline runs next.
+	DateNextRun string `json:"dateNextRun,omitempty"`
+}
+
+// An ImagePipelineStatus represents the observed state of an ImagePipeline.
+type ImagePipelineStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ImagePipelineObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ImagePipeline is a managed resource that represents an EC2 Image Builder
+// image pipeline, which builds images from an image recipe on a schedule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ImagePipeline struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImagePipelineSpec   `json:"spec"`
+	Status ImagePipelineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImagePipelineList contains a list of ImagePipelines
+type ImagePipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImagePipeline `json:"items"`
+}
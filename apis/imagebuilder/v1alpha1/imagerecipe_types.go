@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ComponentParameter is a value for a parameter of a component.
+type ComponentParameter struct {
+	// The name of the parameter.
+	Name string `json:"name"`
+
+	// The value of the parameter.
+	Value []string `json:"value"`
+}
+
+// ComponentConfiguration is a component that is applied by an image recipe.
+type ComponentConfiguration struct {
+	// The ARN of the component.
+	// +crossplane:generate:reference:type=Component
+	// +optional
+	ComponentARN *string `json:"componentArn,omitempty"`
+
+	// ComponentARNRef is a reference to a Component used to set
+	// ComponentARN.
+	// +optional
+	ComponentARNRef *xpv1.Reference `json:"componentArnRef,omitempty"`
+
+	// ComponentARNSelector selects a reference to a Component used to set
+	// ComponentARN.
+	// +optional
+	ComponentARNSelector *xpv1.Selector `json:"componentArnSelector,omitempty"`
+
+	// The parameters that are used to configure the component.
+	// +optional
+	Parameters []ComponentParameter `json:"parameters,omitempty"`
+}
+
+// EBSInstanceBlockDeviceSpecification describes the EBS volume of a block
+// device mapping.
+type EBSInstanceBlockDeviceSpecification struct {
+	// Indicates whether the volume is deleted on instance termination.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+
+	// Indicates whether the volume is encrypted.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// The number of I/O operations per second to provision for the volume.
+	// +optional
+	IOPS *int32 `json:"iops,omitempty"`
+
+	// The ARN of the KMS key used to encrypt the volume.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// The snapshot that defines the device contents.
+	// +optional
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// The throughput of gp3 volumes in MiB/s.
+	// +optional
+	Throughput *int32 `json:"throughput,omitempty"`
+
+	// The size of the volume in GiB.
+	// +optional
+	VolumeSize *int32 `json:"volumeSize,omitempty"`
+
+	// The type of the volume.
+	// +kubebuilder:validation:Enum=standard;io1;io2;gp2;gp3;sc1;st1
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+}
+
+// InstanceBlockDeviceMapping describes a block device of the build and test
+// instances, and of the resulting image.
+type InstanceBlockDeviceMapping struct {
+	// The device to which these mappings apply.
+	// +optional
+	DeviceName *string `json:"deviceName,omitempty"`
+
+	// The EBS volume to attach to the device.
+	// +optional
+	EBS *EBSInstanceBlockDeviceSpecification `json:"ebs,omitempty"`
+
+	// Suppresses the device mapping of the parent image when set to an
+	// empty string.
+	// +optional
+	NoDevice *string `json:"noDevice,omitempty"`
+
+	// The virtual device name of an instance store volume.
+	// +optional
+	VirtualName *string `json:"virtualName,omitempty"`
+}
+
+// SystemsManagerAgent configures the Systems Manager agent of the build
+// instance.
+type SystemsManagerAgent struct {
+	// Indicates whether the Systems Manager agent is removed from the image
+	// after the build.
+	// +optional
+	UninstallAfterBuild *bool `json:"uninstallAfterBuild,omitempty"`
+}
+
+// AdditionalInstanceConfiguration configures the build instance.
+type AdditionalInstanceConfiguration struct {
+	// The configuration of the Systems Manager agent.
+	// +optional
+	SystemsManagerAgent *SystemsManagerAgent `json:"systemsManagerAgent,omitempty"`
+
+	// The base64 encoded user data that replaces the commands Image Builder
+	// runs to install the Systems Manager agent.
+	// +optional
+	UserDataOverride *string `json:"userDataOverride,omitempty"`
+}
+
+// ImageRecipeParameters define the desired state of an Image Builder image
+// recipe. Image recipes cannot be changed once they are created, so every
+// field but the tags is immutable.
+type ImageRecipeParameters struct {
+	// Region is the region you'd like your ImageRecipe to be created in.
+	Region string `json:"region"`
+
+	// The name of the image recipe.
+	// +immutable
+	Name string `json:"name"`
+
+	// The semantic version of the image recipe, in the format
+	// <major>.<minor>.<patch>.
+	// +immutable
+	SemanticVersion string `json:"semanticVersion"`
+
+	// The image the recipe customizes, either an AMI ID or the ARN of an
+	// Image Builder image.
+	// +immutable
+	ParentImage string `json:"parentImage"`
+
+	// The components that are applied to the parent image, in order.
+	// +kubebuilder:validation:MinItems=1
+	// +immutable
+	Components []ComponentConfiguration `json:"components"`
+
+	// The block device mappings of the image.
+	// +optional
+	// +immutable
+	BlockDeviceMappings []InstanceBlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// The description of the image recipe.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// The working directory of the build and test workflows.
+	// +optional
+	// +immutable
+	WorkingDirectory *string `json:"workingDirectory,omitempty"`
+
+	// Additional configuration of the build instance.
+	// +optional
+	// +immutable
+	AdditionalInstanceConfiguration *AdditionalInstanceConfiguration `json:"additionalInstanceConfiguration,omitempty"`
+
+	// The tags of the image recipe.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An ImageRecipeSpec defines the desired state of an ImageRecipe.
+type ImageRecipeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImageRecipeParameters `json:"forProvider"`
+}
+
+// ImageRecipeObservation keeps the state for the external resource
+type ImageRecipeObservation struct {
+	// The Amazon Resource Name (ARN) of the image recipe.
+	ARN string `json:"arn,omitempty"`
+
+	// The owner of the image recipe.
+	Owner string `json:"owner,omitempty"`
+
+	// The operating system platform of the image recipe.
+	Platform string `json:"platform,omitempty"`
+
+	// The date the image recipe was created.
+	DateCreated string `json:"dateCreated,omitempty"`
+}
+
+// An ImageRecipeStatus represents the observed state of an ImageRecipe.
+type ImageRecipeStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ImageRecipeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ImageRecipe is a managed resource that represents a version of an EC2
+// Image Builder image recipe, which defines the parent image and the
+// components applied to it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.semanticVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ImageRecipe struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageRecipeSpec   `json:"spec"`
+	Status ImageRecipeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageRecipeList contains a list of ImageRecipes
+type ImageRecipeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageRecipe `json:"items"`
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// S3Logs configures the upload of build logs to S3.
+type S3Logs struct {
+	// The name of the S3 bucket the logs are uploaded to.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/s3/v1beta1.Bucket
+	// +optional
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+
+	// S3BucketNameRef is a reference to a Bucket used to set S3BucketName.
+	// +optional
+	S3BucketNameRef *xpv1.Reference `json:"s3BucketNameRef,omitempty"`
+
+	// S3BucketNameSelector selects a reference to a Bucket used to set
+	// S3BucketName.
+	// +optional
+	S3BucketNameSelector *xpv1.Selector `json:"s3BucketNameSelector,omitempty"`
+
+	// The key prefix of the uploaded logs.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+}
+
+// Logging configures the logs of the build instances.
+type Logging struct {
+	// The S3 configuration of the logs.
+	// +optional
+	S3Logs *S3Logs `json:"s3Logs,omitempty"`
+}
+
+// InstanceMetadataOptions configures the instance metadata service of the
+// build and test instances.
+type InstanceMetadataOptions struct {
+	// The number of hops an instance metadata request can traverse.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	HTTPPutResponseHopLimit *int32 `json:"httpPutResponseHopLimit,omitempty"`
+
+	// Indicates whether a signed token is required for instance metadata
+	// requests.
+	// +kubebuilder:validation:Enum=required;optional
+	// +optional
+	HTTPTokens *string `json:"httpTokens,omitempty"`
+}
+
+// InfrastructureConfigurationParameters define the desired state of an
+// Image Builder infrastructure configuration.
+type InfrastructureConfigurationParameters struct {
+	// Region is the region you'd like your InfrastructureConfiguration to be
+	// created in.
+	Region string `json:"region"`
+
+	// The name of the infrastructure configuration.
+	// +immutable
+	Name string `json:"name"`
+
+	// The name of the instance profile of the build and test instances.
+	InstanceProfileName string `json:"instanceProfileName"`
+
+	// The instance types of the build and test instances. Image Builder uses
+	// the first type that has capacity available.
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+
+	// The ID of the subnet the build and test instances are launched into.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef is a reference to a Subnet used to set SubnetID.
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet used to set SubnetID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The IDs of the security groups of the build and test instances.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	// +crossplane:generate:reference:refFieldName=SecurityGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SecurityGroupIDSelector
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a list of references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// The name of the key pair used to connect to the build and test
+	// instances.
+	// +optional
+	KeyPair *string `json:"keyPair,omitempty"`
+
+	// The logging configuration of the build and test instances.
+	// +optional
+	Logging *Logging `json:"logging,omitempty"`
+
+	// The ARN of the SNS topic Image Builder publishes build notifications
+	// to.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/sns/v1beta1.Topic
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/sns/v1beta1.SNSTopicARN()
+	// +optional
+	SNSTopicARN *string `json:"snsTopicArn,omitempty"`
+
+	// SNSTopicARNRef is a reference to a Topic used to set SNSTopicARN.
+	// +optional
+	SNSTopicARNRef *xpv1.Reference `json:"snsTopicArnRef,omitempty"`
+
+	// SNSTopicARNSelector selects a reference to a Topic used to set
+	// SNSTopicARN.
+	// +optional
+	SNSTopicARNSelector *xpv1.Selector `json:"snsTopicArnSelector,omitempty"`
+
+	// Indicates whether the instance is terminated when the build or test
+	// fails. Set it to false to keep the instance for troubleshooting.
+	// +optional
+	TerminateInstanceOnFailure *bool `json:"terminateInstanceOnFailure,omitempty"`
+
+	// The instance metadata options of the build and test instances.
+	// +optional
+	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+
+	// The description of the infrastructure configuration.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The tags that are attached to the build and test instances.
+	// +optional
+	ResourceTags map[string]string `json:"resourceTags,omitempty"`
+
+	// The tags of the infrastructure configuration.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// An InfrastructureConfigurationSpec defines the desired state of an
+// InfrastructureConfiguration.
+type InfrastructureConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InfrastructureConfigurationParameters `json:"forProvider"`
+}
+
+// InfrastructureConfigurationObservation keeps the state for the external
+// resource
+type InfrastructureConfigurationObservation struct {
+	// The Amazon Resource Name (ARN) of the infrastructure configuration.
+	ARN string `json:"arn,omitempty"`
+
+	// The date the infrastructure configuration was created.
+	DateCreated string `json:"dateCreated,omitempty"`
+
+	// The date the infrastructure configuration was last updated.
+	DateUpdated string `json:"dateUpdated,omitempty"`
+}
+
+// An InfrastructureConfigurationStatus represents the observed state of an
+// InfrastructureConfiguration.
+type InfrastructureConfigurationStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            InfrastructureConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InfrastructureConfiguration is a managed resource that represents an EC2
+// Image Builder infrastructure configuration, which defines the instances
+// images are built and tested on.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type InfrastructureConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InfrastructureConfigurationSpec   `json:"spec"`
+	Status InfrastructureConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InfrastructureConfigurationList contains a list of
+// InfrastructureConfigurations
+type InfrastructureConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InfrastructureConfiguration `json:"items"`
+}
@@ -673,6 +673,7 @@ func (in *PolicySpec) DeepCopy() *PolicySpec {
 func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1174,6 +1175,7 @@ func (in *ThingSpec) DeepCopy() *ThingSpec {
 func (in *ThingStatus) DeepCopyInto(out *ThingStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -35,6 +36,7 @@ type ClusterParameters struct {
 	EncryptionInfo *EncryptionInfo `json:"encryptionInfo,omitempty"`
 	// Specifies the level of monitoring for the MSK cluster. The possible values
 	// are DEFAULT, PER_BROKER, PER_TOPIC_PER_BROKER, and PER_TOPIC_PER_PARTITION.
+	// +kubebuilder:validation:Enum=DEFAULT;PER_BROKER;PER_TOPIC_PER_BROKER;PER_TOPIC_PER_PARTITION
 	EnhancedMonitoring *string `json:"enhancedMonitoring,omitempty"`
 	// The version of Apache Kafka.
 	// +kubebuilder:validation:Required
@@ -70,8 +72,9 @@ type ClusterObservation struct {
 
 // ClusterStatus defines the observed state of Cluster.
 type ClusterStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -707,6 +707,7 @@ func (in *StreamSpec) DeepCopy() *StreamSpec {
 func (in *StreamStatus) DeepCopyInto(out *StreamStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -21,6 +21,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -73,8 +74,9 @@ type AliasObservation struct {
 
 // AliasStatus defines the observed state of Alias.
 type AliasStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AliasObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            AliasObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -184,6 +184,7 @@ func (in *AliasSpec) DeepCopy() *AliasSpec {
 func (in *AliasStatus) DeepCopyInto(out *AliasStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -645,6 +646,7 @@ func (in *KeySpec) DeepCopy() *KeySpec {
 func (in *KeyStatus) DeepCopyInto(out *KeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -94,6 +95,7 @@ type KeyParameters struct {
 	//
 	//    * Other asymmetric elliptic curve key pairs ECC_SECG_P256K1 (secp256k1),
 	//    commonly used for cryptocurrencies.
+	// +kubebuilder:validation:Enum=RSA_2048;RSA_3072;RSA_4096;ECC_NIST_P256;ECC_NIST_P384;ECC_NIST_P521;ECC_SECG_P256K1;SYMMETRIC_DEFAULT;HMAC_224;HMAC_256;HMAC_384;HMAC_512;SM2
 	CustomerMasterKeySpec *string `json:"customerMasterKeySpec,omitempty"`
 	// A description of the CMK.
 	//
@@ -113,6 +115,7 @@ type KeyParameters struct {
 	//    SIGN_VERIFY.
 	//
 	//    * For asymmetric CMKs with ECC key material, specify SIGN_VERIFY.
+	// +kubebuilder:validation:Enum=SIGN_VERIFY;ENCRYPT_DECRYPT;GENERATE_VERIFY_MAC
 	KeyUsage *string `json:"keyUsage,omitempty"`
 	// The source of the key material for the CMK. You cannot change the origin
 	// after you create the CMK. The default is AWS_KMS, which means AWS KMS creates
@@ -130,6 +133,7 @@ type KeyParameters struct {
 	// and creates its key material in the associated AWS CloudHSM cluster. You
 	// must also use the CustomKeyStoreId parameter to identify the custom key store.
 	// This value is valid only for symmetric CMKs.
+	// +kubebuilder:validation:Enum=AWS_KMS;EXTERNAL;AWS_CLOUDHSM;EXTERNAL_KEY_STORE
 	Origin *string `json:"origin,omitempty"`
 	// The key policy to attach to the CMK.
 	//
@@ -241,8 +245,9 @@ type KeyObservation struct {
 
 // KeyStatus defines the observed state of Key.
 type KeyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          KeyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            KeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AliasRoutingConfiguration shifts a share of the traffic of an alias to a
+// second version of the function.
+type AliasRoutingConfiguration struct {
+	// AdditionalVersionWeights maps a second version of the function to the
+	// share of the invocations, between 0 and 1, that it receives. The
+	// remaining invocations go to the FunctionVersion of the alias.
+	// +optional
+	AdditionalVersionWeights map[string]float64 `json:"additionalVersionWeights,omitempty"`
+}
+
+// AliasParameters define the desired state of a Lambda function alias. The
+// external name of the alias is its name.
+type AliasParameters struct {
+	// Region is the region of the function.
+	// +immutable
+	Region string `json:"region"`
+
+	// FunctionName is the name of the function the alias belongs to.
+	// +optional
+	// +immutable
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef references a Function to retrieve its name.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function to retrieve its
+	// name.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// FunctionVersion is the version of the function the alias invokes, e.g.
+	// 3 or $LATEST.
+	// +optional
+	FunctionVersion *string `json:"functionVersion,omitempty"`
+
+	// FunctionVersionRef references a Function to retrieve the version it
+	// published last. Functions with publish set publish a new version
+	// whenever their code or configuration changes.
+	// +optional
+	FunctionVersionRef *xpv1.Reference `json:"functionVersionRef,omitempty"`
+
+	// FunctionVersionSelector selects a reference to a Function to retrieve
+	// the version it published last.
+	// +optional
+	FunctionVersionSelector *xpv1.Selector `json:"functionVersionSelector,omitempty"`
+
+	// Description of the alias.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// RoutingConfig shifts a share of the invocations of the alias to a
+	// second version, e.g. to roll out a new version gradually.
+	// +optional
+	RoutingConfig *AliasRoutingConfiguration `json:"routingConfig,omitempty"`
+}
+
+// An AliasSpec defines the desired state of an Alias.
+type AliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AliasParameters `json:"forProvider"`
+}
+
+// AliasObservation keeps the state for the external resource
+type AliasObservation struct {
+	// AliasARN is the ARN of the alias.
+	AliasARN string `json:"aliasArn,omitempty"`
+
+	// RevisionID is the identifier of the revision of the alias.
+	RevisionID string `json:"revisionId,omitempty"`
+}
+
+// An AliasStatus represents the observed state of an Alias.
+type AliasStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            AliasObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Alias is a managed resource that represents an AWS Lambda function
+// alias, a named pointer to one or two versions of a function.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FUNCTION",type="string",JSONPath=".spec.forProvider.functionName"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.functionVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Alias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AliasSpec   `json:"spec"`
+	Status AliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AliasList contains a list of Aliases
+type AliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Alias `json:"items"`
+}
+
+// Alias type metadata.
+var (
+	AliasKind             = "Alias"
+	AliasGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AliasKind}.String()
+	AliasKindAPIVersion   = AliasKind + "." + GroupVersion.String()
+	AliasGroupVersionKind = GroupVersion.WithKind(AliasKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Alias{}, &AliasList{})
+}
@@ -47,25 +47,87 @@ type CustomFunctionParameters struct {
 	// +optional
 	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
 
+	// LayerRefs is a list of references to LayerVersions used to set the
+	// Layers. The version of a layer that a LayerVersion published last when
+	// the reference was resolved is used.
+	// +optional
+	LayerRefs []xpv1.Reference `json:"layerRefs,omitempty"`
+
+	// LayerSelector selects references to LayerVersions used to set the
+	// Layers.
+	// +optional
+	LayerSelector *xpv1.Selector `json:"layerSelector,omitempty"`
+
+	// SecretEnvironment are environment variables whose values are read from
+	// Secrets, so that they are not stored in the Function. They take
+	// precedence over the variables of Environment with the same name. Their
+	// values are never reported in the status.
+	// +optional
+	SecretEnvironment []SecretEnvironmentVariable `json:"secretEnvironment,omitempty"`
+
+	// SnapStart configures the snapshots that Lambda takes of the initialized
+	// execution environment of published versions to start them faster.
+	// +optional
+	SnapStart *FunctionSnapStart `json:"snapStart,omitempty"`
+
+	// EphemeralStorage configures the size of the /tmp directory of the
+	// function.
+	// +optional
+	EphemeralStorage *FunctionEphemeralStorage `json:"ephemeralStorage,omitempty"`
+
 	// For network connectivity to AWS resources in a VPC, specify a list of security
 	// groups and subnets in the VPC. When you connect a function to a VPC, it can
 	// only access resources and the internet through that VPC. For more information,
 	// see VPC Settings (https://docs.aws.amazon.com/lambda/latest/dg/configuration-vpc.html).
 	CustomFunctionVPCConfigParameters *CustomFunctionVPCConfigParameters `json:"vpcConfig,omitempty"`
 
-	// The code for the function.
+	// The code for the function. The code is deployed again whenever its
+	// image or S3 object changes.
 	// +kubebuilder:validation:Required
 	CustomFunctionCodeParameters CustomFunctionCodeParameters `json:"code"`
 }
 
+// FunctionSnapStart is the SnapStart setting of a function.
+type FunctionSnapStart struct {
+	// ApplyOn is set to PublishedVersions to take a snapshot of the
+	// initialized execution environment when a version is published.
+	// +kubebuilder:validation:Enum=PublishedVersions;None
+	ApplyOn *string `json:"applyOn,omitempty"`
+}
+
+// FunctionEphemeralStorage is the size of the /tmp directory of a function.
+type FunctionEphemeralStorage struct {
+	// Size of the /tmp directory in MB.
+	// +kubebuilder:validation:Minimum=512
+	// +kubebuilder:validation:Maximum=10240
+	Size *int64 `json:"size"`
+}
+
+// A SecretEnvironmentVariable is an environment variable of a function whose
+// value is read from a Secret.
+type SecretEnvironmentVariable struct {
+	// Name of the environment variable.
+	Name string `json:"name"`
+
+	// SecretKeyRef selects the key of the Secret that holds the value of the
+	// environment variable.
+	SecretKeyRef xpv1.SecretKeySelector `json:"secretKeyRef"`
+}
+
 // CustomFunctionCodeParameters includes custom fields for FunctionCode struct.
 type CustomFunctionCodeParameters struct {
+	// URI of a container image in the Amazon ECR registry.
 	ImageURI *string `json:"imageURI,omitempty"`
 
+	// The Amazon S3 key of the deployment package.
 	S3Key *string `json:"s3Key,omitempty"`
 
+	// For versioned objects, the version of the deployment package object to
+	// use.
 	S3ObjectVersion *string `json:"s3ObjectVersion,omitempty"`
 
+	// An Amazon S3 bucket in the same Amazon Web Services Region as your
+	// function.
 	S3Bucket *string `json:"s3Bucket,omitempty"`
 
 	// S3BucketRef is a reference to an S3 Bucket.
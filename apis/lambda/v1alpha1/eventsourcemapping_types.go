@@ -0,0 +1,283 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Event source mapping states.
+const (
+	EventSourceMappingStateCreating  = "Creating"
+	EventSourceMappingStateEnabling  = "Enabling"
+	EventSourceMappingStateEnabled   = "Enabled"
+	EventSourceMappingStateDisabling = "Disabling"
+	EventSourceMappingStateDisabled  = "Disabled"
+	EventSourceMappingStateUpdating  = "Updating"
+	EventSourceMappingStateDeleting  = "Deleting"
+)
+
+// EventSourceMappingFilter is a pattern that the events of the source are
+// matched against.
+type EventSourceMappingFilter struct {
+	// Pattern is the JSON filter pattern, see
+	// https://docs.aws.amazon.com/lambda/latest/dg/invocation-eventfiltering.html#filtering-syntax.
+	Pattern string `json:"pattern"`
+}
+
+// EventSourceMappingFilterCriteria selects the events that invoke the
+// function.
+type EventSourceMappingFilterCriteria struct {
+	// Filters that an event has to match one of to invoke the function.
+	Filters []EventSourceMappingFilter `json:"filters"`
+}
+
+// EventSourceMappingOnFailure is the destination of the records that could not
+// be processed.
+type EventSourceMappingOnFailure struct {
+	// Destination is the ARN of an SQS queue or SNS topic.
+	Destination string `json:"destination"`
+}
+
+// EventSourceMappingDestinationConfig configures where the records that could
+// not be processed are sent to.
+type EventSourceMappingDestinationConfig struct {
+	// OnFailure is the destination of the records that could not be
+	// processed.
+	OnFailure EventSourceMappingOnFailure `json:"onFailure"`
+}
+
+// EventSourceMappingParameters define the desired state of a Lambda event
+// source mapping. The external name of the mapping is its UUID, which is
+// assigned by Lambda.
+type EventSourceMappingParameters struct {
+	// Region is the region of the function.
+	// +immutable
+	Region string `json:"region"`
+
+	// FunctionName is the name or ARN of the function, or of one of its
+	// aliases or versions.
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef references a Function to retrieve its name.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function to retrieve its
+	// name.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// EventSourceARN is the ARN of the SQS queue, Kinesis stream, DynamoDB
+	// stream or MSK cluster the function reads from.
+	// +optional
+	// +immutable
+	EventSourceARN *string `json:"eventSourceArn,omitempty"`
+
+	// QueueARNRef references an SQS Queue to retrieve its ARN as the event
+	// source.
+	// +optional
+	QueueARNRef *xpv1.Reference `json:"queueArnRef,omitempty"`
+
+	// QueueARNSelector selects a reference to an SQS Queue to retrieve its
+	// ARN as the event source.
+	// +optional
+	QueueARNSelector *xpv1.Selector `json:"queueArnSelector,omitempty"`
+
+	// KinesisStreamARNRef references a Kinesis Stream to retrieve its ARN as
+	// the event source.
+	// +optional
+	KinesisStreamARNRef *xpv1.Reference `json:"kinesisStreamArnRef,omitempty"`
+
+	// KinesisStreamARNSelector selects a reference to a Kinesis Stream to
+	// retrieve its ARN as the event source.
+	// +optional
+	KinesisStreamARNSelector *xpv1.Selector `json:"kinesisStreamArnSelector,omitempty"`
+
+	// DynamoDBStreamARNRef references a DynamoDB Table to retrieve the ARN of
+	// its stream as the event source.
+	// +optional
+	DynamoDBStreamARNRef *xpv1.Reference `json:"dynamoDBStreamArnRef,omitempty"`
+
+	// DynamoDBStreamARNSelector selects a reference to a DynamoDB Table to
+	// retrieve the ARN of its stream as the event source.
+	// +optional
+	DynamoDBStreamARNSelector *xpv1.Selector `json:"dynamoDBStreamArnSelector,omitempty"`
+
+	// MSKClusterARNRef references an MSK Cluster to retrieve its ARN as the
+	// event source.
+	// +optional
+	MSKClusterARNRef *xpv1.Reference `json:"mskClusterArnRef,omitempty"`
+
+	// MSKClusterARNSelector selects a reference to an MSK Cluster to retrieve
+	// its ARN as the event source.
+	// +optional
+	MSKClusterARNSelector *xpv1.Selector `json:"mskClusterArnSelector,omitempty"`
+
+	// Enabled is false to pause the polling of the event source.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// BatchSize is the maximum number of records in each batch that the
+	// function is invoked with.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	BatchSize *int64 `json:"batchSize,omitempty"`
+
+	// MaximumBatchingWindowInSeconds is the maximum time that records are
+	// gathered for before the function is invoked.
+	// +optional
+	MaximumBatchingWindowInSeconds *int64 `json:"maximumBatchingWindowInSeconds,omitempty"`
+
+	// FilterCriteria selects the events that invoke the function.
+	// +optional
+	FilterCriteria *EventSourceMappingFilterCriteria `json:"filterCriteria,omitempty"`
+
+	// StartingPosition is the position in a stream from which to start
+	// reading.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=TRIM_HORIZON;LATEST;AT_TIMESTAMP
+	StartingPosition *string `json:"startingPosition,omitempty"`
+
+	// StartingPositionTimestamp is the time from which to start reading when
+	// StartingPosition is AT_TIMESTAMP.
+	// +optional
+	// +immutable
+	StartingPositionTimestamp *metav1.Time `json:"startingPositionTimestamp,omitempty"`
+
+	// Topics are the names of the Kafka topics to read.
+	// +optional
+	// +immutable
+	Topics []string `json:"topics,omitempty"`
+
+	// DestinationConfig configures where the records of a stream that could
+	// not be processed are sent to.
+	// +optional
+	DestinationConfig *EventSourceMappingDestinationConfig `json:"destinationConfig,omitempty"`
+
+	// BisectBatchOnFunctionError splits a batch of stream records in two and
+	// retries both halves if the function returns an error.
+	// +optional
+	BisectBatchOnFunctionError *bool `json:"bisectBatchOnFunctionError,omitempty"`
+
+	// MaximumRecordAgeInSeconds discards stream records older than the given
+	// age. -1 keeps records until they expire.
+	// +optional
+	MaximumRecordAgeInSeconds *int64 `json:"maximumRecordAgeInSeconds,omitempty"`
+
+	// MaximumRetryAttempts discards stream records after the given number of
+	// retries. -1 retries until the record expires.
+	// +optional
+	MaximumRetryAttempts *int64 `json:"maximumRetryAttempts,omitempty"`
+
+	// ParallelizationFactor is the number of batches of each shard of a
+	// stream that are processed concurrently.
+	// +optional
+	ParallelizationFactor *int64 `json:"parallelizationFactor,omitempty"`
+
+	// TumblingWindowInSeconds is the duration of the processing windows of a
+	// stream.
+	// +optional
+	TumblingWindowInSeconds *int64 `json:"tumblingWindowInSeconds,omitempty"`
+
+	// FunctionResponseTypes lists the ways the function reports the outcome
+	// of a batch.
+	// +optional
+	FunctionResponseTypes []string `json:"functionResponseTypes,omitempty"`
+}
+
+// An EventSourceMappingSpec defines the desired state of an
+// EventSourceMapping.
+type EventSourceMappingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EventSourceMappingParameters `json:"forProvider"`
+}
+
+// EventSourceMappingObservation keeps the state for the external resource
+type EventSourceMappingObservation struct {
+	// UUID is the identifier of the event source mapping.
+	UUID string `json:"uuid,omitempty"`
+
+	// FunctionARN is the ARN of the function that is invoked.
+	FunctionARN string `json:"functionArn,omitempty"`
+
+	// State of the event source mapping.
+	State string `json:"state,omitempty"`
+
+	// StateTransitionReason explains why the state changed.
+	StateTransitionReason string `json:"stateTransitionReason,omitempty"`
+
+	// LastProcessingResult is the result of the last invocation of the
+	// function.
+	LastProcessingResult string `json:"lastProcessingResult,omitempty"`
+}
+
+// An EventSourceMappingStatus represents the observed state of an
+// EventSourceMapping.
+type EventSourceMappingStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            EventSourceMappingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EventSourceMapping is a managed resource that represents an AWS Lambda
+// event source mapping, which invokes a function with the records read from
+// an SQS queue, a Kinesis or DynamoDB stream or an MSK cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="FUNCTION",type="string",JSONPath=".spec.forProvider.functionName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EventSourceMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventSourceMappingSpec   `json:"spec"`
+	Status EventSourceMappingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EventSourceMappingList contains a list of EventSourceMappings
+type EventSourceMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSourceMapping `json:"items"`
+}
+
+// EventSourceMapping type metadata.
+var (
+	EventSourceMappingKind             = "EventSourceMapping"
+	EventSourceMappingGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: EventSourceMappingKind}.String()
+	EventSourceMappingKindAPIVersion   = EventSourceMappingKind + "." + GroupVersion.String()
+	EventSourceMappingGroupVersionKind = GroupVersion.WithKind(EventSourceMappingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EventSourceMapping{}, &EventSourceMappingList{})
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// LayerVersionContent is the S3 object that holds the archive of a layer.
+type LayerVersionContent struct {
+	// S3Bucket is the name of the bucket of the archive. The bucket must be
+	// in the same region as the layer.
+	// +optional
+	S3Bucket *string `json:"s3Bucket,omitempty"`
+
+	// S3BucketRef references a Bucket to retrieve its name.
+	// +optional
+	S3BucketRef *xpv1.Reference `json:"s3BucketRef,omitempty"`
+
+	// S3BucketSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	S3BucketSelector *xpv1.Selector `json:"s3BucketSelector,omitempty"`
+
+	// S3Key is the key of the archive.
+	// +optional
+	S3Key *string `json:"s3Key,omitempty"`
+
+	// S3KeyRef references an Object to retrieve its key.
+	// +optional
+	S3KeyRef *xpv1.Reference `json:"s3KeyRef,omitempty"`
+
+	// S3KeySelector selects a reference to an Object to retrieve its key.
+	// +optional
+	S3KeySelector *xpv1.Selector `json:"s3KeySelector,omitempty"`
+
+	// S3ObjectVersion is the version of the archive in a versioned bucket.
+	// +optional
+	S3ObjectVersion *string `json:"s3ObjectVersion,omitempty"`
+}
+
+// LayerVersionParameters define the desired state of the latest version of
+// an AWS Lambda layer.
+type LayerVersionParameters struct {
+	// Region is the region of the layer.
+	// +immutable
+	Region string `json:"region"`
+
+	// Content is the archive of the layer. A new version of the layer is
+	// published whenever it changes.
+	Content LayerVersionContent `json:"content"`
+
+	// Description of the version.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// CompatibleRuntimes are the function runtimes the layer can be used
+	// with, e.g. python3.9 or nodejs18.x.
+	// +optional
+	// +kubebuilder:validation:MaxItems=15
+	CompatibleRuntimes []*string `json:"compatibleRuntimes,omitempty"`
+
+	// CompatibleArchitectures are the instruction set architectures the
+	// layer can be used with, i.e. x86_64 and arm64.
+	// +optional
+	// +kubebuilder:validation:MaxItems=2
+	CompatibleArchitectures []*string `json:"compatibleArchitectures,omitempty"`
+
+	// LicenseInfo is the SPDX identifier of the license of the layer, the URL
+	// of the license or its full text.
+	// +optional
+	LicenseInfo *string `json:"licenseInfo,omitempty"`
+}
+
+// A LayerVersionSpec defines the desired state of a LayerVersion.
+type LayerVersionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LayerVersionParameters `json:"forProvider"`
+}
+
+// LayerVersionObservation keeps the state for the external resource
+type LayerVersionObservation struct {
+	// LayerARN is the ARN of the layer.
+	LayerARN string `json:"layerArn,omitempty"`
+
+	// LayerVersionARN is the ARN of the version that was published last.
+	// Functions that reference the LayerVersion use this version.
+	LayerVersionARN string `json:"layerVersionArn,omitempty"`
+
+	// Version is the number of the version that was published last.
+	Version int64 `json:"version,omitempty"`
+
+	// CreatedDate is the date the version was published.
+	CreatedDate string `json:"createdDate,omitempty"`
+
+	// CodeSHA256 is the SHA-256 hash of the archive of the version.
+	CodeSHA256 string `json:"codeSha256,omitempty"`
+
+	// CodeSize is the size of the archive of the version in bytes.
+	CodeSize int64 `json:"codeSize,omitempty"`
+}
+
+// A LayerVersionStatus represents the observed state of a LayerVersion.
+type LayerVersionStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            LayerVersionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LayerVersion is a managed resource that represents an AWS Lambda layer.
+// Versions of a layer cannot be changed, so a new version is published
+// whenever the desired state changes, and the version that was published
+// last is reported in the status. All versions of the layer are deleted with
+// the LayerVersion.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LayerVersion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LayerVersionSpec   `json:"spec"`
+	Status LayerVersionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LayerVersionList contains a list of LayerVersions
+type LayerVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LayerVersion `json:"items"`
+}
+
+// LayerVersion type metadata.
+var (
+	LayerVersionKind             = "LayerVersion"
+	LayerVersionGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: LayerVersionKind}.String()
+	LayerVersionKindAPIVersion   = LayerVersionKind + "." + GroupVersion.String()
+	LayerVersionGroupVersionKind = GroupVersion.WithKind(LayerVersionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LayerVersion{}, &LayerVersionList{})
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// PermissionParameters define the desired state of a statement of the
+// resource-based policy of a Lambda function. The external name of the
+// permission is the ID of the statement.
+type PermissionParameters struct {
+	// Region is the region of the function.
+	// +immutable
+	Region string `json:"region"`
+
+	// FunctionName is the name of the function.
+	// +optional
+	// +immutable
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef references a Function to retrieve its name.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function to retrieve its
+	// name.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// Qualifier is the alias or version of the function that the permission
+	// is granted for.
+	// +optional
+	// +immutable
+	Qualifier *string `json:"qualifier,omitempty"`
+
+	// Action that the principal is allowed to call, e.g.
+	// lambda:InvokeFunction.
+	Action string `json:"action"`
+
+	// Principal is the AWS service, e.g. s3.amazonaws.com, or the account ID
+	// or ARN that is granted the permission.
+	Principal string `json:"principal"`
+
+	// SourceARN limits the permission to invocations on behalf of the given
+	// resource, e.g. an S3 bucket, SNS topic or EventBridge rule.
+	// +optional
+	SourceARN *string `json:"sourceArn,omitempty"`
+
+	// SourceAccount limits the permission to invocations on behalf of
+	// resources of the given account.
+	// +optional
+	SourceAccount *string `json:"sourceAccount,omitempty"`
+
+	// PrincipalOrgID limits the permission to principals of the given AWS
+	// Organization.
+	// +optional
+	PrincipalOrgID *string `json:"principalOrgId,omitempty"`
+
+	// EventSourceToken is the token that Alexa Smart Home functions are
+	// invoked with.
+	// +optional
+	EventSourceToken *string `json:"eventSourceToken,omitempty"`
+}
+
+// A PermissionSpec defines the desired state of a Permission.
+type PermissionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PermissionParameters `json:"forProvider"`
+}
+
+// PermissionObservation keeps the state for the external resource
+type PermissionObservation struct {
+	// Statement is the JSON statement of the permission in the policy of the
+	// function.
+	Statement string `json:"statement,omitempty"`
+}
+
+// A PermissionStatus represents the observed state of a Permission.
+type PermissionStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            PermissionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Permission is a managed resource that represents a statement of the
+// resource-based policy of an AWS Lambda function, which grants an AWS
+// service or account access to the function. Since Lambda cannot change a
+// statement, it is replaced when its parameters change.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FUNCTION",type="string",JSONPath=".spec.forProvider.functionName"
+// +kubebuilder:printcolumn:name="PRINCIPAL",type="string",JSONPath=".spec.forProvider.principal"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Permission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PermissionSpec   `json:"spec"`
+	Status PermissionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PermissionList contains a list of Permissions
+type PermissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Permission `json:"items"`
+}
+
+// Permission type metadata.
+var (
+	PermissionKind             = "Permission"
+	PermissionGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PermissionKind}.String()
+	PermissionKindAPIVersion   = PermissionKind + "." + GroupVersion.String()
+	PermissionGroupVersionKind = GroupVersion.WithKind(PermissionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Permission{}, &PermissionList{})
+}
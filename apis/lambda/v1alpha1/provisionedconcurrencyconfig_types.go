@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Provisioned concurrency states.
+const (
+	ProvisionedConcurrencyStatusInProgress = "IN_PROGRESS"
+	ProvisionedConcurrencyStatusReady      = "READY"
+	ProvisionedConcurrencyStatusFailed     = "FAILED"
+)
+
+// ProvisionedConcurrencyConfigParameters define the desired state of the
+// provisioned concurrency of a Lambda function alias or version.
+type ProvisionedConcurrencyConfigParameters struct {
+	// Region is the region of the function.
+	// +immutable
+	Region string `json:"region"`
+
+	// FunctionName is the name of the function.
+	// +optional
+	// +immutable
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef references a Function to retrieve its name.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function to retrieve its
+	// name.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// Qualifier is the name of the alias or the number of the version that
+	// concurrency is provisioned for.
+	// +optional
+	// +immutable
+	Qualifier *string `json:"qualifier,omitempty"`
+
+	// QualifierRef references an Alias to retrieve its name.
+	// +optional
+	QualifierRef *xpv1.Reference `json:"qualifierRef,omitempty"`
+
+	// QualifierSelector selects a reference to an Alias to retrieve its
+	// name.
+	// +optional
+	QualifierSelector *xpv1.Selector `json:"qualifierSelector,omitempty"`
+
+	// ProvisionedConcurrentExecutions is the number of execution
+	// environments that are kept initialized.
+	// +kubebuilder:validation:Minimum=1
+	ProvisionedConcurrentExecutions int64 `json:"provisionedConcurrentExecutions"`
+}
+
+// A ProvisionedConcurrencyConfigSpec defines the desired state of a
+// ProvisionedConcurrencyConfig.
+type ProvisionedConcurrencyConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProvisionedConcurrencyConfigParameters `json:"forProvider"`
+}
+
+// ProvisionedConcurrencyConfigObservation keeps the state for the external
+// resource
+type ProvisionedConcurrencyConfigObservation struct {
+	// Status of the allocation of the execution environments.
+	Status string `json:"status,omitempty"`
+
+	// StatusReason explains why the allocation failed.
+	StatusReason string `json:"statusReason,omitempty"`
+
+	// AllocatedProvisionedConcurrentExecutions is the number of execution
+	// environments that are allocated.
+	AllocatedProvisionedConcurrentExecutions int64 `json:"allocatedProvisionedConcurrentExecutions,omitempty"`
+
+	// AvailableProvisionedConcurrentExecutions is the number of execution
+	// environments that are available.
+	AvailableProvisionedConcurrentExecutions int64 `json:"availableProvisionedConcurrentExecutions,omitempty"`
+}
+
+// A ProvisionedConcurrencyConfigStatus represents the observed state of a
+// ProvisionedConcurrencyConfig.
+type ProvisionedConcurrencyConfigStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ProvisionedConcurrencyConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProvisionedConcurrencyConfig is a managed resource that represents the
+// provisioned concurrency of an AWS Lambda function alias or version, which
+// keeps execution environments initialized to avoid cold starts.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FUNCTION",type="string",JSONPath=".spec.forProvider.functionName"
+// +kubebuilder:printcolumn:name="QUALIFIER",type="string",JSONPath=".spec.forProvider.qualifier"
+// +kubebuilder:printcolumn:name="AVAILABLE",type="integer",JSONPath=".status.atProvider.availableProvisionedConcurrentExecutions"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ProvisionedConcurrencyConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProvisionedConcurrencyConfigSpec   `json:"spec"`
+	Status ProvisionedConcurrencyConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProvisionedConcurrencyConfigList contains a list of
+// ProvisionedConcurrencyConfigs
+type ProvisionedConcurrencyConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProvisionedConcurrencyConfig `json:"items"`
+}
+
+// ProvisionedConcurrencyConfig type metadata.
+var (
+	ProvisionedConcurrencyConfigKind             = "ProvisionedConcurrencyConfig"
+	ProvisionedConcurrencyConfigGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ProvisionedConcurrencyConfigKind}.String()
+	ProvisionedConcurrencyConfigKindAPIVersion   = ProvisionedConcurrencyConfigKind + "." + GroupVersion.String()
+	ProvisionedConcurrencyConfigGroupVersionKind = GroupVersion.WithKind(ProvisionedConcurrencyConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProvisionedConcurrencyConfig{}, &ProvisionedConcurrencyConfigList{})
+}
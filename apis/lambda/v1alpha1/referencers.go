@@ -19,17 +19,62 @@ package v1alpha1
 import (
 	"context"
 
+	s3v1alpha3 "github.com/crossplane/provider-aws/apis/s3/v1alpha3"
 	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
 
+	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	kafkav1alpha1 "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
+	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
 	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// PublishedVersion returns the version that a Function published last.
+func PublishedVersion() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Function)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.Version == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.Version
+	}
+}
+
+// FunctionARN returns the ARN of a Function.
+func FunctionARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Function)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.FunctionARN)
+	}
+}
+
+// LayerVersionARN returns the ARN of the version that a LayerVersion published
+// last.
+func LayerVersionARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LayerVersion)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.LayerVersionARN
+	}
+}
+
 // ResolveReferences of this Function
 func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -93,13 +138,27 @@ func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) erro
 	mg.Spec.ForProvider.CustomFunctionCodeParameters.S3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CustomFunctionParameters.CustomFunctionCodeParameters.S3BucketRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.layers
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.Layers),
+		References:    mg.Spec.ForProvider.LayerRefs,
+		Selector:      mg.Spec.ForProvider.LayerSelector,
+		To:            reference.To{Managed: &LayerVersion{}, List: &LayerVersionList{}},
+		Extract:       LayerVersionARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.layers")
+	}
+	mg.Spec.ForProvider.Layers = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.LayerRefs = mrsp.ResolvedReferences
+
 	// Resolve spec.forProvider.kmsKeyARN
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KMSKeyARN),
 		Reference:    mg.Spec.ForProvider.KMSKeyARNRef,
 		Selector:     mg.Spec.ForProvider.KMSKeyARNSelector,
 		To:           reference.To{Managed: &kms.Key{}, List: &kms.KeyList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      awsv1beta1.CrossAccount(mg, reference.ExternalName(), kms.KMSKeyARN()),
 	})
 	if err != nil {
 		return errors.Wrap(err, "spec.forProvider.kmsKeyARN")
@@ -109,3 +168,199 @@ func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 	return nil
 }
+
+// ResolveReferences of this Alias
+func (mg *Alias) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.functionVersion
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionVersion),
+		Reference:    mg.Spec.ForProvider.FunctionVersionRef,
+		Selector:     mg.Spec.ForProvider.FunctionVersionSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      PublishedVersion(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionVersion")
+	}
+	mg.Spec.ForProvider.FunctionVersion = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionVersionRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EventSourceMapping
+func (mg *EventSourceMapping) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.eventSourceArn. Only one of the references to
+	// an event source is expected to be set; once the ARN is resolved, the
+	// remaining references are skipped.
+	sources := []struct {
+		ref     **xpv1.Reference
+		sel     *xpv1.Selector
+		to      reference.To
+		extract reference.ExtractValueFn
+	}{
+		{
+			ref:     &mg.Spec.ForProvider.QueueARNRef,
+			sel:     mg.Spec.ForProvider.QueueARNSelector,
+			to:      reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+			extract: sqsv1beta1.QueueARN(),
+		},
+		{
+			ref:     &mg.Spec.ForProvider.KinesisStreamARNRef,
+			sel:     mg.Spec.ForProvider.KinesisStreamARNSelector,
+			to:      reference.To{Managed: &kinesisv1alpha1.Stream{}, List: &kinesisv1alpha1.StreamList{}},
+			extract: kinesisv1alpha1.StreamARN(),
+		},
+		{
+			ref:     &mg.Spec.ForProvider.DynamoDBStreamARNRef,
+			sel:     mg.Spec.ForProvider.DynamoDBStreamARNSelector,
+			to:      reference.To{Managed: &dynamodbv1alpha1.Table{}, List: &dynamodbv1alpha1.TableList{}},
+			extract: dynamodbv1alpha1.LatestStreamARN(),
+		},
+		{
+			ref:     &mg.Spec.ForProvider.MSKClusterARNRef,
+			sel:     mg.Spec.ForProvider.MSKClusterARNSelector,
+			to:      reference.To{Managed: &kafkav1alpha1.Cluster{}, List: &kafkav1alpha1.ClusterList{}},
+			extract: kafkav1alpha1.ClusterARN(),
+		},
+	}
+	for _, s := range sources {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EventSourceARN),
+			Reference:    *s.ref,
+			Selector:     s.sel,
+			To:           s.to,
+			Extract:      s.extract,
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.eventSourceArn")
+		}
+		mg.Spec.ForProvider.EventSourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+		*s.ref = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this LayerVersion
+func (mg *LayerVersion) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.content.s3Bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Content.S3Bucket),
+		Reference:    mg.Spec.ForProvider.Content.S3BucketRef,
+		Selector:     mg.Spec.ForProvider.Content.S3BucketSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.content.s3Bucket")
+	}
+	mg.Spec.ForProvider.Content.S3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Content.S3BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.content.s3Key
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Content.S3Key),
+		Reference:    mg.Spec.ForProvider.Content.S3KeyRef,
+		Selector:     mg.Spec.ForProvider.Content.S3KeySelector,
+		To:           reference.To{Managed: &s3v1alpha3.Object{}, List: &s3v1alpha3.ObjectList{}},
+		Extract:      s3v1alpha3.ObjectKey(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.content.s3Key")
+	}
+	mg.Spec.ForProvider.Content.S3Key = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Content.S3KeyRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Permission
+func (mg *Permission) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ProvisionedConcurrencyConfig
+func (mg *ProvisionedConcurrencyConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.qualifier
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Qualifier),
+		Reference:    mg.Spec.ForProvider.QualifierRef,
+		Selector:     mg.Spec.ForProvider.QualifierSelector,
+		To:           reference.To{Managed: &Alias{}, List: &AliasList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.qualifier")
+	}
+	mg.Spec.ForProvider.Qualifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.QualifierRef = rsp.ResolvedReference
+
+	return nil
+}
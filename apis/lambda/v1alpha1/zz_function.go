@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -65,10 +66,12 @@ type FunctionParameters struct {
 	MemorySize *int64 `json:"memorySize,omitempty"`
 	// The type of deployment package. Set to Image for container image and set
 	// Zip for ZIP archive.
+	// +kubebuilder:validation:Enum=Zip;Image
 	PackageType *string `json:"packageType,omitempty"`
 	// Set to true to publish the first version of the function during creation.
 	Publish *bool `json:"publish,omitempty"`
 	// The identifier of the function's runtime (https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html).
+	// +kubebuilder:validation:Enum=nodejs;nodejs4.3;nodejs6.10;nodejs8.10;nodejs10.x;nodejs12.x;nodejs14.x;nodejs16.x;java8;java8.al2;java11;python2.7;python3.6;python3.7;python3.8;python3.9;dotnetcore1.0;dotnetcore2.0;dotnetcore2.1;dotnetcore3.1;dotnet6;nodejs4.3-edge;go1.x;ruby2.5;ruby2.7;provided;provided.al2;nodejs18.x;python3.10;java17;ruby3.2;python3.11;nodejs20.x;provided.al2023;python3.12;java21
 	Runtime *string `json:"runtime,omitempty"`
 	// A list of tags (https://docs.aws.amazon.com/lambda/latest/dg/tagging.html)
 	// to apply to the function.
@@ -136,8 +139,9 @@ type FunctionObservation struct {
 
 // FunctionStatus defines the observed state of Function.
 type FunctionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          FunctionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            FunctionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
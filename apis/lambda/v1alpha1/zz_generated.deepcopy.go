@@ -81,6 +81,192 @@ func (in *AccountUsage) DeepCopy() *AccountUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Alias) DeepCopyInto(out *Alias) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Alias.
+func (in *Alias) DeepCopy() *Alias {
+	if in == nil {
+		return nil
+	}
+	out := new(Alias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Alias) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasList) DeepCopyInto(out *AliasList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Alias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasList.
+func (in *AliasList) DeepCopy() *AliasList {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AliasList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasObservation) DeepCopyInto(out *AliasObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasObservation.
+func (in *AliasObservation) DeepCopy() *AliasObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasParameters) DeepCopyInto(out *AliasParameters) {
+	*out = *in
+	if in.FunctionName != nil {
+		in, out := &in.FunctionName, &out.FunctionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionNameRef != nil {
+		in, out := &in.FunctionNameRef, &out.FunctionNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionNameSelector != nil {
+		in, out := &in.FunctionNameSelector, &out.FunctionNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FunctionVersion != nil {
+		in, out := &in.FunctionVersion, &out.FunctionVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionVersionRef != nil {
+		in, out := &in.FunctionVersionRef, &out.FunctionVersionRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionVersionSelector != nil {
+		in, out := &in.FunctionVersionSelector, &out.FunctionVersionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoutingConfig != nil {
+		in, out := &in.RoutingConfig, &out.RoutingConfig
+		*out = new(AliasRoutingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasParameters.
+func (in *AliasParameters) DeepCopy() *AliasParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasRoutingConfiguration) DeepCopyInto(out *AliasRoutingConfiguration) {
+	*out = *in
+	if in.AdditionalVersionWeights != nil {
+		in, out := &in.AdditionalVersionWeights, &out.AdditionalVersionWeights
+		*out = make(map[string]float64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasRoutingConfiguration.
+func (in *AliasRoutingConfiguration) DeepCopy() *AliasRoutingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasRoutingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasSpec) DeepCopyInto(out *AliasSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasSpec.
+func (in *AliasSpec) DeepCopy() *AliasSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasStatus) DeepCopyInto(out *AliasStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasStatus.
+func (in *AliasStatus) DeepCopy() *AliasStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CodeSigningConfig) DeepCopyInto(out *CodeSigningConfig) {
 	*out = *in
@@ -199,6 +385,31 @@ func (in *CustomFunctionParameters) DeepCopyInto(out *CustomFunctionParameters)
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LayerRefs != nil {
+		in, out := &in.LayerRefs, &out.LayerRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LayerSelector != nil {
+		in, out := &in.LayerSelector, &out.LayerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretEnvironment != nil {
+		in, out := &in.SecretEnvironment, &out.SecretEnvironment
+		*out = make([]SecretEnvironmentVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.SnapStart != nil {
+		in, out := &in.SnapStart, &out.SnapStart
+		*out = new(FunctionSnapStart)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
+		*out = new(FunctionEphemeralStorage)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CustomFunctionVPCConfigParameters != nil {
 		in, out := &in.CustomFunctionVPCConfigParameters, &out.CustomFunctionVPCConfigParameters
 		*out = new(CustomFunctionVPCConfigParameters)
@@ -385,51 +596,109 @@ func (in *EnvironmentResponse) DeepCopy() *EnvironmentResponse {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileSystemConfig) DeepCopyInto(out *FileSystemConfig) {
+func (in *EventSourceMapping) DeepCopyInto(out *EventSourceMapping) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMapping.
+func (in *EventSourceMapping) DeepCopy() *EventSourceMapping {
+	if in == nil {
+		return nil
 	}
-	if in.LocalMountPath != nil {
-		in, out := &in.LocalMountPath, &out.LocalMountPath
-		*out = new(string)
-		**out = **in
+	out := new(EventSourceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSourceMapping) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemConfig.
-func (in *FileSystemConfig) DeepCopy() *FileSystemConfig {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingDestinationConfig) DeepCopyInto(out *EventSourceMappingDestinationConfig) {
+	*out = *in
+	out.OnFailure = in.OnFailure
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingDestinationConfig.
+func (in *EventSourceMappingDestinationConfig) DeepCopy() *EventSourceMappingDestinationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSystemConfig)
+	out := new(EventSourceMappingDestinationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Function) DeepCopyInto(out *Function) {
+func (in *EventSourceMappingFilter) DeepCopyInto(out *EventSourceMappingFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingFilter.
+func (in *EventSourceMappingFilter) DeepCopy() *EventSourceMappingFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingFilterCriteria) DeepCopyInto(out *EventSourceMappingFilterCriteria) {
+	*out = *in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]EventSourceMappingFilter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingFilterCriteria.
+func (in *EventSourceMappingFilterCriteria) DeepCopy() *EventSourceMappingFilterCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingFilterCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingList) DeepCopyInto(out *EventSourceMappingList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EventSourceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Function.
-func (in *Function) DeepCopy() *Function {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingList.
+func (in *EventSourceMappingList) DeepCopy() *EventSourceMappingList {
 	if in == nil {
 		return nil
 	}
-	out := new(Function)
+	out := new(EventSourceMappingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Function) DeepCopyObject() runtime.Object {
+func (in *EventSourceMappingList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -437,66 +706,327 @@ func (in *Function) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FunctionCode) DeepCopyInto(out *FunctionCode) {
+func (in *EventSourceMappingObservation) DeepCopyInto(out *EventSourceMappingObservation) {
 	*out = *in
-	if in.ImageURI != nil {
-		in, out := &in.ImageURI, &out.ImageURI
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionCode.
-func (in *FunctionCode) DeepCopy() *FunctionCode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingObservation.
+func (in *EventSourceMappingObservation) DeepCopy() *EventSourceMappingObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(FunctionCode)
+	out := new(EventSourceMappingObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FunctionCodeLocation) DeepCopyInto(out *FunctionCodeLocation) {
+func (in *EventSourceMappingOnFailure) DeepCopyInto(out *EventSourceMappingOnFailure) {
 	*out = *in
-	if in.ImageURI != nil {
-		in, out := &in.ImageURI, &out.ImageURI
-		*out = new(string)
-		**out = **in
-	}
-	if in.Location != nil {
-		in, out := &in.Location, &out.Location
-		*out = new(string)
-		**out = **in
-	}
-	if in.RepositoryType != nil {
-		in, out := &in.RepositoryType, &out.RepositoryType
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResolvedImageURI != nil {
-		in, out := &in.ResolvedImageURI, &out.ResolvedImageURI
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionCodeLocation.
-func (in *FunctionCodeLocation) DeepCopy() *FunctionCodeLocation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingOnFailure.
+func (in *EventSourceMappingOnFailure) DeepCopy() *EventSourceMappingOnFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(FunctionCodeLocation)
+	out := new(EventSourceMappingOnFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FunctionConfiguration) DeepCopyInto(out *FunctionConfiguration) {
+func (in *EventSourceMappingParameters) DeepCopyInto(out *EventSourceMappingParameters) {
 	*out = *in
-	if in.CodeSHA256 != nil {
-		in, out := &in.CodeSHA256, &out.CodeSHA256
-		*out = new(string)
+	if in.FunctionName != nil {
+		in, out := &in.FunctionName, &out.FunctionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionNameRef != nil {
+		in, out := &in.FunctionNameRef, &out.FunctionNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionNameSelector != nil {
+		in, out := &in.FunctionNameSelector, &out.FunctionNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventSourceARN != nil {
+		in, out := &in.EventSourceARN, &out.EventSourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueARNRef != nil {
+		in, out := &in.QueueARNRef, &out.QueueARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.QueueARNSelector != nil {
+		in, out := &in.QueueARNSelector, &out.QueueARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KinesisStreamARNRef != nil {
+		in, out := &in.KinesisStreamARNRef, &out.KinesisStreamARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KinesisStreamARNSelector != nil {
+		in, out := &in.KinesisStreamARNSelector, &out.KinesisStreamARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DynamoDBStreamARNRef != nil {
+		in, out := &in.DynamoDBStreamARNRef, &out.DynamoDBStreamARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DynamoDBStreamARNSelector != nil {
+		in, out := &in.DynamoDBStreamARNSelector, &out.DynamoDBStreamARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MSKClusterARNRef != nil {
+		in, out := &in.MSKClusterARNRef, &out.MSKClusterARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.MSKClusterARNSelector != nil {
+		in, out := &in.MSKClusterARNSelector, &out.MSKClusterARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaximumBatchingWindowInSeconds != nil {
+		in, out := &in.MaximumBatchingWindowInSeconds, &out.MaximumBatchingWindowInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FilterCriteria != nil {
+		in, out := &in.FilterCriteria, &out.FilterCriteria
+		*out = new(EventSourceMappingFilterCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartingPosition != nil {
+		in, out := &in.StartingPosition, &out.StartingPosition
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartingPositionTimestamp != nil {
+		in, out := &in.StartingPositionTimestamp, &out.StartingPositionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationConfig != nil {
+		in, out := &in.DestinationConfig, &out.DestinationConfig
+		*out = new(EventSourceMappingDestinationConfig)
+		**out = **in
+	}
+	if in.BisectBatchOnFunctionError != nil {
+		in, out := &in.BisectBatchOnFunctionError, &out.BisectBatchOnFunctionError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaximumRecordAgeInSeconds != nil {
+		in, out := &in.MaximumRecordAgeInSeconds, &out.MaximumRecordAgeInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaximumRetryAttempts != nil {
+		in, out := &in.MaximumRetryAttempts, &out.MaximumRetryAttempts
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ParallelizationFactor != nil {
+		in, out := &in.ParallelizationFactor, &out.ParallelizationFactor
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TumblingWindowInSeconds != nil {
+		in, out := &in.TumblingWindowInSeconds, &out.TumblingWindowInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FunctionResponseTypes != nil {
+		in, out := &in.FunctionResponseTypes, &out.FunctionResponseTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingParameters.
+func (in *EventSourceMappingParameters) DeepCopy() *EventSourceMappingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingSpec) DeepCopyInto(out *EventSourceMappingSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingSpec.
+func (in *EventSourceMappingSpec) DeepCopy() *EventSourceMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingStatus) DeepCopyInto(out *EventSourceMappingStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingStatus.
+func (in *EventSourceMappingStatus) DeepCopy() *EventSourceMappingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSystemConfig) DeepCopyInto(out *FileSystemConfig) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.LocalMountPath != nil {
+		in, out := &in.LocalMountPath, &out.LocalMountPath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemConfig.
+func (in *FileSystemConfig) DeepCopy() *FileSystemConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSystemConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Function) DeepCopyInto(out *Function) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Function.
+func (in *Function) DeepCopy() *Function {
+	if in == nil {
+		return nil
+	}
+	out := new(Function)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Function) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionCode) DeepCopyInto(out *FunctionCode) {
+	*out = *in
+	if in.ImageURI != nil {
+		in, out := &in.ImageURI, &out.ImageURI
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionCode.
+func (in *FunctionCode) DeepCopy() *FunctionCode {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionCodeLocation) DeepCopyInto(out *FunctionCodeLocation) {
+	*out = *in
+	if in.ImageURI != nil {
+		in, out := &in.ImageURI, &out.ImageURI
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepositoryType != nil {
+		in, out := &in.RepositoryType, &out.RepositoryType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResolvedImageURI != nil {
+		in, out := &in.ResolvedImageURI, &out.ResolvedImageURI
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionCodeLocation.
+func (in *FunctionCodeLocation) DeepCopy() *FunctionCodeLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionCodeLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionConfiguration) DeepCopyInto(out *FunctionConfiguration) {
+	*out = *in
+	if in.CodeSHA256 != nil {
+		in, out := &in.CodeSHA256, &out.CodeSHA256
+		*out = new(string)
 		**out = **in
 	}
 	if in.CodeSize != nil {
@@ -662,6 +1192,26 @@ func (in *FunctionConfiguration) DeepCopy() *FunctionConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionEphemeralStorage) DeepCopyInto(out *FunctionEphemeralStorage) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionEphemeralStorage.
+func (in *FunctionEphemeralStorage) DeepCopy() *FunctionEphemeralStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionEphemeralStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FunctionEventInvokeConfig) DeepCopyInto(out *FunctionEventInvokeConfig) {
 	*out = *in
@@ -942,6 +1492,26 @@ func (in *FunctionParameters) DeepCopy() *FunctionParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionSnapStart) DeepCopyInto(out *FunctionSnapStart) {
+	*out = *in
+	if in.ApplyOn != nil {
+		in, out := &in.ApplyOn, &out.ApplyOn
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionSnapStart.
+func (in *FunctionSnapStart) DeepCopy() *FunctionSnapStart {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionSnapStart)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FunctionSpec) DeepCopyInto(out *FunctionSpec) {
 	*out = *in
@@ -963,6 +1533,7 @@ func (in *FunctionSpec) DeepCopy() *FunctionSpec {
 func (in *FunctionStatus) DeepCopyInto(out *FunctionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1104,14 +1675,91 @@ func (in *Layer) DeepCopy() *Layer {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LayerVersionContentOutput) DeepCopyInto(out *LayerVersionContentOutput) {
+func (in *LayerVersion) DeepCopyInto(out *LayerVersion) {
 	*out = *in
-	if in.CodeSHA256 != nil {
-		in, out := &in.CodeSHA256, &out.CodeSHA256
-		*out = new(string)
-		**out = **in
-	}
-	if in.CodeSize != nil {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LayerVersion.
+func (in *LayerVersion) DeepCopy() *LayerVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(LayerVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LayerVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LayerVersionContent) DeepCopyInto(out *LayerVersionContent) {
+	*out = *in
+	if in.S3Bucket != nil {
+		in, out := &in.S3Bucket, &out.S3Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketRef != nil {
+		in, out := &in.S3BucketRef, &out.S3BucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.S3BucketSelector != nil {
+		in, out := &in.S3BucketSelector, &out.S3BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3Key != nil {
+		in, out := &in.S3Key, &out.S3Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3KeyRef != nil {
+		in, out := &in.S3KeyRef, &out.S3KeyRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.S3KeySelector != nil {
+		in, out := &in.S3KeySelector, &out.S3KeySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3ObjectVersion != nil {
+		in, out := &in.S3ObjectVersion, &out.S3ObjectVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LayerVersionContent.
+func (in *LayerVersionContent) DeepCopy() *LayerVersionContent {
+	if in == nil {
+		return nil
+	}
+	out := new(LayerVersionContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LayerVersionContentOutput) DeepCopyInto(out *LayerVersionContentOutput) {
+	*out = *in
+	if in.CodeSHA256 != nil {
+		in, out := &in.CodeSHA256, &out.CodeSHA256
+		*out = new(string)
+		**out = **in
+	}
+	if in.CodeSize != nil {
 		in, out := &in.CodeSize, &out.CodeSize
 		*out = new(int64)
 		**out = **in
@@ -1143,6 +1791,136 @@ func (in *LayerVersionContentOutput) DeepCopy() *LayerVersionContentOutput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LayerVersionList) DeepCopyInto(out *LayerVersionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LayerVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LayerVersionList.
+func (in *LayerVersionList) DeepCopy() *LayerVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(LayerVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LayerVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LayerVersionObservation) DeepCopyInto(out *LayerVersionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LayerVersionObservation.
+func (in *LayerVersionObservation) DeepCopy() *LayerVersionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LayerVersionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LayerVersionParameters) DeepCopyInto(out *LayerVersionParameters) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.CompatibleRuntimes != nil {
+		in, out := &in.CompatibleRuntimes, &out.CompatibleRuntimes
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.CompatibleArchitectures != nil {
+		in, out := &in.CompatibleArchitectures, &out.CompatibleArchitectures
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.LicenseInfo != nil {
+		in, out := &in.LicenseInfo, &out.LicenseInfo
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LayerVersionParameters.
+func (in *LayerVersionParameters) DeepCopy() *LayerVersionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LayerVersionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LayerVersionSpec) DeepCopyInto(out *LayerVersionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LayerVersionSpec.
+func (in *LayerVersionSpec) DeepCopy() *LayerVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LayerVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LayerVersionStatus) DeepCopyInto(out *LayerVersionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LayerVersionStatus.
+func (in *LayerVersionStatus) DeepCopy() *LayerVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LayerVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LayerVersionsListItem) DeepCopyInto(out *LayerVersionsListItem) {
 	*out = *in
@@ -1173,6 +1951,229 @@ func (in *LayerVersionsListItem) DeepCopy() *LayerVersionsListItem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Permission) DeepCopyInto(out *Permission) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permission.
+func (in *Permission) DeepCopy() *Permission {
+	if in == nil {
+		return nil
+	}
+	out := new(Permission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Permission) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionList) DeepCopyInto(out *PermissionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Permission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionList.
+func (in *PermissionList) DeepCopy() *PermissionList {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PermissionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionObservation) DeepCopyInto(out *PermissionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionObservation.
+func (in *PermissionObservation) DeepCopy() *PermissionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionParameters) DeepCopyInto(out *PermissionParameters) {
+	*out = *in
+	if in.FunctionName != nil {
+		in, out := &in.FunctionName, &out.FunctionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionNameRef != nil {
+		in, out := &in.FunctionNameRef, &out.FunctionNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionNameSelector != nil {
+		in, out := &in.FunctionNameSelector, &out.FunctionNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Qualifier != nil {
+		in, out := &in.Qualifier, &out.Qualifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceARN != nil {
+		in, out := &in.SourceARN, &out.SourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceAccount != nil {
+		in, out := &in.SourceAccount, &out.SourceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrincipalOrgID != nil {
+		in, out := &in.PrincipalOrgID, &out.PrincipalOrgID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventSourceToken != nil {
+		in, out := &in.EventSourceToken, &out.EventSourceToken
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionParameters.
+func (in *PermissionParameters) DeepCopy() *PermissionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSpec) DeepCopyInto(out *PermissionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSpec.
+func (in *PermissionSpec) DeepCopy() *PermissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionStatus) DeepCopyInto(out *PermissionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionStatus.
+func (in *PermissionStatus) DeepCopy() *PermissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionedConcurrencyConfig) DeepCopyInto(out *ProvisionedConcurrencyConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionedConcurrencyConfig.
+func (in *ProvisionedConcurrencyConfig) DeepCopy() *ProvisionedConcurrencyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionedConcurrencyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisionedConcurrencyConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionedConcurrencyConfigList) DeepCopyInto(out *ProvisionedConcurrencyConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProvisionedConcurrencyConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionedConcurrencyConfigList.
+func (in *ProvisionedConcurrencyConfigList) DeepCopy() *ProvisionedConcurrencyConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionedConcurrencyConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisionedConcurrencyConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisionedConcurrencyConfigListItem) DeepCopyInto(out *ProvisionedConcurrencyConfigListItem) {
 	*out = *in
@@ -1203,6 +2204,101 @@ func (in *ProvisionedConcurrencyConfigListItem) DeepCopy() *ProvisionedConcurren
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionedConcurrencyConfigObservation) DeepCopyInto(out *ProvisionedConcurrencyConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionedConcurrencyConfigObservation.
+func (in *ProvisionedConcurrencyConfigObservation) DeepCopy() *ProvisionedConcurrencyConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionedConcurrencyConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionedConcurrencyConfigParameters) DeepCopyInto(out *ProvisionedConcurrencyConfigParameters) {
+	*out = *in
+	if in.FunctionName != nil {
+		in, out := &in.FunctionName, &out.FunctionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionNameRef != nil {
+		in, out := &in.FunctionNameRef, &out.FunctionNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionNameSelector != nil {
+		in, out := &in.FunctionNameSelector, &out.FunctionNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Qualifier != nil {
+		in, out := &in.Qualifier, &out.Qualifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.QualifierRef != nil {
+		in, out := &in.QualifierRef, &out.QualifierRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.QualifierSelector != nil {
+		in, out := &in.QualifierSelector, &out.QualifierSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionedConcurrencyConfigParameters.
+func (in *ProvisionedConcurrencyConfigParameters) DeepCopy() *ProvisionedConcurrencyConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionedConcurrencyConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionedConcurrencyConfigSpec) DeepCopyInto(out *ProvisionedConcurrencyConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionedConcurrencyConfigSpec.
+func (in *ProvisionedConcurrencyConfigSpec) DeepCopy() *ProvisionedConcurrencyConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionedConcurrencyConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionedConcurrencyConfigStatus) DeepCopyInto(out *ProvisionedConcurrencyConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionedConcurrencyConfigStatus.
+func (in *ProvisionedConcurrencyConfigStatus) DeepCopy() *ProvisionedConcurrencyConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionedConcurrencyConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PutFunctionConcurrencyOutput) DeepCopyInto(out *PutFunctionConcurrencyOutput) {
 	*out = *in
@@ -1223,6 +2319,22 @@ func (in *PutFunctionConcurrencyOutput) DeepCopy() *PutFunctionConcurrencyOutput
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretEnvironmentVariable) DeepCopyInto(out *SecretEnvironmentVariable) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEnvironmentVariable.
+func (in *SecretEnvironmentVariable) DeepCopy() *SecretEnvironmentVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretEnvironmentVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingConfig) DeepCopyInto(out *TracingConfig) {
 	*out = *in
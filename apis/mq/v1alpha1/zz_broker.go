@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -30,6 +31,7 @@ type BrokerParameters struct {
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
 
+	// +kubebuilder:validation:Enum=SIMPLE;LDAP
 	AuthenticationStrategy *string `json:"authenticationStrategy,omitempty"`
 
 	AutoMinorVersionUpgrade *bool `json:"autoMinorVersionUpgrade,omitempty"`
@@ -38,6 +40,7 @@ type BrokerParameters struct {
 
 	CreatorRequestID *string `json:"creatorRequestID,omitempty"`
 
+	// +kubebuilder:validation:Enum=SINGLE_INSTANCE;ACTIVE_STANDBY_MULTI_AZ;CLUSTER_MULTI_AZ
 	DeploymentMode *string `json:"deploymentMode,omitempty"`
 
 	EncryptionOptions *EncryptionOptions `json:"encryptionOptions,omitempty"`
@@ -58,6 +61,7 @@ type BrokerParameters struct {
 
 	SecurityGroups []*string `json:"securityGroups,omitempty"`
 
+	// +kubebuilder:validation:Enum=EBS;EFS
 	StorageType *string `json:"storageType,omitempty"`
 
 	SubnetIDs []*string `json:"subnetIDs,omitempty"`
@@ -81,8 +85,9 @@ type BrokerObservation struct {
 
 // BrokerStatus defines the observed state of Broker.
 type BrokerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          BrokerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            BrokerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -854,6 +854,7 @@ func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
 func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
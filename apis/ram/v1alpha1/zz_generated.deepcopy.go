@@ -499,6 +499,7 @@ func (in *ResourceShareSpec) DeepCopy() *ResourceShareSpec {
 func (in *ResourceShareStatus) DeepCopyInto(out *ResourceShareStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
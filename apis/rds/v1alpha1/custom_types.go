@@ -44,6 +44,14 @@ type CustomDBClusterParameters struct {
 	// +optional
 	AutogeneratePassword *bool `json:"autogeneratePassword,omitempty"`
 
+	// DeleteGeneratedPasswordSecret indicates whether the controller should
+	// delete the secret it created to store a generated password when the
+	// DBCluster is deleted. Secrets that existed before the password was
+	// generated are never deleted. Has no effect if the deletion policy is
+	// Orphan.
+	// +optional
+	DeleteGeneratedPasswordSecret *bool `json:"deleteGeneratedPasswordSecret,omitempty"`
+
 	// DomainIAMRoleNameRef is a reference to an IAMRole used to set
 	// DomainIAMRoleName.
 	// +optional
@@ -69,6 +77,14 @@ type CustomDBClusterParameters struct {
 	// +optional
 	MasterUserPasswordSecretRef *xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef"`
 
+	// ReaderConnectionSecretRef specifies the namespace and name of a Secret
+	// to which the connection details of the reader endpoint of the DBCluster
+	// are written. Workloads that only read, such as analytics, can use it to
+	// avoid accidentally writing to the primary instance. The connection
+	// secret of the DBCluster keeps pointing at the cluster (writer) endpoint.
+	// +optional
+	ReaderConnectionSecretRef *xpv1.SecretReference `json:"readerConnectionSecretRef,omitempty"`
+
 	// A list of EC2 VPC security groups to associate with this DB cluster.
 	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIDs,omitempty"`
 
@@ -176,6 +192,14 @@ type CustomDBInstanceParameters struct {
 	// +optional
 	AutogeneratePassword bool `json:"autogeneratePassword,omitempty"`
 
+	// DeleteGeneratedPasswordSecret indicates whether the controller should
+	// delete the secret it created to store a generated password when the
+	// DBInstance is deleted. Secrets that existed before the password was
+	// generated are never deleted. Has no effect if the deletion policy is
+	// Orphan.
+	// +optional
+	DeleteGeneratedPasswordSecret bool `json:"deleteGeneratedPasswordSecret,omitempty"`
+
 	// A list of database security groups to associate with this DB instance
 	DBSecurityGroups []string `json:"dbSecurityGroups,omitempty"`
 
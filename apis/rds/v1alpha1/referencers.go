@@ -23,6 +23,7 @@ import (
 	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -54,7 +55,7 @@ func (mg *DBCluster) ResolveReferences(ctx context.Context, c client.Reader) err
 		Reference:    mg.Spec.ForProvider.KMSKeyIDRef,
 		Selector:     mg.Spec.ForProvider.KMSKeyIDSelector,
 		To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      awsv1beta1.CrossAccount(mg, reference.ExternalName(), kmsv1alpha1.KMSKeyARN()),
 	})
 	if err != nil {
 		return errors.Wrap(err, "spec.forProvider.kmsKeyID")
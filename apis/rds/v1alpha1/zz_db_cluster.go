@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -142,6 +143,7 @@ type DBClusterParameters struct {
 	// Valid Values: aurora (for MySQL 5.6-compatible Aurora), aurora-mysql (for
 	// MySQL 5.7-compatible Aurora), and aurora-postgresql
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=aurora-mysql;aurora-postgresql;mysql;postgres
 	Engine *string `json:"engine"`
 	// The DB engine mode of the DB cluster, either provisioned, serverless, parallelquery,
 	// global, or multimaster.
@@ -468,8 +470,9 @@ type DBClusterObservation struct {
 
 // DBClusterStatus defines the observed state of DBCluster.
 type DBClusterStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DBClusterObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            DBClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -383,6 +384,7 @@ type DBInstanceParameters struct {
 	//
 	//    * sqlserver-web
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=aurora-mysql;aurora-postgresql;custom-oracle-ee;custom-oracle-ee-cdb;custom-sqlserver-ee;custom-sqlserver-se;custom-sqlserver-web;db2-ae;db2-se;mariadb;mysql;oracle-ee;oracle-ee-cdb;oracle-se2;oracle-se2-cdb;postgres;sqlserver-ee;sqlserver-se;sqlserver-ex;sqlserver-web
 	Engine *string `json:"engine"`
 	// The version number of the database engine to use.
 	//
@@ -711,6 +713,7 @@ type DBInstanceParameters struct {
 	// If you specify io1, you must also include a value for the Iops parameter.
 	//
 	// Default: io1 if the Iops parameter is specified, otherwise gp2
+	// +kubebuilder:validation:Enum=gp2;gp3;io1;standard
 	StorageType *string `json:"storageType,omitempty"`
 	// Tags to assign to the DB instance.
 	Tags []*Tag `json:"tags,omitempty"`
@@ -857,8 +860,9 @@ type DBInstanceObservation struct {
 
 // DBInstanceStatus defines the observed state of DBInstance.
 type DBInstanceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DBInstanceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            DBInstanceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -402,6 +402,11 @@ func (in *CustomDBClusterParameters) DeepCopyInto(out *CustomDBClusterParameters
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DeleteGeneratedPasswordSecret != nil {
+		in, out := &in.DeleteGeneratedPasswordSecret, &out.DeleteGeneratedPasswordSecret
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DomainIAMRoleNameRef != nil {
 		in, out := &in.DomainIAMRoleNameRef, &out.DomainIAMRoleNameRef
 		*out = new(v1.Reference)
@@ -427,6 +432,11 @@ func (in *CustomDBClusterParameters) DeepCopyInto(out *CustomDBClusterParameters
 		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
+	if in.ReaderConnectionSecretRef != nil {
+		in, out := &in.ReaderConnectionSecretRef, &out.ReaderConnectionSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
 	if in.VPCSecurityGroupIDs != nil {
 		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
 		*out = make([]string, len(*in))
@@ -1204,6 +1214,7 @@ func (in *DBClusterParameterGroupSpec) DeepCopy() *DBClusterParameterGroupSpec {
 func (in *DBClusterParameterGroupStatus) DeepCopyInto(out *DBClusterParameterGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1672,6 +1683,7 @@ func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
 func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2887,6 +2899,7 @@ func (in *DBInstanceSpec) DeepCopy() *DBInstanceSpec {
 func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3483,6 +3496,7 @@ func (in *DBParameterGroupSpec) DeepCopy() *DBParameterGroupSpec {
 func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -4692,6 +4706,7 @@ func (in *GlobalClusterSpec) DeepCopy() *GlobalClusterSpec {
 func (in *GlobalClusterStatus) DeepCopyInto(out *GlobalClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
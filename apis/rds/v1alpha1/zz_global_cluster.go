@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -37,6 +38,7 @@ type GlobalClusterParameters struct {
 	// can't be deleted when deletion protection is enabled.
 	DeletionProtection *bool `json:"deletionProtection,omitempty"`
 	// The name of the database engine to be used for this DB cluster.
+	// +kubebuilder:validation:Enum=aurora-mysql;aurora-postgresql
 	Engine *string `json:"engine,omitempty"`
 	// The engine version of the Aurora global database.
 	EngineVersion *string `json:"engineVersion,omitempty"`
@@ -79,8 +81,9 @@ type GlobalClusterObservation struct {
 
 // GlobalClusterStatus defines the observed state of GlobalCluster.
 type GlobalClusterStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          GlobalClusterObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            GlobalClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
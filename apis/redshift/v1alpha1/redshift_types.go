@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // Redshift cluster states.
@@ -554,9 +556,10 @@ type ElasticIPStatus struct {
 }
 
 // Endpoint is used as a response element in the following actions:
-//    * CreateCluster
-//    * DescribeClusters
-//    * DeleteCluster
+//   - CreateCluster
+//   - DescribeClusters
+//   - DeleteCluster
+//
 // Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/Endpoint
 type Endpoint struct {
 	// Address specifies the DNS address of the cluster.
@@ -645,8 +648,9 @@ type ClusterSpec struct {
 
 // ClusterStatus represents the observed state of an AWS Redshift Cluster.
 type ClusterStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// +kubebuilder:object:root=true
+
+// HealthCheck is a managed resource that represents an AWS Route53 health
+// check, which monitors an endpoint, other health checks or a CloudWatch alarm
+// so that ResourceRecordSets can route traffic away from unhealthy resources.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type HealthCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HealthCheckSpec   `json:"spec"`
+	Status HealthCheckStatus `json:"status,omitempty"`
+}
+
+// HealthCheckSpec defines the desired state of an AWS Route53 health check.
+type HealthCheckSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HealthCheckParameters `json:"forProvider"`
+}
+
+// HealthCheckStatus represents the observed state of a HealthCheck.
+type HealthCheckStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            HealthCheckObservation `json:"atProvider,omitempty"`
+}
+
+// HealthCheckParameters define the desired state of an AWS Route53 health
+// check.
+type HealthCheckParameters struct {
+	// Type of the health check. HTTP, HTTPS and TCP health checks establish a
+	// connection with the endpoint, the STR_MATCH variants additionally search
+	// the response body for SearchString. CALCULATED health checks monitor
+	// ChildHealthChecks and CLOUDWATCH_METRIC health checks monitor the alarm
+	// in AlarmIdentifier.
+	// +immutable
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;HTTP_STR_MATCH;HTTPS_STR_MATCH;TCP;CALCULATED;CLOUDWATCH_METRIC
+	Type string `json:"type"`
+
+	// IPAddress of the endpoint to check. Route 53 resolves
+	// FullyQualifiedDomainName if it is omitted.
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// FullyQualifiedDomainName of the endpoint to check. It is sent in the
+	// Host header and used for SNI if IPAddress is set.
+	// +optional
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName,omitempty"`
+
+	// Port of the endpoint to check. Defaults to 80 for HTTP and 443 for
+	// HTTPS health checks.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// ResourcePath that Route 53 requests from the endpoint, such as
+	// /health.
+	// +optional
+	ResourcePath *string `json:"resourcePath,omitempty"`
+
+	// SearchString that must appear in the first 5120 bytes of the response
+	// body of HTTP_STR_MATCH and HTTPS_STR_MATCH health checks.
+	// +optional
+	SearchString *string `json:"searchString,omitempty"`
+
+	// RequestInterval is the number of seconds between two health checks of
+	// the endpoint by each health checker.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=10;30
+	RequestInterval *int32 `json:"requestInterval,omitempty"`
+
+	// FailureThreshold is the number of consecutive health checks that an
+	// endpoint must pass or fail to change its status.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+
+	// MeasureLatency shows the latency between the health checkers and the
+	// endpoint on CloudWatch graphs in the Route 53 console.
+	// +immutable
+	// +optional
+	MeasureLatency *bool `json:"measureLatency,omitempty"`
+
+	// Inverted reverses the status of the health check.
+	// +optional
+	Inverted *bool `json:"inverted,omitempty"`
+
+	// Disabled stops the health check, which is then always considered
+	// healthy.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// EnableSNI sends FullyQualifiedDomainName during the TLS negotiation of
+	// HTTPS health checks.
+	// +optional
+	EnableSNI *bool `json:"enableSNI,omitempty"`
+
+	// Regions that Route 53 checks the endpoint from. All regions are used if
+	// it is omitted.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+
+	// HealthThreshold is the number of ChildHealthChecks that must be healthy
+	// for a CALCULATED health check to be healthy.
+	// +optional
+	HealthThreshold *int32 `json:"healthThreshold,omitempty"`
+
+	// ChildHealthChecks are the IDs of the health checks monitored by a
+	// CALCULATED health check.
+	// +optional
+	ChildHealthChecks []string `json:"childHealthChecks,omitempty"`
+
+	// ChildHealthCheckRefs references HealthChecks to retrieve their IDs and
+	// populate ChildHealthChecks.
+	// +optional
+	ChildHealthCheckRefs []xpv1.Reference `json:"childHealthCheckRefs,omitempty"`
+
+	// ChildHealthCheckSelector selects references to HealthChecks to populate
+	// ChildHealthChecks.
+	// +optional
+	ChildHealthCheckSelector *xpv1.Selector `json:"childHealthCheckSelector,omitempty"`
+
+	// AlarmIdentifier identifies the CloudWatch alarm monitored by a
+	// CLOUDWATCH_METRIC health check.
+	// +optional
+	AlarmIdentifier *AlarmIdentifier `json:"alarmIdentifier,omitempty"`
+
+	// InsufficientDataHealthStatus is the status of a CLOUDWATCH_METRIC
+	// health check while its alarm has insufficient data.
+	// +optional
+	// +kubebuilder:validation:Enum=Healthy;Unhealthy;LastKnownStatus
+	InsufficientDataHealthStatus *string `json:"insufficientDataHealthStatus,omitempty"`
+}
+
+// AlarmIdentifier identifies a CloudWatch alarm.
+type AlarmIdentifier struct {
+	// Name of the alarm.
+	Name string `json:"name"`
+
+	// Region of the alarm.
+	Region string `json:"region"`
+}
+
+// HealthCheckObservation keeps the state for the external resource.
+type HealthCheckObservation struct {
+	// CallerReference is the unique string that identified the request to
+	// create the health check.
+	CallerReference string `json:"callerReference,omitempty"`
+
+	// HealthCheckVersion is incremented by Route 53 each time the health
+	// check is updated.
+	HealthCheckVersion int64 `json:"healthCheckVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheckList contains a list of HealthCheck.
+type HealthCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []HealthCheck `json:"items"`
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // +kubebuilder:object:root=true
@@ -48,8 +50,9 @@ type HostedZoneSpec struct {
 
 // HostedZoneStatus represents the observed state of a HostedZone.
 type HostedZoneStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          HostedZoneObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            HostedZoneObservation `json:"atProvider,omitempty"`
 }
 
 // HostedZoneParameters define the desired state of an AWS Route53 Hosted HostedZone.
@@ -22,11 +22,67 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	apigatewayv2 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	cloudfront "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	elb "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	elbv2 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	s3 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
 )
 
+// An aliasTargetReference references a resource that an AliasTarget routes
+// traffic to.
+type aliasTargetReference struct {
+	reference    **xpv1.Reference
+	selector     *xpv1.Selector
+	to           reference.To
+	dnsName      reference.ExtractValueFn
+	hostedZoneID reference.ExtractValueFn
+}
+
+func aliasTargetReferences(at *AliasTarget) []aliasTargetReference {
+	return []aliasTargetReference{
+		{
+			reference:    &at.LoadBalancerRef,
+			selector:     at.LoadBalancerSelector,
+			to:           reference.To{Managed: &elbv2.LoadBalancer{}, List: &elbv2.LoadBalancerList{}},
+			dnsName:      elbv2.LoadBalancerDNSName(),
+			hostedZoneID: elbv2.LoadBalancerCanonicalHostedZoneID(),
+		},
+		{
+			reference:    &at.ELBRef,
+			selector:     at.ELBSelector,
+			to:           reference.To{Managed: &elb.ELB{}, List: &elb.ELBList{}},
+			dnsName:      elb.ELBDNSName(),
+			hostedZoneID: elb.ELBCanonicalHostedZoneNameID(),
+		},
+		{
+			reference:    &at.DistributionRef,
+			selector:     at.DistributionSelector,
+			to:           reference.To{Managed: &cloudfront.Distribution{}, List: &cloudfront.DistributionList{}},
+			dnsName:      cloudfront.DistributionDomainName(),
+			hostedZoneID: cloudfront.DistributionHostedZoneID(),
+		},
+		{
+			reference:    &at.APIGatewayDomainNameRef,
+			selector:     at.APIGatewayDomainNameSelector,
+			to:           reference.To{Managed: &apigatewayv2.DomainName{}, List: &apigatewayv2.DomainNameList{}},
+			dnsName:      apigatewayv2.DomainNameAPIGatewayDomainName(),
+			hostedZoneID: apigatewayv2.DomainNameHostedZoneID(),
+		},
+		{
+			reference:    &at.BucketRef,
+			selector:     at.BucketSelector,
+			to:           reference.To{Managed: &s3.Bucket{}, List: &s3.BucketList{}},
+			dnsName:      s3.BucketWebsiteDomainName(),
+			hostedZoneID: s3.BucketWebsiteHostedZoneID(),
+		},
+	}
+}
+
 // ResolveReferences of this Zone
 func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -45,6 +101,56 @@ func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Rea
 	mg.Spec.ForProvider.ZoneID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.ZoneIDRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.healthCheckId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.HealthCheckID),
+		Reference:    mg.Spec.ForProvider.HealthCheckIDRef,
+		Selector:     mg.Spec.ForProvider.HealthCheckIDSelector,
+		To:           reference.To{Managed: &HealthCheck{}, List: &HealthCheckList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.healthCheckId")
+	}
+	mg.Spec.ForProvider.HealthCheckID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HealthCheckIDRef = rsp.ResolvedReference
+
+	at := mg.Spec.ForProvider.AliasTarget
+	if at == nil {
+		return nil
+	}
+	// The DNS name and the hosted zone ID of an alias target are resolved
+	// from the same referenced resource.
+	for _, t := range aliasTargetReferences(at) {
+		// Resolve spec.forProvider.aliasTarget.dnsName
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: at.DNSName,
+			Reference:    *t.reference,
+			Selector:     t.selector,
+			To:           t.to,
+			Extract:      t.dnsName,
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.aliasTarget.dnsName")
+		}
+		at.DNSName = rsp.ResolvedValue
+		*t.reference = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.aliasTarget.hostedZoneId
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: at.HostedZoneID,
+			Reference:    *t.reference,
+			Selector:     t.selector,
+			To:           t.to,
+			Extract:      t.hostedZoneID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.aliasTarget.hostedZoneId")
+		}
+		at.HostedZoneID = rsp.ResolvedValue
+		*t.reference = rsp.ResolvedReference
+	}
+
 	return nil
 }
 
@@ -72,3 +178,24 @@ func (mg *HostedZone) ResolveReferences(ctx context.Context, c client.Reader) er
 
 	return nil
 }
+
+// ResolveReferences of the child health checks of a HealthCheck
+func (mg *HealthCheck) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.childHealthChecks
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ChildHealthChecks,
+		References:    mg.Spec.ForProvider.ChildHealthCheckRefs,
+		Selector:      mg.Spec.ForProvider.ChildHealthCheckSelector,
+		To:            reference.To{Managed: &HealthCheck{}, List: &HealthCheckList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.childHealthChecks")
+	}
+	mg.Spec.ForProvider.ChildHealthChecks = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ChildHealthCheckRefs = mrsp.ResolvedReferences
+
+	return nil
+}
@@ -48,6 +48,14 @@ var (
 	HostedZoneGroupVersionKind = SchemeGroupVersion.WithKind(HostedZoneKind)
 )
 
+// HealthCheck type metadata.
+var (
+	HealthCheckKind             = reflect.TypeOf(HealthCheck{}).Name()
+	HealthCheckGroupKind        = schema.GroupKind{Group: Group, Kind: HealthCheckKind}.String()
+	HealthCheckKindAPIVersion   = HealthCheckKind + "." + SchemeGroupVersion.String()
+	HealthCheckGroupVersionKind = SchemeGroupVersion.WithKind(HealthCheckKind)
+)
+
 // ResourceRecordSet type metadata.
 var (
 	ResourceRecordSetKind             = reflect.TypeOf(ResourceRecordSet{}).Name()
@@ -59,4 +67,5 @@ var (
 func init() {
 	SchemeBuilder.Register(&HostedZone{}, &HostedZoneList{})
 	SchemeBuilder.Register(&ResourceRecordSet{}, &ResourceRecordSetList{})
+	SchemeBuilder.Register(&HealthCheck{}, &HealthCheckList{})
 }
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // ResourceRecordSetParameters define the desired state of an AWS Route53 Resource Record.
@@ -83,6 +85,7 @@ type ResourceRecordSetParameters struct {
 	//
 	//    * Configuring Failover in a Private Hosted Zone (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html)
 	// +optional
+	// +kubebuilder:validation:Enum=PRIMARY;SECONDARY
 	Failover string `json:"failover,omitempty"`
 
 	// Geolocation resource record sets only: A complex type that lets you control
@@ -224,6 +227,15 @@ type ResourceRecordSetParameters struct {
 	// +optional
 	HealthCheckID *string `json:"healthCheckId,omitempty"`
 
+	// HealthCheckIDRef references a HealthCheck to retrieve its ID.
+	// +optional
+	HealthCheckIDRef *xpv1.Reference `json:"healthCheckIdRef,omitempty"`
+
+	// HealthCheckIDSelector selects a reference to a HealthCheck to retrieve
+	// its ID.
+	// +optional
+	HealthCheckIDSelector *xpv1.Selector `json:"healthCheckIdSelector,omitempty"`
+
 	// Multivalue answer resource record sets only: To route traffic approximately
 	// randomly to multiple resources, such as web servers, create one multivalue
 	// answer record for each resource and specify true for MultiValueAnswer. Note
@@ -385,6 +397,7 @@ type ResourceRecordSetParameters struct {
 	//    because the alias record must have the same type as the record you're
 	//    routing traffic to, and creating a CNAME record for the zone apex isn't
 	//    supported even for an alias record.
+	// +kubebuilder:validation:Enum=A;AAAA;CAA;CNAME;DS;MX;NAPTR;NS;PTR;SOA;SPF;SRV;TXT
 	Type string `json:"type"`
 
 	// Weighted resource record sets only: Among resource record sets that have
@@ -439,11 +452,11 @@ type ResourceRecordSetParameters struct {
 //
 // When creating resource record sets for a private hosted zone, note the following:
 //
-//    * Creating geolocation alias resource record sets or latency alias resource
-//    record sets in a private hosted zone is unsupported.
+//   - Creating geolocation alias resource record sets or latency alias resource
+//     record sets in a private hosted zone is unsupported.
 //
-//    * For information about creating failover resource record sets in a private
-//    hosted zone, see Configuring Failover in a Private Hosted Zone (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html).
+//   - For information about creating failover resource record sets in a private
+//     hosted zone, see Configuring Failover in a Private Hosted Zone (https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/dns-failover-private-hosted-zones.html).
 type AliasTarget struct {
 
 	// Alias resource record sets only: The value that you specify depends on where
@@ -573,7 +586,11 @@ type AliasTarget struct {
 	// for which the value of Type is CNAME. This is because the alias record must
 	// have the same type as the record that you're routing traffic to, and creating
 	// a CNAME record for the zone apex isn't supported even for an alias record.
-	DNSName string `json:"dnsName"`
+	//
+	// It is resolved together with HostedZoneID if the alias target references
+	// a resource.
+	// +optional
+	DNSName string `json:"dnsName,omitempty"`
 
 	// Applies only to alias, failover alias, geolocation alias, latency alias,
 	// and weighted alias resource record sets: When EvaluateTargetHealth is true,
@@ -723,7 +740,64 @@ type AliasTarget struct {
 	//
 	// Specify the hosted zone ID of your hosted zone. (An alias resource record
 	// set can't reference a resource record set in a different hosted zone.)
-	HostedZoneID string `json:"hostedZoneId"`
+	//
+	// It is resolved together with DNSName if the alias target references a
+	// resource.
+	// +optional
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+
+	// LoadBalancerRef references an elbv2 LoadBalancer to retrieve its DNS
+	// name and hosted zone ID.
+	// +optional
+	LoadBalancerRef *xpv1.Reference `json:"loadBalancerRef,omitempty"`
+
+	// LoadBalancerSelector selects a reference to an elbv2 LoadBalancer to
+	// retrieve its DNS name and hosted zone ID.
+	// +optional
+	LoadBalancerSelector *xpv1.Selector `json:"loadBalancerSelector,omitempty"`
+
+	// ELBRef references a classic ELB to retrieve its DNS name and hosted
+	// zone ID.
+	// +optional
+	ELBRef *xpv1.Reference `json:"elbRef,omitempty"`
+
+	// ELBSelector selects a reference to a classic ELB to retrieve its DNS
+	// name and hosted zone ID.
+	// +optional
+	ELBSelector *xpv1.Selector `json:"elbSelector,omitempty"`
+
+	// DistributionRef references a CloudFront Distribution to retrieve its
+	// domain name and hosted zone ID.
+	// +optional
+	DistributionRef *xpv1.Reference `json:"distributionRef,omitempty"`
+
+	// DistributionSelector selects a reference to a CloudFront Distribution
+	// to retrieve its domain name and hosted zone ID.
+	// +optional
+	DistributionSelector *xpv1.Selector `json:"distributionSelector,omitempty"`
+
+	// APIGatewayDomainNameRef references an API Gateway v2 DomainName to
+	// retrieve the domain name and hosted zone ID of its first configuration.
+	// +optional
+	APIGatewayDomainNameRef *xpv1.Reference `json:"apiGatewayDomainNameRef,omitempty"`
+
+	// APIGatewayDomainNameSelector selects a reference to an API Gateway v2
+	// DomainName to retrieve the domain name and hosted zone ID of its first
+	// configuration.
+	// +optional
+	APIGatewayDomainNameSelector *xpv1.Selector `json:"apiGatewayDomainNameSelector,omitempty"`
+
+	// BucketRef references an S3 Bucket configured as a static website to
+	// retrieve the domain name and hosted zone ID of the website endpoint of
+	// its region. The name of the bucket must be the name of the record.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to an S3 Bucket configured as a
+	// static website to retrieve the domain name and hosted zone ID of the
+	// website endpoint of its region.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
 }
 
 // GeoLocation lets you control how Amazon Route 53 responds to DNS queries
@@ -803,7 +877,8 @@ type ResourceRecordSetSpec struct {
 
 // ResourceRecordSetStatus represents the observed state of a ResourceRecordSet.
 type ResourceRecordSetStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -26,9 +26,74 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlarmIdentifier) DeepCopyInto(out *AlarmIdentifier) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlarmIdentifier.
+func (in *AlarmIdentifier) DeepCopy() *AlarmIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(AlarmIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AliasTarget) DeepCopyInto(out *AliasTarget) {
 	*out = *in
+	if in.LoadBalancerRef != nil {
+		in, out := &in.LoadBalancerRef, &out.LoadBalancerRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.LoadBalancerSelector != nil {
+		in, out := &in.LoadBalancerSelector, &out.LoadBalancerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ELBRef != nil {
+		in, out := &in.ELBRef, &out.ELBRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ELBSelector != nil {
+		in, out := &in.ELBSelector, &out.ELBSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DistributionRef != nil {
+		in, out := &in.DistributionRef, &out.DistributionRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DistributionSelector != nil {
+		in, out := &in.DistributionSelector, &out.DistributionSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIGatewayDomainNameRef != nil {
+		in, out := &in.APIGatewayDomainNameRef, &out.APIGatewayDomainNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.APIGatewayDomainNameSelector != nil {
+		in, out := &in.APIGatewayDomainNameSelector, &out.APIGatewayDomainNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasTarget.
@@ -116,6 +181,220 @@ func (in *GeoLocation) DeepCopy() *GeoLocation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HealthCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckList) DeepCopyInto(out *HealthCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckList.
+func (in *HealthCheckList) DeepCopy() *HealthCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HealthCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckObservation) DeepCopyInto(out *HealthCheckObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckObservation.
+func (in *HealthCheckObservation) DeepCopy() *HealthCheckObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckParameters) DeepCopyInto(out *HealthCheckParameters) {
+	*out = *in
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.FullyQualifiedDomainName != nil {
+		in, out := &in.FullyQualifiedDomainName, &out.FullyQualifiedDomainName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResourcePath != nil {
+		in, out := &in.ResourcePath, &out.ResourcePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.SearchString != nil {
+		in, out := &in.SearchString, &out.SearchString
+		*out = new(string)
+		**out = **in
+	}
+	if in.RequestInterval != nil {
+		in, out := &in.RequestInterval, &out.RequestInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MeasureLatency != nil {
+		in, out := &in.MeasureLatency, &out.MeasureLatency
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Inverted != nil {
+		in, out := &in.Inverted, &out.Inverted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableSNI != nil {
+		in, out := &in.EnableSNI, &out.EnableSNI
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthThreshold != nil {
+		in, out := &in.HealthThreshold, &out.HealthThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ChildHealthChecks != nil {
+		in, out := &in.ChildHealthChecks, &out.ChildHealthChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChildHealthCheckRefs != nil {
+		in, out := &in.ChildHealthCheckRefs, &out.ChildHealthCheckRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChildHealthCheckSelector != nil {
+		in, out := &in.ChildHealthCheckSelector, &out.ChildHealthCheckSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AlarmIdentifier != nil {
+		in, out := &in.AlarmIdentifier, &out.AlarmIdentifier
+		*out = new(AlarmIdentifier)
+		**out = **in
+	}
+	if in.InsufficientDataHealthStatus != nil {
+		in, out := &in.InsufficientDataHealthStatus, &out.InsufficientDataHealthStatus
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckParameters.
+func (in *HealthCheckParameters) DeepCopy() *HealthCheckParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckSpec.
+func (in *HealthCheckSpec) DeepCopy() *HealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckStatus) DeepCopyInto(out *HealthCheckStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckStatus.
+func (in *HealthCheckStatus) DeepCopy() *HealthCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostedZone) DeepCopyInto(out *HostedZone) {
 	*out = *in
@@ -264,6 +543,7 @@ func (in *HostedZoneSpec) DeepCopy() *HostedZoneSpec {
 func (in *HostedZoneStatus) DeepCopyInto(out *HostedZoneStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -372,7 +652,7 @@ func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParame
 	if in.AliasTarget != nil {
 		in, out := &in.AliasTarget, &out.AliasTarget
 		*out = new(AliasTarget)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GeoLocation != nil {
 		in, out := &in.GeoLocation, &out.GeoLocation
@@ -384,6 +664,16 @@ func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.HealthCheckIDRef != nil {
+		in, out := &in.HealthCheckIDRef, &out.HealthCheckIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.HealthCheckIDSelector != nil {
+		in, out := &in.HealthCheckIDSelector, &out.HealthCheckIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MultiValueAnswer != nil {
 		in, out := &in.MultiValueAnswer, &out.MultiValueAnswer
 		*out = new(bool)
@@ -462,6 +752,7 @@ func (in *ResourceRecordSetSpec) DeepCopy() *ResourceRecordSetSpec {
 func (in *ResourceRecordSetStatus) DeepCopyInto(out *ResourceRecordSetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetStatus.
@@ -20,6 +20,15 @@ package v1alpha1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this HealthCheckList.
+func (l *HealthCheckList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this HostedZoneList.
 func (l *HostedZoneList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -166,6 +166,7 @@ func (in *ResolverRuleAssociationSpec) DeepCopy() *ResolverRuleAssociationSpec {
 func (in *ResolverRuleAssociationStatus) DeepCopyInto(out *ResolverRuleAssociationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
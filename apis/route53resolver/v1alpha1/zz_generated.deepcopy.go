@@ -456,6 +456,7 @@ func (in *ResolverEndpointSpec) DeepCopy() *ResolverEndpointSpec {
 func (in *ResolverEndpointStatus) DeepCopyInto(out *ResolverEndpointStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -864,6 +865,7 @@ func (in *ResolverRuleSpec) DeepCopy() *ResolverRuleSpec {
 func (in *ResolverRuleStatus) DeepCopyInto(out *ResolverRuleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
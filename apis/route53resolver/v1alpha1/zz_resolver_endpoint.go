@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -37,6 +38,7 @@ type ResolverEndpointParameters struct {
 	//    * OUTBOUND: Resolver forwards DNS queries from the DNS service for a VPC
 	//    to your network
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=INBOUND;OUTBOUND
 	Direction *string `json:"direction"`
 	// A friendly name that lets you easily find a configuration in the Resolver
 	// dashboard in the Route 53 console.
@@ -117,8 +119,9 @@ type ResolverEndpointObservation struct {
 
 // ResolverEndpointStatus defines the observed state of ResolverEndpoint.
 type ResolverEndpointStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ResolverEndpointObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ResolverEndpointObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
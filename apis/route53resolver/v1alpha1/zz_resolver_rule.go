@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -56,6 +57,7 @@ type ResolverRuleParameters struct {
 	// Currently, only Resolver can create rules that have a value of RECURSIVE
 	// for RuleType.
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=FORWARD;SYSTEM;RECURSIVE
 	RuleType *string `json:"ruleType"`
 	// A list of the tag keys and values that you want to associate with the endpoint.
 	Tags []*Tag `json:"tags,omitempty"`
@@ -104,8 +106,9 @@ type ResolverRuleObservation struct {
 
 // ResolverRuleStatus defines the observed state of ResolverRule.
 type ResolverRuleStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ResolverRuleObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ResolverRuleObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
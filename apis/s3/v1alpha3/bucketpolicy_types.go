@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // BucketPolicyParameters define the desired state of an AWS BucketPolicy.
@@ -225,13 +227,15 @@ type BucketPolicySpec struct {
 // An BucketPolicyStatus represents the observed state of an
 // BucketPolicy.
 type BucketPolicyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
 
 // An BucketPolicy is a managed resource that represents an AWS Bucket
-// policy.
+// policy. A bucket has a single policy, so a bucket should be managed by only
+// one BucketPolicy.
 // +kubebuilder:printcolumn:name="BUCKETNAME",type="string",JSONPath=".spec.forProvider.bucketName"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ObjectParameters define the desired state of an AWS S3 Object.
+type ObjectParameters struct {
+	// Region is the region of the bucket the object is uploaded to.
+	// +immutable
+	Region string `json:"region"`
+
+	// BucketName is the name of the bucket the object is uploaded to.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket to retrieve its name.
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Key is the object key under which the content is stored in the bucket.
+	// +immutable
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Content is the plain text content of the object.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentSecretRef selects a key of a Secret that holds the content of
+	// the object. It takes precedence over Content and ContentConfigMapRef.
+	// +optional
+	ContentSecretRef *xpv1.SecretKeySelector `json:"contentSecretRef,omitempty"`
+
+	// ContentConfigMapRef selects a key of a ConfigMap that holds the content
+	// of the object. It takes precedence over Content.
+	// +optional
+	ContentConfigMapRef *awsv1beta1.ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+
+	// A standard MIME type describing the format of the contents. For more
+	// information, see http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.17.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Can be used to specify caching behavior along the request/reply chain.
+	// For more information, see http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.9.
+	// +optional
+	CacheControl *string `json:"cacheControl,omitempty"`
+
+	// The server-side encryption algorithm used when storing the object.
+	// +optional
+	// +kubebuilder:validation:Enum=AES256;aws:kms
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// The ID of the symmetric customer managed KMS key to use for object
+	// encryption when ServerSideEncryption is aws:kms.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKmsKeyId,omitempty"`
+}
+
+// ObjectObservation keeps the state for the external resource
+type ObjectObservation struct {
+	// ETag is the entity tag of the uploaded object.
+	ETag string `json:"eTag,omitempty"`
+
+	// VersionID is the version of the object, if versioning is enabled on
+	// the bucket.
+	VersionID string `json:"versionId,omitempty"`
+
+	// ContentSHA256 is the hex-encoded SHA-256 hash of the content that was
+	// uploaded by this Object.
+	ContentSHA256 string `json:"contentSha256,omitempty"`
+}
+
+// An ObjectSpec defines the desired state of an Object.
+type ObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectParameters `json:"forProvider"`
+}
+
+// An ObjectStatus represents the observed state of an Object.
+type ObjectStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Object is a managed resource that represents a small AWS S3 object, such
+// as a bootstrap script or a configuration file, whose content is uploaded
+// from the spec, a Secret or a ConfigMap. The object is uploaded again when
+// its content no longer matches the desired content.
+// +kubebuilder:printcolumn:name="BUCKETNAME",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Object struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectSpec   `json:"spec"`
+	Status ObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectList contains a list of Objects
+type ObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Object `json:"items"`
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -28,6 +29,17 @@ import (
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 )
 
+// ObjectKey returns the key of an Object.
+func ObjectKey() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Object)
+		if !ok {
+			return ""
+		}
+		return r.Spec.ForProvider.Key
+	}
+}
+
 // ResolveReferences of this BucketPolicy
 func (mg *BucketPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -101,3 +113,24 @@ func ResolvePrincipal(ctx context.Context, r *reference.APIResolver, principal *
 	}
 	return nil
 }
+
+// ResolveReferences of this Object
+func (mg *Object) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.bucketName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BucketName),
+		Reference:    mg.Spec.ForProvider.BucketNameRef,
+		Selector:     mg.Spec.ForProvider.BucketNameSelector,
+		To:           reference.To{Managed: &v1beta1.Bucket{}, List: &v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucketName")
+	}
+	mg.Spec.ForProvider.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -45,6 +45,15 @@ var (
 	BucketPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyKind)
 )
 
+// Object type metadata.
+var (
+	ObjectKind             = reflect.TypeOf(Object{}).Name()
+	ObjectGroupKind        = schema.GroupKind{Group: Group, Kind: ObjectKind}.String()
+	ObjectKindAPIVersion   = ObjectKind + "." + SchemeGroupVersion.String()
+	ObjectGroupVersionKind = SchemeGroupVersion.WithKind(ObjectKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{})
+	SchemeBuilder.Register(&Object{}, &ObjectList{})
 }
@@ -23,6 +23,7 @@ package v1alpha3
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -275,6 +276,7 @@ func (in *BucketPolicyStatement) DeepCopy() *BucketPolicyStatement {
 func (in *BucketPolicyStatus) DeepCopyInto(out *BucketPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyStatus.
@@ -379,3 +381,177 @@ func (in *ConditionPair) DeepCopy() *ConditionPair {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Object) DeepCopyInto(out *Object) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Object.
+func (in *Object) DeepCopy() *Object {
+	if in == nil {
+		return nil
+	}
+	out := new(Object)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Object) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectList) DeepCopyInto(out *ObjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Object, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectList.
+func (in *ObjectList) DeepCopy() *ObjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectObservation) DeepCopyInto(out *ObjectObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectObservation.
+func (in *ObjectObservation) DeepCopy() *ObjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectParameters) DeepCopyInto(out *ObjectParameters) {
+	*out = *in
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentSecretRef != nil {
+		in, out := &in.ContentSecretRef, &out.ContentSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ContentConfigMapRef != nil {
+		in, out := &in.ContentConfigMapRef, &out.ContentConfigMapRef
+		*out = new(v1beta1.ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheControl != nil {
+		in, out := &in.CacheControl, &out.CacheControl
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerSideEncryption != nil {
+		in, out := &in.ServerSideEncryption, &out.ServerSideEncryption
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSEKMSKeyID != nil {
+		in, out := &in.SSEKMSKeyID, &out.SSEKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectParameters.
+func (in *ObjectParameters) DeepCopy() *ObjectParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSpec) DeepCopyInto(out *ObjectSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSpec.
+func (in *ObjectSpec) DeepCopy() *ObjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStatus) DeepCopyInto(out *ObjectStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStatus.
+func (in *ObjectStatus) DeepCopy() *ObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
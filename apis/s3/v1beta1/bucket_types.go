@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -60,10 +61,32 @@ type BucketParameters struct {
 	// +optional
 	GrantWriteACP *string `json:"grantWriteAcp,omitempty"`
 
+	// Specifies the S3 Object Ownership of the bucket. BucketOwnerEnforced
+	// disables ACLs, and the bucket owner owns every object in the bucket.
+	// With BucketOwnerPreferred, the bucket owner owns objects uploaded with the
+	// bucket-owner-full-control canned ACL, and with ObjectWriter the uploading
+	// account owns the objects it uploads.
+	// For more information, see Controlling ownership of objects
+	// (https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html).
+	// +kubebuilder:validation:Enum=BucketOwnerEnforced;BucketOwnerPreferred;ObjectWriter
+	// +optional
+	ObjectOwnership *string `json:"objectOwnership,omitempty"`
+
 	// Specifies whether you want S3 Object Lock to be enabled for the new bucket.
+	// Object Lock cannot be disabled once it is enabled.
+	// +immutable
 	// +optional
 	ObjectLockEnabledForBucket *bool `json:"objectLockEnabledForBucket,omitempty"`
 
+	// Specifies the Object Lock configuration of the bucket, including the
+	// default retention that is applied to new objects. Object Lock is enabled
+	// on the bucket when this is set, and it cannot be disabled afterwards;
+	// removing this only removes the default retention.
+	// For more information, see Locking Objects
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/object-lock.html).
+	// +optional
+	ObjectLockConfiguration *ObjectLockConfiguration `json:"objectLockConfiguration,omitempty"`
+
 	// Specifies default encryption for a bucket using server-side encryption with
 	// Amazon S3-managed keys (SSE-S3) or customer master keys stored in AWS KMS
 	// (SSE-KMS). For information about the Amazon S3 default encryption feature,
@@ -133,6 +156,33 @@ type BucketParameters struct {
 	// +optional
 	LifecycleConfiguration *BucketLifecycleConfiguration `json:"lifecycleConfiguration,omitempty"`
 
+	// Specifies the S3 Intelligent-Tiering configurations of the bucket, which
+	// move objects stored in the S3 Intelligent-Tiering storage class to the
+	// archive access tiers. Configurations on the bucket that are not listed
+	// here are deleted.
+	// For more information, see Using S3 Intelligent-Tiering
+	// (https://docs.aws.amazon.com/AmazonS3/latest/userguide/using-intelligent-tiering.html).
+	// +optional
+	IntelligentTieringConfigurations []IntelligentTieringConfiguration `json:"intelligentTieringConfigurations,omitempty"`
+
+	// Specifies the inventory configurations of the bucket, which export
+	// lists of its objects and their metadata to a destination bucket on a
+	// schedule. Configurations on the bucket that are not listed here are
+	// deleted.
+	// For more information, see Amazon S3 Inventory
+	// (https://docs.aws.amazon.com/AmazonS3/latest/userguide/storage-inventory.html).
+	// +optional
+	InventoryConfigurations []InventoryConfiguration `json:"inventoryConfigurations,omitempty"`
+
+	// Specifies the analytics configurations of the bucket, which analyze
+	// storage access patterns to decide when to transition objects to a
+	// different storage class. Configurations on the bucket that are not
+	// listed here are deleted.
+	// For more information, see Amazon S3 analytics - Storage Class Analysis
+	// (https://docs.aws.amazon.com/AmazonS3/latest/userguide/analytics-storage-class.html).
+	// +optional
+	AnalyticsConfigurations []AnalyticsConfiguration `json:"analyticsConfigurations,omitempty"`
+
 	// Enables notifications of specified events for a bucket.
 	// For more information about event notifications, see Configuring Event Notifications
 	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/NotificationHowTo.html).
@@ -160,8 +210,9 @@ type BucketExternalStatus struct {
 
 // BucketStatus represents the observed state of the Bucket.
 type BucketStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          BucketExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            BucketExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
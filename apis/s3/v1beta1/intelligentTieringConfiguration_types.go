@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// IntelligentTieringConfiguration specifies the S3 Intelligent-Tiering configuration
+// for an Amazon S3 bucket. For information about the S3 Intelligent-Tiering storage
+// class, see Storage class for automatically optimizing frequently and infrequently
+// accessed objects (https://docs.aws.amazon.com/AmazonS3/latest/dev/storage-class-intro.html#sc-dynamic-data-access).
+type IntelligentTieringConfiguration struct {
+	// The ID used to identify the S3 Intelligent-Tiering configuration.
+	//
+	// ID is a required field
+	ID string `json:"id"`
+
+	// Specifies a bucket filter. The configuration only includes objects that
+	// meet the filter's criteria.
+	// +optional
+	Filter *IntelligentTieringFilter `json:"filter,omitempty"`
+
+	// Specifies the status of the configuration.
+	//
+	// Status is a required field, valid values are Enabled or Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
+
+	// Specifies the S3 Intelligent-Tiering storage class tier of the configuration.
+	//
+	// Tierings is a required field
+	Tierings []Tiering `json:"tierings"`
+}
+
+// IntelligentTieringFilter is used to identify objects that the S3
+// Intelligent-Tiering configuration applies to.
+// A Filter must have exactly one of Prefix, Tag, or And specified.
+type IntelligentTieringFilter struct {
+	// A conjunction (logical AND) of predicates, which is used in evaluating
+	// the configuration. The operator must have at least two predicates, and
+	// an object must match all of the predicates in order for the filter to
+	// apply.
+	And *IntelligentTieringAndOperator `json:"and,omitempty"`
+
+	// An object key name prefix that identifies the subset of objects to which
+	// the rule applies.
+	Prefix *string `json:"prefix,omitempty"`
+
+	// A container of a key value name pair.
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+// IntelligentTieringAndOperator is a container for specifying S3
+// Intelligent-Tiering filters. The filters determine the subset of objects to
+// which the rule applies.
+type IntelligentTieringAndOperator struct {
+	// An object key name prefix that identifies the subset of objects to which
+	// the configuration applies.
+	Prefix *string `json:"prefix,omitempty"`
+
+	// All of these tags must exist in the object's tag set in order for the
+	// configuration to apply.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// Tiering specifies the S3 Intelligent-Tiering archive access tier and the
+// number of consecutive days without access after which objects are moved to
+// it.
+type Tiering struct {
+	// S3 Intelligent-Tiering access tier. See Storage class for automatically
+	// optimizing frequently and infrequently accessed objects
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/storage-class-intro.html#sc-dynamic-data-access)
+	// for a list of access tiers in the S3 Intelligent-Tiering storage class.
+	//
+	// AccessTier is a required field, valid values are ARCHIVE_ACCESS or DEEP_ARCHIVE_ACCESS
+	// +kubebuilder:validation:Enum=ARCHIVE_ACCESS;DEEP_ARCHIVE_ACCESS
+	AccessTier string `json:"accessTier"`
+
+	// The number of consecutive days of no access after which an object will
+	// be eligible to be transitioned to the corresponding tier. The minimum
+	// number of days specified for Archive Access tier must be at least 90
+	// days and Deep Archive Access tier must be at least 180 days. The maximum
+	// can be up to 2 years (730 days).
+	//
+	// Days is a required field
+	// +kubebuilder:validation:Minimum=90
+	// +kubebuilder:validation:Maximum=730
+	Days int32 `json:"days"`
+}
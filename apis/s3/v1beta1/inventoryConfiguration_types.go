@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// InventoryConfiguration specifies the inventory configuration for an Amazon
+// S3 bucket. For more information, see Amazon S3 Inventory
+// (https://docs.aws.amazon.com/AmazonS3/latest/userguide/storage-inventory.html).
+type InventoryConfiguration struct {
+	// The ID used to identify the inventory configuration.
+	//
+	// ID is a required field
+	ID string `json:"id"`
+
+	// Specifies whether the inventory is enabled or disabled. If set to
+	// true, an inventory list is generated. If set to false, no inventory
+	// list is generated.
+	//
+	// IsEnabled is a required field
+	IsEnabled bool `json:"isEnabled"`
+
+	// Contains information about where to publish the inventory results.
+	//
+	// Destination is a required field
+	Destination InventoryDestination `json:"destination"`
+
+	// Specifies an inventory filter. The inventory only includes objects that
+	// meet the filter's criteria.
+	// +optional
+	Filter *InventoryFilter `json:"filter,omitempty"`
+
+	// Object versions to include in the inventory list. If set to All, the
+	// list includes all the object versions, which adds the version-related
+	// fields VersionId, IsLatest, and DeleteMarker to the list. If set to
+	// Current, the list does not contain these version-related fields.
+	//
+	// IncludedObjectVersions is a required field, valid values are All or Current
+	// +kubebuilder:validation:Enum=All;Current
+	IncludedObjectVersions string `json:"includedObjectVersions"`
+
+	// Contains the optional fields that are included in the inventory
+	// results. Valid values are Size, LastModifiedDate, StorageClass, ETag,
+	// IsMultipartUploaded, ReplicationStatus, EncryptionStatus,
+	// ObjectLockRetainUntilDate, ObjectLockMode, ObjectLockLegalHoldStatus,
+	// IntelligentTieringAccessTier and BucketKeyStatus.
+	// +optional
+	OptionalFields []string `json:"optionalFields,omitempty"`
+
+	// Specifies the schedule for generating inventory results.
+	//
+	// Schedule is a required field
+	Schedule InventorySchedule `json:"schedule"`
+}
+
+// InventoryDestination specifies the inventory configuration for an Amazon S3
+// bucket.
+type InventoryDestination struct {
+	// Contains the bucket name, file format, bucket owner (optional), and
+	// prefix (optional) where inventory results are published.
+	//
+	// S3BucketDestination is a required field
+	S3BucketDestination InventoryS3BucketDestination `json:"s3BucketDestination"`
+}
+
+// InventoryS3BucketDestination contains the bucket name, file format, bucket
+// owner (optional), and prefix (optional) where inventory results are
+// published.
+type InventoryS3BucketDestination struct {
+	// The Amazon Resource Name (ARN) of the bucket where inventory results
+	// will be published.
+	// At least one of bucket, bucketRef or bucketSelector is required.
+	// +optional
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketARN()
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its ARN
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its ARN
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// The account ID that owns the destination S3 bucket. If no account ID is
+	// provided, the owner is not validated before exporting data. Although
+	// this value is optional, we strongly recommend that you set it to help
+	// prevent problems if the destination bucket ownership changes.
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Specifies the output format of the inventory results.
+	//
+	// Format is a required field, valid values are CSV, ORC or Parquet
+	// +kubebuilder:validation:Enum=CSV;ORC;Parquet
+	Format string `json:"format"`
+
+	// Contains the type of server-side encryption used to encrypt the
+	// inventory results.
+	// +optional
+	Encryption *InventoryEncryption `json:"encryption,omitempty"`
+
+	// The prefix that is prepended to all inventory results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// InventoryEncryption contains the type of server-side encryption used to
+// encrypt the inventory results. Exactly one of SSEKMS or SSES3 must be
+// specified.
+type InventoryEncryption struct {
+	// Specifies the use of SSE-KMS to encrypt delivered inventory reports.
+	// +optional
+	SSEKMS *SSEKMS `json:"sseKms,omitempty"`
+
+	// Specifies the use of SSE-S3 to encrypt delivered inventory reports.
+	// +optional
+	SSES3 *SSES3 `json:"sseS3,omitempty"`
+}
+
+// SSEKMS specifies the use of SSE-KMS to encrypt delivered inventory reports.
+type SSEKMS struct {
+	// Specifies the ID of the AWS Key Management Service (AWS KMS) symmetric
+	// customer managed key to use for encrypting inventory reports.
+	//
+	// KeyID is a required field
+	KeyID string `json:"keyId"`
+}
+
+// SSES3 specifies the use of SSE-S3 to encrypt delivered inventory reports.
+type SSES3 struct{}
+
+// InventoryFilter specifies an inventory filter. The inventory only includes
+// objects that meet the filter's criteria.
+type InventoryFilter struct {
+	// The prefix that an object must have to be included in the inventory
+	// results.
+	//
+	// Prefix is a required field
+	Prefix string `json:"prefix"`
+}
+
+// InventorySchedule specifies the schedule for generating inventory results.
+type InventorySchedule struct {
+	// Specifies how frequently inventory results are produced.
+	//
+	// Frequency is a required field, valid values are Daily or Weekly
+	// +kubebuilder:validation:Enum=Daily;Weekly
+	Frequency string `json:"frequency"`
+}
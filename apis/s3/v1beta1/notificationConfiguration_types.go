@@ -132,17 +132,19 @@ type QueueConfiguration struct {
 	ID *string `json:"ID,omitempty"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SQS queue to which Amazon S3
-	// publishes a message when it detects events of the specified type.
-	//
-	// QueueArn is a required field
+	// publishes a message when it detects events of the specified type. The
+	// queue policy must allow Amazon S3 to send messages to the queue.
+	// At least one of queueArn, queueArnRef or queueArnSelector is required.
+	// +optional
 	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/sqs/v1beta1.Queue
-	QueueArn string `json:"queueArn"`
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/sqs/v1beta1.QueueARN()
+	QueueArn string `json:"queueArn,omitempty"`
 
-	// QueueArnRef references an Queue to retrieve its ARN
+	// QueueArnRef references a Queue to retrieve its ARN
 	// +optional
 	QueueArnRef *xpv1.Reference `json:"queueArnRef,omitempty"`
 
-	// QueueArnSelector selects a reference to an Queue to retrieve its ARN
+	// QueueArnSelector selects a reference to a Queue to retrieve its ARN
 	// +optional
 	QueueArnSelector *xpv1.Selector `json:"queueArnSelector,omitempty"`
 }
@@ -171,18 +173,19 @@ type TopicConfiguration struct {
 	ID *string `json:"ID,omitempty"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SNS topic to which Amazon S3
-	// publishes a message when it detects events of the specified type.
-	// At least one of topicArn, topicArnRef or topicSelector is required.
+	// publishes a message when it detects events of the specified type. The
+	// topic policy must allow Amazon S3 to publish to the topic.
+	// At least one of topicArn, topicRef or topicSelector is required.
 	// +optional
-	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/notification/v1alpha1.SNSTopic
-	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/notification/v1alpha1.SNSTopicARN()
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/sns/v1beta1.Topic
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/sns/v1beta1.SNSTopicARN()
 	TopicArn *string `json:"topicArn,omitempty"`
 
-	// TopicArnRef references an SNS Topic to retrieve its Arn
+	// TopicArnRef references an SNS Topic to retrieve its ARN
 	// +optional
 	TopicArnRef *xpv1.Reference `json:"topicRef,omitempty"`
 
-	// TopicArnSelector selects a reference to an SNS Topic to retrieve its Arn
+	// TopicArnSelector selects a reference to an SNS Topic to retrieve its ARN
 	// +optional
 	TopicArnSelector *xpv1.Selector `json:"topicSelector,omitempty"`
 }
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// ObjectLockConfiguration is the container element for Object Lock configuration
+// parameters. For more information, see Locking Objects
+// (https://docs.aws.amazon.com/AmazonS3/latest/dev/object-lock.html) in the
+// Amazon Simple Storage Service Developer Guide.
+type ObjectLockConfiguration struct {
+	// Specifies the Object Lock rule for the specified object. Enable this rule
+	// when you apply ObjectLockConfiguration to a bucket. Bucket settings require
+	// both a mode and a period. The period can be either Days or Years but you
+	// must select one. You cannot specify Days and Years at the same time.
+	// +optional
+	Rule *ObjectLockRule `json:"rule,omitempty"`
+}
+
+// ObjectLockRule is the container element for an Object Lock rule.
+type ObjectLockRule struct {
+	// The default Object Lock retention mode and period that you want to apply
+	// to new objects placed in the specified bucket.
+	DefaultRetention DefaultRetention `json:"defaultRetention"`
+}
+
+// DefaultRetention is the container element for specifying the default Object
+// Lock retention settings for new objects placed in the specified bucket.
+type DefaultRetention struct {
+	// The default Object Lock retention mode you want to apply to new objects
+	// placed in the specified bucket. Objects in COMPLIANCE mode cannot be
+	// deleted or overwritten by any user, including the root user, until the
+	// retention period expires.
+	//
+	// Mode is a required field, valid values are GOVERNANCE or COMPLIANCE
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode string `json:"mode"`
+
+	// The number of days that you want to specify for the default retention
+	// period.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Days int32 `json:"days,omitempty"`
+
+	// The number of years that you want to specify for the default retention
+	// period.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Years int32 `json:"years,omitempty"`
+}
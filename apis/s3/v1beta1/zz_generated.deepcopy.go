@@ -71,6 +71,138 @@ func (in *AccessControlTranslation) DeepCopy() *AccessControlTranslation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalyticsAndOperator) DeepCopyInto(out *AnalyticsAndOperator) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalyticsAndOperator.
+func (in *AnalyticsAndOperator) DeepCopy() *AnalyticsAndOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalyticsAndOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalyticsConfiguration) DeepCopyInto(out *AnalyticsConfiguration) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AnalyticsFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	in.StorageClassAnalysis.DeepCopyInto(&out.StorageClassAnalysis)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalyticsConfiguration.
+func (in *AnalyticsConfiguration) DeepCopy() *AnalyticsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalyticsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalyticsExportDestination) DeepCopyInto(out *AnalyticsExportDestination) {
+	*out = *in
+	in.S3BucketDestination.DeepCopyInto(&out.S3BucketDestination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalyticsExportDestination.
+func (in *AnalyticsExportDestination) DeepCopy() *AnalyticsExportDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalyticsExportDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalyticsFilter) DeepCopyInto(out *AnalyticsFilter) {
+	*out = *in
+	if in.And != nil {
+		in, out := &in.And, &out.And
+		*out = new(AnalyticsAndOperator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(Tag)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalyticsFilter.
+func (in *AnalyticsFilter) DeepCopy() *AnalyticsFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalyticsFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalyticsS3BucketDestination) DeepCopyInto(out *AnalyticsS3BucketDestination) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketAccountID != nil {
+		in, out := &in.BucketAccountID, &out.BucketAccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalyticsS3BucketDestination.
+func (in *AnalyticsS3BucketDestination) DeepCopy() *AnalyticsS3BucketDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalyticsS3BucketDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bucket) DeepCopyInto(out *Bucket) {
 	*out = *in
@@ -200,11 +332,21 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ObjectOwnership != nil {
+		in, out := &in.ObjectOwnership, &out.ObjectOwnership
+		*out = new(string)
+		**out = **in
+	}
 	if in.ObjectLockEnabledForBucket != nil {
 		in, out := &in.ObjectLockEnabledForBucket, &out.ObjectLockEnabledForBucket
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ObjectLockConfiguration != nil {
+		in, out := &in.ObjectLockConfiguration, &out.ObjectLockConfiguration
+		*out = new(ObjectLockConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServerSideEncryptionConfiguration != nil {
 		in, out := &in.ServerSideEncryptionConfiguration, &out.ServerSideEncryptionConfiguration
 		*out = new(ServerSideEncryptionConfiguration)
@@ -255,6 +397,27 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(BucketLifecycleConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IntelligentTieringConfigurations != nil {
+		in, out := &in.IntelligentTieringConfigurations, &out.IntelligentTieringConfigurations
+		*out = make([]IntelligentTieringConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InventoryConfigurations != nil {
+		in, out := &in.InventoryConfigurations, &out.InventoryConfigurations
+		*out = make([]InventoryConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AnalyticsConfigurations != nil {
+		in, out := &in.AnalyticsConfigurations, &out.AnalyticsConfigurations
+		*out = make([]AnalyticsConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NotificationConfiguration != nil {
 		in, out := &in.NotificationConfiguration, &out.NotificationConfiguration
 		*out = new(NotificationConfiguration)
@@ -298,6 +461,7 @@ func (in *BucketSpec) DeepCopy() *BucketSpec {
 func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -393,6 +557,21 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRetention) DeepCopyInto(out *DefaultRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRetention.
+func (in *DefaultRetention) DeepCopy() *DefaultRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeleteMarkerReplication) DeepCopyInto(out *DeleteMarkerReplication) {
 	*out = *in
@@ -563,6 +742,229 @@ func (in *IndexDocument) DeepCopy() *IndexDocument {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntelligentTieringAndOperator) DeepCopyInto(out *IntelligentTieringAndOperator) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntelligentTieringAndOperator.
+func (in *IntelligentTieringAndOperator) DeepCopy() *IntelligentTieringAndOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(IntelligentTieringAndOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntelligentTieringConfiguration) DeepCopyInto(out *IntelligentTieringConfiguration) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(IntelligentTieringFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tierings != nil {
+		in, out := &in.Tierings, &out.Tierings
+		*out = make([]Tiering, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntelligentTieringConfiguration.
+func (in *IntelligentTieringConfiguration) DeepCopy() *IntelligentTieringConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IntelligentTieringConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntelligentTieringFilter) DeepCopyInto(out *IntelligentTieringFilter) {
+	*out = *in
+	if in.And != nil {
+		in, out := &in.And, &out.And
+		*out = new(IntelligentTieringAndOperator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(Tag)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntelligentTieringFilter.
+func (in *IntelligentTieringFilter) DeepCopy() *IntelligentTieringFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(IntelligentTieringFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryConfiguration) DeepCopyInto(out *InventoryConfiguration) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(InventoryFilter)
+		**out = **in
+	}
+	if in.OptionalFields != nil {
+		in, out := &in.OptionalFields, &out.OptionalFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Schedule = in.Schedule
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryConfiguration.
+func (in *InventoryConfiguration) DeepCopy() *InventoryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryDestination) DeepCopyInto(out *InventoryDestination) {
+	*out = *in
+	in.S3BucketDestination.DeepCopyInto(&out.S3BucketDestination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryDestination.
+func (in *InventoryDestination) DeepCopy() *InventoryDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryEncryption) DeepCopyInto(out *InventoryEncryption) {
+	*out = *in
+	if in.SSEKMS != nil {
+		in, out := &in.SSEKMS, &out.SSEKMS
+		*out = new(SSEKMS)
+		**out = **in
+	}
+	if in.SSES3 != nil {
+		in, out := &in.SSES3, &out.SSES3
+		*out = new(SSES3)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryEncryption.
+func (in *InventoryEncryption) DeepCopy() *InventoryEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryFilter) DeepCopyInto(out *InventoryFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryFilter.
+func (in *InventoryFilter) DeepCopy() *InventoryFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryS3BucketDestination) DeepCopyInto(out *InventoryS3BucketDestination) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(InventoryEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryS3BucketDestination.
+func (in *InventoryS3BucketDestination) DeepCopy() *InventoryS3BucketDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryS3BucketDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventorySchedule) DeepCopyInto(out *InventorySchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventorySchedule.
+func (in *InventorySchedule) DeepCopy() *InventorySchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(InventorySchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LambdaFunctionConfiguration) DeepCopyInto(out *LambdaFunctionConfiguration) {
 	*out = *in
@@ -858,6 +1260,42 @@ func (in *NotificationConfigurationFilter) DeepCopy() *NotificationConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockConfiguration) DeepCopyInto(out *ObjectLockConfiguration) {
+	*out = *in
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = new(ObjectLockRule)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockConfiguration.
+func (in *ObjectLockConfiguration) DeepCopy() *ObjectLockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockRule) DeepCopyInto(out *ObjectLockRule) {
+	*out = *in
+	out.DefaultRetention = in.DefaultRetention
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockRule.
+func (in *ObjectLockRule) DeepCopy() *ObjectLockRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PaymentConfiguration) DeepCopyInto(out *PaymentConfiguration) {
 	*out = *in
@@ -1205,6 +1643,36 @@ func (in *S3KeyFilter) DeepCopy() *S3KeyFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSEKMS) DeepCopyInto(out *SSEKMS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSEKMS.
+func (in *SSEKMS) DeepCopy() *SSEKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(SSEKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSES3) DeepCopyInto(out *SSES3) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSES3.
+func (in *SSES3) DeepCopy() *SSES3 {
+	if in == nil {
+		return nil
+	}
+	out := new(SSES3)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerSideEncryptionByDefault) DeepCopyInto(out *ServerSideEncryptionByDefault) {
 	*out = *in
@@ -1304,6 +1772,42 @@ func (in *SseKmsEncryptedObjects) DeepCopy() *SseKmsEncryptedObjects {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassAnalysis) DeepCopyInto(out *StorageClassAnalysis) {
+	*out = *in
+	if in.DataExport != nil {
+		in, out := &in.DataExport, &out.DataExport
+		*out = new(StorageClassAnalysisDataExport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassAnalysis.
+func (in *StorageClassAnalysis) DeepCopy() *StorageClassAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassAnalysisDataExport) DeepCopyInto(out *StorageClassAnalysisDataExport) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassAnalysisDataExport.
+func (in *StorageClassAnalysisDataExport) DeepCopy() *StorageClassAnalysisDataExport {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassAnalysisDataExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -1390,6 +1894,21 @@ func (in *TargetGrantee) DeepCopy() *TargetGrantee {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tiering) DeepCopyInto(out *Tiering) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tiering.
+func (in *Tiering) DeepCopy() *Tiering {
+	if in == nil {
+		return nil
+	}
+	out := new(Tiering)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopicConfiguration) DeepCopyInto(out *TopicConfiguration) {
 	*out = *in
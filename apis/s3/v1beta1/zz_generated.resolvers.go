@@ -23,7 +23,7 @@ import (
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
 	v1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	v1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
-	v1alpha11 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	v1beta12 "github.com/crossplane/provider-aws/apis/sns/v1beta1"
 	v1beta11 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -134,11 +134,49 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 			}
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.InventoryConfigurations); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.S3BucketDestination.Bucket),
+			Extract:      BucketARN(),
+			Reference:    mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.S3BucketDestination.BucketRef,
+			Selector:     mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.S3BucketDestination.BucketSelector,
+			To: reference.To{
+				List:    &BucketList{},
+				Managed: &Bucket{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.S3BucketDestination.Bucket")
+		}
+		mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.S3BucketDestination.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.S3BucketDestination.BucketRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.AnalyticsConfigurations); i3++ {
+		if mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport != nil {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.Destination.S3BucketDestination.Bucket),
+				Extract:      BucketARN(),
+				Reference:    mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.Destination.S3BucketDestination.BucketRef,
+				Selector:     mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.Destination.S3BucketDestination.BucketSelector,
+				To: reference.To{
+					List:    &BucketList{},
+					Managed: &Bucket{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.Destination.S3BucketDestination.Bucket")
+			}
+			mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.Destination.S3BucketDestination.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+			mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.Destination.S3BucketDestination.BucketRef = rsp.ResolvedReference
+
+		}
+	}
 	if mg.Spec.ForProvider.NotificationConfiguration != nil {
 		for i4 := 0; i4 < len(mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations); i4++ {
 			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 				CurrentValue: mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations[i4].QueueArn,
-				Extract:      reference.ExternalName(),
+				Extract:      v1beta11.QueueARN(),
 				Reference:    mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations[i4].QueueArnRef,
 				Selector:     mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations[i4].QueueArnSelector,
 				To: reference.To{
@@ -158,12 +196,12 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 		for i4 := 0; i4 < len(mg.Spec.ForProvider.NotificationConfiguration.TopicConfigurations); i4++ {
 			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NotificationConfiguration.TopicConfigurations[i4].TopicArn),
-				Extract:      v1alpha11.SNSTopicARN(),
+				Extract:      v1beta12.SNSTopicARN(),
 				Reference:    mg.Spec.ForProvider.NotificationConfiguration.TopicConfigurations[i4].TopicArnRef,
 				Selector:     mg.Spec.ForProvider.NotificationConfiguration.TopicConfigurations[i4].TopicArnSelector,
 				To: reference.To{
-					List:    &v1alpha11.SNSTopicList{},
-					Managed: &v1alpha11.SNSTopic{},
+					List:    &v1beta12.TopicList{},
+					Managed: &v1beta12.Topic{},
 				},
 			})
 			if err != nil {
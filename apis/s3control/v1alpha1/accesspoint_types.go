@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// PublicAccessBlockConfiguration specifies the public access settings of an
+// access point.
+type PublicAccessBlockConfiguration struct {
+	// Specifies whether Amazon S3 should block public access control lists
+	// (ACLs) for requests made through the access point.
+	// +optional
+	BlockPublicACLs *bool `json:"blockPublicAcls,omitempty"`
+
+	// Specifies whether Amazon S3 should block public policies for requests
+	// made through the access point.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// Specifies whether Amazon S3 should ignore public ACLs for requests made
+	// through the access point.
+	// +optional
+	IgnorePublicACLs *bool `json:"ignorePublicAcls,omitempty"`
+
+	// Specifies whether Amazon S3 should restrict access through the access
+	// point to AWS service principals and authorized users when it has a
+	// public policy.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
+
+// VPCConfiguration restricts an access point to requests from a Virtual
+// Private Cloud.
+type VPCConfiguration struct {
+	// VPCID is the ID of the VPC that is allowed to use the access point.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// AccessPointParameters define the desired state of an AWS S3 access point.
+// The external name of the access point is its name.
+type AccessPointParameters struct {
+	// Region is the region of the bucket the access point is created for.
+	// +immutable
+	Region string `json:"region"`
+
+	// AccountID is the ID of the AWS account that owns the access point.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Bucket is the name of the bucket the access point is associated with.
+	// +optional
+	// +immutable
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its name
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its name
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// BucketAccountID is the ID of the AWS account that owns the bucket, if
+	// it is not the account of the access point.
+	// +optional
+	// +immutable
+	BucketAccountID *string `json:"bucketAccountId,omitempty"`
+
+	// Policy is the JSON access point policy. Resources in the policy are
+	// access point ARNs, e.g.
+	// arn:aws:s3:<region>:<account>:accesspoint/<name>/object/*.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// PublicAccessBlockConfiguration of the access point. All public access
+	// is blocked if it is not specified.
+	// +optional
+	// +immutable
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// VPCConfiguration restricts the access point to requests from a VPC.
+	// The access point accepts requests from the internet if it is not
+	// specified.
+	// +optional
+	// +immutable
+	VPCConfiguration *VPCConfiguration `json:"vpcConfiguration,omitempty"`
+}
+
+// An AccessPointSpec defines the desired state of an AccessPoint.
+type AccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPointParameters `json:"forProvider"`
+}
+
+// AccessPointObservation keeps the state for the external resource
+type AccessPointObservation struct {
+	// ARN of the access point.
+	ARN string `json:"arn,omitempty"`
+
+	// Alias of the access point, which can be used in place of a bucket
+	// name.
+	Alias string `json:"alias,omitempty"`
+
+	// NetworkOrigin is either Internet or VPC.
+	NetworkOrigin string `json:"networkOrigin,omitempty"`
+
+	// Endpoints of the access point by endpoint type.
+	Endpoints map[string]string `json:"endpoints,omitempty"`
+}
+
+// An AccessPointStatus represents the observed state of an AccessPoint.
+type AccessPointStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            AccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPoint is a managed resource that represents a named network
+// endpoint of an AWS S3 bucket with its own access policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".status.atProvider.alias"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPointSpec   `json:"spec"`
+	Status AccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPointList contains a list of AccessPoints
+type AccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPoint `json:"items"`
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Multi-Region Access Point states.
+const (
+	MultiRegionAccessPointStatusReady                     = "READY"
+	MultiRegionAccessPointStatusInconsistentAcrossRegions = "INCONSISTENT_ACROSS_REGIONS"
+	MultiRegionAccessPointStatusCreating                  = "CREATING"
+	MultiRegionAccessPointStatusPartiallyCreated          = "PARTIALLY_CREATED"
+	MultiRegionAccessPointStatusPartiallyDeleted          = "PARTIALLY_DELETED"
+	MultiRegionAccessPointStatusDeleting                  = "DELETING"
+)
+
+// MultiRegionAccessPointRegion is a bucket that requests made through a
+// Multi-Region Access Point can be routed to.
+type MultiRegionAccessPointRegion struct {
+	// Bucket is the name of the bucket.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its name
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its name
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+}
+
+// MultiRegionAccessPointParameters define the desired state of an AWS S3
+// Multi-Region Access Point. The external name of the Multi-Region Access
+// Point is its name.
+type MultiRegionAccessPointParameters struct {
+	// AccountID is the ID of the AWS account that owns the Multi-Region
+	// Access Point.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Regions are the buckets, at most one per region, that the Multi-Region
+	// Access Point routes requests to.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	Regions []MultiRegionAccessPointRegion `json:"regions"`
+
+	// Policy is the JSON Multi-Region Access Point policy.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// PublicAccessBlockConfiguration of the Multi-Region Access Point. All
+	// public access is blocked if it is not specified.
+	// +optional
+	// +immutable
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+}
+
+// A MultiRegionAccessPointSpec defines the desired state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MultiRegionAccessPointParameters `json:"forProvider"`
+}
+
+// MultiRegionAccessPointObservation keeps the state for the external resource
+type MultiRegionAccessPointObservation struct {
+	// ARN of the Multi-Region Access Point.
+	ARN string `json:"arn,omitempty"`
+
+	// Alias of the Multi-Region Access Point, which is used in its ARN and
+	// host name.
+	Alias string `json:"alias,omitempty"`
+
+	// Status of the Multi-Region Access Point.
+	Status string `json:"status,omitempty"`
+}
+
+// A MultiRegionAccessPointStatus represents the observed state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            MultiRegionAccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MultiRegionAccessPoint is a managed resource that represents a global
+// endpoint routing AWS S3 requests to buckets in several regions.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".status.atProvider.alias"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MultiRegionAccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MultiRegionAccessPointSpec   `json:"spec"`
+	Status MultiRegionAccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MultiRegionAccessPointList contains a list of MultiRegionAccessPoints
+type MultiRegionAccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MultiRegionAccessPoint `json:"items"`
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this AccessPoint
+func (mg *AccessPoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.bucket
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Bucket),
+		Reference:    mg.Spec.ForProvider.BucketRef,
+		Selector:     mg.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	mg.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcConfiguration.vpcId
+	if vpc := mg.Spec.ForProvider.VPCConfiguration; vpc != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(vpc.VPCID),
+			Reference:    vpc.VPCIDRef,
+			Selector:     vpc.VPCIDSelector,
+			To:           reference.To{Managed: &ec2v1beta1.VPC{}, List: &ec2v1beta1.VPCList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.vpcConfiguration.vpcId")
+		}
+		vpc.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+		vpc.VPCIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this MultiRegionAccessPoint
+func (mg *MultiRegionAccessPoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.regions[*].bucket
+	for i := range mg.Spec.ForProvider.Regions {
+		region := &mg.Spec.ForProvider.Regions[i]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(region.Bucket),
+			Reference:    region.BucketRef,
+			Selector:     region.BucketSelector,
+			To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.regions[%d].bucket", i))
+		}
+		region.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+		region.BucketRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this StorageLensConfiguration
+func (mg *StorageLensConfiguration) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.dataExport.s3BucketDestination.bucket
+	if mg.Spec.ForProvider.DataExport != nil && mg.Spec.ForProvider.DataExport.S3BucketDestination != nil {
+		dst := mg.Spec.ForProvider.DataExport.S3BucketDestination
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(dst.Bucket),
+			Reference:    dst.BucketRef,
+			Selector:     dst.BucketSelector,
+			To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+			Extract:      s3v1beta1.BucketARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.dataExport.s3BucketDestination.bucket")
+		}
+		dst.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+		dst.BucketRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
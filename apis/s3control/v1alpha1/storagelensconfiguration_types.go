@@ -0,0 +1,313 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// StorageLensMetrics enables or disables a group of Storage Lens metrics.
+type StorageLensMetrics struct {
+	// IsEnabled specifies whether the metrics are enabled.
+	IsEnabled bool `json:"isEnabled"`
+}
+
+// AccountLevel specifies the account-level metrics of a Storage Lens
+// configuration.
+type AccountLevel struct {
+	// ActivityMetrics specifies whether activity metrics are enabled for the
+	// account.
+	// +optional
+	ActivityMetrics *StorageLensMetrics `json:"activityMetrics,omitempty"`
+
+	// AdvancedCostOptimizationMetrics specifies whether advanced
+	// cost-optimization metrics are enabled for the account.
+	// +optional
+	AdvancedCostOptimizationMetrics *StorageLensMetrics `json:"advancedCostOptimizationMetrics,omitempty"`
+
+	// AdvancedDataProtectionMetrics specifies whether advanced data-protection
+	// metrics are enabled for the account.
+	// +optional
+	AdvancedDataProtectionMetrics *StorageLensMetrics `json:"advancedDataProtectionMetrics,omitempty"`
+
+	// DetailedStatusCodesMetrics specifies whether detailed status code
+	// metrics are enabled for the account.
+	// +optional
+	DetailedStatusCodesMetrics *StorageLensMetrics `json:"detailedStatusCodesMetrics,omitempty"`
+
+	// BucketLevel specifies the bucket-level metrics of the configuration.
+	BucketLevel BucketLevel `json:"bucketLevel"`
+}
+
+// BucketLevel specifies the bucket-level metrics of a Storage Lens
+// configuration.
+type BucketLevel struct {
+	// ActivityMetrics specifies whether activity metrics are enabled for the
+	// buckets.
+	// +optional
+	ActivityMetrics *StorageLensMetrics `json:"activityMetrics,omitempty"`
+
+	// AdvancedCostOptimizationMetrics specifies whether advanced
+	// cost-optimization metrics are enabled for the buckets.
+	// +optional
+	AdvancedCostOptimizationMetrics *StorageLensMetrics `json:"advancedCostOptimizationMetrics,omitempty"`
+
+	// AdvancedDataProtectionMetrics specifies whether advanced data-protection
+	// metrics are enabled for the buckets.
+	// +optional
+	AdvancedDataProtectionMetrics *StorageLensMetrics `json:"advancedDataProtectionMetrics,omitempty"`
+
+	// DetailedStatusCodesMetrics specifies whether detailed status code
+	// metrics are enabled for the buckets.
+	// +optional
+	DetailedStatusCodesMetrics *StorageLensMetrics `json:"detailedStatusCodesMetrics,omitempty"`
+
+	// PrefixLevel specifies the prefix-level metrics of the buckets.
+	// +optional
+	PrefixLevel *PrefixLevel `json:"prefixLevel,omitempty"`
+}
+
+// PrefixLevel specifies the prefix-level metrics of a Storage Lens
+// configuration.
+type PrefixLevel struct {
+	// StorageMetrics specifies the prefix-level storage metrics.
+	StorageMetrics PrefixLevelStorageMetrics `json:"storageMetrics"`
+}
+
+// PrefixLevelStorageMetrics specifies the prefix-level storage metrics and
+// the prefixes they are collected for.
+type PrefixLevelStorageMetrics struct {
+	// IsEnabled specifies whether prefix-level storage metrics are enabled.
+	IsEnabled bool `json:"isEnabled"`
+
+	// SelectionCriteria selects the prefixes the metrics are collected for.
+	// +optional
+	SelectionCriteria *SelectionCriteria `json:"selectionCriteria,omitempty"`
+}
+
+// SelectionCriteria selects the prefixes prefix-level metrics are collected
+// for.
+type SelectionCriteria struct {
+	// Delimiter is the delimiter of the prefixes.
+	// +optional
+	Delimiter *string `json:"delimiter,omitempty"`
+
+	// MaxDepth is the maximum depth of the prefixes.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	MaxDepth *int64 `json:"maxDepth,omitempty"`
+
+	// MinStorageBytesPercentage is the minimum share of the bucket storage a
+	// prefix must have to be included, in percent.
+	// +kubebuilder:validation:Minimum=0.1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinStorageBytesPercentage *float64 `json:"minStorageBytesPercentage,omitempty"`
+}
+
+// StorageLensScope lists the buckets and regions that are included in or
+// excluded from a Storage Lens configuration. Only one of buckets or regions
+// can be set.
+type StorageLensScope struct {
+	// Buckets is the list of bucket ARNs.
+	// +optional
+	Buckets []string `json:"buckets,omitempty"`
+
+	// Regions is the list of regions.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+}
+
+// StorageLensDataExport specifies where the metrics of a Storage Lens
+// configuration are exported to.
+type StorageLensDataExport struct {
+	// CloudWatchMetrics specifies whether the metrics are published to
+	// Amazon CloudWatch.
+	// +optional
+	CloudWatchMetrics *StorageLensMetrics `json:"cloudWatchMetrics,omitempty"`
+
+	// S3BucketDestination specifies the bucket the metrics export is
+	// delivered to.
+	// +optional
+	S3BucketDestination *StorageLensS3BucketDestination `json:"s3BucketDestination,omitempty"`
+}
+
+// StorageLensS3BucketDestination specifies the bucket a Storage Lens metrics
+// export is delivered to.
+type StorageLensS3BucketDestination struct {
+	// AccountID is the ID of the account that owns the destination bucket.
+	AccountID string `json:"accountId"`
+
+	// Bucket is the ARN of the destination bucket.
+	// At least one of bucket, bucketRef or bucketSelector is required.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its ARN
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its ARN
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Encryption specifies the server-side encryption of the export.
+	// +optional
+	Encryption *StorageLensDataExportEncryption `json:"encryption,omitempty"`
+
+	// Format of the export.
+	// +kubebuilder:validation:Enum=CSV;Parquet
+	Format string `json:"format"`
+
+	// OutputSchemaVersion is the schema version of the export.
+	// +kubebuilder:validation:Enum=V_1
+	// +kubebuilder:default=V_1
+	OutputSchemaVersion string `json:"outputSchemaVersion"`
+
+	// Prefix of the export objects.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
+
+// StorageLensDataExportEncryption specifies the server-side encryption of a
+// Storage Lens metrics export. Only one of sseKms or sseS3 can be set.
+type StorageLensDataExportEncryption struct {
+	// SSEKMS encrypts the export with an AWS KMS key.
+	// +optional
+	SSEKMS *SSEKMS `json:"sseKms,omitempty"`
+
+	// SSES3 encrypts the export with Amazon S3 managed keys.
+	// +optional
+	SSES3 *SSES3 `json:"sseS3,omitempty"`
+}
+
+// SSEKMS specifies the AWS KMS key used to encrypt a metrics export.
+type SSEKMS struct {
+	// KeyID is the ARN of the AWS KMS key.
+	KeyID string `json:"keyId"`
+}
+
+// SSES3 specifies the use of Amazon S3 managed keys to encrypt a metrics
+// export.
+type SSES3 struct{}
+
+// StorageLensTag is a tag of a Storage Lens configuration.
+type StorageLensTag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// StorageLensConfigurationParameters define the desired state of an Amazon
+// S3 Storage Lens configuration. The external name of the configuration is
+// its ID.
+type StorageLensConfigurationParameters struct {
+	// Region is the home region of the configuration.
+	// +immutable
+	Region string `json:"region"`
+
+	// AccountID is the ID of the AWS account that owns the configuration.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// IsEnabled specifies whether the configuration is enabled.
+	IsEnabled bool `json:"isEnabled"`
+
+	// AccountLevel specifies the metrics of the configuration.
+	AccountLevel AccountLevel `json:"accountLevel"`
+
+	// AWSOrgARN is the ARN of the AWS Organization whose accounts are
+	// included in the configuration. Only the management account or a
+	// delegated administrator can set it.
+	// +optional
+	AWSOrgARN *string `json:"awsOrgArn,omitempty"`
+
+	// DataExport specifies where the metrics are exported to.
+	// +optional
+	DataExport *StorageLensDataExport `json:"dataExport,omitempty"`
+
+	// Include lists the buckets or regions that are included in the
+	// configuration. Only one of include or exclude can be set.
+	// +optional
+	Include *StorageLensScope `json:"include,omitempty"`
+
+	// Exclude lists the buckets or regions that are excluded from the
+	// configuration. Only one of include or exclude can be set.
+	// +optional
+	Exclude *StorageLensScope `json:"exclude,omitempty"`
+
+	// Tags of the configuration.
+	// +optional
+	Tags []StorageLensTag `json:"tags,omitempty"`
+}
+
+// A StorageLensConfigurationSpec defines the desired state of a
+// StorageLensConfiguration.
+type StorageLensConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StorageLensConfigurationParameters `json:"forProvider"`
+}
+
+// StorageLensConfigurationObservation keeps the state for the external
+// resource
+type StorageLensConfigurationObservation struct {
+	// ARN of the configuration.
+	ARN string `json:"arn,omitempty"`
+}
+
+// A StorageLensConfigurationStatus represents the observed state of a
+// StorageLensConfiguration.
+type StorageLensConfigurationStatus struct {
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            StorageLensConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StorageLensConfiguration is a managed resource that represents an Amazon
+// S3 Storage Lens configuration, which collects storage usage and activity
+// metrics across the buckets of an account or organization.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENABLED",type="boolean",JSONPath=".spec.forProvider.isEnabled"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type StorageLensConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StorageLensConfigurationSpec   `json:"spec"`
+	Status StorageLensConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StorageLensConfigurationList contains a list of StorageLensConfigurations
+type StorageLensConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StorageLensConfiguration `json:"items"`
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // ResolveReferences of this Secret
@@ -37,7 +38,7 @@ func (mg *Secret) ResolveReferences(ctx context.Context, c client.Reader) error
 		Reference:    mg.Spec.ForProvider.KMSKeyIDRef,
 		Selector:     mg.Spec.ForProvider.KMSKeyIDSelector,
 		To:           reference.To{Managed: &kms.Key{}, List: &kms.KeyList{}},
-		Extract:      reference.ExternalName(),
+		Extract:      awsv1beta1.CrossAccount(mg, reference.ExternalName(), kms.KMSKeyARN()),
 	})
 	if err != nil {
 		return errors.Wrap(err, "spec.forProvider.kmsKeyId")
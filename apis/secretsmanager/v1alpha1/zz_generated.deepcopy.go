@@ -391,6 +391,7 @@ func (in *SecretSpec) DeepCopy() *SecretSpec {
 func (in *SecretStatus) DeepCopyInto(out *SecretStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
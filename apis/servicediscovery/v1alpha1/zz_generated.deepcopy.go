@@ -258,6 +258,7 @@ func (in *HTTPNamespaceSpec) DeepCopy() *HTTPNamespaceSpec {
 func (in *HTTPNamespaceStatus) DeepCopyInto(out *HTTPNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -518,6 +519,7 @@ func (in *PrivateDNSNamespaceSpec) DeepCopy() *PrivateDNSNamespaceSpec {
 func (in *PrivateDNSNamespaceStatus) DeepCopyInto(out *PrivateDNSNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -668,6 +670,7 @@ func (in *PublicDNSNamespaceSpec) DeepCopy() *PublicDNSNamespaceSpec {
 func (in *PublicDNSNamespaceStatus) DeepCopyInto(out *PublicDNSNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
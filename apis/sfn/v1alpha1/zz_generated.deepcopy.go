@@ -211,6 +211,7 @@ func (in *ActivitySpec) DeepCopy() *ActivitySpec {
 func (in *ActivityStatus) DeepCopyInto(out *ActivityStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -687,6 +688,7 @@ func (in *StateMachineSpec) DeepCopy() *StateMachineSpec {
 func (in *StateMachineStatus) DeepCopyInto(out *StateMachineStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
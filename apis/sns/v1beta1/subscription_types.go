@@ -19,6 +19,8 @@ package v1beta1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -111,8 +113,9 @@ type SubscriptionObservation struct {
 
 // SubscriptionStatus is the status of AWS SNS Topic
 type SubscriptionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SubscriptionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            SubscriptionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // Tag represent a user-provided metadata that can be associated with a
@@ -115,8 +117,9 @@ type TopicObservation struct {
 
 // TopicStatus is the status of AWS SNS Topic
 type TopicStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TopicObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            TopicObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -181,6 +181,7 @@ func (in *SubscriptionSpec) DeepCopy() *SubscriptionSpec {
 func (in *SubscriptionStatus) DeepCopyInto(out *SubscriptionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -371,6 +372,7 @@ func (in *TopicSpec) DeepCopy() *TopicSpec {
 func (in *TopicStatus) DeepCopyInto(out *TopicStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // Enum values for Queue attribute names
@@ -212,8 +214,9 @@ type QueueObservation struct {
 
 // QueueStatus represents the observed state of a Queue.
 type QueueStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          QueueObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            QueueObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -206,6 +206,7 @@ func (in *QueueSpec) DeepCopy() *QueueSpec {
 func (in *QueueStatus) DeepCopyInto(out *QueueStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// TestManagedResourcesReportSyncStatus guards against generated types that
+// were regenerated without the SyncStatus the controllers fill in.
+func TestManagedResourcesReportSyncStatus(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	for gvk, typ := range s.AllKnownTypes() {
+		if _, ok := reflect.New(typ).Interface().(resource.Managed); !ok {
+			continue
+		}
+		st, ok := typ.FieldByName("Status")
+		if !ok {
+			t.Errorf("%s: no status", gvk)
+			continue
+		}
+		f, ok := st.Type.FieldByName("SyncStatus")
+		if !ok || !f.Anonymous || f.Type != reflect.TypeOf(v1beta1.SyncStatus{}) {
+			t.Errorf("%s: status does not embed v1beta1.SyncStatus", gvk)
+		}
+	}
+}
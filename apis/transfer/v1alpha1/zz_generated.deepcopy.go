@@ -846,6 +846,7 @@ func (in *ServerSpec) DeepCopy() *ServerSpec {
 func (in *ServerStatus) DeepCopyInto(out *ServerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1081,6 +1082,7 @@ func (in *UserSpec) DeepCopy() *UserSpec {
 func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SyncStatus.DeepCopyInto(&out.SyncStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
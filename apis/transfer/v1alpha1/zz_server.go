@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -30,6 +31,7 @@ type ServerParameters struct {
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
 
+	// +kubebuilder:validation:Enum=S3;EFS
 	Domain *string `json:"domain,omitempty"`
 	// The type of VPC endpoint that you want your server to connect to. You can
 	// choose to connect to the public internet or a VPC endpoint. With a VPC endpoint,
@@ -40,6 +42,7 @@ type ServerParameters struct {
 	// addresses (BYO IP included) with your server's endpoint and use VPC security
 	// groups to restrict traffic by the client's public IP address. This is not
 	// possible with EndpointType set to VPC_ENDPOINT.
+	// +kubebuilder:validation:Enum=PUBLIC;VPC;VPC_ENDPOINT
 	EndpointType *string `json:"endpointType,omitempty"`
 	// The RSA private key as generated by the ssh-keygen -N "" -m PEM -f my-new-server-key
 	// command.
@@ -63,6 +66,7 @@ type ServerParameters struct {
 	// of your choosing. The API_GATEWAY setting requires you to provide an API
 	// Gateway endpoint URL to call for authentication using the IdentityProviderDetails
 	// parameter.
+	// +kubebuilder:validation:Enum=SERVICE_MANAGED;API_GATEWAY;AWS_DIRECTORY_SERVICE;AWS_LAMBDA
 	IdentityProviderType *string `json:"identityProviderType,omitempty"`
 	// Specifies the file transfer protocol or protocols over which your file transfer
 	// protocol client can connect to your server's endpoint. The available protocols
@@ -108,8 +112,9 @@ type ServerObservation struct {
 
 // ServerStatus defines the observed state of Server.
 type ServerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ServerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            ServerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
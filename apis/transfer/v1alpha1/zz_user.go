@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -62,6 +63,7 @@ type UserParameters struct {
 	// clients. If you set it LOGICAL, you will need to provide mappings in the
 	// HomeDirectoryMappings for how you want to make Amazon S3 paths visible to
 	// your users.
+	// +kubebuilder:validation:Enum=PATH;LOGICAL
 	HomeDirectoryType *string `json:"homeDirectoryType,omitempty"`
 	// A scope-down policy for your user so you can use the same IAM role across
 	// multiple users. This policy scopes down user access to portions of their
@@ -104,8 +106,9 @@ type UserObservation struct {
 
 // UserStatus defines the observed state of User.
 type UserStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          UserObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus   `json:",inline"`
+	awsv1beta1.SyncStatus `json:",inline"`
+	AtProvider            UserObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
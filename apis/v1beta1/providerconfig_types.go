@@ -39,6 +39,14 @@ type ProviderConfigSpec struct {
 	// of AWS calls made by the provider.
 	// +optional
 	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// CheckServiceQuotas makes the controllers of resources that are subject
+	// to hard account limits, like VPCs, Elastic IPs and RDS instances, query
+	// AWS Service Quotas before creating them. A resource whose creation would
+	// exceed the applied quota gets a QuotaExceeded condition and is not
+	// created until the quota allows it.
+	// +optional
+	CheckServiceQuotas *bool `json:"checkServiceQuotas,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -152,6 +160,21 @@ type DynamicURLConfig struct {
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// CallerIdentity is the AWS identity the credentials of this
+	// ProviderConfig resolve to. It is only reported when the provider runs
+	// with its credentials check enabled.
+	// +optional
+	CallerIdentity *CallerIdentity `json:"callerIdentity,omitempty"`
+}
+
+// CallerIdentity is the result of an STS GetCallerIdentity call.
+type CallerIdentity struct {
+	// Account is the ID of the AWS account the credentials belong to.
+	Account string `json:"account"`
+
+	// ARN of the IAM user or assumed role the credentials belong to.
+	ARN string `json:"arn"`
 }
 
 // +kubebuilder:object:root=true
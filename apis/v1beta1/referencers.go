@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// defaultProviderConfigName is the ProviderConfig used by managed resources
+// that don't reference one.
+const defaultProviderConfigName = "default"
+
+// CrossAccount returns an extractor that uses the sameAccount extractor for
+// referenced resources that use the same ProviderConfig as the referencer, and
+// the crossAccount extractor for those that use another one. Resources under
+// another ProviderConfig may live in another account, in which case names and
+// IDs like those of KMS keys do not identify them, but their ARNs do.
+func CrossAccount(referencer resource.Managed, sameAccount, crossAccount reference.ExtractValueFn) reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if providerConfigName(mg) != providerConfigName(referencer) {
+			return crossAccount(mg)
+		}
+		return sameAccount(mg)
+	}
+}
+
+func providerConfigName(mg resource.Managed) string {
+	if r := mg.GetProviderConfigReference(); r != nil {
+		return r.Name
+	}
+	return defaultProviderConfigName
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestCrossAccount(t *testing.T) {
+	managed := func(pc string) *fake.Managed {
+		mg := &fake.Managed{}
+		if pc != "" {
+			mg.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+		}
+		return mg
+	}
+	id := func(resource.Managed) string { return "id" }
+	arn := func(resource.Managed) string { return "arn" }
+
+	cases := map[string]struct {
+		referencer resource.Managed
+		referenced resource.Managed
+		want       string
+	}{
+		"SameProviderConfig": {
+			referencer: managed("spoke"),
+			referenced: managed("spoke"),
+			want:       "id",
+		},
+		"DefaultProviderConfig": {
+			referencer: managed(""),
+			referenced: managed("default"),
+			want:       "id",
+		},
+		"OtherProviderConfig": {
+			referencer: managed("spoke"),
+			referenced: managed("hub"),
+			want:       "arn",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CrossAccount(tc.referencer, id, arn)(tc.referenced)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CrossAccount(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
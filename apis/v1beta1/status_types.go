@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncStatus records how recently the controller of a managed resource
+// synchronised it with AWS. Every managed resource of this provider embeds it
+// in its status.
+type SyncStatus struct {
+	// ObservedGeneration is the most recent generation of the resource whose
+	// spec the controller has applied to the external resource.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncTime is the last time the controller consulted AWS about the
+	// external resource. It is refreshed at most every few minutes so that
+	// the resource is not written on every poll.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// LastRequestID is the ID of the last AWS API request recorded together
+	// with LastSyncTime or made to create, update or delete the external
+	// resource. AWS support asks for it when investigating a request.
+	// +optional
+	LastRequestID string `json:"lastRequestID,omitempty"`
+}
@@ -25,6 +25,36 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CallerIdentity) DeepCopyInto(out *CallerIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallerIdentity.
+func (in *CallerIdentity) DeepCopy() *CallerIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(CallerIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicURLConfig) DeepCopyInto(out *DynamicURLConfig) {
 	*out = *in
@@ -164,6 +194,11 @@ func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 		*out = new(EndpointConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CheckServiceQuotas != nil {
+		in, out := &in.CheckServiceQuotas, &out.CheckServiceQuotas
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -180,6 +215,11 @@ func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
 func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
 	*out = *in
 	in.ProviderConfigStatus.DeepCopyInto(&out.ProviderConfigStatus)
+	if in.CallerIdentity != nil {
+		in, out := &in.CallerIdentity, &out.CallerIdentity
+		*out = new(CallerIdentity)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
@@ -266,6 +306,25 @@ func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncStatus.
+func (in *SyncStatus) DeepCopy() *SyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLConfig) DeepCopyInto(out *URLConfig) {
 	*out = *in
@@ -21,15 +21,21 @@ import (
 	"path/filepath"
 
 	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"sigs.k8s.io/controller-runtime/pkg/config/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 
 	"github.com/crossplane/provider-aws/apis"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/controller"
+	"github.com/crossplane/provider-aws/pkg/controller/config"
+	iamwebhook "github.com/crossplane/provider-aws/pkg/webhook/iam"
 )
 
 func main() {
@@ -39,6 +45,17 @@ func main() {
 		syncInterval   = app.Flag("sync", "Sync interval controls how often all resources will be double checked for drift.").Short('s').Default("1h").Duration()
 		pollInterval   = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("1m").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		probeAddress   = app.Flag("health-probe-bind-address", "Address the liveness and readiness probes are served on when the credentials check is enabled.").Default(":8081").String()
+		checkCreds     = app.Flag("credentials-check", "Report ready only once the credentials of the ProviderConfig named by --credentials-check-provider-config can call STS GetCallerIdentity.").Default("false").Bool()
+		checkCredsPC   = app.Flag("credentials-check-provider-config", "ProviderConfig whose credentials are checked.").Default("default").String()
+		checkCredsRgn  = app.Flag("credentials-check-region", "AWS region STS is called in to check credentials.").Default("us-east-1").String()
+		maxReconciles  = app.Flag("max-concurrent-reconciles", "Maximum number of resources of each kind that are reconciled concurrently.").Default("1").Int()
+		kindReconciles = app.Flag("max-concurrent-reconciles-per-kind", "Maximum number of resources of the given kind that are reconciled concurrently, overriding --max-concurrent-reconciles, e.g. SecurityGroup.ec2.aws.crossplane.io=10. May be repeated.").StringMap()
+		validatePol    = app.Flag("policy-validation", "Serve a validating webhook that rejects IAM Policy and RolePolicy documents with Access Analyzer errors or security warnings.").Default("false").Bool()
+		validatePolPC  = app.Flag("policy-validation-provider-config", "ProviderConfig whose credentials are used to call Access Analyzer.").Default("default").String()
+		validatePolRgn = app.Flag("policy-validation-region", "AWS region Access Analyzer is called in.").Default("us-east-1").String()
+		webhookPort    = app.Flag("webhook-port", "Port the webhook server listens on.").Default("9443").Int()
+		webhookCertDir = app.Flag("webhook-tls-cert-dir", "Directory containing the tls.crt and tls.key the webhook server serves.").Default("/tmp/k8s-webhook-server/serving-certs").String()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -56,15 +73,37 @@ func main() {
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
-	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+	s := runtime.NewScheme()
+	kingpin.FatalIfError(apis.AddToScheme(s), "Cannot add AWS APIs to scheme")
+	concurrency, err := controller.GroupKindConcurrency(s, *maxReconciles, *kindReconciles)
+	kingpin.FatalIfError(err, "Cannot configure concurrent reconciles")
+
+	o := ctrl.Options{
 		LeaderElection:   *leaderElection,
 		LeaderElectionID: "crossplane-leader-election-provider-aws",
 		SyncPeriod:       syncInterval,
-	})
+		Controller:       v1alpha1.ControllerConfigurationSpec{GroupKindConcurrency: concurrency},
+		NewClient:        awsclient.NewApplyingClientFunc,
+	}
+	if *checkCreds {
+		o.HealthProbeBindAddress = *probeAddress
+	}
+	if *validatePol {
+		o.Port = *webhookPort
+		o.CertDir = *webhookCertDir
+	}
+	mgr, err := ctrl.NewManager(cfg, o)
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
 	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewGlobal(ratelimiter.DefaultGlobalRPS), *pollInterval), "Cannot setup AWS controllers")
+	if *checkCreds {
+		kingpin.FatalIfError(mgr.AddHealthzCheck("ping", healthz.Ping), "Cannot add liveness check")
+		kingpin.FatalIfError(config.SetupCredentialsCheck(mgr, log, *checkCredsPC, *checkCredsRgn), "Cannot add credentials check")
+	}
+	if *validatePol {
+		kingpin.FatalIfError(iamwebhook.SetupPolicyValidation(mgr, log, *validatePolPC, *validatePolRgn), "Cannot setup IAM policy validation webhook")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
 }
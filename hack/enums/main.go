@@ -0,0 +1,305 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Enums adds enum validation to the string parameters of the managed
+// resources that ack-generate generates into the supplied service
+// directories, since the code generator does not emit it. The valid values of
+// a parameter are the enum of its shape in the AWS API model that the SDK is
+// generated from or, if the model declares it as a plain string, the valid
+// values listed in the model's documentation of the parameter. It is run by
+// make services after the code generator.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const enumMarker = "\t// +kubebuilder:validation:Enum="
+
+var (
+	generated = []byte("// Code generated by ack-generate. DO NOT EDIT.")
+
+	// parameters matches the start of a generated parameters struct.
+	parameters = regexp.MustCompile(`^type (\w+)Parameters struct {$`)
+
+	// stringField matches a string field of a generated struct.
+	stringField = regexp.MustCompile("^\t\\w+ +\\*string +`json:\"(\\w+)[,\"]")
+
+	tag      = regexp.MustCompile(`<[^>]*>`)
+	listItem = regexp.MustCompile(`<li>\s*<p>\s*(.*?)</p>\s*</li>`)
+	code     = regexp.MustCompile(`^<code>([^<]+)</code>`)
+	value    = regexp.MustCompile(`^[\w.:/-]+$`)
+
+	// caseInsensitive are the members whose values the API accepts in any
+	// case, so that the enum of the model would reject values that work.
+	caseInsensitive = map[string]bool{
+		"CreateBrokerRequest$EngineType": true,
+	}
+
+	// modelNames are the names of the API models of the services whose
+	// directory is named differently and that don't configure a model_name.
+	modelNames = map[string]string{
+		"cloudwatchlogs": "logs",
+		"efs":            "elasticfilesystem",
+		"sfn":            "states",
+	}
+)
+
+func main() {
+	models, err := modelsDir()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, dir := range os.Args[1:] {
+		if err := addEnumsIn(models, dir); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// modelsDir returns the directory of the API models that ship with the
+// version of the SDK the provider depends on.
+func modelsDir() (string, error) {
+	pkg, err := build.Import("github.com/aws/aws-sdk-go/aws", ".", build.FindOnly)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(pkg.Dir), "models", "apis"), nil
+}
+
+func addEnumsIn(models, dir string) error {
+	m, err := loadModel(filepath.Join(models, modelName(dir)))
+	if err != nil {
+		return fmt.Errorf("%s: %w", dir, err)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "v1alpha1", "zz_*.go"))
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		src, err := os.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return err
+		}
+		out, err := addEnums(src, m.validValues)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f, err)
+		}
+		if bytes.Equal(src, out) {
+			continue
+		}
+		if err := os.WriteFile(f, out, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modelName returns the name of the API model of the service in the supplied
+// directory, which is configured the same way as for ack-generate.
+func modelName(dir string) string {
+	svc := filepath.Base(dir)
+	cfg := struct {
+		ModelName string `json:"model_name"`
+	}{}
+	if b, err := os.ReadFile(filepath.Clean(filepath.Join(dir, "generator-config.yaml"))); err == nil {
+		if err := yaml.Unmarshal(b, &cfg); err == nil && cfg.ModelName != "" {
+			return cfg.ModelName
+		}
+	}
+	if n, ok := modelNames[svc]; ok {
+		return n
+	}
+	return svc
+}
+
+type shape struct {
+	Type    string   `json:"type"`
+	Enum    []string `json:"enum"`
+	Members map[string]struct {
+		Shape string `json:"shape"`
+	} `json:"members"`
+}
+
+type model struct {
+	Operations map[string]struct {
+		Input struct {
+			Shape string `json:"shape"`
+		} `json:"input"`
+	} `json:"operations"`
+	Shapes map[string]shape `json:"shapes"`
+
+	// Docs holds the documentation of the references to each shape, keyed
+	// by the shape and then by the referencing Shape$Member.
+	Docs map[string]map[string]string
+}
+
+// loadModel loads the latest version of the API model in the supplied
+// directory.
+func loadModel(dir string) (*model, error) {
+	versions, err := filepath.Glob(filepath.Join(dir, "*", "api-2.json"))
+	if err != nil || len(versions) == 0 {
+		return nil, fmt.Errorf("no API model in %s", dir)
+	}
+	sort.Strings(versions)
+	dir = filepath.Dir(versions[len(versions)-1])
+
+	m := &model{}
+	b, err := os.ReadFile(filepath.Clean(filepath.Join(dir, "api-2.json")))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, m); err != nil {
+		return nil, err
+	}
+	docs := struct {
+		Shapes map[string]struct {
+			Refs map[string]string `json:"refs"`
+		} `json:"shapes"`
+	}{}
+	b, err = os.ReadFile(filepath.Clean(filepath.Join(dir, "docs-2.json")))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &docs); err != nil {
+		return nil, err
+	}
+	m.Docs = make(map[string]map[string]string, len(docs.Shapes))
+	for name, s := range docs.Shapes {
+		m.Docs[name] = s.Refs
+	}
+	return m, nil
+}
+
+// validValues returns the valid values of the string members of the input of
+// the operation that creates the supplied kind, keyed by their lower case
+// name.
+func (m *model) validValues(kind string) map[string][]string {
+	op, ok := m.Operations["Create"+kind]
+	if !ok {
+		return nil
+	}
+	in := op.Input.Shape
+	vals := map[string][]string{}
+	for name, ref := range m.Shapes[in].Members {
+		s := m.Shapes[ref.Shape]
+		if s.Type != "string" || caseInsensitive[in+"$"+name] {
+			continue
+		}
+		v := s.Enum
+		if len(v) == 0 {
+			v = documentedValues(m.Docs[ref.Shape][in+"$"+name])
+		}
+		if len(v) > 0 {
+			vals[strings.ToLower(name)] = v
+		}
+	}
+	return vals
+}
+
+// documentedValues returns the valid values listed in the supplied
+// documentation of a member, either inline as "Valid Values: a | b" or as a
+// list that has a single value per item. Anything else, such as ranges or
+// values that depend on other members, is not considered a list of values.
+func documentedValues(doc string) []string {
+	i := strings.Index(strings.ToLower(doc), "valid values:")
+	if i < 0 {
+		return nil
+	}
+	rest := doc[i+len("valid values:"):]
+	p := rest
+	if j := strings.Index(rest, "</p>"); j >= 0 {
+		p, rest = rest[:j], rest[j+len("</p>"):]
+	}
+
+	var vals []string
+	if inline := strings.TrimSpace(tag.ReplaceAllString(p, "")); inline != "" {
+		vals = strings.Split(inline, "|")
+	} else {
+		rest = strings.TrimSpace(rest)
+		j := strings.Index(rest, "</ul>")
+		if !strings.HasPrefix(rest, "<ul>") || j < 0 {
+			return nil
+		}
+		list := rest[:j]
+		items := listItem.FindAllStringSubmatch(list, -1)
+		if len(items) != strings.Count(list, "<li>") {
+			return nil
+		}
+		for _, item := range items {
+			c := code.FindStringSubmatch(item[1])
+			if c == nil {
+				return nil
+			}
+			vals = append(vals, c[1])
+		}
+	}
+	if len(vals) < 2 {
+		return nil
+	}
+	for i := range vals {
+		vals[i] = strings.TrimSpace(vals[i])
+		if !value.MatchString(vals[i]) {
+			return nil
+		}
+	}
+	return vals
+}
+
+// addEnums adds an enum marker to each string field of the parameters structs
+// of the supplied generated source whose valid values are known, replacing
+// the markers that were added before.
+func addEnums(src []byte, validValues func(kind string) map[string][]string) ([]byte, error) {
+	if !bytes.Contains(src, generated) {
+		return src, nil
+	}
+	lines := strings.Split(string(src), "\n")
+	out := make([]string, 0, len(lines))
+	var vals map[string][]string
+	inParameters := false
+	for _, l := range lines {
+		switch m := parameters.FindStringSubmatch(l); {
+		case m != nil:
+			vals = validValues(m[1])
+			inParameters = true
+		case l == "}":
+			inParameters = false
+		case inParameters && strings.HasPrefix(l, enumMarker):
+			continue
+		case inParameters:
+			if f := stringField.FindStringSubmatch(l); f != nil {
+				if v, ok := vals[strings.ToLower(f[1])]; ok {
+					out = append(out, enumMarker+strings.Join(v, ";"))
+				}
+			}
+		}
+		out = append(out, l)
+	}
+	return format.Source([]byte(strings.Join(out, "\n")))
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const header = `// Code generated by ack-generate. DO NOT EDIT.
+
+package v1alpha1
+
+`
+
+func TestDocumentedValues(t *testing.T) {
+	cases := map[string]struct {
+		doc  string
+		want []string
+	}{
+		"Inline": {
+			doc:  `<p>The storage type.</p> <p>Valid Values: <code>gp2 | gp3 | io1 | standard</code> </p> <p>Default: <code>gp2</code>.</p>`,
+			want: []string{"gp2", "gp3", "io1", "standard"},
+		},
+		"List": {
+			doc:  `<p>Valid Values:</p> <ul> <li> <p> <code>aurora-mysql</code> (for Aurora MySQL DB instances)</p> </li> <li> <p> <code>mysql</code> </p> </li> </ul>`,
+			want: []string{"aurora-mysql", "mysql"},
+		},
+		"ListOfConditionalValues": {
+			doc: `<p>Valid Values:</p> <ul> <li> <p>Aurora DB clusters - <code>aurora | aurora-iopt1</code> </p> </li> <li> <p>Multi-AZ DB clusters - <code>io1</code> </p> </li> </ul>`,
+		},
+		"Range": {
+			doc: `<p>Valid Values: <code>1-65535</code> </p>`,
+		},
+		"Sentence": {
+			doc: `<p>Valid values: Any number between 0 and 35.</p>`,
+		},
+		"Undocumented": {
+			doc: `<p>The name of the database.</p>`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, documentedValues(tc.doc)); diff != "" {
+				t.Errorf("documentedValues(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddEnums(t *testing.T) {
+	validValues := func(kind string) map[string][]string {
+		if kind != "DBInstance" {
+			return nil
+		}
+		return map[string][]string{"storagetype": {"gp2", "gp3"}}
+	}
+
+	cases := map[string]struct {
+		src  string
+		want string
+	}{
+		"Generated": {
+			src: header + `type DBInstanceParameters struct {
+	// The storage type.
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+	Engine *string ` + "`json:\"engine\"`" + `
+}
+
+type DBInstanceObservation struct {
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+}
+`,
+			want: header + `type DBInstanceParameters struct {
+	// The storage type.
+	// +kubebuilder:validation:Enum=gp2;gp3
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+	Engine      *string ` + "`json:\"engine\"`" + `
+}
+
+type DBInstanceObservation struct {
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+}
+`,
+		},
+		"OutdatedMarker": {
+			src: header + `type DBInstanceParameters struct {
+	// +kubebuilder:validation:Enum=gp2
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+}
+`,
+			want: header + `type DBInstanceParameters struct {
+	// +kubebuilder:validation:Enum=gp2;gp3
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+}
+`,
+		},
+		"UnknownKind": {
+			src: header + `type OptionGroupParameters struct {
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+}
+`,
+			want: header + `type OptionGroupParameters struct {
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+}
+`,
+		},
+		"NotGenerated": {
+			src: `package v1alpha1
+
+type DBInstanceParameters struct {
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+}
+`,
+			want: `package v1alpha1
+
+type DBInstanceParameters struct {
+	StorageType *string ` + "`json:\"storageType,omitempty\"`" + `
+}
+`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := addEnums([]byte(tc.src), validValues)
+			if err != nil {
+				t.Fatalf("addEnums(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("addEnums(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Syncstatus embeds SyncStatus in the status of the managed resources that
+// ack-generate generates into the supplied API directories, since the code
+// generator cannot do so itself. It is run by make services after the code
+// generator.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+var (
+	generated = []byte("// Code generated by ack-generate. DO NOT EDIT.")
+
+	// status matches the start of a generated status struct.
+	status = regexp.MustCompile("(?m)^type \\w+Status struct {\n\txpv1\\.ResourceStatus +`json:\",inline\"`\n")
+
+	syncStatus  = []byte("\tawsv1beta1.SyncStatus `json:\",inline\"`\n")
+	xpv1Import  = []byte("\txpv1 \"github.com/crossplane/crossplane-runtime/apis/common/v1\"\n")
+	awsv1Import = []byte("\tawsv1beta1 \"github.com/crossplane/provider-aws/apis/v1beta1\"\n")
+)
+
+func main() {
+	for _, dir := range os.Args[1:] {
+		if err := embedSyncStatusIn(dir); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func embedSyncStatusIn(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "zz_*.go"))
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		src, err := os.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return err
+		}
+		out, err := embedSyncStatus(src)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f, err)
+		}
+		if bytes.Equal(src, out) {
+			continue
+		}
+		if err := os.WriteFile(f, out, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// embedSyncStatus embeds SyncStatus in the status structs of the supplied
+// generated source, unless they embed it already.
+func embedSyncStatus(src []byte) ([]byte, error) {
+	if !bytes.Contains(src, generated) || bytes.Contains(src, syncStatus) || !status.Match(src) {
+		return src, nil
+	}
+	out := status.ReplaceAllFunc(src, func(m []byte) []byte {
+		return append(append([]byte{}, m...), syncStatus...)
+	})
+	if !bytes.Contains(out, awsv1Import) {
+		out = bytes.Replace(out, xpv1Import, append(append([]byte{}, xpv1Import...), awsv1Import...), 1)
+	}
+	return format.Source(out)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const header = `// Code generated by ack-generate. DO NOT EDIT.
+
+package v1alpha1
+
+`
+
+func TestEmbedSyncStatus(t *testing.T) {
+	cases := map[string]struct {
+		src  string
+		want string
+	}{
+		"Generated": {
+			src: header + `import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// APIStatus defines the observed state of API.
+type APIStatus struct {
+	xpv1.ResourceStatus ` + "`json:\",inline\"`" + `
+	AtProvider          APIObservation ` + "`json:\"atProvider,omitempty\"`" + `
+}
+`,
+			want: header + `import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// APIStatus defines the observed state of API.
+type APIStatus struct {
+	xpv1.ResourceStatus   ` + "`json:\",inline\"`" + `
+	awsv1beta1.SyncStatus ` + "`json:\",inline\"`" + `
+	AtProvider            APIObservation ` + "`json:\"atProvider,omitempty\"`" + `
+}
+`,
+		},
+		"AlreadyEmbedded": {
+			src: header + `type APIStatus struct {
+	xpv1.ResourceStatus   ` + "`json:\",inline\"`" + `
+	awsv1beta1.SyncStatus ` + "`json:\",inline\"`" + `
+}
+`,
+			want: header + `type APIStatus struct {
+	xpv1.ResourceStatus   ` + "`json:\",inline\"`" + `
+	awsv1beta1.SyncStatus ` + "`json:\",inline\"`" + `
+}
+`,
+		},
+		"NotGenerated": {
+			src: `package v1alpha1
+
+type APIStatus struct {
+	xpv1.ResourceStatus ` + "`json:\",inline\"`" + `
+}
+`,
+			want: `package v1alpha1
+
+type APIStatus struct {
+	xpv1.ResourceStatus ` + "`json:\",inline\"`" + `
+}
+`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := embedSyncStatus([]byte(tc.src))
+			if err != nil {
+				t.Fatalf("embedSyncStatus(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("embedSyncStatus(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
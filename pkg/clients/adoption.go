@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyAdoptedAt is added to managed resources that were created for
+// an existing external resource, i.e. with its external name already set, once
+// that resource was adopted. Its value is the time of adoption.
+const AnnotationKeyAdoptedAt = "aws.crossplane.io/adopted-at"
+
+// adopting returns true if the supplied managed resource is yet to be
+// reconciled for the first time although it names an external resource that
+// it did not create.
+func adopting(mg resource.Managed) bool {
+	if meta.GetExternalName(mg) == "" || mg.GetAnnotations()[AnnotationKeyAdoptedAt] != "" {
+		return false
+	}
+	if !meta.GetExternalCreatePending(mg).IsZero() || !meta.GetExternalCreateSucceeded(mg).IsZero() {
+		return false
+	}
+	return mg.GetCondition(xpv1.TypeSynced).Status == corev1.ConditionUnknown
+}
+
+// adopt adopts the external resource observed for the supplied managed
+// resource. Its first observation only late initializes the spec, so fields
+// that were left unset take the values of the existing resource instead of
+// being reset to their defaults. The spec is compared to the resource as usual
+// from the next observation on.
+func adopt(mg resource.Managed, o managed.ExternalObservation) managed.ExternalObservation {
+	if !o.ResourceExists {
+		return o
+	}
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyAdoptedAt: time.Now().UTC().Format(time.RFC3339)})
+	// The reconciler persists the spec, and the annotation with it, only if
+	// it was late initialized.
+	o.ResourceLateInitialized = true
+	o.ResourceUpToDate = true
+	return o
+}
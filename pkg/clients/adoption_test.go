@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func TestAdoption(t *testing.T) {
+	observed := func(exists bool) managed.ExternalConnecter {
+		return managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return &managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					return managed.ExternalObservation{ResourceExists: exists}, nil
+				},
+			}, nil
+		})
+	}
+
+	type args struct {
+		connecter    managed.ExternalConnecter
+		externalName string
+		annotations  map[string]string
+		conditions   []xpv1.Condition
+	}
+	type want struct {
+		obs     managed.ExternalObservation
+		adopted bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Adopted": {
+			args: args{
+				connecter:    observed(true),
+				externalName: "vpc-123",
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				adopted: true,
+			},
+		},
+		"NotFound": {
+			args: args{
+				connecter:    observed(false),
+				externalName: "vpc-123",
+			},
+			want: want{
+				obs: managed.ExternalObservation{},
+			},
+		},
+		"AlreadyAdopted": {
+			args: args{
+				connecter:    observed(true),
+				externalName: "vpc-123",
+				annotations:  map[string]string{AnnotationKeyAdoptedAt: "2021-10-01T00:00:00Z"},
+			},
+			want: want{
+				obs:     managed.ExternalObservation{ResourceExists: true},
+				adopted: true,
+			},
+		},
+		"AlreadyReconciled": {
+			args: args{
+				connecter:    observed(true),
+				externalName: "vpc-123",
+				conditions:   []xpv1.Condition{xpv1.ReconcileSuccess()},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"CreatedByProvider": {
+			args: args{
+				connecter:    observed(true),
+				externalName: "vpc-123",
+				annotations:  map[string]string{meta.AnnotationKeyExternalCreatePending: time.Now().Format(time.RFC3339)},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				connecter: observed(true),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &ec2v1beta1.VPC{ObjectMeta: metav1.ObjectMeta{Annotations: tc.args.annotations}}
+			if tc.args.externalName != "" {
+				meta.SetExternalName(cr, tc.args.externalName)
+			}
+			cr.SetConditions(tc.args.conditions...)
+			ext, err := WrapExternal(&test.MockClient{}, tc.args.connecter).Connect(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			o, err := ext.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Observe(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, o); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.adopted, cr.GetAnnotations()[AnnotationKeyAdoptedAt] != ""); diff != "" {
+				t.Errorf("adopted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
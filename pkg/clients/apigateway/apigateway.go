@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errTagResource   = "cannot tag API Gateway resource"
+	errUntagResource = "cannot untag API Gateway resource"
+)
+
+// AnnotationKeyDefinitionChecksum is added to RestAPIs and Deployments. Its
+// value is the checksum of the OpenAPI document that was imported into the
+// REST API, or that the REST API was deployed with, last, since API Gateway
+// does not report it.
+const AnnotationKeyDefinitionChecksum = "apigateway.aws.crossplane.io/definition-checksum"
+
+// Client defines API Gateway Client operations
+type Client interface {
+	CreateRestApiWithContext(ctx context.Context, input *apigateway.CreateRestApiInput, opts ...request.Option) (*apigateway.RestApi, error)
+	ImportRestApiWithContext(ctx context.Context, input *apigateway.ImportRestApiInput, opts ...request.Option) (*apigateway.RestApi, error)
+	PutRestApiWithContext(ctx context.Context, input *apigateway.PutRestApiInput, opts ...request.Option) (*apigateway.RestApi, error)
+	GetRestApiWithContext(ctx context.Context, input *apigateway.GetRestApiInput, opts ...request.Option) (*apigateway.RestApi, error)
+	UpdateRestApiWithContext(ctx context.Context, input *apigateway.UpdateRestApiInput, opts ...request.Option) (*apigateway.RestApi, error)
+	DeleteRestApiWithContext(ctx context.Context, input *apigateway.DeleteRestApiInput, opts ...request.Option) (*apigateway.DeleteRestApiOutput, error)
+	GetResourceWithContext(ctx context.Context, input *apigateway.GetResourceInput, opts ...request.Option) (*apigateway.Resource, error)
+	GetResourcesWithContext(ctx context.Context, input *apigateway.GetResourcesInput, opts ...request.Option) (*apigateway.GetResourcesOutput, error)
+	CreateResourceWithContext(ctx context.Context, input *apigateway.CreateResourceInput, opts ...request.Option) (*apigateway.Resource, error)
+	UpdateResourceWithContext(ctx context.Context, input *apigateway.UpdateResourceInput, opts ...request.Option) (*apigateway.Resource, error)
+	DeleteResourceWithContext(ctx context.Context, input *apigateway.DeleteResourceInput, opts ...request.Option) (*apigateway.DeleteResourceOutput, error)
+	GetMethodWithContext(ctx context.Context, input *apigateway.GetMethodInput, opts ...request.Option) (*apigateway.Method, error)
+	PutMethodWithContext(ctx context.Context, input *apigateway.PutMethodInput, opts ...request.Option) (*apigateway.Method, error)
+	UpdateMethodWithContext(ctx context.Context, input *apigateway.UpdateMethodInput, opts ...request.Option) (*apigateway.Method, error)
+	DeleteMethodWithContext(ctx context.Context, input *apigateway.DeleteMethodInput, opts ...request.Option) (*apigateway.DeleteMethodOutput, error)
+	PutIntegrationWithContext(ctx context.Context, input *apigateway.PutIntegrationInput, opts ...request.Option) (*apigateway.Integration, error)
+	DeleteIntegrationWithContext(ctx context.Context, input *apigateway.DeleteIntegrationInput, opts ...request.Option) (*apigateway.DeleteIntegrationOutput, error)
+	CreateDeploymentWithContext(ctx context.Context, input *apigateway.CreateDeploymentInput, opts ...request.Option) (*apigateway.Deployment, error)
+	GetDeploymentWithContext(ctx context.Context, input *apigateway.GetDeploymentInput, opts ...request.Option) (*apigateway.Deployment, error)
+	UpdateDeploymentWithContext(ctx context.Context, input *apigateway.UpdateDeploymentInput, opts ...request.Option) (*apigateway.Deployment, error)
+	DeleteDeploymentWithContext(ctx context.Context, input *apigateway.DeleteDeploymentInput, opts ...request.Option) (*apigateway.DeleteDeploymentOutput, error)
+	CreateStageWithContext(ctx context.Context, input *apigateway.CreateStageInput, opts ...request.Option) (*apigateway.Stage, error)
+	GetStageWithContext(ctx context.Context, input *apigateway.GetStageInput, opts ...request.Option) (*apigateway.Stage, error)
+	UpdateStageWithContext(ctx context.Context, input *apigateway.UpdateStageInput, opts ...request.Option) (*apigateway.Stage, error)
+	DeleteStageWithContext(ctx context.Context, input *apigateway.DeleteStageInput, opts ...request.Option) (*apigateway.DeleteStageOutput, error)
+	CreateUsagePlanWithContext(ctx context.Context, input *apigateway.CreateUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error)
+	GetUsagePlanWithContext(ctx context.Context, input *apigateway.GetUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error)
+	UpdateUsagePlanWithContext(ctx context.Context, input *apigateway.UpdateUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error)
+	DeleteUsagePlanWithContext(ctx context.Context, input *apigateway.DeleteUsagePlanInput, opts ...request.Option) (*apigateway.DeleteUsagePlanOutput, error)
+	GetUsagePlanKeysWithContext(ctx context.Context, input *apigateway.GetUsagePlanKeysInput, opts ...request.Option) (*apigateway.GetUsagePlanKeysOutput, error)
+	CreateUsagePlanKeyWithContext(ctx context.Context, input *apigateway.CreateUsagePlanKeyInput, opts ...request.Option) (*apigateway.UsagePlanKey, error)
+	DeleteUsagePlanKeyWithContext(ctx context.Context, input *apigateway.DeleteUsagePlanKeyInput, opts ...request.Option) (*apigateway.DeleteUsagePlanKeyOutput, error)
+	CreateApiKeyWithContext(ctx context.Context, input *apigateway.CreateApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error)
+	GetApiKeyWithContext(ctx context.Context, input *apigateway.GetApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error)
+	UpdateApiKeyWithContext(ctx context.Context, input *apigateway.UpdateApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error)
+	DeleteApiKeyWithContext(ctx context.Context, input *apigateway.DeleteApiKeyInput, opts ...request.Option) (*apigateway.DeleteApiKeyOutput, error)
+	TagResourceWithContext(ctx context.Context, input *apigateway.TagResourceInput, opts ...request.Option) (*apigateway.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *apigateway.UntagResourceInput, opts ...request.Option) (*apigateway.UntagResourceOutput, error)
+}
+
+// NewClient returns a new API Gateway client using the given session.
+func NewClient(sess *session.Session) Client {
+	return apigateway.New(sess)
+}
+
+// IsNotFound returns true if the error is because the resource doesn't exist.
+func IsNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == apigateway.ErrCodeNotFoundException
+}
+
+// ARN returns the ARN of the API Gateway resource with the given path, e.g.
+// /restapis/a1b2c3d4e5, which is used to tag it.
+func ARN(region, path string) string {
+	return fmt.Sprintf("arn:aws:apigateway:%s::%s", region, path)
+}
+
+// UpdateTags makes the tags of the resource with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, client Client, arn string, desired, observed map[string]string) error {
+	add, remove := awsclient.DiffTags(desired, observed)
+	if len(remove) > 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &apigateway.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     aws.StringSlice(remove),
+		}); err != nil {
+			return awsclient.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResourceWithContext(ctx, &apigateway.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        aws.StringMap(add),
+		}); err != nil {
+			return awsclient.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
+
+func metaTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	m := metav1.NewTime(*t)
+	return &m
+}
+
+// Patch operations address the fields of API Gateway resources using JSON
+// pointers, so slashes and tildes in their keys need to be escaped.
+var pathEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func patch(op, path string, value *string) *apigateway.PatchOperation {
+	return &apigateway.PatchOperation{Op: aws.String(op), Path: aws.String(path), Value: value}
+}
+
+// patchString returns the operations that replace the observed value of the
+// given path with the desired one. Fields that are not set are not patched.
+func patchString(path string, desired, observed *string) []*apigateway.PatchOperation {
+	if desired == nil || aws.StringValue(desired) == aws.StringValue(observed) {
+		return nil
+	}
+	return []*apigateway.PatchOperation{patch(apigateway.OpReplace, path, desired)}
+}
+
+func patchBool(path string, desired, observed *bool) []*apigateway.PatchOperation {
+	if desired == nil || aws.BoolValue(desired) == aws.BoolValue(observed) {
+		return nil
+	}
+	return []*apigateway.PatchOperation{patch(apigateway.OpReplace, path, aws.String(strconv.FormatBool(*desired)))}
+}
+
+func patchInt64(path string, desired, observed *int64) []*apigateway.PatchOperation {
+	if desired == nil || aws.Int64Value(desired) == aws.Int64Value(observed) {
+		return nil
+	}
+	return []*apigateway.PatchOperation{patch(apigateway.OpReplace, path, aws.String(strconv.FormatInt(*desired, 10)))}
+}
+
+// patchSet returns the operations that add the desired values missing from
+// the observed ones to the given path, and remove the other observed ones.
+func patchSet(path string, desired, observed []string) []*apigateway.PatchOperation {
+	want := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		want[v] = true
+	}
+	have := make(map[string]bool, len(observed))
+	var res []*apigateway.PatchOperation
+	for _, v := range observed {
+		have[v] = true
+		if !want[v] {
+			res = append(res, patch(apigateway.OpRemove, path, aws.String(v)))
+		}
+	}
+	for _, v := range desired {
+		if !have[v] {
+			res = append(res, patch(apigateway.OpAdd, path, aws.String(v)))
+		}
+	}
+	return res
+}
+
+// patchMap returns the operations that make the entries below the given path
+// match the desired ones. New entries are created using the given operation,
+// which is either add or replace depending on the field.
+func patchMap(path, createOp string, desired, observed map[string]string) []*apigateway.PatchOperation {
+	var res []*apigateway.PatchOperation
+	for _, k := range sortedKeys(observed) {
+		if _, ok := desired[k]; !ok {
+			res = append(res, patch(apigateway.OpRemove, path+"/"+pathEscaper.Replace(k), nil))
+		}
+	}
+	for _, k := range sortedKeys(desired) {
+		v, ok := observed[k]
+		switch {
+		case !ok:
+			res = append(res, patch(createOp, path+"/"+pathEscaper.Replace(k), aws.String(desired[k])))
+		case v != desired[k]:
+			res = append(res, patch(apigateway.OpReplace, path+"/"+pathEscaper.Replace(k), aws.String(desired[k])))
+		}
+	}
+	return res
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
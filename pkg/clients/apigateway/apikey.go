@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// APIKeyARN returns the ARN of the API key with the given ID.
+func APIKeyARN(region, id string) string {
+	return ARN(region, "/apikeys/"+id)
+}
+
+// GenerateCreateAPIKeyInput returns the input for CreateApiKey. API keys are
+// enabled unless they are disabled explicitly.
+func GenerateCreateAPIKeyInput(p v1alpha1.APIKeyParameters) *apigateway.CreateApiKeyInput {
+	input := &apigateway.CreateApiKeyInput{
+		Name:        aws.String(p.Name),
+		Description: p.Description,
+		Enabled:     aws.Bool(p.Enabled == nil || *p.Enabled),
+		CustomerId:  p.CustomerID,
+	}
+	if len(p.Tags) > 0 {
+		input.Tags = aws.StringMap(p.Tags)
+	}
+	return input
+}
+
+// LateInitializeAPIKey fills the empty fields of the given parameters with
+// the values of the given API key.
+func LateInitializeAPIKey(p *v1alpha1.APIKeyParameters, k *apigateway.ApiKey) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, k.Description)
+	p.Enabled = awsclient.LateInitializeBoolPtr(p.Enabled, k.Enabled)
+	p.CustomerID = awsclient.LateInitializeStringPtr(p.CustomerID, k.CustomerId)
+}
+
+// GenerateAPIKeyPatchOperations returns the operations that update the given
+// API key to match the given parameters.
+func GenerateAPIKeyPatchOperations(p v1alpha1.APIKeyParameters, k *apigateway.ApiKey) []*apigateway.PatchOperation {
+	ops := patchString("/name", aws.String(p.Name), k.Name)
+	ops = append(ops, patchString("/description", p.Description, k.Description)...)
+	ops = append(ops, patchBool("/enabled", p.Enabled, k.Enabled)...)
+	return append(ops, patchString("/customerId", p.CustomerID, k.CustomerId)...)
+}
+
+// GenerateAPIKeyObservation returns the observation of the given API key.
+func GenerateAPIKeyObservation(k *apigateway.ApiKey) v1alpha1.APIKeyObservation {
+	return v1alpha1.APIKeyObservation{
+		ID:          aws.StringValue(k.Id),
+		CreatedDate: metaTime(k.CreatedDate),
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// LateInitializeDeployment fills the empty fields of the given parameters
+// with the values of the given deployment.
+func LateInitializeDeployment(p *v1alpha1.DeploymentParameters, d *apigateway.Deployment) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, d.Description)
+}
+
+// GenerateDeploymentPatchOperations returns the operations that update the
+// given deployment to match the given parameters. Deploying another version
+// of the REST API requires a new deployment.
+func GenerateDeploymentPatchOperations(p v1alpha1.DeploymentParameters, d *apigateway.Deployment) []*apigateway.PatchOperation {
+	return patchString("/description", p.Description, d.Description)
+}
+
+// GenerateDeploymentObservation returns the observation of the given
+// deployment.
+func GenerateDeploymentObservation(d *apigateway.Deployment) v1alpha1.DeploymentObservation {
+	return v1alpha1.DeploymentObservation{
+		ID:          aws.StringValue(d.Id),
+		CreatedDate: metaTime(d.CreatedDate),
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateRestApiWithContext      func(ctx context.Context, input *apigateway.CreateRestApiInput, opts []request.Option) (*apigateway.RestApi, error)
+	MockImportRestApiWithContext      func(ctx context.Context, input *apigateway.ImportRestApiInput, opts []request.Option) (*apigateway.RestApi, error)
+	MockPutRestApiWithContext         func(ctx context.Context, input *apigateway.PutRestApiInput, opts []request.Option) (*apigateway.RestApi, error)
+	MockGetRestApiWithContext         func(ctx context.Context, input *apigateway.GetRestApiInput, opts []request.Option) (*apigateway.RestApi, error)
+	MockUpdateRestApiWithContext      func(ctx context.Context, input *apigateway.UpdateRestApiInput, opts []request.Option) (*apigateway.RestApi, error)
+	MockDeleteRestApiWithContext      func(ctx context.Context, input *apigateway.DeleteRestApiInput, opts []request.Option) (*apigateway.DeleteRestApiOutput, error)
+	MockGetResourceWithContext        func(ctx context.Context, input *apigateway.GetResourceInput, opts []request.Option) (*apigateway.Resource, error)
+	MockGetResourcesWithContext       func(ctx context.Context, input *apigateway.GetResourcesInput, opts []request.Option) (*apigateway.GetResourcesOutput, error)
+	MockCreateResourceWithContext     func(ctx context.Context, input *apigateway.CreateResourceInput, opts []request.Option) (*apigateway.Resource, error)
+	MockUpdateResourceWithContext     func(ctx context.Context, input *apigateway.UpdateResourceInput, opts []request.Option) (*apigateway.Resource, error)
+	MockDeleteResourceWithContext     func(ctx context.Context, input *apigateway.DeleteResourceInput, opts []request.Option) (*apigateway.DeleteResourceOutput, error)
+	MockGetMethodWithContext          func(ctx context.Context, input *apigateway.GetMethodInput, opts []request.Option) (*apigateway.Method, error)
+	MockPutMethodWithContext          func(ctx context.Context, input *apigateway.PutMethodInput, opts []request.Option) (*apigateway.Method, error)
+	MockUpdateMethodWithContext       func(ctx context.Context, input *apigateway.UpdateMethodInput, opts []request.Option) (*apigateway.Method, error)
+	MockDeleteMethodWithContext       func(ctx context.Context, input *apigateway.DeleteMethodInput, opts []request.Option) (*apigateway.DeleteMethodOutput, error)
+	MockPutIntegrationWithContext     func(ctx context.Context, input *apigateway.PutIntegrationInput, opts []request.Option) (*apigateway.Integration, error)
+	MockDeleteIntegrationWithContext  func(ctx context.Context, input *apigateway.DeleteIntegrationInput, opts []request.Option) (*apigateway.DeleteIntegrationOutput, error)
+	MockCreateDeploymentWithContext   func(ctx context.Context, input *apigateway.CreateDeploymentInput, opts []request.Option) (*apigateway.Deployment, error)
+	MockGetDeploymentWithContext      func(ctx context.Context, input *apigateway.GetDeploymentInput, opts []request.Option) (*apigateway.Deployment, error)
+	MockUpdateDeploymentWithContext   func(ctx context.Context, input *apigateway.UpdateDeploymentInput, opts []request.Option) (*apigateway.Deployment, error)
+	MockDeleteDeploymentWithContext   func(ctx context.Context, input *apigateway.DeleteDeploymentInput, opts []request.Option) (*apigateway.DeleteDeploymentOutput, error)
+	MockCreateStageWithContext        func(ctx context.Context, input *apigateway.CreateStageInput, opts []request.Option) (*apigateway.Stage, error)
+	MockGetStageWithContext           func(ctx context.Context, input *apigateway.GetStageInput, opts []request.Option) (*apigateway.Stage, error)
+	MockUpdateStageWithContext        func(ctx context.Context, input *apigateway.UpdateStageInput, opts []request.Option) (*apigateway.Stage, error)
+	MockDeleteStageWithContext        func(ctx context.Context, input *apigateway.DeleteStageInput, opts []request.Option) (*apigateway.DeleteStageOutput, error)
+	MockCreateUsagePlanWithContext    func(ctx context.Context, input *apigateway.CreateUsagePlanInput, opts []request.Option) (*apigateway.UsagePlan, error)
+	MockGetUsagePlanWithContext       func(ctx context.Context, input *apigateway.GetUsagePlanInput, opts []request.Option) (*apigateway.UsagePlan, error)
+	MockUpdateUsagePlanWithContext    func(ctx context.Context, input *apigateway.UpdateUsagePlanInput, opts []request.Option) (*apigateway.UsagePlan, error)
+	MockDeleteUsagePlanWithContext    func(ctx context.Context, input *apigateway.DeleteUsagePlanInput, opts []request.Option) (*apigateway.DeleteUsagePlanOutput, error)
+	MockGetUsagePlanKeysWithContext   func(ctx context.Context, input *apigateway.GetUsagePlanKeysInput, opts []request.Option) (*apigateway.GetUsagePlanKeysOutput, error)
+	MockCreateUsagePlanKeyWithContext func(ctx context.Context, input *apigateway.CreateUsagePlanKeyInput, opts []request.Option) (*apigateway.UsagePlanKey, error)
+	MockDeleteUsagePlanKeyWithContext func(ctx context.Context, input *apigateway.DeleteUsagePlanKeyInput, opts []request.Option) (*apigateway.DeleteUsagePlanKeyOutput, error)
+	MockCreateApiKeyWithContext       func(ctx context.Context, input *apigateway.CreateApiKeyInput, opts []request.Option) (*apigateway.ApiKey, error)
+	MockGetApiKeyWithContext          func(ctx context.Context, input *apigateway.GetApiKeyInput, opts []request.Option) (*apigateway.ApiKey, error)
+	MockUpdateApiKeyWithContext       func(ctx context.Context, input *apigateway.UpdateApiKeyInput, opts []request.Option) (*apigateway.ApiKey, error)
+	MockDeleteApiKeyWithContext       func(ctx context.Context, input *apigateway.DeleteApiKeyInput, opts []request.Option) (*apigateway.DeleteApiKeyOutput, error)
+	MockTagResourceWithContext        func(ctx context.Context, input *apigateway.TagResourceInput, opts []request.Option) (*apigateway.TagResourceOutput, error)
+	MockUntagResourceWithContext      func(ctx context.Context, input *apigateway.UntagResourceInput, opts []request.Option) (*apigateway.UntagResourceOutput, error)
+}
+
+// CreateRestApiWithContext mocks CreateRestApiWithContext method
+func (m *MockClient) CreateRestApiWithContext(ctx context.Context, input *apigateway.CreateRestApiInput, opts ...request.Option) (*apigateway.RestApi, error) {
+	return m.MockCreateRestApiWithContext(ctx, input, opts)
+}
+
+// ImportRestApiWithContext mocks ImportRestApiWithContext method
+func (m *MockClient) ImportRestApiWithContext(ctx context.Context, input *apigateway.ImportRestApiInput, opts ...request.Option) (*apigateway.RestApi, error) {
+	return m.MockImportRestApiWithContext(ctx, input, opts)
+}
+
+// PutRestApiWithContext mocks PutRestApiWithContext method
+func (m *MockClient) PutRestApiWithContext(ctx context.Context, input *apigateway.PutRestApiInput, opts ...request.Option) (*apigateway.RestApi, error) {
+	return m.MockPutRestApiWithContext(ctx, input, opts)
+}
+
+// GetRestApiWithContext mocks GetRestApiWithContext method
+func (m *MockClient) GetRestApiWithContext(ctx context.Context, input *apigateway.GetRestApiInput, opts ...request.Option) (*apigateway.RestApi, error) {
+	return m.MockGetRestApiWithContext(ctx, input, opts)
+}
+
+// UpdateRestApiWithContext mocks UpdateRestApiWithContext method
+func (m *MockClient) UpdateRestApiWithContext(ctx context.Context, input *apigateway.UpdateRestApiInput, opts ...request.Option) (*apigateway.RestApi, error) {
+	return m.MockUpdateRestApiWithContext(ctx, input, opts)
+}
+
+// DeleteRestApiWithContext mocks DeleteRestApiWithContext method
+func (m *MockClient) DeleteRestApiWithContext(ctx context.Context, input *apigateway.DeleteRestApiInput, opts ...request.Option) (*apigateway.DeleteRestApiOutput, error) {
+	return m.MockDeleteRestApiWithContext(ctx, input, opts)
+}
+
+// GetResourceWithContext mocks GetResourceWithContext method
+func (m *MockClient) GetResourceWithContext(ctx context.Context, input *apigateway.GetResourceInput, opts ...request.Option) (*apigateway.Resource, error) {
+	return m.MockGetResourceWithContext(ctx, input, opts)
+}
+
+// GetResourcesWithContext mocks GetResourcesWithContext method
+func (m *MockClient) GetResourcesWithContext(ctx context.Context, input *apigateway.GetResourcesInput, opts ...request.Option) (*apigateway.GetResourcesOutput, error) {
+	return m.MockGetResourcesWithContext(ctx, input, opts)
+}
+
+// CreateResourceWithContext mocks CreateResourceWithContext method
+func (m *MockClient) CreateResourceWithContext(ctx context.Context, input *apigateway.CreateResourceInput, opts ...request.Option) (*apigateway.Resource, error) {
+	return m.MockCreateResourceWithContext(ctx, input, opts)
+}
+
+// UpdateResourceWithContext mocks UpdateResourceWithContext method
+func (m *MockClient) UpdateResourceWithContext(ctx context.Context, input *apigateway.UpdateResourceInput, opts ...request.Option) (*apigateway.Resource, error) {
+	return m.MockUpdateResourceWithContext(ctx, input, opts)
+}
+
+// DeleteResourceWithContext mocks DeleteResourceWithContext method
+func (m *MockClient) DeleteResourceWithContext(ctx context.Context, input *apigateway.DeleteResourceInput, opts ...request.Option) (*apigateway.DeleteResourceOutput, error) {
+	return m.MockDeleteResourceWithContext(ctx, input, opts)
+}
+
+// GetMethodWithContext mocks GetMethodWithContext method
+func (m *MockClient) GetMethodWithContext(ctx context.Context, input *apigateway.GetMethodInput, opts ...request.Option) (*apigateway.Method, error) {
+	return m.MockGetMethodWithContext(ctx, input, opts)
+}
+
+// PutMethodWithContext mocks PutMethodWithContext method
+func (m *MockClient) PutMethodWithContext(ctx context.Context, input *apigateway.PutMethodInput, opts ...request.Option) (*apigateway.Method, error) {
+	return m.MockPutMethodWithContext(ctx, input, opts)
+}
+
+// UpdateMethodWithContext mocks UpdateMethodWithContext method
+func (m *MockClient) UpdateMethodWithContext(ctx context.Context, input *apigateway.UpdateMethodInput, opts ...request.Option) (*apigateway.Method, error) {
+	return m.MockUpdateMethodWithContext(ctx, input, opts)
+}
+
+// DeleteMethodWithContext mocks DeleteMethodWithContext method
+func (m *MockClient) DeleteMethodWithContext(ctx context.Context, input *apigateway.DeleteMethodInput, opts ...request.Option) (*apigateway.DeleteMethodOutput, error) {
+	return m.MockDeleteMethodWithContext(ctx, input, opts)
+}
+
+// PutIntegrationWithContext mocks PutIntegrationWithContext method
+func (m *MockClient) PutIntegrationWithContext(ctx context.Context, input *apigateway.PutIntegrationInput, opts ...request.Option) (*apigateway.Integration, error) {
+	return m.MockPutIntegrationWithContext(ctx, input, opts)
+}
+
+// DeleteIntegrationWithContext mocks DeleteIntegrationWithContext method
+func (m *MockClient) DeleteIntegrationWithContext(ctx context.Context, input *apigateway.DeleteIntegrationInput, opts ...request.Option) (*apigateway.DeleteIntegrationOutput, error) {
+	return m.MockDeleteIntegrationWithContext(ctx, input, opts)
+}
+
+// CreateDeploymentWithContext mocks CreateDeploymentWithContext method
+func (m *MockClient) CreateDeploymentWithContext(ctx context.Context, input *apigateway.CreateDeploymentInput, opts ...request.Option) (*apigateway.Deployment, error) {
+	return m.MockCreateDeploymentWithContext(ctx, input, opts)
+}
+
+// GetDeploymentWithContext mocks GetDeploymentWithContext method
+func (m *MockClient) GetDeploymentWithContext(ctx context.Context, input *apigateway.GetDeploymentInput, opts ...request.Option) (*apigateway.Deployment, error) {
+	return m.MockGetDeploymentWithContext(ctx, input, opts)
+}
+
+// UpdateDeploymentWithContext mocks UpdateDeploymentWithContext method
+func (m *MockClient) UpdateDeploymentWithContext(ctx context.Context, input *apigateway.UpdateDeploymentInput, opts ...request.Option) (*apigateway.Deployment, error) {
+	return m.MockUpdateDeploymentWithContext(ctx, input, opts)
+}
+
+// DeleteDeploymentWithContext mocks DeleteDeploymentWithContext method
+func (m *MockClient) DeleteDeploymentWithContext(ctx context.Context, input *apigateway.DeleteDeploymentInput, opts ...request.Option) (*apigateway.DeleteDeploymentOutput, error) {
+	return m.MockDeleteDeploymentWithContext(ctx, input, opts)
+}
+
+// CreateStageWithContext mocks CreateStageWithContext method
+func (m *MockClient) CreateStageWithContext(ctx context.Context, input *apigateway.CreateStageInput, opts ...request.Option) (*apigateway.Stage, error) {
+	return m.MockCreateStageWithContext(ctx, input, opts)
+}
+
+// GetStageWithContext mocks GetStageWithContext method
+func (m *MockClient) GetStageWithContext(ctx context.Context, input *apigateway.GetStageInput, opts ...request.Option) (*apigateway.Stage, error) {
+	return m.MockGetStageWithContext(ctx, input, opts)
+}
+
+// UpdateStageWithContext mocks UpdateStageWithContext method
+func (m *MockClient) UpdateStageWithContext(ctx context.Context, input *apigateway.UpdateStageInput, opts ...request.Option) (*apigateway.Stage, error) {
+	return m.MockUpdateStageWithContext(ctx, input, opts)
+}
+
+// DeleteStageWithContext mocks DeleteStageWithContext method
+func (m *MockClient) DeleteStageWithContext(ctx context.Context, input *apigateway.DeleteStageInput, opts ...request.Option) (*apigateway.DeleteStageOutput, error) {
+	return m.MockDeleteStageWithContext(ctx, input, opts)
+}
+
+// CreateUsagePlanWithContext mocks CreateUsagePlanWithContext method
+func (m *MockClient) CreateUsagePlanWithContext(ctx context.Context, input *apigateway.CreateUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error) {
+	return m.MockCreateUsagePlanWithContext(ctx, input, opts)
+}
+
+// GetUsagePlanWithContext mocks GetUsagePlanWithContext method
+func (m *MockClient) GetUsagePlanWithContext(ctx context.Context, input *apigateway.GetUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error) {
+	return m.MockGetUsagePlanWithContext(ctx, input, opts)
+}
+
+// UpdateUsagePlanWithContext mocks UpdateUsagePlanWithContext method
+func (m *MockClient) UpdateUsagePlanWithContext(ctx context.Context, input *apigateway.UpdateUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error) {
+	return m.MockUpdateUsagePlanWithContext(ctx, input, opts)
+}
+
+// DeleteUsagePlanWithContext mocks DeleteUsagePlanWithContext method
+func (m *MockClient) DeleteUsagePlanWithContext(ctx context.Context, input *apigateway.DeleteUsagePlanInput, opts ...request.Option) (*apigateway.DeleteUsagePlanOutput, error) {
+	return m.MockDeleteUsagePlanWithContext(ctx, input, opts)
+}
+
+// GetUsagePlanKeysWithContext mocks GetUsagePlanKeysWithContext method
+func (m *MockClient) GetUsagePlanKeysWithContext(ctx context.Context, input *apigateway.GetUsagePlanKeysInput, opts ...request.Option) (*apigateway.GetUsagePlanKeysOutput, error) {
+	return m.MockGetUsagePlanKeysWithContext(ctx, input, opts)
+}
+
+// CreateUsagePlanKeyWithContext mocks CreateUsagePlanKeyWithContext method
+func (m *MockClient) CreateUsagePlanKeyWithContext(ctx context.Context, input *apigateway.CreateUsagePlanKeyInput, opts ...request.Option) (*apigateway.UsagePlanKey, error) {
+	return m.MockCreateUsagePlanKeyWithContext(ctx, input, opts)
+}
+
+// DeleteUsagePlanKeyWithContext mocks DeleteUsagePlanKeyWithContext method
+func (m *MockClient) DeleteUsagePlanKeyWithContext(ctx context.Context, input *apigateway.DeleteUsagePlanKeyInput, opts ...request.Option) (*apigateway.DeleteUsagePlanKeyOutput, error) {
+	return m.MockDeleteUsagePlanKeyWithContext(ctx, input, opts)
+}
+
+// CreateApiKeyWithContext mocks CreateApiKeyWithContext method
+func (m *MockClient) CreateApiKeyWithContext(ctx context.Context, input *apigateway.CreateApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error) {
+	return m.MockCreateApiKeyWithContext(ctx, input, opts)
+}
+
+// GetApiKeyWithContext mocks GetApiKeyWithContext method
+func (m *MockClient) GetApiKeyWithContext(ctx context.Context, input *apigateway.GetApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error) {
+	return m.MockGetApiKeyWithContext(ctx, input, opts)
+}
+
+// UpdateApiKeyWithContext mocks UpdateApiKeyWithContext method
+func (m *MockClient) UpdateApiKeyWithContext(ctx context.Context, input *apigateway.UpdateApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error) {
+	return m.MockUpdateApiKeyWithContext(ctx, input, opts)
+}
+
+// DeleteApiKeyWithContext mocks DeleteApiKeyWithContext method
+func (m *MockClient) DeleteApiKeyWithContext(ctx context.Context, input *apigateway.DeleteApiKeyInput, opts ...request.Option) (*apigateway.DeleteApiKeyOutput, error) {
+	return m.MockDeleteApiKeyWithContext(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *apigateway.TagResourceInput, opts ...request.Option) (*apigateway.TagResourceOutput, error) {
+	return m.MockTagResourceWithContext(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *apigateway.UntagResourceInput, opts ...request.Option) (*apigateway.UntagResourceOutput, error) {
+	return m.MockUntagResourceWithContext(ctx, input, opts)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GeneratePutMethodInput returns the input for PutMethod.
+func GeneratePutMethodInput(p v1alpha1.MethodParameters) *apigateway.PutMethodInput {
+	input := &apigateway.PutMethodInput{
+		RestApiId:          p.RestAPIID,
+		ResourceId:         p.ResourceID,
+		HttpMethod:         aws.String(p.HTTPMethod),
+		AuthorizationType:  aws.String(p.AuthorizationType),
+		AuthorizerId:       p.AuthorizerID,
+		ApiKeyRequired:     p.APIKeyRequired,
+		OperationName:      p.OperationName,
+		RequestValidatorId: p.RequestValidatorID,
+	}
+	if len(p.AuthorizationScopes) > 0 {
+		input.AuthorizationScopes = aws.StringSlice(p.AuthorizationScopes)
+	}
+	if len(p.RequestParameters) > 0 {
+		input.RequestParameters = aws.BoolMap(p.RequestParameters)
+	}
+	return input
+}
+
+// LateInitializeMethod fills the empty fields of the given parameters with
+// the values of the given method.
+func LateInitializeMethod(p *v1alpha1.MethodParameters, m *apigateway.Method) {
+	p.APIKeyRequired = awsclient.LateInitializeBoolPtr(p.APIKeyRequired, m.ApiKeyRequired)
+}
+
+func formatBoolMap(m map[string]bool) map[string]string {
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = strconv.FormatBool(v)
+	}
+	return res
+}
+
+// GenerateMethodPatchOperations returns the operations that update the given
+// method to match the given parameters. The integration of the method is
+// replaced rather than patched.
+func GenerateMethodPatchOperations(p v1alpha1.MethodParameters, m *apigateway.Method) []*apigateway.PatchOperation {
+	ops := patchString("/authorizationType", aws.String(p.AuthorizationType), m.AuthorizationType)
+	ops = append(ops, patchString("/authorizerId", p.AuthorizerID, m.AuthorizerId)...)
+	ops = append(ops, patchBool("/apiKeyRequired", p.APIKeyRequired, m.ApiKeyRequired)...)
+	ops = append(ops, patchString("/operationName", p.OperationName, m.OperationName)...)
+	ops = append(ops, patchString("/requestValidatorId", p.RequestValidatorID, m.RequestValidatorId)...)
+	ops = append(ops, patchSet("/authorizationScopes", p.AuthorizationScopes, aws.StringValueSlice(m.AuthorizationScopes))...)
+	return append(ops, patchMap("/requestParameters", apigateway.OpAdd, formatBoolMap(p.RequestParameters), formatBoolMap(aws.BoolValueMap(m.RequestParameters)))...)
+}
+
+// GeneratePutIntegrationInput returns the input for PutIntegration.
+func GeneratePutIntegrationInput(p v1alpha1.MethodParameters) *apigateway.PutIntegrationInput {
+	in := p.Integration
+	input := &apigateway.PutIntegrationInput{
+		RestApiId:             p.RestAPIID,
+		ResourceId:            p.ResourceID,
+		HttpMethod:            aws.String(p.HTTPMethod),
+		Type:                  aws.String(in.Type),
+		IntegrationHttpMethod: in.IntegrationHTTPMethod,
+		Uri:                   in.URI,
+		ConnectionType:        in.ConnectionType,
+		ConnectionId:          in.ConnectionID,
+		Credentials:           in.Credentials,
+		PassthroughBehavior:   in.PassthroughBehavior,
+		TimeoutInMillis:       in.TimeoutInMillis,
+	}
+	if len(in.RequestParameters) > 0 {
+		input.RequestParameters = aws.StringMap(in.RequestParameters)
+	}
+	if len(in.RequestTemplates) > 0 {
+		input.RequestTemplates = aws.StringMap(in.RequestTemplates)
+	}
+	return input
+}
+
+// IsIntegrationUpToDate returns true if the given integration matches the
+// desired one. Optional fields API Gateway defaults are only compared if they
+// are set.
+func IsIntegrationUpToDate(in *v1alpha1.MethodIntegration, observed *apigateway.Integration) bool {
+	if in == nil || observed == nil {
+		return in == nil && observed == nil
+	}
+	optional := func(desired, observed *string) bool {
+		return desired == nil || aws.StringValue(desired) == aws.StringValue(observed)
+	}
+	return in.Type == aws.StringValue(observed.Type) &&
+		aws.StringValue(in.URI) == aws.StringValue(observed.Uri) &&
+		optional(in.IntegrationHTTPMethod, observed.HttpMethod) &&
+		optional(in.ConnectionType, observed.ConnectionType) &&
+		optional(in.ConnectionID, observed.ConnectionId) &&
+		optional(in.Credentials, observed.Credentials) &&
+		optional(in.PassthroughBehavior, observed.PassthroughBehavior) &&
+		(in.TimeoutInMillis == nil || aws.Int64Value(in.TimeoutInMillis) == aws.Int64Value(observed.TimeoutInMillis)) &&
+		cmp.Equal(in.RequestParameters, aws.StringValueMap(observed.RequestParameters), cmpopts.EquateEmpty()) &&
+		cmp.Equal(in.RequestTemplates, aws.StringValueMap(observed.RequestTemplates), cmpopts.EquateEmpty())
+}
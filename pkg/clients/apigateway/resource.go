@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const errNoRootResource = "the REST API has no root resource"
+
+// RootResourceID returns the ID of the root resource, i.e. the resource with
+// the path /, of the given REST API.
+func RootResourceID(ctx context.Context, client Client, restAPIID string) (string, error) {
+	input := &apigateway.GetResourcesInput{RestApiId: aws.String(restAPIID)}
+	for {
+		resp, err := client.GetResourcesWithContext(ctx, input)
+		if err != nil {
+			return "", err
+		}
+		for _, r := range resp.Items {
+			if aws.StringValue(r.Path) == "/" {
+				return aws.StringValue(r.Id), nil
+			}
+		}
+		if aws.StringValue(resp.Position) == "" {
+			return "", errors.New(errNoRootResource)
+		}
+		input.Position = resp.Position
+	}
+}
+
+// LateInitializeResource fills the empty fields of the given parameters with
+// the values of the given resource.
+func LateInitializeResource(p *v1alpha1.ResourceParameters, r *apigateway.Resource) {
+	p.ParentID = awsclient.LateInitializeStringPtr(p.ParentID, r.ParentId)
+}
+
+// GenerateResourcePatchOperations returns the operations that update the
+// given resource to match the given parameters.
+func GenerateResourcePatchOperations(p v1alpha1.ResourceParameters, r *apigateway.Resource) []*apigateway.PatchOperation {
+	ops := patchString("/pathPart", aws.String(p.PathPart), r.PathPart)
+	return append(ops, patchString("/parentId", p.ParentID, r.ParentId)...)
+}
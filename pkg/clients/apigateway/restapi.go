@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetDefinitionConfigMap = "cannot get the ConfigMap that holds the OpenAPI document"
+
+	paramEndpointConfigurationTypes = "endpointConfigurationTypes"
+)
+
+// RestAPIARN returns the ARN of the REST API with the given ID.
+func RestAPIARN(region, id string) string {
+	return ARN(region, "/restapis/"+id)
+}
+
+// GetDefinition returns the OpenAPI document of the given REST API, which is
+// empty if the REST API is not defined by one.
+func GetDefinition(ctx context.Context, kube client.Reader, p v1alpha1.RestAPIParameters) ([]byte, error) {
+	d := p.Definition
+	if d == nil {
+		return nil, nil
+	}
+	if ref := d.BodyConfigMapRef; ref != nil {
+		data, err := awsclient.GetConfigMapData(ctx, kube, *ref)
+		return data, errors.Wrap(err, errGetDefinitionConfigMap)
+	}
+	return []byte(aws.StringValue(d.Body)), nil
+}
+
+// DefinitionChecksum returns the hex-encoded SHA-256 checksum of the given
+// OpenAPI document, or an empty string if there is no document.
+func DefinitionChecksum(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	h := sha256.Sum256(body)
+	return hex.EncodeToString(h[:])
+}
+
+func generateEndpointConfiguration(c *v1alpha1.EndpointConfiguration) *apigateway.EndpointConfiguration {
+	if c == nil {
+		return nil
+	}
+	res := &apigateway.EndpointConfiguration{Types: aws.StringSlice([]string{c.Type})}
+	if len(c.VPCEndpointIDs) > 0 {
+		res.VpcEndpointIds = aws.StringSlice(c.VPCEndpointIDs)
+	}
+	return res
+}
+
+// GenerateCreateRestAPIInput returns the input for CreateRestApi.
+func GenerateCreateRestAPIInput(p v1alpha1.RestAPIParameters) *apigateway.CreateRestApiInput {
+	input := &apigateway.CreateRestApiInput{
+		Name:                      aws.String(p.Name),
+		Description:               p.Description,
+		ApiKeySource:              p.APIKeySource,
+		MinimumCompressionSize:    p.MinimumCompressionSize,
+		DisableExecuteApiEndpoint: p.DisableExecuteAPIEndpoint,
+		EndpointConfiguration:     generateEndpointConfiguration(p.EndpointConfiguration),
+		Policy:                    p.Policy,
+	}
+	if len(p.BinaryMediaTypes) > 0 {
+		input.BinaryMediaTypes = aws.StringSlice(p.BinaryMediaTypes)
+	}
+	if len(p.Tags) > 0 {
+		input.Tags = aws.StringMap(p.Tags)
+	}
+	return input
+}
+
+func generateImportParameters(p v1alpha1.RestAPIParameters) map[string]*string {
+	if p.EndpointConfiguration == nil {
+		return nil
+	}
+	return map[string]*string{paramEndpointConfigurationTypes: aws.String(p.EndpointConfiguration.Type)}
+}
+
+// GenerateImportRestAPIInput returns the input for ImportRestApi, which
+// creates a REST API from the given OpenAPI document.
+func GenerateImportRestAPIInput(p v1alpha1.RestAPIParameters, body []byte) *apigateway.ImportRestApiInput {
+	return &apigateway.ImportRestApiInput{
+		Body:           body,
+		FailOnWarnings: p.Definition.FailOnWarnings,
+		Parameters:     generateImportParameters(p),
+	}
+}
+
+// GeneratePutRestAPIInput returns the input for PutRestApi, which imports the
+// given OpenAPI document into an existing REST API.
+func GeneratePutRestAPIInput(id string, p v1alpha1.RestAPIParameters, body []byte) *apigateway.PutRestApiInput {
+	mode := p.Definition.Mode
+	if mode == "" {
+		mode = v1alpha1.DefinitionModeOverwrite
+	}
+	return &apigateway.PutRestApiInput{
+		RestApiId:      aws.String(id),
+		Body:           body,
+		Mode:           aws.String(mode),
+		FailOnWarnings: p.Definition.FailOnWarnings,
+		Parameters:     generateImportParameters(p),
+	}
+}
+
+// policyDocument returns the policy of a REST API as a JSON document. API
+// Gateway returns it escaped, like a JSON string.
+func policyDocument(policy *string) *string {
+	if policy == nil {
+		return nil
+	}
+	var res string
+	if err := json.Unmarshal([]byte(`"`+aws.StringValue(policy)+`"`), &res); err != nil {
+		return policy
+	}
+	return &res
+}
+
+// LateInitializeRestAPI fills the empty fields of the given parameters with
+// the values of the given REST API.
+func LateInitializeRestAPI(p *v1alpha1.RestAPIParameters, api *apigateway.RestApi) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, api.Description)
+	p.APIKeySource = awsclient.LateInitializeStringPtr(p.APIKeySource, api.ApiKeySource)
+	p.DisableExecuteAPIEndpoint = awsclient.LateInitializeBoolPtr(p.DisableExecuteAPIEndpoint, api.DisableExecuteApiEndpoint)
+	p.Policy = awsclient.LateInitializeStringPtr(p.Policy, policyDocument(api.Policy))
+	if p.BinaryMediaTypes == nil && len(api.BinaryMediaTypes) > 0 {
+		p.BinaryMediaTypes = aws.StringValueSlice(api.BinaryMediaTypes)
+	}
+	if p.EndpointConfiguration == nil && api.EndpointConfiguration != nil && len(api.EndpointConfiguration.Types) > 0 {
+		p.EndpointConfiguration = &v1alpha1.EndpointConfiguration{
+			Type: aws.StringValue(api.EndpointConfiguration.Types[0]),
+		}
+		if len(api.EndpointConfiguration.VpcEndpointIds) > 0 {
+			p.EndpointConfiguration.VPCEndpointIDs = aws.StringValueSlice(api.EndpointConfiguration.VpcEndpointIds)
+		}
+	}
+}
+
+// GenerateRestAPIObservation returns the observation of the given REST API.
+func GenerateRestAPIObservation(api *apigateway.RestApi) v1alpha1.RestAPIObservation {
+	o := v1alpha1.RestAPIObservation{
+		ID:          aws.StringValue(api.Id),
+		CreatedDate: metaTime(api.CreatedDate),
+	}
+	if len(api.Warnings) > 0 {
+		o.Warnings = aws.StringValueSlice(api.Warnings)
+	}
+	return o
+}
+
+// GenerateRestAPIPatchOperations returns the operations that update the given
+// REST API to match the given parameters.
+func GenerateRestAPIPatchOperations(p v1alpha1.RestAPIParameters, api *apigateway.RestApi) []*apigateway.PatchOperation {
+	ops := patchString("/name", aws.String(p.Name), api.Name)
+	ops = append(ops, patchString("/description", p.Description, api.Description)...)
+	ops = append(ops, patchString("/apiKeySource", p.APIKeySource, api.ApiKeySource)...)
+	ops = append(ops, patchBool("/disableExecuteApiEndpoint", p.DisableExecuteAPIEndpoint, api.DisableExecuteApiEndpoint)...)
+
+	// Compression is disabled by removing the minimum size.
+	switch {
+	case p.MinimumCompressionSize != nil:
+		ops = append(ops, patchInt64("/minimumCompressionSize", p.MinimumCompressionSize, api.MinimumCompressionSize)...)
+	case api.MinimumCompressionSize != nil:
+		ops = append(ops, patch(apigateway.OpReplace, "/minimumCompressionSize", aws.String("")))
+	}
+
+	if p.BinaryMediaTypes != nil {
+		observed := aws.StringValueSlice(api.BinaryMediaTypes)
+		for _, op := range patchSet("/binaryMediaTypes", p.BinaryMediaTypes, observed) {
+			// Media types are part of the path rather than the value.
+			op.Path = aws.String(aws.StringValue(op.Path) + "/" + pathEscaper.Replace(aws.StringValue(op.Value)))
+			op.Value = nil
+			ops = append(ops, op)
+		}
+	}
+
+	if c := p.EndpointConfiguration; c != nil {
+		observed := &apigateway.EndpointConfiguration{}
+		if api.EndpointConfiguration != nil {
+			observed = api.EndpointConfiguration
+		}
+		if len(observed.Types) > 0 && aws.StringValue(observed.Types[0]) != c.Type {
+			ops = append(ops, patch(apigateway.OpReplace, "/endpointConfiguration/types/"+aws.StringValue(observed.Types[0]), aws.String(c.Type)))
+		}
+		ops = append(ops, patchSet("/endpointConfiguration/vpcEndpointIds", c.VPCEndpointIDs, aws.StringValueSlice(observed.VpcEndpointIds))...)
+	}
+
+	if p.Policy != nil && !awsclient.IsPolicyUpToDate(p.Policy, policyDocument(api.Policy)) {
+		ops = append(ops, patch(apigateway.OpReplace, "/policy", p.Policy))
+	}
+	return ops
+}
+
+// IsRestAPIUpToDate returns true if the given REST API matches the given
+// parameters.
+func IsRestAPIUpToDate(p v1alpha1.RestAPIParameters, api *apigateway.RestApi) bool {
+	return len(GenerateRestAPIPatchOperations(p, api)) == 0
+}
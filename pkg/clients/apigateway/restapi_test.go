@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+)
+
+func restAPIParameters(m ...func(*v1alpha1.RestAPIParameters)) v1alpha1.RestAPIParameters {
+	p := v1alpha1.RestAPIParameters{
+		Name:             "pets",
+		BinaryMediaTypes: []string{"image/png"},
+		EndpointConfiguration: &v1alpha1.EndpointConfiguration{
+			Type: apigateway.EndpointTypeRegional,
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedRestAPI() *apigateway.RestApi {
+	return &apigateway.RestApi{
+		Name:                      aws.String("pets"),
+		ApiKeySource:              aws.String(apigateway.ApiKeySourceTypeHeader),
+		DisableExecuteApiEndpoint: aws.Bool(false),
+		BinaryMediaTypes:          aws.StringSlice([]string{"image/png"}),
+		EndpointConfiguration: &apigateway.EndpointConfiguration{
+			Types: aws.StringSlice([]string{apigateway.EndpointTypeRegional}),
+		},
+		MinimumCompressionSize: aws.Int64(1024),
+		Policy:                 aws.String(`{\"Version\":\"2012-10-17\",\"Statement\":[]}`),
+	}
+}
+
+func TestGenerateRestAPIPatchOperations(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RestAPIParameters
+		want []*apigateway.PatchOperation
+	}{
+		"UpToDateAfterLateInitialization": {
+			p: restAPIParameters(func(p *v1alpha1.RestAPIParameters) {
+				p.MinimumCompressionSize = aws.Int64(1024)
+			}),
+		},
+		"CompressionDisabled": {
+			p: restAPIParameters(),
+			want: []*apigateway.PatchOperation{
+				patch(apigateway.OpReplace, "/minimumCompressionSize", aws.String("")),
+			},
+		},
+		"BinaryMediaTypeChanged": {
+			p: restAPIParameters(func(p *v1alpha1.RestAPIParameters) {
+				p.MinimumCompressionSize = aws.Int64(1024)
+				p.BinaryMediaTypes = []string{"*/*"}
+			}),
+			want: []*apigateway.PatchOperation{
+				patch(apigateway.OpRemove, "/binaryMediaTypes/image~1png", nil),
+				patch(apigateway.OpAdd, "/binaryMediaTypes/*~1*", nil),
+			},
+		},
+		"EndpointTypeChanged": {
+			p: restAPIParameters(func(p *v1alpha1.RestAPIParameters) {
+				p.MinimumCompressionSize = aws.Int64(1024)
+				p.EndpointConfiguration.Type = apigateway.EndpointTypeEdge
+			}),
+			want: []*apigateway.PatchOperation{
+				patch(apigateway.OpReplace, "/endpointConfiguration/types/REGIONAL", aws.String(apigateway.EndpointTypeEdge)),
+			},
+		},
+		"PolicyChanged": {
+			p: restAPIParameters(func(p *v1alpha1.RestAPIParameters) {
+				p.MinimumCompressionSize = aws.Int64(1024)
+				p.Policy = aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"execute-api:Invoke","Resource":"*"}]}`)
+			}),
+			want: []*apigateway.PatchOperation{
+				patch(apigateway.OpReplace, "/policy", aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"execute-api:Invoke","Resource":"*"}]}`)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := observedRestAPI()
+			LateInitializeRestAPI(&tc.p, observed)
+			got := GenerateRestAPIPatchOperations(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDefinitionChecksum(t *testing.T) {
+	cases := map[string]struct {
+		body []byte
+		want string
+	}{
+		"NoDocument": {
+			want: "",
+		},
+		"Document": {
+			body: []byte("openapi: 3.0.1\n"),
+			want: "81ae8a79e80245ea3eff12f8e6a6a285a4904b11358e72b866108e03b153e9ab",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DefinitionChecksum(tc.body)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
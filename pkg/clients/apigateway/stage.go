@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// StageARN returns the ARN of the stage with the given name of the REST API
+// with the given ID.
+func StageARN(region, restAPIID, name string) string {
+	return ARN(region, "/restapis/"+restAPIID+"/stages/"+name)
+}
+
+// InvokeURL returns the URL the stage with the given name of the REST API
+// with the given ID is invoked at.
+func InvokeURL(region, restAPIID, name string) string {
+	return fmt.Sprintf("https://%s.execute-api.%s.amazonaws.com/%s", restAPIID, region, name)
+}
+
+// GenerateCreateStageInput returns the input for CreateStage.
+func GenerateCreateStageInput(name string, p v1alpha1.StageParameters) *apigateway.CreateStageInput {
+	input := &apigateway.CreateStageInput{
+		RestApiId:           p.RestAPIID,
+		StageName:           aws.String(name),
+		DeploymentId:        p.DeploymentID,
+		Description:         p.Description,
+		CacheClusterEnabled: p.CacheClusterEnabled,
+		CacheClusterSize:    p.CacheClusterSize,
+		TracingEnabled:      p.TracingEnabled,
+	}
+	if len(p.Variables) > 0 {
+		input.Variables = aws.StringMap(p.Variables)
+	}
+	if len(p.Tags) > 0 {
+		input.Tags = aws.StringMap(p.Tags)
+	}
+	return input
+}
+
+// LateInitializeStage fills the empty fields of the given parameters with the
+// values of the given stage.
+func LateInitializeStage(p *v1alpha1.StageParameters, s *apigateway.Stage) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, s.Description)
+	p.CacheClusterEnabled = awsclient.LateInitializeBoolPtr(p.CacheClusterEnabled, s.CacheClusterEnabled)
+	p.CacheClusterSize = awsclient.LateInitializeStringPtr(p.CacheClusterSize, s.CacheClusterSize)
+	p.TracingEnabled = awsclient.LateInitializeBoolPtr(p.TracingEnabled, s.TracingEnabled)
+}
+
+// GenerateStageObservation returns the observation of the given stage of the
+// REST API with the given ID.
+func GenerateStageObservation(region, restAPIID string, s *apigateway.Stage) v1alpha1.StageObservation {
+	return v1alpha1.StageObservation{
+		InvokeURL:          InvokeURL(region, restAPIID, aws.StringValue(s.StageName)),
+		CacheClusterStatus: aws.StringValue(s.CacheClusterStatus),
+		CreatedDate:        metaTime(s.CreatedDate),
+		LastUpdatedDate:    metaTime(s.LastUpdatedDate),
+	}
+}
+
+// GenerateStagePatchOperations returns the operations that update the given
+// stage to match the given parameters.
+func GenerateStagePatchOperations(p v1alpha1.StageParameters, s *apigateway.Stage) []*apigateway.PatchOperation {
+	ops := patchString("/deploymentId", p.DeploymentID, s.DeploymentId)
+	ops = append(ops, patchString("/description", p.Description, s.Description)...)
+	ops = append(ops, patchBool("/cacheClusterEnabled", p.CacheClusterEnabled, s.CacheClusterEnabled)...)
+	ops = append(ops, patchString("/cacheClusterSize", p.CacheClusterSize, s.CacheClusterSize)...)
+	ops = append(ops, patchBool("/tracingEnabled", p.TracingEnabled, s.TracingEnabled)...)
+	return append(ops, patchMap("/variables", apigateway.OpReplace, p.Variables, aws.StringValueMap(s.Variables))...)
+}
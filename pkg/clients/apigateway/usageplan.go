@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// UsagePlanKeyTypeAPIKey is the type of usage plan keys that are API keys,
+// which is the only one there is.
+const UsagePlanKeyTypeAPIKey = "API_KEY"
+
+// UsagePlanARN returns the ARN of the usage plan with the given ID.
+func UsagePlanARN(region, id string) string {
+	return ARN(region, "/usageplans/"+id)
+}
+
+// apiStages returns the stages of the given usage plan in the form
+// {restApiId}:{stage} used to patch them.
+func apiStages(stages []v1alpha1.UsagePlanAPIStage) []string {
+	res := make([]string, len(stages))
+	for i, s := range stages {
+		res[i] = aws.StringValue(s.RestAPIID) + ":" + aws.StringValue(s.Stage)
+	}
+	return res
+}
+
+func observedAPIStages(stages []*apigateway.ApiStage) []string {
+	res := make([]string, len(stages))
+	for i, s := range stages {
+		res[i] = aws.StringValue(s.ApiId) + ":" + aws.StringValue(s.Stage)
+	}
+	return res
+}
+
+func generateThrottleSettings(t *v1alpha1.ThrottleSettings) *apigateway.ThrottleSettings {
+	if t == nil {
+		return nil
+	}
+	return &apigateway.ThrottleSettings{BurstLimit: t.BurstLimit, RateLimit: t.RateLimit}
+}
+
+func generateQuotaSettings(q *v1alpha1.QuotaSettings) *apigateway.QuotaSettings {
+	if q == nil {
+		return nil
+	}
+	return &apigateway.QuotaSettings{Limit: aws.Int64(q.Limit), Offset: q.Offset, Period: aws.String(q.Period)}
+}
+
+// GenerateCreateUsagePlanInput returns the input for CreateUsagePlan.
+func GenerateCreateUsagePlanInput(p v1alpha1.UsagePlanParameters) *apigateway.CreateUsagePlanInput {
+	input := &apigateway.CreateUsagePlanInput{
+		Name:        aws.String(p.Name),
+		Description: p.Description,
+		Throttle:    generateThrottleSettings(p.Throttle),
+		Quota:       generateQuotaSettings(p.Quota),
+	}
+	for _, s := range p.APIStages {
+		input.ApiStages = append(input.ApiStages, &apigateway.ApiStage{ApiId: s.RestAPIID, Stage: s.Stage})
+	}
+	if len(p.Tags) > 0 {
+		input.Tags = aws.StringMap(p.Tags)
+	}
+	return input
+}
+
+// LateInitializeUsagePlan fills the empty fields of the given parameters with
+// the values of the given usage plan.
+func LateInitializeUsagePlan(p *v1alpha1.UsagePlanParameters, u *apigateway.UsagePlan) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, u.Description)
+	if p.Throttle == nil && u.Throttle != nil {
+		p.Throttle = &v1alpha1.ThrottleSettings{BurstLimit: u.Throttle.BurstLimit, RateLimit: u.Throttle.RateLimit}
+	}
+	if p.Quota == nil && u.Quota != nil {
+		p.Quota = &v1alpha1.QuotaSettings{
+			Limit:  aws.Int64Value(u.Quota.Limit),
+			Offset: u.Quota.Offset,
+			Period: aws.StringValue(u.Quota.Period),
+		}
+	}
+}
+
+// GenerateUsagePlanPatchOperations returns the operations that update the
+// given usage plan to match the given parameters. The API keys of the usage
+// plan are not part of it.
+func GenerateUsagePlanPatchOperations(p v1alpha1.UsagePlanParameters, u *apigateway.UsagePlan) []*apigateway.PatchOperation {
+	ops := patchString("/name", aws.String(p.Name), u.Name)
+	ops = append(ops, patchString("/description", p.Description, u.Description)...)
+	ops = append(ops, patchSet("/apiStages", apiStages(p.APIStages), observedAPIStages(u.ApiStages))...)
+	if t := p.Throttle; t != nil {
+		observed := &apigateway.ThrottleSettings{}
+		if u.Throttle != nil {
+			observed = u.Throttle
+		}
+		ops = append(ops, patchInt64("/throttle/burstLimit", t.BurstLimit, observed.BurstLimit)...)
+		if t.RateLimit != nil && aws.Float64Value(t.RateLimit) != aws.Float64Value(observed.RateLimit) {
+			ops = append(ops, patch(apigateway.OpReplace, "/throttle/rateLimit", aws.String(strconv.FormatFloat(*t.RateLimit, 'f', -1, 64))))
+		}
+	}
+	if q := p.Quota; q != nil {
+		observed := &apigateway.QuotaSettings{}
+		if u.Quota != nil {
+			observed = u.Quota
+		}
+		ops = append(ops, patchInt64("/quota/limit", aws.Int64(q.Limit), observed.Limit)...)
+		ops = append(ops, patchInt64("/quota/offset", q.Offset, observed.Offset)...)
+		ops = append(ops, patchString("/quota/period", aws.String(q.Period), observed.Period)...)
+	}
+	return ops
+}
+
+// GenerateRemoveAPIStagesPatchOperations returns the operations that remove
+// all stages from the given usage plan, which is required to delete it.
+func GenerateRemoveAPIStagesPatchOperations(u *apigateway.UsagePlan) []*apigateway.PatchOperation {
+	return patchSet("/apiStages", nil, observedAPIStages(u.ApiStages))
+}
+
+// UsagePlanKeyIDs returns the IDs of the API keys of the given usage plan.
+func UsagePlanKeyIDs(ctx context.Context, client Client, id string) ([]string, error) {
+	var res []string
+	input := &apigateway.GetUsagePlanKeysInput{UsagePlanId: aws.String(id)}
+	for {
+		resp, err := client.GetUsagePlanKeysWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range resp.Items {
+			res = append(res, aws.StringValue(k.Id))
+		}
+		if aws.StringValue(resp.Position) == "" {
+			return res, nil
+		}
+		input.Position = resp.Position
+	}
+}
+
+// DiffUsagePlanKeyIDs returns the IDs of the desired API keys that are not
+// observed, and the IDs of the observed API keys that are not desired.
+func DiffUsagePlanKeyIDs(desired, observed []string) (add, remove []string) {
+	have := make(map[string]bool, len(observed))
+	for _, id := range observed {
+		have[id] = true
+	}
+	want := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		want[id] = true
+		if !have[id] {
+			add = append(add, id)
+		}
+	}
+	for _, id := range observed {
+		if !want[id] {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
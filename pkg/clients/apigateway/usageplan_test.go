@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+)
+
+func usagePlanParameters(m ...func(*v1alpha1.UsagePlanParameters)) v1alpha1.UsagePlanParameters {
+	p := v1alpha1.UsagePlanParameters{
+		Name: "basic",
+		APIStages: []v1alpha1.UsagePlanAPIStage{
+			{RestAPIID: aws.String("a1b2c3"), Stage: aws.String("prod")},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedUsagePlan() *apigateway.UsagePlan {
+	return &apigateway.UsagePlan{
+		Name: aws.String("basic"),
+		ApiStages: []*apigateway.ApiStage{
+			{ApiId: aws.String("a1b2c3"), Stage: aws.String("prod")},
+		},
+		Throttle: &apigateway.ThrottleSettings{BurstLimit: aws.Int64(10), RateLimit: aws.Float64(5)},
+		Quota:    &apigateway.QuotaSettings{Limit: aws.Int64(1000), Offset: aws.Int64(0), Period: aws.String(apigateway.QuotaPeriodTypeDay)},
+	}
+}
+
+func TestGenerateUsagePlanPatchOperations(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.UsagePlanParameters
+		want []*apigateway.PatchOperation
+	}{
+		"UpToDateAfterLateInitialization": {
+			p: usagePlanParameters(),
+		},
+		"StageChanged": {
+			p: usagePlanParameters(func(p *v1alpha1.UsagePlanParameters) {
+				p.APIStages[0].Stage = aws.String("dev")
+			}),
+			want: []*apigateway.PatchOperation{
+				patch(apigateway.OpRemove, "/apiStages", aws.String("a1b2c3:prod")),
+				patch(apigateway.OpAdd, "/apiStages", aws.String("a1b2c3:dev")),
+			},
+		},
+		"LimitsChanged": {
+			p: usagePlanParameters(func(p *v1alpha1.UsagePlanParameters) {
+				p.Throttle = &v1alpha1.ThrottleSettings{BurstLimit: aws.Int64(10), RateLimit: aws.Float64(2.5)}
+				p.Quota = &v1alpha1.QuotaSettings{Limit: 5000, Period: apigateway.QuotaPeriodTypeMonth}
+			}),
+			want: []*apigateway.PatchOperation{
+				patch(apigateway.OpReplace, "/throttle/rateLimit", aws.String("2.5")),
+				patch(apigateway.OpReplace, "/quota/limit", aws.String("5000")),
+				patch(apigateway.OpReplace, "/quota/period", aws.String(apigateway.QuotaPeriodTypeMonth)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := observedUsagePlan()
+			LateInitializeUsagePlan(&tc.p, observed)
+			got := GenerateUsagePlanPatchOperations(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffUsagePlanKeyIDs(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want
+	}{
+		"UpToDate": {
+			desired:  []string{"k1", "k2"},
+			observed: []string{"k2", "k1"},
+		},
+		"Changed": {
+			desired:  []string{"k1", "k3"},
+			observed: []string{"k1", "k2"},
+			want: want{
+				add:    []string{"k3"},
+				remove: []string{"k2"},
+			},
+		},
+		"AllRemoved": {
+			observed: []string{"k1"},
+			want: want{
+				remove: []string{"k1"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffUsagePlanKeyIDs(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// FieldManager is the field manager of the status and critical annotations of
+// managed resources, which are written using server-side apply.
+const FieldManager = "provider-aws"
+
+const (
+	errApplyStatus              = "cannot apply status"
+	errApplyCriticalAnnotations = "cannot apply critical annotations"
+)
+
+// criticalAnnotations are the annotations that the managed reconciler must not
+// lose, because they cannot be recovered from the external resource.
+var criticalAnnotations = []string{
+	meta.AnnotationKeyExternalName,
+	meta.AnnotationKeyExternalCreatePending,
+	meta.AnnotationKeyExternalCreateSucceeded,
+	meta.AnnotationKeyExternalCreateFailed,
+}
+
+// NewApplyingClient returns a client that writes status using server-side
+// apply rather than updates. Status writes then never conflict with changes
+// made to an object since it was read, e.g. by users or other controllers, so
+// they need not be retried.
+func NewApplyingClient(c client.Client) client.Client {
+	return &applyingClient{Client: c}
+}
+
+// NewApplyingClientFunc is a cluster.NewClientFunc that returns the default
+// client of a controller manager, wrapped by NewApplyingClient.
+func NewApplyingClientFunc(c cache.Cache, cfg *rest.Config, o client.Options, uncached ...client.Object) (client.Client, error) {
+	kube, err := cluster.DefaultNewClient(c, cfg, o, uncached...)
+	if err != nil {
+		return nil, err
+	}
+	return NewApplyingClient(kube), nil
+}
+
+type applyingClient struct {
+	client.Client
+}
+
+func (c *applyingClient) Status() client.StatusWriter {
+	return &applyingStatusWriter{StatusWriter: c.Client.Status(), client: c.Client}
+}
+
+type applyingStatusWriter struct {
+	client.StatusWriter
+	client client.Client
+}
+
+// Update applies the status of the supplied object, which is then updated
+// with the content returned by the API server.
+func (w *applyingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	u, err := applyConfiguration(w.client, obj)
+	if err != nil {
+		return errors.Wrap(err, errApplyStatus)
+	}
+	paved, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return errors.Wrap(err, errApplyStatus)
+	}
+	if s, ok := paved["status"]; ok {
+		u.Object["status"] = s
+	}
+	po := []client.PatchOption{client.FieldOwner(FieldManager), client.ForceOwnership}
+	if uo := (&client.UpdateOptions{}).ApplyOptions(opts); len(uo.DryRun) > 0 {
+		po = append(po, client.DryRunAll)
+	}
+	if err := w.StatusWriter.Patch(ctx, u, client.Apply, po...); err != nil {
+		return err
+	}
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj), errApplyStatus)
+}
+
+// A CriticalAnnotationApplier persists critical annotations using server-side
+// apply, so that they are not lost to conflicts with other writers.
+type CriticalAnnotationApplier struct {
+	client client.Client
+}
+
+// NewCriticalAnnotationApplier returns a CriticalAnnotationApplier that applies
+// annotations using the supplied client.
+func NewCriticalAnnotationApplier(c client.Client) *CriticalAnnotationApplier {
+	return &CriticalAnnotationApplier{client: c}
+}
+
+// UpdateCriticalAnnotations applies the critical annotations of the supplied
+// object, retrying in the face of API server errors. Like an update, any
+// pending changes to the object are reset to its state according to the API
+// server.
+func (a *CriticalAnnotationApplier) UpdateCriticalAnnotations(ctx context.Context, o client.Object) error {
+	u, err := applyConfiguration(a.client, o)
+	if err != nil {
+		return errors.Wrap(err, errApplyCriticalAnnotations)
+	}
+	an := map[string]string{}
+	for _, k := range criticalAnnotations {
+		if v, ok := o.GetAnnotations()[k]; ok {
+			an[k] = v
+		}
+	}
+	u.SetAnnotations(an)
+	err = retry.OnError(retry.DefaultRetry, resource.IsAPIError, func() error {
+		return a.client.Patch(ctx, u, client.Apply, client.FieldOwner(FieldManager), client.ForceOwnership)
+	})
+	if err != nil {
+		return errors.Wrap(err, errApplyCriticalAnnotations)
+	}
+	return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, o), errApplyCriticalAnnotations)
+}
+
+// applyConfiguration returns an object that identifies the supplied object,
+// to which the fields to be applied can be added.
+func applyConfiguration(c client.Client, o client.Object) (*unstructured.Unstructured, error) {
+	gvk := o.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		var err error
+		if gvk, err = apiutil.GVKForObject(o, c.Scheme()); err != nil {
+			return nil, err
+		}
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	u.SetName(o.GetName())
+	u.SetNamespace(o.GetNamespace())
+	return u, nil
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func vpc(m ...func(*ec2v1beta1.VPC)) *ec2v1beta1.VPC {
+	cr := &ec2v1beta1.VPC{}
+	cr.SetGroupVersionKind(ec2v1beta1.VPCGroupVersionKind)
+	cr.SetName("example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// applied returns a patch function that records the object and patch type it
+// was called with, and responds with the supplied resource version.
+func applied(obj *map[string]interface{}, pt *string, resourceVersion string, err error) func(context.Context, client.Object, client.Patch, ...client.PatchOption) error {
+	return func(_ context.Context, o client.Object, p client.Patch, opts ...client.PatchOption) error {
+		u := o.(*unstructured.Unstructured)
+		*obj = u.DeepCopy().Object
+		*pt = string(p.Type())
+		if err != nil {
+			return err
+		}
+		po := &client.PatchOptions{}
+		po.ApplyOptions(opts)
+		if po.FieldManager != FieldManager || po.Force == nil || !*po.Force {
+			return errors.New("not applied by a forcing field manager")
+		}
+		u.SetResourceVersion(resourceVersion)
+		return nil
+	}
+}
+
+func TestApplyingStatusWriter(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obj map[string]interface{}
+		cr  *ec2v1beta1.VPC
+		err error
+	}
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Applied": {
+			want: want{
+				obj: map[string]interface{}{
+					"apiVersion": "ec2.aws.crossplane.io/v1beta1",
+					"kind":       "VPC",
+					"metadata":   map[string]interface{}{"name": "example"},
+					"status": map[string]interface{}{
+						"atProvider": map[string]interface{}{"ownerId": "123456789012"},
+					},
+				},
+				cr: vpc(func(cr *ec2v1beta1.VPC) {
+					cr.SetResourceVersion("2")
+					cr.Status.AtProvider.OwnerID = "123456789012"
+				}),
+			},
+		},
+		"ApplyFailed": {
+			err: errBoom,
+			want: want{
+				obj: map[string]interface{}{
+					"apiVersion": "ec2.aws.crossplane.io/v1beta1",
+					"kind":       "VPC",
+					"metadata":   map[string]interface{}{"name": "example"},
+					"status": map[string]interface{}{
+						"atProvider": map[string]interface{}{"ownerId": "123456789012"},
+					},
+				},
+				cr: vpc(func(cr *ec2v1beta1.VPC) {
+					cr.SetResourceVersion("1")
+					cr.Spec.ForProvider.CIDRBlock = "10.0.0.0/16"
+					cr.Status.AtProvider.OwnerID = "123456789012"
+				}),
+				err: errBoom,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var obj map[string]interface{}
+			var pt string
+			kube := NewApplyingClient(&test.MockClient{
+				MockStatusUpdate: test.NewMockStatusUpdateFn(errors.New("status must be applied")),
+				MockStatusPatch:  applied(&obj, &pt, "2", tc.err),
+			})
+			cr := vpc(func(cr *ec2v1beta1.VPC) {
+				cr.SetResourceVersion("1")
+				cr.Spec.ForProvider.CIDRBlock = "10.0.0.0/16"
+				cr.Status.AtProvider.OwnerID = "123456789012"
+			})
+			err := kube.Status().Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(string(client.Apply.Type()), pt); diff != "" {
+				t.Errorf("Update(...): -want patch type, +got patch type:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, obj); diff != "" {
+				t.Errorf("Update(...): -want applied, +got applied:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCriticalAnnotationApplier(t *testing.T) {
+	errBoom := errors.New("boom")
+	annotated := func(cr *ec2v1beta1.VPC) {
+		meta.SetExternalName(cr, "vpc-1")
+		meta.AddAnnotations(cr, map[string]string{
+			meta.AnnotationKeyExternalCreateSucceeded: "now",
+			"example.org/unrelated":                   "true",
+		})
+	}
+
+	type want struct {
+		obj map[string]interface{}
+		cr  *ec2v1beta1.VPC
+		err error
+	}
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Applied": {
+			want: want{
+				obj: map[string]interface{}{
+					"apiVersion": "ec2.aws.crossplane.io/v1beta1",
+					"kind":       "VPC",
+					"metadata": map[string]interface{}{
+						"name": "example",
+						"annotations": map[string]interface{}{
+							meta.AnnotationKeyExternalName:            "vpc-1",
+							meta.AnnotationKeyExternalCreateSucceeded: "now",
+						},
+					},
+				},
+				// The pending spec and unrelated annotation are reset to the
+				// state returned by the API server.
+				cr: vpc(func(cr *ec2v1beta1.VPC) {
+					cr.SetResourceVersion("2")
+					meta.SetExternalName(cr, "vpc-1")
+					meta.AddAnnotations(cr, map[string]string{meta.AnnotationKeyExternalCreateSucceeded: "now"})
+					cr.Spec.ForProvider.EnableDNSSupport = nil
+				}),
+			},
+		},
+		"ApplyFailed": {
+			err: errBoom,
+			want: want{
+				obj: map[string]interface{}{
+					"apiVersion": "ec2.aws.crossplane.io/v1beta1",
+					"kind":       "VPC",
+					"metadata": map[string]interface{}{
+						"name": "example",
+						"annotations": map[string]interface{}{
+							meta.AnnotationKeyExternalName:            "vpc-1",
+							meta.AnnotationKeyExternalCreateSucceeded: "now",
+						},
+					},
+				},
+				cr: vpc(annotated, func(cr *ec2v1beta1.VPC) {
+					cr.SetResourceVersion("1")
+					cr.Spec.ForProvider.EnableDNSSupport = Bool(true)
+				}),
+				err: errors.Wrap(errBoom, errApplyCriticalAnnotations),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var obj map[string]interface{}
+			var pt string
+			a := NewCriticalAnnotationApplier(&test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errors.New("annotations must be applied")),
+				MockPatch:  applied(&obj, &pt, "2", tc.err),
+			})
+			cr := vpc(annotated, func(cr *ec2v1beta1.VPC) {
+				cr.SetResourceVersion("1")
+				cr.Spec.ForProvider.EnableDNSSupport = Bool(true)
+			})
+			err := a.UpdateCriticalAnnotations(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("UpdateCriticalAnnotations(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(string(client.Apply.Type()), pt); diff != "" {
+				t.Errorf("UpdateCriticalAnnotations(...): -want patch type, +got patch type:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, obj); diff != "" {
+				t.Errorf("UpdateCriticalAnnotations(...): -want applied, +got applied:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr); diff != "" {
+				t.Errorf("UpdateCriticalAnnotations(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
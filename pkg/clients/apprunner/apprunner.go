@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apprunner
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTags      = "cannot list tags of App Runner resource"
+	errTagResource   = "cannot tag App Runner resource"
+	errUntagResource = "cannot untag App Runner resource"
+)
+
+// Client defines App Runner Client operations
+type Client interface {
+	CreateServiceWithContext(ctx context.Context, input *apprunner.CreateServiceInput, opts ...request.Option) (*apprunner.CreateServiceOutput, error)
+	DescribeServiceWithContext(ctx context.Context, input *apprunner.DescribeServiceInput, opts ...request.Option) (*apprunner.DescribeServiceOutput, error)
+	UpdateServiceWithContext(ctx context.Context, input *apprunner.UpdateServiceInput, opts ...request.Option) (*apprunner.UpdateServiceOutput, error)
+	DeleteServiceWithContext(ctx context.Context, input *apprunner.DeleteServiceInput, opts ...request.Option) (*apprunner.DeleteServiceOutput, error)
+	ListTagsForResourceWithContext(ctx context.Context, input *apprunner.ListTagsForResourceInput, opts ...request.Option) (*apprunner.ListTagsForResourceOutput, error)
+	TagResourceWithContext(ctx context.Context, input *apprunner.TagResourceInput, opts ...request.Option) (*apprunner.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *apprunner.UntagResourceInput, opts ...request.Option) (*apprunner.UntagResourceOutput, error)
+}
+
+// NewClient returns a new App Runner client using the given session.
+func NewClient(sess *session.Session) Client {
+	return apprunner.New(sess)
+}
+
+// IsNotFound returns true if the error is because the resource doesn't
+// exist.
+func IsNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == apprunner.ErrCodeResourceNotFoundException
+}
+
+// GenerateTags converts the given tag map to App Runner tags, sorted by key.
+func GenerateTags(tags map[string]string) []*apprunner.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	res := make([]*apprunner.Tag, len(keys))
+	for i, k := range keys {
+		res[i] = &apprunner.Tag{Key: aws.String(k), Value: aws.String(tags[k])}
+	}
+	return res
+}
+
+// ListTags returns the tags of the resource with the given ARN.
+func ListTags(ctx context.Context, client Client, arn string) (map[string]string, error) {
+	resp, err := client.ListTagsForResourceWithContext(ctx, &apprunner.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errListTags)
+	}
+	if len(resp.Tags) == 0 {
+		return nil, nil
+	}
+	res := make(map[string]string, len(resp.Tags))
+	for _, t := range resp.Tags {
+		res[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return res, nil
+}
+
+// UpdateTags makes the tags of the resource with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, client Client, arn string, desired, observed map[string]string) error {
+	add, remove := awsclient.DiffTags(desired, observed)
+	if len(remove) > 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &apprunner.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     aws.StringSlice(remove),
+		}); err != nil {
+			return awsclient.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResourceWithContext(ctx, &apprunner.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        GenerateTags(add),
+		}); err != nil {
+			return awsclient.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/apprunner"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateServiceWithContext       func(ctx context.Context, input *apprunner.CreateServiceInput, opts []request.Option) (*apprunner.CreateServiceOutput, error)
+	MockDescribeServiceWithContext     func(ctx context.Context, input *apprunner.DescribeServiceInput, opts []request.Option) (*apprunner.DescribeServiceOutput, error)
+	MockUpdateServiceWithContext       func(ctx context.Context, input *apprunner.UpdateServiceInput, opts []request.Option) (*apprunner.UpdateServiceOutput, error)
+	MockDeleteServiceWithContext       func(ctx context.Context, input *apprunner.DeleteServiceInput, opts []request.Option) (*apprunner.DeleteServiceOutput, error)
+	MockListTagsForResourceWithContext func(ctx context.Context, input *apprunner.ListTagsForResourceInput, opts []request.Option) (*apprunner.ListTagsForResourceOutput, error)
+	MockTagResourceWithContext         func(ctx context.Context, input *apprunner.TagResourceInput, opts []request.Option) (*apprunner.TagResourceOutput, error)
+	MockUntagResourceWithContext       func(ctx context.Context, input *apprunner.UntagResourceInput, opts []request.Option) (*apprunner.UntagResourceOutput, error)
+}
+
+// CreateServiceWithContext mocks CreateServiceWithContext method
+func (m *MockClient) CreateServiceWithContext(ctx context.Context, input *apprunner.CreateServiceInput, opts ...request.Option) (*apprunner.CreateServiceOutput, error) {
+	return m.MockCreateServiceWithContext(ctx, input, opts)
+}
+
+// DescribeServiceWithContext mocks DescribeServiceWithContext method
+func (m *MockClient) DescribeServiceWithContext(ctx context.Context, input *apprunner.DescribeServiceInput, opts ...request.Option) (*apprunner.DescribeServiceOutput, error) {
+	return m.MockDescribeServiceWithContext(ctx, input, opts)
+}
+
+// UpdateServiceWithContext mocks UpdateServiceWithContext method
+func (m *MockClient) UpdateServiceWithContext(ctx context.Context, input *apprunner.UpdateServiceInput, opts ...request.Option) (*apprunner.UpdateServiceOutput, error) {
+	return m.MockUpdateServiceWithContext(ctx, input, opts)
+}
+
+// DeleteServiceWithContext mocks DeleteServiceWithContext method
+func (m *MockClient) DeleteServiceWithContext(ctx context.Context, input *apprunner.DeleteServiceInput, opts ...request.Option) (*apprunner.DeleteServiceOutput, error) {
+	return m.MockDeleteServiceWithContext(ctx, input, opts)
+}
+
+// ListTagsForResourceWithContext mocks ListTagsForResourceWithContext method
+func (m *MockClient) ListTagsForResourceWithContext(ctx context.Context, input *apprunner.ListTagsForResourceInput, opts ...request.Option) (*apprunner.ListTagsForResourceOutput, error) {
+	return m.MockListTagsForResourceWithContext(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *apprunner.TagResourceInput, opts ...request.Option) (*apprunner.TagResourceOutput, error) {
+	return m.MockTagResourceWithContext(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *apprunner.UntagResourceInput, opts ...request.Option) (*apprunner.UntagResourceOutput, error) {
+	return m.MockUntagResourceWithContext(ctx, input, opts)
+}
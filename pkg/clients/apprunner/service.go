@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apprunner
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const defaultImageTag = "latest"
+
+// ImageIdentifier returns the identifier of the image the service is
+// deployed from, made up of the repository URI and the image tag.
+func ImageIdentifier(r v1alpha1.ImageRepository) string {
+	tag := r.ImageTag
+	if tag == "" {
+		tag = defaultImageTag
+	}
+	return aws.StringValue(r.RepositoryURI) + ":" + tag
+}
+
+func generateSourceConfiguration(s v1alpha1.SourceConfiguration) *apprunner.SourceConfiguration {
+	res := &apprunner.SourceConfiguration{
+		AutoDeploymentsEnabled: s.AutoDeploymentsEnabled,
+		ImageRepository: &apprunner.ImageRepository{
+			ImageIdentifier:     aws.String(ImageIdentifier(s.ImageRepository)),
+			ImageRepositoryType: aws.String(s.ImageRepository.ImageRepositoryType),
+		},
+	}
+	if s.AccessRoleARN != nil {
+		res.AuthenticationConfiguration = &apprunner.AuthenticationConfiguration{AccessRoleArn: s.AccessRoleARN}
+	}
+	if c := s.ImageRepository.ImageConfiguration; c != nil {
+		res.ImageRepository.ImageConfiguration = &apprunner.ImageConfiguration{
+			Port:                        c.Port,
+			RuntimeEnvironmentVariables: aws.StringMap(c.RuntimeEnvironmentVariables),
+			StartCommand:                c.StartCommand,
+		}
+		if len(c.RuntimeEnvironmentVariables) == 0 {
+			res.ImageRepository.ImageConfiguration.RuntimeEnvironmentVariables = nil
+		}
+	}
+	return res
+}
+
+func generateInstanceConfiguration(c *v1alpha1.InstanceConfiguration) *apprunner.InstanceConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &apprunner.InstanceConfiguration{
+		Cpu:             c.CPU,
+		Memory:          c.Memory,
+		InstanceRoleArn: c.InstanceRoleARN,
+	}
+}
+
+func generateHealthCheckConfiguration(c *v1alpha1.HealthCheckConfiguration) *apprunner.HealthCheckConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &apprunner.HealthCheckConfiguration{
+		Protocol:           c.Protocol,
+		Path:               c.Path,
+		Interval:           c.Interval,
+		Timeout:            c.Timeout,
+		HealthyThreshold:   c.HealthyThreshold,
+		UnhealthyThreshold: c.UnhealthyThreshold,
+	}
+}
+
+func generateNetworkConfiguration(c *v1alpha1.NetworkConfiguration) *apprunner.NetworkConfiguration {
+	if c == nil {
+		return nil
+	}
+	res := &apprunner.NetworkConfiguration{}
+	if c.EgressConfiguration != nil {
+		res.EgressConfiguration = &apprunner.EgressConfiguration{
+			EgressType:      c.EgressConfiguration.EgressType,
+			VpcConnectorArn: c.EgressConfiguration.VPCConnectorARN,
+		}
+	}
+	if c.IngressConfiguration != nil {
+		res.IngressConfiguration = &apprunner.IngressConfiguration{
+			IsPubliclyAccessible: c.IngressConfiguration.IsPubliclyAccessible,
+		}
+	}
+	return res
+}
+
+// GenerateCreateServiceInput returns the input for CreateService.
+func GenerateCreateServiceInput(p v1alpha1.ServiceParameters) *apprunner.CreateServiceInput {
+	input := &apprunner.CreateServiceInput{
+		ServiceName:                 aws.String(p.ServiceName),
+		SourceConfiguration:         generateSourceConfiguration(p.SourceConfiguration),
+		InstanceConfiguration:       generateInstanceConfiguration(p.InstanceConfiguration),
+		AutoScalingConfigurationArn: p.AutoScalingConfigurationARN,
+		HealthCheckConfiguration:    generateHealthCheckConfiguration(p.HealthCheckConfiguration),
+		NetworkConfiguration:        generateNetworkConfiguration(p.NetworkConfiguration),
+		Tags:                        GenerateTags(p.Tags),
+	}
+	if p.KMSKey != nil {
+		input.EncryptionConfiguration = &apprunner.EncryptionConfiguration{KmsKey: p.KMSKey}
+	}
+	return input
+}
+
+// GenerateUpdateServiceInput returns the input for UpdateService.
+func GenerateUpdateServiceInput(arn string, p v1alpha1.ServiceParameters) *apprunner.UpdateServiceInput {
+	return &apprunner.UpdateServiceInput{
+		ServiceArn:                  aws.String(arn),
+		SourceConfiguration:         generateSourceConfiguration(p.SourceConfiguration),
+		InstanceConfiguration:       generateInstanceConfiguration(p.InstanceConfiguration),
+		AutoScalingConfigurationArn: p.AutoScalingConfigurationARN,
+		HealthCheckConfiguration:    generateHealthCheckConfiguration(p.HealthCheckConfiguration),
+		NetworkConfiguration:        generateNetworkConfiguration(p.NetworkConfiguration),
+	}
+}
+
+// GenerateServiceObservation returns the observation of the given service.
+func GenerateServiceObservation(s *apprunner.Service) v1alpha1.ServiceObservation {
+	return v1alpha1.ServiceObservation{
+		ServiceARN: aws.StringValue(s.ServiceArn),
+		ServiceID:  aws.StringValue(s.ServiceId),
+		ServiceURL: aws.StringValue(s.ServiceUrl),
+		Status:     aws.StringValue(s.Status),
+	}
+}
+
+// LateInitializeService fills the empty fields of the given parameters with
+// the values App Runner defaulted them to.
+func LateInitializeService(p *v1alpha1.ServiceParameters, s *apprunner.Service) { // nolint:gocyclo
+	if src := s.SourceConfiguration; src != nil {
+		p.SourceConfiguration.AutoDeploymentsEnabled = awsclient.LateInitializeBoolPtr(p.SourceConfiguration.AutoDeploymentsEnabled, src.AutoDeploymentsEnabled)
+		if src.ImageRepository != nil && src.ImageRepository.ImageConfiguration != nil {
+			if p.SourceConfiguration.ImageRepository.ImageConfiguration == nil {
+				p.SourceConfiguration.ImageRepository.ImageConfiguration = &v1alpha1.ImageConfiguration{}
+			}
+			c := p.SourceConfiguration.ImageRepository.ImageConfiguration
+			c.Port = awsclient.LateInitializeStringPtr(c.Port, src.ImageRepository.ImageConfiguration.Port)
+		}
+	}
+	p.AutoScalingConfigurationARN = awsclient.LateInitializeStringPtr(p.AutoScalingConfigurationARN, autoScalingConfigurationARN(s))
+	if c := s.InstanceConfiguration; c != nil {
+		if p.InstanceConfiguration == nil {
+			p.InstanceConfiguration = &v1alpha1.InstanceConfiguration{}
+		}
+		p.InstanceConfiguration.CPU = awsclient.LateInitializeStringPtr(p.InstanceConfiguration.CPU, c.Cpu)
+		p.InstanceConfiguration.Memory = awsclient.LateInitializeStringPtr(p.InstanceConfiguration.Memory, c.Memory)
+	}
+	if c := s.HealthCheckConfiguration; c != nil {
+		if p.HealthCheckConfiguration == nil {
+			p.HealthCheckConfiguration = &v1alpha1.HealthCheckConfiguration{}
+		}
+		h := p.HealthCheckConfiguration
+		h.Protocol = awsclient.LateInitializeStringPtr(h.Protocol, c.Protocol)
+		h.Path = awsclient.LateInitializeStringPtr(h.Path, c.Path)
+		h.Interval = awsclient.LateInitializeInt64Ptr(h.Interval, c.Interval)
+		h.Timeout = awsclient.LateInitializeInt64Ptr(h.Timeout, c.Timeout)
+		h.HealthyThreshold = awsclient.LateInitializeInt64Ptr(h.HealthyThreshold, c.HealthyThreshold)
+		h.UnhealthyThreshold = awsclient.LateInitializeInt64Ptr(h.UnhealthyThreshold, c.UnhealthyThreshold)
+	}
+	if c := s.NetworkConfiguration; c != nil {
+		if p.NetworkConfiguration == nil {
+			p.NetworkConfiguration = &v1alpha1.NetworkConfiguration{}
+		}
+		n := p.NetworkConfiguration
+		if c.EgressConfiguration != nil && n.EgressConfiguration == nil {
+			n.EgressConfiguration = &v1alpha1.EgressConfiguration{
+				EgressType:      c.EgressConfiguration.EgressType,
+				VPCConnectorARN: c.EgressConfiguration.VpcConnectorArn,
+			}
+		}
+		if c.IngressConfiguration != nil && n.IngressConfiguration == nil {
+			n.IngressConfiguration = &v1alpha1.IngressConfiguration{
+				IsPubliclyAccessible: c.IngressConfiguration.IsPubliclyAccessible,
+			}
+		}
+	}
+}
+
+func autoScalingConfigurationARN(s *apprunner.Service) *string {
+	if s.AutoScalingConfigurationSummary == nil {
+		return nil
+	}
+	return s.AutoScalingConfigurationSummary.AutoScalingConfigurationArn
+}
+
+// IsServiceUpToDate checks whether the source, the instance, the auto
+// scaling, the health check and the network configuration of the service
+// are up to date. Tags are not compared.
+func IsServiceUpToDate(p v1alpha1.ServiceParameters, s *apprunner.Service) bool {
+	opts := []cmp.Option{cmpopts.EquateEmpty()}
+	switch {
+	case !cmp.Equal(generateSourceConfiguration(p.SourceConfiguration), s.SourceConfiguration, opts...):
+		return false
+	case !cmp.Equal(generateInstanceConfiguration(p.InstanceConfiguration), s.InstanceConfiguration, opts...):
+		return false
+	case aws.StringValue(p.AutoScalingConfigurationARN) != aws.StringValue(autoScalingConfigurationARN(s)):
+		return false
+	case !cmp.Equal(generateHealthCheckConfiguration(p.HealthCheckConfiguration), s.HealthCheckConfiguration, opts...):
+		return false
+	}
+	return cmp.Equal(generateNetworkConfiguration(p.NetworkConfiguration), s.NetworkConfiguration, opts...)
+}
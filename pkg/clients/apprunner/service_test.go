@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apprunner
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
+)
+
+var (
+	repositoryURI   = "123456789012.dkr.ecr.us-east-1.amazonaws.com/web"
+	accessRoleARN   = "arn:aws:iam::123456789012:role/apprunner-access"
+	autoScalingARN  = "arn:aws:apprunner:us-east-1:123456789012:autoscalingconfiguration/DefaultConfiguration/1/00000000000000000000000000000001"
+	vpcConnectorARN = "arn:aws:apprunner:us-east-1:123456789012:vpcconnector/web/1/00000000000000000000000000000001"
+)
+
+func serviceParameters(m ...func(*v1alpha1.ServiceParameters)) v1alpha1.ServiceParameters {
+	p := v1alpha1.ServiceParameters{
+		ServiceName: "web",
+		SourceConfiguration: v1alpha1.SourceConfiguration{
+			ImageRepository: v1alpha1.ImageRepository{
+				RepositoryURI:       aws.String(repositoryURI),
+				ImageTag:            "v1",
+				ImageRepositoryType: apprunner.ImageRepositoryTypeEcr,
+			},
+			AccessRoleARN: aws.String(accessRoleARN),
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedService() *apprunner.Service {
+	return &apprunner.Service{
+		ServiceName: aws.String("web"),
+		Status:      aws.String(v1alpha1.ServiceStatusRunning),
+		SourceConfiguration: &apprunner.SourceConfiguration{
+			AutoDeploymentsEnabled: aws.Bool(false),
+			AuthenticationConfiguration: &apprunner.AuthenticationConfiguration{
+				AccessRoleArn: aws.String(accessRoleARN),
+			},
+			ImageRepository: &apprunner.ImageRepository{
+				ImageIdentifier:     aws.String(repositoryURI + ":v1"),
+				ImageRepositoryType: aws.String(apprunner.ImageRepositoryTypeEcr),
+				ImageConfiguration: &apprunner.ImageConfiguration{
+					Port: aws.String("8080"),
+				},
+			},
+		},
+		InstanceConfiguration: &apprunner.InstanceConfiguration{
+			Cpu:    aws.String("1024"),
+			Memory: aws.String("2048"),
+		},
+		AutoScalingConfigurationSummary: &apprunner.AutoScalingConfigurationSummary{
+			AutoScalingConfigurationArn: aws.String(autoScalingARN),
+		},
+		HealthCheckConfiguration: &apprunner.HealthCheckConfiguration{
+			Protocol:           aws.String(apprunner.HealthCheckProtocolTcp),
+			Path:               aws.String("/"),
+			Interval:           aws.Int64(5),
+			Timeout:            aws.Int64(2),
+			HealthyThreshold:   aws.Int64(1),
+			UnhealthyThreshold: aws.Int64(5),
+		},
+		NetworkConfiguration: &apprunner.NetworkConfiguration{
+			EgressConfiguration: &apprunner.EgressConfiguration{
+				EgressType: aws.String(apprunner.EgressTypeDefault),
+			},
+			IngressConfiguration: &apprunner.IngressConfiguration{
+				IsPubliclyAccessible: aws.Bool(true),
+			},
+		},
+	}
+}
+
+func TestImageIdentifier(t *testing.T) {
+	cases := map[string]struct {
+		r    v1alpha1.ImageRepository
+		want string
+	}{
+		"Tagged": {
+			r:    v1alpha1.ImageRepository{RepositoryURI: aws.String(repositoryURI), ImageTag: "v1"},
+			want: repositoryURI + ":v1",
+		},
+		"DefaultTag": {
+			r:    v1alpha1.ImageRepository{RepositoryURI: aws.String(repositoryURI)},
+			want: repositoryURI + ":latest",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ImageIdentifier(tc.r)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsServiceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ServiceParameters
+		want bool
+	}{
+		"UpToDateAfterLateInitialization": {
+			p:    serviceParameters(),
+			want: true,
+		},
+		"ImageTagChanged": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.SourceConfiguration.ImageRepository.ImageTag = "v2"
+			}),
+			want: false,
+		},
+		"EnvironmentVariableAdded": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.SourceConfiguration.ImageRepository.ImageConfiguration = &v1alpha1.ImageConfiguration{
+					RuntimeEnvironmentVariables: map[string]string{"LOG_LEVEL": "debug"},
+				}
+			}),
+			want: false,
+		},
+		"MemoryChanged": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.InstanceConfiguration = &v1alpha1.InstanceConfiguration{Memory: aws.String("4096")}
+			}),
+			want: false,
+		},
+		"VPCConnectorAttached": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.NetworkConfiguration = &v1alpha1.NetworkConfiguration{
+					EgressConfiguration: &v1alpha1.EgressConfiguration{
+						EgressType:      aws.String(apprunner.EgressTypeVpc),
+						VPCConnectorARN: aws.String(vpcConnectorARN),
+					},
+				}
+			}),
+			want: false,
+		},
+		"AutoScalingConfigurationChanged": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.AutoScalingConfigurationARN = aws.String("arn:aws:apprunner:us-east-1:123456789012:autoscalingconfiguration/web/1/00000000000000000000000000000002")
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := observedService()
+			LateInitializeService(&tc.p, observed)
+			got := IsServiceUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errUpdateAsyncStatus = "cannot update status with the async operation in progress"
+
+// TypeAsyncOperation indicates whether the provider is waiting for a long
+// running operation it started, e.g. the creation of a database cluster, to
+// complete in AWS. The time the operation started is reported as the last
+// transition time of the condition.
+const TypeAsyncOperation xpv1.ConditionType = "AsyncOperation"
+
+// Reasons an async operation is or is not in progress.
+const (
+	ReasonAsyncInProgress xpv1.ConditionReason = "AsyncInProgress"
+	ReasonAsyncCompleted  xpv1.ConditionReason = "AsyncCompleted"
+)
+
+// AsyncInProgress returns a condition that indicates the provider is waiting
+// for the supplied operation to complete.
+func AsyncInProgress(op string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAsyncOperation,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAsyncInProgress,
+		Message:            fmt.Sprintf("waiting for %s to complete", op),
+	}
+}
+
+// AsyncCompleted returns a condition that indicates the last async operation
+// of the resource has completed.
+func AsyncCompleted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAsyncOperation,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAsyncCompleted,
+	}
+}
+
+// SetAsyncInProgress records that the supplied operation was started. Repeated
+// calls for the same operation keep the time it was first recorded.
+func SetAsyncInProgress(mg resource.Conditioned, op string) {
+	mg.SetConditions(AsyncInProgress(op))
+}
+
+// PersistAsyncInProgress is like SetAsyncInProgress but immediately writes
+// the status of the managed resource. It is meant for postCreate hooks since
+// the managed reconciler discards status changes made while creating.
+func PersistAsyncInProgress(ctx context.Context, kube client.Client, mg resource.Managed, op string) error {
+	SetAsyncInProgress(mg, op)
+	// NOTE: Updating the status overwrites the object with what's
+	// returned from the API server, which would drop annotations set during
+	// creation, like external name, that are not persisted yet.
+	a := mg.GetAnnotations()
+	err := kube.Status().Update(ctx, mg)
+	meta.AddAnnotations(mg, a)
+	return errors.Wrap(err, errUpdateAsyncStatus)
+}
+
+// CompleteAsyncOperation marks the async operation in progress, if any, as
+// completed. It is meant to be called once an observation shows the resource
+// settled.
+func CompleteAsyncOperation(mg resource.Conditioned) {
+	if mg.GetCondition(TypeAsyncOperation).Status == corev1.ConditionTrue {
+		mg.SetConditions(AsyncCompleted())
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestPersistAsyncInProgress(t *testing.T) {
+	errBoom := errors.New("boom")
+	type want struct {
+		err  error
+		cond xpv1.Condition
+		name string
+	}
+	cases := map[string]struct {
+		kube client.Client
+		want want
+	}{
+		"Successful": {
+			kube: &test.MockClient{
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					// Simulate the API server returning the object without
+					// annotations that were not persisted yet.
+					obj.SetAnnotations(nil)
+					return nil
+				},
+			},
+			want: want{
+				cond: AsyncInProgress("CreateCluster"),
+				name: "cool",
+			},
+		},
+		"StatusUpdateFailed": {
+			kube: &test.MockClient{
+				MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+			},
+			want: want{
+				err:  errors.Wrap(errBoom, errUpdateAsyncStatus),
+				cond: AsyncInProgress("CreateCluster"),
+				name: "cool",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			meta.SetExternalName(mg, "cool")
+			err := PersistAsyncInProgress(context.Background(), tc.kube, mg, "CreateCluster")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, mg.GetCondition(TypeAsyncOperation)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.name, meta.GetExternalName(mg)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompleteAsyncOperation(t *testing.T) {
+	cases := map[string]struct {
+		conditions []xpv1.Condition
+		want       xpv1.Condition
+	}{
+		"InProgress": {
+			conditions: []xpv1.Condition{AsyncInProgress("UpdateClusterVersion")},
+			want:       AsyncCompleted(),
+		},
+		"NothingInProgress": {
+			want: xpv1.Condition{Type: TypeAsyncOperation, Status: "Unknown"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{ConditionedStatus: xpv1.ConditionedStatus{Conditions: tc.conditions}}
+			CompleteAsyncOperation(mg)
+			if diff := cmp.Diff(tc.want, mg.GetCondition(TypeAsyncOperation)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
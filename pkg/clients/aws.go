@@ -38,6 +38,7 @@ import (
 	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-ini/ini"
 	"github.com/google/go-cmp/cmp"
@@ -84,28 +85,106 @@ const (
 // GetConfig constructs an *aws.Config that can be used to authenticate to AWS
 // API by the AWS clients.
 func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
+	var cfg *aws.Config
+	var err error
 	switch {
 	case mg.GetProviderConfigReference() != nil:
-		return UseProviderConfig(ctx, c, mg, region)
+		cfg, err = UseProviderConfig(ctx, c, mg, region)
 	case mg.GetProviderReference() != nil:
-		return UseProvider(ctx, c, mg, region)
+		cfg, err = UseProvider(ctx, c, mg, region)
 	default:
 		return nil, errors.New("neither providerConfigRef nor providerRef is given")
 	}
+	if err != nil {
+		return nil, err
+	}
+	// The config may be a shallow copy of a cached one, so its API options are
+	// cloned rather than appended to in place.
+	cfg.APIOptions = append(append([]func(*middleware.Stack) error{}, cfg.APIOptions...), addRequestIDRecorder)
+	return cfg, nil
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) { // nolint:gocyclo
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get referenced Provider")
 	}
 
-	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
-	if err := t.Track(ctx, mg); err != nil {
+	if err := trackProviderConfigUsage(ctx, c, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	return UseProviderConfigCredentials(ctx, c, pc, region)
+}
+
+// untrackedKey marks a context in which connecting to a managed resource does
+// not record that it uses its ProviderConfig.
+type untrackedKey struct{}
+
+// withoutUsageTracking returns a context in which the configs produced for a
+// managed resource do not record that it uses its ProviderConfig, e.g. when it
+// is observed using a ProviderConfig other than its own.
+func withoutUsageTracking(ctx context.Context) context.Context {
+	return context.WithValue(ctx, untrackedKey{}, true)
+}
+
+func trackProviderConfigUsage(ctx context.Context, c client.Client, mg resource.Managed) error {
+	if ctx.Value(untrackedKey{}) != nil {
+		return nil
+	}
+	return resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{}).Track(ctx, mg)
+}
+
+// A credentialsError is returned when the credentials of a ProviderConfig
+// cannot be loaded or used.
+type credentialsError struct {
+	error
+}
+
+func (e credentialsError) Unwrap() error {
+	return e.error
+}
+
+// IsCredentialsError returns true if the supplied error was caused by
+// credentials that could not be loaded, or that AWS rejected.
+func IsCredentialsError(err error) bool {
+	if r, _ := ClassifyError(err); r == ReasonInvalidCredentials {
+		return true
+	}
+	var ce credentialsError
+	return errors.As(err, &ce)
+}
+
+// UseProviderConfigCredentials produces a config that authenticates to AWS
+// with the credentials of the supplied ProviderConfig. Unlike
+// UseProviderConfig it does not record a usage of the ProviderConfig, so it
+// is meant for calls that are not made on behalf of a managed resource.
+func UseProviderConfigCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	data, err := providerConfigCredentials(ctx, c, pc)
+	if err != nil {
+		return nil, credentialsError{err}
+	}
+	cfg, err := configs.getV2(ctx, newConfigKey(pc, region, data), func() (*aws.Config, error) {
+		return useProviderConfigCredentials(ctx, pc, data, region)
+	})
+	if err != nil {
+		return nil, credentialsError{err}
+	}
+	return cfg, nil
+}
+
+// providerConfigCredentials returns the credentials the supplied ProviderConfig
+// refers to, or nil if it uses the identity injected into the provider pod.
+func providerConfigCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, error) {
+	if pc.Spec.Credentials.Source == xpv1.CredentialsSourceInjectedIdentity {
+		return nil, nil
+	}
+	data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+	return data, errors.Wrap(err, "cannot get credentials")
+}
+
+func useProviderConfigCredentials(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (*aws.Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRoleARN != nil {
@@ -121,10 +200,6 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		}
 		return SetResolver(pc, cfg), nil
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
-		}
 		if pc.Spec.AssumeRoleARN != nil {
 			cfg, err := UseProviderSecretAssumeRole(ctx, data, DefaultSection, region, pc)
 			if err != nil {
@@ -352,7 +427,7 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -361,10 +436,29 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 		return nil, errors.Wrap(err, "cannot get referenced ProviderConfig")
 	}
 
-	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
-	if err := t.Track(ctx, mg); err != nil {
+	if err := trackProviderConfigUsage(ctx, c, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+	return UseProviderConfigCredentialsV1(ctx, c, pc, region)
+}
+
+// UseProviderConfigCredentialsV1 is the AWSv1 counterpart of
+// UseProviderConfigCredentials.
+func UseProviderConfigCredentialsV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*session.Session, error) {
+	data, err := providerConfigCredentials(ctx, c, pc)
+	if err != nil {
+		return nil, credentialsError{err}
+	}
+	sess, err := configs.getV1(newConfigKey(pc, region, data), func() (*session.Session, error) {
+		return useProviderConfigCredentialsV1(ctx, pc, data, region)
+	})
+	if err != nil {
+		return nil, credentialsError{err}
+	}
+	return sess, nil
+}
+
+func useProviderConfigCredentialsV1(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (*session.Session, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRoleARN != nil {
@@ -372,32 +466,38 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 			if err != nil {
 				return nil, errors.Wrap(err, "cannot use pod service account to assume role")
 			}
-			return session.NewSession(cfg)
+			return newSessionV1(cfg)
 		}
 		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, pc, DefaultSection, region)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use pod service account")
 		}
-		return session.NewSession(cfg)
+		return newSessionV1(cfg)
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
-		}
-
 		if pc.Spec.AssumeRoleARN != nil {
 			cfg, err := UseProviderSecretV1AssumeRole(ctx, data, pc, DefaultSection, region)
 			if err != nil {
 				return nil, errors.Wrap(err, "cannot use secret")
 			}
-			return session.NewSession(cfg)
+			return newSessionV1(cfg)
 		}
 		cfg, err := UseProviderSecretV1(ctx, data, pc, DefaultSection, region)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use secret")
 		}
-		return session.NewSession(cfg)
+		return newSessionV1(cfg)
+	}
+}
+
+// newSessionV1 returns a session that records the IDs of the requests made
+// with it, see WrapExternal.
+func newSessionV1(cfg *awsv1.Config) (*session.Session, error) {
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
 	}
+	sess.Handlers.Complete.PushBack(recordRequestIDV1)
+	return sess, nil
 }
 
 // UseProviderSecretV1AssumeRole - AWS v1 configuration which can be used to issue requests against AWS API
@@ -423,16 +523,11 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 	)
 	config.Credentials = aws.NewCredentialsCache(stsAssume)
 
-	v2creds, err := config.Credentials.Retrieve(ctx)
+	v1creds, err := credentialsV1(ctx, config.Credentials)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
 
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
-
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
@@ -491,14 +586,10 @@ func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
 	}
-	v2creds, err := cnf.Credentials.Retrieve(ctx)
+	v1creds, err := credentialsV1(ctx, cnf.Credentials)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
@@ -512,14 +603,10 @@ func UsePodServiceAccountV1(ctx context.Context, _ []byte, pc *v1beta1.ProviderC
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	v2creds, err := cfg.Credentials.Retrieve(ctx)
+	v1creds, err := credentialsV1(ctx, cfg.Credentials)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
@@ -791,6 +878,15 @@ func LateInitializeInt64Ptr(in *int64, from *int64) *int64 {
 	return from
 }
 
+// LateInitializeFloat64Ptr returns in if it's non-nil, otherwise returns from
+// which is the backup for the cases in is nil.
+func LateInitializeFloat64Ptr(in *float64, from *float64) *float64 {
+	if in != nil {
+		return in
+	}
+	return from
+}
+
 // LateInitializeInt32 returns in if it's non-zero, otherwise returns from
 // which is the backup for the cases in is zero.
 func LateInitializeInt32(in int32, from int32) int32 {
@@ -979,15 +1075,24 @@ func Wrap(err error, msg string) error {
 	// the underlying error. So, we need to strip off the unique request ID
 	// manually.
 	if v1RequestError, ok := err.(awserr.RequestFailure); ok {
-		// TODO(negz): This loses context about the underlying error
-		// type, preventing us from using errors.As to figure out what
-		// kind of error it is. Could we do this without losing
-		// context?
-		return errors.Wrap(errors.New(strings.ReplaceAll(err.Error(), v1RequestError.RequestID(), "")), msg)
+		return errors.Wrap(&v1Error{err: v1RequestError, msg: strings.ReplaceAll(err.Error(), v1RequestError.RequestID(), "")}, msg)
 	}
 	return errors.Wrap(err, msg)
 }
 
+// v1Error is an AWS SDK v1 error whose message does not contain the request
+// ID. It still satisfies awserr.Error so that its code can be inspected with
+// errors.As.
+type v1Error struct {
+	err awserr.Error
+	msg string
+}
+
+func (e *v1Error) Error() string   { return e.msg }
+func (e *v1Error) Code() string    { return e.err.Code() }
+func (e *v1Error) Message() string { return e.err.Message() }
+func (e *v1Error) OrigErr() error  { return e.err.OrigErr() }
+
 // DiffTagsMapPtr returns which AWS Tags exist in the resource tags and which are outdated and should be removed
 func DiffTagsMapPtr(spec map[string]*string, current map[string]*string) (map[string]*string, []*string) {
 	addMap := make(map[string]*string, len(spec))
@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	// maxConfigAge is how long a config whose credentials do not expire,
+	// e.g. static credentials read from a Secret, is cached.
+	maxConfigAge = time.Hour
+
+	// configExpiryWindow is how long before its credentials expire a config
+	// is built again, so that calls in flight don't use expired credentials.
+	configExpiryWindow = time.Minute
+)
+
+// configs caches the AWS configs built for ProviderConfigs. Building one may
+// call STS to assume a role, which would otherwise happen on every reconcile
+// of every managed resource.
+var configs = newConfigCache()
+
+// A configKey identifies the inputs a config was built from. Editing a
+// ProviderConfig bumps its generation and updating its credentials changes
+// their hash, both of which result in a new config being built.
+type configKey struct {
+	uid         types.UID
+	generation  int64
+	credentials string
+	region      string
+}
+
+func newConfigKey(pc *v1beta1.ProviderConfig, region string, credentials []byte) configKey {
+	k := configKey{uid: pc.GetUID(), generation: pc.GetGeneration(), region: region}
+	if credentials != nil {
+		h := sha256.Sum256(credentials)
+		k.credentials = hex.EncodeToString(h[:])
+	}
+	return k
+}
+
+type configEntry struct {
+	v2      *aws.Config
+	v1      *session.Session
+	expires time.Time
+}
+
+// configCache is a thread-safe cache of AWS SDK v1 sessions and v2 configs.
+type configCache struct {
+	mu  sync.Mutex
+	v2  map[configKey]configEntry
+	v1  map[configKey]configEntry
+	now func() time.Time
+}
+
+func newConfigCache() *configCache {
+	return &configCache{
+		v2:  map[configKey]configEntry{},
+		v1:  map[configKey]configEntry{},
+		now: time.Now,
+	}
+}
+
+// getV2 returns a copy of the config cached for the supplied key, building
+// it with the supplied function if there is none or it expired.
+func (c *configCache) getV2(ctx context.Context, k configKey, build func() (*aws.Config, error)) (*aws.Config, error) {
+	c.mu.Lock()
+	e, ok := c.v2[k]
+	c.mu.Unlock()
+	if ok && c.now().Before(e.expires) {
+		cfg := e.v2.Copy()
+		return &cfg, nil
+	}
+
+	cfg, err := build()
+	if err != nil {
+		return nil, err
+	}
+	// Retrieving the credentials up front assumes the role, if any, once for
+	// all the users of the config and tells how long they are valid for. A
+	// config whose credentials cannot be retrieved is not cached so that the
+	// error is reported by, and retried with, the calls made with it.
+	if cfg.Credentials == nil {
+		return cfg, nil
+	}
+	if creds, err := cfg.Credentials.Retrieve(ctx); err == nil {
+		c.mu.Lock()
+		c.store(c.v2, k, configEntry{v2: cfg, expires: c.expiry(creds.CanExpire, creds.Expires)})
+		c.mu.Unlock()
+	}
+	cp := cfg.Copy()
+	return &cp, nil
+}
+
+// getV1 returns a copy of the session cached for the supplied key, building
+// it with the supplied function if there is none or it expired.
+func (c *configCache) getV1(k configKey, build func() (*session.Session, error)) (*session.Session, error) {
+	c.mu.Lock()
+	e, ok := c.v1[k]
+	c.mu.Unlock()
+	if ok && c.now().Before(e.expires) {
+		return e.v1.Copy(), nil
+	}
+
+	sess, err := build()
+	if err != nil {
+		return nil, err
+	}
+	var t time.Time
+	if sess.Config.Credentials != nil {
+		t, err = sess.Config.Credentials.ExpiresAt()
+	}
+
+	c.mu.Lock()
+	c.store(c.v1, k, configEntry{v1: sess, expires: c.expiry(err == nil && !t.IsZero(), t)})
+	c.mu.Unlock()
+
+	return sess.Copy(), nil
+}
+
+func (c *configCache) expiry(canExpire bool, expires time.Time) time.Time {
+	if canExpire {
+		return expires.Add(-configExpiryWindow)
+	}
+	return c.now().Add(maxConfigAge)
+}
+
+// store caches the supplied entry, dropping those built for older generations
+// of the same ProviderConfig and those that expired. The caller must hold the
+// lock.
+func (c *configCache) store(m map[configKey]configEntry, k configKey, e configEntry) {
+	now := c.now()
+	for ok, oe := range m {
+		if (ok.uid == k.uid && ok.generation < k.generation) || !now.Before(oe.expires) {
+			delete(m, ok)
+		}
+	}
+	m[k] = e
+}
+
+// credentialsV1 returns AWS SDK v1 credentials that are retrieved from the
+// supplied v2 provider, so that they are refreshed when they expire rather
+// than being a snapshot of the credentials at the time the config was built.
+func credentialsV1(ctx context.Context, p aws.CredentialsProvider) (*credentialsv1.Credentials, error) {
+	v := &v1CredentialsProvider{provider: p}
+	c := credentialsv1.NewCredentials(v)
+	// Retrieve them once so that invalid credentials are reported when the
+	// config is built rather than when it is used.
+	if _, err := c.GetWithContext(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+type v1CredentialsProvider struct {
+	provider aws.CredentialsProvider
+	creds    aws.Credentials
+}
+
+func (p *v1CredentialsProvider) Retrieve() (credentialsv1.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+func (p *v1CredentialsProvider) RetrieveWithContext(ctx credentialsv1.Context) (credentialsv1.Value, error) {
+	creds, err := p.provider.Retrieve(ctx)
+	if err != nil {
+		return credentialsv1.Value{}, err
+	}
+	p.creds = creds
+	return credentialsv1.Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		ProviderName:    creds.Source,
+	}, nil
+}
+
+func (p *v1CredentialsProvider) IsExpired() bool {
+	return p.creds.Expired()
+}
+
+// ExpiresAt returns the zero time for credentials that do not expire.
+func (p *v1CredentialsProvider) ExpiresAt() time.Time {
+	if !p.creds.CanExpire {
+		return time.Time{}
+	}
+	return p.creds.Expires
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func providerConfig(generation int64) *v1beta1.ProviderConfig {
+	return &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{UID: "cool-uid", Generation: generation}}
+}
+
+func TestConfigCacheV2(t *testing.T) {
+	now := time.Now()
+	assumed := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: "id", CanExpire: true, Expires: now.Add(15 * time.Minute)}, nil
+	})
+	static := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: "id"}, nil
+	})
+
+	type call struct {
+		key   configKey
+		after time.Duration
+	}
+	cases := map[string]struct {
+		creds  aws.CredentialsProvider
+		calls  []call
+		builds int
+	}{
+		"Cached": {
+			creds: static,
+			calls: []call{
+				{key: newConfigKey(providerConfig(1), "us-east-1", []byte("a"))},
+				{key: newConfigKey(providerConfig(1), "us-east-1", []byte("a")), after: time.Minute},
+			},
+			builds: 1,
+		},
+		"ProviderConfigChanged": {
+			creds: static,
+			calls: []call{
+				{key: newConfigKey(providerConfig(1), "us-east-1", []byte("a"))},
+				{key: newConfigKey(providerConfig(2), "us-east-1", []byte("a"))},
+			},
+			builds: 2,
+		},
+		"CredentialsChanged": {
+			creds: static,
+			calls: []call{
+				{key: newConfigKey(providerConfig(1), "us-east-1", []byte("a"))},
+				{key: newConfigKey(providerConfig(1), "us-east-1", []byte("b"))},
+			},
+			builds: 2,
+		},
+		"StaticCredentialsExpired": {
+			creds: static,
+			calls: []call{
+				{key: newConfigKey(providerConfig(1), "us-east-1", nil)},
+				{key: newConfigKey(providerConfig(1), "us-east-1", nil), after: maxConfigAge},
+			},
+			builds: 2,
+		},
+		"AssumedRoleValid": {
+			creds: assumed,
+			calls: []call{
+				{key: newConfigKey(providerConfig(1), "us-east-1", nil)},
+				{key: newConfigKey(providerConfig(1), "us-east-1", nil), after: 10 * time.Minute},
+			},
+			builds: 1,
+		},
+		"AssumedRoleExpiring": {
+			creds: assumed,
+			calls: []call{
+				{key: newConfigKey(providerConfig(1), "us-east-1", nil)},
+				{key: newConfigKey(providerConfig(1), "us-east-1", nil), after: 15*time.Minute - configExpiryWindow},
+			},
+			builds: 2,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newConfigCache()
+			builds := 0
+			for _, call := range tc.calls {
+				c.now = func() time.Time { return now.Add(call.after) }
+				cfg, err := c.getV2(context.Background(), call.key, func() (*aws.Config, error) {
+					builds++
+					return &aws.Config{Credentials: tc.creds}, nil
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				// Callers must not be able to modify the cached config.
+				cfg.APIOptions = append(cfg.APIOptions, func(*middleware.Stack) error { return nil })
+			}
+			if diff := cmp.Diff(tc.builds, builds); diff != "" {
+				t.Errorf("builds: -want, +got:\n%s", diff)
+			}
+			for _, e := range c.v2 {
+				if diff := cmp.Diff(0, len(e.v2.APIOptions)); diff != "" {
+					t.Errorf("cached APIOptions: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCredentialsV1(t *testing.T) {
+	retrieved := 0
+	expires := time.Now().Add(-time.Second)
+	p := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		retrieved++
+		e := expires
+		expires = time.Now().Add(time.Hour)
+		return aws.Credentials{AccessKeyID: "id", CanExpire: true, Expires: e}, nil
+	})
+	c, err := credentialsV1(context.Background(), p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The first credentials are already expired, so getting them again must
+	// retrieve new ones from the v2 provider.
+	if _, err := c.Get(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(2, retrieved); diff != "" {
+		t.Errorf("retrieved: -want, +got:\n%s", diff)
+	}
+	got, err := c.ExpiresAt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.After(time.Now()) {
+		t.Errorf("ExpiresAt(): want refreshed credentials to expire in the future, got %s", got)
+	}
+}
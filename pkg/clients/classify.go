@@ -0,0 +1,340 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TypeAWSError indicates whether the last call the provider made to the AWS API
+// for a resource failed, and if so, what kind of failure it was.
+const TypeAWSError xpv1.ConditionType = "AWSError"
+
+// Reasons an AWS API call failed, derived from the error code returned by AWS.
+const (
+	ReasonAccessDenied        xpv1.ConditionReason = "AccessDenied"
+	ReasonInvalidCredentials  xpv1.ConditionReason = "InvalidCredentials"
+	ReasonThrottled           xpv1.ConditionReason = "Throttled"
+	ReasonDependencyViolation xpv1.ConditionReason = "DependencyViolation"
+	ReasonInvalidParameter    xpv1.ConditionReason = "InvalidParameter"
+	ReasonLimitExceeded       xpv1.ConditionReason = "LimitExceeded"
+	ReasonAPIError            xpv1.ConditionReason = "APIError"
+
+	ReasonAPICallSucceeded xpv1.ConditionReason = "Succeeded"
+)
+
+var reasons = map[string]xpv1.ConditionReason{
+	"AccessDenied":          ReasonAccessDenied,
+	"AccessDeniedException": ReasonAccessDenied,
+	"UnauthorizedOperation": ReasonAccessDenied,
+	"AuthFailure":           ReasonAccessDenied,
+
+	"InvalidClientTokenId":        ReasonInvalidCredentials,
+	"UnrecognizedClientException": ReasonInvalidCredentials,
+	"ExpiredToken":                ReasonInvalidCredentials,
+	"ExpiredTokenException":       ReasonInvalidCredentials,
+	"SignatureDoesNotMatch":       ReasonInvalidCredentials,
+
+	"Throttling":                             ReasonThrottled,
+	"ThrottlingException":                    ReasonThrottled,
+	"ThrottledException":                     ReasonThrottled,
+	"RequestLimitExceeded":                   ReasonThrottled,
+	"TooManyRequestsException":               ReasonThrottled,
+	"ProvisionedThroughputExceededException": ReasonThrottled,
+	"SlowDown":                               ReasonThrottled,
+
+	"DependencyViolation":    ReasonDependencyViolation,
+	"DeleteConflict":         ReasonDependencyViolation,
+	"ResourceInUse":          ReasonDependencyViolation,
+	"ResourceInUseException": ReasonDependencyViolation,
+
+	"InvalidParameter":            ReasonInvalidParameter,
+	"InvalidParameterCombination": ReasonInvalidParameter,
+	"InvalidParameterValue":       ReasonInvalidParameter,
+	"InvalidParameterException":   ReasonInvalidParameter,
+	"InvalidInput":                ReasonInvalidParameter,
+	"MalformedPolicyDocument":     ReasonInvalidParameter,
+	"ValidationError":             ReasonInvalidParameter,
+	"ValidationException":         ReasonInvalidParameter,
+
+	"LimitExceeded":          ReasonLimitExceeded,
+	"LimitExceededException": ReasonLimitExceeded,
+}
+
+// ErrorCode returns the AWS error code of the supplied error, e.g.
+// AccessDenied, and whether it was an AWS API error at all. Errors from both
+// SDK versions are supported, including those wrapped by Wrap.
+func ErrorCode(err error) (string, bool) {
+	code, _, ok := apiError(err)
+	return code, ok
+}
+
+func apiError(err error) (code, msg string, ok bool) {
+	var v2 smithy.APIError
+	if errors.As(err, &v2) {
+		return v2.ErrorCode(), v2.ErrorMessage(), true
+	}
+	var v1 awserr.Error
+	if errors.As(err, &v1) {
+		return v1.Code(), v1.Message(), true
+	}
+	return "", "", false
+}
+
+// ClassifyError returns the condition reason for the supplied error, and false
+// if it was not returned by the AWS API.
+func ClassifyError(err error) (xpv1.ConditionReason, bool) {
+	code, ok := ErrorCode(err)
+	if !ok {
+		return "", false
+	}
+	if r, ok := reasons[code]; ok {
+		return r, true
+	}
+	// Quota errors are named after the quota, e.g. AddressLimitExceeded or
+	// DBInstanceQuotaExceeded.
+	if strings.HasSuffix(code, "LimitExceeded") || strings.HasSuffix(code, "QuotaExceeded") ||
+		strings.HasSuffix(code, "QuotaExceededFault") || strings.HasSuffix(code, "LimitExceededException") {
+		return ReasonLimitExceeded, true
+	}
+	return ReasonAPIError, true
+}
+
+// AWSError returns a condition that indicates the last AWS API call for a
+// resource failed with the supplied error, if it was returned by the AWS API.
+func AWSError(err error) (xpv1.Condition, bool) {
+	r, ok := ClassifyError(err)
+	if !ok {
+		return xpv1.Condition{}, false
+	}
+	code, msg, _ := apiError(err)
+	return xpv1.Condition{
+		Type:               TypeAWSError,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            fmt.Sprintf("%s: %s", code, msg),
+	}, true
+}
+
+// AWSSucceeded returns a condition that indicates the last AWS API call for a
+// resource succeeded.
+func AWSSucceeded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAWSError,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAPICallSucceeded,
+	}
+}
+
+// WrapExternal wraps the supplied connecter with the behaviour every
+// controller of this provider shares:
+//
+//   - AWS API errors returned by it, or by the clients it produces, are
+//     reported as an AWSError condition, see ClassifyError.
+//   - The time, spec generation and request ID of the last sync are recorded
+//     in the SyncStatus of the resource.
+//   - Resources that name a fallback ProviderConfig are observed using it
+//     while their own credentials fail, see AnnotationKeyFallbackProviderConfig.
+//   - Resources created for an existing external resource adopt it, see
+//     AnnotationKeyAdoptedAt.
+//   - Create-only fields are ignored once the resource exists, see
+//     AnnotationKeyCreateOnlyFields.
+//   - Fields that drifted from the spec are reported as events before they
+//     are corrected, see WithRecorder.
+func WrapExternal(kube client.Client, c managed.ExternalConnecter, o ...WrapExternalOption) managed.ExternalConnecter {
+	sc := &wrappedConnecter{kube: kube, connecter: c, record: event.NewNopRecorder()}
+	for _, fn := range o {
+		fn(sc)
+	}
+	return sc
+}
+
+// A WrapExternalOption configures a connecter wrapped by WrapExternal.
+type WrapExternalOption func(*wrappedConnecter)
+
+// WithRecorder specifies how to record events about resources, e.g. about the
+// fields that drifted before they are corrected.
+func WithRecorder(r event.Recorder) WrapExternalOption {
+	return func(c *wrappedConnecter) {
+		c.record = r
+	}
+}
+
+type wrappedConnecter struct {
+	kube      client.Client
+	connecter managed.ExternalConnecter
+	record    event.Recorder
+}
+
+func (c *wrappedConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		setAWSError(mg, err)
+		if fallbackProviderConfig(mg) == "" || !IsCredentialsError(err) {
+			return nil, err
+		}
+		// The resource can still be observed, but nothing else.
+		return &wrappedExternal{kube: c.kube, connecter: c.connecter, record: c.record, readOnly: err}, nil
+	}
+	return &wrappedExternal{kube: c.kube, connecter: c.connecter, record: c.record, external: ext}, nil
+}
+
+type wrappedExternal struct {
+	kube      client.Client
+	connecter managed.ExternalConnecter
+	record    event.Recorder
+	external  managed.ExternalClient
+
+	// readOnly is why the resource was observed using its fallback
+	// ProviderConfig, if it was. Nothing but observations may be made then.
+	readOnly error
+}
+
+func (e *wrappedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, err := unsetCreateOnlyFields(mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	ctx, ids := withRequestIDs(ctx)
+	o, err := e.observe(ctx, mg)
+	o.ResourceLateInitialized = c.restore(o.ResourceLateInitialized)
+	syncStatusOf(mg).observed(ids.last(), false)
+	if err != nil {
+		setAWSError(mg, err)
+		return o, err
+	}
+	if e.readOnly != nil {
+		return o, nil
+	}
+	// Only a resource that needs no further calls is known to be fine, since
+	// a failed create or update is followed by a successful observation.
+	if o.ResourceExists && o.ResourceUpToDate {
+		clearAWSError(mg)
+		syncStatusOf(mg).applied(mg.GetGeneration())
+	}
+	// Adopting a resource does not apply its spec, so it is not recorded as
+	// applied above, and any drift is left to be corrected later.
+	if adopting(mg) {
+		return adopt(mg, o), nil
+	}
+	e.reportDrift(mg, o)
+	return o, nil
+}
+
+// reportDrift reports the drifted fields recorded while observing a resource
+// that is about to be updated, and clears them once it is up to date.
+func (e *wrappedExternal) reportDrift(mg resource.Managed, o managed.ExternalObservation) {
+	c := mg.GetCondition(TypeDrifted)
+	if c.Status != corev1.ConditionTrue || !o.ResourceExists {
+		return
+	}
+	if o.ResourceUpToDate {
+		mg.SetConditions(NotDrifted())
+		return
+	}
+	e.record.Event(mg, event.Normal(reasonDriftDetected, "Fields differ from the external resource: "+c.Message))
+}
+
+func (e *wrappedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if e.readOnly != nil {
+		return managed.ExternalCreation{}, errors.Wrap(e.readOnly, errReadOnly)
+	}
+	ctx, ids := withRequestIDs(ctx)
+	c, err := e.external.Create(ctx, mg)
+	syncStatusOf(mg).observed(ids.last(), err == nil)
+	if err == nil || !setAWSError(mg, err) {
+		return c, err
+	}
+	// NOTE: The managed reconciler reads the resource again after a failed
+	// create, which would discard the condition, so it is written here.
+	// Annotations are kept since the update overwrites the object with what
+	// is returned from the API server. The create error is what the user
+	// needs to see, so it is returned even if the status update fails.
+	a := mg.GetAnnotations()
+	_ = e.kube.Status().Update(ctx, mg)
+	meta.AddAnnotations(mg, a)
+	return c, err
+}
+
+func (e *wrappedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if e.readOnly != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(e.readOnly, errReadOnly)
+	}
+	c, err := unsetCreateOnlyFields(mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	ctx, ids := withRequestIDs(ctx)
+	u, err := e.external.Update(ctx, mg)
+	c.restore(false)
+	syncStatusOf(mg).observed(ids.last(), err == nil)
+	if err != nil {
+		setAWSError(mg, err)
+		return u, err
+	}
+	clearAWSError(mg)
+	syncStatusOf(mg).applied(mg.GetGeneration())
+	return u, nil
+}
+
+func (e *wrappedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if e.readOnly != nil {
+		return errors.Wrap(e.readOnly, errReadOnly)
+	}
+	ctx, ids := withRequestIDs(ctx)
+	err := e.external.Delete(ctx, mg)
+	syncStatusOf(mg).observed(ids.last(), err == nil)
+	if err != nil {
+		setAWSError(mg, err)
+	}
+	return err
+}
+
+// setAWSError sets the AWSError condition if the supplied error was returned
+// by the AWS API, and reports whether it did.
+func setAWSError(mg resource.Conditioned, err error) bool {
+	c, ok := AWSError(err)
+	if ok {
+		mg.SetConditions(c)
+	}
+	return ok
+}
+
+// clearAWSError marks the last AWS API call as succeeded if a previous one
+// failed.
+func clearAWSError(mg resource.Conditioned) {
+	if mg.GetCondition(TypeAWSError).Status == corev1.ConditionTrue {
+		mg.SetConditions(AWSSucceeded())
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestClassifyError(t *testing.T) {
+	type want struct {
+		reason xpv1.ConditionReason
+		ok     bool
+	}
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"V2AccessDenied": {
+			err:  errors.Wrap(&smithy.GenericAPIError{Code: "UnauthorizedOperation"}, "cannot describe VPC"),
+			want: want{reason: ReasonAccessDenied, ok: true},
+		},
+		"V1Throttled": {
+			err:  Wrap(awserr.NewRequestFailure(awserr.New("Throttling", "Rate exceeded", nil), 400, "req-id"), "cannot get role"),
+			want: want{reason: ReasonThrottled, ok: true},
+		},
+		"DependencyViolation": {
+			err:  &smithy.GenericAPIError{Code: "DependencyViolation"},
+			want: want{reason: ReasonDependencyViolation, ok: true},
+		},
+		"InvalidParameterCombination": {
+			err:  awserr.New("InvalidParameterCombination", "no", nil),
+			want: want{reason: ReasonInvalidParameter, ok: true},
+		},
+		"NamedQuota": {
+			err:  awserr.New("DBInstanceQuotaExceeded", "no", nil),
+			want: want{reason: ReasonLimitExceeded, ok: true},
+		},
+		"OtherAWSError": {
+			err:  &smithy.GenericAPIError{Code: "InvalidVpcID.NotFound"},
+			want: want{reason: ReasonAPIError, ok: true},
+		},
+		"NotAnAWSError": {
+			err: errors.New("boom"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, ok := ClassifyError(tc.err)
+			if diff := cmp.Diff(tc.want, want{reason: r, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWrapKeepsCode(t *testing.T) {
+	err := Wrap(awserr.NewRequestFailure(awserr.New("AccessDenied", "nope", nil), 403, "req-id"), "cannot create bucket")
+	code, ok := ErrorCode(err)
+	if diff := cmp.Diff("AccessDenied", code); diff != "" || !ok {
+		t.Errorf("ErrorCode(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestWrappedExternal(t *testing.T) {
+	errDenied := &smithy.GenericAPIError{Code: "AccessDenied", Message: "not allowed"}
+	errNotAWS := errors.New("boom")
+	denied, _ := AWSError(errDenied)
+
+	type args struct {
+		kube       client.Client
+		ext        managed.ExternalClient
+		conditions []xpv1.Condition
+		op         func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error
+	}
+	type want struct {
+		err  error
+		cond xpv1.Condition
+		name string
+	}
+	observe := func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+		_, err := e.Observe(ctx, mg)
+		return err
+	}
+	create := func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+		_, err := e.Create(ctx, mg)
+		return err
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ObserveFailed": {
+			args: args{
+				ext: &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, errDenied
+					},
+				},
+				op: observe,
+			},
+			want: want{err: errDenied, cond: denied, name: "cool"},
+		},
+		"ObserveUpToDateClearsError": {
+			args: args{
+				ext: &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+					},
+				},
+				conditions: []xpv1.Condition{denied},
+				op:         observe,
+			},
+			want: want{cond: AWSSucceeded(), name: "cool"},
+		},
+		"CreateFailedPersistsCondition": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						obj.SetAnnotations(nil)
+						return nil
+					},
+				},
+				ext: &managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, errDenied
+					},
+				},
+				op: create,
+			},
+			want: want{err: errDenied, cond: denied, name: "cool"},
+		},
+		"CreateFailedNotAnAWSError": {
+			args: args{
+				ext: &managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, errNotAWS
+					},
+				},
+				op: create,
+			},
+			want: want{err: errNotAWS, cond: xpv1.Condition{Type: TypeAWSError, Status: "Unknown"}, name: "cool"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{ConditionedStatus: xpv1.ConditionedStatus{Conditions: tc.args.conditions}}
+			meta.SetExternalName(mg, "cool")
+			c := WrapExternal(tc.args.kube, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return tc.args.ext, nil
+			}))
+			e, _ := c.Connect(context.Background(), mg)
+			err := tc.args.op(context.Background(), e, mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, mg.GetCondition(TypeAWSError), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.name, meta.GetExternalName(mg)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines CloudFront Client operations
+type Client interface {
+	CreateFunctionWithContext(ctx context.Context, input *cloudfront.CreateFunctionInput, opts ...request.Option) (*cloudfront.CreateFunctionOutput, error)
+	DescribeFunctionWithContext(ctx context.Context, input *cloudfront.DescribeFunctionInput, opts ...request.Option) (*cloudfront.DescribeFunctionOutput, error)
+	GetFunctionWithContext(ctx context.Context, input *cloudfront.GetFunctionInput, opts ...request.Option) (*cloudfront.GetFunctionOutput, error)
+	UpdateFunctionWithContext(ctx context.Context, input *cloudfront.UpdateFunctionInput, opts ...request.Option) (*cloudfront.UpdateFunctionOutput, error)
+	PublishFunctionWithContext(ctx context.Context, input *cloudfront.PublishFunctionInput, opts ...request.Option) (*cloudfront.PublishFunctionOutput, error)
+	DeleteFunctionWithContext(ctx context.Context, input *cloudfront.DeleteFunctionInput, opts ...request.Option) (*cloudfront.DeleteFunctionOutput, error)
+
+	CreateKeyValueStoreWithContext(ctx context.Context, input *cloudfront.CreateKeyValueStoreInput, opts ...request.Option) (*cloudfront.CreateKeyValueStoreOutput, error)
+	DescribeKeyValueStoreWithContext(ctx context.Context, input *cloudfront.DescribeKeyValueStoreInput, opts ...request.Option) (*cloudfront.DescribeKeyValueStoreOutput, error)
+	UpdateKeyValueStoreWithContext(ctx context.Context, input *cloudfront.UpdateKeyValueStoreInput, opts ...request.Option) (*cloudfront.UpdateKeyValueStoreOutput, error)
+	DeleteKeyValueStoreWithContext(ctx context.Context, input *cloudfront.DeleteKeyValueStoreInput, opts ...request.Option) (*cloudfront.DeleteKeyValueStoreOutput, error)
+
+	CreateOriginAccessControlWithContext(ctx context.Context, input *cloudfront.CreateOriginAccessControlInput, opts ...request.Option) (*cloudfront.CreateOriginAccessControlOutput, error)
+	GetOriginAccessControlWithContext(ctx context.Context, input *cloudfront.GetOriginAccessControlInput, opts ...request.Option) (*cloudfront.GetOriginAccessControlOutput, error)
+	UpdateOriginAccessControlWithContext(ctx context.Context, input *cloudfront.UpdateOriginAccessControlInput, opts ...request.Option) (*cloudfront.UpdateOriginAccessControlOutput, error)
+	DeleteOriginAccessControlWithContext(ctx context.Context, input *cloudfront.DeleteOriginAccessControlInput, opts ...request.Option) (*cloudfront.DeleteOriginAccessControlOutput, error)
+}
+
+// NewClient returns a new CloudFront client using the given session.
+func NewClient(sess *session.Session) Client {
+	return cloudfront.New(sess)
+}
+
+// IsNotFound returns true if the error is because the function, or the
+// requested stage of it, the key value store or the origin access control
+// doesn't exist.
+func IsNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == cloudfront.ErrCodeNoSuchFunctionExists ||
+		code == cloudfront.ErrCodeEntityNotFound ||
+		code == cloudfront.ErrCodeNoSuchOriginAccessControl
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateFunctionWithContext            func(ctx context.Context, input *cloudfront.CreateFunctionInput, opts []request.Option) (*cloudfront.CreateFunctionOutput, error)
+	MockDescribeFunctionWithContext          func(ctx context.Context, input *cloudfront.DescribeFunctionInput, opts []request.Option) (*cloudfront.DescribeFunctionOutput, error)
+	MockGetFunctionWithContext               func(ctx context.Context, input *cloudfront.GetFunctionInput, opts []request.Option) (*cloudfront.GetFunctionOutput, error)
+	MockUpdateFunctionWithContext            func(ctx context.Context, input *cloudfront.UpdateFunctionInput, opts []request.Option) (*cloudfront.UpdateFunctionOutput, error)
+	MockPublishFunctionWithContext           func(ctx context.Context, input *cloudfront.PublishFunctionInput, opts []request.Option) (*cloudfront.PublishFunctionOutput, error)
+	MockDeleteFunctionWithContext            func(ctx context.Context, input *cloudfront.DeleteFunctionInput, opts []request.Option) (*cloudfront.DeleteFunctionOutput, error)
+	MockCreateKeyValueStoreWithContext       func(ctx context.Context, input *cloudfront.CreateKeyValueStoreInput, opts []request.Option) (*cloudfront.CreateKeyValueStoreOutput, error)
+	MockDescribeKeyValueStoreWithContext     func(ctx context.Context, input *cloudfront.DescribeKeyValueStoreInput, opts []request.Option) (*cloudfront.DescribeKeyValueStoreOutput, error)
+	MockUpdateKeyValueStoreWithContext       func(ctx context.Context, input *cloudfront.UpdateKeyValueStoreInput, opts []request.Option) (*cloudfront.UpdateKeyValueStoreOutput, error)
+	MockDeleteKeyValueStoreWithContext       func(ctx context.Context, input *cloudfront.DeleteKeyValueStoreInput, opts []request.Option) (*cloudfront.DeleteKeyValueStoreOutput, error)
+	MockCreateOriginAccessControlWithContext func(ctx context.Context, input *cloudfront.CreateOriginAccessControlInput, opts []request.Option) (*cloudfront.CreateOriginAccessControlOutput, error)
+	MockGetOriginAccessControlWithContext    func(ctx context.Context, input *cloudfront.GetOriginAccessControlInput, opts []request.Option) (*cloudfront.GetOriginAccessControlOutput, error)
+	MockUpdateOriginAccessControlWithContext func(ctx context.Context, input *cloudfront.UpdateOriginAccessControlInput, opts []request.Option) (*cloudfront.UpdateOriginAccessControlOutput, error)
+	MockDeleteOriginAccessControlWithContext func(ctx context.Context, input *cloudfront.DeleteOriginAccessControlInput, opts []request.Option) (*cloudfront.DeleteOriginAccessControlOutput, error)
+}
+
+// CreateFunctionWithContext mocks CreateFunctionWithContext method
+func (m *MockClient) CreateFunctionWithContext(ctx context.Context, input *cloudfront.CreateFunctionInput, opts ...request.Option) (*cloudfront.CreateFunctionOutput, error) {
+	return m.MockCreateFunctionWithContext(ctx, input, opts)
+}
+
+// DescribeFunctionWithContext mocks DescribeFunctionWithContext method
+func (m *MockClient) DescribeFunctionWithContext(ctx context.Context, input *cloudfront.DescribeFunctionInput, opts ...request.Option) (*cloudfront.DescribeFunctionOutput, error) {
+	return m.MockDescribeFunctionWithContext(ctx, input, opts)
+}
+
+// GetFunctionWithContext mocks GetFunctionWithContext method
+func (m *MockClient) GetFunctionWithContext(ctx context.Context, input *cloudfront.GetFunctionInput, opts ...request.Option) (*cloudfront.GetFunctionOutput, error) {
+	return m.MockGetFunctionWithContext(ctx, input, opts)
+}
+
+// UpdateFunctionWithContext mocks UpdateFunctionWithContext method
+func (m *MockClient) UpdateFunctionWithContext(ctx context.Context, input *cloudfront.UpdateFunctionInput, opts ...request.Option) (*cloudfront.UpdateFunctionOutput, error) {
+	return m.MockUpdateFunctionWithContext(ctx, input, opts)
+}
+
+// PublishFunctionWithContext mocks PublishFunctionWithContext method
+func (m *MockClient) PublishFunctionWithContext(ctx context.Context, input *cloudfront.PublishFunctionInput, opts ...request.Option) (*cloudfront.PublishFunctionOutput, error) {
+	return m.MockPublishFunctionWithContext(ctx, input, opts)
+}
+
+// DeleteFunctionWithContext mocks DeleteFunctionWithContext method
+func (m *MockClient) DeleteFunctionWithContext(ctx context.Context, input *cloudfront.DeleteFunctionInput, opts ...request.Option) (*cloudfront.DeleteFunctionOutput, error) {
+	return m.MockDeleteFunctionWithContext(ctx, input, opts)
+}
+
+// CreateKeyValueStoreWithContext mocks CreateKeyValueStoreWithContext method
+func (m *MockClient) CreateKeyValueStoreWithContext(ctx context.Context, input *cloudfront.CreateKeyValueStoreInput, opts ...request.Option) (*cloudfront.CreateKeyValueStoreOutput, error) {
+	return m.MockCreateKeyValueStoreWithContext(ctx, input, opts)
+}
+
+// DescribeKeyValueStoreWithContext mocks DescribeKeyValueStoreWithContext method
+func (m *MockClient) DescribeKeyValueStoreWithContext(ctx context.Context, input *cloudfront.DescribeKeyValueStoreInput, opts ...request.Option) (*cloudfront.DescribeKeyValueStoreOutput, error) {
+	return m.MockDescribeKeyValueStoreWithContext(ctx, input, opts)
+}
+
+// UpdateKeyValueStoreWithContext mocks UpdateKeyValueStoreWithContext method
+func (m *MockClient) UpdateKeyValueStoreWithContext(ctx context.Context, input *cloudfront.UpdateKeyValueStoreInput, opts ...request.Option) (*cloudfront.UpdateKeyValueStoreOutput, error) {
+	return m.MockUpdateKeyValueStoreWithContext(ctx, input, opts)
+}
+
+// DeleteKeyValueStoreWithContext mocks DeleteKeyValueStoreWithContext method
+func (m *MockClient) DeleteKeyValueStoreWithContext(ctx context.Context, input *cloudfront.DeleteKeyValueStoreInput, opts ...request.Option) (*cloudfront.DeleteKeyValueStoreOutput, error) {
+	return m.MockDeleteKeyValueStoreWithContext(ctx, input, opts)
+}
+
+// CreateOriginAccessControlWithContext mocks CreateOriginAccessControlWithContext method
+func (m *MockClient) CreateOriginAccessControlWithContext(ctx context.Context, input *cloudfront.CreateOriginAccessControlInput, opts ...request.Option) (*cloudfront.CreateOriginAccessControlOutput, error) {
+	return m.MockCreateOriginAccessControlWithContext(ctx, input, opts)
+}
+
+// GetOriginAccessControlWithContext mocks GetOriginAccessControlWithContext method
+func (m *MockClient) GetOriginAccessControlWithContext(ctx context.Context, input *cloudfront.GetOriginAccessControlInput, opts ...request.Option) (*cloudfront.GetOriginAccessControlOutput, error) {
+	return m.MockGetOriginAccessControlWithContext(ctx, input, opts)
+}
+
+// UpdateOriginAccessControlWithContext mocks UpdateOriginAccessControlWithContext method
+func (m *MockClient) UpdateOriginAccessControlWithContext(ctx context.Context, input *cloudfront.UpdateOriginAccessControlInput, opts ...request.Option) (*cloudfront.UpdateOriginAccessControlOutput, error) {
+	return m.MockUpdateOriginAccessControlWithContext(ctx, input, opts)
+}
+
+// DeleteOriginAccessControlWithContext mocks DeleteOriginAccessControlWithContext method
+func (m *MockClient) DeleteOriginAccessControlWithContext(ctx context.Context, input *cloudfront.DeleteOriginAccessControlInput, opts ...request.Option) (*cloudfront.DeleteOriginAccessControlOutput, error) {
+	return m.MockDeleteOriginAccessControlWithContext(ctx, input, opts)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const errGetCodeConfigMap = "cannot get the code ConfigMap"
+
+// GetFunctionCode returns the code of the function, read from the referenced
+// ConfigMap if there is one.
+func GetFunctionCode(ctx context.Context, kube client.Reader, p v1alpha1.FunctionParameters) ([]byte, error) {
+	if ref := p.CodeConfigMapRef; ref != nil {
+		data, err := awsclients.GetConfigMapData(ctx, kube, *ref)
+		return data, errors.Wrap(err, errGetCodeConfigMap)
+	}
+	return []byte(aws.StringValue(p.Code)), nil
+}
+
+// GenerateFunctionConfig returns the configuration of the given function.
+func GenerateFunctionConfig(p v1alpha1.FunctionParameters) *cloudfront.FunctionConfig {
+	c := &cloudfront.FunctionConfig{
+		Comment: aws.String(aws.StringValue(p.Comment)),
+		Runtime: aws.String(p.Runtime),
+	}
+	if p.KeyValueStoreARN != nil {
+		c.KeyValueStoreAssociations = &cloudfront.KeyValueStoreAssociations{
+			Quantity: aws.Int64(1),
+			Items:    []*cloudfront.KeyValueStoreAssociation{{KeyValueStoreARN: p.KeyValueStoreARN}},
+		}
+	}
+	return c
+}
+
+// keyValueStoreARN returns the ARN of the key value store associated with a
+// stage of the function, if any.
+func keyValueStoreARN(c *cloudfront.FunctionConfig) string {
+	if c.KeyValueStoreAssociations == nil || len(c.KeyValueStoreAssociations.Items) == 0 {
+		return ""
+	}
+	return aws.StringValue(c.KeyValueStoreAssociations.Items[0].KeyValueStoreARN)
+}
+
+// GenerateFunctionObservation returns the observation of the DEVELOPMENT
+// stage of the given function.
+func GenerateFunctionObservation(o *cloudfront.DescribeFunctionOutput) v1alpha1.FunctionObservation {
+	obs := v1alpha1.FunctionObservation{ETag: aws.StringValue(o.ETag)}
+	if o.FunctionSummary == nil {
+		return obs
+	}
+	obs.Status = aws.StringValue(o.FunctionSummary.Status)
+	if o.FunctionSummary.FunctionMetadata != nil {
+		obs.FunctionARN = aws.StringValue(o.FunctionSummary.FunctionMetadata.FunctionARN)
+	}
+	return obs
+}
+
+// IsFunctionConfigUpToDate returns true if the observed configuration of a
+// stage of the function matches the desired one.
+func IsFunctionConfigUpToDate(p v1alpha1.FunctionParameters, o *cloudfront.DescribeFunctionOutput) bool {
+	if o.FunctionSummary == nil || o.FunctionSummary.FunctionConfig == nil {
+		return false
+	}
+	c := o.FunctionSummary.FunctionConfig
+	return aws.StringValue(p.Comment) == aws.StringValue(c.Comment) &&
+		p.Runtime == aws.StringValue(c.Runtime) &&
+		aws.StringValue(p.KeyValueStoreARN) == keyValueStoreARN(c)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+// GenerateCreateKeyValueStoreInput returns the input for CreateKeyValueStore.
+func GenerateCreateKeyValueStoreInput(name string, p v1alpha1.KeyValueStoreParameters) *cloudfront.CreateKeyValueStoreInput {
+	in := &cloudfront.CreateKeyValueStoreInput{
+		Name:    aws.String(name),
+		Comment: p.Comment,
+	}
+	if s := p.ImportSource; s != nil {
+		in.ImportSource = &cloudfront.ImportSource{
+			SourceType: aws.String(s.SourceType),
+			SourceARN:  aws.String(s.SourceARN),
+		}
+	}
+	return in
+}
+
+// GenerateKeyValueStoreObservation returns the observation of the given key
+// value store.
+func GenerateKeyValueStoreObservation(eTag *string, s *cloudfront.KeyValueStore) v1alpha1.KeyValueStoreObservation {
+	obs := v1alpha1.KeyValueStoreObservation{ETag: aws.StringValue(eTag)}
+	if s == nil {
+		return obs
+	}
+	obs.ARN = aws.StringValue(s.ARN)
+	obs.ID = aws.StringValue(s.Id)
+	obs.Status = aws.StringValue(s.Status)
+	return obs
+}
+
+// IsKeyValueStoreUpToDate returns true if the observed key value store
+// matches the desired one. Only its comment can be updated.
+func IsKeyValueStoreUpToDate(p v1alpha1.KeyValueStoreParameters, s *cloudfront.KeyValueStore) bool {
+	if s == nil {
+		return false
+	}
+	return aws.StringValue(p.Comment) == aws.StringValue(s.Comment)
+}
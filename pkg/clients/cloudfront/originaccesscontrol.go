@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+// GenerateOriginAccessControlConfig returns the configuration of the given
+// origin access control.
+func GenerateOriginAccessControlConfig(p v1alpha1.OriginAccessControlParameters) *cloudfront.OriginAccessControlConfig {
+	return &cloudfront.OriginAccessControlConfig{
+		Name:                          aws.String(p.Name),
+		Description:                   aws.String(aws.StringValue(p.Description)),
+		OriginAccessControlOriginType: aws.String(p.OriginType),
+		SigningBehavior:               aws.String(p.SigningBehavior),
+		SigningProtocol:               aws.String(p.SigningProtocol),
+	}
+}
+
+// IsOriginAccessControlUpToDate returns true if the observed configuration of
+// the origin access control matches the desired one.
+func IsOriginAccessControlUpToDate(p v1alpha1.OriginAccessControlParameters, c *cloudfront.OriginAccessControlConfig) bool {
+	if c == nil {
+		return false
+	}
+	return p.Name == aws.StringValue(c.Name) &&
+		aws.StringValue(p.Description) == aws.StringValue(c.Description) &&
+		p.OriginType == aws.StringValue(c.OriginAccessControlOriginType) &&
+		p.SigningBehavior == aws.StringValue(c.SigningBehavior) &&
+		p.SigningProtocol == aws.StringValue(c.SigningProtocol)
+}
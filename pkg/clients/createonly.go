@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyCreateOnlyFields is the annotation of a managed resource that
+// lists, separated by commas, the fields of its spec.forProvider that are only
+// used to create the external resource, e.g. "allocatedStorage" or
+// "scalingConfig.desiredSize". Once the resource exists they are neither
+// compared to nor updated from the spec, so that they may be changed outside
+// of Crossplane, for example by autoscaling or password rotation. Fields that
+// are late initialized take the observed values instead.
+const AnnotationKeyCreateOnlyFields = "aws.crossplane.io/create-only-fields"
+
+const errUnknownCreateOnlyField = "unknown create-only field"
+
+// createOnlyFields are the fields of a managed resource that are unset while it
+// is observed or updated.
+type createOnlyFields struct {
+	mg     resource.Managed
+	paths  [][]string
+	values []reflect.Value
+
+	// forProvider is a copy of spec.forProvider before the fields were
+	// unset.
+	forProvider reflect.Value
+}
+
+// unsetCreateOnlyFields unsets the create-only fields of the supplied managed
+// resource. Those that are still unset are restored by restore.
+func unsetCreateOnlyFields(mg resource.Managed) (*createOnlyFields, error) {
+	c := &createOnlyFields{mg: mg}
+	a := mg.GetAnnotations()[AnnotationKeyCreateOnlyFields]
+	if a == "" {
+		return c, nil
+	}
+	if cp, ok := mg.DeepCopyObject().(resource.Managed); ok {
+		c.forProvider = forProvider(cp)
+	}
+	for _, p := range strings.Split(a, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		path := strings.Split(p, ".")
+		f, err := fieldByJSONPath(forProvider(mg), path)
+		if err != nil {
+			c.restore(false)
+			return nil, errors.Wrap(err, errUnknownCreateOnlyField)
+		}
+		if !f.IsValid() {
+			continue
+		}
+		v := reflect.New(f.Type()).Elem()
+		v.Set(f)
+		f.Set(reflect.Zero(f.Type()))
+		c.paths = append(c.paths, path)
+		c.values = append(c.values, v)
+	}
+	return c, nil
+}
+
+// restore the create-only fields that were not late initialized, and returns
+// whether the spec was late initialized, given whether the managed resource
+// reported that it was. Fields that were late initialized to the values they
+// had before they were unset are not reported.
+func (c *createOnlyFields) restore(lateInitialized bool) bool {
+	for i, path := range c.paths {
+		f, _ := fieldByJSONPath(forProvider(c.mg), path)
+		if f.IsValid() && f.IsZero() {
+			f.Set(c.values[i])
+		}
+	}
+	if !lateInitialized || !c.forProvider.IsValid() {
+		return lateInitialized
+	}
+	return !reflect.DeepEqual(c.forProvider.Interface(), forProvider(c.mg).Interface())
+}
+
+// forProvider returns the spec.forProvider field of the supplied managed
+// resource, or an invalid value if it has none.
+func forProvider(mg resource.Managed) reflect.Value {
+	v := reflect.Indirect(reflect.ValueOf(mg)).FieldByName("Spec")
+	if !v.IsValid() {
+		return v
+	}
+	return v.FieldByName("ForProvider")
+}
+
+// fieldByJSONPath returns the field of the supplied struct at the supplied path
+// of JSON field names. An invalid value is returned if a struct along the path
+// is nil.
+func fieldByJSONPath(v reflect.Value, path []string) (reflect.Value, error) {
+	for i, name := range path {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, nil
+			}
+			v = v.Elem()
+		}
+		var f reflect.StructField
+		ok := v.Kind() == reflect.Struct
+		if ok {
+			f, ok = structFieldByJSONName(v.Type(), name)
+		}
+		if !ok {
+			return reflect.Value{}, errors.New(strings.Join(path[:i+1], "."))
+		}
+		v = v.FieldByIndex(f.Index)
+	}
+	return v, nil
+}
+
+func structFieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := strings.Split(f.Tag.Get("json"), ",")
+		if tag[0] == name {
+			return f, true
+		}
+		// Embedded structs, like custom parameters, are inlined.
+		if f.Anonymous && tag[0] == "" {
+			if ef, ok := structFieldByJSONName(f.Type, name); ok {
+				ef.Index = append([]int{i}, ef.Index...)
+				return ef, true
+			}
+		}
+	}
+	return reflect.StructField{}, false
+}
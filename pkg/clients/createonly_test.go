@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func TestCreateOnlyFields(t *testing.T) {
+	// observed returns a client that late initializes the IPv6 pool of a
+	// VPC to the supplied value, if any, and records the pool it saw.
+	observed := func(pool *string, lateInit bool, saw **string) managed.ExternalConnecter {
+		return managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return &managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+					cr := mg.(*ec2v1beta1.VPC)
+					*saw = cr.Spec.ForProvider.Ipv6Pool
+					if pool != nil && cr.Spec.ForProvider.Ipv6Pool == nil {
+						cr.Spec.ForProvider.Ipv6Pool = pool
+					}
+					return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: lateInit}, nil
+				},
+				UpdateFn: func(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+					*saw = mg.(*ec2v1beta1.VPC).Spec.ForProvider.Ipv6Pool
+					return managed.ExternalUpdate{}, nil
+				},
+			}, nil
+		})
+	}
+
+	type args struct {
+		fields   string
+		pool     *string
+		lateInit bool
+	}
+	type want struct {
+		err      error
+		saw      *string
+		pool     *string
+		lateInit bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"LateInitialized": {
+			args: args{fields: "ipv6Pool", pool: String("rotated"), lateInit: true},
+			want: want{pool: String("rotated"), lateInit: true},
+		},
+		"LateInitializedToDesiredValue": {
+			args: args{fields: "ipv6Pool", pool: String("initial"), lateInit: true},
+			want: want{pool: String("initial")},
+		},
+		"NotLateInitialized": {
+			args: args{fields: " ipv6Pool, enableDnsSupport"},
+			want: want{pool: String("initial")},
+		},
+		"NotCreateOnly": {
+			args: args{pool: String("rotated")},
+			want: want{saw: String("initial"), pool: String("initial")},
+		},
+		"UnknownField": {
+			args: args{fields: "ipv6Pool,ipv6Pools"},
+			want: want{err: errors.Wrap(errors.New("ipv6Pools"), errUnknownCreateOnlyField), pool: String("initial")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &ec2v1beta1.VPC{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{AnnotationKeyCreateOnlyFields: tc.args.fields},
+			}}
+			cr.Spec.ForProvider.Ipv6Pool = String("initial")
+			var saw *string
+			ext, _ := WrapExternal(&test.MockClient{}, observed(tc.args.pool, tc.args.lateInit, &saw)).Connect(context.Background(), cr)
+
+			o, err := ext.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.saw, saw); diff != "" {
+				t.Errorf("Observe(...): -want pool, +got pool:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.lateInit, o.ResourceLateInitialized); diff != "" {
+				t.Errorf("Observe(...): -want late initialized, +got late initialized:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pool, cr.Spec.ForProvider.Ipv6Pool); diff != "" {
+				t.Errorf("spec: -want pool, +got pool:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+
+			saw = nil
+			if _, err := ext.Update(context.Background(), cr); err != nil {
+				t.Fatalf("Update(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.saw, saw); diff != "" {
+				t.Errorf("Update(...): -want pool, +got pool:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pool, cr.Spec.ForProvider.Ipv6Pool); diff != "" {
+				t.Errorf("spec: -want pool, +got pool:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFieldByJSONPath(t *testing.T) {
+	p := &driftParameters{Size: 3}
+	cases := map[string]struct {
+		path    []string
+		want    interface{}
+		invalid bool
+		err     error
+	}{
+		"Field":        {path: []string{"size"}, want: 3},
+		"NilParent":    {path: []string{"nested", "enabled"}, invalid: true},
+		"NotAnObject":  {path: []string{"size", "value"}, err: errors.New("size.value")},
+		"UnknownField": {path: []string{"sizes"}, err: errors.New("sizes")},
+		"PointerField": {path: []string{"name"}, want: (*string)(nil)},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f, err := fieldByJSONPath(reflect.ValueOf(p), tc.path)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("fieldByJSONPath(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.invalid, !f.IsValid()); diff != "" {
+				t.Errorf("fieldByJSONPath(...): -want invalid, +got invalid:\n%s", diff)
+			}
+			if f.IsValid() {
+				if diff := cmp.Diff(tc.want, f.Interface()); diff != "" {
+					t.Errorf("fieldByJSONPath(...): -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
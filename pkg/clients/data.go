@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	errFmtNoConfigMapKey = "ConfigMap %s/%s has no key %s"
+	errFmtNoSecretKey    = "Secret %s/%s has no key %s"
+)
+
+// GetConfigMapData returns the value of the key selected by the supplied
+// reference, looking it up in the binary data of the ConfigMap first. A key
+// that the ConfigMap doesn't have is an error.
+func GetConfigMapData(ctx context.Context, kube client.Reader, ref v1beta1.ConfigMapKeySelector) ([]byte, error) {
+	cm := &corev1.ConfigMap{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return nil, err
+	}
+	if data, ok := cm.BinaryData[ref.Key]; ok {
+		return data, nil
+	}
+	if s, ok := cm.Data[ref.Key]; ok {
+		return []byte(s), nil
+	}
+	return nil, errors.Errorf(errFmtNoConfigMapKey, ref.Namespace, ref.Name, ref.Key)
+}
+
+// GetSecretData returns the value of the key selected by the supplied
+// reference. A key that the Secret doesn't have is an error.
+func GetSecretData(ctx context.Context, kube client.Reader, ref xpv1.SecretKeySelector) ([]byte, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, err
+	}
+	data, ok := s.Data[ref.Key]
+	if !ok {
+		return nil, errors.Errorf(errFmtNoSecretKey, ref.Namespace, ref.Name, ref.Key)
+	}
+	return data, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestGetConfigMapData(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := v1beta1.ConfigMapKeySelector{Name: "cloud-init", Namespace: "default", Key: "user-data"}
+
+	type want struct {
+		data []byte
+		err  error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		want want
+	}{
+		"Data": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"user-data": "echo hi"}
+					return nil
+				},
+			},
+			want: want{data: []byte("echo hi")},
+		},
+		"BinaryDataFirst": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"user-data": "echo hi"}
+					obj.(*corev1.ConfigMap).BinaryData = map[string][]byte{"user-data": []byte("echo bye")}
+					return nil
+				},
+			},
+			want: want{data: []byte("echo bye")},
+		},
+		"GetError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{err: errBoom},
+		},
+		"KeyMissing": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"other": "echo hi"}
+					return nil
+				},
+			},
+			want: want{err: errors.Errorf(errFmtNoConfigMapKey, "default", "cloud-init", "user-data")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := GetConfigMapData(context.Background(), tc.kube, ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nGetConfigMapData(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.data, data); diff != "" {
+				t.Errorf("\nGetConfigMapData(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetSecretData(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "cloud-init", Namespace: "default"}, Key: "user-data"}
+
+	type want struct {
+		data []byte
+		err  error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		want want
+	}{
+		"Data": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"user-data": []byte("echo hi")}
+					return nil
+				},
+			},
+			want: want{data: []byte("echo hi")},
+		},
+		"GetError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{err: errBoom},
+		},
+		"KeyMissing": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"other": []byte("echo hi")}
+					return nil
+				},
+			},
+			want: want{err: errors.Errorf(errFmtNoSecretKey, "default", "cloud-init", "user-data")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := GetSecretData(context.Background(), tc.kube, ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nGetSecretData(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.data, data); diff != "" {
+				t.Errorf("\nGetSecretData(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
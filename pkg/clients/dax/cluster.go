@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dax
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateClusterInput returns the input to create the cluster with the
+// given name.
+func GenerateCreateClusterInput(name string, p v1alpha1.ClusterParameters) *dax.CreateClusterInput {
+	in := &dax.CreateClusterInput{
+		ClusterName:                   aws.String(name),
+		Description:                   p.Description,
+		NodeType:                      aws.String(p.NodeType),
+		ReplicationFactor:             aws.Int64(p.ReplicationFactor),
+		AvailabilityZones:             aws.StringSlice(p.AvailabilityZones),
+		IamRoleArn:                    p.IAMRoleARN,
+		SubnetGroupName:               p.SubnetGroupName,
+		ParameterGroupName:            p.ParameterGroupName,
+		SecurityGroupIds:              aws.StringSlice(p.SecurityGroupIDs),
+		NotificationTopicArn:          p.NotificationTopicARN,
+		PreferredMaintenanceWindow:    p.PreferredMaintenanceWindow,
+		ClusterEndpointEncryptionType: p.ClusterEndpointEncryptionType,
+		Tags:                          GenerateTags(p.Tags),
+	}
+	if len(p.AvailabilityZones) == 0 {
+		in.AvailabilityZones = nil
+	}
+	if len(p.SecurityGroupIDs) == 0 {
+		in.SecurityGroupIds = nil
+	}
+	if p.SSESpecification != nil {
+		in.SSESpecification = &dax.SSESpecification{Enabled: aws.Bool(p.SSESpecification.Enabled)}
+	}
+	return in
+}
+
+// GenerateUpdateClusterInput returns the input to update the settings of the
+// cluster with the given name that can be changed in place.
+func GenerateUpdateClusterInput(name string, p v1alpha1.ClusterParameters) *dax.UpdateClusterInput {
+	in := &dax.UpdateClusterInput{
+		ClusterName:                aws.String(name),
+		Description:                p.Description,
+		ParameterGroupName:         p.ParameterGroupName,
+		SecurityGroupIds:           aws.StringSlice(p.SecurityGroupIDs),
+		NotificationTopicArn:       p.NotificationTopicARN,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+	}
+	if len(p.SecurityGroupIDs) == 0 {
+		in.SecurityGroupIds = nil
+	}
+	return in
+}
+
+// GenerateClusterObservation returns the observation of the given cluster.
+func GenerateClusterObservation(c *dax.Cluster) v1alpha1.ClusterObservation {
+	o := v1alpha1.ClusterObservation{
+		ClusterARN:  aws.StringValue(c.ClusterArn),
+		Status:      aws.StringValue(c.Status),
+		TotalNodes:  aws.Int64Value(c.TotalNodes),
+		ActiveNodes: aws.Int64Value(c.ActiveNodes),
+	}
+	if c.ClusterDiscoveryEndpoint != nil {
+		o.ClusterDiscoveryEndpoint = aws.StringValue(c.ClusterDiscoveryEndpoint.Address)
+	}
+	return o
+}
+
+func securityGroupIDs(c *dax.Cluster) []string {
+	if len(c.SecurityGroups) == 0 {
+		return nil
+	}
+	res := make([]string, len(c.SecurityGroups))
+	for i, sg := range c.SecurityGroups {
+		res[i] = aws.StringValue(sg.SecurityGroupIdentifier)
+	}
+	return res
+}
+
+func notificationTopicARN(c *dax.Cluster) *string {
+	if c.NotificationConfiguration == nil {
+		return nil
+	}
+	return c.NotificationConfiguration.TopicArn
+}
+
+func parameterGroupName(c *dax.Cluster) *string {
+	if c.ParameterGroup == nil {
+		return nil
+	}
+	return c.ParameterGroup.ParameterGroupName
+}
+
+// LateInitializeCluster fills the empty fields of the given parameters with
+// the values of the observed cluster.
+func LateInitializeCluster(p *v1alpha1.ClusterParameters, c *dax.Cluster) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, c.Description)
+	p.IAMRoleARN = awsclient.LateInitializeStringPtr(p.IAMRoleARN, c.IamRoleArn)
+	p.SubnetGroupName = awsclient.LateInitializeStringPtr(p.SubnetGroupName, c.SubnetGroup)
+	p.ParameterGroupName = awsclient.LateInitializeStringPtr(p.ParameterGroupName, parameterGroupName(c))
+	p.NotificationTopicARN = awsclient.LateInitializeStringPtr(p.NotificationTopicARN, notificationTopicARN(c))
+	p.PreferredMaintenanceWindow = awsclient.LateInitializeStringPtr(p.PreferredMaintenanceWindow, c.PreferredMaintenanceWindow)
+	p.ClusterEndpointEncryptionType = awsclient.LateInitializeStringPtr(p.ClusterEndpointEncryptionType, c.ClusterEndpointEncryptionType)
+	if len(p.SecurityGroupIDs) == 0 {
+		p.SecurityGroupIDs = securityGroupIDs(c)
+	}
+}
+
+// IsReplicationFactorUpToDate returns whether the cluster has as many nodes
+// as desired.
+func IsReplicationFactorUpToDate(p v1alpha1.ClusterParameters, c *dax.Cluster) bool {
+	return p.ReplicationFactor == aws.Int64Value(c.TotalNodes)
+}
+
+// IsClusterSettingsUpToDate returns whether the settings of the cluster that
+// are changed by UpdateCluster match the desired ones.
+func IsClusterSettingsUpToDate(p v1alpha1.ClusterParameters, c *dax.Cluster) bool {
+	switch {
+	case aws.StringValue(p.Description) != aws.StringValue(c.Description),
+		aws.StringValue(p.ParameterGroupName) != aws.StringValue(parameterGroupName(c)),
+		aws.StringValue(p.NotificationTopicARN) != aws.StringValue(notificationTopicARN(c)),
+		aws.StringValue(p.PreferredMaintenanceWindow) != aws.StringValue(c.PreferredMaintenanceWindow):
+		return false
+	}
+	return cmp.Equal(sortedStrings(p.SecurityGroupIDs), sortedStrings(securityGroupIDs(c)), cmpopts.EquateEmpty())
+}
+
+// IsClusterUpToDate returns whether the observed cluster matches the desired
+// parameters, except for its tags.
+func IsClusterUpToDate(p v1alpha1.ClusterParameters, c *dax.Cluster) bool {
+	return IsReplicationFactorUpToDate(p, c) && IsClusterSettingsUpToDate(p, c)
+}
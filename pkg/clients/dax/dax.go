@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dax
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dax"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTags      = "cannot list tags of DAX resource"
+	errTagResource   = "cannot tag DAX resource"
+	errUntagResource = "cannot untag DAX resource"
+)
+
+// Client defines DAX Client operations
+type Client interface {
+	CreateClusterWithContext(ctx context.Context, input *dax.CreateClusterInput, opts ...request.Option) (*dax.CreateClusterOutput, error)
+	DescribeClustersWithContext(ctx context.Context, input *dax.DescribeClustersInput, opts ...request.Option) (*dax.DescribeClustersOutput, error)
+	UpdateClusterWithContext(ctx context.Context, input *dax.UpdateClusterInput, opts ...request.Option) (*dax.UpdateClusterOutput, error)
+	DeleteClusterWithContext(ctx context.Context, input *dax.DeleteClusterInput, opts ...request.Option) (*dax.DeleteClusterOutput, error)
+	IncreaseReplicationFactorWithContext(ctx context.Context, input *dax.IncreaseReplicationFactorInput, opts ...request.Option) (*dax.IncreaseReplicationFactorOutput, error)
+	DecreaseReplicationFactorWithContext(ctx context.Context, input *dax.DecreaseReplicationFactorInput, opts ...request.Option) (*dax.DecreaseReplicationFactorOutput, error)
+
+	CreateParameterGroupWithContext(ctx context.Context, input *dax.CreateParameterGroupInput, opts ...request.Option) (*dax.CreateParameterGroupOutput, error)
+	DescribeParameterGroupsWithContext(ctx context.Context, input *dax.DescribeParameterGroupsInput, opts ...request.Option) (*dax.DescribeParameterGroupsOutput, error)
+	DescribeParametersWithContext(ctx context.Context, input *dax.DescribeParametersInput, opts ...request.Option) (*dax.DescribeParametersOutput, error)
+	UpdateParameterGroupWithContext(ctx context.Context, input *dax.UpdateParameterGroupInput, opts ...request.Option) (*dax.UpdateParameterGroupOutput, error)
+	DeleteParameterGroupWithContext(ctx context.Context, input *dax.DeleteParameterGroupInput, opts ...request.Option) (*dax.DeleteParameterGroupOutput, error)
+
+	CreateSubnetGroupWithContext(ctx context.Context, input *dax.CreateSubnetGroupInput, opts ...request.Option) (*dax.CreateSubnetGroupOutput, error)
+	DescribeSubnetGroupsWithContext(ctx context.Context, input *dax.DescribeSubnetGroupsInput, opts ...request.Option) (*dax.DescribeSubnetGroupsOutput, error)
+	UpdateSubnetGroupWithContext(ctx context.Context, input *dax.UpdateSubnetGroupInput, opts ...request.Option) (*dax.UpdateSubnetGroupOutput, error)
+	DeleteSubnetGroupWithContext(ctx context.Context, input *dax.DeleteSubnetGroupInput, opts ...request.Option) (*dax.DeleteSubnetGroupOutput, error)
+
+	ListTagsWithContext(ctx context.Context, input *dax.ListTagsInput, opts ...request.Option) (*dax.ListTagsOutput, error)
+	TagResourceWithContext(ctx context.Context, input *dax.TagResourceInput, opts ...request.Option) (*dax.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *dax.UntagResourceInput, opts ...request.Option) (*dax.UntagResourceOutput, error)
+}
+
+// NewClient returns a new DAX client using the given session.
+func NewClient(sess *session.Session) Client {
+	return dax.New(sess)
+}
+
+// IsClusterNotFound returns true if the error is because the cluster doesn't
+// exist.
+func IsClusterNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == dax.ErrCodeClusterNotFoundFault
+}
+
+// IsParameterGroupNotFound returns true if the error is because the
+// parameter group doesn't exist.
+func IsParameterGroupNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == dax.ErrCodeParameterGroupNotFoundFault
+}
+
+// IsSubnetGroupNotFound returns true if the error is because the subnet group
+// doesn't exist.
+func IsSubnetGroupNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == dax.ErrCodeSubnetGroupNotFoundFault
+}
+
+// GenerateTags converts the given tag map to DAX tags, sorted by key.
+func GenerateTags(tags map[string]string) []*dax.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	res := make([]*dax.Tag, len(keys))
+	for i, k := range keys {
+		res[i] = &dax.Tag{Key: aws.String(k), Value: aws.String(tags[k])}
+	}
+	return res
+}
+
+// ListTags returns the tags of the resource with the given ARN.
+func ListTags(ctx context.Context, client Client, arn string) (map[string]string, error) {
+	var res map[string]string
+	input := &dax.ListTagsInput{ResourceName: aws.String(arn)}
+	for {
+		resp, err := client.ListTagsWithContext(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errListTags)
+		}
+		for _, t := range resp.Tags {
+			if res == nil {
+				res = map[string]string{}
+			}
+			res[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+		if aws.StringValue(resp.NextToken) == "" {
+			return res, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+// UpdateTags makes the tags of the resource with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, client Client, arn string, desired, observed map[string]string) error {
+	add, remove := awsclient.DiffTags(desired, observed)
+	if len(remove) > 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &dax.UntagResourceInput{
+			ResourceName: aws.String(arn),
+			TagKeys:      aws.StringSlice(remove),
+		}); err != nil {
+			return awsclient.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResourceWithContext(ctx, &dax.TagResourceInput{
+			ResourceName: aws.String(arn),
+			Tags:         GenerateTags(add),
+		}); err != nil {
+			return awsclient.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
+
+// sortedStrings returns a sorted copy of the given strings.
+func sortedStrings(in []string) []string {
+	res := make([]string, len(in))
+	copy(res, in)
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dax
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+)
+
+func TestIsParameterGroupUpToDate(t *testing.T) {
+	observed := map[string]string{
+		"query-ttl-millis":  "300000",
+		"record-ttl-millis": "300000",
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ParameterGroupParameters
+		want bool
+	}{
+		"NoParameters": {
+			p:    v1alpha1.ParameterGroupParameters{},
+			want: true,
+		},
+		"SameValue": {
+			p: v1alpha1.ParameterGroupParameters{ParameterNameValues: []v1alpha1.ParameterNameValue{
+				{ParameterName: "query-ttl-millis", ParameterValue: "300000"},
+			}},
+			want: true,
+		},
+		"DifferentValue": {
+			p: v1alpha1.ParameterGroupParameters{ParameterNameValues: []v1alpha1.ParameterNameValue{
+				{ParameterName: "query-ttl-millis", ParameterValue: "300000"},
+				{ParameterName: "record-ttl-millis", ParameterValue: "60000"},
+			}},
+			want: false,
+		},
+		"UnknownParameter": {
+			p: v1alpha1.ParameterGroupParameters{ParameterNameValues: []v1alpha1.ParameterNameValue{
+				{ParameterName: "item-ttl-millis", ParameterValue: "60000"},
+			}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsParameterGroupUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSubnetGroupUpToDate(t *testing.T) {
+	observed := &dax.SubnetGroup{
+		Description: aws.String("private subnets"),
+		Subnets: []*dax.Subnet{
+			{SubnetIdentifier: aws.String("subnet-1")},
+			{SubnetIdentifier: aws.String("subnet-2")},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.SubnetGroupParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.SubnetGroupParameters{Description: aws.String("private subnets"), SubnetIDs: []string{"subnet-1", "subnet-2"}},
+			want: true,
+		},
+		"DifferentOrder": {
+			p:    v1alpha1.SubnetGroupParameters{Description: aws.String("private subnets"), SubnetIDs: []string{"subnet-2", "subnet-1"}},
+			want: true,
+		},
+		"SubnetRemoved": {
+			p:    v1alpha1.SubnetGroupParameters{Description: aws.String("private subnets"), SubnetIDs: []string{"subnet-1"}},
+			want: false,
+		},
+		"DescriptionChanged": {
+			p:    v1alpha1.SubnetGroupParameters{Description: aws.String("subnets"), SubnetIDs: []string{"subnet-1", "subnet-2"}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsSubnetGroupUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
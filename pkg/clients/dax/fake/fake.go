@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dax"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/dax"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateClusterWithContext             func(ctx context.Context, input *dax.CreateClusterInput, opts []request.Option) (*dax.CreateClusterOutput, error)
+	MockDescribeClustersWithContext          func(ctx context.Context, input *dax.DescribeClustersInput, opts []request.Option) (*dax.DescribeClustersOutput, error)
+	MockUpdateClusterWithContext             func(ctx context.Context, input *dax.UpdateClusterInput, opts []request.Option) (*dax.UpdateClusterOutput, error)
+	MockDeleteClusterWithContext             func(ctx context.Context, input *dax.DeleteClusterInput, opts []request.Option) (*dax.DeleteClusterOutput, error)
+	MockIncreaseReplicationFactorWithContext func(ctx context.Context, input *dax.IncreaseReplicationFactorInput, opts []request.Option) (*dax.IncreaseReplicationFactorOutput, error)
+	MockDecreaseReplicationFactorWithContext func(ctx context.Context, input *dax.DecreaseReplicationFactorInput, opts []request.Option) (*dax.DecreaseReplicationFactorOutput, error)
+	MockCreateParameterGroupWithContext      func(ctx context.Context, input *dax.CreateParameterGroupInput, opts []request.Option) (*dax.CreateParameterGroupOutput, error)
+	MockDescribeParameterGroupsWithContext   func(ctx context.Context, input *dax.DescribeParameterGroupsInput, opts []request.Option) (*dax.DescribeParameterGroupsOutput, error)
+	MockDescribeParametersWithContext        func(ctx context.Context, input *dax.DescribeParametersInput, opts []request.Option) (*dax.DescribeParametersOutput, error)
+	MockUpdateParameterGroupWithContext      func(ctx context.Context, input *dax.UpdateParameterGroupInput, opts []request.Option) (*dax.UpdateParameterGroupOutput, error)
+	MockDeleteParameterGroupWithContext      func(ctx context.Context, input *dax.DeleteParameterGroupInput, opts []request.Option) (*dax.DeleteParameterGroupOutput, error)
+	MockCreateSubnetGroupWithContext         func(ctx context.Context, input *dax.CreateSubnetGroupInput, opts []request.Option) (*dax.CreateSubnetGroupOutput, error)
+	MockDescribeSubnetGroupsWithContext      func(ctx context.Context, input *dax.DescribeSubnetGroupsInput, opts []request.Option) (*dax.DescribeSubnetGroupsOutput, error)
+	MockUpdateSubnetGroupWithContext         func(ctx context.Context, input *dax.UpdateSubnetGroupInput, opts []request.Option) (*dax.UpdateSubnetGroupOutput, error)
+	MockDeleteSubnetGroupWithContext         func(ctx context.Context, input *dax.DeleteSubnetGroupInput, opts []request.Option) (*dax.DeleteSubnetGroupOutput, error)
+	MockListTagsWithContext                  func(ctx context.Context, input *dax.ListTagsInput, opts []request.Option) (*dax.ListTagsOutput, error)
+	MockTagResourceWithContext               func(ctx context.Context, input *dax.TagResourceInput, opts []request.Option) (*dax.TagResourceOutput, error)
+	MockUntagResourceWithContext             func(ctx context.Context, input *dax.UntagResourceInput, opts []request.Option) (*dax.UntagResourceOutput, error)
+}
+
+// CreateClusterWithContext mocks CreateClusterWithContext method
+func (m *MockClient) CreateClusterWithContext(ctx context.Context, input *dax.CreateClusterInput, opts ...request.Option) (*dax.CreateClusterOutput, error) {
+	return m.MockCreateClusterWithContext(ctx, input, opts)
+}
+
+// DescribeClustersWithContext mocks DescribeClustersWithContext method
+func (m *MockClient) DescribeClustersWithContext(ctx context.Context, input *dax.DescribeClustersInput, opts ...request.Option) (*dax.DescribeClustersOutput, error) {
+	return m.MockDescribeClustersWithContext(ctx, input, opts)
+}
+
+// UpdateClusterWithContext mocks UpdateClusterWithContext method
+func (m *MockClient) UpdateClusterWithContext(ctx context.Context, input *dax.UpdateClusterInput, opts ...request.Option) (*dax.UpdateClusterOutput, error) {
+	return m.MockUpdateClusterWithContext(ctx, input, opts)
+}
+
+// DeleteClusterWithContext mocks DeleteClusterWithContext method
+func (m *MockClient) DeleteClusterWithContext(ctx context.Context, input *dax.DeleteClusterInput, opts ...request.Option) (*dax.DeleteClusterOutput, error) {
+	return m.MockDeleteClusterWithContext(ctx, input, opts)
+}
+
+// IncreaseReplicationFactorWithContext mocks IncreaseReplicationFactorWithContext method
+func (m *MockClient) IncreaseReplicationFactorWithContext(ctx context.Context, input *dax.IncreaseReplicationFactorInput, opts ...request.Option) (*dax.IncreaseReplicationFactorOutput, error) {
+	return m.MockIncreaseReplicationFactorWithContext(ctx, input, opts)
+}
+
+// DecreaseReplicationFactorWithContext mocks DecreaseReplicationFactorWithContext method
+func (m *MockClient) DecreaseReplicationFactorWithContext(ctx context.Context, input *dax.DecreaseReplicationFactorInput, opts ...request.Option) (*dax.DecreaseReplicationFactorOutput, error) {
+	return m.MockDecreaseReplicationFactorWithContext(ctx, input, opts)
+}
+
+// CreateParameterGroupWithContext mocks CreateParameterGroupWithContext method
+func (m *MockClient) CreateParameterGroupWithContext(ctx context.Context, input *dax.CreateParameterGroupInput, opts ...request.Option) (*dax.CreateParameterGroupOutput, error) {
+	return m.MockCreateParameterGroupWithContext(ctx, input, opts)
+}
+
+// DescribeParameterGroupsWithContext mocks DescribeParameterGroupsWithContext method
+func (m *MockClient) DescribeParameterGroupsWithContext(ctx context.Context, input *dax.DescribeParameterGroupsInput, opts ...request.Option) (*dax.DescribeParameterGroupsOutput, error) {
+	return m.MockDescribeParameterGroupsWithContext(ctx, input, opts)
+}
+
+// DescribeParametersWithContext mocks DescribeParametersWithContext method
+func (m *MockClient) DescribeParametersWithContext(ctx context.Context, input *dax.DescribeParametersInput, opts ...request.Option) (*dax.DescribeParametersOutput, error) {
+	return m.MockDescribeParametersWithContext(ctx, input, opts)
+}
+
+// UpdateParameterGroupWithContext mocks UpdateParameterGroupWithContext method
+func (m *MockClient) UpdateParameterGroupWithContext(ctx context.Context, input *dax.UpdateParameterGroupInput, opts ...request.Option) (*dax.UpdateParameterGroupOutput, error) {
+	return m.MockUpdateParameterGroupWithContext(ctx, input, opts)
+}
+
+// DeleteParameterGroupWithContext mocks DeleteParameterGroupWithContext method
+func (m *MockClient) DeleteParameterGroupWithContext(ctx context.Context, input *dax.DeleteParameterGroupInput, opts ...request.Option) (*dax.DeleteParameterGroupOutput, error) {
+	return m.MockDeleteParameterGroupWithContext(ctx, input, opts)
+}
+
+// CreateSubnetGroupWithContext mocks CreateSubnetGroupWithContext method
+func (m *MockClient) CreateSubnetGroupWithContext(ctx context.Context, input *dax.CreateSubnetGroupInput, opts ...request.Option) (*dax.CreateSubnetGroupOutput, error) {
+	return m.MockCreateSubnetGroupWithContext(ctx, input, opts)
+}
+
+// DescribeSubnetGroupsWithContext mocks DescribeSubnetGroupsWithContext method
+func (m *MockClient) DescribeSubnetGroupsWithContext(ctx context.Context, input *dax.DescribeSubnetGroupsInput, opts ...request.Option) (*dax.DescribeSubnetGroupsOutput, error) {
+	return m.MockDescribeSubnetGroupsWithContext(ctx, input, opts)
+}
+
+// UpdateSubnetGroupWithContext mocks UpdateSubnetGroupWithContext method
+func (m *MockClient) UpdateSubnetGroupWithContext(ctx context.Context, input *dax.UpdateSubnetGroupInput, opts ...request.Option) (*dax.UpdateSubnetGroupOutput, error) {
+	return m.MockUpdateSubnetGroupWithContext(ctx, input, opts)
+}
+
+// DeleteSubnetGroupWithContext mocks DeleteSubnetGroupWithContext method
+func (m *MockClient) DeleteSubnetGroupWithContext(ctx context.Context, input *dax.DeleteSubnetGroupInput, opts ...request.Option) (*dax.DeleteSubnetGroupOutput, error) {
+	return m.MockDeleteSubnetGroupWithContext(ctx, input, opts)
+}
+
+// ListTagsWithContext mocks ListTagsWithContext method
+func (m *MockClient) ListTagsWithContext(ctx context.Context, input *dax.ListTagsInput, opts ...request.Option) (*dax.ListTagsOutput, error) {
+	return m.MockListTagsWithContext(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *dax.TagResourceInput, opts ...request.Option) (*dax.TagResourceOutput, error) {
+	return m.MockTagResourceWithContext(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *dax.UntagResourceInput, opts ...request.Option) (*dax.UntagResourceOutput, error) {
+	return m.MockUntagResourceWithContext(ctx, input, opts)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dax
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// DescribeParameters returns the values of all parameters of the parameter
+// group with the given name, keyed by parameter name.
+func DescribeParameters(ctx context.Context, client Client, name string) (map[string]string, error) {
+	res := map[string]string{}
+	input := &dax.DescribeParametersInput{ParameterGroupName: aws.String(name)}
+	for {
+		resp, err := client.DescribeParametersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range resp.Parameters {
+			res[aws.StringValue(p.ParameterName)] = aws.StringValue(p.ParameterValue)
+		}
+		if aws.StringValue(resp.NextToken) == "" {
+			return res, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+// GenerateUpdateParameterGroupInput returns the input to set the desired
+// parameters of the parameter group with the given name.
+func GenerateUpdateParameterGroupInput(name string, p v1alpha1.ParameterGroupParameters) *dax.UpdateParameterGroupInput {
+	in := &dax.UpdateParameterGroupInput{
+		ParameterGroupName:  aws.String(name),
+		ParameterNameValues: make([]*dax.ParameterNameValue, len(p.ParameterNameValues)),
+	}
+	for i, v := range p.ParameterNameValues {
+		in.ParameterNameValues[i] = &dax.ParameterNameValue{
+			ParameterName:  aws.String(v.ParameterName),
+			ParameterValue: aws.String(v.ParameterValue),
+		}
+	}
+	return in
+}
+
+// LateInitializeParameterGroup fills the empty fields of the given parameters
+// with the values of the observed parameter group.
+func LateInitializeParameterGroup(p *v1alpha1.ParameterGroupParameters, g *dax.ParameterGroup) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, g.Description)
+}
+
+// IsParameterGroupUpToDate returns whether the desired parameters have the
+// observed values. Parameters that are not part of the spec are ignored.
+func IsParameterGroupUpToDate(p v1alpha1.ParameterGroupParameters, observed map[string]string) bool {
+	for _, v := range p.ParameterNameValues {
+		if cur, ok := observed[v.ParameterName]; !ok || cur != v.ParameterValue {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dax
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateSubnetGroupInput returns the input to create the subnet group
+// with the given name.
+func GenerateCreateSubnetGroupInput(name string, p v1alpha1.SubnetGroupParameters) *dax.CreateSubnetGroupInput {
+	return &dax.CreateSubnetGroupInput{
+		SubnetGroupName: aws.String(name),
+		Description:     p.Description,
+		SubnetIds:       aws.StringSlice(p.SubnetIDs),
+	}
+}
+
+// GenerateUpdateSubnetGroupInput returns the input to update the subnet group
+// with the given name.
+func GenerateUpdateSubnetGroupInput(name string, p v1alpha1.SubnetGroupParameters) *dax.UpdateSubnetGroupInput {
+	return &dax.UpdateSubnetGroupInput{
+		SubnetGroupName: aws.String(name),
+		Description:     p.Description,
+		SubnetIds:       aws.StringSlice(p.SubnetIDs),
+	}
+}
+
+func subnetIDs(g *dax.SubnetGroup) []string {
+	res := make([]string, len(g.Subnets))
+	for i, s := range g.Subnets {
+		res[i] = aws.StringValue(s.SubnetIdentifier)
+	}
+	return res
+}
+
+// LateInitializeSubnetGroup fills the empty fields of the given parameters
+// with the values of the observed subnet group.
+func LateInitializeSubnetGroup(p *v1alpha1.SubnetGroupParameters, g *dax.SubnetGroup) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, g.Description)
+	if len(p.SubnetIDs) == 0 && len(g.Subnets) != 0 {
+		p.SubnetIDs = subnetIDs(g)
+	}
+}
+
+// IsSubnetGroupUpToDate returns whether the observed subnet group matches the
+// desired parameters. The order of the subnets is ignored.
+func IsSubnetGroupUpToDate(p v1alpha1.SubnetGroupParameters, g *dax.SubnetGroup) bool {
+	if aws.StringValue(p.Description) != aws.StringValue(g.Description) {
+		return false
+	}
+	return cmp.Equal(sortedStrings(p.SubnetIDs), sortedStrings(subnetIDs(g)), cmpopts.EquateEmpty())
+}
@@ -0,0 +1,405 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// LabelKeyComposite is the label Crossplane adds to the resources composed by
+// a composite resource, whose value is the name of that composite resource.
+const LabelKeyComposite = "crossplane.io/composite"
+
+// TypeDeletionDeferred resources are being deleted, but their external
+// resource is kept until the resources that reference it are gone.
+const TypeDeletionDeferred xpv1.ConditionType = "DeletionDeferred"
+
+// Reasons a deletion is, or is no longer, deferred.
+const (
+	ReasonDependentsExist   xpv1.ConditionReason = "DependentsExist"
+	ReasonDependentsDeleted xpv1.ConditionReason = "DependentsDeleted"
+)
+
+const (
+	errListDependents  = "cannot list the resources that reference the managed resource"
+	errDependentsExist = "deletion deferred until the resources that reference the managed resource are deleted"
+)
+
+// DeletionDeferred returns a condition indicating that the external resource
+// will not be deleted while the supplied dependents exist.
+func DeletionDeferred(dependents []string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionDeferred,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDependentsExist,
+		Message:            "waiting for " + strings.Join(dependents, ", "),
+	}
+}
+
+// DependentsDeleted returns a condition indicating that the external resource
+// is deleted since nothing references it anymore.
+func DependentsDeleted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionDeferred,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDependentsDeleted,
+	}
+}
+
+// DeferDeletion wraps the supplied connecter so that the external resource of
+// a composed managed resource is not deleted while other resources composed
+// by the same composite resource reference it, e.g. a DBSubnetGroup while the
+// DBCluster that resolved a reference to it still exists. Deleting it first
+// would only fail with a DependencyViolation until the dependent is gone.
+func DeferDeletion(kube client.Client, c managed.ExternalConnecter) managed.ExternalConnecter {
+	s := kube.Scheme()
+	return &deletionDeferringConnecter{kube: kube, scheme: s, kinds: referencingKinds(s), connecter: c}
+}
+
+type deletionDeferringConnecter struct {
+	kube      client.Client
+	scheme    *runtime.Scheme
+	kinds     []schema.GroupVersionKind
+	connecter managed.ExternalConnecter
+}
+
+func (c *deletionDeferringConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ext, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &deletionDeferringExternal{ExternalClient: ext, kube: c.kube, scheme: c.scheme, kinds: c.kinds}, nil
+}
+
+type deletionDeferringExternal struct {
+	managed.ExternalClient
+	kube   client.Client
+	scheme *runtime.Scheme
+	kinds  []schema.GroupVersionKind
+}
+
+func (e *deletionDeferringExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	deps, err := e.dependents(ctx, mg)
+	if err != nil {
+		return errors.Wrap(err, errListDependents)
+	}
+	if len(deps) > 0 {
+		mg.SetConditions(DeletionDeferred(deps))
+		return errors.Errorf("%s: %s", errDependentsExist, strings.Join(deps, ", "))
+	}
+	if mg.GetCondition(TypeDeletionDeferred).Status == corev1.ConditionTrue {
+		mg.SetConditions(DependentsDeleted())
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
+
+// dependents returns the kind and name of the resources composed by the same
+// composite resource as the supplied one that reference it. Resources that
+// weren't composed are deleted right away.
+func (e *deletionDeferringExternal) dependents(ctx context.Context, mg resource.Managed) ([]string, error) {
+	xr, ok := mg.GetLabels()[LabelKeyComposite]
+	if !ok {
+		return nil, nil
+	}
+	var deps []string
+	for _, gvk := range e.kinds {
+		o, err := e.scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err != nil {
+			return nil, err
+		}
+		l, ok := o.(client.ObjectList)
+		if !ok {
+			continue
+		}
+		if err := e.kube.List(ctx, l, client.MatchingLabels{LabelKeyComposite: xr}); err != nil {
+			return nil, err
+		}
+		items, err := apimeta.ExtractList(l)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range items {
+			d, ok := i.(resource.Managed)
+			if !ok || d.GetUID() == mg.GetUID() {
+				continue
+			}
+			// Resources that reference each other would wait for each
+			// other forever, so neither is a dependent of the other.
+			if references(ctx, e.scheme, d, mg) && !references(ctx, e.scheme, mg, d) {
+				deps = append(deps, gvk.Kind+"/"+d.GetName())
+			}
+		}
+	}
+	sort.Strings(deps)
+	return deps, nil
+}
+
+var (
+	referenceType = reflect.TypeOf(xpv1.Reference{})
+
+	kindsMu sync.Mutex
+	kinds   = map[*runtime.Scheme][]schema.GroupVersionKind{}
+)
+
+// referencingKinds returns the managed resource kinds known to the supplied
+// scheme that can reference other managed resources, at their newest version.
+// They are looked up once per scheme, since every controller asks for them.
+func referencingKinds(s *runtime.Scheme) []schema.GroupVersionKind {
+	if s == nil {
+		return nil
+	}
+	kindsMu.Lock()
+	defer kindsMu.Unlock()
+	if k, ok := kinds[s]; ok {
+		return k
+	}
+	newest := map[schema.GroupKind]string{}
+	for gvk, t := range s.AllKnownTypes() {
+		if _, ok := reflect.New(t).Interface().(resource.Managed); !ok {
+			continue
+		}
+		spec, ok := t.FieldByName("Spec")
+		if !ok {
+			continue
+		}
+		fp, ok := spec.Type.FieldByName("ForProvider")
+		if !ok || !hasReference(fp.Type, map[reflect.Type]bool{}) {
+			continue
+		}
+		if v, ok := newest[gvk.GroupKind()]; ok && version.CompareKubeAwareVersionStrings(v, gvk.Version) < 0 {
+			continue
+		}
+		newest[gvk.GroupKind()] = gvk.Version
+	}
+	k := make([]schema.GroupVersionKind, 0, len(newest))
+	for gk, v := range newest {
+		k = append(k, gk.WithVersion(v))
+	}
+	sort.Slice(k, func(i, j int) bool { return k[i].String() < k[j].String() })
+	kinds[s] = k
+	return k
+}
+
+func hasReference(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if t == referenceType {
+		return true
+	}
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return hasReference(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if hasReference(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// referenceResolver is implemented by the managed resources that can
+// reference other managed resources.
+type referenceResolver interface {
+	ResolveReferences(ctx context.Context, c client.Reader) error
+}
+
+// references returns true if the parameters of from have a reference to to.
+// The references to its ProviderConfig and connection secret are not of
+// interest. A reference only holds the name of the resource it points to, so
+// the kind of every reference with the name of to is learned by resolving it
+// on its own. If that isn't possible the name has to do.
+func references(ctx context.Context, s *runtime.Scheme, from, to resource.Managed) bool {
+	v := forProvider(from)
+	if !v.IsValid() {
+		return false
+	}
+	n := countReferencesTo(v, to.GetName(), map[uintptr]bool{})
+	if n == 0 {
+		return false
+	}
+	gvk, err := apiutil.GVKForObject(to, s)
+	if err != nil {
+		return true
+	}
+	for i := 0; i < n; i++ {
+		gk, ok := referencedKind(ctx, s, from, to.GetName(), i)
+		if !ok || gk == gvk.GroupKind() {
+			return true
+		}
+	}
+	return false
+}
+
+// countReferencesTo returns the number of references to a resource with the
+// supplied name in the supplied value. Pointers that were seen already are not
+// followed again.
+func countReferencesTo(v reflect.Value, name string, seen map[uintptr]bool) int {
+	if v.Type() == referenceType {
+		if v.Interface().(xpv1.Reference).Name == name {
+			return 1
+		}
+		return 0
+	}
+	n := 0
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr:
+		if v.IsNil() || seen[v.Pointer()] {
+			return 0
+		}
+		seen[v.Pointer()] = true
+		n = countReferencesTo(v.Elem(), name, seen)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			n += countReferencesTo(v.Index(i), name, seen)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			n += countReferencesTo(v.MapIndex(k), name, seen)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			n += countReferencesTo(v.Field(i), name, seen)
+		}
+	}
+	return n
+}
+
+// errReferenceRecorded stops the resolution of a reference once its kind is
+// recorded.
+var errReferenceRecorded = errors.New("reference recorded")
+
+// referenceRecorder is a client.Reader that records the kind of the first
+// resource it is asked for, without getting it.
+type referenceRecorder struct {
+	scheme   *runtime.Scheme
+	kind     schema.GroupKind
+	recorded bool
+}
+
+func (r *referenceRecorder) Get(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	if r.recorded {
+		return errReferenceRecorded
+	}
+	gvk, err := apiutil.GVKForObject(obj, r.scheme)
+	if err != nil {
+		return err
+	}
+	r.kind, r.recorded = gvk.GroupKind(), true
+	return errReferenceRecorded
+}
+
+func (r *referenceRecorder) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	return errReferenceRecorded
+}
+
+// referencedKind returns the kind of the nth reference to a resource with the
+// supplied name in the parameters of the supplied managed resource. It is
+// resolved on a copy of the managed resource whose parameters hold nothing but
+// that reference, so that it is the only one its resolver asks for.
+func referencedKind(ctx context.Context, s *runtime.Scheme, mg resource.Managed, name string, n int) (schema.GroupKind, bool) {
+	c, ok := mg.DeepCopyObject().(resource.Managed)
+	if !ok {
+		return schema.GroupKind{}, false
+	}
+	rr, ok := c.(referenceResolver)
+	if !ok {
+		return schema.GroupKind{}, false
+	}
+	// References aren't resolved for resources that are being deleted.
+	c.SetDeletionTimestamp(nil)
+	isolateReference(forProvider(c), name, &n)
+	r := &referenceRecorder{scheme: s}
+	_ = rr.ResolveReferences(ctx, r)
+	return r.kind, r.recorded
+}
+
+// isolateReference zeroes everything in the supplied value but the nth
+// reference to a resource with the supplied name, counting n down as it visits
+// them. Values are zeroed so that none is mistaken for a resolved reference.
+func isolateReference(v reflect.Value, name string, n *int) {
+	if !v.CanSet() {
+		return
+	}
+	if !hasReference(v.Type(), map[reflect.Type]bool{}) {
+		v.Set(reflect.Zero(v.Type()))
+		return
+	}
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr:
+		switch {
+		case v.IsNil():
+		case v.Type().Elem() == referenceType:
+			if !isNthReference(v.Elem(), name, n) {
+				v.Set(reflect.Zero(v.Type()))
+			}
+		default:
+			isolateReference(v.Elem(), name, n)
+		}
+	case reflect.Slice:
+		if v.Type().Elem() != referenceType {
+			for i := 0; i < v.Len(); i++ {
+				isolateReference(v.Index(i), name, n)
+			}
+			return
+		}
+		kept := reflect.MakeSlice(v.Type(), 0, 1)
+		for i := 0; i < v.Len(); i++ {
+			if isNthReference(v.Index(i), name, n) {
+				kept = reflect.Append(kept, v.Index(i))
+			}
+		}
+		v.Set(kept)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			isolateReference(v.Field(i), name, n)
+		}
+	default:
+		v.Set(reflect.Zero(v.Type()))
+	}
+}
+
+func isNthReference(v reflect.Value, name string, n *int) bool {
+	if v.Interface().(xpv1.Reference).Name != name {
+		return false
+	}
+	*n--
+	return *n == -1
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func TestDeletionDeferringExternal(t *testing.T) {
+	errBoom := errors.New("boom")
+	s := runtime.NewScheme()
+	if err := ec2v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	composed := map[string]string{LabelKeyComposite: "cool-xr"}
+	subnet := func(ref string) ec2v1beta1.Subnet {
+		return ec2v1beta1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{Name: "cool-subnet", UID: "subnet-uid", Labels: composed},
+			Spec: ec2v1beta1.SubnetSpec{
+				ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "cool-vpc"}},
+				ForProvider:  ec2v1beta1.SubnetParameters{VPCIDRef: &xpv1.Reference{Name: ref}},
+			},
+		}
+	}
+	securityGroup := func(name, ref string) *ec2v1beta1.SecurityGroup {
+		return &ec2v1beta1.SecurityGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-uid"), Labels: composed},
+			Spec: ec2v1beta1.SecurityGroupSpec{
+				ForProvider: ec2v1beta1.SecurityGroupParameters{
+					Ingress: []ec2v1beta1.IPPermission{{
+						UserIDGroupPairs: []ec2v1beta1.UserIDGroupPair{{GroupIDRef: &xpv1.Reference{Name: ref}}},
+					}},
+				},
+			},
+		}
+	}
+	withSecurityGroups := func(sgs ...ec2v1beta1.SecurityGroup) test.MockListFn {
+		return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			if l, ok := obj.(*ec2v1beta1.SecurityGroupList); ok {
+				l.Items = sgs
+			}
+			return nil
+		}
+	}
+	withSubnets := func(subnets ...ec2v1beta1.Subnet) test.MockListFn {
+		return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			if l, ok := obj.(*ec2v1beta1.SubnetList); ok {
+				l.Items = subnets
+			}
+			return nil
+		}
+	}
+
+	type args struct {
+		cr         resource.Managed
+		list       test.MockListFn
+		labels     map[string]string
+		conditions []xpv1.Condition
+	}
+	type want struct {
+		err     error
+		deleted bool
+		cond    xpv1.Condition
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotComposed": {
+			args: args{
+				list: withSubnets(subnet("cool-vpc")),
+			},
+			want: want{
+				deleted: true,
+				cond:    xpv1.Condition{Type: TypeDeletionDeferred, Status: corev1.ConditionUnknown},
+			},
+		},
+		"DependentExists": {
+			args: args{
+				list:   withSubnets(subnet("cool-vpc")),
+				labels: composed,
+			},
+			want: want{
+				err:  errors.Errorf("%s: %s", errDependentsExist, "Subnet/cool-subnet"),
+				cond: DeletionDeferred([]string{"Subnet/cool-subnet"}),
+			},
+		},
+		"OnlyOtherResourcesReferenced": {
+			args: args{
+				// The ProviderConfig reference of the subnet names the
+				// VPC too, but isn't a dependency on it.
+				list:   withSubnets(subnet("other-vpc")),
+				labels: composed,
+			},
+			want: want{
+				deleted: true,
+				cond:    xpv1.Condition{Type: TypeDeletionDeferred, Status: corev1.ConditionUnknown},
+			},
+		},
+		"OtherKindWithTheSameName": {
+			args: args{
+				// The subnet references a VPC, not the internet gateway
+				// that happens to have the same name.
+				cr:     &ec2v1beta1.InternetGateway{ObjectMeta: metav1.ObjectMeta{Name: "cool-vpc", UID: "igw-uid", Labels: composed}},
+				list:   withSubnets(subnet("cool-vpc")),
+				labels: composed,
+			},
+			want: want{
+				deleted: true,
+				cond:    xpv1.Condition{Type: TypeDeletionDeferred, Status: corev1.ConditionUnknown},
+			},
+		},
+		"ReferencedEachOther": {
+			args: args{
+				cr:   securityGroup("cool-sg", "other-sg"),
+				list: withSecurityGroups(*securityGroup("other-sg", "cool-sg")),
+			},
+			want: want{
+				deleted: true,
+				cond:    xpv1.Condition{Type: TypeDeletionDeferred, Status: corev1.ConditionUnknown},
+			},
+		},
+		"NestedDependentExists": {
+			args: args{
+				cr:   securityGroup("cool-sg", "unrelated-sg"),
+				list: withSecurityGroups(*securityGroup("other-sg", "cool-sg")),
+			},
+			want: want{
+				err:  errors.Errorf("%s: %s", errDependentsExist, "SecurityGroup/other-sg"),
+				cond: DeletionDeferred([]string{"SecurityGroup/other-sg"}),
+			},
+		},
+		"DependentsDeleted": {
+			args: args{
+				list:       withSubnets(),
+				labels:     composed,
+				conditions: []xpv1.Condition{DeletionDeferred([]string{"Subnet/cool-subnet"})},
+			},
+			want: want{
+				deleted: true,
+				cond:    DependentsDeleted(),
+			},
+		},
+		"ListFailed": {
+			args: args{
+				list:   test.NewMockListFn(errBoom),
+				labels: composed,
+			},
+			want: want{
+				err:  errors.Wrap(errBoom, errListDependents),
+				cond: xpv1.Condition{Type: TypeDeletionDeferred, Status: corev1.ConditionUnknown},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			e := &deletionDeferringExternal{
+				ExternalClient: &managed.ExternalClientFns{
+					DeleteFn: func(_ context.Context, _ resource.Managed) error {
+						deleted = true
+						return nil
+					},
+				},
+				kube:   &test.MockClient{MockList: tc.args.list},
+				scheme: s,
+				kinds:  referencingKinds(s),
+			}
+			cr := tc.args.cr
+			if cr == nil {
+				cr = &ec2v1beta1.VPC{ObjectMeta: metav1.ObjectMeta{Name: "cool-vpc", UID: "vpc-uid", Labels: tc.args.labels}}
+			}
+			cr.SetConditions(tc.args.conditions...)
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, cr.GetCondition(TypeDeletionDeferred), test.EquateConditions()); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReferencingKinds(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := ec2v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]bool{}
+	for _, gvk := range referencingKinds(s) {
+		got[gvk.Kind] = true
+	}
+	// A VPC references nothing, while a Subnet references its VPC.
+	if got["VPC"] || !got["Subnet"] {
+		t.Errorf("referencingKinds(...): want Subnet but not VPC, got %v", got)
+	}
+}
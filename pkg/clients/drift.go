@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TypeDrifted resources have fields whose desired value differs from the one
+// observed in AWS. The Synced condition can't tell which, since the reconciler
+// resets it whenever it updates the external resource.
+const TypeDrifted xpv1.ConditionType = "Drifted"
+
+// Reasons a resource has, or has no longer, drifted.
+const (
+	ReasonFieldsDiffer xpv1.ConditionReason = "FieldsDiffer"
+	ReasonUpToDate     xpv1.ConditionReason = "UpToDate"
+)
+
+const reasonDriftDetected event.Reason = "DriftDetected"
+
+const (
+	// maxDriftFields is the number of drifted fields listed in a message.
+	maxDriftFields = 10
+	// maxDriftValueLength is the length values are truncated to in a
+	// message. Policy documents easily exceed it.
+	maxDriftValueLength = 64
+
+	unset = "(unset)"
+)
+
+// Redacted replaces the values of sensitive fields in a drift report.
+const Redacted = "(redacted)"
+
+// sensitiveFields are the parts of the field names whose values are never
+// included in a drift report.
+var sensitiveFields = []string{"password", "secret", "token", "credential", "privatekey", "userdata"}
+
+// A Drift is a field whose desired value differs from the observed one.
+type Drift struct {
+	// Path of the field, using the JSON names of its parents.
+	Path     string
+	Desired  string
+	Observed string
+}
+
+// String returns a human-readable description of the drift.
+func (d Drift) String() string {
+	return fmt.Sprintf("%s: want %s, got %s", d.Path, d.Desired, d.Observed)
+}
+
+// DiffFields returns the fields whose values differ between desired and
+// observed, which must be of the same type, using the supplied options to
+// compare them. The values of sensitive fields, like passwords, are redacted.
+func DiffFields(desired, observed interface{}, opts ...cmp.Option) []Drift {
+	r := &driftReporter{}
+	cmp.Equal(desired, observed, append(opts, cmp.Reporter(r))...)
+	return r.drift
+}
+
+// IgnoreUnsetDesired ignores the fields that are unset in the desired value
+// passed to DiffFields, such as the fields left out of a patch.
+func IgnoreUnsetDesired() cmp.Option {
+	return cmp.FilterPath(func(p cmp.Path) bool {
+		d, _ := p.Last().Values()
+		return !d.IsValid() || isNil(d)
+	}, cmp.Ignore())
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+type driftReporter struct {
+	path  cmp.Path
+	drift []Drift
+	seen  map[string]bool
+}
+
+func (r *driftReporter) PushStep(ps cmp.PathStep) {
+	r.path = append(r.path, ps)
+}
+
+func (r *driftReporter) PopStep() {
+	r.path = r.path[:len(r.path)-1]
+}
+
+func (r *driftReporter) Report(rs cmp.Result) {
+	if rs.Equal() {
+		return
+	}
+	p, sensitive := fieldPath(r.path)
+	if r.seen[p] {
+		return
+	}
+	if r.seen == nil {
+		r.seen = map[string]bool{}
+	}
+	r.seen[p] = true
+	d, o := r.path.Last().Values()
+	drift := Drift{Path: p, Desired: Redacted, Observed: Redacted}
+	if !sensitive {
+		drift.Desired, drift.Observed = formatValue(d), formatValue(o)
+	}
+	r.drift = append(r.drift, drift)
+}
+
+// fieldPath returns the path of the field at the end of the supplied path, and
+// whether any field along it is sensitive.
+func fieldPath(p cmp.Path) (string, bool) {
+	b := &strings.Builder{}
+	sensitive := false
+	for i, ps := range p {
+		switch s := ps.(type) {
+		case cmp.StructField:
+			name := jsonName(p.Index(i-1).Type(), s.Name())
+			sensitive = sensitive || isSensitive(name)
+			if b.Len() > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(name)
+		case cmp.MapIndex:
+			fmt.Fprintf(b, "[%v]", s.Key())
+		case cmp.SliceIndex:
+			// Elements that exist on one side only have no index on
+			// the other.
+			k, o := s.SplitKeys()
+			if k < 0 {
+				k = o
+			}
+			fmt.Fprintf(b, "[%d]", k)
+		}
+	}
+	return b.String(), sensitive
+}
+
+// jsonName returns the JSON name of the named field of the supplied struct
+// type, or the name itself if it has none.
+func jsonName(t reflect.Type, name string) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	f, ok := t.FieldByName(name)
+	if !ok {
+		return name
+	}
+	if n := strings.Split(f.Tag.Get("json"), ",")[0]; n != "" && n != "-" {
+		return n
+	}
+	return name
+}
+
+func isSensitive(name string) bool {
+	n := strings.ToLower(name)
+	for _, s := range sensitiveFields {
+		if strings.Contains(n, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatValue(v reflect.Value) string {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return unset
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() || !v.CanInterface() {
+		return unset
+	}
+	s := fmt.Sprintf("%v", v.Interface())
+	if v.Kind() == reflect.String {
+		s = fmt.Sprintf("%q", v.String())
+	}
+	if len(s) > maxDriftValueLength {
+		s = s[:maxDriftValueLength] + "..."
+	}
+	return s
+}
+
+// Drifted returns a condition listing the supplied drifted fields.
+func Drifted(drift []Drift) xpv1.Condition {
+	fields := make([]string, 0, maxDriftFields)
+	for i, d := range drift {
+		if i == maxDriftFields {
+			fields = append(fields, fmt.Sprintf("and %d more", len(drift)-maxDriftFields))
+			break
+		}
+		fields = append(fields, d.String())
+	}
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonFieldsDiffer,
+		Message:            strings.Join(fields, "; "),
+	}
+}
+
+// NotDrifted returns a condition indicating that the desired state of the
+// resource matches the observed one.
+func NotDrifted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpToDate,
+	}
+}
+
+// RecordDrift records the supplied drifted fields of the managed resource in
+// its Drifted condition. Controllers call it while checking whether the
+// resource is up to date; it is reported in an event before it is corrected.
+func RecordDrift(mg resource.Managed, drift []Drift) {
+	if len(drift) > 0 {
+		mg.SetConditions(Drifted(drift))
+		return
+	}
+	if mg.GetCondition(TypeDrifted).Status == corev1.ConditionTrue {
+		mg.SetConditions(NotDrifted())
+	}
+}
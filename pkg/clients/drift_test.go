@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+type driftParameters struct {
+	Name           *string           `json:"name,omitempty"`
+	Size           int               `json:"size"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	Ports          []int             `json:"ports,omitempty"`
+	MasterPassword *string           `json:"masterPassword,omitempty"`
+	Nested         *driftNested      `json:"nested,omitempty"`
+}
+
+type driftNested struct {
+	Enabled bool `json:"enabled"`
+}
+
+func TestDiffFields(t *testing.T) {
+	type args struct {
+		desired  *driftParameters
+		observed *driftParameters
+		opts     []cmp.Option
+	}
+	cases := map[string]struct {
+		args args
+		want []Drift
+	}{
+		"UpToDate": {
+			args: args{
+				desired:  &driftParameters{Name: String("cool"), Size: 1},
+				observed: &driftParameters{Name: String("cool"), Size: 1},
+			},
+		},
+		"FieldsDiffer": {
+			args: args{
+				desired:  &driftParameters{Name: String("cool"), Size: 2, Nested: &driftNested{Enabled: true}},
+				observed: &driftParameters{Size: 1, Nested: &driftNested{}},
+			},
+			want: []Drift{
+				{Path: "name", Desired: `"cool"`, Observed: unset},
+				{Path: "size", Desired: "2", Observed: "1"},
+				{Path: "nested.enabled", Desired: "true", Observed: "false"},
+			},
+		},
+		"MapsAndSlices": {
+			args: args{
+				desired:  &driftParameters{Tags: map[string]string{"env": "prod"}, Ports: []int{80, 443}},
+				observed: &driftParameters{Tags: map[string]string{"env": "dev"}, Ports: []int{80}},
+			},
+			want: []Drift{
+				{Path: "tags[env]", Desired: `"prod"`, Observed: `"dev"`},
+				{Path: "ports[1]", Desired: "443", Observed: unset},
+			},
+		},
+		"SensitiveFieldRedacted": {
+			args: args{
+				desired:  &driftParameters{MasterPassword: String("hunter2")},
+				observed: &driftParameters{MasterPassword: String("hunter3")},
+			},
+			want: []Drift{
+				{Path: "masterPassword", Desired: Redacted, Observed: Redacted},
+			},
+		},
+		"IgnoreUnsetDesired": {
+			args: args{
+				desired:  &driftParameters{Size: 2},
+				observed: &driftParameters{Name: String("cool"), Size: 1, Tags: map[string]string{"env": "dev"}},
+				opts:     []cmp.Option{IgnoreUnsetDesired()},
+			},
+			want: []Drift{
+				{Path: "size", Desired: "2", Observed: "1"},
+			},
+		},
+		"OptionsRespected": {
+			args: args{
+				desired:  &driftParameters{Name: String("cool"), Size: 2},
+				observed: &driftParameters{Size: 1},
+				opts:     []cmp.Option{cmpopts.IgnoreFields(driftParameters{}, "Name")},
+			},
+			want: []Drift{
+				{Path: "size", Desired: "2", Observed: "1"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffFields(tc.args.desired, tc.args.observed, tc.args.opts...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DiffFields(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type recordedEvents []event.Event
+
+func (r *recordedEvents) Event(_ runtime.Object, e event.Event) { *r = append(*r, e) }
+
+func (r *recordedEvents) WithAnnotations(...string) event.Recorder { return r }
+
+func TestReportDrift(t *testing.T) {
+	drift := []Drift{{Path: "size", Desired: "2", Observed: "1"}}
+
+	type args struct {
+		upToDate   bool
+		drift      []Drift
+		conditions []xpv1.Condition
+	}
+	type want struct {
+		status corev1.ConditionStatus
+		events int
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Drifted": {
+			args: args{drift: drift},
+			want: want{status: corev1.ConditionTrue, events: 1},
+		},
+		"Corrected": {
+			args: args{upToDate: true, conditions: []xpv1.Condition{Drifted(drift)}},
+			want: want{status: corev1.ConditionFalse},
+		},
+		"NeverDrifted": {
+			args: args{upToDate: true},
+			want: want{status: corev1.ConditionUnknown},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &ec2v1beta1.VPC{}
+			cr.SetConditions(xpv1.ReconcileSuccess())
+			cr.SetConditions(tc.args.conditions...)
+			events := &recordedEvents{}
+			c := WrapExternal(&test.MockClient{}, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						RecordDrift(mg, tc.args.drift)
+						return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: tc.args.upToDate}, nil
+					},
+				}, nil
+			}), WithRecorder(events))
+			ext, _ := c.Connect(context.Background(), cr)
+			if _, err := ext.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("Observe(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.status, cr.GetCondition(TypeDrifted).Status); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, len(*events)); diff != "" {
+				t.Errorf("events: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,9 @@ const (
 	AddressAddressNotFound = "InvalidAddress.NotFound"
 	// AddressAllocationNotFound addreess not found by allocation
 	AddressAllocationNotFound = "InvalidAllocationID.NotFound"
+
+	// AmazonIPv4Pool is the ID of Amazon's pool of public IPv4 addresses.
+	AmazonIPv4Pool = "amazon"
 )
 
 // AddressClient is the external client used for ElasticIP Custom Resource
@@ -80,6 +83,27 @@ func IsStandardDomain(e v1beta1.AddressParameters) bool {
 	return e.Domain != nil && *e.Domain == *aws.String(string(ec2types.DomainTypeStandard))
 }
 
+// IsOwnedPoolAddress checks whether the address is allocated from an address
+// pool that is owned by the account, i.e. a BYOIP or customer-owned pool,
+// rather than from Amazon's pool.
+func IsOwnedPoolAddress(e v1beta1.AddressParameters) bool {
+	if aws.ToString(e.CustomerOwnedIPv4Pool) != "" {
+		return true
+	}
+	pool := aws.ToString(e.PublicIPv4Pool)
+	return pool != "" && pool != AmazonIPv4Pool
+}
+
+// IsAmazonPoolAddress checks whether the observed address was allocated from
+// Amazon's pool of IPv4 addresses.
+func IsAmazonPoolAddress(a ec2types.Address) bool {
+	if aws.ToString(a.CustomerOwnedIpv4Pool) != "" {
+		return false
+	}
+	pool := aws.ToString(a.PublicIpv4Pool)
+	return pool == "" || pool == AmazonIPv4Pool
+}
+
 // GenerateEC2Tags generates a tag array with type that EC2 client expects.
 func GenerateEC2Tags(tags []v1beta1.Tag) []ec2types.Tag {
 	res := make([]ec2types.Tag, len(tags))
@@ -0,0 +1,148 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// CapacityReservationIDNotFound is the code that is returned by ec2 when
+	// the given CapacityReservationID is not valid
+	CapacityReservationIDNotFound = "InvalidCapacityReservationId.NotFound"
+)
+
+// CapacityReservationClient is the external client used for
+// CapacityReservation Custom Resource
+type CapacityReservationClient interface {
+	CreateCapacityReservation(ctx context.Context, input *ec2.CreateCapacityReservationInput, opts ...func(*ec2.Options)) (*ec2.CreateCapacityReservationOutput, error)
+	DescribeCapacityReservations(ctx context.Context, input *ec2.DescribeCapacityReservationsInput, opts ...func(*ec2.Options)) (*ec2.DescribeCapacityReservationsOutput, error)
+	ModifyCapacityReservation(ctx context.Context, input *ec2.ModifyCapacityReservationInput, opts ...func(*ec2.Options)) (*ec2.ModifyCapacityReservationOutput, error)
+	CancelCapacityReservation(ctx context.Context, input *ec2.CancelCapacityReservationInput, opts ...func(*ec2.Options)) (*ec2.CancelCapacityReservationOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewCapacityReservationClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewCapacityReservationClient(cfg aws.Config) CapacityReservationClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsCapacityReservationNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsCapacityReservationNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == CapacityReservationIDNotFound
+}
+
+// GenerateCreateCapacityReservationInput returns the input to create the
+// supplied CapacityReservation.
+func GenerateCreateCapacityReservationInput(p manualv1alpha1.CapacityReservationParameters) *ec2.CreateCapacityReservationInput {
+	input := &ec2.CreateCapacityReservationInput{
+		AvailabilityZone:      aws.String(p.AvailabilityZone),
+		InstanceType:          aws.String(p.InstanceType),
+		InstancePlatform:      ec2types.CapacityReservationInstancePlatform(p.InstancePlatform),
+		InstanceCount:         aws.Int32(p.InstanceCount),
+		EbsOptimized:          p.EBSOptimized,
+		EphemeralStorage:      p.EphemeralStorage,
+		EndDateType:           ec2types.EndDateType(aws.ToString(p.EndDateType)),
+		InstanceMatchCriteria: ec2types.InstanceMatchCriteria(aws.ToString(p.InstanceMatchCriteria)),
+		Tenancy:               ec2types.CapacityReservationTenancy(aws.ToString(p.Tenancy)),
+	}
+	if p.EndDate != nil {
+		input.EndDate = &p.EndDate.Time
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeCapacityReservation,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// GenerateCapacityReservationObservation is used to produce
+// manualv1alpha1.CapacityReservationObservation from ec2types.CapacityReservation.
+func GenerateCapacityReservationObservation(cr ec2types.CapacityReservation) manualv1alpha1.CapacityReservationObservation {
+	return manualv1alpha1.CapacityReservationObservation{
+		CapacityReservationID:  aws.ToString(cr.CapacityReservationId),
+		CapacityReservationARN: aws.ToString(cr.CapacityReservationArn),
+		OwnerID:                aws.ToString(cr.OwnerId),
+		State:                  string(cr.State),
+		AvailableInstanceCount: cr.AvailableInstanceCount,
+		TotalInstanceCount:     cr.TotalInstanceCount,
+		StartDate:              FromTimePtr(cr.StartDate),
+	}
+}
+
+// LateInitializeCapacityReservation fills the empty fields in
+// *manualv1alpha1.CapacityReservationParameters with the values seen in
+// ec2types.CapacityReservation.
+func LateInitializeCapacityReservation(in *manualv1alpha1.CapacityReservationParameters, cr *ec2types.CapacityReservation) {
+	if cr == nil {
+		return
+	}
+	in.EBSOptimized = awsclients.LateInitializeBoolPtr(in.EBSOptimized, cr.EbsOptimized)
+	in.EphemeralStorage = awsclients.LateInitializeBoolPtr(in.EphemeralStorage, cr.EphemeralStorage)
+	in.EndDateType = awsclients.LateInitializeStringPtr(in.EndDateType, aws.String(string(cr.EndDateType)))
+	in.InstanceMatchCriteria = awsclients.LateInitializeStringPtr(in.InstanceMatchCriteria, aws.String(string(cr.InstanceMatchCriteria)))
+	in.Tenancy = awsclients.LateInitializeStringPtr(in.Tenancy, aws.String(string(cr.Tenancy)))
+	if in.EndDate == nil && cr.EndDate != nil {
+		in.EndDate = FromTimePtr(cr.EndDate)
+	}
+	if len(in.Tags) == 0 && len(cr.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(cr.Tags)
+	}
+}
+
+// IsCapacityReservationUpToDate checks whether there is a change in any of
+// the modifiable fields.
+func IsCapacityReservationUpToDate(p manualv1alpha1.CapacityReservationParameters, cr ec2types.CapacityReservation) bool {
+	return IsCapacityUpToDate(p, cr) && manualv1alpha1.CompareTags(p.Tags, cr.Tags)
+}
+
+// IsCapacityUpToDate checks whether the reserved instance count and the end of
+// the reservation are as desired.
+func IsCapacityUpToDate(p manualv1alpha1.CapacityReservationParameters, cr ec2types.CapacityReservation) bool {
+	if p.InstanceCount != aws.ToInt32(cr.TotalInstanceCount) {
+		return false
+	}
+	if p.EndDateType != nil && aws.ToString(p.EndDateType) != string(cr.EndDateType) {
+		return false
+	}
+	return isEndDateUpToDate(p.EndDate, cr.EndDate)
+}
+
+// isEndDateUpToDate compares the end dates at second precision, which is the
+// precision AWS keeps them with.
+func isEndDateUpToDate(desired *metav1.Time, observed *time.Time) bool {
+	if desired == nil {
+		return true
+	}
+	return observed != nil && desired.Time.Truncate(time.Second).Equal(observed.Truncate(time.Second))
+}
+
+// GenerateModifyCapacityReservationInput returns the input to bring the
+// instance count and end of the supplied reservation to the desired state.
+func GenerateModifyCapacityReservationInput(id string, p manualv1alpha1.CapacityReservationParameters) *ec2.ModifyCapacityReservationInput {
+	input := &ec2.ModifyCapacityReservationInput{
+		CapacityReservationId: aws.String(id),
+		InstanceCount:         aws.Int32(p.InstanceCount),
+		EndDateType:           ec2types.EndDateType(aws.ToString(p.EndDateType)),
+	}
+	// An end date may only be given for limited reservations.
+	if p.EndDate != nil && input.EndDateType != ec2types.EndDateTypeUnlimited {
+		input.EndDate = &p.EndDate.Time
+	}
+	return input
+}
@@ -0,0 +1,149 @@
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+var (
+	crID      = "cr-123"
+	crEndDate = time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func crSpec(count int32) manualv1alpha1.CapacityReservationParameters {
+	return manualv1alpha1.CapacityReservationParameters{
+		AvailabilityZone: "us-east-1a",
+		InstanceType:     "m5.large",
+		InstancePlatform: string(ec2types.CapacityReservationInstancePlatformLinuxUnix),
+		InstanceCount:    count,
+	}
+}
+
+func crObserved(count int32) ec2types.CapacityReservation {
+	return ec2types.CapacityReservation{
+		CapacityReservationId: aws.String(crID),
+		TotalInstanceCount:    aws.Int32(count),
+		EndDateType:           ec2types.EndDateTypeUnlimited,
+	}
+}
+
+func TestIsCapacityReservationUpToDate(t *testing.T) {
+	limited := func(p manualv1alpha1.CapacityReservationParameters, end time.Time) manualv1alpha1.CapacityReservationParameters {
+		p.EndDateType = aws.String(string(ec2types.EndDateTypeLimited))
+		p.EndDate = &metav1.Time{Time: end}
+		return p
+	}
+	limitedObserved := func(o ec2types.CapacityReservation, end time.Time) ec2types.CapacityReservation {
+		o.EndDateType = ec2types.EndDateTypeLimited
+		o.EndDate = &end
+		return o
+	}
+
+	cases := map[string]struct {
+		p    manualv1alpha1.CapacityReservationParameters
+		cr   ec2types.CapacityReservation
+		want bool
+	}{
+		"UpToDate": {
+			p:    crSpec(2),
+			cr:   crObserved(2),
+			want: true,
+		},
+		"InstanceCountChanged": {
+			p:    crSpec(4),
+			cr:   crObserved(2),
+			want: false,
+		},
+		"EndDateTypeChanged": {
+			p:    limited(crSpec(2), crEndDate),
+			cr:   crObserved(2),
+			want: false,
+		},
+		"EndDateWithinSecond": {
+			p:    limited(crSpec(2), crEndDate.Add(300*time.Millisecond)),
+			cr:   limitedObserved(crObserved(2), crEndDate),
+			want: true,
+		},
+		"EndDateChanged": {
+			p:    limited(crSpec(2), crEndDate.Add(time.Hour)),
+			cr:   limitedObserved(crObserved(2), crEndDate),
+			want: false,
+		},
+		"TagsChanged": {
+			p: func() manualv1alpha1.CapacityReservationParameters {
+				p := crSpec(2)
+				p.Tags = []manualv1alpha1.Tag{{Key: "k", Value: "v"}}
+				return p
+			}(),
+			cr:   crObserved(2),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCapacityReservationUpToDate(tc.p, tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyCapacityReservationInput(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.CapacityReservationParameters
+		want *ec2.ModifyCapacityReservationInput
+	}{
+		"InstanceCount": {
+			p: crSpec(3),
+			want: &ec2.ModifyCapacityReservationInput{
+				CapacityReservationId: aws.String(crID),
+				InstanceCount:         aws.Int32(3),
+			},
+		},
+		"Limited": {
+			p: func() manualv1alpha1.CapacityReservationParameters {
+				p := crSpec(3)
+				p.EndDateType = aws.String(string(ec2types.EndDateTypeLimited))
+				p.EndDate = &metav1.Time{Time: crEndDate}
+				return p
+			}(),
+			want: &ec2.ModifyCapacityReservationInput{
+				CapacityReservationId: aws.String(crID),
+				InstanceCount:         aws.Int32(3),
+				EndDateType:           ec2types.EndDateTypeLimited,
+				EndDate:               &crEndDate,
+			},
+		},
+		"UnlimitedDropsEndDate": {
+			p: func() manualv1alpha1.CapacityReservationParameters {
+				p := crSpec(3)
+				p.EndDateType = aws.String(string(ec2types.EndDateTypeUnlimited))
+				p.EndDate = &metav1.Time{Time: crEndDate}
+				return p
+			}(),
+			want: &ec2.ModifyCapacityReservationInput{
+				CapacityReservationId: aws.String(crID),
+				InstanceCount:         aws.Int32(3),
+				EndDateType:           ec2types.EndDateTypeUnlimited,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyCapacityReservationInput(crID, tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2.ModifyCapacityReservationInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// ClientVPNEndpointIDNotFound is the code that is returned by ec2 when
+	// the given ClientVPNEndpointID is not valid
+	ClientVPNEndpointIDNotFound = "InvalidClientVpnEndpointId.NotFound"
+
+	// ClientConfigurationKey is the connection detail that holds the client
+	// configuration file of a Client VPN endpoint.
+	ClientConfigurationKey = "clientConfiguration"
+
+	// ClientVPNRouteOriginAddRoute is the origin of the routes of a Client
+	// VPN endpoint that were not added by AWS.
+	ClientVPNRouteOriginAddRoute = "add-route"
+)
+
+// ClientVPNEndpointClient is the external client used for ClientVPNEndpoint
+// Custom Resource
+type ClientVPNEndpointClient interface {
+	CreateClientVpnEndpoint(ctx context.Context, input *ec2.CreateClientVpnEndpointInput, opts ...func(*ec2.Options)) (*ec2.CreateClientVpnEndpointOutput, error)
+	DescribeClientVpnEndpoints(ctx context.Context, input *ec2.DescribeClientVpnEndpointsInput, opts ...func(*ec2.Options)) (*ec2.DescribeClientVpnEndpointsOutput, error)
+	ModifyClientVpnEndpoint(ctx context.Context, input *ec2.ModifyClientVpnEndpointInput, opts ...func(*ec2.Options)) (*ec2.ModifyClientVpnEndpointOutput, error)
+	DeleteClientVpnEndpoint(ctx context.Context, input *ec2.DeleteClientVpnEndpointInput, opts ...func(*ec2.Options)) (*ec2.DeleteClientVpnEndpointOutput, error)
+	ExportClientVpnClientConfiguration(ctx context.Context, input *ec2.ExportClientVpnClientConfigurationInput, opts ...func(*ec2.Options)) (*ec2.ExportClientVpnClientConfigurationOutput, error)
+	DescribeClientVpnTargetNetworks(ctx context.Context, input *ec2.DescribeClientVpnTargetNetworksInput, opts ...func(*ec2.Options)) (*ec2.DescribeClientVpnTargetNetworksOutput, error)
+	AssociateClientVpnTargetNetwork(ctx context.Context, input *ec2.AssociateClientVpnTargetNetworkInput, opts ...func(*ec2.Options)) (*ec2.AssociateClientVpnTargetNetworkOutput, error)
+	DisassociateClientVpnTargetNetwork(ctx context.Context, input *ec2.DisassociateClientVpnTargetNetworkInput, opts ...func(*ec2.Options)) (*ec2.DisassociateClientVpnTargetNetworkOutput, error)
+	DescribeClientVpnAuthorizationRules(ctx context.Context, input *ec2.DescribeClientVpnAuthorizationRulesInput, opts ...func(*ec2.Options)) (*ec2.DescribeClientVpnAuthorizationRulesOutput, error)
+	AuthorizeClientVpnIngress(ctx context.Context, input *ec2.AuthorizeClientVpnIngressInput, opts ...func(*ec2.Options)) (*ec2.AuthorizeClientVpnIngressOutput, error)
+	RevokeClientVpnIngress(ctx context.Context, input *ec2.RevokeClientVpnIngressInput, opts ...func(*ec2.Options)) (*ec2.RevokeClientVpnIngressOutput, error)
+	DescribeClientVpnRoutes(ctx context.Context, input *ec2.DescribeClientVpnRoutesInput, opts ...func(*ec2.Options)) (*ec2.DescribeClientVpnRoutesOutput, error)
+	CreateClientVpnRoute(ctx context.Context, input *ec2.CreateClientVpnRouteInput, opts ...func(*ec2.Options)) (*ec2.CreateClientVpnRouteOutput, error)
+	DeleteClientVpnRoute(ctx context.Context, input *ec2.DeleteClientVpnRouteInput, opts ...func(*ec2.Options)) (*ec2.DeleteClientVpnRouteOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewClientVPNEndpointClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewClientVPNEndpointClient(cfg aws.Config) ClientVPNEndpointClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsClientVPNEndpointNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsClientVPNEndpointNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ClientVPNEndpointIDNotFound
+}
+
+// generateConnectionLogOptions returns the connection log options of the
+// endpoint. Logging is disabled unless it is configured.
+func generateConnectionLogOptions(o *manualv1alpha1.ClientVPNConnectionLogOptions) *ec2types.ConnectionLogOptions {
+	if o == nil {
+		return &ec2types.ConnectionLogOptions{Enabled: aws.Bool(false)}
+	}
+	return &ec2types.ConnectionLogOptions{
+		Enabled:             aws.Bool(o.Enabled),
+		CloudwatchLogGroup:  o.CloudWatchLogGroup,
+		CloudwatchLogStream: o.CloudWatchLogStream,
+	}
+}
+
+// GenerateCreateClientVPNEndpointInput returns the input to create the
+// supplied Client VPN endpoint.
+func GenerateCreateClientVPNEndpointInput(p manualv1alpha1.ClientVPNEndpointParameters) *ec2.CreateClientVpnEndpointInput {
+	input := &ec2.CreateClientVpnEndpointInput{
+		ClientCidrBlock:      aws.String(p.ClientCIDRBlock),
+		ServerCertificateArn: p.ServerCertificateARN,
+		ConnectionLogOptions: generateConnectionLogOptions(p.ConnectionLogOptions),
+		Description:          p.Description,
+		DnsServers:           p.DNSServers,
+		VpcId:                p.VPCID,
+		SecurityGroupIds:     p.SecurityGroupIDs,
+		SelfServicePortal:    ec2types.SelfServicePortal(aws.ToString(p.SelfServicePortal)),
+		SplitTunnel:          p.SplitTunnel,
+		TransportProtocol:    ec2types.TransportProtocol(aws.ToString(p.TransportProtocol)),
+		VpnPort:              p.VPNPort,
+	}
+	for _, a := range p.AuthenticationOptions {
+		o := ec2types.ClientVpnAuthenticationRequest{Type: ec2types.ClientVpnAuthenticationType(a.Type)}
+		switch o.Type {
+		case ec2types.ClientVpnAuthenticationTypeCertificateAuthentication:
+			o.MutualAuthentication = &ec2types.CertificateAuthenticationRequest{ClientRootCertificateChainArn: a.ClientRootCertificateChainARN}
+		case ec2types.ClientVpnAuthenticationTypeDirectoryServiceAuthentication:
+			o.ActiveDirectory = &ec2types.DirectoryServiceAuthenticationRequest{DirectoryId: a.ActiveDirectoryID}
+		case ec2types.ClientVpnAuthenticationTypeFederatedAuthentication:
+			o.FederatedAuthentication = &ec2types.FederatedAuthenticationRequest{
+				SAMLProviderArn:            a.SAMLProviderARN,
+				SelfServiceSAMLProviderArn: a.SelfServiceSAMLProviderARN,
+			}
+		}
+		input.AuthenticationOptions = append(input.AuthenticationOptions, o)
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeClientVpnEndpoint,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// GenerateModifyClientVPNEndpointInput returns the input to update the
+// modifiable attributes of the supplied Client VPN endpoint.
+func GenerateModifyClientVPNEndpointInput(id string, p manualv1alpha1.ClientVPNEndpointParameters) *ec2.ModifyClientVpnEndpointInput {
+	input := &ec2.ModifyClientVpnEndpointInput{
+		ClientVpnEndpointId:  aws.String(id),
+		ServerCertificateArn: p.ServerCertificateARN,
+		ConnectionLogOptions: generateConnectionLogOptions(p.ConnectionLogOptions),
+		Description:          p.Description,
+		DnsServers: &ec2types.DnsServersOptionsModifyStructure{
+			CustomDnsServers: p.DNSServers,
+			Enabled:          aws.Bool(len(p.DNSServers) != 0),
+		},
+		SelfServicePortal: ec2types.SelfServicePortal(aws.ToString(p.SelfServicePortal)),
+		SplitTunnel:       p.SplitTunnel,
+		VpnPort:           p.VPNPort,
+	}
+	// Security groups can only be changed together with the VPC.
+	if p.VPCID != nil {
+		input.VpcId = p.VPCID
+		input.SecurityGroupIds = p.SecurityGroupIDs
+	}
+	return input
+}
+
+// GenerateClientVPNEndpointObservation is used to produce
+// manualv1alpha1.ClientVPNEndpointObservation from ec2types.ClientVpnEndpoint and
+// its associations, authorization rules and routes.
+func GenerateClientVPNEndpointObservation(e ec2types.ClientVpnEndpoint, networks []ec2types.TargetNetwork, rules []ec2types.AuthorizationRule, routes []ec2types.ClientVpnRoute) manualv1alpha1.ClientVPNEndpointObservation {
+	o := manualv1alpha1.ClientVPNEndpointObservation{
+		ClientVPNEndpointID:  aws.ToString(e.ClientVpnEndpointId),
+		DNSName:              aws.ToString(e.DnsName),
+		SelfServicePortalURL: aws.ToString(e.SelfServicePortalUrl),
+	}
+	if e.Status != nil {
+		o.Status = string(e.Status.Code)
+		o.StatusMessage = aws.ToString(e.Status.Message)
+	}
+	for _, n := range networks {
+		a := manualv1alpha1.ClientVPNNetworkAssociationObservation{
+			AssociationID: aws.ToString(n.AssociationId),
+			SubnetID:      aws.ToString(n.TargetNetworkId),
+			VPCID:         aws.ToString(n.VpcId),
+		}
+		if n.Status != nil {
+			a.Status = string(n.Status.Code)
+		}
+		o.NetworkAssociations = append(o.NetworkAssociations, a)
+	}
+	for _, r := range rules {
+		a := manualv1alpha1.ClientVPNAuthorizationRuleObservation{
+			DestinationCIDR: aws.ToString(r.DestinationCidr),
+			GroupID:         aws.ToString(r.GroupId),
+			AccessAll:       aws.ToBool(r.AccessAll),
+		}
+		if r.Status != nil {
+			a.Status = string(r.Status.Code)
+		}
+		o.AuthorizationRules = append(o.AuthorizationRules, a)
+	}
+	for _, r := range routes {
+		a := manualv1alpha1.ClientVPNRouteObservation{
+			DestinationCIDR: aws.ToString(r.DestinationCidr),
+			TargetSubnet:    aws.ToString(r.TargetSubnet),
+			Origin:          aws.ToString(r.Origin),
+		}
+		if r.Status != nil {
+			a.Status = string(r.Status.Code)
+		}
+		o.Routes = append(o.Routes, a)
+	}
+	return o
+}
+
+// GetClientVPNEndpointConnectionDetails returns the connection details of
+// the Client VPN endpoint.
+func GetClientVPNEndpointConnectionDetails(configuration *string) managed.ConnectionDetails {
+	if aws.ToString(configuration) == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		ClientConfigurationKey: []byte(aws.ToString(configuration)),
+	}
+}
+
+// LateInitializeClientVPNEndpoint fills the empty fields in
+// *manualv1alpha1.ClientVPNEndpointParameters with the values seen in
+// ec2types.ClientVpnEndpoint.
+func LateInitializeClientVPNEndpoint(in *manualv1alpha1.ClientVPNEndpointParameters, e *ec2types.ClientVpnEndpoint) {
+	if e == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, e.Description)
+	in.ServerCertificateARN = awsclients.LateInitializeStringPtr(in.ServerCertificateARN, e.ServerCertificateArn)
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, e.VpcId)
+	if len(in.SecurityGroupIDs) == 0 {
+		in.SecurityGroupIDs = e.SecurityGroupIds
+	}
+	in.SplitTunnel = awsclients.LateInitializeBoolPtr(in.SplitTunnel, e.SplitTunnel)
+	if in.TransportProtocol == nil && e.TransportProtocol != "" {
+		in.TransportProtocol = aws.String(string(e.TransportProtocol))
+	}
+	in.VPNPort = awsclients.LateInitializeInt32Ptr(in.VPNPort, e.VpnPort)
+	if len(in.Tags) == 0 && len(e.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(e.Tags)
+	}
+}
+
+// IsClientVPNEndpointUpToDate checks whether the modifiable attributes of the
+// Client VPN endpoint are up to date.
+func IsClientVPNEndpointUpToDate(p manualv1alpha1.ClientVPNEndpointParameters, e ec2types.ClientVpnEndpoint) bool {
+	desired := generateConnectionLogOptions(p.ConnectionLogOptions)
+	observed := &ec2types.ConnectionLogOptions{Enabled: aws.Bool(false)}
+	if e.ConnectionLogOptions != nil {
+		observed = &ec2types.ConnectionLogOptions{
+			Enabled:             aws.Bool(aws.ToBool(e.ConnectionLogOptions.Enabled)),
+			CloudwatchLogGroup:  e.ConnectionLogOptions.CloudwatchLogGroup,
+			CloudwatchLogStream: e.ConnectionLogOptions.CloudwatchLogStream,
+		}
+	}
+	if !cmp.Equal(desired, observed, cmpopts.IgnoreUnexported(ec2types.ConnectionLogOptions{})) {
+		return false
+	}
+	if p.SelfServicePortal != nil && (aws.ToString(p.SelfServicePortal) == string(ec2types.SelfServicePortalEnabled)) != (aws.ToString(e.SelfServicePortalUrl) != "") {
+		return false
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return aws.ToString(p.Description) == aws.ToString(e.Description) &&
+		aws.ToString(p.ServerCertificateARN) == aws.ToString(e.ServerCertificateArn) &&
+		cmp.Equal(p.DNSServers, e.DnsServers, cmpopts.EquateEmpty(), sortStrings) &&
+		cmp.Equal(p.SecurityGroupIDs, e.SecurityGroupIds, cmpopts.EquateEmpty(), sortStrings) &&
+		aws.ToString(p.VPCID) == aws.ToString(e.VpcId) &&
+		aws.ToBool(p.SplitTunnel) == aws.ToBool(e.SplitTunnel) &&
+		aws.ToInt32(p.VPNPort) == aws.ToInt32(e.VpnPort) &&
+		manualv1alpha1.CompareTags(p.Tags, e.Tags)
+}
+
+// DiffClientVPNNetworkAssociations returns the subnets that have to be
+// associated with the Client VPN endpoint, and the IDs of the associations
+// that have to be removed. Associations that are being removed are ignored.
+func DiffClientVPNNetworkAssociations(p manualv1alpha1.ClientVPNEndpointParameters, networks []ec2types.TargetNetwork) (associate, disassociate []string) {
+	desired := map[string]bool{}
+	for _, a := range p.NetworkAssociations {
+		desired[aws.ToString(a.SubnetID)] = true
+	}
+	observed := map[string]bool{}
+	for _, n := range networks {
+		if n.Status != nil && (n.Status.Code == ec2types.AssociationStatusCodeDisassociating || n.Status.Code == ec2types.AssociationStatusCodeDisassociated) {
+			continue
+		}
+		subnet := aws.ToString(n.TargetNetworkId)
+		observed[subnet] = true
+		if !desired[subnet] {
+			disassociate = append(disassociate, aws.ToString(n.AssociationId))
+		}
+	}
+	for _, a := range p.NetworkAssociations {
+		subnet := aws.ToString(a.SubnetID)
+		if subnet != "" && !observed[subnet] {
+			associate = append(associate, subnet)
+			observed[subnet] = true
+		}
+	}
+	sort.Strings(disassociate)
+	return associate, disassociate
+}
+
+// clientVPNAuthorizationRuleKey identifies an authorization rule by its
+// network and the group it grants access to.
+func clientVPNAuthorizationRuleKey(cidr, group string, all bool) string {
+	if all {
+		return cidr + "|*"
+	}
+	return cidr + "|" + group
+}
+
+// DiffClientVPNAuthorizationRules returns the authorization rules that have
+// to be added to and revoked from the Client VPN endpoint. Rules that are
+// being revoked are ignored.
+func DiffClientVPNAuthorizationRules(p manualv1alpha1.ClientVPNEndpointParameters, rules []ec2types.AuthorizationRule) (authorize, revoke []manualv1alpha1.ClientVPNAuthorizationRule) {
+	desired := map[string]bool{}
+	for _, r := range p.AuthorizationRules {
+		desired[clientVPNAuthorizationRuleKey(r.TargetNetworkCIDR, aws.ToString(r.AccessGroupID), aws.ToBool(r.AuthorizeAllGroups))] = true
+	}
+	observed := map[string]bool{}
+	for _, r := range rules {
+		if r.Status != nil && r.Status.Code == ec2types.ClientVpnAuthorizationRuleStatusCodeRevoking {
+			continue
+		}
+		key := clientVPNAuthorizationRuleKey(aws.ToString(r.DestinationCidr), aws.ToString(r.GroupId), aws.ToBool(r.AccessAll))
+		observed[key] = true
+		if !desired[key] {
+			rule := manualv1alpha1.ClientVPNAuthorizationRule{TargetNetworkCIDR: aws.ToString(r.DestinationCidr)}
+			if aws.ToBool(r.AccessAll) {
+				rule.AuthorizeAllGroups = aws.Bool(true)
+			} else {
+				rule.AccessGroupID = r.GroupId
+			}
+			revoke = append(revoke, rule)
+		}
+	}
+	for _, r := range p.AuthorizationRules {
+		key := clientVPNAuthorizationRuleKey(r.TargetNetworkCIDR, aws.ToString(r.AccessGroupID), aws.ToBool(r.AuthorizeAllGroups))
+		if !observed[key] {
+			authorize = append(authorize, r)
+			observed[key] = true
+		}
+	}
+	return authorize, revoke
+}
+
+// DiffClientVPNRoutes returns the routes that have to be added to and
+// removed from the Client VPN endpoint. Only routes that were not added by
+// AWS are removed, and routes that are being deleted are ignored.
+func DiffClientVPNRoutes(p manualv1alpha1.ClientVPNEndpointParameters, routes []ec2types.ClientVpnRoute) (create, remove []manualv1alpha1.ClientVPNRoute) {
+	key := func(cidr, subnet string) string { return cidr + "|" + subnet }
+	desired := map[string]bool{}
+	for _, r := range p.Routes {
+		desired[key(r.DestinationCIDRBlock, aws.ToString(r.TargetSubnetID))] = true
+	}
+	observed := map[string]bool{}
+	for _, r := range routes {
+		if r.Status != nil && r.Status.Code == ec2types.ClientVpnRouteStatusCodeDeleting {
+			continue
+		}
+		k := key(aws.ToString(r.DestinationCidr), aws.ToString(r.TargetSubnet))
+		observed[k] = true
+		if !desired[k] && aws.ToString(r.Origin) == ClientVPNRouteOriginAddRoute {
+			remove = append(remove, manualv1alpha1.ClientVPNRoute{
+				DestinationCIDRBlock: aws.ToString(r.DestinationCidr),
+				TargetSubnetID:       r.TargetSubnet,
+			})
+		}
+	}
+	for _, r := range p.Routes {
+		k := key(r.DestinationCIDRBlock, aws.ToString(r.TargetSubnetID))
+		if !observed[k] {
+			create = append(create, r)
+			observed[k] = true
+		}
+	}
+	return create, remove
+}
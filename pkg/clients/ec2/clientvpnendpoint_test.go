@@ -0,0 +1,130 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func authorizationRule(cidr, group string, code ec2types.ClientVpnAuthorizationRuleStatusCode) ec2types.AuthorizationRule {
+	r := ec2types.AuthorizationRule{
+		DestinationCidr: aws.String(cidr),
+		Status:          &ec2types.ClientVpnAuthorizationRuleStatus{Code: code},
+	}
+	if group == "" {
+		r.AccessAll = aws.Bool(true)
+	} else {
+		r.GroupId = aws.String(group)
+	}
+	return r
+}
+
+func TestDiffClientVPNAuthorizationRules(t *testing.T) {
+	type want struct {
+		authorize []manualv1alpha1.ClientVPNAuthorizationRule
+		revoke    []manualv1alpha1.ClientVPNAuthorizationRule
+	}
+	cases := map[string]struct {
+		rules    []manualv1alpha1.ClientVPNAuthorizationRule
+		observed []ec2types.AuthorizationRule
+		want
+	}{
+		"UpToDate": {
+			rules: []manualv1alpha1.ClientVPNAuthorizationRule{
+				{TargetNetworkCIDR: "10.0.0.0/16", AuthorizeAllGroups: aws.Bool(true)},
+				{TargetNetworkCIDR: "10.1.0.0/16", AccessGroupID: aws.String("admins")},
+			},
+			observed: []ec2types.AuthorizationRule{
+				authorizationRule("10.1.0.0/16", "admins", ec2types.ClientVpnAuthorizationRuleStatusCodeActive),
+				authorizationRule("10.0.0.0/16", "", ec2types.ClientVpnAuthorizationRuleStatusCodeAuthorizing),
+			},
+		},
+		"GroupChanged": {
+			rules: []manualv1alpha1.ClientVPNAuthorizationRule{
+				{TargetNetworkCIDR: "10.1.0.0/16", AccessGroupID: aws.String("devs")},
+			},
+			observed: []ec2types.AuthorizationRule{
+				authorizationRule("10.1.0.0/16", "admins", ec2types.ClientVpnAuthorizationRuleStatusCodeActive),
+			},
+			want: want{
+				authorize: []manualv1alpha1.ClientVPNAuthorizationRule{{TargetNetworkCIDR: "10.1.0.0/16", AccessGroupID: aws.String("devs")}},
+				revoke:    []manualv1alpha1.ClientVPNAuthorizationRule{{TargetNetworkCIDR: "10.1.0.0/16", AccessGroupID: aws.String("admins")}},
+			},
+		},
+		"IgnoresRevokingRules": {
+			rules: []manualv1alpha1.ClientVPNAuthorizationRule{
+				{TargetNetworkCIDR: "10.0.0.0/16", AuthorizeAllGroups: aws.Bool(true)},
+			},
+			observed: []ec2types.AuthorizationRule{
+				authorizationRule("10.0.0.0/16", "", ec2types.ClientVpnAuthorizationRuleStatusCodeRevoking),
+				authorizationRule("10.2.0.0/16", "", ec2types.ClientVpnAuthorizationRuleStatusCodeRevoking),
+			},
+			want: want{
+				authorize: []manualv1alpha1.ClientVPNAuthorizationRule{{TargetNetworkCIDR: "10.0.0.0/16", AuthorizeAllGroups: aws.Bool(true)}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			authorize, revoke := DiffClientVPNAuthorizationRules(manualv1alpha1.ClientVPNEndpointParameters{AuthorizationRules: tc.rules}, tc.observed)
+			if diff := cmp.Diff(tc.want.authorize, authorize); diff != "" {
+				t.Errorf("authorize: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.revoke, revoke); diff != "" {
+				t.Errorf("revoke: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffClientVPNRoutes(t *testing.T) {
+	type want struct {
+		create []manualv1alpha1.ClientVPNRoute
+		remove []manualv1alpha1.ClientVPNRoute
+	}
+	route := func(cidr, origin string, code ec2types.ClientVpnRouteStatusCode) ec2types.ClientVpnRoute {
+		return ec2types.ClientVpnRoute{
+			DestinationCidr: aws.String(cidr),
+			TargetSubnet:    aws.String("subnet-a"),
+			Origin:          aws.String(origin),
+			Status:          &ec2types.ClientVpnRouteStatus{Code: code},
+		}
+	}
+	cases := map[string]struct {
+		routes   []manualv1alpha1.ClientVPNRoute
+		observed []ec2types.ClientVpnRoute
+		want
+	}{
+		"KeepsAssociationRoutes": {
+			observed: []ec2types.ClientVpnRoute{
+				route("10.0.0.0/16", "associate", ec2types.ClientVpnRouteStatusCodeActive),
+			},
+		},
+		"ReplacesRoute": {
+			routes: []manualv1alpha1.ClientVPNRoute{{DestinationCIDRBlock: "0.0.0.0/0", TargetSubnetID: aws.String("subnet-a")}},
+			observed: []ec2types.ClientVpnRoute{
+				route("10.1.0.0/16", ClientVPNRouteOriginAddRoute, ec2types.ClientVpnRouteStatusCodeActive),
+				route("10.2.0.0/16", ClientVPNRouteOriginAddRoute, ec2types.ClientVpnRouteStatusCodeDeleting),
+			},
+			want: want{
+				create: []manualv1alpha1.ClientVPNRoute{{DestinationCIDRBlock: "0.0.0.0/0", TargetSubnetID: aws.String("subnet-a")}},
+				remove: []manualv1alpha1.ClientVPNRoute{{DestinationCIDRBlock: "10.1.0.0/16", TargetSubnetID: aws.String("subnet-a")}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, remove := DiffClientVPNRoutes(manualv1alpha1.ClientVPNEndpointParameters{Routes: tc.routes}, tc.observed)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
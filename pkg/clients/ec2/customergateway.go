@@ -0,0 +1,104 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// CustomerGatewayIDNotFound is the code that is returned by ec2 when the
+	// given CustomerGatewayID is not valid
+	CustomerGatewayIDNotFound = "InvalidCustomerGatewayID.NotFound"
+
+	// CustomerGatewayStateDeleted is the state of a customer gateway that
+	// has been deleted.
+	CustomerGatewayStateDeleted = "deleted"
+)
+
+// CustomerGatewayClient is the external client used for CustomerGateway
+// Custom Resource
+type CustomerGatewayClient interface {
+	CreateCustomerGateway(ctx context.Context, input *ec2.CreateCustomerGatewayInput, opts ...func(*ec2.Options)) (*ec2.CreateCustomerGatewayOutput, error)
+	DescribeCustomerGateways(ctx context.Context, input *ec2.DescribeCustomerGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeCustomerGatewaysOutput, error)
+	DeleteCustomerGateway(ctx context.Context, input *ec2.DeleteCustomerGatewayInput, opts ...func(*ec2.Options)) (*ec2.DeleteCustomerGatewayOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewCustomerGatewayClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewCustomerGatewayClient(cfg aws.Config) CustomerGatewayClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsCustomerGatewayNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsCustomerGatewayNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == CustomerGatewayIDNotFound
+}
+
+// GenerateCreateCustomerGatewayInput returns the input to create the supplied
+// customer gateway.
+func GenerateCreateCustomerGatewayInput(p manualv1alpha1.CustomerGatewayParameters) *ec2.CreateCustomerGatewayInput {
+	input := &ec2.CreateCustomerGatewayInput{
+		Type:           ec2types.GatewayType(p.Type),
+		BgpAsn:         p.BGPASN,
+		PublicIp:       p.IPAddress,
+		CertificateArn: p.CertificateARN,
+		DeviceName:     p.DeviceName,
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeCustomerGateway,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// GenerateCustomerGatewayObservation is used to produce
+// manualv1alpha1.CustomerGatewayObservation from ec2types.CustomerGateway.
+func GenerateCustomerGatewayObservation(g ec2types.CustomerGateway) manualv1alpha1.CustomerGatewayObservation {
+	return manualv1alpha1.CustomerGatewayObservation{
+		CustomerGatewayID: aws.ToString(g.CustomerGatewayId),
+		State:             aws.ToString(g.State),
+	}
+}
+
+// LateInitializeCustomerGateway fills the empty fields in
+// *manualv1alpha1.CustomerGatewayParameters with the values seen in
+// ec2types.CustomerGateway.
+func LateInitializeCustomerGateway(in *manualv1alpha1.CustomerGatewayParameters, g *ec2types.CustomerGateway) {
+	if g == nil {
+		return
+	}
+	in.Type = awsclients.LateInitializeString(in.Type, g.Type)
+	if in.BGPASN == nil && g.BgpAsn != nil {
+		// ec2 reports the ASN as a string, although it is set as a number.
+		if asn, err := strconv.ParseInt(aws.ToString(g.BgpAsn), 10, 32); err == nil {
+			in.BGPASN = aws.Int32(int32(asn))
+		}
+	}
+	in.IPAddress = awsclients.LateInitializeStringPtr(in.IPAddress, g.IpAddress)
+	in.CertificateARN = awsclients.LateInitializeStringPtr(in.CertificateARN, g.CertificateArn)
+	in.DeviceName = awsclients.LateInitializeStringPtr(in.DeviceName, g.DeviceName)
+	if len(in.Tags) == 0 && len(g.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(g.Tags)
+	}
+}
+
+// IsCustomerGatewayUpToDate checks whether the tags of the customer gateway
+// are up to date. Customer gateways cannot be modified otherwise.
+func IsCustomerGatewayUpToDate(p manualv1alpha1.CustomerGatewayParameters, g ec2types.CustomerGateway) bool {
+	return manualv1alpha1.CompareTags(p.Tags, g.Tags)
+}
@@ -0,0 +1,154 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// DHCPOptionsIDNotFound is the code that is returned by ec2 when the
+	// given DHCPOptionsID is not valid
+	DHCPOptionsIDNotFound = "InvalidDhcpOptionID.NotFound"
+
+	// DefaultDHCPOptionsID is the ID used to associate a VPC with the default
+	// DHCP options of its region.
+	DefaultDHCPOptionsID = "default"
+
+	dhcpDomainName         = "domain-name"
+	dhcpDomainNameServers  = "domain-name-servers"
+	dhcpNTPServers         = "ntp-servers"
+	dhcpNetBIOSNameServers = "netbios-name-servers"
+	dhcpNetBIOSNodeType    = "netbios-node-type"
+)
+
+// DHCPOptionsClient is the external client used for DHCPOptions Custom
+// Resource
+type DHCPOptionsClient interface {
+	CreateDhcpOptions(ctx context.Context, input *ec2.CreateDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.CreateDhcpOptionsOutput, error)
+	DescribeDhcpOptions(ctx context.Context, input *ec2.DescribeDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeDhcpOptionsOutput, error)
+	DeleteDhcpOptions(ctx context.Context, input *ec2.DeleteDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.DeleteDhcpOptionsOutput, error)
+	AssociateDhcpOptions(ctx context.Context, input *ec2.AssociateDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.AssociateDhcpOptionsOutput, error)
+	DescribeVpcs(ctx context.Context, input *ec2.DescribeVpcsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewDHCPOptionsClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewDHCPOptionsClient(cfg aws.Config) DHCPOptionsClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsDHCPOptionsNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsDHCPOptionsNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == DHCPOptionsIDNotFound
+}
+
+// GenerateDHCPConfigurations returns the DHCP configurations that make up the
+// supplied DHCP options.
+func GenerateDHCPConfigurations(p manualv1alpha1.DHCPOptionsParameters) []ec2types.NewDhcpConfiguration {
+	var res []ec2types.NewDhcpConfiguration
+	add := func(key string, values []string) {
+		if len(values) != 0 {
+			res = append(res, ec2types.NewDhcpConfiguration{Key: aws.String(key), Values: values})
+		}
+	}
+	if p.DomainName != nil {
+		add(dhcpDomainName, []string{aws.ToString(p.DomainName)})
+	}
+	add(dhcpDomainNameServers, p.DomainNameServers)
+	add(dhcpNTPServers, p.NTPServers)
+	add(dhcpNetBIOSNameServers, p.NetBIOSNameServers)
+	if p.NetBIOSNodeType != nil {
+		add(dhcpNetBIOSNodeType, []string{aws.ToString(p.NetBIOSNodeType)})
+	}
+	return res
+}
+
+// GenerateDHCPOptionsObservation is used to produce
+// manualv1alpha1.DHCPOptionsObservation from ec2types.DhcpOptions and the VPCs it is
+// associated with.
+func GenerateDHCPOptionsObservation(o ec2types.DhcpOptions, vpcIDs []string) manualv1alpha1.DHCPOptionsObservation {
+	return manualv1alpha1.DHCPOptionsObservation{
+		DHCPOptionsID: aws.ToString(o.DhcpOptionsId),
+		OwnerID:       aws.ToString(o.OwnerId),
+		VPCIDs:        vpcIDs,
+	}
+}
+
+// LateInitializeDHCPOptions fills the empty fields in
+// *manualv1alpha1.DHCPOptionsParameters with the values seen in ec2types.DhcpOptions.
+func LateInitializeDHCPOptions(in *manualv1alpha1.DHCPOptionsParameters, o *ec2types.DhcpOptions) {
+	if o == nil {
+		return
+	}
+	for _, c := range o.DhcpConfigurations {
+		values := make([]string, len(c.Values))
+		for i, v := range c.Values {
+			values[i] = aws.ToString(v.Value)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		switch aws.ToString(c.Key) {
+		case dhcpDomainName:
+			if in.DomainName == nil {
+				in.DomainName = aws.String(values[0])
+			}
+		case dhcpDomainNameServers:
+			if len(in.DomainNameServers) == 0 {
+				in.DomainNameServers = values
+			}
+		case dhcpNTPServers:
+			if len(in.NTPServers) == 0 {
+				in.NTPServers = values
+			}
+		case dhcpNetBIOSNameServers:
+			if len(in.NetBIOSNameServers) == 0 {
+				in.NetBIOSNameServers = values
+			}
+		case dhcpNetBIOSNodeType:
+			if in.NetBIOSNodeType == nil {
+				in.NetBIOSNodeType = aws.String(values[0])
+			}
+		}
+	}
+	if len(in.Tags) == 0 && len(o.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(o.Tags)
+	}
+}
+
+// DiffDHCPOptionsAssociations returns whether the desired VPC has to be
+// associated with the DHCP options, and which of the VPCs that are associated
+// with them have to be associated with the default options instead.
+func DiffDHCPOptionsAssociations(p manualv1alpha1.DHCPOptionsParameters, vpcIDs []string) (associate bool, dissociate []string) {
+	desired := aws.ToString(p.VPCID)
+	associate = desired != ""
+	for _, id := range vpcIDs {
+		if id == desired {
+			associate = false
+			continue
+		}
+		dissociate = append(dissociate, id)
+	}
+	sort.Strings(dissociate)
+	return associate, dissociate
+}
+
+// IsDHCPOptionsUpToDate checks whether the options are associated with the
+// desired VPC only, and whether their tags are up to date. The DHCP options
+// themselves cannot be modified.
+func IsDHCPOptionsUpToDate(p manualv1alpha1.DHCPOptionsParameters, o ec2types.DhcpOptions, vpcIDs []string) bool {
+	associate, dissociate := DiffDHCPOptionsAssociations(p, vpcIDs)
+	return !associate && len(dissociate) == 0 && manualv1alpha1.CompareTags(p.Tags, o.Tags)
+}
@@ -0,0 +1,123 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestGenerateDHCPConfigurations(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.DHCPOptionsParameters
+		want []ec2types.NewDhcpConfiguration
+	}{
+		"Empty": {},
+		"All": {
+			p: manualv1alpha1.DHCPOptionsParameters{
+				DomainName:        aws.String("corp.example.com"),
+				DomainNameServers: []string{"10.0.0.2", "10.0.0.3"},
+				NTPServers:        []string{"10.0.0.4"},
+				NetBIOSNodeType:   aws.String("2"),
+			},
+			want: []ec2types.NewDhcpConfiguration{
+				{Key: aws.String("domain-name"), Values: []string{"corp.example.com"}},
+				{Key: aws.String("domain-name-servers"), Values: []string{"10.0.0.2", "10.0.0.3"}},
+				{Key: aws.String("ntp-servers"), Values: []string{"10.0.0.4"}},
+				{Key: aws.String("netbios-node-type"), Values: []string{"2"}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDHCPConfigurations(tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2types.NewDhcpConfiguration{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeDHCPOptions(t *testing.T) {
+	value := func(v string) ec2types.AttributeValue { return ec2types.AttributeValue{Value: aws.String(v)} }
+	observed := &ec2types.DhcpOptions{
+		DhcpConfigurations: []ec2types.DhcpConfiguration{
+			{Key: aws.String("domain-name"), Values: []ec2types.AttributeValue{value("corp.example.com")}},
+			{Key: aws.String("domain-name-servers"), Values: []ec2types.AttributeValue{value("10.0.0.2"), value("10.0.0.3")}},
+		},
+	}
+
+	cases := map[string]struct {
+		in   manualv1alpha1.DHCPOptionsParameters
+		want manualv1alpha1.DHCPOptionsParameters
+	}{
+		"FillsEmpty": {
+			want: manualv1alpha1.DHCPOptionsParameters{
+				DomainName:        aws.String("corp.example.com"),
+				DomainNameServers: []string{"10.0.0.2", "10.0.0.3"},
+			},
+		},
+		"KeepsSet": {
+			in: manualv1alpha1.DHCPOptionsParameters{
+				DomainName: aws.String("other.example.com"),
+			},
+			want: manualv1alpha1.DHCPOptionsParameters{
+				DomainName:        aws.String("other.example.com"),
+				DomainNameServers: []string{"10.0.0.2", "10.0.0.3"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDHCPOptions(&tc.in, observed)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffDHCPOptionsAssociations(t *testing.T) {
+	type want struct {
+		associate  bool
+		dissociate []string
+	}
+	cases := map[string]struct {
+		vpcID  *string
+		vpcIDs []string
+		want
+	}{
+		"UpToDate": {
+			vpcID:  aws.String("vpc-a"),
+			vpcIDs: []string{"vpc-a"},
+		},
+		"NotAssociated": {
+			vpcID: aws.String("vpc-a"),
+			want:  want{associate: true},
+		},
+		"Moved": {
+			vpcID:  aws.String("vpc-b"),
+			vpcIDs: []string{"vpc-a"},
+			want:   want{associate: true, dissociate: []string{"vpc-a"}},
+		},
+		"Removed": {
+			vpcIDs: []string{"vpc-c", "vpc-a"},
+			want:   want{dissociate: []string{"vpc-a", "vpc-c"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, dissociate := DiffDHCPOptionsAssociations(manualv1alpha1.DHCPOptionsParameters{VPCID: tc.vpcID}, tc.vpcIDs)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.dissociate, dissociate); diff != "" {
+				t.Errorf("dissociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// FleetIDNotFound is the code that is returned by ec2 when the given
+	// FleetID is not valid
+	FleetIDNotFound = "InvalidFleetId.NotFound"
+)
+
+// EC2FleetClient is the external client used for EC2Fleet Custom Resource
+type EC2FleetClient interface {
+	CreateFleet(ctx context.Context, input *ec2.CreateFleetInput, opts ...func(*ec2.Options)) (*ec2.CreateFleetOutput, error)
+	DescribeFleets(ctx context.Context, input *ec2.DescribeFleetsInput, opts ...func(*ec2.Options)) (*ec2.DescribeFleetsOutput, error)
+	ModifyFleet(ctx context.Context, input *ec2.ModifyFleetInput, opts ...func(*ec2.Options)) (*ec2.ModifyFleetOutput, error)
+	DeleteFleets(ctx context.Context, input *ec2.DeleteFleetsInput, opts ...func(*ec2.Options)) (*ec2.DeleteFleetsOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewEC2FleetClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewEC2FleetClient(cfg aws.Config) EC2FleetClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsEC2FleetNotFoundErr returns true if the error is because the item doesn't
+// exist
+func IsEC2FleetNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == FleetIDNotFound
+}
+
+func float64Ptr(i *int32) *float64 {
+	if i == nil {
+		return nil
+	}
+	return aws.Float64(float64(*i))
+}
+
+func int32Ptr(f *float64) *int32 {
+	if f == nil {
+		return nil
+	}
+	return aws.Int32(int32(*f))
+}
+
+func generateFleetLaunchTemplateConfigs(configs []manualv1alpha1.EC2FleetLaunchTemplateConfig) []ec2types.FleetLaunchTemplateConfigRequest {
+	if len(configs) == 0 {
+		return nil
+	}
+	res := make([]ec2types.FleetLaunchTemplateConfigRequest, len(configs))
+	for i, c := range configs {
+		res[i] = ec2types.FleetLaunchTemplateConfigRequest{
+			LaunchTemplateSpecification: &ec2types.FleetLaunchTemplateSpecificationRequest{
+				LaunchTemplateId:   c.LaunchTemplateSpecification.LaunchTemplateID,
+				LaunchTemplateName: c.LaunchTemplateSpecification.LaunchTemplateName,
+				Version:            aws.String(c.LaunchTemplateSpecification.Version),
+			},
+		}
+		for _, o := range c.Overrides {
+			res[i].Overrides = append(res[i].Overrides, ec2types.FleetLaunchTemplateOverridesRequest{
+				AvailabilityZone: o.AvailabilityZone,
+				InstanceType:     ec2types.InstanceType(aws.ToString(o.InstanceType)),
+				MaxPrice:         o.MaxPrice,
+				Priority:         float64Ptr(o.Priority),
+				SubnetId:         o.SubnetID,
+				WeightedCapacity: float64Ptr(o.WeightedCapacity),
+			})
+		}
+	}
+	return res
+}
+
+func generateFleetTargetCapacitySpecification(s manualv1alpha1.EC2FleetTargetCapacitySpecification) *ec2types.TargetCapacitySpecificationRequest {
+	return &ec2types.TargetCapacitySpecificationRequest{
+		TotalTargetCapacity:       aws.Int32(s.TotalTargetCapacity),
+		OnDemandTargetCapacity:    s.OnDemandTargetCapacity,
+		SpotTargetCapacity:        s.SpotTargetCapacity,
+		DefaultTargetCapacityType: ec2types.DefaultTargetCapacityType(aws.ToString(s.DefaultTargetCapacityType)),
+	}
+}
+
+func generateFleetSpotOptions(o *manualv1alpha1.EC2FleetSpotOptions) *ec2types.SpotOptionsRequest {
+	if o == nil {
+		return nil
+	}
+	res := &ec2types.SpotOptionsRequest{
+		AllocationStrategy:           ec2types.SpotAllocationStrategy(aws.ToString(o.AllocationStrategy)),
+		InstanceInterruptionBehavior: ec2types.SpotInstanceInterruptionBehavior(aws.ToString(o.InstanceInterruptionBehavior)),
+		InstancePoolsToUseCount:      o.InstancePoolsToUseCount,
+		MaxTotalPrice:                o.MaxTotalPrice,
+		SingleAvailabilityZone:       o.SingleAvailabilityZone,
+		SingleInstanceType:           o.SingleInstanceType,
+	}
+	if o.MaintenanceStrategies != nil && o.MaintenanceStrategies.CapacityRebalance != nil {
+		res.MaintenanceStrategies = &ec2types.FleetSpotMaintenanceStrategiesRequest{
+			CapacityRebalance: &ec2types.FleetSpotCapacityRebalanceRequest{
+				ReplacementStrategy: ec2types.FleetReplacementStrategy(o.MaintenanceStrategies.CapacityRebalance.ReplacementStrategy),
+				TerminationDelay:    o.MaintenanceStrategies.CapacityRebalance.TerminationDelay,
+			},
+		}
+	}
+	return res
+}
+
+func generateFleetOnDemandOptions(o *manualv1alpha1.EC2FleetOnDemandOptions) *ec2types.OnDemandOptionsRequest {
+	if o == nil {
+		return nil
+	}
+	return &ec2types.OnDemandOptionsRequest{
+		AllocationStrategy:     ec2types.FleetOnDemandAllocationStrategy(aws.ToString(o.AllocationStrategy)),
+		MaxTotalPrice:          o.MaxTotalPrice,
+		SingleAvailabilityZone: o.SingleAvailabilityZone,
+		SingleInstanceType:     o.SingleInstanceType,
+	}
+}
+
+// GenerateCreateEC2FleetInput returns the input to create the supplied
+// EC2Fleet.
+func GenerateCreateEC2FleetInput(p manualv1alpha1.EC2FleetParameters) *ec2.CreateFleetInput {
+	input := &ec2.CreateFleetInput{
+		Type:                             ec2types.FleetType(aws.ToString(p.Type)),
+		LaunchTemplateConfigs:            generateFleetLaunchTemplateConfigs(p.LaunchTemplateConfigs),
+		TargetCapacitySpecification:      generateFleetTargetCapacitySpecification(p.TargetCapacitySpecification),
+		SpotOptions:                      generateFleetSpotOptions(p.SpotOptions),
+		OnDemandOptions:                  generateFleetOnDemandOptions(p.OnDemandOptions),
+		ExcessCapacityTerminationPolicy:  ec2types.FleetExcessCapacityTerminationPolicy(aws.ToString(p.ExcessCapacityTerminationPolicy)),
+		ReplaceUnhealthyInstances:        p.ReplaceUnhealthyInstances,
+		TerminateInstancesWithExpiration: p.TerminateInstancesWithExpiration,
+	}
+	if p.ValidFrom != nil {
+		input.ValidFrom = &p.ValidFrom.Time
+	}
+	if p.ValidUntil != nil {
+		input.ValidUntil = &p.ValidUntil.Time
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeFleet,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// GenerateModifyEC2FleetInput returns the input to bring the launch templates
+// and the target capacity of the supplied fleet to the desired state.
+func GenerateModifyEC2FleetInput(id string, p manualv1alpha1.EC2FleetParameters) *ec2.ModifyFleetInput {
+	return &ec2.ModifyFleetInput{
+		FleetId:                         aws.String(id),
+		LaunchTemplateConfigs:           generateFleetLaunchTemplateConfigs(p.LaunchTemplateConfigs),
+		TargetCapacitySpecification:     generateFleetTargetCapacitySpecification(p.TargetCapacitySpecification),
+		ExcessCapacityTerminationPolicy: ec2types.FleetExcessCapacityTerminationPolicy(aws.ToString(p.ExcessCapacityTerminationPolicy)),
+	}
+}
+
+// GenerateEC2FleetObservation is used to produce manualv1alpha1.EC2FleetObservation
+// from ec2types.FleetData.
+func GenerateEC2FleetObservation(f ec2types.FleetData) manualv1alpha1.EC2FleetObservation {
+	o := manualv1alpha1.EC2FleetObservation{
+		FleetID:                   aws.ToString(f.FleetId),
+		FleetState:                string(f.FleetState),
+		ActivityStatus:            string(f.ActivityStatus),
+		FulfilledCapacity:         aws.ToInt32(int32Ptr(f.FulfilledCapacity)),
+		FulfilledOnDemandCapacity: aws.ToInt32(int32Ptr(f.FulfilledOnDemandCapacity)),
+		CreateTime:                FromTimePtr(f.CreateTime),
+	}
+	for _, i := range f.Instances {
+		o.InstanceIDs = append(o.InstanceIDs, i.InstanceIds...)
+	}
+	for _, e := range f.Errors {
+		o.Errors = append(o.Errors, manualv1alpha1.EC2FleetError{
+			ErrorCode:    aws.ToString(e.ErrorCode),
+			ErrorMessage: aws.ToString(e.ErrorMessage),
+		})
+	}
+	return o
+}
+
+// LateInitializeEC2Fleet fills the empty fields in *manualv1alpha1.EC2FleetParameters
+// with the values seen in ec2types.FleetData.
+func LateInitializeEC2Fleet(in *manualv1alpha1.EC2FleetParameters, f *ec2types.FleetData) {
+	if f == nil {
+		return
+	}
+	in.Type = awsclients.LateInitializeStringPtr(in.Type, aws.String(string(f.Type)))
+	in.ExcessCapacityTerminationPolicy = awsclients.LateInitializeStringPtr(in.ExcessCapacityTerminationPolicy, aws.String(string(f.ExcessCapacityTerminationPolicy)))
+	in.ReplaceUnhealthyInstances = awsclients.LateInitializeBoolPtr(in.ReplaceUnhealthyInstances, f.ReplaceUnhealthyInstances)
+	in.TerminateInstancesWithExpiration = awsclients.LateInitializeBoolPtr(in.TerminateInstancesWithExpiration, f.TerminateInstancesWithExpiration)
+	if f.TargetCapacitySpecification != nil {
+		s := &in.TargetCapacitySpecification
+		s.DefaultTargetCapacityType = awsclients.LateInitializeStringPtr(s.DefaultTargetCapacityType, aws.String(string(f.TargetCapacitySpecification.DefaultTargetCapacityType)))
+	}
+	if len(in.Tags) == 0 && len(f.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(f.Tags)
+	}
+}
+
+// isOptionalStringUpToDate returns true if the desired value is unset or
+// matches the observed one.
+func isOptionalStringUpToDate(desired *string, observed string) bool {
+	return desired == nil || *desired == observed
+}
+
+// isOptionalInt32UpToDate returns true if the desired value is unset or
+// matches the observed one.
+func isOptionalInt32UpToDate(desired, observed *int32) bool {
+	return desired == nil || *desired == aws.ToInt32(observed)
+}
+
+func isFleetTargetCapacityUpToDate(s manualv1alpha1.EC2FleetTargetCapacitySpecification, o *ec2types.TargetCapacitySpecification) bool {
+	if o == nil {
+		return false
+	}
+	return s.TotalTargetCapacity == aws.ToInt32(o.TotalTargetCapacity) &&
+		isOptionalInt32UpToDate(s.OnDemandTargetCapacity, o.OnDemandTargetCapacity) &&
+		isOptionalInt32UpToDate(s.SpotTargetCapacity, o.SpotTargetCapacity) &&
+		isOptionalStringUpToDate(s.DefaultTargetCapacityType, string(o.DefaultTargetCapacityType))
+}
+
+func isFleetLaunchTemplateOverridesUpToDate(o manualv1alpha1.EC2FleetLaunchTemplateOverrides, observed ec2types.FleetLaunchTemplateOverrides) bool {
+	return aws.ToString(o.AvailabilityZone) == aws.ToString(observed.AvailabilityZone) &&
+		aws.ToString(o.InstanceType) == string(observed.InstanceType) &&
+		aws.ToString(o.MaxPrice) == aws.ToString(observed.MaxPrice) &&
+		aws.ToInt32(o.Priority) == aws.ToInt32(int32Ptr(observed.Priority)) &&
+		aws.ToString(o.SubnetID) == aws.ToString(observed.SubnetId) &&
+		isOptionalInt32UpToDate(o.WeightedCapacity, int32Ptr(observed.WeightedCapacity))
+}
+
+func isFleetLaunchTemplateConfigUpToDate(c manualv1alpha1.EC2FleetLaunchTemplateConfig, observed ec2types.FleetLaunchTemplateConfig) bool {
+	spec := observed.LaunchTemplateSpecification
+	if spec == nil ||
+		c.LaunchTemplateSpecification.Version != aws.ToString(spec.Version) ||
+		!isOptionalStringUpToDate(c.LaunchTemplateSpecification.LaunchTemplateID, aws.ToString(spec.LaunchTemplateId)) ||
+		!isOptionalStringUpToDate(c.LaunchTemplateSpecification.LaunchTemplateName, aws.ToString(spec.LaunchTemplateName)) {
+		return false
+	}
+	if len(c.Overrides) != len(observed.Overrides) {
+		return false
+	}
+	for i := range c.Overrides {
+		if !isFleetLaunchTemplateOverridesUpToDate(c.Overrides[i], observed.Overrides[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEC2FleetConfigUpToDate checks whether the launch templates, the target
+// capacity and the excess capacity termination policy of the fleet, which
+// are the only settings that can be modified, are as desired.
+func IsEC2FleetConfigUpToDate(p manualv1alpha1.EC2FleetParameters, f ec2types.FleetData) bool {
+	if !isFleetTargetCapacityUpToDate(p.TargetCapacitySpecification, f.TargetCapacitySpecification) ||
+		!isOptionalStringUpToDate(p.ExcessCapacityTerminationPolicy, string(f.ExcessCapacityTerminationPolicy)) {
+		return false
+	}
+	if len(p.LaunchTemplateConfigs) != len(f.LaunchTemplateConfigs) {
+		return false
+	}
+	for i := range p.LaunchTemplateConfigs {
+		if !isFleetLaunchTemplateConfigUpToDate(p.LaunchTemplateConfigs[i], f.LaunchTemplateConfigs[i]) {
+			return false
+		}
+	}
+	return true
+}
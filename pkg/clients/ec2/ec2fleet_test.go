@@ -0,0 +1,130 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func fleetParameters(m ...func(*manualv1alpha1.EC2FleetParameters)) manualv1alpha1.EC2FleetParameters {
+	p := manualv1alpha1.EC2FleetParameters{
+		LaunchTemplateConfigs: []manualv1alpha1.EC2FleetLaunchTemplateConfig{{
+			LaunchTemplateSpecification: manualv1alpha1.EC2FleetLaunchTemplateSpecification{
+				LaunchTemplateID: aws.String("lt-123"),
+				Version:          "1",
+			},
+			Overrides: []manualv1alpha1.EC2FleetLaunchTemplateOverrides{{
+				InstanceType:     aws.String("m5.large"),
+				SubnetID:         aws.String("subnet-1"),
+				WeightedCapacity: aws.Int32(2),
+			}},
+		}},
+		TargetCapacitySpecification: manualv1alpha1.EC2FleetTargetCapacitySpecification{
+			TotalTargetCapacity:    4,
+			OnDemandTargetCapacity: aws.Int32(1),
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func fleetData(m ...func(*ec2types.FleetData)) ec2types.FleetData {
+	f := ec2types.FleetData{
+		LaunchTemplateConfigs: []ec2types.FleetLaunchTemplateConfig{{
+			LaunchTemplateSpecification: &ec2types.FleetLaunchTemplateSpecification{
+				LaunchTemplateId:   aws.String("lt-123"),
+				LaunchTemplateName: aws.String("sample"),
+				Version:            aws.String("1"),
+			},
+			Overrides: []ec2types.FleetLaunchTemplateOverrides{{
+				InstanceType:     ec2types.InstanceTypeM5Large,
+				SubnetId:         aws.String("subnet-1"),
+				WeightedCapacity: aws.Float64(2),
+			}},
+		}},
+		TargetCapacitySpecification: &ec2types.TargetCapacitySpecification{
+			TotalTargetCapacity:       aws.Int32(4),
+			OnDemandTargetCapacity:    aws.Int32(1),
+			SpotTargetCapacity:        aws.Int32(0),
+			DefaultTargetCapacityType: ec2types.DefaultTargetCapacityTypeOnDemand,
+		},
+		ExcessCapacityTerminationPolicy: ec2types.FleetExcessCapacityTerminationPolicyTermination,
+	}
+	for _, fn := range m {
+		fn(&f)
+	}
+	return f
+}
+
+func TestIsEC2FleetConfigUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.EC2FleetParameters
+		f    ec2types.FleetData
+		want bool
+	}{
+		"UpToDate": {
+			p:    fleetParameters(),
+			f:    fleetData(),
+			want: true,
+		},
+		"TotalCapacityChanged": {
+			p: fleetParameters(func(p *manualv1alpha1.EC2FleetParameters) {
+				p.TargetCapacitySpecification.TotalTargetCapacity = 6
+			}),
+			f: fleetData(),
+		},
+		"SpotCapacityChanged": {
+			p: fleetParameters(func(p *manualv1alpha1.EC2FleetParameters) {
+				p.TargetCapacitySpecification.SpotTargetCapacity = aws.Int32(2)
+			}),
+			f: fleetData(),
+		},
+		"ExcessCapacityTerminationPolicyChanged": {
+			p: fleetParameters(func(p *manualv1alpha1.EC2FleetParameters) {
+				p.ExcessCapacityTerminationPolicy = aws.String(string(ec2types.FleetExcessCapacityTerminationPolicyNoTermination))
+			}),
+			f: fleetData(),
+		},
+		"VersionChanged": {
+			p: fleetParameters(func(p *manualv1alpha1.EC2FleetParameters) {
+				p.LaunchTemplateConfigs[0].LaunchTemplateSpecification.Version = "2"
+			}),
+			f: fleetData(),
+		},
+		"OverrideAdded": {
+			p: fleetParameters(func(p *manualv1alpha1.EC2FleetParameters) {
+				p.LaunchTemplateConfigs[0].Overrides = append(p.LaunchTemplateConfigs[0].Overrides, manualv1alpha1.EC2FleetLaunchTemplateOverrides{
+					InstanceType: aws.String("m5a.large"),
+				})
+			}),
+			f: fleetData(),
+		},
+		"OverrideWeightChanged": {
+			p: fleetParameters(func(p *manualv1alpha1.EC2FleetParameters) {
+				p.LaunchTemplateConfigs[0].Overrides[0].WeightedCapacity = aws.Int32(1)
+			}),
+			f: fleetData(),
+		},
+		"MissingTargetCapacity": {
+			p: fleetParameters(),
+			f: fleetData(func(f *ec2types.FleetData) {
+				f.TargetCapacitySpecification = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEC2FleetConfigUpToDate(tc.p, tc.f)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsEC2FleetConfigUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
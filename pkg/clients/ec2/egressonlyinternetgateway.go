@@ -0,0 +1,83 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// EgressOnlyInternetGatewayIDNotFound is the code that is returned by ec2
+	// when the given EgressOnlyInternetGatewayID is not valid
+	EgressOnlyInternetGatewayIDNotFound = "InvalidGatewayID.NotFound"
+)
+
+// EgressOnlyInternetGatewayClient is the external client used for
+// EgressOnlyInternetGateway Custom Resource
+type EgressOnlyInternetGatewayClient interface {
+	CreateEgressOnlyInternetGateway(ctx context.Context, input *ec2.CreateEgressOnlyInternetGatewayInput, opts ...func(*ec2.Options)) (*ec2.CreateEgressOnlyInternetGatewayOutput, error)
+	DeleteEgressOnlyInternetGateway(ctx context.Context, input *ec2.DeleteEgressOnlyInternetGatewayInput, opts ...func(*ec2.Options)) (*ec2.DeleteEgressOnlyInternetGatewayOutput, error)
+	DescribeEgressOnlyInternetGateways(ctx context.Context, input *ec2.DescribeEgressOnlyInternetGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeEgressOnlyInternetGatewaysOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+}
+
+// NewEgressOnlyInternetGatewayClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewEgressOnlyInternetGatewayClient(cfg aws.Config) EgressOnlyInternetGatewayClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsEgressOnlyInternetGatewayNotFoundErr returns true if the error is because
+// the item doesn't exist
+func IsEgressOnlyInternetGatewayNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == EgressOnlyInternetGatewayIDNotFound
+}
+
+// GenerateEgressOnlyIGObservation is used to produce
+// manualv1alpha1.EgressOnlyInternetGatewayObservation from
+// ec2types.EgressOnlyInternetGateway.
+func GenerateEgressOnlyIGObservation(ig ec2types.EgressOnlyInternetGateway) manualv1alpha1.EgressOnlyInternetGatewayObservation {
+	o := manualv1alpha1.EgressOnlyInternetGatewayObservation{
+		EgressOnlyInternetGatewayID: aws.ToString(ig.EgressOnlyInternetGatewayId),
+	}
+	if len(ig.Attachments) > 0 {
+		o.Attachments = make([]manualv1alpha1.EgressOnlyInternetGatewayAttachment, len(ig.Attachments))
+		for i, a := range ig.Attachments {
+			o.Attachments[i] = manualv1alpha1.EgressOnlyInternetGatewayAttachment{
+				AttachmentStatus: string(a.State),
+				VPCID:            aws.ToString(a.VpcId),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeEgressOnlyIG fills the empty fields in
+// *manualv1alpha1.EgressOnlyInternetGatewayParameters with the values seen in
+// ec2types.EgressOnlyInternetGateway.
+func LateInitializeEgressOnlyIG(in *manualv1alpha1.EgressOnlyInternetGatewayParameters, ig *ec2types.EgressOnlyInternetGateway) {
+	if ig == nil {
+		return
+	}
+	if len(ig.Attachments) > 0 {
+		in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, ig.Attachments[0].VpcId)
+	}
+	if len(in.Tags) == 0 && len(ig.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(ig.Tags)
+	}
+}
+
+// IsEgressOnlyIGUpToDate checks whether there is a change in any of the
+// modifiable fields. The VPC of an egress-only internet gateway cannot be
+// changed, so only tags are compared.
+func IsEgressOnlyIGUpToDate(p manualv1alpha1.EgressOnlyInternetGatewayParameters, ig ec2types.EgressOnlyInternetGateway) bool {
+	return manualv1alpha1.CompareTags(p.Tags, ig.Tags)
+}
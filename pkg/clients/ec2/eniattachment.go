@@ -0,0 +1,67 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// ENIAttachmentNotFound is the code that is returned by ec2 when the
+	// given attachment of a network interface doesn't exist
+	ENIAttachmentNotFound = "InvalidAttachmentID.NotFound"
+)
+
+// ENIAttachmentClient is the external client used for ENIAttachment Custom
+// Resource
+type ENIAttachmentClient interface {
+	DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+	AttachNetworkInterface(ctx context.Context, input *ec2.AttachNetworkInterfaceInput, opts ...func(*ec2.Options)) (*ec2.AttachNetworkInterfaceOutput, error)
+	DetachNetworkInterface(ctx context.Context, input *ec2.DetachNetworkInterfaceInput, opts ...func(*ec2.Options)) (*ec2.DetachNetworkInterfaceOutput, error)
+	ModifyNetworkInterfaceAttribute(ctx context.Context, input *ec2.ModifyNetworkInterfaceAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error)
+}
+
+// NewENIAttachmentClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewENIAttachmentClient(cfg aws.Config) ENIAttachmentClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsENIAttachmentNotFoundErr returns true if the error is because the
+// network interface is not attached anymore
+func IsENIAttachmentNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ENIAttachmentNotFound
+}
+
+// FindENIAttachment returns the attachment of the network interface to the
+// given instance, or nil if it is not attached to it.
+func FindENIAttachment(ni ec2types.NetworkInterface, instanceID string) *ec2types.NetworkInterfaceAttachment {
+	if ni.Attachment == nil || aws.ToString(ni.Attachment.InstanceId) != instanceID {
+		return nil
+	}
+	return ni.Attachment
+}
+
+// GenerateENIAttachmentObservation is used to produce
+// manualv1alpha1.ENIAttachmentObservation from
+// ec2types.NetworkInterfaceAttachment.
+func GenerateENIAttachmentObservation(a ec2types.NetworkInterfaceAttachment) manualv1alpha1.ENIAttachmentObservation {
+	return manualv1alpha1.ENIAttachmentObservation{
+		AttachmentID: aws.ToString(a.AttachmentId),
+		Status:       string(a.Status),
+		AttachTime:   FromTimePtr(a.AttachTime),
+	}
+}
+
+// IsENIAttachmentUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsENIAttachmentUpToDate(p manualv1alpha1.ENIAttachmentParameters, a ec2types.NetworkInterfaceAttachment) bool {
+	return p.DeleteOnTermination == nil || aws.ToBool(p.DeleteOnTermination) == aws.ToBool(a.DeleteOnTermination)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CapacityReservationClient = (*MockCapacityReservationClient)(nil)
+
+// MockCapacityReservationClient is a type that implements all the methods for
+// CapacityReservationClient interface
+type MockCapacityReservationClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateCapacityReservationInput, opts []func(*ec2.Options)) (*ec2.CreateCapacityReservationOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeCapacityReservationsInput, opts []func(*ec2.Options)) (*ec2.DescribeCapacityReservationsOutput, error)
+	MockModify     func(ctx context.Context, input *ec2.ModifyCapacityReservationInput, opts []func(*ec2.Options)) (*ec2.ModifyCapacityReservationOutput, error)
+	MockCancel     func(ctx context.Context, input *ec2.CancelCapacityReservationInput, opts []func(*ec2.Options)) (*ec2.CancelCapacityReservationOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateCapacityReservation mocks CreateCapacityReservation method
+func (m *MockCapacityReservationClient) CreateCapacityReservation(ctx context.Context, input *ec2.CreateCapacityReservationInput, opts ...func(*ec2.Options)) (*ec2.CreateCapacityReservationOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeCapacityReservations mocks DescribeCapacityReservations method
+func (m *MockCapacityReservationClient) DescribeCapacityReservations(ctx context.Context, input *ec2.DescribeCapacityReservationsInput, opts ...func(*ec2.Options)) (*ec2.DescribeCapacityReservationsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyCapacityReservation mocks ModifyCapacityReservation method
+func (m *MockCapacityReservationClient) ModifyCapacityReservation(ctx context.Context, input *ec2.ModifyCapacityReservationInput, opts ...func(*ec2.Options)) (*ec2.ModifyCapacityReservationOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// CancelCapacityReservation mocks CancelCapacityReservation method
+func (m *MockCapacityReservationClient) CancelCapacityReservation(ctx context.Context, input *ec2.CancelCapacityReservationInput, opts ...func(*ec2.Options)) (*ec2.CancelCapacityReservationOutput, error) {
+	return m.MockCancel(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockCapacityReservationClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockCapacityReservationClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ClientVPNEndpointClient = (*MockClientVPNEndpointClient)(nil)
+
+// MockClientVPNEndpointClient is a type that implements all the methods for
+// ClientVPNEndpointClient interface
+type MockClientVPNEndpointClient struct {
+	MockCreate                     func(ctx context.Context, input *ec2.CreateClientVpnEndpointInput, opts []func(*ec2.Options)) (*ec2.CreateClientVpnEndpointOutput, error)
+	MockDescribe                   func(ctx context.Context, input *ec2.DescribeClientVpnEndpointsInput, opts []func(*ec2.Options)) (*ec2.DescribeClientVpnEndpointsOutput, error)
+	MockModify                     func(ctx context.Context, input *ec2.ModifyClientVpnEndpointInput, opts []func(*ec2.Options)) (*ec2.ModifyClientVpnEndpointOutput, error)
+	MockDelete                     func(ctx context.Context, input *ec2.DeleteClientVpnEndpointInput, opts []func(*ec2.Options)) (*ec2.DeleteClientVpnEndpointOutput, error)
+	MockExportClientConfiguration  func(ctx context.Context, input *ec2.ExportClientVpnClientConfigurationInput, opts []func(*ec2.Options)) (*ec2.ExportClientVpnClientConfigurationOutput, error)
+	MockDescribeTargetNetworks     func(ctx context.Context, input *ec2.DescribeClientVpnTargetNetworksInput, opts []func(*ec2.Options)) (*ec2.DescribeClientVpnTargetNetworksOutput, error)
+	MockAssociateTargetNetwork     func(ctx context.Context, input *ec2.AssociateClientVpnTargetNetworkInput, opts []func(*ec2.Options)) (*ec2.AssociateClientVpnTargetNetworkOutput, error)
+	MockDisassociateTargetNetwork  func(ctx context.Context, input *ec2.DisassociateClientVpnTargetNetworkInput, opts []func(*ec2.Options)) (*ec2.DisassociateClientVpnTargetNetworkOutput, error)
+	MockDescribeAuthorizationRules func(ctx context.Context, input *ec2.DescribeClientVpnAuthorizationRulesInput, opts []func(*ec2.Options)) (*ec2.DescribeClientVpnAuthorizationRulesOutput, error)
+	MockAuthorizeIngress           func(ctx context.Context, input *ec2.AuthorizeClientVpnIngressInput, opts []func(*ec2.Options)) (*ec2.AuthorizeClientVpnIngressOutput, error)
+	MockRevokeIngress              func(ctx context.Context, input *ec2.RevokeClientVpnIngressInput, opts []func(*ec2.Options)) (*ec2.RevokeClientVpnIngressOutput, error)
+	MockDescribeRoutes             func(ctx context.Context, input *ec2.DescribeClientVpnRoutesInput, opts []func(*ec2.Options)) (*ec2.DescribeClientVpnRoutesOutput, error)
+	MockCreateRoute                func(ctx context.Context, input *ec2.CreateClientVpnRouteInput, opts []func(*ec2.Options)) (*ec2.CreateClientVpnRouteOutput, error)
+	MockDeleteRoute                func(ctx context.Context, input *ec2.DeleteClientVpnRouteInput, opts []func(*ec2.Options)) (*ec2.DeleteClientVpnRouteOutput, error)
+	MockCreateTags                 func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags                 func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateClientVpnEndpoint mocks CreateClientVpnEndpoint method
+func (m *MockClientVPNEndpointClient) CreateClientVpnEndpoint(ctx context.Context, input *ec2.CreateClientVpnEndpointInput, opts ...func(*ec2.Options)) (*ec2.CreateClientVpnEndpointOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeClientVpnEndpoints mocks DescribeClientVpnEndpoints method
+func (m *MockClientVPNEndpointClient) DescribeClientVpnEndpoints(ctx context.Context, input *ec2.DescribeClientVpnEndpointsInput, opts ...func(*ec2.Options)) (*ec2.DescribeClientVpnEndpointsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyClientVpnEndpoint mocks ModifyClientVpnEndpoint method
+func (m *MockClientVPNEndpointClient) ModifyClientVpnEndpoint(ctx context.Context, input *ec2.ModifyClientVpnEndpointInput, opts ...func(*ec2.Options)) (*ec2.ModifyClientVpnEndpointOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// DeleteClientVpnEndpoint mocks DeleteClientVpnEndpoint method
+func (m *MockClientVPNEndpointClient) DeleteClientVpnEndpoint(ctx context.Context, input *ec2.DeleteClientVpnEndpointInput, opts ...func(*ec2.Options)) (*ec2.DeleteClientVpnEndpointOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// ExportClientVpnClientConfiguration mocks ExportClientVpnClientConfiguration method
+func (m *MockClientVPNEndpointClient) ExportClientVpnClientConfiguration(ctx context.Context, input *ec2.ExportClientVpnClientConfigurationInput, opts ...func(*ec2.Options)) (*ec2.ExportClientVpnClientConfigurationOutput, error) {
+	return m.MockExportClientConfiguration(ctx, input, opts)
+}
+
+// DescribeClientVpnTargetNetworks mocks DescribeClientVpnTargetNetworks method
+func (m *MockClientVPNEndpointClient) DescribeClientVpnTargetNetworks(ctx context.Context, input *ec2.DescribeClientVpnTargetNetworksInput, opts ...func(*ec2.Options)) (*ec2.DescribeClientVpnTargetNetworksOutput, error) {
+	return m.MockDescribeTargetNetworks(ctx, input, opts)
+}
+
+// AssociateClientVpnTargetNetwork mocks AssociateClientVpnTargetNetwork method
+func (m *MockClientVPNEndpointClient) AssociateClientVpnTargetNetwork(ctx context.Context, input *ec2.AssociateClientVpnTargetNetworkInput, opts ...func(*ec2.Options)) (*ec2.AssociateClientVpnTargetNetworkOutput, error) {
+	return m.MockAssociateTargetNetwork(ctx, input, opts)
+}
+
+// DisassociateClientVpnTargetNetwork mocks DisassociateClientVpnTargetNetwork method
+func (m *MockClientVPNEndpointClient) DisassociateClientVpnTargetNetwork(ctx context.Context, input *ec2.DisassociateClientVpnTargetNetworkInput, opts ...func(*ec2.Options)) (*ec2.DisassociateClientVpnTargetNetworkOutput, error) {
+	return m.MockDisassociateTargetNetwork(ctx, input, opts)
+}
+
+// DescribeClientVpnAuthorizationRules mocks DescribeClientVpnAuthorizationRules method
+func (m *MockClientVPNEndpointClient) DescribeClientVpnAuthorizationRules(ctx context.Context, input *ec2.DescribeClientVpnAuthorizationRulesInput, opts ...func(*ec2.Options)) (*ec2.DescribeClientVpnAuthorizationRulesOutput, error) {
+	return m.MockDescribeAuthorizationRules(ctx, input, opts)
+}
+
+// AuthorizeClientVpnIngress mocks AuthorizeClientVpnIngress method
+func (m *MockClientVPNEndpointClient) AuthorizeClientVpnIngress(ctx context.Context, input *ec2.AuthorizeClientVpnIngressInput, opts ...func(*ec2.Options)) (*ec2.AuthorizeClientVpnIngressOutput, error) {
+	return m.MockAuthorizeIngress(ctx, input, opts)
+}
+
+// RevokeClientVpnIngress mocks RevokeClientVpnIngress method
+func (m *MockClientVPNEndpointClient) RevokeClientVpnIngress(ctx context.Context, input *ec2.RevokeClientVpnIngressInput, opts ...func(*ec2.Options)) (*ec2.RevokeClientVpnIngressOutput, error) {
+	return m.MockRevokeIngress(ctx, input, opts)
+}
+
+// DescribeClientVpnRoutes mocks DescribeClientVpnRoutes method
+func (m *MockClientVPNEndpointClient) DescribeClientVpnRoutes(ctx context.Context, input *ec2.DescribeClientVpnRoutesInput, opts ...func(*ec2.Options)) (*ec2.DescribeClientVpnRoutesOutput, error) {
+	return m.MockDescribeRoutes(ctx, input, opts)
+}
+
+// CreateClientVpnRoute mocks CreateClientVpnRoute method
+func (m *MockClientVPNEndpointClient) CreateClientVpnRoute(ctx context.Context, input *ec2.CreateClientVpnRouteInput, opts ...func(*ec2.Options)) (*ec2.CreateClientVpnRouteOutput, error) {
+	return m.MockCreateRoute(ctx, input, opts)
+}
+
+// DeleteClientVpnRoute mocks DeleteClientVpnRoute method
+func (m *MockClientVPNEndpointClient) DeleteClientVpnRoute(ctx context.Context, input *ec2.DeleteClientVpnRouteInput, opts ...func(*ec2.Options)) (*ec2.DeleteClientVpnRouteOutput, error) {
+	return m.MockDeleteRoute(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockClientVPNEndpointClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockClientVPNEndpointClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.DHCPOptionsClient = (*MockDHCPOptionsClient)(nil)
+
+// MockDHCPOptionsClient is a type that implements all the methods for
+// DHCPOptionsClient interface
+type MockDHCPOptionsClient struct {
+	MockCreate       func(ctx context.Context, input *ec2.CreateDhcpOptionsInput, opts []func(*ec2.Options)) (*ec2.CreateDhcpOptionsOutput, error)
+	MockDescribe     func(ctx context.Context, input *ec2.DescribeDhcpOptionsInput, opts []func(*ec2.Options)) (*ec2.DescribeDhcpOptionsOutput, error)
+	MockDelete       func(ctx context.Context, input *ec2.DeleteDhcpOptionsInput, opts []func(*ec2.Options)) (*ec2.DeleteDhcpOptionsOutput, error)
+	MockAssociate    func(ctx context.Context, input *ec2.AssociateDhcpOptionsInput, opts []func(*ec2.Options)) (*ec2.AssociateDhcpOptionsOutput, error)
+	MockDescribeVpcs func(ctx context.Context, input *ec2.DescribeVpcsInput, opts []func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	MockCreateTags   func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags   func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateDhcpOptions mocks CreateDhcpOptions method
+func (m *MockDHCPOptionsClient) CreateDhcpOptions(ctx context.Context, input *ec2.CreateDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.CreateDhcpOptionsOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeDhcpOptions mocks DescribeDhcpOptions method
+func (m *MockDHCPOptionsClient) DescribeDhcpOptions(ctx context.Context, input *ec2.DescribeDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeDhcpOptionsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DeleteDhcpOptions mocks DeleteDhcpOptions method
+func (m *MockDHCPOptionsClient) DeleteDhcpOptions(ctx context.Context, input *ec2.DeleteDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.DeleteDhcpOptionsOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// AssociateDhcpOptions mocks AssociateDhcpOptions method
+func (m *MockDHCPOptionsClient) AssociateDhcpOptions(ctx context.Context, input *ec2.AssociateDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.AssociateDhcpOptionsOutput, error) {
+	return m.MockAssociate(ctx, input, opts)
+}
+
+// DescribeVpcs mocks DescribeVpcs method
+func (m *MockDHCPOptionsClient) DescribeVpcs(ctx context.Context, input *ec2.DescribeVpcsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+	return m.MockDescribeVpcs(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockDHCPOptionsClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockDHCPOptionsClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
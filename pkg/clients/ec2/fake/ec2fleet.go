@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EC2FleetClient = (*MockEC2FleetClient)(nil)
+
+// MockEC2FleetClient is a type that implements all the methods for
+// EC2FleetClient interface
+type MockEC2FleetClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateFleetInput, opts []func(*ec2.Options)) (*ec2.CreateFleetOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeFleetsInput, opts []func(*ec2.Options)) (*ec2.DescribeFleetsOutput, error)
+	MockModify     func(ctx context.Context, input *ec2.ModifyFleetInput, opts []func(*ec2.Options)) (*ec2.ModifyFleetOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteFleetsInput, opts []func(*ec2.Options)) (*ec2.DeleteFleetsOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateFleet mocks CreateFleet method
+func (m *MockEC2FleetClient) CreateFleet(ctx context.Context, input *ec2.CreateFleetInput, opts ...func(*ec2.Options)) (*ec2.CreateFleetOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeFleets mocks DescribeFleets method
+func (m *MockEC2FleetClient) DescribeFleets(ctx context.Context, input *ec2.DescribeFleetsInput, opts ...func(*ec2.Options)) (*ec2.DescribeFleetsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyFleet mocks ModifyFleet method
+func (m *MockEC2FleetClient) ModifyFleet(ctx context.Context, input *ec2.ModifyFleetInput, opts ...func(*ec2.Options)) (*ec2.ModifyFleetOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// DeleteFleets mocks DeleteFleets method
+func (m *MockEC2FleetClient) DeleteFleets(ctx context.Context, input *ec2.DeleteFleetsInput, opts ...func(*ec2.Options)) (*ec2.DeleteFleetsOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockEC2FleetClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockEC2FleetClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
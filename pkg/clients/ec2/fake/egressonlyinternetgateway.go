@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EgressOnlyInternetGatewayClient = (*MockEgressOnlyInternetGatewayClient)(nil)
+
+// MockEgressOnlyInternetGatewayClient is a type that implements all the
+// methods for EgressOnlyInternetGatewayClient interface
+type MockEgressOnlyInternetGatewayClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateEgressOnlyInternetGatewayInput, opts []func(*ec2.Options)) (*ec2.CreateEgressOnlyInternetGatewayOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteEgressOnlyInternetGatewayInput, opts []func(*ec2.Options)) (*ec2.DeleteEgressOnlyInternetGatewayOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeEgressOnlyInternetGatewaysInput, opts []func(*ec2.Options)) (*ec2.DescribeEgressOnlyInternetGatewaysOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+}
+
+// CreateEgressOnlyInternetGateway mocks CreateEgressOnlyInternetGateway method
+func (m *MockEgressOnlyInternetGatewayClient) CreateEgressOnlyInternetGateway(ctx context.Context, input *ec2.CreateEgressOnlyInternetGatewayInput, opts ...func(*ec2.Options)) (*ec2.CreateEgressOnlyInternetGatewayOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DeleteEgressOnlyInternetGateway mocks DeleteEgressOnlyInternetGateway method
+func (m *MockEgressOnlyInternetGatewayClient) DeleteEgressOnlyInternetGateway(ctx context.Context, input *ec2.DeleteEgressOnlyInternetGatewayInput, opts ...func(*ec2.Options)) (*ec2.DeleteEgressOnlyInternetGatewayOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// DescribeEgressOnlyInternetGateways mocks DescribeEgressOnlyInternetGateways method
+func (m *MockEgressOnlyInternetGatewayClient) DescribeEgressOnlyInternetGateways(ctx context.Context, input *ec2.DescribeEgressOnlyInternetGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeEgressOnlyInternetGatewaysOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockEgressOnlyInternetGatewayClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ENIAttachmentClient = (*MockENIAttachmentClient)(nil)
+
+// MockENIAttachmentClient is a type that implements all the methods for
+// ENIAttachmentClient interface
+type MockENIAttachmentClient struct {
+	MockDescribe        func(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts []func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+	MockAttach          func(ctx context.Context, input *ec2.AttachNetworkInterfaceInput, opts []func(*ec2.Options)) (*ec2.AttachNetworkInterfaceOutput, error)
+	MockDetach          func(ctx context.Context, input *ec2.DetachNetworkInterfaceInput, opts []func(*ec2.Options)) (*ec2.DetachNetworkInterfaceOutput, error)
+	MockModifyAttribute func(ctx context.Context, input *ec2.ModifyNetworkInterfaceAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error)
+}
+
+// DescribeNetworkInterfaces mocks DescribeNetworkInterfaces method
+func (m *MockENIAttachmentClient) DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// AttachNetworkInterface mocks AttachNetworkInterface method
+func (m *MockENIAttachmentClient) AttachNetworkInterface(ctx context.Context, input *ec2.AttachNetworkInterfaceInput, opts ...func(*ec2.Options)) (*ec2.AttachNetworkInterfaceOutput, error) {
+	return m.MockAttach(ctx, input, opts)
+}
+
+// DetachNetworkInterface mocks DetachNetworkInterface method
+func (m *MockENIAttachmentClient) DetachNetworkInterface(ctx context.Context, input *ec2.DetachNetworkInterfaceInput, opts ...func(*ec2.Options)) (*ec2.DetachNetworkInterfaceOutput, error) {
+	return m.MockDetach(ctx, input, opts)
+}
+
+// ModifyNetworkInterfaceAttribute mocks ModifyNetworkInterfaceAttribute method
+func (m *MockENIAttachmentClient) ModifyNetworkInterfaceAttribute(ctx context.Context, input *ec2.ModifyNetworkInterfaceAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error) {
+	return m.MockModifyAttribute(ctx, input, opts)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ImageClient = (*MockImageClient)(nil)
+
+// MockImageClient is a type that implements all the methods for
+// ImageClient interface
+type MockImageClient struct {
+	MockCopy               func(ctx context.Context, input *ec2.CopyImageInput, opts []func(*ec2.Options)) (*ec2.CopyImageOutput, error)
+	MockCreate             func(ctx context.Context, input *ec2.CreateImageInput, opts []func(*ec2.Options)) (*ec2.CreateImageOutput, error)
+	MockDescribe           func(ctx context.Context, input *ec2.DescribeImagesInput, opts []func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	MockDescribeAttribute  func(ctx context.Context, input *ec2.DescribeImageAttributeInput, opts []func(*ec2.Options)) (*ec2.DescribeImageAttributeOutput, error)
+	MockModifyAttribute    func(ctx context.Context, input *ec2.ModifyImageAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifyImageAttributeOutput, error)
+	MockEnableDeprecation  func(ctx context.Context, input *ec2.EnableImageDeprecationInput, opts []func(*ec2.Options)) (*ec2.EnableImageDeprecationOutput, error)
+	MockDisableDeprecation func(ctx context.Context, input *ec2.DisableImageDeprecationInput, opts []func(*ec2.Options)) (*ec2.DisableImageDeprecationOutput, error)
+	MockDeregister         func(ctx context.Context, input *ec2.DeregisterImageInput, opts []func(*ec2.Options)) (*ec2.DeregisterImageOutput, error)
+	MockDeleteSnapshot     func(ctx context.Context, input *ec2.DeleteSnapshotInput, opts []func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error)
+	MockCreateTags         func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags         func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CopyImage mocks CopyImage method
+func (m *MockImageClient) CopyImage(ctx context.Context, input *ec2.CopyImageInput, opts ...func(*ec2.Options)) (*ec2.CopyImageOutput, error) {
+	return m.MockCopy(ctx, input, opts)
+}
+
+// CreateImage mocks CreateImage method
+func (m *MockImageClient) CreateImage(ctx context.Context, input *ec2.CreateImageInput, opts ...func(*ec2.Options)) (*ec2.CreateImageOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeImages mocks DescribeImages method
+func (m *MockImageClient) DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, opts ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DescribeImageAttribute mocks DescribeImageAttribute method
+func (m *MockImageClient) DescribeImageAttribute(ctx context.Context, input *ec2.DescribeImageAttributeInput, opts ...func(*ec2.Options)) (*ec2.DescribeImageAttributeOutput, error) {
+	return m.MockDescribeAttribute(ctx, input, opts)
+}
+
+// ModifyImageAttribute mocks ModifyImageAttribute method
+func (m *MockImageClient) ModifyImageAttribute(ctx context.Context, input *ec2.ModifyImageAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyImageAttributeOutput, error) {
+	return m.MockModifyAttribute(ctx, input, opts)
+}
+
+// EnableImageDeprecation mocks EnableImageDeprecation method
+func (m *MockImageClient) EnableImageDeprecation(ctx context.Context, input *ec2.EnableImageDeprecationInput, opts ...func(*ec2.Options)) (*ec2.EnableImageDeprecationOutput, error) {
+	return m.MockEnableDeprecation(ctx, input, opts)
+}
+
+// DisableImageDeprecation mocks DisableImageDeprecation method
+func (m *MockImageClient) DisableImageDeprecation(ctx context.Context, input *ec2.DisableImageDeprecationInput, opts ...func(*ec2.Options)) (*ec2.DisableImageDeprecationOutput, error) {
+	return m.MockDisableDeprecation(ctx, input, opts)
+}
+
+// DeregisterImage mocks DeregisterImage method
+func (m *MockImageClient) DeregisterImage(ctx context.Context, input *ec2.DeregisterImageInput, opts ...func(*ec2.Options)) (*ec2.DeregisterImageOutput, error) {
+	return m.MockDeregister(ctx, input, opts)
+}
+
+// DeleteSnapshot mocks DeleteSnapshot method
+func (m *MockImageClient) DeleteSnapshot(ctx context.Context, input *ec2.DeleteSnapshotInput, opts ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error) {
+	return m.MockDeleteSnapshot(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockImageClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockImageClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
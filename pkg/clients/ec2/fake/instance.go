@@ -35,6 +35,8 @@ type MockInstanceClient struct {
 	MockDescribeInstanceAttribute func(context.Context, *ec2.DescribeInstanceAttributeInput, []func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	MockModifyInstanceAttribute   func(context.Context, *ec2.ModifyInstanceAttributeInput, []func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
 	MockCreateTags                func(context.Context, *ec2.CreateTagsInput, []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockStartInstances            func(context.Context, *ec2.StartInstancesInput, []func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	MockStopInstances             func(context.Context, *ec2.StopInstancesInput, []func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 }
 
 // RunInstances mocks RunInstances method
@@ -66,3 +68,13 @@ func (m *MockInstanceClient) ModifyInstanceAttribute(ctx context.Context, input
 func (m *MockInstanceClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
 	return m.MockCreateTags(ctx, input, opts)
 }
+
+// StartInstances mocks StartInstances method
+func (m *MockInstanceClient) StartInstances(ctx context.Context, input *ec2.StartInstancesInput, opts ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	return m.MockStartInstances(ctx, input, opts)
+}
+
+// StopInstances mocks StopInstances method
+func (m *MockInstanceClient) StopInstances(ctx context.Context, input *ec2.StopInstancesInput, opts ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	return m.MockStopInstances(ctx, input, opts)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// MockLaunchTemplateClient for testing
+type MockLaunchTemplateClient struct {
+	ec2iface.EC2API
+
+	MockDescribeLaunchTemplateVersionsWithContext func(context.Context, *ec2.DescribeLaunchTemplateVersionsInput, ...request.Option) (*ec2.DescribeLaunchTemplateVersionsOutput, error)
+	MockCreateLaunchTemplateVersionWithContext    func(context.Context, *ec2.CreateLaunchTemplateVersionInput, ...request.Option) (*ec2.CreateLaunchTemplateVersionOutput, error)
+	MockDeleteLaunchTemplateVersionsWithContext   func(context.Context, *ec2.DeleteLaunchTemplateVersionsInput, ...request.Option) (*ec2.DeleteLaunchTemplateVersionsOutput, error)
+}
+
+// DescribeLaunchTemplateVersionsWithContext mocks DescribeLaunchTemplateVersionsWithContext
+func (m *MockLaunchTemplateClient) DescribeLaunchTemplateVersionsWithContext(ctx context.Context, input *ec2.DescribeLaunchTemplateVersionsInput, opts ...request.Option) (*ec2.DescribeLaunchTemplateVersionsOutput, error) {
+	return m.MockDescribeLaunchTemplateVersionsWithContext(ctx, input, opts...)
+}
+
+// CreateLaunchTemplateVersionWithContext mocks CreateLaunchTemplateVersionWithContext
+func (m *MockLaunchTemplateClient) CreateLaunchTemplateVersionWithContext(ctx context.Context, input *ec2.CreateLaunchTemplateVersionInput, opts ...request.Option) (*ec2.CreateLaunchTemplateVersionOutput, error) {
+	return m.MockCreateLaunchTemplateVersionWithContext(ctx, input, opts...)
+}
+
+// DeleteLaunchTemplateVersionsWithContext mocks DeleteLaunchTemplateVersionsWithContext
+func (m *MockLaunchTemplateClient) DeleteLaunchTemplateVersionsWithContext(ctx context.Context, input *ec2.DeleteLaunchTemplateVersionsInput, opts ...request.Option) (*ec2.DeleteLaunchTemplateVersionsOutput, error) {
+	return m.MockDeleteLaunchTemplateVersionsWithContext(ctx, input, opts...)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NetworkInterfaceClient = (*MockNetworkInterfaceClient)(nil)
+
+// MockNetworkInterfaceClient is a type that implements all the methods for
+// NetworkInterfaceClient interface
+type MockNetworkInterfaceClient struct {
+	MockCreate                     func(ctx context.Context, input *ec2.CreateNetworkInterfaceInput, opts []func(*ec2.Options)) (*ec2.CreateNetworkInterfaceOutput, error)
+	MockDescribe                   func(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts []func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+	MockModifyAttribute            func(ctx context.Context, input *ec2.ModifyNetworkInterfaceAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error)
+	MockAssignPrivateIPAddresses   func(ctx context.Context, input *ec2.AssignPrivateIpAddressesInput, opts []func(*ec2.Options)) (*ec2.AssignPrivateIpAddressesOutput, error)
+	MockUnassignPrivateIPAddresses func(ctx context.Context, input *ec2.UnassignPrivateIpAddressesInput, opts []func(*ec2.Options)) (*ec2.UnassignPrivateIpAddressesOutput, error)
+	MockDelete                     func(ctx context.Context, input *ec2.DeleteNetworkInterfaceInput, opts []func(*ec2.Options)) (*ec2.DeleteNetworkInterfaceOutput, error)
+	MockCreateTags                 func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags                 func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateNetworkInterface mocks CreateNetworkInterface method
+func (m *MockNetworkInterfaceClient) CreateNetworkInterface(ctx context.Context, input *ec2.CreateNetworkInterfaceInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkInterfaceOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeNetworkInterfaces mocks DescribeNetworkInterfaces method
+func (m *MockNetworkInterfaceClient) DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyNetworkInterfaceAttribute mocks ModifyNetworkInterfaceAttribute method
+func (m *MockNetworkInterfaceClient) ModifyNetworkInterfaceAttribute(ctx context.Context, input *ec2.ModifyNetworkInterfaceAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error) {
+	return m.MockModifyAttribute(ctx, input, opts)
+}
+
+// AssignPrivateIpAddresses mocks AssignPrivateIpAddresses method
+func (m *MockNetworkInterfaceClient) AssignPrivateIpAddresses(ctx context.Context, input *ec2.AssignPrivateIpAddressesInput, opts ...func(*ec2.Options)) (*ec2.AssignPrivateIpAddressesOutput, error) {
+	return m.MockAssignPrivateIPAddresses(ctx, input, opts)
+}
+
+// UnassignPrivateIpAddresses mocks UnassignPrivateIpAddresses method
+func (m *MockNetworkInterfaceClient) UnassignPrivateIpAddresses(ctx context.Context, input *ec2.UnassignPrivateIpAddressesInput, opts ...func(*ec2.Options)) (*ec2.UnassignPrivateIpAddressesOutput, error) {
+	return m.MockUnassignPrivateIPAddresses(ctx, input, opts)
+}
+
+// DeleteNetworkInterface mocks DeleteNetworkInterface method
+func (m *MockNetworkInterfaceClient) DeleteNetworkInterface(ctx context.Context, input *ec2.DeleteNetworkInterfaceInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkInterfaceOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockNetworkInterfaceClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockNetworkInterfaceClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// MockRouteClient for testing
+type MockRouteClient struct {
+	ec2iface.EC2API
+
+	MockDescribeRouteTablesWithContext func(context.Context, *ec2.DescribeRouteTablesInput, ...request.Option) (*ec2.DescribeRouteTablesOutput, error)
+	MockReplaceRouteWithContext        func(context.Context, *ec2.ReplaceRouteInput, ...request.Option) (*ec2.ReplaceRouteOutput, error)
+}
+
+// DescribeRouteTablesWithContext mocks DescribeRouteTablesWithContext
+func (m *MockRouteClient) DescribeRouteTablesWithContext(ctx context.Context, input *ec2.DescribeRouteTablesInput, opts ...request.Option) (*ec2.DescribeRouteTablesOutput, error) {
+	return m.MockDescribeRouteTablesWithContext(ctx, input, opts...)
+}
+
+// ReplaceRouteWithContext mocks ReplaceRouteWithContext
+func (m *MockRouteClient) ReplaceRouteWithContext(ctx context.Context, input *ec2.ReplaceRouteInput, opts ...request.Option) (*ec2.ReplaceRouteOutput, error) {
+	return m.MockReplaceRouteWithContext(ctx, input, opts...)
+}
@@ -29,11 +29,13 @@ var _ clientset.SubnetClient = (*MockSubnetClient)(nil)
 
 // MockSubnetClient is a type that implements all the methods for SubnetClient interface
 type MockSubnetClient struct {
-	MockCreate     func(ctx context.Context, input *ec2.CreateSubnetInput, opts []func(*ec2.Options)) (*ec2.CreateSubnetOutput, error)
-	MockDelete     func(ctx context.Context, input *ec2.DeleteSubnetInput, opts []func(*ec2.Options)) (*ec2.DeleteSubnetOutput, error)
-	MockDescribe   func(ctx context.Context, input *ec2.DescribeSubnetsInput, opts []func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
-	MockModify     func(ctx context.Context, input *ec2.ModifySubnetAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifySubnetAttributeOutput, error)
-	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockCreate       func(ctx context.Context, input *ec2.CreateSubnetInput, opts []func(*ec2.Options)) (*ec2.CreateSubnetOutput, error)
+	MockDelete       func(ctx context.Context, input *ec2.DeleteSubnetInput, opts []func(*ec2.Options)) (*ec2.DeleteSubnetOutput, error)
+	MockDescribe     func(ctx context.Context, input *ec2.DescribeSubnetsInput, opts []func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
+	MockModify       func(ctx context.Context, input *ec2.ModifySubnetAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifySubnetAttributeOutput, error)
+	MockCreateTags   func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockAssociate    func(ctx context.Context, input *ec2.AssociateSubnetCidrBlockInput, opts []func(*ec2.Options)) (*ec2.AssociateSubnetCidrBlockOutput, error)
+	MockDisassociate func(ctx context.Context, input *ec2.DisassociateSubnetCidrBlockInput, opts []func(*ec2.Options)) (*ec2.DisassociateSubnetCidrBlockOutput, error)
 }
 
 // CreateSubnet mocks CreateSubnet method
@@ -60,3 +62,13 @@ func (m *MockSubnetClient) ModifySubnetAttribute(ctx context.Context, input *ec2
 func (m *MockSubnetClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
 	return m.MockCreateTags(ctx, input, opts)
 }
+
+// AssociateSubnetCidrBlock mocks AssociateSubnetCidrBlock method
+func (m *MockSubnetClient) AssociateSubnetCidrBlock(ctx context.Context, input *ec2.AssociateSubnetCidrBlockInput, opts ...func(*ec2.Options)) (*ec2.AssociateSubnetCidrBlockOutput, error) {
+	return m.MockAssociate(ctx, input, opts)
+}
+
+// DisassociateSubnetCidrBlock mocks DisassociateSubnetCidrBlock method
+func (m *MockSubnetClient) DisassociateSubnetCidrBlock(ctx context.Context, input *ec2.DisassociateSubnetCidrBlockInput, opts ...func(*ec2.Options)) (*ec2.DisassociateSubnetCidrBlockOutput, error) {
+	return m.MockDisassociate(ctx, input, opts)
+}
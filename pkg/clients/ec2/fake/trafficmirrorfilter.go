@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TrafficMirrorFilterClient = (*MockTrafficMirrorFilterClient)(nil)
+
+// MockTrafficMirrorFilterClient is a type that implements all the methods for
+// TrafficMirrorFilterClient interface
+type MockTrafficMirrorFilterClient struct {
+	MockCreate                func(ctx context.Context, input *ec2.CreateTrafficMirrorFilterInput, opts []func(*ec2.Options)) (*ec2.CreateTrafficMirrorFilterOutput, error)
+	MockDescribe              func(ctx context.Context, input *ec2.DescribeTrafficMirrorFiltersInput, opts []func(*ec2.Options)) (*ec2.DescribeTrafficMirrorFiltersOutput, error)
+	MockModifyNetworkServices func(ctx context.Context, input *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, opts []func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterNetworkServicesOutput, error)
+	MockDelete                func(ctx context.Context, input *ec2.DeleteTrafficMirrorFilterInput, opts []func(*ec2.Options)) (*ec2.DeleteTrafficMirrorFilterOutput, error)
+	MockCreateRule            func(ctx context.Context, input *ec2.CreateTrafficMirrorFilterRuleInput, opts []func(*ec2.Options)) (*ec2.CreateTrafficMirrorFilterRuleOutput, error)
+	MockModifyRule            func(ctx context.Context, input *ec2.ModifyTrafficMirrorFilterRuleInput, opts []func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterRuleOutput, error)
+	MockDeleteRule            func(ctx context.Context, input *ec2.DeleteTrafficMirrorFilterRuleInput, opts []func(*ec2.Options)) (*ec2.DeleteTrafficMirrorFilterRuleOutput, error)
+	MockCreateTags            func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags            func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateTrafficMirrorFilter mocks CreateTrafficMirrorFilter method
+func (m *MockTrafficMirrorFilterClient) CreateTrafficMirrorFilter(ctx context.Context, input *ec2.CreateTrafficMirrorFilterInput, opts ...func(*ec2.Options)) (*ec2.CreateTrafficMirrorFilterOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeTrafficMirrorFilters mocks DescribeTrafficMirrorFilters method
+func (m *MockTrafficMirrorFilterClient) DescribeTrafficMirrorFilters(ctx context.Context, input *ec2.DescribeTrafficMirrorFiltersInput, opts ...func(*ec2.Options)) (*ec2.DescribeTrafficMirrorFiltersOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyTrafficMirrorFilterNetworkServices mocks ModifyTrafficMirrorFilterNetworkServices method
+func (m *MockTrafficMirrorFilterClient) ModifyTrafficMirrorFilterNetworkServices(ctx context.Context, input *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, opts ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterNetworkServicesOutput, error) {
+	return m.MockModifyNetworkServices(ctx, input, opts)
+}
+
+// DeleteTrafficMirrorFilter mocks DeleteTrafficMirrorFilter method
+func (m *MockTrafficMirrorFilterClient) DeleteTrafficMirrorFilter(ctx context.Context, input *ec2.DeleteTrafficMirrorFilterInput, opts ...func(*ec2.Options)) (*ec2.DeleteTrafficMirrorFilterOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTrafficMirrorFilterRule mocks CreateTrafficMirrorFilterRule method
+func (m *MockTrafficMirrorFilterClient) CreateTrafficMirrorFilterRule(ctx context.Context, input *ec2.CreateTrafficMirrorFilterRuleInput, opts ...func(*ec2.Options)) (*ec2.CreateTrafficMirrorFilterRuleOutput, error) {
+	return m.MockCreateRule(ctx, input, opts)
+}
+
+// ModifyTrafficMirrorFilterRule mocks ModifyTrafficMirrorFilterRule method
+func (m *MockTrafficMirrorFilterClient) ModifyTrafficMirrorFilterRule(ctx context.Context, input *ec2.ModifyTrafficMirrorFilterRuleInput, opts ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterRuleOutput, error) {
+	return m.MockModifyRule(ctx, input, opts)
+}
+
+// DeleteTrafficMirrorFilterRule mocks DeleteTrafficMirrorFilterRule method
+func (m *MockTrafficMirrorFilterClient) DeleteTrafficMirrorFilterRule(ctx context.Context, input *ec2.DeleteTrafficMirrorFilterRuleInput, opts ...func(*ec2.Options)) (*ec2.DeleteTrafficMirrorFilterRuleOutput, error) {
+	return m.MockDeleteRule(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockTrafficMirrorFilterClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockTrafficMirrorFilterClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
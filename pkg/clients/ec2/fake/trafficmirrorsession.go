@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TrafficMirrorSessionClient = (*MockTrafficMirrorSessionClient)(nil)
+
+// MockTrafficMirrorSessionClient is a type that implements all the methods for
+// TrafficMirrorSessionClient interface
+type MockTrafficMirrorSessionClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateTrafficMirrorSessionInput, opts []func(*ec2.Options)) (*ec2.CreateTrafficMirrorSessionOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeTrafficMirrorSessionsInput, opts []func(*ec2.Options)) (*ec2.DescribeTrafficMirrorSessionsOutput, error)
+	MockModify     func(ctx context.Context, input *ec2.ModifyTrafficMirrorSessionInput, opts []func(*ec2.Options)) (*ec2.ModifyTrafficMirrorSessionOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteTrafficMirrorSessionInput, opts []func(*ec2.Options)) (*ec2.DeleteTrafficMirrorSessionOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateTrafficMirrorSession mocks CreateTrafficMirrorSession method
+func (m *MockTrafficMirrorSessionClient) CreateTrafficMirrorSession(ctx context.Context, input *ec2.CreateTrafficMirrorSessionInput, opts ...func(*ec2.Options)) (*ec2.CreateTrafficMirrorSessionOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeTrafficMirrorSessions mocks DescribeTrafficMirrorSessions method
+func (m *MockTrafficMirrorSessionClient) DescribeTrafficMirrorSessions(ctx context.Context, input *ec2.DescribeTrafficMirrorSessionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTrafficMirrorSessionsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyTrafficMirrorSession mocks ModifyTrafficMirrorSession method
+func (m *MockTrafficMirrorSessionClient) ModifyTrafficMirrorSession(ctx context.Context, input *ec2.ModifyTrafficMirrorSessionInput, opts ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorSessionOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// DeleteTrafficMirrorSession mocks DeleteTrafficMirrorSession method
+func (m *MockTrafficMirrorSessionClient) DeleteTrafficMirrorSession(ctx context.Context, input *ec2.DeleteTrafficMirrorSessionInput, opts ...func(*ec2.Options)) (*ec2.DeleteTrafficMirrorSessionOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockTrafficMirrorSessionClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockTrafficMirrorSessionClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -29,13 +29,15 @@ var _ clientset.VPCClient = (*MockVPCClient)(nil)
 
 // MockVPCClient is a type that implements all the methods for VPCClient interface
 type MockVPCClient struct {
-	MockCreate               func(ctx context.Context, input *ec2.CreateVpcInput, opts []func(*ec2.Options)) (*ec2.CreateVpcOutput, error)
-	MockDelete               func(ctx context.Context, input *ec2.DeleteVpcInput, opts []func(*ec2.Options)) (*ec2.DeleteVpcOutput, error)
-	MockDescribe             func(ctx context.Context, input *ec2.DescribeVpcsInput, opts []func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
-	MockModifyAttribute      func(ctx context.Context, input *ec2.ModifyVpcAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifyVpcAttributeOutput, error)
-	MockModifyTenancy        func(ctx context.Context, input *ec2.ModifyVpcTenancyInput, opts []func(*ec2.Options)) (*ec2.ModifyVpcTenancyOutput, error)
-	MockCreateTags           func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
-	MockDescribeVpcAttribute func(ctx context.Context, input *ec2.DescribeVpcAttributeInput, opts []func(*ec2.Options)) (*ec2.DescribeVpcAttributeOutput, error)
+	MockCreate                func(ctx context.Context, input *ec2.CreateVpcInput, opts []func(*ec2.Options)) (*ec2.CreateVpcOutput, error)
+	MockDelete                func(ctx context.Context, input *ec2.DeleteVpcInput, opts []func(*ec2.Options)) (*ec2.DeleteVpcOutput, error)
+	MockDescribe              func(ctx context.Context, input *ec2.DescribeVpcsInput, opts []func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	MockModifyAttribute       func(ctx context.Context, input *ec2.ModifyVpcAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifyVpcAttributeOutput, error)
+	MockModifyTenancy         func(ctx context.Context, input *ec2.ModifyVpcTenancyInput, opts []func(*ec2.Options)) (*ec2.ModifyVpcTenancyOutput, error)
+	MockCreateTags            func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDescribeVpcAttribute  func(ctx context.Context, input *ec2.DescribeVpcAttributeInput, opts []func(*ec2.Options)) (*ec2.DescribeVpcAttributeOutput, error)
+	MockAssociateCIDRBlock    func(ctx context.Context, input *ec2.AssociateVpcCidrBlockInput, opts []func(*ec2.Options)) (*ec2.AssociateVpcCidrBlockOutput, error)
+	MockDisassociateCIDRBlock func(ctx context.Context, input *ec2.DisassociateVpcCidrBlockInput, opts []func(*ec2.Options)) (*ec2.DisassociateVpcCidrBlockOutput, error)
 }
 
 // CreateVpc mocks CreateVpc method
@@ -72,3 +74,13 @@ func (m *MockVPCClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInp
 func (m *MockVPCClient) DescribeVpcAttribute(ctx context.Context, input *ec2.DescribeVpcAttributeInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpcAttributeOutput, error) {
 	return m.MockDescribeVpcAttribute(ctx, input, opts)
 }
+
+// AssociateVpcCidrBlock mocks AssociateVpcCidrBlock method
+func (m *MockVPCClient) AssociateVpcCidrBlock(ctx context.Context, input *ec2.AssociateVpcCidrBlockInput, opts ...func(*ec2.Options)) (*ec2.AssociateVpcCidrBlockOutput, error) {
+	return m.MockAssociateCIDRBlock(ctx, input, opts)
+}
+
+// DisassociateVpcCidrBlock mocks DisassociateVpcCidrBlock method
+func (m *MockVPCClient) DisassociateVpcCidrBlock(ctx context.Context, input *ec2.DisassociateVpcCidrBlockInput, opts ...func(*ec2.Options)) (*ec2.DisassociateVpcCidrBlockOutput, error) {
+	return m.MockDisassociateCIDRBlock(ctx, input, opts)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// MockVPCEndpointServiceConfigurationClient for testing
+type MockVPCEndpointServiceConfigurationClient struct {
+	ec2iface.EC2API
+
+	MockDescribeVpcEndpointServicePermissionsWithContext func(context.Context, *ec2.DescribeVpcEndpointServicePermissionsInput, ...request.Option) (*ec2.DescribeVpcEndpointServicePermissionsOutput, error)
+	MockModifyVpcEndpointServicePermissionsWithContext   func(context.Context, *ec2.ModifyVpcEndpointServicePermissionsInput, ...request.Option) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error)
+	MockDescribeVpcEndpointConnectionsWithContext        func(context.Context, *ec2.DescribeVpcEndpointConnectionsInput, ...request.Option) (*ec2.DescribeVpcEndpointConnectionsOutput, error)
+	MockAcceptVpcEndpointConnectionsWithContext          func(context.Context, *ec2.AcceptVpcEndpointConnectionsInput, ...request.Option) (*ec2.AcceptVpcEndpointConnectionsOutput, error)
+}
+
+// DescribeVpcEndpointServicePermissionsWithContext mocks DescribeVpcEndpointServicePermissionsWithContext
+func (m *MockVPCEndpointServiceConfigurationClient) DescribeVpcEndpointServicePermissionsWithContext(ctx context.Context, input *ec2.DescribeVpcEndpointServicePermissionsInput, opts ...request.Option) (*ec2.DescribeVpcEndpointServicePermissionsOutput, error) {
+	return m.MockDescribeVpcEndpointServicePermissionsWithContext(ctx, input, opts...)
+}
+
+// ModifyVpcEndpointServicePermissionsWithContext mocks ModifyVpcEndpointServicePermissionsWithContext
+func (m *MockVPCEndpointServiceConfigurationClient) ModifyVpcEndpointServicePermissionsWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServicePermissionsInput, opts ...request.Option) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+	return m.MockModifyVpcEndpointServicePermissionsWithContext(ctx, input, opts...)
+}
+
+// DescribeVpcEndpointConnectionsWithContext mocks DescribeVpcEndpointConnectionsWithContext
+func (m *MockVPCEndpointServiceConfigurationClient) DescribeVpcEndpointConnectionsWithContext(ctx context.Context, input *ec2.DescribeVpcEndpointConnectionsInput, opts ...request.Option) (*ec2.DescribeVpcEndpointConnectionsOutput, error) {
+	return m.MockDescribeVpcEndpointConnectionsWithContext(ctx, input, opts...)
+}
+
+// AcceptVpcEndpointConnectionsWithContext mocks AcceptVpcEndpointConnectionsWithContext
+func (m *MockVPCEndpointServiceConfigurationClient) AcceptVpcEndpointConnectionsWithContext(ctx context.Context, input *ec2.AcceptVpcEndpointConnectionsInput, opts ...request.Option) (*ec2.AcceptVpcEndpointConnectionsOutput, error) {
+	return m.MockAcceptVpcEndpointConnectionsWithContext(ctx, input, opts...)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// MockVPCPeeringConnectionClient for testing
+type MockVPCPeeringConnectionClient struct {
+	ec2iface.EC2API
+
+	MockAcceptVpcPeeringConnectionWithContext        func(context.Context, *ec2.AcceptVpcPeeringConnectionInput, ...request.Option) (*ec2.AcceptVpcPeeringConnectionOutput, error)
+	MockModifyVpcPeeringConnectionOptionsWithContext func(context.Context, *ec2.ModifyVpcPeeringConnectionOptionsInput, ...request.Option) (*ec2.ModifyVpcPeeringConnectionOptionsOutput, error)
+	MockDescribeVpcPeeringConnectionsWithContext     func(context.Context, *ec2.DescribeVpcPeeringConnectionsInput, ...request.Option) (*ec2.DescribeVpcPeeringConnectionsOutput, error)
+}
+
+// AcceptVpcPeeringConnectionWithContext mocks AcceptVpcPeeringConnectionWithContext
+func (m *MockVPCPeeringConnectionClient) AcceptVpcPeeringConnectionWithContext(ctx context.Context, input *ec2.AcceptVpcPeeringConnectionInput, opts ...request.Option) (*ec2.AcceptVpcPeeringConnectionOutput, error) {
+	return m.MockAcceptVpcPeeringConnectionWithContext(ctx, input, opts...)
+}
+
+// ModifyVpcPeeringConnectionOptionsWithContext mocks ModifyVpcPeeringConnectionOptionsWithContext
+func (m *MockVPCPeeringConnectionClient) ModifyVpcPeeringConnectionOptionsWithContext(ctx context.Context, input *ec2.ModifyVpcPeeringConnectionOptionsInput, opts ...request.Option) (*ec2.ModifyVpcPeeringConnectionOptionsOutput, error) {
+	return m.MockModifyVpcPeeringConnectionOptionsWithContext(ctx, input, opts...)
+}
+
+// DescribeVpcPeeringConnectionsWithContext mocks DescribeVpcPeeringConnectionsWithContext
+func (m *MockVPCPeeringConnectionClient) DescribeVpcPeeringConnectionsWithContext(ctx context.Context, input *ec2.DescribeVpcPeeringConnectionsInput, opts ...request.Option) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+	return m.MockDescribeVpcPeeringConnectionsWithContext(ctx, input, opts...)
+}
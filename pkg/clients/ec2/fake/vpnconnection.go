@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VPNConnectionClient = (*MockVPNConnectionClient)(nil)
+
+// MockVPNConnectionClient is a type that implements all the methods for
+// VPNConnectionClient interface
+type MockVPNConnectionClient struct {
+	MockCreate              func(ctx context.Context, input *ec2.CreateVpnConnectionInput, opts []func(*ec2.Options)) (*ec2.CreateVpnConnectionOutput, error)
+	MockDescribe            func(ctx context.Context, input *ec2.DescribeVpnConnectionsInput, opts []func(*ec2.Options)) (*ec2.DescribeVpnConnectionsOutput, error)
+	MockDelete              func(ctx context.Context, input *ec2.DeleteVpnConnectionInput, opts []func(*ec2.Options)) (*ec2.DeleteVpnConnectionOutput, error)
+	MockCreateRoute         func(ctx context.Context, input *ec2.CreateVpnConnectionRouteInput, opts []func(*ec2.Options)) (*ec2.CreateVpnConnectionRouteOutput, error)
+	MockDeleteRoute         func(ctx context.Context, input *ec2.DeleteVpnConnectionRouteInput, opts []func(*ec2.Options)) (*ec2.DeleteVpnConnectionRouteOutput, error)
+	MockModifyTunnelOptions func(ctx context.Context, input *ec2.ModifyVpnTunnelOptionsInput, opts []func(*ec2.Options)) (*ec2.ModifyVpnTunnelOptionsOutput, error)
+	MockCreateTags          func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags          func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateVpnConnection mocks CreateVpnConnection method
+func (m *MockVPNConnectionClient) CreateVpnConnection(ctx context.Context, input *ec2.CreateVpnConnectionInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnConnectionOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeVpnConnections mocks DescribeVpnConnections method
+func (m *MockVPNConnectionClient) DescribeVpnConnections(ctx context.Context, input *ec2.DescribeVpnConnectionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpnConnectionsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DeleteVpnConnection mocks DeleteVpnConnection method
+func (m *MockVPNConnectionClient) DeleteVpnConnection(ctx context.Context, input *ec2.DeleteVpnConnectionInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateVpnConnectionRoute mocks CreateVpnConnectionRoute method
+func (m *MockVPNConnectionClient) CreateVpnConnectionRoute(ctx context.Context, input *ec2.CreateVpnConnectionRouteInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnConnectionRouteOutput, error) {
+	return m.MockCreateRoute(ctx, input, opts)
+}
+
+// DeleteVpnConnectionRoute mocks DeleteVpnConnectionRoute method
+func (m *MockVPNConnectionClient) DeleteVpnConnectionRoute(ctx context.Context, input *ec2.DeleteVpnConnectionRouteInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionRouteOutput, error) {
+	return m.MockDeleteRoute(ctx, input, opts)
+}
+
+// ModifyVpnTunnelOptions mocks ModifyVpnTunnelOptions method
+func (m *MockVPNConnectionClient) ModifyVpnTunnelOptions(ctx context.Context, input *ec2.ModifyVpnTunnelOptionsInput, opts ...func(*ec2.Options)) (*ec2.ModifyVpnTunnelOptionsOutput, error) {
+	return m.MockModifyTunnelOptions(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockVPNConnectionClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockVPNConnectionClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
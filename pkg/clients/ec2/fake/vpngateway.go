@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VPNGatewayClient = (*MockVPNGatewayClient)(nil)
+
+// MockVPNGatewayClient is a type that implements all the methods for
+// VPNGatewayClient interface
+type MockVPNGatewayClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateVpnGatewayInput, opts []func(*ec2.Options)) (*ec2.CreateVpnGatewayOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeVpnGatewaysInput, opts []func(*ec2.Options)) (*ec2.DescribeVpnGatewaysOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteVpnGatewayInput, opts []func(*ec2.Options)) (*ec2.DeleteVpnGatewayOutput, error)
+	MockAttach     func(ctx context.Context, input *ec2.AttachVpnGatewayInput, opts []func(*ec2.Options)) (*ec2.AttachVpnGatewayOutput, error)
+	MockDetach     func(ctx context.Context, input *ec2.DetachVpnGatewayInput, opts []func(*ec2.Options)) (*ec2.DetachVpnGatewayOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateVpnGateway mocks CreateVpnGateway method
+func (m *MockVPNGatewayClient) CreateVpnGateway(ctx context.Context, input *ec2.CreateVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnGatewayOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeVpnGateways mocks DescribeVpnGateways method
+func (m *MockVPNGatewayClient) DescribeVpnGateways(ctx context.Context, input *ec2.DescribeVpnGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpnGatewaysOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DeleteVpnGateway mocks DeleteVpnGateway method
+func (m *MockVPNGatewayClient) DeleteVpnGateway(ctx context.Context, input *ec2.DeleteVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnGatewayOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// AttachVpnGateway mocks AttachVpnGateway method
+func (m *MockVPNGatewayClient) AttachVpnGateway(ctx context.Context, input *ec2.AttachVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.AttachVpnGatewayOutput, error) {
+	return m.MockAttach(ctx, input, opts)
+}
+
+// DetachVpnGateway mocks DetachVpnGateway method
+func (m *MockVPNGatewayClient) DetachVpnGateway(ctx context.Context, input *ec2.DetachVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.DetachVpnGatewayOutput, error) {
+	return m.MockDetach(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockVPNGatewayClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockVPNGatewayClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
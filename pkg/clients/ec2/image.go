@@ -0,0 +1,155 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// ImageIDNotFound is the code that is returned by ec2 when the given
+	// ImageID is not valid
+	ImageIDNotFound = "InvalidAMIID.NotFound"
+)
+
+// ImageClient is the external client used for Image Custom Resource
+type ImageClient interface {
+	CopyImage(ctx context.Context, input *ec2.CopyImageInput, opts ...func(*ec2.Options)) (*ec2.CopyImageOutput, error)
+	CreateImage(ctx context.Context, input *ec2.CreateImageInput, opts ...func(*ec2.Options)) (*ec2.CreateImageOutput, error)
+	DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, opts ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	DescribeImageAttribute(ctx context.Context, input *ec2.DescribeImageAttributeInput, opts ...func(*ec2.Options)) (*ec2.DescribeImageAttributeOutput, error)
+	ModifyImageAttribute(ctx context.Context, input *ec2.ModifyImageAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyImageAttributeOutput, error)
+	EnableImageDeprecation(ctx context.Context, input *ec2.EnableImageDeprecationInput, opts ...func(*ec2.Options)) (*ec2.EnableImageDeprecationOutput, error)
+	DisableImageDeprecation(ctx context.Context, input *ec2.DisableImageDeprecationInput, opts ...func(*ec2.Options)) (*ec2.DisableImageDeprecationOutput, error)
+	DeregisterImage(ctx context.Context, input *ec2.DeregisterImageInput, opts ...func(*ec2.Options)) (*ec2.DeregisterImageOutput, error)
+	DeleteSnapshot(ctx context.Context, input *ec2.DeleteSnapshotInput, opts ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewImageClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewImageClient(cfg aws.Config) ImageClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsImageNotFoundErr returns true if the error is because the item doesn't
+// exist
+func IsImageNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ImageIDNotFound
+}
+
+// GenerateImageObservation is used to produce manualv1alpha1.ImageObservation from
+// ec2types.Image.
+func GenerateImageObservation(i ec2types.Image) manualv1alpha1.ImageObservation {
+	return manualv1alpha1.ImageObservation{
+		ImageID:         aws.ToString(i.ImageId),
+		OwnerID:         aws.ToString(i.OwnerId),
+		State:           string(i.State),
+		Architecture:    string(i.Architecture),
+		CreationDate:    aws.ToString(i.CreationDate),
+		DeprecationTime: aws.ToString(i.DeprecationTime),
+		Public:          aws.ToBool(i.Public),
+		SnapshotIDs:     ImageSnapshotIDs(i),
+	}
+}
+
+// ImageSnapshotIDs returns the IDs of the EBS snapshots backing the image.
+func ImageSnapshotIDs(i ec2types.Image) []string {
+	var ids []string
+	for _, m := range i.BlockDeviceMappings {
+		if m.Ebs != nil && aws.ToString(m.Ebs.SnapshotId) != "" {
+			ids = append(ids, aws.ToString(m.Ebs.SnapshotId))
+		}
+	}
+	return ids
+}
+
+// LateInitializeImage fills the empty fields in *manualv1alpha1.ImageParameters with
+// the values seen in ec2types.Image.
+func LateInitializeImage(in *manualv1alpha1.ImageParameters, i *ec2types.Image) {
+	if i == nil {
+		return
+	}
+	if in.Description == nil && aws.ToString(i.Description) != "" {
+		in.Description = i.Description
+	}
+	if len(in.Tags) == 0 && len(i.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(i.Tags)
+	}
+}
+
+// DiffImageLaunchPermissions returns the launch permissions that have to be
+// added to and removed from the image for the observed permissions to match
+// the desired ones. Public launch permissions are not managed.
+func DiffImageLaunchPermissions(desired *manualv1alpha1.ImageLaunchPermissions, observed []ec2types.LaunchPermission) (add, remove []ec2types.LaunchPermission) {
+	var want []ec2types.LaunchPermission
+	if desired != nil {
+		for _, id := range desired.UserIDs {
+			want = append(want, ec2types.LaunchPermission{UserId: aws.String(id)})
+		}
+		for _, arn := range desired.OrganizationARNs {
+			want = append(want, ec2types.LaunchPermission{OrganizationArn: aws.String(arn)})
+		}
+		for _, arn := range desired.OrganizationalUnitARNs {
+			want = append(want, ec2types.LaunchPermission{OrganizationalUnitArn: aws.String(arn)})
+		}
+	}
+	key := func(p ec2types.LaunchPermission) string {
+		return aws.ToString(p.UserId) + "|" + aws.ToString(p.OrganizationArn) + "|" + aws.ToString(p.OrganizationalUnitArn)
+	}
+	have := map[string]bool{}
+	for _, p := range observed {
+		if p.Group != "" {
+			continue
+		}
+		have[key(p)] = true
+	}
+	wanted := map[string]bool{}
+	for _, p := range want {
+		wanted[key(p)] = true
+		if !have[key(p)] {
+			add = append(add, p)
+		}
+	}
+	for _, p := range observed {
+		if p.Group == "" && !wanted[key(p)] {
+			remove = append(remove, p)
+		}
+	}
+	return add, remove
+}
+
+// IsImageDeprecationUpToDate returns true if the observed deprecation time
+// of the image matches the desired one. AWS rounds the deprecation time to
+// the nearest minute.
+func IsImageDeprecationUpToDate(desired *metav1.Time, observed *string) bool {
+	if desired == nil {
+		return aws.ToString(observed) == ""
+	}
+	t, err := time.Parse(time.RFC3339, aws.ToString(observed))
+	if err != nil {
+		return false
+	}
+	return desired.Time.Round(time.Minute).Equal(t)
+}
+
+// IsImageUpToDate checks whether there is a change in any of the modifiable
+// fields.
+func IsImageUpToDate(p manualv1alpha1.ImageParameters, i ec2types.Image, permissions []ec2types.LaunchPermission) bool {
+	add, remove := DiffImageLaunchPermissions(p.LaunchPermissions, permissions)
+	if len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	return IsImageDeprecationUpToDate(p.DeprecateAt, i.DeprecationTime) &&
+		manualv1alpha1.CompareTags(p.Tags, i.Tags)
+}
@@ -0,0 +1,123 @@
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestDiffImageLaunchPermissions(t *testing.T) {
+	orgARN := "arn:aws:organizations::123456789012:organization/o-123"
+	ouARN := "arn:aws:organizations::123456789012:ou/o-123/ou-123"
+
+	type want struct {
+		add    []ec2types.LaunchPermission
+		remove []ec2types.LaunchPermission
+	}
+
+	cases := map[string]struct {
+		desired  *manualv1alpha1.ImageLaunchPermissions
+		observed []ec2types.LaunchPermission
+		want
+	}{
+		"UpToDate": {
+			desired: &manualv1alpha1.ImageLaunchPermissions{
+				UserIDs:                []string{"111111111111"},
+				OrganizationalUnitARNs: []string{ouARN},
+			},
+			observed: []ec2types.LaunchPermission{
+				{OrganizationalUnitArn: aws.String(ouARN)},
+				{UserId: aws.String("111111111111")},
+			},
+		},
+		"ShareWithAccountAndOrganization": {
+			desired: &manualv1alpha1.ImageLaunchPermissions{
+				UserIDs:          []string{"111111111111", "222222222222"},
+				OrganizationARNs: []string{orgARN},
+			},
+			observed: []ec2types.LaunchPermission{
+				{UserId: aws.String("111111111111")},
+			},
+			want: want{
+				add: []ec2types.LaunchPermission{
+					{UserId: aws.String("222222222222")},
+					{OrganizationArn: aws.String(orgARN)},
+				},
+			},
+		},
+		"StopSharing": {
+			observed: []ec2types.LaunchPermission{
+				{UserId: aws.String("111111111111")},
+				{OrganizationalUnitArn: aws.String(ouARN)},
+			},
+			want: want{
+				remove: []ec2types.LaunchPermission{
+					{UserId: aws.String("111111111111")},
+					{OrganizationalUnitArn: aws.String(ouARN)},
+				},
+			},
+		},
+		"PublicIsIgnored": {
+			observed: []ec2types.LaunchPermission{
+				{Group: ec2types.PermissionGroupAll},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffImageLaunchPermissions(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreUnexported(ec2types.LaunchPermission{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.IgnoreUnexported(ec2types.LaunchPermission{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsImageDeprecationUpToDate(t *testing.T) {
+	deprecateAt := metav1.NewTime(time.Date(2022, 1, 1, 12, 30, 20, 0, time.UTC))
+
+	cases := map[string]struct {
+		desired  *metav1.Time
+		observed *string
+		want     bool
+	}{
+		"NotDeprecated": {
+			want: true,
+		},
+		"RoundedToMinute": {
+			desired:  &deprecateAt,
+			observed: aws.String("2022-01-01T12:30:00.000Z"),
+			want:     true,
+		},
+		"DifferentTime": {
+			desired:  &deprecateAt,
+			observed: aws.String("2022-02-01T12:30:00.000Z"),
+		},
+		"DeprecationToBeEnabled": {
+			desired: &deprecateAt,
+		},
+		"DeprecationToBeDisabled": {
+			observed: aws.String("2022-01-01T12:30:00.000Z"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsImageDeprecationUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,7 +15,9 @@ package ec2
 
 import (
 	"context"
-	"errors"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"time"
@@ -24,7 +26,9 @@ import (
 	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
@@ -33,6 +37,9 @@ import (
 const (
 	// InstanceNotFound is the code that is returned by ec2 when the given InstanceID is not valid
 	InstanceNotFound = "InvalidInstanceID.NotFound"
+
+	errGetUserDataSecret    = "cannot get the user data Secret"
+	errGetUserDataConfigMap = "cannot get the user data ConfigMap"
 )
 
 // InstanceClient is the external client used for Instance Custom Resource
@@ -42,6 +49,8 @@ type InstanceClient interface {
 	DescribeInstances(context.Context, *ec2.DescribeInstancesInput, ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
 	DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	ModifyInstanceAttribute(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
+	StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 	CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
 }
 
@@ -79,9 +88,65 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	if awsclients.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
 		return false
 	}
+	if instance.State != nil && !IsInstancePowerStateUpToDate(spec.State, string(instance.State.Name)) {
+		return false
+	}
 	return manualv1alpha1.CompareGroupIDs(spec.SecurityGroupIDs, instance.SecurityGroups)
 }
 
+// IsInstanceStopDesired returns true if the supplied desired power state asks
+// for the instance to be stopped or hibernated.
+func IsInstanceStopDesired(desired *string) bool {
+	switch awsclients.StringValue(desired) {
+	case manualv1alpha1.InstanceStateStopped, manualv1alpha1.InstanceStateHibernated:
+		return true
+	}
+	return false
+}
+
+// IsInstancePowerStateUpToDate returns true if the observed state of the
+// instance is, or is transitioning to, the desired power state. Hibernated
+// instances are reported as stopped by ec2.
+func IsInstancePowerStateUpToDate(desired *string, observed string) bool {
+	switch {
+	case awsclients.StringValue(desired) == manualv1alpha1.InstanceStateRunning:
+		return observed == string(types.InstanceStateNameRunning) || observed == string(types.InstanceStateNamePending)
+	case IsInstanceStopDesired(desired):
+		return observed == string(types.InstanceStateNameStopped) || observed == string(types.InstanceStateNameStopping)
+	}
+	return true
+}
+
+// GetUserData returns the base64-encoded user data of the instance, rendered
+// from the referenced Secret or ConfigMap if there is one.
+func GetUserData(ctx context.Context, kube client.Reader, p manualv1alpha1.InstanceParameters) (*string, error) {
+	switch {
+	case p.UserDataSecretRef != nil:
+		data, err := awsclients.GetSecretData(ctx, kube, *p.UserDataSecretRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetUserDataSecret)
+		}
+		return aws.String(base64.StdEncoding.EncodeToString(data)), nil
+	case p.UserDataConfigMapRef != nil:
+		data, err := awsclients.GetConfigMapData(ctx, kube, *p.UserDataConfigMapRef)
+		if err != nil {
+			return nil, errors.Wrap(err, errGetUserDataConfigMap)
+		}
+		return aws.String(base64.StdEncoding.EncodeToString(data)), nil
+	}
+	return p.UserData, nil
+}
+
+// UserDataHash returns the SHA-256 hash of the supplied base64-encoded user
+// data, or an empty string if there is none.
+func UserDataHash(userData *string) string {
+	if awsclients.StringValue(userData) == "" {
+		return ""
+	}
+	h := sha256.Sum256([]byte(*userData))
+	return hex.EncodeToString(h[:])
+}
+
 // GenerateInstanceObservation is used to produce manualv1alpha1.InstanceObservation from
 // a []ec2.Instance.
 func GenerateInstanceObservation(i types.Instance) manualv1alpha1.InstanceObservation {
@@ -194,7 +259,9 @@ func LateInitializeInstance(in *manualv1alpha1.InstanceParameters, instance *typ
 		in.InstanceType = awsclients.LateInitializeString(in.InstanceType, attributes.InstanceType.Value)
 	}
 
-	if attributes.UserData != nil {
+	// user data that is read from a Secret or ConfigMap must not end up in
+	// the spec.
+	if attributes.UserData != nil && in.UserDataSecretRef == nil && in.UserDataConfigMapRef == nil {
 		in.UserData = awsclients.LateInitializeStringPtr(in.UserData, attributes.UserData.Value)
 	}
 
@@ -719,10 +786,11 @@ func GenerateEC2Placement(p *manualv1alpha1.Placement) *types.Placement {
 	return nil
 }
 
-// GeneratePlacement converts ec2.Placement into an internal Placement
-func GeneratePlacement(p *types.Placement) *manualv1alpha1.Placement {
+// GeneratePlacement converts ec2.Placement into an internal
+// PlacementObservation
+func GeneratePlacement(p *types.Placement) *manualv1alpha1.PlacementObservation {
 	if p != nil {
-		return &manualv1alpha1.Placement{
+		return &manualv1alpha1.PlacementObservation{
 			Affinity:             p.Affinity,
 			AvailabilityZone:     p.AvailabilityZone,
 			GroupName:            p.GroupName,
@@ -14,6 +14,7 @@ limitations under the License.
 package ec2
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -22,10 +23,15 @@ import (
 	"github.com/aws/smithy-go/document"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 const (
@@ -487,7 +493,7 @@ func TestGenerateInstanceObservation(t *testing.T) {
 				},
 				OutpostARN: aws.String(outpostARN),
 				Platform:   string(types.PlatformValuesWindows),
-				Placement: &manualv1alpha1.Placement{
+				Placement: &manualv1alpha1.PlacementObservation{
 					Affinity:             aws.String(placementAff),
 					GroupName:            aws.String(groupName),
 					HostID:               aws.String(hostID),
@@ -865,3 +871,122 @@ func TestGenerateEC2RunInstancesInput(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUserData(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		userData *string
+		err      error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		p    manualv1alpha1.InstanceParameters
+		want want
+	}{
+		"Inline": {
+			p:    manualv1alpha1.InstanceParameters{UserData: aws.String("ZWNobyBoaQ==")},
+			want: want{userData: aws.String("ZWNobyBoaQ==")},
+		},
+		"ConfigMap": {
+			kube: &test.MockClient{
+				MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"user-data": "echo hi"}
+					return nil
+				},
+			},
+			p: manualv1alpha1.InstanceParameters{
+				UserDataConfigMapRef: &awsv1beta1.ConfigMapKeySelector{Name: "cloud-init", Namespace: "default", Key: "user-data"},
+			},
+			want: want{userData: aws.String("ZWNobyBoaQ==")},
+		},
+		"ConfigMapMissing": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			p: manualv1alpha1.InstanceParameters{
+				UserDataConfigMapRef: &awsv1beta1.ConfigMapKeySelector{Name: "cloud-init", Namespace: "default", Key: "user-data"},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetUserDataConfigMap)},
+		},
+		"ConfigMapKeyMissing": {
+			kube: &test.MockClient{
+				MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"other": "echo hi"}
+					return nil
+				},
+			},
+			p: manualv1alpha1.InstanceParameters{
+				UserDataConfigMapRef: &awsv1beta1.ConfigMapKeySelector{Name: "cloud-init", Namespace: "default", Key: "user-data"},
+			},
+			want: want{err: errors.Wrap(errors.New("ConfigMap default/cloud-init has no key user-data"), errGetUserDataConfigMap)},
+		},
+		"SecretKeyMissing": {
+			kube: &test.MockClient{
+				MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"other": []byte("echo hi")}
+					return nil
+				},
+			},
+			p: manualv1alpha1.InstanceParameters{
+				UserDataSecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "cloud-init", Namespace: "default"}, Key: "user-data"},
+			},
+			want: want{err: errors.Wrap(errors.New("Secret default/cloud-init has no key user-data"), errGetUserDataSecret)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			userData, err := GetUserData(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.userData, userData); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstancePowerStateUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *string
+		observed string
+		want     bool
+	}{
+		"Unmanaged": {
+			observed: string(types.InstanceStateNameStopped),
+			want:     true,
+		},
+		"Running": {
+			desired:  aws.String(manualv1alpha1.InstanceStateRunning),
+			observed: string(types.InstanceStateNamePending),
+			want:     true,
+		},
+		"RunningButStopped": {
+			desired:  aws.String(manualv1alpha1.InstanceStateRunning),
+			observed: string(types.InstanceStateNameStopped),
+			want:     false,
+		},
+		"Hibernated": {
+			desired:  aws.String(manualv1alpha1.InstanceStateHibernated),
+			observed: string(types.InstanceStateNameStopped),
+			want:     true,
+		},
+		"StoppedButRunning": {
+			desired:  aws.String(manualv1alpha1.InstanceStateStopped),
+			observed: string(types.InstanceStateNameRunning),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstancePowerStateUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
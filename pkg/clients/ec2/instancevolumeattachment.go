@@ -0,0 +1,73 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// VolumeIDNotFound is the code that is returned by ec2 when the given
+	// VolumeID is not valid
+	VolumeIDNotFound = "InvalidVolume.NotFound"
+
+	// VolumeAttachmentNotFound is the code that is returned by ec2 when the
+	// given volume is not attached to the given instance
+	VolumeAttachmentNotFound = "InvalidAttachment.NotFound"
+)
+
+// InstanceVolumeAttachmentClient is the external client used for
+// InstanceVolumeAttachment Custom Resource
+type InstanceVolumeAttachmentClient interface {
+	DescribeVolumes(ctx context.Context, input *ec2.DescribeVolumesInput, opts ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+	AttachVolume(ctx context.Context, input *ec2.AttachVolumeInput, opts ...func(*ec2.Options)) (*ec2.AttachVolumeOutput, error)
+	DetachVolume(ctx context.Context, input *ec2.DetachVolumeInput, opts ...func(*ec2.Options)) (*ec2.DetachVolumeOutput, error)
+}
+
+// NewInstanceVolumeAttachmentClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewInstanceVolumeAttachmentClient(cfg aws.Config) InstanceVolumeAttachmentClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsVolumeNotFoundErr returns true if the error is because the volume doesn't
+// exist
+func IsVolumeNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VolumeIDNotFound
+}
+
+// IsVolumeAttachmentNotFoundErr returns true if the error is because the
+// volume is not attached to the instance
+func IsVolumeAttachmentNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VolumeAttachmentNotFound
+}
+
+// FindVolumeAttachment returns the attachment of the given volume to the given
+// instance, or nil if the volume is not attached to it.
+func FindVolumeAttachment(v ec2types.Volume, instanceID string) *ec2types.VolumeAttachment {
+	for i := range v.Attachments {
+		if aws.ToString(v.Attachments[i].InstanceId) == instanceID {
+			return &v.Attachments[i]
+		}
+	}
+	return nil
+}
+
+// GenerateInstanceVolumeAttachmentObservation is used to produce
+// manualv1alpha1.InstanceVolumeAttachmentObservation from
+// ec2types.VolumeAttachment.
+func GenerateInstanceVolumeAttachmentObservation(a ec2types.VolumeAttachment) manualv1alpha1.InstanceVolumeAttachmentObservation {
+	return manualv1alpha1.InstanceVolumeAttachmentObservation{
+		State:               string(a.State),
+		AttachTime:          FromTimePtr(a.AttachTime),
+		DeleteOnTermination: a.DeleteOnTermination,
+	}
+}
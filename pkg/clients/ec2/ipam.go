@@ -0,0 +1,144 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// IPAMIDNotFound is the code that is returned by ec2 when the given
+	// IPAMID is not valid
+	IPAMIDNotFound = "InvalidIpamId.NotFound"
+)
+
+// IPAMClient is the external client used for IPAM Custom Resource
+type IPAMClient interface {
+	CreateIpam(ctx context.Context, input *ec2.CreateIpamInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamOutput, error)
+	DescribeIpams(ctx context.Context, input *ec2.DescribeIpamsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamsOutput, error)
+	ModifyIpam(ctx context.Context, input *ec2.ModifyIpamInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamOutput, error)
+	DeleteIpam(ctx context.Context, input *ec2.DeleteIpamInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewIPAMClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewIPAMClient(cfg aws.Config) IPAMClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsIPAMNotFoundErr returns true if the error is because the item doesn't
+// exist
+func IsIPAMNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == IPAMIDNotFound
+}
+
+// GenerateCreateIPAMInput returns the input to create the supplied IPAM.
+func GenerateCreateIPAMInput(p manualv1alpha1.IPAMParameters) *ec2.CreateIpamInput {
+	input := &ec2.CreateIpamInput{
+		Description: p.Description,
+	}
+	for _, r := range p.OperatingRegions {
+		input.OperatingRegions = append(input.OperatingRegions, ec2types.AddIpamOperatingRegion{RegionName: aws.String(r)})
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeIpam,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// GenerateModifyIPAMInput returns the input to update the description and
+// operating regions of the supplied IPAM.
+func GenerateModifyIPAMInput(id string, p manualv1alpha1.IPAMParameters, i ec2types.Ipam) *ec2.ModifyIpamInput {
+	input := &ec2.ModifyIpamInput{
+		IpamId:      aws.String(id),
+		Description: p.Description,
+	}
+	add, remove := DiffIPAMOperatingRegions(p, i.OperatingRegions)
+	for _, r := range add {
+		input.AddOperatingRegions = append(input.AddOperatingRegions, ec2types.AddIpamOperatingRegion{RegionName: aws.String(r)})
+	}
+	for _, r := range remove {
+		input.RemoveOperatingRegions = append(input.RemoveOperatingRegions, ec2types.RemoveIpamOperatingRegion{RegionName: aws.String(r)})
+	}
+	return input
+}
+
+// GenerateIPAMObservation is used to produce manualv1alpha1.IPAMObservation from
+// ec2types.Ipam.
+func GenerateIPAMObservation(i ec2types.Ipam) manualv1alpha1.IPAMObservation {
+	return manualv1alpha1.IPAMObservation{
+		IPAMID:                aws.ToString(i.IpamId),
+		IPAMARN:               aws.ToString(i.IpamArn),
+		IPAMRegion:            aws.ToString(i.IpamRegion),
+		OwnerID:               aws.ToString(i.OwnerId),
+		PrivateDefaultScopeID: aws.ToString(i.PrivateDefaultScopeId),
+		PublicDefaultScopeID:  aws.ToString(i.PublicDefaultScopeId),
+		ScopeCount:            aws.ToInt32(i.ScopeCount),
+		State:                 string(i.State),
+	}
+}
+
+// LateInitializeIPAM fills the empty fields in *manualv1alpha1.IPAMParameters with
+// the values seen in ec2types.Ipam.
+func LateInitializeIPAM(in *manualv1alpha1.IPAMParameters, i *ec2types.Ipam) {
+	if i == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, i.Description)
+	if len(in.OperatingRegions) == 0 {
+		for _, r := range i.OperatingRegions {
+			in.OperatingRegions = append(in.OperatingRegions, aws.ToString(r.RegionName))
+		}
+	}
+	if len(in.Tags) == 0 && len(i.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(i.Tags)
+	}
+}
+
+// DiffIPAMOperatingRegions returns the operating regions that have to be
+// added to and removed from the IPAM.
+func DiffIPAMOperatingRegions(p manualv1alpha1.IPAMParameters, regions []ec2types.IpamOperatingRegion) (add, remove []string) {
+	desired := map[string]bool{}
+	for _, r := range p.OperatingRegions {
+		desired[r] = true
+	}
+	observed := map[string]bool{}
+	for _, r := range regions {
+		name := aws.ToString(r.RegionName)
+		observed[name] = true
+		if !desired[name] {
+			remove = append(remove, name)
+		}
+	}
+	for _, r := range p.OperatingRegions {
+		if !observed[r] {
+			add = append(add, r)
+			observed[r] = true
+		}
+	}
+	sort.Strings(remove)
+	return add, remove
+}
+
+// IsIPAMUpToDate checks whether the description, operating regions and tags
+// of the IPAM are up to date.
+func IsIPAMUpToDate(p manualv1alpha1.IPAMParameters, i ec2types.Ipam) bool {
+	add, remove := DiffIPAMOperatingRegions(p, i.OperatingRegions)
+	return aws.ToString(p.Description) == aws.ToString(i.Description) &&
+		len(add) == 0 && len(remove) == 0 &&
+		manualv1alpha1.CompareTags(p.Tags, i.Tags)
+}
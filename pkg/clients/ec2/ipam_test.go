@@ -0,0 +1,62 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func operatingRegions(names ...string) []ec2types.IpamOperatingRegion {
+	regions := make([]ec2types.IpamOperatingRegion, len(names))
+	for i, n := range names {
+		regions[i] = ec2types.IpamOperatingRegion{RegionName: aws.String(n)}
+	}
+	return regions
+}
+
+func TestDiffIPAMOperatingRegions(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+	cases := map[string]struct {
+		regions  []string
+		observed []ec2types.IpamOperatingRegion
+		want
+	}{
+		"UpToDate": {
+			regions:  []string{"us-east-1", "eu-west-1"},
+			observed: operatingRegions("eu-west-1", "us-east-1"),
+		},
+		"AddAndRemove": {
+			regions:  []string{"us-east-1", "eu-west-1"},
+			observed: operatingRegions("us-west-2", "us-east-1", "ap-south-1"),
+			want: want{
+				add:    []string{"eu-west-1"},
+				remove: []string{"ap-south-1", "us-west-2"},
+			},
+		},
+		"DuplicateDesired": {
+			regions: []string{"us-east-1", "us-east-1"},
+			want: want{
+				add: []string{"us-east-1"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffIPAMOperatingRegions(manualv1alpha1.IPAMParameters{OperatingRegions: tc.regions}, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
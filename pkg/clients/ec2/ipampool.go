@@ -0,0 +1,160 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// IPAMPoolIDNotFound is the code that is returned by ec2 when the given
+	// IPAMPoolID is not valid
+	IPAMPoolIDNotFound = "InvalidIpamPoolId.NotFound"
+)
+
+// IPAMPoolClient is the external client used for IPAMPool Custom Resource
+type IPAMPoolClient interface {
+	CreateIpamPool(ctx context.Context, input *ec2.CreateIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamPoolOutput, error)
+	DescribeIpamPools(ctx context.Context, input *ec2.DescribeIpamPoolsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamPoolsOutput, error)
+	ModifyIpamPool(ctx context.Context, input *ec2.ModifyIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error)
+	DeleteIpamPool(ctx context.Context, input *ec2.DeleteIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamPoolOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewIPAMPoolClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewIPAMPoolClient(cfg aws.Config) IPAMPoolClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsIPAMPoolNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsIPAMPoolNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == IPAMPoolIDNotFound
+}
+
+// GenerateCreateIPAMPoolInput returns the input to create the supplied IPAM
+// pool.
+func GenerateCreateIPAMPoolInput(p manualv1alpha1.IPAMPoolParameters) *ec2.CreateIpamPoolInput {
+	input := &ec2.CreateIpamPoolInput{
+		IpamScopeId:                    p.IPAMScopeID,
+		AddressFamily:                  ec2types.AddressFamily(p.AddressFamily),
+		Locale:                         p.Locale,
+		SourceIpamPoolId:               p.SourceIPAMPoolID,
+		Description:                    p.Description,
+		AutoImport:                     p.AutoImport,
+		PubliclyAdvertisable:           p.PubliclyAdvertisable,
+		AwsService:                     ec2types.IpamPoolAwsService(aws.ToString(p.AWSService)),
+		AllocationDefaultNetmaskLength: p.AllocationDefaultNetmaskLength,
+		AllocationMinNetmaskLength:     p.AllocationMinNetmaskLength,
+		AllocationMaxNetmaskLength:     p.AllocationMaxNetmaskLength,
+	}
+	for _, t := range p.AllocationResourceTags {
+		input.AllocationResourceTags = append(input.AllocationResourceTags, ec2types.RequestIpamResourceTag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeIpamPool,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// GenerateModifyIPAMPoolInput returns the input to update the modifiable
+// attributes of the supplied IPAM pool.
+func GenerateModifyIPAMPoolInput(id string, p manualv1alpha1.IPAMPoolParameters, pool ec2types.IpamPool) *ec2.ModifyIpamPoolInput {
+	input := &ec2.ModifyIpamPoolInput{
+		IpamPoolId:                     aws.String(id),
+		Description:                    p.Description,
+		AutoImport:                     p.AutoImport,
+		AllocationDefaultNetmaskLength: p.AllocationDefaultNetmaskLength,
+		AllocationMinNetmaskLength:     p.AllocationMinNetmaskLength,
+		AllocationMaxNetmaskLength:     p.AllocationMaxNetmaskLength,
+	}
+	input.AddAllocationResourceTags, input.RemoveAllocationResourceTags = DiffIPAMPoolAllocationResourceTags(p, pool.AllocationResourceTags)
+	return input
+}
+
+// GenerateIPAMPoolObservation is used to produce manualv1alpha1.IPAMPoolObservation
+// from ec2types.IpamPool.
+func GenerateIPAMPoolObservation(p ec2types.IpamPool) manualv1alpha1.IPAMPoolObservation {
+	return manualv1alpha1.IPAMPoolObservation{
+		IPAMPoolID:    aws.ToString(p.IpamPoolId),
+		IPAMPoolARN:   aws.ToString(p.IpamPoolArn),
+		IPAMARN:       aws.ToString(p.IpamArn),
+		IPAMScopeType: string(p.IpamScopeType),
+		PoolDepth:     aws.ToInt32(p.PoolDepth),
+		State:         string(p.State),
+		StateMessage:  aws.ToString(p.StateMessage),
+	}
+}
+
+// LateInitializeIPAMPool fills the empty fields in
+// *manualv1alpha1.IPAMPoolParameters with the values seen in ec2types.IpamPool.
+func LateInitializeIPAMPool(in *manualv1alpha1.IPAMPoolParameters, p *ec2types.IpamPool) {
+	if p == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, p.Description)
+	in.Locale = awsclients.LateInitializeStringPtr(in.Locale, p.Locale)
+	in.SourceIPAMPoolID = awsclients.LateInitializeStringPtr(in.SourceIPAMPoolID, p.SourceIpamPoolId)
+	in.AutoImport = awsclients.LateInitializeBoolPtr(in.AutoImport, p.AutoImport)
+	in.PubliclyAdvertisable = awsclients.LateInitializeBoolPtr(in.PubliclyAdvertisable, p.PubliclyAdvertisable)
+	if in.AWSService == nil && p.AwsService != "" {
+		in.AWSService = aws.String(string(p.AwsService))
+	}
+	in.AllocationDefaultNetmaskLength = awsclients.LateInitializeInt32Ptr(in.AllocationDefaultNetmaskLength, p.AllocationDefaultNetmaskLength)
+	in.AllocationMinNetmaskLength = awsclients.LateInitializeInt32Ptr(in.AllocationMinNetmaskLength, p.AllocationMinNetmaskLength)
+	in.AllocationMaxNetmaskLength = awsclients.LateInitializeInt32Ptr(in.AllocationMaxNetmaskLength, p.AllocationMaxNetmaskLength)
+	if len(in.Tags) == 0 && len(p.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(p.Tags)
+	}
+}
+
+// DiffIPAMPoolAllocationResourceTags returns the allocation resource tags
+// that have to be added to and removed from the IPAM pool.
+func DiffIPAMPoolAllocationResourceTags(p manualv1alpha1.IPAMPoolParameters, tags []ec2types.IpamResourceTag) (add, remove []ec2types.RequestIpamResourceTag) {
+	desired := map[string]string{}
+	for _, t := range p.AllocationResourceTags {
+		desired[t.Key] = t.Value
+	}
+	observed := map[string]string{}
+	for _, t := range tags {
+		k, v := aws.ToString(t.Key), aws.ToString(t.Value)
+		observed[k] = v
+		if dv, ok := desired[k]; !ok || dv != v {
+			remove = append(remove, ec2types.RequestIpamResourceTag{Key: t.Key, Value: t.Value})
+		}
+	}
+	for _, t := range p.AllocationResourceTags {
+		if v, ok := observed[t.Key]; !ok || v != t.Value {
+			add = append(add, ec2types.RequestIpamResourceTag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+	}
+	sort.Slice(remove, func(i, j int) bool { return aws.ToString(remove[i].Key) < aws.ToString(remove[j].Key) })
+	return add, remove
+}
+
+// IsIPAMPoolUpToDate checks whether the modifiable attributes of the IPAM
+// pool are up to date.
+func IsIPAMPoolUpToDate(p manualv1alpha1.IPAMPoolParameters, pool ec2types.IpamPool) bool {
+	add, remove := DiffIPAMPoolAllocationResourceTags(p, pool.AllocationResourceTags)
+	return aws.ToString(p.Description) == aws.ToString(pool.Description) &&
+		aws.ToBool(p.AutoImport) == aws.ToBool(pool.AutoImport) &&
+		aws.ToInt32(p.AllocationDefaultNetmaskLength) == aws.ToInt32(pool.AllocationDefaultNetmaskLength) &&
+		aws.ToInt32(p.AllocationMinNetmaskLength) == aws.ToInt32(pool.AllocationMinNetmaskLength) &&
+		aws.ToInt32(p.AllocationMaxNetmaskLength) == aws.ToInt32(pool.AllocationMaxNetmaskLength) &&
+		len(add) == 0 && len(remove) == 0 &&
+		manualv1alpha1.CompareTags(p.Tags, pool.Tags)
+}
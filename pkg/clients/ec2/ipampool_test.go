@@ -0,0 +1,64 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestDiffIPAMPoolAllocationResourceTags(t *testing.T) {
+	type want struct {
+		add    []ec2types.RequestIpamResourceTag
+		remove []ec2types.RequestIpamResourceTag
+	}
+	cases := map[string]struct {
+		tags     []manualv1alpha1.Tag
+		observed []ec2types.IpamResourceTag
+		want
+	}{
+		"UpToDate": {
+			tags:     []manualv1alpha1.Tag{{Key: "env", Value: "prod"}},
+			observed: []ec2types.IpamResourceTag{{Key: aws.String("env"), Value: aws.String("prod")}},
+		},
+		"ValueChanged": {
+			tags:     []manualv1alpha1.Tag{{Key: "env", Value: "prod"}},
+			observed: []ec2types.IpamResourceTag{{Key: aws.String("env"), Value: aws.String("dev")}},
+			want: want{
+				add:    []ec2types.RequestIpamResourceTag{{Key: aws.String("env"), Value: aws.String("prod")}},
+				remove: []ec2types.RequestIpamResourceTag{{Key: aws.String("env"), Value: aws.String("dev")}},
+			},
+		},
+		"AddAndRemove": {
+			tags: []manualv1alpha1.Tag{{Key: "env", Value: "prod"}, {Key: "team", Value: "net"}},
+			observed: []ec2types.IpamResourceTag{
+				{Key: aws.String("owner"), Value: aws.String("me")},
+				{Key: aws.String("env"), Value: aws.String("prod")},
+				{Key: aws.String("cost"), Value: aws.String("42")},
+			},
+			want: want{
+				add: []ec2types.RequestIpamResourceTag{{Key: aws.String("team"), Value: aws.String("net")}},
+				remove: []ec2types.RequestIpamResourceTag{
+					{Key: aws.String("cost"), Value: aws.String("42")},
+					{Key: aws.String("owner"), Value: aws.String("me")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffIPAMPoolAllocationResourceTags(manualv1alpha1.IPAMPoolParameters{AllocationResourceTags: tc.tags}, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreUnexported(ec2types.RequestIpamResourceTag{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.IgnoreUnexported(ec2types.RequestIpamResourceTag{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
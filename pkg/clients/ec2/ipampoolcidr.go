@@ -0,0 +1,54 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+// IPAMPoolCIDRClient is the external client used for IPAMPoolCIDR Custom
+// Resource
+type IPAMPoolCIDRClient interface {
+	ProvisionIpamPoolCidr(ctx context.Context, input *ec2.ProvisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.ProvisionIpamPoolCidrOutput, error)
+	GetIpamPoolCidrs(ctx context.Context, input *ec2.GetIpamPoolCidrsInput, opts ...func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error)
+	DeprovisionIpamPoolCidr(ctx context.Context, input *ec2.DeprovisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.DeprovisionIpamPoolCidrOutput, error)
+}
+
+// NewIPAMPoolCIDRClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewIPAMPoolCIDRClient(cfg aws.Config) IPAMPoolCIDRClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// GenerateProvisionIPAMPoolCIDRInput returns the input to provision the
+// supplied CIDR to its IPAM pool.
+func GenerateProvisionIPAMPoolCIDRInput(p manualv1alpha1.IPAMPoolCIDRParameters) *ec2.ProvisionIpamPoolCidrInput {
+	input := &ec2.ProvisionIpamPoolCidrInput{
+		IpamPoolId: p.IPAMPoolID,
+		Cidr:       aws.String(p.CIDR),
+	}
+	if p.CIDRAuthorizationContext != nil {
+		input.CidrAuthorizationContext = &ec2types.IpamCidrAuthorizationContext{
+			Message:   aws.String(p.CIDRAuthorizationContext.Message),
+			Signature: aws.String(p.CIDRAuthorizationContext.Signature),
+		}
+	}
+	return input
+}
+
+// GenerateIPAMPoolCIDRObservation is used to produce
+// manualv1alpha1.IPAMPoolCIDRObservation from ec2types.IpamPoolCidr.
+func GenerateIPAMPoolCIDRObservation(c ec2types.IpamPoolCidr) manualv1alpha1.IPAMPoolCIDRObservation {
+	o := manualv1alpha1.IPAMPoolCIDRObservation{
+		State: string(c.State),
+	}
+	if c.FailureReason != nil {
+		o.FailureReason = fmt.Sprintf("%s: %s", c.FailureReason.Code, aws.ToString(c.FailureReason.Message))
+	}
+	return o
+}
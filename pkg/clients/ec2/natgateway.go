@@ -3,6 +3,7 @@ package ec2
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -12,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 const (
@@ -53,6 +55,7 @@ func GenerateNATGatewayObservation(nat ec2types.NatGateway) v1beta1.NATGatewayOb
 		}
 	}
 	observation := v1beta1.NATGatewayObservation{
+		ConnectivityType:    string(nat.ConnectivityType),
 		CreateTime:          &metav1.Time{Time: *nat.CreateTime},
 		NatGatewayAddresses: addresses,
 		NatGatewayID:        aws.ToString(nat.NatGatewayId),
@@ -68,3 +71,37 @@ func GenerateNATGatewayObservation(nat ec2types.NatGateway) v1beta1.NATGatewayOb
 	}
 	return observation
 }
+
+// LateInitializeNATGateway fills the empty fields in
+// *v1beta1.NATGatewayParameters with the values seen in ec2types.NatGateway.
+func LateInitializeNATGateway(in *v1beta1.NATGatewayParameters, nat *ec2types.NatGateway) {
+	if nat == nil {
+		return
+	}
+	in.ConnectivityType = awsclients.LateInitializeString(in.ConnectivityType, aws.String(string(nat.ConnectivityType)))
+}
+
+// NATGatewayAllocationDrift returns the drift between the Elastic IP
+// allocation of the supplied parameters and the ones associated with the
+// supplied public NAT gateway. The Elastic IP of a NAT gateway cannot be
+// changed, so the drift can only be reported.
+func NATGatewayAllocationDrift(p v1beta1.NATGatewayParameters, nat ec2types.NatGateway) []awsclients.Drift {
+	if nat.ConnectivityType == ec2types.ConnectivityTypePrivate || p.AllocationID == nil || nat.State != ec2types.NatGatewayStateAvailable {
+		return nil
+	}
+	observed := "(unset)"
+	for _, a := range nat.NatGatewayAddresses {
+		if a.AllocationId == nil {
+			continue
+		}
+		if aws.ToString(a.AllocationId) == aws.ToString(p.AllocationID) {
+			return nil
+		}
+		observed = fmt.Sprintf("%q", aws.ToString(a.AllocationId))
+	}
+	return []awsclients.Drift{{
+		Path:     "allocationId",
+		Desired:  fmt.Sprintf("%q", aws.ToString(p.AllocationID)),
+		Observed: observed,
+	}}
+}
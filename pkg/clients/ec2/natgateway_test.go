@@ -103,6 +103,24 @@ func TestGenerateNATGatewayObservation(t *testing.T) {
 				VpcID:               natVpcID,
 			},
 		},
+		"Private": {
+			in: ec2types.NatGateway{
+				ConnectivityType:    ec2types.ConnectivityTypePrivate,
+				CreateTime:          &time,
+				NatGatewayAddresses: natAddresses(),
+				NatGatewayId:        aws.String(natGatewayID),
+				State:               v1beta1.NatGatewayStatusAvailable,
+				VpcId:               aws.String(natVpcID),
+			},
+			out: v1beta1.NATGatewayObservation{
+				ConnectivityType:    string(ec2types.ConnectivityTypePrivate),
+				CreateTime:          &v1.Time{Time: time},
+				NatGatewayAddresses: specAddresses(),
+				NatGatewayID:        natGatewayID,
+				State:               v1beta1.NatGatewayStatusAvailable,
+				VpcID:               natVpcID,
+			},
+		},
 		"stateFailed": {
 			in: ec2types.NatGateway{
 				CreateTime:          &time,
@@ -137,3 +155,59 @@ func TestGenerateNATGatewayObservation(t *testing.T) {
 		})
 	}
 }
+
+func TestNATGatewayAllocationDrift(t *testing.T) {
+	cases := map[string]struct {
+		p    v1beta1.NATGatewayParameters
+		nat  ec2types.NatGateway
+		want []aws.Drift
+	}{
+		"SameAllocation": {
+			p: v1beta1.NATGatewayParameters{AllocationID: aws.String(natAllocationID)},
+			nat: ec2types.NatGateway{
+				NatGatewayAddresses: natAddresses(),
+				State:               ec2types.NatGatewayStateAvailable,
+			},
+		},
+		"DifferentAllocation": {
+			p: v1beta1.NATGatewayParameters{AllocationID: aws.String("other allocation id")},
+			nat: ec2types.NatGateway{
+				NatGatewayAddresses: natAddresses(),
+				State:               ec2types.NatGatewayStateAvailable,
+			},
+			want: []aws.Drift{{
+				Path:     "allocationId",
+				Desired:  `"other allocation id"`,
+				Observed: `"some allocation id"`,
+			}},
+		},
+		"NoAllocation": {
+			p:   v1beta1.NATGatewayParameters{AllocationID: aws.String(natAllocationID)},
+			nat: ec2types.NatGateway{State: ec2types.NatGatewayStateAvailable},
+			want: []aws.Drift{{
+				Path:     "allocationId",
+				Desired:  `"some allocation id"`,
+				Observed: "(unset)",
+			}},
+		},
+		"Pending": {
+			p:   v1beta1.NATGatewayParameters{AllocationID: aws.String(natAllocationID)},
+			nat: ec2types.NatGateway{State: ec2types.NatGatewayStatePending},
+		},
+		"Private": {
+			p: v1beta1.NATGatewayParameters{AllocationID: aws.String(natAllocationID)},
+			nat: ec2types.NatGateway{
+				ConnectivityType: ec2types.ConnectivityTypePrivate,
+				State:            ec2types.NatGatewayStateAvailable,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NATGatewayAllocationDrift(tc.p, tc.nat)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NATGatewayAllocationDrift(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given
+	// NetworkACLID is not valid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// NetworkACLMaxRuleNumber is the highest rule number of an entry that can
+	// be created. The rule numbers above it belong to the deny-all entries
+	// every network ACL has, 32767 for IPv4 and 32768 for IPv6, which cannot
+	// be changed or removed.
+	NetworkACLMaxRuleNumber = 32766
+)
+
+// protocolNumbers maps the protocol names accepted by ec2 to the numbers it
+// reports.
+var protocolNumbers = map[string]string{
+	"all":    "-1",
+	"icmp":   "1",
+	"tcp":    "6",
+	"udp":    "17",
+	"icmpv6": "58",
+}
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAcl(ctx context.Context, input *ec2.CreateNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error)
+	DeleteNetworkAcl(ctx context.Context, input *ec2.DeleteNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclOutput, error)
+	DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg aws.Config) NetworkACLClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the item doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLIDNotFound
+}
+
+// GenerateNetworkACLObservation is used to produce manualv1alpha1.NetworkACLObservation
+// from ec2types.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2types.NetworkAcl) manualv1alpha1.NetworkACLObservation {
+	o := manualv1alpha1.NetworkACLObservation{
+		NetworkACLID: aws.ToString(acl.NetworkAclId),
+		IsDefault:    aws.ToBool(acl.IsDefault),
+		OwnerID:      aws.ToString(acl.OwnerId),
+	}
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]manualv1alpha1.NetworkACLAssociation, len(acl.Associations))
+		for i, a := range acl.Associations {
+			o.Associations[i] = manualv1alpha1.NetworkACLAssociation{
+				AssociationID: aws.ToString(a.NetworkAclAssociationId),
+				SubnetID:      aws.ToString(a.SubnetId),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeNetworkACL fills the empty fields in
+// *manualv1alpha1.NetworkACLParameters with the values seen in ec2types.NetworkAcl.
+func LateInitializeNetworkACL(in *manualv1alpha1.NetworkACLParameters, acl *ec2types.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, acl.VpcId)
+	if len(in.Tags) == 0 && len(acl.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(acl.Tags)
+	}
+}
+
+// IsNetworkACLUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsNetworkACLUpToDate(p manualv1alpha1.NetworkACLParameters, acl ec2types.NetworkAcl) bool {
+	create, replace, remove := DiffNetworkACLEntries(p.Entries, acl.Entries)
+	if len(create) != 0 || len(replace) != 0 || len(remove) != 0 {
+		return false
+	}
+	return manualv1alpha1.CompareTags(p.Tags, acl.Tags)
+}
+
+type networkACLEntryKey struct {
+	egress     bool
+	ruleNumber int32
+}
+
+// DiffNetworkACLEntries returns the desired entries that need to be created,
+// the ones that need to replace an observed entry with the same rule number
+// and direction, and the observed entries that need to be deleted. The
+// default deny-all entries are never deleted.
+func DiffNetworkACLEntries(desired []manualv1alpha1.NetworkACLEntry, observed []ec2types.NetworkAclEntry) (create, replace []manualv1alpha1.NetworkACLEntry, remove []ec2types.NetworkAclEntry) {
+	current := map[networkACLEntryKey]manualv1alpha1.NetworkACLEntry{}
+	for _, e := range observed {
+		if aws.ToInt32(e.RuleNumber) > NetworkACLMaxRuleNumber {
+			continue
+		}
+		current[networkACLEntryKey{egress: aws.ToBool(e.Egress), ruleNumber: aws.ToInt32(e.RuleNumber)}] = GenerateNetworkACLEntry(e)
+	}
+
+	wanted := map[networkACLEntryKey]bool{}
+	for _, e := range desired {
+		k := networkACLEntryKey{egress: aws.ToBool(e.Egress), ruleNumber: e.RuleNumber}
+		wanted[k] = true
+		c, ok := current[k]
+		switch {
+		case !ok:
+			create = append(create, e)
+		case !cmp.Equal(normalizeNetworkACLEntry(e), c):
+			replace = append(replace, e)
+		}
+	}
+
+	for _, e := range observed {
+		k := networkACLEntryKey{egress: aws.ToBool(e.Egress), ruleNumber: aws.ToInt32(e.RuleNumber)}
+		if k.ruleNumber <= NetworkACLMaxRuleNumber && !wanted[k] {
+			remove = append(remove, e)
+		}
+	}
+	return create, replace, remove
+}
+
+// GenerateNetworkACLEntry is used to produce manualv1alpha1.NetworkACLEntry from
+// ec2types.NetworkAclEntry.
+func GenerateNetworkACLEntry(e ec2types.NetworkAclEntry) manualv1alpha1.NetworkACLEntry {
+	o := manualv1alpha1.NetworkACLEntry{
+		RuleNumber:    aws.ToInt32(e.RuleNumber),
+		Egress:        aws.Bool(aws.ToBool(e.Egress)),
+		Protocol:      aws.ToString(e.Protocol),
+		RuleAction:    string(e.RuleAction),
+		CIDRBlock:     e.CidrBlock,
+		IPv6CIDRBlock: e.Ipv6CidrBlock,
+	}
+	if e.PortRange != nil {
+		o.PortRange = &manualv1alpha1.NetworkACLPortRange{
+			From: aws.ToInt32(e.PortRange.From),
+			To:   aws.ToInt32(e.PortRange.To),
+		}
+	}
+	if e.IcmpTypeCode != nil {
+		o.ICMPTypeCode = &manualv1alpha1.NetworkACLICMPTypeCode{
+			Code: aws.ToInt32(e.IcmpTypeCode.Code),
+			Type: aws.ToInt32(e.IcmpTypeCode.Type),
+		}
+	}
+	return normalizeNetworkACLEntry(o)
+}
+
+// normalizeNetworkACLEntry returns the supplied entry the way ec2 reports it:
+// with a numeric protocol, and with a port range or ICMP type and code only
+// for the protocols they apply to.
+func normalizeNetworkACLEntry(e manualv1alpha1.NetworkACLEntry) manualv1alpha1.NetworkACLEntry {
+	if n, ok := protocolNumbers[strings.ToLower(e.Protocol)]; ok {
+		e.Protocol = n
+	}
+	e.Egress = aws.Bool(aws.ToBool(e.Egress))
+	if e.Protocol != protocolNumbers["tcp"] && e.Protocol != protocolNumbers["udp"] {
+		e.PortRange = nil
+	}
+	if e.Protocol != protocolNumbers["icmp"] && e.Protocol != protocolNumbers["icmpv6"] {
+		e.ICMPTypeCode = nil
+	}
+	return e
+}
+
+// GenerateEC2PortRange returns the ec2types.PortRange of the supplied entry.
+func GenerateEC2PortRange(e manualv1alpha1.NetworkACLEntry) *ec2types.PortRange {
+	if e.PortRange == nil {
+		return nil
+	}
+	return &ec2types.PortRange{
+		From: aws.Int32(e.PortRange.From),
+		To:   aws.Int32(e.PortRange.To),
+	}
+}
+
+// GenerateEC2IcmpTypeCode returns the ec2types.IcmpTypeCode of the supplied
+// entry.
+func GenerateEC2IcmpTypeCode(e manualv1alpha1.NetworkACLEntry) *ec2types.IcmpTypeCode {
+	if e.ICMPTypeCode == nil {
+		return nil
+	}
+	return &ec2types.IcmpTypeCode{
+		Code: aws.Int32(e.ICMPTypeCode.Code),
+		Type: aws.Int32(e.ICMPTypeCode.Type),
+	}
+}
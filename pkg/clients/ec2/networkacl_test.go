@@ -0,0 +1,210 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+var (
+	aclCIDR = "10.0.0.0/16"
+)
+
+func aclDefaultEntries() []ec2types.NetworkAclEntry {
+	return []ec2types.NetworkAclEntry{
+		{
+			RuleNumber: aws.Int32(32767),
+			Egress:     aws.Bool(false),
+			Protocol:   aws.String("-1"),
+			RuleAction: ec2types.RuleActionDeny,
+			CidrBlock:  aws.String("0.0.0.0/0"),
+		},
+		{
+			RuleNumber: aws.Int32(32767),
+			Egress:     aws.Bool(true),
+			Protocol:   aws.String("-1"),
+			RuleAction: ec2types.RuleActionDeny,
+			CidrBlock:  aws.String("0.0.0.0/0"),
+		},
+	}
+}
+
+func aclIPv6DefaultEntries() []ec2types.NetworkAclEntry {
+	return []ec2types.NetworkAclEntry{
+		{
+			RuleNumber:    aws.Int32(32768),
+			Egress:        aws.Bool(false),
+			Protocol:      aws.String("-1"),
+			RuleAction:    ec2types.RuleActionDeny,
+			Ipv6CidrBlock: aws.String("::/0"),
+		},
+		{
+			RuleNumber:    aws.Int32(32768),
+			Egress:        aws.Bool(true),
+			Protocol:      aws.String("-1"),
+			RuleAction:    ec2types.RuleActionDeny,
+			Ipv6CidrBlock: aws.String("::/0"),
+		},
+	}
+}
+
+func aclHTTPSEntry() ec2types.NetworkAclEntry {
+	return ec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(100),
+		Egress:     aws.Bool(false),
+		Protocol:   aws.String("6"),
+		RuleAction: ec2types.RuleActionAllow,
+		CidrBlock:  aws.String(aclCIDR),
+		PortRange:  &ec2types.PortRange{From: aws.Int32(443), To: aws.Int32(443)},
+	}
+}
+
+func specHTTPSEntry() manualv1alpha1.NetworkACLEntry {
+	return manualv1alpha1.NetworkACLEntry{
+		RuleNumber: 100,
+		Protocol:   "tcp",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String(aclCIDR),
+		PortRange:  &manualv1alpha1.NetworkACLPortRange{From: 443, To: 443},
+	}
+}
+
+func TestDiffNetworkACLEntries(t *testing.T) {
+	type want struct {
+		create  []manualv1alpha1.NetworkACLEntry
+		replace []manualv1alpha1.NetworkACLEntry
+		remove  []ec2types.NetworkAclEntry
+	}
+	cases := map[string]struct {
+		desired  []manualv1alpha1.NetworkACLEntry
+		observed []ec2types.NetworkAclEntry
+		want
+	}{
+		"UpToDate": {
+			desired:  []manualv1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			observed: append(aclDefaultEntries(), aclHTTPSEntry()),
+		},
+		"AllProtocolsIgnoresPorts": {
+			desired: []manualv1alpha1.NetworkACLEntry{{
+				RuleNumber: 200,
+				Egress:     aws.Bool(true),
+				Protocol:   "-1",
+				RuleAction: "allow",
+				CIDRBlock:  aws.String(aclCIDR),
+				PortRange:  &manualv1alpha1.NetworkACLPortRange{From: 0, To: 65535},
+			}},
+			observed: []ec2types.NetworkAclEntry{{
+				RuleNumber: aws.Int32(200),
+				Egress:     aws.Bool(true),
+				Protocol:   aws.String("-1"),
+				RuleAction: ec2types.RuleActionAllow,
+				CidrBlock:  aws.String(aclCIDR),
+			}},
+		},
+		"Missing": {
+			desired:  []manualv1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			observed: aclDefaultEntries(),
+			want: want{
+				create: []manualv1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			},
+		},
+		"Drifted": {
+			desired: []manualv1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			observed: []ec2types.NetworkAclEntry{{
+				RuleNumber: aws.Int32(100),
+				Egress:     aws.Bool(false),
+				Protocol:   aws.String("6"),
+				RuleAction: ec2types.RuleActionAllow,
+				CidrBlock:  aws.String(aclCIDR),
+				PortRange:  &ec2types.PortRange{From: aws.Int32(80), To: aws.Int32(80)},
+			}},
+			want: want{
+				replace: []manualv1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			},
+		},
+		"SameRuleNumberOtherDirection": {
+			desired: []manualv1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			observed: []ec2types.NetworkAclEntry{{
+				RuleNumber: aws.Int32(100),
+				Egress:     aws.Bool(true),
+				Protocol:   aws.String("-1"),
+				RuleAction: ec2types.RuleActionAllow,
+				CidrBlock:  aws.String(aclCIDR),
+			}},
+			want: want{
+				create: []manualv1alpha1.NetworkACLEntry{specHTTPSEntry()},
+				remove: []ec2types.NetworkAclEntry{{
+					RuleNumber: aws.Int32(100),
+					Egress:     aws.Bool(true),
+					Protocol:   aws.String("-1"),
+					RuleAction: ec2types.RuleActionAllow,
+					CidrBlock:  aws.String(aclCIDR),
+				}},
+			},
+		},
+		"IPv6DefaultEntries": {
+			desired:  []manualv1alpha1.NetworkACLEntry{specHTTPSEntry()},
+			observed: append(append(aclDefaultEntries(), aclIPv6DefaultEntries()...), aclHTTPSEntry()),
+		},
+		"Unwanted": {
+			observed: append(append(aclDefaultEntries(), aclIPv6DefaultEntries()...), aclHTTPSEntry()),
+			want: want{
+				remove: []ec2types.NetworkAclEntry{aclHTTPSEntry()},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, replace, remove := DiffNetworkACLEntries(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.replace, replace); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.IgnoreUnexported(ec2types.NetworkAclEntry{}, ec2types.PortRange{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNetworkACLObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  ec2types.NetworkAcl
+		out manualv1alpha1.NetworkACLObservation
+	}{
+		"AllFilled": {
+			in: ec2types.NetworkAcl{
+				NetworkAclId: aws.String("acl"),
+				IsDefault:    aws.Bool(false),
+				OwnerId:      aws.String("owner"),
+				Associations: []ec2types.NetworkAclAssociation{{
+					NetworkAclAssociationId: aws.String("assoc"),
+					SubnetId:                aws.String("subnet"),
+				}},
+			},
+			out: manualv1alpha1.NetworkACLObservation{
+				NetworkACLID: "acl",
+				OwnerID:      "owner",
+				Associations: []manualv1alpha1.NetworkACLAssociation{{
+					AssociationID: "assoc",
+					SubnetID:      "subnet",
+				}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateNetworkACLObservation(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateNetworkACLObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkInterfaceIDNotFound is the code that is returned by ec2 when the
+	// given NetworkInterfaceID is not valid
+	NetworkInterfaceIDNotFound = "InvalidNetworkInterfaceID.NotFound"
+)
+
+// NetworkInterfaceClient is the external client used for NetworkInterface
+// Custom Resource
+type NetworkInterfaceClient interface {
+	CreateNetworkInterface(ctx context.Context, input *ec2.CreateNetworkInterfaceInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkInterfaceOutput, error)
+	DescribeNetworkInterfaces(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+	ModifyNetworkInterfaceAttribute(ctx context.Context, input *ec2.ModifyNetworkInterfaceAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error)
+	AssignPrivateIpAddresses(ctx context.Context, input *ec2.AssignPrivateIpAddressesInput, opts ...func(*ec2.Options)) (*ec2.AssignPrivateIpAddressesOutput, error)
+	UnassignPrivateIpAddresses(ctx context.Context, input *ec2.UnassignPrivateIpAddressesInput, opts ...func(*ec2.Options)) (*ec2.UnassignPrivateIpAddressesOutput, error)
+	DeleteNetworkInterface(ctx context.Context, input *ec2.DeleteNetworkInterfaceInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkInterfaceOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewNetworkInterfaceClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewNetworkInterfaceClient(cfg aws.Config) NetworkInterfaceClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsNetworkInterfaceNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsNetworkInterfaceNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkInterfaceIDNotFound
+}
+
+// NetworkInterfaceSecondaryPrivateIPAddresses returns the secondary private
+// IPv4 addresses of the network interface.
+func NetworkInterfaceSecondaryPrivateIPAddresses(ni ec2types.NetworkInterface) []string {
+	var ips []string
+	for _, a := range ni.PrivateIpAddresses {
+		if !aws.ToBool(a.Primary) {
+			ips = append(ips, aws.ToString(a.PrivateIpAddress))
+		}
+	}
+	return ips
+}
+
+// NetworkInterfaceSecurityGroupIDs returns the IDs of the security groups of
+// the network interface.
+func NetworkInterfaceSecurityGroupIDs(ni ec2types.NetworkInterface) []string {
+	ids := make([]string, len(ni.Groups))
+	for i, g := range ni.Groups {
+		ids[i] = aws.ToString(g.GroupId)
+	}
+	return ids
+}
+
+// GenerateNetworkInterfaceObservation is used to produce
+// manualv1alpha1.NetworkInterfaceObservation from ec2types.NetworkInterface.
+func GenerateNetworkInterfaceObservation(ni ec2types.NetworkInterface) manualv1alpha1.NetworkInterfaceObservation {
+	o := manualv1alpha1.NetworkInterfaceObservation{
+		NetworkInterfaceID:          aws.ToString(ni.NetworkInterfaceId),
+		OwnerID:                     aws.ToString(ni.OwnerId),
+		AvailabilityZone:            aws.ToString(ni.AvailabilityZone),
+		VPCID:                       aws.ToString(ni.VpcId),
+		MACAddress:                  aws.ToString(ni.MacAddress),
+		PrivateIPAddress:            aws.ToString(ni.PrivateIpAddress),
+		SecondaryPrivateIPAddresses: NetworkInterfaceSecondaryPrivateIPAddresses(ni),
+		Status:                      string(ni.Status),
+	}
+	if ni.Attachment != nil {
+		o.Attachment = &manualv1alpha1.NetworkInterfaceAttachmentObservation{
+			AttachmentID: aws.ToString(ni.Attachment.AttachmentId),
+			InstanceID:   aws.ToString(ni.Attachment.InstanceId),
+			DeviceIndex:  aws.ToInt32(ni.Attachment.DeviceIndex),
+			Status:       string(ni.Attachment.Status),
+		}
+	}
+	return o
+}
+
+// LateInitializeNetworkInterface fills the empty fields in
+// *manualv1alpha1.NetworkInterfaceParameters with the values seen in
+// ec2types.NetworkInterface.
+func LateInitializeNetworkInterface(in *manualv1alpha1.NetworkInterfaceParameters, ni *ec2types.NetworkInterface) {
+	if ni == nil {
+		return
+	}
+	if in.Description == nil && aws.ToString(ni.Description) != "" {
+		in.Description = ni.Description
+	}
+	in.PrivateIPAddress = awsclients.LateInitializeStringPtr(in.PrivateIPAddress, ni.PrivateIpAddress)
+	in.SourceDestCheck = awsclients.LateInitializeBoolPtr(in.SourceDestCheck, ni.SourceDestCheck)
+	if len(in.SecurityGroupIDs) == 0 && len(ni.Groups) != 0 {
+		in.SecurityGroupIDs = NetworkInterfaceSecurityGroupIDs(*ni)
+	}
+}
+
+// DiffNetworkInterfacePrivateIPAddresses returns the secondary private IPv4
+// addresses that have to be assigned to and unassigned from the network
+// interface. If only a number of secondary addresses is desired, the number
+// of addresses AWS has to select is returned instead of the addresses to
+// assign.
+func DiffNetworkInterfacePrivateIPAddresses(p manualv1alpha1.NetworkInterfaceParameters, ni ec2types.NetworkInterface) (assign []string, assignCount int32, unassign []string) {
+	observed := NetworkInterfaceSecondaryPrivateIPAddresses(ni)
+	switch {
+	case len(p.SecondaryPrivateIPAddresses) != 0:
+		have := map[string]bool{}
+		for _, ip := range observed {
+			have[ip] = true
+		}
+		want := map[string]bool{}
+		for _, ip := range p.SecondaryPrivateIPAddresses {
+			want[ip] = true
+			if !have[ip] {
+				assign = append(assign, ip)
+			}
+		}
+		for _, ip := range observed {
+			if !want[ip] {
+				unassign = append(unassign, ip)
+			}
+		}
+		sort.Strings(unassign)
+	case p.SecondaryPrivateIPAddressCount != nil:
+		n := int(aws.ToInt32(p.SecondaryPrivateIPAddressCount))
+		if len(observed) < n {
+			assignCount = int32(n - len(observed))
+		}
+		if len(observed) > n {
+			unassign = observed[n:]
+		}
+	}
+	return assign, assignCount, unassign
+}
+
+// IsNetworkInterfaceUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsNetworkInterfaceUpToDate(p manualv1alpha1.NetworkInterfaceParameters, ni ec2types.NetworkInterface) bool {
+	assign, assignCount, unassign := DiffNetworkInterfacePrivateIPAddresses(p, ni)
+	if len(assign) != 0 || assignCount != 0 || len(unassign) != 0 {
+		return false
+	}
+	if p.SourceDestCheck != nil && aws.ToBool(p.SourceDestCheck) != aws.ToBool(ni.SourceDestCheck) {
+		return false
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return aws.ToString(p.Description) == aws.ToString(ni.Description) &&
+		cmp.Equal(p.SecurityGroupIDs, NetworkInterfaceSecurityGroupIDs(ni), cmpopts.EquateEmpty(), sortStrings) &&
+		manualv1alpha1.CompareTags(p.Tags, ni.TagSet)
+}
@@ -0,0 +1,140 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func networkInterfaceWithIPs(secondary ...string) ec2types.NetworkInterface {
+	ni := ec2types.NetworkInterface{
+		PrivateIpAddress: aws.String("10.0.0.10"),
+		PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{{
+			PrivateIpAddress: aws.String("10.0.0.10"),
+			Primary:          aws.Bool(true),
+		}},
+	}
+	for _, ip := range secondary {
+		ni.PrivateIpAddresses = append(ni.PrivateIpAddresses, ec2types.NetworkInterfacePrivateIpAddress{
+			PrivateIpAddress: aws.String(ip),
+			Primary:          aws.Bool(false),
+		})
+	}
+	return ni
+}
+
+func TestDiffNetworkInterfacePrivateIPAddresses(t *testing.T) {
+	type want struct {
+		assign      []string
+		assignCount int32
+		unassign    []string
+	}
+
+	cases := map[string]struct {
+		p  manualv1alpha1.NetworkInterfaceParameters
+		ni ec2types.NetworkInterface
+		want
+	}{
+		"Unmanaged": {
+			ni: networkInterfaceWithIPs("10.0.0.11"),
+		},
+		"AddressesUpToDate": {
+			p:  manualv1alpha1.NetworkInterfaceParameters{SecondaryPrivateIPAddresses: []string{"10.0.0.12", "10.0.0.11"}},
+			ni: networkInterfaceWithIPs("10.0.0.11", "10.0.0.12"),
+		},
+		"AddressesChanged": {
+			p:  manualv1alpha1.NetworkInterfaceParameters{SecondaryPrivateIPAddresses: []string{"10.0.0.11", "10.0.0.13"}},
+			ni: networkInterfaceWithIPs("10.0.0.12", "10.0.0.11"),
+			want: want{
+				assign:   []string{"10.0.0.13"},
+				unassign: []string{"10.0.0.12"},
+			},
+		},
+		"CountIncreased": {
+			p:  manualv1alpha1.NetworkInterfaceParameters{SecondaryPrivateIPAddressCount: aws.Int32(3)},
+			ni: networkInterfaceWithIPs("10.0.0.11"),
+			want: want{
+				assignCount: 2,
+			},
+		},
+		"CountDecreased": {
+			p:  manualv1alpha1.NetworkInterfaceParameters{SecondaryPrivateIPAddressCount: aws.Int32(1)},
+			ni: networkInterfaceWithIPs("10.0.0.11", "10.0.0.12", "10.0.0.13"),
+			want: want{
+				unassign: []string{"10.0.0.12", "10.0.0.13"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assign, assignCount, unassign := DiffNetworkInterfacePrivateIPAddresses(tc.p, tc.ni)
+			if diff := cmp.Diff(tc.want.assign, assign); diff != "" {
+				t.Errorf("assign: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.assignCount, assignCount); diff != "" {
+				t.Errorf("assignCount: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.unassign, unassign); diff != "" {
+				t.Errorf("unassign: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNetworkInterfaceUpToDate(t *testing.T) {
+	observed := networkInterfaceWithIPs()
+	observed.Description = aws.String("appliance")
+	observed.SourceDestCheck = aws.Bool(true)
+	observed.Groups = []ec2types.GroupIdentifier{{GroupId: aws.String("sg-2")}, {GroupId: aws.String("sg-1")}}
+
+	cases := map[string]struct {
+		p    manualv1alpha1.NetworkInterfaceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: manualv1alpha1.NetworkInterfaceParameters{
+				Description:      aws.String("appliance"),
+				SourceDestCheck:  aws.Bool(true),
+				SecurityGroupIDs: []string{"sg-1", "sg-2"},
+			},
+			want: true,
+		},
+		"SourceDestCheckDisabled": {
+			p: manualv1alpha1.NetworkInterfaceParameters{
+				Description:      aws.String("appliance"),
+				SourceDestCheck:  aws.Bool(false),
+				SecurityGroupIDs: []string{"sg-1", "sg-2"},
+			},
+			want: false,
+		},
+		"SecurityGroupsChanged": {
+			p: manualv1alpha1.NetworkInterfaceParameters{
+				Description:      aws.String("appliance"),
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p: manualv1alpha1.NetworkInterfaceParameters{
+				Description:      aws.String("appliance"),
+				SecurityGroupIDs: []string{"sg-1", "sg-2"},
+				Tags:             []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNetworkInterfaceUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
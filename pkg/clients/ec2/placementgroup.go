@@ -0,0 +1,72 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// PlacementGroupNotFound is the code that is returned by ec2 when the
+	// given placement group name is not valid
+	PlacementGroupNotFound = "InvalidPlacementGroup.Unknown"
+)
+
+// PlacementGroupClient is the external client used for PlacementGroup Custom
+// Resource
+type PlacementGroupClient interface {
+	CreatePlacementGroup(ctx context.Context, input *ec2.CreatePlacementGroupInput, opts ...func(*ec2.Options)) (*ec2.CreatePlacementGroupOutput, error)
+	DescribePlacementGroups(ctx context.Context, input *ec2.DescribePlacementGroupsInput, opts ...func(*ec2.Options)) (*ec2.DescribePlacementGroupsOutput, error)
+	DeletePlacementGroup(ctx context.Context, input *ec2.DeletePlacementGroupInput, opts ...func(*ec2.Options)) (*ec2.DeletePlacementGroupOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewPlacementGroupClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewPlacementGroupClient(cfg aws.Config) PlacementGroupClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsPlacementGroupNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsPlacementGroupNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == PlacementGroupNotFound
+}
+
+// GeneratePlacementGroupObservation is used to produce
+// manualv1alpha1.PlacementGroupObservation from ec2types.PlacementGroup.
+func GeneratePlacementGroupObservation(pg ec2types.PlacementGroup) manualv1alpha1.PlacementGroupObservation {
+	return manualv1alpha1.PlacementGroupObservation{
+		GroupID: aws.ToString(pg.GroupId),
+		State:   string(pg.State),
+	}
+}
+
+// LateInitializePlacementGroup fills the empty fields in
+// *manualv1alpha1.PlacementGroupParameters with the values seen in
+// ec2types.PlacementGroup.
+func LateInitializePlacementGroup(in *manualv1alpha1.PlacementGroupParameters, pg *ec2types.PlacementGroup) {
+	if pg == nil {
+		return
+	}
+	if in.PartitionCount == nil && pg.PartitionCount != nil && aws.ToInt32(pg.PartitionCount) != 0 {
+		in.PartitionCount = pg.PartitionCount
+	}
+	if len(in.Tags) == 0 && len(pg.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(pg.Tags)
+	}
+}
+
+// IsPlacementGroupUpToDate checks whether there is a change in any of the
+// modifiable fields. Only the tags of a placement group can be changed.
+func IsPlacementGroupUpToDate(p manualv1alpha1.PlacementGroupParameters, pg ec2types.PlacementGroup) bool {
+	return manualv1alpha1.CompareTags(p.Tags, pg.Tags)
+}
@@ -81,16 +81,17 @@ func GenerateRTObservation(rt ec2types.RouteTable) v1beta1.RouteTableObservation
 		o.Routes = make([]v1beta1.RouteState, len(rt.Routes))
 		for i, rt := range rt.Routes {
 			o.Routes[i] = v1beta1.RouteState{
-				State:                    string(rt.State),
-				DestinationCIDRBlock:     aws.ToString(rt.DestinationCidrBlock),
-				DestinationIPV6CIDRBlock: aws.ToString(rt.DestinationIpv6CidrBlock),
-				GatewayID:                aws.ToString(rt.GatewayId),
-				InstanceID:               aws.ToString(rt.InstanceId),
-				LocalGatewayID:           aws.ToString(rt.LocalGatewayId),
-				NatGatewayID:             aws.ToString(rt.NatGatewayId),
-				NetworkInterfaceID:       aws.ToString(rt.NetworkInterfaceId),
-				TransitGatewayID:         aws.ToString(rt.TransitGatewayId),
-				VpcPeeringConnectionID:   aws.ToString(rt.VpcPeeringConnectionId),
+				State:                       string(rt.State),
+				DestinationCIDRBlock:        aws.ToString(rt.DestinationCidrBlock),
+				DestinationIPV6CIDRBlock:    aws.ToString(rt.DestinationIpv6CidrBlock),
+				EgressOnlyInternetGatewayID: aws.ToString(rt.EgressOnlyInternetGatewayId),
+				GatewayID:                   aws.ToString(rt.GatewayId),
+				InstanceID:                  aws.ToString(rt.InstanceId),
+				LocalGatewayID:              aws.ToString(rt.LocalGatewayId),
+				NatGatewayID:                aws.ToString(rt.NatGatewayId),
+				NetworkInterfaceID:          aws.ToString(rt.NetworkInterfaceId),
+				TransitGatewayID:            aws.ToString(rt.TransitGatewayId),
+				VpcPeeringConnectionID:      aws.ToString(rt.VpcPeeringConnectionId),
 			}
 		}
 	}
@@ -118,18 +119,20 @@ func LateInitializeRT(in *v1beta1.RouteTableParameters, rt *ec2types.RouteTable)
 	}
 	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, rt.VpcId)
 
-	if len(in.Routes) == 0 && len(rt.Routes) != 0 {
+	if !aws.ToBool(in.IgnoreRoutes) && len(in.Routes) == 0 && len(rt.Routes) != 0 {
 		in.Routes = make([]v1beta1.RouteBeta, len(rt.Routes))
 		for i, val := range rt.Routes {
 			in.Routes[i] = v1beta1.RouteBeta{
-				DestinationCIDRBlock:   val.DestinationCidrBlock,
-				GatewayID:              val.GatewayId,
-				InstanceID:             val.InstanceId,
-				LocalGatewayID:         val.LocalGatewayId,
-				NatGatewayID:           val.NatGatewayId,
-				NetworkInterfaceID:     val.NetworkInterfaceId,
-				TransitGatewayID:       val.TransitGatewayId,
-				VpcPeeringConnectionID: val.VpcPeeringConnectionId,
+				DestinationCIDRBlock:        val.DestinationCidrBlock,
+				DestinationIPV6CIDRBlock:    val.DestinationIpv6CidrBlock,
+				EgressOnlyInternetGatewayID: val.EgressOnlyInternetGatewayId,
+				GatewayID:                   val.GatewayId,
+				InstanceID:                  val.InstanceId,
+				LocalGatewayID:              val.LocalGatewayId,
+				NatGatewayID:                val.NatGatewayId,
+				NetworkInterfaceID:          val.NetworkInterfaceId,
+				TransitGatewayID:            val.TransitGatewayId,
+				VpcPeeringConnectionID:      val.VpcPeeringConnectionId,
 			}
 		}
 	}
@@ -153,19 +156,30 @@ func LateInitializeRT(in *v1beta1.RouteTableParameters, rt *ec2types.RouteTable)
 // *ec2.RouteTable
 func CreateRTPatch(in ec2types.RouteTable, target v1beta1.RouteTableParameters) (*v1beta1.RouteTableParameters, error) {
 	targetCopy := target.DeepCopy()
-	currentParams := &v1beta1.RouteTableParameters{}
+	currentParams := &v1beta1.RouteTableParameters{
+		IgnoreRoutes: target.IgnoreRoutes,
+	}
 
 	v1beta1.SortTags(target.Tags, in.Tags)
 
-	// Add the default route for fair comparison.
+	// Add the default routes for fair comparison. A VPC with an IPv6 CIDR
+	// block has a local route for both its IPv4 and IPv6 CIDR blocks.
+	var local []v1beta1.RouteBeta
 	for _, val := range in.Routes {
 		if val.GatewayId != nil && *val.GatewayId == DefaultLocalGatewayID {
-			targetCopy.Routes = append([]v1beta1.RouteBeta{{
-				GatewayID:            val.GatewayId,
-				DestinationCIDRBlock: val.DestinationCidrBlock,
-			}}, target.Routes...)
+			local = append(local, v1beta1.RouteBeta{
+				GatewayID:                val.GatewayId,
+				DestinationCIDRBlock:     val.DestinationCidrBlock,
+				DestinationIPV6CIDRBlock: val.DestinationIpv6CidrBlock,
+			})
 		}
 	}
+	switch {
+	case aws.ToBool(target.IgnoreRoutes):
+		targetCopy.Routes = nil
+	case len(local) > 0:
+		targetCopy.Routes = append(local, target.Routes...)
+	}
 	SortRoutes(targetCopy.Routes, in.Routes)
 
 	LateInitializeRT(currentParams, &in)
@@ -202,15 +216,21 @@ func IsRtUpToDate(p v1beta1.RouteTableParameters, rt ec2types.RouteTable) (bool,
 	), nil
 }
 
-// SortRoutes sorts array of Routes on DestinationCIDR
+// SortRoutes sorts array of Routes on DestinationCIDR, and then on
+// DestinationIPV6CIDR.
 func SortRoutes(route []v1beta1.RouteBeta, ec2Route []ec2types.Route) {
 	sort.Slice(route, func(i, j int) bool {
-		return (route[i].DestinationCIDRBlock != nil && *route[i].DestinationCIDRBlock < *route[j].DestinationCIDRBlock) ||
-			(route[i].DestinationIPV6CIDRBlock != nil && *route[i].DestinationIPV6CIDRBlock < *route[j].DestinationIPV6CIDRBlock)
+		return lessDestination(route[i].DestinationCIDRBlock, route[i].DestinationIPV6CIDRBlock, route[j].DestinationCIDRBlock, route[j].DestinationIPV6CIDRBlock)
 	})
 
 	sort.Slice(ec2Route, func(i, j int) bool {
-		return (ec2Route[i].DestinationCidrBlock != nil && *ec2Route[i].DestinationCidrBlock < *ec2Route[j].DestinationCidrBlock) ||
-			(ec2Route[i].DestinationIpv6CidrBlock != nil && *ec2Route[i].DestinationIpv6CidrBlock < *ec2Route[j].DestinationIpv6CidrBlock)
+		return lessDestination(ec2Route[i].DestinationCidrBlock, ec2Route[i].DestinationIpv6CidrBlock, ec2Route[j].DestinationCidrBlock, ec2Route[j].DestinationIpv6CidrBlock)
 	})
 }
+
+func lessDestination(ipv4i, ipv6i, ipv4j, ipv6j *string) bool {
+	if aws.ToString(ipv4i) != aws.ToString(ipv4j) {
+		return aws.ToString(ipv4i) < aws.ToString(ipv4j)
+	}
+	return aws.ToString(ipv6i) < aws.ToString(ipv6j)
+}
@@ -159,6 +159,73 @@ func TestCreateRTPatch(t *testing.T) {
 				},
 			},
 		},
+		"SameIPv6Routes": {
+			args: args{
+				rt: ec2types.RouteTable{
+					Associations: rtAssociations(),
+					VpcId:        aws.String(rtVPC),
+					Routes: []ec2types.Route{
+						{
+							DestinationIpv6CidrBlock:    aws.String("::/0"),
+							EgressOnlyInternetGatewayId: aws.String("eigw"),
+						},
+						{
+							DestinationIpv6CidrBlock: aws.String("2600:1f18:a1b:3c00::/56"),
+							GatewayId:                aws.String(DefaultLocalGatewayID),
+						},
+						{
+							DestinationCidrBlock: aws.String("10.0.0.0/16"),
+							GatewayId:            aws.String(DefaultLocalGatewayID),
+						},
+					},
+				},
+				p: &v1beta1.RouteTableParameters{
+					Associations: specAssociations(),
+					VPCID:        aws.String(rtVPC),
+					Routes: []v1beta1.RouteBeta{
+						{
+							DestinationIPV6CIDRBlock:    aws.String("::/0"),
+							EgressOnlyInternetGatewayID: aws.String("eigw"),
+						},
+					},
+				},
+			},
+			want: want{
+				patch: &v1beta1.RouteTableParameters{},
+			},
+		},
+		"IgnoredRoutes": {
+			args: args{
+				rt: ec2types.RouteTable{
+					Associations: rtAssociations(),
+					VpcId:        aws.String(rtVPC),
+					Routes: []ec2types.Route{
+						{
+							DestinationCidrBlock: aws.String("10.0.0.0/16"),
+							GatewayId:            aws.String(DefaultLocalGatewayID),
+						},
+						{
+							DestinationCidrBlock: aws.String("0.0.0.0/0"),
+							NatGatewayId:         aws.String("nat"),
+						},
+					},
+				},
+				p: &v1beta1.RouteTableParameters{
+					Associations: specAssociations(),
+					VPCID:        aws.String(rtVPC),
+					IgnoreRoutes: aws.Bool(true),
+					Routes: []v1beta1.RouteBeta{
+						{
+							DestinationCIDRBlock: aws.String("0.0.0.0/0"),
+							GatewayID:            aws.String("igw"),
+						},
+					},
+				},
+			},
+			want: want{
+				patch: &v1beta1.RouteTableParameters{},
+			},
+		},
 	}
 
 	for name, tc := range cases {
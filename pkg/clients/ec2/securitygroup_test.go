@@ -113,6 +113,25 @@ func TestIsSGUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"IgnoredRules": {
+			args: args{
+				sg: ec2types.SecurityGroup{
+					Description:         aws.String(sgDesc),
+					GroupName:           aws.String(sgName),
+					VpcId:               aws.String(sgVpc),
+					IpPermissions:       sgIPPermission(80),
+					IpPermissionsEgress: sgIPPermission(443),
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description:   sgDesc,
+					GroupName:     sgName,
+					VPCID:         aws.String(sgVpc),
+					IgnoreIngress: aws.Bool(true),
+					IgnoreEgress:  aws.Bool(true),
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,135 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// SecurityGroupRuleIDNotFound is the code that is returned by ec2 when the
+	// given SecurityGroupRuleID is not valid
+	SecurityGroupRuleIDNotFound = "InvalidSecurityGroupRuleId.NotFound"
+)
+
+// SecurityGroupRuleClient is the external client used for SecurityGroupRule
+// Custom Resource
+type SecurityGroupRuleClient interface {
+	AuthorizeSecurityGroupIngress(ctx context.Context, input *ec2.AuthorizeSecurityGroupIngressInput, opts ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupIngressOutput, error)
+	AuthorizeSecurityGroupEgress(ctx context.Context, input *ec2.AuthorizeSecurityGroupEgressInput, opts ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupEgressOutput, error)
+	RevokeSecurityGroupIngress(ctx context.Context, input *ec2.RevokeSecurityGroupIngressInput, opts ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupIngressOutput, error)
+	RevokeSecurityGroupEgress(ctx context.Context, input *ec2.RevokeSecurityGroupEgressInput, opts ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupEgressOutput, error)
+	DescribeSecurityGroupRules(ctx context.Context, input *ec2.DescribeSecurityGroupRulesInput, opts ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupRulesOutput, error)
+	ModifySecurityGroupRules(ctx context.Context, input *ec2.ModifySecurityGroupRulesInput, opts ...func(*ec2.Options)) (*ec2.ModifySecurityGroupRulesOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewSecurityGroupRuleClient returns a new client using AWS credentials as JSON encoded data.
+func NewSecurityGroupRuleClient(cfg aws.Config) SecurityGroupRuleClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsSecurityGroupRuleNotFoundErr returns true if the error is because the item doesn't exist
+func IsSecurityGroupRuleNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == SecurityGroupRuleIDNotFound
+}
+
+// GenerateSecurityGroupRuleObservation is used to produce
+// manualv1alpha1.SecurityGroupRuleObservation from ec2types.SecurityGroupRule.
+func GenerateSecurityGroupRuleObservation(r ec2types.SecurityGroupRule) manualv1alpha1.SecurityGroupRuleObservation {
+	return manualv1alpha1.SecurityGroupRuleObservation{
+		SecurityGroupRuleID:  aws.ToString(r.SecurityGroupRuleId),
+		SecurityGroupOwnerID: aws.ToString(r.GroupOwnerId),
+	}
+}
+
+// LateInitializeSecurityGroupRule fills the empty fields in
+// *manualv1alpha1.SecurityGroupRuleParameters with the values seen in
+// ec2types.SecurityGroupRule.
+func LateInitializeSecurityGroupRule(in *manualv1alpha1.SecurityGroupRuleParameters, r *ec2types.SecurityGroupRule) {
+	if r == nil {
+		return
+	}
+	in.SecurityGroupID = awsclients.LateInitializeStringPtr(in.SecurityGroupID, r.GroupId)
+	in.FromPort = awsclients.LateInitializeInt32Ptr(in.FromPort, r.FromPort)
+	in.ToPort = awsclients.LateInitializeInt32Ptr(in.ToPort, r.ToPort)
+	if len(in.Tags) == 0 && len(r.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(r.Tags)
+	}
+}
+
+// GenerateSecurityGroupRuleRequest returns the ec2types.SecurityGroupRuleRequest
+// that describes the desired rule.
+func GenerateSecurityGroupRuleRequest(p manualv1alpha1.SecurityGroupRuleParameters) *ec2types.SecurityGroupRuleRequest {
+	return &ec2types.SecurityGroupRuleRequest{
+		IpProtocol:        aws.String(p.Protocol),
+		FromPort:          p.FromPort,
+		ToPort:            p.ToPort,
+		CidrIpv4:          p.CIDRIPv4,
+		CidrIpv6:          p.CIDRIPv6,
+		PrefixListId:      p.PrefixListID,
+		ReferencedGroupId: p.ReferencedSecurityGroupID,
+		Description:       p.Description,
+	}
+}
+
+// GenerateSecurityGroupRulePermission returns the ec2types.IpPermission that
+// authorizes the desired rule.
+func GenerateSecurityGroupRulePermission(p manualv1alpha1.SecurityGroupRuleParameters) ec2types.IpPermission {
+	perm := ec2types.IpPermission{
+		IpProtocol: aws.String(p.Protocol),
+		FromPort:   p.FromPort,
+		ToPort:     p.ToPort,
+	}
+	switch {
+	case p.CIDRIPv4 != nil:
+		perm.IpRanges = []ec2types.IpRange{{CidrIp: p.CIDRIPv4, Description: p.Description}}
+	case p.CIDRIPv6 != nil:
+		perm.Ipv6Ranges = []ec2types.Ipv6Range{{CidrIpv6: p.CIDRIPv6, Description: p.Description}}
+	case p.PrefixListID != nil:
+		perm.PrefixListIds = []ec2types.PrefixListId{{PrefixListId: p.PrefixListID, Description: p.Description}}
+	case p.ReferencedSecurityGroupID != nil:
+		perm.UserIdGroupPairs = []ec2types.UserIdGroupPair{{
+			GroupId:                p.ReferencedSecurityGroupID,
+			UserId:                 p.ReferencedSecurityGroupOwnerID,
+			VpcPeeringConnectionId: p.VPCPeeringConnectionID,
+			Description:            p.Description,
+		}}
+	}
+	return perm
+}
+
+// IsSecurityGroupRuleUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsSecurityGroupRuleUpToDate(p manualv1alpha1.SecurityGroupRuleParameters, r ec2types.SecurityGroupRule) bool {
+	protocol := strings.ToLower(p.Protocol)
+	if n, ok := protocolNumbers[protocol]; ok && n == aws.ToString(r.IpProtocol) {
+		protocol = aws.ToString(r.IpProtocol)
+	}
+	var referenced *string
+	if r.ReferencedGroupInfo != nil {
+		referenced = r.ReferencedGroupInfo.GroupId
+	}
+	switch {
+	case protocol != aws.ToString(r.IpProtocol),
+		aws.ToInt32(p.FromPort) != aws.ToInt32(r.FromPort),
+		aws.ToInt32(p.ToPort) != aws.ToInt32(r.ToPort),
+		aws.ToString(p.CIDRIPv4) != aws.ToString(r.CidrIpv4),
+		aws.ToString(p.CIDRIPv6) != aws.ToString(r.CidrIpv6),
+		aws.ToString(p.PrefixListID) != aws.ToString(r.PrefixListId),
+		aws.ToString(p.ReferencedSecurityGroupID) != aws.ToString(referenced),
+		aws.ToString(p.Description) != aws.ToString(r.Description):
+		return false
+	}
+	return manualv1alpha1.CompareTags(p.Tags, r.Tags)
+}
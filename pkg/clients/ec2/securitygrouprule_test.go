@@ -0,0 +1,165 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+var (
+	sgrCIDR = "10.0.0.0/16"
+	sgrRef  = "sg-other"
+)
+
+func sgrSpec() manualv1alpha1.SecurityGroupRuleParameters {
+	return manualv1alpha1.SecurityGroupRuleParameters{
+		Type:     manualv1alpha1.SecurityGroupRuleTypeIngress,
+		Protocol: "tcp",
+		FromPort: aws.Int32(443),
+		ToPort:   aws.Int32(443),
+		CIDRIPv4: aws.String(sgrCIDR),
+	}
+}
+
+func sgrObserved() ec2types.SecurityGroupRule {
+	return ec2types.SecurityGroupRule{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int32(443),
+		ToPort:     aws.Int32(443),
+		CidrIpv4:   aws.String(sgrCIDR),
+	}
+}
+
+func TestIsSecurityGroupRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.SecurityGroupRuleParameters
+		r    ec2types.SecurityGroupRule
+		want bool
+	}{
+		"SameFields": {
+			p:    sgrSpec(),
+			r:    sgrObserved(),
+			want: true,
+		},
+		"AllProtocols": {
+			p: manualv1alpha1.SecurityGroupRuleParameters{
+				Protocol: "all",
+				FromPort: aws.Int32(-1),
+				ToPort:   aws.Int32(-1),
+				CIDRIPv4: aws.String(sgrCIDR),
+			},
+			r: ec2types.SecurityGroupRule{
+				IpProtocol: aws.String("-1"),
+				FromPort:   aws.Int32(-1),
+				ToPort:     aws.Int32(-1),
+				CidrIpv4:   aws.String(sgrCIDR),
+			},
+			want: true,
+		},
+		"DifferentPorts": {
+			p: sgrSpec(),
+			r: func() ec2types.SecurityGroupRule {
+				r := sgrObserved()
+				r.ToPort = aws.Int32(444)
+				return r
+			}(),
+			want: false,
+		},
+		"DifferentReferencedGroup": {
+			p: func() manualv1alpha1.SecurityGroupRuleParameters {
+				p := sgrSpec()
+				p.CIDRIPv4 = nil
+				p.ReferencedSecurityGroupID = aws.String(sgrRef)
+				return p
+			}(),
+			r: func() ec2types.SecurityGroupRule {
+				r := sgrObserved()
+				r.CidrIpv4 = nil
+				r.ReferencedGroupInfo = &ec2types.ReferencedSecurityGroup{GroupId: aws.String("sg-another")}
+				return r
+			}(),
+			want: false,
+		},
+		"DifferentTags": {
+			p: func() manualv1alpha1.SecurityGroupRuleParameters {
+				p := sgrSpec()
+				p.Tags = []manualv1alpha1.Tag{{Key: "k", Value: "v"}}
+				return p
+			}(),
+			r:    sgrObserved(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSecurityGroupRuleUpToDate(tc.p, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSecurityGroupRuleUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSecurityGroupRulePermission(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.SecurityGroupRuleParameters
+		want ec2types.IpPermission
+	}{
+		"CIDR": {
+			p: sgrSpec(),
+			want: ec2types.IpPermission{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int32(443),
+				ToPort:     aws.Int32(443),
+				IpRanges:   []ec2types.IpRange{{CidrIp: aws.String(sgrCIDR)}},
+			},
+		},
+		"ReferencedGroup": {
+			p: manualv1alpha1.SecurityGroupRuleParameters{
+				Protocol:                  "-1",
+				ReferencedSecurityGroupID: aws.String(sgrRef),
+				Description:               aws.String("from peers"),
+			},
+			want: ec2types.IpPermission{
+				IpProtocol: aws.String("-1"),
+				UserIdGroupPairs: []ec2types.UserIdGroupPair{{
+					GroupId:     aws.String(sgrRef),
+					Description: aws.String("from peers"),
+				}},
+			},
+		},
+		"PeeredGroup": {
+			p: manualv1alpha1.SecurityGroupRuleParameters{
+				Protocol:                       "tcp",
+				FromPort:                       aws.Int32(5432),
+				ToPort:                         aws.Int32(5432),
+				ReferencedSecurityGroupID:      aws.String(sgrRef),
+				ReferencedSecurityGroupOwnerID: aws.String("123456789012"),
+				VPCPeeringConnectionID:         aws.String("pcx-123"),
+			},
+			want: ec2types.IpPermission{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int32(5432),
+				ToPort:     aws.Int32(5432),
+				UserIdGroupPairs: []ec2types.UserIdGroupPair{{
+					GroupId:                aws.String(sgrRef),
+					UserId:                 aws.String("123456789012"),
+					VpcPeeringConnectionId: aws.String("pcx-123"),
+				}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSecurityGroupRulePermission(tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2types.IpPermission{}, ec2types.IpRange{}, ec2types.UserIdGroupPair{})); diff != "" {
+				t.Errorf("GenerateSecurityGroupRulePermission(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
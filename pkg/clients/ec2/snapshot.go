@@ -0,0 +1,121 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// SnapshotIDNotFound is the code that is returned by ec2 when the given
+	// SnapshotID is not valid
+	SnapshotIDNotFound = "InvalidSnapshot.NotFound"
+)
+
+// SnapshotClient is the external client used for Snapshot Custom Resource
+type SnapshotClient interface {
+	CreateSnapshot(ctx context.Context, input *ec2.CreateSnapshotInput, opts ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error)
+	DescribeSnapshots(ctx context.Context, input *ec2.DescribeSnapshotsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error)
+	DeleteSnapshot(ctx context.Context, input *ec2.DeleteSnapshotInput, opts ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error)
+	DescribeFastSnapshotRestores(ctx context.Context, input *ec2.DescribeFastSnapshotRestoresInput, opts ...func(*ec2.Options)) (*ec2.DescribeFastSnapshotRestoresOutput, error)
+	EnableFastSnapshotRestores(ctx context.Context, input *ec2.EnableFastSnapshotRestoresInput, opts ...func(*ec2.Options)) (*ec2.EnableFastSnapshotRestoresOutput, error)
+	DisableFastSnapshotRestores(ctx context.Context, input *ec2.DisableFastSnapshotRestoresInput, opts ...func(*ec2.Options)) (*ec2.DisableFastSnapshotRestoresOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewSnapshotClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewSnapshotClient(cfg aws.Config) SnapshotClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsSnapshotNotFoundErr returns true if the error is because the item doesn't
+// exist
+func IsSnapshotNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == SnapshotIDNotFound
+}
+
+// GenerateSnapshotObservation is used to produce
+// manualv1alpha1.SnapshotObservation from ec2types.Snapshot and the fast
+// snapshot restores of it.
+func GenerateSnapshotObservation(s ec2types.Snapshot, restores []ec2types.DescribeFastSnapshotRestoreSuccessItem) manualv1alpha1.SnapshotObservation {
+	o := manualv1alpha1.SnapshotObservation{
+		SnapshotID: aws.ToString(s.SnapshotId),
+		OwnerID:    aws.ToString(s.OwnerId),
+		State:      string(s.State),
+		Progress:   aws.ToString(s.Progress),
+		VolumeSize: s.VolumeSize,
+		Encrypted:  s.Encrypted,
+		StartTime:  FromTimePtr(s.StartTime),
+	}
+	for _, r := range restores {
+		o.FastSnapshotRestores = append(o.FastSnapshotRestores, manualv1alpha1.FastSnapshotRestoreObservation{
+			AvailabilityZone: aws.ToString(r.AvailabilityZone),
+			State:            string(r.State),
+		})
+	}
+	return o
+}
+
+// LateInitializeSnapshot fills the empty fields in
+// *manualv1alpha1.SnapshotParameters with the values seen in ec2types.Snapshot.
+func LateInitializeSnapshot(in *manualv1alpha1.SnapshotParameters, s *ec2types.Snapshot) {
+	if s == nil {
+		return
+	}
+	if in.Description == nil && aws.ToString(s.Description) != "" {
+		in.Description = s.Description
+	}
+	if len(in.Tags) == 0 && len(s.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(s.Tags)
+	}
+}
+
+// DiffFastSnapshotRestoreAvailabilityZones returns the Availability Zones
+// fast snapshot restore needs to be enabled and disabled in for the observed
+// restores to match the desired zones. Restores that are being disabled
+// already are not considered.
+func DiffFastSnapshotRestoreAvailabilityZones(desired []string, restores []ec2types.DescribeFastSnapshotRestoreSuccessItem) (enable, disable []string) {
+	observed := map[string]bool{}
+	for _, r := range restores {
+		switch r.State {
+		case ec2types.FastSnapshotRestoreStateCodeDisabling, ec2types.FastSnapshotRestoreStateCodeDisabled:
+			continue
+		}
+		observed[aws.ToString(r.AvailabilityZone)] = true
+	}
+	want := map[string]bool{}
+	for _, az := range desired {
+		want[az] = true
+		if !observed[az] {
+			enable = append(enable, az)
+		}
+	}
+	for az := range observed {
+		if !want[az] {
+			disable = append(disable, az)
+		}
+	}
+	sort.Strings(enable)
+	sort.Strings(disable)
+	return enable, disable
+}
+
+// IsSnapshotUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsSnapshotUpToDate(p manualv1alpha1.SnapshotParameters, s ec2types.Snapshot, restores []ec2types.DescribeFastSnapshotRestoreSuccessItem) bool {
+	enable, disable := DiffFastSnapshotRestoreAvailabilityZones(p.FastSnapshotRestoreAvailabilityZones, restores)
+	if len(enable) != 0 || len(disable) != 0 {
+		return false
+	}
+	return manualv1alpha1.CompareTags(p.Tags, s.Tags)
+}
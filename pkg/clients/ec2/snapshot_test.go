@@ -0,0 +1,71 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func fastSnapshotRestore(az string, state ec2types.FastSnapshotRestoreStateCode) ec2types.DescribeFastSnapshotRestoreSuccessItem {
+	return ec2types.DescribeFastSnapshotRestoreSuccessItem{
+		AvailabilityZone: aws.String(az),
+		State:            state,
+	}
+}
+
+func TestDiffFastSnapshotRestoreAvailabilityZones(t *testing.T) {
+	type want struct {
+		enable  []string
+		disable []string
+	}
+	cases := map[string]struct {
+		desired  []string
+		restores []ec2types.DescribeFastSnapshotRestoreSuccessItem
+		want
+	}{
+		"UpToDate": {
+			desired: []string{"us-east-1a", "us-east-1b"},
+			restores: []ec2types.DescribeFastSnapshotRestoreSuccessItem{
+				fastSnapshotRestore("us-east-1b", ec2types.FastSnapshotRestoreStateCodeOptimizing),
+				fastSnapshotRestore("us-east-1a", ec2types.FastSnapshotRestoreStateCodeEnabled),
+			},
+		},
+		"Missing": {
+			desired: []string{"us-east-1b", "us-east-1a"},
+			want: want{
+				enable: []string{"us-east-1a", "us-east-1b"},
+			},
+		},
+		"Unwanted": {
+			restores: []ec2types.DescribeFastSnapshotRestoreSuccessItem{
+				fastSnapshotRestore("us-east-1a", ec2types.FastSnapshotRestoreStateCodeEnabled),
+			},
+			want: want{
+				disable: []string{"us-east-1a"},
+			},
+		},
+		"BeingDisabled": {
+			desired: []string{"us-east-1a"},
+			restores: []ec2types.DescribeFastSnapshotRestoreSuccessItem{
+				fastSnapshotRestore("us-east-1a", ec2types.FastSnapshotRestoreStateCodeDisabling),
+				fastSnapshotRestore("us-east-1b", ec2types.FastSnapshotRestoreStateCodeDisabling),
+			},
+			want: want{
+				enable: []string{"us-east-1a"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			enable, disable := DiffFastSnapshotRestoreAvailabilityZones(tc.desired, tc.restores)
+			if diff := cmp.Diff(tc.want.enable, enable); diff != "" {
+				t.Errorf("enable: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disable, disable); diff != "" {
+				t.Errorf("disable: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,6 +24,8 @@ type SubnetClient interface {
 	DescribeSubnets(ctx context.Context, input *ec2.DescribeSubnetsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
 	DeleteSubnet(ctx context.Context, input *ec2.DeleteSubnetInput, opts ...func(*ec2.Options)) (*ec2.DeleteSubnetOutput, error)
 	ModifySubnetAttribute(ctx context.Context, input *ec2.ModifySubnetAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifySubnetAttributeOutput, error)
+	AssociateSubnetCidrBlock(ctx context.Context, input *ec2.AssociateSubnetCidrBlockInput, opts ...func(*ec2.Options)) (*ec2.AssociateSubnetCidrBlockOutput, error)
+	DisassociateSubnetCidrBlock(ctx context.Context, input *ec2.DisassociateSubnetCidrBlockInput, opts ...func(*ec2.Options)) (*ec2.DisassociateSubnetCidrBlockOutput, error)
 	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
 }
 
@@ -67,8 +69,8 @@ func LateInitializeSubnet(in *v1beta1.SubnetParameters, s *ec2types.Subnet) { //
 	in.MapPublicIPOnLaunch = awsclients.LateInitializeBoolPtr(in.MapPublicIPOnLaunch, s.MapPublicIpOnLaunch)
 	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, s.VpcId)
 
-	if s.Ipv6CidrBlockAssociationSet != nil && len(s.Ipv6CidrBlockAssociationSet) > 0 {
-		in.IPv6CIDRBlock = awsclients.LateInitializeStringPtr(in.IPv6CIDRBlock, s.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlock)
+	if a := activeSubnetIPv6CIDRBlockAssociation(*s); a != nil {
+		in.IPv6CIDRBlock = awsclients.LateInitializeStringPtr(in.IPv6CIDRBlock, a.Ipv6CidrBlock)
 	}
 
 	if len(in.Tags) == 0 && len(s.Tags) != 0 {
@@ -84,5 +86,48 @@ func IsSubnetUpToDate(p v1beta1.SubnetParameters, s ec2types.Subnet) bool {
 	if aws.ToBool(p.AssignIPv6AddressOnCreation) != aws.ToBool(s.AssignIpv6AddressOnCreation) {
 		return false
 	}
+	if NeedsSubnetIPv6CIDRBlock(p, s) {
+		return false
+	}
 	return v1beta1.CompareTags(p.Tags, s.Tags)
 }
+
+// activeSubnetIPv6CIDRBlockAssociation returns the IPv6 CIDR block that is
+// associated or being associated with the subnet, if any. A subnet can have
+// at most one such block.
+func activeSubnetIPv6CIDRBlockAssociation(s ec2types.Subnet) *ec2types.SubnetIpv6CidrBlockAssociation {
+	for i, a := range s.Ipv6CidrBlockAssociationSet {
+		if a.Ipv6CidrBlockState == nil {
+			continue
+		}
+		if a.Ipv6CidrBlockState.State == ec2types.SubnetCidrBlockStateCodeAssociated || a.Ipv6CidrBlockState.State == ec2types.SubnetCidrBlockStateCodeAssociating {
+			return &s.Ipv6CidrBlockAssociationSet[i]
+		}
+	}
+	return nil
+}
+
+// NeedsSubnetIPv6CIDRBlock returns true if the supplied parameters specify an
+// IPv6 CIDR block that is not associated or being associated with the
+// supplied subnet.
+func NeedsSubnetIPv6CIDRBlock(p v1beta1.SubnetParameters, s ec2types.Subnet) bool {
+	if aws.ToString(p.IPv6CIDRBlock) == "" {
+		return false
+	}
+	a := activeSubnetIPv6CIDRBlockAssociation(s)
+	return a == nil || aws.ToString(a.Ipv6CidrBlock) != aws.ToString(p.IPv6CIDRBlock)
+}
+
+// StaleSubnetIPv6CIDRBlockAssociationID returns the ID of the association of
+// the IPv6 CIDR block of the supplied subnet if it has to be disassociated
+// because the supplied parameters specify a different block.
+func StaleSubnetIPv6CIDRBlockAssociationID(p v1beta1.SubnetParameters, s ec2types.Subnet) *string {
+	if aws.ToString(p.IPv6CIDRBlock) == "" {
+		return nil
+	}
+	a := activeSubnetIPv6CIDRBlockAssociation(s)
+	if a == nil || aws.ToString(a.Ipv6CidrBlock) == aws.ToString(p.IPv6CIDRBlock) {
+		return nil
+	}
+	return a.AssociationId
+}
@@ -74,6 +74,75 @@ func TestIsSubnetUpToDate(t *testing.T) {
 	}
 }
 
+func TestSubnetIPv6CIDRBlock(t *testing.T) {
+	associated := func(block, id string, state ec2types.SubnetCidrBlockStateCode) ec2types.SubnetIpv6CidrBlockAssociation {
+		return ec2types.SubnetIpv6CidrBlockAssociation{
+			AssociationId:      aws.String(id),
+			Ipv6CidrBlock:      aws.String(block),
+			Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{State: state},
+		}
+	}
+
+	type want struct {
+		needs bool
+		stale *string
+	}
+
+	cases := map[string]struct {
+		p      v1beta1.SubnetParameters
+		subnet ec2types.Subnet
+		want
+	}{
+		"NotDesired": {
+			subnet: ec2types.Subnet{Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
+				associated("2001:db8::/64", "a-1", ec2types.SubnetCidrBlockStateCodeAssociated),
+			}},
+		},
+		"Missing": {
+			p: v1beta1.SubnetParameters{IPv6CIDRBlock: aws.String("2001:db8::/64")},
+			want: want{
+				needs: true,
+			},
+		},
+		"Associated": {
+			p: v1beta1.SubnetParameters{IPv6CIDRBlock: aws.String("2001:db8::/64")},
+			subnet: ec2types.Subnet{Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
+				associated("2001:db8::/64", "a-1", ec2types.SubnetCidrBlockStateCodeAssociated),
+			}},
+		},
+		"Replaced": {
+			p: v1beta1.SubnetParameters{IPv6CIDRBlock: aws.String("2001:db8:0:1::/64")},
+			subnet: ec2types.Subnet{Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
+				associated("2001:db8::/64", "a-1", ec2types.SubnetCidrBlockStateCodeAssociated),
+			}},
+			want: want{
+				needs: true,
+				stale: aws.String("a-1"),
+			},
+		},
+		"PreviousDisassociating": {
+			p: v1beta1.SubnetParameters{IPv6CIDRBlock: aws.String("2001:db8:0:1::/64")},
+			subnet: ec2types.Subnet{Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
+				associated("2001:db8::/64", "a-1", ec2types.SubnetCidrBlockStateCodeDisassociating),
+			}},
+			want: want{
+				needs: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.needs, NeedsSubnetIPv6CIDRBlock(tc.p, tc.subnet)); diff != "" {
+				t.Errorf("needs: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.stale, StaleSubnetIPv6CIDRBlockAssociationID(tc.p, tc.subnet)); diff != "" {
+				t.Errorf("stale: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateSubnetObservation(t *testing.T) {
 	cases := map[string]struct {
 		in  ec2types.Subnet
@@ -0,0 +1,220 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// TrafficMirrorFilterIDNotFound is the code that is returned by ec2 when
+	// the given TrafficMirrorFilterID is not valid
+	TrafficMirrorFilterIDNotFound = "InvalidTrafficMirrorFilterId.NotFound"
+
+	// TrafficMirrorFilterRuleIDNotFound is the code that is returned by ec2
+	// when the given TrafficMirrorFilterRuleID is not valid
+	TrafficMirrorFilterRuleIDNotFound = "InvalidTrafficMirrorFilterRuleId.NotFound"
+)
+
+// TrafficMirrorFilterClient is the external client used for
+// TrafficMirrorFilter Custom Resource
+type TrafficMirrorFilterClient interface {
+	CreateTrafficMirrorFilter(ctx context.Context, input *ec2.CreateTrafficMirrorFilterInput, opts ...func(*ec2.Options)) (*ec2.CreateTrafficMirrorFilterOutput, error)
+	DescribeTrafficMirrorFilters(ctx context.Context, input *ec2.DescribeTrafficMirrorFiltersInput, opts ...func(*ec2.Options)) (*ec2.DescribeTrafficMirrorFiltersOutput, error)
+	ModifyTrafficMirrorFilterNetworkServices(ctx context.Context, input *ec2.ModifyTrafficMirrorFilterNetworkServicesInput, opts ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterNetworkServicesOutput, error)
+	DeleteTrafficMirrorFilter(ctx context.Context, input *ec2.DeleteTrafficMirrorFilterInput, opts ...func(*ec2.Options)) (*ec2.DeleteTrafficMirrorFilterOutput, error)
+	CreateTrafficMirrorFilterRule(ctx context.Context, input *ec2.CreateTrafficMirrorFilterRuleInput, opts ...func(*ec2.Options)) (*ec2.CreateTrafficMirrorFilterRuleOutput, error)
+	ModifyTrafficMirrorFilterRule(ctx context.Context, input *ec2.ModifyTrafficMirrorFilterRuleInput, opts ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorFilterRuleOutput, error)
+	DeleteTrafficMirrorFilterRule(ctx context.Context, input *ec2.DeleteTrafficMirrorFilterRuleInput, opts ...func(*ec2.Options)) (*ec2.DeleteTrafficMirrorFilterRuleOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewTrafficMirrorFilterClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewTrafficMirrorFilterClient(cfg aws.Config) TrafficMirrorFilterClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsTrafficMirrorFilterNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsTrafficMirrorFilterNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TrafficMirrorFilterIDNotFound
+}
+
+// IsTrafficMirrorFilterRuleNotFoundErr returns true if the error is because
+// the rule doesn't exist
+func IsTrafficMirrorFilterRuleNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TrafficMirrorFilterRuleIDNotFound
+}
+
+// GenerateTrafficMirrorFilterObservation is used to produce
+// manualv1alpha1.TrafficMirrorFilterObservation from ec2types.TrafficMirrorFilter.
+func GenerateTrafficMirrorFilterObservation(f ec2types.TrafficMirrorFilter) manualv1alpha1.TrafficMirrorFilterObservation {
+	return manualv1alpha1.TrafficMirrorFilterObservation{
+		TrafficMirrorFilterID: aws.ToString(f.TrafficMirrorFilterId),
+	}
+}
+
+// LateInitializeTrafficMirrorFilter fills the empty fields in
+// *manualv1alpha1.TrafficMirrorFilterParameters with the values seen in
+// ec2types.TrafficMirrorFilter.
+func LateInitializeTrafficMirrorFilter(in *manualv1alpha1.TrafficMirrorFilterParameters, f *ec2types.TrafficMirrorFilter) {
+	if f == nil {
+		return
+	}
+	if in.Description == nil && aws.ToString(f.Description) != "" {
+		in.Description = f.Description
+	}
+}
+
+// DiffTrafficMirrorFilterNetworkServices returns the network services that
+// have to be added to and removed from the Traffic Mirror filter.
+func DiffTrafficMirrorFilterNetworkServices(p manualv1alpha1.TrafficMirrorFilterParameters, f ec2types.TrafficMirrorFilter) (add, remove []ec2types.TrafficMirrorNetworkService) {
+	have := map[string]bool{}
+	for _, s := range f.NetworkServices {
+		have[string(s)] = true
+	}
+	want := map[string]bool{}
+	for _, s := range p.NetworkServices {
+		want[s] = true
+		if !have[s] {
+			add = append(add, ec2types.TrafficMirrorNetworkService(s))
+		}
+	}
+	for _, s := range f.NetworkServices {
+		if !want[string(s)] {
+			remove = append(remove, s)
+		}
+	}
+	return add, remove
+}
+
+// TrafficMirrorFilterRuleChanges are the calls needed to bring the rules of a
+// Traffic Mirror filter to the desired state.
+type TrafficMirrorFilterRuleChanges struct {
+	Create []*ec2.CreateTrafficMirrorFilterRuleInput
+	Modify []*ec2.ModifyTrafficMirrorFilterRuleInput
+	Delete []*ec2.DeleteTrafficMirrorFilterRuleInput
+}
+
+// Empty returns true if no rule has to be changed.
+func (c TrafficMirrorFilterRuleChanges) Empty() bool {
+	return len(c.Create) == 0 && len(c.Modify) == 0 && len(c.Delete) == 0
+}
+
+// DiffTrafficMirrorFilterRules returns the rules that have to be created,
+// modified and deleted. Rules are matched on their direction and rule number.
+func DiffTrafficMirrorFilterRules(p manualv1alpha1.TrafficMirrorFilterParameters, f ec2types.TrafficMirrorFilter) TrafficMirrorFilterRuleChanges {
+	c := TrafficMirrorFilterRuleChanges{}
+	diffTrafficMirrorFilterRules(&c, aws.ToString(f.TrafficMirrorFilterId), ec2types.TrafficDirectionIngress, p.IngressRules, f.IngressFilterRules)
+	diffTrafficMirrorFilterRules(&c, aws.ToString(f.TrafficMirrorFilterId), ec2types.TrafficDirectionEgress, p.EgressRules, f.EgressFilterRules)
+	return c
+}
+
+func diffTrafficMirrorFilterRules(c *TrafficMirrorFilterRuleChanges, filterID string, direction ec2types.TrafficDirection, desired []manualv1alpha1.TrafficMirrorFilterRule, observed []ec2types.TrafficMirrorFilterRule) {
+	have := map[int32]ec2types.TrafficMirrorFilterRule{}
+	for _, r := range observed {
+		have[aws.ToInt32(r.RuleNumber)] = r
+	}
+	want := map[int32]bool{}
+	for _, r := range desired {
+		want[r.RuleNumber] = true
+		o, ok := have[r.RuleNumber]
+		switch {
+		case !ok:
+			c.Create = append(c.Create, &ec2.CreateTrafficMirrorFilterRuleInput{
+				TrafficMirrorFilterId: aws.String(filterID),
+				TrafficDirection:      direction,
+				RuleNumber:            aws.Int32(r.RuleNumber),
+				RuleAction:            ec2types.TrafficMirrorRuleAction(r.RuleAction),
+				Protocol:              r.Protocol,
+				DestinationCidrBlock:  aws.String(r.DestinationCIDRBlock),
+				SourceCidrBlock:       aws.String(r.SourceCIDRBlock),
+				DestinationPortRange:  generateTrafficMirrorPortRange(r.DestinationPortRange),
+				SourcePortRange:       generateTrafficMirrorPortRange(r.SourcePortRange),
+				Description:           r.Description,
+			})
+		case !isTrafficMirrorFilterRuleUpToDate(r, o):
+			c.Modify = append(c.Modify, generateModifyTrafficMirrorFilterRuleInput(r, o))
+		}
+	}
+	for _, r := range observed {
+		if !want[aws.ToInt32(r.RuleNumber)] {
+			c.Delete = append(c.Delete, &ec2.DeleteTrafficMirrorFilterRuleInput{
+				TrafficMirrorFilterRuleId: r.TrafficMirrorFilterRuleId,
+			})
+		}
+	}
+}
+
+func generateTrafficMirrorPortRange(r *manualv1alpha1.TrafficMirrorPortRange) *ec2types.TrafficMirrorPortRangeRequest {
+	if r == nil {
+		return nil
+	}
+	return &ec2types.TrafficMirrorPortRangeRequest{
+		FromPort: aws.Int32(r.FromPort),
+		ToPort:   aws.Int32(r.ToPort),
+	}
+}
+
+func generateModifyTrafficMirrorFilterRuleInput(r manualv1alpha1.TrafficMirrorFilterRule, o ec2types.TrafficMirrorFilterRule) *ec2.ModifyTrafficMirrorFilterRuleInput {
+	input := &ec2.ModifyTrafficMirrorFilterRuleInput{
+		TrafficMirrorFilterRuleId: o.TrafficMirrorFilterRuleId,
+		RuleAction:                ec2types.TrafficMirrorRuleAction(r.RuleAction),
+		Protocol:                  r.Protocol,
+		DestinationCidrBlock:      aws.String(r.DestinationCIDRBlock),
+		SourceCidrBlock:           aws.String(r.SourceCIDRBlock),
+		DestinationPortRange:      generateTrafficMirrorPortRange(r.DestinationPortRange),
+		SourcePortRange:           generateTrafficMirrorPortRange(r.SourcePortRange),
+		Description:               r.Description,
+	}
+	if r.Protocol == nil && o.Protocol != nil {
+		input.RemoveFields = append(input.RemoveFields, ec2types.TrafficMirrorFilterRuleFieldProtocol)
+	}
+	if r.DestinationPortRange == nil && o.DestinationPortRange != nil {
+		input.RemoveFields = append(input.RemoveFields, ec2types.TrafficMirrorFilterRuleFieldDestinationPortRange)
+	}
+	if r.SourcePortRange == nil && o.SourcePortRange != nil {
+		input.RemoveFields = append(input.RemoveFields, ec2types.TrafficMirrorFilterRuleFieldSourcePortRange)
+	}
+	if r.Description == nil && o.Description != nil {
+		input.RemoveFields = append(input.RemoveFields, ec2types.TrafficMirrorFilterRuleFieldDescription)
+	}
+	return input
+}
+
+func isTrafficMirrorPortRangeEqual(r *manualv1alpha1.TrafficMirrorPortRange, o *ec2types.TrafficMirrorPortRange) bool {
+	if r == nil || o == nil {
+		return r == nil && o == nil
+	}
+	return r.FromPort == aws.ToInt32(o.FromPort) && r.ToPort == aws.ToInt32(o.ToPort)
+}
+
+func isTrafficMirrorFilterRuleUpToDate(r manualv1alpha1.TrafficMirrorFilterRule, o ec2types.TrafficMirrorFilterRule) bool {
+	return r.RuleAction == string(o.RuleAction) &&
+		aws.ToInt32(r.Protocol) == aws.ToInt32(o.Protocol) &&
+		r.DestinationCIDRBlock == aws.ToString(o.DestinationCidrBlock) &&
+		r.SourceCIDRBlock == aws.ToString(o.SourceCidrBlock) &&
+		isTrafficMirrorPortRangeEqual(r.DestinationPortRange, o.DestinationPortRange) &&
+		isTrafficMirrorPortRangeEqual(r.SourcePortRange, o.SourcePortRange) &&
+		aws.ToString(r.Description) == aws.ToString(o.Description)
+}
+
+// IsTrafficMirrorFilterUpToDate checks whether there is a change in any of
+// the modifiable fields.
+func IsTrafficMirrorFilterUpToDate(p manualv1alpha1.TrafficMirrorFilterParameters, f ec2types.TrafficMirrorFilter) bool {
+	add, remove := DiffTrafficMirrorFilterNetworkServices(p, f)
+	if len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	return DiffTrafficMirrorFilterRules(p, f).Empty() && manualv1alpha1.CompareTags(p.Tags, f.Tags)
+}
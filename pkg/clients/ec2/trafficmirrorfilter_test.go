@@ -0,0 +1,190 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+var (
+	tmfID = "tmf-123"
+)
+
+func tmfRule(number int32, action string) manualv1alpha1.TrafficMirrorFilterRule {
+	return manualv1alpha1.TrafficMirrorFilterRule{
+		RuleNumber:           number,
+		RuleAction:           action,
+		Protocol:             aws.Int32(6),
+		DestinationCIDRBlock: "0.0.0.0/0",
+		SourceCIDRBlock:      "10.0.0.0/16",
+		DestinationPortRange: &manualv1alpha1.TrafficMirrorPortRange{FromPort: 443, ToPort: 443},
+	}
+}
+
+func tmfObservedRule(id string, number int32, action ec2types.TrafficMirrorRuleAction) ec2types.TrafficMirrorFilterRule {
+	return ec2types.TrafficMirrorFilterRule{
+		TrafficMirrorFilterRuleId: aws.String(id),
+		RuleNumber:                aws.Int32(number),
+		RuleAction:                action,
+		Protocol:                  aws.Int32(6),
+		DestinationCidrBlock:      aws.String("0.0.0.0/0"),
+		SourceCidrBlock:           aws.String("10.0.0.0/16"),
+		DestinationPortRange:      &ec2types.TrafficMirrorPortRange{FromPort: aws.Int32(443), ToPort: aws.Int32(443)},
+	}
+}
+
+func TestDiffTrafficMirrorFilterRules(t *testing.T) {
+	type args struct {
+		p manualv1alpha1.TrafficMirrorFilterParameters
+		f ec2types.TrafficMirrorFilter
+	}
+
+	cases := map[string]struct {
+		args args
+		want TrafficMirrorFilterRuleChanges
+	}{
+		"UpToDate": {
+			args: args{
+				p: manualv1alpha1.TrafficMirrorFilterParameters{
+					IngressRules: []manualv1alpha1.TrafficMirrorFilterRule{tmfRule(10, "accept")},
+				},
+				f: ec2types.TrafficMirrorFilter{
+					TrafficMirrorFilterId: aws.String(tmfID),
+					IngressFilterRules:    []ec2types.TrafficMirrorFilterRule{tmfObservedRule("r-1", 10, ec2types.TrafficMirrorRuleActionAccept)},
+				},
+			},
+			want: TrafficMirrorFilterRuleChanges{},
+		},
+		"SameNumberOtherDirection": {
+			args: args{
+				p: manualv1alpha1.TrafficMirrorFilterParameters{
+					EgressRules: []manualv1alpha1.TrafficMirrorFilterRule{tmfRule(10, "accept")},
+				},
+				f: ec2types.TrafficMirrorFilter{
+					TrafficMirrorFilterId: aws.String(tmfID),
+					IngressFilterRules:    []ec2types.TrafficMirrorFilterRule{tmfObservedRule("r-1", 10, ec2types.TrafficMirrorRuleActionAccept)},
+				},
+			},
+			want: TrafficMirrorFilterRuleChanges{
+				Create: []*ec2.CreateTrafficMirrorFilterRuleInput{{
+					TrafficMirrorFilterId: aws.String(tmfID),
+					TrafficDirection:      ec2types.TrafficDirectionEgress,
+					RuleNumber:            aws.Int32(10),
+					RuleAction:            ec2types.TrafficMirrorRuleActionAccept,
+					Protocol:              aws.Int32(6),
+					DestinationCidrBlock:  aws.String("0.0.0.0/0"),
+					SourceCidrBlock:       aws.String("10.0.0.0/16"),
+					DestinationPortRange:  &ec2types.TrafficMirrorPortRangeRequest{FromPort: aws.Int32(443), ToPort: aws.Int32(443)},
+				}},
+				Delete: []*ec2.DeleteTrafficMirrorFilterRuleInput{{
+					TrafficMirrorFilterRuleId: aws.String("r-1"),
+				}},
+			},
+		},
+		"ModifiedRule": {
+			args: args{
+				p: manualv1alpha1.TrafficMirrorFilterParameters{
+					IngressRules: []manualv1alpha1.TrafficMirrorFilterRule{{
+						RuleNumber:           10,
+						RuleAction:           "reject",
+						DestinationCIDRBlock: "0.0.0.0/0",
+						SourceCIDRBlock:      "10.0.0.0/16",
+					}},
+				},
+				f: ec2types.TrafficMirrorFilter{
+					TrafficMirrorFilterId: aws.String(tmfID),
+					IngressFilterRules:    []ec2types.TrafficMirrorFilterRule{tmfObservedRule("r-1", 10, ec2types.TrafficMirrorRuleActionAccept)},
+				},
+			},
+			want: TrafficMirrorFilterRuleChanges{
+				Modify: []*ec2.ModifyTrafficMirrorFilterRuleInput{{
+					TrafficMirrorFilterRuleId: aws.String("r-1"),
+					RuleAction:                ec2types.TrafficMirrorRuleActionReject,
+					DestinationCidrBlock:      aws.String("0.0.0.0/0"),
+					SourceCidrBlock:           aws.String("10.0.0.0/16"),
+					RemoveFields: []ec2types.TrafficMirrorFilterRuleField{
+						ec2types.TrafficMirrorFilterRuleFieldProtocol,
+						ec2types.TrafficMirrorFilterRuleFieldDestinationPortRange,
+					},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffTrafficMirrorFilterRules(tc.args.p, tc.args.f)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2.CreateTrafficMirrorFilterRuleInput{}, ec2.ModifyTrafficMirrorFilterRuleInput{}, ec2.DeleteTrafficMirrorFilterRuleInput{}, ec2types.TrafficMirrorPortRangeRequest{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTrafficMirrorFilterUpToDate(t *testing.T) {
+	type args struct {
+		p manualv1alpha1.TrafficMirrorFilterParameters
+		f ec2types.TrafficMirrorFilter
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: manualv1alpha1.TrafficMirrorFilterParameters{
+					NetworkServices: []string{"amazon-dns"},
+					EgressRules:     []manualv1alpha1.TrafficMirrorFilterRule{tmfRule(10, "accept")},
+				},
+				f: ec2types.TrafficMirrorFilter{
+					NetworkServices:   []ec2types.TrafficMirrorNetworkService{ec2types.TrafficMirrorNetworkServiceAmazonDns},
+					EgressFilterRules: []ec2types.TrafficMirrorFilterRule{tmfObservedRule("r-1", 10, ec2types.TrafficMirrorRuleActionAccept)},
+				},
+			},
+			want: true,
+		},
+		"NetworkServiceRemoved": {
+			args: args{
+				p: manualv1alpha1.TrafficMirrorFilterParameters{},
+				f: ec2types.TrafficMirrorFilter{
+					NetworkServices: []ec2types.TrafficMirrorNetworkService{ec2types.TrafficMirrorNetworkServiceAmazonDns},
+				},
+			},
+			want: false,
+		},
+		"RuleMissing": {
+			args: args{
+				p: manualv1alpha1.TrafficMirrorFilterParameters{
+					EgressRules: []manualv1alpha1.TrafficMirrorFilterRule{tmfRule(10, "accept")},
+				},
+				f: ec2types.TrafficMirrorFilter{},
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			args: args{
+				p: manualv1alpha1.TrafficMirrorFilterParameters{
+					Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+				},
+				f: ec2types.TrafficMirrorFilter{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTrafficMirrorFilterUpToDate(tc.args.p, tc.args.f)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
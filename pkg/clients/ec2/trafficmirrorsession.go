@@ -0,0 +1,106 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TrafficMirrorSessionIDNotFound is the code that is returned by ec2 when
+	// the given TrafficMirrorSessionID is not valid
+	TrafficMirrorSessionIDNotFound = "InvalidTrafficMirrorSessionId.NotFound"
+)
+
+// TrafficMirrorSessionClient is the external client used for
+// TrafficMirrorSession Custom Resource
+type TrafficMirrorSessionClient interface {
+	CreateTrafficMirrorSession(ctx context.Context, input *ec2.CreateTrafficMirrorSessionInput, opts ...func(*ec2.Options)) (*ec2.CreateTrafficMirrorSessionOutput, error)
+	DescribeTrafficMirrorSessions(ctx context.Context, input *ec2.DescribeTrafficMirrorSessionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTrafficMirrorSessionsOutput, error)
+	ModifyTrafficMirrorSession(ctx context.Context, input *ec2.ModifyTrafficMirrorSessionInput, opts ...func(*ec2.Options)) (*ec2.ModifyTrafficMirrorSessionOutput, error)
+	DeleteTrafficMirrorSession(ctx context.Context, input *ec2.DeleteTrafficMirrorSessionInput, opts ...func(*ec2.Options)) (*ec2.DeleteTrafficMirrorSessionOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewTrafficMirrorSessionClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewTrafficMirrorSessionClient(cfg aws.Config) TrafficMirrorSessionClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsTrafficMirrorSessionNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsTrafficMirrorSessionNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TrafficMirrorSessionIDNotFound
+}
+
+// GenerateTrafficMirrorSessionObservation is used to produce
+// manualv1alpha1.TrafficMirrorSessionObservation from ec2types.TrafficMirrorSession.
+func GenerateTrafficMirrorSessionObservation(s ec2types.TrafficMirrorSession) manualv1alpha1.TrafficMirrorSessionObservation {
+	return manualv1alpha1.TrafficMirrorSessionObservation{
+		TrafficMirrorSessionID: aws.ToString(s.TrafficMirrorSessionId),
+		OwnerID:                aws.ToString(s.OwnerId),
+	}
+}
+
+// LateInitializeTrafficMirrorSession fills the empty fields in
+// *manualv1alpha1.TrafficMirrorSessionParameters with the values seen in
+// ec2types.TrafficMirrorSession.
+func LateInitializeTrafficMirrorSession(in *manualv1alpha1.TrafficMirrorSessionParameters, s *ec2types.TrafficMirrorSession) {
+	if s == nil {
+		return
+	}
+	in.VirtualNetworkID = awsclients.LateInitializeInt32Ptr(in.VirtualNetworkID, s.VirtualNetworkId)
+}
+
+// GenerateModifyTrafficMirrorSessionInput returns the input that modifies the
+// Traffic Mirror session to match the parameters. Optional fields that are
+// not set anymore are removed from the session.
+func GenerateModifyTrafficMirrorSessionInput(id string, p manualv1alpha1.TrafficMirrorSessionParameters) *ec2.ModifyTrafficMirrorSessionInput {
+	input := &ec2.ModifyTrafficMirrorSessionInput{
+		TrafficMirrorSessionId: aws.String(id),
+		TrafficMirrorTargetId:  p.TrafficMirrorTargetID,
+		TrafficMirrorFilterId:  p.TrafficMirrorFilterID,
+		SessionNumber:          aws.Int32(p.SessionNumber),
+		PacketLength:           p.PacketLength,
+		VirtualNetworkId:       p.VirtualNetworkID,
+		Description:            p.Description,
+	}
+	if p.PacketLength == nil {
+		input.RemoveFields = append(input.RemoveFields, ec2types.TrafficMirrorSessionFieldPacketLength)
+	}
+	if p.Description == nil {
+		input.RemoveFields = append(input.RemoveFields, ec2types.TrafficMirrorSessionFieldDescription)
+	}
+	return input
+}
+
+// NeedsTrafficMirrorSessionModification returns true if the Traffic Mirror
+// session differs from the parameters in any field other than its tags.
+func NeedsTrafficMirrorSessionModification(p manualv1alpha1.TrafficMirrorSessionParameters, s ec2types.TrafficMirrorSession) bool {
+	if p.VirtualNetworkID != nil && aws.ToInt32(p.VirtualNetworkID) != aws.ToInt32(s.VirtualNetworkId) {
+		return true
+	}
+	if p.PacketLength != nil && aws.ToInt32(p.PacketLength) != aws.ToInt32(s.PacketLength) {
+		return true
+	}
+	return aws.ToString(p.TrafficMirrorTargetID) != aws.ToString(s.TrafficMirrorTargetId) ||
+		aws.ToString(p.TrafficMirrorFilterID) != aws.ToString(s.TrafficMirrorFilterId) ||
+		p.SessionNumber != aws.ToInt32(s.SessionNumber) ||
+		aws.ToString(p.Description) != aws.ToString(s.Description)
+}
+
+// IsTrafficMirrorSessionUpToDate checks whether there is a change in any of
+// the modifiable fields.
+func IsTrafficMirrorSessionUpToDate(p manualv1alpha1.TrafficMirrorSessionParameters, s ec2types.TrafficMirrorSession) bool {
+	return !NeedsTrafficMirrorSessionModification(p, s) && manualv1alpha1.CompareTags(p.Tags, s.Tags)
+}
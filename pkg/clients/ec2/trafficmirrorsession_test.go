@@ -0,0 +1,134 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+var (
+	tmsID       = "tms-123"
+	tmsTargetID = "tmt-123"
+	tmsFilterID = "tmf-123"
+)
+
+func tmsParameters() manualv1alpha1.TrafficMirrorSessionParameters {
+	return manualv1alpha1.TrafficMirrorSessionParameters{
+		TrafficMirrorTargetID: aws.String(tmsTargetID),
+		TrafficMirrorFilterID: aws.String(tmsFilterID),
+		SessionNumber:         1,
+	}
+}
+
+func tmsObserved() ec2types.TrafficMirrorSession {
+	return ec2types.TrafficMirrorSession{
+		TrafficMirrorSessionId: aws.String(tmsID),
+		TrafficMirrorTargetId:  aws.String(tmsTargetID),
+		TrafficMirrorFilterId:  aws.String(tmsFilterID),
+		SessionNumber:          aws.Int32(1),
+		PacketLength:           aws.Int32(8500),
+		VirtualNetworkId:       aws.Int32(42),
+	}
+}
+
+func TestIsTrafficMirrorSessionUpToDate(t *testing.T) {
+	type args struct {
+		p manualv1alpha1.TrafficMirrorSessionParameters
+		s ec2types.TrafficMirrorSession
+	}
+
+	withFilter := tmsParameters()
+	withFilter.TrafficMirrorFilterID = aws.String("tmf-456")
+	withPacketLength := tmsParameters()
+	withPacketLength.PacketLength = aws.Int32(128)
+	withVNI := tmsParameters()
+	withVNI.VirtualNetworkID = aws.Int32(42)
+	withTags := tmsParameters()
+	withTags.Tags = []manualv1alpha1.Tag{{Key: "k", Value: "v"}}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{p: tmsParameters(), s: tmsObserved()},
+			want: true,
+		},
+		"SameVirtualNetworkID": {
+			args: args{p: withVNI, s: tmsObserved()},
+			want: true,
+		},
+		"DifferentFilter": {
+			args: args{p: withFilter, s: tmsObserved()},
+			want: false,
+		},
+		"DifferentPacketLength": {
+			args: args{p: withPacketLength, s: tmsObserved()},
+			want: false,
+		},
+		"DifferentTags": {
+			args: args{p: withTags, s: tmsObserved()},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTrafficMirrorSessionUpToDate(tc.args.p, tc.args.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyTrafficMirrorSessionInput(t *testing.T) {
+	withPacketLength := tmsParameters()
+	withPacketLength.PacketLength = aws.Int32(128)
+	withPacketLength.Description = aws.String("mirror")
+
+	cases := map[string]struct {
+		p    manualv1alpha1.TrafficMirrorSessionParameters
+		want *ec2.ModifyTrafficMirrorSessionInput
+	}{
+		"RemoveOptionalFields": {
+			p: tmsParameters(),
+			want: &ec2.ModifyTrafficMirrorSessionInput{
+				TrafficMirrorSessionId: aws.String(tmsID),
+				TrafficMirrorTargetId:  aws.String(tmsTargetID),
+				TrafficMirrorFilterId:  aws.String(tmsFilterID),
+				SessionNumber:          aws.Int32(1),
+				RemoveFields: []ec2types.TrafficMirrorSessionField{
+					ec2types.TrafficMirrorSessionFieldPacketLength,
+					ec2types.TrafficMirrorSessionFieldDescription,
+				},
+			},
+		},
+		"SetOptionalFields": {
+			p: withPacketLength,
+			want: &ec2.ModifyTrafficMirrorSessionInput{
+				TrafficMirrorSessionId: aws.String(tmsID),
+				TrafficMirrorTargetId:  aws.String(tmsTargetID),
+				TrafficMirrorFilterId:  aws.String(tmsFilterID),
+				SessionNumber:          aws.Int32(1),
+				PacketLength:           aws.Int32(128),
+				Description:            aws.String("mirror"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyTrafficMirrorSessionInput(tmsID, tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2.ModifyTrafficMirrorSessionInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
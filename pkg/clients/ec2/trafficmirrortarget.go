@@ -0,0 +1,70 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// TrafficMirrorTargetIDNotFound is the code that is returned by ec2 when
+	// the given TrafficMirrorTargetID is not valid
+	TrafficMirrorTargetIDNotFound = "InvalidTrafficMirrorTargetId.NotFound"
+)
+
+// TrafficMirrorTargetClient is the external client used for
+// TrafficMirrorTarget Custom Resource
+type TrafficMirrorTargetClient interface {
+	CreateTrafficMirrorTarget(ctx context.Context, input *ec2.CreateTrafficMirrorTargetInput, opts ...func(*ec2.Options)) (*ec2.CreateTrafficMirrorTargetOutput, error)
+	DescribeTrafficMirrorTargets(ctx context.Context, input *ec2.DescribeTrafficMirrorTargetsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTrafficMirrorTargetsOutput, error)
+	DeleteTrafficMirrorTarget(ctx context.Context, input *ec2.DeleteTrafficMirrorTargetInput, opts ...func(*ec2.Options)) (*ec2.DeleteTrafficMirrorTargetOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewTrafficMirrorTargetClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewTrafficMirrorTargetClient(cfg aws.Config) TrafficMirrorTargetClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsTrafficMirrorTargetNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsTrafficMirrorTargetNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TrafficMirrorTargetIDNotFound
+}
+
+// GenerateTrafficMirrorTargetObservation is used to produce
+// manualv1alpha1.TrafficMirrorTargetObservation from ec2types.TrafficMirrorTarget.
+func GenerateTrafficMirrorTargetObservation(t ec2types.TrafficMirrorTarget) manualv1alpha1.TrafficMirrorTargetObservation {
+	return manualv1alpha1.TrafficMirrorTargetObservation{
+		TrafficMirrorTargetID: aws.ToString(t.TrafficMirrorTargetId),
+		OwnerID:               aws.ToString(t.OwnerId),
+		Type:                  string(t.Type),
+	}
+}
+
+// LateInitializeTrafficMirrorTarget fills the empty fields in
+// *manualv1alpha1.TrafficMirrorTargetParameters with the values seen in
+// ec2types.TrafficMirrorTarget.
+func LateInitializeTrafficMirrorTarget(in *manualv1alpha1.TrafficMirrorTargetParameters, t *ec2types.TrafficMirrorTarget) {
+	if t == nil {
+		return
+	}
+	if in.Description == nil && aws.ToString(t.Description) != "" {
+		in.Description = t.Description
+	}
+}
+
+// IsTrafficMirrorTargetUpToDate checks whether there is a change in any of
+// the modifiable fields. Only the tags of a target can be modified.
+func IsTrafficMirrorTargetUpToDate(p manualv1alpha1.TrafficMirrorTargetParameters, t ec2types.TrafficMirrorTarget) bool {
+	return manualv1alpha1.CompareTags(p.Tags, t.Tags)
+}
@@ -27,6 +27,8 @@ type VPCClient interface {
 	ModifyVpcAttribute(ctx context.Context, input *ec2.ModifyVpcAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyVpcAttributeOutput, error)
 	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
 	ModifyVpcTenancy(ctx context.Context, input *ec2.ModifyVpcTenancyInput, opts ...func(*ec2.Options)) (*ec2.ModifyVpcTenancyOutput, error)
+	AssociateVpcCidrBlock(ctx context.Context, input *ec2.AssociateVpcCidrBlockInput, opts ...func(*ec2.Options)) (*ec2.AssociateVpcCidrBlockOutput, error)
+	DisassociateVpcCidrBlock(ctx context.Context, input *ec2.DisassociateVpcCidrBlockInput, opts ...func(*ec2.Options)) (*ec2.DisassociateVpcCidrBlockOutput, error)
 }
 
 // NewVPCClient returns a new client using AWS credentials as JSON encoded data.
@@ -52,9 +54,74 @@ func IsVpcUpToDate(spec v1beta1.VPCParameters, vpc ec2types.Vpc, attributes ec2.
 		return false
 	}
 
+	associate, disassociate := DiffVPCCIDRBlocks(spec, vpc)
+	if len(associate) != 0 || len(disassociate) != 0 {
+		return false
+	}
+
+	if NeedsAmazonProvidedIPv6CIDRBlock(spec, vpc) {
+		return false
+	}
+
 	return v1beta1.CompareTags(spec.Tags, vpc.Tags)
 }
 
+// NeedsAmazonProvidedIPv6CIDRBlock returns true if the supplied spec requests
+// an Amazon-provided IPv6 CIDR block but the supplied VPC has no IPv6 CIDR
+// block associated or being associated with it.
+func NeedsAmazonProvidedIPv6CIDRBlock(spec v1beta1.VPCParameters, vpc ec2types.Vpc) bool {
+	if !aws.ToBool(spec.AmazonProvidedIpv6CIDRBlock) {
+		return false
+	}
+	for _, a := range vpc.Ipv6CidrBlockAssociationSet {
+		if a.Ipv6CidrBlockState == nil {
+			continue
+		}
+		if a.Ipv6CidrBlockState.State == ec2types.VpcCidrBlockStateCodeAssociated || a.Ipv6CidrBlockState.State == ec2types.VpcCidrBlockStateCodeAssociating {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffVPCCIDRBlocks returns the additional CIDR blocks of the supplied spec
+// that need to be associated with the supplied VPC, and the IDs of the
+// associations of secondary CIDR blocks that need to be disassociated from
+// it. Nothing is returned if the spec does not manage additional CIDR blocks.
+func DiffVPCCIDRBlocks(spec v1beta1.VPCParameters, vpc ec2types.Vpc) ([]string, []string) {
+	if spec.AdditionalCIDRBlocks == nil {
+		return nil, nil
+	}
+	desired := map[string]bool{}
+	for _, c := range spec.AdditionalCIDRBlocks {
+		desired[c] = true
+	}
+
+	observed := map[string]bool{}
+	var disassociate []string
+	for _, a := range vpc.CidrBlockAssociationSet {
+		if a.CidrBlockState == nil || (a.CidrBlockState.State != ec2types.VpcCidrBlockStateCodeAssociated && a.CidrBlockState.State != ec2types.VpcCidrBlockStateCodeAssociating) {
+			continue
+		}
+		c := aws.ToString(a.CidrBlock)
+		if c == aws.ToString(vpc.CidrBlock) {
+			continue
+		}
+		observed[c] = true
+		if !desired[c] {
+			disassociate = append(disassociate, aws.ToString(a.AssociationId))
+		}
+	}
+
+	var associate []string
+	for _, c := range spec.AdditionalCIDRBlocks {
+		if !observed[c] && c != aws.ToString(vpc.CidrBlock) {
+			associate = append(associate, c)
+		}
+	}
+	return associate, disassociate
+}
+
 // GenerateVpcObservation is used to produce v1beta1.VPCObservation from
 // ec2types.Vpc.
 func GenerateVpcObservation(vpc ec2types.Vpc) v1beta1.VPCObservation {
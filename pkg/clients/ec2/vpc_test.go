@@ -56,3 +56,68 @@ func TestGenerateVPCObservation(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffVPCCIDRBlocks(t *testing.T) {
+	primary := "10.0.0.0/16"
+	association := func(id, cidr string, state ec2types.VpcCidrBlockStateCode) ec2types.VpcCidrBlockAssociation {
+		return ec2types.VpcCidrBlockAssociation{
+			AssociationId:  aws.String(id),
+			CidrBlock:      aws.String(cidr),
+			CidrBlockState: &ec2types.VpcCidrBlockState{State: state},
+		}
+	}
+	type want struct {
+		associate    []string
+		disassociate []string
+	}
+	cases := map[string]struct {
+		spec v1beta1.VPCParameters
+		vpc  ec2types.Vpc
+		want want
+	}{
+		"NotManaged": {
+			spec: v1beta1.VPCParameters{CIDRBlock: primary},
+			vpc: ec2types.Vpc{
+				CidrBlock:               aws.String(primary),
+				CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{association("a-2", "100.64.0.0/16", ec2types.VpcCidrBlockStateCodeAssociated)},
+			},
+		},
+		"UpToDate": {
+			spec: v1beta1.VPCParameters{CIDRBlock: primary, AdditionalCIDRBlocks: []string{"100.64.0.0/16"}},
+			vpc: ec2types.Vpc{
+				CidrBlock: aws.String(primary),
+				CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
+					association("a-1", primary, ec2types.VpcCidrBlockStateCodeAssociated),
+					association("a-2", "100.64.0.0/16", ec2types.VpcCidrBlockStateCodeAssociating),
+				},
+			},
+		},
+		"Changed": {
+			spec: v1beta1.VPCParameters{CIDRBlock: primary, AdditionalCIDRBlocks: []string{"100.64.0.0/16"}},
+			vpc: ec2types.Vpc{
+				CidrBlock: aws.String(primary),
+				CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
+					association("a-1", primary, ec2types.VpcCidrBlockStateCodeAssociated),
+					association("a-2", "10.1.0.0/16", ec2types.VpcCidrBlockStateCodeAssociated),
+					association("a-3", "100.64.0.0/16", ec2types.VpcCidrBlockStateCodeDisassociated),
+				},
+			},
+			want: want{
+				associate:    []string{"100.64.0.0/16"},
+				disassociate: []string{"a-2"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			associate, disassociate := DiffVPCCIDRBlocks(tc.spec, tc.vpc)
+			if diff := cmp.Diff(tc.want.associate, associate); diff != "" {
+				t.Errorf("associate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociate, disassociate); diff != "" {
+				t.Errorf("disassociate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
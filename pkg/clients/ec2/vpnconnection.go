@@ -0,0 +1,376 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	pkgerrors "github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPNConnectionIDNotFound is the code that is returned by ec2 when the
+	// given VPNConnectionID is not valid
+	VPNConnectionIDNotFound = "InvalidVpnConnectionID.NotFound"
+
+	// CustomerGatewayConfigurationKey is the connection detail that holds
+	// the configuration of the customer gateway of a VPN connection, in the
+	// native XML format.
+	CustomerGatewayConfigurationKey = "customerGatewayConfiguration"
+
+	errGetPreSharedKeySecret = "cannot get the pre-shared key secret of the VPN tunnel"
+)
+
+// VPNConnectionClient is the external client used for VPNConnection Custom
+// Resource
+type VPNConnectionClient interface {
+	CreateVpnConnection(ctx context.Context, input *ec2.CreateVpnConnectionInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnConnectionOutput, error)
+	DescribeVpnConnections(ctx context.Context, input *ec2.DescribeVpnConnectionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpnConnectionsOutput, error)
+	DeleteVpnConnection(ctx context.Context, input *ec2.DeleteVpnConnectionInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionOutput, error)
+	CreateVpnConnectionRoute(ctx context.Context, input *ec2.CreateVpnConnectionRouteInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnConnectionRouteOutput, error)
+	DeleteVpnConnectionRoute(ctx context.Context, input *ec2.DeleteVpnConnectionRouteInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnConnectionRouteOutput, error)
+	ModifyVpnTunnelOptions(ctx context.Context, input *ec2.ModifyVpnTunnelOptionsInput, opts ...func(*ec2.Options)) (*ec2.ModifyVpnTunnelOptionsOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewVPNConnectionClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewVPNConnectionClient(cfg aws.Config) VPNConnectionClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsVPNConnectionNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsVPNConnectionNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VPNConnectionIDNotFound
+}
+
+// GetVPNTunnelPreSharedKeys returns the pre-shared keys of the tunnels of the
+// VPN connection, read from the referenced Secrets. The key of a tunnel
+// without a reference is nil.
+func GetVPNTunnelPreSharedKeys(ctx context.Context, kube client.Reader, p manualv1alpha1.VPNConnectionParameters) ([]*string, error) {
+	keys := make([]*string, len(p.TunnelOptions))
+	for i, t := range p.TunnelOptions {
+		ref := t.PreSharedKeySecretRef
+		if ref == nil {
+			continue
+		}
+		key, err := awsclients.GetSecretData(ctx, kube, *ref)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, errGetPreSharedKeySecret)
+		}
+		keys[i] = aws.String(string(key))
+	}
+	return keys, nil
+}
+
+// GenerateCreateVPNConnectionInput returns the input to create the supplied
+// VPN connection, using the supplied pre-shared keys for its tunnels.
+func GenerateCreateVPNConnectionInput(p manualv1alpha1.VPNConnectionParameters, keys []*string) *ec2.CreateVpnConnectionInput {
+	input := &ec2.CreateVpnConnectionInput{
+		Type:              aws.String(p.Type),
+		CustomerGatewayId: p.CustomerGatewayID,
+		VpnGatewayId:      p.VPNGatewayID,
+		TransitGatewayId:  p.TransitGatewayID,
+		Options: &ec2types.VpnConnectionOptionsSpecification{
+			StaticRoutesOnly:      p.StaticRoutesOnly,
+			EnableAcceleration:    p.EnableAcceleration,
+			LocalIpv4NetworkCidr:  p.LocalIPv4NetworkCIDR,
+			RemoteIpv4NetworkCidr: p.RemoteIPv4NetworkCIDR,
+			TunnelInsideIpVersion: ec2types.TunnelInsideIpVersion(aws.ToString(p.TunnelInsideIPVersion)),
+		},
+	}
+	for i, t := range p.TunnelOptions {
+		o := generateVPNTunnelOptionsSpecification(t)
+		o.TunnelInsideCidr = t.TunnelInsideCIDR
+		o.TunnelInsideIpv6Cidr = t.TunnelInsideIPv6CIDR
+		if i < len(keys) {
+			o.PreSharedKey = keys[i]
+		}
+		input.Options.TunnelOptions = append(input.Options.TunnelOptions, o)
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeVpnConnection,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// generateVPNTunnelOptionsSpecification returns the options of a tunnel that
+// can be modified after the VPN connection has been created.
+func generateVPNTunnelOptionsSpecification(t manualv1alpha1.VPNTunnelOptions) ec2types.VpnTunnelOptionsSpecification {
+	o := ec2types.VpnTunnelOptionsSpecification{
+		DPDTimeoutAction:       t.DPDTimeoutAction,
+		DPDTimeoutSeconds:      t.DPDTimeoutSeconds,
+		Phase1LifetimeSeconds:  t.Phase1LifetimeSeconds,
+		Phase2LifetimeSeconds:  t.Phase2LifetimeSeconds,
+		RekeyFuzzPercentage:    t.RekeyFuzzPercentage,
+		RekeyMarginTimeSeconds: t.RekeyMarginTimeSeconds,
+		ReplayWindowSize:       t.ReplayWindowSize,
+		StartupAction:          t.StartupAction,
+	}
+	for _, v := range t.IKEVersions {
+		o.IKEVersions = append(o.IKEVersions, ec2types.IKEVersionsRequestListValue{Value: aws.String(v)})
+	}
+	for _, v := range t.Phase1DHGroupNumbers {
+		o.Phase1DHGroupNumbers = append(o.Phase1DHGroupNumbers, ec2types.Phase1DHGroupNumbersRequestListValue{Value: aws.Int32(v)})
+	}
+	for _, v := range t.Phase1EncryptionAlgorithms {
+		o.Phase1EncryptionAlgorithms = append(o.Phase1EncryptionAlgorithms, ec2types.Phase1EncryptionAlgorithmsRequestListValue{Value: aws.String(v)})
+	}
+	for _, v := range t.Phase1IntegrityAlgorithms {
+		o.Phase1IntegrityAlgorithms = append(o.Phase1IntegrityAlgorithms, ec2types.Phase1IntegrityAlgorithmsRequestListValue{Value: aws.String(v)})
+	}
+	for _, v := range t.Phase2DHGroupNumbers {
+		o.Phase2DHGroupNumbers = append(o.Phase2DHGroupNumbers, ec2types.Phase2DHGroupNumbersRequestListValue{Value: aws.Int32(v)})
+	}
+	for _, v := range t.Phase2EncryptionAlgorithms {
+		o.Phase2EncryptionAlgorithms = append(o.Phase2EncryptionAlgorithms, ec2types.Phase2EncryptionAlgorithmsRequestListValue{Value: aws.String(v)})
+	}
+	for _, v := range t.Phase2IntegrityAlgorithms {
+		o.Phase2IntegrityAlgorithms = append(o.Phase2IntegrityAlgorithms, ec2types.Phase2IntegrityAlgorithmsRequestListValue{Value: aws.String(v)})
+	}
+	return o
+}
+
+// generateVPNTunnelOptions returns the modifiable options of an observed
+// tunnel.
+func generateVPNTunnelOptions(o ec2types.TunnelOption) manualv1alpha1.VPNTunnelOptions {
+	t := manualv1alpha1.VPNTunnelOptions{
+		DPDTimeoutAction:       o.DpdTimeoutAction,
+		DPDTimeoutSeconds:      o.DpdTimeoutSeconds,
+		Phase1LifetimeSeconds:  o.Phase1LifetimeSeconds,
+		Phase2LifetimeSeconds:  o.Phase2LifetimeSeconds,
+		RekeyFuzzPercentage:    o.RekeyFuzzPercentage,
+		RekeyMarginTimeSeconds: o.RekeyMarginTimeSeconds,
+		ReplayWindowSize:       o.ReplayWindowSize,
+		StartupAction:          o.StartupAction,
+	}
+	for _, v := range o.IkeVersions {
+		t.IKEVersions = append(t.IKEVersions, aws.ToString(v.Value))
+	}
+	for _, v := range o.Phase1DHGroupNumbers {
+		t.Phase1DHGroupNumbers = append(t.Phase1DHGroupNumbers, aws.ToInt32(v.Value))
+	}
+	for _, v := range o.Phase1EncryptionAlgorithms {
+		t.Phase1EncryptionAlgorithms = append(t.Phase1EncryptionAlgorithms, aws.ToString(v.Value))
+	}
+	for _, v := range o.Phase1IntegrityAlgorithms {
+		t.Phase1IntegrityAlgorithms = append(t.Phase1IntegrityAlgorithms, aws.ToString(v.Value))
+	}
+	for _, v := range o.Phase2DHGroupNumbers {
+		t.Phase2DHGroupNumbers = append(t.Phase2DHGroupNumbers, aws.ToInt32(v.Value))
+	}
+	for _, v := range o.Phase2EncryptionAlgorithms {
+		t.Phase2EncryptionAlgorithms = append(t.Phase2EncryptionAlgorithms, aws.ToString(v.Value))
+	}
+	for _, v := range o.Phase2IntegrityAlgorithms {
+		t.Phase2IntegrityAlgorithms = append(t.Phase2IntegrityAlgorithms, aws.ToString(v.Value))
+	}
+	return t
+}
+
+// sortedTunnelOptions returns the observed tunnels of the VPN connection in
+// the order of their outside IP addresses.
+func sortedTunnelOptions(c ec2types.VpnConnection) []ec2types.TunnelOption {
+	if c.Options == nil {
+		return nil
+	}
+	res := make([]ec2types.TunnelOption, len(c.Options.TunnelOptions))
+	copy(res, c.Options.TunnelOptions)
+	sort.SliceStable(res, func(i, j int) bool {
+		return aws.ToString(res[i].OutsideIpAddress) < aws.ToString(res[j].OutsideIpAddress)
+	})
+	return res
+}
+
+// GenerateVPNConnectionObservation is used to produce
+// manualv1alpha1.VPNConnectionObservation from ec2types.VpnConnection.
+func GenerateVPNConnectionObservation(c ec2types.VpnConnection) manualv1alpha1.VPNConnectionObservation {
+	o := manualv1alpha1.VPNConnectionObservation{
+		VPNConnectionID:         aws.ToString(c.VpnConnectionId),
+		State:                   string(c.State),
+		Category:                aws.ToString(c.Category),
+		GatewayAssociationState: string(c.GatewayAssociationState),
+	}
+	for _, r := range c.Routes {
+		o.Routes = append(o.Routes, manualv1alpha1.VPNStaticRoute{
+			DestinationCIDRBlock: aws.ToString(r.DestinationCidrBlock),
+			Source:               string(r.Source),
+			State:                string(r.State),
+		})
+	}
+	for _, t := range c.VgwTelemetry {
+		o.VGWTelemetry = append(o.VGWTelemetry, manualv1alpha1.VGWTelemetry{
+			OutsideIPAddress:   aws.ToString(t.OutsideIpAddress),
+			Status:             string(t.Status),
+			StatusMessage:      aws.ToString(t.StatusMessage),
+			AcceptedRouteCount: aws.ToInt32(t.AcceptedRouteCount),
+			LastStatusChange:   FromTimePtr(t.LastStatusChange),
+			CertificateARN:     aws.ToString(t.CertificateArn),
+		})
+	}
+	return o
+}
+
+// GetVPNConnectionDetails returns the connection details of the VPN
+// connection.
+func GetVPNConnectionDetails(c ec2types.VpnConnection) managed.ConnectionDetails {
+	if aws.ToString(c.CustomerGatewayConfiguration) == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		CustomerGatewayConfigurationKey: []byte(aws.ToString(c.CustomerGatewayConfiguration)),
+	}
+}
+
+// LateInitializeVPNConnection fills the empty fields in
+// *manualv1alpha1.VPNConnectionParameters with the values seen in
+// ec2types.VpnConnection.
+func LateInitializeVPNConnection(in *manualv1alpha1.VPNConnectionParameters, c *ec2types.VpnConnection) {
+	if c == nil {
+		return
+	}
+	in.Type = awsclients.LateInitializeString(in.Type, aws.String(string(c.Type)))
+	in.CustomerGatewayID = awsclients.LateInitializeStringPtr(in.CustomerGatewayID, c.CustomerGatewayId)
+	in.VPNGatewayID = awsclients.LateInitializeStringPtr(in.VPNGatewayID, c.VpnGatewayId)
+	in.TransitGatewayID = awsclients.LateInitializeStringPtr(in.TransitGatewayID, c.TransitGatewayId)
+	if c.Options != nil {
+		in.StaticRoutesOnly = awsclients.LateInitializeBoolPtr(in.StaticRoutesOnly, c.Options.StaticRoutesOnly)
+		in.EnableAcceleration = awsclients.LateInitializeBoolPtr(in.EnableAcceleration, c.Options.EnableAcceleration)
+		in.LocalIPv4NetworkCIDR = awsclients.LateInitializeStringPtr(in.LocalIPv4NetworkCIDR, c.Options.LocalIpv4NetworkCidr)
+		in.RemoteIPv4NetworkCIDR = awsclients.LateInitializeStringPtr(in.RemoteIPv4NetworkCIDR, c.Options.RemoteIpv4NetworkCidr)
+		if in.TunnelInsideIPVersion == nil && c.Options.TunnelInsideIpVersion != "" {
+			in.TunnelInsideIPVersion = aws.String(string(c.Options.TunnelInsideIpVersion))
+		}
+	}
+	for i, o := range sortedTunnelOptions(*c) {
+		if i == len(in.TunnelOptions) {
+			in.TunnelOptions = append(in.TunnelOptions, manualv1alpha1.VPNTunnelOptions{})
+		}
+		lateInitializeVPNTunnelOptions(&in.TunnelOptions[i], o)
+	}
+	if len(in.Tags) == 0 && len(c.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(c.Tags)
+	}
+}
+
+// lateInitializeVPNTunnelOptions fills the empty fields in
+// *manualv1alpha1.VPNTunnelOptions with the values seen in ec2types.TunnelOption.
+// The pre-shared key is never late-initialized, since it is a secret.
+func lateInitializeVPNTunnelOptions(in *manualv1alpha1.VPNTunnelOptions, o ec2types.TunnelOption) {
+	observed := generateVPNTunnelOptions(o)
+	in.TunnelInsideCIDR = awsclients.LateInitializeStringPtr(in.TunnelInsideCIDR, o.TunnelInsideCidr)
+	in.TunnelInsideIPv6CIDR = awsclients.LateInitializeStringPtr(in.TunnelInsideIPv6CIDR, o.TunnelInsideIpv6Cidr)
+	in.DPDTimeoutAction = awsclients.LateInitializeStringPtr(in.DPDTimeoutAction, observed.DPDTimeoutAction)
+	in.DPDTimeoutSeconds = awsclients.LateInitializeInt32Ptr(in.DPDTimeoutSeconds, observed.DPDTimeoutSeconds)
+	in.Phase1LifetimeSeconds = awsclients.LateInitializeInt32Ptr(in.Phase1LifetimeSeconds, observed.Phase1LifetimeSeconds)
+	in.Phase2LifetimeSeconds = awsclients.LateInitializeInt32Ptr(in.Phase2LifetimeSeconds, observed.Phase2LifetimeSeconds)
+	in.RekeyFuzzPercentage = awsclients.LateInitializeInt32Ptr(in.RekeyFuzzPercentage, observed.RekeyFuzzPercentage)
+	in.RekeyMarginTimeSeconds = awsclients.LateInitializeInt32Ptr(in.RekeyMarginTimeSeconds, observed.RekeyMarginTimeSeconds)
+	in.ReplayWindowSize = awsclients.LateInitializeInt32Ptr(in.ReplayWindowSize, observed.ReplayWindowSize)
+	in.StartupAction = awsclients.LateInitializeStringPtr(in.StartupAction, observed.StartupAction)
+	if len(in.IKEVersions) == 0 {
+		in.IKEVersions = observed.IKEVersions
+	}
+	if len(in.Phase1DHGroupNumbers) == 0 {
+		in.Phase1DHGroupNumbers = observed.Phase1DHGroupNumbers
+	}
+	if len(in.Phase1EncryptionAlgorithms) == 0 {
+		in.Phase1EncryptionAlgorithms = observed.Phase1EncryptionAlgorithms
+	}
+	if len(in.Phase1IntegrityAlgorithms) == 0 {
+		in.Phase1IntegrityAlgorithms = observed.Phase1IntegrityAlgorithms
+	}
+	if len(in.Phase2DHGroupNumbers) == 0 {
+		in.Phase2DHGroupNumbers = observed.Phase2DHGroupNumbers
+	}
+	if len(in.Phase2EncryptionAlgorithms) == 0 {
+		in.Phase2EncryptionAlgorithms = observed.Phase2EncryptionAlgorithms
+	}
+	if len(in.Phase2IntegrityAlgorithms) == 0 {
+		in.Phase2IntegrityAlgorithms = observed.Phase2IntegrityAlgorithms
+	}
+}
+
+// GenerateModifyVPNTunnelOptionsInputs returns the inputs to modify the
+// tunnels whose options differ from the desired ones. The inside CIDRs and
+// the pre-shared keys of the tunnels are not updated.
+func GenerateModifyVPNTunnelOptionsInputs(p manualv1alpha1.VPNConnectionParameters, c ec2types.VpnConnection) []*ec2.ModifyVpnTunnelOptionsInput {
+	var res []*ec2.ModifyVpnTunnelOptionsInput
+	for i, o := range sortedTunnelOptions(c) {
+		if i == len(p.TunnelOptions) {
+			break
+		}
+		desired := p.TunnelOptions[i].DeepCopy()
+		desired.TunnelInsideCIDR = nil
+		desired.TunnelInsideIPv6CIDR = nil
+		desired.PreSharedKeySecretRef = nil
+		if cmp.Equal(*desired, generateVPNTunnelOptions(o), cmpopts.EquateEmpty(),
+			cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+			cmpopts.SortSlices(func(a, b int32) bool { return a < b })) {
+			continue
+		}
+		spec := generateVPNTunnelOptionsSpecification(*desired)
+		options := ec2types.ModifyVpnTunnelOptionsSpecification(spec)
+		res = append(res, &ec2.ModifyVpnTunnelOptionsInput{
+			VpnConnectionId:           c.VpnConnectionId,
+			VpnTunnelOutsideIpAddress: o.OutsideIpAddress,
+			TunnelOptions:             &options,
+		})
+	}
+	return res
+}
+
+// DiffVPNConnectionRoutes returns the destination CIDR blocks of the static
+// routes that have to be added to and removed from the VPN connection.
+// Routes that are being deleted are ignored.
+func DiffVPNConnectionRoutes(p manualv1alpha1.VPNConnectionParameters, routes []ec2types.VpnStaticRoute) (add, remove []string) {
+	observed := map[string]bool{}
+	for _, r := range routes {
+		if r.State == ec2types.VpnStateDeleting || r.State == ec2types.VpnStateDeleted {
+			continue
+		}
+		observed[aws.ToString(r.DestinationCidrBlock)] = true
+	}
+	desired := map[string]bool{}
+	for _, cidr := range p.Routes {
+		desired[cidr] = true
+		if !observed[cidr] {
+			add = append(add, cidr)
+		}
+	}
+	for cidr := range observed {
+		if !desired[cidr] {
+			remove = append(remove, cidr)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+// IsVPNConnectionUpToDate checks whether the static routes, the tunnel
+// options and the tags of the VPN connection are up to date.
+func IsVPNConnectionUpToDate(p manualv1alpha1.VPNConnectionParameters, c ec2types.VpnConnection) bool {
+	add, remove := DiffVPNConnectionRoutes(p, c.Routes)
+	return len(add) == 0 && len(remove) == 0 &&
+		len(GenerateModifyVPNTunnelOptionsInputs(p, c)) == 0 &&
+		manualv1alpha1.CompareTags(p.Tags, c.Tags)
+}
@@ -0,0 +1,129 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func staticRoute(cidr string, state ec2types.VpnState) ec2types.VpnStaticRoute {
+	return ec2types.VpnStaticRoute{
+		DestinationCidrBlock: aws.String(cidr),
+		State:                state,
+	}
+}
+
+func TestDiffVPNConnectionRoutes(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+	cases := map[string]struct {
+		routes []string
+		actual []ec2types.VpnStaticRoute
+		want
+	}{
+		"UpToDate": {
+			routes: []string{"10.1.0.0/16", "10.0.0.0/16"},
+			actual: []ec2types.VpnStaticRoute{
+				staticRoute("10.0.0.0/16", ec2types.VpnStateAvailable),
+				staticRoute("10.1.0.0/16", ec2types.VpnStatePending),
+			},
+		},
+		"Changed": {
+			routes: []string{"10.2.0.0/16", "10.0.0.0/16"},
+			actual: []ec2types.VpnStaticRoute{
+				staticRoute("10.0.0.0/16", ec2types.VpnStateAvailable),
+				staticRoute("10.1.0.0/16", ec2types.VpnStateAvailable),
+			},
+			want: want{
+				add:    []string{"10.2.0.0/16"},
+				remove: []string{"10.1.0.0/16"},
+			},
+		},
+		"BeingDeleted": {
+			routes: []string{"10.0.0.0/16"},
+			actual: []ec2types.VpnStaticRoute{
+				staticRoute("10.0.0.0/16", ec2types.VpnStateDeleting),
+				staticRoute("10.1.0.0/16", ec2types.VpnStateDeleted),
+			},
+			want: want{
+				add: []string{"10.0.0.0/16"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffVPNConnectionRoutes(manualv1alpha1.VPNConnectionParameters{Routes: tc.routes}, tc.actual)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyVPNTunnelOptionsInputs(t *testing.T) {
+	connection := ec2types.VpnConnection{
+		VpnConnectionId: aws.String("vpn-123"),
+		Options: &ec2types.VpnConnectionOptions{
+			TunnelOptions: []ec2types.TunnelOption{
+				{
+					OutsideIpAddress: aws.String("203.0.113.2"),
+					TunnelInsideCidr: aws.String("169.254.10.4/30"),
+					IkeVersions:      []ec2types.IKEVersionsListValue{{Value: aws.String("ikev2")}, {Value: aws.String("ikev1")}},
+				},
+				{
+					OutsideIpAddress: aws.String("203.0.113.1"),
+					TunnelInsideCidr: aws.String("169.254.10.0/30"),
+					IkeVersions:      []ec2types.IKEVersionsListValue{{Value: aws.String("ikev1")}, {Value: aws.String("ikev2")}},
+				},
+			},
+		},
+	}
+	cases := map[string]struct {
+		tunnels []manualv1alpha1.VPNTunnelOptions
+		want    []*ec2.ModifyVpnTunnelOptionsInput
+	}{
+		"UpToDate": {
+			tunnels: []manualv1alpha1.VPNTunnelOptions{
+				{TunnelInsideCIDR: aws.String("169.254.10.0/30"), IKEVersions: []string{"ikev2", "ikev1"}},
+				{TunnelInsideCIDR: aws.String("169.254.10.4/30"), IKEVersions: []string{"ikev1", "ikev2"}},
+			},
+		},
+		"IgnoresImmutableOptions": {
+			tunnels: []manualv1alpha1.VPNTunnelOptions{
+				{TunnelInsideCIDR: aws.String("169.254.20.0/30"), IKEVersions: []string{"ikev1", "ikev2"}},
+			},
+		},
+		"SecondTunnelChanged": {
+			tunnels: []manualv1alpha1.VPNTunnelOptions{
+				{IKEVersions: []string{"ikev1", "ikev2"}},
+				{IKEVersions: []string{"ikev2"}},
+			},
+			want: []*ec2.ModifyVpnTunnelOptionsInput{{
+				VpnConnectionId:           aws.String("vpn-123"),
+				VpnTunnelOutsideIpAddress: aws.String("203.0.113.2"),
+				TunnelOptions: &ec2types.ModifyVpnTunnelOptionsSpecification{
+					IKEVersions: []ec2types.IKEVersionsRequestListValue{{Value: aws.String("ikev2")}},
+				},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyVPNTunnelOptionsInputs(manualv1alpha1.VPNConnectionParameters{TunnelOptions: tc.tunnels}, connection)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2.ModifyVpnTunnelOptionsInput{}, ec2types.ModifyVpnTunnelOptionsSpecification{}, ec2types.IKEVersionsRequestListValue{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPNGatewayIDNotFound is the code that is returned by ec2 when the given
+	// VPNGatewayID is not valid
+	VPNGatewayIDNotFound = "InvalidVpnGatewayID.NotFound"
+)
+
+// VPNGatewayClient is the external client used for VPNGateway Custom Resource
+type VPNGatewayClient interface {
+	CreateVpnGateway(ctx context.Context, input *ec2.CreateVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.CreateVpnGatewayOutput, error)
+	DescribeVpnGateways(ctx context.Context, input *ec2.DescribeVpnGatewaysInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpnGatewaysOutput, error)
+	DeleteVpnGateway(ctx context.Context, input *ec2.DeleteVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpnGatewayOutput, error)
+	AttachVpnGateway(ctx context.Context, input *ec2.AttachVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.AttachVpnGatewayOutput, error)
+	DetachVpnGateway(ctx context.Context, input *ec2.DetachVpnGatewayInput, opts ...func(*ec2.Options)) (*ec2.DetachVpnGatewayOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewVPNGatewayClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewVPNGatewayClient(cfg aws.Config) VPNGatewayClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsVPNGatewayNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsVPNGatewayNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VPNGatewayIDNotFound
+}
+
+// GenerateCreateVPNGatewayInput returns the input to create the supplied
+// virtual private gateway.
+func GenerateCreateVPNGatewayInput(p manualv1alpha1.VPNGatewayParameters) *ec2.CreateVpnGatewayInput {
+	input := &ec2.CreateVpnGatewayInput{
+		Type:             ec2types.GatewayType(p.Type),
+		AmazonSideAsn:    p.AmazonSideASN,
+		AvailabilityZone: p.AvailabilityZone,
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeVpnGateway,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// GenerateVPNGatewayObservation is used to produce
+// manualv1alpha1.VPNGatewayObservation from ec2types.VpnGateway.
+func GenerateVPNGatewayObservation(g ec2types.VpnGateway) manualv1alpha1.VPNGatewayObservation {
+	o := manualv1alpha1.VPNGatewayObservation{
+		VPNGatewayID: aws.ToString(g.VpnGatewayId),
+		State:        string(g.State),
+	}
+	for _, a := range g.VpcAttachments {
+		o.VPCAttachments = append(o.VPCAttachments, manualv1alpha1.VPCAttachment{
+			VPCID: aws.ToString(a.VpcId),
+			State: string(a.State),
+		})
+	}
+	return o
+}
+
+// LateInitializeVPNGateway fills the empty fields in
+// *manualv1alpha1.VPNGatewayParameters with the values seen in ec2types.VpnGateway.
+func LateInitializeVPNGateway(in *manualv1alpha1.VPNGatewayParameters, g *ec2types.VpnGateway) {
+	if g == nil {
+		return
+	}
+	in.Type = awsclients.LateInitializeString(in.Type, aws.String(string(g.Type)))
+	in.AmazonSideASN = awsclients.LateInitializeInt64Ptr(in.AmazonSideASN, g.AmazonSideAsn)
+	in.AvailabilityZone = awsclients.LateInitializeStringPtr(in.AvailabilityZone, g.AvailabilityZone)
+	if len(in.Tags) == 0 && len(g.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(g.Tags)
+	}
+}
+
+// DiffVPNGatewayAttachments returns whether the desired VPC has to be
+// attached to the virtual private gateway, and which of the VPCs that are
+// attached to it have to be detached. Attachments that are already being
+// removed are ignored.
+func DiffVPNGatewayAttachments(p manualv1alpha1.VPNGatewayParameters, attachments []ec2types.VpcAttachment) (attach bool, detach []string) {
+	desired := aws.ToString(p.VPCID)
+	attach = desired != ""
+	for _, a := range attachments {
+		if a.State != ec2types.AttachmentStatusAttaching && a.State != ec2types.AttachmentStatusAttached {
+			continue
+		}
+		id := aws.ToString(a.VpcId)
+		if id == desired {
+			attach = false
+			continue
+		}
+		detach = append(detach, id)
+	}
+	sort.Strings(detach)
+	return attach, detach
+}
+
+// IsVPNGatewayUpToDate checks whether the virtual private gateway is attached
+// to the desired VPC only, and whether its tags are up to date.
+func IsVPNGatewayUpToDate(p manualv1alpha1.VPNGatewayParameters, g ec2types.VpnGateway) bool {
+	attach, detach := DiffVPNGatewayAttachments(p, g.VpcAttachments)
+	return !attach && len(detach) == 0 && manualv1alpha1.CompareTags(p.Tags, g.Tags)
+}
@@ -0,0 +1,71 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func vpcAttachment(id string, state ec2types.AttachmentStatus) ec2types.VpcAttachment {
+	return ec2types.VpcAttachment{
+		VpcId: aws.String(id),
+		State: state,
+	}
+}
+
+func TestDiffVPNGatewayAttachments(t *testing.T) {
+	type want struct {
+		attach bool
+		detach []string
+	}
+	cases := map[string]struct {
+		vpcID       *string
+		attachments []ec2types.VpcAttachment
+		want
+	}{
+		"Attached": {
+			vpcID:       aws.String("vpc-a"),
+			attachments: []ec2types.VpcAttachment{vpcAttachment("vpc-a", ec2types.AttachmentStatusAttaching)},
+		},
+		"NotAttached": {
+			vpcID:       aws.String("vpc-a"),
+			attachments: []ec2types.VpcAttachment{vpcAttachment("vpc-a", ec2types.AttachmentStatusDetached)},
+			want: want{
+				attach: true,
+			},
+		},
+		"AttachedToOthers": {
+			vpcID: aws.String("vpc-a"),
+			attachments: []ec2types.VpcAttachment{
+				vpcAttachment("vpc-c", ec2types.AttachmentStatusAttached),
+				vpcAttachment("vpc-b", ec2types.AttachmentStatusAttached),
+				vpcAttachment("vpc-d", ec2types.AttachmentStatusDetaching),
+			},
+			want: want{
+				attach: true,
+				detach: []string{"vpc-b", "vpc-c"},
+			},
+		},
+		"NoVPC": {
+			attachments: []ec2types.VpcAttachment{vpcAttachment("vpc-a", ec2types.AttachmentStatusAttached)},
+			want: want{
+				detach: []string{"vpc-a"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffVPNGatewayAttachments(manualv1alpha1.VPNGatewayParameters{VPCID: tc.vpcID}, tc.attachments)
+			if diff := cmp.Diff(tc.want.attach, attach); diff != "" {
+				t.Errorf("attach: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detach, detach); diff != "" {
+				t.Errorf("detach: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
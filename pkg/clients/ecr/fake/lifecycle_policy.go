@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LifecyclePolicyClient = (*MockLifecyclePolicyClient)(nil)
+
+// MockLifecyclePolicyClient is a type that implements all the methods for LifecyclePolicyClient interface
+type MockLifecyclePolicyClient struct {
+	MockPut    func(ctx context.Context, input *ecr.PutLifecyclePolicyInput, opts []func(*ecr.Options)) (*ecr.PutLifecyclePolicyOutput, error)
+	MockDelete func(ctx context.Context, input *ecr.DeleteLifecyclePolicyInput, opts []func(*ecr.Options)) (*ecr.DeleteLifecyclePolicyOutput, error)
+	MockGet    func(ctx context.Context, input *ecr.GetLifecyclePolicyInput, opts []func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error)
+}
+
+// PutLifecyclePolicy mocks ecr method
+func (m *MockLifecyclePolicyClient) PutLifecyclePolicy(ctx context.Context, input *ecr.PutLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.PutLifecyclePolicyOutput, error) {
+	return m.MockPut(ctx, input, opts)
+}
+
+// DeleteLifecyclePolicy mocks ecr method
+func (m *MockLifecyclePolicyClient) DeleteLifecyclePolicy(ctx context.Context, input *ecr.DeleteLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.DeleteLifecyclePolicyOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// GetLifecyclePolicy mocks ecr method
+func (m *MockLifecyclePolicyClient) GetLifecyclePolicy(ctx context.Context, input *ecr.GetLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
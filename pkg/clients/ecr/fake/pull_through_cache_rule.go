@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PullThroughCacheRuleClient = (*MockPullThroughCacheRuleClient)(nil)
+
+// MockPullThroughCacheRuleClient is a type that implements all the methods for PullThroughCacheRuleClient interface
+type MockPullThroughCacheRuleClient struct {
+	MockCreate   func(ctx context.Context, input *ecr.CreatePullThroughCacheRuleInput, opts []request.Option) (*ecr.CreatePullThroughCacheRuleOutput, error)
+	MockDescribe func(ctx context.Context, input *ecr.DescribePullThroughCacheRulesInput, opts []request.Option) (*ecr.DescribePullThroughCacheRulesOutput, error)
+	MockDelete   func(ctx context.Context, input *ecr.DeletePullThroughCacheRuleInput, opts []request.Option) (*ecr.DeletePullThroughCacheRuleOutput, error)
+}
+
+// CreatePullThroughCacheRuleWithContext mocks ecr method
+func (m *MockPullThroughCacheRuleClient) CreatePullThroughCacheRuleWithContext(ctx context.Context, input *ecr.CreatePullThroughCacheRuleInput, opts ...request.Option) (*ecr.CreatePullThroughCacheRuleOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribePullThroughCacheRulesWithContext mocks ecr method
+func (m *MockPullThroughCacheRuleClient) DescribePullThroughCacheRulesWithContext(ctx context.Context, input *ecr.DescribePullThroughCacheRulesInput, opts ...request.Option) (*ecr.DescribePullThroughCacheRulesOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DeletePullThroughCacheRuleWithContext mocks ecr method
+func (m *MockPullThroughCacheRuleClient) DeletePullThroughCacheRuleWithContext(ctx context.Context, input *ecr.DeletePullThroughCacheRuleInput, opts ...request.Option) (*ecr.DeletePullThroughCacheRuleOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
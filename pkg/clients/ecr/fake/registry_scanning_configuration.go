@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.RegistryScanningConfigurationClient = (*MockRegistryScanningConfigurationClient)(nil)
+
+// MockRegistryScanningConfigurationClient is a type that implements all the methods for RegistryScanningConfigurationClient interface
+type MockRegistryScanningConfigurationClient struct {
+	MockGet func(ctx context.Context, input *ecr.GetRegistryScanningConfigurationInput, opts []request.Option) (*ecr.GetRegistryScanningConfigurationOutput, error)
+	MockPut func(ctx context.Context, input *ecr.PutRegistryScanningConfigurationInput, opts []request.Option) (*ecr.PutRegistryScanningConfigurationOutput, error)
+}
+
+// GetRegistryScanningConfigurationWithContext mocks ecr method
+func (m *MockRegistryScanningConfigurationClient) GetRegistryScanningConfigurationWithContext(ctx context.Context, input *ecr.GetRegistryScanningConfigurationInput, opts ...request.Option) (*ecr.GetRegistryScanningConfigurationOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// PutRegistryScanningConfigurationWithContext mocks ecr method
+func (m *MockRegistryScanningConfigurationClient) PutRegistryScanningConfigurationWithContext(ctx context.Context, input *ecr.PutRegistryScanningConfigurationInput, opts ...request.Option) (*ecr.PutRegistryScanningConfigurationOutput, error) {
+	return m.MockPut(ctx, input, opts)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awsecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errLifecyclePolicyNotSpecified = "failed to format Lifecycle Policy, no rawPolicy or policy specified"
+)
+
+// LifecyclePolicyClient is the external client used for Lifecycle Policy Resource
+type LifecyclePolicyClient interface {
+	PutLifecyclePolicy(ctx context.Context, input *ecr.PutLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.PutLifecyclePolicyOutput, error)
+	DeleteLifecyclePolicy(ctx context.Context, input *ecr.DeleteLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.DeleteLifecyclePolicyOutput, error)
+	GetLifecyclePolicy(ctx context.Context, input *ecr.GetLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error)
+}
+
+// GeneratePutLifecyclePolicyInput generates the PutLifecyclePolicyInput from
+// the LifecyclePolicyParameters and the already formatted policy text.
+func GeneratePutLifecyclePolicyInput(params *v1alpha1.LifecyclePolicyParameters, policy *string) *ecr.PutLifecyclePolicyInput {
+	return &ecr.PutLifecyclePolicyInput{
+		RepositoryName:      params.RepositoryName,
+		RegistryId:          params.RegistryID,
+		LifecyclePolicyText: policy,
+	}
+}
+
+// LateInitializeLifecyclePolicy fills the empty fields in
+// *v1alpha1.LifecyclePolicyParameters with the values seen in
+// ecr.GetLifecyclePolicyOutput.
+func LateInitializeLifecyclePolicy(in *v1alpha1.LifecyclePolicyParameters, r *ecr.GetLifecyclePolicyOutput) {
+	if r == nil {
+		return
+	}
+	in.RegistryID = awsclient.LateInitializeStringPtr(in.RegistryID, r.RegistryId)
+}
+
+// GenerateLifecyclePolicyObservation is used to produce
+// v1alpha1.LifecyclePolicyObservation from ecr.GetLifecyclePolicyOutput.
+func GenerateLifecyclePolicyObservation(r *ecr.GetLifecyclePolicyOutput) v1alpha1.LifecyclePolicyObservation {
+	o := v1alpha1.LifecyclePolicyObservation{}
+	if r != nil && r.LastEvaluatedAt != nil {
+		t := metav1.NewTime(*r.LastEvaluatedAt)
+		o.LastEvaluatedAt = &t
+	}
+	return o
+}
+
+// IsLifecyclePolicyNotFoundErr returns true if the error code indicates that
+// the lifecycle policy was not found
+func IsLifecyclePolicyNotFoundErr(err error) bool {
+	var notFoundError *awsecrtypes.LifecyclePolicyNotFoundException
+	return errors.As(err, &notFoundError)
+}
+
+// RawLifecyclePolicyData returns the JSON lifecycle policy text of the given
+// LifecyclePolicy, serialized from its structured policy if no raw policy is
+// given.
+func RawLifecyclePolicyData(original *v1alpha1.LifecyclePolicy) (string, error) {
+	if original == nil {
+		return "", errors.New(errLifecyclePolicyNotSpecified)
+	}
+	switch {
+	case original.Spec.ForProvider.RawPolicy != nil:
+		return *original.Spec.ForProvider.RawPolicy, nil
+	case original.Spec.ForProvider.Policy != nil:
+		// The JSON field names of LifecyclePolicyBody match the lifecycle
+		// policy document format, so no custom serializer is needed.
+		byteData, err := json.Marshal(original.Spec.ForProvider.Policy)
+		if err != nil {
+			return "", err
+		}
+		return string(byteData), nil
+	}
+	return "", errors.New(errLifecyclePolicyNotSpecified)
+}
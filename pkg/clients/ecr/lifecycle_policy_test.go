@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestRawLifecyclePolicyData(t *testing.T) {
+	raw := `{"rules":[]}`
+	structured := &v1alpha1.LifecyclePolicyBody{
+		Rules: []v1alpha1.LifecyclePolicyRule{
+			{
+				RulePriority: 1,
+				Description:  aws.String("expire old untagged images"),
+				Selection: v1alpha1.LifecyclePolicySelection{
+					TagStatus:   "untagged",
+					CountType:   "sinceImagePushed",
+					CountUnit:   aws.String("days"),
+					CountNumber: 14,
+				},
+				Action: v1alpha1.LifecyclePolicyAction{Type: "expire"},
+			},
+		},
+	}
+
+	type want struct {
+		str string
+		err error
+	}
+
+	cases := map[string]struct {
+		cr *v1alpha1.LifecyclePolicy
+		want
+	}{
+		"StructuredPolicy": {
+			cr: &v1alpha1.LifecyclePolicy{Spec: v1alpha1.LifecyclePolicySpec{ForProvider: v1alpha1.LifecyclePolicyParameters{Policy: structured}}},
+			want: want{
+				str: `{"rules":[{"rulePriority":1,"description":"expire old untagged images","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`,
+			},
+		},
+		"RawPolicy": {
+			cr: &v1alpha1.LifecyclePolicy{Spec: v1alpha1.LifecyclePolicySpec{ForProvider: v1alpha1.LifecyclePolicyParameters{RawPolicy: &raw, Policy: structured}}},
+			want: want{
+				str: raw,
+			},
+		},
+		"NoPolicy": {
+			cr: &v1alpha1.LifecyclePolicy{},
+			want: want{
+				err: errors.New(errLifecyclePolicyNotSpecified),
+			},
+		},
+		"Nil": {
+			want: want{
+				err: errors.New(errLifecyclePolicyNotSpecified),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			str, err := RawLifecyclePolicyData(tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.str, str); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ecrv1 "github.com/aws/aws-sdk-go/service/ecr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PullThroughCacheRuleClient is the external client used for the
+// PullThroughCacheRule Resource. The pull through cache API is not part of
+// the version of aws-sdk-go-v2 used by the rest of this package, so it is
+// backed by aws-sdk-go.
+type PullThroughCacheRuleClient interface {
+	CreatePullThroughCacheRuleWithContext(ctx context.Context, input *ecrv1.CreatePullThroughCacheRuleInput, opts ...request.Option) (*ecrv1.CreatePullThroughCacheRuleOutput, error)
+	DescribePullThroughCacheRulesWithContext(ctx context.Context, input *ecrv1.DescribePullThroughCacheRulesInput, opts ...request.Option) (*ecrv1.DescribePullThroughCacheRulesOutput, error)
+	DeletePullThroughCacheRuleWithContext(ctx context.Context, input *ecrv1.DeletePullThroughCacheRuleInput, opts ...request.Option) (*ecrv1.DeletePullThroughCacheRuleOutput, error)
+}
+
+// NewPullThroughCacheRuleClient returns a new PullThroughCacheRuleClient
+// using the given session.
+func NewPullThroughCacheRuleClient(sess *session.Session) PullThroughCacheRuleClient {
+	return ecrv1.New(sess)
+}
+
+// IsPullThroughCacheRuleNotFoundErr returns true if the error indicates that
+// the pull through cache rule was not found
+func IsPullThroughCacheRuleNotFoundErr(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == ecrv1.ErrCodePullThroughCacheRuleNotFoundException
+}
+
+// GenerateCreatePullThroughCacheRuleInput returns the input to create the
+// pull through cache rule with the given repository prefix.
+func GenerateCreatePullThroughCacheRuleInput(prefix string, p v1alpha1.PullThroughCacheRuleParameters) *ecrv1.CreatePullThroughCacheRuleInput {
+	return &ecrv1.CreatePullThroughCacheRuleInput{
+		EcrRepositoryPrefix: awsclient.String(prefix),
+		RegistryId:          p.RegistryID,
+		UpstreamRegistryUrl: awsclient.String(p.UpstreamRegistryURL),
+	}
+}
+
+// LateInitializePullThroughCacheRule fills the empty fields in
+// *v1alpha1.PullThroughCacheRuleParameters with the values seen in
+// ecr.PullThroughCacheRule.
+func LateInitializePullThroughCacheRule(in *v1alpha1.PullThroughCacheRuleParameters, r *ecrv1.PullThroughCacheRule) {
+	if r == nil {
+		return
+	}
+	in.RegistryID = awsclient.LateInitializeStringPtr(in.RegistryID, r.RegistryId)
+}
+
+// GeneratePullThroughCacheRuleObservation is used to produce
+// v1alpha1.PullThroughCacheRuleObservation from ecr.PullThroughCacheRule.
+func GeneratePullThroughCacheRuleObservation(r *ecrv1.PullThroughCacheRule) v1alpha1.PullThroughCacheRuleObservation {
+	o := v1alpha1.PullThroughCacheRuleObservation{}
+	if r.CreatedAt != nil {
+		t := metav1.NewTime(*r.CreatedAt)
+		o.CreatedAt = &t
+	}
+	return o
+}
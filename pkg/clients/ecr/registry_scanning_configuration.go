@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ecrv1 "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// RegistryScanningConfigurationClient is the external client used for the
+// RegistryScanningConfiguration Resource. Like the pull through cache API,
+// the registry scanning API is only available in aws-sdk-go.
+type RegistryScanningConfigurationClient interface {
+	GetRegistryScanningConfigurationWithContext(ctx context.Context, input *ecrv1.GetRegistryScanningConfigurationInput, opts ...request.Option) (*ecrv1.GetRegistryScanningConfigurationOutput, error)
+	PutRegistryScanningConfigurationWithContext(ctx context.Context, input *ecrv1.PutRegistryScanningConfigurationInput, opts ...request.Option) (*ecrv1.PutRegistryScanningConfigurationOutput, error)
+}
+
+// NewRegistryScanningConfigurationClient returns a new
+// RegistryScanningConfigurationClient using the given session.
+func NewRegistryScanningConfigurationClient(sess *session.Session) RegistryScanningConfigurationClient {
+	return ecrv1.New(sess)
+}
+
+// GeneratePutRegistryScanningConfigurationInput returns the input to put the
+// scanning configuration described by the supplied parameters.
+func GeneratePutRegistryScanningConfigurationInput(p v1alpha1.RegistryScanningConfigurationParameters) *ecrv1.PutRegistryScanningConfigurationInput {
+	in := &ecrv1.PutRegistryScanningConfigurationInput{
+		ScanType: awsclient.String(p.ScanType),
+		Rules:    make([]*ecrv1.RegistryScanningRule, len(p.Rules)),
+	}
+	for i, r := range p.Rules {
+		rule := &ecrv1.RegistryScanningRule{
+			ScanFrequency:     awsclient.String(r.ScanFrequency),
+			RepositoryFilters: make([]*ecrv1.ScanningRepositoryFilter, len(r.RepositoryFilters)),
+		}
+		for j, f := range r.RepositoryFilters {
+			rule.RepositoryFilters[j] = &ecrv1.ScanningRepositoryFilter{
+				Filter:     awsclient.String(f.Filter),
+				FilterType: awsclient.String(f.FilterType),
+			}
+		}
+		in.Rules[i] = rule
+	}
+	return in
+}
+
+// GenerateRegistryScanningConfigurationParameters returns the parameters
+// that describe the supplied scanning configuration.
+func GenerateRegistryScanningConfigurationParameters(c *ecrv1.RegistryScanningConfiguration) v1alpha1.RegistryScanningConfigurationParameters {
+	p := v1alpha1.RegistryScanningConfigurationParameters{}
+	if c == nil {
+		return p
+	}
+	p.ScanType = awsclient.StringValue(c.ScanType)
+	for _, r := range c.Rules {
+		rule := v1alpha1.RegistryScanningRule{ScanFrequency: awsclient.StringValue(r.ScanFrequency)}
+		for _, f := range r.RepositoryFilters {
+			rule.RepositoryFilters = append(rule.RepositoryFilters, v1alpha1.ScanningRepositoryFilter{
+				Filter:     awsclient.StringValue(f.Filter),
+				FilterType: awsclient.StringValue(f.FilterType),
+			})
+		}
+		p.Rules = append(p.Rules, rule)
+	}
+	return p
+}
+
+// IsRegistryScanningConfigurationDefault returns true if the supplied
+// scanning configuration is the one of a registry that was never configured,
+// or whose configuration was removed.
+func IsRegistryScanningConfigurationDefault(c *ecrv1.RegistryScanningConfiguration) bool {
+	return c == nil || (len(c.Rules) == 0 && awsclient.StringValue(c.ScanType) != v1alpha1.ScanTypeEnhanced)
+}
+
+// IsRegistryScanningConfigurationUpToDate checks whether the observed
+// scanning configuration matches the desired parameters.
+func IsRegistryScanningConfigurationUpToDate(p v1alpha1.RegistryScanningConfigurationParameters, observed *ecrv1.RegistryScanningConfiguration) bool {
+	return cmp.Equal(p, GenerateRegistryScanningConfigurationParameters(observed),
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.RegistryScanningConfigurationParameters{}, "Region"))
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"testing"
+
+	ecrv1 "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func scanningParams() v1alpha1.RegistryScanningConfigurationParameters {
+	return v1alpha1.RegistryScanningConfigurationParameters{
+		Region:   "us-east-1",
+		ScanType: v1alpha1.ScanTypeEnhanced,
+		Rules: []v1alpha1.RegistryScanningRule{{
+			ScanFrequency: "CONTINUOUS_SCAN",
+			RepositoryFilters: []v1alpha1.ScanningRepositoryFilter{
+				{Filter: "prod-*", FilterType: "WILDCARD"},
+			},
+		}},
+	}
+}
+
+func scanningConfiguration() *ecrv1.RegistryScanningConfiguration {
+	return &ecrv1.RegistryScanningConfiguration{
+		ScanType: aws.String(v1alpha1.ScanTypeEnhanced),
+		Rules: []*ecrv1.RegistryScanningRule{{
+			ScanFrequency: aws.String("CONTINUOUS_SCAN"),
+			RepositoryFilters: []*ecrv1.ScanningRepositoryFilter{
+				{Filter: aws.String("prod-*"), FilterType: aws.String("WILDCARD")},
+			},
+		}},
+	}
+}
+
+func TestIsRegistryScanningConfigurationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params   v1alpha1.RegistryScanningConfigurationParameters
+		observed *ecrv1.RegistryScanningConfiguration
+		want     bool
+	}{
+		"UpToDate": {
+			params:   scanningParams(),
+			observed: scanningConfiguration(),
+			want:     true,
+		},
+		"ScanTypeChanged": {
+			params: func() v1alpha1.RegistryScanningConfigurationParameters {
+				p := scanningParams()
+				p.ScanType = v1alpha1.ScanTypeBasic
+				return p
+			}(),
+			observed: scanningConfiguration(),
+			want:     false,
+		},
+		"ScanFrequencyChanged": {
+			params: func() v1alpha1.RegistryScanningConfigurationParameters {
+				p := scanningParams()
+				p.Rules[0].ScanFrequency = "SCAN_ON_PUSH"
+				return p
+			}(),
+			observed: scanningConfiguration(),
+			want:     false,
+		},
+		"FilterAdded": {
+			params: func() v1alpha1.RegistryScanningConfigurationParameters {
+				p := scanningParams()
+				p.Rules[0].RepositoryFilters = append(p.Rules[0].RepositoryFilters, v1alpha1.ScanningRepositoryFilter{Filter: "*", FilterType: "WILDCARD"})
+				return p
+			}(),
+			observed: scanningConfiguration(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRegistryScanningConfigurationUpToDate(tc.params, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRegistryScanningConfigurationDefault(t *testing.T) {
+	cases := map[string]struct {
+		observed *ecrv1.RegistryScanningConfiguration
+		want     bool
+	}{
+		"Nil": {
+			want: true,
+		},
+		"BasicWithoutRules": {
+			observed: &ecrv1.RegistryScanningConfiguration{ScanType: aws.String(v1alpha1.ScanTypeBasic), Rules: []*ecrv1.RegistryScanningRule{}},
+			want:     true,
+		},
+		"EnhancedWithoutRules": {
+			observed: &ecrv1.RegistryScanningConfiguration{ScanType: aws.String(v1alpha1.ScanTypeEnhanced)},
+			want:     false,
+		},
+		"BasicWithRules": {
+			observed: func() *ecrv1.RegistryScanningConfiguration {
+				c := scanningConfiguration()
+				c.ScanType = aws.String(v1alpha1.ScanTypeBasic)
+				return c
+			}(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRegistryScanningConfigurationDefault(tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
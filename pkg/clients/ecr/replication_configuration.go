@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ReplicationConfigurationClient is the external client used for the
+// ReplicationConfiguration Resource
+type ReplicationConfigurationClient interface {
+	DescribeRegistry(ctx context.Context, input *ecr.DescribeRegistryInput, opts ...func(*ecr.Options)) (*ecr.DescribeRegistryOutput, error)
+	PutReplicationConfiguration(ctx context.Context, input *ecr.PutReplicationConfigurationInput, opts ...func(*ecr.Options)) (*ecr.PutReplicationConfigurationOutput, error)
+}
+
+// GenerateReplicationConfiguration converts the given parameters to the
+// replication configuration of a registry.
+func GenerateReplicationConfiguration(p v1alpha1.ReplicationConfigurationParameters) *ecrtypes.ReplicationConfiguration {
+	c := &ecrtypes.ReplicationConfiguration{Rules: make([]ecrtypes.ReplicationRule, len(p.Rules))}
+	for i, r := range p.Rules {
+		rule := ecrtypes.ReplicationRule{Destinations: make([]ecrtypes.ReplicationDestination, len(r.Destinations))}
+		for j, d := range r.Destinations {
+			rule.Destinations[j] = ecrtypes.ReplicationDestination{
+				Region:     awsclient.String(d.Region),
+				RegistryId: awsclient.String(d.RegistryID),
+			}
+		}
+		if len(r.RepositoryFilters) > 0 {
+			rule.RepositoryFilters = make([]ecrtypes.RepositoryFilter, len(r.RepositoryFilters))
+			for j, f := range r.RepositoryFilters {
+				rule.RepositoryFilters[j] = ecrtypes.RepositoryFilter{
+					Filter:     awsclient.String(f.Filter),
+					FilterType: ecrtypes.RepositoryFilterType(f.FilterType),
+				}
+			}
+		}
+		c.Rules[i] = rule
+	}
+	return c
+}
+
+// IsReplicationConfigurationUpToDate checks whether the observed replication
+// configuration matches the desired parameters. Rules are order sensitive.
+func IsReplicationConfigurationUpToDate(p v1alpha1.ReplicationConfigurationParameters, observed *ecrtypes.ReplicationConfiguration) bool {
+	if observed == nil {
+		observed = &ecrtypes.ReplicationConfiguration{}
+	}
+	return cmp.Equal(GenerateReplicationConfiguration(p), observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(ecrtypes.ReplicationConfiguration{}, ecrtypes.ReplicationRule{},
+			ecrtypes.ReplicationDestination{}, ecrtypes.RepositoryFilter{}))
+}
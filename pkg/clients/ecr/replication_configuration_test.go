@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecr
+
+import (
+	"testing"
+
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func replicationParams() v1alpha1.ReplicationConfigurationParameters {
+	return v1alpha1.ReplicationConfigurationParameters{
+		Region: "us-east-1",
+		Rules: []v1alpha1.ReplicationRule{
+			{
+				Destinations: []v1alpha1.ReplicationDestination{
+					{Region: "eu-west-1", RegistryID: "123456789012"},
+				},
+				RepositoryFilters: []v1alpha1.RepositoryFilter{
+					{Filter: "prod", FilterType: "PREFIX_MATCH"},
+				},
+			},
+			{
+				Destinations: []v1alpha1.ReplicationDestination{
+					{Region: "us-east-1", RegistryID: "210987654321"},
+				},
+			},
+		},
+	}
+}
+
+func replicationConfiguration() *ecrtypes.ReplicationConfiguration {
+	return &ecrtypes.ReplicationConfiguration{
+		Rules: []ecrtypes.ReplicationRule{
+			{
+				Destinations: []ecrtypes.ReplicationDestination{
+					{Region: aws.String("eu-west-1"), RegistryId: aws.String("123456789012")},
+				},
+				RepositoryFilters: []ecrtypes.RepositoryFilter{
+					{Filter: aws.String("prod"), FilterType: ecrtypes.RepositoryFilterTypePrefixMatch},
+				},
+			},
+			{
+				Destinations: []ecrtypes.ReplicationDestination{
+					{Region: aws.String("us-east-1"), RegistryId: aws.String("210987654321")},
+				},
+				RepositoryFilters: []ecrtypes.RepositoryFilter{},
+			},
+		},
+	}
+}
+
+func TestIsReplicationConfigurationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params   v1alpha1.ReplicationConfigurationParameters
+		observed *ecrtypes.ReplicationConfiguration
+		want     bool
+	}{
+		"UpToDate": {
+			params:   replicationParams(),
+			observed: replicationConfiguration(),
+			want:     true,
+		},
+		"DestinationChanged": {
+			params: func() v1alpha1.ReplicationConfigurationParameters {
+				p := replicationParams()
+				p.Rules[0].Destinations[0].Region = "eu-central-1"
+				return p
+			}(),
+			observed: replicationConfiguration(),
+			want:     false,
+		},
+		"FilterRemoved": {
+			params: func() v1alpha1.ReplicationConfigurationParameters {
+				p := replicationParams()
+				p.Rules[0].RepositoryFilters = nil
+				return p
+			}(),
+			observed: replicationConfiguration(),
+			want:     false,
+		},
+		"RuleAdded": {
+			params: replicationParams(),
+			observed: &ecrtypes.ReplicationConfiguration{
+				Rules: replicationConfiguration().Rules[:1],
+			},
+			want: false,
+		},
+		"NoConfiguration": {
+			params: replicationParams(),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsReplicationConfigurationUpToDate(tc.params, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -104,6 +104,19 @@ func IsRepositoryUpToDate(e *v1beta1.RepositoryParameters, tags []ecrtypes.Tag,
 		CompareTags(e.Tags, tags)
 }
 
+// RepositoryDrift returns the modifiable fields of the supplied parameters,
+// other than tags, whose values differ from the ones of the supplied
+// repository. Fields that are not set in the parameters are ignored.
+func RepositoryDrift(e *v1beta1.RepositoryParameters, repo *ecrtypes.Repository) []awsclient.Drift {
+	desired := &v1beta1.RepositoryParameters{
+		ImageScanningConfiguration: e.ImageScanningConfiguration,
+		ImageTagMutability:         e.ImageTagMutability,
+	}
+	observed := &v1beta1.RepositoryParameters{}
+	LateInitializeRepository(observed, repo)
+	return awsclient.DiffFields(desired, observed, awsclient.IgnoreUnsetDesired())
+}
+
 // IsRepoNotFoundErr returns true if the error is because the item doesn't exist
 func IsRepoNotFoundErr(err error) bool {
 	var notFoundError *ecrtypes.RepositoryNotFoundException
@@ -123,6 +123,64 @@ func TestIsRepositoryUpToDate(t *testing.T) {
 	}
 }
 
+func TestRepositoryDrift(t *testing.T) {
+	immutable := "IMMUTABLE"
+
+	cases := map[string]struct {
+		e    v1beta1.RepositoryParameters
+		repo ecrtypes.Repository
+		want []aws.Drift
+	}{
+		"NoDrift": {
+			e: v1beta1.RepositoryParameters{
+				ImageScanningConfiguration: &imageScanConfig,
+				ImageTagMutability:         &tagMutability,
+			},
+			repo: ecrtypes.Repository{
+				ImageScanningConfiguration: &awsImageScanConfig,
+				ImageTagMutability:         ecrtypes.ImageTagMutabilityMutable,
+			},
+		},
+		"UnsetFieldsIgnored": {
+			e: v1beta1.RepositoryParameters{},
+			repo: ecrtypes.Repository{
+				ImageScanningConfiguration: &awsImageScanConfig,
+				ImageTagMutability:         ecrtypes.ImageTagMutabilityMutable,
+			},
+		},
+		"ScanOnPushDrifted": {
+			e: v1beta1.RepositoryParameters{
+				ImageScanningConfiguration: &imageScanConfig,
+				ImageTagMutability:         &tagMutability,
+			},
+			repo: ecrtypes.Repository{
+				ImageScanningConfiguration: &ecrtypes.ImageScanningConfiguration{ScanOnPush: false},
+				ImageTagMutability:         ecrtypes.ImageTagMutabilityMutable,
+			},
+			want: []aws.Drift{{Path: "imageScanningConfiguration.scanOnPush", Desired: "true", Observed: "false"}},
+		},
+		"TagMutabilityDrifted": {
+			e: v1beta1.RepositoryParameters{
+				ImageTagMutability: &immutable,
+			},
+			repo: ecrtypes.Repository{
+				ImageScanningConfiguration: &awsImageScanConfig,
+				ImageTagMutability:         ecrtypes.ImageTagMutabilityMutable,
+			},
+			want: []aws.Drift{{Path: "imageTagMutability", Desired: `"IMMUTABLE"`, Observed: `"MUTABLE"`}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RepositoryDrift(&tc.e, &tc.repo)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateCreateRepositoryInput(t *testing.T) {
 	type args struct {
 		name string
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AutoScalingClient defines the Application Auto Scaling operations used to
+// scale ECS services.
+type AutoScalingClient interface {
+	RegisterScalableTargetWithContext(ctx context.Context, input *applicationautoscaling.RegisterScalableTargetInput, opts ...request.Option) (*applicationautoscaling.RegisterScalableTargetOutput, error)
+	DescribeScalableTargetsWithContext(ctx context.Context, input *applicationautoscaling.DescribeScalableTargetsInput, opts ...request.Option) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
+	DeregisterScalableTargetWithContext(ctx context.Context, input *applicationautoscaling.DeregisterScalableTargetInput, opts ...request.Option) (*applicationautoscaling.DeregisterScalableTargetOutput, error)
+	PutScalingPolicyWithContext(ctx context.Context, input *applicationautoscaling.PutScalingPolicyInput, opts ...request.Option) (*applicationautoscaling.PutScalingPolicyOutput, error)
+	DescribeScalingPoliciesWithContext(ctx context.Context, input *applicationautoscaling.DescribeScalingPoliciesInput, opts ...request.Option) (*applicationautoscaling.DescribeScalingPoliciesOutput, error)
+	DeleteScalingPolicyWithContext(ctx context.Context, input *applicationautoscaling.DeleteScalingPolicyInput, opts ...request.Option) (*applicationautoscaling.DeleteScalingPolicyOutput, error)
+}
+
+// NewAutoScalingClient returns a new Application Auto Scaling client using
+// the given session.
+func NewAutoScalingClient(sess *session.Session) AutoScalingClient {
+	return applicationautoscaling.New(sess)
+}
+
+// IsScalableTargetNotFound returns true if the error is because the scalable
+// target or scaling policy doesn't exist.
+func IsScalableTargetNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == applicationautoscaling.ErrCodeObjectNotFoundException
+}
+
+// ServiceResourceID returns the Application Auto Scaling resource ID of the
+// service with the given name on the cluster with the given ARN.
+func ServiceResourceID(clusterARN, name string) string {
+	return "service/" + clusterARN[strings.LastIndex(clusterARN, "/")+1:] + "/" + name
+}
+
+// GenerateRegisterScalableTargetInput returns the input for
+// RegisterScalableTarget.
+func GenerateRegisterScalableTargetInput(resourceID string, a *v1alpha1.ServiceAutoScaling) *applicationautoscaling.RegisterScalableTargetInput {
+	return &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceEcs),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+		ResourceId:        aws.String(resourceID),
+		MinCapacity:       aws.Int64(a.MinCapacity),
+		MaxCapacity:       aws.Int64(a.MaxCapacity),
+	}
+}
+
+func generateTargetTrackingConfiguration(p v1alpha1.TargetTrackingPolicy) *applicationautoscaling.TargetTrackingScalingPolicyConfiguration {
+	return &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+		PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String(p.PredefinedMetricType),
+			ResourceLabel:        p.ResourceLabel,
+		},
+		TargetValue:      aws.Float64(p.TargetValue),
+		ScaleInCooldown:  p.ScaleInCooldown,
+		ScaleOutCooldown: p.ScaleOutCooldown,
+		DisableScaleIn:   p.DisableScaleIn,
+	}
+}
+
+// GeneratePutScalingPolicyInput returns the input for PutScalingPolicy.
+func GeneratePutScalingPolicyInput(resourceID string, p v1alpha1.TargetTrackingPolicy) *applicationautoscaling.PutScalingPolicyInput {
+	return &applicationautoscaling.PutScalingPolicyInput{
+		ServiceNamespace:                         aws.String(applicationautoscaling.ServiceNamespaceEcs),
+		ScalableDimension:                        aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+		ResourceId:                               aws.String(resourceID),
+		PolicyName:                               aws.String(p.PolicyName),
+		PolicyType:                               aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		TargetTrackingScalingPolicyConfiguration: generateTargetTrackingConfiguration(p),
+	}
+}
+
+// IsScalableTargetUpToDate checks whether the observed scalable target has
+// the desired capacity limits.
+func IsScalableTargetUpToDate(a *v1alpha1.ServiceAutoScaling, t *applicationautoscaling.ScalableTarget) bool {
+	return t != nil &&
+		a.MinCapacity == aws.Int64Value(t.MinCapacity) &&
+		a.MaxCapacity == aws.Int64Value(t.MaxCapacity)
+}
+
+func isTargetTrackingPolicyUpToDate(p v1alpha1.TargetTrackingPolicy, observed *applicationautoscaling.ScalingPolicy) bool {
+	c := observed.TargetTrackingScalingPolicyConfiguration
+	if aws.StringValue(observed.PolicyType) != applicationautoscaling.PolicyTypeTargetTrackingScaling || c == nil || c.PredefinedMetricSpecification == nil {
+		return false
+	}
+	return p.PredefinedMetricType == aws.StringValue(c.PredefinedMetricSpecification.PredefinedMetricType) &&
+		aws.StringValue(p.ResourceLabel) == aws.StringValue(c.PredefinedMetricSpecification.ResourceLabel) &&
+		p.TargetValue == aws.Float64Value(c.TargetValue) &&
+		(p.ScaleInCooldown == nil || aws.Int64Value(p.ScaleInCooldown) == aws.Int64Value(c.ScaleInCooldown)) &&
+		(p.ScaleOutCooldown == nil || aws.Int64Value(p.ScaleOutCooldown) == aws.Int64Value(c.ScaleOutCooldown)) &&
+		aws.BoolValue(p.DisableScaleIn) == aws.BoolValue(c.DisableScaleIn)
+}
+
+// DiffScalingPolicies returns the desired policies that are missing or
+// outdated, and the names of the observed policies that aren't desired.
+func DiffScalingPolicies(desired []v1alpha1.TargetTrackingPolicy, observed []*applicationautoscaling.ScalingPolicy) (put []v1alpha1.TargetTrackingPolicy, remove []string) {
+	byName := make(map[string]*applicationautoscaling.ScalingPolicy, len(observed))
+	for _, o := range observed {
+		byName[aws.StringValue(o.PolicyName)] = o
+	}
+	for _, p := range desired {
+		o, ok := byName[p.PolicyName]
+		if !ok || !isTargetTrackingPolicyUpToDate(p, o) {
+			put = append(put, p)
+		}
+		delete(byName, p.PolicyName)
+	}
+	for _, o := range observed {
+		if _, ok := byName[aws.StringValue(o.PolicyName)]; ok {
+			remove = append(remove, aws.StringValue(o.PolicyName))
+		}
+	}
+	return put, remove
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+func TestServiceResourceID(t *testing.T) {
+	got := ServiceResourceID("arn:aws:ecs:us-east-1:123456789012:cluster/default", "web")
+	if diff := cmp.Diff("service/default/web", got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiffScalingPolicies(t *testing.T) {
+	cpu := v1alpha1.TargetTrackingPolicy{
+		PolicyName:           "cpu",
+		PredefinedMetricType: v1alpha1.MetricTypeCPUUtilization,
+		TargetValue:          50,
+	}
+	observedCPU := func(target float64) *applicationautoscaling.ScalingPolicy {
+		return &applicationautoscaling.ScalingPolicy{
+			PolicyName: aws.String("cpu"),
+			PolicyType: aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+			TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+				PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+					PredefinedMetricType: aws.String(v1alpha1.MetricTypeCPUUtilization),
+				},
+				TargetValue:      aws.Float64(target),
+				ScaleInCooldown:  aws.Int64(300),
+				ScaleOutCooldown: aws.Int64(300),
+			},
+		}
+	}
+
+	type want struct {
+		put    []v1alpha1.TargetTrackingPolicy
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha1.TargetTrackingPolicy
+		observed []*applicationautoscaling.ScalingPolicy
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.TargetTrackingPolicy{cpu},
+			observed: []*applicationautoscaling.ScalingPolicy{observedCPU(50)},
+		},
+		"Missing": {
+			desired: []v1alpha1.TargetTrackingPolicy{cpu},
+			want:    want{put: []v1alpha1.TargetTrackingPolicy{cpu}},
+		},
+		"TargetChanged": {
+			desired:  []v1alpha1.TargetTrackingPolicy{cpu},
+			observed: []*applicationautoscaling.ScalingPolicy{observedCPU(70)},
+			want:     want{put: []v1alpha1.TargetTrackingPolicy{cpu}},
+		},
+		"Undesired": {
+			observed: []*applicationautoscaling.ScalingPolicy{observedCPU(50)},
+			want:     want{remove: []string{"cpu"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, remove := DiffScalingPolicies(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
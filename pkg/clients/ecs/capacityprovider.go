@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func generateManagedScaling(m *v1alpha1.ManagedScaling) *ecs.ManagedScaling {
+	if m == nil {
+		return nil
+	}
+	return &ecs.ManagedScaling{
+		Status:                 m.Status,
+		TargetCapacity:         m.TargetCapacity,
+		MinimumScalingStepSize: m.MinimumScalingStepSize,
+		MaximumScalingStepSize: m.MaximumScalingStepSize,
+		InstanceWarmupPeriod:   m.InstanceWarmupPeriod,
+	}
+}
+
+// GenerateCreateCapacityProviderInput returns the input for
+// CreateCapacityProvider.
+func GenerateCreateCapacityProviderInput(name string, p v1alpha1.CapacityProviderParameters) *ecs.CreateCapacityProviderInput {
+	return &ecs.CreateCapacityProviderInput{
+		Name: aws.String(name),
+		AutoScalingGroupProvider: &ecs.AutoScalingGroupProvider{
+			AutoScalingGroupArn:          aws.String(p.AutoScalingGroupProvider.AutoScalingGroupARN),
+			ManagedScaling:               generateManagedScaling(p.AutoScalingGroupProvider.ManagedScaling),
+			ManagedTerminationProtection: p.AutoScalingGroupProvider.ManagedTerminationProtection,
+		},
+		Tags: GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateCapacityProviderInput returns the input for
+// UpdateCapacityProvider. The Auto Scaling group of a capacity provider
+// cannot be changed.
+func GenerateUpdateCapacityProviderInput(name string, p v1alpha1.CapacityProviderParameters) *ecs.UpdateCapacityProviderInput {
+	return &ecs.UpdateCapacityProviderInput{
+		Name: aws.String(name),
+		AutoScalingGroupProvider: &ecs.AutoScalingGroupProviderUpdate{
+			ManagedScaling:               generateManagedScaling(p.AutoScalingGroupProvider.ManagedScaling),
+			ManagedTerminationProtection: p.AutoScalingGroupProvider.ManagedTerminationProtection,
+		},
+	}
+}
+
+// GenerateCapacityProviderObservation returns the observation of the given
+// capacity provider.
+func GenerateCapacityProviderObservation(c *ecs.CapacityProvider) v1alpha1.CapacityProviderObservation {
+	return v1alpha1.CapacityProviderObservation{
+		CapacityProviderARN: aws.StringValue(c.CapacityProviderArn),
+		Status:              aws.StringValue(c.Status),
+		UpdateStatus:        aws.StringValue(c.UpdateStatus),
+		UpdateStatusReason:  aws.StringValue(c.UpdateStatusReason),
+	}
+}
+
+// LateInitializeCapacityProvider fills the empty fields of the given
+// parameters with the values of the observed capacity provider, which
+// include the managed scaling defaults of ECS.
+func LateInitializeCapacityProvider(p *v1alpha1.CapacityProviderParameters, c *ecs.CapacityProvider) {
+	observed := c.AutoScalingGroupProvider
+	if observed == nil {
+		return
+	}
+	asg := &p.AutoScalingGroupProvider
+	asg.ManagedTerminationProtection = lateInitializeStringPtr(asg.ManagedTerminationProtection, observed.ManagedTerminationProtection)
+	if observed.ManagedScaling == nil {
+		return
+	}
+	if asg.ManagedScaling == nil {
+		asg.ManagedScaling = &v1alpha1.ManagedScaling{}
+	}
+	m := asg.ManagedScaling
+	m.Status = lateInitializeStringPtr(m.Status, observed.ManagedScaling.Status)
+	m.TargetCapacity = awsclient.LateInitializeInt64Ptr(m.TargetCapacity, observed.ManagedScaling.TargetCapacity)
+	m.MinimumScalingStepSize = awsclient.LateInitializeInt64Ptr(m.MinimumScalingStepSize, observed.ManagedScaling.MinimumScalingStepSize)
+	m.MaximumScalingStepSize = awsclient.LateInitializeInt64Ptr(m.MaximumScalingStepSize, observed.ManagedScaling.MaximumScalingStepSize)
+	m.InstanceWarmupPeriod = awsclient.LateInitializeInt64Ptr(m.InstanceWarmupPeriod, observed.ManagedScaling.InstanceWarmupPeriod)
+}
+
+// IsCapacityProviderUpToDate checks whether the managed scaling and the
+// managed termination protection of the capacity provider are up to date.
+func IsCapacityProviderUpToDate(p v1alpha1.CapacityProviderParameters, c *ecs.CapacityProvider) bool {
+	observed := c.AutoScalingGroupProvider
+	if observed == nil {
+		return false
+	}
+	desired := p.AutoScalingGroupProvider
+	if desired.ManagedTerminationProtection != nil && aws.StringValue(desired.ManagedTerminationProtection) != aws.StringValue(observed.ManagedTerminationProtection) {
+		return false
+	}
+	if desired.ManagedScaling == nil {
+		return true
+	}
+	return cmp.Equal(generateManagedScaling(desired.ManagedScaling), observed.ManagedScaling)
+}
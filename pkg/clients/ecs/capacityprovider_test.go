@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+var autoScalingGroupARN = "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:123:autoScalingGroupName/ecs"
+
+func observedCapacityProvider() *ecs.CapacityProvider {
+	return &ecs.CapacityProvider{
+		Name:   aws.String("ec2"),
+		Status: aws.String(v1alpha1.CapacityProviderStatusActive),
+		AutoScalingGroupProvider: &ecs.AutoScalingGroupProvider{
+			AutoScalingGroupArn: aws.String(autoScalingGroupARN),
+			ManagedScaling: &ecs.ManagedScaling{
+				Status:                 aws.String(ecs.ManagedScalingStatusEnabled),
+				TargetCapacity:         aws.Int64(100),
+				MinimumScalingStepSize: aws.Int64(1),
+				MaximumScalingStepSize: aws.Int64(10000),
+				InstanceWarmupPeriod:   aws.Int64(300),
+			},
+			ManagedTerminationProtection: aws.String(ecs.ManagedTerminationProtectionDisabled),
+		},
+	}
+}
+
+func TestLateInitializeCapacityProvider(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CapacityProviderParameters
+		want v1alpha1.CapacityProviderParameters
+	}{
+		"ManagedScalingDefaults": {
+			p: v1alpha1.CapacityProviderParameters{
+				AutoScalingGroupProvider: v1alpha1.AutoScalingGroupProvider{
+					AutoScalingGroupARN: autoScalingGroupARN,
+					ManagedScaling: &v1alpha1.ManagedScaling{
+						Status:         aws.String(ecs.ManagedScalingStatusEnabled),
+						TargetCapacity: aws.Int64(100),
+					},
+				},
+			},
+			want: v1alpha1.CapacityProviderParameters{
+				AutoScalingGroupProvider: v1alpha1.AutoScalingGroupProvider{
+					AutoScalingGroupARN: autoScalingGroupARN,
+					ManagedScaling: &v1alpha1.ManagedScaling{
+						Status:                 aws.String(ecs.ManagedScalingStatusEnabled),
+						TargetCapacity:         aws.Int64(100),
+						MinimumScalingStepSize: aws.Int64(1),
+						MaximumScalingStepSize: aws.Int64(10000),
+						InstanceWarmupPeriod:   aws.Int64(300),
+					},
+					ManagedTerminationProtection: aws.String(ecs.ManagedTerminationProtectionDisabled),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeCapacityProvider(&tc.p, observedCapacityProvider())
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCapacityProviderUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CapacityProviderParameters
+		want bool
+	}{
+		"ManagedScalingNotSpecified": {
+			p: v1alpha1.CapacityProviderParameters{
+				AutoScalingGroupProvider: v1alpha1.AutoScalingGroupProvider{
+					AutoScalingGroupARN: autoScalingGroupARN,
+				},
+			},
+			want: true,
+		},
+		"TargetCapacityChanged": {
+			p: v1alpha1.CapacityProviderParameters{
+				AutoScalingGroupProvider: v1alpha1.AutoScalingGroupProvider{
+					AutoScalingGroupARN: autoScalingGroupARN,
+					ManagedScaling: &v1alpha1.ManagedScaling{
+						Status:                 aws.String(ecs.ManagedScalingStatusEnabled),
+						TargetCapacity:         aws.Int64(80),
+						MinimumScalingStepSize: aws.Int64(1),
+						MaximumScalingStepSize: aws.Int64(10000),
+						InstanceWarmupPeriod:   aws.Int64(300),
+					},
+				},
+			},
+			want: false,
+		},
+		"TerminationProtectionEnabled": {
+			p: v1alpha1.CapacityProviderParameters{
+				AutoScalingGroupProvider: v1alpha1.AutoScalingGroupProvider{
+					AutoScalingGroupARN:          autoScalingGroupARN,
+					ManagedTerminationProtection: aws.String(ecs.ManagedTerminationProtectionEnabled),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCapacityProviderUpToDate(tc.p, observedCapacityProvider())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+func generateClusterSettings(s []v1alpha1.ClusterSetting) []*ecs.ClusterSetting {
+	if len(s) == 0 {
+		return nil
+	}
+	res := make([]*ecs.ClusterSetting, len(s))
+	for i := range s {
+		res[i] = &ecs.ClusterSetting{Name: aws.String(s[i].Name), Value: aws.String(s[i].Value)}
+	}
+	return res
+}
+
+func generateClusterConfiguration(c *v1alpha1.ClusterConfiguration) *ecs.ClusterConfiguration {
+	if c == nil || c.ExecuteCommandConfiguration == nil {
+		return nil
+	}
+	e := c.ExecuteCommandConfiguration
+	res := &ecs.ClusterConfiguration{
+		ExecuteCommandConfiguration: &ecs.ExecuteCommandConfiguration{
+			KmsKeyId: e.KMSKeyID,
+			Logging:  e.Logging,
+		},
+	}
+	if l := e.LogConfiguration; l != nil {
+		res.ExecuteCommandConfiguration.LogConfiguration = &ecs.ExecuteCommandLogConfiguration{
+			CloudWatchLogGroupName:      l.CloudWatchLogGroupName,
+			CloudWatchEncryptionEnabled: l.CloudWatchEncryptionEnabled,
+			S3BucketName:                l.S3BucketName,
+			S3EncryptionEnabled:         l.S3EncryptionEnabled,
+			S3KeyPrefix:                 l.S3KeyPrefix,
+		}
+	}
+	return res
+}
+
+func generateObservedClusterConfiguration(c *ecs.ClusterConfiguration) *v1alpha1.ClusterConfiguration {
+	if c == nil || c.ExecuteCommandConfiguration == nil {
+		return nil
+	}
+	e := c.ExecuteCommandConfiguration
+	res := &v1alpha1.ClusterConfiguration{
+		ExecuteCommandConfiguration: &v1alpha1.ExecuteCommandConfiguration{
+			KMSKeyID: e.KmsKeyId,
+			Logging:  e.Logging,
+		},
+	}
+	if l := e.LogConfiguration; l != nil {
+		res.ExecuteCommandConfiguration.LogConfiguration = &v1alpha1.ExecuteCommandLogConfiguration{
+			CloudWatchLogGroupName:      l.CloudWatchLogGroupName,
+			CloudWatchEncryptionEnabled: l.CloudWatchEncryptionEnabled,
+			S3BucketName:                l.S3BucketName,
+			S3EncryptionEnabled:         l.S3EncryptionEnabled,
+			S3KeyPrefix:                 l.S3KeyPrefix,
+		}
+	}
+	return res
+}
+
+func generateCapacityProviders(names []string) []*string {
+	if len(names) == 0 {
+		return nil
+	}
+	return aws.StringSlice(names)
+}
+
+func generateCapacityProviderStrategy(items []v1alpha1.CapacityProviderStrategyItem) []*ecs.CapacityProviderStrategyItem {
+	if len(items) == 0 {
+		return nil
+	}
+	res := make([]*ecs.CapacityProviderStrategyItem, len(items))
+	for i := range items {
+		res[i] = &ecs.CapacityProviderStrategyItem{
+			CapacityProvider: aws.String(items[i].CapacityProvider),
+			Base:             items[i].Base,
+			Weight:           items[i].Weight,
+		}
+	}
+	return res
+}
+
+// normalizeCapacityProviderStrategy returns the given strategy sorted by
+// capacity provider, with the base and the weight that ECS defaults to 0.
+func normalizeCapacityProviderStrategy(items []v1alpha1.CapacityProviderStrategyItem) []v1alpha1.CapacityProviderStrategyItem {
+	res := make([]v1alpha1.CapacityProviderStrategyItem, len(items))
+	for i, item := range items {
+		res[i] = v1alpha1.CapacityProviderStrategyItem{
+			CapacityProvider: item.CapacityProvider,
+			Base:             aws.Int64(aws.Int64Value(item.Base)),
+			Weight:           aws.Int64(aws.Int64Value(item.Weight)),
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].CapacityProvider < res[j].CapacityProvider })
+	return res
+}
+
+func generateObservedCapacityProviderStrategy(items []*ecs.CapacityProviderStrategyItem) []v1alpha1.CapacityProviderStrategyItem {
+	if len(items) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.CapacityProviderStrategyItem, len(items))
+	for i, item := range items {
+		res[i] = v1alpha1.CapacityProviderStrategyItem{
+			CapacityProvider: aws.StringValue(item.CapacityProvider),
+			Base:             item.Base,
+			Weight:           item.Weight,
+		}
+	}
+	return res
+}
+
+// GenerateCreateClusterInput returns the input for CreateCluster.
+func GenerateCreateClusterInput(name string, p v1alpha1.ClusterParameters) *ecs.CreateClusterInput {
+	return &ecs.CreateClusterInput{
+		ClusterName:   aws.String(name),
+		Settings:      generateClusterSettings(p.Settings),
+		Configuration: generateClusterConfiguration(p.Configuration),
+		Tags:          GenerateTags(p.Tags),
+
+		CapacityProviders:               generateCapacityProviders(p.CapacityProviders),
+		DefaultCapacityProviderStrategy: generateCapacityProviderStrategy(p.DefaultCapacityProviderStrategy),
+	}
+}
+
+// GenerateUpdateClusterSettingsInput returns the input for
+// UpdateClusterSettings.
+func GenerateUpdateClusterSettingsInput(name string, p v1alpha1.ClusterParameters) *ecs.UpdateClusterSettingsInput {
+	return &ecs.UpdateClusterSettingsInput{
+		Cluster:  aws.String(name),
+		Settings: generateClusterSettings(p.Settings),
+	}
+}
+
+// GenerateUpdateClusterInput returns the input for UpdateCluster.
+func GenerateUpdateClusterInput(name string, p v1alpha1.ClusterParameters) *ecs.UpdateClusterInput {
+	return &ecs.UpdateClusterInput{
+		Cluster:       aws.String(name),
+		Configuration: generateClusterConfiguration(p.Configuration),
+	}
+}
+
+// GeneratePutClusterCapacityProvidersInput returns the input for
+// PutClusterCapacityProviders. ECS replaces both the capacity providers and
+// the default strategy of the cluster, so both are always sent.
+func GeneratePutClusterCapacityProvidersInput(name string, p v1alpha1.ClusterParameters) *ecs.PutClusterCapacityProvidersInput {
+	input := &ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(name),
+		CapacityProviders:               aws.StringSlice(p.CapacityProviders),
+		DefaultCapacityProviderStrategy: generateCapacityProviderStrategy(p.DefaultCapacityProviderStrategy),
+	}
+	if input.DefaultCapacityProviderStrategy == nil {
+		input.DefaultCapacityProviderStrategy = []*ecs.CapacityProviderStrategyItem{}
+	}
+	return input
+}
+
+// GenerateClusterObservation returns the observation of the given cluster.
+func GenerateClusterObservation(c *ecs.Cluster) v1alpha1.ClusterObservation {
+	return v1alpha1.ClusterObservation{
+		ClusterARN:                        aws.StringValue(c.ClusterArn),
+		Status:                            aws.StringValue(c.Status),
+		RegisteredContainerInstancesCount: aws.Int64Value(c.RegisteredContainerInstancesCount),
+		RunningTasksCount:                 aws.Int64Value(c.RunningTasksCount),
+		PendingTasksCount:                 aws.Int64Value(c.PendingTasksCount),
+		ActiveServicesCount:               aws.Int64Value(c.ActiveServicesCount),
+	}
+}
+
+// LateInitializeCluster fills the empty fields of the given parameters with
+// the values of the observed cluster.
+func LateInitializeCluster(p *v1alpha1.ClusterParameters, c *ecs.Cluster) {
+	if len(p.Settings) == 0 && len(c.Settings) != 0 {
+		p.Settings = make([]v1alpha1.ClusterSetting, len(c.Settings))
+		for i, s := range c.Settings {
+			p.Settings[i] = v1alpha1.ClusterSetting{Name: aws.StringValue(s.Name), Value: aws.StringValue(s.Value)}
+		}
+	}
+	if len(p.CapacityProviders) == 0 && len(c.CapacityProviders) != 0 {
+		p.CapacityProviders = aws.StringValueSlice(c.CapacityProviders)
+	}
+	if len(p.DefaultCapacityProviderStrategy) == 0 {
+		p.DefaultCapacityProviderStrategy = generateObservedCapacityProviderStrategy(c.DefaultCapacityProviderStrategy)
+	}
+}
+
+// IsClusterSettingsUpToDate checks whether every desired setting of the
+// cluster has the desired value.
+func IsClusterSettingsUpToDate(p v1alpha1.ClusterParameters, c *ecs.Cluster) bool {
+	observed := make(map[string]string, len(c.Settings))
+	for _, s := range c.Settings {
+		observed[aws.StringValue(s.Name)] = aws.StringValue(s.Value)
+	}
+	for _, s := range p.Settings {
+		if observed[s.Name] != s.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// IsClusterConfigurationUpToDate checks whether the configuration of the
+// cluster is up to date. A configuration that is not specified is not
+// checked.
+func IsClusterConfigurationUpToDate(p v1alpha1.ClusterParameters, c *ecs.Cluster) bool {
+	if p.Configuration == nil || p.Configuration.ExecuteCommandConfiguration == nil {
+		return true
+	}
+	return cmp.Equal(p.Configuration, generateObservedClusterConfiguration(c.Configuration), cmpopts.EquateEmpty())
+}
+
+// IsClusterCapacityProvidersUpToDate checks whether the capacity providers
+// and the default capacity provider strategy of the cluster are up to date.
+// Neither depends on the order of its items.
+func IsClusterCapacityProvidersUpToDate(p v1alpha1.ClusterParameters, c *ecs.Cluster) bool {
+	if !cmp.Equal(p.CapacityProviders, aws.StringValueSlice(c.CapacityProviders), cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+		return false
+	}
+	return cmp.Equal(normalizeCapacityProviderStrategy(p.DefaultCapacityProviderStrategy),
+		normalizeCapacityProviderStrategy(generateObservedCapacityProviderStrategy(c.DefaultCapacityProviderStrategy)),
+		cmpopts.EquateEmpty())
+}
+
+// IsClusterUpToDate checks whether the settings, the configuration and the
+// capacity providers of the cluster are up to date.
+func IsClusterUpToDate(p v1alpha1.ClusterParameters, c *ecs.Cluster) bool {
+	return IsClusterSettingsUpToDate(p, c) &&
+		IsClusterConfigurationUpToDate(p, c) &&
+		IsClusterCapacityProvidersUpToDate(p, c)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+func TestIsClusterCapacityProvidersUpToDate(t *testing.T) {
+	observed := &ecs.Cluster{
+		CapacityProviders: aws.StringSlice([]string{"FARGATE", "ec2"}),
+		DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{
+			{CapacityProvider: aws.String("FARGATE"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+			{CapacityProvider: aws.String("ec2"), Base: aws.Int64(0), Weight: aws.Int64(3)},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.ClusterParameters
+		want bool
+	}{
+		"UpToDateInAnyOrder": {
+			p: v1alpha1.ClusterParameters{
+				CapacityProviders: []string{"ec2", "FARGATE"},
+				DefaultCapacityProviderStrategy: []v1alpha1.CapacityProviderStrategyItem{
+					{CapacityProvider: "ec2", Weight: aws.Int64(3)},
+					{CapacityProvider: "FARGATE", Base: aws.Int64(1), Weight: aws.Int64(1)},
+				},
+			},
+			want: true,
+		},
+		"CapacityProviderRemoved": {
+			p: v1alpha1.ClusterParameters{
+				CapacityProviders: []string{"FARGATE"},
+				DefaultCapacityProviderStrategy: []v1alpha1.CapacityProviderStrategyItem{
+					{CapacityProvider: "FARGATE", Base: aws.Int64(1), Weight: aws.Int64(1)},
+				},
+			},
+			want: false,
+		},
+		"WeightChanged": {
+			p: v1alpha1.ClusterParameters{
+				CapacityProviders: []string{"FARGATE", "ec2"},
+				DefaultCapacityProviderStrategy: []v1alpha1.CapacityProviderStrategyItem{
+					{CapacityProvider: "FARGATE", Base: aws.Int64(1), Weight: aws.Int64(1)},
+					{CapacityProvider: "ec2", Weight: aws.Int64(1)},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsClusterCapacityProvidersUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePutClusterCapacityProvidersInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ClusterParameters
+		want *ecs.PutClusterCapacityProvidersInput
+	}{
+		"WithStrategy": {
+			p: v1alpha1.ClusterParameters{
+				CapacityProviders: []string{"ec2"},
+				DefaultCapacityProviderStrategy: []v1alpha1.CapacityProviderStrategyItem{
+					{CapacityProvider: "ec2", Weight: aws.Int64(1)},
+				},
+			},
+			want: &ecs.PutClusterCapacityProvidersInput{
+				Cluster:           aws.String("example"),
+				CapacityProviders: aws.StringSlice([]string{"ec2"}),
+				DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("ec2"), Weight: aws.Int64(1)},
+				},
+			},
+		},
+		"RemoveAll": {
+			p: v1alpha1.ClusterParameters{},
+			want: &ecs.PutClusterCapacityProvidersInput{
+				Cluster:                         aws.String("example"),
+				CapacityProviders:               []*string{},
+				DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePutClusterCapacityProvidersInput("example", tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errTagResource   = "cannot tag ECS resource"
+	errUntagResource = "cannot untag ECS resource"
+)
+
+// Client defines ECS Client operations
+type Client interface {
+	CreateClusterWithContext(ctx context.Context, input *ecs.CreateClusterInput, opts ...request.Option) (*ecs.CreateClusterOutput, error)
+	DescribeClustersWithContext(ctx context.Context, input *ecs.DescribeClustersInput, opts ...request.Option) (*ecs.DescribeClustersOutput, error)
+	UpdateClusterWithContext(ctx context.Context, input *ecs.UpdateClusterInput, opts ...request.Option) (*ecs.UpdateClusterOutput, error)
+	UpdateClusterSettingsWithContext(ctx context.Context, input *ecs.UpdateClusterSettingsInput, opts ...request.Option) (*ecs.UpdateClusterSettingsOutput, error)
+	DeleteClusterWithContext(ctx context.Context, input *ecs.DeleteClusterInput, opts ...request.Option) (*ecs.DeleteClusterOutput, error)
+	PutClusterCapacityProvidersWithContext(ctx context.Context, input *ecs.PutClusterCapacityProvidersInput, opts ...request.Option) (*ecs.PutClusterCapacityProvidersOutput, error)
+	CreateCapacityProviderWithContext(ctx context.Context, input *ecs.CreateCapacityProviderInput, opts ...request.Option) (*ecs.CreateCapacityProviderOutput, error)
+	DescribeCapacityProvidersWithContext(ctx context.Context, input *ecs.DescribeCapacityProvidersInput, opts ...request.Option) (*ecs.DescribeCapacityProvidersOutput, error)
+	UpdateCapacityProviderWithContext(ctx context.Context, input *ecs.UpdateCapacityProviderInput, opts ...request.Option) (*ecs.UpdateCapacityProviderOutput, error)
+	DeleteCapacityProviderWithContext(ctx context.Context, input *ecs.DeleteCapacityProviderInput, opts ...request.Option) (*ecs.DeleteCapacityProviderOutput, error)
+	RegisterTaskDefinitionWithContext(ctx context.Context, input *ecs.RegisterTaskDefinitionInput, opts ...request.Option) (*ecs.RegisterTaskDefinitionOutput, error)
+	DescribeTaskDefinitionWithContext(ctx context.Context, input *ecs.DescribeTaskDefinitionInput, opts ...request.Option) (*ecs.DescribeTaskDefinitionOutput, error)
+	DeregisterTaskDefinitionWithContext(ctx context.Context, input *ecs.DeregisterTaskDefinitionInput, opts ...request.Option) (*ecs.DeregisterTaskDefinitionOutput, error)
+	CreateServiceWithContext(ctx context.Context, input *ecs.CreateServiceInput, opts ...request.Option) (*ecs.CreateServiceOutput, error)
+	DescribeServicesWithContext(ctx context.Context, input *ecs.DescribeServicesInput, opts ...request.Option) (*ecs.DescribeServicesOutput, error)
+	UpdateServiceWithContext(ctx context.Context, input *ecs.UpdateServiceInput, opts ...request.Option) (*ecs.UpdateServiceOutput, error)
+	DeleteServiceWithContext(ctx context.Context, input *ecs.DeleteServiceInput, opts ...request.Option) (*ecs.DeleteServiceOutput, error)
+	TagResourceWithContext(ctx context.Context, input *ecs.TagResourceInput, opts ...request.Option) (*ecs.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *ecs.UntagResourceInput, opts ...request.Option) (*ecs.UntagResourceOutput, error)
+}
+
+// NewClient returns a new ECS client using the given session.
+func NewClient(sess *session.Session) Client {
+	return ecs.New(sess)
+}
+
+// IsClusterNotFound returns true if the error is because the cluster doesn't
+// exist.
+func IsClusterNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == ecs.ErrCodeClusterNotFoundException
+}
+
+// IsServiceNotFound returns true if the error is because the service doesn't
+// exist or has already been deleted.
+func IsServiceNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == ecs.ErrCodeServiceNotFoundException || code == ecs.ErrCodeServiceNotActiveException
+}
+
+// IsClientException returns true if the error is a client error, which ECS
+// returns among others for task definitions that don't exist.
+func IsClientException(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == ecs.ErrCodeClientException
+}
+
+// GenerateTags converts the given tag map to ECS tags, sorted by key.
+func GenerateTags(tags map[string]string) []*ecs.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	res := make([]*ecs.Tag, len(keys))
+	for i, k := range keys {
+		res[i] = &ecs.Tag{Key: aws.String(k), Value: aws.String(tags[k])}
+	}
+	return res
+}
+
+// TagsToMap converts the given ECS tags to a tag map.
+func TagsToMap(tags []*ecs.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(tags))
+	for _, t := range tags {
+		res[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return res
+}
+
+// UpdateTags makes the tags of the resource with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, client Client, arn string, desired, observed map[string]string) error {
+	add, remove := awsclient.DiffTags(desired, observed)
+	if len(remove) > 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &ecs.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     aws.StringSlice(remove),
+		}); err != nil {
+			return awsclient.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResourceWithContext(ctx, &ecs.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        GenerateTags(add),
+		}); err != nil {
+			return awsclient.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AutoScalingClient = (*MockAutoScalingClient)(nil)
+
+// MockAutoScalingClient is a type that implements all the methods for
+// AutoScalingClient interface
+type MockAutoScalingClient struct {
+	MockRegisterScalableTargetWithContext   func(ctx context.Context, input *applicationautoscaling.RegisterScalableTargetInput, opts []request.Option) (*applicationautoscaling.RegisterScalableTargetOutput, error)
+	MockDescribeScalableTargetsWithContext  func(ctx context.Context, input *applicationautoscaling.DescribeScalableTargetsInput, opts []request.Option) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
+	MockDeregisterScalableTargetWithContext func(ctx context.Context, input *applicationautoscaling.DeregisterScalableTargetInput, opts []request.Option) (*applicationautoscaling.DeregisterScalableTargetOutput, error)
+	MockPutScalingPolicyWithContext         func(ctx context.Context, input *applicationautoscaling.PutScalingPolicyInput, opts []request.Option) (*applicationautoscaling.PutScalingPolicyOutput, error)
+	MockDescribeScalingPoliciesWithContext  func(ctx context.Context, input *applicationautoscaling.DescribeScalingPoliciesInput, opts []request.Option) (*applicationautoscaling.DescribeScalingPoliciesOutput, error)
+	MockDeleteScalingPolicyWithContext      func(ctx context.Context, input *applicationautoscaling.DeleteScalingPolicyInput, opts []request.Option) (*applicationautoscaling.DeleteScalingPolicyOutput, error)
+}
+
+// RegisterScalableTargetWithContext mocks RegisterScalableTargetWithContext method
+func (m *MockAutoScalingClient) RegisterScalableTargetWithContext(ctx context.Context, input *applicationautoscaling.RegisterScalableTargetInput, opts ...request.Option) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+	return m.MockRegisterScalableTargetWithContext(ctx, input, opts)
+}
+
+// DescribeScalableTargetsWithContext mocks DescribeScalableTargetsWithContext method
+func (m *MockAutoScalingClient) DescribeScalableTargetsWithContext(ctx context.Context, input *applicationautoscaling.DescribeScalableTargetsInput, opts ...request.Option) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	return m.MockDescribeScalableTargetsWithContext(ctx, input, opts)
+}
+
+// DeregisterScalableTargetWithContext mocks DeregisterScalableTargetWithContext method
+func (m *MockAutoScalingClient) DeregisterScalableTargetWithContext(ctx context.Context, input *applicationautoscaling.DeregisterScalableTargetInput, opts ...request.Option) (*applicationautoscaling.DeregisterScalableTargetOutput, error) {
+	return m.MockDeregisterScalableTargetWithContext(ctx, input, opts)
+}
+
+// PutScalingPolicyWithContext mocks PutScalingPolicyWithContext method
+func (m *MockAutoScalingClient) PutScalingPolicyWithContext(ctx context.Context, input *applicationautoscaling.PutScalingPolicyInput, opts ...request.Option) (*applicationautoscaling.PutScalingPolicyOutput, error) {
+	return m.MockPutScalingPolicyWithContext(ctx, input, opts)
+}
+
+// DescribeScalingPoliciesWithContext mocks DescribeScalingPoliciesWithContext method
+func (m *MockAutoScalingClient) DescribeScalingPoliciesWithContext(ctx context.Context, input *applicationautoscaling.DescribeScalingPoliciesInput, opts ...request.Option) (*applicationautoscaling.DescribeScalingPoliciesOutput, error) {
+	return m.MockDescribeScalingPoliciesWithContext(ctx, input, opts)
+}
+
+// DeleteScalingPolicyWithContext mocks DeleteScalingPolicyWithContext method
+func (m *MockAutoScalingClient) DeleteScalingPolicyWithContext(ctx context.Context, input *applicationautoscaling.DeleteScalingPolicyInput, opts ...request.Option) (*applicationautoscaling.DeleteScalingPolicyOutput, error) {
+	return m.MockDeleteScalingPolicyWithContext(ctx, input, opts)
+}
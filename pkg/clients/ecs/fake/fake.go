@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateClusterWithContext               func(ctx context.Context, input *ecs.CreateClusterInput, opts []request.Option) (*ecs.CreateClusterOutput, error)
+	MockDescribeClustersWithContext            func(ctx context.Context, input *ecs.DescribeClustersInput, opts []request.Option) (*ecs.DescribeClustersOutput, error)
+	MockUpdateClusterWithContext               func(ctx context.Context, input *ecs.UpdateClusterInput, opts []request.Option) (*ecs.UpdateClusterOutput, error)
+	MockUpdateClusterSettingsWithContext       func(ctx context.Context, input *ecs.UpdateClusterSettingsInput, opts []request.Option) (*ecs.UpdateClusterSettingsOutput, error)
+	MockDeleteClusterWithContext               func(ctx context.Context, input *ecs.DeleteClusterInput, opts []request.Option) (*ecs.DeleteClusterOutput, error)
+	MockPutClusterCapacityProvidersWithContext func(ctx context.Context, input *ecs.PutClusterCapacityProvidersInput, opts []request.Option) (*ecs.PutClusterCapacityProvidersOutput, error)
+	MockCreateCapacityProviderWithContext      func(ctx context.Context, input *ecs.CreateCapacityProviderInput, opts []request.Option) (*ecs.CreateCapacityProviderOutput, error)
+	MockDescribeCapacityProvidersWithContext   func(ctx context.Context, input *ecs.DescribeCapacityProvidersInput, opts []request.Option) (*ecs.DescribeCapacityProvidersOutput, error)
+	MockUpdateCapacityProviderWithContext      func(ctx context.Context, input *ecs.UpdateCapacityProviderInput, opts []request.Option) (*ecs.UpdateCapacityProviderOutput, error)
+	MockDeleteCapacityProviderWithContext      func(ctx context.Context, input *ecs.DeleteCapacityProviderInput, opts []request.Option) (*ecs.DeleteCapacityProviderOutput, error)
+	MockRegisterTaskDefinitionWithContext      func(ctx context.Context, input *ecs.RegisterTaskDefinitionInput, opts []request.Option) (*ecs.RegisterTaskDefinitionOutput, error)
+	MockDescribeTaskDefinitionWithContext      func(ctx context.Context, input *ecs.DescribeTaskDefinitionInput, opts []request.Option) (*ecs.DescribeTaskDefinitionOutput, error)
+	MockDeregisterTaskDefinitionWithContext    func(ctx context.Context, input *ecs.DeregisterTaskDefinitionInput, opts []request.Option) (*ecs.DeregisterTaskDefinitionOutput, error)
+	MockCreateServiceWithContext               func(ctx context.Context, input *ecs.CreateServiceInput, opts []request.Option) (*ecs.CreateServiceOutput, error)
+	MockDescribeServicesWithContext            func(ctx context.Context, input *ecs.DescribeServicesInput, opts []request.Option) (*ecs.DescribeServicesOutput, error)
+	MockUpdateServiceWithContext               func(ctx context.Context, input *ecs.UpdateServiceInput, opts []request.Option) (*ecs.UpdateServiceOutput, error)
+	MockDeleteServiceWithContext               func(ctx context.Context, input *ecs.DeleteServiceInput, opts []request.Option) (*ecs.DeleteServiceOutput, error)
+	MockTagResourceWithContext                 func(ctx context.Context, input *ecs.TagResourceInput, opts []request.Option) (*ecs.TagResourceOutput, error)
+	MockUntagResourceWithContext               func(ctx context.Context, input *ecs.UntagResourceInput, opts []request.Option) (*ecs.UntagResourceOutput, error)
+}
+
+// CreateClusterWithContext mocks CreateClusterWithContext method
+func (m *MockClient) CreateClusterWithContext(ctx context.Context, input *ecs.CreateClusterInput, opts ...request.Option) (*ecs.CreateClusterOutput, error) {
+	return m.MockCreateClusterWithContext(ctx, input, opts)
+}
+
+// DescribeClustersWithContext mocks DescribeClustersWithContext method
+func (m *MockClient) DescribeClustersWithContext(ctx context.Context, input *ecs.DescribeClustersInput, opts ...request.Option) (*ecs.DescribeClustersOutput, error) {
+	return m.MockDescribeClustersWithContext(ctx, input, opts)
+}
+
+// UpdateClusterWithContext mocks UpdateClusterWithContext method
+func (m *MockClient) UpdateClusterWithContext(ctx context.Context, input *ecs.UpdateClusterInput, opts ...request.Option) (*ecs.UpdateClusterOutput, error) {
+	return m.MockUpdateClusterWithContext(ctx, input, opts)
+}
+
+// UpdateClusterSettingsWithContext mocks UpdateClusterSettingsWithContext method
+func (m *MockClient) UpdateClusterSettingsWithContext(ctx context.Context, input *ecs.UpdateClusterSettingsInput, opts ...request.Option) (*ecs.UpdateClusterSettingsOutput, error) {
+	return m.MockUpdateClusterSettingsWithContext(ctx, input, opts)
+}
+
+// DeleteClusterWithContext mocks DeleteClusterWithContext method
+func (m *MockClient) DeleteClusterWithContext(ctx context.Context, input *ecs.DeleteClusterInput, opts ...request.Option) (*ecs.DeleteClusterOutput, error) {
+	return m.MockDeleteClusterWithContext(ctx, input, opts)
+}
+
+// PutClusterCapacityProvidersWithContext mocks PutClusterCapacityProvidersWithContext method
+func (m *MockClient) PutClusterCapacityProvidersWithContext(ctx context.Context, input *ecs.PutClusterCapacityProvidersInput, opts ...request.Option) (*ecs.PutClusterCapacityProvidersOutput, error) {
+	return m.MockPutClusterCapacityProvidersWithContext(ctx, input, opts)
+}
+
+// CreateCapacityProviderWithContext mocks CreateCapacityProviderWithContext method
+func (m *MockClient) CreateCapacityProviderWithContext(ctx context.Context, input *ecs.CreateCapacityProviderInput, opts ...request.Option) (*ecs.CreateCapacityProviderOutput, error) {
+	return m.MockCreateCapacityProviderWithContext(ctx, input, opts)
+}
+
+// DescribeCapacityProvidersWithContext mocks DescribeCapacityProvidersWithContext method
+func (m *MockClient) DescribeCapacityProvidersWithContext(ctx context.Context, input *ecs.DescribeCapacityProvidersInput, opts ...request.Option) (*ecs.DescribeCapacityProvidersOutput, error) {
+	return m.MockDescribeCapacityProvidersWithContext(ctx, input, opts)
+}
+
+// UpdateCapacityProviderWithContext mocks UpdateCapacityProviderWithContext method
+func (m *MockClient) UpdateCapacityProviderWithContext(ctx context.Context, input *ecs.UpdateCapacityProviderInput, opts ...request.Option) (*ecs.UpdateCapacityProviderOutput, error) {
+	return m.MockUpdateCapacityProviderWithContext(ctx, input, opts)
+}
+
+// DeleteCapacityProviderWithContext mocks DeleteCapacityProviderWithContext method
+func (m *MockClient) DeleteCapacityProviderWithContext(ctx context.Context, input *ecs.DeleteCapacityProviderInput, opts ...request.Option) (*ecs.DeleteCapacityProviderOutput, error) {
+	return m.MockDeleteCapacityProviderWithContext(ctx, input, opts)
+}
+
+// RegisterTaskDefinitionWithContext mocks RegisterTaskDefinitionWithContext method
+func (m *MockClient) RegisterTaskDefinitionWithContext(ctx context.Context, input *ecs.RegisterTaskDefinitionInput, opts ...request.Option) (*ecs.RegisterTaskDefinitionOutput, error) {
+	return m.MockRegisterTaskDefinitionWithContext(ctx, input, opts)
+}
+
+// DescribeTaskDefinitionWithContext mocks DescribeTaskDefinitionWithContext method
+func (m *MockClient) DescribeTaskDefinitionWithContext(ctx context.Context, input *ecs.DescribeTaskDefinitionInput, opts ...request.Option) (*ecs.DescribeTaskDefinitionOutput, error) {
+	return m.MockDescribeTaskDefinitionWithContext(ctx, input, opts)
+}
+
+// DeregisterTaskDefinitionWithContext mocks DeregisterTaskDefinitionWithContext method
+func (m *MockClient) DeregisterTaskDefinitionWithContext(ctx context.Context, input *ecs.DeregisterTaskDefinitionInput, opts ...request.Option) (*ecs.DeregisterTaskDefinitionOutput, error) {
+	return m.MockDeregisterTaskDefinitionWithContext(ctx, input, opts)
+}
+
+// CreateServiceWithContext mocks CreateServiceWithContext method
+func (m *MockClient) CreateServiceWithContext(ctx context.Context, input *ecs.CreateServiceInput, opts ...request.Option) (*ecs.CreateServiceOutput, error) {
+	return m.MockCreateServiceWithContext(ctx, input, opts)
+}
+
+// DescribeServicesWithContext mocks DescribeServicesWithContext method
+func (m *MockClient) DescribeServicesWithContext(ctx context.Context, input *ecs.DescribeServicesInput, opts ...request.Option) (*ecs.DescribeServicesOutput, error) {
+	return m.MockDescribeServicesWithContext(ctx, input, opts)
+}
+
+// UpdateServiceWithContext mocks UpdateServiceWithContext method
+func (m *MockClient) UpdateServiceWithContext(ctx context.Context, input *ecs.UpdateServiceInput, opts ...request.Option) (*ecs.UpdateServiceOutput, error) {
+	return m.MockUpdateServiceWithContext(ctx, input, opts)
+}
+
+// DeleteServiceWithContext mocks DeleteServiceWithContext method
+func (m *MockClient) DeleteServiceWithContext(ctx context.Context, input *ecs.DeleteServiceInput, opts ...request.Option) (*ecs.DeleteServiceOutput, error) {
+	return m.MockDeleteServiceWithContext(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *ecs.TagResourceInput, opts ...request.Option) (*ecs.TagResourceOutput, error) {
+	return m.MockTagResourceWithContext(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *ecs.UntagResourceInput, opts ...request.Option) (*ecs.UntagResourceOutput, error) {
+	return m.MockUntagResourceWithContext(ctx, input, opts)
+}
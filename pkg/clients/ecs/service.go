@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+const deploymentStatusPrimary = "PRIMARY"
+
+func generateDeploymentConfiguration(d *v1alpha1.DeploymentConfiguration) *ecs.DeploymentConfiguration {
+	if d == nil {
+		return nil
+	}
+	res := &ecs.DeploymentConfiguration{
+		MaximumPercent:        d.MaximumPercent,
+		MinimumHealthyPercent: d.MinimumHealthyPercent,
+	}
+	if cb := d.DeploymentCircuitBreaker; cb != nil {
+		res.DeploymentCircuitBreaker = &ecs.DeploymentCircuitBreaker{
+			Enable:   aws.Bool(cb.Enable),
+			Rollback: aws.Bool(cb.Rollback),
+		}
+	}
+	return res
+}
+
+func generateLoadBalancers(lbs []v1alpha1.LoadBalancer) []*ecs.LoadBalancer {
+	if len(lbs) == 0 {
+		return nil
+	}
+	res := make([]*ecs.LoadBalancer, len(lbs))
+	for i, lb := range lbs {
+		res[i] = &ecs.LoadBalancer{
+			TargetGroupArn:   lb.TargetGroupARN,
+			LoadBalancerName: lb.LoadBalancerName,
+			ContainerName:    aws.String(lb.ContainerName),
+			ContainerPort:    aws.Int64(lb.ContainerPort),
+		}
+	}
+	return res
+}
+
+func generateNetworkConfiguration(n *v1alpha1.NetworkConfiguration) *ecs.NetworkConfiguration {
+	if n == nil || n.AWSVPCConfiguration == nil {
+		return nil
+	}
+	c := n.AWSVPCConfiguration
+	return &ecs.NetworkConfiguration{
+		AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+			Subnets:        aws.StringSlice(c.Subnets),
+			SecurityGroups: aws.StringSlice(c.SecurityGroups),
+			AssignPublicIp: c.AssignPublicIP,
+		},
+	}
+}
+
+// GenerateCreateServiceInput returns the input for CreateService.
+func GenerateCreateServiceInput(name string, p v1alpha1.ServiceParameters) *ecs.CreateServiceInput {
+	return &ecs.CreateServiceInput{
+		ServiceName:                   aws.String(name),
+		Cluster:                       p.Cluster,
+		TaskDefinition:                p.TaskDefinition,
+		DesiredCount:                  p.DesiredCount,
+		LaunchType:                    p.LaunchType,
+		PlatformVersion:               p.PlatformVersion,
+		SchedulingStrategy:            p.SchedulingStrategy,
+		DeploymentConfiguration:       generateDeploymentConfiguration(p.DeploymentConfiguration),
+		LoadBalancers:                 generateLoadBalancers(p.LoadBalancers),
+		NetworkConfiguration:          generateNetworkConfiguration(p.NetworkConfiguration),
+		HealthCheckGracePeriodSeconds: p.HealthCheckGracePeriodSeconds,
+		EnableExecuteCommand:          p.EnableExecuteCommand,
+		EnableECSManagedTags:          p.EnableECSManagedTags,
+		PropagateTags:                 p.PropagateTags,
+		Tags:                          GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateServiceInput returns the input for UpdateService. The given
+// task definition is the revision the service is updated to.
+func GenerateUpdateServiceInput(name, taskDefinition string, p v1alpha1.ServiceParameters) *ecs.UpdateServiceInput {
+	res := &ecs.UpdateServiceInput{
+		Service:                       aws.String(name),
+		Cluster:                       p.Cluster,
+		PlatformVersion:               p.PlatformVersion,
+		DeploymentConfiguration:       generateDeploymentConfiguration(p.DeploymentConfiguration),
+		NetworkConfiguration:          generateNetworkConfiguration(p.NetworkConfiguration),
+		HealthCheckGracePeriodSeconds: p.HealthCheckGracePeriodSeconds,
+		EnableExecuteCommand:          p.EnableExecuteCommand,
+		EnableECSManagedTags:          p.EnableECSManagedTags,
+		PropagateTags:                 p.PropagateTags,
+	}
+	if p.AutoScaling == nil {
+		res.DesiredCount = p.DesiredCount
+	}
+	if taskDefinition != "" {
+		res.TaskDefinition = aws.String(taskDefinition)
+	}
+	// An empty list removes the load balancers, whereas an omitted one
+	// leaves them untouched.
+	if p.LoadBalancers != nil {
+		res.LoadBalancers = generateLoadBalancers(p.LoadBalancers)
+		if res.LoadBalancers == nil {
+			res.LoadBalancers = []*ecs.LoadBalancer{}
+		}
+	}
+	return res
+}
+
+// GenerateServiceObservation returns the observation of the given service.
+func GenerateServiceObservation(s *ecs.Service) v1alpha1.ServiceObservation {
+	o := v1alpha1.ServiceObservation{
+		ServiceARN:     aws.StringValue(s.ServiceArn),
+		Status:         aws.StringValue(s.Status),
+		TaskDefinition: aws.StringValue(s.TaskDefinition),
+		RunningCount:   aws.Int64Value(s.RunningCount),
+		PendingCount:   aws.Int64Value(s.PendingCount),
+		DesiredCount:   aws.Int64Value(s.DesiredCount),
+	}
+	for _, d := range s.Deployments {
+		if aws.StringValue(d.Status) == deploymentStatusPrimary {
+			o.RolloutState = aws.StringValue(d.RolloutState)
+			o.RolloutStateReason = aws.StringValue(d.RolloutStateReason)
+		}
+	}
+	return o
+}
+
+// RolloutCondition returns the condition of the given rollout state of the
+// primary deployment of a service.
+func RolloutCondition(state, reason string) xpv1.Condition {
+	c := xpv1.Condition{
+		Type:               v1alpha1.TypeRolledOut,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1alpha1.ReasonRolloutInProgress,
+		Message:            reason,
+	}
+	switch state {
+	case ecs.DeploymentRolloutStateCompleted:
+		c.Status = corev1.ConditionTrue
+		c.Reason = v1alpha1.ReasonRolloutCompleted
+	case ecs.DeploymentRolloutStateFailed:
+		c.Reason = v1alpha1.ReasonRolloutFailed
+	}
+	return c
+}
+
+// LateInitializeService fills the empty fields of the given parameters with
+// the values of the observed service.
+func LateInitializeService(p *v1alpha1.ServiceParameters, s *ecs.Service) {
+	p.LaunchType = lateInitializeStringPtr(p.LaunchType, s.LaunchType)
+	p.PlatformVersion = lateInitializeStringPtr(p.PlatformVersion, s.PlatformVersion)
+	p.SchedulingStrategy = lateInitializeStringPtr(p.SchedulingStrategy, s.SchedulingStrategy)
+	p.PropagateTags = lateInitializeStringPtr(p.PropagateTags, s.PropagateTags)
+	// The desired count of a service that is scaled automatically would be
+	// outdated as soon as it is recorded.
+	if p.DesiredCount == nil && s.DesiredCount != nil && p.AutoScaling == nil {
+		p.DesiredCount = s.DesiredCount
+	}
+	if p.HealthCheckGracePeriodSeconds == nil && s.HealthCheckGracePeriodSeconds != nil {
+		p.HealthCheckGracePeriodSeconds = s.HealthCheckGracePeriodSeconds
+	}
+	if p.DeploymentConfiguration == nil && s.DeploymentConfiguration != nil {
+		p.DeploymentConfiguration = &v1alpha1.DeploymentConfiguration{
+			MaximumPercent:        s.DeploymentConfiguration.MaximumPercent,
+			MinimumHealthyPercent: s.DeploymentConfiguration.MinimumHealthyPercent,
+		}
+		if cb := s.DeploymentConfiguration.DeploymentCircuitBreaker; cb != nil {
+			p.DeploymentConfiguration.DeploymentCircuitBreaker = &v1alpha1.DeploymentCircuitBreaker{
+				Enable:   aws.BoolValue(cb.Enable),
+				Rollback: aws.BoolValue(cb.Rollback),
+			}
+		}
+	}
+}
+
+func isLoadBalancersUpToDate(lbs []v1alpha1.LoadBalancer, observed []*ecs.LoadBalancer) bool {
+	less := func(a, b *ecs.LoadBalancer) bool { return a.String() < b.String() }
+	return cmp.Equal(generateLoadBalancers(lbs), observed, cmpopts.EquateEmpty(), cmpopts.SortSlices(less))
+}
+
+func isNetworkConfigurationUpToDate(n *v1alpha1.NetworkConfiguration, observed *ecs.NetworkConfiguration) bool {
+	if n == nil || n.AWSVPCConfiguration == nil {
+		return true
+	}
+	if observed == nil || observed.AwsvpcConfiguration == nil {
+		return false
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	c, o := n.AWSVPCConfiguration, observed.AwsvpcConfiguration
+	assignPublicIP := aws.StringValue(c.AssignPublicIP)
+	if assignPublicIP == "" {
+		assignPublicIP = ecs.AssignPublicIpDisabled
+	}
+	return cmp.Equal(c.Subnets, aws.StringValueSlice(o.Subnets), cmpopts.EquateEmpty(), sortStrings) &&
+		cmp.Equal(c.SecurityGroups, aws.StringValueSlice(o.SecurityGroups), cmpopts.EquateEmpty(), sortStrings) &&
+		assignPublicIP == aws.StringValue(o.AssignPublicIp)
+}
+
+func isDeploymentConfigurationUpToDate(d *v1alpha1.DeploymentConfiguration, observed *ecs.DeploymentConfiguration) bool {
+	if d == nil {
+		return true
+	}
+	if observed == nil {
+		return false
+	}
+	return (d.MaximumPercent == nil || aws.Int64Value(d.MaximumPercent) == aws.Int64Value(observed.MaximumPercent)) &&
+		(d.MinimumHealthyPercent == nil || aws.Int64Value(d.MinimumHealthyPercent) == aws.Int64Value(observed.MinimumHealthyPercent)) &&
+		isDeploymentCircuitBreakerUpToDate(d.DeploymentCircuitBreaker, observed.DeploymentCircuitBreaker)
+}
+
+func isDeploymentCircuitBreakerUpToDate(cb *v1alpha1.DeploymentCircuitBreaker, observed *ecs.DeploymentCircuitBreaker) bool {
+	if cb == nil {
+		return true
+	}
+	if observed == nil {
+		return !cb.Enable && !cb.Rollback
+	}
+	return cb.Enable == aws.BoolValue(observed.Enable) && cb.Rollback == aws.BoolValue(observed.Rollback)
+}
+
+// IsServiceUpToDate checks whether the service is up to date. The given task
+// definition is the ARN of the revision the service should run, or empty if
+// it could not be determined. Tags are not checked, and neither is the desired
+// count of a service that is scaled automatically.
+func IsServiceUpToDate(p v1alpha1.ServiceParameters, taskDefinition string, s *ecs.Service) bool {
+	return (taskDefinition == "" || taskDefinition == aws.StringValue(s.TaskDefinition)) &&
+		(p.DesiredCount == nil || p.AutoScaling != nil || aws.Int64Value(p.DesiredCount) == aws.Int64Value(s.DesiredCount)) &&
+		optionalStringEqual(p.PlatformVersion, s.PlatformVersion) &&
+		optionalStringEqual(p.PropagateTags, s.PropagateTags) &&
+		(p.HealthCheckGracePeriodSeconds == nil || aws.Int64Value(p.HealthCheckGracePeriodSeconds) == aws.Int64Value(s.HealthCheckGracePeriodSeconds)) &&
+		aws.BoolValue(p.EnableExecuteCommand) == aws.BoolValue(s.EnableExecuteCommand) &&
+		aws.BoolValue(p.EnableECSManagedTags) == aws.BoolValue(s.EnableECSManagedTags) &&
+		isDeploymentConfigurationUpToDate(p.DeploymentConfiguration, s.DeploymentConfiguration) &&
+		(p.LoadBalancers == nil || isLoadBalancersUpToDate(p.LoadBalancers, s.LoadBalancers)) &&
+		isNetworkConfigurationUpToDate(p.NetworkConfiguration, s.NetworkConfiguration)
+}
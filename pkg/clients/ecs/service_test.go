@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+var (
+	targetGroupARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/123"
+	revision1      = "arn:aws:ecs:us-east-1:123456789012:task-definition/web:1"
+	revision2      = "arn:aws:ecs:us-east-1:123456789012:task-definition/web:2"
+)
+
+func serviceParameters(m ...func(*v1alpha1.ServiceParameters)) v1alpha1.ServiceParameters {
+	p := v1alpha1.ServiceParameters{
+		Cluster:        aws.String("default"),
+		TaskDefinition: aws.String("web"),
+		DesiredCount:   aws.Int64(2),
+		LaunchType:     aws.String(ecs.LaunchTypeFargate),
+		DeploymentConfiguration: &v1alpha1.DeploymentConfiguration{
+			MinimumHealthyPercent: aws.Int64(50),
+		},
+		LoadBalancers: []v1alpha1.LoadBalancer{{
+			TargetGroupARN: aws.String(targetGroupARN),
+			ContainerName:  "web",
+			ContainerPort:  80,
+		}},
+		NetworkConfiguration: &v1alpha1.NetworkConfiguration{
+			AWSVPCConfiguration: &v1alpha1.AWSVPCConfiguration{
+				Subnets:        []string{"subnet-a", "subnet-b"},
+				SecurityGroups: []string{"sg-1"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedService() *ecs.Service {
+	return &ecs.Service{
+		ServiceName:    aws.String("web"),
+		Status:         aws.String(v1alpha1.ServiceStatusActive),
+		TaskDefinition: aws.String(revision1),
+		DesiredCount:   aws.Int64(2),
+		LaunchType:     aws.String(ecs.LaunchTypeFargate),
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MaximumPercent:        aws.Int64(200),
+			MinimumHealthyPercent: aws.Int64(50),
+		},
+		LoadBalancers: []*ecs.LoadBalancer{{
+			TargetGroupArn: aws.String(targetGroupARN),
+			ContainerName:  aws.String("web"),
+			ContainerPort:  aws.Int64(80),
+		}},
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				Subnets:        aws.StringSlice([]string{"subnet-b", "subnet-a"}),
+				SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+				AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+			},
+		},
+		PropagateTags: aws.String(ecs.PropagateTagsNone),
+	}
+}
+
+func TestIsServiceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p              v1alpha1.ServiceParameters
+		taskDefinition string
+		s              *ecs.Service
+		want           bool
+	}{
+		"UpToDate": {
+			p:              serviceParameters(),
+			taskDefinition: revision1,
+			s:              observedService(),
+			want:           true,
+		},
+		"NewTaskDefinitionRevision": {
+			p:              serviceParameters(),
+			taskDefinition: revision2,
+			s:              observedService(),
+			want:           false,
+		},
+		"DesiredCountChanged": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.DesiredCount = aws.Int64(3)
+			}),
+			taskDefinition: revision1,
+			s:              observedService(),
+			want:           false,
+		},
+		"DesiredCountScaledAutomatically": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.DesiredCount = aws.Int64(3)
+				p.AutoScaling = &v1alpha1.ServiceAutoScaling{MinCapacity: 1, MaxCapacity: 4}
+			}),
+			taskDefinition: revision1,
+			s:              observedService(),
+			want:           true,
+		},
+		"CircuitBreakerEnabled": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.DeploymentConfiguration.DeploymentCircuitBreaker = &v1alpha1.DeploymentCircuitBreaker{Enable: true, Rollback: true}
+			}),
+			taskDefinition: revision1,
+			s:              observedService(),
+			want:           false,
+		},
+		"CircuitBreakerDisabled": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.DeploymentConfiguration.DeploymentCircuitBreaker = &v1alpha1.DeploymentCircuitBreaker{}
+			}),
+			taskDefinition: revision1,
+			s:              observedService(),
+			want:           true,
+		},
+		"PublicIPAssigned": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.NetworkConfiguration.AWSVPCConfiguration.AssignPublicIP = aws.String(ecs.AssignPublicIpEnabled)
+			}),
+			taskDefinition: revision1,
+			s:              observedService(),
+			want:           false,
+		},
+		"LoadBalancerRemoved": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.LoadBalancers = []v1alpha1.LoadBalancer{}
+			}),
+			taskDefinition: revision1,
+			s:              observedService(),
+			want:           false,
+		},
+		"LoadBalancersNotSpecified": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.LoadBalancers = nil
+			}),
+			taskDefinition: revision1,
+			s:              observedService(),
+			want:           true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServiceUpToDate(tc.p, tc.taskDefinition, tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateServiceInput(t *testing.T) {
+	cases := map[string]struct {
+		p              v1alpha1.ServiceParameters
+		taskDefinition string
+		want           *ecs.UpdateServiceInput
+	}{
+		"NewRevision": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.LoadBalancers = nil
+				p.NetworkConfiguration = nil
+			}),
+			taskDefinition: revision2,
+			want: &ecs.UpdateServiceInput{
+				Service:        aws.String("web"),
+				Cluster:        aws.String("default"),
+				TaskDefinition: aws.String(revision2),
+				DesiredCount:   aws.Int64(2),
+				DeploymentConfiguration: &ecs.DeploymentConfiguration{
+					MinimumHealthyPercent: aws.Int64(50),
+				},
+			},
+		},
+		"ScaledAutomatically": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.LoadBalancers = nil
+				p.NetworkConfiguration = nil
+				p.AutoScaling = &v1alpha1.ServiceAutoScaling{MinCapacity: 1, MaxCapacity: 4}
+				p.DeploymentConfiguration.DeploymentCircuitBreaker = &v1alpha1.DeploymentCircuitBreaker{Enable: true, Rollback: true}
+			}),
+			want: &ecs.UpdateServiceInput{
+				Service: aws.String("web"),
+				Cluster: aws.String("default"),
+				DeploymentConfiguration: &ecs.DeploymentConfiguration{
+					MinimumHealthyPercent: aws.Int64(50),
+					DeploymentCircuitBreaker: &ecs.DeploymentCircuitBreaker{
+						Enable:   aws.Bool(true),
+						Rollback: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"RemoveLoadBalancers": {
+			p: serviceParameters(func(p *v1alpha1.ServiceParameters) {
+				p.LoadBalancers = []v1alpha1.LoadBalancer{}
+				p.NetworkConfiguration = nil
+				p.DeploymentConfiguration = nil
+			}),
+			want: &ecs.UpdateServiceInput{
+				Service:       aws.String("web"),
+				Cluster:       aws.String("default"),
+				DesiredCount:  aws.Int64(2),
+				LoadBalancers: []*ecs.LoadBalancer{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateServiceInput("web", tc.taskDefinition, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+// Defaults ECS applies to the fields of a task definition that are omitted
+// on registration.
+const (
+	defaultProtocol          = "tcp"
+	defaultRootDirectory     = "/"
+	defaultTransitEncryption = "DISABLED"
+	defaultHealthInterval    = 30
+	defaultHealthRetries     = 3
+	defaultHealthTimeout     = 5
+)
+
+func generateSecrets(s []v1alpha1.Secret) []*ecs.Secret {
+	if len(s) == 0 {
+		return nil
+	}
+	res := make([]*ecs.Secret, len(s))
+	for i := range s {
+		res[i] = &ecs.Secret{Name: aws.String(s[i].Name), ValueFrom: aws.String(s[i].ValueFrom)}
+	}
+	return res
+}
+
+func generateObservedSecrets(s []*ecs.Secret) []v1alpha1.Secret {
+	if len(s) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.Secret, len(s))
+	for i := range s {
+		res[i] = v1alpha1.Secret{Name: aws.StringValue(s[i].Name), ValueFrom: aws.StringValue(s[i].ValueFrom)}
+	}
+	return res
+}
+
+func generateContainerDefinition(c v1alpha1.ContainerDefinition) *ecs.ContainerDefinition { // nolint:gocyclo
+	res := &ecs.ContainerDefinition{
+		Name:                   aws.String(c.Name),
+		Image:                  aws.String(c.Image),
+		Cpu:                    c.CPU,
+		Memory:                 c.Memory,
+		MemoryReservation:      c.MemoryReservation,
+		Essential:              c.Essential,
+		WorkingDirectory:       c.WorkingDirectory,
+		User:                   c.User,
+		ReadonlyRootFilesystem: c.ReadonlyRootFilesystem,
+		Secrets:                generateSecrets(c.Secrets),
+	}
+	if len(c.Command) != 0 {
+		res.Command = aws.StringSlice(c.Command)
+	}
+	if len(c.EntryPoint) != 0 {
+		res.EntryPoint = aws.StringSlice(c.EntryPoint)
+	}
+	for _, e := range c.Environment {
+		res.Environment = append(res.Environment, &ecs.KeyValuePair{Name: aws.String(e.Name), Value: aws.String(e.Value)})
+	}
+	for _, pm := range c.PortMappings {
+		res.PortMappings = append(res.PortMappings, &ecs.PortMapping{
+			ContainerPort: aws.Int64(pm.ContainerPort),
+			HostPort:      pm.HostPort,
+			Protocol:      pm.Protocol,
+			Name:          pm.Name,
+			AppProtocol:   pm.AppProtocol,
+		})
+	}
+	if l := c.LogConfiguration; l != nil {
+		res.LogConfiguration = &ecs.LogConfiguration{
+			LogDriver:     aws.String(l.LogDriver),
+			SecretOptions: generateSecrets(l.SecretOptions),
+		}
+		if len(l.Options) != 0 {
+			res.LogConfiguration.Options = aws.StringMap(l.Options)
+		}
+	}
+	if h := c.HealthCheck; h != nil {
+		res.HealthCheck = &ecs.HealthCheck{
+			Command:     aws.StringSlice(h.Command),
+			Interval:    h.Interval,
+			Retries:     h.Retries,
+			StartPeriod: h.StartPeriod,
+			Timeout:     h.Timeout,
+		}
+	}
+	for _, m := range c.MountPoints {
+		res.MountPoints = append(res.MountPoints, &ecs.MountPoint{
+			SourceVolume:  aws.String(m.SourceVolume),
+			ContainerPath: aws.String(m.ContainerPath),
+			ReadOnly:      m.ReadOnly,
+		})
+	}
+	for _, d := range c.DependsOn {
+		res.DependsOn = append(res.DependsOn, &ecs.ContainerDependency{
+			ContainerName: aws.String(d.ContainerName),
+			Condition:     aws.String(d.Condition),
+		})
+	}
+	return res
+}
+
+func generateObservedContainerDefinition(c *ecs.ContainerDefinition) v1alpha1.ContainerDefinition { // nolint:gocyclo
+	res := v1alpha1.ContainerDefinition{
+		Name:                   aws.StringValue(c.Name),
+		Image:                  aws.StringValue(c.Image),
+		CPU:                    c.Cpu,
+		Memory:                 c.Memory,
+		MemoryReservation:      c.MemoryReservation,
+		Essential:              c.Essential,
+		Command:                aws.StringValueSlice(c.Command),
+		EntryPoint:             aws.StringValueSlice(c.EntryPoint),
+		WorkingDirectory:       c.WorkingDirectory,
+		User:                   c.User,
+		ReadonlyRootFilesystem: c.ReadonlyRootFilesystem,
+		Secrets:                generateObservedSecrets(c.Secrets),
+	}
+	for _, e := range c.Environment {
+		res.Environment = append(res.Environment, v1alpha1.KeyValuePair{Name: aws.StringValue(e.Name), Value: aws.StringValue(e.Value)})
+	}
+	for _, pm := range c.PortMappings {
+		res.PortMappings = append(res.PortMappings, v1alpha1.PortMapping{
+			ContainerPort: aws.Int64Value(pm.ContainerPort),
+			HostPort:      pm.HostPort,
+			Protocol:      pm.Protocol,
+			Name:          pm.Name,
+			AppProtocol:   pm.AppProtocol,
+		})
+	}
+	if l := c.LogConfiguration; l != nil {
+		res.LogConfiguration = &v1alpha1.LogConfiguration{
+			LogDriver:     aws.StringValue(l.LogDriver),
+			Options:       aws.StringValueMap(l.Options),
+			SecretOptions: generateObservedSecrets(l.SecretOptions),
+		}
+	}
+	if h := c.HealthCheck; h != nil {
+		res.HealthCheck = &v1alpha1.HealthCheck{
+			Command:     aws.StringValueSlice(h.Command),
+			Interval:    h.Interval,
+			Retries:     h.Retries,
+			StartPeriod: h.StartPeriod,
+			Timeout:     h.Timeout,
+		}
+	}
+	for _, m := range c.MountPoints {
+		res.MountPoints = append(res.MountPoints, v1alpha1.MountPoint{
+			SourceVolume:  aws.StringValue(m.SourceVolume),
+			ContainerPath: aws.StringValue(m.ContainerPath),
+			ReadOnly:      m.ReadOnly,
+		})
+	}
+	for _, d := range c.DependsOn {
+		res.DependsOn = append(res.DependsOn, v1alpha1.ContainerDependency{
+			ContainerName: aws.StringValue(d.ContainerName),
+			Condition:     aws.StringValue(d.Condition),
+		})
+	}
+	return res
+}
+
+func generateVolumes(v []v1alpha1.Volume) []*ecs.Volume {
+	if len(v) == 0 {
+		return nil
+	}
+	res := make([]*ecs.Volume, len(v))
+	for i := range v {
+		res[i] = &ecs.Volume{Name: aws.String(v[i].Name)}
+		if e := v[i].EFSVolumeConfiguration; e != nil {
+			res[i].EfsVolumeConfiguration = &ecs.EFSVolumeConfiguration{
+				FileSystemId:      aws.String(e.FileSystemID),
+				RootDirectory:     e.RootDirectory,
+				TransitEncryption: e.TransitEncryption,
+			}
+		}
+	}
+	return res
+}
+
+func generateObservedVolumes(v []*ecs.Volume) []v1alpha1.Volume {
+	if len(v) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.Volume, len(v))
+	for i := range v {
+		res[i] = v1alpha1.Volume{Name: aws.StringValue(v[i].Name)}
+		if e := v[i].EfsVolumeConfiguration; e != nil {
+			res[i].EFSVolumeConfiguration = &v1alpha1.EFSVolumeConfiguration{
+				FileSystemID:      aws.StringValue(e.FileSystemId),
+				RootDirectory:     e.RootDirectory,
+				TransitEncryption: e.TransitEncryption,
+			}
+		}
+	}
+	return res
+}
+
+// GenerateRegisterTaskDefinitionInput returns the input for
+// RegisterTaskDefinition.
+func GenerateRegisterTaskDefinitionInput(p v1alpha1.TaskDefinitionParameters) *ecs.RegisterTaskDefinitionInput {
+	res := &ecs.RegisterTaskDefinitionInput{
+		Family:           aws.String(p.Family),
+		Cpu:              p.CPU,
+		Memory:           p.Memory,
+		NetworkMode:      p.NetworkMode,
+		ExecutionRoleArn: p.ExecutionRoleARN,
+		TaskRoleArn:      p.TaskRoleARN,
+		Volumes:          generateVolumes(p.Volumes),
+		Tags:             GenerateTags(p.Tags),
+	}
+	for _, c := range p.ContainerDefinitions {
+		res.ContainerDefinitions = append(res.ContainerDefinitions, generateContainerDefinition(c))
+	}
+	if len(p.RequiresCompatibilities) != 0 {
+		res.RequiresCompatibilities = aws.StringSlice(p.RequiresCompatibilities)
+	}
+	if r := p.RuntimePlatform; r != nil {
+		res.RuntimePlatform = &ecs.RuntimePlatform{
+			CpuArchitecture:       r.CPUArchitecture,
+			OperatingSystemFamily: r.OperatingSystemFamily,
+		}
+	}
+	if e := p.EphemeralStorage; e != nil {
+		res.EphemeralStorage = &ecs.EphemeralStorage{SizeInGiB: aws.Int64(e.SizeInGiB)}
+	}
+	return res
+}
+
+// GenerateTaskDefinitionObservation returns the observation of the given task
+// definition revision.
+func GenerateTaskDefinitionObservation(td *ecs.TaskDefinition) v1alpha1.TaskDefinitionObservation {
+	return v1alpha1.TaskDefinitionObservation{
+		TaskDefinitionARN: aws.StringValue(td.TaskDefinitionArn),
+		Revision:          aws.Int64Value(td.Revision),
+		Status:            aws.StringValue(td.Status),
+	}
+}
+
+// LateInitializeTaskDefinition fills the empty fields of the given parameters
+// with the values of the observed task definition revision.
+func LateInitializeTaskDefinition(p *v1alpha1.TaskDefinitionParameters, td *ecs.TaskDefinition) {
+	p.CPU = lateInitializeStringPtr(p.CPU, td.Cpu)
+	p.Memory = lateInitializeStringPtr(p.Memory, td.Memory)
+	p.NetworkMode = lateInitializeStringPtr(p.NetworkMode, td.NetworkMode)
+}
+
+func lateInitializeStringPtr(in, from *string) *string {
+	if in != nil || aws.StringValue(from) == "" {
+		return in
+	}
+	return from
+}
+
+// normalizeContainerDefinitions fills the fields that ECS defaults on
+// registration and sorts the unordered lists, so that the desired and the
+// observed container definitions can be compared.
+func normalizeContainerDefinitions(networkMode string, cds []v1alpha1.ContainerDefinition) { // nolint:gocyclo
+	for i := range cds {
+		c := &cds[i]
+		if c.CPU == nil {
+			c.CPU = aws.Int64(0)
+		}
+		if c.Essential == nil {
+			c.Essential = aws.Bool(true)
+		}
+		for j := range c.PortMappings {
+			pm := &c.PortMappings[j]
+			if pm.Protocol == nil {
+				pm.Protocol = aws.String(defaultProtocol)
+			}
+			if pm.HostPort == nil {
+				pm.HostPort = aws.Int64(0)
+				if networkMode == ecs.NetworkModeAwsvpc || networkMode == ecs.NetworkModeHost {
+					pm.HostPort = aws.Int64(pm.ContainerPort)
+				}
+			}
+		}
+		if h := c.HealthCheck; h != nil {
+			if h.Interval == nil {
+				h.Interval = aws.Int64(defaultHealthInterval)
+			}
+			if h.Retries == nil {
+				h.Retries = aws.Int64(defaultHealthRetries)
+			}
+			if h.Timeout == nil {
+				h.Timeout = aws.Int64(defaultHealthTimeout)
+			}
+			if h.StartPeriod == nil {
+				h.StartPeriod = aws.Int64(0)
+			}
+		}
+		sort.Slice(c.Environment, func(a, b int) bool { return c.Environment[a].Name < c.Environment[b].Name })
+		sort.Slice(c.Secrets, func(a, b int) bool { return c.Secrets[a].Name < c.Secrets[b].Name })
+	}
+}
+
+func normalizeVolumes(v []v1alpha1.Volume) {
+	for i := range v {
+		e := v[i].EFSVolumeConfiguration
+		if e == nil {
+			continue
+		}
+		if e.RootDirectory == nil {
+			e.RootDirectory = aws.String(defaultRootDirectory)
+		}
+		if e.TransitEncryption == nil {
+			e.TransitEncryption = aws.String(defaultTransitEncryption)
+		}
+	}
+}
+
+// IsTaskDefinitionUpToDate checks whether the given task definition revision
+// matches the desired parameters. Tags are not checked since they can be
+// updated without registering a new revision.
+func IsTaskDefinitionUpToDate(p v1alpha1.TaskDefinitionParameters, td *ecs.TaskDefinition) bool {
+	if p.Family != aws.StringValue(td.Family) ||
+		!optionalStringEqual(p.CPU, td.Cpu) ||
+		!optionalStringEqual(p.Memory, td.Memory) ||
+		!optionalStringEqual(p.NetworkMode, td.NetworkMode) ||
+		aws.StringValue(p.ExecutionRoleARN) != aws.StringValue(td.ExecutionRoleArn) ||
+		aws.StringValue(p.TaskRoleARN) != aws.StringValue(td.TaskRoleArn) {
+		return false
+	}
+	networkMode := aws.StringValue(td.NetworkMode)
+	desired := p.DeepCopy()
+	normalizeContainerDefinitions(networkMode, desired.ContainerDefinitions)
+	normalizeVolumes(desired.Volumes)
+	observedContainers := make([]v1alpha1.ContainerDefinition, len(td.ContainerDefinitions))
+	for i := range td.ContainerDefinitions {
+		observedContainers[i] = generateObservedContainerDefinition(td.ContainerDefinitions[i])
+	}
+	normalizeContainerDefinitions(networkMode, observedContainers)
+	observedVolumes := generateObservedVolumes(td.Volumes)
+	normalizeVolumes(observedVolumes)
+
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	var observedRuntimePlatform *v1alpha1.RuntimePlatform
+	if r := td.RuntimePlatform; r != nil {
+		observedRuntimePlatform = &v1alpha1.RuntimePlatform{CPUArchitecture: r.CpuArchitecture, OperatingSystemFamily: r.OperatingSystemFamily}
+	}
+	var observedEphemeralStorage *v1alpha1.EphemeralStorage
+	if e := td.EphemeralStorage; e != nil {
+		observedEphemeralStorage = &v1alpha1.EphemeralStorage{SizeInGiB: aws.Int64Value(e.SizeInGiB)}
+	}
+	return cmp.Equal(desired.ContainerDefinitions, observedContainers, cmpopts.EquateEmpty()) &&
+		cmp.Equal(desired.Volumes, observedVolumes, cmpopts.EquateEmpty()) &&
+		cmp.Equal(desired.RequiresCompatibilities, aws.StringValueSlice(td.RequiresCompatibilities), cmpopts.EquateEmpty(), sortStrings) &&
+		(desired.RuntimePlatform == nil || cmp.Equal(desired.RuntimePlatform, observedRuntimePlatform)) &&
+		(desired.EphemeralStorage == nil || cmp.Equal(desired.EphemeralStorage, observedEphemeralStorage))
+}
+
+// optionalStringEqual returns true if the desired value is unset or equal to
+// the observed one.
+func optionalStringEqual(desired, observed *string) bool {
+	return desired == nil || aws.StringValue(desired) == aws.StringValue(observed)
+}
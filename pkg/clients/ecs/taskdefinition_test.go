@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+)
+
+var (
+	executionRoleARN = "arn:aws:iam::123456789012:role/execution"
+)
+
+func fargateTaskDefinition(m ...func(*v1alpha1.TaskDefinitionParameters)) v1alpha1.TaskDefinitionParameters {
+	p := v1alpha1.TaskDefinitionParameters{
+		Family:                  "web",
+		CPU:                     aws.String("256"),
+		Memory:                  aws.String("512"),
+		NetworkMode:             aws.String(ecs.NetworkModeAwsvpc),
+		RequiresCompatibilities: []string{ecs.CompatibilityFargate},
+		ExecutionRoleARN:        aws.String(executionRoleARN),
+		ContainerDefinitions: []v1alpha1.ContainerDefinition{{
+			Name:  "web",
+			Image: "nginx:1.23",
+			Environment: []v1alpha1.KeyValuePair{
+				{Name: "B", Value: "2"},
+				{Name: "A", Value: "1"},
+			},
+			PortMappings: []v1alpha1.PortMapping{{ContainerPort: 80}},
+			LogConfiguration: &v1alpha1.LogConfiguration{
+				LogDriver: "awslogs",
+				Options:   map[string]string{"awslogs-group": "web"},
+			},
+		}},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+// observedTaskDefinition is the revision ECS returns for the
+// fargateTaskDefinition, including the defaults it applies on registration.
+func observedTaskDefinition() *ecs.TaskDefinition {
+	return &ecs.TaskDefinition{
+		TaskDefinitionArn:       aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:1"),
+		Family:                  aws.String("web"),
+		Revision:                aws.Int64(1),
+		Status:                  aws.String(ecs.TaskDefinitionStatusActive),
+		Cpu:                     aws.String("256"),
+		Memory:                  aws.String("512"),
+		NetworkMode:             aws.String(ecs.NetworkModeAwsvpc),
+		RequiresCompatibilities: aws.StringSlice([]string{ecs.CompatibilityFargate}),
+		ExecutionRoleArn:        aws.String(executionRoleARN),
+		ContainerDefinitions: []*ecs.ContainerDefinition{{
+			Name:      aws.String("web"),
+			Image:     aws.String("nginx:1.23"),
+			Cpu:       aws.Int64(0),
+			Essential: aws.Bool(true),
+			Environment: []*ecs.KeyValuePair{
+				{Name: aws.String("A"), Value: aws.String("1")},
+				{Name: aws.String("B"), Value: aws.String("2")},
+			},
+			PortMappings: []*ecs.PortMapping{{
+				ContainerPort: aws.Int64(80),
+				HostPort:      aws.Int64(80),
+				Protocol:      aws.String("tcp"),
+			}},
+			LogConfiguration: &ecs.LogConfiguration{
+				LogDriver: aws.String("awslogs"),
+				Options:   map[string]*string{"awslogs-group": aws.String("web")},
+			},
+			MountPoints: []*ecs.MountPoint{},
+			VolumesFrom: []*ecs.VolumeFrom{},
+		}},
+		Volumes: []*ecs.Volume{},
+	}
+}
+
+func TestGenerateRegisterTaskDefinitionInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TaskDefinitionParameters
+		want *ecs.RegisterTaskDefinitionInput
+	}{
+		"Fargate": {
+			p: fargateTaskDefinition(func(p *v1alpha1.TaskDefinitionParameters) {
+				p.Volumes = []v1alpha1.Volume{{
+					Name:                   "data",
+					EFSVolumeConfiguration: &v1alpha1.EFSVolumeConfiguration{FileSystemID: "fs-123"},
+				}}
+				p.EphemeralStorage = &v1alpha1.EphemeralStorage{SizeInGiB: 30}
+				p.Tags = map[string]string{"k": "v"}
+			}),
+			want: &ecs.RegisterTaskDefinitionInput{
+				Family:                  aws.String("web"),
+				Cpu:                     aws.String("256"),
+				Memory:                  aws.String("512"),
+				NetworkMode:             aws.String(ecs.NetworkModeAwsvpc),
+				RequiresCompatibilities: aws.StringSlice([]string{ecs.CompatibilityFargate}),
+				ExecutionRoleArn:        aws.String(executionRoleARN),
+				ContainerDefinitions: []*ecs.ContainerDefinition{{
+					Name:  aws.String("web"),
+					Image: aws.String("nginx:1.23"),
+					Environment: []*ecs.KeyValuePair{
+						{Name: aws.String("B"), Value: aws.String("2")},
+						{Name: aws.String("A"), Value: aws.String("1")},
+					},
+					PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80)}},
+					LogConfiguration: &ecs.LogConfiguration{
+						LogDriver: aws.String("awslogs"),
+						Options:   map[string]*string{"awslogs-group": aws.String("web")},
+					},
+				}},
+				Volumes: []*ecs.Volume{{
+					Name:                   aws.String("data"),
+					EfsVolumeConfiguration: &ecs.EFSVolumeConfiguration{FileSystemId: aws.String("fs-123")},
+				}},
+				EphemeralStorage: &ecs.EphemeralStorage{SizeInGiB: aws.Int64(30)},
+				Tags:             []*ecs.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRegisterTaskDefinitionInput(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTaskDefinitionUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TaskDefinitionParameters
+		td   *ecs.TaskDefinition
+		want bool
+	}{
+		"UpToDateWithDefaults": {
+			p:    fargateTaskDefinition(),
+			td:   observedTaskDefinition(),
+			want: true,
+		},
+		"ImageChanged": {
+			p: fargateTaskDefinition(func(p *v1alpha1.TaskDefinitionParameters) {
+				p.ContainerDefinitions[0].Image = "nginx:1.24"
+			}),
+			td:   observedTaskDefinition(),
+			want: false,
+		},
+		"EnvironmentChanged": {
+			p: fargateTaskDefinition(func(p *v1alpha1.TaskDefinitionParameters) {
+				p.ContainerDefinitions[0].Environment[0].Value = "3"
+			}),
+			td:   observedTaskDefinition(),
+			want: false,
+		},
+		"ContainerAdded": {
+			p: fargateTaskDefinition(func(p *v1alpha1.TaskDefinitionParameters) {
+				p.ContainerDefinitions = append(p.ContainerDefinitions, v1alpha1.ContainerDefinition{Name: "sidecar", Image: "envoy"})
+			}),
+			td:   observedTaskDefinition(),
+			want: false,
+		},
+		"MemoryChanged": {
+			p: fargateTaskDefinition(func(p *v1alpha1.TaskDefinitionParameters) {
+				p.Memory = aws.String("1024")
+			}),
+			td:   observedTaskDefinition(),
+			want: false,
+		},
+		"TaskRoleAdded": {
+			p: fargateTaskDefinition(func(p *v1alpha1.TaskDefinitionParameters) {
+				p.TaskRoleARN = aws.String("arn:aws:iam::123456789012:role/task")
+			}),
+			td:   observedTaskDefinition(),
+			want: false,
+		},
+		"TagsIgnored": {
+			p: fargateTaskDefinition(func(p *v1alpha1.TaskDefinitionParameters) {
+				p.Tags = map[string]string{"k": "v"}
+			}),
+			td:   observedTaskDefinition(),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTaskDefinitionUpToDate(tc.p, tc.td)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
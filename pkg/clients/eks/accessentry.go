@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+)
+
+// GenerateCreateAccessEntryInput from AccessEntryParameters.
+func GenerateCreateAccessEntryInput(p manualv1alpha1.AccessEntryParameters) *eks.CreateAccessEntryInput {
+	return &eks.CreateAccessEntryInput{
+		ClusterName:      aws.String(p.ClusterName),
+		PrincipalArn:     aws.String(p.PrincipalARN),
+		KubernetesGroups: p.KubernetesGroups,
+		Type:             p.Type,
+		Username:         p.Username,
+		Tags:             p.Tags,
+	}
+}
+
+// GenerateUpdateAccessEntryInput from AccessEntryParameters. The Kubernetes
+// groups are always sent so that removing all of them is applied as well.
+func GenerateUpdateAccessEntryInput(principalARN string, p manualv1alpha1.AccessEntryParameters) *eks.UpdateAccessEntryInput {
+	groups := p.KubernetesGroups
+	if groups == nil {
+		groups = []string{}
+	}
+	return &eks.UpdateAccessEntryInput{
+		ClusterName:      aws.String(p.ClusterName),
+		PrincipalArn:     aws.String(principalARN),
+		KubernetesGroups: groups,
+		Username:         p.Username,
+	}
+}
+
+// GenerateAccessEntryObservation is used to produce
+// manualv1alpha1.AccessEntryObservation from eks.AccessEntry.
+func GenerateAccessEntryObservation(ae *types.AccessEntry) manualv1alpha1.AccessEntryObservation {
+	if ae == nil {
+		return manualv1alpha1.AccessEntryObservation{}
+	}
+	o := manualv1alpha1.AccessEntryObservation{
+		AccessEntryARN: aws.ToString(ae.AccessEntryArn),
+	}
+	if ae.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *ae.CreatedAt}
+	}
+	if ae.ModifiedAt != nil {
+		o.ModifiedAt = &metav1.Time{Time: *ae.ModifiedAt}
+	}
+	return o
+}
+
+// LateInitializeAccessEntry fills the empty fields in
+// *manualv1alpha1.AccessEntryParameters with the values seen in
+// eks.AccessEntry.
+func LateInitializeAccessEntry(in *manualv1alpha1.AccessEntryParameters, ae *types.AccessEntry) {
+	if ae == nil {
+		return
+	}
+	if in.Type == nil {
+		in.Type = ae.Type
+	}
+	if in.Username == nil {
+		in.Username = ae.Username
+	}
+	if len(in.KubernetesGroups) == 0 && len(ae.KubernetesGroups) > 0 {
+		in.KubernetesGroups = ae.KubernetesGroups
+	}
+	if len(in.Tags) == 0 && len(ae.Tags) > 0 {
+		in.Tags = ae.Tags
+	}
+}
+
+// IsAccessEntryUpToDate checks whether the Kubernetes groups, username or tags
+// of the access entry differ from the desired ones. The order of the groups
+// is ignored.
+func IsAccessEntryUpToDate(p manualv1alpha1.AccessEntryParameters, ae *types.AccessEntry) bool {
+	return isAccessEntryConfigUpToDate(p, ae) && cmp.Equal(p.Tags, ae.Tags, cmpopts.EquateEmpty())
+}
+
+func isAccessEntryConfigUpToDate(p manualv1alpha1.AccessEntryParameters, ae *types.AccessEntry) bool {
+	if p.Username != nil && aws.ToString(p.Username) != aws.ToString(ae.Username) {
+		return false
+	}
+	return cmp.Equal(p.KubernetesGroups, ae.KubernetesGroups, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// NeedsAccessEntryUpdate returns whether UpdateAccessEntry has to be called to
+// bring the access entry to the desired state, i.e. whether anything but its
+// tags differ.
+func NeedsAccessEntryUpdate(p manualv1alpha1.AccessEntryParameters, ae *types.AccessEntry) bool {
+	return !isAccessEntryConfigUpToDate(p, ae)
+}
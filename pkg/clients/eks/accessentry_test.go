@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+)
+
+var (
+	accessEntryCluster   = "my-cluster"
+	accessEntryPrincipal = "arn:aws:iam::123456789012:role/admin"
+)
+
+func TestGenerateUpdateAccessEntryInput(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.AccessEntryParameters
+		want *eks.UpdateAccessEntryInput
+	}{
+		"AllFields": {
+			p: manualv1alpha1.AccessEntryParameters{
+				ClusterName:      accessEntryCluster,
+				KubernetesGroups: []string{"admins"},
+				Username:         aws.String("admin"),
+			},
+			want: &eks.UpdateAccessEntryInput{
+				ClusterName:      &accessEntryCluster,
+				PrincipalArn:     &accessEntryPrincipal,
+				KubernetesGroups: []string{"admins"},
+				Username:         aws.String("admin"),
+			},
+		},
+		"RemovedGroups": {
+			p: manualv1alpha1.AccessEntryParameters{
+				ClusterName: accessEntryCluster,
+			},
+			want: &eks.UpdateAccessEntryInput{
+				ClusterName:      &accessEntryCluster,
+				PrincipalArn:     &accessEntryPrincipal,
+				KubernetesGroups: []string{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateAccessEntryInput(accessEntryPrincipal, tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(eks.UpdateAccessEntryInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAccessEntry(t *testing.T) {
+	cases := map[string]struct {
+		p    *manualv1alpha1.AccessEntryParameters
+		ae   *types.AccessEntry
+		want *manualv1alpha1.AccessEntryParameters
+	}{
+		"AllFieldsEmpty": {
+			p: &manualv1alpha1.AccessEntryParameters{},
+			ae: &types.AccessEntry{
+				KubernetesGroups: []string{"admins"},
+				Type:             aws.String("STANDARD"),
+				Username:         aws.String("arn:aws:sts::123456789012:assumed-role/admin/{{SessionName}}"),
+				Tags:             map[string]string{"k": "v"},
+			},
+			want: &manualv1alpha1.AccessEntryParameters{
+				KubernetesGroups: []string{"admins"},
+				Type:             aws.String("STANDARD"),
+				Username:         aws.String("arn:aws:sts::123456789012:assumed-role/admin/{{SessionName}}"),
+				Tags:             map[string]string{"k": "v"},
+			},
+		},
+		"NoOverride": {
+			p: &manualv1alpha1.AccessEntryParameters{
+				KubernetesGroups: []string{"viewers"},
+				Username:         aws.String("viewer"),
+			},
+			ae: &types.AccessEntry{
+				KubernetesGroups: []string{"admins"},
+				Type:             aws.String("STANDARD"),
+				Username:         aws.String("admin"),
+			},
+			want: &manualv1alpha1.AccessEntryParameters{
+				KubernetesGroups: []string{"viewers"},
+				Type:             aws.String("STANDARD"),
+				Username:         aws.String("viewer"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAccessEntry(tc.p, tc.ae)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccessEntryUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.AccessEntryParameters
+		ae   *types.AccessEntry
+		want bool
+	}{
+		"UpToDate": {
+			p: manualv1alpha1.AccessEntryParameters{
+				KubernetesGroups: []string{"b", "a"},
+				Username:         aws.String("admin"),
+				Tags:             map[string]string{"k": "v"},
+			},
+			ae: &types.AccessEntry{
+				KubernetesGroups: []string{"a", "b"},
+				Username:         aws.String("admin"),
+				Tags:             map[string]string{"k": "v"},
+			},
+			want: true,
+		},
+		"GroupsChanged": {
+			p: manualv1alpha1.AccessEntryParameters{
+				KubernetesGroups: []string{"a"},
+			},
+			ae: &types.AccessEntry{
+				KubernetesGroups: []string{"a", "b"},
+			},
+			want: false,
+		},
+		"UsernameChanged": {
+			p: manualv1alpha1.AccessEntryParameters{
+				Username: aws.String("viewer"),
+			},
+			ae: &types.AccessEntry{
+				Username: aws.String("admin"),
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			p: manualv1alpha1.AccessEntryParameters{
+				Tags: map[string]string{"k": "v"},
+			},
+			ae:   &types.AccessEntry{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessEntryUpToDate(tc.p, tc.ae)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
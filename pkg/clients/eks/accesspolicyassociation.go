@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+)
+
+// GenerateAssociateAccessPolicyInput from AccessPolicyAssociationParameters.
+// It is used both to create the association and to update its scope.
+func GenerateAssociateAccessPolicyInput(p manualv1alpha1.AccessPolicyAssociationParameters) *eks.AssociateAccessPolicyInput {
+	return &eks.AssociateAccessPolicyInput{
+		ClusterName:  aws.String(p.ClusterName),
+		PrincipalArn: aws.String(p.PrincipalARN),
+		PolicyArn:    aws.String(p.PolicyARN),
+		AccessScope: &types.AccessScope{
+			Type:       types.AccessScopeType(p.AccessScope.Type),
+			Namespaces: p.AccessScope.Namespaces,
+		},
+	}
+}
+
+// FindAssociatedAccessPolicy returns the association of the supplied access
+// policy, or nil if it is not among the supplied ones.
+func FindAssociatedAccessPolicy(policies []types.AssociatedAccessPolicy, policyARN string) *types.AssociatedAccessPolicy {
+	for i := range policies {
+		if aws.ToString(policies[i].PolicyArn) == policyARN {
+			return &policies[i]
+		}
+	}
+	return nil
+}
+
+// GenerateAccessPolicyAssociationObservation is used to produce
+// manualv1alpha1.AccessPolicyAssociationObservation from
+// eks.AssociatedAccessPolicy.
+func GenerateAccessPolicyAssociationObservation(ap *types.AssociatedAccessPolicy) manualv1alpha1.AccessPolicyAssociationObservation {
+	o := manualv1alpha1.AccessPolicyAssociationObservation{}
+	if ap == nil {
+		return o
+	}
+	if ap.AssociatedAt != nil {
+		o.AssociatedAt = &metav1.Time{Time: *ap.AssociatedAt}
+	}
+	if ap.ModifiedAt != nil {
+		o.ModifiedAt = &metav1.Time{Time: *ap.ModifiedAt}
+	}
+	return o
+}
+
+// IsAccessPolicyAssociationUpToDate checks whether the access scope of the
+// association differs from the desired one. The order of the namespaces is
+// ignored.
+func IsAccessPolicyAssociationUpToDate(p manualv1alpha1.AccessPolicyAssociationParameters, ap *types.AssociatedAccessPolicy) bool {
+	if ap.AccessScope == nil {
+		return false
+	}
+	if p.AccessScope.Type != string(ap.AccessScope.Type) {
+		return false
+	}
+	return cmp.Equal(p.AccessScope.Namespaces, ap.AccessScope.Namespaces, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+)
+
+var (
+	viewPolicy  = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+	adminPolicy = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSAdminPolicy"
+)
+
+func TestFindAssociatedAccessPolicy(t *testing.T) {
+	policies := []types.AssociatedAccessPolicy{
+		{PolicyArn: aws.String(viewPolicy)},
+		{PolicyArn: aws.String(adminPolicy)},
+	}
+
+	cases := map[string]struct {
+		policyARN string
+		want      *types.AssociatedAccessPolicy
+	}{
+		"Found": {
+			policyARN: adminPolicy,
+			want:      &types.AssociatedAccessPolicy{PolicyArn: aws.String(adminPolicy)},
+		},
+		"NotFound": {
+			policyARN: "arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindAssociatedAccessPolicy(policies, tc.policyARN)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(types.AssociatedAccessPolicy{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccessPolicyAssociationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.AccessPolicyAssociationParameters
+		ap   *types.AssociatedAccessPolicy
+		want bool
+	}{
+		"UpToDate": {
+			p: manualv1alpha1.AccessPolicyAssociationParameters{
+				AccessScope: manualv1alpha1.AccessScope{Type: "namespace", Namespaces: []string{"b", "a"}},
+			},
+			ap: &types.AssociatedAccessPolicy{
+				AccessScope: &types.AccessScope{Type: types.AccessScopeTypeNamespace, Namespaces: []string{"a", "b"}},
+			},
+			want: true,
+		},
+		"TypeChanged": {
+			p: manualv1alpha1.AccessPolicyAssociationParameters{
+				AccessScope: manualv1alpha1.AccessScope{Type: "cluster"},
+			},
+			ap: &types.AssociatedAccessPolicy{
+				AccessScope: &types.AccessScope{Type: types.AccessScopeTypeNamespace, Namespaces: []string{"a"}},
+			},
+			want: false,
+		},
+		"NamespacesChanged": {
+			p: manualv1alpha1.AccessPolicyAssociationParameters{
+				AccessScope: manualv1alpha1.AccessScope{Type: "namespace", Namespaces: []string{"a", "c"}},
+			},
+			ap: &types.AssociatedAccessPolicy{
+				AccessScope: &types.AccessScope{Type: types.AccessScopeTypeNamespace, Namespaces: []string{"a", "b"}},
+			},
+			want: false,
+		},
+		"NoObservedScope": {
+			p: manualv1alpha1.AccessPolicyAssociationParameters{
+				AccessScope: manualv1alpha1.AccessScope{Type: "cluster"},
+			},
+			ap:   &types.AssociatedAccessPolicy{},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessPolicyAssociationUpToDate(tc.p, tc.ap)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
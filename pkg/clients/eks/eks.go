@@ -21,8 +21,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
-
 	"net"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
@@ -49,13 +49,22 @@ const (
 	expireHeader     = "X-Amz-Expires"
 	expireHeaderTime = "60"
 	v1Prefix         = "k8s-aws-v1."
+
+	execAPIVersion = "client.authentication.k8s.io/v1beta1"
+	execCommand    = "aws"
 )
 
+// TokenRefreshInterval is the longest time after which the token embedded in
+// the kubeconfig of a cluster must be refreshed. Tokens are valid for 15
+// minutes.
+const TokenRefreshInterval = 10 * time.Minute
+
 // Client defines EKS Client operations
 type Client interface {
 	CreateCluster(ctx context.Context, input *eks.CreateClusterInput, opts ...func(*eks.Options)) (*eks.CreateClusterOutput, error)
 	DescribeCluster(ctx context.Context, input *eks.DescribeClusterInput, opts ...func(*eks.Options)) (*eks.DescribeClusterOutput, error)
 	UpdateClusterConfig(ctx context.Context, input *eks.UpdateClusterConfigInput, opts ...func(*eks.Options)) (*eks.UpdateClusterConfigOutput, error)
+	AssociateEncryptionConfig(ctx context.Context, input *eks.AssociateEncryptionConfigInput, opts ...func(*eks.Options)) (*eks.AssociateEncryptionConfigOutput, error)
 	DeleteCluster(ctx context.Context, input *eks.DeleteClusterInput, opts ...func(*eks.Options)) (*eks.DeleteClusterOutput, error)
 	TagResource(ctx context.Context, input *eks.TagResourceInput, opts ...func(*eks.Options)) (*eks.TagResourceOutput, error)
 	UntagResource(ctx context.Context, input *eks.UntagResourceInput, opts ...func(*eks.Options)) (*eks.UntagResourceOutput, error)
@@ -70,10 +79,22 @@ type Client interface {
 	DescribeFargateProfile(ctx context.Context, input *eks.DescribeFargateProfileInput, opts ...func(*eks.Options)) (*eks.DescribeFargateProfileOutput, error)
 	CreateFargateProfile(ctx context.Context, input *eks.CreateFargateProfileInput, opts ...func(*eks.Options)) (*eks.CreateFargateProfileOutput, error)
 	DeleteFargateProfile(ctx context.Context, input *eks.DeleteFargateProfileInput, opts ...func(*eks.Options)) (*eks.DeleteFargateProfileOutput, error)
+	ListFargateProfiles(ctx context.Context, input *eks.ListFargateProfilesInput, opts ...func(*eks.Options)) (*eks.ListFargateProfilesOutput, error)
 
 	DescribeIdentityProviderConfig(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error)
 	AssociateIdentityProviderConfig(ctx context.Context, input *eks.AssociateIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.AssociateIdentityProviderConfigOutput, error)
 	DisassociateIdentityProviderConfig(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error)
+
+	DescribeUpdate(ctx context.Context, input *eks.DescribeUpdateInput, opts ...func(*eks.Options)) (*eks.DescribeUpdateOutput, error)
+
+	DescribeAccessEntry(ctx context.Context, input *eks.DescribeAccessEntryInput, opts ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error)
+	CreateAccessEntry(ctx context.Context, input *eks.CreateAccessEntryInput, opts ...func(*eks.Options)) (*eks.CreateAccessEntryOutput, error)
+	UpdateAccessEntry(ctx context.Context, input *eks.UpdateAccessEntryInput, opts ...func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error)
+	DeleteAccessEntry(ctx context.Context, input *eks.DeleteAccessEntryInput, opts ...func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error)
+
+	ListAssociatedAccessPolicies(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, opts ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error)
+	AssociateAccessPolicy(ctx context.Context, input *eks.AssociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error)
+	DisassociateAccessPolicy(ctx context.Context, input *eks.DisassociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.DisassociateAccessPolicyOutput, error)
 }
 
 // STSClient STS presigner
@@ -117,17 +138,7 @@ func GenerateCreateClusterInput(name string, p *v1beta1.ClusterParameters) *eks.
 		Version: p.Version,
 	}
 
-	if len(p.EncryptionConfig) > 0 {
-		c.EncryptionConfig = make([]ekstypes.EncryptionConfig, len(p.EncryptionConfig))
-		for i, conf := range p.EncryptionConfig {
-			c.EncryptionConfig[i] = ekstypes.EncryptionConfig{
-				Provider: &ekstypes.Provider{
-					KeyArn: awsclients.String(conf.Provider.KeyArn),
-				},
-				Resources: conf.Resources,
-			}
-		}
-	}
+	c.EncryptionConfig = generateEncryptionConfig(p.EncryptionConfig)
 
 	c.ResourcesVpcConfig = &ekstypes.VpcConfigRequest{
 		EndpointPrivateAccess: p.ResourcesVpcConfig.EndpointPrivateAccess,
@@ -158,12 +169,37 @@ func GenerateCreateClusterInput(name string, p *v1beta1.ClusterParameters) *eks.
 	return c
 }
 
+func generateEncryptionConfig(in []v1beta1.EncryptionConfig) []ekstypes.EncryptionConfig {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]ekstypes.EncryptionConfig, len(in))
+	for i, conf := range in {
+		out[i] = ekstypes.EncryptionConfig{
+			Provider: &ekstypes.Provider{
+				KeyArn: awsclients.String(conf.Provider.KeyArn),
+			},
+			Resources: conf.Resources,
+		}
+	}
+	return out
+}
+
 // CreatePatch creates a *v1beta1.ClusterParameters that has only the changed
 // values between the target *v1beta1.ClusterParameters and the current
 // *ekstypes.Cluster.
 func CreatePatch(in *ekstypes.Cluster, target *v1beta1.ClusterParameters) (*v1beta1.ClusterParameters, error) {
 	currentParams := &v1beta1.ClusterParameters{}
 	LateInitialize(currentParams, in)
+	// The OpenID Connect provider is an IAM resource that is not part of the
+	// cluster itself.
+	currentParams.OpenIDConnectProvider = target.OpenIDConnectProvider
+	// The kubeconfig only affects the connection details.
+	currentParams.Kubeconfig = target.Kubeconfig
+	// Logging and encryption are compared and updated separately since they
+	// have dedicated update calls.
+	currentParams.Logging = target.Logging
+	currentParams.EncryptionConfig = target.EncryptionConfig
 
 	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
 	if err != nil {
@@ -182,22 +218,6 @@ func GenerateUpdateClusterConfigInput(name string, p *v1beta1.ClusterParameters)
 		Name: awsclients.String(name),
 	}
 
-	if p.Logging != nil {
-		u.Logging = &ekstypes.Logging{
-			ClusterLogging: make([]ekstypes.LogSetup, len(p.Logging.ClusterLogging)),
-		}
-		for i, cl := range p.Logging.ClusterLogging {
-			types := make([]ekstypes.LogType, len(cl.Types))
-			for j, t := range cl.Types {
-				types[j] = ekstypes.LogType(t)
-			}
-			u.Logging.ClusterLogging[i] = ekstypes.LogSetup{
-				Enabled: cl.Enabled,
-				Types:   types,
-			}
-		}
-	}
-
 	// NOTE(muvaf): SecurityGroupIds and SubnetIds cannot be updated. They are
 	// included in VpcConfigRequest probably because it is used in Create call
 	// as well.
@@ -209,6 +229,86 @@ func GenerateUpdateClusterConfigInput(name string, p *v1beta1.ClusterParameters)
 	return u
 }
 
+// GenerateUpdateClusterLoggingInput returns the input to enable the log types
+// that are enabled in the supplied ClusterParameters and to disable all the
+// others. EKS does not allow updating the logging together with other
+// configuration.
+func GenerateUpdateClusterLoggingInput(name string, p *v1beta1.ClusterParameters) *eks.UpdateClusterConfigInput {
+	enabled := enabledLogTypes(p.Logging)
+	var enable, disable []ekstypes.LogType
+	for _, t := range ekstypes.LogType("").Values() {
+		if enabled[t] {
+			enable = append(enable, t)
+		} else {
+			disable = append(disable, t)
+		}
+	}
+	l := &ekstypes.Logging{}
+	if len(enable) > 0 {
+		l.ClusterLogging = append(l.ClusterLogging, ekstypes.LogSetup{Enabled: aws.Bool(true), Types: enable})
+	}
+	if len(disable) > 0 {
+		l.ClusterLogging = append(l.ClusterLogging, ekstypes.LogSetup{Enabled: aws.Bool(false), Types: disable})
+	}
+	return &eks.UpdateClusterConfigInput{
+		Name:    awsclients.String(name),
+		Logging: l,
+	}
+}
+
+// GenerateAssociateEncryptionConfigInput returns the input to enable the
+// encryption of an existing cluster.
+func GenerateAssociateEncryptionConfigInput(name string, p *v1beta1.ClusterParameters) *eks.AssociateEncryptionConfigInput {
+	return &eks.AssociateEncryptionConfigInput{
+		ClusterName:      awsclients.String(name),
+		EncryptionConfig: generateEncryptionConfig(p.EncryptionConfig),
+	}
+}
+
+// NeedsEncryptionConfig returns true if the encryption is desired but not yet
+// enabled for the cluster. Once enabled, the encryption configuration of a
+// cluster cannot be changed.
+func NeedsEncryptionConfig(p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster) bool {
+	return len(p.EncryptionConfig) > 0 && len(cluster.EncryptionConfig) == 0
+}
+
+// IsLoggingUpToDate checks whether exactly the desired log types are enabled.
+// Log types that are not enabled in the desired configuration are expected
+// to be disabled.
+func IsLoggingUpToDate(p *v1beta1.Logging, l *ekstypes.Logging) bool {
+	if p == nil {
+		return true
+	}
+	observed := map[ekstypes.LogType]bool{}
+	if l != nil {
+		for _, s := range l.ClusterLogging {
+			if !aws.ToBool(s.Enabled) {
+				continue
+			}
+			for _, t := range s.Types {
+				observed[t] = true
+			}
+		}
+	}
+	return cmp.Equal(enabledLogTypes(p), observed, cmpopts.EquateEmpty())
+}
+
+func enabledLogTypes(p *v1beta1.Logging) map[ekstypes.LogType]bool {
+	enabled := map[ekstypes.LogType]bool{}
+	if p == nil {
+		return enabled
+	}
+	for _, s := range p.ClusterLogging {
+		if !aws.ToBool(s.Enabled) {
+			continue
+		}
+		for _, t := range s.Types {
+			enabled[ekstypes.LogType(t)] = true
+		}
+	}
+	return enabled
+}
+
 // GenerateObservation is used to produce v1beta1.ClusterObservation from
 // ekstypes.Cluster.
 func GenerateObservation(cluster *ekstypes.Cluster) v1beta1.ClusterObservation { // nolint:gocyclo
@@ -334,6 +434,9 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster) (bool,
 			}
 		}
 	}
+	if !IsLoggingUpToDate(p.Logging, cluster.Logging) || NeedsEncryptionConfig(p, cluster) {
+		return false, nil
+	}
 	res := cmp.Equal(&v1beta1.ClusterParameters{}, patch, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
 		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region"),
@@ -342,30 +445,17 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster) (bool,
 }
 
 // GetConnectionDetails extracts managed.ConnectionDetails out of ekstypes.Cluster.
-func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient) managed.ConnectionDetails {
+func GetConnectionDetails(ctx context.Context, p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster, stsClient STSClient) managed.ConnectionDetails {
 	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
 		return managed.ConnectionDetails{}
 	}
 
-	getCallerIdentity, _ := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
-		func(po *sts.PresignOptions) {
-			po.ClientOptions = []func(*sts.Options){
-				sts.WithAPIOptions(
-					smithyhttp.AddHeaderValue(clusterIDHeader, *cluster.Name),
-					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
-				),
-			}
-		},
-	)
-
-	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
-	// EKS cluster resource. Signing the URL means that anyone in possession of
-	// this Kubeconfig will now be able to access the EKS cluster until this URL
-	// expires. This is necessary for other systems, such as core Crossplane, to
-	// be able to schedule workloads to the cluster for now, but is not the most
-	// secure way of accessing the cluster.
-	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-	token := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
+	var authInfo *clientcmdapi.AuthInfo
+	if p.Kubeconfig != nil && p.Kubeconfig.Authentication == v1beta1.KubeconfigAuthenticationExec {
+		authInfo = &clientcmdapi.AuthInfo{Exec: generateExecConfig(aws.ToString(p.Region), *cluster.Name, p.Kubeconfig.RoleARN)}
+	} else {
+		authInfo = &clientcmdapi.AuthInfo{Token: generateToken(ctx, *cluster.Name, stsClient)}
+	}
 
 	// NOTE(hasheddan): We must decode the CA data before constructing our
 	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
@@ -388,9 +478,7 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			*cluster.Name: {
-				Token: token,
-			},
+			*cluster.Name: authInfo,
 		},
 		CurrentContext: *cluster.Name,
 	}
@@ -405,3 +493,42 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 		xpv1.ResourceCredentialsSecretCAKey:         caData,
 	}
 }
+
+// generateToken returns a token to authenticate to the named cluster as the
+// caller of the supplied STS client.
+func generateToken(ctx context.Context, name string, stsClient STSClient) string {
+	getCallerIdentity, _ := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
+		func(po *sts.PresignOptions) {
+			po.ClientOptions = []func(*sts.Options){
+				sts.WithAPIOptions(
+					smithyhttp.AddHeaderValue(clusterIDHeader, name),
+					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
+				),
+			}
+		},
+	)
+
+	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
+	// EKS cluster resource. Signing the URL means that anyone in possession of
+	// this Kubeconfig will now be able to access the EKS cluster until this URL
+	// expires. This is necessary for other systems, such as core Crossplane, to
+	// be able to schedule workloads to the cluster for now, but is not the most
+	// secure way of accessing the cluster.
+	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+	return v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
+}
+
+// generateExecConfig returns the configuration of a kubeconfig that gets a
+// token to authenticate to the named cluster by running aws eks get-token.
+func generateExecConfig(region, name string, roleARN *string) *clientcmdapi.ExecConfig {
+	args := []string{"--region", region, "eks", "get-token", "--cluster-name", name}
+	if roleARN != nil {
+		args = append(args, "--role-arn", *roleARN)
+	}
+	return &clientcmdapi.ExecConfig{
+		APIVersion:  execAPIVersion,
+		Command:     execCommand,
+		Args:        args,
+		InstallHint: "The AWS CLI is required to authenticate to this cluster, see https://aws.amazon.com/cli/",
+	}
+}
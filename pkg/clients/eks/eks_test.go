@@ -17,20 +17,25 @@ limitations under the License.
 package eks
 
 import (
+	"context"
+	"encoding/base64"
 	"testing"
 	"time"
 
 	"github.com/aws/smithy-go/document"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
 )
 
 var (
@@ -285,16 +290,6 @@ func TestGenerateUpdateClusterInput(t *testing.T) {
 				},
 			},
 			want: &eks.UpdateClusterConfigInput{
-				Logging: &ekstypes.Logging{
-					ClusterLogging: []ekstypes.LogSetup{
-						{
-							Enabled: &falseVal,
-							Types: []ekstypes.LogType{
-								ekstypes.LogTypeApi,
-							},
-						},
-					},
-				},
 				Name: &clusterName,
 				ResourcesVpcConfig: &ekstypes.VpcConfigRequest{
 					EndpointPrivateAccess: &trueVal,
@@ -337,6 +332,136 @@ func TestGenerateUpdateClusterInput(t *testing.T) {
 	}
 }
 
+func TestGenerateUpdateClusterLoggingInput(t *testing.T) {
+	type args struct {
+		name string
+		p    *v1beta1.ClusterParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.UpdateClusterConfigInput
+	}{
+		"EnableSome": {
+			args: args{
+				name: clusterName,
+				p: &v1beta1.ClusterParameters{
+					Logging: &v1beta1.Logging{
+						ClusterLogging: []v1beta1.LogSetup{
+							{Enabled: &trueVal, Types: []v1beta1.LogType{v1beta1.LogTypeAudit, v1beta1.LogTypeAPI}},
+							{Enabled: &falseVal, Types: []v1beta1.LogType{v1beta1.LogTypeScheduler}},
+						},
+					},
+				},
+			},
+			want: &eks.UpdateClusterConfigInput{
+				Name: &clusterName,
+				Logging: &ekstypes.Logging{
+					ClusterLogging: []ekstypes.LogSetup{
+						{Enabled: &trueVal, Types: []ekstypes.LogType{ekstypes.LogTypeApi, ekstypes.LogTypeAudit}},
+						{Enabled: &falseVal, Types: []ekstypes.LogType{ekstypes.LogTypeAuthenticator, ekstypes.LogTypeControllerManager, ekstypes.LogTypeScheduler}},
+					},
+				},
+			},
+		},
+		"DisableAll": {
+			args: args{
+				name: clusterName,
+				p: &v1beta1.ClusterParameters{
+					Logging: &v1beta1.Logging{},
+				},
+			},
+			want: &eks.UpdateClusterConfigInput{
+				Name: &clusterName,
+				Logging: &ekstypes.Logging{
+					ClusterLogging: []ekstypes.LogSetup{
+						{Enabled: &falseVal, Types: []ekstypes.LogType{ekstypes.LogTypeApi, ekstypes.LogTypeAudit, ekstypes.LogTypeAuthenticator, ekstypes.LogTypeControllerManager, ekstypes.LogTypeScheduler}},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateClusterLoggingInput(tc.args.name, tc.args.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLoggingUpToDate(t *testing.T) {
+	type args struct {
+		p *v1beta1.Logging
+		l *ekstypes.Logging
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotSpecified": {
+			args: args{
+				l: &ekstypes.Logging{
+					ClusterLogging: []ekstypes.LogSetup{{Enabled: &trueVal, Types: []ekstypes.LogType{ekstypes.LogTypeApi}}},
+				},
+			},
+			want: true,
+		},
+		"SameEnabledTypes": {
+			args: args{
+				p: &v1beta1.Logging{
+					ClusterLogging: []v1beta1.LogSetup{{Enabled: &trueVal, Types: []v1beta1.LogType{v1beta1.LogTypeAudit, v1beta1.LogTypeAPI}}},
+				},
+				l: &ekstypes.Logging{
+					ClusterLogging: []ekstypes.LogSetup{
+						{Enabled: &trueVal, Types: []ekstypes.LogType{ekstypes.LogTypeApi, ekstypes.LogTypeAudit}},
+						{Enabled: &falseVal, Types: []ekstypes.LogType{ekstypes.LogTypeAuthenticator, ekstypes.LogTypeControllerManager, ekstypes.LogTypeScheduler}},
+					},
+				},
+			},
+			want: true,
+		},
+		"TypeDisabled": {
+			args: args{
+				p: &v1beta1.Logging{
+					ClusterLogging: []v1beta1.LogSetup{{Enabled: &trueVal, Types: []v1beta1.LogType{v1beta1.LogTypeAPI}}},
+				},
+				l: &ekstypes.Logging{
+					ClusterLogging: []ekstypes.LogSetup{
+						{Enabled: &trueVal, Types: []ekstypes.LogType{ekstypes.LogTypeApi, ekstypes.LogTypeAudit}},
+					},
+				},
+			},
+			want: false,
+		},
+		"TypeEnabled": {
+			args: args{
+				p: &v1beta1.Logging{
+					ClusterLogging: []v1beta1.LogSetup{{Enabled: &trueVal, Types: []v1beta1.LogType{v1beta1.LogTypeAPI}}},
+				},
+				l: &ekstypes.Logging{
+					ClusterLogging: []ekstypes.LogSetup{
+						{Enabled: &falseVal, Types: []ekstypes.LogType{ekstypes.LogTypeApi}},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLoggingUpToDate(tc.args.p, tc.args.l)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateObservation(t *testing.T) {
 	createTime := time.Now()
 	clusterArn := "my:arn"
@@ -565,6 +690,36 @@ func TestIsUpToDate(t *testing.T) {
 		args args
 		want bool
 	}{
+		"NeedsEncryption": {
+			args: args{
+				p: &v1beta1.ClusterParameters{
+					EncryptionConfig: []v1beta1.EncryptionConfig{{
+						Provider:  v1beta1.Provider{KeyArn: keyArn},
+						Resources: []string{"secrets"},
+					}},
+				},
+				cluster: &ekstypes.Cluster{},
+			},
+			want: false,
+		},
+		"IgnoresOpenIDConnectProvider": {
+			args: args{
+				p: &v1beta1.ClusterParameters{
+					OpenIDConnectProvider: &v1beta1.OpenIDConnectProviderConfig{ClientIDList: []string{"sts.amazonaws.com"}},
+				},
+				cluster: &ekstypes.Cluster{},
+			},
+			want: true,
+		},
+		"IgnoresKubeconfig": {
+			args: args{
+				p: &v1beta1.ClusterParameters{
+					Kubeconfig: &v1beta1.KubeconfigConfig{Authentication: v1beta1.KubeconfigAuthenticationExec},
+				},
+				cluster: &ekstypes.Cluster{},
+			},
+			want: true,
+		},
 		"SameFields": {
 			args: args{
 				p: &v1beta1.ClusterParameters{
@@ -821,3 +976,59 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateToken(t *testing.T) {
+	presignedURL := "https://sts.amazonaws.com/?Action=GetCallerIdentity"
+	stsClient := &fake.MockSTSClient{
+		MockPresignGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+			return &v4.PresignedHTTPRequest{URL: presignedURL}, nil
+		},
+	}
+	want := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURL))
+	if diff := cmp.Diff(want, generateToken(context.TODO(), clusterName, stsClient)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateExecConfig(t *testing.T) {
+	region := "us-east-1"
+
+	type args struct {
+		region  string
+		name    string
+		roleARN *string
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"NoRole": {
+			args: args{
+				region: region,
+				name:   clusterName,
+			},
+			want: []string{"--region", region, "eks", "get-token", "--cluster-name", clusterName},
+		},
+		"WithRole": {
+			args: args{
+				region:  region,
+				name:    clusterName,
+				roleARN: &roleArn,
+			},
+			want: []string{"--region", region, "eks", "get-token", "--cluster-name", clusterName, "--role-arn", roleArn},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateExecConfig(tc.args.region, tc.args.name, tc.args.roleARN)
+			if got.Command != execCommand || got.APIVersion != execAPIVersion {
+				t.Errorf("r: want %s (%s), got %s (%s)", execCommand, execAPIVersion, got.Command, got.APIVersion)
+			}
+			if diff := cmp.Diff(tc.want, got.Args); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
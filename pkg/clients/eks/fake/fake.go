@@ -26,13 +26,14 @@ import (
 
 // MockClient is a fake implementation of eks.Client.
 type MockClient struct {
-	MockCreateCluster        func(ctx context.Context, input *eks.CreateClusterInput, opts []func(*eks.Options)) (*eks.CreateClusterOutput, error)
-	MockDescribeCluster      func(ctx context.Context, input *eks.DescribeClusterInput, opts []func(*eks.Options)) (*eks.DescribeClusterOutput, error)
-	MockUpdateClusterConfig  func(ctx context.Context, input *eks.UpdateClusterConfigInput, opts []func(*eks.Options)) (*eks.UpdateClusterConfigOutput, error)
-	MockDeleteCluster        func(ctx context.Context, input *eks.DeleteClusterInput, opts []func(*eks.Options)) (*eks.DeleteClusterOutput, error)
-	MockTagResource          func(ctx context.Context, input *eks.TagResourceInput, opts []func(*eks.Options)) (*eks.TagResourceOutput, error)
-	MockUntagResource        func(ctx context.Context, input *eks.UntagResourceInput, opts []func(*eks.Options)) (*eks.UntagResourceOutput, error)
-	MockUpdateClusterVersion func(ctx context.Context, input *eks.UpdateClusterVersionInput, opts []func(*eks.Options)) (*eks.UpdateClusterVersionOutput, error)
+	MockCreateCluster             func(ctx context.Context, input *eks.CreateClusterInput, opts []func(*eks.Options)) (*eks.CreateClusterOutput, error)
+	MockDescribeCluster           func(ctx context.Context, input *eks.DescribeClusterInput, opts []func(*eks.Options)) (*eks.DescribeClusterOutput, error)
+	MockUpdateClusterConfig       func(ctx context.Context, input *eks.UpdateClusterConfigInput, opts []func(*eks.Options)) (*eks.UpdateClusterConfigOutput, error)
+	MockAssociateEncryptionConfig func(ctx context.Context, input *eks.AssociateEncryptionConfigInput, opts []func(*eks.Options)) (*eks.AssociateEncryptionConfigOutput, error)
+	MockDeleteCluster             func(ctx context.Context, input *eks.DeleteClusterInput, opts []func(*eks.Options)) (*eks.DeleteClusterOutput, error)
+	MockTagResource               func(ctx context.Context, input *eks.TagResourceInput, opts []func(*eks.Options)) (*eks.TagResourceOutput, error)
+	MockUntagResource             func(ctx context.Context, input *eks.UntagResourceInput, opts []func(*eks.Options)) (*eks.UntagResourceOutput, error)
+	MockUpdateClusterVersion      func(ctx context.Context, input *eks.UpdateClusterVersionInput, opts []func(*eks.Options)) (*eks.UpdateClusterVersionOutput, error)
 
 	MockDescribeNodegroup      func(ctx context.Context, input *eks.DescribeNodegroupInput, opts []func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
 	MockCreateNodegroup        func(ctx context.Context, input *eks.CreateNodegroupInput, opts []func(*eks.Options)) (*eks.CreateNodegroupOutput, error)
@@ -43,10 +44,22 @@ type MockClient struct {
 	MockDescribeFargateProfile func(ctx context.Context, input *eks.DescribeFargateProfileInput, opts []func(*eks.Options)) (*eks.DescribeFargateProfileOutput, error)
 	MockCreateFargateProfile   func(ctx context.Context, input *eks.CreateFargateProfileInput, opts []func(*eks.Options)) (*eks.CreateFargateProfileOutput, error)
 	MockDeleteFargateProfile   func(ctx context.Context, input *eks.DeleteFargateProfileInput, opts []func(*eks.Options)) (*eks.DeleteFargateProfileOutput, error)
+	MockListFargateProfiles    func(ctx context.Context, input *eks.ListFargateProfilesInput, opts []func(*eks.Options)) (*eks.ListFargateProfilesOutput, error)
 
 	MockDescribeIdentityProviderConfig     func(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error)
 	MockAssociateIdentityProviderConfig    func(ctx context.Context, input *eks.AssociateIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.AssociateIdentityProviderConfigOutput, error)
 	MockDisassociateIdentityProviderConfig func(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error)
+
+	MockDescribeUpdate func(ctx context.Context, input *eks.DescribeUpdateInput, opts []func(*eks.Options)) (*eks.DescribeUpdateOutput, error)
+
+	MockDescribeAccessEntry func(ctx context.Context, input *eks.DescribeAccessEntryInput, opts []func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error)
+	MockCreateAccessEntry   func(ctx context.Context, input *eks.CreateAccessEntryInput, opts []func(*eks.Options)) (*eks.CreateAccessEntryOutput, error)
+	MockUpdateAccessEntry   func(ctx context.Context, input *eks.UpdateAccessEntryInput, opts []func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error)
+	MockDeleteAccessEntry   func(ctx context.Context, input *eks.DeleteAccessEntryInput, opts []func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error)
+
+	MockListAssociatedAccessPolicies func(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, opts []func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error)
+	MockAssociateAccessPolicy        func(ctx context.Context, input *eks.AssociateAccessPolicyInput, opts []func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error)
+	MockDisassociateAccessPolicy     func(ctx context.Context, input *eks.DisassociateAccessPolicyInput, opts []func(*eks.Options)) (*eks.DisassociateAccessPolicyOutput, error)
 }
 
 // MockSTSClient mock sts client
@@ -76,6 +89,12 @@ func (c *MockClient) UpdateClusterConfig(ctx context.Context, input *eks.UpdateC
 	return c.MockUpdateClusterConfig(ctx, input, opts)
 }
 
+// AssociateEncryptionConfig calls the underlying
+// MockAssociateEncryptionConfig method.
+func (c *MockClient) AssociateEncryptionConfig(ctx context.Context, input *eks.AssociateEncryptionConfigInput, opts ...func(*eks.Options)) (*eks.AssociateEncryptionConfigOutput, error) {
+	return c.MockAssociateEncryptionConfig(ctx, input, opts)
+}
+
 // DeleteCluster calls the underlying MockDeleteCluster method.
 func (c *MockClient) DeleteCluster(ctx context.Context, input *eks.DeleteClusterInput, opts ...func(*eks.Options)) (*eks.DeleteClusterOutput, error) {
 	return c.MockDeleteCluster(ctx, input, opts)
@@ -145,6 +164,12 @@ func (c *MockClient) DeleteFargateProfile(ctx context.Context, input *eks.Delete
 	return c.MockDeleteFargateProfile(ctx, input, opts)
 }
 
+// ListFargateProfiles calls the underlying MockListFargateProfiles
+// method.
+func (c *MockClient) ListFargateProfiles(ctx context.Context, input *eks.ListFargateProfilesInput, opts ...func(*eks.Options)) (*eks.ListFargateProfilesOutput, error) {
+	return c.MockListFargateProfiles(ctx, input, opts)
+}
+
 // DescribeIdentityProviderConfig calls the underlying MockDescribeIdentityProviderConfig
 // method
 func (c *MockClient) DescribeIdentityProviderConfig(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error) {
@@ -162,3 +187,43 @@ func (c *MockClient) AssociateIdentityProviderConfig(ctx context.Context, input
 func (c *MockClient) DisassociateIdentityProviderConfig(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error) {
 	return c.MockDisassociateIdentityProviderConfig(ctx, input, opts)
 }
+
+// DescribeUpdate calls the underlying MockDescribeUpdate method.
+func (c *MockClient) DescribeUpdate(ctx context.Context, input *eks.DescribeUpdateInput, opts ...func(*eks.Options)) (*eks.DescribeUpdateOutput, error) {
+	return c.MockDescribeUpdate(ctx, input, opts)
+}
+
+// DescribeAccessEntry calls the underlying MockDescribeAccessEntry method.
+func (c *MockClient) DescribeAccessEntry(ctx context.Context, input *eks.DescribeAccessEntryInput, opts ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error) {
+	return c.MockDescribeAccessEntry(ctx, input, opts)
+}
+
+// CreateAccessEntry calls the underlying MockCreateAccessEntry method.
+func (c *MockClient) CreateAccessEntry(ctx context.Context, input *eks.CreateAccessEntryInput, opts ...func(*eks.Options)) (*eks.CreateAccessEntryOutput, error) {
+	return c.MockCreateAccessEntry(ctx, input, opts)
+}
+
+// UpdateAccessEntry calls the underlying MockUpdateAccessEntry method.
+func (c *MockClient) UpdateAccessEntry(ctx context.Context, input *eks.UpdateAccessEntryInput, opts ...func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error) {
+	return c.MockUpdateAccessEntry(ctx, input, opts)
+}
+
+// DeleteAccessEntry calls the underlying MockDeleteAccessEntry method.
+func (c *MockClient) DeleteAccessEntry(ctx context.Context, input *eks.DeleteAccessEntryInput, opts ...func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error) {
+	return c.MockDeleteAccessEntry(ctx, input, opts)
+}
+
+// ListAssociatedAccessPolicies calls the underlying MockListAssociatedAccessPolicies method.
+func (c *MockClient) ListAssociatedAccessPolicies(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, opts ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error) {
+	return c.MockListAssociatedAccessPolicies(ctx, input, opts)
+}
+
+// AssociateAccessPolicy calls the underlying MockAssociateAccessPolicy method.
+func (c *MockClient) AssociateAccessPolicy(ctx context.Context, input *eks.AssociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error) {
+	return c.MockAssociateAccessPolicy(ctx, input, opts)
+}
+
+// DisassociateAccessPolicy calls the underlying MockDisassociateAccessPolicy method.
+func (c *MockClient) DisassociateAccessPolicy(ctx context.Context, input *eks.DisassociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.DisassociateAccessPolicyOutput, error) {
+	return c.MockDisassociateAccessPolicy(ctx, input, opts)
+}
@@ -82,8 +82,35 @@ func LateInitializeFargateProfile(in *v1beta1.FargateProfileParameters, fp *ekst
 	}
 }
 
-// IsFargateProfileUpToDate checks whether there is a change in the tags.
-// Any other field is immutable and can't be updated.
+// IsFargateProfileUpToDate checks whether there is a change in the tags or in
+// any of the immutable fields.
 func IsFargateProfileUpToDate(p v1beta1.FargateProfileParameters, fp *ekstypes.FargateProfile) bool { // nolint:gocyclo
-	return cmp.Equal(p.Tags, fp.Tags, cmpopts.EquateEmpty())
+	return cmp.Equal(p.Tags, fp.Tags, cmpopts.EquateEmpty()) && len(GetFargateProfileImmutableFieldChanges(p, fp)) == 0
+}
+
+// GetFargateProfileImmutableFieldChanges returns the names of the fields in
+// FargateProfileParameters that differ from eks.FargateProfile but can't be
+// updated. Such changes can only be applied by recreating the profile.
+func GetFargateProfileImmutableFieldChanges(p v1beta1.FargateProfileParameters, fp *ekstypes.FargateProfile) []string {
+	var changed []string
+	if p.PodExecutionRoleArn != "" && p.PodExecutionRoleArn != awsclients.StringValue(fp.PodExecutionRoleArn) {
+		changed = append(changed, "podExecutionRoleArn")
+	}
+	if !cmp.Equal(p.Subnets, fp.Subnets, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+		changed = append(changed, "subnets")
+	}
+	observed := make([]v1beta1.FargateProfileSelector, len(fp.Selectors))
+	for i, sel := range fp.Selectors {
+		observed[i] = v1beta1.FargateProfileSelector{
+			Labels:    sel.Labels,
+			Namespace: sel.Namespace,
+		}
+	}
+	sortSelectors := cmpopts.SortSlices(func(a, b v1beta1.FargateProfileSelector) bool {
+		return awsclients.StringValue(a.Namespace) < awsclients.StringValue(b.Namespace)
+	})
+	if !cmp.Equal(p.Selectors, observed, cmpopts.EquateEmpty(), sortSelectors) {
+		changed = append(changed, "selectors")
+	}
+	return changed
 }
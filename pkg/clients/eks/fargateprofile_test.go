@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 
 	"github.com/aws/smithy-go/document"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -189,6 +190,17 @@ func TestIsFargateProfileUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"ImmutableFieldChanged": {
+			args: args{
+				p: v1beta1.FargateProfileParameters{
+					Subnets: []string{"subnet1", "subnet2"},
+				},
+				n: &ekstypes.FargateProfile{
+					Subnets: []string{"subnet1"},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -200,3 +212,64 @@ func TestIsFargateProfileUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFargateProfileImmutableFieldChanges(t *testing.T) {
+	type args struct {
+		p v1beta1.FargateProfileParameters
+		n *ekstypes.FargateProfile
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"NoChanges": {
+			args: args{
+				p: v1beta1.FargateProfileParameters{
+					PodExecutionRoleArn: "arn:role",
+					Subnets:             []string{"subnet2", "subnet1"},
+					Selectors: []v1beta1.FargateProfileSelector{
+						{Namespace: awsclients.String("kube-system")},
+						{Namespace: awsclients.String("default"), Labels: map[string]string{"app": "web"}},
+					},
+				},
+				n: &ekstypes.FargateProfile{
+					PodExecutionRoleArn: awsclients.String("arn:role"),
+					Subnets:             []string{"subnet1", "subnet2"},
+					Selectors: []ekstypes.FargateProfileSelector{
+						{Namespace: awsclients.String("default"), Labels: map[string]string{"app": "web"}},
+						{Namespace: awsclients.String("kube-system")},
+					},
+				},
+			},
+		},
+		"AllChanged": {
+			args: args{
+				p: v1beta1.FargateProfileParameters{
+					PodExecutionRoleArn: "arn:other-role",
+					Subnets:             []string{"subnet3"},
+					Selectors: []v1beta1.FargateProfileSelector{
+						{Namespace: awsclients.String("default"), Labels: map[string]string{"app": "api"}},
+					},
+				},
+				n: &ekstypes.FargateProfile{
+					PodExecutionRoleArn: awsclients.String("arn:role"),
+					Subnets:             []string{"subnet1", "subnet2"},
+					Selectors: []ekstypes.FargateProfileSelector{
+						{Namespace: awsclients.String("default"), Labels: map[string]string{"app": "web"}},
+					},
+				},
+			},
+			want: []string{"podExecutionRoleArn", "subnets", "selectors"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetFargateProfileImmutableFieldChanges(tc.args.p, tc.args.n)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package eks
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -65,15 +68,27 @@ func GenerateIdentityProviderConfigObservation(ip *types.IdentityProviderConfigR
 	if ip == nil {
 		return manualv1alpha1.IdentityProviderConfigObservation{}
 	}
-	o := manualv1alpha1.IdentityProviderConfigObservation{
-		Status: manualv1alpha1.IdentityProviderConfigStatusType(ip.Oidc.Status),
-	}
-	if ip.Oidc != nil && ip.Oidc.IdentityProviderConfigArn != nil {
-		o.IdentityProviderConfigArn = *ip.Oidc.IdentityProviderConfigArn
+	o := manualv1alpha1.IdentityProviderConfigObservation{}
+	if ip.Oidc != nil {
+		o.Status = manualv1alpha1.IdentityProviderConfigStatusType(ip.Oidc.Status)
+		o.IdentityProviderConfigArn = aws.ToString(ip.Oidc.IdentityProviderConfigArn)
 	}
 	return o
 }
 
+// GenerateUpdateErrorMessage joins the errors of a failed eks.Update, e.g. the
+// one that associates an identity provider config, into a single message.
+func GenerateUpdateErrorMessage(u *types.Update) string {
+	if u == nil {
+		return ""
+	}
+	msgs := make([]string, len(u.Errors))
+	for i, e := range u.Errors {
+		msgs[i] = fmt.Sprintf("%s: %s", e.ErrorCode, aws.ToString(e.ErrorMessage))
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // IsIdentityProviderConfigUpToDate checks whether there is a change in the tags.
 // Any other field is immutable and can't be updated.
 func IsIdentityProviderConfigUpToDate(p *manualv1alpha1.IdentityProviderConfigParameters, ip *types.IdentityProviderConfigResponse) bool { // nolint:gocyclo
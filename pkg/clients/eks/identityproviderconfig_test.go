@@ -154,6 +154,12 @@ func TestGenerateIdentityProviderConfigObservation(t *testing.T) {
 				IdentityProviderConfigArn: ipArn,
 			},
 		},
+		"NoOidc": {
+			args: args{
+				n: &types.IdentityProviderConfigResponse{},
+			},
+			want: manualv1alpha1.IdentityProviderConfigObservation{},
+		},
 	}
 
 	for name, tc := range cases {
@@ -17,6 +17,8 @@ limitations under the License.
 package eks
 
 import (
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -125,10 +127,82 @@ func GenerateUpdateNodeGroupConfigInput(name string, p *manualv1alpha1.NodeGroup
 			}
 		}
 	}
-	// TODO(muvaf): Add support for updating taints.
+	if add, remove := diffTaints(p.Taints, ng.Taints); len(add) > 0 || len(remove) > 0 {
+		u.Taints = &ekstypes.UpdateTaintsPayload{
+			AddOrUpdateTaints: add,
+			RemoveTaints:      remove,
+		}
+	}
+	return u
+}
+
+// GenerateUpdateNodeGroupVersionInput returns the input to roll the nodes of
+// the node group to the desired Kubernetes version, AMI release version or
+// launch template version. Only the fields that differ from the observed node
+// group are set so that EKS picks the matching defaults for the others.
+func GenerateUpdateNodeGroupVersionInput(name string, p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) *eks.UpdateNodegroupVersionInput {
+	u := &eks.UpdateNodegroupVersionInput{
+		NodegroupName: &name,
+		ClusterName:   &p.ClusterName,
+	}
+	if p.Version != nil && aws.ToString(p.Version) != aws.ToString(ng.Version) {
+		u.Version = p.Version
+	}
+	if rv := desiredReleaseVersion(p); rv != nil && aws.ToString(rv) != aws.ToString(ng.ReleaseVersion) {
+		u.ReleaseVersion = rv
+	}
+	if !isLaunchTemplateVersionUpToDate(p.LaunchTemplate, ng.LaunchTemplate) {
+		u.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{
+			Id:      p.LaunchTemplate.ID,
+			Name:    p.LaunchTemplate.Name,
+			Version: p.LaunchTemplate.Version,
+		}
+	}
 	return u
 }
 
+// diffTaints returns the taints that need to be added or updated and the ones
+// that need to be removed to go from the observed to the desired taints. A
+// taint is identified by its key and effect.
+func diffTaints(desired []manualv1alpha1.Taint, observed []ekstypes.Taint) (addOrUpdate []ekstypes.Taint, remove []ekstypes.Taint) {
+	type taintID struct {
+		key    string
+		effect string
+	}
+	current := make(map[taintID]string, len(observed))
+	for _, t := range observed {
+		current[taintID{key: aws.ToString(t.Key), effect: string(t.Effect)}] = aws.ToString(t.Value)
+	}
+	wanted := make(map[taintID]bool, len(desired))
+	for _, t := range desired {
+		id := taintID{key: aws.ToString(t.Key), effect: t.Effect}
+		wanted[id] = true
+		if v, ok := current[id]; ok && v == aws.ToString(t.Value) {
+			continue
+		}
+		addOrUpdate = append(addOrUpdate, ekstypes.Taint{
+			Effect: ekstypes.TaintEffect(t.Effect),
+			Key:    t.Key,
+			Value:  t.Value,
+		})
+	}
+	for _, t := range observed {
+		if !wanted[taintID{key: aws.ToString(t.Key), effect: string(t.Effect)}] {
+			remove = append(remove, ekstypes.Taint{Effect: t.Effect, Key: t.Key})
+		}
+	}
+	return addOrUpdate, remove
+}
+
+// isLaunchTemplateVersionUpToDate returns false only if a launch template
+// version is desired and the node group uses a different one.
+func isLaunchTemplateVersionUpToDate(p *manualv1alpha1.LaunchTemplateSpecification, lt *ekstypes.LaunchTemplateSpecification) bool {
+	if p == nil || p.Version == nil {
+		return true
+	}
+	return lt != nil && aws.ToString(p.Version) == aws.ToString(lt.Version)
+}
+
 // GenerateNodeGroupObservation is used to produce manualv1alpha1.NodeGroupObservation
 // from eks.Nodegroup.
 func GenerateNodeGroupObservation(ng *ekstypes.Nodegroup) manualv1alpha1.NodeGroupObservation { // nolint:gocyclo
@@ -136,8 +210,9 @@ func GenerateNodeGroupObservation(ng *ekstypes.Nodegroup) manualv1alpha1.NodeGro
 		return manualv1alpha1.NodeGroupObservation{}
 	}
 	o := manualv1alpha1.NodeGroupObservation{
-		NodeGroupArn: awsclient.StringValue(ng.NodegroupArn),
-		Status:       manualv1alpha1.NodeGroupStatusType(ng.Status),
+		NodeGroupArn:   awsclient.StringValue(ng.NodegroupArn),
+		ReleaseVersion: awsclient.StringValue(ng.ReleaseVersion),
+		Status:         manualv1alpha1.NodeGroupStatusType(ng.Status),
 	}
 	if ng.CreatedAt != nil {
 		o.CreatedAt = &metav1.Time{Time: *ng.CreatedAt}
@@ -206,7 +281,6 @@ func LateInitializeNodeGroup(in *manualv1alpha1.NodeGroupParameters, ng *ekstype
 			MaxSize:     ng.ScalingConfig.MaxSize,
 		}
 	}
-	in.ReleaseVersion = awsclient.LateInitializeStringPtr(in.ReleaseVersion, ng.ReleaseVersion)
 	in.Version = awsclient.LateInitializeStringPtr(in.Version, ng.Version)
 	// NOTE(hasheddan): we always will set the default Crossplane tags in
 	// practice during initialization in the controller, but we check if no tags
@@ -226,17 +300,52 @@ func LateInitializeNodeGroup(in *manualv1alpha1.NodeGroupParameters, ng *ekstype
 	}
 }
 
+// IsNodeGroupVersionUpToDate checks whether the nodes run the desired
+// Kubernetes version, AMI release version and launch template version.
+func IsNodeGroupVersionUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) bool {
+	if !cmp.Equal(p.Version, ng.Version) {
+		return false
+	}
+	// NOTE: the release version is not late initialized because EKS picks a
+	// new one whenever the Kubernetes version changes.
+	if rv := desiredReleaseVersion(p); rv != nil && aws.ToString(rv) != aws.ToString(ng.ReleaseVersion) {
+		return false
+	}
+	return isLaunchTemplateVersionUpToDate(p.LaunchTemplate, ng.LaunchTemplate)
+}
+
+// desiredReleaseVersion returns the AMI release version to run, if any. The
+// release versions of Amazon Linux AMIs start with the Kubernetes version they
+// are built for. Earlier versions of the provider late initialized the release
+// version, which then stays in the spec when the Kubernetes version is bumped,
+// so one that was built for another Kubernetes version is ignored.
+func desiredReleaseVersion(p *manualv1alpha1.NodeGroupParameters) *string {
+	if p.ReleaseVersion == nil || p.Version == nil {
+		return p.ReleaseVersion
+	}
+	if p.AMIType != nil && !strings.HasPrefix(*p.AMIType, "AL2") {
+		return p.ReleaseVersion
+	}
+	if !strings.HasPrefix(*p.ReleaseVersion, *p.Version+".") {
+		return nil
+	}
+	return p.ReleaseVersion
+}
+
 // IsNodeGroupUpToDate checks whether there is a change in any of the modifiable fields.
 func IsNodeGroupUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) bool { // nolint:gocyclo
 	if !cmp.Equal(p.Tags, ng.Tags, cmpopts.EquateEmpty()) {
 		return false
 	}
-	if !cmp.Equal(p.Version, ng.Version) {
+	if !IsNodeGroupVersionUpToDate(p, ng) {
 		return false
 	}
 	if !cmp.Equal(p.Labels, ng.Labels, cmpopts.EquateEmpty()) {
 		return false
 	}
+	if add, remove := diffTaints(p.Taints, ng.Taints); len(add) > 0 || len(remove) > 0 {
+		return false
+	}
 	if p.ScalingConfig == nil && ng.ScalingConfig == nil {
 		return true
 	}
@@ -363,6 +363,39 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 				},
 			},
 		},
+		"Taints": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Taints: []manualv1alpha1.Taint{
+						{Effect: "NO_SCHEDULE", Key: awsclients.String("keep"), Value: awsclients.String("v")},
+						{Effect: "NO_EXECUTE", Key: awsclients.String("change"), Value: awsclients.String("new")},
+						{Effect: "PREFER_NO_SCHEDULE", Key: awsclients.String("add")},
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Taints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("keep"), Value: awsclients.String("v")},
+						{Effect: ekstypes.TaintEffectNoExecute, Key: awsclients.String("change"), Value: awsclients.String("old")},
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("remove"), Value: awsclients.String("v")},
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupConfigInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Taints: &ekstypes.UpdateTaintsPayload{
+					AddOrUpdateTaints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoExecute, Key: awsclients.String("change"), Value: awsclients.String("new")},
+						{Effect: ekstypes.TaintEffectPreferNoSchedule, Key: awsclients.String("add")},
+					},
+					RemoveTaints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("remove")},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -375,6 +408,118 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 	}
 }
 
+func TestGenerateUpdateNodeGroupVersionInput(t *testing.T) {
+	otherVersion := "1.17"
+	releaseVersion := "1.16.15-20210501"
+	otherReleaseVersion := "1.16.15-20210601"
+
+	type args struct {
+		name string
+		p    *manualv1alpha1.NodeGroupParameters
+		n    *ekstypes.Nodegroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.UpdateNodegroupVersionInput
+	}{
+		"KubernetesVersion": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &otherVersion,
+				},
+				n: &ekstypes.Nodegroup{
+					Version:        &version,
+					ReleaseVersion: &releaseVersion,
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &otherVersion,
+			},
+		},
+		"ReleaseVersion": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName:    clusterName,
+					Version:        &version,
+					ReleaseVersion: &otherReleaseVersion,
+				},
+				n: &ekstypes.Nodegroup{
+					Version:        &version,
+					ReleaseVersion: &releaseVersion,
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:    &clusterName,
+				NodegroupName:  &ngName,
+				ReleaseVersion: &otherReleaseVersion,
+			},
+		},
+		"KubernetesVersionWithLateInitializedReleaseVersion": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName:    clusterName,
+					Version:        &otherVersion,
+					ReleaseVersion: &releaseVersion,
+				},
+				n: &ekstypes.Nodegroup{
+					Version:        &version,
+					ReleaseVersion: &releaseVersion,
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &otherVersion,
+			},
+		},
+		"LaunchTemplateVersion": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						Name:    awsclients.String("lt"),
+						Version: awsclients.String("2"),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Id:      awsclients.String("lt-123"),
+						Name:    awsclients.String("lt"),
+						Version: awsclients.String("1"),
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+					Name:    awsclients.String("lt"),
+					Version: awsclients.String("2"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateNodeGroupVersionInput(tc.args.name, tc.args.p, tc.args.n)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateUpdateNodeObservation(t *testing.T) {
 	ngArn := "cool:arn"
 	now := time.Now()
@@ -494,11 +639,10 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 				},
 			},
 			want: &manualv1alpha1.NodeGroupParameters{
-				AMIType:        &ami,
-				DiskSize:       &diskSize,
-				InstanceTypes:  []string{"cool-type"},
-				Labels:         map[string]string{"cool": "label"},
-				ReleaseVersion: &version,
+				AMIType:       &ami,
+				DiskSize:      &diskSize,
+				InstanceTypes: []string{"cool-type"},
+				Labels:        map[string]string{"cool": "label"},
 				RemoteAccess: &manualv1alpha1.RemoteAccessConfig{
 					EC2SSHKey:            &keyArn,
 					SourceSecurityGroups: []string{"cool-group"},
@@ -541,11 +685,10 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 				},
 			},
 			want: &manualv1alpha1.NodeGroupParameters{
-				AMIType:        &ami,
-				DiskSize:       &diskSize,
-				InstanceTypes:  []string{"cool-type"},
-				Labels:         map[string]string{"cool": "label"},
-				ReleaseVersion: &version,
+				AMIType:       &ami,
+				DiskSize:      &diskSize,
+				InstanceTypes: []string{"cool-type"},
+				Labels:        map[string]string{"cool": "label"},
 				RemoteAccess: &manualv1alpha1.RemoteAccessConfig{
 					EC2SSHKey:            &keyArn,
 					SourceSecurityGroups: []string{"cool-group"},
@@ -711,6 +854,82 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"UpdateTaints": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Version: &version,
+					Taints: []manualv1alpha1.Taint{
+						{Effect: "NO_SCHEDULE", Key: awsclients.String("key"), Value: awsclients.String("new")},
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					Taints: []ekstypes.Taint{
+						{Effect: ekstypes.TaintEffectNoSchedule, Key: awsclients.String("key"), Value: awsclients.String("old")},
+					},
+				},
+			},
+			want: false,
+		},
+		"UpdateReleaseVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Version:        &version,
+					ReleaseVersion: awsclients.String("1.16.15-20210601"),
+				},
+				n: &ekstypes.Nodegroup{
+					Version:        &version,
+					ReleaseVersion: awsclients.String("1.16.15-20210501"),
+				},
+			},
+			want: false,
+		},
+		"UpgradedWithLateInitializedReleaseVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Version:        &otherVersion,
+					ReleaseVersion: awsclients.String("1.16.15-20210501"),
+				},
+				n: &ekstypes.Nodegroup{
+					Version:        &otherVersion,
+					ReleaseVersion: awsclients.String("1.17.12-20210722"),
+				},
+			},
+			want: true,
+		},
+		"BottlerocketReleaseVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					AMIType:        awsclients.String("BOTTLEROCKET_x86_64"),
+					Version:        &otherVersion,
+					ReleaseVersion: awsclients.String("1.9.2-7d9b5ab0"),
+				},
+				n: &ekstypes.Nodegroup{
+					Version:        &otherVersion,
+					ReleaseVersion: awsclients.String("1.9.1-8a0e4b2c"),
+				},
+			},
+			want: false,
+		},
+		"UpdateLaunchTemplateVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Version: &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						ID:      awsclients.String("lt-123"),
+						Version: awsclients.String("2"),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Id:      awsclients.String("lt-123"),
+						Version: awsclients.String("1"),
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
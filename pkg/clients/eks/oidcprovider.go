@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+)
+
+// FindOIDCProviderARN returns the ARN of the IAM OpenID Connect provider of
+// the supplied issuer URL, or an empty string if there is none among the
+// supplied providers.
+func FindOIDCProviderARN(providers []iamtypes.OpenIDConnectProviderListEntry, issuer string) string {
+	// Provider ARNs end with the issuer URL without its scheme, e.g.
+	// arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE
+	suffix := ":oidc-provider/" + strings.TrimPrefix(issuer, "https://")
+	for _, p := range providers {
+		if strings.HasSuffix(aws.ToString(p.Arn), suffix) {
+			return aws.ToString(p.Arn)
+		}
+	}
+	return ""
+}
+
+// GenerateCreateOIDCProviderInput returns the input to create the IAM OpenID
+// Connect provider of the supplied issuer. The provider is tagged like the
+// cluster.
+func GenerateCreateOIDCProviderInput(issuer, thumbprint string, p *v1beta1.ClusterParameters) *iam.CreateOpenIDConnectProviderInput {
+	in := &iam.CreateOpenIDConnectProviderInput{
+		Url:            aws.String(issuer),
+		ThumbprintList: []string{thumbprint},
+	}
+	if p.OpenIDConnectProvider != nil {
+		in.ClientIDList = p.OpenIDConnectProvider.ClientIDList
+	}
+	keys := make([]string, 0, len(p.Tags))
+	for k := range p.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		in.Tags = append(in.Tags, iamtypes.Tag{Key: aws.String(k), Value: aws.String(p.Tags[k])})
+	}
+	return in
+}
+
+// IsOIDCProviderUpToDate checks whether the IAM OpenID Connect provider
+// accepts the desired client IDs.
+func IsOIDCProviderUpToDate(c *v1beta1.OpenIDConnectProviderConfig, o *iam.GetOpenIDConnectProviderOutput) bool {
+	return cmp.Equal(c.ClientIDList, o.ClientIDList, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
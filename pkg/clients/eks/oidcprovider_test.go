@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	oidcIssuer      = "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	oidcProviderARN = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+)
+
+func TestFindOIDCProviderARN(t *testing.T) {
+	type args struct {
+		providers []iamtypes.OpenIDConnectProviderListEntry
+		issuer    string
+	}
+
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"Found": {
+			args: args{
+				providers: []iamtypes.OpenIDConnectProviderListEntry{
+					{Arn: awsclients.String("arn:aws:iam::123456789012:oidc-provider/example.com")},
+					{Arn: &oidcProviderARN},
+				},
+				issuer: oidcIssuer,
+			},
+			want: oidcProviderARN,
+		},
+		"OtherClusterOfSameRegion": {
+			args: args{
+				providers: []iamtypes.OpenIDConnectProviderListEntry{
+					{Arn: awsclients.String("arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/OTHEREXAMPLE")},
+				},
+				issuer: oidcIssuer,
+			},
+			want: "",
+		},
+		"NoProviders": {
+			args: args{
+				issuer: oidcIssuer,
+			},
+			want: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindOIDCProviderARN(tc.args.providers, tc.args.issuer)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateOIDCProviderInput(t *testing.T) {
+	type args struct {
+		issuer     string
+		thumbprint string
+		p          *v1beta1.ClusterParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *iam.CreateOpenIDConnectProviderInput
+	}{
+		"ClientIDsAndTags": {
+			args: args{
+				issuer:     oidcIssuer,
+				thumbprint: "9e99a48a9960b14926bb7f3b02e22da2b0ab7280",
+				p: &v1beta1.ClusterParameters{
+					OpenIDConnectProvider: &v1beta1.OpenIDConnectProviderConfig{ClientIDList: []string{"sts.amazonaws.com"}},
+					Tags:                  map[string]string{"b": "2", "a": "1"},
+				},
+			},
+			want: &iam.CreateOpenIDConnectProviderInput{
+				Url:            &oidcIssuer,
+				ClientIDList:   []string{"sts.amazonaws.com"},
+				ThumbprintList: []string{"9e99a48a9960b14926bb7f3b02e22da2b0ab7280"},
+				Tags: []iamtypes.Tag{
+					{Key: awsclients.String("a"), Value: awsclients.String("1")},
+					{Key: awsclients.String("b"), Value: awsclients.String("2")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateOIDCProviderInput(tc.args.issuer, tc.args.thumbprint, tc.args.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(iam.CreateOpenIDConnectProviderInput{}, iamtypes.Tag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsOIDCProviderUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1beta1.OpenIDConnectProviderConfig
+		o    *iam.GetOpenIDConnectProviderOutput
+		want bool
+	}{
+		"UpToDate": {
+			c:    &v1beta1.OpenIDConnectProviderConfig{ClientIDList: []string{"b", "a"}},
+			o:    &iam.GetOpenIDConnectProviderOutput{ClientIDList: []string{"a", "b"}},
+			want: true,
+		},
+		"ClientIDChanged": {
+			c:    &v1beta1.OpenIDConnectProviderConfig{ClientIDList: []string{"sts.amazonaws.com"}},
+			o:    &iam.GetOpenIDConnectProviderOutput{ClientIDList: []string{"other"}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsOIDCProviderUpToDate(tc.c, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyFallbackProviderConfig is the annotation of a managed resource
+// that names the ProviderConfig used to observe it while the credentials of its
+// own ProviderConfig fail, e.g. while they are being rotated. The fallback is
+// only ever used to observe the resource, never to change it.
+const AnnotationKeyFallbackProviderConfig = "aws.crossplane.io/fallback-provider-config"
+
+// TypeReadOnly resources were last observed using their fallback
+// ProviderConfig. Changes to them are not applied until the credentials of
+// their own ProviderConfig work again.
+const TypeReadOnly xpv1.ConditionType = "ReadOnly"
+
+// Reasons a resource is, or is no longer, read-only.
+const (
+	ReasonFallbackProviderConfig xpv1.ConditionReason = "FallbackProviderConfig"
+	ReasonProviderConfig         xpv1.ConditionReason = "ProviderConfig"
+)
+
+const errReadOnly = "refusing to change the external resource using the fallback ProviderConfig"
+
+// ReadOnly returns a condition indicating that the resource was observed using
+// the named fallback ProviderConfig because of the supplied error.
+func ReadOnly(providerConfig string, cause error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReadOnly,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonFallbackProviderConfig,
+		Message:            "observed using ProviderConfig " + providerConfig + ": " + cause.Error(),
+	}
+}
+
+// Writable returns a condition indicating that the resource was observed using
+// its own ProviderConfig.
+func Writable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReadOnly,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonProviderConfig,
+	}
+}
+
+func fallbackProviderConfig(mg resource.Managed) string {
+	return mg.GetAnnotations()[AnnotationKeyFallbackProviderConfig]
+}
+
+// observe observes the supplied resource, using its fallback ProviderConfig if
+// its credentials could not be loaded or were rejected.
+func (e *wrappedExternal) observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if e.external == nil {
+		return e.observeWithFallback(ctx, mg, e.readOnly)
+	}
+	o, err := e.external.Observe(ctx, mg)
+	if !IsCredentialsError(err) || fallbackProviderConfig(mg) == "" {
+		if err == nil && mg.GetCondition(TypeReadOnly).Status == corev1.ConditionTrue {
+			mg.SetConditions(Writable())
+		}
+		return o, err
+	}
+	return e.observeWithFallback(ctx, mg, err)
+}
+
+// observeWithFallback observes a copy of the supplied resource that uses its
+// fallback ProviderConfig, and takes the status observed that way. The cause is
+// returned if the fallback fails too, since it is what needs fixing.
+func (e *wrappedExternal) observeWithFallback(ctx context.Context, mg resource.Managed, cause error) (managed.ExternalObservation, error) {
+	pc := fallbackProviderConfig(mg)
+	fb, ok := mg.DeepCopyObject().(resource.Managed)
+	if !ok {
+		return managed.ExternalObservation{}, cause
+	}
+	fb.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+	// The copy has the UID of the resource, so recording its usage of the
+	// fallback ProviderConfig would replace the usage of its own one.
+	ext, err := e.connecter.Connect(withoutUsageTracking(ctx), fb)
+	if err != nil {
+		return managed.ExternalObservation{}, cause
+	}
+	o, err := ext.Observe(ctx, fb)
+	if err != nil {
+		return managed.ExternalObservation{}, cause
+	}
+	// Only the status is taken. The spec of the copy may have been late
+	// initialized, but that is a change the resource does not need to see
+	// until it is observed using its own ProviderConfig.
+	copyStatus(mg, fb)
+	o.ResourceLateInitialized = false
+	setAWSError(mg, cause)
+	mg.SetConditions(ReadOnly(pc, cause))
+	e.readOnly = cause
+	return o, nil
+}
+
+// copyStatus copies the status of one managed resource to another of the same
+// type.
+func copyStatus(to, from resource.Managed) {
+	t := reflect.ValueOf(to).Elem().FieldByName("Status")
+	f := reflect.ValueOf(from).Elem().FieldByName("Status")
+	if !t.IsValid() || !f.IsValid() || t.Type() != f.Type() {
+		return
+	}
+	t.Set(f)
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+func TestFallbackObservation(t *testing.T) {
+	errExpired := &smithy.GenericAPIError{Code: "ExpiredToken", Message: "token expired"}
+	errNoSecret := credentialsError{errors.New("cannot get credentials secret")}
+	errNoProviderConfig := errors.New("cannot get referenced ProviderConfig")
+
+	// connecter connects using the ProviderConfig named fallback to a client
+	// that observes a VPC with the supplied state, and fails to connect, or
+	// observe, using any other ProviderConfig with the supplied errors. The
+	// usage of the fallback ProviderConfig must not be tracked.
+	connecter := func(connectErr, observeErr error) managed.ExternalConnecter {
+		return managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+			if mg.GetProviderConfigReference().Name == "fallback" {
+				if ctx.Value(untrackedKey{}) == nil {
+					return nil, errors.New("usage of the fallback ProviderConfig is tracked")
+				}
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+						mg.(*ec2v1beta1.VPC).Status.AtProvider.VPCState = "available"
+						return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+					},
+				}, nil
+			}
+			if connectErr != nil {
+				return nil, connectErr
+			}
+			return &managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, observeErr
+				},
+				UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+					return managed.ExternalUpdate{}, nil
+				},
+			}, nil
+		})
+	}
+
+	type args struct {
+		connecter  managed.ExternalConnecter
+		fallback   string
+		conditions []xpv1.Condition
+	}
+	type want struct {
+		connectErr error
+		observeErr error
+		updateErr  error
+		state      string
+		readOnly   xpv1.Condition
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ConnectFailed": {
+			args: args{
+				connecter: connecter(errNoSecret, nil),
+				fallback:  "fallback",
+			},
+			want: want{
+				updateErr: errors.Wrap(errNoSecret, errReadOnly),
+				state:     "available",
+				readOnly:  ReadOnly("fallback", errNoSecret),
+			},
+		},
+		"ConnectFailedOtherwise": {
+			args: args{
+				connecter: connecter(errNoProviderConfig, nil),
+				fallback:  "fallback",
+			},
+			want: want{
+				connectErr: errNoProviderConfig,
+				readOnly:   xpv1.Condition{Type: TypeReadOnly, Status: corev1.ConditionUnknown},
+			},
+		},
+		"CredentialsExpired": {
+			args: args{
+				connecter: connecter(nil, errExpired),
+				fallback:  "fallback",
+			},
+			want: want{
+				updateErr: errors.Wrap(errExpired, errReadOnly),
+				state:     "available",
+				readOnly:  ReadOnly("fallback", errExpired),
+			},
+		},
+		"NoFallback": {
+			args: args{
+				connecter: connecter(errNoSecret, nil),
+			},
+			want: want{
+				connectErr: errNoSecret,
+				readOnly:   xpv1.Condition{Type: TypeReadOnly, Status: corev1.ConditionUnknown},
+			},
+		},
+		"FallbackFailed": {
+			args: args{
+				connecter: connecter(nil, errExpired),
+				fallback:  "missing",
+			},
+			want: want{
+				observeErr: errExpired,
+				readOnly:   xpv1.Condition{Type: TypeReadOnly, Status: corev1.ConditionUnknown},
+			},
+		},
+		"CredentialsWorkAgain": {
+			args: args{
+				connecter:  connecter(nil, nil),
+				fallback:   "fallback",
+				conditions: []xpv1.Condition{ReadOnly("fallback", errExpired)},
+			},
+			want: want{
+				readOnly: Writable(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &ec2v1beta1.VPC{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{AnnotationKeyFallbackProviderConfig: tc.args.fallback},
+			}}
+			cr.SetProviderConfigReference(&xpv1.Reference{Name: "primary"})
+			cr.SetConditions(tc.args.conditions...)
+			c := WrapExternal(&test.MockClient{}, tc.args.connecter)
+
+			ext, err := c.Connect(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.connectErr, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("Connect(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			_, err = ext.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.observeErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if err == nil {
+				_, err = ext.Update(context.Background(), cr)
+				if diff := cmp.Diff(tc.want.updateErr, err, test.EquateErrors()); diff != "" {
+					t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.state, cr.Status.AtProvider.VPCState); diff != "" {
+				t.Errorf("state: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.readOnly, cr.GetCondition(TypeReadOnly), test.EquateConditions()); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff("primary", cr.GetProviderConfigReference().Name); diff != "" {
+				t.Errorf("providerConfigRef: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrackProviderConfigUsage(t *testing.T) {
+	cases := map[string]struct {
+		ctx  context.Context
+		want bool
+	}{
+		"Tracked": {
+			ctx:  context.Background(),
+			want: true,
+		},
+		"Untracked": {
+			ctx:  withoutUsageTracking(context.Background()),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &ec2v1beta1.VPC{}
+			cr.SetProviderConfigReference(&xpv1.Reference{Name: "primary"})
+			tracked := false
+			kube := &test.MockClient{MockGet: func(context.Context, client.ObjectKey, client.Object) error {
+				tracked = true
+				return errors.New("boom")
+			}}
+			_ = trackProviderConfigUsage(tc.ctx, kube, cr)
+			if diff := cmp.Diff(tc.want, tracked); diff != "" {
+				t.Errorf("tracked: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateAcceleratorInput returns the input for CreateAccelerator.
+func GenerateCreateAcceleratorInput(idempotencyToken string, p v1alpha1.AcceleratorParameters) *globalaccelerator.CreateAcceleratorInput {
+	return &globalaccelerator.CreateAcceleratorInput{
+		IdempotencyToken: aws.String(idempotencyToken),
+		Name:             aws.String(p.Name),
+		IpAddressType:    p.IPAddressType,
+		IpAddresses:      aws.StringSlice(p.IPAddresses),
+		Enabled:          p.Enabled,
+		Tags:             GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateAcceleratorInput returns the input for UpdateAccelerator.
+func GenerateUpdateAcceleratorInput(arn string, p v1alpha1.AcceleratorParameters) *globalaccelerator.UpdateAcceleratorInput {
+	return &globalaccelerator.UpdateAcceleratorInput{
+		AcceleratorArn: aws.String(arn),
+		Name:           aws.String(p.Name),
+		IpAddressType:  p.IPAddressType,
+		Enabled:        p.Enabled,
+	}
+}
+
+// GenerateAcceleratorObservation returns the observation of the given
+// accelerator.
+func GenerateAcceleratorObservation(a *globalaccelerator.Accelerator) v1alpha1.AcceleratorObservation {
+	o := v1alpha1.AcceleratorObservation{
+		AcceleratorARN:   aws.StringValue(a.AcceleratorArn),
+		DNSName:          aws.StringValue(a.DnsName),
+		DualStackDNSName: aws.StringValue(a.DualStackDnsName),
+		Status:           aws.StringValue(a.Status),
+	}
+	for _, s := range a.IpSets {
+		o.IPSets = append(o.IPSets, v1alpha1.IPSet{
+			IPAddressFamily: aws.StringValue(s.IpAddressFamily),
+			IPAddresses:     aws.StringValueSlice(s.IpAddresses),
+		})
+	}
+	return o
+}
+
+// LateInitializeAccelerator fills the empty fields of the given parameters
+// with the values Global Accelerator defaulted them to.
+func LateInitializeAccelerator(p *v1alpha1.AcceleratorParameters, a *globalaccelerator.Accelerator) {
+	p.IPAddressType = awsclient.LateInitializeStringPtr(p.IPAddressType, a.IpAddressType)
+	p.Enabled = awsclient.LateInitializeBoolPtr(p.Enabled, a.Enabled)
+}
+
+// IsAcceleratorUpToDate checks whether the name, the IP address type and
+// whether the accelerator is enabled are up to date. Tags are not compared.
+func IsAcceleratorUpToDate(p v1alpha1.AcceleratorParameters, a *globalaccelerator.Accelerator) bool {
+	return p.Name == aws.StringValue(a.Name) &&
+		(p.IPAddressType == nil || aws.StringValue(p.IPAddressType) == aws.StringValue(a.IpAddressType)) &&
+		(p.Enabled == nil || aws.BoolValue(p.Enabled) == aws.BoolValue(a.Enabled))
+}
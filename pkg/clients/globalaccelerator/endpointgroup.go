@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func generateEndpointConfigurations(c []v1alpha1.EndpointConfiguration) []*globalaccelerator.EndpointConfiguration {
+	res := make([]*globalaccelerator.EndpointConfiguration, len(c))
+	for i := range c {
+		res[i] = &globalaccelerator.EndpointConfiguration{
+			EndpointId:                  c[i].EndpointID,
+			Weight:                      c[i].Weight,
+			ClientIPPreservationEnabled: c[i].ClientIPPreservationEnabled,
+		}
+	}
+	return res
+}
+
+func generatePortOverrides(o []v1alpha1.PortOverride) []*globalaccelerator.PortOverride {
+	res := make([]*globalaccelerator.PortOverride, len(o))
+	for i := range o {
+		res[i] = &globalaccelerator.PortOverride{
+			ListenerPort: aws.Int64(o[i].ListenerPort),
+			EndpointPort: aws.Int64(o[i].EndpointPort),
+		}
+	}
+	return res
+}
+
+// GenerateCreateEndpointGroupInput returns the input for CreateEndpointGroup.
+func GenerateCreateEndpointGroupInput(idempotencyToken string, p v1alpha1.EndpointGroupParameters) *globalaccelerator.CreateEndpointGroupInput {
+	return &globalaccelerator.CreateEndpointGroupInput{
+		IdempotencyToken:           aws.String(idempotencyToken),
+		ListenerArn:                p.ListenerARN,
+		EndpointGroupRegion:        aws.String(p.EndpointGroupRegion),
+		EndpointConfigurations:     generateEndpointConfigurations(p.EndpointConfigurations),
+		TrafficDialPercentage:      p.TrafficDialPercentage,
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckProtocol:        p.HealthCheckProtocol,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		ThresholdCount:             p.ThresholdCount,
+		PortOverrides:              generatePortOverrides(p.PortOverrides),
+	}
+}
+
+// GenerateUpdateEndpointGroupInput returns the input for UpdateEndpointGroup.
+// Endpoints and port overrides that are omitted from an update are removed,
+// so the input always carries the complete desired state.
+func GenerateUpdateEndpointGroupInput(arn string, p v1alpha1.EndpointGroupParameters) *globalaccelerator.UpdateEndpointGroupInput {
+	return &globalaccelerator.UpdateEndpointGroupInput{
+		EndpointGroupArn:           aws.String(arn),
+		EndpointConfigurations:     generateEndpointConfigurations(p.EndpointConfigurations),
+		TrafficDialPercentage:      p.TrafficDialPercentage,
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckProtocol:        p.HealthCheckProtocol,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		ThresholdCount:             p.ThresholdCount,
+		PortOverrides:              generatePortOverrides(p.PortOverrides),
+	}
+}
+
+// GenerateEndpointGroupObservation returns the observation of the given
+// endpoint group.
+func GenerateEndpointGroupObservation(g *globalaccelerator.EndpointGroup) v1alpha1.EndpointGroupObservation {
+	o := v1alpha1.EndpointGroupObservation{
+		EndpointGroupARN: aws.StringValue(g.EndpointGroupArn),
+	}
+	for _, d := range g.EndpointDescriptions {
+		o.EndpointDescriptions = append(o.EndpointDescriptions, v1alpha1.EndpointDescription{
+			EndpointID:   aws.StringValue(d.EndpointId),
+			HealthState:  aws.StringValue(d.HealthState),
+			HealthReason: aws.StringValue(d.HealthReason),
+		})
+	}
+	return o
+}
+
+func endpointDescriptions(g *globalaccelerator.EndpointGroup) map[string]*globalaccelerator.EndpointDescription {
+	res := make(map[string]*globalaccelerator.EndpointDescription, len(g.EndpointDescriptions))
+	for _, d := range g.EndpointDescriptions {
+		res[aws.StringValue(d.EndpointId)] = d
+	}
+	return res
+}
+
+// LateInitializeEndpointGroup fills the empty fields of the given parameters
+// with the values Global Accelerator defaulted them to.
+func LateInitializeEndpointGroup(p *v1alpha1.EndpointGroupParameters, g *globalaccelerator.EndpointGroup) {
+	p.TrafficDialPercentage = awsclient.LateInitializeFloat64Ptr(p.TrafficDialPercentage, g.TrafficDialPercentage)
+	p.HealthCheckPort = awsclient.LateInitializeInt64Ptr(p.HealthCheckPort, g.HealthCheckPort)
+	p.HealthCheckProtocol = awsclient.LateInitializeStringPtr(p.HealthCheckProtocol, g.HealthCheckProtocol)
+	p.HealthCheckPath = awsclient.LateInitializeStringPtr(p.HealthCheckPath, g.HealthCheckPath)
+	p.HealthCheckIntervalSeconds = awsclient.LateInitializeInt64Ptr(p.HealthCheckIntervalSeconds, g.HealthCheckIntervalSeconds)
+	p.ThresholdCount = awsclient.LateInitializeInt64Ptr(p.ThresholdCount, g.ThresholdCount)
+
+	descriptions := endpointDescriptions(g)
+	for i := range p.EndpointConfigurations {
+		c := &p.EndpointConfigurations[i]
+		d, ok := descriptions[aws.StringValue(c.EndpointID)]
+		if !ok {
+			continue
+		}
+		c.Weight = awsclient.LateInitializeInt64Ptr(c.Weight, d.Weight)
+		c.ClientIPPreservationEnabled = awsclient.LateInitializeBoolPtr(c.ClientIPPreservationEnabled, d.ClientIPPreservationEnabled)
+	}
+}
+
+// IsEndpointGroupUpToDate checks whether the endpoints, the traffic dial, the
+// health check configuration and the port overrides of the endpoint group
+// are up to date.
+func IsEndpointGroupUpToDate(p v1alpha1.EndpointGroupParameters, g *globalaccelerator.EndpointGroup) bool { // nolint:gocyclo
+	if !(optionalFloat64Equal(p.TrafficDialPercentage, g.TrafficDialPercentage) &&
+		optionalInt64Equal(p.HealthCheckPort, g.HealthCheckPort) &&
+		optionalStringEqual(p.HealthCheckProtocol, g.HealthCheckProtocol) &&
+		optionalStringEqual(p.HealthCheckPath, g.HealthCheckPath) &&
+		optionalInt64Equal(p.HealthCheckIntervalSeconds, g.HealthCheckIntervalSeconds) &&
+		optionalInt64Equal(p.ThresholdCount, g.ThresholdCount)) {
+		return false
+	}
+
+	if len(p.EndpointConfigurations) != len(g.EndpointDescriptions) {
+		return false
+	}
+	descriptions := endpointDescriptions(g)
+	for _, c := range p.EndpointConfigurations {
+		d, ok := descriptions[aws.StringValue(c.EndpointID)]
+		if !ok ||
+			!optionalInt64Equal(c.Weight, d.Weight) ||
+			(c.ClientIPPreservationEnabled != nil && aws.BoolValue(c.ClientIPPreservationEnabled) != aws.BoolValue(d.ClientIPPreservationEnabled)) {
+			return false
+		}
+	}
+
+	if len(p.PortOverrides) != len(g.PortOverrides) {
+		return false
+	}
+	overrides := make(map[int64]int64, len(g.PortOverrides))
+	for _, o := range g.PortOverrides {
+		overrides[aws.Int64Value(o.ListenerPort)] = aws.Int64Value(o.EndpointPort)
+	}
+	for _, o := range p.PortOverrides {
+		if port, ok := overrides[o.ListenerPort]; !ok || port != o.EndpointPort {
+			return false
+		}
+	}
+	return true
+}
+
+// optionalStringEqual returns true if the desired value is unset or equal to
+// the observed one.
+func optionalStringEqual(desired, observed *string) bool {
+	return desired == nil || aws.StringValue(desired) == aws.StringValue(observed)
+}
+
+// optionalInt64Equal returns true if the desired value is unset or equal to
+// the observed one.
+func optionalInt64Equal(desired, observed *int64) bool {
+	return desired == nil || aws.Int64Value(desired) == aws.Int64Value(observed)
+}
+
+// optionalFloat64Equal returns true if the desired value is unset or equal to
+// the observed one.
+func optionalFloat64Equal(desired, observed *float64) bool {
+	return desired == nil || aws.Float64Value(desired) == aws.Float64Value(observed)
+}
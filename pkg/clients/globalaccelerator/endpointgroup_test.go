@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+)
+
+var (
+	loadBalancerARN = "arn:aws:elasticloadbalancing:eu-west-1:123456789012:loadbalancer/app/web/0000000000000001"
+	allocationID    = "eipalloc-00000000000000001"
+)
+
+func endpointGroupParameters(m ...func(*v1alpha1.EndpointGroupParameters)) v1alpha1.EndpointGroupParameters {
+	p := v1alpha1.EndpointGroupParameters{
+		EndpointGroupRegion: "eu-west-1",
+		EndpointConfigurations: []v1alpha1.EndpointConfiguration{
+			{EndpointID: aws.String(loadBalancerARN), Weight: aws.Int64(200)},
+			{EndpointID: aws.String(allocationID)},
+		},
+		PortOverrides: []v1alpha1.PortOverride{
+			{ListenerPort: 443, EndpointPort: 8443},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedEndpointGroup() *globalaccelerator.EndpointGroup {
+	return &globalaccelerator.EndpointGroup{
+		EndpointGroupRegion: aws.String("eu-west-1"),
+		EndpointDescriptions: []*globalaccelerator.EndpointDescription{
+			{
+				EndpointId:                  aws.String(allocationID),
+				Weight:                      aws.Int64(128),
+				ClientIPPreservationEnabled: aws.Bool(false),
+				HealthState:                 aws.String(globalaccelerator.HealthStateHealthy),
+			},
+			{
+				EndpointId:                  aws.String(loadBalancerARN),
+				Weight:                      aws.Int64(200),
+				ClientIPPreservationEnabled: aws.Bool(true),
+				HealthState:                 aws.String(globalaccelerator.HealthStateHealthy),
+			},
+		},
+		TrafficDialPercentage:      aws.Float64(100),
+		HealthCheckPort:            aws.Int64(443),
+		HealthCheckProtocol:        aws.String(globalaccelerator.HealthCheckProtocolTcp),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		ThresholdCount:             aws.Int64(3),
+		PortOverrides: []*globalaccelerator.PortOverride{
+			{ListenerPort: aws.Int64(443), EndpointPort: aws.Int64(8443)},
+		},
+	}
+}
+
+func TestLateInitializeEndpointGroup(t *testing.T) {
+	p := endpointGroupParameters()
+	LateInitializeEndpointGroup(&p, observedEndpointGroup())
+
+	want := endpointGroupParameters(func(p *v1alpha1.EndpointGroupParameters) {
+		p.EndpointConfigurations = []v1alpha1.EndpointConfiguration{
+			{EndpointID: aws.String(loadBalancerARN), Weight: aws.Int64(200), ClientIPPreservationEnabled: aws.Bool(true)},
+			{EndpointID: aws.String(allocationID), Weight: aws.Int64(128), ClientIPPreservationEnabled: aws.Bool(false)},
+		}
+		p.TrafficDialPercentage = aws.Float64(100)
+		p.HealthCheckPort = aws.Int64(443)
+		p.HealthCheckProtocol = aws.String(globalaccelerator.HealthCheckProtocolTcp)
+		p.HealthCheckIntervalSeconds = aws.Int64(30)
+		p.ThresholdCount = aws.Int64(3)
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsEndpointGroupUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.EndpointGroupParameters
+		want bool
+	}{
+		"UpToDateAfterLateInitialization": {
+			p:    endpointGroupParameters(),
+			want: true,
+		},
+		"TrafficDialChanged": {
+			p: endpointGroupParameters(func(p *v1alpha1.EndpointGroupParameters) {
+				p.TrafficDialPercentage = aws.Float64(50)
+			}),
+			want: false,
+		},
+		"WeightChanged": {
+			p: endpointGroupParameters(func(p *v1alpha1.EndpointGroupParameters) {
+				p.EndpointConfigurations[0].Weight = aws.Int64(100)
+			}),
+			want: false,
+		},
+		"EndpointRemoved": {
+			p: endpointGroupParameters(func(p *v1alpha1.EndpointGroupParameters) {
+				p.EndpointConfigurations = p.EndpointConfigurations[:1]
+			}),
+			want: false,
+		},
+		"EndpointReplaced": {
+			p: endpointGroupParameters(func(p *v1alpha1.EndpointGroupParameters) {
+				p.EndpointConfigurations[1].EndpointID = aws.String("eipalloc-00000000000000002")
+			}),
+			want: false,
+		},
+		"PortOverrideChanged": {
+			p: endpointGroupParameters(func(p *v1alpha1.EndpointGroupParameters) {
+				p.PortOverrides[0].EndpointPort = 9443
+			}),
+			want: false,
+		},
+		"HealthCheckPathChanged": {
+			p: endpointGroupParameters(func(p *v1alpha1.EndpointGroupParameters) {
+				p.HealthCheckPath = aws.String("/healthz")
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := observedEndpointGroup()
+			LateInitializeEndpointGroup(&tc.p, observed)
+			got := IsEndpointGroupUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
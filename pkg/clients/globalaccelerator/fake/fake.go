@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateAcceleratorWithContext     func(ctx context.Context, input *globalaccelerator.CreateAcceleratorInput, opts []request.Option) (*globalaccelerator.CreateAcceleratorOutput, error)
+	MockDescribeAcceleratorWithContext   func(ctx context.Context, input *globalaccelerator.DescribeAcceleratorInput, opts []request.Option) (*globalaccelerator.DescribeAcceleratorOutput, error)
+	MockUpdateAcceleratorWithContext     func(ctx context.Context, input *globalaccelerator.UpdateAcceleratorInput, opts []request.Option) (*globalaccelerator.UpdateAcceleratorOutput, error)
+	MockDeleteAcceleratorWithContext     func(ctx context.Context, input *globalaccelerator.DeleteAcceleratorInput, opts []request.Option) (*globalaccelerator.DeleteAcceleratorOutput, error)
+	MockCreateListenerWithContext        func(ctx context.Context, input *globalaccelerator.CreateListenerInput, opts []request.Option) (*globalaccelerator.CreateListenerOutput, error)
+	MockDescribeListenerWithContext      func(ctx context.Context, input *globalaccelerator.DescribeListenerInput, opts []request.Option) (*globalaccelerator.DescribeListenerOutput, error)
+	MockUpdateListenerWithContext        func(ctx context.Context, input *globalaccelerator.UpdateListenerInput, opts []request.Option) (*globalaccelerator.UpdateListenerOutput, error)
+	MockDeleteListenerWithContext        func(ctx context.Context, input *globalaccelerator.DeleteListenerInput, opts []request.Option) (*globalaccelerator.DeleteListenerOutput, error)
+	MockCreateEndpointGroupWithContext   func(ctx context.Context, input *globalaccelerator.CreateEndpointGroupInput, opts []request.Option) (*globalaccelerator.CreateEndpointGroupOutput, error)
+	MockDescribeEndpointGroupWithContext func(ctx context.Context, input *globalaccelerator.DescribeEndpointGroupInput, opts []request.Option) (*globalaccelerator.DescribeEndpointGroupOutput, error)
+	MockUpdateEndpointGroupWithContext   func(ctx context.Context, input *globalaccelerator.UpdateEndpointGroupInput, opts []request.Option) (*globalaccelerator.UpdateEndpointGroupOutput, error)
+	MockDeleteEndpointGroupWithContext   func(ctx context.Context, input *globalaccelerator.DeleteEndpointGroupInput, opts []request.Option) (*globalaccelerator.DeleteEndpointGroupOutput, error)
+	MockListTagsForResourceWithContext   func(ctx context.Context, input *globalaccelerator.ListTagsForResourceInput, opts []request.Option) (*globalaccelerator.ListTagsForResourceOutput, error)
+	MockTagResourceWithContext           func(ctx context.Context, input *globalaccelerator.TagResourceInput, opts []request.Option) (*globalaccelerator.TagResourceOutput, error)
+	MockUntagResourceWithContext         func(ctx context.Context, input *globalaccelerator.UntagResourceInput, opts []request.Option) (*globalaccelerator.UntagResourceOutput, error)
+}
+
+// CreateAcceleratorWithContext mocks CreateAcceleratorWithContext method
+func (m *MockClient) CreateAcceleratorWithContext(ctx context.Context, input *globalaccelerator.CreateAcceleratorInput, opts ...request.Option) (*globalaccelerator.CreateAcceleratorOutput, error) {
+	return m.MockCreateAcceleratorWithContext(ctx, input, opts)
+}
+
+// DescribeAcceleratorWithContext mocks DescribeAcceleratorWithContext method
+func (m *MockClient) DescribeAcceleratorWithContext(ctx context.Context, input *globalaccelerator.DescribeAcceleratorInput, opts ...request.Option) (*globalaccelerator.DescribeAcceleratorOutput, error) {
+	return m.MockDescribeAcceleratorWithContext(ctx, input, opts)
+}
+
+// UpdateAcceleratorWithContext mocks UpdateAcceleratorWithContext method
+func (m *MockClient) UpdateAcceleratorWithContext(ctx context.Context, input *globalaccelerator.UpdateAcceleratorInput, opts ...request.Option) (*globalaccelerator.UpdateAcceleratorOutput, error) {
+	return m.MockUpdateAcceleratorWithContext(ctx, input, opts)
+}
+
+// DeleteAcceleratorWithContext mocks DeleteAcceleratorWithContext method
+func (m *MockClient) DeleteAcceleratorWithContext(ctx context.Context, input *globalaccelerator.DeleteAcceleratorInput, opts ...request.Option) (*globalaccelerator.DeleteAcceleratorOutput, error) {
+	return m.MockDeleteAcceleratorWithContext(ctx, input, opts)
+}
+
+// CreateListenerWithContext mocks CreateListenerWithContext method
+func (m *MockClient) CreateListenerWithContext(ctx context.Context, input *globalaccelerator.CreateListenerInput, opts ...request.Option) (*globalaccelerator.CreateListenerOutput, error) {
+	return m.MockCreateListenerWithContext(ctx, input, opts)
+}
+
+// DescribeListenerWithContext mocks DescribeListenerWithContext method
+func (m *MockClient) DescribeListenerWithContext(ctx context.Context, input *globalaccelerator.DescribeListenerInput, opts ...request.Option) (*globalaccelerator.DescribeListenerOutput, error) {
+	return m.MockDescribeListenerWithContext(ctx, input, opts)
+}
+
+// UpdateListenerWithContext mocks UpdateListenerWithContext method
+func (m *MockClient) UpdateListenerWithContext(ctx context.Context, input *globalaccelerator.UpdateListenerInput, opts ...request.Option) (*globalaccelerator.UpdateListenerOutput, error) {
+	return m.MockUpdateListenerWithContext(ctx, input, opts)
+}
+
+// DeleteListenerWithContext mocks DeleteListenerWithContext method
+func (m *MockClient) DeleteListenerWithContext(ctx context.Context, input *globalaccelerator.DeleteListenerInput, opts ...request.Option) (*globalaccelerator.DeleteListenerOutput, error) {
+	return m.MockDeleteListenerWithContext(ctx, input, opts)
+}
+
+// CreateEndpointGroupWithContext mocks CreateEndpointGroupWithContext method
+func (m *MockClient) CreateEndpointGroupWithContext(ctx context.Context, input *globalaccelerator.CreateEndpointGroupInput, opts ...request.Option) (*globalaccelerator.CreateEndpointGroupOutput, error) {
+	return m.MockCreateEndpointGroupWithContext(ctx, input, opts)
+}
+
+// DescribeEndpointGroupWithContext mocks DescribeEndpointGroupWithContext method
+func (m *MockClient) DescribeEndpointGroupWithContext(ctx context.Context, input *globalaccelerator.DescribeEndpointGroupInput, opts ...request.Option) (*globalaccelerator.DescribeEndpointGroupOutput, error) {
+	return m.MockDescribeEndpointGroupWithContext(ctx, input, opts)
+}
+
+// UpdateEndpointGroupWithContext mocks UpdateEndpointGroupWithContext method
+func (m *MockClient) UpdateEndpointGroupWithContext(ctx context.Context, input *globalaccelerator.UpdateEndpointGroupInput, opts ...request.Option) (*globalaccelerator.UpdateEndpointGroupOutput, error) {
+	return m.MockUpdateEndpointGroupWithContext(ctx, input, opts)
+}
+
+// DeleteEndpointGroupWithContext mocks DeleteEndpointGroupWithContext method
+func (m *MockClient) DeleteEndpointGroupWithContext(ctx context.Context, input *globalaccelerator.DeleteEndpointGroupInput, opts ...request.Option) (*globalaccelerator.DeleteEndpointGroupOutput, error) {
+	return m.MockDeleteEndpointGroupWithContext(ctx, input, opts)
+}
+
+// ListTagsForResourceWithContext mocks ListTagsForResourceWithContext method
+func (m *MockClient) ListTagsForResourceWithContext(ctx context.Context, input *globalaccelerator.ListTagsForResourceInput, opts ...request.Option) (*globalaccelerator.ListTagsForResourceOutput, error) {
+	return m.MockListTagsForResourceWithContext(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *globalaccelerator.TagResourceInput, opts ...request.Option) (*globalaccelerator.TagResourceOutput, error) {
+	return m.MockTagResourceWithContext(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *globalaccelerator.UntagResourceInput, opts ...request.Option) (*globalaccelerator.UntagResourceOutput, error) {
+	return m.MockUntagResourceWithContext(ctx, input, opts)
+}
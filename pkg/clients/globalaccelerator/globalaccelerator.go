@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Region is the region Global Accelerator requests have to be sent to.
+const Region = "us-west-2"
+
+const (
+	errListTags      = "cannot list tags of Global Accelerator resource"
+	errTagResource   = "cannot tag Global Accelerator resource"
+	errUntagResource = "cannot untag Global Accelerator resource"
+)
+
+// Client defines Global Accelerator Client operations
+type Client interface {
+	CreateAcceleratorWithContext(ctx context.Context, input *globalaccelerator.CreateAcceleratorInput, opts ...request.Option) (*globalaccelerator.CreateAcceleratorOutput, error)
+	DescribeAcceleratorWithContext(ctx context.Context, input *globalaccelerator.DescribeAcceleratorInput, opts ...request.Option) (*globalaccelerator.DescribeAcceleratorOutput, error)
+	UpdateAcceleratorWithContext(ctx context.Context, input *globalaccelerator.UpdateAcceleratorInput, opts ...request.Option) (*globalaccelerator.UpdateAcceleratorOutput, error)
+	DeleteAcceleratorWithContext(ctx context.Context, input *globalaccelerator.DeleteAcceleratorInput, opts ...request.Option) (*globalaccelerator.DeleteAcceleratorOutput, error)
+	CreateListenerWithContext(ctx context.Context, input *globalaccelerator.CreateListenerInput, opts ...request.Option) (*globalaccelerator.CreateListenerOutput, error)
+	DescribeListenerWithContext(ctx context.Context, input *globalaccelerator.DescribeListenerInput, opts ...request.Option) (*globalaccelerator.DescribeListenerOutput, error)
+	UpdateListenerWithContext(ctx context.Context, input *globalaccelerator.UpdateListenerInput, opts ...request.Option) (*globalaccelerator.UpdateListenerOutput, error)
+	DeleteListenerWithContext(ctx context.Context, input *globalaccelerator.DeleteListenerInput, opts ...request.Option) (*globalaccelerator.DeleteListenerOutput, error)
+	CreateEndpointGroupWithContext(ctx context.Context, input *globalaccelerator.CreateEndpointGroupInput, opts ...request.Option) (*globalaccelerator.CreateEndpointGroupOutput, error)
+	DescribeEndpointGroupWithContext(ctx context.Context, input *globalaccelerator.DescribeEndpointGroupInput, opts ...request.Option) (*globalaccelerator.DescribeEndpointGroupOutput, error)
+	UpdateEndpointGroupWithContext(ctx context.Context, input *globalaccelerator.UpdateEndpointGroupInput, opts ...request.Option) (*globalaccelerator.UpdateEndpointGroupOutput, error)
+	DeleteEndpointGroupWithContext(ctx context.Context, input *globalaccelerator.DeleteEndpointGroupInput, opts ...request.Option) (*globalaccelerator.DeleteEndpointGroupOutput, error)
+	ListTagsForResourceWithContext(ctx context.Context, input *globalaccelerator.ListTagsForResourceInput, opts ...request.Option) (*globalaccelerator.ListTagsForResourceOutput, error)
+	TagResourceWithContext(ctx context.Context, input *globalaccelerator.TagResourceInput, opts ...request.Option) (*globalaccelerator.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *globalaccelerator.UntagResourceInput, opts ...request.Option) (*globalaccelerator.UntagResourceOutput, error)
+}
+
+// NewClient returns a new Global Accelerator client using the given session.
+func NewClient(sess *session.Session) Client {
+	return globalaccelerator.New(sess)
+}
+
+// IsNotFound returns true if the error is because the accelerator, listener
+// or endpoint group doesn't exist.
+func IsNotFound(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	switch code {
+	case globalaccelerator.ErrCodeAcceleratorNotFoundException,
+		globalaccelerator.ErrCodeListenerNotFoundException,
+		globalaccelerator.ErrCodeEndpointGroupNotFoundException:
+		return true
+	}
+	return false
+}
+
+// GenerateTags converts the given tag map to Global Accelerator tags, sorted
+// by key.
+func GenerateTags(tags map[string]string) []*globalaccelerator.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	res := make([]*globalaccelerator.Tag, len(keys))
+	for i, k := range keys {
+		res[i] = &globalaccelerator.Tag{Key: aws.String(k), Value: aws.String(tags[k])}
+	}
+	return res
+}
+
+// ListTags returns the tags of the resource with the given ARN.
+func ListTags(ctx context.Context, client Client, arn string) (map[string]string, error) {
+	resp, err := client.ListTagsForResourceWithContext(ctx, &globalaccelerator.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errListTags)
+	}
+	if len(resp.Tags) == 0 {
+		return nil, nil
+	}
+	res := make(map[string]string, len(resp.Tags))
+	for _, t := range resp.Tags {
+		res[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return res, nil
+}
+
+// UpdateTags makes the tags of the resource with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, client Client, arn string, desired, observed map[string]string) error {
+	add, remove := awsclient.DiffTags(desired, observed)
+	if len(remove) > 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &globalaccelerator.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     aws.StringSlice(remove),
+		}); err != nil {
+			return awsclient.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResourceWithContext(ctx, &globalaccelerator.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        GenerateTags(add),
+		}); err != nil {
+			return awsclient.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func generatePortRanges(r []v1alpha1.PortRange) []*globalaccelerator.PortRange {
+	res := make([]*globalaccelerator.PortRange, len(r))
+	for i := range r {
+		res[i] = &globalaccelerator.PortRange{
+			FromPort: aws.Int64(r[i].FromPort),
+			ToPort:   aws.Int64(r[i].ToPort),
+		}
+	}
+	return res
+}
+
+// GenerateCreateListenerInput returns the input for CreateListener.
+func GenerateCreateListenerInput(idempotencyToken string, p v1alpha1.ListenerParameters) *globalaccelerator.CreateListenerInput {
+	return &globalaccelerator.CreateListenerInput{
+		IdempotencyToken: aws.String(idempotencyToken),
+		AcceleratorArn:   p.AcceleratorARN,
+		PortRanges:       generatePortRanges(p.PortRanges),
+		Protocol:         aws.String(p.Protocol),
+		ClientAffinity:   p.ClientAffinity,
+	}
+}
+
+// GenerateUpdateListenerInput returns the input for UpdateListener.
+func GenerateUpdateListenerInput(arn string, p v1alpha1.ListenerParameters) *globalaccelerator.UpdateListenerInput {
+	return &globalaccelerator.UpdateListenerInput{
+		ListenerArn:    aws.String(arn),
+		PortRanges:     generatePortRanges(p.PortRanges),
+		Protocol:       aws.String(p.Protocol),
+		ClientAffinity: p.ClientAffinity,
+	}
+}
+
+// LateInitializeListener fills the empty fields of the given parameters with
+// the values Global Accelerator defaulted them to.
+func LateInitializeListener(p *v1alpha1.ListenerParameters, l *globalaccelerator.Listener) {
+	p.ClientAffinity = awsclient.LateInitializeStringPtr(p.ClientAffinity, l.ClientAffinity)
+}
+
+// IsListenerUpToDate checks whether the port ranges, the protocol and the
+// client affinity of the listener are up to date.
+func IsListenerUpToDate(p v1alpha1.ListenerParameters, l *globalaccelerator.Listener) bool {
+	if p.Protocol != aws.StringValue(l.Protocol) ||
+		(p.ClientAffinity != nil && aws.StringValue(p.ClientAffinity) != aws.StringValue(l.ClientAffinity)) ||
+		len(p.PortRanges) != len(l.PortRanges) {
+		return false
+	}
+	// Port ranges are not returned in any particular order.
+	observed := make([]v1alpha1.PortRange, len(l.PortRanges))
+	for i, r := range l.PortRanges {
+		observed[i] = v1alpha1.PortRange{FromPort: aws.Int64Value(r.FromPort), ToPort: aws.Int64Value(r.ToPort)}
+	}
+	desired := append([]v1alpha1.PortRange{}, p.PortRanges...)
+	for _, r := range [][]v1alpha1.PortRange{observed, desired} {
+		r := r
+		sort.Slice(r, func(i, j int) bool {
+			return r[i].FromPort < r[j].FromPort || (r[i].FromPort == r[j].FromPort && r[i].ToPort < r[j].ToPort)
+		})
+	}
+	for i := range desired {
+		if desired[i] != observed[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalaccelerator
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+)
+
+func listenerParameters(m ...func(*v1alpha1.ListenerParameters)) v1alpha1.ListenerParameters {
+	p := v1alpha1.ListenerParameters{
+		PortRanges: []v1alpha1.PortRange{
+			{FromPort: 80, ToPort: 80},
+			{FromPort: 443, ToPort: 443},
+		},
+		Protocol: globalaccelerator.ProtocolTcp,
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedListener() *globalaccelerator.Listener {
+	return &globalaccelerator.Listener{
+		PortRanges: []*globalaccelerator.PortRange{
+			{FromPort: aws.Int64(443), ToPort: aws.Int64(443)},
+			{FromPort: aws.Int64(80), ToPort: aws.Int64(80)},
+		},
+		Protocol:       aws.String(globalaccelerator.ProtocolTcp),
+		ClientAffinity: aws.String(globalaccelerator.ClientAffinityNone),
+	}
+}
+
+func TestIsListenerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ListenerParameters
+		want bool
+	}{
+		"UpToDateAfterLateInitialization": {
+			p:    listenerParameters(),
+			want: true,
+		},
+		"ProtocolChanged": {
+			p: listenerParameters(func(p *v1alpha1.ListenerParameters) {
+				p.Protocol = globalaccelerator.ProtocolUdp
+			}),
+			want: false,
+		},
+		"ClientAffinityChanged": {
+			p: listenerParameters(func(p *v1alpha1.ListenerParameters) {
+				p.ClientAffinity = aws.String(globalaccelerator.ClientAffinitySourceIp)
+			}),
+			want: false,
+		},
+		"PortRangeAdded": {
+			p: listenerParameters(func(p *v1alpha1.ListenerParameters) {
+				p.PortRanges = append(p.PortRanges, v1alpha1.PortRange{FromPort: 8080, ToPort: 8090})
+			}),
+			want: false,
+		},
+		"PortRangeChanged": {
+			p: listenerParameters(func(p *v1alpha1.ListenerParameters) {
+				p.PortRanges[1] = v1alpha1.PortRange{FromPort: 443, ToPort: 444}
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := observedListener()
+			LateInitializeListener(&tc.p, observed)
+			got := IsListenerUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines Route53 health check operations
+type Client interface {
+	CreateHealthCheck(ctx context.Context, input *route53.CreateHealthCheckInput, opts ...func(*route53.Options)) (*route53.CreateHealthCheckOutput, error)
+	GetHealthCheck(ctx context.Context, input *route53.GetHealthCheckInput, opts ...func(*route53.Options)) (*route53.GetHealthCheckOutput, error)
+	UpdateHealthCheck(ctx context.Context, input *route53.UpdateHealthCheckInput, opts ...func(*route53.Options)) (*route53.UpdateHealthCheckOutput, error)
+	DeleteHealthCheck(ctx context.Context, input *route53.DeleteHealthCheckInput, opts ...func(*route53.Options)) (*route53.DeleteHealthCheckOutput, error)
+}
+
+// NewClient creates new Route53 health check client with provided AWS
+// Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return route53.NewFromConfig(cfg)
+}
+
+// IsNotFound returns true if the error code indicates that the requested
+// health check was not found
+func IsNotFound(err error) bool {
+	var nshc *route53types.NoSuchHealthCheck
+	return errors.As(err, &nshc)
+}
+
+// GenerateCreateHealthCheckInput returns a route53 CreateHealthCheckInput
+// using which a route53 health check can be created.
+func GenerateCreateHealthCheckInput(cr *v1alpha1.HealthCheck) *route53.CreateHealthCheckInput {
+	p := cr.Spec.ForProvider
+	c := &route53types.HealthCheckConfig{
+		Type:                     route53types.HealthCheckType(p.Type),
+		IPAddress:                p.IPAddress,
+		FullyQualifiedDomainName: p.FullyQualifiedDomainName,
+		Port:                     p.Port,
+		ResourcePath:             p.ResourcePath,
+		SearchString:             p.SearchString,
+		RequestInterval:          p.RequestInterval,
+		FailureThreshold:         p.FailureThreshold,
+		MeasureLatency:           p.MeasureLatency,
+		Inverted:                 p.Inverted,
+		Disabled:                 p.Disabled,
+		EnableSNI:                p.EnableSNI,
+		Regions:                  generateRegions(p.Regions),
+		HealthThreshold:          p.HealthThreshold,
+		ChildHealthChecks:        p.ChildHealthChecks,
+		AlarmIdentifier:          generateAlarmIdentifier(p.AlarmIdentifier),
+	}
+	if p.InsufficientDataHealthStatus != nil {
+		c.InsufficientDataHealthStatus = route53types.InsufficientDataHealthStatus(*p.InsufficientDataHealthStatus)
+	}
+	return &route53.CreateHealthCheckInput{
+		CallerReference:   aws.String(string(cr.UID)),
+		HealthCheckConfig: c,
+	}
+}
+
+// GenerateUpdateHealthCheckInput returns a route53 UpdateHealthCheckInput
+// using which the given version of a route53 health check can be updated.
+func GenerateUpdateHealthCheckInput(id string, version int64, p v1alpha1.HealthCheckParameters) *route53.UpdateHealthCheckInput {
+	in := &route53.UpdateHealthCheckInput{
+		HealthCheckId:            aws.String(id),
+		HealthCheckVersion:       aws.Int64(version),
+		IPAddress:                p.IPAddress,
+		FullyQualifiedDomainName: p.FullyQualifiedDomainName,
+		Port:                     p.Port,
+		ResourcePath:             p.ResourcePath,
+		SearchString:             p.SearchString,
+		FailureThreshold:         p.FailureThreshold,
+		Inverted:                 p.Inverted,
+		Disabled:                 p.Disabled,
+		EnableSNI:                p.EnableSNI,
+		Regions:                  generateRegions(p.Regions),
+		HealthThreshold:          p.HealthThreshold,
+		ChildHealthChecks:        p.ChildHealthChecks,
+		AlarmIdentifier:          generateAlarmIdentifier(p.AlarmIdentifier),
+	}
+	if p.InsufficientDataHealthStatus != nil {
+		in.InsufficientDataHealthStatus = route53types.InsufficientDataHealthStatus(*p.InsufficientDataHealthStatus)
+	}
+	// Omitted elements are left as they are unless they are reset.
+	if p.FullyQualifiedDomainName == nil {
+		in.ResetElements = append(in.ResetElements, route53types.ResettableElementNameFullyQualifiedDomainName)
+	}
+	if len(p.Regions) == 0 {
+		in.ResetElements = append(in.ResetElements, route53types.ResettableElementNameRegions)
+	}
+	if p.ResourcePath == nil {
+		in.ResetElements = append(in.ResetElements, route53types.ResettableElementNameResourcePath)
+	}
+	if len(p.ChildHealthChecks) == 0 {
+		in.ResetElements = append(in.ResetElements, route53types.ResettableElementNameChildHealthChecks)
+	}
+	return in
+}
+
+// GenerateObservation generates and returns v1alpha1.HealthCheckObservation
+// which can be used as the status of the runtime object
+func GenerateObservation(hc route53types.HealthCheck) v1alpha1.HealthCheckObservation {
+	return v1alpha1.HealthCheckObservation{
+		CallerReference:    aws.ToString(hc.CallerReference),
+		HealthCheckVersion: aws.ToInt64(hc.HealthCheckVersion),
+	}
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.HealthCheckParameters
+// with the values seen in route53types.HealthCheck.
+func LateInitialize(spec *v1alpha1.HealthCheckParameters, hc *route53types.HealthCheck) {
+	if hc == nil || hc.HealthCheckConfig == nil {
+		return
+	}
+	c := hc.HealthCheckConfig
+	spec.Type = awsclients.LateInitializeString(spec.Type, aws.String(string(c.Type)))
+	spec.IPAddress = awsclients.LateInitializeStringPtr(spec.IPAddress, c.IPAddress)
+	spec.FullyQualifiedDomainName = awsclients.LateInitializeStringPtr(spec.FullyQualifiedDomainName, c.FullyQualifiedDomainName)
+	spec.Port = awsclients.LateInitializeInt32Ptr(spec.Port, c.Port)
+	spec.ResourcePath = awsclients.LateInitializeStringPtr(spec.ResourcePath, c.ResourcePath)
+	spec.SearchString = awsclients.LateInitializeStringPtr(spec.SearchString, c.SearchString)
+	spec.RequestInterval = awsclients.LateInitializeInt32Ptr(spec.RequestInterval, c.RequestInterval)
+	spec.FailureThreshold = awsclients.LateInitializeInt32Ptr(spec.FailureThreshold, c.FailureThreshold)
+	spec.MeasureLatency = awsclients.LateInitializeBoolPtr(spec.MeasureLatency, c.MeasureLatency)
+	spec.Inverted = awsclients.LateInitializeBoolPtr(spec.Inverted, c.Inverted)
+	spec.Disabled = awsclients.LateInitializeBoolPtr(spec.Disabled, c.Disabled)
+	spec.EnableSNI = awsclients.LateInitializeBoolPtr(spec.EnableSNI, c.EnableSNI)
+	spec.HealthThreshold = awsclients.LateInitializeInt32Ptr(spec.HealthThreshold, c.HealthThreshold)
+	if len(spec.Regions) == 0 {
+		for _, r := range c.Regions {
+			spec.Regions = append(spec.Regions, string(r))
+		}
+	}
+	if len(spec.ChildHealthChecks) == 0 && len(c.ChildHealthChecks) != 0 {
+		spec.ChildHealthChecks = append([]string{}, c.ChildHealthChecks...)
+	}
+	if spec.AlarmIdentifier == nil && c.AlarmIdentifier != nil {
+		spec.AlarmIdentifier = &v1alpha1.AlarmIdentifier{
+			Name:   aws.ToString(c.AlarmIdentifier.Name),
+			Region: string(c.AlarmIdentifier.Region),
+		}
+	}
+	if c.InsufficientDataHealthStatus != "" {
+		spec.InsufficientDataHealthStatus = awsclients.LateInitializeStringPtr(spec.InsufficientDataHealthStatus, aws.String(string(c.InsufficientDataHealthStatus)))
+	}
+}
+
+// IsUpToDate checks whether the health check is configured as desired.
+func IsUpToDate(spec v1alpha1.HealthCheckParameters, hc route53types.HealthCheck) bool {
+	current := v1alpha1.HealthCheckParameters{}
+	LateInitialize(&current, &hc)
+	return cmp.Equal(current, spec,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(xpv1.Reference{}, &xpv1.Selector{}),
+		cmpopts.SortSlices(func(x, y string) bool { return x < y }),
+	)
+}
+
+func generateRegions(regions []string) []route53types.HealthCheckRegion {
+	if len(regions) == 0 {
+		return nil
+	}
+	r := make([]route53types.HealthCheckRegion, len(regions))
+	for i, v := range regions {
+		r[i] = route53types.HealthCheckRegion(v)
+	}
+	return r
+}
+
+func generateAlarmIdentifier(a *v1alpha1.AlarmIdentifier) *route53types.AlarmIdentifier {
+	if a == nil {
+		return nil
+	}
+	return &route53types.AlarmIdentifier{
+		Name:   aws.String(a.Name),
+		Region: route53types.CloudWatchRegion(a.Region),
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+)
+
+func TestIsNotFound(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"validError": {
+			err:  &route53types.NoSuchHealthCheck{},
+			want: true,
+		},
+		"invalidAwsError": {
+			err:  &smithy.GenericAPIError{Code: "something"},
+			want: false,
+		},
+		"randomError": {
+			err:  errors.New("the specified health check does not exist"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateHealthCheckInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.HealthCheckParameters
+		want *route53.UpdateHealthCheckInput
+	}{
+		"ResetsOmittedElements": {
+			p: v1alpha1.HealthCheckParameters{
+				Type:      "HTTP",
+				IPAddress: aws.String("192.0.2.1"),
+				Port:      aws.Int32(80),
+			},
+			want: &route53.UpdateHealthCheckInput{
+				HealthCheckId:      aws.String("abc"),
+				HealthCheckVersion: aws.Int64(2),
+				IPAddress:          aws.String("192.0.2.1"),
+				Port:               aws.Int32(80),
+				ResetElements: []route53types.ResettableElementName{
+					route53types.ResettableElementNameFullyQualifiedDomainName,
+					route53types.ResettableElementNameRegions,
+					route53types.ResettableElementNameResourcePath,
+					route53types.ResettableElementNameChildHealthChecks,
+				},
+			},
+		},
+		"KeepsSetElements": {
+			p: v1alpha1.HealthCheckParameters{
+				Type:                         "HTTPS",
+				FullyQualifiedDomainName:     aws.String("example.com"),
+				ResourcePath:                 aws.String("/healthz"),
+				Regions:                      []string{"us-east-1", "eu-west-1", "ap-southeast-1"},
+				InsufficientDataHealthStatus: aws.String("Healthy"),
+			},
+			want: &route53.UpdateHealthCheckInput{
+				HealthCheckId:                aws.String("abc"),
+				HealthCheckVersion:           aws.Int64(2),
+				FullyQualifiedDomainName:     aws.String("example.com"),
+				ResourcePath:                 aws.String("/healthz"),
+				Regions:                      []route53types.HealthCheckRegion{"us-east-1", "eu-west-1", "ap-southeast-1"},
+				InsufficientDataHealthStatus: route53types.InsufficientDataHealthStatusHealthy,
+				ResetElements: []route53types.ResettableElementName{
+					route53types.ResettableElementNameChildHealthChecks,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateHealthCheckInput("abc", 2, tc.p)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(route53.UpdateHealthCheckInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.HealthCheckParameters
+		hc   route53types.HealthCheck
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.HealthCheckParameters{
+				Type:             "HTTP",
+				IPAddress:        aws.String("192.0.2.1"),
+				Port:             aws.Int32(80),
+				FailureThreshold: aws.Int32(3),
+				Regions:          []string{"us-west-1", "us-east-1"},
+			},
+			hc: route53types.HealthCheck{
+				HealthCheckConfig: &route53types.HealthCheckConfig{
+					Type:             route53types.HealthCheckTypeHttp,
+					IPAddress:        aws.String("192.0.2.1"),
+					Port:             aws.Int32(80),
+					FailureThreshold: aws.Int32(3),
+					Regions:          []route53types.HealthCheckRegion{"us-east-1", "us-west-1"},
+				},
+			},
+			want: true,
+		},
+		"FailureThresholdChanged": {
+			p: v1alpha1.HealthCheckParameters{
+				Type:             "HTTP",
+				IPAddress:        aws.String("192.0.2.1"),
+				FailureThreshold: aws.Int32(5),
+			},
+			hc: route53types.HealthCheck{
+				HealthCheckConfig: &route53types.HealthCheckConfig{
+					Type:             route53types.HealthCheckTypeHttp,
+					IPAddress:        aws.String("192.0.2.1"),
+					FailureThreshold: aws.Int32(3),
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsUpToDate(tc.p, tc.hc); got != tc.want {
+				t.Errorf("IsUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
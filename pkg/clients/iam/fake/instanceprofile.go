@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InstanceProfileClient = (*MockInstanceProfileClient)(nil)
+
+// MockInstanceProfileClient is a type that implements all the methods for InstanceProfileClient interface
+type MockInstanceProfileClient struct {
+	MockGetInstanceProfile            func(ctx context.Context, input *iam.GetInstanceProfileInput, opts []func(*iam.Options)) (*iam.GetInstanceProfileOutput, error)
+	MockCreateInstanceProfile         func(ctx context.Context, input *iam.CreateInstanceProfileInput, opts []func(*iam.Options)) (*iam.CreateInstanceProfileOutput, error)
+	MockDeleteInstanceProfile         func(ctx context.Context, input *iam.DeleteInstanceProfileInput, opts []func(*iam.Options)) (*iam.DeleteInstanceProfileOutput, error)
+	MockAddRoleToInstanceProfile      func(ctx context.Context, input *iam.AddRoleToInstanceProfileInput, opts []func(*iam.Options)) (*iam.AddRoleToInstanceProfileOutput, error)
+	MockRemoveRoleFromInstanceProfile func(ctx context.Context, input *iam.RemoveRoleFromInstanceProfileInput, opts []func(*iam.Options)) (*iam.RemoveRoleFromInstanceProfileOutput, error)
+}
+
+// GetInstanceProfile mocks GetInstanceProfile method
+func (m *MockInstanceProfileClient) GetInstanceProfile(ctx context.Context, input *iam.GetInstanceProfileInput, opts ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error) {
+	return m.MockGetInstanceProfile(ctx, input, opts)
+}
+
+// CreateInstanceProfile mocks CreateInstanceProfile method
+func (m *MockInstanceProfileClient) CreateInstanceProfile(ctx context.Context, input *iam.CreateInstanceProfileInput, opts ...func(*iam.Options)) (*iam.CreateInstanceProfileOutput, error) {
+	return m.MockCreateInstanceProfile(ctx, input, opts)
+}
+
+// DeleteInstanceProfile mocks DeleteInstanceProfile method
+func (m *MockInstanceProfileClient) DeleteInstanceProfile(ctx context.Context, input *iam.DeleteInstanceProfileInput, opts ...func(*iam.Options)) (*iam.DeleteInstanceProfileOutput, error) {
+	return m.MockDeleteInstanceProfile(ctx, input, opts)
+}
+
+// AddRoleToInstanceProfile mocks AddRoleToInstanceProfile method
+func (m *MockInstanceProfileClient) AddRoleToInstanceProfile(ctx context.Context, input *iam.AddRoleToInstanceProfileInput, opts ...func(*iam.Options)) (*iam.AddRoleToInstanceProfileOutput, error) {
+	return m.MockAddRoleToInstanceProfile(ctx, input, opts)
+}
+
+// RemoveRoleFromInstanceProfile mocks RemoveRoleFromInstanceProfile method
+func (m *MockInstanceProfileClient) RemoveRoleFromInstanceProfile(ctx context.Context, input *iam.RemoveRoleFromInstanceProfileInput, opts ...func(*iam.Options)) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+	return m.MockRemoveRoleFromInstanceProfile(ctx, input, opts)
+}
@@ -35,6 +35,7 @@ type MockOpenIDConnectProviderClient struct {
 	MockRemoveClientIDFromOpenIDConnectProvider func(ctx context.Context, input *iam.RemoveClientIDFromOpenIDConnectProviderInput, opts []func(*iam.Options)) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error)
 	MockUpdateOpenIDConnectProviderThumbprint   func(ctx context.Context, input *iam.UpdateOpenIDConnectProviderThumbprintInput, opts []func(*iam.Options)) (*iam.UpdateOpenIDConnectProviderThumbprintOutput, error)
 	MockDeleteOpenIDConnectProvider             func(ctx context.Context, input *iam.DeleteOpenIDConnectProviderInput, opts []func(*iam.Options)) (*iam.DeleteOpenIDConnectProviderOutput, error)
+	MockListOpenIDConnectProviders              func(ctx context.Context, input *iam.ListOpenIDConnectProvidersInput, opts []func(*iam.Options)) (*iam.ListOpenIDConnectProvidersOutput, error)
 }
 
 // GetOpenIDConnectProvider mocks client call.
@@ -66,3 +67,8 @@ func (m *MockOpenIDConnectProviderClient) UpdateOpenIDConnectProviderThumbprint(
 func (m *MockOpenIDConnectProviderClient) DeleteOpenIDConnectProvider(ctx context.Context, input *iam.DeleteOpenIDConnectProviderInput, opts ...func(*iam.Options)) (*iam.DeleteOpenIDConnectProviderOutput, error) {
 	return m.MockDeleteOpenIDConnectProvider(ctx, input, opts)
 }
+
+// ListOpenIDConnectProviders mocks client call.
+func (m *MockOpenIDConnectProviderClient) ListOpenIDConnectProviders(ctx context.Context, input *iam.ListOpenIDConnectProvidersInput, opts ...func(*iam.Options)) (*iam.ListOpenIDConnectProvidersOutput, error) {
+	return m.MockListOpenIDConnectProviders(ctx, input, opts)
+}
@@ -36,6 +36,9 @@ type MockRoleClient struct {
 	MockUpdateAssumeRolePolicy func(ctx context.Context, input *iam.UpdateAssumeRolePolicyInput, opts []func(*iam.Options)) (*iam.UpdateAssumeRolePolicyOutput, error)
 	MockTagRole                func(ctx context.Context, input *iam.TagRoleInput, opts []func(*iam.Options)) (*iam.TagRoleOutput, error)
 	MockUntagRole              func(ctx context.Context, input *iam.UntagRoleInput, opts []func(*iam.Options)) (*iam.UntagRoleOutput, error)
+
+	MockPutRolePermissionsBoundary    func(ctx context.Context, input *iam.PutRolePermissionsBoundaryInput, opts []func(*iam.Options)) (*iam.PutRolePermissionsBoundaryOutput, error)
+	MockDeleteRolePermissionsBoundary func(ctx context.Context, input *iam.DeleteRolePermissionsBoundaryInput, opts []func(*iam.Options)) (*iam.DeleteRolePermissionsBoundaryOutput, error)
 }
 
 // GetRole mocks GetRole method
@@ -72,3 +75,13 @@ func (m *MockRoleClient) TagRole(ctx context.Context, input *iam.TagRoleInput, o
 func (m *MockRoleClient) UntagRole(ctx context.Context, input *iam.UntagRoleInput, opts ...func(*iam.Options)) (*iam.UntagRoleOutput, error) {
 	return m.MockUntagRole(ctx, input, opts)
 }
+
+// PutRolePermissionsBoundary mocks PutRolePermissionsBoundary method
+func (m *MockRoleClient) PutRolePermissionsBoundary(ctx context.Context, input *iam.PutRolePermissionsBoundaryInput, opts ...func(*iam.Options)) (*iam.PutRolePermissionsBoundaryOutput, error) {
+	return m.MockPutRolePermissionsBoundary(ctx, input, opts)
+}
+
+// DeleteRolePermissionsBoundary mocks DeleteRolePermissionsBoundary method
+func (m *MockRoleClient) DeleteRolePermissionsBoundary(ctx context.Context, input *iam.DeleteRolePermissionsBoundaryInput, opts ...func(*iam.Options)) (*iam.DeleteRolePermissionsBoundaryOutput, error) {
+	return m.MockDeleteRolePermissionsBoundary(ctx, input, opts)
+}
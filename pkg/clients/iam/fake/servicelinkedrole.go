@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ServiceLinkedRoleClient = (*MockServiceLinkedRoleClient)(nil)
+
+// MockServiceLinkedRoleClient is a type that implements all the methods for ServiceLinkedRoleClient interface
+type MockServiceLinkedRoleClient struct {
+	MockGetRole                            func(ctx context.Context, input *iam.GetRoleInput, opts []func(*iam.Options)) (*iam.GetRoleOutput, error)
+	MockCreateServiceLinkedRole            func(ctx context.Context, input *iam.CreateServiceLinkedRoleInput, opts []func(*iam.Options)) (*iam.CreateServiceLinkedRoleOutput, error)
+	MockDeleteServiceLinkedRole            func(ctx context.Context, input *iam.DeleteServiceLinkedRoleInput, opts []func(*iam.Options)) (*iam.DeleteServiceLinkedRoleOutput, error)
+	MockGetServiceLinkedRoleDeletionStatus func(ctx context.Context, input *iam.GetServiceLinkedRoleDeletionStatusInput, opts []func(*iam.Options)) (*iam.GetServiceLinkedRoleDeletionStatusOutput, error)
+	MockUpdateRole                         func(ctx context.Context, input *iam.UpdateRoleInput, opts []func(*iam.Options)) (*iam.UpdateRoleOutput, error)
+}
+
+// GetRole mocks GetRole method
+func (m *MockServiceLinkedRoleClient) GetRole(ctx context.Context, input *iam.GetRoleInput, opts ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	return m.MockGetRole(ctx, input, opts)
+}
+
+// CreateServiceLinkedRole mocks CreateServiceLinkedRole method
+func (m *MockServiceLinkedRoleClient) CreateServiceLinkedRole(ctx context.Context, input *iam.CreateServiceLinkedRoleInput, opts ...func(*iam.Options)) (*iam.CreateServiceLinkedRoleOutput, error) {
+	return m.MockCreateServiceLinkedRole(ctx, input, opts)
+}
+
+// DeleteServiceLinkedRole mocks DeleteServiceLinkedRole method
+func (m *MockServiceLinkedRoleClient) DeleteServiceLinkedRole(ctx context.Context, input *iam.DeleteServiceLinkedRoleInput, opts ...func(*iam.Options)) (*iam.DeleteServiceLinkedRoleOutput, error) {
+	return m.MockDeleteServiceLinkedRole(ctx, input, opts)
+}
+
+// GetServiceLinkedRoleDeletionStatus mocks GetServiceLinkedRoleDeletionStatus method
+func (m *MockServiceLinkedRoleClient) GetServiceLinkedRoleDeletionStatus(ctx context.Context, input *iam.GetServiceLinkedRoleDeletionStatusInput, opts ...func(*iam.Options)) (*iam.GetServiceLinkedRoleDeletionStatusOutput, error) {
+	return m.MockGetServiceLinkedRoleDeletionStatus(ctx, input, opts)
+}
+
+// UpdateRole mocks UpdateRole method
+func (m *MockServiceLinkedRoleClient) UpdateRole(ctx context.Context, input *iam.UpdateRoleInput, opts ...func(*iam.Options)) (*iam.UpdateRoleOutput, error) {
+	return m.MockUpdateRole(ctx, input, opts)
+}
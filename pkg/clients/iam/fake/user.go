@@ -33,6 +33,9 @@ type MockUserClient struct {
 	MockCreateUser func(ctx context.Context, input *iam.CreateUserInput, opts []func(*iam.Options)) (*iam.CreateUserOutput, error)
 	MockDeleteUser func(ctx context.Context, input *iam.DeleteUserInput, opts []func(*iam.Options)) (*iam.DeleteUserOutput, error)
 	MockUpdateUser func(ctx context.Context, input *iam.UpdateUserInput, opts []func(*iam.Options)) (*iam.UpdateUserOutput, error)
+
+	MockPutUserPermissionsBoundary    func(ctx context.Context, input *iam.PutUserPermissionsBoundaryInput, opts []func(*iam.Options)) (*iam.PutUserPermissionsBoundaryOutput, error)
+	MockDeleteUserPermissionsBoundary func(ctx context.Context, input *iam.DeleteUserPermissionsBoundaryInput, opts []func(*iam.Options)) (*iam.DeleteUserPermissionsBoundaryOutput, error)
 }
 
 // GetUser mocks GetUser method
@@ -54,3 +57,13 @@ func (m *MockUserClient) DeleteUser(ctx context.Context, input *iam.DeleteUserIn
 func (m *MockUserClient) UpdateUser(ctx context.Context, input *iam.UpdateUserInput, opts ...func(*iam.Options)) (*iam.UpdateUserOutput, error) {
 	return m.MockUpdateUser(ctx, input, opts)
 }
+
+// PutUserPermissionsBoundary mocks PutUserPermissionsBoundary method
+func (m *MockUserClient) PutUserPermissionsBoundary(ctx context.Context, input *iam.PutUserPermissionsBoundaryInput, opts ...func(*iam.Options)) (*iam.PutUserPermissionsBoundaryOutput, error) {
+	return m.MockPutUserPermissionsBoundary(ctx, input, opts)
+}
+
+// DeleteUserPermissionsBoundary mocks DeleteUserPermissionsBoundary method
+func (m *MockUserClient) DeleteUserPermissionsBoundary(ctx context.Context, input *iam.DeleteUserPermissionsBoundaryInput, opts ...func(*iam.Options)) (*iam.DeleteUserPermissionsBoundaryOutput, error) {
+	return m.MockDeleteUserPermissionsBoundary(ctx, input, opts)
+}
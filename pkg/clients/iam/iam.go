@@ -29,6 +29,16 @@ type StatementEntry struct {
 	Resource []string
 }
 
+// IsPermissionsBoundaryUpToDate returns whether the observed permissions
+// boundary of a role or user is the desired one. No permissions boundary is
+// desired if the ARN is nil.
+func IsPermissionsBoundaryUpToDate(arn *string, observed *iamtypes.AttachedPermissionsBoundary) bool {
+	if observed == nil {
+		return aws.ToString(arn) == ""
+	}
+	return aws.ToString(arn) == aws.ToString(observed.PermissionsBoundaryArn)
+}
+
 // BuildIAMTags build a tag array with type that IAM client expects.
 func BuildIAMTags(tags []v1beta1.Tag) []iamtypes.Tag {
 	res := make([]iamtypes.Tag, len(tags))
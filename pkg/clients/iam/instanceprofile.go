@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+)
+
+// InstanceProfileClient is the external client used for InstanceProfile Custom Resource
+type InstanceProfileClient interface {
+	GetInstanceProfile(ctx context.Context, input *iam.GetInstanceProfileInput, opts ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error)
+	CreateInstanceProfile(ctx context.Context, input *iam.CreateInstanceProfileInput, opts ...func(*iam.Options)) (*iam.CreateInstanceProfileOutput, error)
+	DeleteInstanceProfile(ctx context.Context, input *iam.DeleteInstanceProfileInput, opts ...func(*iam.Options)) (*iam.DeleteInstanceProfileOutput, error)
+	AddRoleToInstanceProfile(ctx context.Context, input *iam.AddRoleToInstanceProfileInput, opts ...func(*iam.Options)) (*iam.AddRoleToInstanceProfileOutput, error)
+	RemoveRoleFromInstanceProfile(ctx context.Context, input *iam.RemoveRoleFromInstanceProfileInput, opts ...func(*iam.Options)) (*iam.RemoveRoleFromInstanceProfileOutput, error)
+}
+
+// NewInstanceProfileClient returns a new client given an aws config
+func NewInstanceProfileClient(conf aws.Config) InstanceProfileClient {
+	return iam.NewFromConfig(conf)
+}
+
+// GenerateInstanceProfileObservation is used to produce InstanceProfileObservation
+// from iamtypes.InstanceProfile.
+func GenerateInstanceProfileObservation(profile iamtypes.InstanceProfile) v1alpha1.InstanceProfileObservation {
+	o := v1alpha1.InstanceProfileObservation{
+		ARN:               aws.ToString(profile.Arn),
+		InstanceProfileID: aws.ToString(profile.InstanceProfileId),
+	}
+	for _, r := range profile.Roles {
+		o.RoleNames = append(o.RoleNames, aws.ToString(r.RoleName))
+	}
+	return o
+}
+
+// DiffInstanceProfileRoles returns the role that needs to be added to the
+// instance profile and the roles that need to be removed from it. An instance
+// profile holds at most one role, so any role other than the desired one is
+// removed.
+func DiffInstanceProfileRoles(p v1alpha1.InstanceProfileParameters, profile iamtypes.InstanceProfile) (add *string, remove []string) {
+	desired := aws.ToString(p.RoleName)
+	found := false
+	for _, r := range profile.Roles {
+		name := aws.ToString(r.RoleName)
+		if name == desired {
+			found = true
+			continue
+		}
+		remove = append(remove, name)
+	}
+	if desired != "" && !found {
+		add = p.RoleName
+	}
+	return add, remove
+}
+
+// IsInstanceProfileUpToDate checks whether the instance profile holds the
+// desired role.
+func IsInstanceProfileUpToDate(p v1alpha1.InstanceProfileParameters, profile iamtypes.InstanceProfile) bool {
+	add, remove := DiffInstanceProfileRoles(p, profile)
+	return add == nil && len(remove) == 0
+}
@@ -18,6 +18,13 @@ package iam
 
 import (
 	"context"
+	"crypto/sha1" // nolint:gosec
+	"crypto/tls"
+	"encoding/hex"
+	"net"
+	"net/url"
+
+	"github.com/pkg/errors"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/iam/v1beta1"
 
@@ -41,6 +48,7 @@ type OpenIDConnectProviderClient interface {
 	RemoveClientIDFromOpenIDConnectProvider(ctx context.Context, input *iam.RemoveClientIDFromOpenIDConnectProviderInput, opts ...func(*iam.Options)) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error)
 	UpdateOpenIDConnectProviderThumbprint(ctx context.Context, input *iam.UpdateOpenIDConnectProviderThumbprintInput, opts ...func(*iam.Options)) (*iam.UpdateOpenIDConnectProviderThumbprintOutput, error)
 	DeleteOpenIDConnectProvider(ctx context.Context, input *iam.DeleteOpenIDConnectProviderInput, opts ...func(*iam.Options)) (*iam.DeleteOpenIDConnectProviderOutput, error)
+	ListOpenIDConnectProviders(ctx context.Context, input *iam.ListOpenIDConnectProvidersInput, opts ...func(*iam.Options)) (*iam.ListOpenIDConnectProvidersOutput, error)
 }
 
 // GenerateOIDCProviderObservation is used to produce v1alpha1.OpenIDConnectProvider
@@ -51,6 +59,7 @@ func GenerateOIDCProviderObservation(observed iam.GetOpenIDConnectProviderOutput
 		createdTime := metav1.NewTime(*observed.CreateDate)
 		o.CreateDate = &createdTime
 	}
+	o.ThumbprintList = observed.ThumbprintList
 	return o
 }
 
@@ -90,3 +99,30 @@ func SliceDifference(current, desired []string) (add, remove []string) {
 	}
 	return
 }
+
+// GetThumbprint returns the thumbprint of the top certificate authority in the
+// certificate chain served for the supplied OpenID Connect issuer URL, which
+// is what IAM expects as the thumbprint of an OpenID Connect provider.
+func GetThumbprint(ctx context.Context, issuerURL string) (string, error) {
+	u, err := url.Parse(issuerURL)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot parse issuer URL")
+	}
+	port := u.Port()
+	if port == "" {
+		port = "443"
+	}
+	d := &tls.Dialer{Config: &tls.Config{ServerName: u.Hostname(), MinVersion: tls.VersionTLS12}}
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		return "", errors.Wrap(err, "cannot connect to issuer")
+	}
+	defer conn.Close() // nolint:errcheck
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", errors.New("issuer did not present any certificates")
+	}
+	// IAM requires the SHA-1 fingerprint of the certificate.
+	sum := sha1.Sum(certs[len(certs)-1].Raw) // nolint:gosec
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -10,11 +10,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
-	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const errPolicyDocumentUnescape = "malformed policy document escaping"
+
 // PolicyClient is the external client used for Policy Custom Resource
 type PolicyClient interface {
 	GetPolicy(ctx context.Context, input *iam.GetPolicyInput, opts ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
@@ -45,28 +47,30 @@ func NewSTSClient(cfg aws.Config) STSClient {
 
 // IsPolicyUpToDate checks whether there is a change in any of the modifiable fields in policy.
 func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion) (bool, error) {
-	// The AWS API returns Policy Document as an escaped string.
-	// Due to differences in the methods to escape a string, the comparison result between
-	// the spec.Document and policy.Document can sometimes be false negative (due to spaces, line feeds).
-	// Escaping with a common method and then comparing is a safe way.
-
-	if *policy.Document == "" || in.Document == "" {
+	if aws.ToString(policy.Document) == "" || in.Document == "" {
 		return false, nil
 	}
-
-	unescapedPolicy, err := url.QueryUnescape(aws.ToString(policy.Document))
+	drift, err := PolicyDocumentDrift(in.Document, aws.ToString(policy.Document))
 	if err != nil {
-		return false, nil
+		return false, err
 	}
+	return len(drift) == 0, nil
+}
 
-	compactPolicy, err := awsclients.CompactAndEscapeJSON(unescapedPolicy)
+// PolicyDocumentDrift returns the differences between the desired policy
+// document and the observed one, which IAM returns URL encoded, in the sense
+// of awsclients.PolicyDocumentDrift.
+func PolicyDocumentDrift(desired, observed string) ([]awsclients.Drift, error) {
+	unescaped, err := url.QueryUnescape(observed)
 	if err != nil {
-		return false, err
+		return nil, errors.Wrap(err, errPolicyDocumentUnescape)
 	}
-	compactSpecPolicy, err := awsclients.CompactAndEscapeJSON(in.Document)
+	drift, err := awsclients.PolicyDocumentDrift(desired, unescaped)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-
-	return cmp.Equal(compactPolicy, compactSpecPolicy), nil
+	for i := range drift {
+		drift[i].Path = "document" + drift[i].Path
+	}
+	return drift, nil
 }
@@ -1,12 +1,19 @@
 package iam
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 var (
@@ -35,6 +42,12 @@ var (
 		  }
 		]
 	   }`
+
+	// document3 is semantically equal to document1.
+	document3 = `{"Statement":[{"Action":["sts:AssumeRole"],"Principal":{"Service":["eks.amazonaws.com"]},"Effect":"Allow"}],"Version":"2012-10-17"}`
+
+	multiAction       = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`
+	multiActionSorted = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject","s3:GetObject"],"Resource":"*"}]}`
 )
 
 func TestIsPolicyUpToDate(t *testing.T) {
@@ -69,6 +82,28 @@ func TestIsPolicyUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"ReorderedAndSingleValues": {
+			args: args{
+				p: v1beta1.PolicyParameters{
+					Document: document1,
+				},
+				version: iamtypes.PolicyVersion{
+					Document: &document3,
+				},
+			},
+			want: true,
+		},
+		"URLEncodedObservation": {
+			args: args{
+				p: v1beta1.PolicyParameters{
+					Document: document1,
+				},
+				version: iamtypes.PolicyVersion{
+					Document: aws.String(url.QueryEscape(document1)),
+				},
+			},
+			want: true,
+		},
 		"EmptyPolicy": {
 			args: args{
 				p: v1beta1.PolicyParameters{},
@@ -89,3 +124,68 @@ func TestIsPolicyUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyDocumentDrift(t *testing.T) {
+	type args struct {
+		desired  string
+		observed string
+	}
+	type want struct {
+		drift []awsclients.Drift
+		err   error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Equivalent": {
+			args: args{
+				desired:  document1,
+				observed: url.QueryEscape(document3),
+			},
+			want: want{},
+		},
+		"ListOrderAndDuplicates": {
+			args: args{
+				desired:  multiAction,
+				observed: multiActionSorted,
+			},
+			want: want{},
+		},
+		"Changed": {
+			args: args{
+				desired:  document1,
+				observed: url.QueryEscape(document2),
+			},
+			want: want{
+				drift: []awsclients.Drift{{
+					Path:     "document[Statement][Effect]",
+					Desired:  `"Allow"`,
+					Observed: `"Deny"`,
+				}},
+			},
+		},
+		"MalformedDesired": {
+			args: args{
+				desired:  "{",
+				observed: document1,
+			},
+			want: want{
+				err: errors.Wrap(errors.New("unexpected EOF"), "malformed policy document JSON"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			drift, err := PolicyDocumentDrift(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.drift, drift); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package iam
 
 import (
 	"context"
-	"encoding/json"
 	"net/url"
 
 	"github.com/aws/smithy-go/document"
@@ -36,6 +35,8 @@ type RoleClient interface {
 	UpdateAssumeRolePolicy(ctx context.Context, input *iam.UpdateAssumeRolePolicyInput, opts ...func(*iam.Options)) (*iam.UpdateAssumeRolePolicyOutput, error)
 	TagRole(ctx context.Context, input *iam.TagRoleInput, opts ...func(*iam.Options)) (*iam.TagRoleOutput, error)
 	UntagRole(ctx context.Context, input *iam.UntagRoleInput, opts ...func(*iam.Options)) (*iam.UntagRoleOutput, error)
+	PutRolePermissionsBoundary(ctx context.Context, input *iam.PutRolePermissionsBoundaryInput, opts ...func(*iam.Options)) (*iam.PutRolePermissionsBoundaryOutput, error)
+	DeleteRolePermissionsBoundary(ctx context.Context, input *iam.DeleteRolePermissionsBoundaryInput, opts ...func(*iam.Options)) (*iam.DeleteRolePermissionsBoundaryOutput, error)
 }
 
 // NewRoleClient returns a new client using AWS credentials as JSON encoded data.
@@ -89,6 +90,15 @@ func GenerateRole(in v1beta1.RoleParameters, role *iamtypes.Role) error {
 	role.Description = in.Description
 	role.MaxSessionDuration = in.MaxSessionDuration
 	role.Path = in.Path
+	if !IsPermissionsBoundaryUpToDate(in.PermissionsBoundary, role.PermissionsBoundary) {
+		role.PermissionsBoundary = nil
+		if in.PermissionsBoundary != nil {
+			role.PermissionsBoundary = &iamtypes.AttachedPermissionsBoundary{
+				PermissionsBoundaryArn:  in.PermissionsBoundary,
+				PermissionsBoundaryType: iamtypes.PermissionsBoundaryAttachmentTypePolicy,
+			}
+		}
+	}
 
 	if len(in.Tags) != 0 {
 		role.Tags = make([]iamtypes.Tag, len(in.Tags))
@@ -113,10 +123,6 @@ func LateInitializeRole(in *v1beta1.RoleParameters, role *iamtypes.Role) {
 	in.MaxSessionDuration = awsclients.LateInitializeInt32Ptr(in.MaxSessionDuration, role.MaxSessionDuration)
 	in.Path = awsclients.LateInitializeStringPtr(in.Path, role.Path)
 
-	if role.PermissionsBoundary != nil {
-		in.PermissionsBoundary = awsclients.LateInitializeStringPtr(in.PermissionsBoundary, role.PermissionsBoundary.PermissionsBoundaryArn)
-	}
-
 	if in.Tags == nil && role.Tags != nil {
 		for _, tag := range role.Tags {
 			in.Tags = append(in.Tags, v1beta1.Tag{Key: aws.ToString(tag.Key), Value: aws.ToString(tag.Value)})
@@ -124,24 +130,6 @@ func LateInitializeRole(in *v1beta1.RoleParameters, role *iamtypes.Role) {
 	}
 }
 
-// CreatePatch creates a *v1beta1.RoleParameters that has only the changed
-// values between the target *v1beta1.RoleParameters and the current
-// *iamtypes.Role
-func CreatePatch(in *iamtypes.Role, target *v1beta1.RoleParameters) (*v1beta1.RoleParameters, error) {
-	currentParams := &v1beta1.RoleParameters{}
-	LateInitializeRole(currentParams, in)
-
-	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
-	if err != nil {
-		return nil, err
-	}
-	patch := &v1beta1.RoleParameters{}
-	if err := json.Unmarshal(jsonPatch, patch); err != nil {
-		return nil, err
-	}
-	return patch, nil
-}
-
 func isAssumeRolePolicyUpToDate(a, b *string) (bool, error) {
 	if a == nil || b == nil {
 		return a == b, nil
@@ -157,7 +145,31 @@ func isAssumeRolePolicyUpToDate(a, b *string) (bool, error) {
 		return false, errors.Wrap(err, errPolicyJSONUnescape)
 	}
 
-	return awsclients.IsPolicyUpToDate(&jsonA, &jsonB), nil
+	return awsclients.IsPolicyDocumentUpToDate(jsonA, jsonB)
+}
+
+// IsAssumeRolePolicyUpToDate checks whether the trust policy of the role is
+// semantically equal to the desired one, regardless of formatting and of the
+// URL encoding of the observed document.
+func IsAssumeRolePolicyUpToDate(in v1beta1.RoleParameters, observed iamtypes.Role) (bool, error) {
+	if in.AssumeRolePolicyDocument == "" {
+		return true, nil
+	}
+	desired, err := awsclients.CompactAndEscapeJSON(in.AssumeRolePolicyDocument)
+	if err != nil {
+		return false, errors.Wrap(err, errPolicyJSONEscape)
+	}
+	return isAssumeRolePolicyUpToDate(&desired, observed.AssumeRolePolicyDocument)
+}
+
+// IsRoleSettingsUpToDate checks whether the description and the maximum
+// session duration of the role, which are changed by UpdateRole, are the
+// desired ones.
+func IsRoleSettingsUpToDate(in v1beta1.RoleParameters, observed iamtypes.Role) bool {
+	if aws.ToString(in.Description) != aws.ToString(observed.Description) {
+		return false
+	}
+	return in.MaxSessionDuration == nil || aws.ToInt32(in.MaxSessionDuration) == aws.ToInt32(observed.MaxSessionDuration)
 }
 
 // IsRoleUpToDate checks whether there is a change in any of the modifiable fields in role.
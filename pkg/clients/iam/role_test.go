@@ -198,6 +198,8 @@ func TestLateInitializeRole(t *testing.T) {
 				p.Description = &description
 			}),
 		},
+		// The permissions boundary is not late initialized, so that it can
+		// be removed by unsetting it.
 		"PointerFields": {
 			args: args{
 				spec: roleParams(),
@@ -220,7 +222,6 @@ func TestLateInitializeRole(t *testing.T) {
 						Value: tagValue,
 					},
 				}
-				p.PermissionsBoundary = &roleARN
 			}),
 		},
 	}
@@ -272,6 +273,38 @@ func TestIsRoleUpToDate(t *testing.T) {
 			want:     true,
 			wantDiff: "",
 		},
+		"SamePermissionsBoundary": {
+			args: args{
+				role: iamtypes.Role{
+					AssumeRolePolicyDocument: escapedPolicyJSON(),
+					PermissionsBoundary: &iamtypes.AttachedPermissionsBoundary{
+						PermissionsBoundaryArn:  &roleARN,
+						PermissionsBoundaryType: iamtypes.PermissionsBoundaryAttachmentTypePolicy,
+					},
+				},
+				p: v1beta1.RoleParameters{
+					AssumeRolePolicyDocument: assumeRolePolicyDocument,
+					PermissionsBoundary:      &roleARN,
+				},
+			},
+			want: true,
+		},
+		"RemovedPermissionsBoundary": {
+			args: args{
+				role: iamtypes.Role{
+					AssumeRolePolicyDocument: escapedPolicyJSON(),
+					PermissionsBoundary: &iamtypes.AttachedPermissionsBoundary{
+						PermissionsBoundaryArn:  &roleARN,
+						PermissionsBoundaryType: iamtypes.PermissionsBoundaryAttachmentTypePolicy,
+					},
+				},
+				p: v1beta1.RoleParameters{
+					AssumeRolePolicyDocument: assumeRolePolicyDocument,
+				},
+			},
+			want:     false,
+			wantDiff: "Found observed difference in IAM role",
+		},
 		"DifferentPolicy": {
 			args: args{
 				role: iamtypes.Role{
@@ -345,6 +378,118 @@ observed assume role policy: %7B%22Version%22%3A%222012-10-17%22%2C%22Statement%
 	}
 }
 
+func TestIsAssumeRolePolicyUpToDate(t *testing.T) {
+	type args struct {
+		role iamtypes.Role
+		p    v1beta1.RoleParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SamePolicyDifferentFormatting": {
+			args: args{
+				role: iamtypes.Role{AssumeRolePolicyDocument: escapedPolicyJSON()},
+				p: v1beta1.RoleParameters{
+					AssumeRolePolicyDocument: `{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"eks.amazonaws.com"}}],"Version":"2012-10-17"}`,
+				},
+			},
+			want: true,
+		},
+		"SingleValueLists": {
+			args: args{
+				role: iamtypes.Role{AssumeRolePolicyDocument: escapedPolicyJSON()},
+				p: v1beta1.RoleParameters{
+					AssumeRolePolicyDocument: `{"Statement":{"Action":["sts:AssumeRole"],"Effect":"Allow","Principal":{"Service":["eks.amazonaws.com"]}},"Version":"2012-10-17"}`,
+				},
+			},
+			want: true,
+		},
+		"DifferentPolicy": {
+			args: args{
+				role: iamtypes.Role{AssumeRolePolicyDocument: escapedPolicyJSON()},
+				p:    v1beta1.RoleParameters{AssumeRolePolicyDocument: assumeRolePolicyDocument2},
+			},
+			want: false,
+		},
+		"NoDesiredPolicy": {
+			args: args{
+				role: iamtypes.Role{AssumeRolePolicyDocument: escapedPolicyJSON()},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsAssumeRolePolicyUpToDate(tc.args.p, tc.args.role)
+			if err != nil {
+				t.Errorf("r: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRoleSettingsUpToDate(t *testing.T) {
+	type args struct {
+		role iamtypes.Role
+		p    v1beta1.RoleParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameSettings": {
+			args: args{
+				role: *role(),
+				p:    *roleParams(),
+			},
+			want: true,
+		},
+		"DifferentDescription": {
+			args: args{
+				role: *role(),
+				p: *roleParams(func(p *v1beta1.RoleParameters) {
+					p.Description = aws.String("other")
+				}),
+			},
+			want: false,
+		},
+		"DifferentMaxSessionDuration": {
+			args: args{
+				role: *role(),
+				p: *roleParams(func(p *v1beta1.RoleParameters) {
+					p.MaxSessionDuration = aws.Int32(7200)
+				}),
+			},
+			want: false,
+		},
+		"NoDesiredMaxSessionDuration": {
+			args: args{
+				role: *role(),
+				p: *roleParams(func(p *v1beta1.RoleParameters) {
+					p.MaxSessionDuration = nil
+				}),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRoleSettingsUpToDate(tc.args.p, tc.args.role)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDiffIAMTags(t *testing.T) {
 	type args struct {
 		local  []v1beta1.Tag
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+)
+
+// RolePolicyClient is the external client used for RolePolicy Custom Resource
+type RolePolicyClient interface {
+	GetRolePolicy(ctx context.Context, input *iam.GetRolePolicyInput, opts ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	PutRolePolicy(ctx context.Context, input *iam.PutRolePolicyInput, opts ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	DeleteRolePolicy(ctx context.Context, input *iam.DeleteRolePolicyInput, opts ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
+}
+
+// NewRolePolicyClient returns a new client given an aws config
+func NewRolePolicyClient(conf aws.Config) RolePolicyClient {
+	return iam.NewFromConfig(conf)
+}
+
+// GeneratePutRolePolicyInput returns the input for PutRolePolicy.
+func GeneratePutRolePolicyInput(name string, p *v1alpha1.RolePolicyParameters) *iam.PutRolePolicyInput {
+	return &iam.PutRolePolicyInput{
+		RoleName:       aws.String(p.RoleName),
+		PolicyName:     aws.String(name),
+		PolicyDocument: aws.String(p.Document),
+	}
+}
+
+// IsRolePolicyUpToDate checks whether the observed inline policy document is
+// equivalent to the desired one.
+func IsRolePolicyUpToDate(p *v1alpha1.RolePolicyParameters, observed *iam.GetRolePolicyOutput) bool {
+	drift, err := PolicyDocumentDrift(p.Document, aws.ToString(observed.PolicyDocument))
+	return err == nil && len(drift) == 0
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ServiceLinkedRoleClient is the external client used for ServiceLinkedRole Custom Resource
+type ServiceLinkedRoleClient interface {
+	GetRole(ctx context.Context, input *iam.GetRoleInput, opts ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	CreateServiceLinkedRole(ctx context.Context, input *iam.CreateServiceLinkedRoleInput, opts ...func(*iam.Options)) (*iam.CreateServiceLinkedRoleOutput, error)
+	DeleteServiceLinkedRole(ctx context.Context, input *iam.DeleteServiceLinkedRoleInput, opts ...func(*iam.Options)) (*iam.DeleteServiceLinkedRoleOutput, error)
+	GetServiceLinkedRoleDeletionStatus(ctx context.Context, input *iam.GetServiceLinkedRoleDeletionStatusInput, opts ...func(*iam.Options)) (*iam.GetServiceLinkedRoleDeletionStatusOutput, error)
+	UpdateRole(ctx context.Context, input *iam.UpdateRoleInput, opts ...func(*iam.Options)) (*iam.UpdateRoleOutput, error)
+}
+
+// NewServiceLinkedRoleClient returns a new client given an aws config
+func NewServiceLinkedRoleClient(conf aws.Config) ServiceLinkedRoleClient {
+	return iam.NewFromConfig(conf)
+}
+
+// GenerateServiceLinkedRoleObservation is used to produce
+// ServiceLinkedRoleObservation from iamtypes.Role. The pending deletion task,
+// if any, is kept as it is not part of the role.
+func GenerateServiceLinkedRoleObservation(role iamtypes.Role, deletionTaskID string) v1alpha1.ServiceLinkedRoleObservation {
+	return v1alpha1.ServiceLinkedRoleObservation{
+		ARN:            aws.ToString(role.Arn),
+		RoleID:         aws.ToString(role.RoleId),
+		Path:           aws.ToString(role.Path),
+		DeletionTaskID: deletionTaskID,
+	}
+}
+
+// LateInitializeServiceLinkedRole fills the empty fields in
+// *v1alpha1.ServiceLinkedRoleParameters with the values seen in iamtypes.Role.
+// Many services set a description on the roles they are linked to.
+func LateInitializeServiceLinkedRole(in *v1alpha1.ServiceLinkedRoleParameters, role iamtypes.Role) {
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, role.Description)
+}
+
+// IsServiceLinkedRoleUpToDate checks whether the description of the role is
+// the desired one. The other parameters cannot be changed.
+func IsServiceLinkedRoleUpToDate(in v1alpha1.ServiceLinkedRoleParameters, role iamtypes.Role) bool {
+	return aws.ToString(in.Description) == aws.ToString(role.Description)
+}
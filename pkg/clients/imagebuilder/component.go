@@ -0,0 +1,67 @@
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+)
+
+// ComponentClient is the external client used for Component Custom Resource
+type ComponentClient interface {
+	CreateComponent(ctx context.Context, input *imagebuilder.CreateComponentInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.CreateComponentOutput, error)
+	GetComponent(ctx context.Context, input *imagebuilder.GetComponentInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.GetComponentOutput, error)
+	DeleteComponent(ctx context.Context, input *imagebuilder.DeleteComponentInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.DeleteComponentOutput, error)
+	TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// NewComponentClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewComponentClient(cfg aws.Config) ComponentClient {
+	return imagebuilder.NewFromConfig(cfg)
+}
+
+// GenerateCreateComponentInput returns the input for CreateComponent. The
+// client token makes retries of the same creation idempotent.
+func GenerateCreateComponentInput(clientToken string, p v1alpha1.ComponentParameters) *imagebuilder.CreateComponentInput {
+	return &imagebuilder.CreateComponentInput{
+		ClientToken:         aws.String(clientToken),
+		Name:                aws.String(p.Name),
+		SemanticVersion:     aws.String(p.SemanticVersion),
+		Platform:            types.Platform(p.Platform),
+		Data:                p.Data,
+		Uri:                 p.URI,
+		ChangeDescription:   p.ChangeDescription,
+		Description:         p.Description,
+		KmsKeyId:            p.KMSKeyID,
+		SupportedOsVersions: p.SupportedOSVersions,
+		Tags:                p.Tags,
+	}
+}
+
+// GenerateComponentObservation returns the observation of the given component.
+func GenerateComponentObservation(c types.Component) v1alpha1.ComponentObservation {
+	o := v1alpha1.ComponentObservation{
+		ARN:         aws.ToString(c.Arn),
+		Owner:       aws.ToString(c.Owner),
+		Type:        string(c.Type),
+		Encrypted:   aws.ToBool(c.Encrypted),
+		DateCreated: aws.ToString(c.DateCreated),
+	}
+	if c.State != nil {
+		o.Status = string(c.State.Status)
+	}
+	return o
+}
+
+// LateInitializeComponent fills the empty fields of the given parameters
+// with the values of the observed component.
+func LateInitializeComponent(p *v1alpha1.ComponentParameters, c types.Component) {
+	if len(p.SupportedOSVersions) == 0 && len(c.SupportedOsVersions) != 0 {
+		p.SupportedOSVersions = c.SupportedOsVersions
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ComponentClient = (*MockComponentClient)(nil)
+
+// MockComponentClient is a type that implements all the methods for
+// ComponentClient interface
+type MockComponentClient struct {
+	MockCreate        func(ctx context.Context, input *imagebuilder.CreateComponentInput, opts []func(*imagebuilder.Options)) (*imagebuilder.CreateComponentOutput, error)
+	MockGet           func(ctx context.Context, input *imagebuilder.GetComponentInput, opts []func(*imagebuilder.Options)) (*imagebuilder.GetComponentOutput, error)
+	MockDelete        func(ctx context.Context, input *imagebuilder.DeleteComponentInput, opts []func(*imagebuilder.Options)) (*imagebuilder.DeleteComponentOutput, error)
+	MockTagResource   func(ctx context.Context, input *imagebuilder.TagResourceInput, opts []func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	MockUntagResource func(ctx context.Context, input *imagebuilder.UntagResourceInput, opts []func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// CreateComponent mocks CreateComponent method
+func (m *MockComponentClient) CreateComponent(ctx context.Context, input *imagebuilder.CreateComponentInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.CreateComponentOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// GetComponent mocks GetComponent method
+func (m *MockComponentClient) GetComponent(ctx context.Context, input *imagebuilder.GetComponentInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.GetComponentOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// DeleteComponent mocks DeleteComponent method
+func (m *MockComponentClient) DeleteComponent(ctx context.Context, input *imagebuilder.DeleteComponentInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.DeleteComponentOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// TagResource mocks TagResource method
+func (m *MockComponentClient) TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error) {
+	return m.MockTagResource(ctx, input, opts)
+}
+
+// UntagResource mocks UntagResource method
+func (m *MockComponentClient) UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error) {
+	return m.MockUntagResource(ctx, input, opts)
+}
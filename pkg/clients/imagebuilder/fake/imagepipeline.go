@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ImagePipelineClient = (*MockImagePipelineClient)(nil)
+
+// MockImagePipelineClient is a type that implements all the methods for
+// ImagePipelineClient interface
+type MockImagePipelineClient struct {
+	MockCreate        func(ctx context.Context, input *imagebuilder.CreateImagePipelineInput, opts []func(*imagebuilder.Options)) (*imagebuilder.CreateImagePipelineOutput, error)
+	MockGet           func(ctx context.Context, input *imagebuilder.GetImagePipelineInput, opts []func(*imagebuilder.Options)) (*imagebuilder.GetImagePipelineOutput, error)
+	MockUpdate        func(ctx context.Context, input *imagebuilder.UpdateImagePipelineInput, opts []func(*imagebuilder.Options)) (*imagebuilder.UpdateImagePipelineOutput, error)
+	MockDelete        func(ctx context.Context, input *imagebuilder.DeleteImagePipelineInput, opts []func(*imagebuilder.Options)) (*imagebuilder.DeleteImagePipelineOutput, error)
+	MockTagResource   func(ctx context.Context, input *imagebuilder.TagResourceInput, opts []func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	MockUntagResource func(ctx context.Context, input *imagebuilder.UntagResourceInput, opts []func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// CreateImagePipeline mocks CreateImagePipeline method
+func (m *MockImagePipelineClient) CreateImagePipeline(ctx context.Context, input *imagebuilder.CreateImagePipelineInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.CreateImagePipelineOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// GetImagePipeline mocks GetImagePipeline method
+func (m *MockImagePipelineClient) GetImagePipeline(ctx context.Context, input *imagebuilder.GetImagePipelineInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.GetImagePipelineOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// UpdateImagePipeline mocks UpdateImagePipeline method
+func (m *MockImagePipelineClient) UpdateImagePipeline(ctx context.Context, input *imagebuilder.UpdateImagePipelineInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UpdateImagePipelineOutput, error) {
+	return m.MockUpdate(ctx, input, opts)
+}
+
+// DeleteImagePipeline mocks DeleteImagePipeline method
+func (m *MockImagePipelineClient) DeleteImagePipeline(ctx context.Context, input *imagebuilder.DeleteImagePipelineInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.DeleteImagePipelineOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// TagResource mocks TagResource method
+func (m *MockImagePipelineClient) TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error) {
+	return m.MockTagResource(ctx, input, opts)
+}
+
+// UntagResource mocks UntagResource method
+func (m *MockImagePipelineClient) UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error) {
+	return m.MockUntagResource(ctx, input, opts)
+}
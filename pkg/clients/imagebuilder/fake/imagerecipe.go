@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ImageRecipeClient = (*MockImageRecipeClient)(nil)
+
+// MockImageRecipeClient is a type that implements all the methods for
+// ImageRecipeClient interface
+type MockImageRecipeClient struct {
+	MockCreate        func(ctx context.Context, input *imagebuilder.CreateImageRecipeInput, opts []func(*imagebuilder.Options)) (*imagebuilder.CreateImageRecipeOutput, error)
+	MockGet           func(ctx context.Context, input *imagebuilder.GetImageRecipeInput, opts []func(*imagebuilder.Options)) (*imagebuilder.GetImageRecipeOutput, error)
+	MockDelete        func(ctx context.Context, input *imagebuilder.DeleteImageRecipeInput, opts []func(*imagebuilder.Options)) (*imagebuilder.DeleteImageRecipeOutput, error)
+	MockTagResource   func(ctx context.Context, input *imagebuilder.TagResourceInput, opts []func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	MockUntagResource func(ctx context.Context, input *imagebuilder.UntagResourceInput, opts []func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// CreateImageRecipe mocks CreateImageRecipe method
+func (m *MockImageRecipeClient) CreateImageRecipe(ctx context.Context, input *imagebuilder.CreateImageRecipeInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.CreateImageRecipeOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// GetImageRecipe mocks GetImageRecipe method
+func (m *MockImageRecipeClient) GetImageRecipe(ctx context.Context, input *imagebuilder.GetImageRecipeInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.GetImageRecipeOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// DeleteImageRecipe mocks DeleteImageRecipe method
+func (m *MockImageRecipeClient) DeleteImageRecipe(ctx context.Context, input *imagebuilder.DeleteImageRecipeInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.DeleteImageRecipeOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// TagResource mocks TagResource method
+func (m *MockImageRecipeClient) TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error) {
+	return m.MockTagResource(ctx, input, opts)
+}
+
+// UntagResource mocks UntagResource method
+func (m *MockImageRecipeClient) UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error) {
+	return m.MockUntagResource(ctx, input, opts)
+}
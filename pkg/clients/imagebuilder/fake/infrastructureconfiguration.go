@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/imagebuilder"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.InfrastructureConfigurationClient = (*MockInfrastructureConfigurationClient)(nil)
+
+// MockInfrastructureConfigurationClient is a type that implements all the methods for
+// InfrastructureConfigurationClient interface
+type MockInfrastructureConfigurationClient struct {
+	MockCreate        func(ctx context.Context, input *imagebuilder.CreateInfrastructureConfigurationInput, opts []func(*imagebuilder.Options)) (*imagebuilder.CreateInfrastructureConfigurationOutput, error)
+	MockGet           func(ctx context.Context, input *imagebuilder.GetInfrastructureConfigurationInput, opts []func(*imagebuilder.Options)) (*imagebuilder.GetInfrastructureConfigurationOutput, error)
+	MockUpdate        func(ctx context.Context, input *imagebuilder.UpdateInfrastructureConfigurationInput, opts []func(*imagebuilder.Options)) (*imagebuilder.UpdateInfrastructureConfigurationOutput, error)
+	MockDelete        func(ctx context.Context, input *imagebuilder.DeleteInfrastructureConfigurationInput, opts []func(*imagebuilder.Options)) (*imagebuilder.DeleteInfrastructureConfigurationOutput, error)
+	MockTagResource   func(ctx context.Context, input *imagebuilder.TagResourceInput, opts []func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	MockUntagResource func(ctx context.Context, input *imagebuilder.UntagResourceInput, opts []func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// CreateInfrastructureConfiguration mocks CreateInfrastructureConfiguration method
+func (m *MockInfrastructureConfigurationClient) CreateInfrastructureConfiguration(ctx context.Context, input *imagebuilder.CreateInfrastructureConfigurationInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.CreateInfrastructureConfigurationOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// GetInfrastructureConfiguration mocks GetInfrastructureConfiguration method
+func (m *MockInfrastructureConfigurationClient) GetInfrastructureConfiguration(ctx context.Context, input *imagebuilder.GetInfrastructureConfigurationInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.GetInfrastructureConfigurationOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// UpdateInfrastructureConfiguration mocks UpdateInfrastructureConfiguration method
+func (m *MockInfrastructureConfigurationClient) UpdateInfrastructureConfiguration(ctx context.Context, input *imagebuilder.UpdateInfrastructureConfigurationInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UpdateInfrastructureConfigurationOutput, error) {
+	return m.MockUpdate(ctx, input, opts)
+}
+
+// DeleteInfrastructureConfiguration mocks DeleteInfrastructureConfiguration method
+func (m *MockInfrastructureConfigurationClient) DeleteInfrastructureConfiguration(ctx context.Context, input *imagebuilder.DeleteInfrastructureConfigurationInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.DeleteInfrastructureConfigurationOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// TagResource mocks TagResource method
+func (m *MockInfrastructureConfigurationClient) TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error) {
+	return m.MockTagResource(ctx, input, opts)
+}
+
+// UntagResource mocks UntagResource method
+func (m *MockInfrastructureConfigurationClient) UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error) {
+	return m.MockUntagResource(ctx, input, opts)
+}
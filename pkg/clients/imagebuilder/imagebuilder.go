@@ -0,0 +1,59 @@
+package imagebuilder
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errTagResource   = "cannot tag Image Builder resource"
+	errUntagResource = "cannot untag Image Builder resource"
+)
+
+// TagClient is the part of the Image Builder API that manages the tags of
+// all Image Builder resources.
+type TagClient interface {
+	TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// IsNotFound returns true if the error is because the resource doesn't exist.
+func IsNotFound(err error) bool {
+	var nf *types.ResourceNotFoundException
+	return errors.As(err, &nf)
+}
+
+// optionalStringEqual returns true if the desired value is unset or equal to
+// the observed one.
+func optionalStringEqual(desired, observed *string) bool {
+	return desired == nil || aws.ToString(desired) == aws.ToString(observed)
+}
+
+// UpdateTags makes the tags of the resource with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, client TagClient, arn string, desired, observed map[string]string) error {
+	add, remove := awsclient.DiffTags(desired, observed)
+	if len(remove) > 0 {
+		if _, err := client.UntagResource(ctx, &imagebuilder.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     remove,
+		}); err != nil {
+			return awsclient.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResource(ctx, &imagebuilder.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        add,
+		}); err != nil {
+			return awsclient.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
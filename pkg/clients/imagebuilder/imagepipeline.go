@@ -0,0 +1,149 @@
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ImagePipelineClient is the external client used for ImagePipeline Custom
+// Resource
+type ImagePipelineClient interface {
+	CreateImagePipeline(ctx context.Context, input *imagebuilder.CreateImagePipelineInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.CreateImagePipelineOutput, error)
+	GetImagePipeline(ctx context.Context, input *imagebuilder.GetImagePipelineInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.GetImagePipelineOutput, error)
+	UpdateImagePipeline(ctx context.Context, input *imagebuilder.UpdateImagePipelineInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UpdateImagePipelineOutput, error)
+	DeleteImagePipeline(ctx context.Context, input *imagebuilder.DeleteImagePipelineInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.DeleteImagePipelineOutput, error)
+	TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// NewImagePipelineClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewImagePipelineClient(cfg aws.Config) ImagePipelineClient {
+	return imagebuilder.NewFromConfig(cfg)
+}
+
+func generateImageTestsConfiguration(c *v1alpha1.ImageTestsConfiguration) *types.ImageTestsConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &types.ImageTestsConfiguration{
+		ImageTestsEnabled: c.ImageTestsEnabled,
+		TimeoutMinutes:    c.TimeoutMinutes,
+	}
+}
+
+func generateSchedule(s *v1alpha1.Schedule) *types.Schedule {
+	if s == nil {
+		return nil
+	}
+	return &types.Schedule{
+		ScheduleExpression:              s.ScheduleExpression,
+		PipelineExecutionStartCondition: types.PipelineExecutionStartCondition(aws.ToString(s.PipelineExecutionStartCondition)),
+		Timezone:                        s.Timezone,
+	}
+}
+
+// GenerateCreateImagePipelineInput returns the input for CreateImagePipeline.
+func GenerateCreateImagePipelineInput(clientToken string, p v1alpha1.ImagePipelineParameters) *imagebuilder.CreateImagePipelineInput {
+	return &imagebuilder.CreateImagePipelineInput{
+		ClientToken:                    aws.String(clientToken),
+		Name:                           aws.String(p.Name),
+		ImageRecipeArn:                 p.ImageRecipeARN,
+		InfrastructureConfigurationArn: p.InfrastructureConfigurationARN,
+		DistributionConfigurationArn:   p.DistributionConfigurationARN,
+		Description:                    p.Description,
+		EnhancedImageMetadataEnabled:   p.EnhancedImageMetadataEnabled,
+		ImageTestsConfiguration:        generateImageTestsConfiguration(p.ImageTestsConfiguration),
+		Schedule:                       generateSchedule(p.Schedule),
+		Status:                         types.PipelineStatus(aws.ToString(p.Status)),
+		Tags:                           p.Tags,
+	}
+}
+
+// GenerateUpdateImagePipelineInput returns the input for UpdateImagePipeline.
+// Fields that are omitted from an update are reset, so the input always
+// carries the complete desired state.
+func GenerateUpdateImagePipelineInput(arn, clientToken string, p v1alpha1.ImagePipelineParameters) *imagebuilder.UpdateImagePipelineInput {
+	return &imagebuilder.UpdateImagePipelineInput{
+		ClientToken:                    aws.String(clientToken),
+		ImagePipelineArn:               aws.String(arn),
+		ImageRecipeArn:                 p.ImageRecipeARN,
+		InfrastructureConfigurationArn: p.InfrastructureConfigurationARN,
+		DistributionConfigurationArn:   p.DistributionConfigurationARN,
+		Description:                    p.Description,
+		EnhancedImageMetadataEnabled:   p.EnhancedImageMetadataEnabled,
+		ImageTestsConfiguration:        generateImageTestsConfiguration(p.ImageTestsConfiguration),
+		Schedule:                       generateSchedule(p.Schedule),
+		Status:                         types.PipelineStatus(aws.ToString(p.Status)),
+	}
+}
+
+// GenerateImagePipelineObservation returns the observation of the given image
+// pipeline.
+func GenerateImagePipelineObservation(p types.ImagePipeline) v1alpha1.ImagePipelineObservation {
+	return v1alpha1.ImagePipelineObservation{
+		ARN:         aws.ToString(p.Arn),
+		Platform:    string(p.Platform),
+		DateCreated: aws.ToString(p.DateCreated),
+		DateUpdated: aws.ToString(p.DateUpdated),
+		DateLastRun: aws.ToString(p.DateLastRun),
+		DateNextRun: aws.ToString(p.DateNextRun),
+	}
+}
+
+// LateInitializeImagePipeline fills the empty fields of the given parameters
+// with the values of the observed image pipeline.
+func LateInitializeImagePipeline(p *v1alpha1.ImagePipelineParameters, o types.ImagePipeline) {
+	p.EnhancedImageMetadataEnabled = awsclient.LateInitializeBoolPtr(p.EnhancedImageMetadataEnabled, o.EnhancedImageMetadataEnabled)
+	if p.Status == nil && o.Status != "" {
+		p.Status = aws.String(string(o.Status))
+	}
+	if p.ImageTestsConfiguration == nil && o.ImageTestsConfiguration != nil {
+		p.ImageTestsConfiguration = &v1alpha1.ImageTestsConfiguration{
+			ImageTestsEnabled: o.ImageTestsConfiguration.ImageTestsEnabled,
+			TimeoutMinutes:    o.ImageTestsConfiguration.TimeoutMinutes,
+		}
+	}
+	if p.Schedule != nil && p.Schedule.PipelineExecutionStartCondition == nil && o.Schedule != nil && o.Schedule.PipelineExecutionStartCondition != "" {
+		p.Schedule.PipelineExecutionStartCondition = aws.String(string(o.Schedule.PipelineExecutionStartCondition))
+	}
+}
+
+// IsImagePipelineUpToDate checks whether the updatable fields of the image
+// pipeline are up to date.
+func IsImagePipelineUpToDate(p v1alpha1.ImagePipelineParameters, o types.ImagePipeline) bool { // nolint:gocyclo
+	if !(aws.ToString(p.ImageRecipeARN) == aws.ToString(o.ImageRecipeArn) &&
+		aws.ToString(p.InfrastructureConfigurationARN) == aws.ToString(o.InfrastructureConfigurationArn) &&
+		aws.ToString(p.DistributionConfigurationARN) == aws.ToString(o.DistributionConfigurationArn) &&
+		optionalStringEqual(p.Description, o.Description) &&
+		(p.EnhancedImageMetadataEnabled == nil || aws.ToBool(p.EnhancedImageMetadataEnabled) == aws.ToBool(o.EnhancedImageMetadataEnabled)) &&
+		(p.Status == nil || aws.ToString(p.Status) == string(o.Status))) {
+		return false
+	}
+	if c := p.ImageTestsConfiguration; c != nil {
+		if o.ImageTestsConfiguration == nil ||
+			(c.ImageTestsEnabled != nil && aws.ToBool(c.ImageTestsEnabled) != aws.ToBool(o.ImageTestsConfiguration.ImageTestsEnabled)) ||
+			(c.TimeoutMinutes != nil && aws.ToInt32(c.TimeoutMinutes) != aws.ToInt32(o.ImageTestsConfiguration.TimeoutMinutes)) {
+			return false
+		}
+	}
+	observed := o.Schedule
+	if observed != nil && observed.ScheduleExpression == nil {
+		observed = nil
+	}
+	switch {
+	case p.Schedule == nil || p.Schedule.ScheduleExpression == nil:
+		return observed == nil
+	case observed == nil:
+		return false
+	}
+	return aws.ToString(p.Schedule.ScheduleExpression) == aws.ToString(observed.ScheduleExpression) &&
+		optionalStringEqual(p.Schedule.PipelineExecutionStartCondition, aws.String(string(observed.PipelineExecutionStartCondition))) &&
+		optionalStringEqual(p.Schedule.Timezone, observed.Timezone)
+}
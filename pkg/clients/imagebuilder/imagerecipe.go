@@ -0,0 +1,92 @@
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+)
+
+// ImageRecipeClient is the external client used for ImageRecipe Custom
+// Resource
+type ImageRecipeClient interface {
+	CreateImageRecipe(ctx context.Context, input *imagebuilder.CreateImageRecipeInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.CreateImageRecipeOutput, error)
+	GetImageRecipe(ctx context.Context, input *imagebuilder.GetImageRecipeInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.GetImageRecipeOutput, error)
+	DeleteImageRecipe(ctx context.Context, input *imagebuilder.DeleteImageRecipeInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.DeleteImageRecipeOutput, error)
+	TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// NewImageRecipeClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewImageRecipeClient(cfg aws.Config) ImageRecipeClient {
+	return imagebuilder.NewFromConfig(cfg)
+}
+
+// GenerateCreateImageRecipeInput returns the input for CreateImageRecipe.
+func GenerateCreateImageRecipeInput(clientToken string, p v1alpha1.ImageRecipeParameters) *imagebuilder.CreateImageRecipeInput {
+	in := &imagebuilder.CreateImageRecipeInput{
+		ClientToken:      aws.String(clientToken),
+		Name:             aws.String(p.Name),
+		SemanticVersion:  aws.String(p.SemanticVersion),
+		ParentImage:      aws.String(p.ParentImage),
+		Description:      p.Description,
+		WorkingDirectory: p.WorkingDirectory,
+		Tags:             p.Tags,
+	}
+	for _, c := range p.Components {
+		cc := types.ComponentConfiguration{ComponentArn: c.ComponentARN}
+		for _, param := range c.Parameters {
+			cc.Parameters = append(cc.Parameters, types.ComponentParameter{
+				Name:  aws.String(param.Name),
+				Value: param.Value,
+			})
+		}
+		in.Components = append(in.Components, cc)
+	}
+	for _, m := range p.BlockDeviceMappings {
+		bdm := types.InstanceBlockDeviceMapping{
+			DeviceName:  m.DeviceName,
+			NoDevice:    m.NoDevice,
+			VirtualName: m.VirtualName,
+		}
+		if m.EBS != nil {
+			bdm.Ebs = &types.EbsInstanceBlockDeviceSpecification{
+				DeleteOnTermination: m.EBS.DeleteOnTermination,
+				Encrypted:           m.EBS.Encrypted,
+				Iops:                m.EBS.IOPS,
+				KmsKeyId:            m.EBS.KMSKeyID,
+				SnapshotId:          m.EBS.SnapshotID,
+				Throughput:          m.EBS.Throughput,
+				VolumeSize:          m.EBS.VolumeSize,
+				VolumeType:          types.EbsVolumeType(aws.ToString(m.EBS.VolumeType)),
+			}
+		}
+		in.BlockDeviceMappings = append(in.BlockDeviceMappings, bdm)
+	}
+	if c := p.AdditionalInstanceConfiguration; c != nil {
+		in.AdditionalInstanceConfiguration = &types.AdditionalInstanceConfiguration{
+			UserDataOverride: c.UserDataOverride,
+		}
+		if c.SystemsManagerAgent != nil {
+			in.AdditionalInstanceConfiguration.SystemsManagerAgent = &types.SystemsManagerAgent{
+				UninstallAfterBuild: c.SystemsManagerAgent.UninstallAfterBuild,
+			}
+		}
+	}
+	return in
+}
+
+// GenerateImageRecipeObservation returns the observation of the given image
+// recipe.
+func GenerateImageRecipeObservation(r types.ImageRecipe) v1alpha1.ImageRecipeObservation {
+	return v1alpha1.ImageRecipeObservation{
+		ARN:         aws.ToString(r.Arn),
+		Owner:       aws.ToString(r.Owner),
+		Platform:    string(r.Platform),
+		DateCreated: aws.ToString(r.DateCreated),
+	}
+}
@@ -0,0 +1,111 @@
+package imagebuilder
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+)
+
+func TestGenerateCreateImageRecipeInput(t *testing.T) {
+	componentARN := "arn:aws:imagebuilder:us-east-1:123456789012:component/sample/1.0.0/1"
+	gp3 := string(types.EbsVolumeTypeGp3)
+
+	cases := map[string]struct {
+		p    v1alpha1.ImageRecipeParameters
+		want *imagebuilder.CreateImageRecipeInput
+	}{
+		"Minimal": {
+			p: v1alpha1.ImageRecipeParameters{
+				Name:            "sample",
+				SemanticVersion: "1.0.0",
+				ParentImage:     "ami-123",
+				Components: []v1alpha1.ComponentConfiguration{
+					{ComponentARN: aws.String(componentARN)},
+				},
+			},
+			want: &imagebuilder.CreateImageRecipeInput{
+				ClientToken:     aws.String("token"),
+				Name:            aws.String("sample"),
+				SemanticVersion: aws.String("1.0.0"),
+				ParentImage:     aws.String("ami-123"),
+				Components: []types.ComponentConfiguration{
+					{ComponentArn: aws.String(componentARN)},
+				},
+			},
+		},
+		"Full": {
+			p: v1alpha1.ImageRecipeParameters{
+				Name:            "sample",
+				SemanticVersion: "1.0.0",
+				ParentImage:     "ami-123",
+				Components: []v1alpha1.ComponentConfiguration{{
+					ComponentARN: aws.String(componentARN),
+					Parameters: []v1alpha1.ComponentParameter{
+						{Name: "packages", Value: []string{"git", "jq"}},
+					},
+				}},
+				BlockDeviceMappings: []v1alpha1.InstanceBlockDeviceMapping{{
+					DeviceName: aws.String("/dev/xvda"),
+					EBS: &v1alpha1.EBSInstanceBlockDeviceSpecification{
+						VolumeSize: aws.Int32(30),
+						VolumeType: &gp3,
+					},
+				}},
+				AdditionalInstanceConfiguration: &v1alpha1.AdditionalInstanceConfiguration{
+					SystemsManagerAgent: &v1alpha1.SystemsManagerAgent{
+						UninstallAfterBuild: aws.Bool(true),
+					},
+				},
+				Tags: map[string]string{"k": "v"},
+			},
+			want: &imagebuilder.CreateImageRecipeInput{
+				ClientToken:     aws.String("token"),
+				Name:            aws.String("sample"),
+				SemanticVersion: aws.String("1.0.0"),
+				ParentImage:     aws.String("ami-123"),
+				Components: []types.ComponentConfiguration{{
+					ComponentArn: aws.String(componentARN),
+					Parameters: []types.ComponentParameter{
+						{Name: aws.String("packages"), Value: []string{"git", "jq"}},
+					},
+				}},
+				BlockDeviceMappings: []types.InstanceBlockDeviceMapping{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs: &types.EbsInstanceBlockDeviceSpecification{
+						VolumeSize: aws.Int32(30),
+						VolumeType: types.EbsVolumeTypeGp3,
+					},
+				}},
+				AdditionalInstanceConfiguration: &types.AdditionalInstanceConfiguration{
+					SystemsManagerAgent: &types.SystemsManagerAgent{
+						UninstallAfterBuild: aws.Bool(true),
+					},
+				},
+				Tags: map[string]string{"k": "v"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateImageRecipeInput("token", tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(
+				imagebuilder.CreateImageRecipeInput{},
+				types.ComponentConfiguration{},
+				types.ComponentParameter{},
+				types.InstanceBlockDeviceMapping{},
+				types.EbsInstanceBlockDeviceSpecification{},
+				types.AdditionalInstanceConfiguration{},
+				types.SystemsManagerAgent{},
+			)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// InfrastructureConfigurationClient is the external client used for
+// InfrastructureConfiguration Custom Resource
+type InfrastructureConfigurationClient interface {
+	CreateInfrastructureConfiguration(ctx context.Context, input *imagebuilder.CreateInfrastructureConfigurationInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.CreateInfrastructureConfigurationOutput, error)
+	GetInfrastructureConfiguration(ctx context.Context, input *imagebuilder.GetInfrastructureConfigurationInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.GetInfrastructureConfigurationOutput, error)
+	UpdateInfrastructureConfiguration(ctx context.Context, input *imagebuilder.UpdateInfrastructureConfigurationInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UpdateInfrastructureConfigurationOutput, error)
+	DeleteInfrastructureConfiguration(ctx context.Context, input *imagebuilder.DeleteInfrastructureConfigurationInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.DeleteInfrastructureConfigurationOutput, error)
+	TagResource(ctx context.Context, input *imagebuilder.TagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.TagResourceOutput, error)
+	UntagResource(ctx context.Context, input *imagebuilder.UntagResourceInput, opts ...func(*imagebuilder.Options)) (*imagebuilder.UntagResourceOutput, error)
+}
+
+// NewInfrastructureConfigurationClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewInfrastructureConfigurationClient(cfg aws.Config) InfrastructureConfigurationClient {
+	return imagebuilder.NewFromConfig(cfg)
+}
+
+func generateLogging(l *v1alpha1.Logging) *types.Logging {
+	if l == nil || l.S3Logs == nil {
+		return nil
+	}
+	return &types.Logging{
+		S3Logs: &types.S3Logs{
+			S3BucketName: l.S3Logs.S3BucketName,
+			S3KeyPrefix:  l.S3Logs.S3KeyPrefix,
+		},
+	}
+}
+
+func generateInstanceMetadataOptions(o *v1alpha1.InstanceMetadataOptions) *types.InstanceMetadataOptions {
+	if o == nil {
+		return nil
+	}
+	return &types.InstanceMetadataOptions{
+		HttpPutResponseHopLimit: o.HTTPPutResponseHopLimit,
+		HttpTokens:              o.HTTPTokens,
+	}
+}
+
+// GenerateCreateInfrastructureConfigurationInput returns the input for
+// CreateInfrastructureConfiguration.
+func GenerateCreateInfrastructureConfigurationInput(clientToken string, p v1alpha1.InfrastructureConfigurationParameters) *imagebuilder.CreateInfrastructureConfigurationInput {
+	return &imagebuilder.CreateInfrastructureConfigurationInput{
+		ClientToken:                aws.String(clientToken),
+		Name:                       aws.String(p.Name),
+		InstanceProfileName:        aws.String(p.InstanceProfileName),
+		InstanceTypes:              p.InstanceTypes,
+		SubnetId:                   p.SubnetID,
+		SecurityGroupIds:           p.SecurityGroupIDs,
+		KeyPair:                    p.KeyPair,
+		Logging:                    generateLogging(p.Logging),
+		SnsTopicArn:                p.SNSTopicARN,
+		TerminateInstanceOnFailure: p.TerminateInstanceOnFailure,
+		InstanceMetadataOptions:    generateInstanceMetadataOptions(p.InstanceMetadataOptions),
+		Description:                p.Description,
+		ResourceTags:               p.ResourceTags,
+		Tags:                       p.Tags,
+	}
+}
+
+// GenerateUpdateInfrastructureConfigurationInput returns the input for
+// UpdateInfrastructureConfiguration. Fields that are omitted from an update
+// are reset, so the input always carries the complete desired state.
+func GenerateUpdateInfrastructureConfigurationInput(arn, clientToken string, p v1alpha1.InfrastructureConfigurationParameters) *imagebuilder.UpdateInfrastructureConfigurationInput {
+	return &imagebuilder.UpdateInfrastructureConfigurationInput{
+		ClientToken:                    aws.String(clientToken),
+		InfrastructureConfigurationArn: aws.String(arn),
+		InstanceProfileName:            aws.String(p.InstanceProfileName),
+		InstanceTypes:                  p.InstanceTypes,
+		SubnetId:                       p.SubnetID,
+		SecurityGroupIds:               p.SecurityGroupIDs,
+		KeyPair:                        p.KeyPair,
+		Logging:                        generateLogging(p.Logging),
+		SnsTopicArn:                    p.SNSTopicARN,
+		TerminateInstanceOnFailure:     p.TerminateInstanceOnFailure,
+		InstanceMetadataOptions:        generateInstanceMetadataOptions(p.InstanceMetadataOptions),
+		Description:                    p.Description,
+		ResourceTags:                   p.ResourceTags,
+	}
+}
+
+// GenerateInfrastructureConfigurationObservation returns the observation of
+// the given infrastructure configuration.
+func GenerateInfrastructureConfigurationObservation(c types.InfrastructureConfiguration) v1alpha1.InfrastructureConfigurationObservation {
+	return v1alpha1.InfrastructureConfigurationObservation{
+		ARN:         aws.ToString(c.Arn),
+		DateCreated: aws.ToString(c.DateCreated),
+		DateUpdated: aws.ToString(c.DateUpdated),
+	}
+}
+
+// LateInitializeInfrastructureConfiguration fills the empty fields of the
+// given parameters with the values of the observed infrastructure
+// configuration.
+func LateInitializeInfrastructureConfiguration(p *v1alpha1.InfrastructureConfigurationParameters, c types.InfrastructureConfiguration) {
+	if len(p.InstanceTypes) == 0 && len(c.InstanceTypes) != 0 {
+		p.InstanceTypes = c.InstanceTypes
+	}
+	p.TerminateInstanceOnFailure = awsclient.LateInitializeBoolPtr(p.TerminateInstanceOnFailure, c.TerminateInstanceOnFailure)
+	if p.InstanceMetadataOptions == nil && c.InstanceMetadataOptions != nil {
+		p.InstanceMetadataOptions = &v1alpha1.InstanceMetadataOptions{
+			HTTPPutResponseHopLimit: c.InstanceMetadataOptions.HttpPutResponseHopLimit,
+			HTTPTokens:              c.InstanceMetadataOptions.HttpTokens,
+		}
+	}
+}
+
+// IsInfrastructureConfigurationUpToDate checks whether the updatable fields
+// of the infrastructure configuration are up to date.
+func IsInfrastructureConfigurationUpToDate(p v1alpha1.InfrastructureConfigurationParameters, c types.InfrastructureConfiguration) bool {
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	observedLogging := c.Logging
+	if observedLogging != nil && observedLogging.S3Logs == nil {
+		observedLogging = nil
+	}
+	return p.InstanceProfileName == aws.ToString(c.InstanceProfileName) &&
+		cmp.Equal(p.InstanceTypes, c.InstanceTypes, cmpopts.EquateEmpty(), sortStrings) &&
+		aws.ToString(p.SubnetID) == aws.ToString(c.SubnetId) &&
+		cmp.Equal(p.SecurityGroupIDs, c.SecurityGroupIds, cmpopts.EquateEmpty(), sortStrings) &&
+		aws.ToString(p.KeyPair) == aws.ToString(c.KeyPair) &&
+		cmp.Equal(generateLogging(p.Logging), observedLogging, cmpopts.IgnoreUnexported(types.Logging{}, types.S3Logs{})) &&
+		aws.ToString(p.SNSTopicARN) == aws.ToString(c.SnsTopicArn) &&
+		aws.ToBool(p.TerminateInstanceOnFailure) == aws.ToBool(c.TerminateInstanceOnFailure) &&
+		(p.InstanceMetadataOptions == nil || cmp.Equal(generateInstanceMetadataOptions(p.InstanceMetadataOptions), c.InstanceMetadataOptions, cmpopts.IgnoreUnexported(types.InstanceMetadataOptions{}))) &&
+		optionalStringEqual(p.Description, c.Description) &&
+		cmp.Equal(p.ResourceTags, c.ResourceTags, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,118 @@
+package imagebuilder
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+)
+
+func infrastructureConfigurationParameters() v1alpha1.InfrastructureConfigurationParameters {
+	return v1alpha1.InfrastructureConfigurationParameters{
+		Name:                "sample",
+		InstanceProfileName: "sample-profile",
+		InstanceTypes:       []string{"t3.medium", "t3.large"},
+		SubnetID:            aws.String("subnet-1"),
+		SecurityGroupIDs:    []string{"sg-2", "sg-1"},
+		Logging: &v1alpha1.Logging{
+			S3Logs: &v1alpha1.S3Logs{
+				S3BucketName: aws.String("logs"),
+			},
+		},
+		TerminateInstanceOnFailure: aws.Bool(true),
+	}
+}
+
+func infrastructureConfiguration() types.InfrastructureConfiguration {
+	return types.InfrastructureConfiguration{
+		Name:                aws.String("sample"),
+		InstanceProfileName: aws.String("sample-profile"),
+		InstanceTypes:       []string{"t3.large", "t3.medium"},
+		SubnetId:            aws.String("subnet-1"),
+		SecurityGroupIds:    []string{"sg-1", "sg-2"},
+		Logging: &types.Logging{
+			S3Logs: &types.S3Logs{
+				S3BucketName: aws.String("logs"),
+			},
+		},
+		TerminateInstanceOnFailure: aws.Bool(true),
+		InstanceMetadataOptions: &types.InstanceMetadataOptions{
+			HttpPutResponseHopLimit: aws.Int32(1),
+			HttpTokens:              aws.String("optional"),
+		},
+	}
+}
+
+func TestIsInfrastructureConfigurationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    func(*v1alpha1.InfrastructureConfigurationParameters)
+		c    func(*types.InfrastructureConfiguration)
+		want bool
+	}{
+		"UpToDate": {
+			want: true,
+		},
+		"InstanceProfileChanged": {
+			p: func(p *v1alpha1.InfrastructureConfigurationParameters) {
+				p.InstanceProfileName = "other-profile"
+			},
+		},
+		"SecurityGroupAdded": {
+			p: func(p *v1alpha1.InfrastructureConfigurationParameters) {
+				p.SecurityGroupIDs = append(p.SecurityGroupIDs, "sg-3")
+			},
+		},
+		"LoggingRemoved": {
+			p: func(p *v1alpha1.InfrastructureConfigurationParameters) {
+				p.Logging = nil
+			},
+		},
+		"KeyPrefixChanged": {
+			p: func(p *v1alpha1.InfrastructureConfigurationParameters) {
+				p.Logging.S3Logs.S3KeyPrefix = aws.String("builds/")
+			},
+		},
+		"MetadataOptionsChanged": {
+			p: func(p *v1alpha1.InfrastructureConfigurationParameters) {
+				p.InstanceMetadataOptions = &v1alpha1.InstanceMetadataOptions{
+					HTTPPutResponseHopLimit: aws.Int32(1),
+					HTTPTokens:              aws.String("required"),
+				}
+			},
+		},
+		"ResourceTagsChanged": {
+			p: func(p *v1alpha1.InfrastructureConfigurationParameters) {
+				p.ResourceTags = map[string]string{"k": "v"}
+			},
+		},
+		"EmptyLoggingObserved": {
+			p: func(p *v1alpha1.InfrastructureConfigurationParameters) {
+				p.Logging = nil
+			},
+			c: func(c *types.InfrastructureConfiguration) {
+				c.Logging = &types.Logging{}
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := infrastructureConfigurationParameters()
+			if tc.p != nil {
+				tc.p(&p)
+			}
+			c := infrastructureConfiguration()
+			if tc.c != nil {
+				tc.c(&c)
+			}
+			got := IsInfrastructureConfigurationUpToDate(p, c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateRoutingConfig converts the routing configuration of an alias.
+// Lambda only removes the weights of an alias when it is given an empty map,
+// so an empty configuration is returned if none is desired.
+func GenerateRoutingConfig(c *v1alpha1.AliasRoutingConfiguration) *lambda.AliasRoutingConfiguration {
+	res := &lambda.AliasRoutingConfiguration{AdditionalVersionWeights: map[string]*float64{}}
+	if c == nil {
+		return res
+	}
+	for v, w := range c.AdditionalVersionWeights {
+		res.AdditionalVersionWeights[v] = aws.Float64(w)
+	}
+	return res
+}
+
+// GenerateCreateAliasInput returns the input to create the given alias.
+func GenerateCreateAliasInput(name string, p v1alpha1.AliasParameters) *lambda.CreateAliasInput {
+	res := &lambda.CreateAliasInput{
+		Name:            aws.String(name),
+		FunctionName:    p.FunctionName,
+		FunctionVersion: p.FunctionVersion,
+		Description:     p.Description,
+	}
+	if p.RoutingConfig != nil {
+		res.RoutingConfig = GenerateRoutingConfig(p.RoutingConfig)
+	}
+	return res
+}
+
+// GenerateUpdateAliasInput returns the input to update the given alias.
+func GenerateUpdateAliasInput(name string, p v1alpha1.AliasParameters) *lambda.UpdateAliasInput {
+	return &lambda.UpdateAliasInput{
+		Name:            aws.String(name),
+		FunctionName:    p.FunctionName,
+		FunctionVersion: p.FunctionVersion,
+		Description:     aws.String(aws.StringValue(p.Description)),
+		RoutingConfig:   GenerateRoutingConfig(p.RoutingConfig),
+	}
+}
+
+// GenerateAliasObservation returns the observation of the given alias.
+func GenerateAliasObservation(a *lambda.AliasConfiguration) v1alpha1.AliasObservation {
+	return v1alpha1.AliasObservation{
+		AliasARN:   aws.StringValue(a.AliasArn),
+		RevisionID: aws.StringValue(a.RevisionId),
+	}
+}
+
+// LateInitializeAlias fills the empty fields of the given parameters with the
+// values of the observed alias.
+func LateInitializeAlias(p *v1alpha1.AliasParameters, a *lambda.AliasConfiguration) {
+	p.FunctionVersion = awsclient.LateInitializeStringPtr(p.FunctionVersion, a.FunctionVersion)
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, a.Description)
+}
+
+// IsAliasUpToDate returns true if the observed alias points to the desired
+// versions with the desired weights.
+func IsAliasUpToDate(p v1alpha1.AliasParameters, a *lambda.AliasConfiguration) bool {
+	if aws.StringValue(p.FunctionVersion) != aws.StringValue(a.FunctionVersion) ||
+		aws.StringValue(p.Description) != aws.StringValue(a.Description) {
+		return false
+	}
+	observed := map[string]float64{}
+	if a.RoutingConfig != nil {
+		observed = aws.Float64ValueMap(a.RoutingConfig.AdditionalVersionWeights)
+	}
+	desired := map[string]float64{}
+	if p.RoutingConfig != nil && p.RoutingConfig.AdditionalVersionWeights != nil {
+		desired = p.RoutingConfig.AdditionalVersionWeights
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateFilterCriteria converts the filter criteria of an event source
+// mapping. Lambda only removes the filters of a mapping when it is given
+// empty criteria, so empty criteria are returned if none are desired.
+func GenerateFilterCriteria(c *v1alpha1.EventSourceMappingFilterCriteria) *lambda.FilterCriteria {
+	res := &lambda.FilterCriteria{Filters: []*lambda.Filter{}}
+	if c == nil {
+		return res
+	}
+	for _, f := range c.Filters {
+		res.Filters = append(res.Filters, &lambda.Filter{Pattern: aws.String(f.Pattern)})
+	}
+	return res
+}
+
+func generateDestinationConfig(c *v1alpha1.EventSourceMappingDestinationConfig) *lambda.DestinationConfig {
+	if c == nil {
+		return nil
+	}
+	return &lambda.DestinationConfig{
+		OnFailure: &lambda.OnFailure{Destination: aws.String(c.OnFailure.Destination)},
+	}
+}
+
+// GenerateCreateEventSourceMappingInput returns the input to create the given
+// event source mapping.
+func GenerateCreateEventSourceMappingInput(p v1alpha1.EventSourceMappingParameters) *lambda.CreateEventSourceMappingInput {
+	res := &lambda.CreateEventSourceMappingInput{
+		FunctionName:                   p.FunctionName,
+		EventSourceArn:                 p.EventSourceARN,
+		Enabled:                        p.Enabled,
+		BatchSize:                      p.BatchSize,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		StartingPosition:               p.StartingPosition,
+		Topics:                         aws.StringSlice(p.Topics),
+		DestinationConfig:              generateDestinationConfig(p.DestinationConfig),
+		BisectBatchOnFunctionError:     p.BisectBatchOnFunctionError,
+		MaximumRecordAgeInSeconds:      p.MaximumRecordAgeInSeconds,
+		MaximumRetryAttempts:           p.MaximumRetryAttempts,
+		ParallelizationFactor:          p.ParallelizationFactor,
+		TumblingWindowInSeconds:        p.TumblingWindowInSeconds,
+		FunctionResponseTypes:          aws.StringSlice(p.FunctionResponseTypes),
+	}
+	if p.StartingPositionTimestamp != nil {
+		res.StartingPositionTimestamp = aws.Time(p.StartingPositionTimestamp.Time)
+	}
+	if p.FilterCriteria != nil {
+		res.FilterCriteria = GenerateFilterCriteria(p.FilterCriteria)
+	}
+	return res
+}
+
+// GenerateUpdateEventSourceMappingInput returns the input to update the
+// event source mapping with the given UUID.
+func GenerateUpdateEventSourceMappingInput(uuid string, p v1alpha1.EventSourceMappingParameters) *lambda.UpdateEventSourceMappingInput {
+	return &lambda.UpdateEventSourceMappingInput{
+		UUID:                           aws.String(uuid),
+		FunctionName:                   p.FunctionName,
+		Enabled:                        aws.Bool(isEnabled(p)),
+		BatchSize:                      p.BatchSize,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		FilterCriteria:                 GenerateFilterCriteria(p.FilterCriteria),
+		DestinationConfig:              generateDestinationConfig(p.DestinationConfig),
+		BisectBatchOnFunctionError:     p.BisectBatchOnFunctionError,
+		MaximumRecordAgeInSeconds:      p.MaximumRecordAgeInSeconds,
+		MaximumRetryAttempts:           p.MaximumRetryAttempts,
+		ParallelizationFactor:          p.ParallelizationFactor,
+		TumblingWindowInSeconds:        p.TumblingWindowInSeconds,
+		FunctionResponseTypes:          aws.StringSlice(p.FunctionResponseTypes),
+	}
+}
+
+// GenerateEventSourceMappingObservation returns the observation of the given
+// event source mapping.
+func GenerateEventSourceMappingObservation(c *lambda.EventSourceMappingConfiguration) v1alpha1.EventSourceMappingObservation {
+	return v1alpha1.EventSourceMappingObservation{
+		UUID:                  aws.StringValue(c.UUID),
+		FunctionARN:           aws.StringValue(c.FunctionArn),
+		State:                 aws.StringValue(c.State),
+		StateTransitionReason: aws.StringValue(c.StateTransitionReason),
+		LastProcessingResult:  aws.StringValue(c.LastProcessingResult),
+	}
+}
+
+// LateInitializeEventSourceMapping fills the empty fields of the given
+// parameters with the values of the observed event source mapping. Lambda
+// only reports the settings that apply to the type of the event source.
+func LateInitializeEventSourceMapping(p *v1alpha1.EventSourceMappingParameters, c *lambda.EventSourceMappingConfiguration) {
+	p.EventSourceARN = awsclient.LateInitializeStringPtr(p.EventSourceARN, c.EventSourceArn)
+	p.BatchSize = awsclient.LateInitializeInt64Ptr(p.BatchSize, c.BatchSize)
+	p.MaximumBatchingWindowInSeconds = awsclient.LateInitializeInt64Ptr(p.MaximumBatchingWindowInSeconds, c.MaximumBatchingWindowInSeconds)
+	p.StartingPosition = awsclient.LateInitializeStringPtr(p.StartingPosition, c.StartingPosition)
+	p.BisectBatchOnFunctionError = awsclient.LateInitializeBoolPtr(p.BisectBatchOnFunctionError, c.BisectBatchOnFunctionError)
+	p.MaximumRecordAgeInSeconds = awsclient.LateInitializeInt64Ptr(p.MaximumRecordAgeInSeconds, c.MaximumRecordAgeInSeconds)
+	p.MaximumRetryAttempts = awsclient.LateInitializeInt64Ptr(p.MaximumRetryAttempts, c.MaximumRetryAttempts)
+	p.ParallelizationFactor = awsclient.LateInitializeInt64Ptr(p.ParallelizationFactor, c.ParallelizationFactor)
+	p.TumblingWindowInSeconds = awsclient.LateInitializeInt64Ptr(p.TumblingWindowInSeconds, c.TumblingWindowInSeconds)
+	if len(p.Topics) == 0 && len(c.Topics) > 0 {
+		p.Topics = aws.StringValueSlice(c.Topics)
+	}
+	if len(p.FunctionResponseTypes) == 0 && len(c.FunctionResponseTypes) > 0 {
+		p.FunctionResponseTypes = aws.StringValueSlice(c.FunctionResponseTypes)
+	}
+	if p.DestinationConfig == nil && c.DestinationConfig != nil && c.DestinationConfig.OnFailure != nil &&
+		aws.StringValue(c.DestinationConfig.OnFailure.Destination) != "" {
+		p.DestinationConfig = &v1alpha1.EventSourceMappingDestinationConfig{
+			OnFailure: v1alpha1.EventSourceMappingOnFailure{Destination: aws.StringValue(c.DestinationConfig.OnFailure.Destination)},
+		}
+	}
+}
+
+// isEnabled returns true if the event source mapping should poll its source,
+// which is the default.
+func isEnabled(p v1alpha1.EventSourceMappingParameters) bool {
+	return p.Enabled == nil || *p.Enabled
+}
+
+// IsFunctionUpToDate returns true if the observed event source mapping
+// invokes the desired function, which may be given by its name, its ARN or
+// either of them qualified with an alias or version.
+func IsFunctionUpToDate(p v1alpha1.EventSourceMappingParameters, c *lambda.EventSourceMappingConfiguration) bool {
+	desired := aws.StringValue(p.FunctionName)
+	observed := aws.StringValue(c.FunctionArn)
+	if strings.HasPrefix(desired, "arn:") {
+		return desired == observed
+	}
+	return strings.HasSuffix(observed, ":function:"+desired)
+}
+
+// IsEventSourceMappingUpToDate returns true if the observed event source
+// mapping matches the desired parameters.
+// nolint:gocyclo
+func IsEventSourceMappingUpToDate(p v1alpha1.EventSourceMappingParameters, c *lambda.EventSourceMappingConfiguration) bool {
+	switch aws.StringValue(c.State) {
+	case v1alpha1.EventSourceMappingStateEnabled, v1alpha1.EventSourceMappingStateEnabling:
+		if !isEnabled(p) {
+			return false
+		}
+	case v1alpha1.EventSourceMappingStateDisabled, v1alpha1.EventSourceMappingStateDisabling:
+		if isEnabled(p) {
+			return false
+		}
+	}
+	if !IsFunctionUpToDate(p, c) {
+		return false
+	}
+	if aws.Int64Value(p.BatchSize) != aws.Int64Value(c.BatchSize) ||
+		aws.Int64Value(p.MaximumBatchingWindowInSeconds) != aws.Int64Value(c.MaximumBatchingWindowInSeconds) ||
+		aws.BoolValue(p.BisectBatchOnFunctionError) != aws.BoolValue(c.BisectBatchOnFunctionError) ||
+		aws.Int64Value(p.MaximumRecordAgeInSeconds) != aws.Int64Value(c.MaximumRecordAgeInSeconds) ||
+		aws.Int64Value(p.MaximumRetryAttempts) != aws.Int64Value(c.MaximumRetryAttempts) ||
+		aws.Int64Value(p.ParallelizationFactor) != aws.Int64Value(c.ParallelizationFactor) ||
+		aws.Int64Value(p.TumblingWindowInSeconds) != aws.Int64Value(c.TumblingWindowInSeconds) {
+		return false
+	}
+	if !cmp.Equal(sortedStrings(p.FunctionResponseTypes), sortedStrings(aws.StringValueSlice(c.FunctionResponseTypes)), cmpopts.EquateEmpty()) {
+		return false
+	}
+	var destination string
+	if c.DestinationConfig != nil && c.DestinationConfig.OnFailure != nil {
+		destination = aws.StringValue(c.DestinationConfig.OnFailure.Destination)
+	}
+	if p.DestinationConfig != nil && p.DestinationConfig.OnFailure.Destination != destination {
+		return false
+	}
+	return cmp.Equal(filterPatterns(p.FilterCriteria), observedFilterPatterns(c.FilterCriteria), cmpopts.EquateEmpty())
+}
+
+func filterPatterns(c *v1alpha1.EventSourceMappingFilterCriteria) []string {
+	if c == nil {
+		return nil
+	}
+	res := make([]string, len(c.Filters))
+	for i, f := range c.Filters {
+		res[i] = f.Pattern
+	}
+	return sortedStrings(res)
+}
+
+func observedFilterPatterns(c *lambda.FilterCriteria) []string {
+	if c == nil {
+		return nil
+	}
+	res := make([]string, len(c.Filters))
+	for i, f := range c.Filters {
+		res[i] = aws.StringValue(f.Pattern)
+	}
+	return sortedStrings(res)
+}
+
+func sortedStrings(s []string) []string {
+	res := append([]string(nil), s...)
+	sort.Strings(res)
+	return res
+}
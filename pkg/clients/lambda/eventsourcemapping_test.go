@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+func TestIsFunctionUpToDate(t *testing.T) {
+	observed := &lambda.EventSourceMappingConfiguration{
+		FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:orders:live"),
+	}
+
+	cases := map[string]struct {
+		name string
+		want bool
+	}{
+		"SameQualifiedName": {
+			name: "orders:live",
+			want: true,
+		},
+		"SameARN": {
+			name: "arn:aws:lambda:us-east-1:123456789012:function:orders:live",
+			want: true,
+		},
+		"UnqualifiedName": {
+			name: "orders",
+			want: false,
+		},
+		"OtherFunction": {
+			name: "payments:live",
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.EventSourceMappingParameters{FunctionName: aws.String(tc.name)}
+			if diff := cmp.Diff(tc.want, IsFunctionUpToDate(p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEventSourceMappingUpToDate(t *testing.T) {
+	observed := func(state string) *lambda.EventSourceMappingConfiguration {
+		return &lambda.EventSourceMappingConfiguration{
+			FunctionArn: aws.String("arn:aws:lambda:us-east-1:123456789012:function:orders"),
+			State:       aws.String(state),
+			BatchSize:   aws.Int64(10),
+			FilterCriteria: &lambda.FilterCriteria{Filters: []*lambda.Filter{
+				{Pattern: aws.String(`{"body":{"type":["order"]}}`)},
+			}},
+		}
+	}
+	params := func(m ...func(*v1alpha1.EventSourceMappingParameters)) v1alpha1.EventSourceMappingParameters {
+		p := v1alpha1.EventSourceMappingParameters{
+			FunctionName: aws.String("orders"),
+			BatchSize:    aws.Int64(10),
+			FilterCriteria: &v1alpha1.EventSourceMappingFilterCriteria{Filters: []v1alpha1.EventSourceMappingFilter{
+				{Pattern: `{"body":{"type":["order"]}}`},
+			}},
+		}
+		for _, f := range m {
+			f(&p)
+		}
+		return p
+	}
+
+	cases := map[string]struct {
+		p        v1alpha1.EventSourceMappingParameters
+		observed *lambda.EventSourceMappingConfiguration
+		want     bool
+	}{
+		"UpToDate": {
+			p:        params(),
+			observed: observed(v1alpha1.EventSourceMappingStateEnabled),
+			want:     true,
+		},
+		"Disable": {
+			p:        params(func(p *v1alpha1.EventSourceMappingParameters) { p.Enabled = aws.Bool(false) }),
+			observed: observed(v1alpha1.EventSourceMappingStateEnabled),
+			want:     false,
+		},
+		"Enable": {
+			p:        params(),
+			observed: observed(v1alpha1.EventSourceMappingStateDisabled),
+			want:     false,
+		},
+		"NewBatchSize": {
+			p:        params(func(p *v1alpha1.EventSourceMappingParameters) { p.BatchSize = aws.Int64(100) }),
+			observed: observed(v1alpha1.EventSourceMappingStateEnabled),
+			want:     false,
+		},
+		"FiltersRemoved": {
+			p:        params(func(p *v1alpha1.EventSourceMappingParameters) { p.FilterCriteria = nil }),
+			observed: observed(v1alpha1.EventSourceMappingStateEnabled),
+			want:     false,
+		},
+		"NewOnFailureDestination": {
+			p: params(func(p *v1alpha1.EventSourceMappingParameters) {
+				p.DestinationConfig = &v1alpha1.EventSourceMappingDestinationConfig{
+					OnFailure: v1alpha1.EventSourceMappingOnFailure{Destination: "arn:aws:sqs:us-east-1:123456789012:failed-orders"},
+				}
+			}),
+			observed: observed(v1alpha1.EventSourceMappingStateEnabled),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsEventSourceMappingUpToDate(tc.p, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lambda"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockCreateAliasWithContext                        func(ctx context.Context, input *lambda.CreateAliasInput, opts []request.Option) (*lambda.AliasConfiguration, error)
+	MockGetAliasWithContext                           func(ctx context.Context, input *lambda.GetAliasInput, opts []request.Option) (*lambda.AliasConfiguration, error)
+	MockUpdateAliasWithContext                        func(ctx context.Context, input *lambda.UpdateAliasInput, opts []request.Option) (*lambda.AliasConfiguration, error)
+	MockDeleteAliasWithContext                        func(ctx context.Context, input *lambda.DeleteAliasInput, opts []request.Option) (*lambda.DeleteAliasOutput, error)
+	MockCreateEventSourceMappingWithContext           func(ctx context.Context, input *lambda.CreateEventSourceMappingInput, opts []request.Option) (*lambda.EventSourceMappingConfiguration, error)
+	MockGetEventSourceMappingWithContext              func(ctx context.Context, input *lambda.GetEventSourceMappingInput, opts []request.Option) (*lambda.EventSourceMappingConfiguration, error)
+	MockUpdateEventSourceMappingWithContext           func(ctx context.Context, input *lambda.UpdateEventSourceMappingInput, opts []request.Option) (*lambda.EventSourceMappingConfiguration, error)
+	MockDeleteEventSourceMappingWithContext           func(ctx context.Context, input *lambda.DeleteEventSourceMappingInput, opts []request.Option) (*lambda.EventSourceMappingConfiguration, error)
+	MockAddPermissionWithContext                      func(ctx context.Context, input *lambda.AddPermissionInput, opts []request.Option) (*lambda.AddPermissionOutput, error)
+	MockGetPolicyWithContext                          func(ctx context.Context, input *lambda.GetPolicyInput, opts []request.Option) (*lambda.GetPolicyOutput, error)
+	MockRemovePermissionWithContext                   func(ctx context.Context, input *lambda.RemovePermissionInput, opts []request.Option) (*lambda.RemovePermissionOutput, error)
+	MockPublishLayerVersionWithContext                func(ctx context.Context, input *lambda.PublishLayerVersionInput, opts []request.Option) (*lambda.PublishLayerVersionOutput, error)
+	MockGetLayerVersionWithContext                    func(ctx context.Context, input *lambda.GetLayerVersionInput, opts []request.Option) (*lambda.GetLayerVersionOutput, error)
+	MockListLayerVersionsWithContext                  func(ctx context.Context, input *lambda.ListLayerVersionsInput, opts []request.Option) (*lambda.ListLayerVersionsOutput, error)
+	MockDeleteLayerVersionWithContext                 func(ctx context.Context, input *lambda.DeleteLayerVersionInput, opts []request.Option) (*lambda.DeleteLayerVersionOutput, error)
+	MockGetProvisionedConcurrencyConfigWithContext    func(ctx context.Context, input *lambda.GetProvisionedConcurrencyConfigInput, opts []request.Option) (*lambda.GetProvisionedConcurrencyConfigOutput, error)
+	MockPutProvisionedConcurrencyConfigWithContext    func(ctx context.Context, input *lambda.PutProvisionedConcurrencyConfigInput, opts []request.Option) (*lambda.PutProvisionedConcurrencyConfigOutput, error)
+	MockDeleteProvisionedConcurrencyConfigWithContext func(ctx context.Context, input *lambda.DeleteProvisionedConcurrencyConfigInput, opts []request.Option) (*lambda.DeleteProvisionedConcurrencyConfigOutput, error)
+}
+
+// CreateAliasWithContext mocks CreateAliasWithContext method
+func (m *MockClient) CreateAliasWithContext(ctx context.Context, input *lambda.CreateAliasInput, opts ...request.Option) (*lambda.AliasConfiguration, error) {
+	return m.MockCreateAliasWithContext(ctx, input, opts)
+}
+
+// GetAliasWithContext mocks GetAliasWithContext method
+func (m *MockClient) GetAliasWithContext(ctx context.Context, input *lambda.GetAliasInput, opts ...request.Option) (*lambda.AliasConfiguration, error) {
+	return m.MockGetAliasWithContext(ctx, input, opts)
+}
+
+// UpdateAliasWithContext mocks UpdateAliasWithContext method
+func (m *MockClient) UpdateAliasWithContext(ctx context.Context, input *lambda.UpdateAliasInput, opts ...request.Option) (*lambda.AliasConfiguration, error) {
+	return m.MockUpdateAliasWithContext(ctx, input, opts)
+}
+
+// DeleteAliasWithContext mocks DeleteAliasWithContext method
+func (m *MockClient) DeleteAliasWithContext(ctx context.Context, input *lambda.DeleteAliasInput, opts ...request.Option) (*lambda.DeleteAliasOutput, error) {
+	return m.MockDeleteAliasWithContext(ctx, input, opts)
+}
+
+// CreateEventSourceMappingWithContext mocks CreateEventSourceMappingWithContext method
+func (m *MockClient) CreateEventSourceMappingWithContext(ctx context.Context, input *lambda.CreateEventSourceMappingInput, opts ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	return m.MockCreateEventSourceMappingWithContext(ctx, input, opts)
+}
+
+// GetEventSourceMappingWithContext mocks GetEventSourceMappingWithContext method
+func (m *MockClient) GetEventSourceMappingWithContext(ctx context.Context, input *lambda.GetEventSourceMappingInput, opts ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	return m.MockGetEventSourceMappingWithContext(ctx, input, opts)
+}
+
+// UpdateEventSourceMappingWithContext mocks UpdateEventSourceMappingWithContext method
+func (m *MockClient) UpdateEventSourceMappingWithContext(ctx context.Context, input *lambda.UpdateEventSourceMappingInput, opts ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	return m.MockUpdateEventSourceMappingWithContext(ctx, input, opts)
+}
+
+// DeleteEventSourceMappingWithContext mocks DeleteEventSourceMappingWithContext method
+func (m *MockClient) DeleteEventSourceMappingWithContext(ctx context.Context, input *lambda.DeleteEventSourceMappingInput, opts ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	return m.MockDeleteEventSourceMappingWithContext(ctx, input, opts)
+}
+
+// AddPermissionWithContext mocks AddPermissionWithContext method
+func (m *MockClient) AddPermissionWithContext(ctx context.Context, input *lambda.AddPermissionInput, opts ...request.Option) (*lambda.AddPermissionOutput, error) {
+	return m.MockAddPermissionWithContext(ctx, input, opts)
+}
+
+// GetPolicyWithContext mocks GetPolicyWithContext method
+func (m *MockClient) GetPolicyWithContext(ctx context.Context, input *lambda.GetPolicyInput, opts ...request.Option) (*lambda.GetPolicyOutput, error) {
+	return m.MockGetPolicyWithContext(ctx, input, opts)
+}
+
+// RemovePermissionWithContext mocks RemovePermissionWithContext method
+func (m *MockClient) RemovePermissionWithContext(ctx context.Context, input *lambda.RemovePermissionInput, opts ...request.Option) (*lambda.RemovePermissionOutput, error) {
+	return m.MockRemovePermissionWithContext(ctx, input, opts)
+}
+
+// PublishLayerVersionWithContext mocks PublishLayerVersionWithContext method
+func (m *MockClient) PublishLayerVersionWithContext(ctx context.Context, input *lambda.PublishLayerVersionInput, opts ...request.Option) (*lambda.PublishLayerVersionOutput, error) {
+	return m.MockPublishLayerVersionWithContext(ctx, input, opts)
+}
+
+// GetLayerVersionWithContext mocks GetLayerVersionWithContext method
+func (m *MockClient) GetLayerVersionWithContext(ctx context.Context, input *lambda.GetLayerVersionInput, opts ...request.Option) (*lambda.GetLayerVersionOutput, error) {
+	return m.MockGetLayerVersionWithContext(ctx, input, opts)
+}
+
+// ListLayerVersionsWithContext mocks ListLayerVersionsWithContext method
+func (m *MockClient) ListLayerVersionsWithContext(ctx context.Context, input *lambda.ListLayerVersionsInput, opts ...request.Option) (*lambda.ListLayerVersionsOutput, error) {
+	return m.MockListLayerVersionsWithContext(ctx, input, opts)
+}
+
+// DeleteLayerVersionWithContext mocks DeleteLayerVersionWithContext method
+func (m *MockClient) DeleteLayerVersionWithContext(ctx context.Context, input *lambda.DeleteLayerVersionInput, opts ...request.Option) (*lambda.DeleteLayerVersionOutput, error) {
+	return m.MockDeleteLayerVersionWithContext(ctx, input, opts)
+}
+
+// GetProvisionedConcurrencyConfigWithContext mocks GetProvisionedConcurrencyConfigWithContext method
+func (m *MockClient) GetProvisionedConcurrencyConfigWithContext(ctx context.Context, input *lambda.GetProvisionedConcurrencyConfigInput, opts ...request.Option) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	return m.MockGetProvisionedConcurrencyConfigWithContext(ctx, input, opts)
+}
+
+// PutProvisionedConcurrencyConfigWithContext mocks PutProvisionedConcurrencyConfigWithContext method
+func (m *MockClient) PutProvisionedConcurrencyConfigWithContext(ctx context.Context, input *lambda.PutProvisionedConcurrencyConfigInput, opts ...request.Option) (*lambda.PutProvisionedConcurrencyConfigOutput, error) {
+	return m.MockPutProvisionedConcurrencyConfigWithContext(ctx, input, opts)
+}
+
+// DeleteProvisionedConcurrencyConfigWithContext mocks DeleteProvisionedConcurrencyConfigWithContext method
+func (m *MockClient) DeleteProvisionedConcurrencyConfigWithContext(ctx context.Context, input *lambda.DeleteProvisionedConcurrencyConfigInput, opts ...request.Option) (*lambda.DeleteProvisionedConcurrencyConfigOutput, error) {
+	return m.MockDeleteProvisionedConcurrencyConfigWithContext(ctx, input, opts)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+// GeneratePublishLayerVersionInput returns the input to publish a version of
+// the given layer.
+func GeneratePublishLayerVersionInput(name string, p v1alpha1.LayerVersionParameters) *lambda.PublishLayerVersionInput {
+	return &lambda.PublishLayerVersionInput{
+		LayerName: aws.String(name),
+		Content: &lambda.LayerVersionContentInput{
+			S3Bucket:        p.Content.S3Bucket,
+			S3Key:           p.Content.S3Key,
+			S3ObjectVersion: p.Content.S3ObjectVersion,
+		},
+		Description:             p.Description,
+		CompatibleRuntimes:      p.CompatibleRuntimes,
+		CompatibleArchitectures: p.CompatibleArchitectures,
+		LicenseInfo:             p.LicenseInfo,
+	}
+}
+
+// GenerateLayerVersionObservation returns the observation of the given layer
+// version.
+func GenerateLayerVersionObservation(v *lambda.GetLayerVersionOutput) v1alpha1.LayerVersionObservation {
+	o := v1alpha1.LayerVersionObservation{
+		LayerARN:        aws.StringValue(v.LayerArn),
+		LayerVersionARN: aws.StringValue(v.LayerVersionArn),
+		Version:         aws.Int64Value(v.Version),
+		CreatedDate:     aws.StringValue(v.CreatedDate),
+	}
+	if v.Content != nil {
+		o.CodeSHA256 = aws.StringValue(v.Content.CodeSha256)
+		o.CodeSize = aws.Int64Value(v.Content.CodeSize)
+	}
+	return o
+}
+
+// LayerVersionContentSource returns the S3 object the archive of a layer is
+// read from.
+func LayerVersionContentSource(c v1alpha1.LayerVersionContent) string {
+	src := "s3://" + aws.StringValue(c.S3Bucket) + "/" + aws.StringValue(c.S3Key)
+	if c.S3ObjectVersion != nil {
+		src += "?versionId=" + aws.StringValue(c.S3ObjectVersion)
+	}
+	return src
+}
+
+// IsLayerVersionUpToDate returns true if the observed layer version has the
+// desired description, license and compatible runtimes and architectures.
+// Lambda does not report the S3 object a version was published from, so its
+// content is not compared.
+func IsLayerVersionUpToDate(p v1alpha1.LayerVersionParameters, v *lambda.GetLayerVersionOutput) bool {
+	if aws.StringValue(p.Description) != aws.StringValue(v.Description) ||
+		aws.StringValue(p.LicenseInfo) != aws.StringValue(v.LicenseInfo) {
+		return false
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return cmp.Equal(aws.StringValueSlice(p.CompatibleRuntimes), aws.StringValueSlice(v.CompatibleRuntimes), sortStrings, cmpopts.EquateEmpty()) &&
+		cmp.Equal(aws.StringValueSlice(p.CompatibleArchitectures), aws.StringValueSlice(v.CompatibleArchitectures), sortStrings, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+// Condition keys that Lambda adds to the statement of a permission.
+const (
+	conditionSourceARN        = "AWS:SourceArn"
+	conditionSourceAccount    = "AWS:SourceAccount"
+	conditionPrincipalOrgID   = "aws:PrincipalOrgID"
+	conditionEventSourceToken = "lambda:EventSourceToken"
+)
+
+var accountID = regexp.MustCompile(`^\d{12}$`)
+
+// PolicyStatement is a statement of the resource-based policy of a function.
+type PolicyStatement struct {
+	Sid       string                                `json:"Sid"`
+	Effect    string                                `json:"Effect"`
+	Principal json.RawMessage                       `json:"Principal"`
+	Action    string                                `json:"Action"`
+	Resource  string                                `json:"Resource"`
+	Condition map[string]map[string]json.RawMessage `json:"Condition,omitempty"`
+}
+
+type policy struct {
+	Statement []json.RawMessage `json:"Statement"`
+}
+
+// FindStatement returns the statement with the given ID of the given policy
+// and its JSON, or nil if the policy has no such statement.
+func FindStatement(doc, sid string) (*PolicyStatement, string, error) {
+	p := policy{}
+	if err := json.Unmarshal([]byte(doc), &p); err != nil {
+		return nil, "", err
+	}
+	for _, raw := range p.Statement {
+		s := &PolicyStatement{}
+		if err := json.Unmarshal(raw, s); err != nil {
+			return nil, "", err
+		}
+		if s.Sid == sid {
+			return s, string(raw), nil
+		}
+	}
+	return nil, "", nil
+}
+
+// GenerateAddPermissionInput returns the input to add the statement with the
+// given ID to the policy of the function.
+func GenerateAddPermissionInput(sid string, p v1alpha1.PermissionParameters) *lambda.AddPermissionInput {
+	return &lambda.AddPermissionInput{
+		StatementId:      aws.String(sid),
+		FunctionName:     p.FunctionName,
+		Qualifier:        p.Qualifier,
+		Action:           aws.String(p.Action),
+		Principal:        aws.String(p.Principal),
+		SourceArn:        p.SourceARN,
+		SourceAccount:    p.SourceAccount,
+		PrincipalOrgID:   p.PrincipalOrgID,
+		EventSourceToken: p.EventSourceToken,
+	}
+}
+
+// principal returns the service, account or "*" that the statement grants
+// access to.
+func (s *PolicyStatement) principal() string {
+	var v string
+	if err := json.Unmarshal(s.Principal, &v); err == nil {
+		return v
+	}
+	p := map[string]string{}
+	_ = json.Unmarshal(s.Principal, &p)
+	if v, ok := p["Service"]; ok {
+		return v
+	}
+	return p["AWS"]
+}
+
+// condition returns the value of the given key in any condition of the
+// statement.
+func (s *PolicyStatement) condition(key string) string {
+	for _, c := range s.Condition {
+		if raw, ok := c[key]; ok {
+			var v string
+			_ = json.Unmarshal(raw, &v)
+			return v
+		}
+	}
+	return ""
+}
+
+// IsPrincipalUpToDate returns true if the statement grants access to the
+// desired principal. Lambda stores account IDs as the ARN of the root user
+// of the account.
+func IsPrincipalUpToDate(desired string, s *PolicyStatement) bool {
+	observed := s.principal()
+	if accountID.MatchString(desired) {
+		return observed == desired || observed == "arn:aws:iam::"+desired+":root"
+	}
+	return observed == desired
+}
+
+// IsPermissionUpToDate returns true if the observed statement matches the
+// desired permission.
+func IsPermissionUpToDate(p v1alpha1.PermissionParameters, s *PolicyStatement) bool {
+	return s.Action == p.Action &&
+		IsPrincipalUpToDate(p.Principal, s) &&
+		s.condition(conditionSourceARN) == aws.StringValue(p.SourceARN) &&
+		s.condition(conditionSourceAccount) == aws.StringValue(p.SourceAccount) &&
+		s.condition(conditionPrincipalOrgID) == aws.StringValue(p.PrincipalOrgID) &&
+		s.condition(conditionEventSourceToken) == aws.StringValue(p.EventSourceToken)
+}
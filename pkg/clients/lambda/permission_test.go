@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+const testPolicy = `{"Version":"2012-10-17","Id":"default","Statement":[` +
+	`{"Sid":"s3","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction","Resource":"arn:aws:lambda:us-east-1:123456789012:function:orders",` +
+	`"Condition":{"StringEquals":{"AWS:SourceAccount":"123456789012"},"ArnLike":{"AWS:SourceArn":"arn:aws:s3:::uploads"}}},` +
+	`{"Sid":"partner","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"lambda:GetFunction","Resource":"arn:aws:lambda:us-east-1:123456789012:function:orders"}]}`
+
+func TestIsPermissionUpToDate(t *testing.T) {
+	s3 := v1alpha1.PermissionParameters{
+		Action:        "lambda:InvokeFunction",
+		Principal:     "s3.amazonaws.com",
+		SourceARN:     aws.String("arn:aws:s3:::uploads"),
+		SourceAccount: aws.String("123456789012"),
+	}
+	otherBucket := s3
+	otherBucket.SourceARN = aws.String("arn:aws:s3:::downloads")
+
+	cases := map[string]struct {
+		sid  string
+		p    v1alpha1.PermissionParameters
+		want bool
+	}{
+		"SameService": {
+			sid:  "s3",
+			p:    s3,
+			want: true,
+		},
+		"NewSourceARN": {
+			sid:  "s3",
+			p:    otherBucket,
+			want: false,
+		},
+		"AccountID": {
+			sid:  "partner",
+			p:    v1alpha1.PermissionParameters{Action: "lambda:GetFunction", Principal: "210987654321"},
+			want: true,
+		},
+		"NewAction": {
+			sid:  "partner",
+			p:    v1alpha1.PermissionParameters{Action: "lambda:InvokeFunction", Principal: "210987654321"},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, _, err := FindStatement(testPolicy, tc.sid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, IsPermissionUpToDate(tc.p, s)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindStatement(t *testing.T) {
+	s, _, err := FindStatement(testPolicy, "sns")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != nil {
+		t.Errorf("FindStatement(...): want nil, got %+v", s)
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+// GeneratePutProvisionedConcurrencyConfigInput returns the input to create or
+// update the given provisioned concurrency.
+func GeneratePutProvisionedConcurrencyConfigInput(p v1alpha1.ProvisionedConcurrencyConfigParameters) *lambda.PutProvisionedConcurrencyConfigInput {
+	return &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    p.FunctionName,
+		Qualifier:                       p.Qualifier,
+		ProvisionedConcurrentExecutions: aws.Int64(p.ProvisionedConcurrentExecutions),
+	}
+}
+
+// GenerateProvisionedConcurrencyConfigObservation returns the observation of
+// the given provisioned concurrency.
+func GenerateProvisionedConcurrencyConfigObservation(o *lambda.GetProvisionedConcurrencyConfigOutput) v1alpha1.ProvisionedConcurrencyConfigObservation {
+	return v1alpha1.ProvisionedConcurrencyConfigObservation{
+		Status:                                   aws.StringValue(o.Status),
+		StatusReason:                             aws.StringValue(o.StatusReason),
+		AllocatedProvisionedConcurrentExecutions: aws.Int64Value(o.AllocatedProvisionedConcurrentExecutions),
+		AvailableProvisionedConcurrentExecutions: aws.Int64Value(o.AvailableProvisionedConcurrentExecutions),
+	}
+}
+
+// IsProvisionedConcurrencyConfigUpToDate returns true if the desired number
+// of execution environments was requested.
+func IsProvisionedConcurrencyConfigUpToDate(p v1alpha1.ProvisionedConcurrencyConfigParameters, o *lambda.GetProvisionedConcurrencyConfigOutput) bool {
+	return p.ProvisionedConcurrentExecutions == aws.Int64Value(o.RequestedProvisionedConcurrentExecutions)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const errPolicyDocumentJSON = "malformed policy document JSON"
+
+// PolicyDocumentDrift returns the differences between the desired policy
+// document and the observed one. Both are canonicalized first, so that
+// documents that only differ in formatting, key order, the order of list
+// elements, or single values written as lists of one are equivalent.
+func PolicyDocumentDrift(desired, observed string) ([]Drift, error) {
+	d, err := canonicalPolicyDocument(desired)
+	if err != nil {
+		return nil, errors.Wrap(err, errPolicyDocumentJSON)
+	}
+	o, err := canonicalPolicyDocument(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errPolicyDocumentJSON)
+	}
+	return DiffFields(d, o), nil
+}
+
+// IsPolicyDocumentUpToDate returns true if the desired policy document is
+// equivalent to the observed one, in the sense of PolicyDocumentDrift.
+func IsPolicyDocumentUpToDate(desired, observed string) (bool, error) {
+	drift, err := PolicyDocumentDrift(desired, observed)
+	if err != nil {
+		return false, err
+	}
+	return len(drift) == 0, nil
+}
+
+func canonicalPolicyDocument(doc string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return canonicalPolicyValue(v), nil
+}
+
+// canonicalPolicyValue sorts and deduplicates the lists in the supplied
+// value, since AWS treats them as sets, and replaces lists of one element by
+// the element.
+func canonicalPolicyValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = canonicalPolicyValue(e)
+		}
+		return t
+	case []interface{}:
+		elems := make(map[string]interface{}, len(t))
+		for _, e := range t {
+			c := canonicalPolicyValue(e)
+			k, _ := json.Marshal(c)
+			elems[string(k)] = c
+		}
+		keys := make([]string, 0, len(elems))
+		for k := range elems {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		if len(keys) == 1 {
+			return elems[keys[0]]
+		}
+		res := make([]interface{}, len(keys))
+		for i, k := range keys {
+			res[i] = elems[k]
+		}
+		return res
+	}
+	return v
+}
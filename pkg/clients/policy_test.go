@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestPolicyDocumentDrift(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`
+
+	type args struct {
+		desired  string
+		observed string
+	}
+	type want struct {
+		drift []Drift
+		err   error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Reformatted": {
+			args: args{
+				desired: policy,
+				observed: `{
+  "Statement": [{"Resource": "*", "Action": ["s3:GetObject", "s3:ListBucket"], "Effect": "Allow"}],
+  "Version": "2012-10-17"
+}`,
+			},
+		},
+		"ListOrderAndDuplicates": {
+			args: args{
+				desired:  policy,
+				observed: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket","s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`,
+			},
+		},
+		"SingleValueLists": {
+			args: args{
+				desired:  policy,
+				observed: `{"Version":"2012-10-17","Statement":{"Effect":["Allow"],"Action":["s3:GetObject","s3:ListBucket"],"Resource":["*"]}}`,
+			},
+		},
+		"Changed": {
+			args: args{
+				desired:  policy,
+				observed: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`,
+			},
+			want: want{
+				drift: []Drift{{Path: "[Statement][Effect]", Desired: `"Allow"`, Observed: `"Deny"`}},
+			},
+		},
+		"MalformedObserved": {
+			args: args{
+				desired:  policy,
+				observed: "{",
+			},
+			want: want{
+				err: errors.Wrap(errors.New("unexpected EOF"), errPolicyDocumentJSON),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			drift, err := PolicyDocumentDrift(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nPolicyDocumentDrift(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.drift, drift); diff != "" {
+				t.Errorf("\nPolicyDocumentDrift(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
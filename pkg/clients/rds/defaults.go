@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"strings"
+)
+
+// IsAuroraEngine returns true if the supplied engine is one of the Aurora
+// engines, whose instances take their port and parameters from their cluster.
+func IsAuroraEngine(engine string) bool {
+	return strings.HasPrefix(engine, "aurora")
+}
+
+// DefaultPort returns the port AWS uses for the supplied engine when none is
+// specified, or nil if the engine is unknown.
+func DefaultPort(engine string) *int64 {
+	var p int64
+	switch {
+	case engine == "aurora", engine == "aurora-mysql", engine == "mysql", engine == "mariadb":
+		p = 3306
+	case engine == "aurora-postgresql", engine == "postgres":
+		p = 5432
+	case strings.HasPrefix(engine, "oracle-"):
+		p = 1521
+	case strings.HasPrefix(engine, "sqlserver-"):
+		p = 1433
+	default:
+		return nil
+	}
+	return &p
+}
+
+// ParameterGroupFamily returns the parameter group family of the supplied
+// engine and engine version, e.g. aurora-postgresql13 or mysql8.0, or an empty
+// string if it cannot be determined.
+func ParameterGroupFamily(engine, engineVersion string) string {
+	v := strings.Split(engineVersion, ".")
+	if len(v) < 2 || v[0] == "" {
+		return ""
+	}
+	switch {
+	case engine == "aurora", engine == "aurora-mysql", engine == "mysql", engine == "mariadb":
+		// Aurora MySQL versions look like 5.7.mysql_aurora.2.07.2, so only
+		// their first two numbers are of interest too.
+		return engine + v[0] + "." + v[1]
+	case engine == "aurora-postgresql", engine == "postgres":
+		// PostgreSQL versions before 10 used the first two numbers as their
+		// major version.
+		if strings.HasPrefix(v[0], "9") {
+			return engine + v[0] + "." + v[1]
+		}
+		return engine + v[0]
+	case strings.HasPrefix(engine, "oracle-"):
+		return engine + "-" + v[0]
+	case strings.HasPrefix(engine, "sqlserver-"):
+		return engine + "-" + v[0] + ".0"
+	}
+	return ""
+}
+
+// DefaultParameterGroupName returns the name of the default parameter group AWS
+// provides for the supplied engine and engine version, or an empty string if
+// it cannot be determined.
+func DefaultParameterGroupName(engine, engineVersion string) string {
+	f := ParameterGroupFamily(engine, engineVersion)
+	if f == "" {
+		return ""
+	}
+	return "default." + f
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestDefaultPort(t *testing.T) {
+	cases := map[string]*int64{
+		"aurora":            awsclient.Int64(3306),
+		"aurora-mysql":      awsclient.Int64(3306),
+		"aurora-postgresql": awsclient.Int64(5432),
+		"mysql":             awsclient.Int64(3306),
+		"mariadb":           awsclient.Int64(3306),
+		"postgres":          awsclient.Int64(5432),
+		"oracle-ee":         awsclient.Int64(1521),
+		"sqlserver-se":      awsclient.Int64(1433),
+		"cassandra":         nil,
+	}
+	for engine, want := range cases {
+		t.Run(engine, func(t *testing.T) {
+			if diff := cmp.Diff(want, DefaultPort(engine)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultParameterGroupName(t *testing.T) {
+	type args struct {
+		engine        string
+		engineVersion string
+	}
+	cases := map[string]struct {
+		args args
+		want string
+	}{
+		"Aurora": {
+			args: args{engine: "aurora", engineVersion: "5.6.10a"},
+			want: "default.aurora5.6",
+		},
+		"AuroraMySQL": {
+			args: args{engine: "aurora-mysql", engineVersion: "5.7.mysql_aurora.2.07.2"},
+			want: "default.aurora-mysql5.7",
+		},
+		"AuroraPostgreSQL": {
+			args: args{engine: "aurora-postgresql", engineVersion: "13.4"},
+			want: "default.aurora-postgresql13",
+		},
+		"PostgreSQL9": {
+			args: args{engine: "postgres", engineVersion: "9.6.22"},
+			want: "default.postgres9.6",
+		},
+		"MySQL": {
+			args: args{engine: "mysql", engineVersion: "8.0.25"},
+			want: "default.mysql8.0",
+		},
+		"Oracle": {
+			args: args{engine: "oracle-ee", engineVersion: "19.0.0.0.ru-2021-07.rur-2021-07.r1"},
+			want: "default.oracle-ee-19",
+		},
+		"SQLServer": {
+			args: args{engine: "sqlserver-ex", engineVersion: "15.00.4073.23.v1"},
+			want: "default.sqlserver-ex-15.0",
+		},
+		"NoVersion": {
+			args: args{engine: "postgres"},
+		},
+		"UnknownEngine": {
+			args: args{engine: "cassandra", engineVersion: "4.0"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DefaultParameterGroupName(tc.args.engine, tc.args.engineVersion)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
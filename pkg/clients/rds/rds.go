@@ -30,10 +30,12 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -42,9 +44,16 @@ import (
 )
 
 const (
-	errGetPasswordSecretFailed = "cannot get password secret"
+	errGetPasswordSecretFailed    = "cannot get password secret"
+	errDeletePasswordSecretFailed = "cannot delete generated password secret"
+	errPublishConnectionFailed    = "cannot publish connection details"
 )
 
+// AnnotationKeyPasswordGeneratedFor is added to the Secrets the controllers
+// create to store a generated master password. Its value is the UID of the
+// managed resource the password was generated for.
+const AnnotationKeyPasswordGeneratedFor = "rds.aws.crossplane.io/password-generated-for"
+
 // Client defines RDS RDSClient operations
 type Client interface {
 	CreateDBInstance(context.Context, *rds.CreateDBInstanceInput, ...func(*rds.Options)) (*rds.CreateDBInstanceOutput, error)
@@ -579,6 +588,8 @@ func LateInitialize(in *v1beta1.RDSInstanceParameters, db *rdstypes.DBInstance)
 }
 
 // IsUpToDate checks whether there is a change in any of the modifiable fields.
+// The fields that changed are recorded in the Drifted condition of the
+// RDSInstance.
 func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance, db rdstypes.DBInstance) (bool, error) {
 	_, pwdChanged, err := GetPassword(ctx, kube, r.Spec.ForProvider.MasterPasswordSecretRef, r.Spec.WriteConnectionSecretToReference)
 	if err != nil {
@@ -588,7 +599,8 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 	if err != nil {
 		return false, err
 	}
-	return cmp.Equal(&v1beta1.RDSInstanceParameters{}, patch, cmpopts.EquateEmpty(),
+	opts := []cmp.Option{
+		cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "Region"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "Tags"),
@@ -597,7 +609,21 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "ApplyModificationsImmediately"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "AllowMajorVersionUpgrade"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordSecretRef"),
-	) && !pwdChanged, nil
+	}
+	if cmp.Equal(&v1beta1.RDSInstanceParameters{}, patch, opts...) && !pwdChanged {
+		awsclients.RecordDrift(r, nil)
+		return true, nil
+	}
+	// The patch holds the desired values of the fields that changed, which
+	// are compared to the observed ones to tell how they changed.
+	current := &v1beta1.RDSInstanceParameters{}
+	LateInitialize(current, &db)
+	drift := awsclients.DiffFields(patch, current, append(opts, awsclients.IgnoreUnsetDesired())...)
+	if pwdChanged {
+		drift = append(drift, awsclients.Drift{Path: "masterPasswordSecretRef", Desired: awsclients.Redacted, Observed: awsclients.Redacted})
+	}
+	awsclients.RecordDrift(r, drift)
+	return false, nil
 }
 
 // GetPassword fetches the referenced input password for an RDSInstance CRD and determines whether it has changed or not
@@ -634,6 +660,44 @@ func GetPassword(ctx context.Context, kube client.Client, in *xpv1.SecretKeySele
 	return newPwd, changed, nil
 }
 
+// DeleteGeneratedPasswordSecret deletes the referenced password Secret if it
+// was created to store a password generated for the supplied managed resource.
+// Secrets that existed before the password was generated are left alone.
+func DeleteGeneratedPasswordSecret(ctx context.Context, kube client.Client, ref *xpv1.SecretKeySelector, mg resource.Managed) error {
+	if ref == nil {
+		return nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetPasswordSecretFailed)
+	}
+	if s.GetAnnotations()[AnnotationKeyPasswordGeneratedFor] != string(mg.GetUID()) {
+		return nil
+	}
+	return errors.Wrap(resource.IgnoreNotFound(kube.Delete(ctx, s)), errDeletePasswordSecretFailed)
+}
+
+// PublishConnectionDetails writes the supplied connection details to the
+// referenced Secret in addition to the connection secret of the supplied
+// managed resource. The Secret is controlled by the managed resource, so it is
+// garbage collected along with it, and is not written if another resource
+// controls it.
+func PublishConnectionDetails(ctx context.Context, kube client.Client, ref *xpv1.SecretReference, mg resource.Managed, of schema.GroupVersionKind, cd managed.ConnectionDetails) error {
+	if ref == nil {
+		return nil
+	}
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ref.Name,
+			Namespace:       ref.Namespace,
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, of))},
+		},
+		Type: resource.SecretTypeConnection,
+		Data: cd,
+	}
+	return errors.Wrap(resource.NewAPIPatchingApplicator(kube).Apply(ctx, s, resource.ConnectionSecretMustBeControllableBy(mg.GetUID())), errPublishConnectionFailed)
+}
+
 // GetConnectionDetails extracts managed.ConnectionDetails out of v1beta1.RDSInstance.
 func GetConnectionDetails(in v1beta1.RDSInstance) managed.ConnectionDetails {
 	if in.Status.AtProvider.Endpoint.Address == "" {
@@ -33,10 +33,12 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/database/v1beta1"
@@ -333,6 +335,29 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsUpToDateRecordsDrift(t *testing.T) {
+	db := rdstypes.DBInstance{AllocatedStorage: allocatedStorage, DBName: &dbName}
+	r := &v1beta1.RDSInstance{Spec: v1beta1.RDSInstanceSpec{ForProvider: v1beta1.RDSInstanceParameters{
+		AllocatedStorage: awsclient.IntAddress(awsclient.Int64(30)),
+		DBName:           &dbName,
+	}}}
+	if _, err := IsUpToDate(context.Background(), nil, r, db); err != nil {
+		t.Fatal(err)
+	}
+	want := awsclient.Drifted([]awsclient.Drift{{Path: "allocatedStorage", Desired: "30", Observed: "20"}})
+	if diff := cmp.Diff(want, r.GetCondition(awsclient.TypeDrifted), test.EquateConditions()); diff != "" {
+		t.Errorf("Drifted: -want, +got:\n%s", diff)
+	}
+
+	r.Spec.ForProvider.AllocatedStorage = awsclient.IntAddress(awsclient.Int64(20))
+	if _, err := IsUpToDate(context.Background(), nil, r, db); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(awsclient.NotDrifted(), r.GetCondition(awsclient.TypeDrifted), test.EquateConditions()); diff != "" {
+		t.Errorf("Drifted: -want, +got:\n%s", diff)
+	}
+}
+
 func TestGetPassword(t *testing.T) {
 	type args struct {
 		in   *xpv1.SecretKeySelector
@@ -532,6 +557,185 @@ func TestGetPassword(t *testing.T) {
 	}
 }
 
+func TestDeleteGeneratedPasswordSecret(t *testing.T) {
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Key: connectionSecretKey,
+	}
+	owner := &v1beta1.RDSInstance{ObjectMeta: metav1.ObjectMeta{UID: "cool-uid"}}
+	withAnnotation := func(uid string) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			if uid != "" {
+				obj.SetAnnotations(map[string]string{AnnotationKeyPasswordGeneratedFor: uid})
+			}
+			return nil
+		}
+	}
+
+	type args struct {
+		ref  *xpv1.SecretKeySelector
+		kube *test.MockClient
+	}
+	type want struct {
+		err     error
+		deleted bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Generated": {
+			args: args{
+				ref:  ref,
+				kube: &test.MockClient{MockGet: withAnnotation("cool-uid"), MockDelete: test.NewMockDeleteFn(nil)},
+			},
+			want: want{deleted: true},
+		},
+		"GeneratedForAnotherResource": {
+			args: args{
+				ref:  ref,
+				kube: &test.MockClient{MockGet: withAnnotation("other-uid"), MockDelete: test.NewMockDeleteFn(nil)},
+			},
+		},
+		"NotGenerated": {
+			args: args{
+				ref:  ref,
+				kube: &test.MockClient{MockGet: withAnnotation(""), MockDelete: test.NewMockDeleteFn(nil)},
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				ref:  ref,
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, connectionSecretName))},
+			},
+		},
+		"NoReference": {
+			args: args{
+				kube: &test.MockClient{},
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				ref:  ref,
+				kube: &test.MockClient{MockGet: withAnnotation("cool-uid"), MockDelete: test.NewMockDeleteFn(errBoom)},
+			},
+			want: want{
+				err:     errors.Wrap(errBoom, errDeletePasswordSecretFailed),
+				deleted: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			if del := tc.args.kube.MockDelete; del != nil {
+				tc.args.kube.MockDelete = func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+					deleted = true
+					return del(ctx, obj, opts...)
+				}
+			}
+			err := DeleteGeneratedPasswordSecret(context.Background(), tc.args.kube, tc.args.ref, owner)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPublishConnectionDetails(t *testing.T) {
+	ref := &xpv1.SecretReference{Name: connectionSecretName, Namespace: secretNamespace}
+	owner := &v1beta1.RDSInstance{ObjectMeta: metav1.ObjectMeta{Name: "cool-db", UID: "cool-uid"}}
+	conn := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte("reader.example.org")}
+	controlledBy := func(uid string) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.SetOwnerReferences([]metav1.OwnerReference{{UID: types.UID(uid), Controller: awsclient.Bool(true)}})
+			return nil
+		}
+	}
+
+	type args struct {
+		ref  *xpv1.SecretReference
+		kube *test.MockClient
+	}
+	type want struct {
+		err    error
+		secret *corev1.Secret
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Created": {
+			args: args{
+				ref: ref,
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, connectionSecretName)),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+			},
+			want: want{
+				secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      connectionSecretName,
+						Namespace: secretNamespace,
+						OwnerReferences: []metav1.OwnerReference{{
+							APIVersion: v1beta1.RDSInstanceGroupVersionKind.GroupVersion().String(),
+							Kind:       v1beta1.RDSInstanceKind,
+							Name:       "cool-db",
+							UID:        "cool-uid",
+							Controller: awsclient.Bool(true),
+						}},
+					},
+					Type: resource.SecretTypeConnection,
+					Data: conn,
+				},
+			},
+		},
+		"ControlledByAnotherResource": {
+			args: args{
+				ref:  ref,
+				kube: &test.MockClient{MockGet: controlledBy("other-uid")},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("existing secret is not controlled by UID \"cool-uid\""), errPublishConnectionFailed),
+			},
+		},
+		"NoReference": {
+			args: args{
+				kube: &test.MockClient{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *corev1.Secret
+			if create := tc.args.kube.MockCreate; create != nil {
+				tc.args.kube.MockCreate = func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+					got = obj.(*corev1.Secret)
+					return create(ctx, obj, opts...)
+				}
+			}
+			err := PublishConnectionDetails(context.Background(), tc.args.kube, tc.args.ref, owner, v1beta1.RDSInstanceGroupVersionKind, conn)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.secret, got); diff != "" {
+				t.Errorf("secret: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateObservation(t *testing.T) {
 	lastRestoreTime, createTime := time.Now(), time.Now()
 	rdsAz := rdstypes.AvailabilityZone{Name: &name}
@@ -68,10 +68,13 @@ func NewClient(cfg aws.Config) Client {
 
 // GetResourceRecordSet returns recordSet if present or err
 func GetResourceRecordSet(ctx context.Context, name string, params v1alpha1.ResourceRecordSetParameters, c Client) (*route53types.ResourceRecordSet, error) {
+	// Records with a routing policy other than simple share their name and
+	// type, so the listing starts at the one with the desired identifier.
 	res, err := c.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
-		HostedZoneId:    params.ZoneID,
-		StartRecordName: &name,
-		StartRecordType: route53types.RRType(params.Type),
+		HostedZoneId:          params.ZoneID,
+		StartRecordName:       &name,
+		StartRecordType:       route53types.RRType(params.Type),
+		StartRecordIdentifier: params.SetIdentifier,
 	})
 	if err != nil {
 		return nil, err
@@ -146,8 +149,7 @@ func IsUpToDate(p v1alpha1.ResourceRecordSetParameters, rrset route53types.Resou
 		return false, err
 	}
 	return cmp.Equal(&v1alpha1.ResourceRecordSetParameters{}, patch,
-		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}),
-		cmpopts.IgnoreFields(v1alpha1.ResourceRecordSetParameters{}, "Region")), nil
+		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{})), nil
 }
 
 // LateInitialize fills the empty fields in *v1alpha1.ResourceRecordSetParameters with
@@ -159,6 +161,20 @@ func LateInitialize(in *v1alpha1.ResourceRecordSetParameters, rrSet *route53type
 	rrType := string(rrSet.Type)
 	in.Type = awsclients.LateInitializeString(in.Type, &rrType)
 	in.TTL = awsclients.LateInitializeInt64Ptr(in.TTL, rrSet.TTL)
+	in.SetIdentifier = awsclients.LateInitializeStringPtr(in.SetIdentifier, rrSet.SetIdentifier)
+	in.Weight = awsclients.LateInitializeInt64Ptr(in.Weight, rrSet.Weight)
+	in.Failover = awsclients.LateInitializeString(in.Failover, aws.String(string(rrSet.Failover)))
+	in.Region = awsclients.LateInitializeString(in.Region, aws.String(string(rrSet.Region)))
+	in.HealthCheckID = awsclients.LateInitializeStringPtr(in.HealthCheckID, rrSet.HealthCheckId)
+	in.MultiValueAnswer = awsclients.LateInitializeBoolPtr(in.MultiValueAnswer, rrSet.MultiValueAnswer)
+	in.TrafficPolicyInstanceID = awsclients.LateInitializeStringPtr(in.TrafficPolicyInstanceID, rrSet.TrafficPolicyInstanceId)
+	if in.GeoLocation == nil && rrSet.GeoLocation != nil {
+		in.GeoLocation = &v1alpha1.GeoLocation{
+			ContinentCode:   rrSet.GeoLocation.ContinentCode,
+			CountryCode:     rrSet.GeoLocation.CountryCode,
+			SubdivisionCode: rrSet.GeoLocation.SubdivisionCode,
+		}
+	}
 	if len(in.ResourceRecords) == 0 && len(rrSet.ResourceRecords) != 0 {
 		in.ResourceRecords = make([]v1alpha1.ResourceRecord, len(rrSet.ResourceRecords))
 		for i, val := range rrSet.ResourceRecords {
@@ -167,6 +183,13 @@ func LateInitialize(in *v1alpha1.ResourceRecordSetParameters, rrSet *route53type
 			}
 		}
 	}
+	if in.AliasTarget == nil && rrSet.AliasTarget != nil {
+		in.AliasTarget = &v1alpha1.AliasTarget{
+			DNSName:              awsclients.StringValue(rrSet.AliasTarget.DNSName),
+			EvaluateTargetHealth: rrSet.AliasTarget.EvaluateTargetHealth,
+			HostedZoneID:         awsclients.StringValue(rrSet.AliasTarget.HostedZoneId),
+		}
+	}
 }
 
 // CreatePatch creates a *v1beta1.ResourceRecordSetParameters that has only the changed
@@ -180,6 +203,18 @@ func CreatePatch(in *route53types.ResourceRecordSet, target *v1alpha1.ResourceRe
 	// skip its comparison.
 	currentParams.ZoneID = target.ZoneID
 
+	// The same goes for the references of the alias target. Route 53 also
+	// returns its DNS name fully qualified and in lower case.
+	if currentParams.AliasTarget != nil && target.AliasTarget != nil {
+		at := target.AliasTarget.DeepCopy()
+		at.EvaluateTargetHealth = currentParams.AliasTarget.EvaluateTargetHealth
+		at.HostedZoneID = currentParams.AliasTarget.HostedZoneID
+		if !strings.EqualFold(strings.TrimSuffix(currentParams.AliasTarget.DNSName, "."), strings.TrimSuffix(at.DNSName, ".")) {
+			at.DNSName = currentParams.AliasTarget.DNSName
+		}
+		currentParams.AliasTarget = at
+	}
+
 	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
 	if err != nil {
 		return nil, err
@@ -19,9 +19,12 @@ package resourcerecordset
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 )
 
@@ -137,6 +140,82 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"SameAliasTarget": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name: &resourceRecordSetName,
+					AliasTarget: &route53types.AliasTarget{
+						DNSName:      aws.String("d111111abcdef8.cloudfront.net."),
+						HostedZoneId: aws.String("Z2FDTNDATAQYW2"),
+					},
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					AliasTarget: &v1alpha1.AliasTarget{
+						DNSName:         "D111111ABCDEF8.cloudfront.net",
+						HostedZoneID:    "Z2FDTNDATAQYW2",
+						DistributionRef: &xpv1.Reference{Name: "example"},
+					},
+				},
+			},
+			want: true,
+		},
+		"DifferentAliasTarget": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name: &resourceRecordSetName,
+					AliasTarget: &route53types.AliasTarget{
+						DNSName:      aws.String("d111111abcdef8.cloudfront.net."),
+						HostedZoneId: aws.String("Z2FDTNDATAQYW2"),
+					},
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					AliasTarget: &v1alpha1.AliasTarget{
+						DNSName:      "d222222abcdef8.cloudfront.net",
+						HostedZoneID: "Z2FDTNDATAQYW2",
+					},
+				},
+			},
+			want: false,
+		},
+		"SameFailoverRecord": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name:            &resourceRecordSetName,
+					TTL:             &ttl,
+					SetIdentifier:   aws.String("primary"),
+					Failover:        route53types.ResourceRecordSetFailoverPrimary,
+					HealthCheckId:   aws.String("abcdef11-2222-3333-4444-555555fedcba"),
+					ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:              &ttl,
+					SetIdentifier:    aws.String("primary"),
+					Failover:         "PRIMARY",
+					HealthCheckID:    aws.String("abcdef11-2222-3333-4444-555555fedcba"),
+					HealthCheckIDRef: &xpv1.Reference{Name: "primary"},
+					ResourceRecords:  []v1alpha1.ResourceRecord{{Value: "192.0.2.1"}},
+				},
+			},
+			want: true,
+		},
+		"DifferentLatencyRegion": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name:            &resourceRecordSetName,
+					TTL:             &ttl,
+					SetIdentifier:   aws.String("us-east-1"),
+					Region:          route53types.ResourceRecordSetRegionUsEast1,
+					ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:             &ttl,
+					SetIdentifier:   aws.String("us-east-1"),
+					Region:          "us-west-2",
+					ResourceRecords: []v1alpha1.ResourceRecord{{Value: "192.0.2.1"}},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
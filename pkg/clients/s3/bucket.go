@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -50,11 +51,25 @@ var (
 	TaggingNotFoundErrCode = "NoSuchTagSet"
 	// WebsiteNotFoundErrCode is the error code sent by AWS when the website config does not exist
 	WebsiteNotFoundErrCode = "NoSuchWebsiteConfiguration"
+	// ObjectLockNotFoundErrCode is the error code sent by AWS when the object lock config does not exist
+	ObjectLockNotFoundErrCode = "ObjectLockConfigurationNotFoundError"
+	// OwnershipControlsNotFoundErrCode is the error code sent by AWS when the ownership controls do not exist
+	OwnershipControlsNotFoundErrCode = "OwnershipControlsNotFoundError"
+
+	// ObjectOwnershipBucketOwnerEnforced is the object ownership that disables
+	// ACLs. It is not part of the object ownership enum of the SDK version in use.
+	ObjectOwnershipBucketOwnerEnforced = "BucketOwnerEnforced"
 
 	// MethodNotAllowed is the error code sent by AWS when the request method for an object is not allowed
 	MethodNotAllowed = "MethodNotAllowed"
 	// UnsupportedArgument is the error code sent by AWS when the request fields contain an argument that is not supported
 	UnsupportedArgument = "UnsupportedArgument"
+	// InvalidArgument is the error code sent by AWS when the request fields contain an invalid argument
+	InvalidArgument = "InvalidArgument"
+
+	// notificationDestinationValidationMsg is the prefix of the error message
+	// sent by AWS when a notification destination cannot be validated
+	notificationDestinationValidationMsg = "Unable to validate the following destination configurations"
 )
 
 // BucketClient is the interface for Client for making S3 Bucket requests.
@@ -97,11 +112,24 @@ type BucketClient interface {
 
 	PutBucketAnalyticsConfiguration(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)
 	GetBucketAnalyticsConfiguration(ctx context.Context, input *s3.GetBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	ListBucketAnalyticsConfigurations(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+	DeleteBucketAnalyticsConfiguration(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
 
 	PutBucketLifecycleConfiguration(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	GetBucketLifecycleConfiguration(ctx context.Context, input *s3.GetBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
 	DeleteBucketLifecycle(ctx context.Context, input *s3.DeleteBucketLifecycleInput, opts ...func(*s3.Options)) (*s3.DeleteBucketLifecycleOutput, error)
 
+	PutBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	ListBucketIntelligentTieringConfigurations(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	DeleteBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
+
+	PutBucketInventoryConfiguration(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+	ListBucketInventoryConfigurations(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	DeleteBucketInventoryConfiguration(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+
+	PutObjectLockConfiguration(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
+	GetObjectLockConfiguration(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+
 	PutBucketNotificationConfiguration(ctx context.Context, input *s3.PutBucketNotificationConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)
 	GetBucketNotificationConfiguration(ctx context.Context, input *s3.GetBucketNotificationConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)
 
@@ -110,6 +138,10 @@ type BucketClient interface {
 	GetPublicAccessBlock(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
 	PutPublicAccessBlock(ctx context.Context, input *s3.PutPublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)
 	DeletePublicAccessBlock(ctx context.Context, input *s3.DeletePublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)
+
+	GetBucketOwnershipControls(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)
+	PutBucketOwnershipControls(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)
+	DeleteBucketOwnershipControls(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -172,6 +204,12 @@ func PublicAccessBlockConfigurationNotFound(err error) bool {
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == PublicAccessBlockNotFoundErrCode
 }
 
+// OwnershipControlsNotFound is parses the aws Error and validates if the ownership controls do not exist
+func OwnershipControlsNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == OwnershipControlsNotFoundErrCode
+}
+
 // LifecycleConfigurationNotFound is parses the aws Error and validates if the lifecycle configuration does not exist
 func LifecycleConfigurationNotFound(err error) bool {
 	var awsErr smithy.APIError
@@ -190,12 +228,26 @@ func TaggingNotFound(err error) bool {
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TaggingNotFoundErrCode
 }
 
+// NotificationDestinationNotValidated parses the aws Error and validates if
+// the notification destinations could not be validated, which happens until
+// their policies or permissions allow Amazon S3 to publish to them
+func NotificationDestinationNotValidated(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == InvalidArgument && strings.HasPrefix(awsErr.ErrorMessage(), notificationDestinationValidationMsg)
+}
+
 // WebsiteConfigurationNotFound is parses the aws Error and validates if the website configuration does not exist
 func WebsiteConfigurationNotFound(err error) bool {
 	var awsErr smithy.APIError
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == WebsiteNotFoundErrCode
 }
 
+// ObjectLockConfigurationNotFound is parses the aws Error and validates if the object lock configuration does not exist
+func ObjectLockConfigurationNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ObjectLockNotFoundErrCode
+}
+
 // MethodNotSupported is parses the aws Error and validates if the method is allowed for a request
 func MethodNotSupported(err error) bool {
 	var awsErr smithy.APIError
@@ -208,8 +260,13 @@ func ArgumentNotSupported(err error) bool {
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == UnsupportedArgument
 }
 
-// UpdateBucketACL creates the ACLInput, sends the request to put an ACL based on the bucket
+// UpdateBucketACL creates the ACLInput, sends the request to put an ACL based on the bucket.
+// ACLs are disabled when the object ownership of the bucket is BucketOwnerEnforced, so no
+// ACL is put for such buckets.
 func UpdateBucketACL(ctx context.Context, client BucketClient, bucket *v1beta1.Bucket) error {
+	if aws.ToString(bucket.Spec.ForProvider.ObjectOwnership) == ObjectOwnershipBucketOwnerEnforced {
+		return nil
+	}
 	config := &s3.PutBucketAclInput{
 		ACL:              s3types.BucketCannedACL(aws.ToString(bucket.Spec.ForProvider.ACL)),
 		Bucket:           aws.String(meta.GetExternalName(bucket)),
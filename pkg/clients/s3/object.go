@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// ObjectContentSHA256MetadataKey is the user-defined metadata key under
+	// which the SHA-256 hash of the uploaded content is stored. S3 returns
+	// user-defined metadata keys in lower case.
+	ObjectContentSHA256MetadataKey = "content-sha256"
+
+	errGetContentSecret    = "cannot get the content Secret"
+	errGetContentConfigMap = "cannot get the content ConfigMap"
+)
+
+// ObjectClient is the external client used for Object Custom Resource
+type ObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+}
+
+// NewObjectClient returns a new client given an aws config
+func NewObjectClient(cfg aws.Config) ObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// IsErrorObjectNotFound returns true if the error code indicates that the
+// object or its bucket was not found. HeadObject responses have no body, so
+// S3 reports both as NotFound.
+func IsErrorObjectNotFound(err error) bool {
+	var awsErr smithy.APIError
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	switch awsErr.ErrorCode() {
+	case "NotFound", "NoSuchKey", "NoSuchBucket":
+		return true
+	}
+	return false
+}
+
+// GetObjectContent returns the content of the object, read from the
+// referenced Secret or ConfigMap if there is one.
+func GetObjectContent(ctx context.Context, kube client.Reader, p v1alpha3.ObjectParameters) ([]byte, error) {
+	switch {
+	case p.ContentSecretRef != nil:
+		data, err := awsclient.GetSecretData(ctx, kube, *p.ContentSecretRef)
+		return data, errors.Wrap(err, errGetContentSecret)
+	case p.ContentConfigMapRef != nil:
+		data, err := awsclient.GetConfigMapData(ctx, kube, *p.ContentConfigMapRef)
+		return data, errors.Wrap(err, errGetContentConfigMap)
+	}
+	return []byte(awsclient.StringValue(p.Content)), nil
+}
+
+// ContentHash returns the hex-encoded SHA-256 hash of the supplied content.
+func ContentHash(content []byte) string {
+	h := sha256.Sum256(content)
+	return hex.EncodeToString(h[:])
+}
+
+// GeneratePutObjectInput returns the input to upload the supplied content
+// with the given parameters. The hash of the content is stored in the
+// metadata of the object so that changes can be detected without
+// downloading it.
+func GeneratePutObjectInput(p v1alpha3.ObjectParameters, content []byte) *s3.PutObjectInput {
+	input := &s3.PutObjectInput{
+		Bucket:        p.BucketName,
+		Key:           aws.String(p.Key),
+		Body:          bytes.NewReader(content),
+		ContentLength: int64(len(content)),
+		ContentType:   p.ContentType,
+		CacheControl:  p.CacheControl,
+		Metadata:      map[string]string{ObjectContentSHA256MetadataKey: ContentHash(content)},
+		SSEKMSKeyId:   p.SSEKMSKeyID,
+	}
+	if p.ServerSideEncryption != nil {
+		input.ServerSideEncryption = s3types.ServerSideEncryption(*p.ServerSideEncryption)
+	}
+	return input
+}
+
+// IsObjectUpToDate checks whether the observed object has the desired content
+// and attributes. Attributes that are not set in the spec are left to the
+// defaults of S3 and not compared.
+func IsObjectUpToDate(p v1alpha3.ObjectParameters, content []byte, obj *s3.HeadObjectOutput) bool {
+	switch {
+	case obj.Metadata[ObjectContentSHA256MetadataKey] != ContentHash(content):
+		return false
+	case p.ContentType != nil && awsclient.StringValue(p.ContentType) != awsclient.StringValue(obj.ContentType):
+		return false
+	case p.CacheControl != nil && awsclient.StringValue(p.CacheControl) != awsclient.StringValue(obj.CacheControl):
+		return false
+	case p.ServerSideEncryption != nil && awsclient.StringValue(p.ServerSideEncryption) != string(obj.ServerSideEncryption):
+		return false
+	}
+	return true
+}
+
+// GenerateObjectObservation is used to produce v1alpha3.ObjectObservation
+// from the head of an object.
+func GenerateObjectObservation(obj *s3.HeadObjectOutput) v1alpha3.ObjectObservation {
+	return v1alpha3.ObjectObservation{
+		ETag:          awsclient.StringValue(obj.ETag),
+		VersionID:     awsclient.StringValue(obj.VersionId),
+		ContentSHA256: obj.Metadata[ObjectContentSHA256MetadataKey],
+	}
+}
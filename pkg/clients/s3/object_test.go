@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestGetObjectContent(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		content []byte
+		err     error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		p    v1alpha3.ObjectParameters
+		want want
+	}{
+		"Inline": {
+			p:    v1alpha3.ObjectParameters{Content: aws.String("echo hi")},
+			want: want{content: []byte("echo hi")},
+		},
+		"Secret": {
+			kube: &test.MockClient{
+				MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"config": []byte("secret")}
+					return nil
+				},
+			},
+			p: v1alpha3.ObjectParameters{
+				Content: aws.String("echo hi"),
+				ContentSecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "bootstrap", Namespace: "default"},
+					Key:             "config",
+				},
+			},
+			want: want{content: []byte("secret")},
+		},
+		"ConfigMap": {
+			kube: &test.MockClient{
+				MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"config": "echo hi"}
+					return nil
+				},
+			},
+			p: v1alpha3.ObjectParameters{
+				ContentConfigMapRef: &awsv1beta1.ConfigMapKeySelector{Name: "bootstrap", Namespace: "default", Key: "config"},
+			},
+			want: want{content: []byte("echo hi")},
+		},
+		"ConfigMapMissing": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			p: v1alpha3.ObjectParameters{
+				ContentConfigMapRef: &awsv1beta1.ConfigMapKeySelector{Name: "bootstrap", Namespace: "default", Key: "config"},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetContentConfigMap)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			content, err := GetObjectContent(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.content, content); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsObjectUpToDate(t *testing.T) {
+	content := []byte(`{"issuer":"https://example.com"}`)
+
+	cases := map[string]struct {
+		p    v1alpha3.ObjectParameters
+		obj  *s3.HeadObjectOutput
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha3.ObjectParameters{ContentType: aws.String("application/json")},
+			obj: &s3.HeadObjectOutput{
+				ContentType: aws.String("application/json"),
+				Metadata:    map[string]string{ObjectContentSHA256MetadataKey: ContentHash(content)},
+			},
+			want: true,
+		},
+		"UnsetAttributesIgnored": {
+			p: v1alpha3.ObjectParameters{},
+			obj: &s3.HeadObjectOutput{
+				ContentType:          aws.String("binary/octet-stream"),
+				ServerSideEncryption: s3types.ServerSideEncryptionAes256,
+				Metadata:             map[string]string{ObjectContentSHA256MetadataKey: ContentHash(content)},
+			},
+			want: true,
+		},
+		"ContentChanged": {
+			p: v1alpha3.ObjectParameters{},
+			obj: &s3.HeadObjectOutput{
+				Metadata: map[string]string{ObjectContentSHA256MetadataKey: ContentHash([]byte("{}"))},
+			},
+			want: false,
+		},
+		"ReplacedOutsideCrossplane": {
+			p:    v1alpha3.ObjectParameters{},
+			obj:  &s3.HeadObjectOutput{},
+			want: false,
+		},
+		"ContentTypeChanged": {
+			p: v1alpha3.ObjectParameters{ContentType: aws.String("application/json")},
+			obj: &s3.HeadObjectOutput{
+				ContentType: aws.String("text/plain"),
+				Metadata:    map[string]string{ObjectContentSHA256MetadataKey: ContentHash(content)},
+			},
+			want: false,
+		},
+		"EncryptionChanged": {
+			p: v1alpha3.ObjectParameters{ServerSideEncryption: aws.String("aws:kms")},
+			obj: &s3.HeadObjectOutput{
+				ServerSideEncryption: s3types.ServerSideEncryptionAes256,
+				Metadata:             map[string]string{ObjectContentSHA256MetadataKey: ContentHash(content)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsObjectUpToDate(tc.p, content, tc.obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// AccessPointNotFoundErrCode is the error code returned when the access
+	// point does not exist.
+	AccessPointNotFoundErrCode = "NoSuchAccessPoint"
+	// AccessPointPolicyNotFoundErrCode is the error code returned when the
+	// access point has no policy.
+	AccessPointPolicyNotFoundErrCode = "NoSuchAccessPointPolicy"
+)
+
+// AccessPointClient is the external client used for the AccessPoint
+// Resource. S3 Control is not part of the version of aws-sdk-go-v2 used by
+// the rest of the provider, so it is backed by aws-sdk-go.
+type AccessPointClient interface {
+	CreateAccessPointWithContext(ctx context.Context, input *s3controlv1.CreateAccessPointInput, opts ...request.Option) (*s3controlv1.CreateAccessPointOutput, error)
+	GetAccessPointWithContext(ctx context.Context, input *s3controlv1.GetAccessPointInput, opts ...request.Option) (*s3controlv1.GetAccessPointOutput, error)
+	DeleteAccessPointWithContext(ctx context.Context, input *s3controlv1.DeleteAccessPointInput, opts ...request.Option) (*s3controlv1.DeleteAccessPointOutput, error)
+	GetAccessPointPolicyWithContext(ctx context.Context, input *s3controlv1.GetAccessPointPolicyInput, opts ...request.Option) (*s3controlv1.GetAccessPointPolicyOutput, error)
+	PutAccessPointPolicyWithContext(ctx context.Context, input *s3controlv1.PutAccessPointPolicyInput, opts ...request.Option) (*s3controlv1.PutAccessPointPolicyOutput, error)
+	DeleteAccessPointPolicyWithContext(ctx context.Context, input *s3controlv1.DeleteAccessPointPolicyInput, opts ...request.Option) (*s3controlv1.DeleteAccessPointPolicyOutput, error)
+}
+
+// NewAccessPointClient returns a new AccessPointClient using the given
+// session.
+func NewAccessPointClient(sess *session.Session) AccessPointClient {
+	return s3controlv1.New(sess)
+}
+
+// IsAccessPointNotFoundErr returns true if the error indicates that the
+// access point was not found
+func IsAccessPointNotFoundErr(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == AccessPointNotFoundErrCode
+}
+
+// IsAccessPointPolicyNotFoundErr returns true if the error indicates that the
+// access point has no policy
+func IsAccessPointPolicyNotFoundErr(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == AccessPointPolicyNotFoundErrCode
+}
+
+// GenerateCreateAccessPointInput returns the input to create the access point
+// with the given name.
+func GenerateCreateAccessPointInput(name string, p v1alpha1.AccessPointParameters) *s3controlv1.CreateAccessPointInput {
+	input := &s3controlv1.CreateAccessPointInput{
+		AccountId:                      awsclient.String(p.AccountID),
+		Bucket:                         p.Bucket,
+		BucketAccountId:                p.BucketAccountID,
+		Name:                           awsclient.String(name),
+		PublicAccessBlockConfiguration: GeneratePublicAccessBlockConfiguration(p.PublicAccessBlockConfiguration),
+	}
+	if p.VPCConfiguration != nil {
+		input.VpcConfiguration = &s3controlv1.VpcConfiguration{VpcId: p.VPCConfiguration.VPCID}
+	}
+	return input
+}
+
+// GeneratePublicAccessBlockConfiguration returns the S3 Control public access
+// block configuration of the given one, or nil if it is nil.
+func GeneratePublicAccessBlockConfiguration(in *v1alpha1.PublicAccessBlockConfiguration) *s3controlv1.PublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	return &s3controlv1.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       in.BlockPublicACLs,
+		BlockPublicPolicy:     in.BlockPublicPolicy,
+		IgnorePublicAcls:      in.IgnorePublicACLs,
+		RestrictPublicBuckets: in.RestrictPublicBuckets,
+	}
+}
+
+// LateInitializeAccessPoint fills the empty fields in
+// *v1alpha1.AccessPointParameters with the values seen in
+// s3control.GetAccessPointOutput.
+func LateInitializeAccessPoint(in *v1alpha1.AccessPointParameters, ap *s3controlv1.GetAccessPointOutput) {
+	if ap == nil {
+		return
+	}
+	in.Bucket = awsclient.LateInitializeStringPtr(in.Bucket, ap.Bucket)
+	in.BucketAccountID = awsclient.LateInitializeStringPtr(in.BucketAccountID, ap.BucketAccountId)
+	if in.PublicAccessBlockConfiguration == nil && ap.PublicAccessBlockConfiguration != nil {
+		in.PublicAccessBlockConfiguration = &v1alpha1.PublicAccessBlockConfiguration{
+			BlockPublicACLs:       ap.PublicAccessBlockConfiguration.BlockPublicAcls,
+			BlockPublicPolicy:     ap.PublicAccessBlockConfiguration.BlockPublicPolicy,
+			IgnorePublicACLs:      ap.PublicAccessBlockConfiguration.IgnorePublicAcls,
+			RestrictPublicBuckets: ap.PublicAccessBlockConfiguration.RestrictPublicBuckets,
+		}
+	}
+}
+
+// GenerateAccessPointObservation is used to produce
+// v1alpha1.AccessPointObservation from s3control.GetAccessPointOutput.
+func GenerateAccessPointObservation(ap *s3controlv1.GetAccessPointOutput) v1alpha1.AccessPointObservation {
+	o := v1alpha1.AccessPointObservation{
+		ARN:           awsclient.StringValue(ap.AccessPointArn),
+		Alias:         awsclient.StringValue(ap.Alias),
+		NetworkOrigin: awsclient.StringValue(ap.NetworkOrigin),
+	}
+	if len(ap.Endpoints) != 0 {
+		o.Endpoints = make(map[string]string, len(ap.Endpoints))
+		for k, v := range ap.Endpoints {
+			o.Endpoints[k] = awsclient.StringValue(v)
+		}
+	}
+	return o
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3control"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccessPointClient = (*MockAccessPointClient)(nil)
+
+// MockAccessPointClient is a type that implements all the methods for AccessPointClient interface
+type MockAccessPointClient struct {
+	MockCreate       func(ctx context.Context, input *s3control.CreateAccessPointInput, opts []request.Option) (*s3control.CreateAccessPointOutput, error)
+	MockGet          func(ctx context.Context, input *s3control.GetAccessPointInput, opts []request.Option) (*s3control.GetAccessPointOutput, error)
+	MockDelete       func(ctx context.Context, input *s3control.DeleteAccessPointInput, opts []request.Option) (*s3control.DeleteAccessPointOutput, error)
+	MockGetPolicy    func(ctx context.Context, input *s3control.GetAccessPointPolicyInput, opts []request.Option) (*s3control.GetAccessPointPolicyOutput, error)
+	MockPutPolicy    func(ctx context.Context, input *s3control.PutAccessPointPolicyInput, opts []request.Option) (*s3control.PutAccessPointPolicyOutput, error)
+	MockDeletePolicy func(ctx context.Context, input *s3control.DeleteAccessPointPolicyInput, opts []request.Option) (*s3control.DeleteAccessPointPolicyOutput, error)
+}
+
+// CreateAccessPointWithContext mocks s3control method
+func (m *MockAccessPointClient) CreateAccessPointWithContext(ctx context.Context, input *s3control.CreateAccessPointInput, opts ...request.Option) (*s3control.CreateAccessPointOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// GetAccessPointWithContext mocks s3control method
+func (m *MockAccessPointClient) GetAccessPointWithContext(ctx context.Context, input *s3control.GetAccessPointInput, opts ...request.Option) (*s3control.GetAccessPointOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// DeleteAccessPointWithContext mocks s3control method
+func (m *MockAccessPointClient) DeleteAccessPointWithContext(ctx context.Context, input *s3control.DeleteAccessPointInput, opts ...request.Option) (*s3control.DeleteAccessPointOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// GetAccessPointPolicyWithContext mocks s3control method
+func (m *MockAccessPointClient) GetAccessPointPolicyWithContext(ctx context.Context, input *s3control.GetAccessPointPolicyInput, opts ...request.Option) (*s3control.GetAccessPointPolicyOutput, error) {
+	return m.MockGetPolicy(ctx, input, opts)
+}
+
+// PutAccessPointPolicyWithContext mocks s3control method
+func (m *MockAccessPointClient) PutAccessPointPolicyWithContext(ctx context.Context, input *s3control.PutAccessPointPolicyInput, opts ...request.Option) (*s3control.PutAccessPointPolicyOutput, error) {
+	return m.MockPutPolicy(ctx, input, opts)
+}
+
+// DeleteAccessPointPolicyWithContext mocks s3control method
+func (m *MockAccessPointClient) DeleteAccessPointPolicyWithContext(ctx context.Context, input *s3control.DeleteAccessPointPolicyInput, opts ...request.Option) (*s3control.DeleteAccessPointPolicyOutput, error) {
+	return m.MockDeletePolicy(ctx, input, opts)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3control"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.MultiRegionAccessPointClient = (*MockMultiRegionAccessPointClient)(nil)
+
+// MockMultiRegionAccessPointClient is a type that implements all the methods for MultiRegionAccessPointClient interface
+type MockMultiRegionAccessPointClient struct {
+	MockCreate    func(ctx context.Context, input *s3control.CreateMultiRegionAccessPointInput, opts []request.Option) (*s3control.CreateMultiRegionAccessPointOutput, error)
+	MockGet       func(ctx context.Context, input *s3control.GetMultiRegionAccessPointInput, opts []request.Option) (*s3control.GetMultiRegionAccessPointOutput, error)
+	MockDelete    func(ctx context.Context, input *s3control.DeleteMultiRegionAccessPointInput, opts []request.Option) (*s3control.DeleteMultiRegionAccessPointOutput, error)
+	MockGetPolicy func(ctx context.Context, input *s3control.GetMultiRegionAccessPointPolicyInput, opts []request.Option) (*s3control.GetMultiRegionAccessPointPolicyOutput, error)
+	MockPutPolicy func(ctx context.Context, input *s3control.PutMultiRegionAccessPointPolicyInput, opts []request.Option) (*s3control.PutMultiRegionAccessPointPolicyOutput, error)
+}
+
+// CreateMultiRegionAccessPointWithContext mocks s3control method
+func (m *MockMultiRegionAccessPointClient) CreateMultiRegionAccessPointWithContext(ctx context.Context, input *s3control.CreateMultiRegionAccessPointInput, opts ...request.Option) (*s3control.CreateMultiRegionAccessPointOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// GetMultiRegionAccessPointWithContext mocks s3control method
+func (m *MockMultiRegionAccessPointClient) GetMultiRegionAccessPointWithContext(ctx context.Context, input *s3control.GetMultiRegionAccessPointInput, opts ...request.Option) (*s3control.GetMultiRegionAccessPointOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// DeleteMultiRegionAccessPointWithContext mocks s3control method
+func (m *MockMultiRegionAccessPointClient) DeleteMultiRegionAccessPointWithContext(ctx context.Context, input *s3control.DeleteMultiRegionAccessPointInput, opts ...request.Option) (*s3control.DeleteMultiRegionAccessPointOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// GetMultiRegionAccessPointPolicyWithContext mocks s3control method
+func (m *MockMultiRegionAccessPointClient) GetMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *s3control.GetMultiRegionAccessPointPolicyInput, opts ...request.Option) (*s3control.GetMultiRegionAccessPointPolicyOutput, error) {
+	return m.MockGetPolicy(ctx, input, opts)
+}
+
+// PutMultiRegionAccessPointPolicyWithContext mocks s3control method
+func (m *MockMultiRegionAccessPointClient) PutMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *s3control.PutMultiRegionAccessPointPolicyInput, opts ...request.Option) (*s3control.PutMultiRegionAccessPointPolicyOutput, error) {
+	return m.MockPutPolicy(ctx, input, opts)
+}
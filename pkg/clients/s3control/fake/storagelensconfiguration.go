@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3control"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.StorageLensConfigurationClient = (*MockStorageLensConfigurationClient)(nil)
+
+// MockStorageLensConfigurationClient is a type that implements all the methods for StorageLensConfigurationClient interface
+type MockStorageLensConfigurationClient struct {
+	MockPut           func(ctx context.Context, input *s3control.PutStorageLensConfigurationInput, opts []request.Option) (*s3control.PutStorageLensConfigurationOutput, error)
+	MockGet           func(ctx context.Context, input *s3control.GetStorageLensConfigurationInput, opts []request.Option) (*s3control.GetStorageLensConfigurationOutput, error)
+	MockDelete        func(ctx context.Context, input *s3control.DeleteStorageLensConfigurationInput, opts []request.Option) (*s3control.DeleteStorageLensConfigurationOutput, error)
+	MockGetTagging    func(ctx context.Context, input *s3control.GetStorageLensConfigurationTaggingInput, opts []request.Option) (*s3control.GetStorageLensConfigurationTaggingOutput, error)
+	MockPutTagging    func(ctx context.Context, input *s3control.PutStorageLensConfigurationTaggingInput, opts []request.Option) (*s3control.PutStorageLensConfigurationTaggingOutput, error)
+	MockDeleteTagging func(ctx context.Context, input *s3control.DeleteStorageLensConfigurationTaggingInput, opts []request.Option) (*s3control.DeleteStorageLensConfigurationTaggingOutput, error)
+}
+
+// PutStorageLensConfigurationWithContext mocks s3control method
+func (m *MockStorageLensConfigurationClient) PutStorageLensConfigurationWithContext(ctx context.Context, input *s3control.PutStorageLensConfigurationInput, opts ...request.Option) (*s3control.PutStorageLensConfigurationOutput, error) {
+	return m.MockPut(ctx, input, opts)
+}
+
+// GetStorageLensConfigurationWithContext mocks s3control method
+func (m *MockStorageLensConfigurationClient) GetStorageLensConfigurationWithContext(ctx context.Context, input *s3control.GetStorageLensConfigurationInput, opts ...request.Option) (*s3control.GetStorageLensConfigurationOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// DeleteStorageLensConfigurationWithContext mocks s3control method
+func (m *MockStorageLensConfigurationClient) DeleteStorageLensConfigurationWithContext(ctx context.Context, input *s3control.DeleteStorageLensConfigurationInput, opts ...request.Option) (*s3control.DeleteStorageLensConfigurationOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// GetStorageLensConfigurationTaggingWithContext mocks s3control method
+func (m *MockStorageLensConfigurationClient) GetStorageLensConfigurationTaggingWithContext(ctx context.Context, input *s3control.GetStorageLensConfigurationTaggingInput, opts ...request.Option) (*s3control.GetStorageLensConfigurationTaggingOutput, error) {
+	return m.MockGetTagging(ctx, input, opts)
+}
+
+// PutStorageLensConfigurationTaggingWithContext mocks s3control method
+func (m *MockStorageLensConfigurationClient) PutStorageLensConfigurationTaggingWithContext(ctx context.Context, input *s3control.PutStorageLensConfigurationTaggingInput, opts ...request.Option) (*s3control.PutStorageLensConfigurationTaggingOutput, error) {
+	return m.MockPutTagging(ctx, input, opts)
+}
+
+// DeleteStorageLensConfigurationTaggingWithContext mocks s3control method
+func (m *MockStorageLensConfigurationClient) DeleteStorageLensConfigurationTaggingWithContext(ctx context.Context, input *s3control.DeleteStorageLensConfigurationTaggingInput, opts ...request.Option) (*s3control.DeleteStorageLensConfigurationTaggingOutput, error) {
+	return m.MockDeleteTagging(ctx, input, opts)
+}
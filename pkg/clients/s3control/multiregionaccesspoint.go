@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// MultiRegionAccessPointNotFoundErrCode is the error code returned when
+	// the Multi-Region Access Point does not exist.
+	MultiRegionAccessPointNotFoundErrCode = "NoSuchMultiRegionAccessPoint"
+
+	// MultiRegionAccessPointRegion is the region Multi-Region Access Point
+	// requests have to be sent to.
+	MultiRegionAccessPointRegion = "us-west-2"
+)
+
+// MultiRegionAccessPointClient is the external client used for the
+// MultiRegionAccessPoint Resource.
+type MultiRegionAccessPointClient interface {
+	CreateMultiRegionAccessPointWithContext(ctx context.Context, input *s3controlv1.CreateMultiRegionAccessPointInput, opts ...request.Option) (*s3controlv1.CreateMultiRegionAccessPointOutput, error)
+	GetMultiRegionAccessPointWithContext(ctx context.Context, input *s3controlv1.GetMultiRegionAccessPointInput, opts ...request.Option) (*s3controlv1.GetMultiRegionAccessPointOutput, error)
+	DeleteMultiRegionAccessPointWithContext(ctx context.Context, input *s3controlv1.DeleteMultiRegionAccessPointInput, opts ...request.Option) (*s3controlv1.DeleteMultiRegionAccessPointOutput, error)
+	GetMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *s3controlv1.GetMultiRegionAccessPointPolicyInput, opts ...request.Option) (*s3controlv1.GetMultiRegionAccessPointPolicyOutput, error)
+	PutMultiRegionAccessPointPolicyWithContext(ctx context.Context, input *s3controlv1.PutMultiRegionAccessPointPolicyInput, opts ...request.Option) (*s3controlv1.PutMultiRegionAccessPointPolicyOutput, error)
+}
+
+// NewMultiRegionAccessPointClient returns a new MultiRegionAccessPointClient
+// using the given session.
+func NewMultiRegionAccessPointClient(sess *session.Session) MultiRegionAccessPointClient {
+	return s3controlv1.New(sess)
+}
+
+// IsMultiRegionAccessPointNotFoundErr returns true if the error indicates
+// that the Multi-Region Access Point was not found
+func IsMultiRegionAccessPointNotFoundErr(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == MultiRegionAccessPointNotFoundErrCode
+}
+
+// GenerateCreateMultiRegionAccessPointInput returns the input to create the
+// Multi-Region Access Point with the given name. The client token makes
+// retries of the asynchronous creation idempotent.
+func GenerateCreateMultiRegionAccessPointInput(name, clientToken string, p v1alpha1.MultiRegionAccessPointParameters) *s3controlv1.CreateMultiRegionAccessPointInput {
+	details := &s3controlv1.CreateMultiRegionAccessPointInput_{
+		Name:              awsclient.String(name),
+		PublicAccessBlock: GeneratePublicAccessBlockConfiguration(p.PublicAccessBlockConfiguration),
+	}
+	for _, r := range p.Regions {
+		details.Regions = append(details.Regions, &s3controlv1.Region{Bucket: r.Bucket})
+	}
+	return &s3controlv1.CreateMultiRegionAccessPointInput{
+		AccountId:   awsclient.String(p.AccountID),
+		ClientToken: awsclient.String(clientToken),
+		Details:     details,
+	}
+}
+
+// GenerateMultiRegionAccessPointObservation is used to produce
+// v1alpha1.MultiRegionAccessPointObservation from
+// s3control.MultiRegionAccessPointReport.
+func GenerateMultiRegionAccessPointObservation(accountID string, r *s3controlv1.MultiRegionAccessPointReport) v1alpha1.MultiRegionAccessPointObservation {
+	o := v1alpha1.MultiRegionAccessPointObservation{
+		Alias:  awsclient.StringValue(r.Alias),
+		Status: awsclient.StringValue(r.Status),
+	}
+	if o.Alias != "" {
+		o.ARN = fmt.Sprintf("arn:aws:s3::%s:accesspoint/%s", accountID, o.Alias)
+	}
+	return o
+}
+
+// IsMultiRegionAccessPointPolicyUpToDate returns true if the established or
+// the proposed policy of the Multi-Region Access Point is the desired one.
+// Policy changes are applied asynchronously, so a proposed policy that
+// matches is not put again. Multi-Region Access Point policies cannot be
+// deleted, so the policy is not managed when none is desired.
+func IsMultiRegionAccessPointPolicyUpToDate(desired *string, doc *s3controlv1.MultiRegionAccessPointPolicyDocument) bool {
+	var established, proposed string
+	if doc != nil && doc.Established != nil {
+		established = awsclient.StringValue(doc.Established.Policy)
+	}
+	if doc != nil && doc.Proposed != nil {
+		proposed = awsclient.StringValue(doc.Proposed.Policy)
+	}
+	if awsclient.StringValue(desired) == "" {
+		return true
+	}
+	return awsclient.IsPolicyUpToDate(desired, &established) || awsclient.IsPolicyUpToDate(desired, &proposed)
+}
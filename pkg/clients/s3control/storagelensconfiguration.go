@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	s3controlv1 "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// StorageLensConfigurationNotFoundErrCode is the error code returned when
+	// the Storage Lens configuration does not exist.
+	StorageLensConfigurationNotFoundErrCode = "NoSuchConfiguration"
+)
+
+// StorageLensConfigurationClient is the external client used for the
+// StorageLensConfiguration Resource.
+type StorageLensConfigurationClient interface {
+	PutStorageLensConfigurationWithContext(ctx context.Context, input *s3controlv1.PutStorageLensConfigurationInput, opts ...request.Option) (*s3controlv1.PutStorageLensConfigurationOutput, error)
+	GetStorageLensConfigurationWithContext(ctx context.Context, input *s3controlv1.GetStorageLensConfigurationInput, opts ...request.Option) (*s3controlv1.GetStorageLensConfigurationOutput, error)
+	DeleteStorageLensConfigurationWithContext(ctx context.Context, input *s3controlv1.DeleteStorageLensConfigurationInput, opts ...request.Option) (*s3controlv1.DeleteStorageLensConfigurationOutput, error)
+	GetStorageLensConfigurationTaggingWithContext(ctx context.Context, input *s3controlv1.GetStorageLensConfigurationTaggingInput, opts ...request.Option) (*s3controlv1.GetStorageLensConfigurationTaggingOutput, error)
+	PutStorageLensConfigurationTaggingWithContext(ctx context.Context, input *s3controlv1.PutStorageLensConfigurationTaggingInput, opts ...request.Option) (*s3controlv1.PutStorageLensConfigurationTaggingOutput, error)
+	DeleteStorageLensConfigurationTaggingWithContext(ctx context.Context, input *s3controlv1.DeleteStorageLensConfigurationTaggingInput, opts ...request.Option) (*s3controlv1.DeleteStorageLensConfigurationTaggingOutput, error)
+}
+
+// NewStorageLensConfigurationClient returns a new
+// StorageLensConfigurationClient using the given session.
+func NewStorageLensConfigurationClient(sess *session.Session) StorageLensConfigurationClient {
+	return s3controlv1.New(sess)
+}
+
+// IsStorageLensConfigurationNotFoundErr returns true if the error indicates
+// that the Storage Lens configuration was not found
+func IsStorageLensConfigurationNotFoundErr(err error) bool {
+	code, _ := awsclient.ErrorCode(err)
+	return code == StorageLensConfigurationNotFoundErrCode
+}
+
+func generateMetrics(in *v1alpha1.StorageLensMetrics) *bool {
+	if in == nil {
+		return nil
+	}
+	return awsclient.Bool(in.IsEnabled)
+}
+
+// stringSlice omits empty lists, which are not accepted in place of a missing
+// list of buckets or regions.
+func stringSlice(in []string) []*string {
+	if len(in) == 0 {
+		return nil
+	}
+	return aws.StringSlice(in)
+}
+
+func isMetricsEnabled(in *v1alpha1.StorageLensMetrics) bool {
+	return in != nil && in.IsEnabled
+}
+
+func generateObservedMetrics(isEnabled *bool) *v1alpha1.StorageLensMetrics {
+	if isEnabled == nil {
+		return nil
+	}
+	return &v1alpha1.StorageLensMetrics{IsEnabled: awsclient.BoolValue(isEnabled)}
+}
+
+// GenerateStorageLensConfiguration returns the Storage Lens configuration
+// with the given ID from the given parameters.
+func GenerateStorageLensConfiguration(id string, p v1alpha1.StorageLensConfigurationParameters) *s3controlv1.StorageLensConfiguration { // nolint:gocyclo
+	c := &s3controlv1.StorageLensConfiguration{
+		Id:        awsclient.String(id),
+		IsEnabled: awsclient.Bool(p.IsEnabled),
+		AccountLevel: &s3controlv1.AccountLevel{
+			BucketLevel: &s3controlv1.BucketLevel{},
+		},
+	}
+	al := p.AccountLevel
+	if v := generateMetrics(al.ActivityMetrics); v != nil {
+		c.AccountLevel.ActivityMetrics = &s3controlv1.ActivityMetrics{IsEnabled: v}
+	}
+	if v := generateMetrics(al.AdvancedCostOptimizationMetrics); v != nil {
+		c.AccountLevel.AdvancedCostOptimizationMetrics = &s3controlv1.AdvancedCostOptimizationMetrics{IsEnabled: v}
+	}
+	if v := generateMetrics(al.AdvancedDataProtectionMetrics); v != nil {
+		c.AccountLevel.AdvancedDataProtectionMetrics = &s3controlv1.AdvancedDataProtectionMetrics{IsEnabled: v}
+	}
+	if v := generateMetrics(al.DetailedStatusCodesMetrics); v != nil {
+		c.AccountLevel.DetailedStatusCodesMetrics = &s3controlv1.DetailedStatusCodesMetrics{IsEnabled: v}
+	}
+	bl := al.BucketLevel
+	if v := generateMetrics(bl.ActivityMetrics); v != nil {
+		c.AccountLevel.BucketLevel.ActivityMetrics = &s3controlv1.ActivityMetrics{IsEnabled: v}
+	}
+	if v := generateMetrics(bl.AdvancedCostOptimizationMetrics); v != nil {
+		c.AccountLevel.BucketLevel.AdvancedCostOptimizationMetrics = &s3controlv1.AdvancedCostOptimizationMetrics{IsEnabled: v}
+	}
+	if v := generateMetrics(bl.AdvancedDataProtectionMetrics); v != nil {
+		c.AccountLevel.BucketLevel.AdvancedDataProtectionMetrics = &s3controlv1.AdvancedDataProtectionMetrics{IsEnabled: v}
+	}
+	if v := generateMetrics(bl.DetailedStatusCodesMetrics); v != nil {
+		c.AccountLevel.BucketLevel.DetailedStatusCodesMetrics = &s3controlv1.DetailedStatusCodesMetrics{IsEnabled: v}
+	}
+	if bl.PrefixLevel != nil {
+		sm := bl.PrefixLevel.StorageMetrics
+		c.AccountLevel.BucketLevel.PrefixLevel = &s3controlv1.PrefixLevel{
+			StorageMetrics: &s3controlv1.PrefixLevelStorageMetrics{IsEnabled: awsclient.Bool(sm.IsEnabled)},
+		}
+		if sm.SelectionCriteria != nil {
+			c.AccountLevel.BucketLevel.PrefixLevel.StorageMetrics.SelectionCriteria = &s3controlv1.SelectionCriteria{
+				Delimiter:                 sm.SelectionCriteria.Delimiter,
+				MaxDepth:                  sm.SelectionCriteria.MaxDepth,
+				MinStorageBytesPercentage: sm.SelectionCriteria.MinStorageBytesPercentage,
+			}
+		}
+	}
+	if p.AWSOrgARN != nil {
+		c.AwsOrg = &s3controlv1.StorageLensAwsOrg{Arn: p.AWSOrgARN}
+	}
+	if p.DataExport != nil {
+		c.DataExport = generateStorageLensDataExport(p.DataExport)
+	}
+	if p.Include != nil {
+		c.Include = &s3controlv1.Include{
+			Buckets: stringSlice(p.Include.Buckets),
+			Regions: stringSlice(p.Include.Regions),
+		}
+	}
+	if p.Exclude != nil {
+		c.Exclude = &s3controlv1.Exclude{
+			Buckets: stringSlice(p.Exclude.Buckets),
+			Regions: stringSlice(p.Exclude.Regions),
+		}
+	}
+	return c
+}
+
+func generateStorageLensDataExport(in *v1alpha1.StorageLensDataExport) *s3controlv1.StorageLensDataExport {
+	de := &s3controlv1.StorageLensDataExport{}
+	if v := generateMetrics(in.CloudWatchMetrics); v != nil {
+		de.CloudWatchMetrics = &s3controlv1.CloudWatchMetrics{IsEnabled: v}
+	}
+	if d := in.S3BucketDestination; d != nil {
+		de.S3BucketDestination = &s3controlv1.S3BucketDestination{
+			AccountId:           awsclient.String(d.AccountID),
+			Arn:                 d.Bucket,
+			Format:              awsclient.String(d.Format),
+			OutputSchemaVersion: awsclient.String(d.OutputSchemaVersion),
+			Prefix:              d.Prefix,
+		}
+		if d.Encryption != nil {
+			de.S3BucketDestination.Encryption = &s3controlv1.StorageLensDataExportEncryption{}
+			if d.Encryption.SSEKMS != nil {
+				de.S3BucketDestination.Encryption.SSEKMS = &s3controlv1.SSEKMS{KeyId: awsclient.String(d.Encryption.SSEKMS.KeyID)}
+			}
+			if d.Encryption.SSES3 != nil {
+				de.S3BucketDestination.Encryption.SSES3 = &s3controlv1.SSES3{}
+			}
+		}
+	}
+	return de
+}
+
+// GenerateStorageLensTags returns the tags of the Storage Lens configuration
+// from the given parameters.
+func GenerateStorageLensTags(p v1alpha1.StorageLensConfigurationParameters) []*s3controlv1.StorageLensTag {
+	if len(p.Tags) == 0 {
+		return nil
+	}
+	tags := make([]*s3controlv1.StorageLensTag, len(p.Tags))
+	for i, t := range p.Tags {
+		tags[i] = &s3controlv1.StorageLensTag{Key: awsclient.String(t.Key), Value: awsclient.String(t.Value)}
+	}
+	return tags
+}
+
+// GeneratePutStorageLensConfigurationInput returns the input to create or
+// replace the Storage Lens configuration with the given ID.
+func GeneratePutStorageLensConfigurationInput(id string, p v1alpha1.StorageLensConfigurationParameters) *s3controlv1.PutStorageLensConfigurationInput {
+	return &s3controlv1.PutStorageLensConfigurationInput{
+		AccountId:                awsclient.String(p.AccountID),
+		ConfigId:                 awsclient.String(id),
+		StorageLensConfiguration: GenerateStorageLensConfiguration(id, p),
+		Tags:                     GenerateStorageLensTags(p),
+	}
+}
+
+// generateObservedParameters returns the parameters that are set in the
+// given Storage Lens configuration and tags. References, selectors and the
+// fields that cannot be observed are left empty.
+func generateObservedParameters(c *s3controlv1.StorageLensConfiguration, tags []*s3controlv1.StorageLensTag) v1alpha1.StorageLensConfigurationParameters { // nolint:gocyclo
+	p := v1alpha1.StorageLensConfigurationParameters{
+		IsEnabled: awsclient.BoolValue(c.IsEnabled),
+	}
+	if al := c.AccountLevel; al != nil {
+		if al.ActivityMetrics != nil {
+			p.AccountLevel.ActivityMetrics = generateObservedMetrics(al.ActivityMetrics.IsEnabled)
+		}
+		if al.AdvancedCostOptimizationMetrics != nil {
+			p.AccountLevel.AdvancedCostOptimizationMetrics = generateObservedMetrics(al.AdvancedCostOptimizationMetrics.IsEnabled)
+		}
+		if al.AdvancedDataProtectionMetrics != nil {
+			p.AccountLevel.AdvancedDataProtectionMetrics = generateObservedMetrics(al.AdvancedDataProtectionMetrics.IsEnabled)
+		}
+		if al.DetailedStatusCodesMetrics != nil {
+			p.AccountLevel.DetailedStatusCodesMetrics = generateObservedMetrics(al.DetailedStatusCodesMetrics.IsEnabled)
+		}
+		if bl := al.BucketLevel; bl != nil {
+			if bl.ActivityMetrics != nil {
+				p.AccountLevel.BucketLevel.ActivityMetrics = generateObservedMetrics(bl.ActivityMetrics.IsEnabled)
+			}
+			if bl.AdvancedCostOptimizationMetrics != nil {
+				p.AccountLevel.BucketLevel.AdvancedCostOptimizationMetrics = generateObservedMetrics(bl.AdvancedCostOptimizationMetrics.IsEnabled)
+			}
+			if bl.AdvancedDataProtectionMetrics != nil {
+				p.AccountLevel.BucketLevel.AdvancedDataProtectionMetrics = generateObservedMetrics(bl.AdvancedDataProtectionMetrics.IsEnabled)
+			}
+			if bl.DetailedStatusCodesMetrics != nil {
+				p.AccountLevel.BucketLevel.DetailedStatusCodesMetrics = generateObservedMetrics(bl.DetailedStatusCodesMetrics.IsEnabled)
+			}
+			if bl.PrefixLevel != nil && bl.PrefixLevel.StorageMetrics != nil {
+				sm := bl.PrefixLevel.StorageMetrics
+				p.AccountLevel.BucketLevel.PrefixLevel = &v1alpha1.PrefixLevel{
+					StorageMetrics: v1alpha1.PrefixLevelStorageMetrics{IsEnabled: awsclient.BoolValue(sm.IsEnabled)},
+				}
+				if sm.SelectionCriteria != nil {
+					p.AccountLevel.BucketLevel.PrefixLevel.StorageMetrics.SelectionCriteria = &v1alpha1.SelectionCriteria{
+						Delimiter:                 sm.SelectionCriteria.Delimiter,
+						MaxDepth:                  sm.SelectionCriteria.MaxDepth,
+						MinStorageBytesPercentage: sm.SelectionCriteria.MinStorageBytesPercentage,
+					}
+				}
+			}
+		}
+	}
+	if c.AwsOrg != nil {
+		p.AWSOrgARN = c.AwsOrg.Arn
+	}
+	if c.DataExport != nil {
+		p.DataExport = &v1alpha1.StorageLensDataExport{}
+		if c.DataExport.CloudWatchMetrics != nil {
+			p.DataExport.CloudWatchMetrics = generateObservedMetrics(c.DataExport.CloudWatchMetrics.IsEnabled)
+		}
+		if d := c.DataExport.S3BucketDestination; d != nil {
+			p.DataExport.S3BucketDestination = &v1alpha1.StorageLensS3BucketDestination{
+				AccountID:           awsclient.StringValue(d.AccountId),
+				Bucket:              d.Arn,
+				Format:              awsclient.StringValue(d.Format),
+				OutputSchemaVersion: awsclient.StringValue(d.OutputSchemaVersion),
+				Prefix:              d.Prefix,
+			}
+			if d.Encryption != nil {
+				p.DataExport.S3BucketDestination.Encryption = &v1alpha1.StorageLensDataExportEncryption{}
+				if d.Encryption.SSEKMS != nil {
+					p.DataExport.S3BucketDestination.Encryption.SSEKMS = &v1alpha1.SSEKMS{KeyID: awsclient.StringValue(d.Encryption.SSEKMS.KeyId)}
+				}
+				if d.Encryption.SSES3 != nil {
+					p.DataExport.S3BucketDestination.Encryption.SSES3 = &v1alpha1.SSES3{}
+				}
+			}
+		}
+	}
+	if c.Include != nil {
+		p.Include = &v1alpha1.StorageLensScope{
+			Buckets: aws.StringValueSlice(c.Include.Buckets),
+			Regions: aws.StringValueSlice(c.Include.Regions),
+		}
+	}
+	if c.Exclude != nil {
+		p.Exclude = &v1alpha1.StorageLensScope{
+			Buckets: aws.StringValueSlice(c.Exclude.Buckets),
+			Regions: aws.StringValueSlice(c.Exclude.Regions),
+		}
+	}
+	for _, t := range tags {
+		p.Tags = append(p.Tags, v1alpha1.StorageLensTag{Key: awsclient.StringValue(t.Key), Value: awsclient.StringValue(t.Value)})
+	}
+	return p
+}
+
+// IsStorageLensConfigurationUpToDate returns true if the given Storage Lens
+// configuration and tags match the desired parameters. Metrics that are not
+// set are considered to be disabled.
+func IsStorageLensConfigurationUpToDate(p v1alpha1.StorageLensConfigurationParameters, c *s3controlv1.StorageLensConfiguration, tags []*s3controlv1.StorageLensTag) bool {
+	observed := generateObservedParameters(c, tags)
+	return cmp.Equal(p, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.StorageLensConfigurationParameters{}, "Region", "AccountID"),
+		cmpopts.IgnoreFields(v1alpha1.StorageLensS3BucketDestination{}, "BucketRef", "BucketSelector"),
+		cmpopts.SortSlices(func(a, b v1alpha1.StorageLensTag) bool { return a.Key < b.Key }),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmp.Comparer(func(a, b *v1alpha1.StorageLensMetrics) bool {
+			return isMetricsEnabled(a) == isMetricsEnabled(b)
+		}),
+	)
+}
+
+// GenerateStorageLensConfigurationObservation is used to produce
+// v1alpha1.StorageLensConfigurationObservation from
+// s3control.StorageLensConfiguration.
+func GenerateStorageLensConfigurationObservation(c *s3controlv1.StorageLensConfiguration) v1alpha1.StorageLensConfigurationObservation {
+	return v1alpha1.StorageLensConfigurationObservation{
+		ARN: awsclient.StringValue(c.StorageLensArn),
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+)
+
+// MockServiceQuotasClient for testing.
+type MockServiceQuotasClient struct {
+	MockGetServiceQuota           func(ctx context.Context, input *servicequotas.GetServiceQuotaInput, opts []func(*servicequotas.Options)) (*servicequotas.GetServiceQuotaOutput, error)
+	MockGetAWSDefaultServiceQuota func(ctx context.Context, input *servicequotas.GetAWSDefaultServiceQuotaInput, opts []func(*servicequotas.Options)) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error)
+}
+
+// GetServiceQuota mocks GetServiceQuota
+func (m *MockServiceQuotasClient) GetServiceQuota(ctx context.Context, i *servicequotas.GetServiceQuotaInput, opts ...func(*servicequotas.Options)) (*servicequotas.GetServiceQuotaOutput, error) {
+	return m.MockGetServiceQuota(ctx, i, opts)
+}
+
+// GetAWSDefaultServiceQuota mocks GetAWSDefaultServiceQuota
+func (m *MockServiceQuotasClient) GetAWSDefaultServiceQuota(ctx context.Context, i *servicequotas.GetAWSDefaultServiceQuotaInput, opts ...func(*servicequotas.Options)) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error) {
+	return m.MockGetAWSDefaultServiceQuota(ctx, i, opts)
+}
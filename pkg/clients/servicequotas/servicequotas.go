@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetProviderConfig = "cannot get referenced ProviderConfig"
+	errGetQuota          = "cannot get service quota"
+	errGetUsage          = "cannot determine current usage of service quota"
+	errStatusUpdate      = "cannot update status of the managed resource"
+	errNoQuotaValue      = "service quota has no value"
+)
+
+// TypeQuotaExceeded resources are blocked from being created because doing so
+// would exceed an AWS service quota.
+const TypeQuotaExceeded xpv1.ConditionType = "QuotaExceeded"
+
+// Reasons a resource is or is not blocked by a service quota.
+const (
+	ReasonQuotaExceeded  xpv1.ConditionReason = "QuotaExceeded"
+	ReasonQuotaAvailable xpv1.ConditionReason = "QuotaAvailable"
+)
+
+// A Quota identifies an AWS service quota.
+type Quota struct {
+	// ServiceCode is the Service Quotas code of the AWS service, e.g. ec2.
+	ServiceCode string
+
+	// QuotaCode is the Service Quotas code of the quota, e.g. L-0263D0A3.
+	QuotaCode string
+
+	// Name is a human readable name of the quota.
+	Name string
+}
+
+// Quotas that are checked before creating resources.
+var (
+	QuotaVPCsPerRegion = Quota{ServiceCode: "vpc", QuotaCode: "L-F678F1CE", Name: "VPCs per Region"}
+	QuotaElasticIPs    = Quota{ServiceCode: "ec2", QuotaCode: "L-0263D0A3", Name: "EC2-VPC Elastic IPs"}
+	QuotaDBInstances   = Quota{ServiceCode: "rds", QuotaCode: "L-7B6409FD", Name: "DB instances"}
+)
+
+// Client is the external client used to query AWS Service Quotas.
+type Client interface {
+	GetServiceQuota(ctx context.Context, input *servicequotas.GetServiceQuotaInput, opts ...func(*servicequotas.Options)) (*servicequotas.GetServiceQuotaOutput, error)
+	GetAWSDefaultServiceQuota(ctx context.Context, input *servicequotas.GetAWSDefaultServiceQuotaInput, opts ...func(*servicequotas.Options)) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error)
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return servicequotas.NewFromConfig(cfg)
+}
+
+// IsNotFound returns true if the error is because the quota has no applied
+// value, which is the case for quotas that were never increased.
+func IsNotFound(err error) bool {
+	var nf *types.NoSuchResourceException
+	return errors.As(err, &nf)
+}
+
+// Enabled returns true if the ProviderConfig of the supplied managed resource
+// asks for service quotas to be checked before resources are created.
+func Enabled(ctx context.Context, kube client.Client, mg resource.Managed) (bool, error) {
+	if mg.GetProviderConfigReference() == nil {
+		return false, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := kube.Get(ctx, ktypes.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return false, errors.Wrap(err, errGetProviderConfig)
+	}
+	return aws.ToBool(pc.Spec.CheckServiceQuotas), nil
+}
+
+// GetLimit returns the value of the supplied quota that applies to the
+// account, falling back to the AWS default value if it was never changed.
+func GetLimit(ctx context.Context, c Client, q Quota) (float64, error) {
+	o, err := c.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(q.ServiceCode),
+		QuotaCode:   aws.String(q.QuotaCode),
+	})
+	if err == nil {
+		return quotaValue(o.Quota)
+	}
+	if !IsNotFound(err) {
+		return 0, awsclient.Wrap(err, errGetQuota)
+	}
+	d, err := c.GetAWSDefaultServiceQuota(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(q.ServiceCode),
+		QuotaCode:   aws.String(q.QuotaCode),
+	})
+	if err != nil {
+		return 0, awsclient.Wrap(err, errGetQuota)
+	}
+	return quotaValue(d.Quota)
+}
+
+func quotaValue(q *types.ServiceQuota) (float64, error) {
+	if q == nil || q.Value == nil {
+		return 0, errors.New(errNoQuotaValue)
+	}
+	return aws.ToFloat64(q.Value), nil
+}
+
+type exceededError struct {
+	quota Quota
+	usage int
+	limit float64
+}
+
+func (e *exceededError) Error() string {
+	return fmt.Sprintf("creating this resource would exceed the %q service quota (%s/%s): %d of %d in use", e.quota.Name, e.quota.ServiceCode, e.quota.QuotaCode, e.usage, int(e.limit))
+}
+
+// IsExceeded returns true if the supplied error indicates that a service
+// quota would be exceeded.
+func IsExceeded(err error) bool {
+	var ee *exceededError
+	return errors.As(err, &ee)
+}
+
+// Exceeded returns a condition that indicates the resource cannot be created
+// because doing so would exceed a service quota.
+func Exceeded(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeQuotaExceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQuotaExceeded,
+		Message:            err.Error(),
+	}
+}
+
+// Available returns a condition that indicates the resource is not blocked by
+// a service quota.
+func Available() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeQuotaExceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQuotaAvailable,
+	}
+}
+
+// A UsageFn returns how many resources currently count towards a quota.
+type UsageFn func(ctx context.Context) (int, error)
+
+// A Checker checks service quotas before resources are created. A nil
+// *Checker never blocks creation, which is how callers represent disabled
+// quota checks.
+type Checker struct {
+	kube   client.Client
+	client Client
+}
+
+// NewChecker returns a Checker that queries the supplied Service Quotas client
+// and records its findings in the status of managed resources.
+func NewChecker(kube client.Client, c Client) *Checker {
+	return &Checker{kube: kube, client: c}
+}
+
+// Check returns an error satisfying IsExceeded if creating one more resource
+// would exceed the supplied quota. The outcome is recorded as a QuotaExceeded
+// condition and persisted immediately, since the managed reconciler discards
+// status changes made while creating an external resource.
+func (c *Checker) Check(ctx context.Context, mg resource.Managed, q Quota, usage UsageFn) error {
+	if c == nil {
+		return nil
+	}
+	used, err := usage(ctx)
+	if err != nil {
+		return errors.Wrap(err, errGetUsage)
+	}
+	limit, err := GetLimit(ctx, c.client, q)
+	if err != nil {
+		return err
+	}
+	if float64(used+1) > limit {
+		ee := &exceededError{quota: q, usage: used, limit: limit}
+		mg.SetConditions(Exceeded(ee))
+		if err := c.kube.Status().Update(ctx, mg); err != nil {
+			return errors.Wrap(err, errStatusUpdate)
+		}
+		return ee
+	}
+	if mg.GetCondition(TypeQuotaExceeded).Status == corev1.ConditionTrue {
+		mg.SetConditions(Available())
+		return errors.Wrap(c.kube.Status().Update(ctx, mg), errStatusUpdate)
+	}
+	return nil
+}
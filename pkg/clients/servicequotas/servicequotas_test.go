@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	sqfake "github.com/crossplane/provider-aws/pkg/clients/servicequotas/fake"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+func withQuota(applied, def *float64) *sqfake.MockServiceQuotasClient {
+	return &sqfake.MockServiceQuotasClient{
+		MockGetServiceQuota: func(_ context.Context, _ *servicequotas.GetServiceQuotaInput, _ []func(*servicequotas.Options)) (*servicequotas.GetServiceQuotaOutput, error) {
+			if applied == nil {
+				return nil, &types.NoSuchResourceException{}
+			}
+			return &servicequotas.GetServiceQuotaOutput{Quota: &types.ServiceQuota{Value: applied}}, nil
+		},
+		MockGetAWSDefaultServiceQuota: func(_ context.Context, _ *servicequotas.GetAWSDefaultServiceQuotaInput, _ []func(*servicequotas.Options)) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error) {
+			return &servicequotas.GetAWSDefaultServiceQuotaOutput{Quota: &types.ServiceQuota{Value: def}}, nil
+		},
+	}
+}
+
+func TestGetLimit(t *testing.T) {
+	type want struct {
+		limit float64
+		err   error
+	}
+	cases := map[string]struct {
+		client Client
+		want
+	}{
+		"Applied": {
+			client: withQuota(aws.Float64(10), aws.Float64(5)),
+			want:   want{limit: 10},
+		},
+		"Default": {
+			client: withQuota(nil, aws.Float64(5)),
+			want:   want{limit: 5},
+		},
+		"Error": {
+			client: &sqfake.MockServiceQuotasClient{
+				MockGetServiceQuota: func(_ context.Context, _ *servicequotas.GetServiceQuotaInput, _ []func(*servicequotas.Options)) (*servicequotas.GetServiceQuotaOutput, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{err: awsclient.Wrap(errBoom, errGetQuota)},
+		},
+		"NoValue": {
+			client: withQuota(nil, nil),
+			want:   want{err: errors.New(errNoQuotaValue)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			limit, err := GetLimit(context.Background(), tc.client, QuotaVPCsPerRegion)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.limit, limit); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	usage := func(n int) UsageFn {
+		return func(_ context.Context) (int, error) { return n, nil }
+	}
+	kube := &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)}
+
+	type args struct {
+		checker *Checker
+		mg      *fake.Managed
+		usage   UsageFn
+	}
+	type want struct {
+		exceeded bool
+		err      error
+		status   corev1.ConditionStatus
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Disabled": {
+			args: args{
+				mg: &fake.Managed{},
+			},
+			want: want{status: corev1.ConditionUnknown},
+		},
+		"WithinQuota": {
+			args: args{
+				checker: NewChecker(kube, withQuota(aws.Float64(5), nil)),
+				mg:      &fake.Managed{},
+				usage:   usage(4),
+			},
+			want: want{status: corev1.ConditionUnknown},
+		},
+		"Exceeded": {
+			args: args{
+				checker: NewChecker(kube, withQuota(aws.Float64(5), nil)),
+				mg:      &fake.Managed{},
+				usage:   usage(5),
+			},
+			want: want{exceeded: true, status: corev1.ConditionTrue},
+		},
+		"NoLongerExceeded": {
+			args: args{
+				checker: NewChecker(kube, withQuota(aws.Float64(6), nil)),
+				mg: &fake.Managed{ConditionedStatus: xpv1.ConditionedStatus{
+					Conditions: []xpv1.Condition{Exceeded(errBoom)},
+				}},
+				usage: usage(5),
+			},
+			want: want{status: corev1.ConditionFalse},
+		},
+		"UsageError": {
+			args: args{
+				checker: NewChecker(kube, withQuota(aws.Float64(5), nil)),
+				mg:      &fake.Managed{},
+				usage:   func(_ context.Context) (int, error) { return 0, errBoom },
+			},
+			want: want{err: errors.Wrap(errBoom, errGetUsage), status: corev1.ConditionUnknown},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.checker.Check(context.Background(), tc.args.mg, QuotaVPCsPerRegion, tc.args.usage)
+			if tc.want.exceeded {
+				if !IsExceeded(err) {
+					t.Errorf("Check(...): want exceeded error, got %v", err)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, tc.args.mg.GetCondition(TypeQuotaExceeded).Status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
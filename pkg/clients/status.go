@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// lastSyncResolution is how old the last sync time of a resource has to be
+// before an observation refreshes it. Every change to the status of a managed
+// resource triggers another reconcile, so refreshing it on every poll would
+// double the calls made to AWS.
+const lastSyncResolution = 5 * time.Minute
+
+// syncStatus updates the SyncStatus of a managed resource. Its methods are
+// no-ops on a nil syncStatus so that resources without one can be passed.
+type syncStatus struct {
+	*v1beta1.SyncStatus
+}
+
+// syncStatusOf returns the SyncStatus embedded in the status of the supplied
+// managed resource. It is looked up by reflection since the managed resource
+// interface does not expose the status of a resource.
+func syncStatusOf(mg resource.Managed) syncStatus {
+	v := reflect.ValueOf(mg)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return syncStatus{}
+	}
+	st := v.Elem().FieldByName("Status")
+	if st.Kind() != reflect.Struct {
+		return syncStatus{}
+	}
+	f := st.FieldByName("SyncStatus")
+	if !f.IsValid() || f.Type() != reflect.TypeOf(v1beta1.SyncStatus{}) {
+		return syncStatus{}
+	}
+	return syncStatus{SyncStatus: f.Addr().Interface().(*v1beta1.SyncStatus)}
+}
+
+// observed records that AWS was consulted about the resource. Unless force is
+// true, nothing is recorded if the last sync time is recent, so that repeated
+// polls and retries of failed calls don't write the resource every time.
+func (s syncStatus) observed(requestID string, force bool) {
+	if s.SyncStatus == nil {
+		return
+	}
+	if !force && s.LastSyncTime != nil && time.Since(s.LastSyncTime.Time) < lastSyncResolution {
+		return
+	}
+	t := metav1.Now()
+	s.LastSyncTime = &t
+	if requestID != "" {
+		s.LastRequestID = requestID
+	}
+}
+
+// applied records that the supplied generation of the spec was applied to the
+// external resource.
+func (s syncStatus) applied(generation int64) {
+	if s.SyncStatus == nil {
+		return
+	}
+	s.ObservedGeneration = generation
+}
+
+type requestIDsKey struct{}
+
+// requestIDs collects the IDs of the AWS API requests made with a context.
+type requestIDs struct {
+	mu sync.Mutex
+	id string
+}
+
+func withRequestIDs(ctx context.Context) (context.Context, *requestIDs) {
+	ids := &requestIDs{}
+	return context.WithValue(ctx, requestIDsKey{}, ids), ids
+}
+
+func (r *requestIDs) last() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.id
+}
+
+func recordRequestID(ctx context.Context, id string) {
+	ids, ok := ctx.Value(requestIDsKey{}).(*requestIDs)
+	if !ok || id == "" {
+		return
+	}
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+	ids.id = id
+}
+
+// addRequestIDRecorder is an AWS SDK v2 API option that records the ID of
+// every request made with a context returned by withRequestIDs.
+func addRequestIDRecorder(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("RequestIDRecorder", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		out, md, err := next.HandleInitialize(ctx, in)
+		if id, ok := awsmiddleware.GetRequestIDMetadata(md); ok {
+			recordRequestID(ctx, id)
+			return out, md, err
+		}
+		var re *awshttp.ResponseError
+		if errors.As(err, &re) {
+			recordRequestID(ctx, re.ServiceRequestID())
+		}
+		return out, md, err
+	}), middleware.Before)
+}
+
+// recordRequestIDV1 is an AWS SDK v1 request handler that records the ID of
+// every request made with a context returned by withRequestIDs.
+func recordRequestIDV1(r *request.Request) {
+	recordRequestID(r.Context(), r.RequestID)
+}
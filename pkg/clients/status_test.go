@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestWrappedExternalSyncStatus(t *testing.T) {
+	errDenied := &smithy.GenericAPIError{Code: "AccessDenied", Message: "not allowed"}
+	errNotAWS := errors.New("boom")
+	denied, _ := AWSError(errDenied)
+	recent := metav1.NewTime(time.Now().Add(-time.Minute))
+	stale := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	type args struct {
+		kube       client.Client
+		ext        managed.ExternalClient
+		conditions []xpv1.Condition
+		sync       v1beta1.SyncStatus
+		op         func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error
+	}
+	type want struct {
+		err        error
+		cond       xpv1.Condition
+		name       string
+		synced     bool
+		requestID  string
+		generation int64
+	}
+	observe := func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+		_, err := e.Observe(ctx, mg)
+		return err
+	}
+	create := func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+		_, err := e.Create(ctx, mg)
+		return err
+	}
+	update := func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) error {
+		_, err := e.Update(ctx, mg)
+		return err
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ObserveFailed": {
+			args: args{
+				ext: &managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						recordRequestID(ctx, "req-1")
+						return managed.ExternalObservation{}, errDenied
+					},
+				},
+				op: observe,
+			},
+			want: want{err: errDenied, cond: denied, name: "cool", synced: true, requestID: "req-1"},
+		},
+		"ObserveUpToDate": {
+			args: args{
+				ext: &managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						recordRequestID(ctx, "req-2")
+						return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+					},
+				},
+				conditions: []xpv1.Condition{denied},
+				sync:       v1beta1.SyncStatus{LastSyncTime: &stale, LastRequestID: "req-1"},
+				op:         observe,
+			},
+			want: want{cond: AWSSucceeded(), name: "cool", synced: true, requestID: "req-2", generation: 3},
+		},
+		"ObserveRecentlySynced": {
+			args: args{
+				ext: &managed.ExternalClientFns{
+					ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						recordRequestID(ctx, "req-2")
+						return managed.ExternalObservation{ResourceExists: true}, nil
+					},
+				},
+				sync: v1beta1.SyncStatus{LastSyncTime: &recent, LastRequestID: "req-1"},
+				op:   observe,
+			},
+			want: want{cond: xpv1.Condition{Type: TypeAWSError, Status: "Unknown"}, name: "cool", requestID: "req-1"},
+		},
+		"UpdateSucceeded": {
+			args: args{
+				ext: &managed.ExternalClientFns{
+					UpdateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+						recordRequestID(ctx, "req-2")
+						return managed.ExternalUpdate{}, nil
+					},
+				},
+				sync: v1beta1.SyncStatus{LastSyncTime: &recent, LastRequestID: "req-1", ObservedGeneration: 2},
+				op:   update,
+			},
+			want: want{cond: xpv1.Condition{Type: TypeAWSError, Status: "Unknown"}, name: "cool", synced: true, requestID: "req-2", generation: 3},
+		},
+		"CreateFailedPersistsCondition": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						obj.SetAnnotations(nil)
+						return nil
+					},
+				},
+				ext: &managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, errDenied
+					},
+				},
+				op: create,
+			},
+			want: want{err: errDenied, cond: denied, name: "cool", synced: true},
+		},
+		"CreateFailedNotAnAWSError": {
+			args: args{
+				ext: &managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, errNotAWS
+					},
+				},
+				op: create,
+			},
+			want: want{err: errNotAWS, cond: xpv1.Condition{Type: TypeAWSError, Status: "Unknown"}, name: "cool", synced: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &ec2v1beta1.VPC{}
+			mg.SetGeneration(3)
+			mg.SetConditions(tc.args.conditions...)
+			mg.Status.SyncStatus = tc.args.sync
+			meta.SetExternalName(mg, "cool")
+			c := WrapExternal(tc.args.kube, managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return tc.args.ext, nil
+			}))
+			e, _ := c.Connect(context.Background(), mg)
+			err := tc.args.op(context.Background(), e, mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cond, mg.GetCondition(TypeAWSError), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.name, meta.GetExternalName(mg)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			s := mg.Status.SyncStatus
+			synced := s.LastSyncTime != nil && time.Since(s.LastSyncTime.Time) < time.Minute/2
+			if diff := cmp.Diff(tc.want.synced, synced); diff != "" {
+				t.Errorf("synced: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.requestID, s.LastRequestID); diff != "" {
+				t.Errorf("requestID: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.generation, s.ObservedGeneration); diff != "" {
+				t.Errorf("generation: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const errGetCallerIdentity = "cannot get caller identity"
+
+// Client is the external client used to query AWS STS.
+type Client interface {
+	GetCallerIdentity(ctx context.Context, input *sts.GetCallerIdentityInput, opts ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return sts.NewFromConfig(cfg)
+}
+
+// GetCallerIdentity returns the AWS identity the credentials of the supplied
+// client resolve to.
+func GetCallerIdentity(ctx context.Context, c Client) (*v1beta1.CallerIdentity, error) {
+	o, err := c.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errGetCallerIdentity)
+	}
+	return &v1beta1.CallerIdentity{
+		Account: aws.ToString(o.Account),
+		ARN:     aws.ToString(o.Arn),
+	}, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errUpdateTags = "cannot update managed resource with Crossplane tags"
+
+// A Tagger adds the tags Crossplane uses to trace external resources back to
+// the managed resources they are managed by, crossplane-kind, crossplane-name
+// and crossplane-providerconfig, to the spec.forProvider.tags of managed
+// resources. Resources without tags are left alone.
+type Tagger struct {
+	kube client.Client
+}
+
+// NewTagger returns a Tagger that updates managed resources using the supplied
+// client.
+func NewTagger(kube client.Client) *Tagger {
+	return &Tagger{kube: kube}
+}
+
+// Initialize adds the Crossplane tags to the supplied managed resource, and
+// updates it if any were missing or differed.
+func (t *Tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	tags, err := fieldByJSONPath(forProvider(mg), []string{"tags"})
+	if err != nil || !tags.IsValid() {
+		return nil
+	}
+	if !addTags(tags, resource.GetExternalTags(mg)) {
+		return nil
+	}
+	return errors.Wrap(t.kube.Update(ctx, mg), errUpdateTags)
+}
+
+// addTags adds the supplied tags to a map of tags, or to a slice of structs
+// with key and value fields. It returns whether any were added or changed.
+func addTags(v reflect.Value, tags map[string]string) bool {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	changed := false
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return false
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		for _, k := range keys {
+			kv := reflect.ValueOf(k).Convert(v.Type().Key())
+			if e := v.MapIndex(kv); e.IsValid() && stringValue(e) == tags[k] {
+				continue
+			}
+			e := reflect.New(v.Type().Elem()).Elem()
+			setString(e, tags[k])
+			v.SetMapIndex(kv, e)
+			changed = true
+		}
+	case reflect.Slice:
+		kf, vf, ok := tagFields(v.Type().Elem())
+		if !ok {
+			return false
+		}
+	Keys:
+		for _, k := range keys {
+			for i := 0; i < v.Len(); i++ {
+				e := reflect.Indirect(v.Index(i))
+				if !e.IsValid() || stringValue(e.FieldByName(kf)) != k {
+					continue
+				}
+				if stringValue(e.FieldByName(vf)) != tags[k] {
+					setString(e.FieldByName(vf), tags[k])
+					changed = true
+				}
+				continue Keys
+			}
+			e := reflect.New(v.Type().Elem()).Elem()
+			s := e
+			if e.Kind() == reflect.Ptr {
+				e.Set(reflect.New(e.Type().Elem()))
+				s = e.Elem()
+			}
+			setString(s.FieldByName(kf), k)
+			setString(s.FieldByName(vf), tags[k])
+			v.Set(reflect.Append(v, e))
+			changed = true
+		}
+	}
+	return changed
+}
+
+// tagFields returns the names of the key and value fields of the supplied tag
+// type, which may be a pointer to a struct.
+func tagFields(t reflect.Type) (string, string, bool) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return "", "", false
+	}
+	for _, f := range [][2]string{{"Key", "Value"}, {"TagKey", "TagValue"}} {
+		_, k := t.FieldByName(f[0])
+		_, v := t.FieldByName(f[1])
+		if k && v {
+			return f[0], f[1], true
+		}
+	}
+	return "", "", false
+}
+
+func stringValue(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.String {
+		return ""
+	}
+	return v.String()
+}
+
+func setString(v reflect.Value, s string) {
+	if v.Kind() == reflect.Ptr {
+		p := reflect.New(v.Type().Elem())
+		p.Elem().SetString(s)
+		v.Set(p)
+		return
+	}
+	v.SetString(s)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	apigatewayv2v1alpha1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+func TestTagger(t *testing.T) {
+	errBoom := errors.New("boom")
+	meta := func(mg resource.Managed, gvk schema.GroupVersionKind) {
+		mg.GetObjectKind().SetGroupVersionKind(gvk)
+		mg.SetName("example")
+		mg.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	}
+	securityGroup := func(tags ...ec2v1beta1.Tag) *ec2v1beta1.SecurityGroup {
+		cr := &ec2v1beta1.SecurityGroup{}
+		meta(cr, ec2v1beta1.SecurityGroupGroupVersionKind)
+		cr.Spec.ForProvider.Tags = tags
+		return cr
+	}
+
+	type args struct {
+		kube client.Client
+		mg   resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SliceOfStructs": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: securityGroup(
+					ec2v1beta1.Tag{Key: "team", Value: "platform"},
+					ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyName, Value: "stale"},
+				),
+			},
+			want: want{mg: securityGroup(
+				ec2v1beta1.Tag{Key: "team", Value: "platform"},
+				ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyName, Value: "example"},
+				ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyKind, Value: "securitygroup.ec2.aws.crossplane.io"},
+				ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyProvider, Value: "default"},
+			)},
+		},
+		"AlreadyTagged": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: securityGroup(
+					ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyKind, Value: "securitygroup.ec2.aws.crossplane.io"},
+					ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyName, Value: "example"},
+					ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyProvider, Value: "default"},
+				),
+			},
+			want: want{mg: securityGroup(
+				ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyKind, Value: "securitygroup.ec2.aws.crossplane.io"},
+				ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyName, Value: "example"},
+				ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyProvider, Value: "default"},
+			)},
+		},
+		"SliceOfStructPointers": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: func() resource.Managed {
+					cr := &kmsv1alpha1.Key{}
+					meta(cr, kmsv1alpha1.KeyGroupVersionKind)
+					return cr
+				}(),
+			},
+			want: want{mg: func() resource.Managed {
+				cr := &kmsv1alpha1.Key{}
+				meta(cr, kmsv1alpha1.KeyGroupVersionKind)
+				cr.Spec.ForProvider.Tags = []*kmsv1alpha1.Tag{
+					{TagKey: String(resource.ExternalResourceTagKeyKind), TagValue: String("key.kms.aws.crossplane.io")},
+					{TagKey: String(resource.ExternalResourceTagKeyName), TagValue: String("example")},
+					{TagKey: String(resource.ExternalResourceTagKeyProvider), TagValue: String("default")},
+				}
+				return cr
+			}()},
+		},
+		"MapOfStrings": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: func() resource.Managed {
+					cr := &sqsv1beta1.Queue{}
+					meta(cr, sqsv1beta1.QueueGroupVersionKind)
+					return cr
+				}(),
+			},
+			want: want{mg: func() resource.Managed {
+				cr := &sqsv1beta1.Queue{}
+				meta(cr, sqsv1beta1.QueueGroupVersionKind)
+				cr.Spec.ForProvider.Tags = map[string]string{
+					resource.ExternalResourceTagKeyKind:     "queue.sqs.aws.crossplane.io",
+					resource.ExternalResourceTagKeyName:     "example",
+					resource.ExternalResourceTagKeyProvider: "default",
+				}
+				return cr
+			}()},
+		},
+		"MapOfStringPointers": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: func() resource.Managed {
+					cr := &apigatewayv2v1alpha1.API{}
+					meta(cr, apigatewayv2v1alpha1.APIGroupVersionKind)
+					cr.Spec.ForProvider.Tags = map[string]*string{"team": String("platform")}
+					return cr
+				}(),
+			},
+			want: want{mg: func() resource.Managed {
+				cr := &apigatewayv2v1alpha1.API{}
+				meta(cr, apigatewayv2v1alpha1.APIGroupVersionKind)
+				cr.Spec.ForProvider.Tags = map[string]*string{
+					"team":                                  String("platform"),
+					resource.ExternalResourceTagKeyKind:     String("api.apigatewayv2.aws.crossplane.io"),
+					resource.ExternalResourceTagKeyName:     String("example"),
+					resource.ExternalResourceTagKeyProvider: String("default"),
+				}
+				return cr
+			}()},
+		},
+		"NotTaggable": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   &iamv1beta1.AccessKey{},
+			},
+			want: want{mg: &iamv1beta1.AccessKey{}},
+		},
+		"UpdateFailed": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg:   securityGroup(),
+			},
+			want: want{
+				mg: securityGroup(
+					ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyKind, Value: "securitygroup.ec2.aws.crossplane.io"},
+					ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyName, Value: "example"},
+					ec2v1beta1.Tag{Key: resource.ExternalResourceTagKeyProvider, Value: "default"},
+				),
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewTagger(tc.args.kube).Initialize(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Initialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -70,7 +70,8 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		For(&v1beta1.Certificate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{client: mgr.GetClient(), newClientFn: acm.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
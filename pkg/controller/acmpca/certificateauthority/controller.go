@@ -70,13 +70,14 @@ func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger, rl workqueue.
 		For(&v1beta1.CertificateAuthority{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateAuthorityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 
 			// TODO: implement tag initializer
 
-			managed.WithInitializers(),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -62,7 +62,8 @@ func SetupCertificateAuthorityPermission(mgr ctrl.Manager, l logging.Logger, rl
 		For(&v1beta1.CertificateAuthorityPermission{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateAuthorityPermissionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an API Gateway APIKey resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the API key"
+	errCreate           = "failed to create the API key"
+	errUpdate           = "failed to update the API key"
+	errDelete           = "failed to delete the API key"
+)
+
+// SetupAPIKey adds a controller that reconciles API Gateway API keys.
+func SetupAPIKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.APIKeyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.APIKey{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.APIKeyGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the ID of the API key, which is only known once
+	// the API key is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	k, err := e.client.GetApiKeyWithContext(ctx, &awsapigateway.GetApiKeyInput{
+		ApiKey:       aws.String(meta.GetExternalName(cr)),
+		IncludeValue: aws.Bool(true),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeAPIKey(&cr.Spec.ForProvider, k)
+
+	cr.Status.AtProvider = apigateway.GenerateAPIKeyObservation(k)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: len(apigateway.GenerateAPIKeyPatchOperations(cr.Spec.ForProvider, k)) == 0 &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, aws.StringValueMap(k.Tags), cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.StringValue(k.Value)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	k, err := e.client.CreateApiKeyWithContext(ctx, apigateway.GenerateCreateAPIKeyInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(k.Id))
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.StringValue(k.Value)),
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+
+	k, err := e.client.GetApiKeyWithContext(ctx, &awsapigateway.GetApiKeyInput{ApiKey: aws.String(id)})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if ops := apigateway.GenerateAPIKeyPatchOperations(cr.Spec.ForProvider, k); len(ops) > 0 {
+		if _, err := e.client.UpdateApiKeyWithContext(ctx, &awsapigateway.UpdateApiKeyInput{
+			ApiKey:          aws.String(id),
+			PatchOperations: ops,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	return managed.ExternalUpdate{}, apigateway.UpdateTags(ctx, e.client, apigateway.APIKeyARN(cr.Spec.ForProvider.Region, id), cr.Spec.ForProvider.Tags, aws.StringValueMap(k.Tags))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.APIKey)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteApiKeyWithContext(ctx, &awsapigateway.DeleteApiKeyInput{
+		ApiKey: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,488 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	keyID = "k1l2m3n4o5"
+	value = "s3cr3t"
+
+	errBoom = errors.New("boom")
+)
+
+type apiKeyModifier func(*v1alpha1.APIKey)
+
+func withExternalName(name string) apiKeyModifier {
+	return func(r *v1alpha1.APIKey) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) apiKeyModifier {
+	return func(r *v1alpha1.APIKey) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.APIKeyParameters) apiKeyModifier {
+	return func(r *v1alpha1.APIKey) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.APIKeyObservation) apiKeyModifier {
+	return func(r *v1alpha1.APIKey) { r.Status.AtProvider = s }
+}
+
+func apiKey(m ...apiKeyModifier) *v1alpha1.APIKey {
+	cr := &v1alpha1.APIKey{
+		Spec: v1alpha1.APIKeySpec{
+			ForProvider: v1alpha1.APIKeyParameters{
+				Region: "us-east-1",
+				Name:   "partner",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedKey() *awsapigateway.ApiKey {
+	return &awsapigateway.ApiKey{
+		Id:          aws.String(keyID),
+		Name:        aws.String("partner"),
+		Description: aws.String("partner access"),
+		Enabled:     aws.Bool(true),
+		Value:       aws.String(value),
+		Tags:        aws.StringMap(map[string]string{"team": "api"}),
+	}
+}
+
+func getAPIKey(_ context.Context, input *awsapigateway.GetApiKeyInput, _ []request.Option) (*awsapigateway.ApiKey, error) {
+	if aws.StringValue(input.ApiKey) != keyID {
+		return nil, errors.New("unexpected API key")
+	}
+	return observedKey(), nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.APIKey
+		result managed.ExternalObservation
+		err    error
+	}
+
+	details := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(value)}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.APIKey
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     apiKey(),
+			want: want{
+				cr: apiKey(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockGetApiKeyWithContext: getAPIKey},
+			cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+				Region:      "us-east-1",
+				Name:        "partner",
+				Description: aws.String("partner access"),
+				Enabled:     aws.Bool(true),
+				Tags:        map[string]string{"team": "api"},
+			})),
+			want: want{
+				cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+					Region:      "us-east-1",
+					Name:        "partner",
+					Description: aws.String("partner access"),
+					Enabled:     aws.Bool(true),
+					Tags:        map[string]string{"team": "api"},
+				}), withStatus(v1alpha1.APIKeyObservation{ID: keyID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockGetApiKeyWithContext: getAPIKey},
+			cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+				Region: "us-east-1",
+				Name:   "partner",
+				Tags:   map[string]string{"team": "api"},
+			})),
+			want: want{
+				cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+					Region:      "us-east-1",
+					Name:        "partner",
+					Description: aws.String("partner access"),
+					Enabled:     aws.Bool(true),
+					Tags:        map[string]string{"team": "api"},
+				}), withStatus(v1alpha1.APIKeyObservation{ID: keyID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       details,
+				},
+			},
+		},
+		"Disabled": {
+			client: &fake.MockClient{MockGetApiKeyWithContext: getAPIKey},
+			cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+				Region:      "us-east-1",
+				Name:        "partner",
+				Description: aws.String("partner access"),
+				Enabled:     aws.Bool(false),
+				Tags:        map[string]string{"team": "api"},
+			})),
+			want: want{
+				cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+					Region:      "us-east-1",
+					Name:        "partner",
+					Description: aws.String("partner access"),
+					Enabled:     aws.Bool(false),
+					Tags:        map[string]string{"team": "api"},
+				}), withStatus(v1alpha1.APIKeyObservation{ID: keyID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"TagsChanged": {
+			client: &fake.MockClient{MockGetApiKeyWithContext: getAPIKey},
+			cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+				Region:      "us-east-1",
+				Name:        "partner",
+				Description: aws.String("partner access"),
+				Enabled:     aws.Bool(true),
+				Tags:        map[string]string{"team": "platform"},
+			})),
+			want: want{
+				cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+					Region:      "us-east-1",
+					Name:        "partner",
+					Description: aws.String("partner access"),
+					Enabled:     aws.Bool(true),
+					Tags:        map[string]string{"team": "platform"},
+				}), withStatus(v1alpha1.APIKeyObservation{ID: keyID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetApiKeyWithContext: func(context.Context, *awsapigateway.GetApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: apiKey(withExternalName(keyID)),
+			want: want{
+				cr: apiKey(withExternalName(keyID)),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetApiKeyWithContext: func(context.Context, *awsapigateway.GetApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+					return nil, errBoom
+				},
+			},
+			cr: apiKey(withExternalName(keyID)),
+			want: want{
+				cr:  apiKey(withExternalName(keyID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.APIKey
+		result managed.ExternalCreation
+		input  *awsapigateway.CreateApiKeyInput
+		err    error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.APIKey
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: apiKey(),
+			want: want{
+				cr: apiKey(withExternalName(keyID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{xpv1.ResourceCredentialsSecretPasswordKey: []byte(value)},
+				},
+				input: &awsapigateway.CreateApiKeyInput{
+					Name:    aws.String("partner"),
+					Enabled: aws.Bool(true),
+				},
+			},
+		},
+		"CreateFailed": {
+			cr:        apiKey(),
+			createErr: errBoom,
+			want: want{
+				cr: apiKey(withConditions(xpv1.Creating())),
+				input: &awsapigateway.CreateApiKeyInput{
+					Name:    aws.String("partner"),
+					Enabled: aws.Bool(true),
+				},
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsapigateway.CreateApiKeyInput
+			e := &external{client: &fake.MockClient{
+				MockCreateApiKeyWithContext: func(_ context.Context, in *awsapigateway.CreateApiKeyInput, _ []request.Option) (*awsapigateway.ApiKey, error) {
+					input = in
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return &awsapigateway.ApiKey{Id: aws.String(keyID), Value: aws.String(value)}, nil
+				},
+			}}
+			o, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		ops     []*awsapigateway.PatchOperation
+		tagged  map[string]*string
+		removed []*string
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.APIKey
+		updateErr error
+		want
+	}{
+		"PatchAndTag": {
+			cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+				Region:      "us-east-1",
+				Name:        "partner",
+				Description: aws.String("partner access"),
+				Enabled:     aws.Bool(false),
+				Tags:        map[string]string{"owner": "payments"},
+			})),
+			want: want{
+				ops: []*awsapigateway.PatchOperation{{
+					Op:    aws.String(awsapigateway.OpReplace),
+					Path:  aws.String("/enabled"),
+					Value: aws.String("false"),
+				}},
+				tagged:  aws.StringMap(map[string]string{"owner": "payments"}),
+				removed: aws.StringSlice([]string{"team"}),
+			},
+		},
+		"NothingToPatch": {
+			cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+				Region:      "us-east-1",
+				Name:        "partner",
+				Description: aws.String("partner access"),
+				Enabled:     aws.Bool(true),
+				Tags:        map[string]string{"team": "api"},
+			})),
+		},
+		"UpdateFailed": {
+			cr: apiKey(withExternalName(keyID), withSpec(v1alpha1.APIKeyParameters{
+				Region: "us-east-1",
+				Name:   "renamed",
+			})),
+			updateErr: errBoom,
+			want: want{
+				ops: []*awsapigateway.PatchOperation{{
+					Op:    aws.String(awsapigateway.OpReplace),
+					Path:  aws.String("/name"),
+					Value: aws.String("renamed"),
+				}},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ops []*awsapigateway.PatchOperation
+			var tagged map[string]*string
+			var removed []*string
+			e := &external{client: &fake.MockClient{
+				MockGetApiKeyWithContext: getAPIKey,
+				MockUpdateApiKeyWithContext: func(_ context.Context, input *awsapigateway.UpdateApiKeyInput, _ []request.Option) (*awsapigateway.ApiKey, error) {
+					ops = input.PatchOperations
+					return &awsapigateway.ApiKey{}, tc.updateErr
+				},
+				MockTagResourceWithContext: func(_ context.Context, input *awsapigateway.TagResourceInput, _ []request.Option) (*awsapigateway.TagResourceOutput, error) {
+					tagged = input.Tags
+					return &awsapigateway.TagResourceOutput{}, nil
+				},
+				MockUntagResourceWithContext: func(_ context.Context, input *awsapigateway.UntagResourceInput, _ []request.Option) (*awsapigateway.UntagResourceOutput, error) {
+					removed = input.TagKeys
+					return &awsapigateway.UntagResourceOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ops, ops); diff != "" {
+				t.Errorf("ops: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("tagged: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.APIKey
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.APIKey
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteApiKeyWithContext: func(_ context.Context, input *awsapigateway.DeleteApiKeyInput, _ []request.Option) (*awsapigateway.DeleteApiKeyOutput, error) {
+					if aws.StringValue(input.ApiKey) != keyID {
+						return nil, errors.New("unexpected API key")
+					}
+					return &awsapigateway.DeleteApiKeyOutput{}, nil
+				},
+			},
+			cr: apiKey(withExternalName(keyID)),
+			want: want{
+				cr: apiKey(withExternalName(keyID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteApiKeyWithContext: func(context.Context, *awsapigateway.DeleteApiKeyInput, []request.Option) (*awsapigateway.DeleteApiKeyOutput, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: apiKey(withExternalName(keyID)),
+			want: want{
+				cr: apiKey(withExternalName(keyID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteApiKeyWithContext: func(context.Context, *awsapigateway.DeleteApiKeyInput, []request.Option) (*awsapigateway.DeleteApiKeyOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: apiKey(withExternalName(keyID)),
+			want: want{
+				cr:  apiKey(withExternalName(keyID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
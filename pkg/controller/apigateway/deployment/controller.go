@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an API Gateway Deployment resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the REST API deployment"
+	errCreate           = "failed to create the REST API deployment"
+	errUpdate           = "failed to update the REST API deployment"
+	errDelete           = "failed to delete the REST API deployment"
+	errKubeUpdateFailed = "cannot update Deployment custom resource"
+)
+
+// SetupDeployment adds a controller that reconciles deployments of API
+// Gateway REST APIs.
+func SetupDeployment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.DeploymentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Deployment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DeploymentGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Deployment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) get(ctx context.Context, cr *v1alpha1.Deployment) (*awsapigateway.Deployment, error) {
+	return e.client.GetDeploymentWithContext(ctx, &awsapigateway.GetDeploymentInput{
+		RestApiId:    cr.Spec.ForProvider.RestAPIID,
+		DeploymentId: aws.String(meta.GetExternalName(cr)),
+	})
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Deployment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the ID of the current deployment, which is only
+	// known once the REST API is deployed.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	d, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeDeployment(&cr.Spec.ForProvider, d)
+
+	cr.Status.AtProvider = apigateway.GenerateDeploymentObservation(d)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: len(apigateway.GenerateDeploymentPatchOperations(cr.Spec.ForProvider, d)) == 0 &&
+			cr.GetAnnotations()[apigateway.AnnotationKeyDefinitionChecksum] == aws.StringValue(cr.Spec.ForProvider.DefinitionChecksum),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// deploy creates a new deployment of the REST API and records its ID, and the
+// checksum it was created for. The status is kept, since the update returns
+// the status that is stored.
+func (e *external) deploy(ctx context.Context, cr *v1alpha1.Deployment) error {
+	d, err := e.client.CreateDeploymentWithContext(ctx, &awsapigateway.CreateDeploymentInput{
+		RestApiId:   cr.Spec.ForProvider.RestAPIID,
+		Description: cr.Spec.ForProvider.Description,
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(d.Id))
+	meta.AddAnnotations(cr, map[string]string{apigateway.AnnotationKeyDefinitionChecksum: aws.StringValue(cr.Spec.ForProvider.DefinitionChecksum)})
+	status := cr.Status.DeepCopy()
+	err = e.kube.Update(ctx, cr)
+	cr.Status = *status
+	return errors.Wrap(err, errKubeUpdateFailed)
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Deployment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, e.deploy(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Deployment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// The REST API is deployed again when its checksum changes. Former
+	// deployments are kept, so that stages which still serve them continue
+	// to work until they are updated.
+	if cr.GetAnnotations()[apigateway.AnnotationKeyDefinitionChecksum] != aws.StringValue(cr.Spec.ForProvider.DefinitionChecksum) {
+		return managed.ExternalUpdate{}, e.deploy(ctx, cr)
+	}
+
+	d, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+
+	_, err = e.client.UpdateDeploymentWithContext(ctx, &awsapigateway.UpdateDeploymentInput{
+		RestApiId:       cr.Spec.ForProvider.RestAPIID,
+		DeploymentId:    aws.String(meta.GetExternalName(cr)),
+		PatchOperations: apigateway.GenerateDeploymentPatchOperations(cr.Spec.ForProvider, d),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Deployment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteDeploymentWithContext(ctx, &awsapigateway.DeleteDeploymentInput{
+		RestApiId:    cr.Spec.ForProvider.RestAPIID,
+		DeploymentId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	restAPIID    = "a1b2c3d4e5"
+	deploymentID = "dep001"
+	redeployedID = "dep002"
+
+	errBoom = errors.New("boom")
+)
+
+type deploymentModifier func(*v1alpha1.Deployment)
+
+func withExternalName(name string) deploymentModifier {
+	return func(r *v1alpha1.Deployment) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) deploymentModifier {
+	return func(r *v1alpha1.Deployment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefinitionChecksum(cs string) deploymentModifier {
+	return func(r *v1alpha1.Deployment) { r.Spec.ForProvider.DefinitionChecksum = aws.String(cs) }
+}
+
+func withDeployedChecksum(cs string) deploymentModifier {
+	return func(r *v1alpha1.Deployment) {
+		meta.AddAnnotations(r, map[string]string{apigateway.AnnotationKeyDefinitionChecksum: cs})
+	}
+}
+
+func withStatus(s v1alpha1.DeploymentObservation) deploymentModifier {
+	return func(r *v1alpha1.Deployment) { r.Status.AtProvider = s }
+}
+
+func deployment(m ...deploymentModifier) *v1alpha1.Deployment {
+	cr := &v1alpha1.Deployment{
+		Spec: v1alpha1.DeploymentSpec{
+			ForProvider: v1alpha1.DeploymentParameters{
+				Region:      "us-east-1",
+				RestAPIID:   aws.String(restAPIID),
+				Description: aws.String("pets"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getDeployment(_ context.Context, input *awsapigateway.GetDeploymentInput, _ []request.Option) (*awsapigateway.Deployment, error) {
+	if aws.StringValue(input.DeploymentId) != deploymentID {
+		return nil, errors.New("unexpected deployment")
+	}
+	return &awsapigateway.Deployment{Id: aws.String(deploymentID), Description: aws.String("pets")}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Deployment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Deployment
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     deployment(),
+			want: want{
+				cr: deployment(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockGetDeploymentWithContext: getDeployment},
+			cr:     deployment(withExternalName(deploymentID), withDefinitionChecksum("v1"), withDeployedChecksum("v1")),
+			want: want{
+				cr: deployment(withExternalName(deploymentID), withDefinitionChecksum("v1"), withDeployedChecksum("v1"),
+					withStatus(v1alpha1.DeploymentObservation{ID: deploymentID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DefinitionChanged": {
+			client: &fake.MockClient{MockGetDeploymentWithContext: getDeployment},
+			cr:     deployment(withExternalName(deploymentID), withDefinitionChecksum("v2"), withDeployedChecksum("v1")),
+			want: want{
+				cr: deployment(withExternalName(deploymentID), withDefinitionChecksum("v2"), withDeployedChecksum("v1"),
+					withStatus(v1alpha1.DeploymentObservation{ID: deploymentID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetDeploymentWithContext: func(context.Context, *awsapigateway.GetDeploymentInput, []request.Option) (*awsapigateway.Deployment, error) {
+					return nil, errBoom
+				},
+			},
+			cr: deployment(withExternalName(deploymentID)),
+			want: want{
+				cr:  deployment(withExternalName(deploymentID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr         *v1alpha1.Deployment
+		redeployed bool
+		err        error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Deployment
+		createErr error
+		want
+	}{
+		"DefinitionChanged": {
+			cr: deployment(withExternalName(deploymentID), withDefinitionChecksum("v2"), withDeployedChecksum("v1")),
+			want: want{
+				cr:         deployment(withExternalName(redeployedID), withDefinitionChecksum("v2"), withDeployedChecksum("v2")),
+				redeployed: true,
+			},
+		},
+		"DescriptionChanged": {
+			cr: deployment(withExternalName(deploymentID), withDefinitionChecksum("v1"), withDeployedChecksum("v1"), func(r *v1alpha1.Deployment) {
+				r.Spec.ForProvider.Description = aws.String("cats")
+			}),
+			want: want{
+				cr: deployment(withExternalName(deploymentID), withDefinitionChecksum("v1"), withDeployedChecksum("v1"), func(r *v1alpha1.Deployment) {
+					r.Spec.ForProvider.Description = aws.String("cats")
+				}),
+			},
+		},
+		"CreateFailed": {
+			cr:        deployment(withExternalName(deploymentID), withDefinitionChecksum("v2"), withDeployedChecksum("v1")),
+			createErr: errBoom,
+			want: want{
+				cr:         deployment(withExternalName(deploymentID), withDefinitionChecksum("v2"), withDeployedChecksum("v1")),
+				redeployed: true,
+				err:        awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			redeployed := false
+			e := &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockClient{
+					MockGetDeploymentWithContext: getDeployment,
+					MockCreateDeploymentWithContext: func(context.Context, *awsapigateway.CreateDeploymentInput, []request.Option) (*awsapigateway.Deployment, error) {
+						redeployed = true
+						if tc.createErr != nil {
+							return nil, tc.createErr
+						}
+						return &awsapigateway.Deployment{Id: aws.String(redeployedID)}, nil
+					},
+					MockUpdateDeploymentWithContext: func(_ context.Context, input *awsapigateway.UpdateDeploymentInput, _ []request.Option) (*awsapigateway.Deployment, error) {
+						if len(input.PatchOperations) != 1 || aws.StringValue(input.PatchOperations[0].Value) != "cats" {
+							return nil, errors.New("unexpected patch operations")
+						}
+						return &awsapigateway.Deployment{}, nil
+					},
+				},
+			}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.redeployed, redeployed); diff != "" {
+				t.Errorf("redeployed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
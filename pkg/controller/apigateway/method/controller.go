@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package method
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not an API Gateway Method resource"
+	errCreateSession     = "cannot create a new session"
+	errGet               = "failed to get the REST API method"
+	errCreate            = "failed to create the REST API method"
+	errUpdate            = "failed to update the REST API method"
+	errDelete            = "failed to delete the REST API method"
+	errPutIntegration    = "failed to put the integration of the REST API method"
+	errDeleteIntegration = "failed to delete the integration of the REST API method"
+)
+
+// SetupMethod adds a controller that reconciles methods of API Gateway REST
+// APIs.
+func SetupMethod(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.MethodGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Method{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.MethodGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Method)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) get(ctx context.Context, cr *v1alpha1.Method) (*awsapigateway.Method, error) {
+	return e.client.GetMethodWithContext(ctx, &awsapigateway.GetMethodInput{
+		RestApiId:  cr.Spec.ForProvider.RestAPIID,
+		ResourceId: cr.Spec.ForProvider.ResourceID,
+		HttpMethod: aws.String(meta.GetExternalName(cr)),
+	})
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Method)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the HTTP method, which is set once the method is
+	// created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	m, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeMethod(&cr.Spec.ForProvider, m)
+
+	cr.Status.AtProvider.Integrated = m.MethodIntegration != nil
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: len(apigateway.GenerateMethodPatchOperations(cr.Spec.ForProvider, m)) == 0 &&
+			apigateway.IsIntegrationUpToDate(cr.Spec.ForProvider.Integration, m.MethodIntegration),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Method)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	if _, err := e.client.PutMethodWithContext(ctx, apigateway.GeneratePutMethodInput(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.HTTPMethod)
+
+	if cr.Spec.ForProvider.Integration == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err := e.client.PutIntegrationWithContext(ctx, apigateway.GeneratePutIntegrationInput(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errPutIntegration)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Method)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	m, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+
+	if ops := apigateway.GenerateMethodPatchOperations(cr.Spec.ForProvider, m); len(ops) > 0 {
+		if _, err := e.client.UpdateMethodWithContext(ctx, &awsapigateway.UpdateMethodInput{
+			RestApiId:       cr.Spec.ForProvider.RestAPIID,
+			ResourceId:      cr.Spec.ForProvider.ResourceID,
+			HttpMethod:      aws.String(meta.GetExternalName(cr)),
+			PatchOperations: ops,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	// Integrations are replaced as a whole, since many of their fields
+	// cannot be patched.
+	switch {
+	case apigateway.IsIntegrationUpToDate(cr.Spec.ForProvider.Integration, m.MethodIntegration):
+	case cr.Spec.ForProvider.Integration != nil:
+		_, err = e.client.PutIntegrationWithContext(ctx, apigateway.GeneratePutIntegrationInput(cr.Spec.ForProvider))
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutIntegration)
+	default:
+		_, err = e.client.DeleteIntegrationWithContext(ctx, &awsapigateway.DeleteIntegrationInput{
+			RestApiId:  cr.Spec.ForProvider.RestAPIID,
+			ResourceId: cr.Spec.ForProvider.ResourceID,
+			HttpMethod: aws.String(meta.GetExternalName(cr)),
+		})
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDeleteIntegration)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Method)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteMethodWithContext(ctx, &awsapigateway.DeleteMethodInput{
+		RestApiId:  cr.Spec.ForProvider.RestAPIID,
+		ResourceId: cr.Spec.ForProvider.ResourceID,
+		HttpMethod: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,497 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package method
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	restAPIID  = "a1b2c3d4e5"
+	resourceID = "r1s2t3"
+	lambdaURI  = "arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/arn:aws:lambda:us-east-1:123456789012:function:pets/invocations"
+	changedURI = "arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/arn:aws:lambda:us-east-1:123456789012:function:owners/invocations"
+
+	errBoom = errors.New("boom")
+)
+
+type methodModifier func(*v1alpha1.Method)
+
+func withExternalName(name string) methodModifier {
+	return func(r *v1alpha1.Method) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) methodModifier {
+	return func(r *v1alpha1.Method) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAPIKeyRequired(b bool) methodModifier {
+	return func(r *v1alpha1.Method) { r.Spec.ForProvider.APIKeyRequired = aws.Bool(b) }
+}
+
+func withIntegration(uri string) methodModifier {
+	return func(r *v1alpha1.Method) {
+		r.Spec.ForProvider.Integration = &v1alpha1.MethodIntegration{
+			Type:                  awsapigateway.IntegrationTypeAwsProxy,
+			IntegrationHTTPMethod: aws.String("POST"),
+			URI:                   aws.String(uri),
+		}
+	}
+}
+
+func withStatus(s v1alpha1.MethodObservation) methodModifier {
+	return func(r *v1alpha1.Method) { r.Status.AtProvider = s }
+}
+
+func method(m ...methodModifier) *v1alpha1.Method {
+	cr := &v1alpha1.Method{
+		Spec: v1alpha1.MethodSpec{
+			ForProvider: v1alpha1.MethodParameters{
+				Region:            "us-east-1",
+				RestAPIID:         aws.String(restAPIID),
+				ResourceID:        aws.String(resourceID),
+				HTTPMethod:        "GET",
+				AuthorizationType: "NONE",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedIntegration(uri string) *awsapigateway.Integration {
+	return &awsapigateway.Integration{
+		Type:       aws.String(awsapigateway.IntegrationTypeAwsProxy),
+		HttpMethod: aws.String("POST"),
+		Uri:        aws.String(uri),
+	}
+}
+
+func getMethod(in *awsapigateway.Integration) func(context.Context, *awsapigateway.GetMethodInput, []request.Option) (*awsapigateway.Method, error) {
+	return func(_ context.Context, input *awsapigateway.GetMethodInput, _ []request.Option) (*awsapigateway.Method, error) {
+		if aws.StringValue(input.RestApiId) != restAPIID || aws.StringValue(input.ResourceId) != resourceID || aws.StringValue(input.HttpMethod) != "GET" {
+			return nil, errors.New("unexpected method")
+		}
+		return &awsapigateway.Method{
+			HttpMethod:        aws.String("GET"),
+			AuthorizationType: aws.String("NONE"),
+			ApiKeyRequired:    aws.Bool(false),
+			MethodIntegration: in,
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Method
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Method
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     method(),
+			want: want{
+				cr: method(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockGetMethodWithContext: getMethod(observedIntegration(lambdaURI))},
+			cr:     method(withExternalName("GET"), withAPIKeyRequired(false), withIntegration(lambdaURI)),
+			want: want{
+				cr: method(withExternalName("GET"), withAPIKeyRequired(false), withIntegration(lambdaURI),
+					withStatus(v1alpha1.MethodObservation{Integrated: true}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockGetMethodWithContext: getMethod(nil)},
+			cr:     method(withExternalName("GET")),
+			want: want{
+				cr: method(withExternalName("GET"), withAPIKeyRequired(false), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"MethodChanged": {
+			client: &fake.MockClient{MockGetMethodWithContext: getMethod(nil)},
+			cr:     method(withExternalName("GET"), withAPIKeyRequired(true)),
+			want: want{
+				cr: method(withExternalName("GET"), withAPIKeyRequired(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"IntegrationChanged": {
+			client: &fake.MockClient{MockGetMethodWithContext: getMethod(observedIntegration(lambdaURI))},
+			cr:     method(withExternalName("GET"), withAPIKeyRequired(false), withIntegration(changedURI)),
+			want: want{
+				cr: method(withExternalName("GET"), withAPIKeyRequired(false), withIntegration(changedURI),
+					withStatus(v1alpha1.MethodObservation{Integrated: true}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"IntegrationRemoved": {
+			client: &fake.MockClient{MockGetMethodWithContext: getMethod(observedIntegration(lambdaURI))},
+			cr:     method(withExternalName("GET"), withAPIKeyRequired(false)),
+			want: want{
+				cr: method(withExternalName("GET"), withAPIKeyRequired(false),
+					withStatus(v1alpha1.MethodObservation{Integrated: true}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetMethodWithContext: func(context.Context, *awsapigateway.GetMethodInput, []request.Option) (*awsapigateway.Method, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: method(withExternalName("GET")),
+			want: want{
+				cr: method(withExternalName("GET")),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetMethodWithContext: func(context.Context, *awsapigateway.GetMethodInput, []request.Option) (*awsapigateway.Method, error) {
+					return nil, errBoom
+				},
+			},
+			cr: method(withExternalName("GET")),
+			want: want{
+				cr:  method(withExternalName("GET")),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr         *v1alpha1.Method
+		integrated bool
+		err        error
+	}
+
+	cases := map[string]struct {
+		cr             *v1alpha1.Method
+		putErr         error
+		integrationErr error
+		want
+	}{
+		"WithoutIntegration": {
+			cr: method(),
+			want: want{
+				cr: method(withExternalName("GET"), withConditions(xpv1.Creating())),
+			},
+		},
+		"WithIntegration": {
+			cr: method(withIntegration(lambdaURI)),
+			want: want{
+				cr:         method(withIntegration(lambdaURI), withExternalName("GET"), withConditions(xpv1.Creating())),
+				integrated: true,
+			},
+		},
+		"PutFailed": {
+			cr:     method(withIntegration(lambdaURI)),
+			putErr: errBoom,
+			want: want{
+				cr:  method(withIntegration(lambdaURI), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+		"PutIntegrationFailed": {
+			cr:             method(withIntegration(lambdaURI)),
+			integrationErr: errBoom,
+			want: want{
+				cr:         method(withIntegration(lambdaURI), withExternalName("GET"), withConditions(xpv1.Creating())),
+				integrated: true,
+				err:        awsclient.Wrap(errBoom, errPutIntegration),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			integrated := false
+			e := &external{client: &fake.MockClient{
+				MockPutMethodWithContext: func(context.Context, *awsapigateway.PutMethodInput, []request.Option) (*awsapigateway.Method, error) {
+					return &awsapigateway.Method{}, tc.putErr
+				},
+				MockPutIntegrationWithContext: func(_ context.Context, input *awsapigateway.PutIntegrationInput, _ []request.Option) (*awsapigateway.Integration, error) {
+					integrated = aws.StringValue(input.Uri) == lambdaURI
+					return &awsapigateway.Integration{}, tc.integrationErr
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.integrated, integrated); diff != "" {
+				t.Errorf("integrated: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		ops                []*awsapigateway.PatchOperation
+		putIntegration     *string
+		deletedIntegration bool
+		err                error
+	}
+
+	cases := map[string]struct {
+		cr          *v1alpha1.Method
+		integration *awsapigateway.Integration
+		updateErr   error
+		deleteErr   error
+		want
+	}{
+		"MethodChanged": {
+			cr:          method(withExternalName("GET"), withAPIKeyRequired(true), withIntegration(lambdaURI)),
+			integration: observedIntegration(lambdaURI),
+			want: want{
+				ops: []*awsapigateway.PatchOperation{{
+					Op:    aws.String(awsapigateway.OpReplace),
+					Path:  aws.String("/apiKeyRequired"),
+					Value: aws.String("true"),
+				}},
+			},
+		},
+		"UpdateFailed": {
+			cr:          method(withExternalName("GET"), withAPIKeyRequired(true)),
+			integration: nil,
+			updateErr:   errBoom,
+			want: want{
+				ops: []*awsapigateway.PatchOperation{{
+					Op:    aws.String(awsapigateway.OpReplace),
+					Path:  aws.String("/apiKeyRequired"),
+					Value: aws.String("true"),
+				}},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"IntegrationAdded": {
+			cr:          method(withExternalName("GET"), withAPIKeyRequired(false), withIntegration(lambdaURI)),
+			integration: nil,
+			want: want{
+				putIntegration: aws.String(lambdaURI),
+			},
+		},
+		"IntegrationReplaced": {
+			cr:          method(withExternalName("GET"), withAPIKeyRequired(false), withIntegration(changedURI)),
+			integration: observedIntegration(lambdaURI),
+			want: want{
+				putIntegration: aws.String(changedURI),
+			},
+		},
+		"IntegrationDeleted": {
+			cr:          method(withExternalName("GET"), withAPIKeyRequired(false)),
+			integration: observedIntegration(lambdaURI),
+			want: want{
+				deletedIntegration: true,
+			},
+		},
+		"IntegrationAlreadyDeleted": {
+			cr:          method(withExternalName("GET"), withAPIKeyRequired(false)),
+			integration: observedIntegration(lambdaURI),
+			deleteErr:   awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil),
+			want: want{
+				deletedIntegration: true,
+			},
+		},
+		"DeleteIntegrationFailed": {
+			cr:          method(withExternalName("GET"), withAPIKeyRequired(false)),
+			integration: observedIntegration(lambdaURI),
+			deleteErr:   errBoom,
+			want: want{
+				deletedIntegration: true,
+				err:                awsclient.Wrap(errBoom, errDeleteIntegration),
+			},
+		},
+		"IntegrationUpToDate": {
+			cr:          method(withExternalName("GET"), withAPIKeyRequired(false), withIntegration(lambdaURI)),
+			integration: observedIntegration(lambdaURI),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ops []*awsapigateway.PatchOperation
+			var putIntegration *string
+			deletedIntegration := false
+			e := &external{client: &fake.MockClient{
+				MockGetMethodWithContext: getMethod(tc.integration),
+				MockUpdateMethodWithContext: func(_ context.Context, input *awsapigateway.UpdateMethodInput, _ []request.Option) (*awsapigateway.Method, error) {
+					ops = input.PatchOperations
+					return &awsapigateway.Method{}, tc.updateErr
+				},
+				MockPutIntegrationWithContext: func(_ context.Context, input *awsapigateway.PutIntegrationInput, _ []request.Option) (*awsapigateway.Integration, error) {
+					putIntegration = input.Uri
+					return &awsapigateway.Integration{}, nil
+				},
+				MockDeleteIntegrationWithContext: func(_ context.Context, input *awsapigateway.DeleteIntegrationInput, _ []request.Option) (*awsapigateway.DeleteIntegrationOutput, error) {
+					deletedIntegration = aws.StringValue(input.HttpMethod) == "GET"
+					return &awsapigateway.DeleteIntegrationOutput{}, tc.deleteErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ops, ops); diff != "" {
+				t.Errorf("ops: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.putIntegration, putIntegration); diff != "" {
+				t.Errorf("putIntegration: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deletedIntegration, deletedIntegration); diff != "" {
+				t.Errorf("deletedIntegration: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Method
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Method
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteMethodWithContext: func(_ context.Context, input *awsapigateway.DeleteMethodInput, _ []request.Option) (*awsapigateway.DeleteMethodOutput, error) {
+					if aws.StringValue(input.HttpMethod) != "GET" || aws.StringValue(input.ResourceId) != resourceID {
+						return nil, errors.New("unexpected method")
+					}
+					return &awsapigateway.DeleteMethodOutput{}, nil
+				},
+			},
+			cr: method(withExternalName("GET")),
+			want: want{
+				cr: method(withExternalName("GET"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteMethodWithContext: func(context.Context, *awsapigateway.DeleteMethodInput, []request.Option) (*awsapigateway.DeleteMethodOutput, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: method(withExternalName("GET")),
+			want: want{
+				cr: method(withExternalName("GET"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteMethodWithContext: func(context.Context, *awsapigateway.DeleteMethodInput, []request.Option) (*awsapigateway.DeleteMethodOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: method(withExternalName("GET")),
+			want: want{
+				cr:  method(withExternalName("GET"), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
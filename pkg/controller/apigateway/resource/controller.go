@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an API Gateway Resource resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the REST API resource"
+	errGetRoot          = "failed to get the root resource of the REST API"
+	errCreate           = "failed to create the REST API resource"
+	errUpdate           = "failed to update the REST API resource"
+	errDelete           = "failed to delete the REST API resource"
+)
+
+// SetupResource adds a controller that reconciles resources of API Gateway
+// REST APIs.
+func SetupResource(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ResourceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Resource{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ResourceGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Resource)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) get(ctx context.Context, cr *v1alpha1.Resource) (*awsapigateway.Resource, error) {
+	return e.client.GetResourceWithContext(ctx, &awsapigateway.GetResourceInput{
+		RestApiId:  cr.Spec.ForProvider.RestAPIID,
+		ResourceId: aws.String(meta.GetExternalName(cr)),
+	})
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Resource)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the ID of the resource, which is only known once
+	// the resource is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	r, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeResource(&cr.Spec.ForProvider, r)
+
+	cr.Status.AtProvider.Path = aws.StringValue(r.Path)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        len(apigateway.GenerateResourcePatchOperations(cr.Spec.ForProvider, r)) == 0,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Resource)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// Resources without a parent are created below the root resource, which
+	// API Gateway creates along with the REST API.
+	parentID := aws.StringValue(cr.Spec.ForProvider.ParentID)
+	if parentID == "" {
+		id, err := apigateway.RootResourceID(ctx, e.client, aws.StringValue(cr.Spec.ForProvider.RestAPIID))
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errGetRoot)
+		}
+		parentID = id
+	}
+
+	r, err := e.client.CreateResourceWithContext(ctx, &awsapigateway.CreateResourceInput{
+		RestApiId: cr.Spec.ForProvider.RestAPIID,
+		ParentId:  aws.String(parentID),
+		PathPart:  aws.String(cr.Spec.ForProvider.PathPart),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(r.Id))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Resource)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	r, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+
+	_, err = e.client.UpdateResourceWithContext(ctx, &awsapigateway.UpdateResourceInput{
+		RestApiId:       cr.Spec.ForProvider.RestAPIID,
+		ResourceId:      aws.String(meta.GetExternalName(cr)),
+		PatchOperations: apigateway.GenerateResourcePatchOperations(cr.Spec.ForProvider, r),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Resource)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteResourceWithContext(ctx, &awsapigateway.DeleteResourceInput{
+		RestApiId:  cr.Spec.ForProvider.RestAPIID,
+		ResourceId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
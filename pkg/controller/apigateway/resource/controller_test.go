@@ -0,0 +1,412 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	restAPIID  = "a1b2c3d4e5"
+	rootID     = "r00t"
+	parentID   = "p4r3nt"
+	resourceID = "r1s2t3"
+
+	errBoom = errors.New("boom")
+)
+
+type resourceModifier func(*v1alpha1.Resource)
+
+func withExternalName(name string) resourceModifier {
+	return func(r *v1alpha1.Resource) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) resourceModifier {
+	return func(r *v1alpha1.Resource) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParentID(id string) resourceModifier {
+	return func(r *v1alpha1.Resource) { r.Spec.ForProvider.ParentID = aws.String(id) }
+}
+
+func withPathPart(p string) resourceModifier {
+	return func(r *v1alpha1.Resource) { r.Spec.ForProvider.PathPart = p }
+}
+
+func withStatus(s v1alpha1.ResourceObservation) resourceModifier {
+	return func(r *v1alpha1.Resource) { r.Status.AtProvider = s }
+}
+
+func apiResource(m ...resourceModifier) *v1alpha1.Resource {
+	cr := &v1alpha1.Resource{
+		Spec: v1alpha1.ResourceSpec{
+			ForProvider: v1alpha1.ResourceParameters{
+				Region:    "us-east-1",
+				RestAPIID: aws.String(restAPIID),
+				PathPart:  "pets",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getResource(_ context.Context, input *awsapigateway.GetResourceInput, _ []request.Option) (*awsapigateway.Resource, error) {
+	if aws.StringValue(input.RestApiId) != restAPIID || aws.StringValue(input.ResourceId) != resourceID {
+		return nil, errors.New("unexpected resource")
+	}
+	return &awsapigateway.Resource{
+		Id:       aws.String(resourceID),
+		ParentId: aws.String(parentID),
+		PathPart: aws.String("pets"),
+		Path:     aws.String("/v1/pets"),
+	}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Resource
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Resource
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     apiResource(),
+			want: want{
+				cr: apiResource(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockGetResourceWithContext: getResource},
+			cr:     apiResource(withExternalName(resourceID), withParentID(parentID)),
+			want: want{
+				cr: apiResource(withExternalName(resourceID), withParentID(parentID),
+					withStatus(v1alpha1.ResourceObservation{Path: "/v1/pets"}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockGetResourceWithContext: getResource},
+			cr:     apiResource(withExternalName(resourceID)),
+			want: want{
+				cr: apiResource(withExternalName(resourceID), withParentID(parentID),
+					withStatus(v1alpha1.ResourceObservation{Path: "/v1/pets"}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PathPartChanged": {
+			client: &fake.MockClient{MockGetResourceWithContext: getResource},
+			cr:     apiResource(withExternalName(resourceID), withParentID(parentID), withPathPart("owners")),
+			want: want{
+				cr: apiResource(withExternalName(resourceID), withParentID(parentID), withPathPart("owners"),
+					withStatus(v1alpha1.ResourceObservation{Path: "/v1/pets"}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetResourceWithContext: func(context.Context, *awsapigateway.GetResourceInput, []request.Option) (*awsapigateway.Resource, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: apiResource(withExternalName(resourceID)),
+			want: want{
+				cr: apiResource(withExternalName(resourceID)),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetResourceWithContext: func(context.Context, *awsapigateway.GetResourceInput, []request.Option) (*awsapigateway.Resource, error) {
+					return nil, errBoom
+				},
+			},
+			cr: apiResource(withExternalName(resourceID)),
+			want: want{
+				cr:  apiResource(withExternalName(resourceID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Resource
+		parent string
+		err    error
+	}
+
+	cases := map[string]struct {
+		cr         *v1alpha1.Resource
+		resources  *awsapigateway.GetResourcesOutput
+		getRootErr error
+		createErr  error
+		want
+	}{
+		"WithParent": {
+			cr: apiResource(withParentID(parentID)),
+			want: want{
+				cr:     apiResource(withParentID(parentID), withExternalName(resourceID), withConditions(xpv1.Creating())),
+				parent: parentID,
+			},
+		},
+		"BelowRoot": {
+			cr: apiResource(),
+			resources: &awsapigateway.GetResourcesOutput{Items: []*awsapigateway.Resource{
+				{Id: aws.String(parentID), Path: aws.String("/v1")},
+				{Id: aws.String(rootID), Path: aws.String("/")},
+			}},
+			want: want{
+				cr:     apiResource(withExternalName(resourceID), withConditions(xpv1.Creating())),
+				parent: rootID,
+			},
+		},
+		"NoRoot": {
+			cr: apiResource(),
+			resources: &awsapigateway.GetResourcesOutput{Items: []*awsapigateway.Resource{
+				{Id: aws.String(parentID), Path: aws.String("/v1")},
+			}},
+			want: want{
+				cr:  apiResource(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errors.New("the REST API has no root resource"), errGetRoot),
+			},
+		},
+		"GetRootFailed": {
+			cr:         apiResource(),
+			getRootErr: errBoom,
+			want: want{
+				cr:  apiResource(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errGetRoot),
+			},
+		},
+		"CreateFailed": {
+			cr:        apiResource(withParentID(parentID)),
+			createErr: errBoom,
+			want: want{
+				cr:     apiResource(withParentID(parentID), withConditions(xpv1.Creating())),
+				parent: parentID,
+				err:    awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var parent string
+			e := &external{client: &fake.MockClient{
+				MockGetResourcesWithContext: func(_ context.Context, input *awsapigateway.GetResourcesInput, _ []request.Option) (*awsapigateway.GetResourcesOutput, error) {
+					if aws.StringValue(input.RestApiId) != restAPIID {
+						return nil, errors.New("unexpected REST API")
+					}
+					return tc.resources, tc.getRootErr
+				},
+				MockCreateResourceWithContext: func(_ context.Context, input *awsapigateway.CreateResourceInput, _ []request.Option) (*awsapigateway.Resource, error) {
+					parent = aws.StringValue(input.ParentId)
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return &awsapigateway.Resource{Id: aws.String(resourceID)}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.parent, parent); diff != "" {
+				t.Errorf("parent: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		ops []*awsapigateway.PatchOperation
+		err error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Resource
+		updateErr error
+		want
+	}{
+		"Moved": {
+			cr: apiResource(withExternalName(resourceID), withParentID(rootID), withPathPart("owners")),
+			want: want{
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpReplace), Path: aws.String("/pathPart"), Value: aws.String("owners")},
+					{Op: aws.String(awsapigateway.OpReplace), Path: aws.String("/parentId"), Value: aws.String(rootID)},
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        apiResource(withExternalName(resourceID), withPathPart("owners")),
+			updateErr: errBoom,
+			want: want{
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpReplace), Path: aws.String("/pathPart"), Value: aws.String("owners")},
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ops []*awsapigateway.PatchOperation
+			e := &external{client: &fake.MockClient{
+				MockGetResourceWithContext: getResource,
+				MockUpdateResourceWithContext: func(_ context.Context, input *awsapigateway.UpdateResourceInput, _ []request.Option) (*awsapigateway.Resource, error) {
+					ops = input.PatchOperations
+					return &awsapigateway.Resource{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ops, ops); diff != "" {
+				t.Errorf("ops: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Resource
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Resource
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteResourceWithContext: func(_ context.Context, input *awsapigateway.DeleteResourceInput, _ []request.Option) (*awsapigateway.DeleteResourceOutput, error) {
+					if aws.StringValue(input.ResourceId) != resourceID {
+						return nil, errors.New("unexpected resource")
+					}
+					return &awsapigateway.DeleteResourceOutput{}, nil
+				},
+			},
+			cr: apiResource(withExternalName(resourceID)),
+			want: want{
+				cr: apiResource(withExternalName(resourceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteResourceWithContext: func(context.Context, *awsapigateway.DeleteResourceInput, []request.Option) (*awsapigateway.DeleteResourceOutput, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: apiResource(withExternalName(resourceID)),
+			want: want{
+				cr: apiResource(withExternalName(resourceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteResourceWithContext: func(context.Context, *awsapigateway.DeleteResourceInput, []request.Option) (*awsapigateway.DeleteResourceOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: apiResource(withExternalName(resourceID)),
+			want: want{
+				cr:  apiResource(withExternalName(resourceID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an API Gateway RestAPI resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the REST API"
+	errCreate           = "failed to create the REST API"
+	errImport           = "failed to import the OpenAPI document into the REST API"
+	errUpdate           = "failed to update the REST API"
+	errDelete           = "failed to delete the REST API"
+	errKubeUpdateFailed = "cannot update RestAPI custom resource"
+)
+
+// SetupRestAPI adds a controller that reconciles API Gateway REST APIs.
+func SetupRestAPI(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.RestAPIGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.RestAPI{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RestAPIGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RestAPI)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.RestAPI)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the ID of the REST API, which is only known once
+	// the REST API is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	api, err := e.client.GetRestApiWithContext(ctx, &awsapigateway.GetRestApiInput{
+		RestApiId: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+
+	// Create and Update are never called for a REST API that is being
+	// deleted, so its definition, which may be gone, is not read either.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	body, err := apigateway.GetDefinition(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeRestAPI(&cr.Spec.ForProvider, api)
+
+	cr.Status.AtProvider = apigateway.GenerateRestAPIObservation(api)
+	cr.Status.AtProvider.DefinitionChecksum = cr.GetAnnotations()[apigateway.AnnotationKeyDefinitionChecksum]
+	cr.SetConditions(xpv1.Available())
+
+	// A REST API whose document is removed keeps the resources and methods
+	// it defined.
+	imported := len(body) == 0 || cr.Status.AtProvider.DefinitionChecksum == apigateway.DefinitionChecksum(body)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: imported && apigateway.IsRestAPIUpToDate(cr.Spec.ForProvider, api) &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, aws.StringValueMap(api.Tags), cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// recordChecksum records the checksum of the OpenAPI document that was
+// imported into the REST API. The status is kept, since the update returns
+// the status that is stored.
+func (e *external) recordChecksum(ctx context.Context, cr *v1alpha1.RestAPI, checksum string) error {
+	meta.AddAnnotations(cr, map[string]string{apigateway.AnnotationKeyDefinitionChecksum: checksum})
+	status := cr.Status.DeepCopy()
+	err := e.kube.Update(ctx, cr)
+	cr.Status = *status
+	cr.Status.AtProvider.DefinitionChecksum = checksum
+	return errors.Wrap(err, errKubeUpdateFailed)
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.RestAPI)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	body, err := apigateway.GetDefinition(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// REST APIs that are defined by an OpenAPI document are created by
+	// importing it. The name and the other settings of the REST API are
+	// applied on top of the document once it is imported.
+	var api *awsapigateway.RestApi
+	if len(body) == 0 {
+		api, err = e.client.CreateRestApiWithContext(ctx, apigateway.GenerateCreateRestAPIInput(cr.Spec.ForProvider))
+	} else {
+		api, err = e.client.ImportRestApiWithContext(ctx, apigateway.GenerateImportRestAPIInput(cr.Spec.ForProvider, body))
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(api.Id))
+
+	if len(body) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, e.recordChecksum(ctx, cr, apigateway.DefinitionChecksum(body))
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.RestAPI)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+
+	body, err := apigateway.GetDefinition(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if checksum := apigateway.DefinitionChecksum(body); checksum != "" && checksum != cr.GetAnnotations()[apigateway.AnnotationKeyDefinitionChecksum] {
+		if _, err := e.client.PutRestApiWithContext(ctx, apigateway.GeneratePutRestAPIInput(id, cr.Spec.ForProvider, body)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errImport)
+		}
+		if err := e.recordChecksum(ctx, cr, checksum); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	api, err := e.client.GetRestApiWithContext(ctx, &awsapigateway.GetRestApiInput{RestApiId: aws.String(id)})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if ops := apigateway.GenerateRestAPIPatchOperations(cr.Spec.ForProvider, api); len(ops) > 0 {
+		if _, err := e.client.UpdateRestApiWithContext(ctx, &awsapigateway.UpdateRestApiInput{
+			RestApiId:       aws.String(id),
+			PatchOperations: ops,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	return managed.ExternalUpdate{}, apigateway.UpdateTags(ctx, e.client, apigateway.RestAPIARN(cr.Spec.ForProvider.Region, id), cr.Spec.ForProvider.Tags, aws.StringValueMap(api.Tags))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.RestAPI)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteRestApiWithContext(ctx, &awsapigateway.DeleteRestApiInput{
+		RestApiId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	restAPIID = "a1b2c3d4e5"
+	document  = "openapi: 3.0.1\ninfo:\n  title: pets\npaths: {}\n"
+	changed   = "openapi: 3.0.1\ninfo:\n  title: pets\npaths:\n  /pets: {}\n"
+
+	errBoom = errors.New("boom")
+)
+
+type restAPIModifier func(*v1alpha1.RestAPI)
+
+func withExternalName(name string) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withBody(body string) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) {
+		r.Spec.ForProvider.Definition = &v1alpha1.APIDefinition{Body: aws.String(body)}
+	}
+}
+
+func withBodyConfigMapRef() restAPIModifier {
+	return func(r *v1alpha1.RestAPI) {
+		r.Spec.ForProvider.Definition = &v1alpha1.APIDefinition{
+			BodyConfigMapRef: &awsv1beta1.ConfigMapKeySelector{Name: "pets", Namespace: "default", Key: "openapi.yaml"},
+		}
+	}
+}
+
+func withChecksum(body string) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) {
+		meta.AddAnnotations(r, map[string]string{apigateway.AnnotationKeyDefinitionChecksum: apigateway.DefinitionChecksum([]byte(body))})
+	}
+}
+
+func withDeletionTimestamp() restAPIModifier {
+	return func(r *v1alpha1.RestAPI) {
+		t := metav1.NewTime(time.Unix(1, 0))
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func withStatus(s v1alpha1.RestAPIObservation) restAPIModifier {
+	return func(r *v1alpha1.RestAPI) { r.Status.AtProvider = s }
+}
+
+func restAPI(m ...restAPIModifier) *v1alpha1.RestAPI {
+	cr := &v1alpha1.RestAPI{
+		Spec: v1alpha1.RestAPISpec{
+			ForProvider: v1alpha1.RestAPIParameters{
+				Region: "us-east-1",
+				Name:   "pets",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getRestAPI(_ context.Context, input *awsapigateway.GetRestApiInput, _ []request.Option) (*awsapigateway.RestApi, error) {
+	if aws.StringValue(input.RestApiId) != restAPIID {
+		return nil, errors.New("unexpected REST API")
+	}
+	return &awsapigateway.RestApi{Id: aws.String(restAPIID), Name: aws.String("pets")}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.RestAPI
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		kube   client.Client
+		client *fake.MockClient
+		cr     *v1alpha1.RestAPI
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     restAPI(),
+			want: want{
+				cr: restAPI(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockGetRestApiWithContext: getRestAPI},
+			cr:     restAPI(withExternalName(restAPIID), withBody(document), withChecksum(document)),
+			want: want{
+				cr: restAPI(withExternalName(restAPIID), withBody(document), withChecksum(document),
+					withStatus(v1alpha1.RestAPIObservation{ID: restAPIID, DefinitionChecksum: apigateway.DefinitionChecksum([]byte(document))}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DefinitionChanged": {
+			client: &fake.MockClient{MockGetRestApiWithContext: getRestAPI},
+			cr:     restAPI(withExternalName(restAPIID), withBody(changed), withChecksum(document)),
+			want: want{
+				cr: restAPI(withExternalName(restAPIID), withBody(changed), withChecksum(document),
+					withStatus(v1alpha1.RestAPIObservation{ID: restAPIID, DefinitionChecksum: apigateway.DefinitionChecksum([]byte(document))}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeletedWithoutDefinitionConfigMap": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			client: &fake.MockClient{MockGetRestApiWithContext: getRestAPI},
+			cr:     restAPI(withExternalName(restAPIID), withBodyConfigMapRef(), withDeletionTimestamp()),
+			want: want{
+				cr:     restAPI(withExternalName(restAPIID), withBodyConfigMapRef(), withDeletionTimestamp()),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetRestApiWithContext: func(context.Context, *awsapigateway.GetRestApiInput, []request.Option) (*awsapigateway.RestApi, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: restAPI(withExternalName(restAPIID)),
+			want: want{
+				cr: restAPI(withExternalName(restAPIID)),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetRestApiWithContext: func(context.Context, *awsapigateway.GetRestApiInput, []request.Option) (*awsapigateway.RestApi, error) {
+					return nil, errBoom
+				},
+			},
+			cr: restAPI(withExternalName(restAPIID)),
+			want: want{
+				cr:  restAPI(withExternalName(restAPIID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr       *v1alpha1.RestAPI
+		body     string
+		imported bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		cr   *v1alpha1.RestAPI
+		want
+	}{
+		"WithoutDefinition": {
+			cr: restAPI(),
+			want: want{
+				cr: restAPI(withExternalName(restAPIID), withConditions(xpv1.Creating())),
+			},
+		},
+		"WithBody": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:   restAPI(withBody(document)),
+			want: want{
+				cr: restAPI(withBody(document), withExternalName(restAPIID), withChecksum(document),
+					withStatus(v1alpha1.RestAPIObservation{DefinitionChecksum: apigateway.DefinitionChecksum([]byte(document))}),
+					withConditions(xpv1.Creating())),
+				body:     document,
+				imported: true,
+			},
+		},
+		"WithBodyConfigMapRef": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					cm := obj.(*corev1.ConfigMap)
+					cm.Data = map[string]string{"openapi.yaml": document}
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			cr: restAPI(withBodyConfigMapRef()),
+			want: want{
+				cr: restAPI(withBodyConfigMapRef(), withExternalName(restAPIID), withChecksum(document),
+					withStatus(v1alpha1.RestAPIObservation{DefinitionChecksum: apigateway.DefinitionChecksum([]byte(document))}),
+					withConditions(xpv1.Creating())),
+				body:     document,
+				imported: true,
+			},
+		},
+		"KubeUpdateFailed": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			cr:   restAPI(withBody(document)),
+			want: want{
+				cr: restAPI(withBody(document), withExternalName(restAPIID), withChecksum(document),
+					withStatus(v1alpha1.RestAPIObservation{DefinitionChecksum: apigateway.DefinitionChecksum([]byte(document))}),
+					withConditions(xpv1.Creating())),
+				body:     document,
+				imported: true,
+				err:      errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var body string
+			imported := false
+			e := &external{kube: tc.kube, client: &fake.MockClient{
+				MockCreateRestApiWithContext: func(context.Context, *awsapigateway.CreateRestApiInput, []request.Option) (*awsapigateway.RestApi, error) {
+					return &awsapigateway.RestApi{Id: aws.String(restAPIID)}, nil
+				},
+				MockImportRestApiWithContext: func(_ context.Context, input *awsapigateway.ImportRestApiInput, _ []request.Option) (*awsapigateway.RestApi, error) {
+					body, imported = string(input.Body), true
+					return &awsapigateway.RestApi{Id: aws.String(restAPIID)}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.imported, imported); diff != "" {
+				t.Errorf("imported: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.body, body); diff != "" {
+				t.Errorf("body: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr   *v1alpha1.RestAPI
+		body string
+		mode string
+		err  error
+	}
+
+	cases := map[string]struct {
+		cr     *v1alpha1.RestAPI
+		putErr error
+		want
+	}{
+		"DefinitionChanged": {
+			cr: restAPI(withExternalName(restAPIID), withBody(changed), withChecksum(document)),
+			want: want{
+				cr: restAPI(withExternalName(restAPIID), withBody(changed), withChecksum(changed),
+					withStatus(v1alpha1.RestAPIObservation{DefinitionChecksum: apigateway.DefinitionChecksum([]byte(changed))})),
+				body: changed,
+				mode: v1alpha1.DefinitionModeOverwrite,
+			},
+		},
+		"DefinitionUnchanged": {
+			cr: restAPI(withExternalName(restAPIID), withBody(document), withChecksum(document)),
+			want: want{
+				cr: restAPI(withExternalName(restAPIID), withBody(document), withChecksum(document)),
+			},
+		},
+		"PutFailed": {
+			cr:     restAPI(withExternalName(restAPIID), withBody(changed), withChecksum(document)),
+			putErr: errBoom,
+			want: want{
+				cr:   restAPI(withExternalName(restAPIID), withBody(changed), withChecksum(document)),
+				body: changed,
+				mode: v1alpha1.DefinitionModeOverwrite,
+				err:  awsclient.Wrap(errBoom, errImport),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var body, mode string
+			e := &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockClient{
+					MockPutRestApiWithContext: func(_ context.Context, input *awsapigateway.PutRestApiInput, _ []request.Option) (*awsapigateway.RestApi, error) {
+						body, mode = string(input.Body), aws.StringValue(input.Mode)
+						return &awsapigateway.RestApi{}, tc.putErr
+					},
+					MockGetRestApiWithContext: getRestAPI,
+				},
+			}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.body, body); diff != "" {
+				t.Errorf("body: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mode, mode); diff != "" {
+				t.Errorf("mode: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
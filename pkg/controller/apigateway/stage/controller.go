@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stage
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an API Gateway Stage resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the REST API stage"
+	errCreate           = "failed to create the REST API stage"
+	errUpdate           = "failed to update the REST API stage"
+	errDelete           = "failed to delete the REST API stage"
+)
+
+// SetupStage adds a controller that reconciles stages of API Gateway REST
+// APIs.
+func SetupStage(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.StageGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Stage{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.StageGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewTagger(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Stage)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) get(ctx context.Context, cr *v1alpha1.Stage) (*awsapigateway.Stage, error) {
+	return e.client.GetStageWithContext(ctx, &awsapigateway.GetStageInput{
+		RestApiId: cr.Spec.ForProvider.RestAPIID,
+		StageName: aws.String(meta.GetExternalName(cr)),
+	})
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Stage)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	s, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeStage(&cr.Spec.ForProvider, s)
+
+	cr.Status.AtProvider = apigateway.GenerateStageObservation(cr.Spec.ForProvider.Region, aws.StringValue(cr.Spec.ForProvider.RestAPIID), s)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: len(apigateway.GenerateStagePatchOperations(cr.Spec.ForProvider, s)) == 0 &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, aws.StringValueMap(s.Tags), cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.InvokeURL),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Stage)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateStageWithContext(ctx, apigateway.GenerateCreateStageInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Stage)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+
+	s, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if ops := apigateway.GenerateStagePatchOperations(cr.Spec.ForProvider, s); len(ops) > 0 {
+		if _, err := e.client.UpdateStageWithContext(ctx, &awsapigateway.UpdateStageInput{
+			RestApiId:       cr.Spec.ForProvider.RestAPIID,
+			StageName:       aws.String(name),
+			PatchOperations: ops,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	arn := apigateway.StageARN(cr.Spec.ForProvider.Region, aws.StringValue(cr.Spec.ForProvider.RestAPIID), name)
+	return managed.ExternalUpdate{}, apigateway.UpdateTags(ctx, e.client, arn, cr.Spec.ForProvider.Tags, aws.StringValueMap(s.Tags))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Stage)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteStageWithContext(ctx, &awsapigateway.DeleteStageInput{
+		RestApiId: cr.Spec.ForProvider.RestAPIID,
+		StageName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
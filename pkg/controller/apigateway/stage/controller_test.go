@@ -0,0 +1,445 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	restAPIID    = "a1b2c3d4e5"
+	stageName    = "prod"
+	deploymentID = "d1e2f3"
+	invokeURL    = "https://a1b2c3d4e5.execute-api.us-east-1.amazonaws.com/prod"
+
+	errBoom = errors.New("boom")
+)
+
+type stageModifier func(*v1alpha1.Stage)
+
+func withExternalName(name string) stageModifier {
+	return func(r *v1alpha1.Stage) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) stageModifier {
+	return func(r *v1alpha1.Stage) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObserved() stageModifier {
+	return func(r *v1alpha1.Stage) {
+		r.Spec.ForProvider.Description = aws.String("production")
+		r.Spec.ForProvider.CacheClusterEnabled = aws.Bool(false)
+		r.Spec.ForProvider.TracingEnabled = aws.Bool(false)
+	}
+}
+
+func withDeploymentID(id string) stageModifier {
+	return func(r *v1alpha1.Stage) { r.Spec.ForProvider.DeploymentID = aws.String(id) }
+}
+
+func withVariables(v map[string]string) stageModifier {
+	return func(r *v1alpha1.Stage) { r.Spec.ForProvider.Variables = v }
+}
+
+func withTags(t map[string]string) stageModifier {
+	return func(r *v1alpha1.Stage) { r.Spec.ForProvider.Tags = t }
+}
+
+func withStatus(s v1alpha1.StageObservation) stageModifier {
+	return func(r *v1alpha1.Stage) { r.Status.AtProvider = s }
+}
+
+func stage(m ...stageModifier) *v1alpha1.Stage {
+	cr := &v1alpha1.Stage{
+		Spec: v1alpha1.StageSpec{
+			ForProvider: v1alpha1.StageParameters{
+				Region:       "us-east-1",
+				RestAPIID:    aws.String(restAPIID),
+				DeploymentID: aws.String(deploymentID),
+				Variables:    map[string]string{"backend": "v1"},
+				Tags:         map[string]string{"team": "api"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getStage(_ context.Context, input *awsapigateway.GetStageInput, _ []request.Option) (*awsapigateway.Stage, error) {
+	if aws.StringValue(input.RestApiId) != restAPIID || aws.StringValue(input.StageName) != stageName {
+		return nil, errors.New("unexpected stage")
+	}
+	return &awsapigateway.Stage{
+		StageName:           aws.String(stageName),
+		DeploymentId:        aws.String(deploymentID),
+		Description:         aws.String("production"),
+		CacheClusterEnabled: aws.Bool(false),
+		TracingEnabled:      aws.Bool(false),
+		Variables:           aws.StringMap(map[string]string{"backend": "v1"}),
+		Tags:                aws.StringMap(map[string]string{"team": "api"}),
+	}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Stage
+		result managed.ExternalObservation
+		err    error
+	}
+
+	details := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte(invokeURL)}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Stage
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{MockGetStageWithContext: getStage},
+			cr:     stage(withExternalName(stageName), withObserved()),
+			want: want{
+				cr: stage(withExternalName(stageName), withObserved(),
+					withStatus(v1alpha1.StageObservation{InvokeURL: invokeURL}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockGetStageWithContext: getStage},
+			cr:     stage(withExternalName(stageName)),
+			want: want{
+				cr: stage(withExternalName(stageName), withObserved(),
+					withStatus(v1alpha1.StageObservation{InvokeURL: invokeURL}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       details,
+				},
+			},
+		},
+		"Redeployed": {
+			client: &fake.MockClient{MockGetStageWithContext: getStage},
+			cr:     stage(withExternalName(stageName), withObserved(), withDeploymentID("g4h5i6")),
+			want: want{
+				cr: stage(withExternalName(stageName), withObserved(), withDeploymentID("g4h5i6"),
+					withStatus(v1alpha1.StageObservation{InvokeURL: invokeURL}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"TagsChanged": {
+			client: &fake.MockClient{MockGetStageWithContext: getStage},
+			cr:     stage(withExternalName(stageName), withObserved(), withTags(nil)),
+			want: want{
+				cr: stage(withExternalName(stageName), withObserved(), withTags(nil),
+					withStatus(v1alpha1.StageObservation{InvokeURL: invokeURL}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetStageWithContext: func(context.Context, *awsapigateway.GetStageInput, []request.Option) (*awsapigateway.Stage, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: stage(withExternalName(stageName)),
+			want: want{
+				cr: stage(withExternalName(stageName)),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetStageWithContext: func(context.Context, *awsapigateway.GetStageInput, []request.Option) (*awsapigateway.Stage, error) {
+					return nil, errBoom
+				},
+			},
+			cr: stage(withExternalName(stageName)),
+			want: want{
+				cr:  stage(withExternalName(stageName)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.Stage
+		input *awsapigateway.CreateStageInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Stage
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: stage(withExternalName(stageName)),
+			want: want{
+				cr: stage(withExternalName(stageName), withConditions(xpv1.Creating())),
+				input: &awsapigateway.CreateStageInput{
+					RestApiId:    aws.String(restAPIID),
+					StageName:    aws.String(stageName),
+					DeploymentId: aws.String(deploymentID),
+					Variables:    aws.StringMap(map[string]string{"backend": "v1"}),
+					Tags:         aws.StringMap(map[string]string{"team": "api"}),
+				},
+			},
+		},
+		"CreateFailed": {
+			cr:        stage(withExternalName(stageName), withVariables(nil), withTags(nil)),
+			createErr: errBoom,
+			want: want{
+				cr: stage(withExternalName(stageName), withVariables(nil), withTags(nil), withConditions(xpv1.Creating())),
+				input: &awsapigateway.CreateStageInput{
+					RestApiId:    aws.String(restAPIID),
+					StageName:    aws.String(stageName),
+					DeploymentId: aws.String(deploymentID),
+				},
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsapigateway.CreateStageInput
+			e := &external{client: &fake.MockClient{
+				MockCreateStageWithContext: func(_ context.Context, in *awsapigateway.CreateStageInput, _ []request.Option) (*awsapigateway.Stage, error) {
+					input = in
+					return &awsapigateway.Stage{}, tc.createErr
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		ops     []*awsapigateway.PatchOperation
+		tagged  map[string]*string
+		removed []*string
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Stage
+		updateErr error
+		want
+	}{
+		"Redeployed": {
+			cr: stage(withExternalName(stageName), withObserved(), withDeploymentID("g4h5i6"),
+				withVariables(map[string]string{"backend": "v2", "region": "eu"})),
+			want: want{
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpReplace), Path: aws.String("/deploymentId"), Value: aws.String("g4h5i6")},
+					{Op: aws.String(awsapigateway.OpReplace), Path: aws.String("/variables/backend"), Value: aws.String("v2")},
+					{Op: aws.String(awsapigateway.OpReplace), Path: aws.String("/variables/region"), Value: aws.String("eu")},
+				},
+			},
+		},
+		"VariableRemoved": {
+			cr: stage(withExternalName(stageName), withObserved(), withVariables(nil)),
+			want: want{
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpRemove), Path: aws.String("/variables/backend")},
+				},
+			},
+		},
+		"Retagged": {
+			cr: stage(withExternalName(stageName), withObserved(), withTags(map[string]string{"owner": "payments"})),
+			want: want{
+				tagged:  aws.StringMap(map[string]string{"owner": "payments"}),
+				removed: aws.StringSlice([]string{"team"}),
+			},
+		},
+		"UpdateFailed": {
+			cr:        stage(withExternalName(stageName), withObserved(), withDeploymentID("g4h5i6")),
+			updateErr: errBoom,
+			want: want{
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpReplace), Path: aws.String("/deploymentId"), Value: aws.String("g4h5i6")},
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ops []*awsapigateway.PatchOperation
+			var tagged map[string]*string
+			var removed []*string
+			e := &external{client: &fake.MockClient{
+				MockGetStageWithContext: getStage,
+				MockUpdateStageWithContext: func(_ context.Context, input *awsapigateway.UpdateStageInput, _ []request.Option) (*awsapigateway.Stage, error) {
+					ops = input.PatchOperations
+					return &awsapigateway.Stage{}, tc.updateErr
+				},
+				MockTagResourceWithContext: func(_ context.Context, input *awsapigateway.TagResourceInput, _ []request.Option) (*awsapigateway.TagResourceOutput, error) {
+					tagged = input.Tags
+					return &awsapigateway.TagResourceOutput{}, nil
+				},
+				MockUntagResourceWithContext: func(_ context.Context, input *awsapigateway.UntagResourceInput, _ []request.Option) (*awsapigateway.UntagResourceOutput, error) {
+					removed = input.TagKeys
+					return &awsapigateway.UntagResourceOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ops, ops); diff != "" {
+				t.Errorf("ops: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("tagged: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Stage
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Stage
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteStageWithContext: func(_ context.Context, input *awsapigateway.DeleteStageInput, _ []request.Option) (*awsapigateway.DeleteStageOutput, error) {
+					if aws.StringValue(input.StageName) != stageName {
+						return nil, errors.New("unexpected stage")
+					}
+					return &awsapigateway.DeleteStageOutput{}, nil
+				},
+			},
+			cr: stage(withExternalName(stageName)),
+			want: want{
+				cr: stage(withExternalName(stageName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteStageWithContext: func(context.Context, *awsapigateway.DeleteStageInput, []request.Option) (*awsapigateway.DeleteStageOutput, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: stage(withExternalName(stageName)),
+			want: want{
+				cr: stage(withExternalName(stageName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteStageWithContext: func(context.Context, *awsapigateway.DeleteStageInput, []request.Option) (*awsapigateway.DeleteStageOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: stage(withExternalName(stageName)),
+			want: want{
+				cr:  stage(withExternalName(stageName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
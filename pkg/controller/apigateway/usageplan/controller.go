@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usageplan
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an API Gateway UsagePlan resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the usage plan"
+	errGetKeys          = "failed to get the API keys of the usage plan"
+	errCreate           = "failed to create the usage plan"
+	errUpdate           = "failed to update the usage plan"
+	errAddKey           = "failed to add an API key to the usage plan"
+	errRemoveKey        = "failed to remove an API key from the usage plan"
+	errRemoveStages     = "failed to remove the stages from the usage plan"
+	errDelete           = "failed to delete the usage plan"
+)
+
+// SetupUsagePlan adds a controller that reconciles API Gateway usage plans.
+func SetupUsagePlan(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.UsagePlanGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.UsagePlan{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.UsagePlanGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.UsagePlan)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) get(ctx context.Context, cr *v1alpha1.UsagePlan) (*awsapigateway.UsagePlan, error) {
+	return e.client.GetUsagePlanWithContext(ctx, &awsapigateway.GetUsagePlanInput{
+		UsagePlanId: aws.String(meta.GetExternalName(cr)),
+	})
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.UsagePlan)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the ID of the usage plan, which is only known
+	// once the usage plan is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	u, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+	keys, err := apigateway.UsagePlanKeyIDs(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetKeys)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeUsagePlan(&cr.Spec.ForProvider, u)
+
+	cr.Status.AtProvider.ID = aws.StringValue(u.Id)
+	cr.SetConditions(xpv1.Available())
+
+	add, remove := apigateway.DiffUsagePlanKeyIDs(cr.Spec.ForProvider.APIKeyIDs, keys)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: len(apigateway.GenerateUsagePlanPatchOperations(cr.Spec.ForProvider, u)) == 0 &&
+			len(add) == 0 && len(remove) == 0 &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, aws.StringValueMap(u.Tags), cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.UsagePlan)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// API keys are added to the usage plan by the first update.
+	u, err := e.client.CreateUsagePlanWithContext(ctx, apigateway.GenerateCreateUsagePlanInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(u.Id))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.UsagePlan)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+
+	u, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if ops := apigateway.GenerateUsagePlanPatchOperations(cr.Spec.ForProvider, u); len(ops) > 0 {
+		if _, err := e.client.UpdateUsagePlanWithContext(ctx, &awsapigateway.UpdateUsagePlanInput{
+			UsagePlanId:     aws.String(id),
+			PatchOperations: ops,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	keys, err := apigateway.UsagePlanKeyIDs(ctx, e.client, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetKeys)
+	}
+	add, remove := apigateway.DiffUsagePlanKeyIDs(cr.Spec.ForProvider.APIKeyIDs, keys)
+	for _, k := range add {
+		if _, err := e.client.CreateUsagePlanKeyWithContext(ctx, &awsapigateway.CreateUsagePlanKeyInput{
+			UsagePlanId: aws.String(id),
+			KeyId:       aws.String(k),
+			KeyType:     aws.String(apigateway.UsagePlanKeyTypeAPIKey),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddKey)
+		}
+	}
+	for _, k := range remove {
+		if _, err := e.client.DeleteUsagePlanKeyWithContext(ctx, &awsapigateway.DeleteUsagePlanKeyInput{
+			UsagePlanId: aws.String(id),
+			KeyId:       aws.String(k),
+		}); resource.Ignore(apigateway.IsNotFound, err) != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveKey)
+		}
+	}
+
+	return managed.ExternalUpdate{}, apigateway.UpdateTags(ctx, e.client, apigateway.UsagePlanARN(cr.Spec.ForProvider.Region, id), cr.Spec.ForProvider.Tags, aws.StringValueMap(u.Tags))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.UsagePlan)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// Usage plans cannot be deleted while they apply to stages.
+	u, err := e.get(ctx, cr)
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+	if ops := apigateway.GenerateRemoveAPIStagesPatchOperations(u); len(ops) > 0 {
+		if _, err := e.client.UpdateUsagePlanWithContext(ctx, &awsapigateway.UpdateUsagePlanInput{
+			UsagePlanId:     u.Id,
+			PatchOperations: ops,
+		}); err != nil {
+			return awsclient.Wrap(err, errRemoveStages)
+		}
+	}
+
+	_, err = e.client.DeleteUsagePlanWithContext(ctx, &awsapigateway.DeleteUsagePlanInput{
+		UsagePlanId: u.Id,
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,551 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usageplan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	planID    = "u1v2w3"
+	restAPIID = "a1b2c3d4e5"
+
+	errBoom = errors.New("boom")
+)
+
+type usagePlanModifier func(*v1alpha1.UsagePlan)
+
+func withExternalName(name string) usagePlanModifier {
+	return func(r *v1alpha1.UsagePlan) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) usagePlanModifier {
+	return func(r *v1alpha1.UsagePlan) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(d string) usagePlanModifier {
+	return func(r *v1alpha1.UsagePlan) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withStages(stages ...string) usagePlanModifier {
+	return func(r *v1alpha1.UsagePlan) {
+		r.Spec.ForProvider.APIStages = nil
+		for _, s := range stages {
+			r.Spec.ForProvider.APIStages = append(r.Spec.ForProvider.APIStages, v1alpha1.UsagePlanAPIStage{
+				RestAPIID: aws.String(restAPIID),
+				Stage:     aws.String(s),
+			})
+		}
+	}
+}
+
+func withAPIKeyIDs(ids ...string) usagePlanModifier {
+	return func(r *v1alpha1.UsagePlan) { r.Spec.ForProvider.APIKeyIDs = ids }
+}
+
+func withStatus(s v1alpha1.UsagePlanObservation) usagePlanModifier {
+	return func(r *v1alpha1.UsagePlan) { r.Status.AtProvider = s }
+}
+
+func usagePlan(m ...usagePlanModifier) *v1alpha1.UsagePlan {
+	cr := &v1alpha1.UsagePlan{
+		Spec: v1alpha1.UsagePlanSpec{
+			ForProvider: v1alpha1.UsagePlanParameters{
+				Region:    "us-east-1",
+				Name:      "gold",
+				APIKeyIDs: []string{"k1", "k2"},
+				Tags:      map[string]string{"team": "api"},
+			},
+		},
+	}
+	withStages("prod")(cr)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedPlan(stages ...string) *awsapigateway.UsagePlan {
+	u := &awsapigateway.UsagePlan{
+		Id:          aws.String(planID),
+		Name:        aws.String("gold"),
+		Description: aws.String("gold tier"),
+		Tags:        aws.StringMap(map[string]string{"team": "api"}),
+	}
+	for _, s := range stages {
+		u.ApiStages = append(u.ApiStages, &awsapigateway.ApiStage{ApiId: aws.String(restAPIID), Stage: aws.String(s)})
+	}
+	return u
+}
+
+func getUsagePlan(u *awsapigateway.UsagePlan) func(context.Context, *awsapigateway.GetUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+	return func(_ context.Context, input *awsapigateway.GetUsagePlanInput, _ []request.Option) (*awsapigateway.UsagePlan, error) {
+		if aws.StringValue(input.UsagePlanId) != planID {
+			return nil, errors.New("unexpected usage plan")
+		}
+		return u, nil
+	}
+}
+
+func getUsagePlanKeys(ids ...string) func(context.Context, *awsapigateway.GetUsagePlanKeysInput, []request.Option) (*awsapigateway.GetUsagePlanKeysOutput, error) {
+	return func(_ context.Context, input *awsapigateway.GetUsagePlanKeysInput, _ []request.Option) (*awsapigateway.GetUsagePlanKeysOutput, error) {
+		if aws.StringValue(input.UsagePlanId) != planID {
+			return nil, errors.New("unexpected usage plan")
+		}
+		o := &awsapigateway.GetUsagePlanKeysOutput{}
+		for _, id := range ids {
+			o.Items = append(o.Items, &awsapigateway.UsagePlanKey{Id: aws.String(id), Type: aws.String("API_KEY")})
+		}
+		return o, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.UsagePlan
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.UsagePlan
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     usagePlan(),
+			want: want{
+				cr: usagePlan(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockGetUsagePlanWithContext:     getUsagePlan(observedPlan("prod")),
+				MockGetUsagePlanKeysWithContext: getUsagePlanKeys("k2", "k1"),
+			},
+			cr: usagePlan(withExternalName(planID), withDescription("gold tier")),
+			want: want{
+				cr: usagePlan(withExternalName(planID), withDescription("gold tier"),
+					withStatus(v1alpha1.UsagePlanObservation{ID: planID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{
+				MockGetUsagePlanWithContext:     getUsagePlan(observedPlan("prod")),
+				MockGetUsagePlanKeysWithContext: getUsagePlanKeys("k1", "k2"),
+			},
+			cr: usagePlan(withExternalName(planID)),
+			want: want{
+				cr: usagePlan(withExternalName(planID), withDescription("gold tier"),
+					withStatus(v1alpha1.UsagePlanObservation{ID: planID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"StagesChanged": {
+			client: &fake.MockClient{
+				MockGetUsagePlanWithContext:     getUsagePlan(observedPlan("prod")),
+				MockGetUsagePlanKeysWithContext: getUsagePlanKeys("k1", "k2"),
+			},
+			cr: usagePlan(withExternalName(planID), withDescription("gold tier"), withStages("prod", "beta")),
+			want: want{
+				cr: usagePlan(withExternalName(planID), withDescription("gold tier"), withStages("prod", "beta"),
+					withStatus(v1alpha1.UsagePlanObservation{ID: planID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"KeysChanged": {
+			client: &fake.MockClient{
+				MockGetUsagePlanWithContext:     getUsagePlan(observedPlan("prod")),
+				MockGetUsagePlanKeysWithContext: getUsagePlanKeys("k1"),
+			},
+			cr: usagePlan(withExternalName(planID), withDescription("gold tier")),
+			want: want{
+				cr: usagePlan(withExternalName(planID), withDescription("gold tier"),
+					withStatus(v1alpha1.UsagePlanObservation{ID: planID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetUsagePlanWithContext: func(context.Context, *awsapigateway.GetUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+					return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil)
+				},
+			},
+			cr: usagePlan(withExternalName(planID)),
+			want: want{
+				cr: usagePlan(withExternalName(planID)),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetUsagePlanWithContext: func(context.Context, *awsapigateway.GetUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+					return nil, errBoom
+				},
+			},
+			cr: usagePlan(withExternalName(planID)),
+			want: want{
+				cr:  usagePlan(withExternalName(planID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"GetKeysFailed": {
+			client: &fake.MockClient{
+				MockGetUsagePlanWithContext: getUsagePlan(observedPlan("prod")),
+				MockGetUsagePlanKeysWithContext: func(context.Context, *awsapigateway.GetUsagePlanKeysInput, []request.Option) (*awsapigateway.GetUsagePlanKeysOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: usagePlan(withExternalName(planID)),
+			want: want{
+				cr:  usagePlan(withExternalName(planID)),
+				err: awsclient.Wrap(errBoom, errGetKeys),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.UsagePlan
+		input *awsapigateway.CreateUsagePlanInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.UsagePlan
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: usagePlan(),
+			want: want{
+				cr: usagePlan(withExternalName(planID), withConditions(xpv1.Creating())),
+				input: &awsapigateway.CreateUsagePlanInput{
+					Name:      aws.String("gold"),
+					ApiStages: []*awsapigateway.ApiStage{{ApiId: aws.String(restAPIID), Stage: aws.String("prod")}},
+					Tags:      aws.StringMap(map[string]string{"team": "api"}),
+				},
+			},
+		},
+		"CreateFailed": {
+			cr:        usagePlan(),
+			createErr: errBoom,
+			want: want{
+				cr: usagePlan(withConditions(xpv1.Creating())),
+				input: &awsapigateway.CreateUsagePlanInput{
+					Name:      aws.String("gold"),
+					ApiStages: []*awsapigateway.ApiStage{{ApiId: aws.String(restAPIID), Stage: aws.String("prod")}},
+					Tags:      aws.StringMap(map[string]string{"team": "api"}),
+				},
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsapigateway.CreateUsagePlanInput
+			e := &external{client: &fake.MockClient{
+				MockCreateUsagePlanWithContext: func(_ context.Context, in *awsapigateway.CreateUsagePlanInput, _ []request.Option) (*awsapigateway.UsagePlan, error) {
+					input = in
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return &awsapigateway.UsagePlan{Id: aws.String(planID)}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		ops     []*awsapigateway.PatchOperation
+		added   []string
+		removed []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.UsagePlan
+		keys      []string
+		updateErr error
+		addErr    error
+		removeErr error
+		want
+	}{
+		"StagesAndKeysChanged": {
+			cr:   usagePlan(withExternalName(planID), withDescription("gold tier"), withStages("beta"), withAPIKeyIDs("k2", "k3")),
+			keys: []string{"k1", "k2"},
+			want: want{
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpRemove), Path: aws.String("/apiStages"), Value: aws.String(restAPIID + ":prod")},
+					{Op: aws.String(awsapigateway.OpAdd), Path: aws.String("/apiStages"), Value: aws.String(restAPIID + ":beta")},
+				},
+				added:   []string{"k3"},
+				removed: []string{"k1"},
+			},
+		},
+		"KeysUpToDate": {
+			cr:   usagePlan(withExternalName(planID), withDescription("gold tier")),
+			keys: []string{"k2", "k1"},
+		},
+		"KeyAlreadyRemoved": {
+			cr:        usagePlan(withExternalName(planID), withDescription("gold tier"), withAPIKeyIDs()),
+			keys:      []string{"k1"},
+			removeErr: awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil),
+			want: want{
+				removed: []string{"k1"},
+			},
+		},
+		"UpdateFailed": {
+			cr:        usagePlan(withExternalName(planID), withDescription("platinum tier")),
+			keys:      []string{"k1", "k2"},
+			updateErr: errBoom,
+			want: want{
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpReplace), Path: aws.String("/description"), Value: aws.String("platinum tier")},
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"AddKeyFailed": {
+			cr:     usagePlan(withExternalName(planID), withDescription("gold tier"), withAPIKeyIDs("k1", "k2", "k3")),
+			keys:   []string{"k1", "k2"},
+			addErr: errBoom,
+			want: want{
+				added: []string{"k3"},
+				err:   awsclient.Wrap(errBoom, errAddKey),
+			},
+		},
+		"RemoveKeyFailed": {
+			cr:        usagePlan(withExternalName(planID), withDescription("gold tier"), withAPIKeyIDs("k1")),
+			keys:      []string{"k1", "k2"},
+			removeErr: errBoom,
+			want: want{
+				removed: []string{"k2"},
+				err:     awsclient.Wrap(errBoom, errRemoveKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ops []*awsapigateway.PatchOperation
+			var added, removed []string
+			e := &external{client: &fake.MockClient{
+				MockGetUsagePlanWithContext:     getUsagePlan(observedPlan("prod")),
+				MockGetUsagePlanKeysWithContext: getUsagePlanKeys(tc.keys...),
+				MockUpdateUsagePlanWithContext: func(_ context.Context, input *awsapigateway.UpdateUsagePlanInput, _ []request.Option) (*awsapigateway.UsagePlan, error) {
+					ops = input.PatchOperations
+					return &awsapigateway.UsagePlan{}, tc.updateErr
+				},
+				MockCreateUsagePlanKeyWithContext: func(_ context.Context, input *awsapigateway.CreateUsagePlanKeyInput, _ []request.Option) (*awsapigateway.UsagePlanKey, error) {
+					if aws.StringValue(input.KeyType) != "API_KEY" {
+						return nil, errors.New("unexpected key type")
+					}
+					added = append(added, aws.StringValue(input.KeyId))
+					return &awsapigateway.UsagePlanKey{}, tc.addErr
+				},
+				MockDeleteUsagePlanKeyWithContext: func(_ context.Context, input *awsapigateway.DeleteUsagePlanKeyInput, _ []request.Option) (*awsapigateway.DeleteUsagePlanKeyOutput, error) {
+					removed = append(removed, aws.StringValue(input.KeyId))
+					return &awsapigateway.DeleteUsagePlanKeyOutput{}, tc.removeErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ops, ops); diff != "" {
+				t.Errorf("ops: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.added, added); diff != "" {
+				t.Errorf("added: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr      *v1alpha1.UsagePlan
+		ops     []*awsapigateway.PatchOperation
+		deleted bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		plan      *awsapigateway.UsagePlan
+		getErr    error
+		updateErr error
+		deleteErr error
+		want
+	}{
+		"WithStages": {
+			plan: observedPlan("prod"),
+			want: want{
+				cr: usagePlan(withExternalName(planID), withConditions(xpv1.Deleting())),
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpRemove), Path: aws.String("/apiStages"), Value: aws.String(restAPIID + ":prod")},
+				},
+				deleted: true,
+			},
+		},
+		"WithoutStages": {
+			plan: observedPlan(),
+			want: want{
+				cr:      usagePlan(withExternalName(planID), withConditions(xpv1.Deleting())),
+				deleted: true,
+			},
+		},
+		"AlreadyDeleted": {
+			getErr: awserr.New(awsapigateway.ErrCodeNotFoundException, "not found", nil),
+			want: want{
+				cr: usagePlan(withExternalName(planID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"GetFailed": {
+			getErr: errBoom,
+			want: want{
+				cr:  usagePlan(withExternalName(planID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"RemoveStagesFailed": {
+			plan:      observedPlan("prod"),
+			updateErr: errBoom,
+			want: want{
+				cr: usagePlan(withExternalName(planID), withConditions(xpv1.Deleting())),
+				ops: []*awsapigateway.PatchOperation{
+					{Op: aws.String(awsapigateway.OpRemove), Path: aws.String("/apiStages"), Value: aws.String(restAPIID + ":prod")},
+				},
+				err: awsclient.Wrap(errBoom, errRemoveStages),
+			},
+		},
+		"DeleteFailed": {
+			plan:      observedPlan(),
+			deleteErr: errBoom,
+			want: want{
+				cr:      usagePlan(withExternalName(planID), withConditions(xpv1.Deleting())),
+				deleted: true,
+				err:     awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ops []*awsapigateway.PatchOperation
+			deleted := false
+			cr := usagePlan(withExternalName(planID))
+			e := &external{client: &fake.MockClient{
+				MockGetUsagePlanWithContext: func(context.Context, *awsapigateway.GetUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+					return tc.plan, tc.getErr
+				},
+				MockUpdateUsagePlanWithContext: func(_ context.Context, input *awsapigateway.UpdateUsagePlanInput, _ []request.Option) (*awsapigateway.UsagePlan, error) {
+					ops = input.PatchOperations
+					return &awsapigateway.UsagePlan{}, tc.updateErr
+				},
+				MockDeleteUsagePlanWithContext: func(_ context.Context, input *awsapigateway.DeleteUsagePlanInput, _ []request.Option) (*awsapigateway.DeleteUsagePlanOutput, error) {
+					deleted = aws.StringValue(input.UsagePlanId) == planID
+					return &awsapigateway.DeleteUsagePlanOutput{}, tc.deleteErr
+				},
+			}}
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ops, ops); diff != "" {
+				t.Errorf("ops: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
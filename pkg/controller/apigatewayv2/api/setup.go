@@ -56,8 +56,9 @@ func SetupAPI(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 		For(&svcapitypes.API{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.APIGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithInitializers(),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(aws.NewTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -57,7 +57,8 @@ func SetupDeployment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		For(&svcapitypes.Deployment{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DeploymentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -44,6 +44,7 @@ func SetupDomainName(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		func(e *external) {
 			e.preObserve = preObserve
 			e.postObserve = postObserve
+			e.lateInitialize = lateInitialize
 			e.preCreate = preCreate
 			e.preDelete = preDelete
 		},
@@ -56,7 +57,9 @@ func SetupDomainName(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		For(&svcapitypes.DomainName{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DomainNameGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), aws.NewTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -75,6 +78,19 @@ func postObserve(_ context.Context, cr *svcapitypes.DomainName, _ *svcsdk.GetDom
 	return obs, nil
 }
 
+func lateInitialize(spec *svcapitypes.DomainNameParameters, resp *svcsdk.GetDomainNameOutput) error {
+	// The domain name and hosted zone ID that API Gateway assigns to each
+	// configuration are what Route 53 alias records route traffic to.
+	for i, c := range resp.DomainNameConfigurations {
+		if i >= len(spec.DomainNameConfigurations) || spec.DomainNameConfigurations[i] == nil {
+			break
+		}
+		spec.DomainNameConfigurations[i].APIGatewayDomainName = aws.LateInitializeStringPtr(spec.DomainNameConfigurations[i].APIGatewayDomainName, c.ApiGatewayDomainName)
+		spec.DomainNameConfigurations[i].HostedZoneID = aws.LateInitializeStringPtr(spec.DomainNameConfigurations[i].HostedZoneID, c.HostedZoneId)
+	}
+	return nil
+}
+
 func preCreate(_ context.Context, cr *svcapitypes.DomainName, obj *svcsdk.CreateDomainNameInput) error {
 	obj.DomainName = aws.String(meta.GetExternalName(cr))
 	return nil
@@ -58,7 +58,8 @@ func SetupIntegration(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		For(&svcapitypes.Integration{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.IntegrationGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
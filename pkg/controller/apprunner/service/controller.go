@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapprunner "github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apprunner"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an App Runner Service resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe the App Runner service"
+	errCreate           = "failed to create the App Runner service"
+	errUpdate           = "failed to update the App Runner service"
+	errDelete           = "failed to delete the App Runner service"
+
+	msgOperationInProgress = "an operation on the service is in progress"
+)
+
+// SetupService adds a controller that reconciles App Runner services.
+func SetupService(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ServiceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Service{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: apprunner.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) apprunner.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apprunner.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Service) (*awsapprunner.Service, error) {
+	resp, err := e.client.DescribeServiceWithContext(ctx, &awsapprunner.DescribeServiceInput{
+		ServiceArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Deleted services remain visible as DELETED for a while.
+	if resp.Service == nil || aws.StringValue(resp.Service.Status) == v1alpha1.ServiceStatusDeleted {
+		return nil, nil
+	}
+	return resp.Service, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the ARN of the service, which is only known once
+	// the service is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apprunner.IsNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apprunner.LateInitializeService(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = apprunner.GenerateServiceObservation(observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.ServiceStatusRunning:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.ServiceStatusOperationInProgress:
+		// Deployments and updates keep serving the previous revision, so a
+		// service only becomes unavailable while it is being created.
+		if cr.GetCondition(xpv1.TypeReady).Reason == xpv1.ReasonAvailable {
+			cr.SetConditions(xpv1.Available().WithMessage(msgOperationInProgress))
+		} else {
+			cr.SetConditions(xpv1.Creating())
+		}
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	tags, err := apprunner.ListTags(ctx, e.client, aws.StringValue(observed.ServiceArn))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: apprunner.IsServiceUpToDate(cr.Spec.ForProvider, observed) &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, tags, cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.ServiceURL),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateServiceWithContext(ctx, apprunner.GenerateCreateServiceInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(out.Service.ServiceArn))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// App Runner rejects updates while another operation is in progress.
+	if cr.Status.AtProvider.Status == v1alpha1.ServiceStatusOperationInProgress {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	if !apprunner.IsServiceUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.UpdateServiceWithContext(ctx, apprunner.GenerateUpdateServiceInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	tags, err := apprunner.ListTags(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, apprunner.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, tags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Service)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	// The deletion of the service is either in progress already or is
+	// retried once the operation in progress completes.
+	if cr.Status.AtProvider.Status == v1alpha1.ServiceStatusOperationInProgress {
+		return nil
+	}
+
+	_, err := e.client.DeleteServiceWithContext(ctx, &awsapprunner.DeleteServiceInput{
+		ServiceArn: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(apprunner.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,473 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapprunner "github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apprunner/fake"
+)
+
+var (
+	serviceARN     = "arn:aws:apprunner:us-east-1:123456789012:service/web/00000000000000000000000000000001"
+	serviceURL     = "abcdefghij.us-east-1.awsapprunner.com"
+	repositoryURI  = "123456789012.dkr.ecr.us-east-1.amazonaws.com/web"
+	autoScalingARN = "arn:aws:apprunner:us-east-1:123456789012:autoscalingconfiguration/DefaultConfiguration/1/00000000000000000000000000000001"
+
+	errBoom = errors.New("boom")
+)
+
+type serviceModifier func(*v1alpha1.Service)
+
+func withExternalName(name string) serviceModifier {
+	return func(r *v1alpha1.Service) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withImageTag(tag string) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Spec.ForProvider.SourceConfiguration.ImageRepository.ImageTag = tag }
+}
+
+func withStatus(s v1alpha1.ServiceObservation) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.AtProvider = s }
+}
+
+func service(m ...serviceModifier) *v1alpha1.Service {
+	cr := &v1alpha1.Service{
+		Spec: v1alpha1.ServiceSpec{
+			ForProvider: v1alpha1.ServiceParameters{
+				ServiceName: "web",
+				SourceConfiguration: v1alpha1.SourceConfiguration{
+					ImageRepository: v1alpha1.ImageRepository{
+						RepositoryURI:       aws.String(repositoryURI),
+						ImageTag:            "v1",
+						ImageRepositoryType: awsapprunner.ImageRepositoryTypeEcr,
+						ImageConfiguration: &v1alpha1.ImageConfiguration{
+							Port: aws.String("8080"),
+						},
+					},
+					AutoDeploymentsEnabled: aws.Bool(false),
+				},
+				InstanceConfiguration: &v1alpha1.InstanceConfiguration{
+					CPU:    aws.String("1024"),
+					Memory: aws.String("2048"),
+				},
+				AutoScalingConfigurationARN: aws.String(autoScalingARN),
+				NetworkConfiguration: &v1alpha1.NetworkConfiguration{
+					EgressConfiguration: &v1alpha1.EgressConfiguration{
+						EgressType: aws.String(awsapprunner.EgressTypeDefault),
+					},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status string) *awsapprunner.Service {
+	return &awsapprunner.Service{
+		ServiceArn:  aws.String(serviceARN),
+		ServiceId:   aws.String("00000000000000000000000000000001"),
+		ServiceName: aws.String("web"),
+		ServiceUrl:  aws.String(serviceURL),
+		Status:      aws.String(status),
+		SourceConfiguration: &awsapprunner.SourceConfiguration{
+			AutoDeploymentsEnabled: aws.Bool(false),
+			ImageRepository: &awsapprunner.ImageRepository{
+				ImageIdentifier:     aws.String(repositoryURI + ":v1"),
+				ImageRepositoryType: aws.String(awsapprunner.ImageRepositoryTypeEcr),
+				ImageConfiguration: &awsapprunner.ImageConfiguration{
+					Port: aws.String("8080"),
+				},
+			},
+		},
+		InstanceConfiguration: &awsapprunner.InstanceConfiguration{
+			Cpu:    aws.String("1024"),
+			Memory: aws.String("2048"),
+		},
+		AutoScalingConfigurationSummary: &awsapprunner.AutoScalingConfigurationSummary{
+			AutoScalingConfigurationArn: aws.String(autoScalingARN),
+		},
+		NetworkConfiguration: &awsapprunner.NetworkConfiguration{
+			EgressConfiguration: &awsapprunner.EgressConfiguration{
+				EgressType: aws.String(awsapprunner.EgressTypeDefault),
+			},
+		},
+	}
+}
+
+func describe(s *awsapprunner.Service) func(context.Context, *awsapprunner.DescribeServiceInput, []request.Option) (*awsapprunner.DescribeServiceOutput, error) {
+	return func(_ context.Context, input *awsapprunner.DescribeServiceInput, _ []request.Option) (*awsapprunner.DescribeServiceOutput, error) {
+		if aws.StringValue(input.ServiceArn) != serviceARN {
+			return nil, errors.New("unexpected service")
+		}
+		return &awsapprunner.DescribeServiceOutput{Service: s}, nil
+	}
+}
+
+func listTags(context.Context, *awsapprunner.ListTagsForResourceInput, []request.Option) (*awsapprunner.ListTagsForResourceOutput, error) {
+	return &awsapprunner.ListTagsForResourceOutput{}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Service
+		result managed.ExternalObservation
+		err    error
+	}
+
+	running := v1alpha1.ServiceObservation{
+		ServiceARN: serviceARN,
+		ServiceID:  "00000000000000000000000000000001",
+		ServiceURL: serviceURL,
+		Status:     v1alpha1.ServiceStatusRunning,
+	}
+	inProgress := running
+	inProgress.Status = v1alpha1.ServiceStatusOperationInProgress
+	connection := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte(serviceURL)}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Service
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     service(),
+			want: want{
+				cr: service(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeServiceWithContext:     describe(observed(v1alpha1.ServiceStatusRunning)),
+				MockListTagsForResourceWithContext: listTags,
+			},
+			cr: service(withExternalName(serviceARN)),
+			want: want{
+				cr: service(withExternalName(serviceARN), withStatus(running), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"ImageTagChanged": {
+			client: &fake.MockClient{
+				MockDescribeServiceWithContext:     describe(observed(v1alpha1.ServiceStatusRunning)),
+				MockListTagsForResourceWithContext: listTags,
+			},
+			cr: service(withExternalName(serviceARN), withImageTag("v2")),
+			want: want{
+				cr: service(withExternalName(serviceARN), withImageTag("v2"), withStatus(running), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"BeingCreated": {
+			client: &fake.MockClient{
+				MockDescribeServiceWithContext:     describe(observed(v1alpha1.ServiceStatusOperationInProgress)),
+				MockListTagsForResourceWithContext: listTags,
+			},
+			cr: service(withExternalName(serviceARN)),
+			want: want{
+				cr: service(withExternalName(serviceARN), withStatus(inProgress), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"BeingDeployed": {
+			client: &fake.MockClient{
+				MockDescribeServiceWithContext:     describe(observed(v1alpha1.ServiceStatusOperationInProgress)),
+				MockListTagsForResourceWithContext: listTags,
+			},
+			cr: service(withExternalName(serviceARN), withConditions(xpv1.Available())),
+			want: want{
+				cr: service(withExternalName(serviceARN), withStatus(inProgress), withConditions(xpv1.Available().WithMessage(msgOperationInProgress))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"Deleted": {
+			client: &fake.MockClient{
+				MockDescribeServiceWithContext: describe(observed(v1alpha1.ServiceStatusDeleted)),
+			},
+			cr: service(withExternalName(serviceARN)),
+			want: want{
+				cr: service(withExternalName(serviceARN)),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeServiceWithContext: func(context.Context, *awsapprunner.DescribeServiceInput, []request.Option) (*awsapprunner.DescribeServiceOutput, error) {
+					return nil, awserr.New(awsapprunner.ErrCodeResourceNotFoundException, "not found", nil)
+				},
+			},
+			cr: service(withExternalName(serviceARN)),
+			want: want{
+				cr: service(withExternalName(serviceARN)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeServiceWithContext: func(context.Context, *awsapprunner.DescribeServiceInput, []request.Option) (*awsapprunner.DescribeServiceOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(withExternalName(serviceARN)),
+			want: want{
+				cr:  service(withExternalName(serviceARN)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Service
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Service
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockCreateServiceWithContext: func(_ context.Context, input *awsapprunner.CreateServiceInput, _ []request.Option) (*awsapprunner.CreateServiceOutput, error) {
+					if aws.StringValue(input.SourceConfiguration.ImageRepository.ImageIdentifier) != repositoryURI+":v1" {
+						return nil, errors.New("unexpected image")
+					}
+					return &awsapprunner.CreateServiceOutput{Service: &awsapprunner.Service{ServiceArn: aws.String(serviceARN)}}, nil
+				},
+			},
+			cr: service(),
+			want: want{
+				cr: service(withExternalName(serviceARN), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockClient{
+				MockCreateServiceWithContext: func(context.Context, *awsapprunner.CreateServiceInput, []request.Option) (*awsapprunner.CreateServiceOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(),
+			want: want{
+				cr:  service(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		image string
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Service
+		updateErr error
+		want
+	}{
+		"NewImageTag": {
+			cr: service(withExternalName(serviceARN), withImageTag("v2"), withStatus(v1alpha1.ServiceObservation{Status: v1alpha1.ServiceStatusRunning})),
+			want: want{
+				image: repositoryURI + ":v2",
+			},
+		},
+		"OperationInProgress": {
+			cr:   service(withExternalName(serviceARN), withImageTag("v2"), withStatus(v1alpha1.ServiceObservation{Status: v1alpha1.ServiceStatusOperationInProgress})),
+			want: want{},
+		},
+		"UpToDate": {
+			cr:   service(withExternalName(serviceARN), withStatus(v1alpha1.ServiceObservation{Status: v1alpha1.ServiceStatusRunning})),
+			want: want{},
+		},
+		"UpdateFailed": {
+			cr:        service(withExternalName(serviceARN), withImageTag("v2"), withStatus(v1alpha1.ServiceObservation{Status: v1alpha1.ServiceStatusRunning})),
+			updateErr: errBoom,
+			want: want{
+				image: repositoryURI + ":v2",
+				err:   awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			image := ""
+			e := &external{client: &fake.MockClient{
+				MockDescribeServiceWithContext:     describe(observed(v1alpha1.ServiceStatusRunning)),
+				MockListTagsForResourceWithContext: listTags,
+				MockUpdateServiceWithContext: func(_ context.Context, input *awsapprunner.UpdateServiceInput, _ []request.Option) (*awsapprunner.UpdateServiceOutput, error) {
+					image = aws.StringValue(input.SourceConfiguration.ImageRepository.ImageIdentifier)
+					return &awsapprunner.UpdateServiceOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.image, image); diff != "" {
+				t.Errorf("image: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Service
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Service
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteServiceWithContext: func(_ context.Context, input *awsapprunner.DeleteServiceInput, _ []request.Option) (*awsapprunner.DeleteServiceOutput, error) {
+					if aws.StringValue(input.ServiceArn) != serviceARN {
+						return nil, errors.New("unexpected service")
+					}
+					return &awsapprunner.DeleteServiceOutput{}, nil
+				},
+			},
+			cr: service(withExternalName(serviceARN)),
+			want: want{
+				cr: service(withExternalName(serviceARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"OperationInProgress": {
+			client: &fake.MockClient{},
+			cr:     service(withExternalName(serviceARN), withStatus(v1alpha1.ServiceObservation{Status: v1alpha1.ServiceStatusOperationInProgress})),
+			want: want{
+				cr: service(withExternalName(serviceARN), withStatus(v1alpha1.ServiceObservation{Status: v1alpha1.ServiceStatusOperationInProgress}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteServiceWithContext: func(context.Context, *awsapprunner.DeleteServiceInput, []request.Option) (*awsapprunner.DeleteServiceOutput, error) {
+					return nil, awserr.New(awsapprunner.ErrCodeResourceNotFoundException, "not found", nil)
+				},
+			},
+			cr: service(withExternalName(serviceARN)),
+			want: want{
+				cr: service(withExternalName(serviceARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteServiceWithContext: func(context.Context, *awsapprunner.DeleteServiceInput, []request.Option) (*awsapprunner.DeleteServiceOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(withExternalName(serviceARN)),
+			want: want{
+				cr:  service(withExternalName(serviceARN), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -54,7 +54,9 @@ func SetupWorkGroup(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLi
 		For(&svcapitypes.WorkGroup{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.WorkGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclients.NewTagger(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -27,6 +27,13 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
+	apigatewayapikey "github.com/crossplane/provider-aws/pkg/controller/apigateway/apikey"
+	apigatewaydeployment "github.com/crossplane/provider-aws/pkg/controller/apigateway/deployment"
+	apigatewaymethod "github.com/crossplane/provider-aws/pkg/controller/apigateway/method"
+	apigatewayresource "github.com/crossplane/provider-aws/pkg/controller/apigateway/resource"
+	apigatewayrestapi "github.com/crossplane/provider-aws/pkg/controller/apigateway/restapi"
+	apigatewaystage "github.com/crossplane/provider-aws/pkg/controller/apigateway/stage"
+	apigatewayusageplan "github.com/crossplane/provider-aws/pkg/controller/apigateway/usageplan"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/api"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/apimapping"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/authorizer"
@@ -39,6 +46,7 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/routeresponse"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/stage"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/vpclink"
+	apprunnerservice "github.com/crossplane/provider-aws/pkg/controller/apprunner/service"
 	athenaworkgroup "github.com/crossplane/provider-aws/pkg/controller/athena/workgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
@@ -46,10 +54,16 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/cachepolicy"
 	cloudfrontorginaccessidentity "github.com/crossplane/provider-aws/pkg/controller/cloudfront/cloudfrontoriginaccessidentity"
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/distribution"
+	cloudfrontkeyvaluestore "github.com/crossplane/provider-aws/pkg/controller/cloudfront/keyvaluestore"
+	cloudfrontfunction "github.com/crossplane/provider-aws/pkg/controller/cloudfront/function"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/originaccesscontrol"
 	cwloggroup "github.com/crossplane/provider-aws/pkg/controller/cloudwatchlogs/loggroup"
 	"github.com/crossplane/provider-aws/pkg/controller/config"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
+	daxcluster "github.com/crossplane/provider-aws/pkg/controller/dax/cluster"
+	daxparametergroup "github.com/crossplane/provider-aws/pkg/controller/dax/parametergroup"
+	daxsubnetgroup "github.com/crossplane/provider-aws/pkg/controller/dax/subnetgroup"
 	docdbcluster "github.com/crossplane/provider-aws/pkg/controller/docdb/dbcluster"
 	docdbclusterparametergroup "github.com/crossplane/provider-aws/pkg/controller/docdb/dbclusterparametergroup"
 	docdbinstance "github.com/crossplane/provider-aws/pkg/controller/docdb/dbinstance"
@@ -58,15 +72,35 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/globaltable"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/table"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/address"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/capacityreservation"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/clientvpnendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/customergateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/dhcpoptions"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/ec2fleet"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/egressonlyinternetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/eniattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/image"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/instance"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/instancevolumeattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/ipam"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/ipampool"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/ipampoolcidr"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplateversion"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/natgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/networkacl"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/networkinterface"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/placementgroup"
 	ec2route "github.com/crossplane/provider-aws/pkg/controller/ec2/route"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygrouprule"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/snapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/trafficmirrorfilter"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/trafficmirrorsession"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/trafficmirrortarget"
 	transitgateway "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgateway"
 	transitgatewayroute "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroute"
 	transitgatewayroutetable "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroutetable"
@@ -77,11 +111,23 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpointserviceconfiguration"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpnconnection"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpngateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/lifecyclepolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/pullthroughcacherule"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/registryscanningconfiguration"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/replicationconfiguration"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repository"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repositorypolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/ecs/capacityprovider"
+	ecscluster "github.com/crossplane/provider-aws/pkg/controller/ecs/cluster"
+	ecsservice "github.com/crossplane/provider-aws/pkg/controller/ecs/service"
+	"github.com/crossplane/provider-aws/pkg/controller/ecs/taskdefinition"
 	"github.com/crossplane/provider-aws/pkg/controller/efs/filesystem"
 	efsmounttarget "github.com/crossplane/provider-aws/pkg/controller/efs/mounttarget"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/accessentry"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/accesspolicyassociation"
 	eksaddon "github.com/crossplane/provider-aws/pkg/controller/eks/addon"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/fargateprofile"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/identityproviderconfig"
@@ -92,6 +138,9 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listener"
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/globalaccelerator/accelerator"
+	"github.com/crossplane/provider-aws/pkg/controller/globalaccelerator/endpointgroup"
+	globalacceleratorlistener "github.com/crossplane/provider-aws/pkg/controller/globalaccelerator/listener"
 	glueclassifier "github.com/crossplane/provider-aws/pkg/controller/glue/classifier"
 	glueconnection "github.com/crossplane/provider-aws/pkg/controller/glue/connection"
 	gluecrawler "github.com/crossplane/provider-aws/pkg/controller/glue/crawler"
@@ -102,12 +151,19 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/iam/group"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/grouppolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/groupusermembership"
+	"github.com/crossplane/provider-aws/pkg/controller/iam/instanceprofile"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/openidconnectprovider"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/policy"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/role"
+	"github.com/crossplane/provider-aws/pkg/controller/iam/rolepolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/rolepolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/iam/servicelinkedrole"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/user"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/userpolicyattachment"
+	imagebuildercomponent "github.com/crossplane/provider-aws/pkg/controller/imagebuilder/component"
+	"github.com/crossplane/provider-aws/pkg/controller/imagebuilder/imagepipeline"
+	"github.com/crossplane/provider-aws/pkg/controller/imagebuilder/imagerecipe"
+	"github.com/crossplane/provider-aws/pkg/controller/imagebuilder/infrastructureconfiguration"
 	iotpolicy "github.com/crossplane/provider-aws/pkg/controller/iot/policy"
 	"github.com/crossplane/provider-aws/pkg/controller/iot/thing"
 	kafkacluster "github.com/crossplane/provider-aws/pkg/controller/kafka/cluster"
@@ -115,7 +171,12 @@ import (
 	kinesisstream "github.com/crossplane/provider-aws/pkg/controller/kinesis/stream"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/alias"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/key"
+	lambdaalias "github.com/crossplane/provider-aws/pkg/controller/lambda/alias"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/eventsourcemapping"
 	"github.com/crossplane/provider-aws/pkg/controller/lambda/function"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/layerversion"
+	lambdapermission "github.com/crossplane/provider-aws/pkg/controller/lambda/permission"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/provisionedconcurrencyconfig"
 	mqbroker "github.com/crossplane/provider-aws/pkg/controller/mq/broker"
 	mquser "github.com/crossplane/provider-aws/pkg/controller/mq/user"
 	neptunecluster "github.com/crossplane/provider-aws/pkg/controller/neptune/dbcluster"
@@ -128,6 +189,7 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbparametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/globalcluster"
 	"github.com/crossplane/provider-aws/pkg/controller/redshift"
+	"github.com/crossplane/provider-aws/pkg/controller/route53/healthcheck"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/hostedzone"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/resourcerecordset"
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverendpoint"
@@ -135,6 +197,10 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverruleassociation"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/object"
+	"github.com/crossplane/provider-aws/pkg/controller/s3control/accesspoint"
+	"github.com/crossplane/provider-aws/pkg/controller/s3control/multiregionaccesspoint"
+	"github.com/crossplane/provider-aws/pkg/controller/s3control/storagelensconfiguration"
 	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/privatednsnamespace"
@@ -164,11 +230,17 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		eks.SetupCluster,
 		eksaddon.SetupAddon,
 		identityproviderconfig.SetupIdentityProviderConfig,
+		accessentry.SetupAccessEntry,
+		accesspolicyassociation.SetupAccessPolicyAssociation,
 		elb.SetupELB,
 		elbattachment.SetupELBAttachment,
 		nodegroup.SetupNodeGroup,
 		s3.SetupBucket,
 		bucketpolicy.SetupBucketPolicy,
+		object.SetupObject,
+		accesspoint.SetupAccessPoint,
+		multiregionaccesspoint.SetupMultiRegionAccessPoint,
+		storagelensconfiguration.SetupStorageLensConfiguration,
 		accesskey.SetupAccessKey,
 		user.SetupUser,
 		group.SetupGroup,
@@ -178,13 +250,19 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		userpolicyattachment.SetupUserPolicyAttachment,
 		grouppolicyattachment.SetupGroupPolicyAttachment,
 		rolepolicyattachment.SetupRolePolicyAttachment,
+		rolepolicy.SetupRolePolicy,
+		instanceprofile.SetupInstanceProfile,
+		servicelinkedrole.SetupServiceLinkedRole,
 		vpc.SetupVPC,
 		subnet.SetupSubnet,
 		securitygroup.SetupSecurityGroup,
+		securitygrouprule.SetupSecurityGroupRule,
 		internetgateway.SetupInternetGateway,
+		egressonlyinternetgateway.SetupEgressOnlyInternetGateway,
 		launchtemplate.SetupLaunchTemplate,
 		launchtemplateversion.SetupLaunchTemplateVersion,
 		natgateway.SetupNatGateway,
+		networkacl.SetupNetworkACL,
 		routetable.SetupRouteTable,
 		dbsubnetgroup.SetupDBSubnetGroup,
 		certificateauthority.SetupCertificateAuthority,
@@ -192,6 +270,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		acm.SetupCertificate,
 		resourcerecordset.SetupResourceRecordSet,
 		hostedzone.SetupHostedZone,
+		healthcheck.SetupHealthCheck,
 		secret.SetupSecret,
 		topic.SetupSNSTopic,
 		subscription.SetupSubscription,
@@ -200,6 +279,10 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		address.SetupAddress,
 		repository.SetupRepository,
 		repositorypolicy.SetupRepositoryPolicy,
+		lifecyclepolicy.SetupLifecyclePolicy,
+		replicationconfiguration.SetupReplicationConfiguration,
+		pullthroughcacherule.SetupPullThroughCacheRule,
+		registryscanningconfiguration.SetupRegistryScanningConfiguration,
 		api.SetupAPI,
 		stage.SetupStage,
 		route.SetupRoute,
@@ -212,12 +295,22 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		apimapping.SetupAPIMapping,
 		routeresponse.SetupRouteResponse,
 		vpclink.SetupVPCLink,
+		apigatewayrestapi.SetupRestAPI,
+		apigatewayresource.SetupResource,
+		apigatewaymethod.SetupMethod,
+		apigatewaydeployment.SetupDeployment,
+		apigatewaystage.SetupStage,
+		apigatewayusageplan.SetupUsagePlan,
+		apigatewayapikey.SetupAPIKey,
 		fargateprofile.SetupFargateProfile,
 		activity.SetupActivity,
 		statemachine.SetupStateMachine,
 		table.SetupTable,
 		backup.SetupBackup,
 		globaltable.SetupGlobalTable,
+		daxcluster.SetupCluster,
+		daxparametergroup.SetupParameterGroup,
+		daxsubnetgroup.SetupSubnetGroup,
 		key.SetupKey,
 		alias.SetupAlias,
 		filesystem.SetupFileSystem,
@@ -231,10 +324,18 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		publicdnsnamespace.SetupPublicDNSNamespace,
 		httpnamespace.SetupHTTPNamespace,
 		function.SetupFunction,
+		lambdaalias.SetupAlias,
+		provisionedconcurrencyconfig.SetupProvisionedConcurrencyConfig,
+		eventsourcemapping.SetupEventSourceMapping,
+		lambdapermission.SetupPermission,
+		layerversion.SetupLayerVersion,
 		openidconnectprovider.SetupOpenIDConnectProvider,
 		distribution.SetupDistribution,
 		cachepolicy.SetupCachePolicy,
 		cloudfrontorginaccessidentity.SetupCloudFrontOriginAccessIdentity,
+		originaccesscontrol.SetupOriginAccessControl,
+		cloudfrontfunction.SetupFunction,
+		cloudfrontkeyvaluestore.SetupKeyValueStore,
 		resolverendpoint.SetupResolverEndpoint,
 		resolverrule.SetupResolverRule,
 		vpcpeeringconnection.SetupVPCPeeringConnection,
@@ -254,6 +355,25 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		mquser.SetupUser,
 		cwloggroup.SetupLogGroup,
 		volume.SetupVolume,
+		instancevolumeattachment.SetupInstanceVolumeAttachment,
+		snapshot.SetupSnapshot,
+		placementgroup.SetupPlacementGroup,
+		capacityreservation.SetupCapacityReservation,
+		dhcpoptions.SetupDHCPOptions,
+		customergateway.SetupCustomerGateway,
+		vpngateway.SetupVPNGateway,
+		vpnconnection.SetupVPNConnection,
+		clientvpnendpoint.SetupClientVPNEndpoint,
+		ipam.SetupIPAM,
+		ipampool.SetupIPAMPool,
+		ipampoolcidr.SetupIPAMPoolCIDR,
+		ec2fleet.SetupEC2Fleet,
+		image.SetupImage,
+		networkinterface.SetupNetworkInterface,
+		eniattachment.SetupENIAttachment,
+		trafficmirrortarget.SetupTrafficMirrorTarget,
+		trafficmirrorfilter.SetupTrafficMirrorFilter,
+		trafficmirrorsession.SetupTrafficMirrorSession,
 		transitgateway.SetupTransitGateway,
 		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
 		thing.SetupThing,
@@ -275,11 +395,26 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll ti
 		subscription.SetupSubscription,
 		nottopic.SetupSNSTopic,
 		notsubscription.SetupSubscription,
+		imagebuildercomponent.SetupComponent,
+		imagerecipe.SetupImageRecipe,
+		infrastructureconfiguration.SetupInfrastructureConfiguration,
+		imagepipeline.SetupImagePipeline,
+		ecscluster.SetupCluster,
+		capacityprovider.SetupCapacityProvider,
+		taskdefinition.SetupTaskDefinition,
+		ecsservice.SetupService,
+		apprunnerservice.SetupService,
+		accelerator.SetupAccelerator,
+		globalacceleratorlistener.SetupListener,
+		endpointgroup.SetupEndpointGroup,
 	} {
 		if err := setup(mgr, l, rl, poll); err != nil {
 			return err
 		}
 	}
 
-	return config.Setup(mgr, l, rl)
+	if err := config.Setup(mgr, l, rl); err != nil {
+		return err
+	}
+	return config.SetupUsageGarbageCollector(mgr, l, rl)
 }
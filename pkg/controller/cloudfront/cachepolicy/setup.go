@@ -47,7 +47,8 @@ func SetupCachePolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		For(&svcapitypes.CachePolicy{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.CachePolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
@@ -60,7 +61,7 @@ func SetupCachePolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 						e.preDelete = preDelete
 					},
 				},
-			}),
+			}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -48,7 +48,8 @@ func SetupCloudFrontOriginAccessIdentity(mgr ctrl.Manager, l logging.Logger, rl
 		For(&svcapitypes.CloudFrontOriginAccessIdentity{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.CloudFrontOriginAccessIdentityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
@@ -61,7 +62,7 @@ func SetupCloudFrontOriginAccessIdentity(mgr ctrl.Manager, l logging.Logger, rl
 						e.preDelete = preDelete
 					},
 				},
-			}),
+			}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
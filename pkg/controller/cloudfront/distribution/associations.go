@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package distribution
+
+import (
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errFmtNoCacheBehavior = "no cache behavior with path pattern %q"
+	errNoDefaultBehavior  = "no default cache behavior"
+	errFmtNoOrigin        = "no origin with ID %q"
+)
+
+// setAssociations sets the functions, response headers policies and origin
+// access controls of the supplied distribution config. They are custom fields
+// of a Distribution, so they aren't part of the generated config.
+func setAssociations(p svcapitypes.DistributionParameters, cfg *svcsdk.DistributionConfig) error {
+	if err := checkAssociations(p, cfg); err != nil {
+		return err
+	}
+	if cfg.DefaultCacheBehavior != nil {
+		cfg.DefaultCacheBehavior.FunctionAssociations = functionAssociations(p, nil)
+		cfg.DefaultCacheBehavior.ResponseHeadersPolicyId = responseHeadersPolicyID(p, nil)
+	}
+	if cfg.CacheBehaviors != nil {
+		for _, b := range cfg.CacheBehaviors.Items {
+			b.FunctionAssociations = functionAssociations(p, b.PathPattern)
+			b.ResponseHeadersPolicyId = responseHeadersPolicyID(p, b.PathPattern)
+		}
+	}
+	if cfg.Origins != nil {
+		for _, o := range cfg.Origins.Items {
+			o.OriginAccessControlId = originAccessControlID(p, awsclients.StringValue(o.Id))
+		}
+	}
+	return nil
+}
+
+// isUpToDateAssociations returns true if the cache behaviors and origins of
+// the supplied distribution config have the desired functions, response
+// headers policies and origin access controls.
+func isUpToDateAssociations(p svcapitypes.DistributionParameters, cfg *svcsdk.DistributionConfig) bool {
+	if b := cfg.DefaultCacheBehavior; b != nil {
+		if !isUpToDateFunctionAssociations(functionAssociations(p, nil), b.FunctionAssociations) ||
+			awsclients.StringValue(b.ResponseHeadersPolicyId) != awsclients.StringValue(responseHeadersPolicyID(p, nil)) {
+			return false
+		}
+	}
+	if cfg.CacheBehaviors != nil {
+		for _, b := range cfg.CacheBehaviors.Items {
+			if !isUpToDateFunctionAssociations(functionAssociations(p, b.PathPattern), b.FunctionAssociations) ||
+				awsclients.StringValue(b.ResponseHeadersPolicyId) != awsclients.StringValue(responseHeadersPolicyID(p, b.PathPattern)) {
+				return false
+			}
+		}
+	}
+	if cfg.Origins != nil {
+		for _, o := range cfg.Origins.Items {
+			if awsclients.StringValue(o.OriginAccessControlId) != awsclients.StringValue(originAccessControlID(p, awsclients.StringValue(o.Id))) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// checkAssociations returns an error if a function, a response headers
+// policy or an origin access control is set for a cache behavior or an origin
+// that the supplied distribution config doesn't have.
+func checkAssociations(p svcapitypes.DistributionParameters, cfg *svcsdk.DistributionConfig) error {
+	pathPatterns := make([]*string, 0, len(p.FunctionAssociations)+len(p.ResponseHeadersPolicies))
+	for _, a := range p.FunctionAssociations {
+		pathPatterns = append(pathPatterns, a.PathPattern)
+	}
+	for _, a := range p.ResponseHeadersPolicies {
+		pathPatterns = append(pathPatterns, a.PathPattern)
+	}
+	for _, pp := range pathPatterns {
+		if !hasCacheBehavior(cfg, pp) {
+			if pp == nil {
+				return errors.New(errNoDefaultBehavior)
+			}
+			return errors.Errorf(errFmtNoCacheBehavior, *pp)
+		}
+	}
+	for _, a := range p.OriginAccessControls {
+		if !hasOrigin(cfg, a.OriginID) {
+			return errors.Errorf(errFmtNoOrigin, a.OriginID)
+		}
+	}
+	return nil
+}
+
+// hasCacheBehavior returns true if the supplied distribution config has a
+// cache behavior with the supplied path pattern, or a default cache behavior
+// if it is nil.
+func hasCacheBehavior(cfg *svcsdk.DistributionConfig, pathPattern *string) bool {
+	if pathPattern == nil {
+		return cfg.DefaultCacheBehavior != nil
+	}
+	if cfg.CacheBehaviors == nil {
+		return false
+	}
+	for _, b := range cfg.CacheBehaviors.Items {
+		if samePathPattern(b.PathPattern, pathPattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOrigin returns true if the supplied distribution config has an origin
+// with the supplied ID.
+func hasOrigin(cfg *svcsdk.DistributionConfig, id string) bool {
+	if cfg.Origins == nil {
+		return false
+	}
+	for _, o := range cfg.Origins.Items {
+		if awsclients.StringValue(o.Id) == id {
+			return true
+		}
+	}
+	return false
+}
+
+// functionAssociations returns the functions of the cache behavior with the
+// supplied path pattern, or of the default cache behavior if it is nil.
+func functionAssociations(p svcapitypes.DistributionParameters, pathPattern *string) *svcsdk.FunctionAssociations {
+	var items []*svcsdk.FunctionAssociation
+	for _, a := range p.FunctionAssociations {
+		if samePathPattern(a.PathPattern, pathPattern) {
+			items = append(items, &svcsdk.FunctionAssociation{
+				EventType:   awsclients.String(a.EventType),
+				FunctionARN: a.FunctionARN,
+			})
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return &svcsdk.FunctionAssociations{Items: items, Quantity: awsclients.Int64(len(items))}
+}
+
+// isUpToDateFunctionAssociations returns true if both function associations
+// run the same functions for the same events. CloudFront reports a cache
+// behavior without functions with a quantity of zero.
+func isUpToDateFunctionAssociations(want, got *svcsdk.FunctionAssociations) bool {
+	functions := func(fa *svcsdk.FunctionAssociations) map[string]string {
+		m := map[string]string{}
+		if fa == nil {
+			return m
+		}
+		for _, a := range fa.Items {
+			m[awsclients.StringValue(a.EventType)] = awsclients.StringValue(a.FunctionARN)
+		}
+		return m
+	}
+	return cmp.Equal(functions(want), functions(got))
+}
+
+// responseHeadersPolicyID returns the ID of the response headers policy of the
+// cache behavior with the supplied path pattern, or of the default cache
+// behavior if it is nil.
+func responseHeadersPolicyID(p svcapitypes.DistributionParameters, pathPattern *string) *string {
+	for _, a := range p.ResponseHeadersPolicies {
+		if samePathPattern(a.PathPattern, pathPattern) {
+			return awsclients.String(a.ResponseHeadersPolicyID)
+		}
+	}
+	return nil
+}
+
+// originAccessControlID returns the ID of the origin access control of the
+// origin with the supplied ID.
+func originAccessControlID(p svcapitypes.DistributionParameters, id string) *string {
+	for _, a := range p.OriginAccessControls {
+		if a.OriginID == id {
+			return a.OriginAccessControlID
+		}
+	}
+	return nil
+}
+
+// samePathPattern returns true if both path patterns are nil, which stands for
+// the default cache behavior, or if they are equal.
+func samePathPattern(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
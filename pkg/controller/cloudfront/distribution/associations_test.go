@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package distribution
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	securityHeadersPolicyID = "67f7725c-6f97-4210-82d7-5512b31e9d03"
+	corsPolicyID            = "5cc3b908-e619-4b99-88e5-2cf7f45965bd"
+	originAccessControl     = "E2QWRUHAPOMQZL"
+	functionARN             = "arn:aws:cloudfront::123456789012:function/rewrite"
+)
+
+func distributionConfig() *svcsdk.DistributionConfig {
+	return &svcsdk.DistributionConfig{
+		DefaultCacheBehavior: &svcsdk.DefaultCacheBehavior{TargetOriginId: awsclients.String("s3")},
+		CacheBehaviors: &svcsdk.CacheBehaviors{
+			Items: []*svcsdk.CacheBehavior{{PathPattern: awsclients.String("/api/*"), TargetOriginId: awsclients.String("api")}},
+		},
+		Origins: &svcsdk.Origins{
+			Items: []*svcsdk.Origin{{Id: awsclients.String("s3")}, {Id: awsclients.String("api")}},
+		},
+	}
+}
+
+func TestSetAssociations(t *testing.T) {
+	type want struct {
+		cfg *svcsdk.DistributionConfig
+		err error
+	}
+
+	cases := map[string]struct {
+		p    svcapitypes.DistributionParameters
+		cfg  *svcsdk.DistributionConfig
+		want want
+	}{
+		"NoAssociations": {
+			cfg: distributionConfig(),
+			want: want{
+				cfg: distributionConfig(),
+			},
+		},
+		"Associations": {
+			p: svcapitypes.DistributionParameters{
+				CustomDistributionParameters: svcapitypes.CustomDistributionParameters{
+					FunctionAssociations: []svcapitypes.CacheBehaviorFunctionAssociation{
+						{EventType: "viewer-request", FunctionARN: awsclients.String(functionARN)},
+					},
+					ResponseHeadersPolicies: []svcapitypes.ResponseHeadersPolicyAssociation{
+						{ResponseHeadersPolicyID: securityHeadersPolicyID},
+						{PathPattern: awsclients.String("/api/*"), ResponseHeadersPolicyID: corsPolicyID},
+					},
+					OriginAccessControls: []svcapitypes.OriginAccessControlAssociation{
+						{OriginID: "s3", OriginAccessControlID: awsclients.String(originAccessControl)},
+					},
+				},
+			},
+			cfg: distributionConfig(),
+			want: want{
+				cfg: func() *svcsdk.DistributionConfig {
+					cfg := distributionConfig()
+					cfg.DefaultCacheBehavior.FunctionAssociations = &svcsdk.FunctionAssociations{
+						Items: []*svcsdk.FunctionAssociation{{
+							EventType:   awsclients.String("viewer-request"),
+							FunctionARN: awsclients.String(functionARN),
+						}},
+						Quantity: awsclients.Int64(1),
+					}
+					cfg.DefaultCacheBehavior.ResponseHeadersPolicyId = awsclients.String(securityHeadersPolicyID)
+					cfg.CacheBehaviors.Items[0].ResponseHeadersPolicyId = awsclients.String(corsPolicyID)
+					cfg.Origins.Items[0].OriginAccessControlId = awsclients.String(originAccessControl)
+					return cfg
+				}(),
+			},
+		},
+		"UnknownCacheBehavior": {
+			p: svcapitypes.DistributionParameters{
+				CustomDistributionParameters: svcapitypes.CustomDistributionParameters{
+					ResponseHeadersPolicies: []svcapitypes.ResponseHeadersPolicyAssociation{
+						{PathPattern: awsclients.String("/static/*"), ResponseHeadersPolicyID: corsPolicyID},
+					},
+				},
+			},
+			cfg: distributionConfig(),
+			want: want{
+				cfg: distributionConfig(),
+				err: errors.Errorf(errFmtNoCacheBehavior, "/static/*"),
+			},
+		},
+		"UnknownFunctionCacheBehavior": {
+			p: svcapitypes.DistributionParameters{
+				CustomDistributionParameters: svcapitypes.CustomDistributionParameters{
+					FunctionAssociations: []svcapitypes.CacheBehaviorFunctionAssociation{
+						{PathPattern: awsclients.String("/static/*"), EventType: "viewer-request", FunctionARN: awsclients.String(functionARN)},
+					},
+				},
+			},
+			cfg: distributionConfig(),
+			want: want{
+				cfg: distributionConfig(),
+				err: errors.Errorf(errFmtNoCacheBehavior, "/static/*"),
+			},
+		},
+		"UnknownOrigin": {
+			p: svcapitypes.DistributionParameters{
+				CustomDistributionParameters: svcapitypes.CustomDistributionParameters{
+					OriginAccessControls: []svcapitypes.OriginAccessControlAssociation{
+						{OriginID: "static", OriginAccessControlID: awsclients.String(originAccessControl)},
+					},
+				},
+			},
+			cfg: distributionConfig(),
+			want: want{
+				cfg: distributionConfig(),
+				err: errors.Errorf(errFmtNoOrigin, "static"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := setAssociations(tc.p, tc.cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nsetAssociations(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cfg, tc.cfg); diff != "" {
+				t.Errorf("\nsetAssociations(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDateAssociations(t *testing.T) {
+	p := svcapitypes.DistributionParameters{
+		CustomDistributionParameters: svcapitypes.CustomDistributionParameters{
+			FunctionAssociations: []svcapitypes.CacheBehaviorFunctionAssociation{
+				{PathPattern: awsclients.String("/api/*"), EventType: "viewer-request", FunctionARN: awsclients.String(functionARN)},
+			},
+			ResponseHeadersPolicies: []svcapitypes.ResponseHeadersPolicyAssociation{
+				{ResponseHeadersPolicyID: securityHeadersPolicyID},
+			},
+			OriginAccessControls: []svcapitypes.OriginAccessControlAssociation{
+				{OriginID: "s3", OriginAccessControlID: awsclients.String(originAccessControl)},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		cfg  func(*svcsdk.DistributionConfig)
+		want bool
+	}{
+		"UpToDate": {
+			cfg:  func(*svcsdk.DistributionConfig) {},
+			want: true,
+		},
+		"NoFunctionsReportedAsZero": {
+			cfg: func(cfg *svcsdk.DistributionConfig) {
+				cfg.DefaultCacheBehavior.FunctionAssociations = &svcsdk.FunctionAssociations{Quantity: awsclients.Int64(0)}
+			},
+			want: true,
+		},
+		"FunctionChanged": {
+			cfg: func(cfg *svcsdk.DistributionConfig) {
+				cfg.CacheBehaviors.Items[0].FunctionAssociations.Items[0].EventType = awsclients.String("viewer-response")
+			},
+			want: false,
+		},
+		"UnlistedFunction": {
+			cfg: func(cfg *svcsdk.DistributionConfig) {
+				cfg.DefaultCacheBehavior.FunctionAssociations = &svcsdk.FunctionAssociations{
+					Items:    []*svcsdk.FunctionAssociation{{EventType: awsclients.String("viewer-request"), FunctionARN: awsclients.String(functionARN)}},
+					Quantity: awsclients.Int64(1),
+				}
+			},
+			want: false,
+		},
+		"ResponseHeadersPolicyChanged": {
+			cfg: func(cfg *svcsdk.DistributionConfig) {
+				cfg.DefaultCacheBehavior.ResponseHeadersPolicyId = awsclients.String(corsPolicyID)
+			},
+			want: false,
+		},
+		"UnlistedResponseHeadersPolicy": {
+			cfg: func(cfg *svcsdk.DistributionConfig) {
+				cfg.CacheBehaviors.Items[0].ResponseHeadersPolicyId = awsclients.String(corsPolicyID)
+			},
+			want: false,
+		},
+		"OriginAccessControlRemoved": {
+			cfg: func(cfg *svcsdk.DistributionConfig) {
+				cfg.Origins.Items[0].OriginAccessControlId = nil
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := distributionConfig()
+			_ = setAssociations(p, cfg)
+			tc.cfg(cfg)
+			if got := isUpToDateAssociations(p, cfg); got != tc.want {
+				t.Errorf("\nisUpToDateAssociations(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
@@ -57,12 +58,14 @@ func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 		For(&svcapitypes.Distribution{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DistributionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
 						e.preCreate = preCreate
-						e.postCreate = postCreate
+						c := &creator{kube: e.kube}
+						e.postCreate = c.postCreate
 						e.lateInitialize = lateInitialize
 						e.preObserve = preObserve
 						e.postObserve = postObserve
@@ -73,7 +76,7 @@ func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 						e.postUpdate = postUpdate
 					},
 				},
-			}),
+			}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -86,17 +89,22 @@ func preCreate(_ context.Context, cr *svcapitypes.Distribution, cdi *svcsdk.Crea
 		cdi.DistributionConfig.Origins.Quantity =
 			awsclients.Int64(len(cr.Spec.ForProvider.DistributionConfig.Origins.Items))
 	}
-	return nil
+	return setAssociations(cr.Spec.ForProvider, cdi.DistributionConfig)
+}
+
+type creator struct {
+	kube client.Client
 }
 
-func postCreate(_ context.Context, cr *svcapitypes.Distribution, cdo *svcsdk.CreateDistributionOutput,
+func (c *creator) postCreate(ctx context.Context, cr *svcapitypes.Distribution, cdo *svcsdk.CreateDistributionOutput,
 	ec managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
 
 	meta.SetExternalName(cr, awsclients.StringValue(cdo.Distribution.Id))
-	return ec, nil
+	// Deploying a new distribution to all edge locations takes a while.
+	return ec, awsclients.PersistAsyncInProgress(ctx, c.kube, cr, "CreateDistribution")
 }
 
 func preObserve(_ context.Context, cr *svcapitypes.Distribution, gdi *svcsdk.GetDistributionInput) error {
@@ -123,10 +131,14 @@ func isUpToDate(cr *svcapitypes.Distribution, gdo *svcsdk.GetDistributionOutput)
 	currentParams := &svcapitypes.DistributionParameters{}
 	_ = lateInitialize(currentParams, gdo)
 
-	return cmp.Equal(*currentParams, cr.Spec.ForProvider,
+	upToDate := cmp.Equal(*currentParams, cr.Spec.ForProvider,
 		// We don't late init region - it's not in the output.
 		cmpopts.IgnoreFields(svcapitypes.DistributionParameters{}, "Region"),
 
+		// The custom fields are not late initialized. References only set
+		// IDs in the config, and the associations are compared below.
+		cmpopts.IgnoreFields(svcapitypes.DistributionParameters{}, "CustomDistributionParameters"),
+
 		// This appears to always be nil in GetDistributionOutput, which
 		// causes false positives for IsUpToDate.
 		cmpopts.IgnoreFields(svcapitypes.ViewerCertificate{}, "CloudFrontDefaultCertificate"),
@@ -144,7 +156,8 @@ func isUpToDate(cr *svcapitypes.Distribution, gdo *svcsdk.GetDistributionOutput)
 		cmpopts.SortSlices(func(x, y *svcapitypes.Origin) bool {
 			return awsclients.StringValue(x.ID) > awsclients.StringValue(y.ID)
 		}),
-	), nil
+	)
+	return upToDate && isUpToDateAssociations(cr.Spec.ForProvider, gdo.Distribution.DistributionConfig), nil
 }
 
 func postObserve(_ context.Context, cr *svcapitypes.Distribution, gdo *svcsdk.GetDistributionOutput,
@@ -159,6 +172,9 @@ func postObserve(_ context.Context, cr *svcapitypes.Distribution, gdo *svcsdk.Ge
 	} else {
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	if awsclients.StringValue(gdo.Distribution.Status) == stateDeployed && eo.ResourceUpToDate {
+		awsclients.CompleteAsyncOperation(cr)
+	}
 	return eo, nil
 }
 
@@ -169,6 +185,7 @@ func postUpdate(_ context.Context, cr *svcapitypes.Distribution, resp *svcsdk.Up
 	}
 	// We need etag of update operation for the next operations.
 	cr.Status.AtProvider.ETag = resp.ETag
+	awsclients.SetAsyncInProgress(cr, "UpdateDistribution")
 	return upd, nil
 }
 
@@ -179,7 +196,7 @@ func preUpdate(_ context.Context, cr *svcapitypes.Distribution, udi *svcsdk.Upda
 	udi.DistributionConfig.Origins.Quantity =
 		awsclients.Int64(len(cr.Spec.ForProvider.DistributionConfig.Origins.Items))
 
-	return nil
+	return setAssociations(cr.Spec.ForProvider, udi.DistributionConfig)
 }
 
 type deleter struct {
@@ -0,0 +1,236 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscloudfront "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a CloudFront Function resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the CloudFront function"
+	errGetLive          = "failed to get the LIVE stage of the CloudFront function"
+	errCreate           = "failed to create the CloudFront function"
+	errUpdate           = "failed to update the CloudFront function"
+	errPublish          = "failed to publish the CloudFront function"
+	errDelete           = "failed to delete the CloudFront function"
+)
+
+// SetupFunction adds a controller that reconciles CloudFront functions.
+func SetupFunction(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.FunctionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Function{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FunctionGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: cloudfront.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) cloudfront.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client cloudfront.Client
+}
+
+// stage returns the description and the code of the supplied stage of the
+// function.
+func (e *external) stage(ctx context.Context, name, stage string) (*awscloudfront.DescribeFunctionOutput, []byte, error) {
+	d, err := e.client.DescribeFunctionWithContext(ctx, &awscloudfront.DescribeFunctionInput{
+		Name:  aws.String(name),
+		Stage: aws.String(stage),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	g, err := e.client.GetFunctionWithContext(ctx, &awscloudfront.GetFunctionInput{
+		Name:  aws.String(name),
+		Stage: aws.String(stage),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return d, g.FunctionCode, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	dev, devCode, err := e.stage(ctx, meta.GetExternalName(cr), awscloudfront.FunctionStageDevelopment)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errGet)
+	}
+	cr.Status.AtProvider = cloudfront.GenerateFunctionObservation(dev)
+	cr.SetConditions(xpv1.Available())
+
+	// Deleting the function only needs the ETag observed above. The code is
+	// not compared, as its ConfigMap may already be gone.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	code, err := cloudfront.GetFunctionCode(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	upToDate := cloudfront.IsFunctionConfigUpToDate(cr.Spec.ForProvider, dev) && bytes.Equal(code, devCode)
+	if aws.BoolValue(cr.Spec.ForProvider.Publish) {
+		// The LIVE stage doesn't exist until the function is published
+		// for the first time.
+		live, liveCode, err := e.stage(ctx, meta.GetExternalName(cr), awscloudfront.FunctionStageLive)
+		if resource.Ignore(cloudfront.IsNotFound, err) != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errGetLive)
+		}
+		cr.Status.AtProvider.Published = err == nil &&
+			cloudfront.IsFunctionConfigUpToDate(cr.Spec.ForProvider, live) && bytes.Equal(code, liveCode)
+		upToDate = upToDate && cr.Status.AtProvider.Published
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	code, err := cloudfront.GetFunctionCode(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	resp, err := e.client.CreateFunctionWithContext(ctx, &awscloudfront.CreateFunctionInput{
+		Name:           aws.String(meta.GetExternalName(cr)),
+		FunctionConfig: cloudfront.GenerateFunctionConfig(cr.Spec.ForProvider),
+		FunctionCode:   code,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{}, e.publish(ctx, cr, aws.StringValue(resp.ETag))
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	code, err := cloudfront.GetFunctionCode(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	resp, err := e.client.UpdateFunctionWithContext(ctx, &awscloudfront.UpdateFunctionInput{
+		Name:           aws.String(meta.GetExternalName(cr)),
+		IfMatch:        aws.String(cr.Status.AtProvider.ETag),
+		FunctionConfig: cloudfront.GenerateFunctionConfig(cr.Spec.ForProvider),
+		FunctionCode:   code,
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	cr.Status.AtProvider.ETag = aws.StringValue(resp.ETag)
+	return managed.ExternalUpdate{}, e.publish(ctx, cr, cr.Status.AtProvider.ETag)
+}
+
+// publish copies the DEVELOPMENT stage with the supplied ETag to the LIVE
+// stage if the function should be published.
+func (e *external) publish(ctx context.Context, cr *v1alpha1.Function, eTag string) error {
+	if !aws.BoolValue(cr.Spec.ForProvider.Publish) {
+		return nil
+	}
+	_, err := e.client.PublishFunctionWithContext(ctx, &awscloudfront.PublishFunctionInput{
+		Name:    aws.String(meta.GetExternalName(cr)),
+		IfMatch: aws.String(eTag),
+	})
+	return awsclient.Wrap(err, errPublish)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// CloudFront refuses to delete a function that is still associated with
+	// a distribution, so deletion is retried until it is released.
+	_, err := e.client.DeleteFunctionWithContext(ctx, &awscloudfront.DeleteFunctionInput{
+		Name:    aws.String(meta.GetExternalName(cr)),
+		IfMatch: aws.String(cr.Status.AtProvider.ETag),
+	})
+	return awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errDelete)
+}
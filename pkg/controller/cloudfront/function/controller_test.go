@@ -0,0 +1,596 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awscloudfront "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront/fake"
+)
+
+var (
+	functionName = "rewrite"
+	functionARN  = "arn:aws:cloudfront::123456789012:function/rewrite"
+	code         = "function handler(event) { return event.request; }"
+	newCode      = "function handler(event) { return event.response; }"
+	eTag         = "E1PA6795UKMFR9"
+	newETag      = "E3UN6WX5RRO2AG"
+	kvsARN       = "arn:aws:cloudfront::123456789012:key-value-store/8aa76c94-4ae1-4c8d-9ad5-3ec2e2c86e4b"
+
+	errBoom = errors.New("boom")
+)
+
+type functionModifier func(*v1alpha1.Function)
+
+func withConditions(c ...xpv1.Condition) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withCode(c string) functionModifier {
+	return func(r *v1alpha1.Function) { r.Spec.ForProvider.Code = aws.String(c) }
+}
+
+func withCodeConfigMapRef(ref *awsv1beta1.ConfigMapKeySelector) functionModifier {
+	return func(r *v1alpha1.Function) {
+		r.Spec.ForProvider.Code = nil
+		r.Spec.ForProvider.CodeConfigMapRef = ref
+	}
+}
+
+func withPublish() functionModifier {
+	return func(r *v1alpha1.Function) { r.Spec.ForProvider.Publish = aws.Bool(true) }
+}
+
+func withKeyValueStoreARN(arn string) functionModifier {
+	return func(r *v1alpha1.Function) {
+		r.Spec.ForProvider.Runtime = awscloudfront.FunctionRuntimeCloudfrontJs20
+		r.Spec.ForProvider.KeyValueStoreARN = aws.String(arn)
+	}
+}
+
+func withDeletionTimestamp() functionModifier {
+	return func(r *v1alpha1.Function) {
+		t := metav1.NewTime(time.Unix(1, 0))
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func withObservation(o v1alpha1.FunctionObservation) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.AtProvider = o }
+}
+
+func function(m ...functionModifier) *v1alpha1.Function {
+	cr := &v1alpha1.Function{
+		Spec: v1alpha1.FunctionSpec{
+			ForProvider: v1alpha1.FunctionParameters{
+				Region:  "us-east-1",
+				Comment: aws.String("rewrites viewer requests"),
+				Runtime: awscloudfront.FunctionRuntimeCloudfrontJs10,
+				Code:    aws.String(code),
+			},
+		},
+	}
+	meta.SetExternalName(cr, functionName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func config() *awscloudfront.FunctionConfig {
+	return &awscloudfront.FunctionConfig{
+		Comment: aws.String("rewrites viewer requests"),
+		Runtime: aws.String(awscloudfront.FunctionRuntimeCloudfrontJs10),
+	}
+}
+
+func observation(published bool) v1alpha1.FunctionObservation {
+	return v1alpha1.FunctionObservation{
+		FunctionARN: functionARN,
+		Status:      "UNPUBLISHED",
+		ETag:        eTag,
+		Published:   published,
+	}
+}
+
+// stages returns mocks that serve the supplied code for each stage of the
+// function. Stages without code don't exist.
+func stages(c map[string]string) (func(context.Context, *awscloudfront.DescribeFunctionInput, []request.Option) (*awscloudfront.DescribeFunctionOutput, error), func(context.Context, *awscloudfront.GetFunctionInput, []request.Option) (*awscloudfront.GetFunctionOutput, error)) {
+	notFound := awserr.New(awscloudfront.ErrCodeNoSuchFunctionExists, "not found", nil)
+	describe := func(_ context.Context, input *awscloudfront.DescribeFunctionInput, _ []request.Option) (*awscloudfront.DescribeFunctionOutput, error) {
+		if _, ok := c[aws.StringValue(input.Stage)]; !ok || aws.StringValue(input.Name) != functionName {
+			return nil, notFound
+		}
+		return &awscloudfront.DescribeFunctionOutput{
+			ETag: aws.String(eTag),
+			FunctionSummary: &awscloudfront.FunctionSummary{
+				Name:             aws.String(functionName),
+				Status:           aws.String("UNPUBLISHED"),
+				FunctionConfig:   config(),
+				FunctionMetadata: &awscloudfront.FunctionMetadata{FunctionARN: aws.String(functionARN)},
+			},
+		}, nil
+	}
+	get := func(_ context.Context, input *awscloudfront.GetFunctionInput, _ []request.Option) (*awscloudfront.GetFunctionOutput, error) {
+		s, ok := c[aws.StringValue(input.Stage)]
+		if !ok || aws.StringValue(input.Name) != functionName {
+			return nil, notFound
+		}
+		return &awscloudfront.GetFunctionOutput{ETag: aws.String(eTag), FunctionCode: []byte(s)}, nil
+	}
+	return describe, get
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Function
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		kube   client.Client
+		stages map[string]string
+		cr     *v1alpha1.Function
+		want
+	}{
+		"NotFound": {
+			stages: map[string]string{},
+			cr:     function(),
+			want: want{
+				cr: function(),
+			},
+		},
+		"UpToDate": {
+			stages: map[string]string{awscloudfront.FunctionStageDevelopment: code},
+			cr:     function(),
+			want: want{
+				cr: function(withObservation(observation(false)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CodeChanged": {
+			stages: map[string]string{awscloudfront.FunctionStageDevelopment: code},
+			cr:     function(withCode(newCode)),
+			want: want{
+				cr: function(withCode(newCode), withObservation(observation(false)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"CodeFromConfigMap": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.ConfigMap).Data = map[string]string{"index.js": newCode}
+					return nil
+				}),
+			},
+			stages: map[string]string{awscloudfront.FunctionStageDevelopment: code},
+			cr:     function(withCodeConfigMapRef(&awsv1beta1.ConfigMapKeySelector{Name: "rewrite", Namespace: "default", Key: "index.js"})),
+			want: want{
+				cr: function(withCodeConfigMapRef(&awsv1beta1.ConfigMapKeySelector{Name: "rewrite", Namespace: "default", Key: "index.js"}),
+					withObservation(observation(false)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeletedWithoutCodeConfigMap": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			stages: map[string]string{awscloudfront.FunctionStageDevelopment: code},
+			cr:     function(withCodeConfigMapRef(&awsv1beta1.ConfigMapKeySelector{Name: "rewrite", Namespace: "default", Key: "index.js"}), withDeletionTimestamp()),
+			want: want{
+				cr: function(withCodeConfigMapRef(&awsv1beta1.ConfigMapKeySelector{Name: "rewrite", Namespace: "default", Key: "index.js"}), withDeletionTimestamp(),
+					withObservation(observation(false)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"Published": {
+			stages: map[string]string{awscloudfront.FunctionStageDevelopment: code, awscloudfront.FunctionStageLive: code},
+			cr:     function(withPublish()),
+			want: want{
+				cr: function(withPublish(), withObservation(observation(true)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeverPublished": {
+			stages: map[string]string{awscloudfront.FunctionStageDevelopment: code},
+			cr:     function(withPublish()),
+			want: want{
+				cr: function(withPublish(), withObservation(observation(false)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"KeyValueStoreAssociated": {
+			stages: map[string]string{awscloudfront.FunctionStageDevelopment: code},
+			cr:     function(withKeyValueStoreARN(kvsARN)),
+			want: want{
+				cr: function(withKeyValueStoreARN(kvsARN), withObservation(observation(false)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"OutdatedLiveStage": {
+			stages: map[string]string{awscloudfront.FunctionStageDevelopment: newCode, awscloudfront.FunctionStageLive: code},
+			cr:     function(withPublish(), withCode(newCode)),
+			want: want{
+				cr: function(withPublish(), withCode(newCode), withObservation(observation(false)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			describe, get := stages(tc.stages)
+			e := &external{kube: tc.kube, client: &fake.MockClient{
+				MockDescribeFunctionWithContext: describe,
+				MockGetFunctionWithContext:      get,
+			}}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveFailed(t *testing.T) {
+	e := &external{client: &fake.MockClient{
+		MockDescribeFunctionWithContext: func(context.Context, *awscloudfront.DescribeFunctionInput, []request.Option) (*awscloudfront.DescribeFunctionOutput, error) {
+			return nil, errBoom
+		},
+	}}
+	_, err := e.Observe(context.Background(), function())
+	if diff := cmp.Diff(awsclient.Wrap(errBoom, errGet), err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr        *v1alpha1.Function
+		published bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Function
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: function(),
+			want: want{
+				cr: function(withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessfulWithPublish": {
+			cr: function(withPublish()),
+			want: want{
+				cr:        function(withPublish(), withConditions(xpv1.Creating())),
+				published: true,
+			},
+		},
+		"CreateFailed": {
+			cr:        function(withPublish()),
+			createErr: errBoom,
+			want: want{
+				cr:  function(withPublish(), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			published := false
+			e := &external{client: &fake.MockClient{
+				MockCreateFunctionWithContext: func(_ context.Context, input *awscloudfront.CreateFunctionInput, _ []request.Option) (*awscloudfront.CreateFunctionOutput, error) {
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					if diff := cmp.Diff(&awscloudfront.CreateFunctionInput{
+						Name:           aws.String(functionName),
+						FunctionConfig: config(),
+						FunctionCode:   []byte(code),
+					}, input); diff != "" {
+						return nil, errors.New(diff)
+					}
+					return &awscloudfront.CreateFunctionOutput{ETag: aws.String(eTag)}, nil
+				},
+				MockPublishFunctionWithContext: func(_ context.Context, input *awscloudfront.PublishFunctionInput, _ []request.Option) (*awscloudfront.PublishFunctionOutput, error) {
+					if aws.StringValue(input.Name) != functionName || aws.StringValue(input.IfMatch) != eTag {
+						return nil, errors.New("unexpected function")
+					}
+					published = true
+					return &awscloudfront.PublishFunctionOutput{}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.published, published); diff != "" {
+				t.Errorf("published: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr        *v1alpha1.Function
+		input     *awscloudfront.UpdateFunctionInput
+		published bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		cr         *v1alpha1.Function
+		updateErr  error
+		publishErr error
+		want
+	}{
+		"Successful": {
+			cr: function(withCode(newCode), withObservation(observation(false))),
+			want: want{
+				cr: function(withCode(newCode), withObservation(v1alpha1.FunctionObservation{
+					FunctionARN: functionARN,
+					Status:      "UNPUBLISHED",
+					ETag:        newETag,
+				})),
+				input: &awscloudfront.UpdateFunctionInput{
+					Name:           aws.String(functionName),
+					IfMatch:        aws.String(eTag),
+					FunctionConfig: config(),
+					FunctionCode:   []byte(newCode),
+				},
+			},
+		},
+		"SuccessfulWithPublish": {
+			cr: function(withPublish(), withCode(newCode), withObservation(observation(false))),
+			want: want{
+				cr: function(withPublish(), withCode(newCode), withObservation(v1alpha1.FunctionObservation{
+					FunctionARN: functionARN,
+					Status:      "UNPUBLISHED",
+					ETag:        newETag,
+				})),
+				input: &awscloudfront.UpdateFunctionInput{
+					Name:           aws.String(functionName),
+					IfMatch:        aws.String(eTag),
+					FunctionConfig: config(),
+					FunctionCode:   []byte(newCode),
+				},
+				published: true,
+			},
+		},
+		"SuccessfulWithKeyValueStore": {
+			cr: function(withKeyValueStoreARN(kvsARN), withObservation(observation(false))),
+			want: want{
+				cr: function(withKeyValueStoreARN(kvsARN), withObservation(v1alpha1.FunctionObservation{
+					FunctionARN: functionARN,
+					Status:      "UNPUBLISHED",
+					ETag:        newETag,
+				})),
+				input: &awscloudfront.UpdateFunctionInput{
+					Name:    aws.String(functionName),
+					IfMatch: aws.String(eTag),
+					FunctionConfig: &awscloudfront.FunctionConfig{
+						Comment: aws.String("rewrites viewer requests"),
+						Runtime: aws.String(awscloudfront.FunctionRuntimeCloudfrontJs20),
+						KeyValueStoreAssociations: &awscloudfront.KeyValueStoreAssociations{
+							Quantity: aws.Int64(1),
+							Items:    []*awscloudfront.KeyValueStoreAssociation{{KeyValueStoreARN: aws.String(kvsARN)}},
+						},
+					},
+					FunctionCode: []byte(code),
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        function(withPublish(), withObservation(observation(false))),
+			updateErr: errBoom,
+			want: want{
+				cr: function(withPublish(), withObservation(observation(false))),
+				input: &awscloudfront.UpdateFunctionInput{
+					Name:           aws.String(functionName),
+					IfMatch:        aws.String(eTag),
+					FunctionConfig: config(),
+					FunctionCode:   []byte(code),
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"PublishFailed": {
+			cr:         function(withPublish(), withObservation(observation(false))),
+			publishErr: errBoom,
+			want: want{
+				cr: function(withPublish(), withObservation(v1alpha1.FunctionObservation{
+					FunctionARN: functionARN,
+					Status:      "UNPUBLISHED",
+					ETag:        newETag,
+				})),
+				input: &awscloudfront.UpdateFunctionInput{
+					Name:           aws.String(functionName),
+					IfMatch:        aws.String(eTag),
+					FunctionConfig: config(),
+					FunctionCode:   []byte(code),
+				},
+				err: awsclient.Wrap(errBoom, errPublish),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awscloudfront.UpdateFunctionInput
+			published := false
+			e := &external{client: &fake.MockClient{
+				MockUpdateFunctionWithContext: func(_ context.Context, in *awscloudfront.UpdateFunctionInput, _ []request.Option) (*awscloudfront.UpdateFunctionOutput, error) {
+					input = in
+					if tc.updateErr != nil {
+						return nil, tc.updateErr
+					}
+					return &awscloudfront.UpdateFunctionOutput{ETag: aws.String(newETag)}, nil
+				},
+				MockPublishFunctionWithContext: func(_ context.Context, in *awscloudfront.PublishFunctionInput, _ []request.Option) (*awscloudfront.PublishFunctionOutput, error) {
+					if tc.publishErr != nil {
+						return nil, tc.publishErr
+					}
+					if aws.StringValue(in.IfMatch) != newETag {
+						return nil, errors.New("unexpected ETag")
+					}
+					published = true
+					return &awscloudfront.PublishFunctionOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.published, published); diff != "" {
+				t.Errorf("published: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Function
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Function
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteFunctionWithContext: func(_ context.Context, input *awscloudfront.DeleteFunctionInput, _ []request.Option) (*awscloudfront.DeleteFunctionOutput, error) {
+					if aws.StringValue(input.Name) != functionName || aws.StringValue(input.IfMatch) != eTag {
+						return nil, errors.New("unexpected function")
+					}
+					return &awscloudfront.DeleteFunctionOutput{}, nil
+				},
+			},
+			cr: function(withObservation(observation(false))),
+			want: want{
+				cr: function(withObservation(observation(false)), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteFunctionWithContext: func(context.Context, *awscloudfront.DeleteFunctionInput, []request.Option) (*awscloudfront.DeleteFunctionOutput, error) {
+					return nil, awserr.New(awscloudfront.ErrCodeNoSuchFunctionExists, "not found", nil)
+				},
+			},
+			cr: function(),
+			want: want{
+				cr: function(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteFunctionWithContext: func(context.Context, *awscloudfront.DeleteFunctionInput, []request.Option) (*awscloudfront.DeleteFunctionOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: function(),
+			want: want{
+				cr:  function(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
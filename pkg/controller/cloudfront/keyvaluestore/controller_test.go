@@ -0,0 +1,455 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyvaluestore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awscloudfront "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront/fake"
+)
+
+var (
+	kvsName = "example-kvs"
+	kvsID   = "8aa76c94-4ae1-4c8d-9ad5-3ec2e2c86e4b"
+	kvsARN  = "arn:aws:cloudfront::123456789012:key-value-store/" + kvsID
+	comment = "redirects for the example site"
+	eTag    = "ETVPDKIKX0DER"
+
+	errBoom = errors.New("boom")
+)
+
+type kvsModifier func(*v1alpha1.KeyValueStore)
+
+func withConditions(c ...xpv1.Condition) kvsModifier {
+	return func(r *v1alpha1.KeyValueStore) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withComment(c *string) kvsModifier {
+	return func(r *v1alpha1.KeyValueStore) { r.Spec.ForProvider.Comment = c }
+}
+
+func withImportSource(s *v1alpha1.ImportSource) kvsModifier {
+	return func(r *v1alpha1.KeyValueStore) { r.Spec.ForProvider.ImportSource = s }
+}
+
+func withETag(t string) kvsModifier {
+	return func(r *v1alpha1.KeyValueStore) { r.Status.AtProvider.ETag = t }
+}
+
+func withObservation(status string) kvsModifier {
+	return func(r *v1alpha1.KeyValueStore) {
+		r.Status.AtProvider = v1alpha1.KeyValueStoreObservation{
+			ARN:    kvsARN,
+			ID:     kvsID,
+			Status: status,
+			ETag:   eTag,
+		}
+	}
+}
+
+func kvs(m ...kvsModifier) *v1alpha1.KeyValueStore {
+	cr := &v1alpha1.KeyValueStore{
+		Spec: v1alpha1.KeyValueStoreSpec{
+			ForProvider: v1alpha1.KeyValueStoreParameters{
+				Region:  "us-east-1",
+				Comment: aws.String(comment),
+			},
+		},
+	}
+	meta.SetExternalName(cr, kvsName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.KeyValueStore
+		result managed.ExternalObservation
+		err    error
+	}
+
+	describe := func(status string, c *string) func(context.Context, *awscloudfront.DescribeKeyValueStoreInput, []request.Option) (*awscloudfront.DescribeKeyValueStoreOutput, error) {
+		return func(_ context.Context, input *awscloudfront.DescribeKeyValueStoreInput, _ []request.Option) (*awscloudfront.DescribeKeyValueStoreOutput, error) {
+			if aws.StringValue(input.Name) != kvsName {
+				return nil, errors.New("unexpected key value store")
+			}
+			return &awscloudfront.DescribeKeyValueStoreOutput{
+				ETag: aws.String(eTag),
+				KeyValueStore: &awscloudfront.KeyValueStore{
+					ARN:     aws.String(kvsARN),
+					Id:      aws.String(kvsID),
+					Name:    aws.String(kvsName),
+					Comment: c,
+					Status:  aws.String(status),
+				},
+			}, nil
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.KeyValueStore
+		want
+	}{
+		"Available": {
+			client: &fake.MockClient{
+				MockDescribeKeyValueStoreWithContext: describe(v1alpha1.KeyValueStoreStatusReady, aws.String(comment)),
+			},
+			cr: kvs(),
+			want: want{
+				cr: kvs(withObservation(v1alpha1.KeyValueStoreStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Provisioning": {
+			client: &fake.MockClient{
+				MockDescribeKeyValueStoreWithContext: describe(v1alpha1.KeyValueStoreStatusProvisioning, aws.String(comment)),
+			},
+			cr: kvs(),
+			want: want{
+				cr: kvs(withObservation(v1alpha1.KeyValueStoreStatusProvisioning), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Failed": {
+			client: &fake.MockClient{
+				MockDescribeKeyValueStoreWithContext: describe(v1alpha1.KeyValueStoreStatusFailed, aws.String(comment)),
+			},
+			cr: kvs(),
+			want: want{
+				cr: kvs(withObservation(v1alpha1.KeyValueStoreStatusFailed), withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NewComment": {
+			client: &fake.MockClient{
+				MockDescribeKeyValueStoreWithContext: describe(v1alpha1.KeyValueStoreStatusReady, nil),
+			},
+			cr: kvs(),
+			want: want{
+				cr: kvs(withObservation(v1alpha1.KeyValueStoreStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeKeyValueStoreWithContext: func(context.Context, *awscloudfront.DescribeKeyValueStoreInput, []request.Option) (*awscloudfront.DescribeKeyValueStoreOutput, error) {
+					return nil, awserr.New(awscloudfront.ErrCodeEntityNotFound, "not found", nil)
+				},
+			},
+			cr: kvs(),
+			want: want{
+				cr: kvs(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeKeyValueStoreWithContext: func(context.Context, *awscloudfront.DescribeKeyValueStoreInput, []request.Option) (*awscloudfront.DescribeKeyValueStoreOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: kvs(),
+			want: want{
+				cr:  kvs(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.KeyValueStore
+		input *awscloudfront.CreateKeyValueStoreInput
+		err   error
+	}
+
+	source := &v1alpha1.ImportSource{
+		SourceType: awscloudfront.ImportSourceTypeS3,
+		SourceARN:  "arn:aws:s3:::example-bucket/redirects.json",
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.KeyValueStore
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: kvs(),
+			want: want{
+				cr: kvs(withConditions(xpv1.Creating())),
+				input: &awscloudfront.CreateKeyValueStoreInput{
+					Name:    aws.String(kvsName),
+					Comment: aws.String(comment),
+				},
+			},
+		},
+		"WithImportSource": {
+			cr: kvs(withComment(nil), withImportSource(source)),
+			want: want{
+				cr: kvs(withComment(nil), withImportSource(source), withConditions(xpv1.Creating())),
+				input: &awscloudfront.CreateKeyValueStoreInput{
+					Name: aws.String(kvsName),
+					ImportSource: &awscloudfront.ImportSource{
+						SourceType: aws.String(awscloudfront.ImportSourceTypeS3),
+						SourceARN:  aws.String("arn:aws:s3:::example-bucket/redirects.json"),
+					},
+				},
+			},
+		},
+		"CreateFailed": {
+			cr:        kvs(),
+			createErr: errBoom,
+			want: want{
+				cr: kvs(withConditions(xpv1.Creating())),
+				input: &awscloudfront.CreateKeyValueStoreInput{
+					Name:    aws.String(kvsName),
+					Comment: aws.String(comment),
+				},
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awscloudfront.CreateKeyValueStoreInput
+			e := &external{client: &fake.MockClient{
+				MockCreateKeyValueStoreWithContext: func(_ context.Context, in *awscloudfront.CreateKeyValueStoreInput, _ []request.Option) (*awscloudfront.CreateKeyValueStoreOutput, error) {
+					input = in
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return &awscloudfront.CreateKeyValueStoreOutput{ETag: aws.String(eTag)}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.KeyValueStore
+		input *awscloudfront.UpdateKeyValueStoreInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.KeyValueStore
+		updateErr error
+		want
+	}{
+		"Successful": {
+			cr: kvs(withETag(eTag)),
+			want: want{
+				cr: kvs(withETag("E2QWRUHEXAMPLE")),
+				input: &awscloudfront.UpdateKeyValueStoreInput{
+					Name:    aws.String(kvsName),
+					IfMatch: aws.String(eTag),
+					Comment: aws.String(comment),
+				},
+			},
+		},
+		"RemovedComment": {
+			cr: kvs(withComment(nil), withETag(eTag)),
+			want: want{
+				cr: kvs(withComment(nil), withETag("E2QWRUHEXAMPLE")),
+				input: &awscloudfront.UpdateKeyValueStoreInput{
+					Name:    aws.String(kvsName),
+					IfMatch: aws.String(eTag),
+					Comment: aws.String(""),
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        kvs(withETag(eTag)),
+			updateErr: errBoom,
+			want: want{
+				cr: kvs(withETag(eTag)),
+				input: &awscloudfront.UpdateKeyValueStoreInput{
+					Name:    aws.String(kvsName),
+					IfMatch: aws.String(eTag),
+					Comment: aws.String(comment),
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awscloudfront.UpdateKeyValueStoreInput
+			e := &external{client: &fake.MockClient{
+				MockUpdateKeyValueStoreWithContext: func(_ context.Context, in *awscloudfront.UpdateKeyValueStoreInput, _ []request.Option) (*awscloudfront.UpdateKeyValueStoreOutput, error) {
+					input = in
+					if tc.updateErr != nil {
+						return nil, tc.updateErr
+					}
+					return &awscloudfront.UpdateKeyValueStoreOutput{ETag: aws.String("E2QWRUHEXAMPLE")}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.KeyValueStore
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.KeyValueStore
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteKeyValueStoreWithContext: func(_ context.Context, input *awscloudfront.DeleteKeyValueStoreInput, _ []request.Option) (*awscloudfront.DeleteKeyValueStoreOutput, error) {
+					if aws.StringValue(input.Name) != kvsName || aws.StringValue(input.IfMatch) != eTag {
+						return nil, errors.New("unexpected key value store")
+					}
+					return &awscloudfront.DeleteKeyValueStoreOutput{}, nil
+				},
+			},
+			cr: kvs(withETag(eTag)),
+			want: want{
+				cr: kvs(withETag(eTag), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteKeyValueStoreWithContext: func(context.Context, *awscloudfront.DeleteKeyValueStoreInput, []request.Option) (*awscloudfront.DeleteKeyValueStoreOutput, error) {
+					return nil, awserr.New(awscloudfront.ErrCodeEntityNotFound, "not found", nil)
+				},
+			},
+			cr: kvs(),
+			want: want{
+				cr: kvs(withConditions(xpv1.Deleting())),
+			},
+		},
+		"StillAssociated": {
+			client: &fake.MockClient{
+				MockDeleteKeyValueStoreWithContext: func(context.Context, *awscloudfront.DeleteKeyValueStoreInput, []request.Option) (*awscloudfront.DeleteKeyValueStoreOutput, error) {
+					return nil, awserr.New(awscloudfront.ErrCodeCannotDeleteEntityWhileInUse, "in use", nil)
+				},
+			},
+			cr: kvs(),
+			want: want{
+				cr:  kvs(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(awserr.New(awscloudfront.ErrCodeCannotDeleteEntityWhileInUse, "in use", nil), errDelete),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteKeyValueStoreWithContext: func(context.Context, *awscloudfront.DeleteKeyValueStoreInput, []request.Option) (*awscloudfront.DeleteKeyValueStoreOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: kvs(),
+			want: want{
+				cr:  kvs(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package originaccesscontrol
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscloudfront "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a CloudFront OriginAccessControl resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the CloudFront origin access control"
+	errCreate           = "failed to create the CloudFront origin access control"
+	errUpdate           = "failed to update the CloudFront origin access control"
+	errDelete           = "failed to delete the CloudFront origin access control"
+)
+
+// SetupOriginAccessControl adds a controller that reconciles CloudFront
+// origin access controls.
+func SetupOriginAccessControl(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.OriginAccessControlGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.OriginAccessControl{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OriginAccessControlGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: cloudfront.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			// The external name is the identifier CloudFront assigns on
+			// creation, so it must not default to the name of the object.
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) cloudfront.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OriginAccessControl)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client cloudfront.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.OriginAccessControl)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := e.client.GetOriginAccessControlWithContext(ctx, &awscloudfront.GetOriginAccessControlInput{
+		Id: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errGet)
+	}
+
+	cr.Status.AtProvider.ETag = aws.StringValue(resp.ETag)
+	cr.SetConditions(xpv1.Available())
+
+	var cfg *awscloudfront.OriginAccessControlConfig
+	if resp.OriginAccessControl != nil {
+		cfg = resp.OriginAccessControl.OriginAccessControlConfig
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cloudfront.IsOriginAccessControlUpToDate(cr.Spec.ForProvider, cfg),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.OriginAccessControl)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	resp, err := e.client.CreateOriginAccessControlWithContext(ctx, &awscloudfront.CreateOriginAccessControlInput{
+		OriginAccessControlConfig: cloudfront.GenerateOriginAccessControlConfig(cr.Spec.ForProvider),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(resp.OriginAccessControl.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.OriginAccessControl)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.UpdateOriginAccessControlWithContext(ctx, &awscloudfront.UpdateOriginAccessControlInput{
+		Id:                        aws.String(meta.GetExternalName(cr)),
+		IfMatch:                   aws.String(cr.Status.AtProvider.ETag),
+		OriginAccessControlConfig: cloudfront.GenerateOriginAccessControlConfig(cr.Spec.ForProvider),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	cr.Status.AtProvider.ETag = aws.StringValue(resp.ETag)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.OriginAccessControl)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// CloudFront refuses to delete an origin access control that is still
+	// used by a distribution, so deletion is retried until it is released.
+	_, err := e.client.DeleteOriginAccessControlWithContext(ctx, &awscloudfront.DeleteOriginAccessControlInput{
+		Id:      aws.String(meta.GetExternalName(cr)),
+		IfMatch: aws.String(cr.Status.AtProvider.ETag),
+	})
+	return awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package originaccesscontrol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awscloudfront "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront/fake"
+)
+
+var (
+	oacID   = "E2QWRUHAPOMQZL"
+	oacName = "example-bucket"
+	eTag    = "E1PA6795UKMFR9"
+
+	errBoom = errors.New("boom")
+)
+
+type oacModifier func(*v1alpha1.OriginAccessControl)
+
+func withExternalName(n string) oacModifier {
+	return func(r *v1alpha1.OriginAccessControl) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) oacModifier {
+	return func(r *v1alpha1.OriginAccessControl) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSigningBehavior(b string) oacModifier {
+	return func(r *v1alpha1.OriginAccessControl) { r.Spec.ForProvider.SigningBehavior = b }
+}
+
+func withETag(t string) oacModifier {
+	return func(r *v1alpha1.OriginAccessControl) { r.Status.AtProvider.ETag = t }
+}
+
+func oac(m ...oacModifier) *v1alpha1.OriginAccessControl {
+	cr := &v1alpha1.OriginAccessControl{
+		Spec: v1alpha1.OriginAccessControlSpec{
+			ForProvider: v1alpha1.OriginAccessControlParameters{
+				Region:          "us-east-1",
+				Name:            oacName,
+				Description:     aws.String("signs requests to the example bucket"),
+				OriginType:      awscloudfront.OriginAccessControlOriginTypesS3,
+				SigningBehavior: awscloudfront.OriginAccessControlSigningBehaviorsAlways,
+				SigningProtocol: awscloudfront.OriginAccessControlSigningProtocolsSigv4,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func config(behavior string) *awscloudfront.OriginAccessControlConfig {
+	return &awscloudfront.OriginAccessControlConfig{
+		Name:                          aws.String(oacName),
+		Description:                   aws.String("signs requests to the example bucket"),
+		OriginAccessControlOriginType: aws.String(awscloudfront.OriginAccessControlOriginTypesS3),
+		SigningBehavior:               aws.String(behavior),
+		SigningProtocol:               aws.String(awscloudfront.OriginAccessControlSigningProtocolsSigv4),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.OriginAccessControl
+		result managed.ExternalObservation
+		err    error
+	}
+
+	get := func(c *awscloudfront.OriginAccessControlConfig) func(context.Context, *awscloudfront.GetOriginAccessControlInput, []request.Option) (*awscloudfront.GetOriginAccessControlOutput, error) {
+		return func(_ context.Context, input *awscloudfront.GetOriginAccessControlInput, _ []request.Option) (*awscloudfront.GetOriginAccessControlOutput, error) {
+			if aws.StringValue(input.Id) != oacID {
+				return nil, errors.New("unexpected origin access control")
+			}
+			return &awscloudfront.GetOriginAccessControlOutput{
+				ETag:                aws.String(eTag),
+				OriginAccessControl: &awscloudfront.OriginAccessControl{Id: aws.String(oacID), OriginAccessControlConfig: c},
+			}, nil
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.OriginAccessControl
+		want
+	}{
+		"NoExternalName": {
+			client: &fake.MockClient{},
+			cr:     oac(),
+			want: want{
+				cr: oac(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockGetOriginAccessControlWithContext: get(config(awscloudfront.OriginAccessControlSigningBehaviorsAlways)),
+			},
+			cr: oac(withExternalName(oacID)),
+			want: want{
+				cr: oac(withExternalName(oacID), withETag(eTag), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NewSigningBehavior": {
+			client: &fake.MockClient{
+				MockGetOriginAccessControlWithContext: get(config(awscloudfront.OriginAccessControlSigningBehaviorsAlways)),
+			},
+			cr: oac(withExternalName(oacID), withSigningBehavior(awscloudfront.OriginAccessControlSigningBehaviorsNoOverride)),
+			want: want{
+				cr: oac(withExternalName(oacID), withSigningBehavior(awscloudfront.OriginAccessControlSigningBehaviorsNoOverride),
+					withETag(eTag), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetOriginAccessControlWithContext: func(context.Context, *awscloudfront.GetOriginAccessControlInput, []request.Option) (*awscloudfront.GetOriginAccessControlOutput, error) {
+					return nil, awserr.New(awscloudfront.ErrCodeNoSuchOriginAccessControl, "not found", nil)
+				},
+			},
+			cr: oac(withExternalName(oacID)),
+			want: want{
+				cr: oac(withExternalName(oacID)),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetOriginAccessControlWithContext: func(context.Context, *awscloudfront.GetOriginAccessControlInput, []request.Option) (*awscloudfront.GetOriginAccessControlOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: oac(withExternalName(oacID)),
+			want: want{
+				cr:  oac(withExternalName(oacID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.OriginAccessControl
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.OriginAccessControl
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockCreateOriginAccessControlWithContext: func(_ context.Context, input *awscloudfront.CreateOriginAccessControlInput, _ []request.Option) (*awscloudfront.CreateOriginAccessControlOutput, error) {
+					if diff := cmp.Diff(config(awscloudfront.OriginAccessControlSigningBehaviorsAlways), input.OriginAccessControlConfig); diff != "" {
+						return nil, errors.New(diff)
+					}
+					return &awscloudfront.CreateOriginAccessControlOutput{
+						ETag:                aws.String(eTag),
+						OriginAccessControl: &awscloudfront.OriginAccessControl{Id: aws.String(oacID)},
+					}, nil
+				},
+			},
+			cr: oac(),
+			want: want{
+				cr:     oac(withExternalName(oacID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockClient{
+				MockCreateOriginAccessControlWithContext: func(context.Context, *awscloudfront.CreateOriginAccessControlInput, []request.Option) (*awscloudfront.CreateOriginAccessControlOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: oac(),
+			want: want{
+				cr:  oac(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.OriginAccessControl
+		input *awscloudfront.UpdateOriginAccessControlInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.OriginAccessControl
+		updateErr error
+		want
+	}{
+		"Successful": {
+			cr: oac(withExternalName(oacID), withSigningBehavior(awscloudfront.OriginAccessControlSigningBehaviorsNever), withETag(eTag)),
+			want: want{
+				cr: oac(withExternalName(oacID), withSigningBehavior(awscloudfront.OriginAccessControlSigningBehaviorsNever), withETag("E3UN6WX5RRO2AG")),
+				input: &awscloudfront.UpdateOriginAccessControlInput{
+					Id:                        aws.String(oacID),
+					IfMatch:                   aws.String(eTag),
+					OriginAccessControlConfig: config(awscloudfront.OriginAccessControlSigningBehaviorsNever),
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        oac(withExternalName(oacID), withETag(eTag)),
+			updateErr: errBoom,
+			want: want{
+				cr: oac(withExternalName(oacID), withETag(eTag)),
+				input: &awscloudfront.UpdateOriginAccessControlInput{
+					Id:                        aws.String(oacID),
+					IfMatch:                   aws.String(eTag),
+					OriginAccessControlConfig: config(awscloudfront.OriginAccessControlSigningBehaviorsAlways),
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awscloudfront.UpdateOriginAccessControlInput
+			e := &external{client: &fake.MockClient{
+				MockUpdateOriginAccessControlWithContext: func(_ context.Context, in *awscloudfront.UpdateOriginAccessControlInput, _ []request.Option) (*awscloudfront.UpdateOriginAccessControlOutput, error) {
+					input = in
+					if tc.updateErr != nil {
+						return nil, tc.updateErr
+					}
+					return &awscloudfront.UpdateOriginAccessControlOutput{ETag: aws.String("E3UN6WX5RRO2AG")}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.OriginAccessControl
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.OriginAccessControl
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteOriginAccessControlWithContext: func(_ context.Context, input *awscloudfront.DeleteOriginAccessControlInput, _ []request.Option) (*awscloudfront.DeleteOriginAccessControlOutput, error) {
+					if aws.StringValue(input.Id) != oacID || aws.StringValue(input.IfMatch) != eTag {
+						return nil, errors.New("unexpected origin access control")
+					}
+					return &awscloudfront.DeleteOriginAccessControlOutput{}, nil
+				},
+			},
+			cr: oac(withExternalName(oacID), withETag(eTag)),
+			want: want{
+				cr: oac(withExternalName(oacID), withETag(eTag), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteOriginAccessControlWithContext: func(context.Context, *awscloudfront.DeleteOriginAccessControlInput, []request.Option) (*awscloudfront.DeleteOriginAccessControlOutput, error) {
+					return nil, awserr.New(awscloudfront.ErrCodeNoSuchOriginAccessControl, "not found", nil)
+				},
+			},
+			cr: oac(withExternalName(oacID)),
+			want: want{
+				cr: oac(withExternalName(oacID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteOriginAccessControlWithContext: func(context.Context, *awscloudfront.DeleteOriginAccessControlInput, []request.Option) (*awscloudfront.DeleteOriginAccessControlOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: oac(withExternalName(oacID)),
+			want: want{
+				cr:  oac(withExternalName(oacID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
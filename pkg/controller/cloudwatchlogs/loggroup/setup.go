@@ -64,8 +64,9 @@ func SetupLogGroup(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLim
 		For(&svcapitypes.LogGroup{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.LogGroupGroupVersionKind),
-			managed.WithInitializers(),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(awsclients.NewTagger(mgr.GetClient())),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errUnknownKind        = "unknown kind %q, kinds are written like SecurityGroup.ec2.aws.crossplane.io"
+	errInvalidConcurrency = "invalid number of concurrent reconciles %q for kind %q"
+)
+
+// GroupKindConcurrency returns the number of concurrent reconciles of each
+// managed resource kind in the supplied scheme, keyed by its group kind, e.g.
+// SecurityGroup.ec2.aws.crossplane.io. Kinds that are not in perKind use the
+// default.
+func GroupKindConcurrency(s *runtime.Scheme, def int, perKind map[string]string) (map[string]int, error) {
+	c := map[string]int{}
+	for gvk, t := range s.AllKnownTypes() {
+		if _, ok := reflect.New(t).Interface().(resource.Managed); ok {
+			c[gvk.GroupKind().String()] = def
+		}
+	}
+	// Sorted so that the first invalid kind is always reported.
+	kinds := make([]string, 0, len(perKind))
+	for k := range perKind {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	for _, k := range kinds {
+		if _, ok := c[k]; !ok {
+			return nil, errors.Errorf(errUnknownKind, k)
+		}
+		n, err := strconv.Atoi(perKind[k])
+		if err != nil || n < 1 {
+			return nil, errors.Errorf(errInvalidConcurrency, perKind[k], k)
+		}
+		c[k] = n
+	}
+	return c, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	mqv1alpha1 "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+)
+
+func TestGroupKindConcurrency(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := mqv1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		c   map[string]int
+		err error
+	}
+	cases := map[string]struct {
+		perKind map[string]string
+		want    want
+	}{
+		"Default": {
+			want: want{c: map[string]int{"Broker.mq.aws.crossplane.io": 2, "User.mq.aws.crossplane.io": 2}},
+		},
+		"PerKind": {
+			perKind: map[string]string{"User.mq.aws.crossplane.io": "10"},
+			want:    want{c: map[string]int{"Broker.mq.aws.crossplane.io": 2, "User.mq.aws.crossplane.io": 10}},
+		},
+		"UnknownKind": {
+			perKind: map[string]string{"User.iam.aws.crossplane.io": "10"},
+			want:    want{err: errors.Errorf(errUnknownKind, "User.iam.aws.crossplane.io")},
+		},
+		"InvalidConcurrency": {
+			perKind: map[string]string{"User.mq.aws.crossplane.io": "0"},
+			want:    want{err: errors.Errorf(errInvalidConcurrency, "0", "User.mq.aws.crossplane.io")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := GroupKindConcurrency(s, 2, tc.perKind)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GroupKindConcurrency(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, c); diff != "" {
+				t.Errorf("GroupKindConcurrency(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sts"
+)
+
+const (
+	// credentialsCheckInterval is how long a successful credentials check
+	// is trusted before STS is called again.
+	credentialsCheckInterval = 5 * time.Minute
+
+	errGetConfig         = "cannot get AWS config for ProviderConfig"
+	errUpdatePCStatus    = "cannot update ProviderConfig status"
+	errCredentialsCheck  = "credentials of ProviderConfig are invalid"
+	credentialsCheckName = "credentials"
+)
+
+// SetupCredentialsCheck adds a readiness check to the manager that fails until
+// the credentials of the named ProviderConfig can be used to call STS
+// GetCallerIdentity. The identity they resolve to is reported in the status of
+// the ProviderConfig.
+func SetupCredentialsCheck(mgr ctrl.Manager, l logging.Logger, providerConfig, region string) error {
+	c := &credentialsCheck{
+		kube:        mgr.GetClient(),
+		log:         l.WithValues("check", credentialsCheckName, "providerconfig", providerConfig),
+		name:        providerConfig,
+		region:      region,
+		newClientFn: sts.NewClient,
+		interval:    credentialsCheckInterval,
+	}
+	return mgr.AddReadyzCheck(credentialsCheckName, c.Check)
+}
+
+type credentialsCheck struct {
+	kube        client.Client
+	log         logging.Logger
+	name        string
+	region      string
+	newClientFn func(aws.Config) sts.Client
+	interval    time.Duration
+
+	mu          sync.Mutex
+	lastSuccess time.Time
+}
+
+// Check satisfies healthz.Checker.
+func (c *credentialsCheck) Check(req *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.lastSuccess) < c.interval {
+		return nil
+	}
+	if err := c.check(req.Context()); err != nil {
+		c.log.Info("Credentials check failed", "error", err)
+		return errors.Wrap(err, errCredentialsCheck)
+	}
+	c.lastSuccess = time.Now()
+	return nil
+}
+
+func (c *credentialsCheck) check(ctx context.Context) error {
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: c.name}, pc); err != nil {
+		return errors.Wrap(err, errGetPC)
+	}
+	cfg, err := awsclient.UseProviderConfigCredentials(ctx, c.kube, pc, c.region)
+	if err != nil {
+		return errors.Wrap(err, errGetConfig)
+	}
+	id, err := sts.GetCallerIdentity(ctx, c.newClientFn(*cfg))
+	if err != nil {
+		return err
+	}
+	if pc.Status.CallerIdentity != nil && *pc.Status.CallerIdentity == *id {
+		return nil
+	}
+	pc.Status.CallerIdentity = id
+	return errors.Wrap(c.kube.Status().Update(ctx, pc), errUpdatePCStatus)
+}
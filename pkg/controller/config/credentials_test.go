@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/sts"
+	"github.com/crossplane/provider-aws/pkg/clients/sts/fake"
+)
+
+var credentials = []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n")
+
+// withProviderConfig returns a MockGetFn that finds a ProviderConfig that
+// reads its credentials from a Secret, and that Secret.
+func withProviderConfig(id *v1beta1.CallerIdentity) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *v1beta1.ProviderConfig:
+			o.SetName(pcName)
+			o.Spec.Credentials = v1beta1.ProviderCredentials{
+				Source: xpv1.CredentialsSourceSecret,
+				CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "crossplane-system"},
+						Key:             "credentials",
+					},
+				},
+			}
+			o.Status.CallerIdentity = id
+			return nil
+		case *corev1.Secret:
+			o.Data = map[string][]byte{"credentials": credentials}
+			return nil
+		}
+		return errBoom
+	}
+}
+
+func TestCredentialsCheck(t *testing.T) {
+	identity := &v1beta1.CallerIdentity{Account: "123456789012", ARN: "arn:aws:iam::123456789012:role/crossplane"}
+
+	type args struct {
+		get         test.MockGetFn
+		sts         sts.Client
+		lastSuccess time.Time
+	}
+	type want struct {
+		err     error
+		updated *v1beta1.CallerIdentity
+		calls   int
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Valid": {
+			args: args{
+				get: withProviderConfig(nil),
+				sts: &fake.MockSTSClient{
+					MockGetCallerIdentity: func(_ context.Context, _ *awssts.GetCallerIdentityInput, _ []func(*awssts.Options)) (*awssts.GetCallerIdentityOutput, error) {
+						return &awssts.GetCallerIdentityOutput{Account: aws.String(identity.Account), Arn: aws.String(identity.ARN)}, nil
+					},
+				},
+			},
+			want: want{updated: identity, calls: 1},
+		},
+		"IdentityUnchanged": {
+			args: args{
+				get: withProviderConfig(identity),
+				sts: &fake.MockSTSClient{
+					MockGetCallerIdentity: func(_ context.Context, _ *awssts.GetCallerIdentityInput, _ []func(*awssts.Options)) (*awssts.GetCallerIdentityOutput, error) {
+						return &awssts.GetCallerIdentityOutput{Account: aws.String(identity.Account), Arn: aws.String(identity.ARN)}, nil
+					},
+				},
+			},
+			want: want{calls: 1},
+		},
+		"RecentlyChecked": {
+			args: args{
+				get:         withProviderConfig(nil),
+				lastSuccess: time.Now(),
+			},
+		},
+		"Invalid": {
+			args: args{
+				get: withProviderConfig(nil),
+				sts: &fake.MockSTSClient{
+					MockGetCallerIdentity: func(_ context.Context, _ *awssts.GetCallerIdentityInput, _ []func(*awssts.Options)) (*awssts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			want: want{
+				err:   errors.Wrap(errors.Wrap(errBoom, "cannot get caller identity"), errCredentialsCheck),
+				calls: 1,
+			},
+		},
+		"ProviderConfigMissing": {
+			args: args{
+				get: test.NewMockGetFn(errBoom),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errGetPC), errCredentialsCheck),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated *v1beta1.CallerIdentity
+			calls := 0
+			c := &credentialsCheck{
+				kube: &test.MockClient{
+					MockGet: tc.args.get,
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						updated = obj.(*v1beta1.ProviderConfig).Status.CallerIdentity
+						return nil
+					},
+				},
+				log:  logging.NewNopLogger(),
+				name: pcName,
+				newClientFn: func(_ aws.Config) sts.Client {
+					calls++
+					return tc.args.sts
+				},
+				interval:    credentialsCheckInterval,
+				lastSuccess: tc.args.lastSuccess,
+			}
+			err := c.Check(httptest.NewRequest("GET", "/readyz", nil))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("updated: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	// usageGCInterval is how often the usages of a ProviderConfig are
+	// checked for staleness. Deleting a managed resource does not trigger
+	// this check, so it has to happen periodically.
+	usageGCInterval = 5 * time.Minute
+
+	errGetPC          = "cannot get ProviderConfig"
+	errListPCUs       = "cannot list ProviderConfigUsages"
+	errGetUsingObject = "cannot get the resource referenced by ProviderConfigUsage"
+	errDeletePCU      = "cannot delete stale ProviderConfigUsage"
+)
+
+// ResourcesPerProviderConfig is the number of managed resources that use a
+// ProviderConfig, as counted by their ProviderConfigUsages.
+var ResourcesPerProviderConfig = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "crossplane_provider_aws_providerconfig_resources",
+	Help: "Number of managed resources using a ProviderConfig.",
+}, []string{"providerconfig"})
+
+func init() {
+	metrics.Registry.MustRegister(ResourcesPerProviderConfig)
+}
+
+// SetupUsageGarbageCollector adds a controller that deletes ProviderConfigUsages
+// whose managed resource is gone, which would otherwise block the deletion of
+// their ProviderConfig forever, and exports the number of resources using
+// each ProviderConfig.
+func SetupUsageGarbageCollector(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := "usagegc/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+
+	r := &usageGarbageCollector{
+		client:   mgr.GetClient(),
+		log:      l.WithValues("controller", name),
+		gauge:    ResourcesPerProviderConfig,
+		interval: usageGCInterval,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1beta1.ProviderConfig{}).
+		Complete(r)
+}
+
+type usageGarbageCollector struct {
+	client   client.Client
+	log      logging.Logger
+	gauge    *prometheus.GaugeVec
+	interval time.Duration
+}
+
+func (r *usageGarbageCollector) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		if kerrors.IsNotFound(err) {
+			r.gauge.DeleteLabelValues(req.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, errGetPC)
+	}
+
+	l := &v1beta1.ProviderConfigUsageList{}
+	if err := r.client.List(ctx, l, client.MatchingLabels{xpv1.LabelKeyProviderName: pc.GetName()}); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errListPCUs)
+	}
+
+	inUse := 0
+	for i := range l.Items {
+		pcu := &l.Items[i]
+		stale, err := r.isStale(ctx, pcu)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !stale {
+			inUse++
+			continue
+		}
+		log.Debug("Deleting stale ProviderConfigUsage", "usage", pcu.GetName(), "resource", pcu.ResourceReference)
+		if err := r.client.Delete(ctx, pcu); resource.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, errors.Wrap(err, errDeletePCU)
+		}
+	}
+
+	r.gauge.WithLabelValues(pc.GetName()).Set(float64(inUse))
+	return reconcile.Result{RequeueAfter: r.interval}, nil
+}
+
+// isStale returns true if the supplied usage no longer accounts for a managed
+// resource that uses its ProviderConfig.
+func (r *usageGarbageCollector) isStale(ctx context.Context, pcu *v1beta1.ProviderConfigUsage) (bool, error) {
+	ref := pcu.ResourceReference
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(ref.APIVersion)
+	u.SetKind(ref.Kind)
+	err := r.client.Get(ctx, types.NamespacedName{Name: ref.Name}, u)
+	switch {
+	// The resource is gone, or its kind is not served anymore because its
+	// CRD was removed.
+	case kerrors.IsNotFound(err), kmeta.IsNoMatchError(err):
+		return true, nil
+	case err != nil:
+		return false, errors.Wrap(err, errGetUsingObject)
+	}
+
+	// A resource with the same name was created after the one this usage
+	// was created for was deleted.
+	if c := metav1.GetControllerOf(pcu); c != nil && c.UID != u.GetUID() {
+		return true, nil
+	}
+
+	// The resource now uses another ProviderConfig.
+	name, _, _ := unstructured.NestedString(u.Object, "spec", "providerConfigRef", "name")
+	return name != "" && name != pcu.ProviderConfigReference.Name, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	pcName = "default"
+	mrName = "cool-vpc"
+	mrUID  = types.UID("cool-uid")
+)
+
+var errBoom = errors.New("boom")
+
+func usage(name string, owner types.UID) v1beta1.ProviderConfigUsage {
+	pcu := v1beta1.ProviderConfigUsage{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		ProviderConfigUsage: xpv1.ProviderConfigUsage{
+			ProviderConfigReference: xpv1.Reference{Name: pcName},
+			ResourceReference: xpv1.TypedReference{
+				APIVersion: "ec2.aws.crossplane.io/v1beta1",
+				Kind:       "VPC",
+				Name:       mrName,
+			},
+		},
+	}
+	ctrl := true
+	pcu.SetOwnerReferences([]metav1.OwnerReference{{UID: owner, Controller: &ctrl}})
+	return pcu
+}
+
+// withResource returns a MockGetFn that finds the ProviderConfig and, if
+// providerConfig is not empty, a managed resource using it.
+func withResource(providerConfig string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *v1beta1.ProviderConfig:
+			o.SetName(pcName)
+			return nil
+		case *unstructured.Unstructured:
+			if providerConfig == "" {
+				return kerrors.NewNotFound(schema.GroupResource{}, mrName)
+			}
+			o.SetUID(mrUID)
+			return unstructured.SetNestedField(o.Object, providerConfig, "spec", "providerConfigRef", "name")
+		}
+		return errBoom
+	}
+}
+
+func withUsages(items ...v1beta1.ProviderConfigUsage) test.MockListFn {
+	return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*v1beta1.ProviderConfigUsageList).Items = items
+		return nil
+	}
+}
+
+func TestUsageGarbageCollector(t *testing.T) {
+	type args struct {
+		get  test.MockGetFn
+		list test.MockListFn
+	}
+	type want struct {
+		result  reconcile.Result
+		err     error
+		deleted []string
+		gauge   float64
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"InUse": {
+			args: args{
+				get:  withResource(pcName),
+				list: withUsages(usage("a", mrUID)),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: usageGCInterval},
+				gauge:  1,
+			},
+		},
+		"ResourceGone": {
+			args: args{
+				get:  withResource(""),
+				list: withUsages(usage("a", mrUID)),
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: usageGCInterval},
+				deleted: []string{"a"},
+			},
+		},
+		"ResourceRecreated": {
+			args: args{
+				get:  withResource(pcName),
+				list: withUsages(usage("old", "old-uid"), usage("a", mrUID)),
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: usageGCInterval},
+				deleted: []string{"old"},
+				gauge:   1,
+			},
+		},
+		"ResourceMovedToAnotherProviderConfig": {
+			args: args{
+				get:  withResource("other"),
+				list: withUsages(usage("a", mrUID)),
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: usageGCInterval},
+				deleted: []string{"a"},
+			},
+		},
+		"ListFailed": {
+			args: args{
+				get: withResource(pcName),
+				list: func(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+					return errBoom
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errListPCUs),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test"}, []string{"providerconfig"})
+			r := &usageGarbageCollector{
+				client: &test.MockClient{
+					MockGet:  tc.args.get,
+					MockList: tc.args.list,
+					MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+						deleted = append(deleted, obj.GetName())
+						return nil
+					},
+				},
+				log:      logging.NewNopLogger(),
+				gauge:    gauge,
+				interval: usageGCInterval,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: pcName}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.gauge, testutil.ToFloat64(gauge.WithLabelValues(pcName))); diff != "" {
+				t.Errorf("gauge: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
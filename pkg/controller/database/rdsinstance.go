@@ -73,8 +73,9 @@ func SetupRDSInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		For(&v1beta1.RDSInstance{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RDSInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}),
-			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &defaulter{kube: mgr.GetClient()}, managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -292,6 +293,39 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(rds.IsErrorNotFound, err), errDeleteFailed)
 }
 
+// defaulter fills in the port and parameter group of RDSInstances from their
+// engine and makes them private unless told otherwise. RDSInstances that
+// already have an external name are left alone, since they may be adopting an
+// existing instance.
+type defaulter struct {
+	kube client.Client
+}
+
+func (d *defaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.RDSInstance)
+	if !ok {
+		return errors.New(errNotRDSInstance)
+	}
+	if meta.GetExternalName(cr) != "" {
+		return nil
+	}
+	p := &cr.Spec.ForProvider
+	if p.Port == nil && !rds.IsAuroraEngine(p.Engine) {
+		if port := rds.DefaultPort(p.Engine); port != nil {
+			p.Port = awsclient.IntAddress(port)
+		}
+	}
+	if p.DBParameterGroupName == nil {
+		if n := rds.DefaultParameterGroupName(p.Engine, awsclient.StringValue(p.EngineVersion)); n != "" {
+			p.DBParameterGroupName = awsclient.String(n)
+		}
+	}
+	if p.PubliclyAccessible == nil {
+		p.PubliclyAccessible = awsclient.Bool(false, awsclient.FieldRequired)
+	}
+	return errors.Wrap(d.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
 type tagger struct {
 	kube client.Client
 }
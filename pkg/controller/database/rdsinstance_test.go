@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
@@ -125,6 +126,26 @@ func withPasswordSecretRef(s xpv1.SecretKeySelector) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MasterPasswordSecretRef = &s }
 }
 
+func withEngine(s string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.Engine = s }
+}
+
+func withPort(i int) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.Port = &i }
+}
+
+func withDBParameterGroupName(s string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.DBParameterGroupName = &s }
+}
+
+func withPubliclyAccessible(b bool) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.PubliclyAccessible = &b }
+}
+
+func withExternalName(s string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { meta.SetExternalName(r, s) }
+}
+
 func instance(m ...rdsModifier) *v1beta1.RDSInstance {
 	cr := &v1beta1.RDSInstance{}
 	for _, f := range m {
@@ -806,3 +827,78 @@ func TestInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaulter(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.RDSInstance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Defaulted": {
+			args: args{
+				cr:   instance(withEngine("postgres"), withEngineVersion(aws.String("13.4"))),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: instance(withEngine("postgres"), withEngineVersion(aws.String("13.4")),
+					withPort(5432), withDBParameterGroupName("default.postgres13"), withPubliclyAccessible(false)),
+			},
+		},
+		"AuroraPortNotDefaulted": {
+			args: args{
+				cr:   instance(withEngine("aurora-mysql")),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: instance(withEngine("aurora-mysql"), withPubliclyAccessible(false)),
+			},
+		},
+		"ExplicitValuesKept": {
+			args: args{
+				cr: instance(withEngine("mysql"), withEngineVersion(aws.String("8.0.25")),
+					withPort(3307), withDBParameterGroupName("custom"), withPubliclyAccessible(true)),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: instance(withEngine("mysql"), withEngineVersion(aws.String("8.0.25")),
+					withPort(3307), withDBParameterGroupName("custom"), withPubliclyAccessible(true)),
+			},
+		},
+		"ExistingExternalName": {
+			args: args{
+				cr:   instance(withEngine("mysql"), withExternalName("adopted")),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				cr: instance(withEngine("mysql"), withExternalName("adopted")),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr:   instance(withEngine("mysql")),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := &defaulter{kube: tc.kube}
+			err := d.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsdax "github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dax"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a DAX Cluster resource"
+	errCreateSession     = "cannot create a new session"
+	errDescribe          = "failed to describe the DAX cluster"
+	errCreate            = "failed to create the DAX cluster"
+	errUpdate            = "failed to update the DAX cluster"
+	errReplicationFactor = "failed to change the replication factor of the DAX cluster"
+	errDelete            = "failed to delete the DAX cluster"
+)
+
+// SetupCluster adds a controller that reconciles DAX clusters.
+func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ClusterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Cluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: dax.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewTagger(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) dax.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client dax.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Cluster) (*awsdax.Cluster, error) {
+	resp, err := e.client.DescribeClustersWithContext(ctx, &awsdax.DescribeClustersInput{
+		ClusterNames: []*string{aws.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Clusters) == 0 {
+		return nil, nil
+	}
+	return resp.Clusters[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(dax.IsClusterNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	dax.LateInitializeCluster(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = dax.GenerateClusterObservation(observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.ClusterStatusAvailable:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.ClusterStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.ClusterStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	obs := managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}
+	if ep := observed.ClusterDiscoveryEndpoint; ep != nil {
+		obs.ConnectionDetails = managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(ep.Address)),
+			xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(aws.Int64Value(ep.Port), 10)),
+		}
+	}
+
+	// A cluster can only be modified once it is available, so it is
+	// considered up to date while it is being created or modified.
+	if cr.Status.AtProvider.Status != v1alpha1.ClusterStatusAvailable {
+		return obs, nil
+	}
+	tags, err := dax.ListTags(ctx, e.client, cr.Status.AtProvider.ClusterARN)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	obs.ResourceUpToDate = dax.IsClusterUpToDate(cr.Spec.ForProvider, observed) &&
+		cmp.Equal(cr.Spec.ForProvider.Tags, tags, cmpopts.EquateEmpty())
+	return obs, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateClusterWithContext(ctx, dax.GenerateCreateClusterInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	name := meta.GetExternalName(cr)
+
+	// Adding or removing nodes puts the cluster in the modifying state, so
+	// the remaining changes are made once it is available again.
+	if !dax.IsReplicationFactorUpToDate(cr.Spec.ForProvider, observed) {
+		return managed.ExternalUpdate{}, awsclient.Wrap(e.changeReplicationFactor(ctx, name, cr.Spec.ForProvider, observed), errReplicationFactor)
+	}
+
+	if !dax.IsClusterSettingsUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.UpdateClusterWithContext(ctx, dax.GenerateUpdateClusterInput(name, cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	arn := aws.StringValue(observed.ClusterArn)
+	tags, err := dax.ListTags(ctx, e.client, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, dax.UpdateTags(ctx, e.client, arn, cr.Spec.ForProvider.Tags, tags)
+}
+
+func (e *external) changeReplicationFactor(ctx context.Context, name string, p v1alpha1.ClusterParameters, c *awsdax.Cluster) error {
+	if p.ReplicationFactor > aws.Int64Value(c.TotalNodes) {
+		_, err := e.client.IncreaseReplicationFactorWithContext(ctx, &awsdax.IncreaseReplicationFactorInput{
+			ClusterName:          aws.String(name),
+			NewReplicationFactor: aws.Int64(p.ReplicationFactor),
+		})
+		return err
+	}
+	_, err := e.client.DecreaseReplicationFactorWithContext(ctx, &awsdax.DecreaseReplicationFactorInput{
+		ClusterName:          aws.String(name),
+		NewReplicationFactor: aws.Int64(p.ReplicationFactor),
+	})
+	return err
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.ClusterStatusDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteClusterWithContext(ctx, &awsdax.DeleteClusterInput{
+		ClusterName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(dax.IsClusterNotFound, err), errDelete)
+}
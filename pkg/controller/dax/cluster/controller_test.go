@@ -0,0 +1,383 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsdax "github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dax/fake"
+)
+
+var (
+	clusterName = "cache"
+	clusterARN  = "arn:aws:dax:us-east-1:123456789012:cache/cache"
+	roleARN     = "arn:aws:iam::123456789012:role/dax"
+	endpoint    = "cache.abcdef.dax-clusters.us-east-1.amazonaws.com"
+
+	errBoom = errors.New("boom")
+)
+
+type clusterModifier func(*v1alpha1.Cluster)
+
+func withConditions(c ...xpv1.Condition) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withReplicationFactor(n int64) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.ReplicationFactor = n }
+}
+
+func withDescription(d string) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withStatus(s v1alpha1.ClusterObservation) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.AtProvider = s }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.Cluster {
+	cr := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ForProvider: v1alpha1.ClusterParameters{
+				Region:                        "us-east-1",
+				Description:                   aws.String("cache of the orders table"),
+				NodeType:                      "dax.r5.large",
+				ReplicationFactor:             3,
+				IAMRoleARN:                    aws.String(roleARN),
+				SubnetGroupName:               aws.String("subnets"),
+				ParameterGroupName:            aws.String("default.dax1.0"),
+				SecurityGroupIDs:              []string{"sg-1"},
+				PreferredMaintenanceWindow:    aws.String("sun:05:00-sun:09:00"),
+				ClusterEndpointEncryptionType: aws.String(awsdax.ClusterEndpointEncryptionTypeNone),
+			},
+		},
+	}
+	meta.SetExternalName(cr, clusterName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status string, nodes int64) *awsdax.Cluster {
+	return &awsdax.Cluster{
+		ClusterName:                   aws.String(clusterName),
+		ClusterArn:                    aws.String(clusterARN),
+		Status:                        aws.String(status),
+		Description:                   aws.String("cache of the orders table"),
+		NodeType:                      aws.String("dax.r5.large"),
+		TotalNodes:                    aws.Int64(nodes),
+		ActiveNodes:                   aws.Int64(nodes),
+		IamRoleArn:                    aws.String(roleARN),
+		SubnetGroup:                   aws.String("subnets"),
+		ParameterGroup:                &awsdax.ParameterGroupStatus{ParameterGroupName: aws.String("default.dax1.0")},
+		SecurityGroups:                []*awsdax.SecurityGroupMembership{{SecurityGroupIdentifier: aws.String("sg-1")}},
+		PreferredMaintenanceWindow:    aws.String("sun:05:00-sun:09:00"),
+		ClusterEndpointEncryptionType: aws.String(awsdax.ClusterEndpointEncryptionTypeNone),
+		ClusterDiscoveryEndpoint:      &awsdax.Endpoint{Address: aws.String(endpoint), Port: aws.Int64(8111)},
+	}
+}
+
+func describe(c *awsdax.Cluster) func(context.Context, *awsdax.DescribeClustersInput, []request.Option) (*awsdax.DescribeClustersOutput, error) {
+	return func(_ context.Context, input *awsdax.DescribeClustersInput, _ []request.Option) (*awsdax.DescribeClustersOutput, error) {
+		if len(input.ClusterNames) != 1 || aws.StringValue(input.ClusterNames[0]) != clusterName {
+			return nil, errors.New("unexpected cluster")
+		}
+		return &awsdax.DescribeClustersOutput{Clusters: []*awsdax.Cluster{c}}, nil
+	}
+}
+
+func listTags(context.Context, *awsdax.ListTagsInput, []request.Option) (*awsdax.ListTagsOutput, error) {
+	return &awsdax.ListTagsOutput{}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Cluster
+		result managed.ExternalObservation
+		err    error
+	}
+
+	available := v1alpha1.ClusterObservation{
+		ClusterARN:               clusterARN,
+		Status:                   v1alpha1.ClusterStatusAvailable,
+		ClusterDiscoveryEndpoint: endpoint,
+		TotalNodes:               3,
+		ActiveNodes:              3,
+	}
+	creating := available
+	creating.Status = v1alpha1.ClusterStatusCreating
+	connection := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("8111"),
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Cluster
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusAvailable, 3)),
+				MockListTagsWithContext:         listTags,
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withStatus(available), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"ReplicationFactorChanged": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusAvailable, 3)),
+				MockListTagsWithContext:         listTags,
+			},
+			cr: cluster(withReplicationFactor(5)),
+			want: want{
+				cr: cluster(withReplicationFactor(5), withStatus(available), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"BeingCreated": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusCreating, 3)),
+			},
+			cr: cluster(withDescription("changed")),
+			want: want{
+				cr: cluster(withDescription("changed"), withStatus(creating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: func(context.Context, *awsdax.DescribeClustersInput, []request.Option) (*awsdax.DescribeClustersOutput, error) {
+					return nil, awserr.New(awsdax.ErrCodeClusterNotFoundFault, "not found", nil)
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: func(context.Context, *awsdax.DescribeClustersInput, []request.Option) (*awsdax.DescribeClustersOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr:  cluster(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Cluster
+		updateErr error
+		want
+	}{
+		"AddNodes": {
+			cr: cluster(withReplicationFactor(5), withDescription("changed")),
+			want: want{
+				calls: []string{"IncreaseReplicationFactor"},
+			},
+		},
+		"RemoveNodes": {
+			cr: cluster(withReplicationFactor(1)),
+			want: want{
+				calls: []string{"DecreaseReplicationFactor"},
+			},
+		},
+		"NewDescription": {
+			cr: cluster(withDescription("changed")),
+			want: want{
+				calls: []string{"UpdateCluster"},
+			},
+		},
+		"UpToDate": {
+			cr:   cluster(),
+			want: want{},
+		},
+		"UpdateFailed": {
+			cr:        cluster(withDescription("changed")),
+			updateErr: errBoom,
+			want: want{
+				calls: []string{"UpdateCluster"},
+				err:   awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusAvailable, 3)),
+				MockListTagsWithContext:         listTags,
+				MockIncreaseReplicationFactorWithContext: func(context.Context, *awsdax.IncreaseReplicationFactorInput, []request.Option) (*awsdax.IncreaseReplicationFactorOutput, error) {
+					calls = append(calls, "IncreaseReplicationFactor")
+					return &awsdax.IncreaseReplicationFactorOutput{}, nil
+				},
+				MockDecreaseReplicationFactorWithContext: func(context.Context, *awsdax.DecreaseReplicationFactorInput, []request.Option) (*awsdax.DecreaseReplicationFactorOutput, error) {
+					calls = append(calls, "DecreaseReplicationFactor")
+					return &awsdax.DecreaseReplicationFactorOutput{}, nil
+				},
+				MockUpdateClusterWithContext: func(context.Context, *awsdax.UpdateClusterInput, []request.Option) (*awsdax.UpdateClusterOutput, error) {
+					calls = append(calls, "UpdateCluster")
+					return &awsdax.UpdateClusterOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Cluster
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Cluster
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteClusterWithContext: func(_ context.Context, input *awsdax.DeleteClusterInput, _ []request.Option) (*awsdax.DeleteClusterOutput, error) {
+					if aws.StringValue(input.ClusterName) != clusterName {
+						return nil, errors.New("unexpected cluster")
+					}
+					return &awsdax.DeleteClusterOutput{}, nil
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			client: &fake.MockClient{},
+			cr:     cluster(withStatus(v1alpha1.ClusterObservation{Status: v1alpha1.ClusterStatusDeleting})),
+			want: want{
+				cr: cluster(withStatus(v1alpha1.ClusterObservation{Status: v1alpha1.ClusterStatusDeleting}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteClusterWithContext: func(context.Context, *awsdax.DeleteClusterInput, []request.Option) (*awsdax.DeleteClusterOutput, error) {
+					return nil, awserr.New(awsdax.ErrCodeClusterNotFoundFault, "not found", nil)
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteClusterWithContext: func(context.Context, *awsdax.DeleteClusterInput, []request.Option) (*awsdax.DeleteClusterOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr:  cluster(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
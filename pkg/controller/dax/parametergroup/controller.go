@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parametergroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsdax "github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dax"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a DAX ParameterGroup resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe the DAX parameter group"
+	errDescribeParams   = "failed to describe the parameters of the DAX parameter group"
+	errCreate           = "failed to create the DAX parameter group"
+	errUpdate           = "failed to update the DAX parameter group"
+	errDelete           = "failed to delete the DAX parameter group"
+)
+
+// SetupParameterGroup adds a controller that reconciles DAX parameter groups.
+func SetupParameterGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.ParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: dax.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) dax.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ParameterGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client dax.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ParameterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.DescribeParameterGroupsWithContext(ctx, &awsdax.DescribeParameterGroupsInput{
+		ParameterGroupNames: []*string{aws.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(dax.IsParameterGroupNotFound, err), errDescribe)
+	}
+	if len(resp.ParameterGroups) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	observed := resp.ParameterGroups[0]
+
+	params, err := dax.DescribeParameters(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeParams)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	dax.LateInitializeParameterGroup(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = v1alpha1.ParameterGroupObservation{
+		ParameterGroupName: aws.StringValue(observed.ParameterGroupName),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        dax.IsParameterGroupUpToDate(cr.Spec.ForProvider, params),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ParameterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// The parameters are set by the first update after the creation, since
+	// a parameter group is created with the default values.
+	_, err := e.client.CreateParameterGroupWithContext(ctx, &awsdax.CreateParameterGroupInput{
+		ParameterGroupName: aws.String(meta.GetExternalName(cr)),
+		Description:        cr.Spec.ForProvider.Description,
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ParameterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.UpdateParameterGroupWithContext(ctx, dax.GenerateUpdateParameterGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ParameterGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteParameterGroupWithContext(ctx, &awsdax.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(dax.IsParameterGroupNotFound, err), errDelete)
+}
@@ -0,0 +1,431 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parametergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsdax "github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dax/fake"
+)
+
+var (
+	groupName = "orders"
+
+	errBoom = errors.New("boom")
+)
+
+type parameterGroupModifier func(*v1alpha1.ParameterGroup)
+
+func withConditions(c ...xpv1.Condition) parameterGroupModifier {
+	return func(r *v1alpha1.ParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(d string) parameterGroupModifier {
+	return func(r *v1alpha1.ParameterGroup) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withParameters(v ...v1alpha1.ParameterNameValue) parameterGroupModifier {
+	return func(r *v1alpha1.ParameterGroup) { r.Spec.ForProvider.ParameterNameValues = v }
+}
+
+func withStatus(s v1alpha1.ParameterGroupObservation) parameterGroupModifier {
+	return func(r *v1alpha1.ParameterGroup) { r.Status.AtProvider = s }
+}
+
+func parameterGroup(m ...parameterGroupModifier) *v1alpha1.ParameterGroup {
+	cr := &v1alpha1.ParameterGroup{
+		Spec: v1alpha1.ParameterGroupSpec{
+			ForProvider: v1alpha1.ParameterGroupParameters{
+				Region: "us-east-1",
+				ParameterNameValues: []v1alpha1.ParameterNameValue{
+					{ParameterName: "query-ttl-millis", ParameterValue: "600000"},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeParameterGroups(_ context.Context, input *awsdax.DescribeParameterGroupsInput, _ []request.Option) (*awsdax.DescribeParameterGroupsOutput, error) {
+	if len(input.ParameterGroupNames) != 1 || aws.StringValue(input.ParameterGroupNames[0]) != groupName {
+		return nil, errors.New("unexpected parameter group")
+	}
+	return &awsdax.DescribeParameterGroupsOutput{ParameterGroups: []*awsdax.ParameterGroup{{
+		ParameterGroupName: aws.String(groupName),
+		Description:        aws.String("orders cache"),
+	}}}, nil
+}
+
+// describeParameters returns the parameters of the parameter group in two
+// pages, to check that all of them are compared.
+func describeParameters(_ context.Context, input *awsdax.DescribeParametersInput, _ []request.Option) (*awsdax.DescribeParametersOutput, error) {
+	if aws.StringValue(input.ParameterGroupName) != groupName {
+		return nil, errors.New("unexpected parameter group")
+	}
+	if input.NextToken == nil {
+		return &awsdax.DescribeParametersOutput{
+			Parameters: []*awsdax.Parameter{{ParameterName: aws.String("query-ttl-millis"), ParameterValue: aws.String("600000")}},
+			NextToken:  aws.String("page-2"),
+		}, nil
+	}
+	return &awsdax.DescribeParametersOutput{
+		Parameters: []*awsdax.Parameter{{ParameterName: aws.String("record-ttl-millis"), ParameterValue: aws.String("300000")}},
+	}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ParameterGroup
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeParameterGroupsWithContext: describeParameterGroups,
+				MockDescribeParametersWithContext:      describeParameters,
+			},
+			cr: parameterGroup(withDescription("orders cache")),
+			want: want{
+				cr: parameterGroup(withDescription("orders cache"),
+					withStatus(v1alpha1.ParameterGroupObservation{ParameterGroupName: groupName}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{
+				MockDescribeParameterGroupsWithContext: describeParameterGroups,
+				MockDescribeParametersWithContext:      describeParameters,
+			},
+			cr: parameterGroup(),
+			want: want{
+				cr: parameterGroup(withDescription("orders cache"),
+					withStatus(v1alpha1.ParameterGroupObservation{ParameterGroupName: groupName}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ParameterOnSecondPageChanged": {
+			client: &fake.MockClient{
+				MockDescribeParameterGroupsWithContext: describeParameterGroups,
+				MockDescribeParametersWithContext:      describeParameters,
+			},
+			cr: parameterGroup(withDescription("orders cache"), withParameters(
+				v1alpha1.ParameterNameValue{ParameterName: "query-ttl-millis", ParameterValue: "600000"},
+				v1alpha1.ParameterNameValue{ParameterName: "record-ttl-millis", ParameterValue: "60000"},
+			)),
+			want: want{
+				cr: parameterGroup(withDescription("orders cache"), withParameters(
+					v1alpha1.ParameterNameValue{ParameterName: "query-ttl-millis", ParameterValue: "600000"},
+					v1alpha1.ParameterNameValue{ParameterName: "record-ttl-millis", ParameterValue: "60000"},
+				), withStatus(v1alpha1.ParameterGroupObservation{ParameterGroupName: groupName}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"UnknownParameter": {
+			client: &fake.MockClient{
+				MockDescribeParameterGroupsWithContext: describeParameterGroups,
+				MockDescribeParametersWithContext:      describeParameters,
+			},
+			cr: parameterGroup(withDescription("orders cache"), withParameters(
+				v1alpha1.ParameterNameValue{ParameterName: "cache-size", ParameterValue: "10"},
+			)),
+			want: want{
+				cr: parameterGroup(withDescription("orders cache"), withParameters(
+					v1alpha1.ParameterNameValue{ParameterName: "cache-size", ParameterValue: "10"},
+				), withStatus(v1alpha1.ParameterGroupObservation{ParameterGroupName: groupName}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeParameterGroupsWithContext: func(context.Context, *awsdax.DescribeParameterGroupsInput, []request.Option) (*awsdax.DescribeParameterGroupsOutput, error) {
+					return nil, awserr.New(awsdax.ErrCodeParameterGroupNotFoundFault, "not found", nil)
+				},
+			},
+			cr: parameterGroup(),
+			want: want{
+				cr: parameterGroup(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeParameterGroupsWithContext: func(context.Context, *awsdax.DescribeParameterGroupsInput, []request.Option) (*awsdax.DescribeParameterGroupsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: parameterGroup(),
+			want: want{
+				cr:  parameterGroup(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DescribeParametersFailed": {
+			client: &fake.MockClient{
+				MockDescribeParameterGroupsWithContext: describeParameterGroups,
+				MockDescribeParametersWithContext: func(context.Context, *awsdax.DescribeParametersInput, []request.Option) (*awsdax.DescribeParametersOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: parameterGroup(),
+			want: want{
+				cr:  parameterGroup(),
+				err: awsclient.Wrap(errBoom, errDescribeParams),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.ParameterGroup
+		input *awsdax.CreateParameterGroupInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.ParameterGroup
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: parameterGroup(withDescription("orders cache")),
+			want: want{
+				cr: parameterGroup(withDescription("orders cache"), withConditions(xpv1.Creating())),
+				input: &awsdax.CreateParameterGroupInput{
+					ParameterGroupName: aws.String(groupName),
+					Description:        aws.String("orders cache"),
+				},
+			},
+		},
+		"CreateFailed": {
+			cr:        parameterGroup(),
+			createErr: errBoom,
+			want: want{
+				cr:    parameterGroup(withConditions(xpv1.Creating())),
+				input: &awsdax.CreateParameterGroupInput{ParameterGroupName: aws.String(groupName)},
+				err:   awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsdax.CreateParameterGroupInput
+			e := &external{client: &fake.MockClient{
+				MockCreateParameterGroupWithContext: func(_ context.Context, in *awsdax.CreateParameterGroupInput, _ []request.Option) (*awsdax.CreateParameterGroupOutput, error) {
+					input = in
+					return &awsdax.CreateParameterGroupOutput{}, tc.createErr
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awsdax.UpdateParameterGroupInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.ParameterGroup
+		updateErr error
+		want
+	}{
+		"Successful": {
+			cr: parameterGroup(withParameters(
+				v1alpha1.ParameterNameValue{ParameterName: "query-ttl-millis", ParameterValue: "0"},
+				v1alpha1.ParameterNameValue{ParameterName: "record-ttl-millis", ParameterValue: "60000"},
+			)),
+			want: want{
+				input: &awsdax.UpdateParameterGroupInput{
+					ParameterGroupName: aws.String(groupName),
+					ParameterNameValues: []*awsdax.ParameterNameValue{
+						{ParameterName: aws.String("query-ttl-millis"), ParameterValue: aws.String("0")},
+						{ParameterName: aws.String("record-ttl-millis"), ParameterValue: aws.String("60000")},
+					},
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        parameterGroup(),
+			updateErr: errBoom,
+			want: want{
+				input: &awsdax.UpdateParameterGroupInput{
+					ParameterGroupName: aws.String(groupName),
+					ParameterNameValues: []*awsdax.ParameterNameValue{
+						{ParameterName: aws.String("query-ttl-millis"), ParameterValue: aws.String("600000")},
+					},
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsdax.UpdateParameterGroupInput
+			e := &external{client: &fake.MockClient{
+				MockUpdateParameterGroupWithContext: func(_ context.Context, in *awsdax.UpdateParameterGroupInput, _ []request.Option) (*awsdax.UpdateParameterGroupOutput, error) {
+					input = in
+					return &awsdax.UpdateParameterGroupOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ParameterGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ParameterGroup
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteParameterGroupWithContext: func(_ context.Context, input *awsdax.DeleteParameterGroupInput, _ []request.Option) (*awsdax.DeleteParameterGroupOutput, error) {
+					if aws.StringValue(input.ParameterGroupName) != groupName {
+						return nil, errors.New("unexpected parameter group")
+					}
+					return &awsdax.DeleteParameterGroupOutput{}, nil
+				},
+			},
+			cr: parameterGroup(),
+			want: want{
+				cr: parameterGroup(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteParameterGroupWithContext: func(context.Context, *awsdax.DeleteParameterGroupInput, []request.Option) (*awsdax.DeleteParameterGroupOutput, error) {
+					return nil, awserr.New(awsdax.ErrCodeParameterGroupNotFoundFault, "not found", nil)
+				},
+			},
+			cr: parameterGroup(),
+			want: want{
+				cr: parameterGroup(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteParameterGroupWithContext: func(context.Context, *awsdax.DeleteParameterGroupInput, []request.Option) (*awsdax.DeleteParameterGroupOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: parameterGroup(),
+			want: want{
+				cr:  parameterGroup(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
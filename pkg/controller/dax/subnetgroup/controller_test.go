@@ -0,0 +1,382 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnetgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsdax "github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dax/fake"
+)
+
+var (
+	groupName = "subnets"
+	vpcID     = "vpc-0123456789abcdef0"
+
+	errBoom = errors.New("boom")
+)
+
+type subnetGroupModifier func(*v1alpha1.SubnetGroup)
+
+func withConditions(c ...xpv1.Condition) subnetGroupModifier {
+	return func(r *v1alpha1.SubnetGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(d string) subnetGroupModifier {
+	return func(r *v1alpha1.SubnetGroup) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withSubnetIDs(ids ...string) subnetGroupModifier {
+	return func(r *v1alpha1.SubnetGroup) { r.Spec.ForProvider.SubnetIDs = ids }
+}
+
+func withStatus(s v1alpha1.SubnetGroupObservation) subnetGroupModifier {
+	return func(r *v1alpha1.SubnetGroup) { r.Status.AtProvider = s }
+}
+
+func subnetGroup(m ...subnetGroupModifier) *v1alpha1.SubnetGroup {
+	cr := &v1alpha1.SubnetGroup{
+		Spec: v1alpha1.SubnetGroupSpec{
+			ForProvider: v1alpha1.SubnetGroupParameters{
+				Region:    "us-east-1",
+				SubnetIDs: []string{"subnet-1", "subnet-2"},
+			},
+		},
+	}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeSubnetGroups(_ context.Context, input *awsdax.DescribeSubnetGroupsInput, _ []request.Option) (*awsdax.DescribeSubnetGroupsOutput, error) {
+	if len(input.SubnetGroupNames) != 1 || aws.StringValue(input.SubnetGroupNames[0]) != groupName {
+		return nil, errors.New("unexpected subnet group")
+	}
+	return &awsdax.DescribeSubnetGroupsOutput{SubnetGroups: []*awsdax.SubnetGroup{{
+		SubnetGroupName: aws.String(groupName),
+		Description:     aws.String("cache subnets"),
+		VpcId:           aws.String(vpcID),
+		Subnets: []*awsdax.Subnet{
+			{SubnetIdentifier: aws.String("subnet-2")},
+			{SubnetIdentifier: aws.String("subnet-1")},
+		},
+	}}}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SubnetGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.SubnetGroup
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{MockDescribeSubnetGroupsWithContext: describeSubnetGroups},
+			cr:     subnetGroup(withDescription("cache subnets")),
+			want: want{
+				cr: subnetGroup(withDescription("cache subnets"),
+					withStatus(v1alpha1.SubnetGroupObservation{VPCID: vpcID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockDescribeSubnetGroupsWithContext: describeSubnetGroups},
+			cr:     subnetGroup(withSubnetIDs()),
+			want: want{
+				cr: subnetGroup(withDescription("cache subnets"), withSubnetIDs("subnet-2", "subnet-1"),
+					withStatus(v1alpha1.SubnetGroupObservation{VPCID: vpcID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"SubnetsChanged": {
+			client: &fake.MockClient{MockDescribeSubnetGroupsWithContext: describeSubnetGroups},
+			cr:     subnetGroup(withDescription("cache subnets"), withSubnetIDs("subnet-1", "subnet-3")),
+			want: want{
+				cr: subnetGroup(withDescription("cache subnets"), withSubnetIDs("subnet-1", "subnet-3"),
+					withStatus(v1alpha1.SubnetGroupObservation{VPCID: vpcID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DescriptionChanged": {
+			client: &fake.MockClient{MockDescribeSubnetGroupsWithContext: describeSubnetGroups},
+			cr:     subnetGroup(withDescription("orders cache subnets")),
+			want: want{
+				cr: subnetGroup(withDescription("orders cache subnets"),
+					withStatus(v1alpha1.SubnetGroupObservation{VPCID: vpcID}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeSubnetGroupsWithContext: func(context.Context, *awsdax.DescribeSubnetGroupsInput, []request.Option) (*awsdax.DescribeSubnetGroupsOutput, error) {
+					return nil, awserr.New(awsdax.ErrCodeSubnetGroupNotFoundFault, "not found", nil)
+				},
+			},
+			cr: subnetGroup(),
+			want: want{
+				cr: subnetGroup(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeSubnetGroupsWithContext: func(context.Context, *awsdax.DescribeSubnetGroupsInput, []request.Option) (*awsdax.DescribeSubnetGroupsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: subnetGroup(),
+			want: want{
+				cr:  subnetGroup(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.SubnetGroup
+		input *awsdax.CreateSubnetGroupInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.SubnetGroup
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: subnetGroup(withDescription("cache subnets")),
+			want: want{
+				cr: subnetGroup(withDescription("cache subnets"), withConditions(xpv1.Creating())),
+				input: &awsdax.CreateSubnetGroupInput{
+					SubnetGroupName: aws.String(groupName),
+					Description:     aws.String("cache subnets"),
+					SubnetIds:       aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				},
+			},
+		},
+		"CreateFailed": {
+			cr:        subnetGroup(),
+			createErr: errBoom,
+			want: want{
+				cr: subnetGroup(withConditions(xpv1.Creating())),
+				input: &awsdax.CreateSubnetGroupInput{
+					SubnetGroupName: aws.String(groupName),
+					SubnetIds:       aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				},
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsdax.CreateSubnetGroupInput
+			e := &external{client: &fake.MockClient{
+				MockCreateSubnetGroupWithContext: func(_ context.Context, in *awsdax.CreateSubnetGroupInput, _ []request.Option) (*awsdax.CreateSubnetGroupOutput, error) {
+					input = in
+					return &awsdax.CreateSubnetGroupOutput{}, tc.createErr
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awsdax.UpdateSubnetGroupInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.SubnetGroup
+		updateErr error
+		want
+	}{
+		"Successful": {
+			cr: subnetGroup(withDescription("orders cache subnets"), withSubnetIDs("subnet-1", "subnet-3")),
+			want: want{
+				input: &awsdax.UpdateSubnetGroupInput{
+					SubnetGroupName: aws.String(groupName),
+					Description:     aws.String("orders cache subnets"),
+					SubnetIds:       aws.StringSlice([]string{"subnet-1", "subnet-3"}),
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        subnetGroup(withSubnetIDs("subnet-3")),
+			updateErr: errBoom,
+			want: want{
+				input: &awsdax.UpdateSubnetGroupInput{
+					SubnetGroupName: aws.String(groupName),
+					SubnetIds:       aws.StringSlice([]string{"subnet-3"}),
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsdax.UpdateSubnetGroupInput
+			e := &external{client: &fake.MockClient{
+				MockUpdateSubnetGroupWithContext: func(_ context.Context, in *awsdax.UpdateSubnetGroupInput, _ []request.Option) (*awsdax.UpdateSubnetGroupOutput, error) {
+					input = in
+					return &awsdax.UpdateSubnetGroupOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SubnetGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.SubnetGroup
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteSubnetGroupWithContext: func(_ context.Context, input *awsdax.DeleteSubnetGroupInput, _ []request.Option) (*awsdax.DeleteSubnetGroupOutput, error) {
+					if aws.StringValue(input.SubnetGroupName) != groupName {
+						return nil, errors.New("unexpected subnet group")
+					}
+					return &awsdax.DeleteSubnetGroupOutput{}, nil
+				},
+			},
+			cr: subnetGroup(),
+			want: want{
+				cr: subnetGroup(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteSubnetGroupWithContext: func(context.Context, *awsdax.DeleteSubnetGroupInput, []request.Option) (*awsdax.DeleteSubnetGroupOutput, error) {
+					return nil, awserr.New(awsdax.ErrCodeSubnetGroupNotFoundFault, "not found", nil)
+				},
+			},
+			cr: subnetGroup(),
+			want: want{
+				cr: subnetGroup(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteSubnetGroupWithContext: func(context.Context, *awsdax.DeleteSubnetGroupInput, []request.Option) (*awsdax.DeleteSubnetGroupOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: subnetGroup(),
+			want: want{
+				cr:  subnetGroup(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
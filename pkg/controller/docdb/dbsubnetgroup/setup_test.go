@@ -58,6 +58,10 @@ type args struct {
 
 type docDBModifier func(*svcapitypes.DBSubnetGroup)
 
+// equateContexts compares the contexts recorded by the fake client by
+// identity, since cmp can't look into the unexported fields of a context.
+var equateContexts = cmp.Comparer(func(a, b context.Context) bool { return a == b })
+
 func subnetGroup(m ...docDBModifier) *svcapitypes.DBSubnetGroup {
 	cr := &svcapitypes.DBSubnetGroup{}
 	for _, f := range m {
@@ -398,7 +402,7 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.docdb, tc.args.docdb.Called); diff != "" {
+			if diff := cmp.Diff(tc.want.docdb, tc.args.docdb.Called, equateContexts); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
@@ -501,7 +505,7 @@ func TestCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.docdb, tc.args.docdb.Called); diff != "" {
+			if diff := cmp.Diff(tc.want.docdb, tc.args.docdb.Called, equateContexts); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
@@ -594,7 +598,7 @@ func TestDelete(t *testing.T) {
 			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.docdb, tc.args.docdb.Called); diff != "" {
+			if diff := cmp.Diff(tc.want.docdb, tc.args.docdb.Called, equateContexts); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
@@ -758,7 +762,7 @@ func TestModify(t *testing.T) {
 			if diff := cmp.Diff(tc.want.result, res, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.docdb, tc.args.docdb.Called); diff != "" {
+			if diff := cmp.Diff(tc.want.docdb, tc.args.docdb.Called, equateContexts); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
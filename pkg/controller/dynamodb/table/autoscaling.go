@@ -0,0 +1,295 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errDescribeScalableTargets  = "failed to describe the scalable targets of the Table"
+	errDescribeScalingPolicies  = "failed to describe the scaling policies of the Table"
+	errRegisterScalableTarget   = "failed to register a scalable target of the Table"
+	errPutScalingPolicy         = "failed to put a scaling policy of the Table"
+	errDeregisterScalableTarget = "failed to deregister a scalable target of the Table"
+)
+
+// scalableCapacity is a capacity of a table, or of one of its global
+// secondary indexes, that is scaled by Application Auto Scaling.
+type scalableCapacity struct {
+	resourceID string
+	dimension  string
+	metricType string
+	scaling    *svcapitypes.CapacityAutoScaling
+}
+
+// policyName returns the name of the target tracking policy of the capacity,
+// which follows the naming used by the DynamoDB console.
+func (c scalableCapacity) policyName() string {
+	return c.metricType + ":" + c.resourceID
+}
+
+// scalableCapacities returns the capacities of the table with the given name
+// that are scaled by the supplied configuration.
+func scalableCapacities(tableName string, a *svcapitypes.TableAutoScaling) []scalableCapacity {
+	if a == nil {
+		return nil
+	}
+	var res []scalableCapacity
+	add := func(resourceID, readDimension, writeDimension string, read, write *svcapitypes.CapacityAutoScaling) {
+		if read != nil {
+			res = append(res, scalableCapacity{resourceID: resourceID, dimension: readDimension, metricType: applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization, scaling: read})
+		}
+		if write != nil {
+			res = append(res, scalableCapacity{resourceID: resourceID, dimension: writeDimension, metricType: applicationautoscaling.MetricTypeDynamoDbwriteCapacityUtilization, scaling: write})
+		}
+	}
+	tableID := "table/" + tableName
+	add(tableID, applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits, applicationautoscaling.ScalableDimensionDynamodbTableWriteCapacityUnits, a.ReadCapacity, a.WriteCapacity)
+	for _, gsi := range a.GlobalSecondaryIndexes {
+		add(tableID+"/index/"+gsi.IndexName, applicationautoscaling.ScalableDimensionDynamodbIndexReadCapacityUnits, applicationautoscaling.ScalableDimensionDynamodbIndexWriteCapacityUnits, gsi.ReadCapacity, gsi.WriteCapacity)
+	}
+	return res
+}
+
+// withAutoScaledCapacity returns the supplied parameters with the provisioned
+// throughput of the scaled capacities set to the observed one, so that the
+// changes made by the scaling policies aren't reverted.
+func withAutoScaledCapacity(p *svcapitypes.TableParameters, t *svcsdk.TableDescription) *svcapitypes.TableParameters { //nolint:gocyclo
+	a := p.AutoScaling
+	if a == nil {
+		return p
+	}
+	out := p.DeepCopy()
+	if out.ProvisionedThroughput != nil && t.ProvisionedThroughput != nil {
+		if a.ReadCapacity != nil {
+			out.ProvisionedThroughput.ReadCapacityUnits = t.ProvisionedThroughput.ReadCapacityUnits
+		}
+		if a.WriteCapacity != nil {
+			out.ProvisionedThroughput.WriteCapacityUnits = t.ProvisionedThroughput.WriteCapacityUnits
+		}
+	}
+	observed := map[string]*svcsdk.GlobalSecondaryIndexDescription{}
+	for _, gsi := range t.GlobalSecondaryIndexes {
+		observed[aws.StringValue(gsi.IndexName)] = gsi
+	}
+	for _, gsi := range out.GlobalSecondaryIndexes {
+		o, ok := observed[aws.StringValue(gsi.IndexName)]
+		if !ok || o.ProvisionedThroughput == nil || gsi.ProvisionedThroughput == nil {
+			continue
+		}
+		for _, s := range a.GlobalSecondaryIndexes {
+			if s.IndexName != aws.StringValue(gsi.IndexName) {
+				continue
+			}
+			if s.ReadCapacity != nil {
+				gsi.ProvisionedThroughput.ReadCapacityUnits = o.ProvisionedThroughput.ReadCapacityUnits
+			}
+			if s.WriteCapacity != nil {
+				gsi.ProvisionedThroughput.WriteCapacityUnits = o.ProvisionedThroughput.WriteCapacityUnits
+			}
+		}
+	}
+	return out
+}
+
+// isScalableTargetUpToDate checks whether the observed scalable target has
+// the desired capacity limits.
+func isScalableTargetUpToDate(c scalableCapacity, t *applicationautoscaling.ScalableTarget) bool {
+	return t != nil &&
+		c.scaling.MinCapacity == aws.Int64Value(t.MinCapacity) &&
+		c.scaling.MaxCapacity == aws.Int64Value(t.MaxCapacity)
+}
+
+// isScalingPolicyUpToDate checks whether the observed scaling policy tracks
+// the desired utilization of the capacity.
+func isScalingPolicyUpToDate(c scalableCapacity, p *applicationautoscaling.ScalingPolicy) bool {
+	if p == nil || p.TargetTrackingScalingPolicyConfiguration == nil {
+		return false
+	}
+	cfg := p.TargetTrackingScalingPolicyConfiguration
+	if cfg.PredefinedMetricSpecification == nil || cfg.TargetValue == nil {
+		return false
+	}
+	s := c.scaling
+	return c.metricType == aws.StringValue(cfg.PredefinedMetricSpecification.PredefinedMetricType) &&
+		s.TargetUtilization == *cfg.TargetValue &&
+		(s.ScaleInCooldown == nil || aws.Int64Value(s.ScaleInCooldown) == aws.Int64Value(cfg.ScaleInCooldown)) &&
+		(s.ScaleOutCooldown == nil || aws.Int64Value(s.ScaleOutCooldown) == aws.Int64Value(cfg.ScaleOutCooldown)) &&
+		aws.BoolValue(s.DisableScaleIn) == aws.BoolValue(cfg.DisableScaleIn)
+}
+
+func generatePutScalingPolicyInput(c scalableCapacity) *applicationautoscaling.PutScalingPolicyInput {
+	return &applicationautoscaling.PutScalingPolicyInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		ResourceId:        aws.String(c.resourceID),
+		ScalableDimension: aws.String(c.dimension),
+		PolicyName:        aws.String(c.policyName()),
+		PolicyType:        aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: aws.String(c.metricType),
+			},
+			TargetValue:      &c.scaling.TargetUtilization,
+			ScaleInCooldown:  c.scaling.ScaleInCooldown,
+			ScaleOutCooldown: c.scaling.ScaleOutCooldown,
+			DisableScaleIn:   c.scaling.DisableScaleIn,
+		},
+	}
+}
+
+// autoScaler registers the capacities of a table with Application Auto
+// Scaling.
+type autoScaler struct {
+	client applicationautoscalingiface.ApplicationAutoScalingAPI
+}
+
+// describe returns the scalable targets of the supplied capacities keyed by
+// their resource ID and dimension, and their scaling policies keyed by name.
+func (a *autoScaler) describe(ctx context.Context, capacities []scalableCapacity) (map[string]*applicationautoscaling.ScalableTarget, map[string]*applicationautoscaling.ScalingPolicy, error) {
+	var resourceIDs, policyNames []*string
+	seen := map[string]bool{}
+	for _, c := range capacities {
+		if !seen[c.resourceID] {
+			resourceIDs = append(resourceIDs, aws.String(c.resourceID))
+			seen[c.resourceID] = true
+		}
+		policyNames = append(policyNames, aws.String(c.policyName()))
+	}
+
+	targets := map[string]*applicationautoscaling.ScalableTarget{}
+	targetsInput := &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace: aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		ResourceIds:      resourceIDs,
+	}
+	for {
+		resp, err := a.client.DescribeScalableTargetsWithContext(ctx, targetsInput)
+		if err != nil {
+			return nil, nil, aws.Wrap(err, errDescribeScalableTargets)
+		}
+		for _, t := range resp.ScalableTargets {
+			targets[aws.StringValue(t.ResourceId)+"|"+aws.StringValue(t.ScalableDimension)] = t
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		targetsInput.NextToken = resp.NextToken
+	}
+
+	policies := map[string]*applicationautoscaling.ScalingPolicy{}
+	policiesInput := &applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace: aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+		PolicyNames:      policyNames,
+	}
+	for {
+		resp, err := a.client.DescribeScalingPoliciesWithContext(ctx, policiesInput)
+		if err != nil {
+			return nil, nil, aws.Wrap(err, errDescribeScalingPolicies)
+		}
+		for _, p := range resp.ScalingPolicies {
+			policies[aws.StringValue(p.PolicyName)] = p
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		policiesInput.NextToken = resp.NextToken
+	}
+	return targets, policies, nil
+}
+
+// isUpToDate checks whether the scalable targets and scaling policies of the
+// table are up to date.
+func (a *autoScaler) isUpToDate(ctx context.Context, cr *svcapitypes.Table) (bool, error) {
+	capacities := scalableCapacities(meta.GetExternalName(cr), cr.Spec.ForProvider.AutoScaling)
+	if len(capacities) == 0 {
+		return true, nil
+	}
+	targets, policies, err := a.describe(ctx, capacities)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range capacities {
+		if !isScalableTargetUpToDate(c, targets[c.resourceID+"|"+c.dimension]) || !isScalingPolicyUpToDate(c, policies[c.policyName()]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// update registers the scaled capacities of the table and puts their target
+// tracking policies.
+func (a *autoScaler) update(ctx context.Context, cr *svcapitypes.Table) error {
+	capacities := scalableCapacities(meta.GetExternalName(cr), cr.Spec.ForProvider.AutoScaling)
+	if len(capacities) == 0 {
+		return nil
+	}
+	targets, policies, err := a.describe(ctx, capacities)
+	if err != nil {
+		return err
+	}
+	for _, c := range capacities {
+		if !isScalableTargetUpToDate(c, targets[c.resourceID+"|"+c.dimension]) {
+			_, err := a.client.RegisterScalableTargetWithContext(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+				ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+				ResourceId:        aws.String(c.resourceID),
+				ScalableDimension: aws.String(c.dimension),
+				MinCapacity:       &c.scaling.MinCapacity,
+				MaxCapacity:       &c.scaling.MaxCapacity,
+			})
+			if err != nil {
+				return aws.Wrap(err, errRegisterScalableTarget)
+			}
+		}
+		if !isScalingPolicyUpToDate(c, policies[c.policyName()]) {
+			if _, err := a.client.PutScalingPolicyWithContext(ctx, generatePutScalingPolicyInput(c)); err != nil {
+				return aws.Wrap(err, errPutScalingPolicy)
+			}
+		}
+	}
+	return nil
+}
+
+// deregister deregisters the scaled capacities of the table.
+func (a *autoScaler) deregister(ctx context.Context, cr *svcapitypes.Table) error {
+	for _, c := range scalableCapacities(meta.GetExternalName(cr), cr.Spec.ForProvider.AutoScaling) {
+		_, err := a.client.DeregisterScalableTargetWithContext(ctx, &applicationautoscaling.DeregisterScalableTargetInput{
+			ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceDynamodb),
+			ResourceId:        aws.String(c.resourceID),
+			ScalableDimension: aws.String(c.dimension),
+		})
+		if err != nil && !isScalableTargetNotFound(err) {
+			return aws.Wrap(err, errDeregisterScalableTarget)
+		}
+	}
+	return nil
+}
+
+// isScalableTargetNotFound returns true if the error is because the scalable
+// target doesn't exist.
+func isScalableTargetNotFound(err error) bool {
+	code, _ := aws.ErrorCode(err)
+	return code == applicationautoscaling.ErrCodeObjectNotFoundException
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+)
+
+func TestScalableCapacities(t *testing.T) {
+	read := &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilization: 70}
+	write := &v1alpha1.CapacityAutoScaling{MinCapacity: 2, MaxCapacity: 20, TargetUtilization: 50}
+
+	cases := map[string]struct {
+		a    *v1alpha1.TableAutoScaling
+		want []scalableCapacity
+	}{
+		"Nil": {},
+		"TableAndIndex": {
+			a: &v1alpha1.TableAutoScaling{
+				ReadCapacity: read,
+				GlobalSecondaryIndexes: []*v1alpha1.IndexAutoScaling{
+					{IndexName: "idx", WriteCapacity: write},
+				},
+			},
+			want: []scalableCapacity{
+				{
+					resourceID: "table/orders",
+					dimension:  applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits,
+					metricType: applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization,
+					scaling:    read,
+				},
+				{
+					resourceID: "table/orders/index/idx",
+					dimension:  applicationautoscaling.ScalableDimensionDynamodbIndexWriteCapacityUnits,
+					metricType: applicationautoscaling.MetricTypeDynamoDbwriteCapacityUtilization,
+					scaling:    write,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := scalableCapacities("orders", tc.a)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(scalableCapacity{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithAutoScaledCapacity(t *testing.T) {
+	table := &svcsdk.TableDescription{
+		ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{
+			ReadCapacityUnits:  aws.Int64(8),
+			WriteCapacityUnits: aws.Int64(3),
+		},
+		GlobalSecondaryIndexes: []*svcsdk.GlobalSecondaryIndexDescription{
+			{
+				IndexName: aws.String("idx"),
+				ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{
+					ReadCapacityUnits:  aws.Int64(6),
+					WriteCapacityUnits: aws.Int64(4),
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		p    *v1alpha1.TableParameters
+		want *v1alpha1.TableParameters
+	}{
+		"NoAutoScaling": {
+			p: &v1alpha1.TableParameters{
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(1)},
+			},
+			want: &v1alpha1.TableParameters{
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(1)},
+			},
+		},
+		"ScaledCapacities": {
+			p: &v1alpha1.TableParameters{
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(1)},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName:             aws.String("idx"),
+						ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(1)},
+					},
+				},
+				CustomTableParameters: v1alpha1.CustomTableParameters{
+					AutoScaling: &v1alpha1.TableAutoScaling{
+						ReadCapacity: &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilization: 70},
+						GlobalSecondaryIndexes: []*v1alpha1.IndexAutoScaling{
+							{IndexName: "idx", WriteCapacity: &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilization: 70}},
+						},
+					},
+				},
+			},
+			want: &v1alpha1.TableParameters{
+				ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(8), WriteCapacityUnits: aws.Int64(1)},
+				GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+					{
+						IndexName:             aws.String("idx"),
+						ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{ReadCapacityUnits: aws.Int64(1), WriteCapacityUnits: aws.Int64(4)},
+					},
+				},
+				CustomTableParameters: v1alpha1.CustomTableParameters{
+					AutoScaling: &v1alpha1.TableAutoScaling{
+						ReadCapacity: &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilization: 70},
+						GlobalSecondaryIndexes: []*v1alpha1.IndexAutoScaling{
+							{IndexName: "idx", WriteCapacity: &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilization: 70}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := withAutoScaledCapacity(tc.p, table)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsScalingPolicyUpToDate(t *testing.T) {
+	c := scalableCapacity{
+		resourceID: "table/orders",
+		dimension:  applicationautoscaling.ScalableDimensionDynamodbTableReadCapacityUnits,
+		metricType: applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization,
+		scaling:    &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilization: 70, ScaleInCooldown: aws.Int64(60)},
+	}
+
+	cases := map[string]struct {
+		p    *applicationautoscaling.ScalingPolicy
+		want bool
+	}{
+		"Missing": {
+			want: false,
+		},
+		"UpToDate": {
+			p:    &applicationautoscaling.ScalingPolicy{TargetTrackingScalingPolicyConfiguration: generatePutScalingPolicyInput(c).TargetTrackingScalingPolicyConfiguration},
+			want: true,
+		},
+		"DifferentTarget": {
+			p: &applicationautoscaling.ScalingPolicy{
+				TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+					PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+						PredefinedMetricType: aws.String(applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization),
+					},
+					TargetValue:     aws.Float64(50),
+					ScaleInCooldown: aws.Int64(60),
+				},
+			},
+			want: false,
+		},
+		"DifferentCooldown": {
+			p: &applicationautoscaling.ScalingPolicy{
+				TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+					PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+						PredefinedMetricType: aws.String(applicationautoscaling.MetricTypeDynamoDbreadCapacityUtilization),
+					},
+					TargetValue:     aws.Float64(70),
+					ScaleInCooldown: aws.Int64(0),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isScalingPolicyUpToDate(c, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errDescribeTimeToLive        = "failed to describe the time to live of the Table"
+	errUpdateTimeToLive          = "failed to update the time to live of the Table"
+	errDescribeContinuousBackups = "failed to describe the continuous backups of the Table"
+	errUpdateContinuousBackups   = "failed to update the continuous backups of the Table"
+	errDescribeKinesisStreaming  = "failed to describe the Kinesis streaming destinations of the Table"
+	errEnableKinesisStreaming    = "failed to enable the Kinesis streaming destination of the Table"
+	errDisableKinesisStreaming   = "failed to disable the Kinesis streaming destination of the Table"
+)
+
+// customConnector connects to DynamoDB like the generated connector, and to
+// Application Auto Scaling with the same session.
+type customConnector struct {
+	kube client.Client
+	opts []option
+}
+
+func (c *customConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Table)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := aws.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &customExternal{
+		external:    newExternal(c.kube, svcsdk.New(sess), c.opts),
+		autoScaling: &autoScaler{client: applicationautoscaling.New(sess)},
+	}, nil
+}
+
+// customExternal manages the settings of a table that have their own APIs,
+// such as its time to live and auto scaling, once the table itself is up to
+// date.
+type customExternal struct {
+	*external
+	autoScaling *autoScaler
+}
+
+func (e *customExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := e.external.Observe(ctx, mg)
+	if err != nil || !obs.ResourceExists || !obs.ResourceUpToDate {
+		return obs, err
+	}
+	cr, ok := mg.(*svcapitypes.Table)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if aws.StringValue(cr.Status.AtProvider.TableStatus) != string(svcapitypes.TableStatus_SDK_ACTIVE) {
+		return obs, nil
+	}
+	for _, isUpToDate := range []func(context.Context, *svcapitypes.Table) (bool, error){
+		e.isTimeToLiveUpToDate,
+		e.isPointInTimeRecoveryUpToDate,
+		e.isKinesisStreamingDestinationUpToDate,
+		e.autoScaling.isUpToDate,
+	} {
+		upToDate, err := isUpToDate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !upToDate {
+			obs.ResourceUpToDate = false
+			break
+		}
+	}
+	return obs, nil
+}
+
+func (e *customExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Table)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// The table is updated before its other settings, because the capacity
+	// of a global secondary index can only be registered once the index
+	// exists. UpdateTable also fails when there is nothing to update, so
+	// it's only called when the table is out of date.
+	resp, err := e.client.DescribeTableWithContext(ctx, &svcsdk.DescribeTableInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalUpdate{}, aws.Wrap(err, errDescribe)
+	}
+	upToDate, err := isUpToDate(cr, resp)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !upToDate {
+		// A switch of the billing mode or table class that was refused
+		// because it was made too often is retried once AWS allows it.
+		u, err := e.external.Update(ctx, mg)
+		if reason := refusedTransition(cr, resp.Table, err, time.Now()); reason != "" {
+			cr.SetConditions(transitionCooldown(reason, err.Error()))
+			return managed.ExternalUpdate{}, nil
+		}
+		return u, err
+	}
+	for _, update := range []func(context.Context, *svcapitypes.Table) error{
+		e.updateTimeToLive,
+		e.updatePointInTimeRecovery,
+		e.updateKinesisStreamingDestination,
+		e.autoScaling.update,
+	} {
+		if err := update(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *customExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Table)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	// Scalable targets outlive the table, and deregistering them deletes
+	// their scaling policies.
+	if err := e.autoScaling.deregister(ctx, cr); err != nil {
+		return err
+	}
+	return e.external.Delete(ctx, mg)
+}
+
+func (e *customExternal) isTimeToLiveUpToDate(ctx context.Context, cr *svcapitypes.Table) (bool, error) {
+	if cr.Spec.ForProvider.TimeToLive == nil {
+		return true, nil
+	}
+	resp, err := e.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return false, aws.Wrap(err, errDescribeTimeToLive)
+	}
+	return isTimeToLiveUpToDate(cr.Spec.ForProvider.TimeToLive, resp.TimeToLiveDescription), nil
+}
+
+func (e *customExternal) updateTimeToLive(ctx context.Context, cr *svcapitypes.Table) error {
+	if cr.Spec.ForProvider.TimeToLive == nil {
+		return nil
+	}
+	resp, err := e.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return aws.Wrap(err, errDescribeTimeToLive)
+	}
+	if isTimeToLiveUpToDate(cr.Spec.ForProvider.TimeToLive, resp.TimeToLiveDescription) {
+		return nil
+	}
+	_, err = e.client.UpdateTimeToLiveWithContext(ctx, &svcsdk.UpdateTimeToLiveInput{
+		TableName:               aws.String(meta.GetExternalName(cr)),
+		TimeToLiveSpecification: generateTimeToLiveSpecification(cr.Spec.ForProvider.TimeToLive, resp.TimeToLiveDescription),
+	})
+	return aws.Wrap(err, errUpdateTimeToLive)
+}
+
+func (e *customExternal) isPointInTimeRecoveryUpToDate(ctx context.Context, cr *svcapitypes.Table) (bool, error) {
+	if cr.Spec.ForProvider.PointInTimeRecoveryEnabled == nil {
+		return true, nil
+	}
+	resp, err := e.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return false, aws.Wrap(err, errDescribeContinuousBackups)
+	}
+	return isPointInTimeRecoveryUpToDate(cr.Spec.ForProvider.PointInTimeRecoveryEnabled, resp.ContinuousBackupsDescription), nil
+}
+
+func (e *customExternal) updatePointInTimeRecovery(ctx context.Context, cr *svcapitypes.Table) error {
+	upToDate, err := e.isPointInTimeRecoveryUpToDate(ctx, cr)
+	if err != nil || upToDate {
+		return err
+	}
+	_, err = e.client.UpdateContinuousBackupsWithContext(ctx, &svcsdk.UpdateContinuousBackupsInput{
+		TableName: aws.String(meta.GetExternalName(cr)),
+		PointInTimeRecoverySpecification: &svcsdk.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: cr.Spec.ForProvider.PointInTimeRecoveryEnabled,
+		},
+	})
+	return aws.Wrap(err, errUpdateContinuousBackups)
+}
+
+func (e *customExternal) describeKinesisStreamingDestination(ctx context.Context, cr *svcapitypes.Table) (enable, disable []*string, err error) {
+	if cr.Spec.ForProvider.KinesisStreamARN == nil {
+		return nil, nil, nil
+	}
+	resp, err := e.client.DescribeKinesisStreamingDestinationWithContext(ctx, &svcsdk.DescribeKinesisStreamingDestinationInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return nil, nil, aws.Wrap(err, errDescribeKinesisStreaming)
+	}
+	enable, disable = generateKinesisStreamingDestinationChanges(aws.StringValue(cr.Spec.ForProvider.KinesisStreamARN), resp.KinesisDataStreamDestinations)
+	return enable, disable, nil
+}
+
+func (e *customExternal) isKinesisStreamingDestinationUpToDate(ctx context.Context, cr *svcapitypes.Table) (bool, error) {
+	enable, disable, err := e.describeKinesisStreamingDestination(ctx, cr)
+	return len(enable) == 0 && len(disable) == 0, err
+}
+
+// updateKinesisStreamingDestination makes a single change per call, since a
+// table can't stream to a destination while another one is being enabled or
+// disabled. Other destinations are disabled before the desired one is enabled.
+func (e *customExternal) updateKinesisStreamingDestination(ctx context.Context, cr *svcapitypes.Table) error {
+	enable, disable, err := e.describeKinesisStreamingDestination(ctx, cr)
+	switch {
+	case err != nil:
+		return err
+	case len(disable) > 0:
+		_, err = e.client.DisableKinesisStreamingDestinationWithContext(ctx, &svcsdk.DisableKinesisStreamingDestinationInput{
+			TableName: aws.String(meta.GetExternalName(cr)),
+			StreamArn: disable[0],
+		})
+		return aws.Wrap(err, errDisableKinesisStreaming)
+	case len(enable) > 0:
+		_, err = e.client.EnableKinesisStreamingDestinationWithContext(ctx, &svcsdk.EnableKinesisStreamingDestinationInput{
+			TableName: aws.String(meta.GetExternalName(cr)),
+			StreamArn: enable[0],
+		})
+		return aws.Wrap(err, errEnableKinesisStreaming)
+	}
+	return nil
+}
+
+// generateKinesisStreamingDestinationChanges returns the Kinesis data streams
+// that have to be enabled and disabled so that the table streams only to the
+// desired one. No changes are returned while a destination is being enabled
+// or disabled.
+func generateKinesisStreamingDestinationChanges(arn string, obs []*svcsdk.KinesisDataStreamDestination) (enable, disable []*string) {
+	active := false
+	for _, d := range obs {
+		switch aws.StringValue(d.DestinationStatus) {
+		case svcsdk.DestinationStatusEnabling, svcsdk.DestinationStatusDisabling:
+			return nil, nil
+		case svcsdk.DestinationStatusActive:
+			if aws.StringValue(d.StreamArn) == arn {
+				active = true
+				continue
+			}
+			disable = append(disable, d.StreamArn)
+		}
+	}
+	if !active {
+		enable = append(enable, aws.String(arn))
+	}
+	return enable, disable
+}
+
+// isTimeToLiveUpToDate checks whether the observed time to live of a table
+// matches the desired one. Time to live can't be changed while it is being
+// enabled or disabled, so it is considered up to date meanwhile.
+func isTimeToLiveUpToDate(spec *svcapitypes.TimeToLive, obs *svcsdk.TimeToLiveDescription) bool {
+	var status, attribute string
+	if obs != nil {
+		status, attribute = aws.StringValue(obs.TimeToLiveStatus), aws.StringValue(obs.AttributeName)
+	}
+	switch status {
+	case svcsdk.TimeToLiveStatusEnabling, svcsdk.TimeToLiveStatusDisabling:
+		return true
+	case svcsdk.TimeToLiveStatusEnabled:
+		return spec.Enabled && spec.AttributeName == attribute
+	}
+	return !spec.Enabled
+}
+
+// generateTimeToLiveSpecification returns the next update of a time to live
+// that isn't up to date. An enabled time to live is disabled first, with its
+// current attribute, since its attribute can't be changed while it's enabled.
+func generateTimeToLiveSpecification(spec *svcapitypes.TimeToLive, obs *svcsdk.TimeToLiveDescription) *svcsdk.TimeToLiveSpecification {
+	if obs != nil && aws.StringValue(obs.TimeToLiveStatus) == svcsdk.TimeToLiveStatusEnabled {
+		return &svcsdk.TimeToLiveSpecification{AttributeName: obs.AttributeName, Enabled: aws.Bool(false, aws.FieldRequired)}
+	}
+	return &svcsdk.TimeToLiveSpecification{AttributeName: aws.String(spec.AttributeName), Enabled: aws.Bool(true)}
+}
+
+// isPointInTimeRecoveryUpToDate checks whether point in time recovery is
+// enabled as desired.
+func isPointInTimeRecoveryUpToDate(spec *bool, obs *svcsdk.ContinuousBackupsDescription) bool {
+	enabled := obs != nil && obs.PointInTimeRecoveryDescription != nil &&
+		aws.StringValue(obs.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus) == svcsdk.PointInTimeRecoveryStatusEnabled
+	return aws.BoolValue(spec) == enabled
+}
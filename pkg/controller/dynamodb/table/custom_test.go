@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+)
+
+func TestIsTimeToLiveUpToDate(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.TimeToLive
+		obs  *svcsdk.TimeToLiveDescription
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Enabled": {
+			args: args{
+				spec: &v1alpha1.TimeToLive{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: true,
+		},
+		"DifferentAttribute": {
+			args: args{
+				spec: &v1alpha1.TimeToLive{AttributeName: "expiresAt", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: false,
+		},
+		"NotEnabled": {
+			args: args{
+				spec: &v1alpha1.TimeToLive{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+			},
+			want: false,
+		},
+		"Disabled": {
+			args: args{
+				spec: &v1alpha1.TimeToLive{AttributeName: "expiry"},
+				obs:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+			},
+			want: true,
+		},
+		"Disabling": {
+			args: args{
+				spec: &v1alpha1.TimeToLive{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabling)},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isTimeToLiveUpToDate(tc.args.spec, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTimeToLiveSpecification(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.TimeToLive
+		obs  *svcsdk.TimeToLiveDescription
+	}
+
+	cases := map[string]struct {
+		args args
+		want *svcsdk.TimeToLiveSpecification
+	}{
+		"Enable": {
+			args: args{
+				spec: &v1alpha1.TimeToLive{AttributeName: "expiry", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+			},
+			want: &svcsdk.TimeToLiveSpecification{AttributeName: aws.String("expiry"), Enabled: aws.Bool(true)},
+		},
+		"DisableBeforeChangingAttribute": {
+			args: args{
+				spec: &v1alpha1.TimeToLive{AttributeName: "expiresAt", Enabled: true},
+				obs:  &svcsdk.TimeToLiveDescription{AttributeName: aws.String("expiry"), TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			},
+			want: &svcsdk.TimeToLiveSpecification{AttributeName: aws.String("expiry"), Enabled: aws.Bool(false)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateTimeToLiveSpecification(tc.args.spec, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPointInTimeRecoveryUpToDate(t *testing.T) {
+	enabled := &svcsdk.ContinuousBackupsDescription{
+		PointInTimeRecoveryDescription: &svcsdk.PointInTimeRecoveryDescription{
+			PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusEnabled),
+		},
+	}
+
+	cases := map[string]struct {
+		spec *bool
+		obs  *svcsdk.ContinuousBackupsDescription
+		want bool
+	}{
+		"Enabled": {
+			spec: aws.Bool(true),
+			obs:  enabled,
+			want: true,
+		},
+		"NotEnabled": {
+			spec: aws.Bool(true),
+			obs:  &svcsdk.ContinuousBackupsDescription{},
+			want: false,
+		},
+		"NotDisabled": {
+			spec: aws.Bool(false),
+			obs:  enabled,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isPointInTimeRecoveryUpToDate(tc.spec, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateKinesisStreamingDestinationChanges(t *testing.T) {
+	desired := "arn:aws:kinesis:us-east-1:123456789012:stream/desired"
+	other := "arn:aws:kinesis:us-east-1:123456789012:stream/other"
+
+	type want struct {
+		enable  []*string
+		disable []*string
+	}
+
+	cases := map[string]struct {
+		obs  []*svcsdk.KinesisDataStreamDestination
+		want want
+	}{
+		"Active": {
+			obs: []*svcsdk.KinesisDataStreamDestination{
+				{StreamArn: aws.String(desired), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+				{StreamArn: aws.String(other), DestinationStatus: aws.String(svcsdk.DestinationStatusDisabled)},
+			},
+		},
+		"NotEnabled": {
+			obs: []*svcsdk.KinesisDataStreamDestination{
+				{StreamArn: aws.String(desired), DestinationStatus: aws.String(svcsdk.DestinationStatusEnableFailed)},
+			},
+			want: want{enable: []*string{aws.String(desired)}},
+		},
+		"OtherActive": {
+			obs: []*svcsdk.KinesisDataStreamDestination{
+				{StreamArn: aws.String(other), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+			},
+			want: want{enable: []*string{aws.String(desired)}, disable: []*string{aws.String(other)}},
+		},
+		"Enabling": {
+			obs: []*svcsdk.KinesisDataStreamDestination{
+				{StreamArn: aws.String(desired), DestinationStatus: aws.String(svcsdk.DestinationStatusEnabling)},
+				{StreamArn: aws.String(other), DestinationStatus: aws.String(svcsdk.DestinationStatusActive)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			enable, disable := generateKinesisStreamingDestinationChanges(desired, tc.obs)
+			if diff := cmp.Diff(tc.want.enable, enable); diff != "" {
+				t.Errorf("enable: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disable, disable); diff != "" {
+				t.Errorf("disable: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -68,11 +68,13 @@ func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 		For(&svcapitypes.Table{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.TableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &customConnector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(
 				managed.NewNameAsExternalName(mgr.GetClient()),
 				managed.NewDefaultProviderConfig(mgr.GetClient()),
 				&tagger{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -84,6 +86,7 @@ func preObserve(_ context.Context, cr *svcapitypes.Table, obj *svcsdk.DescribeTa
 }
 func preCreate(_ context.Context, cr *svcapitypes.Table, obj *svcsdk.CreateTableInput) error {
 	obj.TableName = aws.String(meta.GetExternalName(cr))
+	obj.TableClass = cr.Spec.ForProvider.TableClass
 	return nil
 }
 func preDelete(_ context.Context, cr *svcapitypes.Table, obj *svcsdk.DeleteTableInput) (bool, error) {
@@ -227,6 +230,9 @@ func lateInitialize(in *svcapitypes.TableParameters, t *svcsdk.DescribeTableOutp
 			}
 		}
 	}
+	if in.Replicas == nil && len(t.Table.Replicas) != 0 {
+		in.Replicas = buildReplicas(t.Table.Replicas)
+	}
 
 	return nil
 }
@@ -299,6 +305,36 @@ func buildLocalIndexes(indexes []*svcsdk.LocalSecondaryIndexDescription) []*svca
 	return localSecondaryIndexes
 }
 
+func buildReplicas(replicas []*svcsdk.ReplicaDescription) []*svcapitypes.TableReplica {
+	if len(replicas) == 0 {
+		return nil
+	}
+	tableReplicas := make([]*svcapitypes.TableReplica, len(replicas))
+	for i, val := range replicas {
+		tableReplicas[i] = &svcapitypes.TableReplica{
+			RegionName:     aws.StringValue(val.RegionName),
+			KMSMasterKeyID: val.KMSMasterKeyId,
+		}
+		if val.ProvisionedThroughputOverride != nil {
+			tableReplicas[i].ProvisionedThroughputOverride = &svcapitypes.ProvisionedThroughputOverride{
+				ReadCapacityUnits: val.ProvisionedThroughputOverride.ReadCapacityUnits,
+			}
+		}
+		for _, gsi := range val.GlobalSecondaryIndexes {
+			if gsi.ProvisionedThroughputOverride == nil {
+				continue
+			}
+			tableReplicas[i].GlobalSecondaryIndexes = append(tableReplicas[i].GlobalSecondaryIndexes, &svcapitypes.ReplicaGlobalSecondaryIndex{
+				IndexName: gsi.IndexName,
+				ProvisionedThroughputOverride: &svcapitypes.ProvisionedThroughputOverride{
+					ReadCapacityUnits: gsi.ProvisionedThroughputOverride.ReadCapacityUnits,
+				},
+			})
+		}
+	}
+	return tableReplicas
+}
+
 // createPatch creates a *svcapitypes.TableParameters that has only the changed
 // values between the target *svcapitypes.TableParameters and the current
 // *dynamodb.TableDescription
@@ -334,7 +370,17 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 		return true, nil
 	}
 
-	patch, err := createPatch(resp, &cr.Spec.ForProvider)
+	// Replicas can't be added, updated or removed while another replica is
+	// being created, updated or deleted.
+	if isReplicaTransitioning(resp.Table.Replicas) {
+		return true, nil
+	}
+
+	// A switch of the billing mode that AWS wouldn't allow yet is postponed
+	// and reported as a condition.
+	params, cooldown := withBillingModeCooldown(withAutoScaledCapacity(&cr.Spec.ForProvider, resp.Table), resp.Table, time.Now())
+	setTransitionCondition(cr, resp.Table, cooldown)
+	patch, err := createPatch(resp, params)
 	if err != nil {
 		return false, err
 	}
@@ -348,6 +394,8 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 	switch {
 	case patch.BillingMode != nil:
 		return false, nil
+	case !isTableClassUpToDate(params.TableClass, resp.Table.TableClassSummary):
+		return false, nil
 	case patch.ProvisionedThroughput != nil:
 		// TODO(negz): DescribeTableOutput appears to report that
 		// ProvisionedThroughput is 0 when the billing mode is set to
@@ -358,9 +406,11 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 		// you can't set provisioned throughput when the billing mode is
 		// set to PAY_PER_REQUEST.
 		return false, nil
-	case patch.StreamSpecification != nil:
+	case !isStreamSpecificationUpToDate(params.StreamSpecification, resp.Table.StreamSpecification):
 		return false, nil
-	case len(diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), resp.Table.GlobalSecondaryIndexes)) != 0:
+	case len(diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(params.GlobalSecondaryIndexes), resp.Table.GlobalSecondaryIndexes)) != 0:
+		return false, nil
+	case len(diffReplicas(cr.Spec.ForProvider.Replicas, resp.Table.Replicas)) != 0:
 		return false, nil
 	}
 	return true, nil
@@ -393,11 +443,14 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 		return aws.Wrap(err, errDescribe)
 	}
 
-	p, err := createPatch(out, &cr.Spec.ForProvider)
+	// The scaled capacities are left to their scaling policies.
+	params, _ := withBillingModeCooldown(withAutoScaledCapacity(&cr.Spec.ForProvider, out.Table), out.Table, time.Now())
+	p, err := createPatch(out, params)
 	if err != nil {
 		return err
 	}
-	gsiUpdates := diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), out.Table.GlobalSecondaryIndexes)
+	gsiUpdates := diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(params.GlobalSecondaryIndexes), out.Table.GlobalSecondaryIndexes)
+	replicaUpdates := diffReplicas(cr.Spec.ForProvider.Replicas, out.Table.Replicas)
 	switch {
 	case p.BillingMode != nil:
 		filtered.BillingMode = u.BillingMode
@@ -407,14 +460,19 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 		if aws.StringValue(u.BillingMode) == string(svcapitypes.BillingMode_PROVISIONED) {
 			filtered.ProvisionedThroughput = u.ProvisionedThroughput
 		}
+	case !isTableClassUpToDate(params.TableClass, out.Table.TableClassSummary):
+		filtered.TableClass = params.TableClass
 	case p.ProvisionedThroughput != nil:
 		// NOTE(negz): You may only included provisioned throughput when
 		// the billing mode is PROVISIONED.
-		filtered.ProvisionedThroughput = u.ProvisionedThroughput
-	case p.StreamSpecification != nil:
+		filtered.ProvisionedThroughput = &svcsdk.ProvisionedThroughput{
+			ReadCapacityUnits:  params.ProvisionedThroughput.ReadCapacityUnits,
+			WriteCapacityUnits: params.ProvisionedThroughput.WriteCapacityUnits,
+		}
+	case !isStreamSpecificationUpToDate(params.StreamSpecification, out.Table.StreamSpecification):
 		// NOTE(muvaf): Unless StreamEnabled is changed, updating stream
 		// specification won't work.
-		filtered.StreamSpecification = u.StreamSpecification
+		filtered.StreamSpecification = generateStreamSpecificationUpdate(params.StreamSpecification, out.Table.StreamSpecification)
 	case p.SSESpecification != nil:
 		// NOTE(negz): Attempting to update the KMSMasterKeyId to its
 		// current value returns an error
@@ -427,6 +485,8 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 		}
 	case len(gsiUpdates) != 0:
 		filtered.SetGlobalSecondaryIndexUpdates(gsiUpdates)
+	case len(replicaUpdates) != 0:
+		filtered.SetReplicaUpdates(replicaUpdates)
 	}
 
 	*u = *filtered
@@ -509,6 +569,166 @@ func diffGlobalSecondaryIndexes(spec []*svcsdk.GlobalSecondaryIndexDescription,
 	return nil
 }
 
+// isStreamSpecificationUpToDate checks whether the stream of a table is enabled
+// as desired, with the desired view type. The view type of a disabled stream
+// isn't reported, so it's only compared when the stream is enabled.
+func isStreamSpecificationUpToDate(spec *svcapitypes.StreamSpecification, obs *svcsdk.StreamSpecification) bool {
+	if spec == nil {
+		return true
+	}
+	enabled := obs != nil && aws.BoolValue(obs.StreamEnabled)
+	if aws.BoolValue(spec.StreamEnabled) != enabled {
+		return false
+	}
+	return !enabled || spec.StreamViewType == nil || aws.StringValue(spec.StreamViewType) == aws.StringValue(obs.StreamViewType)
+}
+
+// generateStreamSpecificationUpdate returns the next update of a stream that
+// isn't up to date. The view type of an enabled stream can't be changed, so
+// the stream is disabled first and enabled with the desired view type by the
+// following update.
+func generateStreamSpecificationUpdate(spec *svcapitypes.StreamSpecification, obs *svcsdk.StreamSpecification) *svcsdk.StreamSpecification {
+	if obs != nil && aws.BoolValue(obs.StreamEnabled) {
+		return &svcsdk.StreamSpecification{StreamEnabled: aws.Bool(false, aws.FieldRequired)}
+	}
+	return &svcsdk.StreamSpecification{StreamEnabled: aws.Bool(true), StreamViewType: spec.StreamViewType}
+}
+
+func isReplicaTransitioning(replicas []*svcsdk.ReplicaDescription) bool {
+	for _, r := range replicas {
+		switch aws.StringValue(r.ReplicaStatus) {
+		case svcsdk.ReplicaStatusCreating, svcsdk.ReplicaStatusUpdating, svcsdk.ReplicaStatusDeleting:
+			return true
+		}
+	}
+	return false
+}
+
+// diffReplicas returns the next update that brings the observed replicas of a
+// table closer to the desired ones. Like global secondary indexes, replicas
+// are created and deleted one at a time, so creations are returned first,
+// then updates of existing replicas and lastly a deletion.
+func diffReplicas(spec []*svcapitypes.TableReplica, obs []*svcsdk.ReplicaDescription) []*svcsdk.ReplicationGroupUpdate { //nolint:gocyclo
+	desired := map[string]*svcapitypes.TableReplica{}
+	desiredKeys := make([]string, len(spec))
+	for i, r := range spec {
+		desired[r.RegionName] = r
+		desiredKeys[i] = r.RegionName
+	}
+	existing := map[string]*svcsdk.ReplicaDescription{}
+	existingKeys := make([]string, len(obs))
+	for i, r := range obs {
+		existing[aws.StringValue(r.RegionName)] = r
+		existingKeys[i] = aws.StringValue(r.RegionName)
+	}
+	sort.Strings(desiredKeys)
+	sort.Strings(existingKeys)
+
+	for _, k := range desiredKeys {
+		if _, ok := existing[k]; !ok {
+			return []*svcsdk.ReplicationGroupUpdate{
+				{
+					Create: &svcsdk.CreateReplicationGroupMemberAction{
+						RegionName:                    aws.String(k),
+						KMSMasterKeyId:                desired[k].KMSMasterKeyID,
+						ProvisionedThroughputOverride: generateProvisionedThroughputOverride(desired[k].ProvisionedThroughputOverride),
+						GlobalSecondaryIndexes:        generateReplicaGlobalSecondaryIndexes(desired[k].GlobalSecondaryIndexes),
+					},
+				},
+			}
+		}
+	}
+	for _, k := range desiredKeys {
+		if isReplicaUpToDate(desired[k], existing[k]) {
+			continue
+		}
+		u := &svcsdk.UpdateReplicationGroupMemberAction{
+			RegionName:                    aws.String(k),
+			ProvisionedThroughputOverride: generateProvisionedThroughputOverride(desired[k].ProvisionedThroughputOverride),
+			GlobalSecondaryIndexes:        generateReplicaGlobalSecondaryIndexes(desired[k].GlobalSecondaryIndexes),
+		}
+		// NOTE: Like the KMS key of the table, the KMS key of a replica
+		// can't be updated to its current value.
+		if !isKMSKeyUpToDate(desired[k].KMSMasterKeyID, existing[k].KMSMasterKeyId) {
+			u.KMSMasterKeyId = desired[k].KMSMasterKeyID
+		}
+		return []*svcsdk.ReplicationGroupUpdate{{Update: u}}
+	}
+	for _, k := range existingKeys {
+		if _, ok := desired[k]; !ok {
+			return []*svcsdk.ReplicationGroupUpdate{
+				{
+					Delete: &svcsdk.DeleteReplicationGroupMemberAction{
+						RegionName: aws.String(k),
+					},
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// isKMSKeyUpToDate returns true if the desired KMS key is not set or matches
+// the observed one. DynamoDB reports the KMS key of a replica as an ARN, so a
+// desired key ID matches the ARN that ends with it.
+func isKMSKeyUpToDate(desired, observed *string) bool {
+	if desired == nil {
+		return true
+	}
+	key, obs := aws.StringValue(desired), aws.StringValue(observed)
+	return key == obs || strings.HasSuffix(obs, "/"+key)
+}
+
+// isReplicaUpToDate compares the settings of a replica that are set in its
+// spec with the observed replica.
+func isReplicaUpToDate(spec *svcapitypes.TableReplica, obs *svcsdk.ReplicaDescription) bool {
+	if !isKMSKeyUpToDate(spec.KMSMasterKeyID, obs.KMSMasterKeyId) {
+		return false
+	}
+	if spec.ProvisionedThroughputOverride != nil {
+		if obs.ProvisionedThroughputOverride == nil ||
+			aws.Int64Value(spec.ProvisionedThroughputOverride.ReadCapacityUnits) != aws.Int64Value(obs.ProvisionedThroughputOverride.ReadCapacityUnits) {
+			return false
+		}
+	}
+	indexes := map[string]*svcsdk.ReplicaGlobalSecondaryIndexDescription{}
+	for _, gsi := range obs.GlobalSecondaryIndexes {
+		indexes[aws.StringValue(gsi.IndexName)] = gsi
+	}
+	for _, gsi := range spec.GlobalSecondaryIndexes {
+		if gsi.ProvisionedThroughputOverride == nil {
+			continue
+		}
+		o, ok := indexes[aws.StringValue(gsi.IndexName)]
+		if !ok || o.ProvisionedThroughputOverride == nil ||
+			aws.Int64Value(gsi.ProvisionedThroughputOverride.ReadCapacityUnits) != aws.Int64Value(o.ProvisionedThroughputOverride.ReadCapacityUnits) {
+			return false
+		}
+	}
+	return true
+}
+
+func generateProvisionedThroughputOverride(p *svcapitypes.ProvisionedThroughputOverride) *svcsdk.ProvisionedThroughputOverride {
+	if p == nil {
+		return nil
+	}
+	return &svcsdk.ProvisionedThroughputOverride{ReadCapacityUnits: p.ReadCapacityUnits}
+}
+
+func generateReplicaGlobalSecondaryIndexes(p []*svcapitypes.ReplicaGlobalSecondaryIndex) []*svcsdk.ReplicaGlobalSecondaryIndex {
+	if len(p) == 0 {
+		return nil
+	}
+	result := make([]*svcsdk.ReplicaGlobalSecondaryIndex, len(p))
+	for i, gsi := range p {
+		result[i] = &svcsdk.ReplicaGlobalSecondaryIndex{
+			IndexName:                     gsi.IndexName,
+			ProvisionedThroughputOverride: generateProvisionedThroughputOverride(gsi.ProvisionedThroughputOverride),
+		}
+	}
+	return result
+}
+
 // GenerateGlobalSecondaryIndexDescriptions generates an array of GlobalSecondaryIndexDescriptions.
 func GenerateGlobalSecondaryIndexDescriptions(p []*svcapitypes.GlobalSecondaryIndex) []*svcsdk.GlobalSecondaryIndexDescription { // nolint:gocyclo
 	// Linter is disabled because this is a copy-paste from generated code and
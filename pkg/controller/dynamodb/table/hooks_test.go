@@ -170,6 +170,123 @@ func TestIsUpToDate(t *testing.T) {
 				result: false,
 			},
 		},
+		"AutoScaledCapacity": {
+			args: args{
+				t: svcsdk.DescribeTableOutput{
+					Table: &svcsdk.TableDescription{
+						ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{
+							ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits + 4)),
+							WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+						},
+					},
+				},
+				p: v1alpha1.Table{
+					Spec: v1alpha1.TableSpec{
+						ForProvider: v1alpha1.TableParameters{
+							ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+								ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits)),
+								WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+							},
+							CustomTableParameters: v1alpha1.CustomTableParameters{
+								AutoScaling: &v1alpha1.TableAutoScaling{
+									ReadCapacity: &v1alpha1.CapacityAutoScaling{MinCapacity: 1, MaxCapacity: 10, TargetUtilization: 70},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"StreamViewTypeChanged": {
+			args: args{
+				t: svcsdk.DescribeTableOutput{
+					Table: &svcsdk.TableDescription{
+						StreamSpecification: &svcsdk.StreamSpecification{
+							StreamEnabled:  aws.Bool(true),
+							StreamViewType: aws.String(svcsdk.StreamViewTypeKeysOnly),
+						},
+					},
+				},
+				p: v1alpha1.Table{
+					Spec: v1alpha1.TableSpec{
+						ForProvider: v1alpha1.TableParameters{
+							StreamSpecification: &v1alpha1.StreamSpecification{
+								StreamEnabled:  aws.Bool(true),
+								StreamViewType: aws.String(svcsdk.StreamViewTypeNewAndOldImages),
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"DisabledStreamViewType": {
+			args: args{
+				t: svcsdk.DescribeTableOutput{
+					Table: &svcsdk.TableDescription{},
+				},
+				p: v1alpha1.Table{
+					Spec: v1alpha1.TableSpec{
+						ForProvider: v1alpha1.TableParameters{
+							StreamSpecification: &v1alpha1.StreamSpecification{
+								StreamEnabled:  aws.Bool(false),
+								StreamViewType: aws.String(svcsdk.StreamViewTypeNewAndOldImages),
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"MissingReplica": {
+			args: args{
+				t: svcsdk.DescribeTableOutput{
+					Table: &svcsdk.TableDescription{},
+				},
+				p: v1alpha1.Table{
+					Spec: v1alpha1.TableSpec{
+						ForProvider: v1alpha1.TableParameters{
+							CustomTableParameters: v1alpha1.CustomTableParameters{
+								Replicas: []*v1alpha1.TableReplica{{RegionName: "us-west-2"}},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"ReplicaCreating": {
+			args: args{
+				t: svcsdk.DescribeTableOutput{
+					Table: &svcsdk.TableDescription{
+						Replicas: []*svcsdk.ReplicaDescription{
+							{RegionName: aws.String("us-west-2"), ReplicaStatus: aws.String(svcsdk.ReplicaStatusCreating)},
+						},
+					},
+				},
+				p: v1alpha1.Table{
+					Spec: v1alpha1.TableSpec{
+						ForProvider: v1alpha1.TableParameters{
+							CustomTableParameters: v1alpha1.CustomTableParameters{
+								Replicas: []*v1alpha1.TableReplica{{RegionName: "us-west-2"}, {RegionName: "eu-west-1"}},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -618,3 +735,204 @@ func TestDiffGlobalSecondaryIndexes(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffReplicas(t *testing.T) {
+	keyARN := "arn:aws:kms:us-west-2:123456789012:key/1234abcd"
+	type args struct {
+		spec []*svcapitypes.TableReplica
+		obs  []*svcsdk.ReplicaDescription
+	}
+	type want struct {
+		result []*svcsdk.ReplicationGroupUpdate
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoOp": {
+			args: args{
+				spec: []*svcapitypes.TableReplica{
+					{
+						RegionName:     "us-west-2",
+						KMSMasterKeyID: aws.String("1234abcd"),
+					},
+				},
+				obs: []*svcsdk.ReplicaDescription{
+					{
+						RegionName:     aws.String("us-west-2"),
+						KMSMasterKeyId: aws.String(keyARN),
+					},
+				},
+			},
+		},
+		"CreateOnlyOne": {
+			args: args{
+				spec: []*svcapitypes.TableReplica{
+					{
+						RegionName: "us-west-2",
+						ProvisionedThroughputOverride: &svcapitypes.ProvisionedThroughputOverride{
+							ReadCapacityUnits: aws.Int64(10),
+						},
+					},
+					{
+						RegionName: "eu-west-1",
+					},
+				},
+			},
+			want: want{
+				result: []*svcsdk.ReplicationGroupUpdate{
+					{
+						Create: &svcsdk.CreateReplicationGroupMemberAction{
+							RegionName: aws.String("eu-west-1"),
+						},
+					},
+				},
+			},
+		},
+		"Update": {
+			args: args{
+				spec: []*svcapitypes.TableReplica{
+					{
+						RegionName:     "us-west-2",
+						KMSMasterKeyID: aws.String(keyARN),
+						ProvisionedThroughputOverride: &svcapitypes.ProvisionedThroughputOverride{
+							ReadCapacityUnits: aws.Int64(10),
+						},
+					},
+				},
+				obs: []*svcsdk.ReplicaDescription{
+					{
+						RegionName:     aws.String("us-west-2"),
+						KMSMasterKeyId: aws.String(keyARN),
+						ProvisionedThroughputOverride: &svcsdk.ProvisionedThroughputOverride{
+							ReadCapacityUnits: aws.Int64(5),
+						},
+					},
+				},
+			},
+			want: want{
+				result: []*svcsdk.ReplicationGroupUpdate{
+					{
+						Update: &svcsdk.UpdateReplicationGroupMemberAction{
+							RegionName: aws.String("us-west-2"),
+							ProvisionedThroughputOverride: &svcsdk.ProvisionedThroughputOverride{
+								ReadCapacityUnits: aws.Int64(10),
+							},
+						},
+					},
+				},
+			},
+		},
+		"UpdateIndexOverride": {
+			args: args{
+				spec: []*svcapitypes.TableReplica{
+					{
+						RegionName: "us-west-2",
+						GlobalSecondaryIndexes: []*svcapitypes.ReplicaGlobalSecondaryIndex{
+							{
+								IndexName: aws.String("one"),
+								ProvisionedThroughputOverride: &svcapitypes.ProvisionedThroughputOverride{
+									ReadCapacityUnits: aws.Int64(10),
+								},
+							},
+						},
+					},
+				},
+				obs: []*svcsdk.ReplicaDescription{
+					{
+						RegionName: aws.String("us-west-2"),
+						GlobalSecondaryIndexes: []*svcsdk.ReplicaGlobalSecondaryIndexDescription{
+							{
+								IndexName: aws.String("one"),
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: []*svcsdk.ReplicationGroupUpdate{
+					{
+						Update: &svcsdk.UpdateReplicationGroupMemberAction{
+							RegionName: aws.String("us-west-2"),
+							GlobalSecondaryIndexes: []*svcsdk.ReplicaGlobalSecondaryIndex{
+								{
+									IndexName: aws.String("one"),
+									ProvisionedThroughputOverride: &svcsdk.ProvisionedThroughputOverride{
+										ReadCapacityUnits: aws.Int64(10),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"DeleteOnlyOne": {
+			args: args{
+				obs: []*svcsdk.ReplicaDescription{
+					{
+						RegionName: aws.String("us-west-2"),
+					},
+					{
+						RegionName: aws.String("eu-west-1"),
+					},
+				},
+			},
+			want: want{
+				result: []*svcsdk.ReplicationGroupUpdate{
+					{
+						Delete: &svcsdk.DeleteReplicationGroupMemberAction{
+							RegionName: aws.String("eu-west-1"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := diffReplicas(tc.args.spec, tc.args.obs)
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("diffReplicas(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateStreamSpecificationUpdate(t *testing.T) {
+	spec := &v1alpha1.StreamSpecification{
+		StreamEnabled:  aws.Bool(true),
+		StreamViewType: aws.String(svcsdk.StreamViewTypeNewAndOldImages),
+	}
+
+	cases := map[string]struct {
+		obs  *svcsdk.StreamSpecification
+		want *svcsdk.StreamSpecification
+	}{
+		"Disabled": {
+			want: &svcsdk.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: aws.String(svcsdk.StreamViewTypeNewAndOldImages),
+			},
+		},
+		"EnabledWithOtherViewType": {
+			obs: &svcsdk.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: aws.String(svcsdk.StreamViewTypeKeysOnly),
+			},
+			want: &svcsdk.StreamSpecification{
+				StreamEnabled: aws.Bool(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateStreamSpecificationUpdate(spec, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
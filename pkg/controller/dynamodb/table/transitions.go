@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"fmt"
+	"time"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AWS allows to switch the billing mode of a table once per 24 hours.
+const billingModeCooldown = 24 * time.Hour
+
+// errCodeLimitExceeded is returned when a switch is refused because it was
+// made too often.
+const errCodeLimitExceeded = "LimitExceededException"
+
+// transitionCooldown returns a condition that indicates the table is waiting
+// to switch its billing mode or table class.
+func transitionCooldown(reason xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               svcapitypes.TypeTransitionCooldown,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            msg,
+	}
+}
+
+// transitionAllowed returns a condition that indicates the table is no
+// longer waiting to switch its billing mode or table class.
+func transitionAllowed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               svcapitypes.TypeTransitionCooldown,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             svcapitypes.ReasonTransitionAllowed,
+	}
+}
+
+// observedBillingMode returns the billing mode of a table. The billing mode
+// summary is omitted when the billing mode was never set to PAY_PER_REQUEST.
+func observedBillingMode(t *svcsdk.TableDescription) string {
+	if t.BillingModeSummary != nil && t.BillingModeSummary.BillingMode != nil {
+		return aws.StringValue(t.BillingModeSummary.BillingMode)
+	}
+	return svcsdk.BillingModeProvisioned
+}
+
+// isTableClassUpToDate checks whether a table has the desired class. The
+// class summary is omitted when the class was never changed from STANDARD.
+func isTableClassUpToDate(spec *string, obs *svcsdk.TableClassSummary) bool {
+	if spec == nil {
+		return true
+	}
+	class := svcsdk.TableClassStandard
+	if obs != nil && obs.TableClass != nil {
+		class = aws.StringValue(obs.TableClass)
+	}
+	return aws.StringValue(spec) == class
+}
+
+// pendingTransition returns the cooldown that would refuse the next update of
+// a table, if that update switches its billing mode or table class. The
+// billing mode is switched before the table class.
+func pendingTransition(p *svcapitypes.TableParameters, t *svcsdk.TableDescription) xpv1.ConditionReason {
+	switch {
+	case p.BillingMode != nil && aws.StringValue(p.BillingMode) != observedBillingMode(t):
+		return svcapitypes.ReasonBillingModeCooldown
+	case !isTableClassUpToDate(p.TableClass, t.TableClassSummary):
+		return svcapitypes.ReasonTableClassCooldown
+	}
+	return ""
+}
+
+// withBillingModeCooldown returns the supplied parameters with the observed
+// billing mode and provisioned throughput while the billing mode of the table
+// can't be switched, so that the switch is postponed rather than refused. The
+// condition to report is returned along with them. DescribeTable only reports
+// the last switch to PAY_PER_REQUEST, so a later switch back to PROVISIONED
+// is left to the API to refuse.
+func withBillingModeCooldown(p *svcapitypes.TableParameters, t *svcsdk.TableDescription, now time.Time) (*svcapitypes.TableParameters, *xpv1.Condition) {
+	if pendingTransition(p, t) != svcapitypes.ReasonBillingModeCooldown ||
+		t.BillingModeSummary == nil || t.BillingModeSummary.LastUpdateToPayPerRequestDateTime == nil {
+		return p, nil
+	}
+	allowed := t.BillingModeSummary.LastUpdateToPayPerRequestDateTime.Add(billingModeCooldown)
+	if !now.Before(allowed) {
+		return p, nil
+	}
+	out := p.DeepCopy()
+	out.BillingMode = aws.String(observedBillingMode(t))
+	out.ProvisionedThroughput = nil
+	if t.ProvisionedThroughput != nil {
+		out.ProvisionedThroughput = &svcapitypes.ProvisionedThroughput{
+			ReadCapacityUnits:  t.ProvisionedThroughput.ReadCapacityUnits,
+			WriteCapacityUnits: t.ProvisionedThroughput.WriteCapacityUnits,
+		}
+	}
+	observed := map[string]*svcsdk.GlobalSecondaryIndexDescription{}
+	for _, gsi := range t.GlobalSecondaryIndexes {
+		observed[aws.StringValue(gsi.IndexName)] = gsi
+	}
+	for _, gsi := range out.GlobalSecondaryIndexes {
+		o, ok := observed[aws.StringValue(gsi.IndexName)]
+		if !ok || o.ProvisionedThroughput == nil || gsi.ProvisionedThroughput == nil {
+			continue
+		}
+		gsi.ProvisionedThroughput.ReadCapacityUnits = o.ProvisionedThroughput.ReadCapacityUnits
+		gsi.ProvisionedThroughput.WriteCapacityUnits = o.ProvisionedThroughput.WriteCapacityUnits
+	}
+	c := transitionCooldown(svcapitypes.ReasonBillingModeCooldown,
+		fmt.Sprintf("billing mode can be switched to %s after %s", aws.StringValue(p.BillingMode), allowed.UTC().Format(time.RFC3339)))
+	return out, &c
+}
+
+// setTransitionCondition reports whether the table is waiting to switch its
+// billing mode or table class. The condition is only added once a switch has
+// to wait, and cleared once there is nothing left to switch.
+func setTransitionCondition(cr *svcapitypes.Table, t *svcsdk.TableDescription, cooldown *xpv1.Condition) {
+	switch {
+	case cooldown != nil:
+		cr.SetConditions(*cooldown)
+	case pendingTransition(&cr.Spec.ForProvider, t) == "" && cr.GetCondition(svcapitypes.TypeTransitionCooldown).Status == corev1.ConditionTrue:
+		cr.SetConditions(transitionAllowed())
+	}
+}
+
+// refusedTransition returns the cooldown that caused an update of the table
+// to be refused, if the update switched its billing mode or table class.
+func refusedTransition(cr *svcapitypes.Table, t *svcsdk.TableDescription, err error, now time.Time) xpv1.ConditionReason {
+	if code, ok := aws.ErrorCode(err); !ok || code != errCodeLimitExceeded {
+		return ""
+	}
+	params, _ := withBillingModeCooldown(&cr.Spec.ForProvider, t, now)
+	return pendingTransition(params, t)
+}
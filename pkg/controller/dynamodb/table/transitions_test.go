@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+)
+
+func TestIsTableClassUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec *string
+		obs  *svcsdk.TableClassSummary
+		want bool
+	}{
+		"Omitted": {
+			obs:  &svcsdk.TableClassSummary{TableClass: aws.String(svcsdk.TableClassStandardInfrequentAccess)},
+			want: true,
+		},
+		"ImpliedStandard": {
+			spec: aws.String(svcsdk.TableClassStandard),
+			want: true,
+		},
+		"DifferentClass": {
+			spec: aws.String(svcsdk.TableClassStandardInfrequentAccess),
+			obs:  &svcsdk.TableClassSummary{TableClass: aws.String(svcsdk.TableClassStandard)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isTableClassUpToDate(tc.spec, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithBillingModeCooldown(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	switched := func(ago time.Duration) *svcsdk.TableDescription {
+		return &svcsdk.TableDescription{
+			BillingModeSummary: &svcsdk.BillingModeSummary{
+				BillingMode:                       aws.String(svcsdk.BillingModeProvisioned),
+				LastUpdateToPayPerRequestDateTime: aws.Time(now.Add(-ago)),
+			},
+			ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{
+				ReadCapacityUnits:  aws.Int64(5),
+				WriteCapacityUnits: aws.Int64(5),
+			},
+		}
+	}
+	params := &v1alpha1.TableParameters{
+		BillingMode: aws.String(svcsdk.BillingModePayPerRequest),
+	}
+
+	type want struct {
+		params *v1alpha1.TableParameters
+		reason xpv1.ConditionReason
+	}
+
+	cases := map[string]struct {
+		params *v1alpha1.TableParameters
+		table  *svcsdk.TableDescription
+		want   want
+	}{
+		"CoolingDown": {
+			params: params,
+			table:  switched(time.Hour),
+			want: want{
+				params: &v1alpha1.TableParameters{
+					BillingMode: aws.String(svcsdk.BillingModeProvisioned),
+					ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(5),
+						WriteCapacityUnits: aws.Int64(5),
+					},
+				},
+				reason: v1alpha1.ReasonBillingModeCooldown,
+			},
+		},
+		"CooledDown": {
+			params: params,
+			table:  switched(25 * time.Hour),
+			want:   want{params: params},
+		},
+		"NeverSwitched": {
+			params: params,
+			table:  &svcsdk.TableDescription{},
+			want:   want{params: params},
+		},
+		"NoSwitch": {
+			params: &v1alpha1.TableParameters{BillingMode: aws.String(svcsdk.BillingModeProvisioned)},
+			table:  switched(time.Hour),
+			want:   want{params: &v1alpha1.TableParameters{BillingMode: aws.String(svcsdk.BillingModeProvisioned)}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, c := withBillingModeCooldown(tc.params, tc.table, now)
+			if diff := cmp.Diff(tc.want.params, got); diff != "" {
+				t.Errorf("params: -want, +got:\n%s", diff)
+			}
+			var reason xpv1.ConditionReason
+			if c != nil {
+				reason = c.Reason
+			}
+			if diff := cmp.Diff(tc.want.reason, reason); diff != "" {
+				t.Errorf("reason: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRefusedTransition(t *testing.T) {
+	limitExceeded := awserr.New(errCodeLimitExceeded, "too many switches", nil)
+	table := &svcsdk.TableDescription{
+		TableClassSummary: &svcsdk.TableClassSummary{TableClass: aws.String(svcsdk.TableClassStandard)},
+	}
+	withClass := func(class string) *v1alpha1.Table {
+		cr := &v1alpha1.Table{}
+		cr.Spec.ForProvider.TableClass = aws.String(class)
+		return cr
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.Table
+		err  error
+		want xpv1.ConditionReason
+	}{
+		"TableClassRefused": {
+			cr:   withClass(svcsdk.TableClassStandardInfrequentAccess),
+			err:  errors.Wrap(limitExceeded, errUpdate),
+			want: v1alpha1.ReasonTableClassCooldown,
+		},
+		"OtherUpdateRefused": {
+			cr:  withClass(svcsdk.TableClassStandard),
+			err: errors.Wrap(limitExceeded, errUpdate),
+		},
+		"OtherError": {
+			cr:  withClass(svcsdk.TableClassStandardInfrequentAccess),
+			err: errors.New("boom"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := refusedTransition(tc.cr, table, tc.err, time.Now())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
 )
 
 const (
@@ -54,6 +55,7 @@ const (
 	errCreateTags    = "failed to create tags for the Address resource"
 	errDelete        = "failed to delete the Address resource"
 	errStatusUpdate  = "cannot update status of Address custom resource"
+	errQuota         = "cannot check the EC2-VPC Elastic IPs service quota"
 )
 
 // SetupAddress adds a controller that reconciles Address.
@@ -67,7 +69,8 @@ func SetupAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 		For(&v1beta1.Address{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.AddressGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient()}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithCreationGracePeriod(3*time.Minute),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
@@ -90,12 +93,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: awsec2.NewFromConfig(*cfg), kube: c.kube}, nil
+	e := &external{client: awsec2.NewFromConfig(*cfg), kube: c.kube}
+	enabled, err := servicequotas.Enabled(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		e.quota = servicequotas.NewChecker(c.kube, servicequotas.NewClient(*cfg))
+	}
+	return e, nil
 }
 
 type external struct {
 	kube   client.Client
 	client ec2.AddressClient
+	quota  *servicequotas.Checker
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -157,6 +169,15 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	// NOTE: Only addresses for use in VPCs count towards the quota. Quotas of
+	// EC2-Classic addresses cannot be queried through Service Quotas, and
+	// addresses from BYOIP or customer-owned pools do not count towards it.
+	if !ec2.IsStandardDomain(cr.Spec.ForProvider) && !ec2.IsOwnedPoolAddress(cr.Spec.ForProvider) {
+		if err := e.quota.Check(ctx, cr, servicequotas.QuotaElasticIPs, e.countVPCAddresses); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errQuota)
+		}
+	}
+
 	cr.Status.SetConditions(xpv1.Creating())
 	if err := e.kube.Status().Update(ctx, cr); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errStatusUpdate)
@@ -181,6 +202,27 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalCreation{}, nil
 }
 
+// countVPCAddresses returns the number of Elastic IPs allocated from Amazon's
+// pool for use in VPCs in the region.
+func (e *external) countVPCAddresses(ctx context.Context) (int, error) {
+	o, err := e.client.DescribeAddresses(ctx, &awsec2.DescribeAddressesInput{
+		Filters: []awsec2types.Filter{{
+			Name:   aws.String("domain"),
+			Values: []string{string(awsec2types.DomainTypeVpc)},
+		}},
+	})
+	if err != nil {
+		return 0, awsclient.Wrap(err, errDescribe)
+	}
+	n := 0
+	for _, a := range o.Addresses {
+		if ec2.IsAmazonPoolAddress(a) {
+			n++
+		}
+	}
+	return n, nil
+}
+
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mgd.(*v1beta1.Address)
 	if !ok {
@@ -18,6 +18,7 @@ package address
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -27,6 +28,8 @@ import (
 
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	servicequotasapi "github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,6 +41,8 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+	sqfake "github.com/crossplane/provider-aws/pkg/clients/servicequotas/fake"
 )
 
 var (
@@ -45,15 +50,27 @@ var (
 	domainVpc      = "vpc"
 	domainStandard = "standard"
 	publicIP       = "1.1.1.1"
+	byoipPool      = "ipv4pool-ec2-123"
 	errBoom        = errors.New("boom")
+
+	errQuotaExceeded = fmt.Errorf("creating this resource would exceed the %q service quota (ec2/L-0263D0A3): 5 of 5 in use", "EC2-VPC Elastic IPs")
 )
 
 type args struct {
 	address ec2.AddressClient
 	kube    client.Client
+	quota   *servicequotas.Checker
 	cr      *v1beta1.Address
 }
 
+func withQuota(kube client.Client, limit float64) *servicequotas.Checker {
+	return servicequotas.NewChecker(kube, &sqfake.MockServiceQuotasClient{
+		MockGetServiceQuota: func(_ context.Context, _ *servicequotasapi.GetServiceQuotaInput, _ []func(*servicequotasapi.Options)) (*servicequotasapi.GetServiceQuotaOutput, error) {
+			return &servicequotasapi.GetServiceQuotaOutput{Quota: &servicequotastypes.ServiceQuota{Value: &limit}}, nil
+		},
+	})
+}
+
 type addressModifier func(*v1beta1.Address)
 
 func withTags(tagMaps ...map[string]string) addressModifier {
@@ -276,11 +293,113 @@ func TestCreate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"QuotaExceeded": {
+			args: args{
+				address: &fake.MockAddressClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeAddressesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeAddressesOutput, error) {
+						return &awsec2.DescribeAddressesOutput{Addresses: make([]awsec2types.Address, 5)}, nil
+					},
+				},
+				quota: withQuota(&test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)}, 5),
+				cr:    address(withSpec(v1beta1.AddressParameters{Domain: &domainVpc})),
+			},
+			want: want{
+				cr: address(withSpec(v1beta1.AddressParameters{Domain: &domainVpc}),
+					withConditions(servicequotas.Exceeded(errQuotaExceeded))),
+				err: errors.Wrap(errQuotaExceeded, errQuota),
+			},
+		},
+		"StandardSkipsQuota": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				address: &fake.MockAddressClient{
+					MockAllocate: func(ctx context.Context, input *awsec2.AllocateAddressInput, opts []func(*awsec2.Options)) (*awsec2.AllocateAddressOutput, error) {
+						return &awsec2.AllocateAddressOutput{
+							PublicIp: &publicIP,
+						}, nil
+					},
+				},
+				quota: withQuota(nil, 0),
+				cr: address(withSpec(v1beta1.AddressParameters{
+					Domain: &domainStandard,
+				})),
+			},
+			want: want{
+				cr: address(withExternalName(publicIP),
+					withConditions(xpv1.Creating()),
+					withSpec(v1beta1.AddressParameters{
+						Domain: &domainStandard,
+					})),
+				result: managed.ExternalCreation{},
+			},
+		},
+		"OwnedPoolSkipsQuota": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				address: &fake.MockAddressClient{
+					MockAllocate: func(ctx context.Context, input *awsec2.AllocateAddressInput, opts []func(*awsec2.Options)) (*awsec2.AllocateAddressOutput, error) {
+						if awsclient.StringValue(input.PublicIpv4Pool) != byoipPool {
+							return nil, errors.New("unexpected pool")
+						}
+						return &awsec2.AllocateAddressOutput{
+							AllocationId: &allocationID,
+						}, nil
+					},
+				},
+				quota: withQuota(nil, 0),
+				cr: address(withSpec(v1beta1.AddressParameters{
+					Domain:         &domainVpc,
+					PublicIPv4Pool: &byoipPool,
+				})),
+			},
+			want: want{
+				cr: address(withExternalName(allocationID),
+					withConditions(xpv1.Creating()),
+					withSpec(v1beta1.AddressParameters{
+						Domain:         &domainVpc,
+						PublicIPv4Pool: &byoipPool,
+					})),
+				result: managed.ExternalCreation{},
+			},
+		},
+		"QuotaIgnoresOwnedPoolAddresses": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockClient().MockStatusUpdate,
+				},
+				address: &fake.MockAddressClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeAddressesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeAddressesOutput, error) {
+						return &awsec2.DescribeAddressesOutput{Addresses: []awsec2types.Address{
+							{PublicIpv4Pool: awsclient.String(ec2.AmazonIPv4Pool)},
+							{PublicIpv4Pool: &byoipPool},
+							{PublicIpv4Pool: &byoipPool},
+						}}, nil
+					},
+					MockAllocate: func(ctx context.Context, input *awsec2.AllocateAddressInput, opts []func(*awsec2.Options)) (*awsec2.AllocateAddressOutput, error) {
+						return &awsec2.AllocateAddressOutput{
+							AllocationId: &allocationID,
+						}, nil
+					},
+				},
+				quota: withQuota(nil, 2),
+				cr:    address(withSpec(v1beta1.AddressParameters{Domain: &domainVpc})),
+			},
+			want: want{
+				cr: address(withExternalName(allocationID),
+					withConditions(xpv1.Creating()),
+					withSpec(v1beta1.AddressParameters{Domain: &domainVpc})),
+				result: managed.ExternalCreation{},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.address}
+			e := &external{kube: tc.kube, client: tc.address, quota: tc.quota}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,452 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityreservation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	reservationID = "cr-123"
+	ownerID       = "owner"
+	unlimited     = string(awsec2types.EndDateTypeUnlimited)
+	openCriteria  = string(awsec2types.InstanceMatchCriteriaOpen)
+	tenancy       = string(awsec2types.CapacityReservationTenancyDefault)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.CapacityReservationClient
+	cr     *manualv1alpha1.CapacityReservation
+}
+
+type capacityReservationModifier func(*manualv1alpha1.CapacityReservation)
+
+func withExternalName(name string) capacityReservationModifier {
+	return func(r *manualv1alpha1.CapacityReservation) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) capacityReservationModifier {
+	return func(r *manualv1alpha1.CapacityReservation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.CapacityReservationParameters) capacityReservationModifier {
+	return func(r *manualv1alpha1.CapacityReservation) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.CapacityReservationObservation) capacityReservationModifier {
+	return func(r *manualv1alpha1.CapacityReservation) { r.Status.AtProvider = s }
+}
+
+func capacityReservation(m ...capacityReservationModifier) *manualv1alpha1.CapacityReservation {
+	cr := &manualv1alpha1.CapacityReservation{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(count int32) manualv1alpha1.CapacityReservationParameters {
+	return manualv1alpha1.CapacityReservationParameters{
+		AvailabilityZone:      "us-east-1a",
+		InstanceType:          "m5.large",
+		InstancePlatform:      string(awsec2types.CapacityReservationInstancePlatformLinuxUnix),
+		InstanceCount:         count,
+		EndDateType:           &unlimited,
+		InstanceMatchCriteria: &openCriteria,
+		Tenancy:               &tenancy,
+	}
+}
+
+func observed(state awsec2types.CapacityReservationState, count int32) awsec2types.CapacityReservation {
+	return awsec2types.CapacityReservation{
+		CapacityReservationId:  aws.String(reservationID),
+		OwnerId:                aws.String(ownerID),
+		State:                  state,
+		TotalInstanceCount:     aws.Int32(count),
+		AvailableInstanceCount: aws.Int32(count),
+		EndDateType:            awsec2types.EndDateTypeUnlimited,
+		InstanceMatchCriteria:  awsec2types.InstanceMatchCriteriaOpen,
+		Tenancy:                awsec2types.CapacityReservationTenancyDefault,
+	}
+}
+
+func observation(state awsec2types.CapacityReservationState, count int32) manualv1alpha1.CapacityReservationObservation {
+	return manualv1alpha1.CapacityReservationObservation{
+		CapacityReservationID:  reservationID,
+		OwnerID:                ownerID,
+		State:                  string(state),
+		TotalInstanceCount:     aws.Int32(count),
+		AvailableInstanceCount: aws.Int32(count),
+	}
+}
+
+func describe(r awsec2types.CapacityReservation) func(context.Context, *awsec2.DescribeCapacityReservationsInput, []func(*awsec2.Options)) (*awsec2.DescribeCapacityReservationsOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeCapacityReservationsInput, _ []func(*awsec2.Options)) (*awsec2.DescribeCapacityReservationsOutput, error) {
+		if len(input.CapacityReservationIds) != 1 || input.CapacityReservationIds[0] != reservationID {
+			return nil, errors.New("unexpected capacity reservation")
+		}
+		return &awsec2.DescribeCapacityReservationsOutput{CapacityReservations: []awsec2types.CapacityReservation{r}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.CapacityReservation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: describe(observed(awsec2types.CapacityReservationStateActive, 2)),
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2))),
+			},
+			want: want{
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2)),
+					withStatus(observation(awsec2types.CapacityReservationStateActive, 2)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InstanceCountDrifted": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: describe(observed(awsec2types.CapacityReservationStateActive, 2)),
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(5))),
+			},
+			want: want{
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(5)),
+					withStatus(observation(awsec2types.CapacityReservationStateActive, 2)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Expired": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: describe(observed(awsec2types.CapacityReservationStateExpired, 2)),
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(5))),
+			},
+			want: want{
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(5)),
+					withStatus(observation(awsec2types.CapacityReservationStateExpired, 2)),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Cancelled": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: describe(observed(awsec2types.CapacityReservationStateCancelled, 2)),
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2))),
+			},
+			want: want{
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2))),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: capacityReservation(withSpec(spec(2))),
+			},
+			want: want{
+				cr: capacityReservation(withSpec(spec(2))),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeCapacityReservationsInput, []func(*awsec2.Options)) (*awsec2.DescribeCapacityReservationsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.CapacityReservationIDNotFound}
+					},
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2))),
+			},
+			want: want{
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2))),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeCapacityReservationsInput, []func(*awsec2.Options)) (*awsec2.DescribeCapacityReservationsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2))),
+			},
+			want: want{
+				cr:  capacityReservation(withExternalName(reservationID), withSpec(spec(2))),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.CapacityReservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockCreate: func(_ context.Context, input *awsec2.CreateCapacityReservationInput, _ []func(*awsec2.Options)) (*awsec2.CreateCapacityReservationOutput, error) {
+						if aws.ToInt32(input.InstanceCount) != 2 || aws.ToString(input.InstanceType) != "m5.large" {
+							return nil, errors.New("unexpected input")
+						}
+						return &awsec2.CreateCapacityReservationOutput{CapacityReservation: &awsec2types.CapacityReservation{
+							CapacityReservationId: aws.String(reservationID),
+						}}, nil
+					},
+				},
+				cr: capacityReservation(withSpec(spec(2))),
+			},
+			want: want{
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2)), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockCreate: func(context.Context, *awsec2.CreateCapacityReservationInput, []func(*awsec2.Options)) (*awsec2.CreateCapacityReservationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: capacityReservation(withSpec(spec(2))),
+			},
+			want: want{
+				cr:  capacityReservation(withSpec(spec(2)), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modified *int32
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifiesInstanceCount": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: describe(observed(awsec2types.CapacityReservationStateActive, 2)),
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(5))),
+			},
+			want: want{
+				modified: aws.Int32(5),
+			},
+		},
+		"CapacityUpToDate": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: describe(observed(awsec2types.CapacityReservationStateActive, 2)),
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(2))),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockDescribe: describe(observed(awsec2types.CapacityReservationStateActive, 2)),
+					MockModify: func(context.Context, *awsec2.ModifyCapacityReservationInput, []func(*awsec2.Options)) (*awsec2.ModifyCapacityReservationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: capacityReservation(withExternalName(reservationID), withSpec(spec(5))),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modified *int32
+			c := tc.client.(*fake.MockCapacityReservationClient)
+			if c.MockModify == nil {
+				c.MockModify = func(_ context.Context, input *awsec2.ModifyCapacityReservationInput, _ []func(*awsec2.Options)) (*awsec2.ModifyCapacityReservationOutput, error) {
+					modified = input.InstanceCount
+					return &awsec2.ModifyCapacityReservationOutput{}, nil
+				}
+			}
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.CapacityReservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockCancel: func(_ context.Context, input *awsec2.CancelCapacityReservationInput, _ []func(*awsec2.Options)) (*awsec2.CancelCapacityReservationOutput, error) {
+						if aws.ToString(input.CapacityReservationId) != reservationID {
+							return nil, errors.New("unexpected capacity reservation")
+						}
+						return &awsec2.CancelCapacityReservationOutput{}, nil
+					},
+				},
+				cr: capacityReservation(withExternalName(reservationID)),
+			},
+			want: want{
+				cr: capacityReservation(withExternalName(reservationID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockCancel: func(context.Context, *awsec2.CancelCapacityReservationInput, []func(*awsec2.Options)) (*awsec2.CancelCapacityReservationOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.CapacityReservationIDNotFound}
+					},
+				},
+				cr: capacityReservation(withExternalName(reservationID)),
+			},
+			want: want{
+				cr: capacityReservation(withExternalName(reservationID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"CancelFailed": {
+			args: args{
+				client: &fake.MockCapacityReservationClient{
+					MockCancel: func(context.Context, *awsec2.CancelCapacityReservationInput, []func(*awsec2.Options)) (*awsec2.CancelCapacityReservationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: capacityReservation(withExternalName(reservationID)),
+			},
+			want: want{
+				cr:  capacityReservation(withExternalName(reservationID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errCancel),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
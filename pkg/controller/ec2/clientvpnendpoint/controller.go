@@ -0,0 +1,450 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientvpnendpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not a ClientVPNEndpoint resource"
+	errDescribe            = "failed to describe ClientVPNEndpoint"
+	errMultipleItems       = "retrieved multiple ClientVPNEndpoints for the given clientVpnEndpointId"
+	errDescribeNetworks    = "failed to describe the target networks of the ClientVPNEndpoint"
+	errDescribeRules       = "failed to describe the authorization rules of the ClientVPNEndpoint"
+	errDescribeRoutes      = "failed to describe the routes of the ClientVPNEndpoint"
+	errExportConfiguration = "failed to export the client configuration of the ClientVPNEndpoint"
+	errCreate              = "failed to create the ClientVPNEndpoint resource"
+	errModify              = "failed to modify the ClientVPNEndpoint resource"
+	errAssociate           = "failed to associate the target network with the ClientVPNEndpoint"
+	errDisassociate        = "failed to disassociate the target network from the ClientVPNEndpoint"
+	errAuthorize           = "failed to add the authorization rule to the ClientVPNEndpoint"
+	errRevoke              = "failed to revoke the authorization rule of the ClientVPNEndpoint"
+	errCreateRoute         = "failed to create the route of the ClientVPNEndpoint"
+	errDeleteRoute         = "failed to delete the route of the ClientVPNEndpoint"
+	errDelete              = "failed to delete the ClientVPNEndpoint resource"
+	errCreateTags          = "failed to create tags for the ClientVPNEndpoint resource"
+	errDeleteTags          = "failed to delete tags for the ClientVPNEndpoint resource"
+)
+
+// SetupClientVPNEndpoint adds a controller that reconciles ClientVPNEndpoints.
+func SetupClientVPNEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.ClientVPNEndpointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.ClientVPNEndpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.ClientVPNEndpointGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewClientVPNEndpointClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.ClientVPNEndpointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.ClientVPNEndpoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.ClientVPNEndpointClient
+}
+
+// describe returns the observed Client VPN endpoint, or nil if it doesn't
+// exist anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.ClientVPNEndpoint) (*awsec2types.ClientVpnEndpoint, error) {
+	response, err := e.client.DescribeClientVpnEndpoints(ctx, &awsec2.DescribeClientVpnEndpointsInput{
+		ClientVpnEndpointIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsClientVPNEndpointNotFoundErr, err), errDescribe)
+	}
+	switch len(response.ClientVpnEndpoints) {
+	case 0:
+		return nil, nil
+	case 1:
+		observed := response.ClientVpnEndpoints[0]
+		if observed.Status != nil && observed.Status.Code == awsec2types.ClientVpnEndpointStatusCodeDeleted {
+			return nil, nil
+		}
+		return &observed, nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+// describeNetworks returns all target networks associated with the Client
+// VPN endpoint.
+func (e *external) describeNetworks(ctx context.Context, id string) ([]awsec2types.TargetNetwork, error) {
+	var networks []awsec2types.TargetNetwork
+	input := &awsec2.DescribeClientVpnTargetNetworksInput{ClientVpnEndpointId: aws.String(id)}
+	for {
+		response, err := e.client.DescribeClientVpnTargetNetworks(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errDescribeNetworks)
+		}
+		networks = append(networks, response.ClientVpnTargetNetworks...)
+		if aws.ToString(response.NextToken) == "" {
+			return networks, nil
+		}
+		input.NextToken = response.NextToken
+	}
+}
+
+// describeRules returns all authorization rules of the Client VPN endpoint.
+func (e *external) describeRules(ctx context.Context, id string) ([]awsec2types.AuthorizationRule, error) {
+	var rules []awsec2types.AuthorizationRule
+	input := &awsec2.DescribeClientVpnAuthorizationRulesInput{ClientVpnEndpointId: aws.String(id)}
+	for {
+		response, err := e.client.DescribeClientVpnAuthorizationRules(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errDescribeRules)
+		}
+		rules = append(rules, response.AuthorizationRules...)
+		if aws.ToString(response.NextToken) == "" {
+			return rules, nil
+		}
+		input.NextToken = response.NextToken
+	}
+}
+
+// describeRoutes returns all routes of the Client VPN endpoint.
+func (e *external) describeRoutes(ctx context.Context, id string) ([]awsec2types.ClientVpnRoute, error) {
+	var routes []awsec2types.ClientVpnRoute
+	input := &awsec2.DescribeClientVpnRoutesInput{ClientVpnEndpointId: aws.String(id)}
+	for {
+		response, err := e.client.DescribeClientVpnRoutes(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errDescribeRoutes)
+		}
+		routes = append(routes, response.Routes...)
+		if aws.ToString(response.NextToken) == "" {
+			return routes, nil
+		}
+		input.NextToken = response.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*manualv1alpha1.ClientVPNEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+	networks, err := e.describeNetworks(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	rules, err := e.describeRules(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	routes, err := e.describeRoutes(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeClientVPNEndpoint(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateClientVPNEndpointObservation(*observed, networks, rules, routes)
+	var code awsec2types.ClientVpnEndpointStatusCode
+	if observed.Status != nil {
+		code = observed.Status.Code
+	}
+	switch code {
+	case awsec2types.ClientVpnEndpointStatusCodeAvailable:
+		cr.SetConditions(xpv1.Available())
+	case awsec2types.ClientVpnEndpointStatusCodeDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		// An endpoint stays pending until a target network is associated.
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	var conn managed.ConnectionDetails
+	if code != awsec2types.ClientVpnEndpointStatusCodeDeleting {
+		out, err := e.client.ExportClientVpnClientConfiguration(ctx, &awsec2.ExportClientVpnClientConfigurationInput{
+			ClientVpnEndpointId: aws.String(meta.GetExternalName(cr)),
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errExportConfiguration)
+		}
+		conn = ec2.GetClientVPNEndpointConnectionDetails(out.ClientConfiguration)
+	}
+
+	associate, disassociate := ec2.DiffClientVPNNetworkAssociations(cr.Spec.ForProvider, networks)
+	authorize, revoke := ec2.DiffClientVPNAuthorizationRules(cr.Spec.ForProvider, rules)
+	create, remove := ec2.DiffClientVPNRoutes(cr.Spec.ForProvider, routes)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ec2.IsClientVPNEndpointUpToDate(cr.Spec.ForProvider, *observed) &&
+			len(associate)+len(disassociate)+len(authorize)+len(revoke)+len(create)+len(remove) == 0,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       conn,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ClientVPNEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateClientVpnEndpoint(ctx, ec2.GenerateCreateClientVPNEndpointInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.ClientVpnEndpointId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*manualv1alpha1.ClientVPNEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !ec2.IsClientVPNEndpointUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.ModifyClientVpnEndpoint(ctx, ec2.GenerateModifyClientVPNEndpointInput(id, cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+		}
+	}
+
+	if err := e.updateNetworks(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.updateRules(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.updateRoutes(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// updateNetworks associates the desired subnets with the Client VPN endpoint
+// and removes all other associations.
+func (e *external) updateNetworks(ctx context.Context, cr *manualv1alpha1.ClientVPNEndpoint) error {
+	networks, err := e.describeNetworks(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	associate, disassociate := ec2.DiffClientVPNNetworkAssociations(cr.Spec.ForProvider, networks)
+	if err := e.disassociate(ctx, cr, disassociate); err != nil {
+		return err
+	}
+	for _, subnet := range associate {
+		if _, err := e.client.AssociateClientVpnTargetNetwork(ctx, &awsec2.AssociateClientVpnTargetNetworkInput{
+			ClientVpnEndpointId: aws.String(meta.GetExternalName(cr)),
+			SubnetId:            aws.String(subnet),
+		}); err != nil {
+			return awsclient.Wrap(err, errAssociate)
+		}
+	}
+	return nil
+}
+
+// disassociate removes the supplied target network associations from the
+// Client VPN endpoint.
+func (e *external) disassociate(ctx context.Context, cr *manualv1alpha1.ClientVPNEndpoint, associationIDs []string) error {
+	for _, id := range associationIDs {
+		if _, err := e.client.DisassociateClientVpnTargetNetwork(ctx, &awsec2.DisassociateClientVpnTargetNetworkInput{
+			ClientVpnEndpointId: aws.String(meta.GetExternalName(cr)),
+			AssociationId:       aws.String(id),
+		}); err != nil {
+			return awsclient.Wrap(err, errDisassociate)
+		}
+	}
+	return nil
+}
+
+// updateRules adds the desired authorization rules to the Client VPN endpoint
+// and revokes all other rules.
+func (e *external) updateRules(ctx context.Context, cr *manualv1alpha1.ClientVPNEndpoint) error {
+	rules, err := e.describeRules(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	authorize, revoke := ec2.DiffClientVPNAuthorizationRules(cr.Spec.ForProvider, rules)
+	for _, r := range revoke {
+		if _, err := e.client.RevokeClientVpnIngress(ctx, &awsec2.RevokeClientVpnIngressInput{
+			ClientVpnEndpointId: aws.String(meta.GetExternalName(cr)),
+			TargetNetworkCidr:   aws.String(r.TargetNetworkCIDR),
+			AccessGroupId:       r.AccessGroupID,
+			RevokeAllGroups:     r.AuthorizeAllGroups,
+		}); err != nil {
+			return awsclient.Wrap(err, errRevoke)
+		}
+	}
+	for _, r := range authorize {
+		if _, err := e.client.AuthorizeClientVpnIngress(ctx, &awsec2.AuthorizeClientVpnIngressInput{
+			ClientVpnEndpointId: aws.String(meta.GetExternalName(cr)),
+			TargetNetworkCidr:   aws.String(r.TargetNetworkCIDR),
+			AccessGroupId:       r.AccessGroupID,
+			AuthorizeAllGroups:  r.AuthorizeAllGroups,
+			Description:         r.Description,
+		}); err != nil {
+			return awsclient.Wrap(err, errAuthorize)
+		}
+	}
+	return nil
+}
+
+// updateRoutes adds the desired routes to the Client VPN endpoint and deletes
+// all other routes that were not added by AWS.
+func (e *external) updateRoutes(ctx context.Context, cr *manualv1alpha1.ClientVPNEndpoint) error {
+	routes, err := e.describeRoutes(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	create, remove := ec2.DiffClientVPNRoutes(cr.Spec.ForProvider, routes)
+	for _, r := range remove {
+		if _, err := e.client.DeleteClientVpnRoute(ctx, &awsec2.DeleteClientVpnRouteInput{
+			ClientVpnEndpointId:  aws.String(meta.GetExternalName(cr)),
+			DestinationCidrBlock: aws.String(r.DestinationCIDRBlock),
+			TargetVpcSubnetId:    r.TargetSubnetID,
+		}); err != nil {
+			return awsclient.Wrap(err, errDeleteRoute)
+		}
+	}
+	for _, r := range create {
+		if _, err := e.client.CreateClientVpnRoute(ctx, &awsec2.CreateClientVpnRouteInput{
+			ClientVpnEndpointId:  aws.String(meta.GetExternalName(cr)),
+			DestinationCidrBlock: aws.String(r.DestinationCIDRBlock),
+			TargetVpcSubnetId:    r.TargetSubnetID,
+			Description:          r.Description,
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateRoute)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.ClientVPNEndpoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return err
+	}
+
+	// A Client VPN endpoint can only be deleted once all target networks are
+	// disassociated from it.
+	networks, err := e.describeNetworks(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+	_, disassociate := ec2.DiffClientVPNNetworkAssociations(manualv1alpha1.ClientVPNEndpointParameters{}, networks)
+	if err := e.disassociate(ctx, cr, disassociate); err != nil {
+		return err
+	}
+
+	_, err = e.client.DeleteClientVpnEndpoint(ctx, &awsec2.DeleteClientVpnEndpointInput{
+		ClientVpnEndpointId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsClientVPNEndpointNotFoundErr, err), errDelete)
+}
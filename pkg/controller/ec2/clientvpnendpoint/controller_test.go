@@ -0,0 +1,439 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientvpnendpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	endpointID    = "cvpn-endpoint-123"
+	certARN       = "arn:aws:acm:us-east-1:123456789012:certificate/abc"
+	vpcID         = "vpc-123"
+	subnetA       = "subnet-a"
+	subnetB       = "subnet-b"
+	configuration = "client\ndev tun\n"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.ClientVPNEndpointClient
+	cr     *manualv1alpha1.ClientVPNEndpoint
+}
+
+type clientVPNEndpointModifier func(*manualv1alpha1.ClientVPNEndpoint)
+
+func withExternalName(name string) clientVPNEndpointModifier {
+	return func(r *manualv1alpha1.ClientVPNEndpoint) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) clientVPNEndpointModifier {
+	return func(r *manualv1alpha1.ClientVPNEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSubnets(ids ...string) clientVPNEndpointModifier {
+	return func(r *manualv1alpha1.ClientVPNEndpoint) {
+		for _, id := range ids {
+			r.Spec.ForProvider.NetworkAssociations = append(r.Spec.ForProvider.NetworkAssociations, manualv1alpha1.ClientVPNNetworkAssociation{SubnetID: aws.String(id)})
+		}
+	}
+}
+
+func withLateInit() clientVPNEndpointModifier {
+	return func(r *manualv1alpha1.ClientVPNEndpoint) {
+		r.Spec.ForProvider.ServerCertificateARN = aws.String(certARN)
+		r.Spec.ForProvider.VPCID = aws.String(vpcID)
+		r.Spec.ForProvider.SplitTunnel = aws.Bool(false)
+		r.Spec.ForProvider.TransportProtocol = aws.String(string(awsec2types.TransportProtocolUdp))
+		r.Spec.ForProvider.VPNPort = aws.Int32(443)
+	}
+}
+
+func withStatus(s manualv1alpha1.ClientVPNEndpointObservation) clientVPNEndpointModifier {
+	return func(r *manualv1alpha1.ClientVPNEndpoint) { r.Status.AtProvider = s }
+}
+
+func clientVPNEndpoint(m ...clientVPNEndpointModifier) *manualv1alpha1.ClientVPNEndpoint {
+	cr := &manualv1alpha1.ClientVPNEndpoint{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(code awsec2types.ClientVpnEndpointStatusCode) func(context.Context, *awsec2.DescribeClientVpnEndpointsInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnEndpointsOutput, error) {
+	return func(context.Context, *awsec2.DescribeClientVpnEndpointsInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnEndpointsOutput, error) {
+		return &awsec2.DescribeClientVpnEndpointsOutput{ClientVpnEndpoints: []awsec2types.ClientVpnEndpoint{{
+			ClientVpnEndpointId:  aws.String(endpointID),
+			ServerCertificateArn: aws.String(certARN),
+			VpcId:                aws.String(vpcID),
+			SplitTunnel:          aws.Bool(false),
+			TransportProtocol:    awsec2types.TransportProtocolUdp,
+			VpnPort:              aws.Int32(443),
+			Status:               &awsec2types.ClientVpnEndpointStatus{Code: code},
+		}}}, nil
+	}
+}
+
+// networks maps the IDs of associated subnets to the IDs of their
+// associations.
+type networks map[string]string
+
+func describeNetworks(n networks) func(context.Context, *awsec2.DescribeClientVpnTargetNetworksInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnTargetNetworksOutput, error) {
+	return func(context.Context, *awsec2.DescribeClientVpnTargetNetworksInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnTargetNetworksOutput, error) {
+		out := &awsec2.DescribeClientVpnTargetNetworksOutput{}
+		for subnet, id := range n {
+			out.ClientVpnTargetNetworks = append(out.ClientVpnTargetNetworks, awsec2types.TargetNetwork{
+				AssociationId:   aws.String(id),
+				TargetNetworkId: aws.String(subnet),
+				VpcId:           aws.String(vpcID),
+				Status:          &awsec2types.AssociationStatus{Code: awsec2types.AssociationStatusCodeAssociated},
+			})
+		}
+		return out, nil
+	}
+}
+
+func describeRules(rules ...awsec2types.AuthorizationRule) func(context.Context, *awsec2.DescribeClientVpnAuthorizationRulesInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnAuthorizationRulesOutput, error) {
+	return func(context.Context, *awsec2.DescribeClientVpnAuthorizationRulesInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnAuthorizationRulesOutput, error) {
+		return &awsec2.DescribeClientVpnAuthorizationRulesOutput{AuthorizationRules: rules}, nil
+	}
+}
+
+func describeRoutes(routes ...awsec2types.ClientVpnRoute) func(context.Context, *awsec2.DescribeClientVpnRoutesInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnRoutesOutput, error) {
+	return func(context.Context, *awsec2.DescribeClientVpnRoutesInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnRoutesOutput, error) {
+		return &awsec2.DescribeClientVpnRoutesOutput{Routes: routes}, nil
+	}
+}
+
+func export(context.Context, *awsec2.ExportClientVpnClientConfigurationInput, []func(*awsec2.Options)) (*awsec2.ExportClientVpnClientConfigurationOutput, error) {
+	return &awsec2.ExportClientVpnClientConfigurationOutput{ClientConfiguration: aws.String(configuration)}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.ClientVPNEndpoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClientVPNEndpointClient{
+					MockDescribe:                   describe(awsec2types.ClientVpnEndpointStatusCodeAvailable),
+					MockDescribeTargetNetworks:     describeNetworks(networks{subnetA: "cvpn-assoc-a"}),
+					MockDescribeAuthorizationRules: describeRules(),
+					MockDescribeRoutes:             describeRoutes(),
+					MockExportClientConfiguration:  export,
+				},
+				cr: clientVPNEndpoint(withExternalName(endpointID), withLateInit(), withSubnets(subnetA)),
+			},
+			want: want{
+				cr: clientVPNEndpoint(withExternalName(endpointID), withLateInit(), withSubnets(subnetA),
+					withStatus(manualv1alpha1.ClientVPNEndpointObservation{
+						ClientVPNEndpointID: endpointID,
+						Status:              string(awsec2types.ClientVpnEndpointStatusCodeAvailable),
+						NetworkAssociations: []manualv1alpha1.ClientVPNNetworkAssociationObservation{{
+							AssociationID: "cvpn-assoc-a",
+							SubnetID:      subnetA,
+							VPCID:         vpcID,
+							Status:        string(awsec2types.AssociationStatusCodeAssociated),
+						}},
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ec2.ClientConfigurationKey: []byte(configuration)},
+				},
+			},
+		},
+		"PendingAssociation": {
+			args: args{
+				client: &fake.MockClientVPNEndpointClient{
+					MockDescribe:                   describe(awsec2types.ClientVpnEndpointStatusCodePendingAssociate),
+					MockDescribeTargetNetworks:     describeNetworks(nil),
+					MockDescribeAuthorizationRules: describeRules(),
+					MockDescribeRoutes:             describeRoutes(),
+					MockExportClientConfiguration:  export,
+				},
+				cr: clientVPNEndpoint(withExternalName(endpointID), withSubnets(subnetA)),
+			},
+			want: want{
+				cr: clientVPNEndpoint(withExternalName(endpointID), withLateInit(), withSubnets(subnetA),
+					withStatus(manualv1alpha1.ClientVPNEndpointObservation{
+						ClientVPNEndpointID: endpointID,
+						Status:              string(awsec2types.ClientVpnEndpointStatusCodePendingAssociate),
+					}), withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{ec2.ClientConfigurationKey: []byte(configuration)},
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockClientVPNEndpointClient{
+					MockDescribe: describe(awsec2types.ClientVpnEndpointStatusCodeDeleted),
+				},
+				cr: clientVPNEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: clientVPNEndpoint(withExternalName(endpointID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClientVPNEndpointClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeClientVpnEndpointsInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnEndpointsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.ClientVPNEndpointIDNotFound}
+					},
+				},
+				cr: clientVPNEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: clientVPNEndpoint(withExternalName(endpointID)),
+			},
+		},
+		"DescribeRoutesFailed": {
+			args: args{
+				client: &fake.MockClientVPNEndpointClient{
+					MockDescribe:                   describe(awsec2types.ClientVpnEndpointStatusCodeAvailable),
+					MockDescribeTargetNetworks:     describeNetworks(nil),
+					MockDescribeAuthorizationRules: describeRules(),
+					MockDescribeRoutes: func(context.Context, *awsec2.DescribeClientVpnRoutesInput, []func(*awsec2.Options)) (*awsec2.DescribeClientVpnRoutesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: clientVPNEndpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  clientVPNEndpoint(withExternalName(endpointID)),
+				err: awsclient.Wrap(errBoom, errDescribeRoutes),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		associated    []string
+		disassociated []string
+		authorized    []string
+		revoked       []string
+		createdRoutes []string
+		deletedRoutes []string
+		err           error
+	}
+
+	cases := map[string]struct {
+		networks     networks
+		rules        []awsec2types.AuthorizationRule
+		routes       []awsec2types.ClientVpnRoute
+		cr           *manualv1alpha1.ClientVPNEndpoint
+		associateErr error
+		want
+	}{
+		"MovesToOtherSubnet": {
+			networks: networks{subnetA: "cvpn-assoc-a"},
+			cr:       clientVPNEndpoint(withExternalName(endpointID), withLateInit(), withSubnets(subnetB)),
+			want: want{
+				associated:    []string{subnetB},
+				disassociated: []string{"cvpn-assoc-a"},
+			},
+		},
+		"SyncsRulesAndRoutes": {
+			rules: []awsec2types.AuthorizationRule{{DestinationCidr: aws.String("10.1.0.0/16"), AccessAll: aws.Bool(true)}},
+			routes: []awsec2types.ClientVpnRoute{
+				{DestinationCidr: aws.String("10.0.0.0/16"), TargetSubnet: aws.String(subnetA), Origin: aws.String("associate")},
+				{DestinationCidr: aws.String("10.2.0.0/16"), TargetSubnet: aws.String(subnetA), Origin: aws.String(ec2.ClientVPNRouteOriginAddRoute)},
+			},
+			cr: clientVPNEndpoint(withExternalName(endpointID), withLateInit(), func(r *manualv1alpha1.ClientVPNEndpoint) {
+				r.Spec.ForProvider.AuthorizationRules = []manualv1alpha1.ClientVPNAuthorizationRule{{TargetNetworkCIDR: "0.0.0.0/0", AuthorizeAllGroups: aws.Bool(true)}}
+				r.Spec.ForProvider.Routes = []manualv1alpha1.ClientVPNRoute{{DestinationCIDRBlock: "0.0.0.0/0", TargetSubnetID: aws.String(subnetA)}}
+			}),
+			want: want{
+				authorized:    []string{"0.0.0.0/0"},
+				revoked:       []string{"10.1.0.0/16"},
+				createdRoutes: []string{"0.0.0.0/0"},
+				deletedRoutes: []string{"10.2.0.0/16"},
+			},
+		},
+		"AssociateFailed": {
+			cr:           clientVPNEndpoint(withExternalName(endpointID), withLateInit(), withSubnets(subnetA)),
+			associateErr: errBoom,
+			want: want{
+				associated: []string{subnetA},
+				err:        awsclient.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			e := &external{client: &fake.MockClientVPNEndpointClient{
+				MockDescribe:                   describe(awsec2types.ClientVpnEndpointStatusCodeAvailable),
+				MockDescribeTargetNetworks:     describeNetworks(tc.networks),
+				MockDescribeAuthorizationRules: describeRules(tc.rules...),
+				MockDescribeRoutes:             describeRoutes(tc.routes...),
+				MockAssociateTargetNetwork: func(_ context.Context, in *awsec2.AssociateClientVpnTargetNetworkInput, _ []func(*awsec2.Options)) (*awsec2.AssociateClientVpnTargetNetworkOutput, error) {
+					got.associated = append(got.associated, aws.ToString(in.SubnetId))
+					return &awsec2.AssociateClientVpnTargetNetworkOutput{}, tc.associateErr
+				},
+				MockDisassociateTargetNetwork: func(_ context.Context, in *awsec2.DisassociateClientVpnTargetNetworkInput, _ []func(*awsec2.Options)) (*awsec2.DisassociateClientVpnTargetNetworkOutput, error) {
+					got.disassociated = append(got.disassociated, aws.ToString(in.AssociationId))
+					return &awsec2.DisassociateClientVpnTargetNetworkOutput{}, nil
+				},
+				MockAuthorizeIngress: func(_ context.Context, in *awsec2.AuthorizeClientVpnIngressInput, _ []func(*awsec2.Options)) (*awsec2.AuthorizeClientVpnIngressOutput, error) {
+					got.authorized = append(got.authorized, aws.ToString(in.TargetNetworkCidr))
+					return &awsec2.AuthorizeClientVpnIngressOutput{}, nil
+				},
+				MockRevokeIngress: func(_ context.Context, in *awsec2.RevokeClientVpnIngressInput, _ []func(*awsec2.Options)) (*awsec2.RevokeClientVpnIngressOutput, error) {
+					got.revoked = append(got.revoked, aws.ToString(in.TargetNetworkCidr))
+					return &awsec2.RevokeClientVpnIngressOutput{}, nil
+				},
+				MockCreateRoute: func(_ context.Context, in *awsec2.CreateClientVpnRouteInput, _ []func(*awsec2.Options)) (*awsec2.CreateClientVpnRouteOutput, error) {
+					got.createdRoutes = append(got.createdRoutes, aws.ToString(in.DestinationCidrBlock))
+					return &awsec2.CreateClientVpnRouteOutput{}, nil
+				},
+				MockDeleteRoute: func(_ context.Context, in *awsec2.DeleteClientVpnRouteInput, _ []func(*awsec2.Options)) (*awsec2.DeleteClientVpnRouteOutput, error) {
+					got.deletedRoutes = append(got.deletedRoutes, aws.ToString(in.DestinationCidrBlock))
+					return &awsec2.DeleteClientVpnRouteOutput{}, nil
+				},
+			}}
+			_, got.err = e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		disassociated []string
+		deleted       bool
+		err           error
+	}
+
+	cases := map[string]struct {
+		networks  networks
+		deleteErr error
+		want
+	}{
+		"DisassociatesBeforeDeleting": {
+			networks: networks{subnetA: "cvpn-assoc-a"},
+			want: want{
+				disassociated: []string{"cvpn-assoc-a"},
+				deleted:       true,
+			},
+		},
+		"AlreadyGone": {
+			deleteErr: &smithy.GenericAPIError{Code: ec2.ClientVPNEndpointIDNotFound},
+			want: want{
+				deleted: true,
+			},
+		},
+		"DeleteFailed": {
+			deleteErr: errBoom,
+			want: want{
+				deleted: true,
+				err:     awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var disassociated []string
+			deleted := false
+			e := &external{client: &fake.MockClientVPNEndpointClient{
+				MockDescribe:               describe(awsec2types.ClientVpnEndpointStatusCodeAvailable),
+				MockDescribeTargetNetworks: describeNetworks(tc.networks),
+				MockDisassociateTargetNetwork: func(_ context.Context, in *awsec2.DisassociateClientVpnTargetNetworkInput, _ []func(*awsec2.Options)) (*awsec2.DisassociateClientVpnTargetNetworkOutput, error) {
+					disassociated = append(disassociated, aws.ToString(in.AssociationId))
+					return &awsec2.DisassociateClientVpnTargetNetworkOutput{}, nil
+				},
+				MockDelete: func(context.Context, *awsec2.DeleteClientVpnEndpointInput, []func(*awsec2.Options)) (*awsec2.DeleteClientVpnEndpointOutput, error) {
+					deleted = true
+					return &awsec2.DeleteClientVpnEndpointOutput{}, tc.deleteErr
+				},
+			}}
+			cr := clientVPNEndpoint(withExternalName(endpointID))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disassociated, disassociated); diff != "" {
+				t.Errorf("disassociated: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(clientVPNEndpoint(withExternalName(endpointID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
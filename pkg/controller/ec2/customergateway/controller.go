@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customergateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a CustomerGateway resource"
+	errDescribe         = "failed to describe CustomerGateway"
+	errMultipleItems    = "retrieved multiple CustomerGateways for the given customerGatewayId"
+	errCreate           = "failed to create the CustomerGateway resource"
+	errDelete           = "failed to delete the CustomerGateway resource"
+	errCreateTags       = "failed to create tags for the CustomerGateway resource"
+	errDeleteTags       = "failed to delete tags for the CustomerGateway resource"
+)
+
+// SetupCustomerGateway adds a controller that reconciles CustomerGateways.
+func SetupCustomerGateway(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.CustomerGatewayGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.CustomerGateway{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.CustomerGatewayGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewCustomerGatewayClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.CustomerGatewayClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.CustomerGateway)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.CustomerGatewayClient
+}
+
+// describe returns the observed customer gateway, or nil if it doesn't exist
+// anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.CustomerGateway) (*awsec2types.CustomerGateway, error) {
+	response, err := e.client.DescribeCustomerGateways(ctx, &awsec2.DescribeCustomerGatewaysInput{
+		CustomerGatewayIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsCustomerGatewayNotFoundErr, err), errDescribe)
+	}
+	switch len(response.CustomerGateways) {
+	case 0:
+		return nil, nil
+	case 1:
+		// Deleted customer gateways remain visible for a while.
+		if aws.ToString(response.CustomerGateways[0].State) == ec2.CustomerGatewayStateDeleted {
+			return nil, nil
+		}
+		return &response.CustomerGateways[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.CustomerGateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeCustomerGateway(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateCustomerGatewayObservation(*observed)
+	switch cr.Status.AtProvider.State {
+	case string(awsec2types.VpnStateAvailable):
+		cr.SetConditions(xpv1.Available())
+	case string(awsec2types.VpnStatePending):
+		cr.SetConditions(xpv1.Creating())
+	case string(awsec2types.VpnStateDeleting):
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsCustomerGatewayUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.CustomerGateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateCustomerGateway(ctx, ec2.GenerateCreateCustomerGatewayInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.CustomerGateway.CustomerGatewayId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.CustomerGateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.CustomerGateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteCustomerGateway(ctx, &awsec2.DeleteCustomerGatewayInput{
+		CustomerGatewayId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsCustomerGatewayNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customergateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	gatewayID = "cgw-123"
+	ipAddress = "198.51.100.1"
+	tagKey    = "key"
+	tagValue  = "value"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.CustomerGatewayClient
+	cr     *manualv1alpha1.CustomerGateway
+}
+
+type customerGatewayModifier func(*manualv1alpha1.CustomerGateway)
+
+func withExternalName(name string) customerGatewayModifier {
+	return func(r *manualv1alpha1.CustomerGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) customerGatewayModifier {
+	return func(r *manualv1alpha1.CustomerGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.CustomerGatewayParameters) customerGatewayModifier {
+	return func(r *manualv1alpha1.CustomerGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.CustomerGatewayObservation) customerGatewayModifier {
+	return func(r *manualv1alpha1.CustomerGateway) { r.Status.AtProvider = s }
+}
+
+func customerGateway(m ...customerGatewayModifier) *manualv1alpha1.CustomerGateway {
+	cr := &manualv1alpha1.CustomerGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() manualv1alpha1.CustomerGatewayParameters {
+	return manualv1alpha1.CustomerGatewayParameters{
+		Type:      string(awsec2types.GatewayTypeIpsec1),
+		BGPASN:    aws.Int32(65000),
+		IPAddress: aws.String(ipAddress),
+	}
+}
+
+func describe(state string, tags ...awsec2types.Tag) func(context.Context, *awsec2.DescribeCustomerGatewaysInput, []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+	return func(context.Context, *awsec2.DescribeCustomerGatewaysInput, []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+		return &awsec2.DescribeCustomerGatewaysOutput{CustomerGateways: []awsec2types.CustomerGateway{{
+			CustomerGatewayId: aws.String(gatewayID),
+			Type:              aws.String(string(awsec2types.GatewayTypeIpsec1)),
+			BgpAsn:            aws.String("65000"),
+			IpAddress:         aws.String(ipAddress),
+			State:             aws.String(state),
+			Tags:              tags,
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.CustomerGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDescribe: describe("available"),
+				},
+				cr: customerGateway(withExternalName(gatewayID), withSpec(params())),
+			},
+			want: want{
+				cr: customerGateway(withExternalName(gatewayID), withSpec(params()),
+					withStatus(manualv1alpha1.CustomerGatewayObservation{
+						CustomerGatewayID: gatewayID,
+						State:             "available",
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDescribe: describe("pending"),
+				},
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr: customerGateway(withExternalName(gatewayID), withSpec(params()),
+					withStatus(manualv1alpha1.CustomerGatewayObservation{
+						CustomerGatewayID: gatewayID,
+						State:             "pending",
+					}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"TagsOutdated": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDescribe: describe("available", awsec2types.Tag{Key: aws.String(tagKey), Value: aws.String("old")}),
+				},
+				cr: customerGateway(withExternalName(gatewayID), withSpec(manualv1alpha1.CustomerGatewayParameters{
+					Type:      string(awsec2types.GatewayTypeIpsec1),
+					BGPASN:    aws.Int32(65000),
+					IPAddress: aws.String(ipAddress),
+					Tags:      []manualv1alpha1.Tag{{Key: tagKey, Value: tagValue}},
+				})),
+			},
+			want: want{
+				cr: customerGateway(withExternalName(gatewayID), withSpec(manualv1alpha1.CustomerGatewayParameters{
+					Type:      string(awsec2types.GatewayTypeIpsec1),
+					BGPASN:    aws.Int32(65000),
+					IPAddress: aws.String(ipAddress),
+					Tags:      []manualv1alpha1.Tag{{Key: tagKey, Value: tagValue}},
+				}), withStatus(manualv1alpha1.CustomerGatewayObservation{
+					CustomerGatewayID: gatewayID,
+					State:             "available",
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDescribe: describe(ec2.CustomerGatewayStateDeleted),
+				},
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeCustomerGatewaysInput, []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.CustomerGatewayIDNotFound}
+					},
+				},
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeCustomerGatewaysInput, []func(*awsec2.Options)) (*awsec2.DescribeCustomerGatewaysOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr:  customerGateway(withExternalName(gatewayID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.CustomerGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		createErr error
+		want
+	}{
+		"Successful": {
+			want: want{
+				cr: customerGateway(withExternalName(gatewayID), withSpec(params()), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			createErr: errBoom,
+			want: want{
+				cr:  customerGateway(withSpec(params()), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsec2.CreateCustomerGatewayInput
+			e := &external{client: &fake.MockCustomerGatewayClient{
+				MockCreate: func(_ context.Context, in *awsec2.CreateCustomerGatewayInput, _ []func(*awsec2.Options)) (*awsec2.CreateCustomerGatewayOutput, error) {
+					input = in
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return &awsec2.CreateCustomerGatewayOutput{CustomerGateway: &awsec2types.CustomerGateway{CustomerGatewayId: aws.String(gatewayID)}}, nil
+				},
+			}}
+			cr := customerGateway(withSpec(params()))
+			_, err := e.Create(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			want := &awsec2.CreateCustomerGatewayInput{
+				Type:     awsec2types.GatewayTypeIpsec1,
+				BgpAsn:   aws.Int32(65000),
+				PublicIp: aws.String(ipAddress),
+			}
+			if diff := cmp.Diff(want, input, cmpopts.IgnoreUnexported(awsec2.CreateCustomerGatewayInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.CustomerGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDelete: func(context.Context, *awsec2.DeleteCustomerGatewayInput, []func(*awsec2.Options)) (*awsec2.DeleteCustomerGatewayOutput, error) {
+						return &awsec2.DeleteCustomerGatewayOutput{}, nil
+					},
+				},
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr: customerGateway(withExternalName(gatewayID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDelete: func(context.Context, *awsec2.DeleteCustomerGatewayInput, []func(*awsec2.Options)) (*awsec2.DeleteCustomerGatewayOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.CustomerGatewayIDNotFound}
+					},
+				},
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr: customerGateway(withExternalName(gatewayID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockCustomerGatewayClient{
+					MockDelete: func(context.Context, *awsec2.DeleteCustomerGatewayInput, []func(*awsec2.Options)) (*awsec2.DeleteCustomerGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: customerGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr:  customerGateway(withExternalName(gatewayID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
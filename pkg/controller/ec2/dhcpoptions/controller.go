@@ -0,0 +1,293 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptions
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a DHCPOptions resource"
+	errDescribe         = "failed to describe DHCPOptions"
+	errMultipleItems    = "retrieved multiple DHCPOptions for the given dhcpOptionsId"
+	errDescribeVPCs     = "failed to describe the VPCs associated with the DHCPOptions"
+	errCreate           = "failed to create the DHCPOptions resource"
+	errAssociate        = "failed to associate the DHCPOptions with the VPC"
+	errDissociate       = "failed to associate the VPC with the default DHCP options"
+	errDelete           = "failed to delete the DHCPOptions resource"
+	errCreateTags       = "failed to create tags for the DHCPOptions resource"
+	errDeleteTags       = "failed to delete tags for the DHCPOptions resource"
+
+	filterDHCPOptionsID = "dhcp-options-id"
+)
+
+// SetupDHCPOptions adds a controller that reconciles DHCPOptions.
+func SetupDHCPOptions(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.DHCPOptionsGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.DHCPOptions{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.DHCPOptionsGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewDHCPOptionsClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.DHCPOptionsClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.DHCPOptionsClient
+}
+
+// describe returns the observed DHCP options, or nil if they don't exist
+// anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.DHCPOptions) (*awsec2types.DhcpOptions, error) {
+	response, err := e.client.DescribeDhcpOptions(ctx, &awsec2.DescribeDhcpOptionsInput{
+		DhcpOptionsIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsDHCPOptionsNotFoundErr, err), errDescribe)
+	}
+	switch len(response.DhcpOptions) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.DhcpOptions[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+// associatedVPCs returns the IDs of the VPCs that use the DHCP options.
+func (e *external) associatedVPCs(ctx context.Context, cr *manualv1alpha1.DHCPOptions) ([]string, error) {
+	var ids []string
+	input := &awsec2.DescribeVpcsInput{
+		Filters: []awsec2types.Filter{{
+			Name:   aws.String(filterDHCPOptionsID),
+			Values: []string{meta.GetExternalName(cr)},
+		}},
+	}
+	for {
+		response, err := e.client.DescribeVpcs(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errDescribeVPCs)
+		}
+		for _, v := range response.Vpcs {
+			ids = append(ids, aws.ToString(v.VpcId))
+		}
+		if aws.ToString(response.NextToken) == "" {
+			return ids, nil
+		}
+		input.NextToken = response.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+	vpcIDs, err := e.associatedVPCs(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeDHCPOptions(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateDHCPOptionsObservation(*observed, vpcIDs)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsDHCPOptionsUpToDate(cr.Spec.ForProvider, *observed, vpcIDs),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	input := &awsec2.CreateDhcpOptionsInput{
+		DhcpConfigurations: ec2.GenerateDHCPConfigurations(cr.Spec.ForProvider),
+	}
+	if len(cr.Spec.ForProvider.Tags) != 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeDhcpOptions,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+	out, err := e.client.CreateDhcpOptions(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.DhcpOptions.DhcpOptionsId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	vpcIDs, err := e.associatedVPCs(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	associate, dissociate := ec2.DiffDHCPOptionsAssociations(cr.Spec.ForProvider, vpcIDs)
+	if err := e.dissociate(ctx, dissociate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if associate {
+		if _, err := e.client.AssociateDhcpOptions(ctx, &awsec2.AssociateDhcpOptionsInput{
+			DhcpOptionsId: aws.String(meta.GetExternalName(cr)),
+			VpcId:         cr.Spec.ForProvider.VPCID,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAssociate)
+		}
+	}
+
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// dissociate associates the supplied VPCs with the default DHCP options of
+// their region.
+func (e *external) dissociate(ctx context.Context, vpcIDs []string) error {
+	for _, id := range vpcIDs {
+		if _, err := e.client.AssociateDhcpOptions(ctx, &awsec2.AssociateDhcpOptionsInput{
+			DhcpOptionsId: aws.String(ec2.DefaultDHCPOptionsID),
+			VpcId:         aws.String(id),
+		}); err != nil {
+			return awsclient.Wrap(err, errDissociate)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// DHCP options can only be deleted once no VPC uses them anymore.
+	vpcIDs, err := e.associatedVPCs(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if err := e.dissociate(ctx, vpcIDs); err != nil {
+		return err
+	}
+
+	_, err = e.client.DeleteDhcpOptions(ctx, &awsec2.DeleteDhcpOptionsInput{
+		DhcpOptionsId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsDHCPOptionsNotFoundErr, err), errDelete)
+}
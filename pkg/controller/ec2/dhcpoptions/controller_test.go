@@ -0,0 +1,379 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	optionsID = "dopt-123"
+	ownerID   = "owner"
+	vpcA      = "vpc-a"
+	vpcB      = "vpc-b"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.DHCPOptionsClient
+	cr     *manualv1alpha1.DHCPOptions
+}
+
+type dhcpOptionsModifier func(*manualv1alpha1.DHCPOptions)
+
+func withExternalName(name string) dhcpOptionsModifier {
+	return func(r *manualv1alpha1.DHCPOptions) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) dhcpOptionsModifier {
+	return func(r *manualv1alpha1.DHCPOptions) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withVPC(id string) dhcpOptionsModifier {
+	return func(r *manualv1alpha1.DHCPOptions) { r.Spec.ForProvider.VPCID = aws.String(id) }
+}
+
+func withStatus(s manualv1alpha1.DHCPOptionsObservation) dhcpOptionsModifier {
+	return func(r *manualv1alpha1.DHCPOptions) { r.Status.AtProvider = s }
+}
+
+func dhcpOptions(m ...dhcpOptionsModifier) *manualv1alpha1.DHCPOptions {
+	cr := &manualv1alpha1.DHCPOptions{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeOptions() func(context.Context, *awsec2.DescribeDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+	return func(context.Context, *awsec2.DescribeDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+		return &awsec2.DescribeDhcpOptionsOutput{DhcpOptions: []awsec2types.DhcpOptions{{
+			DhcpOptionsId: aws.String(optionsID),
+			OwnerId:       aws.String(ownerID),
+		}}}, nil
+	}
+}
+
+func describeVPCs(ids ...string) func(context.Context, *awsec2.DescribeVpcsInput, []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeVpcsInput, _ []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+		if len(input.Filters) != 1 || input.Filters[0].Values[0] != optionsID {
+			return nil, errors.New("unexpected filter")
+		}
+		out := &awsec2.DescribeVpcsOutput{}
+		for _, id := range ids {
+			out.Vpcs = append(out.Vpcs, awsec2types.Vpc{VpcId: aws.String(id)})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.DHCPOptions
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe:     describeOptions(),
+					MockDescribeVpcs: describeVPCs(vpcA),
+				},
+				cr: dhcpOptions(withExternalName(optionsID), withVPC(vpcA)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID), withVPC(vpcA),
+					withStatus(manualv1alpha1.DHCPOptionsObservation{
+						DHCPOptionsID: optionsID,
+						OwnerID:       ownerID,
+						VPCIDs:        []string{vpcA},
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AssociatedWithOtherVPC": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe:     describeOptions(),
+					MockDescribeVpcs: describeVPCs(vpcA),
+				},
+				cr: dhcpOptions(withExternalName(optionsID), withVPC(vpcB)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID), withVPC(vpcB),
+					withStatus(manualv1alpha1.DHCPOptionsObservation{
+						DHCPOptionsID: optionsID,
+						OwnerID:       ownerID,
+						VPCIDs:        []string{vpcA},
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.DHCPOptionsIDNotFound}
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+		},
+		"DescribeVPCsFailed": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe: describeOptions(),
+					MockDescribeVpcs: func(context.Context, *awsec2.DescribeVpcsInput, []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(optionsID)),
+				err: awsclient.Wrap(errBoom, errDescribeVPCs),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.DHCPOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockCreate: func(context.Context, *awsec2.CreateDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.CreateDhcpOptionsOutput, error) {
+						return &awsec2.CreateDhcpOptionsOutput{DhcpOptions: &awsec2types.DhcpOptions{DhcpOptionsId: aws.String(optionsID)}}, nil
+					},
+				},
+				cr: dhcpOptions(),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockCreate: func(context.Context, *awsec2.CreateDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.CreateDhcpOptionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: dhcpOptions(),
+			},
+			want: want{
+				cr:  dhcpOptions(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// associations records the DHCP options each VPC was associated with.
+type associations map[string]string
+
+func (a associations) associate(_ context.Context, input *awsec2.AssociateDhcpOptionsInput, _ []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+	a[aws.ToString(input.VpcId)] = aws.ToString(input.DhcpOptionsId)
+	return &awsec2.AssociateDhcpOptionsOutput{}, nil
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		associations associations
+		err          error
+	}
+
+	cases := map[string]struct {
+		vpcs []string
+		cr   *manualv1alpha1.DHCPOptions
+		want
+	}{
+		"Associate": {
+			cr: dhcpOptions(withExternalName(optionsID), withVPC(vpcA)),
+			want: want{
+				associations: associations{vpcA: optionsID},
+			},
+		},
+		"Move": {
+			vpcs: []string{vpcA},
+			cr:   dhcpOptions(withExternalName(optionsID), withVPC(vpcB)),
+			want: want{
+				associations: associations{vpcA: ec2.DefaultDHCPOptionsID, vpcB: optionsID},
+			},
+		},
+		"Dissociate": {
+			vpcs: []string{vpcA},
+			cr:   dhcpOptions(withExternalName(optionsID)),
+			want: want{
+				associations: associations{vpcA: ec2.DefaultDHCPOptionsID},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := associations{}
+			e := &external{client: &fake.MockDHCPOptionsClient{
+				MockDescribe:     describeOptions(),
+				MockDescribeVpcs: describeVPCs(tc.vpcs...),
+				MockAssociate:    a.associate,
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.associations, a); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		associations associations
+		err          error
+	}
+
+	cases := map[string]struct {
+		vpcs   []string
+		delete func(context.Context, *awsec2.DeleteDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.DeleteDhcpOptionsOutput, error)
+		want
+	}{
+		"DissociatesAndDeletes": {
+			vpcs: []string{vpcA},
+			delete: func(context.Context, *awsec2.DeleteDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.DeleteDhcpOptionsOutput, error) {
+				return &awsec2.DeleteDhcpOptionsOutput{}, nil
+			},
+			want: want{
+				associations: associations{vpcA: ec2.DefaultDHCPOptionsID},
+			},
+		},
+		"AlreadyGone": {
+			delete: func(context.Context, *awsec2.DeleteDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.DeleteDhcpOptionsOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: ec2.DHCPOptionsIDNotFound}
+			},
+			want: want{
+				associations: associations{},
+			},
+		},
+		"DeleteFailed": {
+			delete: func(context.Context, *awsec2.DeleteDhcpOptionsInput, []func(*awsec2.Options)) (*awsec2.DeleteDhcpOptionsOutput, error) {
+				return nil, errBoom
+			},
+			want: want{
+				associations: associations{},
+				err:          awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := associations{}
+			e := &external{client: &fake.MockDHCPOptionsClient{
+				MockDescribeVpcs: describeVPCs(tc.vpcs...),
+				MockAssociate:    a.associate,
+				MockDelete:       tc.delete,
+			}}
+			err := e.Delete(context.Background(), dhcpOptions(withExternalName(optionsID)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.associations, a); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2fleet
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an EC2Fleet resource"
+	errDescribe         = "failed to describe EC2Fleet"
+	errMultipleItems    = "retrieved multiple EC2Fleets for the given fleetId"
+	errCreate           = "failed to create the EC2Fleet resource"
+	errModify           = "failed to modify the EC2Fleet resource"
+	errDelete           = "failed to delete the EC2Fleet resource"
+	errCreateTags       = "failed to create tags for the EC2Fleet resource"
+	errDeleteTags       = "failed to delete tags for the EC2Fleet resource"
+)
+
+// SetupEC2Fleet adds a controller that reconciles EC2Fleets.
+func SetupEC2Fleet(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.EC2FleetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.EC2Fleet{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.EC2FleetGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewEC2FleetClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.EC2FleetClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.EC2Fleet)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.EC2FleetClient
+}
+
+// describe returns the observed fleet, or nil if it doesn't exist anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.EC2Fleet) (*awsec2types.FleetData, error) {
+	response, err := e.client.DescribeFleets(ctx, &awsec2.DescribeFleetsInput{
+		FleetIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsEC2FleetNotFoundErr, err), errDescribe)
+	}
+	switch len(response.Fleets) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.Fleets[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+// isModifiable returns true if the launch templates and the target capacity
+// of the fleet can be modified.
+func isModifiable(f awsec2types.FleetData) bool {
+	return f.Type == awsec2types.FleetTypeMaintain && f.FleetState == awsec2types.FleetStateCodeActive
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.EC2Fleet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Deleted fleets remain visible for a while, possibly while their
+	// instances are still terminating.
+	switch observed.FleetState {
+	case awsec2types.FleetStateCodeDeleted,
+		awsec2types.FleetStateCodeDeletedRunning,
+		awsec2types.FleetStateCodeDeletedTerminatingInstances:
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeEC2Fleet(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateEC2FleetObservation(*observed)
+	switch observed.FleetState {
+	case awsec2types.FleetStateCodeActive, awsec2types.FleetStateCodeModifying:
+		cr.SetConditions(xpv1.Available())
+	case awsec2types.FleetStateCodeSubmitted:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate := manualv1alpha1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags) &&
+		(!isModifiable(*observed) || ec2.IsEC2FleetConfigUpToDate(cr.Spec.ForProvider, *observed))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.EC2Fleet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateFleet(ctx, ec2.GenerateCreateEC2FleetInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.FleetId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.EC2Fleet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if isModifiable(*observed) && !ec2.IsEC2FleetConfigUpToDate(cr.Spec.ForProvider, *observed) {
+		if _, err := e.client.ModifyFleet(ctx, ec2.GenerateModifyEC2FleetInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+		}
+	}
+
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.EC2Fleet)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	terminate := cr.Spec.ForProvider.TerminateInstancesOnDeletion
+	if terminate == nil {
+		terminate = aws.Bool(true)
+	}
+	out, err := e.client.DeleteFleets(ctx, &awsec2.DeleteFleetsInput{
+		FleetIds:           []string{meta.GetExternalName(cr)},
+		TerminateInstances: terminate,
+	})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(ec2.IsEC2FleetNotFoundErr, err), errDelete)
+	}
+	// Failures to delete individual fleets are not reported as API errors.
+	for _, f := range out.UnsuccessfulFleetDeletions {
+		if f.Error == nil || f.Error.Code == awsec2types.DeleteFleetErrorCodeFleetIdDoesNotExist {
+			continue
+		}
+		return errors.Wrap(errors.Errorf("%s: %s", f.Error.Code, aws.ToString(f.Error.Message)), errDelete)
+	}
+	return nil
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2fleet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	fleetID      = "fleet-123"
+	instanceID   = "i-123"
+	maintain     = string(awsec2types.FleetTypeMaintain)
+	noTerminate  = string(awsec2types.FleetExcessCapacityTerminationPolicyNoTermination)
+	spotCapacity = string(awsec2types.DefaultTargetCapacityTypeSpot)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.EC2FleetClient
+	cr     *manualv1alpha1.EC2Fleet
+}
+
+type fleetModifier func(*manualv1alpha1.EC2Fleet)
+
+func withExternalName(name string) fleetModifier {
+	return func(r *manualv1alpha1.EC2Fleet) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) fleetModifier {
+	return func(r *manualv1alpha1.EC2Fleet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.EC2FleetParameters) fleetModifier {
+	return func(r *manualv1alpha1.EC2Fleet) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.EC2FleetObservation) fleetModifier {
+	return func(r *manualv1alpha1.EC2Fleet) { r.Status.AtProvider = s }
+}
+
+func fleet(m ...fleetModifier) *manualv1alpha1.EC2Fleet {
+	cr := &manualv1alpha1.EC2Fleet{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(capacity int32) manualv1alpha1.EC2FleetParameters {
+	return manualv1alpha1.EC2FleetParameters{
+		Type: &maintain,
+		LaunchTemplateConfigs: []manualv1alpha1.EC2FleetLaunchTemplateConfig{{
+			LaunchTemplateSpecification: manualv1alpha1.EC2FleetLaunchTemplateSpecification{
+				LaunchTemplateName: aws.String("sample"),
+				Version:            "$Latest",
+			},
+			Overrides: []manualv1alpha1.EC2FleetLaunchTemplateOverrides{
+				{InstanceType: aws.String("m5.large")},
+				{InstanceType: aws.String("m5a.large")},
+			},
+		}},
+		TargetCapacitySpecification: manualv1alpha1.EC2FleetTargetCapacitySpecification{
+			TotalTargetCapacity:       capacity,
+			DefaultTargetCapacityType: &spotCapacity,
+		},
+		ExcessCapacityTerminationPolicy: &noTerminate,
+	}
+}
+
+func observed(state awsec2types.FleetStateCode, capacity int32) awsec2types.FleetData {
+	return awsec2types.FleetData{
+		FleetId:    aws.String(fleetID),
+		FleetState: state,
+		Type:       awsec2types.FleetTypeMaintain,
+		LaunchTemplateConfigs: []awsec2types.FleetLaunchTemplateConfig{{
+			LaunchTemplateSpecification: &awsec2types.FleetLaunchTemplateSpecification{
+				LaunchTemplateName: aws.String("sample"),
+				Version:            aws.String("$Latest"),
+			},
+			Overrides: []awsec2types.FleetLaunchTemplateOverrides{
+				{InstanceType: awsec2types.InstanceTypeM5Large},
+				{InstanceType: awsec2types.InstanceTypeM5aLarge},
+			},
+		}},
+		TargetCapacitySpecification: &awsec2types.TargetCapacitySpecification{
+			TotalTargetCapacity:       aws.Int32(capacity),
+			DefaultTargetCapacityType: awsec2types.DefaultTargetCapacityTypeSpot,
+		},
+		ExcessCapacityTerminationPolicy: awsec2types.FleetExcessCapacityTerminationPolicyNoTermination,
+		FulfilledCapacity:               aws.Float64(1),
+		Instances:                       []awsec2types.DescribeFleetsInstances{{InstanceIds: []string{instanceID}}},
+	}
+}
+
+func observation(state awsec2types.FleetStateCode) manualv1alpha1.EC2FleetObservation {
+	return manualv1alpha1.EC2FleetObservation{
+		FleetID:           fleetID,
+		FleetState:        string(state),
+		FulfilledCapacity: 1,
+		InstanceIDs:       []string{instanceID},
+	}
+}
+
+func describe(f awsec2types.FleetData) func(context.Context, *awsec2.DescribeFleetsInput, []func(*awsec2.Options)) (*awsec2.DescribeFleetsOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeFleetsInput, _ []func(*awsec2.Options)) (*awsec2.DescribeFleetsOutput, error) {
+		if len(input.FleetIds) != 1 || input.FleetIds[0] != fleetID {
+			return nil, errors.New("unexpected fleet")
+		}
+		return &awsec2.DescribeFleetsOutput{Fleets: []awsec2types.FleetData{f}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.EC2Fleet
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribe: describe(observed(awsec2types.FleetStateCodeActive, 2)),
+				},
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2)),
+					withStatus(observation(awsec2types.FleetStateCodeActive)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CapacityChanged": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribe: describe(observed(awsec2types.FleetStateCodeActive, 2)),
+				},
+				cr: fleet(withExternalName(fleetID), withSpec(spec(4))),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withSpec(spec(4)),
+					withStatus(observation(awsec2types.FleetStateCodeActive)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ModifyingIsNotModifiedAgain": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribe: describe(observed(awsec2types.FleetStateCodeModifying, 2)),
+				},
+				cr: fleet(withExternalName(fleetID), withSpec(spec(4))),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withSpec(spec(4)),
+					withStatus(observation(awsec2types.FleetStateCodeModifying)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Submitted": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribe: describe(observed(awsec2types.FleetStateCodeSubmitted, 2)),
+				},
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2)),
+					withStatus(observation(awsec2types.FleetStateCodeSubmitted)),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribe: describe(observed(awsec2types.FleetStateCodeActive, 2)),
+				},
+				cr: fleet(withExternalName(fleetID), withSpec(func() manualv1alpha1.EC2FleetParameters {
+					p := spec(2)
+					p.Type = nil
+					p.ExcessCapacityTerminationPolicy = nil
+					return p
+				}())),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2)),
+					withStatus(observation(awsec2types.FleetStateCodeActive)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribe: describe(observed(awsec2types.FleetStateCodeDeletedTerminatingInstances, 2)),
+				},
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeFleetsInput, []func(*awsec2.Options)) (*awsec2.DescribeFleetsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.FleetIDNotFound}
+					},
+				},
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			},
+			want: want{
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockEC2FleetClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeFleetsInput, []func(*awsec2.Options)) (*awsec2.DescribeFleetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			},
+			want: want{
+				cr:  fleet(withExternalName(fleetID), withSpec(spec(2))),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modified bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		observed  awsec2types.FleetData
+		cr        *manualv1alpha1.EC2Fleet
+		modifyErr error
+		want
+	}{
+		"CapacityChanged": {
+			observed: observed(awsec2types.FleetStateCodeActive, 2),
+			cr:       fleet(withExternalName(fleetID), withSpec(spec(4))),
+			want: want{
+				modified: true,
+			},
+		},
+		"UpToDate": {
+			observed: observed(awsec2types.FleetStateCodeActive, 2),
+			cr:       fleet(withExternalName(fleetID), withSpec(spec(2))),
+		},
+		"RequestFleetIsNotModified": {
+			observed: func() awsec2types.FleetData {
+				f := observed(awsec2types.FleetStateCodeActive, 2)
+				f.Type = awsec2types.FleetTypeRequest
+				return f
+			}(),
+			cr: fleet(withExternalName(fleetID), withSpec(spec(4))),
+		},
+		"ModifyFailed": {
+			observed:  observed(awsec2types.FleetStateCodeActive, 2),
+			cr:        fleet(withExternalName(fleetID), withSpec(spec(4))),
+			modifyErr: errBoom,
+			want: want{
+				modified: true,
+				err:      awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modified := false
+			e := &external{client: &fake.MockEC2FleetClient{
+				MockDescribe: describe(tc.observed),
+				MockModify: func(_ context.Context, in *awsec2.ModifyFleetInput, _ []func(*awsec2.Options)) (*awsec2.ModifyFleetOutput, error) {
+					modified = aws.ToString(in.FleetId) == fleetID && aws.ToInt32(in.TargetCapacitySpecification.TotalTargetCapacity) == 4
+					return &awsec2.ModifyFleetOutput{}, tc.modifyErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified); diff != "" {
+				t.Errorf("modified: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		cr        *manualv1alpha1.EC2Fleet
+		out       *awsec2.DeleteFleetsOutput
+		terminate bool
+		err       error
+	}{
+		"TerminatesInstancesByDefault": {
+			cr:        fleet(withExternalName(fleetID), withSpec(spec(2))),
+			out:       &awsec2.DeleteFleetsOutput{},
+			terminate: true,
+		},
+		"KeepsInstances": {
+			cr: fleet(withExternalName(fleetID), withSpec(func() manualv1alpha1.EC2FleetParameters {
+				p := spec(2)
+				p.TerminateInstancesOnDeletion = aws.Bool(false)
+				return p
+			}())),
+			out: &awsec2.DeleteFleetsOutput{},
+		},
+		"AlreadyGone": {
+			cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			out: &awsec2.DeleteFleetsOutput{UnsuccessfulFleetDeletions: []awsec2types.DeleteFleetErrorItem{{
+				FleetId: aws.String(fleetID),
+				Error:   &awsec2types.DeleteFleetError{Code: awsec2types.DeleteFleetErrorCodeFleetIdDoesNotExist},
+			}}},
+			terminate: true,
+		},
+		"Unsuccessful": {
+			cr: fleet(withExternalName(fleetID), withSpec(spec(2))),
+			out: &awsec2.DeleteFleetsOutput{UnsuccessfulFleetDeletions: []awsec2types.DeleteFleetErrorItem{{
+				FleetId: aws.String(fleetID),
+				Error: &awsec2types.DeleteFleetError{
+					Code:    awsec2types.DeleteFleetErrorCodeFleetNotInDeletableState,
+					Message: aws.String("not deletable"),
+				},
+			}}},
+			terminate: true,
+			err:       errors.Wrap(errors.New("fleetNotInDeletableState: not deletable"), errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var terminate *bool
+			e := &external{client: &fake.MockEC2FleetClient{
+				MockDelete: func(_ context.Context, in *awsec2.DeleteFleetsInput, _ []func(*awsec2.Options)) (*awsec2.DeleteFleetsOutput, error) {
+					terminate = in.TerminateInstances
+					return tc.out, nil
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(aws.Bool(tc.terminate), terminate); diff != "" {
+				t.Errorf("terminate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
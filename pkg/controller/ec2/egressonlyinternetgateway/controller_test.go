@@ -0,0 +1,437 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package egressonlyinternetgateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	vpcID = "some vpc"
+	igID  = "some ID"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ig   ec2.EgressOnlyInternetGatewayClient
+	kube client.Client
+	cr   *manualv1alpha1.EgressOnlyInternetGateway
+}
+
+type igModifier func(*manualv1alpha1.EgressOnlyInternetGateway)
+
+func withExternalName(name string) igModifier {
+	return func(r *manualv1alpha1.EgressOnlyInternetGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) igModifier {
+	return func(r *manualv1alpha1.EgressOnlyInternetGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.EgressOnlyInternetGatewayParameters) igModifier {
+	return func(r *manualv1alpha1.EgressOnlyInternetGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.EgressOnlyInternetGatewayObservation) igModifier {
+	return func(r *manualv1alpha1.EgressOnlyInternetGateway) { r.Status.AtProvider = s }
+}
+
+func ig(m ...igModifier) *manualv1alpha1.EgressOnlyInternetGateway {
+	cr := &manualv1alpha1.EgressOnlyInternetGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func igAttachments(state awsec2types.AttachmentStatus) []awsec2types.InternetGatewayAttachment {
+	return []awsec2types.InternetGatewayAttachment{
+		{
+			VpcId: aws.String(vpcID),
+			State: state,
+		},
+	}
+}
+
+func statusAttachments(state awsec2types.AttachmentStatus) []manualv1alpha1.EgressOnlyInternetGatewayAttachment {
+	return []manualv1alpha1.EgressOnlyInternetGatewayAttachment{
+		{
+			AttachmentStatus: string(state),
+			VPCID:            vpcID,
+		},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.EgressOnlyInternetGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeEgressOnlyInternetGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeEgressOnlyInternetGatewaysOutput, error) {
+						return &awsec2.DescribeEgressOnlyInternetGatewaysOutput{
+							EgressOnlyInternetGateways: []awsec2types.EgressOnlyInternetGateway{
+								{
+									Attachments:                 igAttachments(awsec2types.AttachmentStatusAttached),
+									EgressOnlyInternetGatewayId: aws.String(igID),
+								},
+							},
+						}, nil
+					},
+				},
+				cr: ig(withSpec(manualv1alpha1.EgressOnlyInternetGatewayParameters{
+					VPCID: aws.String(vpcID),
+				}), withExternalName(igID)),
+			},
+			want: want{
+				cr: ig(withSpec(manualv1alpha1.EgressOnlyInternetGatewayParameters{
+					VPCID: aws.String(vpcID),
+				}),
+					withStatus(manualv1alpha1.EgressOnlyInternetGatewayObservation{
+						EgressOnlyInternetGatewayID: igID,
+						Attachments:                 statusAttachments(awsec2types.AttachmentStatusAttached),
+					}),
+					withExternalName(igID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SuccessfulLateInitAttaching": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeEgressOnlyInternetGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeEgressOnlyInternetGatewaysOutput, error) {
+						return &awsec2.DescribeEgressOnlyInternetGatewaysOutput{
+							EgressOnlyInternetGateways: []awsec2types.EgressOnlyInternetGateway{
+								{
+									Attachments:                 igAttachments(awsec2types.AttachmentStatusAttaching),
+									EgressOnlyInternetGatewayId: aws.String(igID),
+								},
+							},
+						}, nil
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr: ig(withSpec(manualv1alpha1.EgressOnlyInternetGatewayParameters{
+					VPCID: aws.String(vpcID),
+				}),
+					withStatus(manualv1alpha1.EgressOnlyInternetGatewayObservation{
+						EgressOnlyInternetGatewayID: igID,
+						Attachments:                 statusAttachments(awsec2types.AttachmentStatusAttaching),
+					}),
+					withExternalName(igID),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeEgressOnlyInternetGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeEgressOnlyInternetGatewaysOutput, error) {
+						return &awsec2.DescribeEgressOnlyInternetGatewaysOutput{}, nil
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr: ig(withExternalName(igID)),
+			},
+		},
+		"MultipleIGs": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeEgressOnlyInternetGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeEgressOnlyInternetGatewaysOutput, error) {
+						return &awsec2.DescribeEgressOnlyInternetGatewaysOutput{
+							EgressOnlyInternetGateways: []awsec2types.EgressOnlyInternetGateway{{}, {}},
+						}, nil
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr:  ig(withExternalName(igID)),
+				err: errors.New(errNotSingleItem),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeEgressOnlyInternetGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeEgressOnlyInternetGatewaysOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr:  ig(withExternalName(igID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.ig}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.EgressOnlyInternetGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateEgressOnlyInternetGatewayInput, opts []func(*awsec2.Options)) (*awsec2.CreateEgressOnlyInternetGatewayOutput, error) {
+						if aws.ToString(input.VpcId) != vpcID {
+							return nil, errors.New("unexpected VPC")
+						}
+						return &awsec2.CreateEgressOnlyInternetGatewayOutput{
+							EgressOnlyInternetGateway: &awsec2types.EgressOnlyInternetGateway{
+								EgressOnlyInternetGatewayId: aws.String(igID),
+							},
+						}, nil
+					},
+				},
+				cr: ig(withSpec(manualv1alpha1.EgressOnlyInternetGatewayParameters{
+					VPCID: aws.String(vpcID),
+				})),
+			},
+			want: want{
+				cr: ig(withSpec(manualv1alpha1.EgressOnlyInternetGatewayParameters{
+					VPCID: aws.String(vpcID),
+				}),
+					withExternalName(igID),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateEgressOnlyInternetGatewayInput, opts []func(*awsec2.Options)) (*awsec2.CreateEgressOnlyInternetGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ig(),
+			},
+			want: want{
+				cr:  ig(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.ig}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.EgressOnlyInternetGateway
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr: ig(withExternalName(igID)),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr:  ig(withExternalName(igID)),
+				err: awsclient.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.ig}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.EgressOnlyInternetGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteEgressOnlyInternetGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DeleteEgressOnlyInternetGatewayOutput, error) {
+						return &awsec2.DeleteEgressOnlyInternetGatewayOutput{}, nil
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr: ig(withExternalName(igID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteEgressOnlyInternetGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DeleteEgressOnlyInternetGatewayOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.EgressOnlyInternetGatewayIDNotFound}
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr: ig(withExternalName(igID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				ig: &fake.MockEgressOnlyInternetGatewayClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteEgressOnlyInternetGatewayInput, opts []func(*awsec2.Options)) (*awsec2.DeleteEgressOnlyInternetGatewayOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ig(withExternalName(igID)),
+			},
+			want: want{
+				cr:  ig(withExternalName(igID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.ig}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
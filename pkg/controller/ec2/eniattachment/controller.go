@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eniattachment
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an ENIAttachment resource"
+	errDescribe         = "failed to describe the network interface of the ENIAttachment resource"
+	errAttach           = "failed to attach the network interface"
+	errModify           = "failed to modify the attachment of the network interface"
+	errDetach           = "failed to detach the network interface"
+)
+
+// SetupENIAttachment adds a controller that reconciles ENIAttachments.
+func SetupENIAttachment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.ENIAttachmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.ENIAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.ENIAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewENIAttachmentClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.ENIAttachmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.ENIAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.ENIAttachmentClient
+}
+
+// attachment returns the attachment of the network interface to the instance,
+// or nil if it is not attached to it.
+func (e *external) attachment(ctx context.Context, cr *manualv1alpha1.ENIAttachment) (*awsec2types.NetworkInterfaceAttachment, error) {
+	response, err := e.client.DescribeNetworkInterfaces(ctx, &awsec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []string{aws.ToString(cr.Spec.ForProvider.NetworkInterfaceID)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsNetworkInterfaceNotFoundErr, err), errDescribe)
+	}
+	if len(response.NetworkInterfaces) == 0 {
+		return nil, nil
+	}
+	a := ec2.FindENIAttachment(response.NetworkInterfaces[0], aws.ToString(cr.Spec.ForProvider.InstanceID))
+	if a == nil || a.Status == awsec2types.AttachmentStatusDetached {
+		return nil, nil
+	}
+	return a, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ENIAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The network interface and the instance identify the attachment, so a
+	// change of either of them results in a new attachment rather than an
+	// update.
+	a, err := e.attachment(ctx, cr)
+	if err != nil || a == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = ec2.GenerateENIAttachmentObservation(*a)
+	switch a.Status {
+	case awsec2types.AttachmentStatusAttaching:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.AttachmentStatusDetaching:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsENIAttachmentUpToDate(cr.Spec.ForProvider, *a),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ENIAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// DeleteOnTermination cannot be set when attaching, it is set by the
+	// following update.
+	_, err := e.client.AttachNetworkInterface(ctx, &awsec2.AttachNetworkInterfaceInput{
+		DeviceIndex:        aws.Int32(cr.Spec.ForProvider.DeviceIndex),
+		InstanceId:         cr.Spec.ForProvider.InstanceID,
+		NetworkInterfaceId: cr.Spec.ForProvider.NetworkInterfaceID,
+		NetworkCardIndex:   cr.Spec.ForProvider.NetworkCardIndex,
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errAttach)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.ENIAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.ModifyNetworkInterfaceAttribute(ctx, &awsec2.ModifyNetworkInterfaceAttributeInput{
+		NetworkInterfaceId: cr.Spec.ForProvider.NetworkInterfaceID,
+		Attachment: &awsec2types.NetworkInterfaceAttachmentChanges{
+			AttachmentId:        aws.String(cr.Status.AtProvider.AttachmentID),
+			DeleteOnTermination: cr.Spec.ForProvider.DeleteOnTermination,
+		},
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.ENIAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DetachNetworkInterface(ctx, &awsec2.DetachNetworkInterfaceInput{
+		AttachmentId: aws.String(cr.Status.AtProvider.AttachmentID),
+		Force:        cr.Spec.ForProvider.ForceDetach,
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsENIAttachmentNotFoundErr, err), errDetach)
+}
@@ -0,0 +1,385 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eniattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	eniID        = "eni-123"
+	instanceID   = "i-123"
+	otherID      = "i-456"
+	attachmentID = "eni-attach-123"
+	errBoom      = errors.New("boom")
+	attachParams = manualv1alpha1.ENIAttachmentParameters{
+		DeviceIndex:        1,
+		NetworkInterfaceID: aws.String(eniID),
+		InstanceID:         aws.String(instanceID),
+	}
+)
+
+type args struct {
+	client ec2.ENIAttachmentClient
+	cr     *manualv1alpha1.ENIAttachment
+}
+
+type attachmentModifier func(*manualv1alpha1.ENIAttachment)
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *manualv1alpha1.ENIAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.ENIAttachmentParameters) attachmentModifier {
+	return func(r *manualv1alpha1.ENIAttachment) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.ENIAttachmentObservation) attachmentModifier {
+	return func(r *manualv1alpha1.ENIAttachment) { r.Status.AtProvider = s }
+}
+
+func attachment(m ...attachmentModifier) *manualv1alpha1.ENIAttachment {
+	cr := &manualv1alpha1.ENIAttachment{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeInterface(a *awsec2types.NetworkInterfaceAttachment) func(context.Context, *awsec2.DescribeNetworkInterfacesInput, []func(*awsec2.Options)) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeNetworkInterfacesInput, _ []func(*awsec2.Options)) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+		if len(input.NetworkInterfaceIds) != 1 || input.NetworkInterfaceIds[0] != eniID {
+			return nil, errors.New("unexpected network interface")
+		}
+		return &awsec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []awsec2types.NetworkInterface{{
+			NetworkInterfaceId: aws.String(eniID),
+			Attachment:         a,
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.ENIAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Attached": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDescribe: describeInterface(&awsec2types.NetworkInterfaceAttachment{
+						AttachmentId: aws.String(attachmentID),
+						InstanceId:   aws.String(instanceID),
+						DeviceIndex:  aws.Int32(1),
+						Status:       awsec2types.AttachmentStatusAttached,
+					}),
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams),
+					withStatus(manualv1alpha1.ENIAttachmentObservation{
+						AttachmentID: attachmentID,
+						Status:       string(awsec2types.AttachmentStatusAttached),
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeleteOnTerminationChanged": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDescribe: describeInterface(&awsec2types.NetworkInterfaceAttachment{
+						AttachmentId:        aws.String(attachmentID),
+						InstanceId:          aws.String(instanceID),
+						Status:              awsec2types.AttachmentStatusAttached,
+						DeleteOnTermination: aws.Bool(false),
+					}),
+				},
+				cr: attachment(withSpec(func() manualv1alpha1.ENIAttachmentParameters {
+					p := attachParams
+					p.DeleteOnTermination = aws.Bool(true)
+					return p
+				}())),
+			},
+			want: want{
+				cr: attachment(withSpec(func() manualv1alpha1.ENIAttachmentParameters {
+					p := attachParams
+					p.DeleteOnTermination = aws.Bool(true)
+					return p
+				}()),
+					withStatus(manualv1alpha1.ENIAttachmentObservation{
+						AttachmentID: attachmentID,
+						Status:       string(awsec2types.AttachmentStatusAttached),
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Attaching": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDescribe: describeInterface(&awsec2types.NetworkInterfaceAttachment{
+						AttachmentId: aws.String(attachmentID),
+						InstanceId:   aws.String(instanceID),
+						Status:       awsec2types.AttachmentStatusAttaching,
+					}),
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams),
+					withStatus(manualv1alpha1.ENIAttachmentObservation{
+						AttachmentID: attachmentID,
+						Status:       string(awsec2types.AttachmentStatusAttaching),
+					}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AttachedToOtherInstance": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDescribe: describeInterface(&awsec2types.NetworkInterfaceAttachment{
+						AttachmentId: aws.String(attachmentID),
+						InstanceId:   aws.String(otherID),
+						Status:       awsec2types.AttachmentStatusAttached,
+					}),
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams)),
+			},
+		},
+		"NotAttached": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDescribe: describeInterface(nil),
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams)),
+			},
+		},
+		"InterfaceNotFound": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeNetworkInterfacesInput, []func(*awsec2.Options)) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkInterfaceIDNotFound}
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeNetworkInterfacesInput, []func(*awsec2.Options)) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr:  attachment(withSpec(attachParams)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.ENIAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockAttach: func(_ context.Context, input *awsec2.AttachNetworkInterfaceInput, _ []func(*awsec2.Options)) (*awsec2.AttachNetworkInterfaceOutput, error) {
+						if aws.ToString(input.NetworkInterfaceId) != eniID || aws.ToString(input.InstanceId) != instanceID || aws.ToInt32(input.DeviceIndex) != 1 {
+							return nil, errors.New("unexpected attachment")
+						}
+						return &awsec2.AttachNetworkInterfaceOutput{AttachmentId: aws.String(attachmentID)}, nil
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams), withConditions(xpv1.Creating())),
+			},
+		},
+		"AttachFailed": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockAttach: func(context.Context, *awsec2.AttachNetworkInterfaceInput, []func(*awsec2.Options)) (*awsec2.AttachNetworkInterfaceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr:  attachment(withSpec(attachParams), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.ENIAttachment
+		err error
+	}
+
+	status := manualv1alpha1.ENIAttachmentObservation{AttachmentID: attachmentID}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDetach: func(_ context.Context, input *awsec2.DetachNetworkInterfaceInput, _ []func(*awsec2.Options)) (*awsec2.DetachNetworkInterfaceOutput, error) {
+						if aws.ToString(input.AttachmentId) != attachmentID {
+							return nil, errors.New("unexpected attachment")
+						}
+						return &awsec2.DetachNetworkInterfaceOutput{}, nil
+					},
+				},
+				cr: attachment(withSpec(attachParams), withStatus(status)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams), withStatus(status), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDetached": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDetach: func(context.Context, *awsec2.DetachNetworkInterfaceInput, []func(*awsec2.Options)) (*awsec2.DetachNetworkInterfaceOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.ENIAttachmentNotFound}
+					},
+				},
+				cr: attachment(withSpec(attachParams), withStatus(status)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams), withStatus(status), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DetachFailed": {
+			args: args{
+				client: &fake.MockENIAttachmentClient{
+					MockDetach: func(context.Context, *awsec2.DetachNetworkInterfaceInput, []func(*awsec2.Options)) (*awsec2.DetachNetworkInterfaceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(attachParams), withStatus(status)),
+			},
+			want: want{
+				cr:  attachment(withSpec(attachParams), withStatus(status), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDetach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
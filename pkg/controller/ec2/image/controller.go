@@ -0,0 +1,352 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not an Image resource"
+	errDescribe            = "failed to describe Image"
+	errMultipleItems       = "retrieved multiple Images for the given imageId"
+	errDescribePermissions = "failed to describe the launch permissions of the Image"
+	errSource              = "exactly one of sourceImageId and instanceId has to be set"
+	errCreate              = "failed to create the Image resource"
+	errModifyPermissions   = "failed to modify the launch permissions of the Image"
+	errEnableDeprecation   = "failed to enable the deprecation of the Image"
+	errDisableDeprecation  = "failed to disable the deprecation of the Image"
+	errDelete              = "failed to deregister the Image resource"
+	errDeleteSnapshot      = "failed to delete a snapshot of the Image"
+	errCreateTags          = "failed to create tags for the Image resource"
+	errDeleteTags          = "failed to delete tags for the Image resource"
+
+	attributeLaunchPermission = "launchPermission"
+)
+
+// SetupImage adds a controller that reconciles Images.
+func SetupImage(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.ImageGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.Image{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.ImageGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewImageClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.ImageClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.Image)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.ImageClient
+}
+
+// describe returns the observed image, or nil if it doesn't exist anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.Image) (*awsec2types.Image, error) {
+	response, err := e.client.DescribeImages(ctx, &awsec2.DescribeImagesInput{
+		ImageIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsImageNotFoundErr, err), errDescribe)
+	}
+	switch len(response.Images) {
+	case 0:
+		return nil, nil
+	case 1:
+		if response.Images[0].State == awsec2types.ImageStateDeregistered {
+			return nil, nil
+		}
+		return &response.Images[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+// describePermissions returns the launch permissions of the image.
+func (e *external) describePermissions(ctx context.Context, cr *manualv1alpha1.Image) ([]awsec2types.LaunchPermission, error) {
+	response, err := e.client.DescribeImageAttribute(ctx, &awsec2.DescribeImageAttributeInput{
+		ImageId:   aws.String(meta.GetExternalName(cr)),
+		Attribute: awsec2types.ImageAttributeNameLaunchPermission,
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errDescribePermissions)
+	}
+	return response.LaunchPermissions, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Image)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeImage(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateImageObservation(*observed)
+	switch observed.State {
+	case awsec2types.ImageStateAvailable:
+		cr.SetConditions(xpv1.Available())
+	case awsec2types.ImageStatePending:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// Images can only be shared and deprecated once they are available.
+	upToDate := true
+	if observed.State == awsec2types.ImageStateAvailable {
+		permissions, err := e.describePermissions(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = ec2.IsImageUpToDate(cr.Spec.ForProvider, *observed, permissions)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Image)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+	if (p.SourceImageID == nil) == (p.InstanceID == nil) {
+		return managed.ExternalCreation{}, errors.New(errSource)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	var id *string
+	if p.SourceImageID != nil {
+		sourceRegion := p.SourceRegion
+		if sourceRegion == nil {
+			sourceRegion = aws.String(p.Region)
+		}
+		// The tags are not copied, they are added once the copy exists.
+		out, err := e.client.CopyImage(ctx, &awsec2.CopyImageInput{
+			ClientToken:   aws.String(string(cr.UID)),
+			Name:          aws.String(p.Name),
+			Description:   p.Description,
+			SourceImageId: p.SourceImageID,
+			SourceRegion:  sourceRegion,
+			Encrypted:     p.Encrypted,
+			KmsKeyId:      p.KMSKeyID,
+		})
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+		}
+		id = out.ImageId
+	} else {
+		input := &awsec2.CreateImageInput{
+			InstanceId:  p.InstanceID,
+			Name:        aws.String(p.Name),
+			Description: p.Description,
+			NoReboot:    p.NoReboot,
+		}
+		if len(p.Tags) != 0 {
+			input.TagSpecifications = []awsec2types.TagSpecification{{
+				ResourceType: awsec2types.ResourceTypeImage,
+				Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+			}}
+		}
+		out, err := e.client.CreateImage(ctx, input)
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+		}
+		id = out.ImageId
+	}
+	meta.SetExternalName(cr, aws.ToString(id))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.Image)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil || observed.State != awsec2types.ImageStateAvailable {
+		return managed.ExternalUpdate{}, err
+	}
+	permissions, err := e.describePermissions(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	add, remove := ec2.DiffImageLaunchPermissions(cr.Spec.ForProvider.LaunchPermissions, permissions)
+	if len(add) > 0 || len(remove) > 0 {
+		if _, err := e.client.ModifyImageAttribute(ctx, &awsec2.ModifyImageAttributeInput{
+			ImageId:   aws.String(meta.GetExternalName(cr)),
+			Attribute: aws.String(attributeLaunchPermission),
+			LaunchPermission: &awsec2types.LaunchPermissionModifications{
+				Add:    add,
+				Remove: remove,
+			},
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyPermissions)
+		}
+	}
+
+	if !ec2.IsImageDeprecationUpToDate(cr.Spec.ForProvider.DeprecateAt, observed.DeprecationTime) {
+		if err := e.updateDeprecation(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	tagsAdd, tagsRemove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(tagsRemove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsRemove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(tagsAdd) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsAdd,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) updateDeprecation(ctx context.Context, cr *manualv1alpha1.Image) error {
+	if cr.Spec.ForProvider.DeprecateAt == nil {
+		_, err := e.client.DisableImageDeprecation(ctx, &awsec2.DisableImageDeprecationInput{
+			ImageId: aws.String(meta.GetExternalName(cr)),
+		})
+		return awsclient.Wrap(err, errDisableDeprecation)
+	}
+	_, err := e.client.EnableImageDeprecation(ctx, &awsec2.EnableImageDeprecationInput{
+		ImageId:     aws.String(meta.GetExternalName(cr)),
+		DeprecateAt: &cr.Spec.ForProvider.DeprecateAt.Time,
+	})
+	return awsclient.Wrap(err, errEnableDeprecation)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.Image)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// The snapshots can only be deleted once the image is deregistered, and
+	// they are not known anymore afterwards.
+	var snapshots []string
+	if aws.ToBool(cr.Spec.ForProvider.DeleteSnapshotsOnDeletion) {
+		observed, err := e.describe(ctx, cr)
+		if err != nil {
+			return err
+		}
+		if observed != nil {
+			snapshots = ec2.ImageSnapshotIDs(*observed)
+		}
+	}
+
+	_, err := e.client.DeregisterImage(ctx, &awsec2.DeregisterImageInput{
+		ImageId: aws.String(meta.GetExternalName(cr)),
+	})
+	if err := resource.Ignore(ec2.IsImageNotFoundErr, err); err != nil {
+		return awsclient.Wrap(err, errDelete)
+	}
+
+	for _, id := range snapshots {
+		_, err := e.client.DeleteSnapshot(ctx, &awsec2.DeleteSnapshotInput{
+			SnapshotId: aws.String(id),
+		})
+		if err := resource.Ignore(ec2.IsSnapshotNotFoundErr, err); err != nil {
+			return awsclient.Wrap(err, errDeleteSnapshot)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,419 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	imageID       = "ami-123"
+	sourceImageID = "ami-456"
+	snapshotID    = "snap-123"
+	accountID     = "111111111111"
+	uid           = "2ab0e2a4-b1c6-4d0b-8bbc-3e3d1f3a8a8f"
+
+	errBoom = errors.New("boom")
+)
+
+type imageModifier func(*manualv1alpha1.Image)
+
+func withExternalName(name string) imageModifier {
+	return func(r *manualv1alpha1.Image) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) imageModifier {
+	return func(r *manualv1alpha1.Image) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.ImageParameters) imageModifier {
+	return func(r *manualv1alpha1.Image) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.ImageObservation) imageModifier {
+	return func(r *manualv1alpha1.Image) { r.Status.AtProvider = s }
+}
+
+func image(m ...imageModifier) *manualv1alpha1.Image {
+	cr := &manualv1alpha1.Image{}
+	cr.UID = types.UID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(accounts ...string) manualv1alpha1.ImageParameters {
+	p := manualv1alpha1.ImageParameters{
+		Region:        "eu-west-1",
+		Name:          "golden",
+		Description:   aws.String("golden image"),
+		SourceImageID: aws.String(sourceImageID),
+		SourceRegion:  aws.String("us-east-1"),
+	}
+	if len(accounts) != 0 {
+		p.LaunchPermissions = &manualv1alpha1.ImageLaunchPermissions{UserIDs: accounts}
+	}
+	return p
+}
+
+func observed(state awsec2types.ImageState) awsec2types.Image {
+	return awsec2types.Image{
+		ImageId:     aws.String(imageID),
+		Name:        aws.String("golden"),
+		Description: aws.String("golden image"),
+		State:       state,
+		BlockDeviceMappings: []awsec2types.BlockDeviceMapping{{
+			DeviceName: aws.String("/dev/xvda"),
+			Ebs:        &awsec2types.EbsBlockDevice{SnapshotId: aws.String(snapshotID)},
+		}},
+	}
+}
+
+func observation(state awsec2types.ImageState) manualv1alpha1.ImageObservation {
+	return manualv1alpha1.ImageObservation{
+		ImageID:     imageID,
+		State:       string(state),
+		SnapshotIDs: []string{snapshotID},
+	}
+}
+
+func describe(i awsec2types.Image) func(context.Context, *awsec2.DescribeImagesInput, []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeImagesInput, _ []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+		if len(input.ImageIds) != 1 || input.ImageIds[0] != imageID {
+			return nil, errors.New("unexpected image")
+		}
+		return &awsec2.DescribeImagesOutput{Images: []awsec2types.Image{i}}, nil
+	}
+}
+
+func describeAttribute(accounts ...string) func(context.Context, *awsec2.DescribeImageAttributeInput, []func(*awsec2.Options)) (*awsec2.DescribeImageAttributeOutput, error) {
+	return func(context.Context, *awsec2.DescribeImageAttributeInput, []func(*awsec2.Options)) (*awsec2.DescribeImageAttributeOutput, error) {
+		out := &awsec2.DescribeImageAttributeOutput{}
+		for _, a := range accounts {
+			out.LaunchPermissions = append(out.LaunchPermissions, awsec2types.LaunchPermission{UserId: aws.String(a)})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.Image
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockImageClient
+		cr     *manualv1alpha1.Image
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockImageClient{
+				MockDescribe:          describe(observed(awsec2types.ImageStateAvailable)),
+				MockDescribeAttribute: describeAttribute(accountID),
+			},
+			cr: image(withExternalName(imageID), withSpec(spec(accountID))),
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(spec(accountID)),
+					withStatus(observation(awsec2types.ImageStateAvailable)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotShared": {
+			client: &fake.MockImageClient{
+				MockDescribe:          describe(observed(awsec2types.ImageStateAvailable)),
+				MockDescribeAttribute: describeAttribute(),
+			},
+			cr: image(withExternalName(imageID), withSpec(spec(accountID))),
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(spec(accountID)),
+					withStatus(observation(awsec2types.ImageStateAvailable)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Pending": {
+			client: &fake.MockImageClient{
+				MockDescribe: describe(observed(awsec2types.ImageStatePending)),
+			},
+			cr: image(withExternalName(imageID), withSpec(spec(accountID))),
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(spec(accountID)),
+					withStatus(observation(awsec2types.ImageStatePending)),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			client: &fake.MockImageClient{
+				MockDescribe:          describe(observed(awsec2types.ImageStateAvailable)),
+				MockDescribeAttribute: describeAttribute(),
+			},
+			cr: image(withExternalName(imageID), withSpec(func() manualv1alpha1.ImageParameters {
+				p := spec()
+				p.Description = nil
+				return p
+			}())),
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(spec()),
+					withStatus(observation(awsec2types.ImageStateAvailable)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Deregistered": {
+			client: &fake.MockImageClient{
+				MockDescribe: describe(observed(awsec2types.ImageStateDeregistered)),
+			},
+			cr: image(withExternalName(imageID), withSpec(spec())),
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(spec())),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockImageClient{
+				MockDescribe: func(context.Context, *awsec2.DescribeImagesInput, []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+					return nil, &smithy.GenericAPIError{Code: ec2.ImageIDNotFound}
+				},
+			},
+			cr: image(withExternalName(imageID), withSpec(spec())),
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(spec())),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockImageClient{
+				MockDescribe: func(context.Context, *awsec2.DescribeImagesInput, []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: image(withExternalName(imageID), withSpec(spec())),
+			want: want{
+				cr:  image(withExternalName(imageID), withSpec(spec())),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.Image
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockImageClient
+		cr     *manualv1alpha1.Image
+		want
+	}{
+		"Copy": {
+			client: &fake.MockImageClient{
+				MockCopy: func(_ context.Context, input *awsec2.CopyImageInput, _ []func(*awsec2.Options)) (*awsec2.CopyImageOutput, error) {
+					if aws.ToString(input.SourceImageId) != sourceImageID || aws.ToString(input.SourceRegion) != "us-east-1" || aws.ToString(input.ClientToken) != uid {
+						return nil, errors.New("unexpected copy")
+					}
+					return &awsec2.CopyImageOutput{ImageId: aws.String(imageID)}, nil
+				},
+			},
+			cr: image(withSpec(spec())),
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(spec()),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FromInstance": {
+			client: &fake.MockImageClient{
+				MockCreate: func(_ context.Context, input *awsec2.CreateImageInput, _ []func(*awsec2.Options)) (*awsec2.CreateImageOutput, error) {
+					if aws.ToString(input.InstanceId) != "i-123" {
+						return nil, errors.New("unexpected instance")
+					}
+					return &awsec2.CreateImageOutput{ImageId: aws.String(imageID)}, nil
+				},
+			},
+			cr: image(withSpec(manualv1alpha1.ImageParameters{Name: "golden", InstanceID: aws.String("i-123")})),
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(manualv1alpha1.ImageParameters{Name: "golden", InstanceID: aws.String("i-123")}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"NoSource": {
+			client: &fake.MockImageClient{},
+			cr:     image(withSpec(manualv1alpha1.ImageParameters{Name: "golden"})),
+			want: want{
+				cr:  image(withSpec(manualv1alpha1.ImageParameters{Name: "golden"})),
+				err: errors.New(errSource),
+			},
+		},
+		"CopyFailed": {
+			client: &fake.MockImageClient{
+				MockCopy: func(context.Context, *awsec2.CopyImageInput, []func(*awsec2.Options)) (*awsec2.CopyImageOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: image(withSpec(spec())),
+			want: want{
+				cr: image(withSpec(spec()),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var added, removed []string
+	e := &external{client: &fake.MockImageClient{
+		MockDescribe:          describe(observed(awsec2types.ImageStateAvailable)),
+		MockDescribeAttribute: describeAttribute("222222222222"),
+		MockModifyAttribute: func(_ context.Context, input *awsec2.ModifyImageAttributeInput, _ []func(*awsec2.Options)) (*awsec2.ModifyImageAttributeOutput, error) {
+			for _, p := range input.LaunchPermission.Add {
+				added = append(added, aws.ToString(p.UserId))
+			}
+			for _, p := range input.LaunchPermission.Remove {
+				removed = append(removed, aws.ToString(p.UserId))
+			}
+			return &awsec2.ModifyImageAttributeOutput{}, nil
+		},
+	}}
+	_, err := e.Update(context.Background(), image(withExternalName(imageID), withSpec(spec(accountID))))
+
+	if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{accountID}, added); diff != "" {
+		t.Errorf("added: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"222222222222"}, removed); diff != "" {
+		t.Errorf("removed: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		deleteSnapshots *bool
+		deregisterErr   error
+		snapshots       []string
+		err             error
+	}{
+		"KeepsSnapshots": {},
+		"DeletesSnapshots": {
+			deleteSnapshots: aws.Bool(true),
+			snapshots:       []string{snapshotID},
+		},
+		"DeregisterFailed": {
+			deleteSnapshots: aws.Bool(true),
+			deregisterErr:   errBoom,
+			err:             awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var snapshots []string
+			e := &external{client: &fake.MockImageClient{
+				MockDescribe: describe(observed(awsec2types.ImageStateAvailable)),
+				MockDeregister: func(context.Context, *awsec2.DeregisterImageInput, []func(*awsec2.Options)) (*awsec2.DeregisterImageOutput, error) {
+					return &awsec2.DeregisterImageOutput{}, tc.deregisterErr
+				},
+				MockDeleteSnapshot: func(_ context.Context, input *awsec2.DeleteSnapshotInput, _ []func(*awsec2.Options)) (*awsec2.DeleteSnapshotOutput, error) {
+					snapshots = append(snapshots, aws.ToString(input.SnapshotId))
+					return &awsec2.DeleteSnapshotOutput{}, nil
+				},
+			}}
+			p := spec()
+			p.DeleteSnapshotsOnDeletion = tc.deleteSnapshots
+			err := e.Delete(context.Background(), image(withExternalName(imageID), withSpec(p)))
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.snapshots, snapshots); diff != "" {
+				t.Errorf("snapshots: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -55,6 +55,9 @@ const (
 	errModifyInstanceAttributes = "failed to modify the Instance resource attributes"
 	errCreateTags               = "failed to create tags for the Instance resource"
 	errDelete                   = "failed to delete the Instance resource"
+	errUserData                 = "failed to render the user data of the Instance resource"
+	errStart                    = "failed to start the Instance resource"
+	errStop                     = "failed to stop the Instance resource"
 )
 
 // SetupInstance adds a controller that reconciles Instances.
@@ -68,7 +71,8 @@ func SetupInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 		For(&svcapitypes.Instance{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.InstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
@@ -195,7 +199,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	observation := ec2.GenerateInstanceObservation(observed)
+	if o.UserData != nil {
+		observation.UserDataHash = ec2.UserDataHash(o.UserData.Value)
+	}
 	condition := ec2.GenerateInstanceCondition(observation)
+	// an instance that is stopped on purpose is not being deleted.
+	if ec2.IsInstanceStopDesired(cr.Spec.ForProvider.State) && observation.State == string(types.InstanceStateNameStopped) {
+		condition = ec2.Available
+	}
 
 	switch condition {
 	case ec2.Creating:
@@ -216,9 +227,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.Status.AtProvider = observation
 
+	desired := cr.Spec.ForProvider.DeepCopy()
+	// The user data is not rendered for an Instance that is being deleted, so
+	// that deleting the Secret or ConfigMap it is read from along with the
+	// Instance does not block its deletion.
+	if !meta.WasDeleted(cr) {
+		userData, err := ec2.GetUserData(ctx, e.kube, cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUserData)
+		}
+		desired.UserData = userData
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o),
+		ResourceUpToDate:        ec2.IsInstanceUpToDate(*desired, observed, o),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -229,9 +252,14 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
-	result, err := e.client.RunInstances(ctx,
-		ec2.GenerateEC2RunInstancesInput(mgd.GetName(), &cr.Spec.ForProvider),
-	)
+	input := ec2.GenerateEC2RunInstancesInput(mgd.GetName(), &cr.Spec.ForProvider)
+	userData, err := ec2.GetUserData(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errUserData)
+	}
+	input.UserData = userData
+
+	result, err := e.client.RunInstances(ctx, input)
 	if err != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 	}
@@ -312,11 +340,16 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if cr.Spec.ForProvider.UserData != nil {
+	userData, err := ec2.GetUserData(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUserData)
+	}
+
+	if userData != nil {
 		modifyInput := &awsec2.ModifyInstanceAttributeInput{
 			InstanceId: aws.String(meta.GetExternalName(cr)),
 			UserData: &types.BlobAttributeValue{
-				Value: []byte(*cr.Spec.ForProvider.UserData),
+				Value: []byte(*userData),
 			},
 		}
 		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
@@ -326,12 +359,35 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+	if _, err = e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
 		Resources: []string{meta.GetExternalName(cr)},
 		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-	})
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{}, e.updatePowerState(ctx, cr)
+}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+// updatePowerState starts or stops the instance if it is in a stable state
+// other than the desired one. Instances that are still transitioning are
+// handled by a later reconcile.
+func (e *external) updatePowerState(ctx context.Context, cr *svcapitypes.Instance) error {
+	observed := cr.Status.AtProvider.State
+	switch {
+	case awsclient.StringValue(cr.Spec.ForProvider.State) == svcapitypes.InstanceStateRunning && observed == string(types.InstanceStateNameStopped):
+		_, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{
+			InstanceIds: []string{meta.GetExternalName(cr)},
+		})
+		return awsclient.Wrap(err, errStart)
+	case ec2.IsInstanceStopDesired(cr.Spec.ForProvider.State) && observed == string(types.InstanceStateNameRunning):
+		_, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+			InstanceIds: []string{meta.GetExternalName(cr)},
+			Hibernate:   aws.Bool(awsclient.StringValue(cr.Spec.ForProvider.State) == svcapitypes.InstanceStateHibernated),
+		})
+		return awsclient.Wrap(err, errStop)
+	}
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -19,10 +19,13 @@ package instance
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -44,6 +47,14 @@ import (
 var (
 	instanceID = "some Id"
 
+	userData          = "#!/bin/bash\necho hello"
+	encodedUserData   = "IyEvYmluL2Jhc2gKZWNobyBoZWxsbw=="
+	userDataKey       = "user-data"
+	userDataSecretRef = xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "cloud-init", Namespace: "default"},
+		Key:             userDataKey,
+	}
+
 	errBoom = errors.New("boom")
 )
 
@@ -63,6 +74,13 @@ func withConditions(c ...xpv1.Condition) instanceModifier {
 	return func(r *manualv1alpha1.Instance) { r.Status.ConditionedStatus.Conditions = c }
 }
 
+func withDeletionTimestamp() instanceModifier {
+	return func(r *manualv1alpha1.Instance) {
+		t := metav1.NewTime(time.Unix(1, 0))
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
 func withSpec(p manualv1alpha1.InstanceParameters) instanceModifier {
 	return func(r *manualv1alpha1.Instance) { r.Spec.ForProvider = p }
 }
@@ -152,6 +170,96 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"UserDataFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{userDataKey: []byte(userData)}
+						return nil
+					},
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{{
+									InstanceId: &instanceID,
+									State: &types.InstanceState{
+										Name: types.InstanceStateNameRunning,
+									},
+								}},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceId: &instanceID,
+							UserData:   &types.AttributeValue{Value: aws.String(encodedUserData)},
+						}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					UserDataSecretRef: &userDataSecretRef,
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					UserDataSecretRef: &userDataSecretRef,
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					State:        "running",
+					UserDataHash: ec2.UserDataHash(aws.String(encodedUserData)),
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeletedWithoutUserDataSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{{
+									InstanceId: &instanceID,
+									State: &types.InstanceState{
+										Name: types.InstanceStateNameShuttingDown,
+									},
+								}},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceId: &instanceID,
+							UserData:   &types.AttributeValue{Value: aws.String(encodedUserData)},
+						}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					UserDataSecretRef: &userDataSecretRef,
+				}), withExternalName(instanceID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					UserDataSecretRef: &userDataSecretRef,
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					State:        "shutting-down",
+					UserDataHash: ec2.UserDataHash(aws.String(encodedUserData)),
+				}), withExternalName(instanceID), withDeletionTimestamp(),
+					withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
 		"MultipleInstances": {
 			args: args{
 				kube: &test.MockClient{
@@ -267,6 +375,48 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{ExternalNameAssigned: true},
 			},
 		},
+		"UserDataFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{userDataKey: []byte(userData)}
+						return nil
+					},
+				},
+				instance: &fake.MockInstanceClient{
+					MockRunInstances: func(ctx context.Context, input *awsec2.RunInstancesInput, opts []func(*awsec2.Options)) (*awsec2.RunInstancesOutput, error) {
+						if aws.ToString(input.UserData) != encodedUserData {
+							return nil, errors.New("unexpected user data")
+						}
+						return &awsec2.RunInstancesOutput{
+							Instances: []types.Instance{{InstanceId: &instanceID}},
+						}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{UserDataSecretRef: &userDataSecretRef})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{UserDataSecretRef: &userDataSecretRef}),
+					withExternalName(instanceID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"UserDataSecretMissing": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				instance: &fake.MockInstanceClient{},
+				cr:       instance(withSpec(manualv1alpha1.InstanceParameters{UserDataSecretRef: &userDataSecretRef})),
+			},
+			want: want{
+				cr:  instance(withSpec(manualv1alpha1.InstanceParameters{UserDataSecretRef: &userDataSecretRef})),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get the user data Secret"), errUserData),
+			},
+		},
 		"CreateFail": {
 			args: args{
 				instance: &fake.MockInstanceClient{
@@ -401,6 +551,67 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"Hibernate": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						if !aws.ToBool(input.Hibernate) {
+							return nil, errors.New("expected the instance to be hibernated")
+						}
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{State: aws.String(manualv1alpha1.InstanceStateHibernated)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "running"})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{State: aws.String(manualv1alpha1.InstanceStateHibernated)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "running"})),
+			},
+		},
+		"StillStopping": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: instance(withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{State: aws.String(manualv1alpha1.InstanceStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "stopping"})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{State: aws.String(manualv1alpha1.InstanceStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "stopping"})),
+			},
+		},
+		"StartFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{State: aws.String(manualv1alpha1.InstanceStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "stopped"})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{State: aws.String(manualv1alpha1.InstanceStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "stopped"})),
+				err: awsclient.Wrap(errBoom, errStart),
+			},
+		},
 	}
 
 	for name, tc := range cases {
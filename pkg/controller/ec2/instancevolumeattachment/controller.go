@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancevolumeattachment
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an InstanceVolumeAttachment resource"
+	errDescribe         = "failed to describe the volume of the InstanceVolumeAttachment resource"
+	errAttach           = "failed to attach the volume"
+	errDetach           = "failed to detach the volume"
+)
+
+// SetupInstanceVolumeAttachment adds a controller that reconciles
+// InstanceVolumeAttachments.
+func SetupInstanceVolumeAttachment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.InstanceVolumeAttachmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.InstanceVolumeAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.InstanceVolumeAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceVolumeAttachmentClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.InstanceVolumeAttachmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.InstanceVolumeAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.InstanceVolumeAttachmentClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.InstanceVolumeAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeVolumes(ctx, &awsec2.DescribeVolumesInput{
+		VolumeIds: []string{cr.Spec.ForProvider.VolumeID},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsVolumeNotFoundErr, err), errDescribe)
+	}
+	if len(response.Volumes) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The volume and the instance identify the attachment, so a change of
+	// either of them results in a new attachment rather than an update.
+	attachment := ec2.FindVolumeAttachment(response.Volumes[0], aws.ToString(cr.Spec.ForProvider.InstanceID))
+	if attachment == nil || attachment.State == awsec2types.VolumeAttachmentStateDetached {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateInstanceVolumeAttachmentObservation(*attachment)
+	switch attachment.State {
+	case awsec2types.VolumeAttachmentStateAttaching:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.VolumeAttachmentStateDetaching:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.InstanceVolumeAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, err := e.client.AttachVolume(ctx, &awsec2.AttachVolumeInput{
+		Device:     aws.String(cr.Spec.ForProvider.Device),
+		InstanceId: cr.Spec.ForProvider.InstanceID,
+		VolumeId:   aws.String(cr.Spec.ForProvider.VolumeID),
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errAttach)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// None of the fields of an attachment can be modified.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.InstanceVolumeAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DetachVolume(ctx, &awsec2.DetachVolumeInput{
+		Device:     aws.String(cr.Spec.ForProvider.Device),
+		InstanceId: cr.Spec.ForProvider.InstanceID,
+		VolumeId:   aws.String(cr.Spec.ForProvider.VolumeID),
+		Force:      cr.Spec.ForProvider.ForceDetach,
+	})
+	return awsclient.Wrap(resource.Ignore(func(err error) bool {
+		return ec2.IsVolumeNotFoundErr(err) || ec2.IsVolumeAttachmentNotFoundErr(err)
+	}, err), errDetach)
+}
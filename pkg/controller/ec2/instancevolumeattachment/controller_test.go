@@ -0,0 +1,341 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancevolumeattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	volumeID     = "vol-123"
+	instanceID   = "i-123"
+	otherID      = "i-456"
+	device       = "/dev/sdf"
+	errBoom      = errors.New("boom")
+	attachParams = manualv1alpha1.InstanceVolumeAttachmentParameters{
+		Device:     device,
+		VolumeID:   volumeID,
+		InstanceID: aws.String(instanceID),
+	}
+)
+
+type args struct {
+	client ec2.InstanceVolumeAttachmentClient
+	cr     *manualv1alpha1.InstanceVolumeAttachment
+}
+
+type attachmentModifier func(*manualv1alpha1.InstanceVolumeAttachment)
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *manualv1alpha1.InstanceVolumeAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.InstanceVolumeAttachmentParameters) attachmentModifier {
+	return func(r *manualv1alpha1.InstanceVolumeAttachment) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.InstanceVolumeAttachmentObservation) attachmentModifier {
+	return func(r *manualv1alpha1.InstanceVolumeAttachment) { r.Status.AtProvider = s }
+}
+
+func attachment(m ...attachmentModifier) *manualv1alpha1.InstanceVolumeAttachment {
+	cr := &manualv1alpha1.InstanceVolumeAttachment{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVolume(attachments ...awsec2types.VolumeAttachment) func(context.Context, *awsec2.DescribeVolumesInput, []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeVolumesInput, _ []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+		if len(input.VolumeIds) != 1 || input.VolumeIds[0] != volumeID {
+			return nil, errors.New("unexpected volume")
+		}
+		return &awsec2.DescribeVolumesOutput{Volumes: []awsec2types.Volume{{
+			VolumeId:    aws.String(volumeID),
+			Attachments: attachments,
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.InstanceVolumeAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Attached": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockDescribe: describeVolume(awsec2types.VolumeAttachment{
+						InstanceId:          aws.String(instanceID),
+						VolumeId:            aws.String(volumeID),
+						Device:              aws.String(device),
+						State:               awsec2types.VolumeAttachmentStateAttached,
+						DeleteOnTermination: aws.Bool(false),
+					}),
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams),
+					withStatus(manualv1alpha1.InstanceVolumeAttachmentObservation{
+						State:               string(awsec2types.VolumeAttachmentStateAttached),
+						DeleteOnTermination: aws.Bool(false),
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Attaching": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockDescribe: describeVolume(awsec2types.VolumeAttachment{
+						InstanceId: aws.String(instanceID),
+						State:      awsec2types.VolumeAttachmentStateAttaching,
+					}),
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams),
+					withStatus(manualv1alpha1.InstanceVolumeAttachmentObservation{
+						State: string(awsec2types.VolumeAttachmentStateAttaching),
+					}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AttachedToOtherInstance": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockDescribe: describeVolume(awsec2types.VolumeAttachment{
+						InstanceId: aws.String(otherID),
+						State:      awsec2types.VolumeAttachmentStateAttached,
+					}),
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams)),
+			},
+		},
+		"VolumeNotFound": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeVolumesInput, []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VolumeIDNotFound}
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeVolumesInput, []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr:  attachment(withSpec(attachParams)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.InstanceVolumeAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockAttach: func(_ context.Context, input *awsec2.AttachVolumeInput, _ []func(*awsec2.Options)) (*awsec2.AttachVolumeOutput, error) {
+						if aws.ToString(input.InstanceId) != instanceID || aws.ToString(input.VolumeId) != volumeID || aws.ToString(input.Device) != device {
+							return nil, errors.New("unexpected input")
+						}
+						return &awsec2.AttachVolumeOutput{}, nil
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams), withConditions(xpv1.Creating())),
+			},
+		},
+		"AttachFailed": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockAttach: func(context.Context, *awsec2.AttachVolumeInput, []func(*awsec2.Options)) (*awsec2.AttachVolumeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr:  attachment(withSpec(attachParams), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.InstanceVolumeAttachment
+		err error
+	}
+
+	forced := attachParams
+	forced.ForceDetach = aws.Bool(true)
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Forced": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockDetach: func(_ context.Context, input *awsec2.DetachVolumeInput, _ []func(*awsec2.Options)) (*awsec2.DetachVolumeOutput, error) {
+						if !aws.ToBool(input.Force) {
+							return nil, errors.New("expected forced detachment")
+						}
+						return &awsec2.DetachVolumeOutput{}, nil
+					},
+				},
+				cr: attachment(withSpec(forced)),
+			},
+			want: want{
+				cr: attachment(withSpec(forced), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDetached": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockDetach: func(context.Context, *awsec2.DetachVolumeInput, []func(*awsec2.Options)) (*awsec2.DetachVolumeOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VolumeAttachmentNotFound}
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr: attachment(withSpec(attachParams), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DetachFailed": {
+			args: args{
+				client: &fake.MockInstanceVolumeAttachmentClient{
+					MockDetach: func(context.Context, *awsec2.DetachVolumeInput, []func(*awsec2.Options)) (*awsec2.DetachVolumeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withSpec(attachParams)),
+			},
+			want: want{
+				cr:  attachment(withSpec(attachParams), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDetach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	ipamID       = "ipam-123"
+	privateScope = "ipam-scope-private"
+	publicScope  = "ipam-scope-public"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMClient
+	cr     *manualv1alpha1.IPAM
+}
+
+type ipamModifier func(*manualv1alpha1.IPAM)
+
+func withExternalName(name string) ipamModifier {
+	return func(r *manualv1alpha1.IPAM) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) ipamModifier {
+	return func(r *manualv1alpha1.IPAM) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withOperatingRegions(regions ...string) ipamModifier {
+	return func(r *manualv1alpha1.IPAM) { r.Spec.ForProvider.OperatingRegions = regions }
+}
+
+func withStatus(s manualv1alpha1.IPAMObservation) ipamModifier {
+	return func(r *manualv1alpha1.IPAM) { r.Status.AtProvider = s }
+}
+
+func ipam(m ...ipamModifier) *manualv1alpha1.IPAM {
+	cr := &manualv1alpha1.IPAM{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.IpamState, regions ...string) func(context.Context, *awsec2.DescribeIpamsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+	return func(context.Context, *awsec2.DescribeIpamsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+		i := awsec2types.Ipam{
+			IpamId:                aws.String(ipamID),
+			PrivateDefaultScopeId: aws.String(privateScope),
+			PublicDefaultScopeId:  aws.String(publicScope),
+			ScopeCount:            aws.Int32(2),
+			State:                 state,
+		}
+		for _, r := range regions {
+			i.OperatingRegions = append(i.OperatingRegions, awsec2types.IpamOperatingRegion{RegionName: aws.String(r)})
+		}
+		return &awsec2.DescribeIpamsOutput{Ipams: []awsec2types.Ipam{i}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.IPAM
+		result managed.ExternalObservation
+		err    error
+	}
+
+	status := func(state awsec2types.IpamState) manualv1alpha1.IPAMObservation {
+		return manualv1alpha1.IPAMObservation{
+			IPAMID:                ipamID,
+			PrivateDefaultScopeID: privateScope,
+			PublicDefaultScopeID:  publicScope,
+			ScopeCount:            2,
+			State:                 string(state),
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describe(awsec2types.IpamStateCreateComplete, "us-east-1"),
+				},
+				cr: ipam(withExternalName(ipamID), withOperatingRegions("us-east-1")),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withOperatingRegions("us-east-1"),
+					withStatus(status(awsec2types.IpamStateCreateComplete)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"MissingOperatingRegion": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describe(awsec2types.IpamStateCreateInProgress, "us-east-1"),
+				},
+				cr: ipam(withExternalName(ipamID), withOperatingRegions("us-east-1", "eu-west-1")),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withOperatingRegions("us-east-1", "eu-west-1"),
+					withStatus(status(awsec2types.IpamStateCreateInProgress)), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitializesOperatingRegions": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describe(awsec2types.IpamStateModifyComplete, "us-east-1"),
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withOperatingRegions("us-east-1"),
+					withStatus(status(awsec2types.IpamStateModifyComplete)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describe(awsec2types.IpamStateDeleteComplete),
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeIpamsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMIDNotFound}
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeIpamsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr:  ipam(withExternalName(ipamID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		added   []string
+		removed []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		regions   []string
+		cr        *manualv1alpha1.IPAM
+		modifyErr error
+		want
+	}{
+		"ChangesOperatingRegions": {
+			regions: []string{"us-east-1", "us-west-2"},
+			cr:      ipam(withExternalName(ipamID), withOperatingRegions("us-east-1", "eu-west-1")),
+			want: want{
+				added:   []string{"eu-west-1"},
+				removed: []string{"us-west-2"},
+			},
+		},
+		"ModifyFailed": {
+			regions:   []string{"us-east-1"},
+			cr:        ipam(withExternalName(ipamID), withOperatingRegions("us-east-1")),
+			modifyErr: errBoom,
+			want: want{
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var added, removed []string
+			e := &external{client: &fake.MockIPAMClient{
+				MockDescribe: describe(awsec2types.IpamStateCreateComplete, tc.regions...),
+				MockModify: func(_ context.Context, in *awsec2.ModifyIpamInput, _ []func(*awsec2.Options)) (*awsec2.ModifyIpamOutput, error) {
+					for _, r := range in.AddOperatingRegions {
+						added = append(added, aws.ToString(r.RegionName))
+					}
+					for _, r := range in.RemoveOperatingRegions {
+						removed = append(removed, aws.ToString(r.RegionName))
+					}
+					return &awsec2.ModifyIpamOutput{}, tc.modifyErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.added, added); diff != "" {
+				t.Errorf("added: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		deleteErr error
+		want      error
+	}{
+		"Successful": {},
+		"AlreadyGone": {
+			deleteErr: &smithy.GenericAPIError{Code: ec2.IPAMIDNotFound},
+		},
+		"DeleteFailed": {
+			deleteErr: errBoom,
+			want:      awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockIPAMClient{
+				MockDelete: func(_ context.Context, in *awsec2.DeleteIpamInput, _ []func(*awsec2.Options)) (*awsec2.DeleteIpamOutput, error) {
+					if aws.ToString(in.IpamId) != ipamID {
+						return nil, errors.New("unexpected IPAM ID")
+					}
+					return &awsec2.DeleteIpamOutput{}, tc.deleteErr
+				},
+			}}
+			cr := ipam(withExternalName(ipamID))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(ipam(withExternalName(ipamID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
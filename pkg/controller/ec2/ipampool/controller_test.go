@@ -0,0 +1,300 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipampool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	poolID = "ipam-pool-123"
+	locale = "us-east-1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMPoolClient
+	cr     *manualv1alpha1.IPAMPool
+}
+
+type ipamPoolModifier func(*manualv1alpha1.IPAMPool)
+
+func withExternalName(name string) ipamPoolModifier {
+	return func(r *manualv1alpha1.IPAMPool) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) ipamPoolModifier {
+	return func(r *manualv1alpha1.IPAMPool) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withLateInit() ipamPoolModifier {
+	return func(r *manualv1alpha1.IPAMPool) {
+		r.Spec.ForProvider.Locale = aws.String(locale)
+		r.Spec.ForProvider.AutoImport = aws.Bool(false)
+		r.Spec.ForProvider.AllocationDefaultNetmaskLength = aws.Int32(24)
+	}
+}
+
+func withAllocationResourceTags(tags ...manualv1alpha1.Tag) ipamPoolModifier {
+	return func(r *manualv1alpha1.IPAMPool) { r.Spec.ForProvider.AllocationResourceTags = tags }
+}
+
+func withStatus(s manualv1alpha1.IPAMPoolObservation) ipamPoolModifier {
+	return func(r *manualv1alpha1.IPAMPool) { r.Status.AtProvider = s }
+}
+
+func ipamPool(m ...ipamPoolModifier) *manualv1alpha1.IPAMPool {
+	cr := &manualv1alpha1.IPAMPool{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.IpamPoolState, tags ...awsec2types.IpamResourceTag) func(context.Context, *awsec2.DescribeIpamPoolsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+	return func(context.Context, *awsec2.DescribeIpamPoolsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+		return &awsec2.DescribeIpamPoolsOutput{IpamPools: []awsec2types.IpamPool{{
+			IpamPoolId:                     aws.String(poolID),
+			Locale:                         aws.String(locale),
+			AutoImport:                     aws.Bool(false),
+			AllocationDefaultNetmaskLength: aws.Int32(24),
+			AllocationResourceTags:         tags,
+			IpamScopeType:                  awsec2types.IpamScopeTypePrivate,
+			PoolDepth:                      aws.Int32(1),
+			State:                          state,
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.IPAMPool
+		result managed.ExternalObservation
+		err    error
+	}
+
+	status := func(state awsec2types.IpamPoolState) manualv1alpha1.IPAMPoolObservation {
+		return manualv1alpha1.IPAMPoolObservation{
+			IPAMPoolID:    poolID,
+			IPAMScopeType: string(awsec2types.IpamScopeTypePrivate),
+			PoolDepth:     1,
+			State:         string(state),
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describe(awsec2types.IpamPoolStateCreateComplete),
+				},
+				cr: ipamPool(withExternalName(poolID), withLateInit()),
+			},
+			want: want{
+				cr: ipamPool(withExternalName(poolID), withLateInit(),
+					withStatus(status(awsec2types.IpamPoolStateCreateComplete)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitializedAndMissingTags": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describe(awsec2types.IpamPoolStateCreateInProgress),
+				},
+				cr: ipamPool(withExternalName(poolID), withAllocationResourceTags(manualv1alpha1.Tag{Key: "team", Value: "network"})),
+			},
+			want: want{
+				cr: ipamPool(withExternalName(poolID), withLateInit(), withAllocationResourceTags(manualv1alpha1.Tag{Key: "team", Value: "network"}),
+					withStatus(status(awsec2types.IpamPoolStateCreateInProgress)), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describe(awsec2types.IpamPoolStateDeleteComplete),
+				},
+				cr: ipamPool(withExternalName(poolID)),
+			},
+			want: want{
+				cr: ipamPool(withExternalName(poolID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeIpamPoolsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMPoolIDNotFound}
+					},
+				},
+				cr: ipamPool(withExternalName(poolID)),
+			},
+			want: want{
+				cr: ipamPool(withExternalName(poolID)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awsec2.ModifyIpamPoolInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		tags      []awsec2types.IpamResourceTag
+		cr        *manualv1alpha1.IPAMPool
+		modifyErr error
+		want
+	}{
+		"ReplacesAllocationResourceTags": {
+			tags: []awsec2types.IpamResourceTag{{Key: aws.String("team"), Value: aws.String("compute")}},
+			cr: ipamPool(withExternalName(poolID), withLateInit(), func(r *manualv1alpha1.IPAMPool) {
+				r.Spec.ForProvider.AllocationMaxNetmaskLength = aws.Int32(28)
+			}, withAllocationResourceTags(manualv1alpha1.Tag{Key: "team", Value: "network"})),
+			want: want{
+				input: &awsec2.ModifyIpamPoolInput{
+					IpamPoolId:                     aws.String(poolID),
+					AutoImport:                     aws.Bool(false),
+					AllocationDefaultNetmaskLength: aws.Int32(24),
+					AllocationMaxNetmaskLength:     aws.Int32(28),
+					AddAllocationResourceTags:      []awsec2types.RequestIpamResourceTag{{Key: aws.String("team"), Value: aws.String("network")}},
+					RemoveAllocationResourceTags:   []awsec2types.RequestIpamResourceTag{{Key: aws.String("team"), Value: aws.String("compute")}},
+				},
+			},
+		},
+		"ModifyFailed": {
+			cr:        ipamPool(withExternalName(poolID), withLateInit()),
+			modifyErr: errBoom,
+			want: want{
+				input: &awsec2.ModifyIpamPoolInput{
+					IpamPoolId:                     aws.String(poolID),
+					AutoImport:                     aws.Bool(false),
+					AllocationDefaultNetmaskLength: aws.Int32(24),
+				},
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsec2.ModifyIpamPoolInput
+			e := &external{client: &fake.MockIPAMPoolClient{
+				MockDescribe: describe(awsec2types.IpamPoolStateCreateComplete, tc.tags...),
+				MockModify: func(_ context.Context, in *awsec2.ModifyIpamPoolInput, _ []func(*awsec2.Options)) (*awsec2.ModifyIpamPoolOutput, error) {
+					input = in
+					return &awsec2.ModifyIpamPoolOutput{}, tc.modifyErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input, cmpopts.IgnoreUnexported(awsec2.ModifyIpamPoolInput{}, awsec2types.RequestIpamResourceTag{})); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		deleteErr error
+		want      error
+	}{
+		"Successful": {},
+		"AlreadyGone": {
+			deleteErr: &smithy.GenericAPIError{Code: ec2.IPAMPoolIDNotFound},
+		},
+		"DeleteFailed": {
+			deleteErr: errBoom,
+			want:      awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockIPAMPoolClient{
+				MockDelete: func(context.Context, *awsec2.DeleteIpamPoolInput, []func(*awsec2.Options)) (*awsec2.DeleteIpamPoolOutput, error) {
+					return &awsec2.DeleteIpamPoolOutput{}, tc.deleteErr
+				},
+			}}
+			cr := ipamPool(withExternalName(poolID))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(ipamPool(withExternalName(poolID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
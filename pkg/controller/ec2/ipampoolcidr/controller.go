@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipampoolcidr
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an IPAMPoolCIDR resource"
+	errGet              = "failed to get the CIDRs of the IPAMPool"
+	errProvision        = "failed to provision the CIDR to the IPAMPool"
+	errDeprovision      = "failed to deprovision the CIDR from the IPAMPool"
+)
+
+// SetupIPAMPoolCIDR adds a controller that reconciles IPAMPoolCIDRs.
+func SetupIPAMPoolCIDR(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.IPAMPoolCIDRGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.IPAMPoolCIDR{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.IPAMPoolCIDRGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewIPAMPoolCIDRClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.IPAMPoolCIDRClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.IPAMPoolCIDR)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.IPAMPoolCIDRClient
+}
+
+// get returns the observed CIDR of the IPAM pool, or nil if it isn't
+// provisioned to the pool.
+func (e *external) get(ctx context.Context, cr *manualv1alpha1.IPAMPoolCIDR) (*awsec2types.IpamPoolCidr, error) {
+	input := &awsec2.GetIpamPoolCidrsInput{IpamPoolId: cr.Spec.ForProvider.IPAMPoolID}
+	for {
+		response, err := e.client.GetIpamPoolCidrs(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(resource.Ignore(ec2.IsIPAMPoolNotFoundErr, err), errGet)
+		}
+		for i := range response.IpamPoolCidrs {
+			c := response.IpamPoolCidrs[i]
+			if aws.ToString(c.Cidr) == meta.GetExternalName(cr) && c.State != awsec2types.IpamPoolCidrStateDeprovisioned {
+				return &c, nil
+			}
+		}
+		if aws.ToString(response.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = response.NextToken
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAMPoolCIDR)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.get(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider = ec2.GenerateIPAMPoolCIDRObservation(*observed)
+	switch observed.State {
+	case awsec2types.IpamPoolCidrStateProvisioned:
+		cr.SetConditions(xpv1.Available())
+	case awsec2types.IpamPoolCidrStatePendingProvision, awsec2types.IpamPoolCidrStatePendingImport:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.IpamPoolCidrStatePendingDeprovision:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// None of the attributes of a provisioned CIDR can be changed.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAMPoolCIDR)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	out, err := e.client.ProvisionIpamPoolCidr(ctx, ec2.GenerateProvisionIPAMPoolCIDRInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errProvision)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.IpamPoolCidr.Cidr))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.IPAMPoolCIDR)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	if cr.Status.AtProvider.State == string(awsec2types.IpamPoolCidrStatePendingDeprovision) {
+		return nil
+	}
+
+	_, err := e.client.DeprovisionIpamPoolCidr(ctx, &awsec2.DeprovisionIpamPoolCidrInput{
+		IpamPoolId: cr.Spec.ForProvider.IPAMPoolID,
+		Cidr:       aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsIPAMPoolNotFoundErr, err), errDeprovision)
+}
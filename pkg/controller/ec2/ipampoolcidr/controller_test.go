@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipampoolcidr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	poolID = "ipam-pool-123"
+	cidr   = "10.0.0.0/8"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMPoolCIDRClient
+	cr     *manualv1alpha1.IPAMPoolCIDR
+}
+
+type ipamPoolCIDRModifier func(*manualv1alpha1.IPAMPoolCIDR)
+
+func withExternalName(name string) ipamPoolCIDRModifier {
+	return func(r *manualv1alpha1.IPAMPoolCIDR) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) ipamPoolCIDRModifier {
+	return func(r *manualv1alpha1.IPAMPoolCIDR) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s manualv1alpha1.IPAMPoolCIDRObservation) ipamPoolCIDRModifier {
+	return func(r *manualv1alpha1.IPAMPoolCIDR) { r.Status.AtProvider = s }
+}
+
+func ipamPoolCIDR(m ...ipamPoolCIDRModifier) *manualv1alpha1.IPAMPoolCIDR {
+	cr := &manualv1alpha1.IPAMPoolCIDR{
+		Spec: manualv1alpha1.IPAMPoolCIDRSpec{ForProvider: manualv1alpha1.IPAMPoolCIDRParameters{
+			IPAMPoolID: aws.String(poolID),
+			CIDR:       cidr,
+		}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func get(cidrs ...awsec2types.IpamPoolCidr) func(context.Context, *awsec2.GetIpamPoolCidrsInput, []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+	return func(_ context.Context, in *awsec2.GetIpamPoolCidrsInput, _ []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+		// Return every CIDR on its own page to exercise pagination.
+		i := 0
+		if in.NextToken != nil {
+			i = len(aws.ToString(in.NextToken))
+		}
+		if i >= len(cidrs) {
+			return &awsec2.GetIpamPoolCidrsOutput{}, nil
+		}
+		out := &awsec2.GetIpamPoolCidrsOutput{IpamPoolCidrs: cidrs[i : i+1]}
+		if i+1 < len(cidrs) {
+			out.NextToken = aws.String(aws.ToString(in.NextToken) + "n")
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.IPAMPoolCIDR
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Provisioned": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGet: get(
+						awsec2types.IpamPoolCidr{Cidr: aws.String("172.16.0.0/12"), State: awsec2types.IpamPoolCidrStateProvisioned},
+						awsec2types.IpamPoolCidr{Cidr: aws.String(cidr), State: awsec2types.IpamPoolCidrStateProvisioned},
+					),
+				},
+				cr: ipamPoolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: ipamPoolCIDR(withExternalName(cidr),
+					withStatus(manualv1alpha1.IPAMPoolCIDRObservation{State: string(awsec2types.IpamPoolCidrStateProvisioned)}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ProvisionFailed": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGet: get(awsec2types.IpamPoolCidr{
+						Cidr:  aws.String(cidr),
+						State: awsec2types.IpamPoolCidrStateFailedProvision,
+						FailureReason: &awsec2types.IpamPoolCidrFailureReason{
+							Code:    awsec2types.IpamPoolCidrFailureCodeCidrNotAvailable,
+							Message: aws.String("overlaps"),
+						},
+					}),
+				},
+				cr: ipamPoolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: ipamPoolCIDR(withExternalName(cidr),
+					withStatus(manualv1alpha1.IPAMPoolCIDRObservation{
+						State:         string(awsec2types.IpamPoolCidrStateFailedProvision),
+						FailureReason: "cidr-not-available: overlaps",
+					}),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deprovisioned": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGet: get(awsec2types.IpamPoolCidr{Cidr: aws.String(cidr), State: awsec2types.IpamPoolCidrStateDeprovisioned}),
+				},
+				cr: ipamPoolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: ipamPoolCIDR(withExternalName(cidr)),
+			},
+		},
+		"PoolNotFound": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGet: func(context.Context, *awsec2.GetIpamPoolCidrsInput, []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMPoolIDNotFound}
+					},
+				},
+				cr: ipamPoolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: ipamPoolCIDR(withExternalName(cidr)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGet: func(context.Context, *awsec2.GetIpamPoolCidrsInput, []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipamPoolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr:  ipamPoolCIDR(withExternalName(cidr)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IPAMPoolCIDR
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockProvision: func(_ context.Context, in *awsec2.ProvisionIpamPoolCidrInput, _ []func(*awsec2.Options)) (*awsec2.ProvisionIpamPoolCidrOutput, error) {
+						return &awsec2.ProvisionIpamPoolCidrOutput{IpamPoolCidr: &awsec2types.IpamPoolCidr{Cidr: in.Cidr}}, nil
+					},
+				},
+				cr: ipamPoolCIDR(),
+			},
+			want: want{
+				cr: ipamPoolCIDR(withExternalName(cidr), withConditions(xpv1.Creating())),
+			},
+		},
+		"ProvisionFailed": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockProvision: func(context.Context, *awsec2.ProvisionIpamPoolCidrInput, []func(*awsec2.Options)) (*awsec2.ProvisionIpamPoolCidrOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipamPoolCIDR(),
+			},
+			want: want{
+				cr:  ipamPoolCIDR(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errProvision),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		deprovisioned bool
+		err           error
+	}
+
+	cases := map[string]struct {
+		cr             *manualv1alpha1.IPAMPoolCIDR
+		deprovisionErr error
+		want
+	}{
+		"Successful": {
+			cr: ipamPoolCIDR(withExternalName(cidr)),
+			want: want{
+				deprovisioned: true,
+			},
+		},
+		"AlreadyDeprovisioning": {
+			cr: ipamPoolCIDR(withExternalName(cidr), withStatus(manualv1alpha1.IPAMPoolCIDRObservation{State: string(awsec2types.IpamPoolCidrStatePendingDeprovision)})),
+		},
+		"PoolGone": {
+			cr:             ipamPoolCIDR(withExternalName(cidr)),
+			deprovisionErr: &smithy.GenericAPIError{Code: ec2.IPAMPoolIDNotFound},
+			want: want{
+				deprovisioned: true,
+			},
+		},
+		"DeprovisionFailed": {
+			cr:             ipamPoolCIDR(withExternalName(cidr)),
+			deprovisionErr: errBoom,
+			want: want{
+				deprovisioned: true,
+				err:           awsclient.Wrap(errBoom, errDeprovision),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deprovisioned := false
+			e := &external{client: &fake.MockIPAMPoolCIDRClient{
+				MockDeprovision: func(_ context.Context, in *awsec2.DeprovisionIpamPoolCidrInput, _ []func(*awsec2.Options)) (*awsec2.DeprovisionIpamPoolCidrOutput, error) {
+					deprovisioned = aws.ToString(in.IpamPoolId) == poolID && aws.ToString(in.Cidr) == cidr
+					return &awsec2.DeprovisionIpamPoolCidrOutput{}, tc.deprovisionErr
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deprovisioned, deprovisioned); diff != "" {
+				t.Errorf("deprovisioned: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
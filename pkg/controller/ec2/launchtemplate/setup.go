@@ -2,10 +2,14 @@ package launchtemplate
 
 import (
 	"context"
+	"encoding/json"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -24,16 +28,26 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+// AnnotationKeyCreatedVersions is added to LaunchTemplates whose versions are
+// managed, i.e. that set updateDefaultVersion or versionRetentionCount. Its
+// value lists the numbers of the versions that were created for changes of the
+// launch template data, so that versions created otherwise, e.g. by
+// LaunchTemplateVersions, are neither compared with the spec nor deleted.
+const AnnotationKeyCreatedVersions = "ec2.aws.crossplane.io/created-versions"
+
 // SetupLaunchTemplate adds a controller that reconciles LaunchTemplate.
 func SetupLaunchTemplate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(svcapitypes.LaunchTemplateGroupKind)
 	opts := []option{
 		func(e *external) {
+			v := &versioner{kube: e.kube, client: e.client}
 			e.preObserve = preObserve
-			e.preUpdate = preUpdate
+			e.preUpdate = v.preUpdate
+			e.postUpdate = v.postUpdate
 			e.preDelete = preDelete
+			e.preCreate = preCreate
 			e.postCreate = postCreate
-			e.postObserve = postObserve
+			e.postObserve = v.postObserve
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -44,7 +58,9 @@ func SetupLaunchTemplate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLi
 		For(&svcapitypes.LaunchTemplate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.LaunchTemplateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -56,14 +72,31 @@ func preObserve(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.D
 	return nil
 }
 
-func preUpdate(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.ModifyLaunchTemplateInput) error {
+func preDelete(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.DeleteLaunchTemplateInput) (bool, error) {
 	obj.LaunchTemplateName = aws.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.CreateLaunchTemplateInput) error {
+	obj.LaunchTemplateData = generateLaunchTemplateData(cr)
 	return nil
 }
 
-func preDelete(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.DeleteLaunchTemplateInput) (bool, error) {
-	obj.LaunchTemplateName = aws.String(meta.GetExternalName(cr))
-	return false, nil
+// generateLaunchTemplateData returns the desired launch template data,
+// including the instance profile that may have been resolved from a
+// reference.
+func generateLaunchTemplateData(cr *svcapitypes.LaunchTemplate) *svcsdk.RequestLaunchTemplateData {
+	data := GenerateCreateLaunchTemplateInput(cr).LaunchTemplateData
+	if cr.Spec.ForProvider.IAMInstanceProfileName == nil {
+		return data
+	}
+	if data == nil {
+		data = &svcsdk.RequestLaunchTemplateData{}
+	}
+	data.IamInstanceProfile = &svcsdk.LaunchTemplateIamInstanceProfileSpecificationRequest{
+		Name: cr.Spec.ForProvider.IAMInstanceProfileName,
+	}
+	return data
 }
 
 func postCreate(_ context.Context, cr *svcapitypes.LaunchTemplate, resp *svcsdk.CreateLaunchTemplateOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
@@ -74,16 +107,231 @@ func postCreate(_ context.Context, cr *svcapitypes.LaunchTemplate, resp *svcsdk.
 	return cre, nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.LaunchTemplate, _ *svcsdk.DescribeLaunchTemplatesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+type versioner struct {
+	kube   client.Client
+	client svcsdkapi.EC2API
+}
+
+func (v *versioner) postObserve(ctx context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.DescribeLaunchTemplatesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 	cr.SetConditions(xpv1.Available())
+	if !managesVersions(cr) {
+		return obs, nil
+	}
+
+	versions, err := v.describeCreatedVersions(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	latest := latestVersion(versions)
+	lt := obj.LaunchTemplates[0]
+	switch {
+	case !isVersionUpToDate(cr, latest),
+		aws.BoolValue(cr.Spec.ForProvider.UpdateDefaultVersion) && aws.Int64Value(lt.DefaultVersionNumber) != aws.Int64Value(latest.VersionNumber),
+		len(versionsToPrune(cr.Spec.ForProvider.VersionRetentionCount, versions, aws.Int64Value(lt.DefaultVersionNumber))) != 0:
+		obs.ResourceUpToDate = false
+	}
 	return obs, nil
 }
 
+// preUpdate creates a new version of the launch template if the launch
+// template data has changed, and makes it the default one if desired.
+func (v *versioner) preUpdate(ctx context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.ModifyLaunchTemplateInput) error {
+	obj.LaunchTemplateName = aws.String(meta.GetExternalName(cr))
+	if !managesVersions(cr) {
+		return nil
+	}
+
+	versions, err := v.describeCreatedVersions(ctx, cr)
+	if err != nil {
+		return err
+	}
+	latest := latestVersion(versions)
+	if !isVersionUpToDate(cr, latest) {
+		resp, err := v.client.CreateLaunchTemplateVersionWithContext(ctx, &svcsdk.CreateLaunchTemplateVersionInput{
+			LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+			LaunchTemplateData: generateLaunchTemplateData(cr),
+			VersionDescription: cr.Spec.ForProvider.VersionDescription,
+		})
+		if err != nil {
+			return aws.Wrap(err, errCreateVersion)
+		}
+		latest = resp.LaunchTemplateVersion
+		if err := v.recordCreatedVersions(ctx, cr, append(versions, latest)); err != nil {
+			return err
+		}
+	}
+	if aws.BoolValue(cr.Spec.ForProvider.UpdateDefaultVersion) && latest != nil {
+		obj.DefaultVersion = aws.String(strconv.FormatInt(aws.Int64Value(latest.VersionNumber), 10))
+	}
+	return nil
+}
+
+// postUpdate deletes the versions that exceed the retention count once the
+// default version has been updated, since the default version can't be
+// deleted.
+func (v *versioner) postUpdate(ctx context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.ModifyLaunchTemplateOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil || cr.Spec.ForProvider.VersionRetentionCount == nil {
+		return upd, err
+	}
+	versions, err := v.describeCreatedVersions(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	prune := versionsToPrune(cr.Spec.ForProvider.VersionRetentionCount, versions, aws.Int64Value(obj.LaunchTemplate.DefaultVersionNumber))
+	if len(prune) == 0 {
+		return upd, nil
+	}
+	_, err = v.client.DeleteLaunchTemplateVersionsWithContext(ctx, &svcsdk.DeleteLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+		Versions:           prune,
+	})
+	return upd, aws.Wrap(err, errDeleteVersions)
+}
+
+// managesVersions returns whether versions are created for changes of the
+// launch template data. Launch templates that don't opt in are left to
+// LaunchTemplateVersions.
+func managesVersions(cr *svcapitypes.LaunchTemplate) bool {
+	return aws.BoolValue(cr.Spec.ForProvider.UpdateDefaultVersion) || cr.Spec.ForProvider.VersionRetentionCount != nil
+}
+
+// createdVersions returns the numbers of the versions that were created for
+// changes of the launch template data. The first version of a launch template
+// is created along with it.
+func createdVersions(cr *svcapitypes.LaunchTemplate) map[int64]bool {
+	created := map[int64]bool{}
+	a, ok := cr.GetAnnotations()[AnnotationKeyCreatedVersions]
+	if !ok {
+		if !meta.GetExternalCreateSucceeded(cr).IsZero() {
+			created[1] = true
+		}
+		return created
+	}
+	for _, s := range strings.Split(a, ",") {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			created[n] = true
+		}
+	}
+	return created
+}
+
+// recordCreatedVersions records the supplied versions as the ones created for
+// changes of the launch template data. The update resets the status to the
+// stored one, so the observed status is put back afterwards.
+func (v *versioner) recordCreatedVersions(ctx context.Context, cr *svcapitypes.LaunchTemplate, versions []*svcsdk.LaunchTemplateVersion) error {
+	numbers := make([]string, len(versions))
+	for i, ver := range versions {
+		numbers[i] = strconv.FormatInt(aws.Int64Value(ver.VersionNumber), 10)
+	}
+	meta.AddAnnotations(cr, map[string]string{AnnotationKeyCreatedVersions: strings.Join(numbers, ",")})
+	status := cr.Status.DeepCopy()
+	err := v.kube.Update(ctx, cr)
+	cr.Status = *status
+	return errors.Wrap(err, errKubeUpdateFailed)
+}
+
+// describeCreatedVersions returns the versions of the launch template that
+// were created for changes of its data.
+func (v *versioner) describeCreatedVersions(ctx context.Context, cr *svcapitypes.LaunchTemplate) ([]*svcsdk.LaunchTemplateVersion, error) {
+	versions, err := v.describeVersions(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	created := createdVersions(cr)
+	var own []*svcsdk.LaunchTemplateVersion
+	for _, ver := range versions {
+		if created[aws.Int64Value(ver.VersionNumber)] {
+			own = append(own, ver)
+		}
+	}
+	return own, nil
+}
+
+// describeVersions returns all versions of the launch template.
+func (v *versioner) describeVersions(ctx context.Context, cr *svcapitypes.LaunchTemplate) ([]*svcsdk.LaunchTemplateVersion, error) {
+	input := &svcsdk.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+	}
+	var versions []*svcsdk.LaunchTemplateVersion
+	for {
+		resp, err := v.client.DescribeLaunchTemplateVersionsWithContext(ctx, input)
+		if err != nil {
+			return nil, aws.Wrap(err, errDescribeVersions)
+		}
+		versions = append(versions, resp.LaunchTemplateVersions...)
+		if aws.StringValue(resp.NextToken) == "" {
+			return versions, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+func latestVersion(versions []*svcsdk.LaunchTemplateVersion) *svcsdk.LaunchTemplateVersion {
+	var latest *svcsdk.LaunchTemplateVersion
+	for _, v := range versions {
+		if latest == nil || aws.Int64Value(v.VersionNumber) > aws.Int64Value(latest.VersionNumber) {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// isVersionUpToDate returns whether the data of the given version matches the
+// desired launch template data. The request and response shapes of the data
+// share their field names, so they are compared in their JSON form, ignoring
+// the fields that are not set in the desired data.
+func isVersionUpToDate(cr *svcapitypes.LaunchTemplate, version *svcsdk.LaunchTemplateVersion) bool {
+	if version == nil {
+		return false
+	}
+	desired, err := toJSONMap(generateLaunchTemplateData(cr))
+	if err != nil {
+		return false
+	}
+	observed, err := toJSONMap(version.LaunchTemplateData)
+	if err != nil {
+		return false
+	}
+	return len(aws.DiffFields(desired, observed, aws.IgnoreUnsetDesired())) == 0
+}
+
+func toJSONMap(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]interface{}{}
+	return m, json.Unmarshal(b, &m)
+}
+
+// versionsToPrune returns the numbers of the versions that are older than the
+// given number of most recent versions, except for the default version.
+func versionsToPrune(retain *int64, versions []*svcsdk.LaunchTemplateVersion, defaultVersion int64) []*string {
+	if retain == nil || int64(len(versions)) <= *retain {
+		return nil
+	}
+	sorted := make([]*svcsdk.LaunchTemplateVersion, len(versions))
+	copy(sorted, versions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return aws.Int64Value(sorted[i].VersionNumber) > aws.Int64Value(sorted[j].VersionNumber)
+	})
+	var prune []*string
+	for _, v := range sorted[*retain:] {
+		if n := aws.Int64Value(v.VersionNumber); n != defaultVersion {
+			prune = append(prune, aws.String(strconv.FormatInt(n, 10)))
+		}
+	}
+	return prune
+}
+
 const (
 	errKubeUpdateFailed = "cannot update LaunchTemplate custom resource"
+	errDescribeVersions = "cannot describe LaunchTemplate versions"
+	errCreateVersion    = "cannot create LaunchTemplate version"
+	errDeleteVersions   = "cannot delete LaunchTemplate versions"
 )
 
 type tagger struct {
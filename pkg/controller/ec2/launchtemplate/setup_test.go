@@ -0,0 +1,350 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	testName = "lt"
+)
+
+func launchTemplate(instanceType string, p svcapitypes.CustomLaunchTemplateParameters) *svcapitypes.LaunchTemplate {
+	cr := &svcapitypes.LaunchTemplate{}
+	meta.SetExternalName(cr, testName)
+	cr.Spec.ForProvider = svcapitypes.LaunchTemplateParameters{
+		LaunchTemplateName: aws.String(testName),
+		LaunchTemplateData: &svcapitypes.RequestLaunchTemplateData{
+			InstanceType: aws.String(instanceType),
+		},
+		CustomLaunchTemplateParameters: p,
+	}
+	return cr
+}
+
+func withCreatedVersions(cr *svcapitypes.LaunchTemplate, v string) *svcapitypes.LaunchTemplate {
+	meta.AddAnnotations(cr, map[string]string{AnnotationKeyCreatedVersions: v})
+	return cr
+}
+
+func version(n int, instanceType string) *svcsdk.LaunchTemplateVersion {
+	return &svcsdk.LaunchTemplateVersion{
+		VersionNumber: aws.Int64(n),
+		LaunchTemplateData: &svcsdk.ResponseLaunchTemplateData{
+			InstanceType: aws.String(instanceType),
+			ImageId:      aws.String("ami-123"),
+		},
+	}
+}
+
+func versions(v ...*svcsdk.LaunchTemplateVersion) func(context.Context, *svcsdk.DescribeLaunchTemplateVersionsInput, ...request.Option) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+	return func(_ context.Context, in *svcsdk.DescribeLaunchTemplateVersionsInput, _ ...request.Option) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+		if aws.StringValue(in.LaunchTemplateName) != testName {
+			return nil, errors.New("unexpected launch template")
+		}
+		return &svcsdk.DescribeLaunchTemplateVersionsOutput{LaunchTemplateVersions: v}, nil
+	}
+}
+
+func described(defaultVersion, latestVersion int) *svcsdk.DescribeLaunchTemplatesOutput {
+	return &svcsdk.DescribeLaunchTemplatesOutput{
+		LaunchTemplates: []*svcsdk.LaunchTemplate{{
+			LaunchTemplateName:   aws.String(testName),
+			DefaultVersionNumber: aws.Int64(defaultVersion),
+			LatestVersionNumber:  aws.Int64(latestVersion),
+		}},
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		client *fake.MockLaunchTemplateClient
+		cr     *svcapitypes.LaunchTemplate
+		obj    *svcsdk.DescribeLaunchTemplatesOutput
+		want   want
+	}{
+		"VersionsNotManaged": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: func(context.Context, *svcsdk.DescribeLaunchTemplateVersionsInput, ...request.Option) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr:   launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{}),
+			obj:  described(1, 1),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"UpToDate": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: versions(version(1, "t3.micro")),
+			},
+			cr:   withCreatedVersions(launchTemplate("t3.micro", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)}), "1"),
+			obj:  described(1, 1),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"FirstVersionOfCreatedTemplate": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: versions(version(1, "t3.micro")),
+			},
+			cr: func() *svcapitypes.LaunchTemplate {
+				cr := launchTemplate("t3.micro", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)})
+				meta.SetExternalCreateSucceeded(cr, time.Now())
+				return cr
+			}(),
+			obj:  described(1, 1),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"DataChanged": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: versions(version(1, "t3.micro")),
+			},
+			cr:   withCreatedVersions(launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)}), "1"),
+			obj:  described(1, 1),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"NoCreatedVersion": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: versions(version(1, "t3.micro")),
+			},
+			cr:   launchTemplate("t3.micro", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)}),
+			obj:  described(1, 1),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"DefaultVersionOutdated": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: versions(version(1, "t3.micro"), version(2, "t3.large")),
+			},
+			cr:   withCreatedVersions(launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)}), "1,2"),
+			obj:  described(1, 2),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"TooManyVersions": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: versions(version(1, "t3.micro"), version(2, "t3.micro"), version(3, "t3.micro")),
+			},
+			cr:   withCreatedVersions(launchTemplate("t3.micro", svcapitypes.CustomLaunchTemplateParameters{VersionRetentionCount: aws.Int64(1)}), "1,2,3"),
+			obj:  described(3, 3),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"IgnoresOtherVersions": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: versions(version(1, "t3.micro"), version(2, "t3.large"), version(3, "t3.xlarge")),
+			},
+			cr:   withCreatedVersions(launchTemplate("t3.micro", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true), VersionRetentionCount: aws.Int64(1)}), "1"),
+			obj:  described(1, 3),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"DescribeVersionsFailed": {
+			client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: func(context.Context, *svcsdk.DescribeLaunchTemplateVersionsInput, ...request.Option) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr:   launchTemplate("t3.micro", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)}),
+			obj:  described(1, 1),
+			want: want{err: aws.Wrap(errBoom, errDescribeVersions)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &versioner{client: tc.client}
+			obs, err := v.postObserve(context.Background(), tc.cr, tc.obj, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	type want struct {
+		input    *svcsdk.ModifyLaunchTemplateInput
+		created  bool
+		recorded string
+	}
+	cases := map[string]struct {
+		existing []*svcsdk.LaunchTemplateVersion
+		cr       *svcapitypes.LaunchTemplate
+		want     want
+	}{
+		"VersionsNotManaged": {
+			existing: []*svcsdk.LaunchTemplateVersion{version(1, "t3.micro")},
+			cr:       launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{}),
+			want: want{
+				input: &svcsdk.ModifyLaunchTemplateInput{LaunchTemplateName: aws.String(testName)},
+			},
+		},
+		"CreatesVersionAndUpdatesDefault": {
+			existing: []*svcsdk.LaunchTemplateVersion{version(1, "t3.micro")},
+			cr:       withCreatedVersions(launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)}), "1"),
+			want: want{
+				input: &svcsdk.ModifyLaunchTemplateInput{
+					LaunchTemplateName: aws.String(testName),
+					DefaultVersion:     aws.String("2"),
+				},
+				created:  true,
+				recorded: "1,2",
+			},
+		},
+		"CreatesVersionOnly": {
+			existing: []*svcsdk.LaunchTemplateVersion{version(1, "t3.micro")},
+			cr:       withCreatedVersions(launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{VersionRetentionCount: aws.Int64(5)}), "1"),
+			want: want{
+				input:    &svcsdk.ModifyLaunchTemplateInput{LaunchTemplateName: aws.String(testName)},
+				created:  true,
+				recorded: "1,2",
+			},
+		},
+		"CreatesVersionOverOtherVersions": {
+			existing: []*svcsdk.LaunchTemplateVersion{version(1, "t3.micro"), version(2, "t3.large")},
+			cr:       withCreatedVersions(launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)}), "1"),
+			want: want{
+				input: &svcsdk.ModifyLaunchTemplateInput{
+					LaunchTemplateName: aws.String(testName),
+					DefaultVersion:     aws.String("3"),
+				},
+				created:  true,
+				recorded: "1,3",
+			},
+		},
+		"CreatesVersionForInstanceProfile": {
+			existing: []*svcsdk.LaunchTemplateVersion{version(1, "t3.large")},
+			cr:       withCreatedVersions(launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{IAMInstanceProfileName: aws.String("profile"), VersionRetentionCount: aws.Int64(5)}), "1"),
+			want: want{
+				input:    &svcsdk.ModifyLaunchTemplateInput{LaunchTemplateName: aws.String(testName)},
+				created:  true,
+				recorded: "1,2",
+			},
+		},
+		"InstanceProfileUpToDate": {
+			existing: []*svcsdk.LaunchTemplateVersion{func() *svcsdk.LaunchTemplateVersion {
+				v := version(1, "t3.large")
+				v.LaunchTemplateData.IamInstanceProfile = &svcsdk.LaunchTemplateIamInstanceProfileSpecification{Name: aws.String("profile")}
+				return v
+			}()},
+			cr: withCreatedVersions(launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{IAMInstanceProfileName: aws.String("profile"), VersionRetentionCount: aws.Int64(5)}), "1"),
+			want: want{
+				input: &svcsdk.ModifyLaunchTemplateInput{LaunchTemplateName: aws.String(testName)},
+			},
+		},
+		"UpdatesDefaultOnly": {
+			existing: []*svcsdk.LaunchTemplateVersion{version(1, "t3.micro"), version(2, "t3.large")},
+			cr:       withCreatedVersions(launchTemplate("t3.large", svcapitypes.CustomLaunchTemplateParameters{UpdateDefaultVersion: aws.Bool(true)}), "1,2"),
+			want: want{
+				input: &svcsdk.ModifyLaunchTemplateInput{
+					LaunchTemplateName: aws.String(testName),
+					DefaultVersion:     aws.String("2"),
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := false
+			recorded := ""
+			kube := &test.MockClient{
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					recorded = obj.GetAnnotations()[AnnotationKeyCreatedVersions]
+					return nil
+				},
+			}
+			v := &versioner{kube: kube, client: &fake.MockLaunchTemplateClient{
+				MockDescribeLaunchTemplateVersionsWithContext: versions(tc.existing...),
+				MockCreateLaunchTemplateVersionWithContext: func(_ context.Context, in *svcsdk.CreateLaunchTemplateVersionInput, _ ...request.Option) (*svcsdk.CreateLaunchTemplateVersionOutput, error) {
+					created = true
+					return &svcsdk.CreateLaunchTemplateVersionOutput{
+						LaunchTemplateVersion: &svcsdk.LaunchTemplateVersion{VersionNumber: aws.Int64(len(tc.existing) + 1)},
+					}, nil
+				},
+			}}
+			input := &svcsdk.ModifyLaunchTemplateInput{}
+			if err := v.preUpdate(context.Background(), tc.cr, input); err != nil {
+				t.Errorf("r: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("r: -want created, +got created:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.recorded, recorded); diff != "" {
+				t.Errorf("r: -want recorded, +got recorded:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVersionsToPrune(t *testing.T) {
+	all := []*svcsdk.LaunchTemplateVersion{
+		version(1, "t3.micro"), version(4, "t3.micro"), version(2, "t3.micro"), version(3, "t3.micro"),
+	}
+	cases := map[string]struct {
+		retain         *int64
+		defaultVersion int64
+		want           []*string
+	}{
+		"NoRetention": {
+			defaultVersion: 4,
+		},
+		"WithinRetention": {
+			retain:         aws.Int64(4),
+			defaultVersion: 4,
+		},
+		"PrunesOldest": {
+			retain:         aws.Int64(2),
+			defaultVersion: 4,
+			want:           []*string{aws.String("2"), aws.String("1")},
+		},
+		"KeepsDefault": {
+			retain:         aws.Int64(1),
+			defaultVersion: 1,
+			want:           []*string{aws.String("3"), aws.String("2")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := versionsToPrune(tc.retain, all, tc.defaultVersion)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
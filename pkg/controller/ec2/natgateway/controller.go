@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -27,13 +28,14 @@ import (
 )
 
 const (
-	errUnexpectedObject = "The managed resource is not an NATGateway resource"
-	errDescribe         = "failed to describe NATGateway"
-	errNotSingleItem    = "either no or multiple NATGateways retrieved for the given natGatewayId"
-	errCreate           = "failed to create the NATGateway resource"
-	errDelete           = "failed to delete the NATGateway resource"
-	errUpdateTags       = "failed to update tags for the NATGateway resource"
-	errDeleteTags       = "failed to delete tags for NATGateway resource"
+	errUnexpectedObject  = "The managed resource is not an NATGateway resource"
+	errDescribe          = "failed to describe NATGateway"
+	errNotSingleItem     = "either no or multiple NATGateways retrieved for the given natGatewayId"
+	errCreate            = "failed to create the NATGateway resource"
+	errDelete            = "failed to delete the NATGateway resource"
+	errUpdateTags        = "failed to update tags for the NATGateway resource"
+	errDeleteTags        = "failed to delete tags for NATGateway resource"
+	errPrivateAllocation = "an Elastic IP allocation cannot be associated with a private NATGateway"
 )
 
 // SetupNatGateway adds a controller that reconciles NatGateways.
@@ -48,10 +50,11 @@ func SetupNatGateway(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		For(&v1beta1.NATGateway{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.NATGatewayGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNatGatewayClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewNatGatewayClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithCreationGracePeriod(3*time.Minute),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -106,6 +109,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	observed := response.NatGateways[0]
 
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNATGateway(&cr.Spec.ForProvider, &observed)
+
 	cr.Status.AtProvider = ec2.GenerateNATGatewayObservation(observed)
 
 	switch cr.Status.AtProvider.State {
@@ -123,9 +129,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
+	// NOTE: A NAT gateway keeps the Elastic IP it was created with, so a
+	// different allocation is reported but does not trigger an update.
+	awsclient.RecordDrift(cr, ec2.NATGatewayAllocationDrift(cr.Spec.ForProvider, observed))
+
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags),
+		ResourceExists:          true,
+		ResourceUpToDate:        v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
 
@@ -135,6 +146,10 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	if cr.Spec.ForProvider.ConnectivityType == string(awsec2types.ConnectivityTypePrivate) && cr.Spec.ForProvider.AllocationID != nil {
+		return managed.ExternalCreation{}, errors.New(errPrivateAllocation)
+	}
+
 	// Create an input without tags.
 	input := &awsec2.CreateNatGatewayInput{
 		ConnectivityType: awsec2types.ConnectivityType(cr.Spec.ForProvider.ConnectivityType),
@@ -337,6 +337,41 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"AllocationDrifted": {
+			args: args{
+				nat: &fake.MockNatGatewayClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNatGatewaysInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNatGatewaysOutput, error) {
+						return natGatewayDescription(awsec2types.NatGatewayStateAvailable, time, nil, nil, false), nil
+					},
+				},
+				cr: nat(withExternalName(natGatewayID),
+					withSpec(v1beta1.NATGatewayParameters{
+						AllocationID: aws.String("other allocation id"),
+						SubnetID:     &natSubnetID,
+						Tags:         specTags(),
+					}),
+				),
+			},
+			want: want{
+				cr: nat(withExternalName(natGatewayID),
+					withSpec(v1beta1.NATGatewayParameters{
+						AllocationID: aws.String("other allocation id"),
+						SubnetID:     &natSubnetID,
+						Tags:         specTags(),
+					}),
+					withStatus(specNatStatus(v1beta1.NatGatewayStatusAvailable, time, nil, nil, false)),
+					withConditions(xpv1.Available(), awsclient.Drifted([]awsclient.Drift{{
+						Path:     "allocationId",
+						Desired:  `"other allocation id"`,
+						Observed: `"some allocation id"`,
+					}})),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"StatusDeleting": {
 			args: args{
 				nat: &fake.MockNatGatewayClient{
@@ -557,6 +592,24 @@ func TestCreate(t *testing.T) {
 			},
 		},
 
+		"PrivateWithAllocation": {
+			args: args{
+				nat: &fake.MockNatGatewayClient{},
+				cr: nat(withSpec(v1beta1.NATGatewayParameters{
+					ConnectivityType: connectivityTypePrivate,
+					AllocationID:     &natAllocationID,
+					SubnetID:         &natSubnetID,
+				})),
+			},
+			want: want{
+				cr: nat(withSpec(v1beta1.NATGatewayParameters{
+					ConnectivityType: connectivityTypePrivate,
+					AllocationID:     &natAllocationID,
+					SubnetID:         &natSubnetID,
+				})),
+				err: errors.New(errPrivateAllocation),
+			},
+		},
 		"FailedRequest": {
 			args: args{
 				kube: &test.MockClient{
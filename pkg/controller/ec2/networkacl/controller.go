@@ -0,0 +1,275 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NetworkACL resource"
+	errDescribe         = "failed to describe NetworkACL"
+	errNotSingleItem    = "either no or multiple NetworkACLs retrieved for the given networkAclId"
+	errCreate           = "failed to create the NetworkACL resource"
+	errDelete           = "failed to delete the NetworkACL resource"
+	errCreateEntry      = "failed to create an entry of the NetworkACL resource"
+	errReplaceEntry     = "failed to replace an entry of the NetworkACL resource"
+	errDeleteEntry      = "failed to delete an entry of the NetworkACL resource"
+	errCreateTags       = "failed to create tags for the NetworkACL resource"
+	errDeleteTags       = "failed to delete tags for the NetworkACL resource"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.NetworkACLGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.NetworkACL{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.NetworkACLGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkACLClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.NetworkACLClient
+}
+
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.NetworkACL) (awsec2types.NetworkAcl, error) {
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return awsec2types.NetworkAcl{}, err
+	}
+	if len(response.NetworkAcls) != 1 {
+		return awsec2types.NetworkAcl{}, errors.New(errNotSingleItem)
+	}
+	return response.NetworkAcls[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, &observed)
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	input := &awsec2.CreateNetworkAclInput{
+		VpcId: cr.Spec.ForProvider.VPCID,
+	}
+	if len(cr.Spec.ForProvider.Tags) != 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeNetworkAcl,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+
+	acl, err := e.client.CreateNetworkAcl(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+
+	// NOTE: The entries are created by the first update, since the network
+	// ACL has to exist first.
+	meta.SetExternalName(cr, aws.ToString(acl.NetworkAcl.NetworkAclId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	if err := e.updateEntries(ctx, cr, observed); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// updateEntries deletes the entries of the observed network ACL that are not
+// desired anymore, replaces the ones that drifted and creates the missing
+// ones. Deleting first frees the rule numbers of removed entries.
+func (e *external) updateEntries(ctx context.Context, cr *manualv1alpha1.NetworkACL, observed awsec2types.NetworkAcl) error {
+	create, replace, remove := ec2.DiffNetworkACLEntries(cr.Spec.ForProvider.Entries, observed.Entries)
+	id := aws.String(meta.GetExternalName(cr))
+
+	for _, r := range remove {
+		if _, err := e.client.DeleteNetworkAclEntry(ctx, &awsec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: id,
+			Egress:       aws.Bool(aws.ToBool(r.Egress)),
+			RuleNumber:   r.RuleNumber,
+		}); err != nil {
+			return awsclient.Wrap(err, errDeleteEntry)
+		}
+	}
+	for _, r := range replace {
+		if _, err := e.client.ReplaceNetworkAclEntry(ctx, &awsec2.ReplaceNetworkAclEntryInput{
+			NetworkAclId:  id,
+			Egress:        aws.Bool(aws.ToBool(r.Egress)),
+			RuleNumber:    aws.Int32(r.RuleNumber),
+			Protocol:      aws.String(r.Protocol),
+			RuleAction:    awsec2types.RuleAction(r.RuleAction),
+			CidrBlock:     r.CIDRBlock,
+			Ipv6CidrBlock: r.IPv6CIDRBlock,
+			PortRange:     ec2.GenerateEC2PortRange(r),
+			IcmpTypeCode:  ec2.GenerateEC2IcmpTypeCode(r),
+		}); err != nil {
+			return awsclient.Wrap(err, errReplaceEntry)
+		}
+	}
+	for _, r := range create {
+		if _, err := e.client.CreateNetworkAclEntry(ctx, &awsec2.CreateNetworkAclEntryInput{
+			NetworkAclId:  id,
+			Egress:        aws.Bool(aws.ToBool(r.Egress)),
+			RuleNumber:    aws.Int32(r.RuleNumber),
+			Protocol:      aws.String(r.Protocol),
+			RuleAction:    awsec2types.RuleAction(r.RuleAction),
+			CidrBlock:     r.CIDRBlock,
+			Ipv6CidrBlock: r.IPv6CIDRBlock,
+			PortRange:     ec2.GenerateEC2PortRange(r),
+			IcmpTypeCode:  ec2.GenerateEC2IcmpTypeCode(r),
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateEntry)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteNetworkAcl(ctx, &awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	})
+
+	return awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,451 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	aclID = "some acl"
+	vpcID = "some vpc"
+	cidr  = "10.0.0.0/16"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	acl  ec2.NetworkACLClient
+	kube client.Client
+	cr   *manualv1alpha1.NetworkACL
+}
+
+type aclModifier func(*manualv1alpha1.NetworkACL)
+
+func withExternalName(name string) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.NetworkACLParameters) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.NetworkACLObservation) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Status.AtProvider = s }
+}
+
+func acl(m ...aclModifier) *manualv1alpha1.NetworkACL {
+	cr := &manualv1alpha1.NetworkACL{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func specEntry(port int32) manualv1alpha1.NetworkACLEntry {
+	return manualv1alpha1.NetworkACLEntry{
+		RuleNumber: 100,
+		Protocol:   "tcp",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String(cidr),
+		PortRange:  &manualv1alpha1.NetworkACLPortRange{From: port, To: port},
+	}
+}
+
+func observedEntry(port int32) awsec2types.NetworkAclEntry {
+	return awsec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(100),
+		Egress:     aws.Bool(false),
+		Protocol:   aws.String("6"),
+		RuleAction: awsec2types.RuleActionAllow,
+		CidrBlock:  aws.String(cidr),
+		PortRange:  &awsec2types.PortRange{From: aws.Int32(port), To: aws.Int32(port)},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(aclID),
+							VpcId:        aws.String(vpcID),
+							Entries:      []awsec2types.NetworkAclEntry{observedEntry(443)},
+						}}}, nil
+					},
+				},
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+				})),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+				}), withStatus(manualv1alpha1.NetworkACLObservation{
+					NetworkACLID: aclID,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EntryDrifted": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(aclID),
+							VpcId:        aws.String(vpcID),
+							Entries:      []awsec2types.NetworkAclEntry{observedEntry(80)},
+						}}}, nil
+					},
+				},
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+				})),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+				}), withStatus(manualv1alpha1.NetworkACLObservation{
+					NetworkACLID: aclID,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  acl(withExternalName(aclID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclOutput, error) {
+						return &awsec2.CreateNetworkAclOutput{NetworkAcl: &awsec2types.NetworkAcl{
+							NetworkAclId: aws.String(aclID),
+						}}, nil
+					},
+				},
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{VPCID: aws.String(vpcID)})),
+			},
+			want: want{
+				cr: acl(withSpec(manualv1alpha1.NetworkACLParameters{VPCID: aws.String(vpcID)}),
+					withExternalName(aclID),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: acl(),
+			},
+			want: want{
+				cr:  acl(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceAndDelete": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						stale := observedEntry(22)
+						stale.RuleNumber = aws.Int32(200)
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(aclID),
+							Entries:      []awsec2types.NetworkAclEntry{observedEntry(80), stale},
+						}}}, nil
+					},
+					MockReplaceEntry: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						if aws.ToInt32(input.RuleNumber) != 100 || aws.ToInt32(input.PortRange.From) != 443 {
+							return nil, errors.New("unexpected entry replaced")
+						}
+						return &awsec2.ReplaceNetworkAclEntryOutput{}, nil
+					},
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						if aws.ToInt32(input.RuleNumber) != 200 {
+							return nil, errors.New("unexpected entry deleted")
+						}
+						return &awsec2.DeleteNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+				})),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+				})),
+			},
+		},
+		"CreateEntryAndTags": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(aclID),
+						}}}, nil
+					},
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return &awsec2.CreateNetworkAclEntryOutput{}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+					Tags:    []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+				})),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+					Tags:    []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+				})),
+			},
+		},
+		"CreateEntryFailed": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(aclID),
+						}}}, nil
+					},
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+				})),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID), withSpec(manualv1alpha1.NetworkACLParameters{
+					Entries: []manualv1alpha1.NetworkACLEntry{specEntry(443)},
+				})),
+				err: awsclient.Wrap(errBoom, errCreateEntry),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkACL
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return &awsec2.DeleteNetworkAclOutput{}, nil
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr: acl(withExternalName(aclID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: acl(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  acl(withExternalName(aclID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
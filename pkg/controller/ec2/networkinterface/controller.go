@@ -0,0 +1,290 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkinterface
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject   = "The managed resource is not a NetworkInterface resource"
+	errDescribe           = "failed to describe NetworkInterface"
+	errMultipleItems      = "retrieved multiple NetworkInterfaces for the given networkInterfaceId"
+	errSecondaryIPs       = "secondaryPrivateIpAddresses and secondaryPrivateIpAddressCount cannot be combined"
+	errCreate             = "failed to create the NetworkInterface resource"
+	errModifyDescription  = "failed to modify the description of the NetworkInterface"
+	errModifySourceDest   = "failed to modify the source/destination check of the NetworkInterface"
+	errModifyGroups       = "failed to modify the security groups of the NetworkInterface"
+	errAssignPrivateIPs   = "failed to assign private IP addresses to the NetworkInterface"
+	errUnassignPrivateIPs = "failed to unassign private IP addresses from the NetworkInterface"
+	errDelete             = "failed to delete the NetworkInterface resource"
+	errCreateTags         = "failed to create tags for the NetworkInterface resource"
+	errDeleteTags         = "failed to delete tags for the NetworkInterface resource"
+)
+
+// SetupNetworkInterface adds a controller that reconciles NetworkInterfaces.
+func SetupNetworkInterface(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.NetworkInterfaceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.NetworkInterface{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.NetworkInterfaceGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkInterfaceClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkInterfaceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.NetworkInterface)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.NetworkInterfaceClient
+}
+
+// describe returns the observed network interface, or nil if it doesn't
+// exist.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.NetworkInterface) (*awsec2types.NetworkInterface, error) {
+	response, err := e.client.DescribeNetworkInterfaces(ctx, &awsec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsNetworkInterfaceNotFoundErr, err), errDescribe)
+	}
+	switch len(response.NetworkInterfaces) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.NetworkInterfaces[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkInterface)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkInterface(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateNetworkInterfaceObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsNetworkInterfaceUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkInterface)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+	if len(p.SecondaryPrivateIPAddresses) != 0 && p.SecondaryPrivateIPAddressCount != nil {
+		return managed.ExternalCreation{}, errors.New(errSecondaryIPs)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// Explicit secondary addresses are assigned by the first update, since
+	// they cannot be requested together with the primary address.
+	input := &awsec2.CreateNetworkInterfaceInput{
+		ClientToken:                    aws.String(string(cr.UID)),
+		SubnetId:                       p.SubnetID,
+		Description:                    p.Description,
+		Groups:                         p.SecurityGroupIDs,
+		InterfaceType:                  awsec2types.NetworkInterfaceCreationType(aws.ToString(p.InterfaceType)),
+		PrivateIpAddress:               p.PrivateIPAddress,
+		SecondaryPrivateIpAddressCount: p.SecondaryPrivateIPAddressCount,
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeNetworkInterface,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	out, err := e.client.CreateNetworkInterface(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.NetworkInterface.NetworkInterfaceId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*manualv1alpha1.NetworkInterface)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := cr.Spec.ForProvider
+	id := aws.String(meta.GetExternalName(cr))
+
+	// Only a single attribute can be modified per call.
+	if aws.ToString(p.Description) != aws.ToString(observed.Description) {
+		if _, err := e.client.ModifyNetworkInterfaceAttribute(ctx, &awsec2.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: id,
+			Description:        &awsec2types.AttributeValue{Value: aws.String(aws.ToString(p.Description))},
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyDescription)
+		}
+	}
+	if p.SourceDestCheck != nil && aws.ToBool(p.SourceDestCheck) != aws.ToBool(observed.SourceDestCheck) {
+		if _, err := e.client.ModifyNetworkInterfaceAttribute(ctx, &awsec2.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: id,
+			SourceDestCheck:    &awsec2types.AttributeBooleanValue{Value: p.SourceDestCheck},
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifySourceDest)
+		}
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if len(p.SecurityGroupIDs) != 0 && !cmp.Equal(p.SecurityGroupIDs, ec2.NetworkInterfaceSecurityGroupIDs(*observed), sortStrings) {
+		if _, err := e.client.ModifyNetworkInterfaceAttribute(ctx, &awsec2.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: id,
+			Groups:             p.SecurityGroupIDs,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyGroups)
+		}
+	}
+
+	assign, assignCount, unassign := ec2.DiffNetworkInterfacePrivateIPAddresses(p, *observed)
+	if len(unassign) > 0 {
+		if _, err := e.client.UnassignPrivateIpAddresses(ctx, &awsec2.UnassignPrivateIpAddressesInput{
+			NetworkInterfaceId: id,
+			PrivateIpAddresses: unassign,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUnassignPrivateIPs)
+		}
+	}
+	if len(assign) > 0 || assignCount > 0 {
+		input := &awsec2.AssignPrivateIpAddressesInput{
+			NetworkInterfaceId: id,
+			PrivateIpAddresses: assign,
+		}
+		if assignCount > 0 {
+			input.SecondaryPrivateIpAddressCount = aws.Int32(assignCount)
+		}
+		if _, err := e.client.AssignPrivateIpAddresses(ctx, input); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAssignPrivateIPs)
+		}
+	}
+
+	tagsAdd, tagsRemove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), observed.TagSet)
+	if len(tagsRemove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsRemove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(tagsAdd) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsAdd,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.NetworkInterface)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteNetworkInterface(ctx, &awsec2.DeleteNetworkInterfaceInput{
+		NetworkInterfaceId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsNetworkInterfaceNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,431 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkinterface
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	eniID     = "eni-123"
+	subnetID  = "subnet-123"
+	sgID      = "sg-123"
+	primaryIP = "10.0.0.10"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.NetworkInterfaceClient
+	cr     *manualv1alpha1.NetworkInterface
+}
+
+type eniModifier func(*manualv1alpha1.NetworkInterface)
+
+func withExternalName(name string) eniModifier {
+	return func(r *manualv1alpha1.NetworkInterface) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) eniModifier {
+	return func(r *manualv1alpha1.NetworkInterface) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.NetworkInterfaceParameters) eniModifier {
+	return func(r *manualv1alpha1.NetworkInterface) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.NetworkInterfaceObservation) eniModifier {
+	return func(r *manualv1alpha1.NetworkInterface) { r.Status.AtProvider = s }
+}
+
+func networkInterface(m ...eniModifier) *manualv1alpha1.NetworkInterface {
+	cr := &manualv1alpha1.NetworkInterface{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(sourceDestCheck bool, secondary ...string) manualv1alpha1.NetworkInterfaceParameters {
+	return manualv1alpha1.NetworkInterfaceParameters{
+		Region:                      "us-east-1",
+		SubnetID:                    aws.String(subnetID),
+		SecurityGroupIDs:            []string{sgID},
+		PrivateIPAddress:            aws.String(primaryIP),
+		SecondaryPrivateIPAddresses: secondary,
+		SourceDestCheck:             aws.Bool(sourceDestCheck),
+	}
+}
+
+func observed(secondary ...string) awsec2types.NetworkInterface {
+	ni := awsec2types.NetworkInterface{
+		NetworkInterfaceId: aws.String(eniID),
+		SubnetId:           aws.String(subnetID),
+		Groups:             []awsec2types.GroupIdentifier{{GroupId: aws.String(sgID)}},
+		PrivateIpAddress:   aws.String(primaryIP),
+		PrivateIpAddresses: []awsec2types.NetworkInterfacePrivateIpAddress{{
+			PrivateIpAddress: aws.String(primaryIP),
+			Primary:          aws.Bool(true),
+		}},
+		SourceDestCheck: aws.Bool(true),
+		Status:          awsec2types.NetworkInterfaceStatusAvailable,
+	}
+	for _, ip := range secondary {
+		ni.PrivateIpAddresses = append(ni.PrivateIpAddresses, awsec2types.NetworkInterfacePrivateIpAddress{
+			PrivateIpAddress: aws.String(ip),
+			Primary:          aws.Bool(false),
+		})
+	}
+	return ni
+}
+
+func observation(secondary ...string) manualv1alpha1.NetworkInterfaceObservation {
+	return manualv1alpha1.NetworkInterfaceObservation{
+		NetworkInterfaceID:          eniID,
+		PrivateIPAddress:            primaryIP,
+		SecondaryPrivateIPAddresses: secondary,
+		Status:                      string(awsec2types.NetworkInterfaceStatusAvailable),
+	}
+}
+
+func describe(ni awsec2types.NetworkInterface) func(context.Context, *awsec2.DescribeNetworkInterfacesInput, []func(*awsec2.Options)) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeNetworkInterfacesInput, _ []func(*awsec2.Options)) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+		if len(input.NetworkInterfaceIds) != 1 || input.NetworkInterfaceIds[0] != eniID {
+			return nil, errors.New("unexpected network interface")
+		}
+		return &awsec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: []awsec2types.NetworkInterface{ni}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkInterface
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockDescribe: describe(observed("10.0.0.11")),
+				},
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(true, "10.0.0.11"))),
+			},
+			want: want{
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(true, "10.0.0.11")),
+					withStatus(observation("10.0.0.11")), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecondaryAddressMissing": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockDescribe: describe(observed()),
+				},
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(true, "10.0.0.11"))),
+			},
+			want: want{
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(true, "10.0.0.11")),
+					withStatus(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockDescribe: describe(observed()),
+				},
+				cr: networkInterface(withExternalName(eniID), withSpec(manualv1alpha1.NetworkInterfaceParameters{
+					Region:   "us-east-1",
+					SubnetID: aws.String(subnetID),
+				})),
+			},
+			want: want{
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(true)),
+					withStatus(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{},
+				cr:     networkInterface(withSpec(spec(true))),
+			},
+			want: want{
+				cr: networkInterface(withSpec(spec(true))),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeNetworkInterfacesInput, []func(*awsec2.Options)) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkInterfaceIDNotFound}
+					},
+				},
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(true))),
+			},
+			want: want{
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(true))),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeNetworkInterfacesInput, []func(*awsec2.Options)) (*awsec2.DescribeNetworkInterfacesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(true))),
+			},
+			want: want{
+				cr:  networkInterface(withExternalName(eniID), withSpec(spec(true))),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkInterface
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockCreate: func(_ context.Context, input *awsec2.CreateNetworkInterfaceInput, _ []func(*awsec2.Options)) (*awsec2.CreateNetworkInterfaceOutput, error) {
+						if aws.ToString(input.SubnetId) != subnetID || aws.ToString(input.PrivateIpAddress) != primaryIP {
+							return nil, errors.New("unexpected input")
+						}
+						return &awsec2.CreateNetworkInterfaceOutput{NetworkInterface: &awsec2types.NetworkInterface{NetworkInterfaceId: aws.String(eniID)}}, nil
+					},
+				},
+				cr: networkInterface(withSpec(spec(false, "10.0.0.11"))),
+			},
+			want: want{
+				cr: networkInterface(withExternalName(eniID), withSpec(spec(false, "10.0.0.11")),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"SecondaryAddressesAndCount": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{},
+				cr: networkInterface(withSpec(func() manualv1alpha1.NetworkInterfaceParameters {
+					p := spec(true, "10.0.0.11")
+					p.SecondaryPrivateIPAddressCount = aws.Int32(1)
+					return p
+				}())),
+			},
+			want: want{
+				cr: networkInterface(withSpec(func() manualv1alpha1.NetworkInterfaceParameters {
+					p := spec(true, "10.0.0.11")
+					p.SecondaryPrivateIPAddressCount = aws.Int32(1)
+					return p
+				}())),
+				err: errors.New(errSecondaryIPs),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockCreate: func(context.Context, *awsec2.CreateNetworkInterfaceInput, []func(*awsec2.Options)) (*awsec2.CreateNetworkInterfaceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkInterface(withSpec(spec(true))),
+			},
+			want: want{
+				cr:  networkInterface(withSpec(spec(true)), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var sourceDestCheck *bool
+	var assigned, unassigned []string
+	e := &external{client: &fake.MockNetworkInterfaceClient{
+		MockDescribe: describe(observed("10.0.0.12")),
+		MockModifyAttribute: func(_ context.Context, input *awsec2.ModifyNetworkInterfaceAttributeInput, _ []func(*awsec2.Options)) (*awsec2.ModifyNetworkInterfaceAttributeOutput, error) {
+			if input.SourceDestCheck != nil {
+				sourceDestCheck = input.SourceDestCheck.Value
+			}
+			return &awsec2.ModifyNetworkInterfaceAttributeOutput{}, nil
+		},
+		MockAssignPrivateIPAddresses: func(_ context.Context, input *awsec2.AssignPrivateIpAddressesInput, _ []func(*awsec2.Options)) (*awsec2.AssignPrivateIpAddressesOutput, error) {
+			assigned = input.PrivateIpAddresses
+			return &awsec2.AssignPrivateIpAddressesOutput{}, nil
+		},
+		MockUnassignPrivateIPAddresses: func(_ context.Context, input *awsec2.UnassignPrivateIpAddressesInput, _ []func(*awsec2.Options)) (*awsec2.UnassignPrivateIpAddressesOutput, error) {
+			unassigned = input.PrivateIpAddresses
+			return &awsec2.UnassignPrivateIpAddressesOutput{}, nil
+		},
+	}}
+	_, err := e.Update(context.Background(), networkInterface(withExternalName(eniID), withSpec(spec(false, "10.0.0.11"))))
+
+	if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(aws.Bool(false), sourceDestCheck); diff != "" {
+		t.Errorf("sourceDestCheck: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"10.0.0.11"}, assigned); diff != "" {
+		t.Errorf("assigned: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"10.0.0.12"}, unassigned); diff != "" {
+		t.Errorf("unassigned: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkInterface
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockDelete: func(context.Context, *awsec2.DeleteNetworkInterfaceInput, []func(*awsec2.Options)) (*awsec2.DeleteNetworkInterfaceOutput, error) {
+						return &awsec2.DeleteNetworkInterfaceOutput{}, nil
+					},
+				},
+				cr: networkInterface(withExternalName(eniID)),
+			},
+			want: want{
+				cr: networkInterface(withExternalName(eniID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockDelete: func(context.Context, *awsec2.DeleteNetworkInterfaceInput, []func(*awsec2.Options)) (*awsec2.DeleteNetworkInterfaceOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkInterfaceIDNotFound}
+					},
+				},
+				cr: networkInterface(withExternalName(eniID)),
+			},
+			want: want{
+				cr: networkInterface(withExternalName(eniID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockNetworkInterfaceClient{
+					MockDelete: func(context.Context, *awsec2.DeleteNetworkInterfaceInput, []func(*awsec2.Options)) (*awsec2.DeleteNetworkInterfaceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkInterface(withExternalName(eniID)),
+			},
+			want: want{
+				cr:  networkInterface(withExternalName(eniID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,430 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	groupName = "hpc"
+	groupID   = "pg-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.PlacementGroupClient
+	cr     *manualv1alpha1.PlacementGroup
+}
+
+type placementGroupModifier func(*manualv1alpha1.PlacementGroup)
+
+func withExternalName(name string) placementGroupModifier {
+	return func(r *manualv1alpha1.PlacementGroup) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) placementGroupModifier {
+	return func(r *manualv1alpha1.PlacementGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.PlacementGroupParameters) placementGroupModifier {
+	return func(r *manualv1alpha1.PlacementGroup) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.PlacementGroupObservation) placementGroupModifier {
+	return func(r *manualv1alpha1.PlacementGroup) { r.Status.AtProvider = s }
+}
+
+func placementGroup(m ...placementGroupModifier) *manualv1alpha1.PlacementGroup {
+	cr := &manualv1alpha1.PlacementGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(tags ...manualv1alpha1.Tag) manualv1alpha1.PlacementGroupParameters {
+	return manualv1alpha1.PlacementGroupParameters{
+		Strategy:       string(awsec2types.PlacementStrategyPartition),
+		PartitionCount: aws.Int32(3),
+		Tags:           tags,
+	}
+}
+
+func describePlacementGroup(state awsec2types.PlacementGroupState, tags ...awsec2types.Tag) func(context.Context, *awsec2.DescribePlacementGroupsInput, []func(*awsec2.Options)) (*awsec2.DescribePlacementGroupsOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribePlacementGroupsInput, _ []func(*awsec2.Options)) (*awsec2.DescribePlacementGroupsOutput, error) {
+		if len(input.GroupNames) != 1 || input.GroupNames[0] != groupName {
+			return nil, errors.New("unexpected placement group")
+		}
+		return &awsec2.DescribePlacementGroupsOutput{PlacementGroups: []awsec2types.PlacementGroup{{
+			GroupId:        aws.String(groupID),
+			GroupName:      aws.String(groupName),
+			Strategy:       awsec2types.PlacementStrategyPartition,
+			PartitionCount: aws.Int32(3),
+			State:          state,
+			Tags:           tags,
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.PlacementGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDescribe: describePlacementGroup(awsec2types.PlacementGroupStateAvailable),
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+			want: want{
+				cr: placementGroup(withExternalName(groupName), withSpec(spec()),
+					withStatus(manualv1alpha1.PlacementGroupObservation{
+						GroupID: groupID,
+						State:   string(awsec2types.PlacementGroupStateAvailable),
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsDiffer": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDescribe: describePlacementGroup(awsec2types.PlacementGroupStateAvailable),
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec(manualv1alpha1.Tag{Key: "k", Value: "v"}))),
+			},
+			want: want{
+				cr: placementGroup(withExternalName(groupName), withSpec(spec(manualv1alpha1.Tag{Key: "k", Value: "v"})),
+					withStatus(manualv1alpha1.PlacementGroupObservation{
+						GroupID: groupID,
+						State:   string(awsec2types.PlacementGroupStateAvailable),
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitTags": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDescribe: describePlacementGroup(awsec2types.PlacementGroupStateAvailable, awsec2types.Tag{Key: aws.String("k"), Value: aws.String("v")}),
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+			want: want{
+				cr: placementGroup(withExternalName(groupName), withSpec(spec(manualv1alpha1.Tag{Key: "k", Value: "v"})),
+					withStatus(manualv1alpha1.PlacementGroupObservation{
+						GroupID: groupID,
+						State:   string(awsec2types.PlacementGroupStateAvailable),
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDescribe: describePlacementGroup(awsec2types.PlacementGroupStateDeleted),
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+			want: want{
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDescribe: func(context.Context, *awsec2.DescribePlacementGroupsInput, []func(*awsec2.Options)) (*awsec2.DescribePlacementGroupsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.PlacementGroupNotFound}
+					},
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+			want: want{
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDescribe: func(context.Context, *awsec2.DescribePlacementGroupsInput, []func(*awsec2.Options)) (*awsec2.DescribePlacementGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+			want: want{
+				cr:  placementGroup(withExternalName(groupName), withSpec(spec())),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.PlacementGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockCreate: func(_ context.Context, input *awsec2.CreatePlacementGroupInput, _ []func(*awsec2.Options)) (*awsec2.CreatePlacementGroupOutput, error) {
+						if aws.ToString(input.GroupName) != groupName || input.Strategy != awsec2types.PlacementStrategyPartition || aws.ToInt32(input.PartitionCount) != 3 {
+							return nil, errors.New("unexpected input")
+						}
+						return &awsec2.CreatePlacementGroupOutput{}, nil
+					},
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+			want: want{
+				cr: placementGroup(withExternalName(groupName), withSpec(spec()), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockCreate: func(context.Context, *awsec2.CreatePlacementGroupInput, []func(*awsec2.Options)) (*awsec2.CreatePlacementGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+			want: want{
+				cr:  placementGroup(withExternalName(groupName), withSpec(spec()), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		added   []awsec2types.Tag
+		removed []awsec2types.Tag
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDescribe: describePlacementGroup(awsec2types.PlacementGroupStateAvailable, awsec2types.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec(manualv1alpha1.Tag{Key: "new", Value: "v"}))),
+			},
+			want: want{
+				added:   []awsec2types.Tag{{Key: aws.String("new"), Value: aws.String("v")}},
+				removed: []awsec2types.Tag{{Key: aws.String("old")}},
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDescribe: func(context.Context, *awsec2.DescribePlacementGroupsInput, []func(*awsec2.Options)) (*awsec2.DescribePlacementGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: placementGroup(withExternalName(groupName), withSpec(spec())),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var added, removed []awsec2types.Tag
+			c := tc.client.(*fake.MockPlacementGroupClient)
+			c.MockCreateTags = func(_ context.Context, input *awsec2.CreateTagsInput, _ []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+				if len(input.Resources) != 1 || input.Resources[0] != groupID {
+					return nil, errors.New("unexpected resource")
+				}
+				added = input.Tags
+				return &awsec2.CreateTagsOutput{}, nil
+			}
+			c.MockDeleteTags = func(_ context.Context, input *awsec2.DeleteTagsInput, _ []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+				if len(input.Resources) != 1 || input.Resources[0] != groupID {
+					return nil, errors.New("unexpected resource")
+				}
+				removed = input.Tags
+				return &awsec2.DeleteTagsOutput{}, nil
+			}
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.added, added, cmp.AllowUnexported(awsec2types.Tag{})); diff != "" {
+				t.Errorf("added: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed, cmp.AllowUnexported(awsec2types.Tag{})); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.PlacementGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDelete: func(_ context.Context, input *awsec2.DeletePlacementGroupInput, _ []func(*awsec2.Options)) (*awsec2.DeletePlacementGroupOutput, error) {
+						if aws.ToString(input.GroupName) != groupName {
+							return nil, errors.New("unexpected placement group")
+						}
+						return &awsec2.DeletePlacementGroupOutput{}, nil
+					},
+				},
+				cr: placementGroup(withExternalName(groupName)),
+			},
+			want: want{
+				cr: placementGroup(withExternalName(groupName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDelete: func(context.Context, *awsec2.DeletePlacementGroupInput, []func(*awsec2.Options)) (*awsec2.DeletePlacementGroupOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.PlacementGroupNotFound}
+					},
+				},
+				cr: placementGroup(withExternalName(groupName)),
+			},
+			want: want{
+				cr: placementGroup(withExternalName(groupName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockPlacementGroupClient{
+					MockDelete: func(context.Context, *awsec2.DeletePlacementGroupInput, []func(*awsec2.Options)) (*awsec2.DeletePlacementGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: placementGroup(withExternalName(groupName)),
+			},
+			want: want{
+				cr:  placementGroup(withExternalName(groupName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
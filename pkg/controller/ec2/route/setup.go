@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -25,6 +26,7 @@ import (
 
 const (
 	errMultipleItems = "retrieved multiple RouteTables for the given routeTableId"
+	errReplace       = "cannot replace Route in AWS"
 )
 
 // SetupRoute adds a controller that reconciles Route.
@@ -34,7 +36,9 @@ func SetupRoute(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 		func(e *external) {
 			e.preCreate = preCreate
 			e.observe = e.observer
+			e.update = e.updater
 			e.preDelete = preDelete
+			e.postDelete = postDelete
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -45,7 +49,8 @@ func SetupRoute(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 		For(&svcapitypes.Route{}).
 		Complete(managed.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.RouteGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -58,6 +63,7 @@ func preCreate(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.CreateRoute
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
 	obj.InstanceId = cr.Spec.ForProvider.InstanceID
 	obj.GatewayId = cr.Spec.ForProvider.GatewayID
+	obj.NetworkInterfaceId = cr.Spec.ForProvider.NetworkInterfaceID
 	return nil
 }
 
@@ -74,35 +80,73 @@ func (e *external) observer(ctx context.Context, mg cpresource.Managed) (managed
 	}
 
 	route, err := e.findRouteByDestination(ctx, cr)
-	if err != nil {
-		return managed.ExternalObservation{ResourceExists: false}, nil
+	if err != nil || route == nil {
+		return managed.ExternalObservation{ResourceExists: false}, err
 	}
 
-	if awsclients.StringValue(route.State) == svcsdk.RouteStateActive {
+	switch awsclients.StringValue(route.State) {
+	case svcsdk.RouteStateActive:
 		cr.SetConditions(xpv1.Available())
+	case svcsdk.RouteStateBlackhole:
+		cr.SetConditions(xpv1.Unavailable())
 	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceUpToDate: isRouteUpToDate(cr.Spec.ForProvider, route),
 	}, nil
 }
 
+func (e *external) updater(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Route)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	p := cr.Spec.ForProvider
+	_, err := e.client.ReplaceRouteWithContext(ctx, &svcsdk.ReplaceRouteInput{
+		RouteTableId:                p.RouteTableID,
+		DestinationCidrBlock:        p.DestinationCIDRBlock,
+		DestinationIpv6CidrBlock:    p.DestinationIPv6CIDRBlock,
+		DestinationPrefixListId:     p.DestinationPrefixListID,
+		CarrierGatewayId:            p.CarrierGatewayID,
+		EgressOnlyInternetGatewayId: p.EgressOnlyInternetGatewayID,
+		GatewayId:                   p.GatewayID,
+		InstanceId:                  p.InstanceID,
+		LocalGatewayId:              p.LocalGatewayID,
+		NatGatewayId:                p.NATGatewayID,
+		NetworkInterfaceId:          p.NetworkInterfaceID,
+		TransitGatewayId:            p.TransitGatewayID,
+		VpcEndpointId:               p.VPCEndpointID,
+		VpcPeeringConnectionId:      p.VPCPeeringConnectionID,
+	})
+	return managed.ExternalUpdate{}, awsclients.Wrap(err, errReplace)
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.DeleteRouteInput) (bool, error) {
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
 	return false, nil
 }
 
-// findRouteByDestination returns the route corresponding to the specified IPv4/IPv6 destination.
-// Returns NotFoundError if no route is found.
-func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.Route) (*svcsdk.Route, error) {
+func postDelete(_ context.Context, _ *svcapitypes.Route, _ *svcsdk.DeleteRouteOutput, err error) error {
+	if isAWSErr(err, ec2.RouteNotFound) || isAWSErr(err, ec2.RouteTableIDNotFound) {
+		return nil
+	}
+	return err
+}
 
+// findRouteByDestination returns the route corresponding to the specified
+// IPv4, IPv6 or prefix list destination. Returns nil if either the route or
+// its route table doesn't exist.
+func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.Route) (*svcsdk.Route, error) {
 	response, err := e.client.DescribeRouteTablesWithContext(ctx, &svcsdk.DescribeRouteTablesInput{
 		RouteTableIds: []*string{cr.Spec.ForProvider.RouteTableID},
 	})
-
+	if isAWSErr(err, ec2.RouteTableIDNotFound) {
+		return nil, nil
+	}
 	if err != nil {
-		return nil, awsclients.Wrap(cpresource.Ignore(ec2.IsRouteTableNotFoundErr, err), errDescribe)
+		return nil, awsclients.Wrap(err, errDescribe)
 	}
 
 	// in a successful response, there should be one and only one object
@@ -111,12 +155,56 @@ func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.R
 	}
 
 	for _, route := range response.RouteTables[0].Routes {
-		if awsclients.StringValue(route.Origin) == svcsdk.RouteOriginCreateRoute {
-			if awsclients.CIDRBlocksEqual(awsclients.StringValue(route.DestinationCidrBlock), *cr.Spec.ForProvider.DestinationCIDRBlock) {
-				return route, nil
-			}
+		if awsclients.StringValue(route.Origin) == svcsdk.RouteOriginCreateRoute && isRouteForDestination(cr.Spec.ForProvider, route) {
+			return route, nil
 		}
+	}
+	return nil, nil
+}
+
+func isRouteForDestination(p svcapitypes.RouteParameters, route *svcsdk.Route) bool {
+	switch {
+	case p.DestinationCIDRBlock != nil:
+		return awsclients.CIDRBlocksEqual(awsclients.StringValue(route.DestinationCidrBlock), *p.DestinationCIDRBlock)
+	case p.DestinationIPv6CIDRBlock != nil:
+		return awsclients.CIDRBlocksEqual(awsclients.StringValue(route.DestinationIpv6CidrBlock), *p.DestinationIPv6CIDRBlock)
+	case p.DestinationPrefixListID != nil:
+		return awsclients.StringValue(route.DestinationPrefixListId) == *p.DestinationPrefixListID
+	}
+	return false
+}
 
+// isRouteUpToDate checks whether the targets set in the parameters match the
+// observed route. Unset targets are not compared since AWS reports some
+// targets implicitly, e.g. the network interface of an instance target or
+// the VPC endpoint of a Gateway Load Balancer target as its gateway.
+func isRouteUpToDate(p svcapitypes.RouteParameters, route *svcsdk.Route) bool {
+	gatewayID := p.GatewayID
+	if gatewayID == nil {
+		gatewayID = p.VPCEndpointID
+	}
+	targets := []struct {
+		desired, observed *string
+	}{
+		{p.CarrierGatewayID, route.CarrierGatewayId},
+		{p.EgressOnlyInternetGatewayID, route.EgressOnlyInternetGatewayId},
+		{gatewayID, route.GatewayId},
+		{p.InstanceID, route.InstanceId},
+		{p.LocalGatewayID, route.LocalGatewayId},
+		{p.NATGatewayID, route.NatGatewayId},
+		{p.NetworkInterfaceID, route.NetworkInterfaceId},
+		{p.TransitGatewayID, route.TransitGatewayId},
+		{p.VPCPeeringConnectionID, route.VpcPeeringConnectionId},
+	}
+	for _, t := range targets {
+		if t.desired != nil && *t.desired != awsclients.StringValue(t.observed) {
+			return false
+		}
 	}
-	return nil, errors.New(errUnexpectedObject)
+	return true
+}
+
+func isAWSErr(err error, code string) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == code
 }
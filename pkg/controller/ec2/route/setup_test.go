@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	testRouteTableID = "rtb-1"
+	testNATGatewayID = "nat-1"
+)
+
+func route(p svcapitypes.RouteParameters) *svcapitypes.Route {
+	p.RouteTableID = aws.String(testRouteTableID)
+	cr := &svcapitypes.Route{Spec: svcapitypes.RouteSpec{ForProvider: p}}
+	meta.SetExternalName(cr, "some-route")
+	return cr
+}
+
+func routeTable(routes ...*svcsdk.Route) func(context.Context, *svcsdk.DescribeRouteTablesInput, ...request.Option) (*svcsdk.DescribeRouteTablesOutput, error) {
+	return func(_ context.Context, in *svcsdk.DescribeRouteTablesInput, _ ...request.Option) (*svcsdk.DescribeRouteTablesOutput, error) {
+		if len(in.RouteTableIds) != 1 || aws.StringValue(in.RouteTableIds[0]) != testRouteTableID {
+			return nil, errors.New("unexpected route table")
+		}
+		return &svcsdk.DescribeRouteTablesOutput{
+			RouteTables: []*svcsdk.RouteTable{{
+				RouteTableId: aws.String(testRouteTableID),
+				Routes:       routes,
+			}},
+		}, nil
+	}
+}
+
+func TestIsRouteUpToDate(t *testing.T) {
+	observed := &svcsdk.Route{
+		DestinationCidrBlock: aws.String("0.0.0.0/0"),
+		InstanceId:           aws.String("i-1"),
+		NetworkInterfaceId:   aws.String("eni-1"),
+	}
+	cases := map[string]struct {
+		p    svcapitypes.RouteParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: svcapitypes.RouteParameters{
+				CustomRouteParameters: svcapitypes.CustomRouteParameters{InstanceID: aws.String("i-1")},
+			},
+			want: true,
+		},
+		"NetworkInterfaceTarget": {
+			p: svcapitypes.RouteParameters{
+				CustomRouteParameters: svcapitypes.CustomRouteParameters{NetworkInterfaceID: aws.String("eni-1")},
+			},
+			want: true,
+		},
+		"TargetChanged": {
+			p: svcapitypes.RouteParameters{
+				CustomRouteParameters: svcapitypes.CustomRouteParameters{NATGatewayID: aws.String(testNATGatewayID)},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isRouteUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	natRoute := svcapitypes.RouteParameters{
+		DestinationCIDRBlock:  aws.String("0.0.0.0/0"),
+		CustomRouteParameters: svcapitypes.CustomRouteParameters{NATGatewayID: aws.String(testNATGatewayID)},
+	}
+
+	type want struct {
+		obs        managed.ExternalObservation
+		conditions []xpv1.Condition
+		err        error
+	}
+	cases := map[string]struct {
+		client *fake.MockRouteClient
+		p      svcapitypes.RouteParameters
+		want   want
+	}{
+		"UpToDate": {
+			client: &fake.MockRouteClient{
+				MockDescribeRouteTablesWithContext: routeTable(&svcsdk.Route{
+					DestinationCidrBlock: aws.String("0.0.0.0/0"),
+					NatGatewayId:         aws.String(testNATGatewayID),
+					Origin:               aws.String(svcsdk.RouteOriginCreateRoute),
+					State:                aws.String(svcsdk.RouteStateActive),
+				}),
+			},
+			p: natRoute,
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+		"PrefixListRouteTargetChanged": {
+			client: &fake.MockRouteClient{
+				MockDescribeRouteTablesWithContext: routeTable(&svcsdk.Route{
+					DestinationPrefixListId: aws.String("pl-1"),
+					GatewayId:               aws.String("igw-1"),
+					Origin:                  aws.String(svcsdk.RouteOriginCreateRoute),
+					State:                   aws.String(svcsdk.RouteStateBlackhole),
+				}),
+			},
+			p: svcapitypes.RouteParameters{
+				DestinationPrefixListID: aws.String("pl-1"),
+				CustomRouteParameters:   svcapitypes.CustomRouteParameters{GatewayID: aws.String("igw-2")},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true},
+				conditions: []xpv1.Condition{xpv1.Unavailable()},
+			},
+		},
+		"RouteNotFound": {
+			client: &fake.MockRouteClient{
+				MockDescribeRouteTablesWithContext: routeTable(&svcsdk.Route{
+					DestinationIpv6CidrBlock: aws.String("::/0"),
+					Origin:                   aws.String(svcsdk.RouteOriginCreateRoute),
+				}),
+			},
+			p: natRoute,
+		},
+		"RouteTableNotFound": {
+			client: &fake.MockRouteClient{
+				MockDescribeRouteTablesWithContext: func(context.Context, *svcsdk.DescribeRouteTablesInput, ...request.Option) (*svcsdk.DescribeRouteTablesOutput, error) {
+					return nil, awserr.New(ec2.RouteTableIDNotFound, "", nil)
+				},
+			},
+			p: natRoute,
+		},
+		"DescribeFailed": {
+			client: &fake.MockRouteClient{
+				MockDescribeRouteTablesWithContext: func(context.Context, *svcsdk.DescribeRouteTablesInput, ...request.Option) (*svcsdk.DescribeRouteTablesOutput, error) {
+					return nil, errBoom
+				},
+			},
+			p:    natRoute,
+			want: want{err: aws.Wrap(errBoom, errDescribe)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			cr := route(tc.p)
+			obs, err := e.observer(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			for _, c := range tc.want.conditions {
+				if diff := cmp.Diff(c, cr.GetCondition(c.Type), test.EquateConditions()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		client *fake.MockRouteClient
+		err    error
+	}{
+		"Replaced": {
+			client: &fake.MockRouteClient{
+				MockReplaceRouteWithContext: func(_ context.Context, in *svcsdk.ReplaceRouteInput, _ ...request.Option) (*svcsdk.ReplaceRouteOutput, error) {
+					if aws.StringValue(in.RouteTableId) != testRouteTableID || aws.StringValue(in.NatGatewayId) != testNATGatewayID {
+						return nil, errors.New("unexpected input")
+					}
+					return &svcsdk.ReplaceRouteOutput{}, nil
+				},
+			},
+		},
+		"ReplaceFailed": {
+			client: &fake.MockRouteClient{
+				MockReplaceRouteWithContext: func(context.Context, *svcsdk.ReplaceRouteInput, ...request.Option) (*svcsdk.ReplaceRouteOutput, error) {
+					return nil, errBoom
+				},
+			},
+			err: aws.Wrap(errBoom, errReplace),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.updater(context.Background(), route(svcapitypes.RouteParameters{
+				DestinationCIDRBlock:  aws.String("0.0.0.0/0"),
+				CustomRouteParameters: svcapitypes.CustomRouteParameters{NATGatewayID: aws.String(testNATGatewayID)},
+			}))
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
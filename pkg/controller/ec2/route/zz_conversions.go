@@ -50,9 +50,6 @@ func GenerateCreateRouteInput(cr *svcapitypes.Route) *svcsdk.CreateRouteInput {
 	if cr.Spec.ForProvider.LocalGatewayID != nil {
 		res.SetLocalGatewayId(*cr.Spec.ForProvider.LocalGatewayID)
 	}
-	if cr.Spec.ForProvider.NetworkInterfaceID != nil {
-		res.SetNetworkInterfaceId(*cr.Spec.ForProvider.NetworkInterfaceID)
-	}
 	if cr.Spec.ForProvider.VPCEndpointID != nil {
 		res.SetVpcEndpointId(*cr.Spec.ForProvider.VPCEndpointID)
 	}
@@ -72,10 +72,11 @@ func SetupRouteTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		For(&v1beta1.RouteTable{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RouteTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithCreationGracePeriod(3*time.Minute),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(),
+			managed.WithReferenceResolver(&referenceResolver{client: mgr.GetClient()}),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -262,13 +263,16 @@ func (e *external) deleteRoutes(ctx context.Context, tableID string, desired []v
 	for _, rt := range observed {
 		found := false
 		for _, ds := range desired {
-			if aws.ToString(ds.DestinationCIDRBlock) == rt.DestinationCIDRBlock && (aws.ToString(ds.GatewayID) == rt.GatewayID &&
-				aws.ToString(ds.InstanceID) == rt.InstanceID &&
-				aws.ToString(ds.LocalGatewayID) == rt.LocalGatewayID &&
-				aws.ToString(ds.NatGatewayID) == rt.NatGatewayID &&
-				aws.ToString(ds.NetworkInterfaceID) == rt.NetworkInterfaceID &&
-				aws.ToString(ds.TransitGatewayID) == rt.TransitGatewayID &&
-				aws.ToString(ds.VpcPeeringConnectionID) == rt.VpcPeeringConnectionID) {
+			if aws.ToString(ds.DestinationCIDRBlock) == rt.DestinationCIDRBlock &&
+				aws.ToString(ds.DestinationIPV6CIDRBlock) == rt.DestinationIPV6CIDRBlock &&
+				(aws.ToString(ds.GatewayID) == rt.GatewayID &&
+					aws.ToString(ds.EgressOnlyInternetGatewayID) == rt.EgressOnlyInternetGatewayID &&
+					aws.ToString(ds.InstanceID) == rt.InstanceID &&
+					aws.ToString(ds.LocalGatewayID) == rt.LocalGatewayID &&
+					aws.ToString(ds.NatGatewayID) == rt.NatGatewayID &&
+					aws.ToString(ds.NetworkInterfaceID) == rt.NetworkInterfaceID &&
+					aws.ToString(ds.TransitGatewayID) == rt.TransitGatewayID &&
+					aws.ToString(ds.VpcPeeringConnectionID) == rt.VpcPeeringConnectionID) {
 
 				found = true
 				break
@@ -303,13 +307,16 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 	for _, rt := range desired {
 		isObserved := false
 		for _, ob := range observed {
-			if ob.DestinationCIDRBlock == aws.ToString(rt.DestinationCIDRBlock) && (ob.GatewayID == aws.ToString(rt.GatewayID) &&
-				ob.InstanceID == aws.ToString(rt.InstanceID) &&
-				ob.LocalGatewayID == aws.ToString(rt.LocalGatewayID) &&
-				ob.NatGatewayID == aws.ToString(rt.NatGatewayID) &&
-				ob.NetworkInterfaceID == aws.ToString(rt.NetworkInterfaceID) &&
-				ob.TransitGatewayID == aws.ToString(rt.TransitGatewayID) &&
-				ob.VpcPeeringConnectionID == aws.ToString(rt.VpcPeeringConnectionID)) {
+			if ob.DestinationCIDRBlock == aws.ToString(rt.DestinationCIDRBlock) &&
+				ob.DestinationIPV6CIDRBlock == aws.ToString(rt.DestinationIPV6CIDRBlock) &&
+				(ob.GatewayID == aws.ToString(rt.GatewayID) &&
+					ob.EgressOnlyInternetGatewayID == aws.ToString(rt.EgressOnlyInternetGatewayID) &&
+					ob.InstanceID == aws.ToString(rt.InstanceID) &&
+					ob.LocalGatewayID == aws.ToString(rt.LocalGatewayID) &&
+					ob.NatGatewayID == aws.ToString(rt.NatGatewayID) &&
+					ob.NetworkInterfaceID == aws.ToString(rt.NetworkInterfaceID) &&
+					ob.TransitGatewayID == aws.ToString(rt.TransitGatewayID) &&
+					ob.VpcPeeringConnectionID == aws.ToString(rt.VpcPeeringConnectionID)) {
 				isObserved = true
 				break
 			}
@@ -317,16 +324,17 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 		// if the route is already created, skip it
 		if !isObserved {
 			_, err := e.client.CreateRoute(ctx, &awsec2.CreateRouteInput{
-				RouteTableId:             aws.String(tableID),
-				DestinationCidrBlock:     rt.DestinationCIDRBlock,
-				GatewayId:                rt.GatewayID,
-				DestinationIpv6CidrBlock: rt.DestinationIPV6CIDRBlock,
-				InstanceId:               rt.InstanceID,
-				LocalGatewayId:           rt.LocalGatewayID,
-				NatGatewayId:             rt.NatGatewayID,
-				NetworkInterfaceId:       rt.NetworkInterfaceID,
-				TransitGatewayId:         rt.TransitGatewayID,
-				VpcPeeringConnectionId:   rt.VpcPeeringConnectionID,
+				RouteTableId:                aws.String(tableID),
+				DestinationCidrBlock:        rt.DestinationCIDRBlock,
+				GatewayId:                   rt.GatewayID,
+				DestinationIpv6CidrBlock:    rt.DestinationIPV6CIDRBlock,
+				EgressOnlyInternetGatewayId: rt.EgressOnlyInternetGatewayID,
+				InstanceId:                  rt.InstanceID,
+				LocalGatewayId:              rt.LocalGatewayID,
+				NatGatewayId:                rt.NatGatewayID,
+				NetworkInterfaceId:          rt.NetworkInterfaceID,
+				TransitGatewayId:            rt.TransitGatewayID,
+				VpcPeeringConnectionId:      rt.VpcPeeringConnectionID,
 			})
 
 			if err != nil {
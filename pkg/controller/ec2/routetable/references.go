@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routetable
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const (
+	errResolveReferences = "cannot resolve references"
+	errUpdateReferences  = "cannot update the RouteTable with its resolved references"
+)
+
+// referenceResolver resolves the references of a RouteTable. Its routes may
+// reference EgressOnlyInternetGateways, which are alpha resources that the
+// v1beta1 API cannot import, so they are resolved here in addition to the
+// references resolved by the ResolveReferences method of the RouteTable.
+type referenceResolver struct {
+	client client.Client
+}
+
+func (r *referenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.RouteTable)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	existing := cr.DeepCopy()
+	if err := cr.ResolveReferences(ctx, r.client); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+	if err := resolveEgressOnlyInternetGateways(ctx, r.client, cr); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.client.Update(ctx, cr), errUpdateReferences)
+}
+
+// resolveEgressOnlyInternetGateways resolves the egress-only internet gateways
+// referenced by the routes of the supplied RouteTable.
+func resolveEgressOnlyInternetGateways(ctx context.Context, c client.Reader, cr *v1beta1.RouteTable) error {
+	r := reference.NewAPIResolver(c, cr)
+	for i := range cr.Spec.ForProvider.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(cr.Spec.ForProvider.Routes[i].EgressOnlyInternetGatewayID),
+			Reference:    cr.Spec.ForProvider.Routes[i].EgressOnlyInternetGatewayIDRef,
+			Selector:     cr.Spec.ForProvider.Routes[i].EgressOnlyInternetGatewayIDSelector,
+			To:           reference.To{Managed: &manualv1alpha1.EgressOnlyInternetGateway{}, List: &manualv1alpha1.EgressOnlyInternetGatewayList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].egressOnlyInternetGatewayId", i)
+		}
+		cr.Spec.ForProvider.Routes[i].EgressOnlyInternetGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+		cr.Spec.ForProvider.Routes[i].EgressOnlyInternetGatewayIDRef = rsp.ResolvedReference
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routetable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+var eigwID = "eigw-0123456789"
+
+func TestResolveReferences(t *testing.T) {
+	type want struct {
+		cr      *v1beta1.RouteTable
+		updated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		kube *test.MockClient
+		cr   *v1beta1.RouteTable
+		want want
+	}{
+		"EgressOnlyInternetGateway": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					eigw, ok := obj.(*manualv1alpha1.EgressOnlyInternetGateway)
+					if !ok {
+						return errBoom
+					}
+					meta.SetExternalName(eigw, eigwID)
+					return nil
+				},
+			},
+			cr: rt(withSpec(v1beta1.RouteTableParameters{
+				VPCID: &vpcID,
+				Routes: []v1beta1.RouteBeta{{
+					EgressOnlyInternetGatewayIDRef: &xpv1.Reference{Name: "eigw"},
+				}},
+			})),
+			want: want{
+				cr: rt(withSpec(v1beta1.RouteTableParameters{
+					VPCID: &vpcID,
+					Routes: []v1beta1.RouteBeta{{
+						EgressOnlyInternetGatewayID:    &eigwID,
+						EgressOnlyInternetGatewayIDRef: &xpv1.Reference{Name: "eigw"},
+					}},
+				})),
+				updated: true,
+			},
+		},
+		"NothingToResolve": {
+			kube: &test.MockClient{},
+			cr: rt(withSpec(v1beta1.RouteTableParameters{
+				VPCID: &vpcID,
+				Routes: []v1beta1.RouteBeta{{
+					EgressOnlyInternetGatewayID: &eigwID,
+				}},
+			})),
+			want: want{
+				cr: rt(withSpec(v1beta1.RouteTableParameters{
+					VPCID: &vpcID,
+					Routes: []v1beta1.RouteBeta{{
+						EgressOnlyInternetGatewayID: &eigwID,
+					}},
+				})),
+			},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: rt(withSpec(v1beta1.RouteTableParameters{
+				VPCID: &vpcID,
+				Routes: []v1beta1.RouteBeta{{
+					EgressOnlyInternetGatewayIDRef: &xpv1.Reference{Name: "eigw"},
+				}},
+			})),
+			want: want{
+				cr: rt(withSpec(v1beta1.RouteTableParameters{
+					VPCID: &vpcID,
+					Routes: []v1beta1.RouteBeta{{
+						EgressOnlyInternetGatewayIDRef: &xpv1.Reference{Name: "eigw"},
+					}},
+				})),
+				err: errors.Wrap(errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "spec.forProvider.routes[0].egressOnlyInternetGatewayId"), errResolveReferences),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			tc.kube.MockUpdate = func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+				updated = true
+				return nil
+			}
+			r := &referenceResolver{client: tc.kube}
+			err := r.ResolveReferences(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ResolveReferences(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("ResolveReferences(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("updated: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygrouprule
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a SecurityGroupRule resource"
+	errDescribe         = "failed to describe SecurityGroupRule"
+	errMultipleItems    = "retrieved multiple SecurityGroupRules for the given securityGroupRuleId"
+	errCreate           = "failed to create the SecurityGroupRule resource"
+	errNoRuleID         = "no SecurityGroupRule was returned by the creation of the SecurityGroupRule resource"
+	errModify           = "failed to modify the SecurityGroupRule resource"
+	errDelete           = "failed to delete the SecurityGroupRule resource"
+	errCreateTags       = "failed to create tags for the SecurityGroupRule resource"
+	errDeleteTags       = "failed to delete tags for the SecurityGroupRule resource"
+)
+
+// SetupSecurityGroupRule adds a controller that reconciles SecurityGroupRules.
+func SetupSecurityGroupRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.SecurityGroupRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.SecurityGroupRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.SecurityGroupRuleGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupRuleClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.SecurityGroupRuleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.SecurityGroupRule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.SecurityGroupRuleClient
+}
+
+// describe returns the observed rule, or nil if it doesn't exist anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.SecurityGroupRule) (*awsec2types.SecurityGroupRule, error) {
+	response, err := e.client.DescribeSecurityGroupRules(ctx, &awsec2.DescribeSecurityGroupRulesInput{
+		SecurityGroupRuleIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsSecurityGroupRuleNotFoundErr, err), errDescribe)
+	}
+	switch len(response.SecurityGroupRules) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.SecurityGroupRules[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeSecurityGroupRule(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateSecurityGroupRuleObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsSecurityGroupRuleUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	perms := []awsec2types.IpPermission{ec2.GenerateSecurityGroupRulePermission(cr.Spec.ForProvider)}
+	var tags []awsec2types.TagSpecification
+	if len(cr.Spec.ForProvider.Tags) != 0 {
+		tags = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeSecurityGroupRule,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+
+	var rules []awsec2types.SecurityGroupRule
+	if cr.Spec.ForProvider.Type == manualv1alpha1.SecurityGroupRuleTypeEgress {
+		out, err := e.client.AuthorizeSecurityGroupEgress(ctx, &awsec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:           cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions:     perms,
+			TagSpecifications: tags,
+		})
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+		}
+		rules = out.SecurityGroupRules
+	} else {
+		out, err := e.client.AuthorizeSecurityGroupIngress(ctx, &awsec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:           cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions:     perms,
+			TagSpecifications: tags,
+		})
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+		}
+		rules = out.SecurityGroupRules
+	}
+
+	if len(rules) == 0 {
+		return managed.ExternalCreation{}, errors.New(errNoRuleID)
+	}
+	meta.SetExternalName(cr, aws.ToString(rules[0].SecurityGroupRuleId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if _, err := e.client.ModifySecurityGroupRules(ctx, &awsec2.ModifySecurityGroupRulesInput{
+		GroupId: cr.Spec.ForProvider.SecurityGroupID,
+		SecurityGroupRules: []awsec2types.SecurityGroupRuleUpdate{{
+			SecurityGroupRuleId: aws.String(meta.GetExternalName(cr)),
+			SecurityGroupRule:   ec2.GenerateSecurityGroupRuleRequest(cr.Spec.ForProvider),
+		}},
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+	}
+
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.SecurityGroupRule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	var err error
+	if cr.Spec.ForProvider.Type == manualv1alpha1.SecurityGroupRuleTypeEgress {
+		_, err = e.client.RevokeSecurityGroupEgress(ctx, &awsec2.RevokeSecurityGroupEgressInput{
+			GroupId:              cr.Spec.ForProvider.SecurityGroupID,
+			SecurityGroupRuleIds: []string{meta.GetExternalName(cr)},
+		})
+	} else {
+		_, err = e.client.RevokeSecurityGroupIngress(ctx, &awsec2.RevokeSecurityGroupIngressInput{
+			GroupId:              cr.Spec.ForProvider.SecurityGroupID,
+			SecurityGroupRuleIds: []string{meta.GetExternalName(cr)},
+		})
+	}
+
+	return awsclient.Wrap(resource.Ignore(func(err error) bool {
+		return ec2.IsSecurityGroupRuleNotFoundErr(err) || ec2.IsSecurityGroupNotFoundErr(err)
+	}, err), errDelete)
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygrouprule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	ruleID  = "sgr-123"
+	sgID    = "sg-123"
+	ownerID = "owner"
+	cidr    = "10.0.0.0/16"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sgr  ec2.SecurityGroupRuleClient
+	kube client.Client
+	cr   *manualv1alpha1.SecurityGroupRule
+}
+
+type ruleModifier func(*manualv1alpha1.SecurityGroupRule)
+
+func withExternalName(name string) ruleModifier {
+	return func(r *manualv1alpha1.SecurityGroupRule) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) ruleModifier {
+	return func(r *manualv1alpha1.SecurityGroupRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.SecurityGroupRuleParameters) ruleModifier {
+	return func(r *manualv1alpha1.SecurityGroupRule) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.SecurityGroupRuleObservation) ruleModifier {
+	return func(r *manualv1alpha1.SecurityGroupRule) { r.Status.AtProvider = s }
+}
+
+func rule(m ...ruleModifier) *manualv1alpha1.SecurityGroupRule {
+	cr := &manualv1alpha1.SecurityGroupRule{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func httpsSpec(t string) manualv1alpha1.SecurityGroupRuleParameters {
+	return manualv1alpha1.SecurityGroupRuleParameters{
+		SecurityGroupID: aws.String(sgID),
+		Type:            t,
+		Protocol:        "tcp",
+		FromPort:        aws.Int32(443),
+		ToPort:          aws.Int32(443),
+		CIDRIPv4:        aws.String(cidr),
+	}
+}
+
+func observedRule(port int32) awsec2types.SecurityGroupRule {
+	return awsec2types.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String(ruleID),
+		GroupId:             aws.String(sgID),
+		GroupOwnerId:        aws.String(ownerID),
+		IpProtocol:          aws.String("tcp"),
+		FromPort:            aws.Int32(port),
+		ToPort:              aws.Int32(port),
+		CidrIpv4:            aws.String(cidr),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.SecurityGroupRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return &awsec2.DescribeSecurityGroupRulesOutput{SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule(443)}}, nil
+					},
+				},
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress)),
+					withStatus(manualv1alpha1.SecurityGroupRuleObservation{
+						SecurityGroupRuleID:  ruleID,
+						SecurityGroupOwnerID: ownerID,
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Drifted": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return &awsec2.DescribeSecurityGroupRulesOutput{SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule(80)}}, nil
+					},
+				},
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress)),
+					withStatus(manualv1alpha1.SecurityGroupRuleObservation{
+						SecurityGroupRuleID:  ruleID,
+						SecurityGroupOwnerID: ownerID,
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.SecurityGroupRuleIDNotFound}
+					},
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID)),
+			},
+		},
+		"NoRules": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return &awsec2.DescribeSecurityGroupRulesOutput{}, nil
+					},
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID)),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withExternalName(ruleID)),
+			},
+			want: want{
+				cr:  rule(withExternalName(ruleID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.sgr}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.SecurityGroupRule
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Ingress": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeIngress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupIngressOutput, error) {
+						return &awsec2.AuthorizeSecurityGroupIngressOutput{SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule(443)}}, nil
+					},
+				},
+				cr: rule(withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+			want: want{
+				cr: rule(withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress)),
+					withExternalName(ruleID),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"Egress": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeEgress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupEgressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupEgressOutput, error) {
+						return &awsec2.AuthorizeSecurityGroupEgressOutput{SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule(443)}}, nil
+					},
+				},
+				cr: rule(withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeEgress))),
+			},
+			want: want{
+				cr: rule(withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeEgress)),
+					withExternalName(ruleID),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeIngress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupIngressOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+			want: want{
+				cr: rule(withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress)),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.sgr}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.SecurityGroupRule
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return &awsec2.DescribeSecurityGroupRulesOutput{SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule(80)}}, nil
+					},
+					MockModify: func(ctx context.Context, input *awsec2.ModifySecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.ModifySecurityGroupRulesOutput, error) {
+						r := input.SecurityGroupRules[0]
+						if aws.ToString(r.SecurityGroupRuleId) != ruleID || aws.ToInt32(r.SecurityGroupRule.FromPort) != 443 {
+							return nil, errors.New("unexpected rule modified")
+						}
+						return &awsec2.ModifySecurityGroupRulesOutput{}, nil
+					},
+				},
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return &awsec2.DescribeSecurityGroupRulesOutput{SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule(80)}}, nil
+					},
+					MockModify: func(ctx context.Context, input *awsec2.ModifySecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.ModifySecurityGroupRulesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+			want: want{
+				cr:  rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.sgr}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.SecurityGroupRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Ingress": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockRevokeIngress: func(ctx context.Context, input *awsec2.RevokeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.RevokeSecurityGroupIngressOutput, error) {
+						return &awsec2.RevokeSecurityGroupIngressOutput{}, nil
+					},
+				},
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress)),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"GroupAlreadyDeleted": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockRevokeEgress: func(ctx context.Context, input *awsec2.RevokeSecurityGroupEgressInput, opts []func(*awsec2.Options)) (*awsec2.RevokeSecurityGroupEgressOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.InvalidGroupNotFound}
+					},
+				},
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeEgress))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeEgress)),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				sgr: &fake.MockSecurityGroupRuleClient{
+					MockRevokeIngress: func(ctx context.Context, input *awsec2.RevokeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.RevokeSecurityGroupIngressOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleID), withSpec(httpsSpec(manualv1alpha1.SecurityGroupRuleTypeIngress)),
+					withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.sgr}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
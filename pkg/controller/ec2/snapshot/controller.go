@@ -0,0 +1,279 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a Snapshot resource"
+	errDescribe         = "failed to describe Snapshot"
+	errMultipleItems    = "retrieved multiple Snapshots for the given snapshotId"
+	errDescribeRestores = "failed to describe the fast snapshot restores of the Snapshot"
+	errCreate           = "failed to create the Snapshot resource"
+	errEnableRestores   = "failed to enable fast snapshot restores for the Snapshot"
+	errDisableRestores  = "failed to disable fast snapshot restores for the Snapshot"
+	errDelete           = "failed to delete the Snapshot resource"
+	errCreateTags       = "failed to create tags for the Snapshot resource"
+	errDeleteTags       = "failed to delete tags for the Snapshot resource"
+
+	filterSnapshotID = "snapshot-id"
+)
+
+// SetupSnapshot adds a controller that reconciles Snapshots.
+func SetupSnapshot(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.SnapshotGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.Snapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.SnapshotGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewSnapshotClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.SnapshotClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.SnapshotClient
+}
+
+// describe returns the observed snapshot, or nil if it doesn't exist anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.Snapshot) (*awsec2types.Snapshot, error) {
+	response, err := e.client.DescribeSnapshots(ctx, &awsec2.DescribeSnapshotsInput{
+		SnapshotIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsSnapshotNotFoundErr, err), errDescribe)
+	}
+	switch len(response.Snapshots) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.Snapshots[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+// describeRestores returns the fast snapshot restores of the snapshot in all
+// Availability Zones.
+func (e *external) describeRestores(ctx context.Context, cr *manualv1alpha1.Snapshot) ([]awsec2types.DescribeFastSnapshotRestoreSuccessItem, error) {
+	response, err := e.client.DescribeFastSnapshotRestores(ctx, &awsec2.DescribeFastSnapshotRestoresInput{
+		Filters: []awsec2types.Filter{{
+			Name:   aws.String(filterSnapshotID),
+			Values: []string{meta.GetExternalName(cr)},
+		}},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errDescribeRestores)
+	}
+	return response.FastSnapshotRestores, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+	restores, err := e.describeRestores(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeSnapshot(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateSnapshotObservation(*observed, restores)
+	switch observed.State {
+	case awsec2types.SnapshotStateCompleted:
+		cr.SetConditions(xpv1.Available())
+	case awsec2types.SnapshotStatePending:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// Fast snapshot restore can only be enabled once the snapshot is
+	// completed.
+	upToDate := observed.State != awsec2types.SnapshotStateCompleted ||
+		ec2.IsSnapshotUpToDate(cr.Spec.ForProvider, *observed, restores)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	input := &awsec2.CreateSnapshotInput{
+		VolumeId:    aws.String(cr.Spec.ForProvider.VolumeID),
+		Description: cr.Spec.ForProvider.Description,
+	}
+	if len(cr.Spec.ForProvider.Tags) != 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeSnapshot,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+	out, err := e.client.CreateSnapshot(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.SnapshotId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	restores, err := e.describeRestores(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	enable, disable := ec2.DiffFastSnapshotRestoreAvailabilityZones(cr.Spec.ForProvider.FastSnapshotRestoreAvailabilityZones, restores)
+	if len(disable) > 0 {
+		if _, err := e.client.DisableFastSnapshotRestores(ctx, &awsec2.DisableFastSnapshotRestoresInput{
+			AvailabilityZones: disable,
+			SourceSnapshotIds: []string{meta.GetExternalName(cr)},
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDisableRestores)
+		}
+	}
+	if len(enable) > 0 {
+		if _, err := e.client.EnableFastSnapshotRestores(ctx, &awsec2.EnableFastSnapshotRestoresInput{
+			AvailabilityZones: enable,
+			SourceSnapshotIds: []string{meta.GetExternalName(cr)},
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errEnableRestores)
+		}
+	}
+
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// Fast snapshot restores are disabled by AWS when the snapshot is deleted.
+	_, err := e.client.DeleteSnapshot(ctx, &awsec2.DeleteSnapshotInput{
+		SnapshotId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsSnapshotNotFoundErr, err), errDelete)
+}
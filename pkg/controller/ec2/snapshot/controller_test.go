@@ -0,0 +1,374 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	snapshotID = "snap-123"
+	volumeID   = "vol-123"
+	ownerID    = "owner"
+	zoneA      = "us-east-1a"
+	zoneB      = "us-east-1b"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.SnapshotClient
+	cr     *manualv1alpha1.Snapshot
+}
+
+type snapshotModifier func(*manualv1alpha1.Snapshot)
+
+func withExternalName(name string) snapshotModifier {
+	return func(r *manualv1alpha1.Snapshot) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) snapshotModifier {
+	return func(r *manualv1alpha1.Snapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.SnapshotParameters) snapshotModifier {
+	return func(r *manualv1alpha1.Snapshot) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.SnapshotObservation) snapshotModifier {
+	return func(r *manualv1alpha1.Snapshot) { r.Status.AtProvider = s }
+}
+
+func snapshot(m ...snapshotModifier) *manualv1alpha1.Snapshot {
+	cr := &manualv1alpha1.Snapshot{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(zones ...string) manualv1alpha1.SnapshotParameters {
+	return manualv1alpha1.SnapshotParameters{
+		VolumeID:                             volumeID,
+		FastSnapshotRestoreAvailabilityZones: zones,
+	}
+}
+
+func describeSnapshot(state awsec2types.SnapshotState) func(context.Context, *awsec2.DescribeSnapshotsInput, []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+	return func(context.Context, *awsec2.DescribeSnapshotsInput, []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+		return &awsec2.DescribeSnapshotsOutput{Snapshots: []awsec2types.Snapshot{{
+			SnapshotId: aws.String(snapshotID),
+			VolumeId:   aws.String(volumeID),
+			OwnerId:    aws.String(ownerID),
+			State:      state,
+		}}}, nil
+	}
+}
+
+func describeRestores(zones ...string) func(context.Context, *awsec2.DescribeFastSnapshotRestoresInput, []func(*awsec2.Options)) (*awsec2.DescribeFastSnapshotRestoresOutput, error) {
+	return func(context.Context, *awsec2.DescribeFastSnapshotRestoresInput, []func(*awsec2.Options)) (*awsec2.DescribeFastSnapshotRestoresOutput, error) {
+		out := &awsec2.DescribeFastSnapshotRestoresOutput{}
+		for _, z := range zones {
+			out.FastSnapshotRestores = append(out.FastSnapshotRestores, awsec2types.DescribeFastSnapshotRestoreSuccessItem{
+				SnapshotId:       aws.String(snapshotID),
+				AvailabilityZone: aws.String(z),
+				State:            awsec2types.FastSnapshotRestoreStateCodeEnabled,
+			})
+		}
+		return out, nil
+	}
+}
+
+func restores(zones ...string) []manualv1alpha1.FastSnapshotRestoreObservation {
+	var res []manualv1alpha1.FastSnapshotRestoreObservation
+	for _, z := range zones {
+		res = append(res, manualv1alpha1.FastSnapshotRestoreObservation{
+			AvailabilityZone: z,
+			State:            string(awsec2types.FastSnapshotRestoreStateCodeEnabled),
+		})
+	}
+	return res
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.Snapshot
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe:         describeSnapshot(awsec2types.SnapshotStateCompleted),
+					MockDescribeRestores: describeRestores(zoneA),
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec(zoneA))),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec(zoneA)),
+					withStatus(manualv1alpha1.SnapshotObservation{
+						SnapshotID:           snapshotID,
+						OwnerID:              ownerID,
+						State:                string(awsec2types.SnapshotStateCompleted),
+						FastSnapshotRestores: restores(zoneA),
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RestoresDiffer": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe:         describeSnapshot(awsec2types.SnapshotStateCompleted),
+					MockDescribeRestores: describeRestores(zoneA),
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec(zoneB))),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec(zoneB)),
+					withStatus(manualv1alpha1.SnapshotObservation{
+						SnapshotID:           snapshotID,
+						OwnerID:              ownerID,
+						State:                string(awsec2types.SnapshotStateCompleted),
+						FastSnapshotRestores: restores(zoneA),
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe:         describeSnapshot(awsec2types.SnapshotStatePending),
+					MockDescribeRestores: describeRestores(),
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec(zoneA))),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec(zoneA)),
+					withStatus(manualv1alpha1.SnapshotObservation{
+						SnapshotID: snapshotID,
+						OwnerID:    ownerID,
+						State:      string(awsec2types.SnapshotStatePending),
+					}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeSnapshotsInput, []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.SnapshotIDNotFound}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec())),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec())),
+			},
+		},
+		"DescribeRestoresFailed": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe: describeSnapshot(awsec2types.SnapshotStateCompleted),
+					MockDescribeRestores: func(context.Context, *awsec2.DescribeFastSnapshotRestoresInput, []func(*awsec2.Options)) (*awsec2.DescribeFastSnapshotRestoresOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec())),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotID), withSpec(spec())),
+				err: awsclient.Wrap(errBoom, errDescribeRestores),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.Snapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockCreate: func(_ context.Context, input *awsec2.CreateSnapshotInput, _ []func(*awsec2.Options)) (*awsec2.CreateSnapshotOutput, error) {
+						if aws.ToString(input.VolumeId) != volumeID {
+							return nil, errors.New("unexpected volume")
+						}
+						return &awsec2.CreateSnapshotOutput{SnapshotId: aws.String(snapshotID)}, nil
+					},
+				},
+				cr: snapshot(withSpec(spec())),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec()), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockCreate: func(context.Context, *awsec2.CreateSnapshotInput, []func(*awsec2.Options)) (*awsec2.CreateSnapshotOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withSpec(spec())),
+			},
+			want: want{
+				cr:  snapshot(withSpec(spec()), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		enabled  []string
+		disabled []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"MovesRestores": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe:         describeSnapshot(awsec2types.SnapshotStateCompleted),
+					MockDescribeRestores: describeRestores(zoneA),
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec(zoneB))),
+			},
+			want: want{
+				enabled:  []string{zoneB},
+				disabled: []string{zoneA},
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeSnapshotsInput, []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID), withSpec(spec(zoneB))),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var enabled, disabled []string
+			c := tc.client.(*fake.MockSnapshotClient)
+			c.MockEnableRestores = func(_ context.Context, input *awsec2.EnableFastSnapshotRestoresInput, _ []func(*awsec2.Options)) (*awsec2.EnableFastSnapshotRestoresOutput, error) {
+				enabled = input.AvailabilityZones
+				return &awsec2.EnableFastSnapshotRestoresOutput{}, nil
+			}
+			c.MockDisableRestores = func(_ context.Context, input *awsec2.DisableFastSnapshotRestoresInput, _ []func(*awsec2.Options)) (*awsec2.DisableFastSnapshotRestoresOutput, error) {
+				disabled = input.AvailabilityZones
+				return &awsec2.DisableFastSnapshotRestoresOutput{}, nil
+			}
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.enabled, enabled); diff != "" {
+				t.Errorf("r: -want enabled, +got enabled:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disabled, disabled); diff != "" {
+				t.Errorf("r: -want disabled, +got disabled:\n%s", diff)
+			}
+		})
+	}
+}
@@ -46,12 +46,14 @@ import (
 const (
 	errUnexpectedObject = "The managed resource is not an Subnet resource"
 
-	errDescribe      = "failed to describe Subnet"
-	errMultipleItems = "retrieved multiple Subnets"
-	errCreate        = "failed to create the Subnet resource"
-	errDelete        = "failed to delete the Subnet resource"
-	errUpdate        = "failed to update the Subnet resource"
-	errCreateTags    = "failed to create tags for the Subnet resource"
+	errDescribe         = "failed to describe Subnet"
+	errMultipleItems    = "retrieved multiple Subnets"
+	errCreate           = "failed to create the Subnet resource"
+	errDelete           = "failed to delete the Subnet resource"
+	errUpdate           = "failed to update the Subnet resource"
+	errCreateTags       = "failed to create tags for the Subnet resource"
+	errAssociateIPv6    = "failed to associate the IPv6 CIDR block with the Subnet resource"
+	errDisassociateIPv6 = "failed to disassociate the IPv6 CIDR block from the Subnet resource"
 )
 
 // SetupSubnet adds a controller that reconciles Subnets.
@@ -65,10 +67,11 @@ func SetupSubnet(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 		For(&v1beta1.Subnet{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SubnetGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSubnetClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewSubnetClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithCreationGracePeriod(3*time.Minute),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithInitializers(),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -196,6 +199,27 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
+	// NOTE: A subnet can only have a single IPv6 CIDR block, so a replaced
+	// block has to be disassociated before the new one can be associated.
+	// This happens in a later reconcile, once the old block is gone.
+	if id := ec2.StaleSubnetIPv6CIDRBlockAssociationID(cr.Spec.ForProvider, subnet); id != nil {
+		_, err := e.client.DisassociateSubnetCidrBlock(ctx, &awsec2.DisassociateSubnetCidrBlockInput{
+			AssociationId: id,
+		})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDisassociateIPv6)
+	}
+
+	// NOTE: The IPv6 CIDR block has to be associated before the subnet can be
+	// configured to assign IPv6 addresses on creation.
+	if ec2.NeedsSubnetIPv6CIDRBlock(cr.Spec.ForProvider, subnet) {
+		if _, err := e.client.AssociateSubnetCidrBlock(ctx, &awsec2.AssociateSubnetCidrBlockInput{
+			Ipv6CidrBlock: cr.Spec.ForProvider.IPv6CIDRBlock,
+			SubnetId:      aws.String(meta.GetExternalName(cr)),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAssociateIPv6)
+		}
+	}
+
 	if aws.ToBool(subnet.MapPublicIpOnLaunch) != aws.ToBool(cr.Spec.ForProvider.MapPublicIPOnLaunch) {
 		_, err = e.client.ModifySubnetAttribute(ctx, &awsec2.ModifySubnetAttributeInput{
 			MapPublicIpOnLaunch: &awsec2types.AttributeBooleanValue{
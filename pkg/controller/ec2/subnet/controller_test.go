@@ -40,6 +40,7 @@ import (
 
 var (
 	subnetID = "some Id"
+	ipv6CIDR = "2600:1f18:a1b:3c00::/64"
 
 	errBoom = errors.New("boom")
 )
@@ -337,6 +338,120 @@ func TestUpdate(t *testing.T) {
 				})),
 			},
 		},
+		"SuccessfulIPv6CIDRBlock": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSubnetsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSubnetsOutput, error) {
+						return &awsec2.DescribeSubnetsOutput{
+							Subnets: []awsec2types.Subnet{{
+								SubnetId: aws.String(subnetID),
+							}},
+						}, nil
+					},
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateSubnetCidrBlockInput, opts []func(*awsec2.Options)) (*awsec2.AssociateSubnetCidrBlockOutput, error) {
+						if aws.ToString(input.Ipv6CidrBlock) != ipv6CIDR {
+							return nil, errors.New("unexpected IPv6 CIDR block")
+						}
+						return &awsec2.AssociateSubnetCidrBlockOutput{}, nil
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+			},
+		},
+		"ReplacedIPv6CIDRBlock": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSubnetsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSubnetsOutput, error) {
+						return &awsec2.DescribeSubnetsOutput{
+							Subnets: []awsec2types.Subnet{{
+								SubnetId: aws.String(subnetID),
+								Ipv6CidrBlockAssociationSet: []awsec2types.SubnetIpv6CidrBlockAssociation{{
+									AssociationId:      aws.String("subnet-cidr-assoc-123"),
+									Ipv6CidrBlock:      aws.String("2001:db8:1234:1a01::/64"),
+									Ipv6CidrBlockState: &awsec2types.SubnetCidrBlockState{State: awsec2types.SubnetCidrBlockStateCodeAssociated},
+								}},
+							}},
+						}, nil
+					},
+					MockDisassociate: func(ctx context.Context, input *awsec2.DisassociateSubnetCidrBlockInput, opts []func(*awsec2.Options)) (*awsec2.DisassociateSubnetCidrBlockOutput, error) {
+						if aws.ToString(input.AssociationId) != "subnet-cidr-assoc-123" {
+							return nil, errors.New("unexpected association")
+						}
+						return &awsec2.DisassociateSubnetCidrBlockOutput{}, nil
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+			},
+		},
+		"DisassociateIPv6CIDRBlockFailed": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSubnetsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSubnetsOutput, error) {
+						return &awsec2.DescribeSubnetsOutput{
+							Subnets: []awsec2types.Subnet{{
+								SubnetId: aws.String(subnetID),
+								Ipv6CidrBlockAssociationSet: []awsec2types.SubnetIpv6CidrBlockAssociation{{
+									AssociationId:      aws.String("subnet-cidr-assoc-123"),
+									Ipv6CidrBlock:      aws.String("2001:db8:1234:1a01::/64"),
+									Ipv6CidrBlockState: &awsec2types.SubnetCidrBlockState{State: awsec2types.SubnetCidrBlockStateCodeAssociated},
+								}},
+							}},
+						}, nil
+					},
+					MockDisassociate: func(ctx context.Context, input *awsec2.DisassociateSubnetCidrBlockInput, opts []func(*awsec2.Options)) (*awsec2.DisassociateSubnetCidrBlockOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+				err: awsclient.Wrap(errBoom, errDisassociateIPv6),
+			},
+		},
+		"AssociateIPv6CIDRBlockFailed": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSubnetsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSubnetsOutput, error) {
+						return &awsec2.DescribeSubnetsOutput{
+							Subnets: []awsec2types.Subnet{{
+								SubnetId: aws.String(subnetID),
+							}},
+						}, nil
+					},
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateSubnetCidrBlockInput, opts []func(*awsec2.Options)) (*awsec2.AssociateSubnetCidrBlockOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv6CIDRBlock: aws.String(ipv6CIDR),
+				})),
+				err: awsclient.Wrap(errBoom, errAssociateIPv6),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,258 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficmirrorfilter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject     = "The managed resource is not a TrafficMirrorFilter resource"
+	errDescribe             = "failed to describe TrafficMirrorFilter"
+	errMultipleItems        = "retrieved multiple TrafficMirrorFilters for the given trafficMirrorFilterId"
+	errCreate               = "failed to create the TrafficMirrorFilter resource"
+	errModifyNetworkService = "failed to modify the network services of the TrafficMirrorFilter"
+	errCreateRule           = "failed to create a rule of the TrafficMirrorFilter"
+	errModifyRule           = "failed to modify a rule of the TrafficMirrorFilter"
+	errDeleteRule           = "failed to delete a rule of the TrafficMirrorFilter"
+	errDelete               = "failed to delete the TrafficMirrorFilter resource"
+	errCreateTags           = "failed to create tags for the TrafficMirrorFilter resource"
+	errDeleteTags           = "failed to delete tags for the TrafficMirrorFilter resource"
+)
+
+// SetupTrafficMirrorFilter adds a controller that reconciles
+// TrafficMirrorFilters.
+func SetupTrafficMirrorFilter(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.TrafficMirrorFilterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.TrafficMirrorFilter{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.TrafficMirrorFilterGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewTrafficMirrorFilterClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TrafficMirrorFilterClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.TrafficMirrorFilter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.TrafficMirrorFilterClient
+}
+
+// describe returns the observed Traffic Mirror filter, or nil if it doesn't
+// exist.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.TrafficMirrorFilter) (*awsec2types.TrafficMirrorFilter, error) {
+	response, err := e.client.DescribeTrafficMirrorFilters(ctx, &awsec2.DescribeTrafficMirrorFiltersInput{
+		TrafficMirrorFilterIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsTrafficMirrorFilterNotFoundErr, err), errDescribe)
+	}
+	switch len(response.TrafficMirrorFilters) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.TrafficMirrorFilters[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorFilter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeTrafficMirrorFilter(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateTrafficMirrorFilterObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsTrafficMirrorFilterUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorFilter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// Network services and rules are added by the first update.
+	input := &awsec2.CreateTrafficMirrorFilterInput{
+		ClientToken: aws.String(string(cr.UID)),
+		Description: p.Description,
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeTrafficMirrorFilter,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	out, err := e.client.CreateTrafficMirrorFilter(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.TrafficMirrorFilter.TrafficMirrorFilterId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorFilter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := cr.Spec.ForProvider
+
+	add, remove := ec2.DiffTrafficMirrorFilterNetworkServices(p, *observed)
+	if len(add) > 0 || len(remove) > 0 {
+		if _, err := e.client.ModifyTrafficMirrorFilterNetworkServices(ctx, &awsec2.ModifyTrafficMirrorFilterNetworkServicesInput{
+			TrafficMirrorFilterId: aws.String(meta.GetExternalName(cr)),
+			AddNetworkServices:    add,
+			RemoveNetworkServices: remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyNetworkService)
+		}
+	}
+
+	// Rules are deleted first so that their rule numbers are free for the
+	// rules that are created.
+	rules := ec2.DiffTrafficMirrorFilterRules(p, *observed)
+	for _, input := range rules.Delete {
+		if _, err := e.client.DeleteTrafficMirrorFilterRule(ctx, input); resource.Ignore(ec2.IsTrafficMirrorFilterRuleNotFoundErr, err) != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteRule)
+		}
+	}
+	for _, input := range rules.Modify {
+		if _, err := e.client.ModifyTrafficMirrorFilterRule(ctx, input); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyRule)
+		}
+	}
+	for _, input := range rules.Create {
+		if _, err := e.client.CreateTrafficMirrorFilterRule(ctx, input); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateRule)
+		}
+	}
+
+	tagsAdd, tagsRemove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), observed.Tags)
+	if len(tagsRemove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsRemove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(tagsAdd) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsAdd,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorFilter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteTrafficMirrorFilter(ctx, &awsec2.DeleteTrafficMirrorFilterInput{
+		TrafficMirrorFilterId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsTrafficMirrorFilterNotFoundErr, err), errDelete)
+}
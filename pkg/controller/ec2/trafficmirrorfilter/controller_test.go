@@ -0,0 +1,347 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficmirrorfilter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	filterID = "tmf-123"
+	ruleID   = "tmfr-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.TrafficMirrorFilterClient
+	cr     *manualv1alpha1.TrafficMirrorFilter
+}
+
+type filterModifier func(*manualv1alpha1.TrafficMirrorFilter)
+
+func withExternalName(name string) filterModifier {
+	return func(r *manualv1alpha1.TrafficMirrorFilter) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) filterModifier {
+	return func(r *manualv1alpha1.TrafficMirrorFilter) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.TrafficMirrorFilterParameters) filterModifier {
+	return func(r *manualv1alpha1.TrafficMirrorFilter) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.TrafficMirrorFilterObservation) filterModifier {
+	return func(r *manualv1alpha1.TrafficMirrorFilter) { r.Status.AtProvider = s }
+}
+
+func filter(m ...filterModifier) *manualv1alpha1.TrafficMirrorFilter {
+	cr := &manualv1alpha1.TrafficMirrorFilter{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(rules ...manualv1alpha1.TrafficMirrorFilterRule) manualv1alpha1.TrafficMirrorFilterParameters {
+	return manualv1alpha1.TrafficMirrorFilterParameters{
+		Region:          "us-east-1",
+		NetworkServices: []string{string(awsec2types.TrafficMirrorNetworkServiceAmazonDns)},
+		IngressRules:    rules,
+	}
+}
+
+func rule(number int32) manualv1alpha1.TrafficMirrorFilterRule {
+	return manualv1alpha1.TrafficMirrorFilterRule{
+		RuleNumber:           number,
+		RuleAction:           string(awsec2types.TrafficMirrorRuleActionAccept),
+		DestinationCIDRBlock: "0.0.0.0/0",
+		SourceCIDRBlock:      "0.0.0.0/0",
+	}
+}
+
+func observed(rules ...awsec2types.TrafficMirrorFilterRule) awsec2types.TrafficMirrorFilter {
+	return awsec2types.TrafficMirrorFilter{
+		TrafficMirrorFilterId: aws.String(filterID),
+		NetworkServices:       []awsec2types.TrafficMirrorNetworkService{awsec2types.TrafficMirrorNetworkServiceAmazonDns},
+		IngressFilterRules:    rules,
+	}
+}
+
+func observedRule(number int32) awsec2types.TrafficMirrorFilterRule {
+	return awsec2types.TrafficMirrorFilterRule{
+		TrafficMirrorFilterRuleId: aws.String(ruleID),
+		TrafficDirection:          awsec2types.TrafficDirectionIngress,
+		RuleNumber:                aws.Int32(number),
+		RuleAction:                awsec2types.TrafficMirrorRuleActionAccept,
+		DestinationCidrBlock:      aws.String("0.0.0.0/0"),
+		SourceCidrBlock:           aws.String("0.0.0.0/0"),
+	}
+}
+
+func describe(f awsec2types.TrafficMirrorFilter) func(context.Context, *awsec2.DescribeTrafficMirrorFiltersInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorFiltersOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeTrafficMirrorFiltersInput, _ []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorFiltersOutput, error) {
+		if len(input.TrafficMirrorFilterIds) != 1 || input.TrafficMirrorFilterIds[0] != filterID {
+			return nil, errors.New("unexpected traffic mirror filter")
+		}
+		return &awsec2.DescribeTrafficMirrorFiltersOutput{TrafficMirrorFilters: []awsec2types.TrafficMirrorFilter{f}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.TrafficMirrorFilter
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockTrafficMirrorFilterClient{
+					MockDescribe: describe(observed(observedRule(10))),
+				},
+				cr: filter(withExternalName(filterID), withSpec(spec(rule(10)))),
+			},
+			want: want{
+				cr: filter(withExternalName(filterID), withSpec(spec(rule(10))),
+					withStatus(manualv1alpha1.TrafficMirrorFilterObservation{TrafficMirrorFilterID: filterID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RuleMissing": {
+			args: args{
+				client: &fake.MockTrafficMirrorFilterClient{
+					MockDescribe: describe(observed()),
+				},
+				cr: filter(withExternalName(filterID), withSpec(spec(rule(10)))),
+			},
+			want: want{
+				cr: filter(withExternalName(filterID), withSpec(spec(rule(10))),
+					withStatus(manualv1alpha1.TrafficMirrorFilterObservation{TrafficMirrorFilterID: filterID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTrafficMirrorFilterClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeTrafficMirrorFiltersInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorFiltersOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TrafficMirrorFilterIDNotFound}
+					},
+				},
+				cr: filter(withExternalName(filterID), withSpec(spec())),
+			},
+			want: want{
+				cr: filter(withExternalName(filterID), withSpec(spec())),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorFilterClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeTrafficMirrorFiltersInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorFiltersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: filter(withExternalName(filterID), withSpec(spec())),
+			},
+			want: want{
+				cr:  filter(withExternalName(filterID), withSpec(spec())),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceRule": {
+			args: args{
+				cr: filter(withExternalName(filterID), withSpec(spec(rule(20)))),
+			},
+			want: want{
+				calls: []string{"DeleteRule " + ruleID, "CreateRule 20"},
+			},
+		},
+		"NetworkServiceRemoved": {
+			args: args{
+				cr: filter(withExternalName(filterID), withSpec(manualv1alpha1.TrafficMirrorFilterParameters{
+					IngressRules: []manualv1alpha1.TrafficMirrorFilterRule{rule(10)},
+				})),
+			},
+			want: want{
+				calls: []string{"ModifyNetworkServices"},
+			},
+		},
+		"CreateRuleFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorFilterClient{
+					MockDescribe: describe(observed()),
+					MockCreateRule: func(context.Context, *awsec2.CreateTrafficMirrorFilterRuleInput, []func(*awsec2.Options)) (*awsec2.CreateTrafficMirrorFilterRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: filter(withExternalName(filterID), withSpec(spec(rule(10)))),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			client := tc.client
+			if client == nil {
+				client = &fake.MockTrafficMirrorFilterClient{
+					MockDescribe: describe(observed(observedRule(10))),
+					MockModifyNetworkServices: func(context.Context, *awsec2.ModifyTrafficMirrorFilterNetworkServicesInput, []func(*awsec2.Options)) (*awsec2.ModifyTrafficMirrorFilterNetworkServicesOutput, error) {
+						calls = append(calls, "ModifyNetworkServices")
+						return &awsec2.ModifyTrafficMirrorFilterNetworkServicesOutput{}, nil
+					},
+					MockDeleteRule: func(_ context.Context, input *awsec2.DeleteTrafficMirrorFilterRuleInput, _ []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorFilterRuleOutput, error) {
+						calls = append(calls, "DeleteRule "+aws.ToString(input.TrafficMirrorFilterRuleId))
+						return &awsec2.DeleteTrafficMirrorFilterRuleOutput{}, nil
+					},
+					MockCreateRule: func(_ context.Context, input *awsec2.CreateTrafficMirrorFilterRuleInput, _ []func(*awsec2.Options)) (*awsec2.CreateTrafficMirrorFilterRuleOutput, error) {
+						if input.TrafficDirection != awsec2types.TrafficDirectionIngress {
+							return nil, errors.New("unexpected direction")
+						}
+						calls = append(calls, "CreateRule 20")
+						return &awsec2.CreateTrafficMirrorFilterRuleOutput{}, nil
+					},
+				}
+			}
+			e := &external{client: client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		err error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTrafficMirrorFilterClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorFilterInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorFilterOutput, error) {
+						return &awsec2.DeleteTrafficMirrorFilterOutput{}, nil
+					},
+				},
+				cr: filter(withExternalName(filterID), withSpec(spec())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockTrafficMirrorFilterClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorFilterInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorFilterOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TrafficMirrorFilterIDNotFound}
+					},
+				},
+				cr: filter(withExternalName(filterID), withSpec(spec())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorFilterClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorFilterInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorFilterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: filter(withExternalName(filterID), withSpec(spec())),
+			},
+			err: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficmirrorsession
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TrafficMirrorSession resource"
+	errDescribe         = "failed to describe TrafficMirrorSession"
+	errMultipleItems    = "retrieved multiple TrafficMirrorSessions for the given trafficMirrorSessionId"
+	errCreate           = "failed to create the TrafficMirrorSession resource"
+	errModify           = "failed to modify the TrafficMirrorSession resource"
+	errDelete           = "failed to delete the TrafficMirrorSession resource"
+	errCreateTags       = "failed to create tags for the TrafficMirrorSession resource"
+	errDeleteTags       = "failed to delete tags for the TrafficMirrorSession resource"
+)
+
+// SetupTrafficMirrorSession adds a controller that reconciles
+// TrafficMirrorSessions.
+func SetupTrafficMirrorSession(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.TrafficMirrorSessionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.TrafficMirrorSession{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.TrafficMirrorSessionGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewTrafficMirrorSessionClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TrafficMirrorSessionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.TrafficMirrorSession)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.TrafficMirrorSessionClient
+}
+
+// describe returns the observed Traffic Mirror session, or nil if it doesn't
+// exist.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.TrafficMirrorSession) (*awsec2types.TrafficMirrorSession, error) {
+	response, err := e.client.DescribeTrafficMirrorSessions(ctx, &awsec2.DescribeTrafficMirrorSessionsInput{
+		TrafficMirrorSessionIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsTrafficMirrorSessionNotFoundErr, err), errDescribe)
+	}
+	switch len(response.TrafficMirrorSessions) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.TrafficMirrorSessions[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorSession)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeTrafficMirrorSession(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateTrafficMirrorSessionObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsTrafficMirrorSessionUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorSession)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	input := &awsec2.CreateTrafficMirrorSessionInput{
+		ClientToken:           aws.String(string(cr.UID)),
+		Description:           p.Description,
+		NetworkInterfaceId:    p.NetworkInterfaceID,
+		TrafficMirrorTargetId: p.TrafficMirrorTargetID,
+		TrafficMirrorFilterId: p.TrafficMirrorFilterID,
+		SessionNumber:         aws.Int32(p.SessionNumber),
+		PacketLength:          p.PacketLength,
+		VirtualNetworkId:      p.VirtualNetworkID,
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeTrafficMirrorSession,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	out, err := e.client.CreateTrafficMirrorSession(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.TrafficMirrorSession.TrafficMirrorSessionId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorSession)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := cr.Spec.ForProvider
+
+	if ec2.NeedsTrafficMirrorSessionModification(p, *observed) {
+		if _, err := e.client.ModifyTrafficMirrorSession(ctx, ec2.GenerateModifyTrafficMirrorSessionInput(meta.GetExternalName(cr), p)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+		}
+	}
+
+	tagsAdd, tagsRemove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), observed.Tags)
+	if len(tagsRemove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsRemove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(tagsAdd) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsAdd,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorSession)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteTrafficMirrorSession(ctx, &awsec2.DeleteTrafficMirrorSessionInput{
+		TrafficMirrorSessionId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsTrafficMirrorSessionNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficmirrorsession
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	sessionID = "tms-123"
+	eniID     = "eni-123"
+	targetID  = "tmt-123"
+	filterID  = "tmf-123"
+	vni       = int32(42)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.TrafficMirrorSessionClient
+	cr     *manualv1alpha1.TrafficMirrorSession
+}
+
+type sessionModifier func(*manualv1alpha1.TrafficMirrorSession)
+
+func withExternalName(name string) sessionModifier {
+	return func(r *manualv1alpha1.TrafficMirrorSession) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) sessionModifier {
+	return func(r *manualv1alpha1.TrafficMirrorSession) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.TrafficMirrorSessionParameters) sessionModifier {
+	return func(r *manualv1alpha1.TrafficMirrorSession) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.TrafficMirrorSessionObservation) sessionModifier {
+	return func(r *manualv1alpha1.TrafficMirrorSession) { r.Status.AtProvider = s }
+}
+
+func session(m ...sessionModifier) *manualv1alpha1.TrafficMirrorSession {
+	cr := &manualv1alpha1.TrafficMirrorSession{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(sessionNumber int32, virtualNetworkID *int32) manualv1alpha1.TrafficMirrorSessionParameters {
+	return manualv1alpha1.TrafficMirrorSessionParameters{
+		Region:                "us-east-1",
+		NetworkInterfaceID:    aws.String(eniID),
+		TrafficMirrorTargetID: aws.String(targetID),
+		TrafficMirrorFilterID: aws.String(filterID),
+		SessionNumber:         sessionNumber,
+		VirtualNetworkID:      virtualNetworkID,
+	}
+}
+
+func observed() awsec2types.TrafficMirrorSession {
+	return awsec2types.TrafficMirrorSession{
+		TrafficMirrorSessionId: aws.String(sessionID),
+		NetworkInterfaceId:     aws.String(eniID),
+		TrafficMirrorTargetId:  aws.String(targetID),
+		TrafficMirrorFilterId:  aws.String(filterID),
+		SessionNumber:          aws.Int32(1),
+		VirtualNetworkId:       aws.Int32(vni),
+	}
+}
+
+func describe(s awsec2types.TrafficMirrorSession) func(context.Context, *awsec2.DescribeTrafficMirrorSessionsInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorSessionsOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeTrafficMirrorSessionsInput, _ []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorSessionsOutput, error) {
+		if len(input.TrafficMirrorSessionIds) != 1 || input.TrafficMirrorSessionIds[0] != sessionID {
+			return nil, errors.New("unexpected traffic mirror session")
+		}
+		return &awsec2.DescribeTrafficMirrorSessionsOutput{TrafficMirrorSessions: []awsec2types.TrafficMirrorSession{s}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.TrafficMirrorSession
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"LateInitialize": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDescribe: describe(observed()),
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(1, nil))),
+			},
+			want: want{
+				cr: session(withExternalName(sessionID), withSpec(spec(1, aws.Int32(vni))),
+					withStatus(manualv1alpha1.TrafficMirrorSessionObservation{TrafficMirrorSessionID: sessionID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"SessionNumberChanged": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDescribe: describe(observed()),
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(2, aws.Int32(vni)))),
+			},
+			want: want{
+				cr: session(withExternalName(sessionID), withSpec(spec(2, aws.Int32(vni))),
+					withStatus(manualv1alpha1.TrafficMirrorSessionObservation{TrafficMirrorSessionID: sessionID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeTrafficMirrorSessionsInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorSessionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TrafficMirrorSessionIDNotFound}
+					},
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(1, nil))),
+			},
+			want: want{
+				cr: session(withExternalName(sessionID), withSpec(spec(1, nil))),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeTrafficMirrorSessionsInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorSessionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(1, nil))),
+			},
+			want: want{
+				cr:  session(withExternalName(sessionID), withSpec(spec(1, nil))),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		args
+		err error
+	}{
+		"Modified": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDescribe: describe(observed()),
+					MockModify: func(_ context.Context, input *awsec2.ModifyTrafficMirrorSessionInput, _ []func(*awsec2.Options)) (*awsec2.ModifyTrafficMirrorSessionOutput, error) {
+						if aws.ToString(input.TrafficMirrorSessionId) != sessionID || aws.ToInt32(input.SessionNumber) != 2 {
+							return nil, errors.New("unexpected input")
+						}
+						return &awsec2.ModifyTrafficMirrorSessionOutput{}, nil
+					},
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(2, aws.Int32(vni)))),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDescribe: describe(observed()),
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(1, aws.Int32(vni)))),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDescribe: describe(observed()),
+					MockModify: func(context.Context, *awsec2.ModifyTrafficMirrorSessionInput, []func(*awsec2.Options)) (*awsec2.ModifyTrafficMirrorSessionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(2, aws.Int32(vni)))),
+			},
+			err: awsclient.Wrap(errBoom, errModify),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		err error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorSessionInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorSessionOutput, error) {
+						return &awsec2.DeleteTrafficMirrorSessionOutput{}, nil
+					},
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(1, nil))),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorSessionInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorSessionOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TrafficMirrorSessionIDNotFound}
+					},
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(1, nil))),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorSessionClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorSessionInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorSessionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: session(withExternalName(sessionID), withSpec(spec(1, nil))),
+			},
+			err: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficmirrortarget
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TrafficMirrorTarget resource"
+	errDescribe         = "failed to describe TrafficMirrorTarget"
+	errMultipleItems    = "retrieved multiple TrafficMirrorTargets for the given trafficMirrorTargetId"
+	errNoTarget         = "exactly one of networkInterfaceId and networkLoadBalancerArn has to be set"
+	errCreate           = "failed to create the TrafficMirrorTarget resource"
+	errDelete           = "failed to delete the TrafficMirrorTarget resource"
+	errCreateTags       = "failed to create tags for the TrafficMirrorTarget resource"
+	errDeleteTags       = "failed to delete tags for the TrafficMirrorTarget resource"
+)
+
+// SetupTrafficMirrorTarget adds a controller that reconciles
+// TrafficMirrorTargets.
+func SetupTrafficMirrorTarget(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.TrafficMirrorTargetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.TrafficMirrorTarget{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.TrafficMirrorTargetGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewTrafficMirrorTargetClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TrafficMirrorTargetClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.TrafficMirrorTarget)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.TrafficMirrorTargetClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorTarget)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	response, err := e.client.DescribeTrafficMirrorTargets(ctx, &awsec2.DescribeTrafficMirrorTargetsInput{
+		TrafficMirrorTargetIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsTrafficMirrorTargetNotFoundErr, err), errDescribe)
+	}
+	switch len(response.TrafficMirrorTargets) {
+	case 0:
+		return managed.ExternalObservation{}, nil
+	case 1:
+	default:
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := response.TrafficMirrorTargets[0]
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeTrafficMirrorTarget(&cr.Spec.ForProvider, &observed)
+
+	cr.Status.AtProvider = ec2.GenerateTrafficMirrorTargetObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsTrafficMirrorTargetUpToDate(cr.Spec.ForProvider, observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorTarget)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+	if (p.NetworkInterfaceID == nil) == (p.NetworkLoadBalancerARN == nil) {
+		return managed.ExternalCreation{}, errors.New(errNoTarget)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	input := &awsec2.CreateTrafficMirrorTargetInput{
+		ClientToken:            aws.String(string(cr.UID)),
+		Description:            p.Description,
+		NetworkInterfaceId:     p.NetworkInterfaceID,
+		NetworkLoadBalancerArn: p.NetworkLoadBalancerARN,
+	}
+	if len(p.Tags) != 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeTrafficMirrorTarget,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	out, err := e.client.CreateTrafficMirrorTarget(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.TrafficMirrorTarget.TrafficMirrorTargetId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorTarget)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeTrafficMirrorTargets(ctx, &awsec2.DescribeTrafficMirrorTargetsInput{
+		TrafficMirrorTargetIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.TrafficMirrorTargets) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+
+	tagsAdd, tagsRemove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), response.TrafficMirrorTargets[0].Tags)
+	if len(tagsRemove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsRemove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(tagsAdd) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsAdd,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.TrafficMirrorTarget)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteTrafficMirrorTarget(ctx, &awsec2.DeleteTrafficMirrorTargetInput{
+		TrafficMirrorTargetId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsTrafficMirrorTargetNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficmirrortarget
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	targetID = "tmt-123"
+	eniID    = "eni-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.TrafficMirrorTargetClient
+	cr     *manualv1alpha1.TrafficMirrorTarget
+}
+
+type targetModifier func(*manualv1alpha1.TrafficMirrorTarget)
+
+func withExternalName(name string) targetModifier {
+	return func(r *manualv1alpha1.TrafficMirrorTarget) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) targetModifier {
+	return func(r *manualv1alpha1.TrafficMirrorTarget) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.TrafficMirrorTargetParameters) targetModifier {
+	return func(r *manualv1alpha1.TrafficMirrorTarget) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.TrafficMirrorTargetObservation) targetModifier {
+	return func(r *manualv1alpha1.TrafficMirrorTarget) { r.Status.AtProvider = s }
+}
+
+func target(m ...targetModifier) *manualv1alpha1.TrafficMirrorTarget {
+	cr := &manualv1alpha1.TrafficMirrorTarget{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(tags ...manualv1alpha1.Tag) manualv1alpha1.TrafficMirrorTargetParameters {
+	return manualv1alpha1.TrafficMirrorTargetParameters{
+		Region:             "us-east-1",
+		NetworkInterfaceID: aws.String(eniID),
+		Tags:               tags,
+	}
+}
+
+func describe(t awsec2types.TrafficMirrorTarget) func(context.Context, *awsec2.DescribeTrafficMirrorTargetsInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorTargetsOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeTrafficMirrorTargetsInput, _ []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorTargetsOutput, error) {
+		if len(input.TrafficMirrorTargetIds) != 1 || input.TrafficMirrorTargetIds[0] != targetID {
+			return nil, errors.New("unexpected traffic mirror target")
+		}
+		return &awsec2.DescribeTrafficMirrorTargetsOutput{TrafficMirrorTargets: []awsec2types.TrafficMirrorTarget{t}}, nil
+	}
+}
+
+func observed(tags ...awsec2types.Tag) awsec2types.TrafficMirrorTarget {
+	return awsec2types.TrafficMirrorTarget{
+		TrafficMirrorTargetId: aws.String(targetID),
+		NetworkInterfaceId:    aws.String(eniID),
+		Type:                  awsec2types.TrafficMirrorTargetTypeNetworkInterface,
+		Tags:                  tags,
+	}
+}
+
+func observation() manualv1alpha1.TrafficMirrorTargetObservation {
+	return manualv1alpha1.TrafficMirrorTargetObservation{
+		TrafficMirrorTargetID: targetID,
+		Type:                  string(awsec2types.TrafficMirrorTargetTypeNetworkInterface),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.TrafficMirrorTarget
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDescribe: describe(observed()),
+				},
+				cr: target(withExternalName(targetID), withSpec(spec())),
+			},
+			want: want{
+				cr: target(withExternalName(targetID), withSpec(spec()),
+					withStatus(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDescribe: describe(observed()),
+				},
+				cr: target(withExternalName(targetID), withSpec(spec(manualv1alpha1.Tag{Key: "k", Value: "v"}))),
+			},
+			want: want{
+				cr: target(withExternalName(targetID), withSpec(spec(manualv1alpha1.Tag{Key: "k", Value: "v"})),
+					withStatus(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeTrafficMirrorTargetsInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorTargetsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TrafficMirrorTargetIDNotFound}
+					},
+				},
+				cr: target(withExternalName(targetID), withSpec(spec())),
+			},
+			want: want{
+				cr: target(withExternalName(targetID), withSpec(spec())),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeTrafficMirrorTargetsInput, []func(*awsec2.Options)) (*awsec2.DescribeTrafficMirrorTargetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: target(withExternalName(targetID), withSpec(spec())),
+			},
+			want: want{
+				cr:  target(withExternalName(targetID), withSpec(spec())),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TrafficMirrorTarget
+		err error
+	}
+
+	noTarget := manualv1alpha1.TrafficMirrorTargetParameters{Region: "us-east-1"}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockCreate: func(_ context.Context, input *awsec2.CreateTrafficMirrorTargetInput, _ []func(*awsec2.Options)) (*awsec2.CreateTrafficMirrorTargetOutput, error) {
+						if aws.ToString(input.NetworkInterfaceId) != eniID {
+							return nil, errors.New("unexpected network interface")
+						}
+						return &awsec2.CreateTrafficMirrorTargetOutput{TrafficMirrorTarget: &awsec2types.TrafficMirrorTarget{TrafficMirrorTargetId: aws.String(targetID)}}, nil
+					},
+				},
+				cr: target(withSpec(spec())),
+			},
+			want: want{
+				cr: target(withExternalName(targetID), withSpec(spec()), withConditions(xpv1.Creating())),
+			},
+		},
+		"NoTarget": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{},
+				cr:     target(withSpec(noTarget)),
+			},
+			want: want{
+				cr:  target(withSpec(noTarget)),
+				err: errors.New(errNoTarget),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockCreate: func(context.Context, *awsec2.CreateTrafficMirrorTargetInput, []func(*awsec2.Options)) (*awsec2.CreateTrafficMirrorTargetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: target(withSpec(spec())),
+			},
+			want: want{
+				cr:  target(withSpec(spec()), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		args
+		err error
+	}{
+		"AddTags": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDescribe: describe(observed()),
+					MockCreateTags: func(_ context.Context, input *awsec2.CreateTagsInput, _ []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						if len(input.Tags) != 1 || aws.ToString(input.Tags[0].Key) != "k" {
+							return nil, errors.New("unexpected tags")
+						}
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: target(withExternalName(targetID), withSpec(spec(manualv1alpha1.Tag{Key: "k", Value: "v"}))),
+			},
+		},
+		"CreateTagsFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDescribe: describe(observed()),
+					MockCreateTags: func(context.Context, *awsec2.CreateTagsInput, []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: target(withExternalName(targetID), withSpec(spec(manualv1alpha1.Tag{Key: "k", Value: "v"}))),
+			},
+			err: awsclient.Wrap(errBoom, errCreateTags),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		err error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorTargetInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorTargetOutput, error) {
+						return &awsec2.DeleteTrafficMirrorTargetOutput{}, nil
+					},
+				},
+				cr: target(withExternalName(targetID), withSpec(spec())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorTargetInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorTargetOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TrafficMirrorTargetIDNotFound}
+					},
+				},
+				cr: target(withExternalName(targetID), withSpec(spec())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockTrafficMirrorTargetClient{
+					MockDelete: func(context.Context, *awsec2.DeleteTrafficMirrorTargetInput, []func(*awsec2.Options)) (*awsec2.DeleteTrafficMirrorTargetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: target(withExternalName(targetID), withSpec(spec())),
+			},
+			err: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
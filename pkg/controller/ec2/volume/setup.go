@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -34,14 +35,23 @@ import (
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errDescribeModifications = "cannot describe volume modifications"
+
+	modificationStateModifying  = "modifying"
+	modificationStateOptimizing = "optimizing"
+)
+
 // SetupVolume adds a controller that reconciles Volume.
 func SetupVolume(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(svcapitypes.VolumeGroupKind)
 	opts := []option{
 		func(e *external) {
+			u := &updater{client: e.client}
 			e.preCreate = preCreate
 			e.postCreate = postCreate
-			e.postObserve = postObserve
+			e.postObserve = u.postObserve
+			e.isUpToDate = isUpToDate
 			e.filterList = filterList
 		},
 	}
@@ -54,7 +64,8 @@ func SetupVolume(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimit
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.VolumeGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -85,7 +96,11 @@ func postCreate(_ context.Context, cr *svcapitypes.Volume, obj *svcsdk.Volume, c
 	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Volume, obj *svcsdk.DescribeVolumesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+type updater struct {
+	client svcsdkapi.EC2API
+}
+
+func (u *updater) postObserve(ctx context.Context, cr *svcapitypes.Volume, obj *svcsdk.DescribeVolumesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -101,8 +116,52 @@ func postObserve(_ context.Context, cr *svcapitypes.Volume, obj *svcsdk.Describe
 		cr.SetConditions(xpv1.Deleting())
 	}
 
+	// A volume can only be modified again once its previous modification has
+	// completed, so we hold off updating while one is still in progress.
+	if !obs.ResourceUpToDate {
+		modifying, err := u.isModifying(ctx, obj.Volumes[0].VolumeId)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		obs.ResourceUpToDate = modifying
+	}
+
 	obs.ConnectionDetails = managed.ConnectionDetails{
 		"volumeID": []byte(awsclients.StringValue(obj.Volumes[0].VolumeId)),
 	}
 	return obs, nil
 }
+
+// isModifying returns true if the latest modification of the given volume is
+// still in progress.
+func (u *updater) isModifying(ctx context.Context, id *string) (bool, error) {
+	resp, err := u.client.DescribeVolumesModificationsWithContext(ctx, &svcsdk.DescribeVolumesModificationsInput{
+		VolumeIds: []*string{id},
+	})
+	if err != nil {
+		return false, awsclients.Wrap(err, errDescribeModifications)
+	}
+	for _, m := range resp.VolumesModifications {
+		switch awsclients.StringValue(m.ModificationState) {
+		case modificationStateModifying, modificationStateOptimizing:
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isUpToDate checks the attributes that can be changed through ModifyVolume.
+// Attributes that are not specified are left to AWS.
+func isUpToDate(cr *svcapitypes.Volume, obj *svcsdk.DescribeVolumesOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	v := obj.Volumes[0]
+	switch {
+	case p.Size != nil && awsclients.Int64Value(p.Size) != awsclients.Int64Value(v.Size),
+		p.VolumeType != nil && awsclients.StringValue(p.VolumeType) != awsclients.StringValue(v.VolumeType),
+		p.IOPS != nil && awsclients.Int64Value(p.IOPS) != awsclients.Int64Value(v.Iops),
+		p.Throughput != nil && awsclients.Int64Value(p.Throughput) != awsclients.Int64Value(v.Throughput),
+		p.MultiAttachEnabled != nil && awsclients.BoolValue(p.MultiAttachEnabled) != awsclients.BoolValue(v.MultiAttachEnabled):
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	testVolumeID = "vol-1"
+)
+
+func volume(p svcapitypes.VolumeParameters) *svcapitypes.Volume {
+	return &svcapitypes.Volume{Spec: svcapitypes.VolumeSpec{ForProvider: p}}
+}
+
+func described() *svcsdk.DescribeVolumesOutput {
+	return &svcsdk.DescribeVolumesOutput{
+		Volumes: []*svcsdk.Volume{{
+			VolumeId:   aws.String(testVolumeID),
+			State:      aws.String(string(svcapitypes.VolumeState_available)),
+			Size:       aws.Int64(100),
+			VolumeType: aws.String("gp3"),
+			Iops:       aws.Int64(3000),
+			Throughput: aws.Int64(125),
+		}},
+	}
+}
+
+func modifications(states ...string) func(context.Context, *svcsdk.DescribeVolumesModificationsInput, ...request.Option) (*svcsdk.DescribeVolumesModificationsOutput, error) {
+	return func(_ context.Context, in *svcsdk.DescribeVolumesModificationsInput, _ ...request.Option) (*svcsdk.DescribeVolumesModificationsOutput, error) {
+		if len(in.VolumeIds) != 1 || aws.StringValue(in.VolumeIds[0]) != testVolumeID {
+			return nil, errors.New("unexpected volume")
+		}
+		out := &svcsdk.DescribeVolumesModificationsOutput{}
+		for _, s := range states {
+			out.VolumesModifications = append(out.VolumesModifications, &svcsdk.VolumeModification{
+				VolumeId:          aws.String(testVolumeID),
+				ModificationState: aws.String(s),
+			})
+		}
+		return out, nil
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    svcapitypes.VolumeParameters
+		want bool
+	}{
+		"NothingSpecified": {
+			want: true,
+		},
+		"UpToDate": {
+			p: svcapitypes.VolumeParameters{
+				Size:       aws.Int64(100),
+				VolumeType: aws.String("gp3"),
+				IOPS:       aws.Int64(3000),
+				Throughput: aws.Int64(125),
+			},
+			want: true,
+		},
+		"SizeChanged": {
+			p:    svcapitypes.VolumeParameters{Size: aws.Int64(200)},
+			want: false,
+		},
+		"TypeChanged": {
+			p:    svcapitypes.VolumeParameters{VolumeType: aws.String("io2")},
+			want: false,
+		},
+		"IOPSChanged": {
+			p:    svcapitypes.VolumeParameters{IOPS: aws.Int64(6000)},
+			want: false,
+		},
+		"ThroughputChanged": {
+			p:    svcapitypes.VolumeParameters{Throughput: aws.Int64(250)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(volume(tc.p), described())
+			if err != nil {
+				t.Errorf("r: unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		client *fake.MockVolumeClient
+		obs    managed.ExternalObservation
+		want   want
+	}{
+		"UpToDate": {
+			client: &fake.MockVolumeClient{},
+			obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			want: want{obs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{"volumeID": []byte(testVolumeID)},
+			}},
+		},
+		"NeedsModification": {
+			client: &fake.MockVolumeClient{
+				MockDescribeVolumesModificationsWithContext: modifications("completed"),
+			},
+			obs: managed.ExternalObservation{ResourceExists: true},
+			want: want{obs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ConnectionDetails: managed.ConnectionDetails{"volumeID": []byte(testVolumeID)},
+			}},
+		},
+		"ModificationInProgress": {
+			client: &fake.MockVolumeClient{
+				MockDescribeVolumesModificationsWithContext: modifications(modificationStateOptimizing),
+			},
+			obs: managed.ExternalObservation{ResourceExists: true},
+			want: want{obs: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{"volumeID": []byte(testVolumeID)},
+			}},
+		},
+		"DescribeModificationsFailed": {
+			client: &fake.MockVolumeClient{
+				MockDescribeVolumesModificationsWithContext: func(context.Context, *svcsdk.DescribeVolumesModificationsInput, ...request.Option) (*svcsdk.DescribeVolumesModificationsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			obs:  managed.ExternalObservation{ResourceExists: true},
+			want: want{err: aws.Wrap(errBoom, errDescribeModifications)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u := &updater{client: tc.client}
+			obs, err := u.postObserve(context.Background(), volume(svcapitypes.VolumeParameters{}), described(), tc.obs, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
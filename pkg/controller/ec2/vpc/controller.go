@@ -42,19 +42,23 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
 )
 
 const (
 	errUnexpectedObject = "The managed resource is not an VPC resource"
 	errKubeUpdateFailed = "cannot update VPC custom resource"
 
-	errDescribe            = "failed to describe VPC with id"
-	errMultipleItems       = "retrieved multiple VPCs for the given vpcId"
-	errCreate              = "failed to create the VPC resource"
-	errUpdate              = "failed to update VPC resource"
-	errModifyVPCAttributes = "failed to modify the VPC resource attributes"
-	errCreateTags          = "failed to create tags for the VPC resource"
-	errDelete              = "failed to delete the VPC resource"
+	errDescribe              = "failed to describe VPC with id"
+	errMultipleItems         = "retrieved multiple VPCs for the given vpcId"
+	errCreate                = "failed to create the VPC resource"
+	errUpdate                = "failed to update VPC resource"
+	errModifyVPCAttributes   = "failed to modify the VPC resource attributes"
+	errCreateTags            = "failed to create tags for the VPC resource"
+	errAssociateCIDRBlock    = "failed to associate a CIDR block with the VPC resource"
+	errDisassociateCIDRBlock = "failed to disassociate a CIDR block from the VPC resource"
+	errDelete                = "failed to delete the VPC resource"
+	errQuota                 = "cannot check the VPCs per Region service quota"
 )
 
 // SetupVPC adds a controller that reconciles VPCs.
@@ -68,7 +72,8 @@ func SetupVPC(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 		For(&v1beta1.VPC{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.VPCGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithCreationGracePeriod(3*time.Minute),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
@@ -92,12 +97,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	e := &external{client: c.newClientFn(*cfg), kube: c.kube}
+	enabled, err := servicequotas.Enabled(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		e.quota = servicequotas.NewChecker(c.kube, servicequotas.NewClient(*cfg))
+	}
+	return e, nil
 }
 
 type external struct {
 	kube   client.Client
 	client ec2.VPCClient
+	quota  *servicequotas.Checker
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -187,8 +201,14 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	if err := e.quota.Check(ctx, cr, servicequotas.QuotaVPCsPerRegion, e.countVPCs); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errQuota)
+	}
+
 	result, err := e.client.CreateVpc(ctx, &awsec2.CreateVpcInput{
-		CidrBlock:                   aws.String(cr.Spec.ForProvider.CIDRBlock),
+		CidrBlock:                   awsclient.String(cr.Spec.ForProvider.CIDRBlock),
+		Ipv4IpamPoolId:              cr.Spec.ForProvider.Ipv4IPAMPoolID,
+		Ipv4NetmaskLength:           cr.Spec.ForProvider.Ipv4NetmaskLength,
 		Ipv6CidrBlock:               cr.Spec.ForProvider.Ipv6CIDRBlock,
 		AmazonProvidedIpv6CidrBlock: cr.Spec.ForProvider.AmazonProvidedIpv6CIDRBlock,
 		Ipv6Pool:                    cr.Spec.ForProvider.Ipv6Pool,
@@ -203,6 +223,23 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalCreation{}, nil
 }
 
+// countVPCs returns the number of VPCs that exist in the region.
+func (e *external) countVPCs(ctx context.Context) (int, error) {
+	n := 0
+	in := &awsec2.DescribeVpcsInput{}
+	for {
+		o, err := e.client.DescribeVpcs(ctx, in)
+		if err != nil {
+			return 0, awsclient.Wrap(err, errDescribe)
+		}
+		n += len(o.Vpcs)
+		if aws.ToString(o.NextToken) == "" {
+			return n, nil
+		}
+		in.NextToken = o.NextToken
+	}
+}
+
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mgd.(*v1beta1.VPC)
 	if !ok {
@@ -229,6 +266,12 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
+	if cr.Spec.ForProvider.AdditionalCIDRBlocks != nil || aws.ToBool(cr.Spec.ForProvider.AmazonProvidedIpv6CIDRBlock) {
+		if err := e.updateCIDRBlocks(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	// NOTE(muvaf): VPCs can only be tagged after the creation and this request
 	// is idempotent.
 	if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
@@ -246,6 +289,47 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 }
 
+// updateCIDRBlocks associates and disassociates secondary CIDR blocks so that
+// they match the additional CIDR blocks of the supplied VPC, and requests an
+// Amazon-provided IPv6 CIDR block if the VPC asks for one but has none.
+func (e *external) updateCIDRBlocks(ctx context.Context, cr *v1beta1.VPC) error {
+	response, err := e.client.DescribeVpcs(ctx, &awsec2.DescribeVpcsInput{
+		VpcIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.Vpcs) != 1 {
+		return errors.New(errMultipleItems)
+	}
+
+	associate, disassociate := ec2.DiffVPCCIDRBlocks(cr.Spec.ForProvider, response.Vpcs[0])
+	for _, id := range disassociate {
+		if _, err := e.client.DisassociateVpcCidrBlock(ctx, &awsec2.DisassociateVpcCidrBlockInput{
+			AssociationId: aws.String(id),
+		}); err != nil {
+			return awsclient.Wrap(err, errDisassociateCIDRBlock)
+		}
+	}
+	for _, c := range associate {
+		if _, err := e.client.AssociateVpcCidrBlock(ctx, &awsec2.AssociateVpcCidrBlockInput{
+			VpcId:     aws.String(meta.GetExternalName(cr)),
+			CidrBlock: aws.String(c),
+		}); err != nil {
+			return awsclient.Wrap(err, errAssociateCIDRBlock)
+		}
+	}
+	if ec2.NeedsAmazonProvidedIPv6CIDRBlock(cr.Spec.ForProvider, response.Vpcs[0]) {
+		if _, err := e.client.AssociateVpcCidrBlock(ctx, &awsec2.AssociateVpcCidrBlockInput{
+			VpcId:                       aws.String(meta.GetExternalName(cr)),
+			AmazonProvidedIpv6CidrBlock: aws.Bool(true),
+		}); err != nil {
+			return awsclient.Wrap(err, errAssociateCIDRBlock)
+		}
+	}
+	return nil
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1beta1.VPC)
 	if !ok {
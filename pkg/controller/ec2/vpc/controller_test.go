@@ -18,6 +18,7 @@ package vpc
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -28,6 +29,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	servicequotasapi "github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,6 +42,8 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+	sqfake "github.com/crossplane/provider-aws/pkg/clients/servicequotas/fake"
 )
 
 var (
@@ -47,13 +52,23 @@ var (
 	tenancyDefault = "default"
 	enableDNS      = true
 
-	errBoom = errors.New("boom")
+	errBoom          = errors.New("boom")
+	errQuotaExceeded = fmt.Errorf("creating this resource would exceed the %q service quota (vpc/L-F678F1CE): 5 of 5 in use", "VPCs per Region")
 )
 
 type args struct {
-	vpc  ec2.VPCClient
-	kube client.Client
-	cr   *v1beta1.VPC
+	vpc   ec2.VPCClient
+	kube  client.Client
+	quota *servicequotas.Checker
+	cr    *v1beta1.VPC
+}
+
+func withQuota(kube client.Client, limit float64) *servicequotas.Checker {
+	return servicequotas.NewChecker(kube, &sqfake.MockServiceQuotasClient{
+		MockGetServiceQuota: func(_ context.Context, _ *servicequotasapi.GetServiceQuotaInput, _ []func(*servicequotasapi.Options)) (*servicequotasapi.GetServiceQuotaOutput, error) {
+			return &servicequotasapi.GetServiceQuotaOutput{Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(limit)}}, nil
+		},
+	})
 }
 
 type vpcModifier func(*v1beta1.VPC)
@@ -275,6 +290,34 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"SuccessfulFromIPAMPool": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateVpcInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpcOutput, error) {
+						if input.CidrBlock != nil || aws.ToString(input.Ipv4IpamPoolId) != "ipam-pool-123" || aws.ToInt32(input.Ipv4NetmaskLength) != 24 {
+							return nil, errBoom
+						}
+						return &awsec2.CreateVpcOutput{
+							Vpc: &awsec2types.Vpc{
+								VpcId:     aws.String(vpcID),
+								CidrBlock: aws.String(cidr),
+							},
+						}, nil
+					},
+				},
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					Ipv4IPAMPoolID:    aws.String("ipam-pool-123"),
+					Ipv4NetmaskLength: aws.Int32(24),
+				})),
+			},
+			want: want{
+				cr: vpc(withExternalName(vpcID), withSpec(v1beta1.VPCParameters{
+					Ipv4IPAMPoolID:    aws.String("ipam-pool-123"),
+					Ipv4NetmaskLength: aws.Int32(24),
+				})),
+				result: managed.ExternalCreation{},
+			},
+		},
 		"CreateFail": {
 			args: args{
 				vpc: &fake.MockVPCClient{
@@ -289,11 +332,52 @@ func TestCreate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"WithinQuota": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{}}}, nil
+					},
+					MockCreate: func(ctx context.Context, input *awsec2.CreateVpcInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpcOutput, error) {
+						return &awsec2.CreateVpcOutput{
+							Vpc: &awsec2types.Vpc{
+								VpcId:     aws.String(vpcID),
+								CidrBlock: aws.String(cidr),
+							},
+						}, nil
+					},
+				},
+				quota: withQuota(nil, 5),
+				cr:    vpc(),
+			},
+			want: want{
+				cr:     vpc(withExternalName(vpcID)),
+				result: managed.ExternalCreation{},
+			},
+		},
+		"QuotaExceeded": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						if input.NextToken == nil {
+							return &awsec2.DescribeVpcsOutput{Vpcs: make([]awsec2types.Vpc, 3), NextToken: aws.String("next")}, nil
+						}
+						return &awsec2.DescribeVpcsOutput{Vpcs: make([]awsec2types.Vpc, 2)}, nil
+					},
+				},
+				quota: withQuota(&test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)}, 5),
+				cr:    vpc(),
+			},
+			want: want{
+				cr:  vpc(withConditions(servicequotas.Exceeded(errQuotaExceeded))),
+				err: errors.Wrap(errQuotaExceeded, errQuota),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.vpc}
+			e := &external{kube: tc.kube, client: tc.vpc, quota: tc.quota}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -343,6 +427,112 @@ func TestUpdate(t *testing.T) {
 				})),
 			},
 		},
+		"SuccessfulCIDRBlocks": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockModifyTenancy: func(ctx context.Context, input *awsec2.ModifyVpcTenancyInput, opts []func(*awsec2.Options)) (*awsec2.ModifyVpcTenancyOutput, error) {
+						return &awsec2.ModifyVpcTenancyOutput{}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{
+							CidrBlock: aws.String(cidr),
+							CidrBlockAssociationSet: []awsec2types.VpcCidrBlockAssociation{
+								{AssociationId: aws.String("primary"), CidrBlock: aws.String(cidr), CidrBlockState: &awsec2types.VpcCidrBlockState{State: awsec2types.VpcCidrBlockStateCodeAssociated}},
+								{AssociationId: aws.String("stale"), CidrBlock: aws.String("10.1.0.0/16"), CidrBlockState: &awsec2types.VpcCidrBlockState{State: awsec2types.VpcCidrBlockStateCodeAssociated}},
+							},
+						}}}, nil
+					},
+					MockDisassociateCIDRBlock: func(ctx context.Context, input *awsec2.DisassociateVpcCidrBlockInput, opts []func(*awsec2.Options)) (*awsec2.DisassociateVpcCidrBlockOutput, error) {
+						if aws.ToString(input.AssociationId) != "stale" {
+							return nil, errors.New("unexpected association")
+						}
+						return &awsec2.DisassociateVpcCidrBlockOutput{}, nil
+					},
+					MockAssociateCIDRBlock: func(ctx context.Context, input *awsec2.AssociateVpcCidrBlockInput, opts []func(*awsec2.Options)) (*awsec2.AssociateVpcCidrBlockOutput, error) {
+						if aws.ToString(input.CidrBlock) != "100.64.0.0/16" {
+							return nil, errors.New("unexpected CIDR block")
+						}
+						return &awsec2.AssociateVpcCidrBlockOutput{}, nil
+					},
+				},
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock:            cidr,
+					AdditionalCIDRBlocks: []string{"100.64.0.0/16"},
+					InstanceTenancy:      aws.String(tenancyDefault),
+				})),
+			},
+			want: want{
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock:            cidr,
+					AdditionalCIDRBlocks: []string{"100.64.0.0/16"},
+					InstanceTenancy:      aws.String(tenancyDefault),
+				})),
+			},
+		},
+		"SuccessfulAmazonProvidedIPv6CIDRBlock": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockModifyTenancy: func(ctx context.Context, input *awsec2.ModifyVpcTenancyInput, opts []func(*awsec2.Options)) (*awsec2.ModifyVpcTenancyOutput, error) {
+						return &awsec2.ModifyVpcTenancyOutput{}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{
+							CidrBlock: aws.String(cidr),
+							Ipv6CidrBlockAssociationSet: []awsec2types.VpcIpv6CidrBlockAssociation{
+								{AssociationId: aws.String("old"), Ipv6CidrBlockState: &awsec2types.VpcCidrBlockState{State: awsec2types.VpcCidrBlockStateCodeDisassociated}},
+							},
+						}}}, nil
+					},
+					MockAssociateCIDRBlock: func(ctx context.Context, input *awsec2.AssociateVpcCidrBlockInput, opts []func(*awsec2.Options)) (*awsec2.AssociateVpcCidrBlockOutput, error) {
+						if !aws.ToBool(input.AmazonProvidedIpv6CidrBlock) || input.CidrBlock != nil {
+							return nil, errors.New("unexpected association request")
+						}
+						return &awsec2.AssociateVpcCidrBlockOutput{}, nil
+					},
+				},
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock:                   cidr,
+					AmazonProvidedIpv6CIDRBlock: aws.Bool(true),
+					InstanceTenancy:             aws.String(tenancyDefault),
+				})),
+			},
+			want: want{
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock:                   cidr,
+					AmazonProvidedIpv6CIDRBlock: aws.Bool(true),
+					InstanceTenancy:             aws.String(tenancyDefault),
+				})),
+			},
+		},
+		"AssociateCIDRBlockFailed": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{CidrBlock: aws.String(cidr)}}}, nil
+					},
+					MockAssociateCIDRBlock: func(ctx context.Context, input *awsec2.AssociateVpcCidrBlockInput, opts []func(*awsec2.Options)) (*awsec2.AssociateVpcCidrBlockOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock:            cidr,
+					AdditionalCIDRBlocks: []string{"100.64.0.0/16"},
+				})),
+			},
+			want: want{
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock:            cidr,
+					AdditionalCIDRBlocks: []string{"100.64.0.0/16"},
+				})),
+				err: awsclient.Wrap(errBoom, errAssociateCIDRBlock),
+			},
+		},
 		"ModifyFailed": {
 			args: args{
 				vpc: &fake.MockVPCClient{
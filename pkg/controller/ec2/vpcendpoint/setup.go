@@ -42,7 +42,8 @@ func SetupVPCEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		For(&svcapitypes.VPCEndpoint{}).
 		Complete(managed.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.VPCEndpointGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
@@ -158,6 +159,11 @@ sgCompare:
 		return false, nil
 	}
 
+	// Check private DNS, which can only be toggled for interface endpoints
+	if cr.Spec.ForProvider.PrivateDNSEnabled != nil && aws.BoolValue(cr.Spec.ForProvider.PrivateDNSEnabled) != aws.BoolValue(obj.VpcEndpoints[0].PrivateDnsEnabled) {
+		return false, nil
+	}
+
 	// Check policyDocument
 	defaultPolicyEndpoint := aws.String("{\"Statement\":[{\"Action\":\"*\",\"Effect\": \"Allow\",\"Principal\":\"*\",\"Resource\":\"*\"}]}")
 	defaultPolicyGateway := aws.String("{\"Version\":\"2008-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":\"*\",\"Action\":\"*\",\"Resource\":\"*\"}]}")
@@ -175,31 +181,28 @@ sgCompare:
 func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.VPCEndpoint, obj *svcsdk.ModifyVpcEndpointInput) error {
 	obj.VpcEndpointId = awsclients.String(meta.GetExternalName(cr))
 
-	// Add fields to upstream AWS
-	obj.SetAddSecurityGroupIds(cr.Spec.ForProvider.SecurityGroupIDs)
-	obj.SetAddSubnetIds(cr.Spec.ForProvider.SubnetIDs)
-	obj.SetAddRouteTableIds(cr.Spec.ForProvider.RouteTableIDs)
 	obj.SetPolicyDocument(aws.StringValue(cr.Spec.ForProvider.PolicyDocument))
+	obj.PrivateDnsEnabled = cr.Spec.ForProvider.PrivateDNSEnabled
 
-	// Remove fields from upstream AWS
 	upstream, err := e.client.DescribeVpcEndpoints(&svcsdk.DescribeVpcEndpointsInput{VpcEndpointIds: []*string{obj.VpcEndpointId}})
 	if err != nil {
 		return err
 	}
 
+	// Add fields missing from upstream AWS
+	upstreamSGs := make([]*string, len(upstream.VpcEndpoints[0].Groups))
+	for i, g := range upstream.VpcEndpoints[0].Groups {
+		upstreamSGs[i] = g.GroupId
+	}
+	obj.SetAddSecurityGroupIds(listSubtractFromStringPtr(cr.Spec.ForProvider.SecurityGroupIDs, upstreamSGs))
+	obj.SetAddSubnetIds(listSubtractFromStringPtr(cr.Spec.ForProvider.SubnetIDs, upstream.VpcEndpoints[0].SubnetIds))
+	obj.SetAddRouteTableIds(listSubtractFromStringPtr(cr.Spec.ForProvider.RouteTableIDs, upstream.VpcEndpoints[0].RouteTableIds))
+
+	// Remove fields from upstream AWS
 	removeSubnets := listSubtractFromStringPtr(upstream.VpcEndpoints[0].SubnetIds, cr.Spec.ForProvider.SubnetIDs)
 	removeRTs := listSubtractFromStringPtr(upstream.VpcEndpoints[0].RouteTableIds, cr.Spec.ForProvider.RouteTableIDs)
 
-	removeSGs := []*string{}
-sgCompare:
-	for _, upstreamSG := range upstream.VpcEndpoints[0].Groups {
-		for _, crSG := range cr.Spec.ForProvider.SecurityGroupIDs {
-			if aws.StringValue(upstreamSG.GroupId) == aws.StringValue(crSG) {
-				continue sgCompare
-			}
-		}
-		removeSGs = append(removeSGs, upstreamSG.GroupId)
-	}
+	removeSGs := listSubtractFromStringPtr(upstreamSGs, cr.Spec.ForProvider.SecurityGroupIDs)
 
 	obj.SetRemoveSubnetIds(removeSubnets)
 	obj.SetRemoveSecurityGroupIds(removeSGs)
@@ -564,3 +564,152 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		cr   *svcapitypes.VPCEndpoint
+		obj  *ec2.DescribeVpcEndpointsOutput
+		want want
+	}{
+		"UpToDate": {
+			cr: vpcEndpoint(withSpec(v1alpha1.VPCEndpointParameters{
+				PrivateDNSEnabled: aws.Bool(true),
+				CustomVPCEndpointParameters: v1alpha1.CustomVPCEndpointParameters{
+					SecurityGroupIDs: []*string{aws.String(testSecurityGroupID)},
+					SubnetIDs:        []*string{aws.String(testSubnetID2), aws.String(testSubnetID1)},
+				},
+			})),
+			obj: &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []*ec2.VpcEndpoint{{
+				Groups:            []*ec2.SecurityGroupIdentifier{{GroupId: aws.String(testSecurityGroupID)}},
+				SubnetIds:         []*string{aws.String(testSubnetID1), aws.String(testSubnetID2)},
+				PrivateDnsEnabled: aws.Bool(true),
+				PolicyDocument:    aws.String(`{"Statement":[{"Action":"*","Effect":"Allow","Principal":"*","Resource":"*"}]}`),
+			}}},
+			want: want{upToDate: true},
+		},
+		"SubnetRemoved": {
+			cr: vpcEndpoint(withSpec(v1alpha1.VPCEndpointParameters{
+				CustomVPCEndpointParameters: v1alpha1.CustomVPCEndpointParameters{
+					SubnetIDs: []*string{aws.String(testSubnetID1)},
+				},
+			})),
+			obj: &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []*ec2.VpcEndpoint{{
+				SubnetIds: []*string{aws.String(testSubnetID1), aws.String(testSubnetID2)},
+			}}},
+			want: want{upToDate: false},
+		},
+		"SecurityGroupChanged": {
+			cr: vpcEndpoint(withSpec(v1alpha1.VPCEndpointParameters{
+				CustomVPCEndpointParameters: v1alpha1.CustomVPCEndpointParameters{
+					SecurityGroupIDs: []*string{aws.String(testSecurityGroupID)},
+				},
+			})),
+			obj: &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []*ec2.VpcEndpoint{{
+				Groups: []*ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-other")}},
+			}}},
+			want: want{upToDate: false},
+		},
+		"PrivateDNSDisabled": {
+			cr: vpcEndpoint(withSpec(v1alpha1.VPCEndpointParameters{
+				PrivateDNSEnabled: aws.Bool(true),
+			})),
+			obj: &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []*ec2.VpcEndpoint{{
+				PrivateDnsEnabled: aws.Bool(false),
+				PolicyDocument:    aws.String(`{"Statement":[{"Action":"*","Effect":"Allow","Principal":"*","Resource":"*"}]}`),
+			}}},
+			want: want{upToDate: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, err := isUpToDate(tc.cr, tc.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	type want struct {
+		obj *ec2.ModifyVpcEndpointInput
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"MembershipChanged": {
+			args: args{
+				vpcendpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: func(*ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
+						return &ec2.DescribeVpcEndpointsOutput{VpcEndpoints: []*ec2.VpcEndpoint{{
+							VpcEndpointId: aws.String(testVPCEndpointID),
+							SubnetIds:     []*string{aws.String(testSubnetID1)},
+							Groups:        []*ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-other")}},
+						}}}, nil
+					},
+				},
+				cr: vpcEndpoint(
+					withExternalName(testVPCEndpointID),
+					withSpec(v1alpha1.VPCEndpointParameters{
+						PrivateDNSEnabled: aws.Bool(false),
+						CustomVPCEndpointParameters: v1alpha1.CustomVPCEndpointParameters{
+							SecurityGroupIDs: []*string{aws.String(testSecurityGroupID)},
+							SubnetIDs:        []*string{aws.String(testSubnetID1), aws.String(testSubnetID2)},
+						},
+					}),
+				),
+			},
+			want: want{
+				obj: &ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:          aws.String(testVPCEndpointID),
+					PrivateDnsEnabled:      aws.Bool(false),
+					AddSubnetIds:           []*string{aws.String(testSubnetID2)},
+					AddSecurityGroupIds:    []*string{aws.String(testSecurityGroupID)},
+					RemoveSecurityGroupIds: []*string{aws.String("sg-other")},
+				},
+			},
+		},
+		"ErrDescribe": {
+			args: args{
+				vpcendpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpoints: func(*ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
+						return nil, errors.New(testErrDescribeVPCEndpointFailed)
+					},
+				},
+				cr: vpcEndpoint(withExternalName(testVPCEndpointID)),
+			},
+			want: want{
+				obj: (&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId: aws.String(testVPCEndpointID),
+				}).SetPolicyDocument(""),
+				err: errors.New(testErrDescribeVPCEndpointFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &custom{client: tc.args.vpcendpoint}
+			obj := &ec2.ModifyVpcEndpointInput{}
+			err := c.preUpdate(context.Background(), tc.args.cr, obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, obj); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -28,7 +29,12 @@ import (
 )
 
 const (
-	errKubeUpdateFailed = "cannot update VPCEndpointServiceConfiguration"
+	errKubeUpdateFailed       = "cannot update VPCEndpointServiceConfiguration"
+	errDescribePermissions    = "cannot describe VPCEndpointServiceConfiguration permissions"
+	errModifyPermissions      = "cannot modify VPCEndpointServiceConfiguration permissions"
+	errDescribeConnections    = "cannot describe VPCEndpointServiceConfiguration endpoint connections"
+	errAcceptConnections      = "cannot accept VPCEndpointServiceConfiguration endpoint connections"
+	endpointPendingAcceptance = "pendingAcceptance"
 )
 
 // SetupVPCEndpointServiceConfiguration adds a controller that reconciles VPCEndpointServiceConfiguration.
@@ -36,13 +42,14 @@ func SetupVPCEndpointServiceConfiguration(mgr ctrl.Manager, l logging.Logger, rl
 	name := managed.ControllerName(svcapitypes.VPCEndpointServiceConfigurationGroupKind)
 	opts := []option{
 		func(e *external) {
-			e.postObserve = postObserve
+			u := &updater{client: e.client}
+			e.postObserve = u.postObserve
 			e.postCreate = postCreate
 			e.preCreate = preCreate
 			e.filterList = filterList
-			u := &updater{client: e.client}
 			e.delete = u.delete
 			e.preUpdate = u.preUpdate
+			e.postUpdate = u.postUpdate
 			e.isUpToDate = isUpToDate
 			e.lateInitialize = lateInitialize
 		},
@@ -55,7 +62,8 @@ func SetupVPCEndpointServiceConfiguration(mgr ctrl.Manager, l logging.Logger, rl
 		For(&svcapitypes.VPCEndpointServiceConfiguration{}).
 		Complete(managed.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.VPCEndpointServiceConfigurationGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -72,7 +80,7 @@ func filterList(cr *svcapitypes.VPCEndpointServiceConfiguration, obj *svcsdk.Des
 	return resp
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.VPCEndpointServiceConfiguration, obj *svcsdk.DescribeVpcEndpointServiceConfigurationsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (u *updater) postObserve(ctx context.Context, cr *svcapitypes.VPCEndpointServiceConfiguration, obj *svcsdk.DescribeVpcEndpointServiceConfigurationsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -92,9 +100,86 @@ func postObserve(_ context.Context, cr *svcapitypes.VPCEndpointServiceConfigurat
 	cr.Status.AtProvider.ServiceConfiguration.ServiceID = obj.ServiceConfigurations[0].ServiceId
 	cr.Status.AtProvider.ServiceConfiguration.ServiceName = obj.ServiceConfigurations[0].ServiceName
 	cr.Status.AtProvider.ServiceConfiguration.ServiceState = obj.ServiceConfigurations[0].ServiceState
+
+	if cr.Spec.ForProvider.AllowedPrincipals != nil {
+		add, remove, err := u.differencePrincipals(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if len(add) != 0 || len(remove) != 0 {
+			obs.ResourceUpToDate = false
+		}
+	}
+
+	if awsclients.BoolValue(cr.Spec.ForProvider.AcceptanceRequired) && awsclients.BoolValue(cr.Spec.ForProvider.AutoAcceptAllowedPrincipals) {
+		if err := u.acceptAllowedConnections(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
 	return obs, nil
 }
 
+// differencePrincipals returns the principals that need to be allowed and
+// disallowed for the service to match the desired state.
+func (u *updater) differencePrincipals(ctx context.Context, cr *svcapitypes.VPCEndpointServiceConfiguration) ([]*string, []*string, error) {
+	resp, err := u.client.DescribeVpcEndpointServicePermissionsWithContext(ctx, &svcsdk.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: awsclients.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return nil, nil, awsclients.Wrap(err, errDescribePermissions)
+	}
+	current := make([]*string, len(resp.AllowedPrincipals))
+	for i, p := range resp.AllowedPrincipals {
+		current[i] = p.Principal
+	}
+	add, remove := DifferenceARN(cr.Spec.ForProvider.AllowedPrincipals, current)
+	return add, remove, nil
+}
+
+// acceptAllowedConnections accepts the endpoint connections that are pending
+// acceptance and are owned by an account of one of the allowed principals.
+func (u *updater) acceptAllowedConnections(ctx context.Context, cr *svcapitypes.VPCEndpointServiceConfiguration) error {
+	resp, err := u.client.DescribeVpcEndpointConnectionsWithContext(ctx, &svcsdk.DescribeVpcEndpointConnectionsInput{
+		Filters: []*svcsdk.Filter{
+			{Name: aws.String("service-id"), Values: []*string{aws.String(meta.GetExternalName(cr))}},
+			{Name: aws.String("vpc-endpoint-state"), Values: []*string{aws.String(endpointPendingAcceptance)}},
+		},
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errDescribeConnections)
+	}
+
+	var ids []*string
+	for _, c := range resp.VpcEndpointConnections {
+		if awsclients.StringValue(c.VpcEndpointState) == endpointPendingAcceptance && isAllowedAccount(cr.Spec.ForProvider.AllowedPrincipals, awsclients.StringValue(c.VpcEndpointOwner)) {
+			ids = append(ids, c.VpcEndpointId)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err = u.client.AcceptVpcEndpointConnectionsWithContext(ctx, &svcsdk.AcceptVpcEndpointConnectionsInput{
+		ServiceId:      awsclients.String(meta.GetExternalName(cr)),
+		VpcEndpointIds: ids,
+	})
+	return awsclients.Wrap(err, errAcceptConnections)
+}
+
+// isAllowedAccount returns true if the supplied account ID is the account of
+// one of the supplied principal ARNs, or if all principals are allowed.
+func isAllowedAccount(principals []*string, account string) bool {
+	for _, p := range principals {
+		principal := awsclients.StringValue(p)
+		if principal == "*" {
+			return true
+		}
+		if parsed, err := arn.Parse(principal); err == nil && parsed.AccountID == account {
+			return true
+		}
+	}
+	return false
+}
+
 func preCreate(ctx context.Context, cr *svcapitypes.VPCEndpointServiceConfiguration, obj *svcsdk.CreateVpcEndpointServiceConfigurationInput) error {
 	obj.ClientToken = awsclients.String(meta.GetExternalName(cr))
 	obj.GatewayLoadBalancerArns = append(obj.GatewayLoadBalancerArns, cr.Spec.ForProvider.GatewayLoadBalancerARNs...)
@@ -184,6 +269,31 @@ func (u *updater) preUpdate(_ context.Context, cr *svcapitypes.VPCEndpointServic
 	return nil
 }
 
+func (u *updater) postUpdate(ctx context.Context, cr *svcapitypes.VPCEndpointServiceConfiguration, obj *svcsdk.ModifyVpcEndpointServiceConfigurationOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil || cr.Spec.ForProvider.AllowedPrincipals == nil {
+		return upd, err
+	}
+
+	add, remove, err := u.differencePrincipals(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return upd, nil
+	}
+	input := &svcsdk.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId: awsclients.String(meta.GetExternalName(cr)),
+	}
+	if len(add) > 0 {
+		input.AddAllowedPrincipals = add
+	}
+	if len(remove) > 0 {
+		input.RemoveAllowedPrincipals = remove
+	}
+	_, err = u.client.ModifyVpcEndpointServicePermissionsWithContext(ctx, input)
+	return upd, awsclients.Wrap(err, errModifyPermissions)
+}
+
 func (u *updater) delete(ctx context.Context, mg cpresource.Managed) error {
 
 	cr, ok := mg.(*svcapitypes.VPCEndpointServiceConfiguration)
@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpointserviceconfiguration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const (
+	testServiceID      = "vpce-svc-1"
+	testPrincipal      = "arn:aws:iam::123456789012:root"
+	testOtherPrincipal = "arn:aws:iam::210987654321:root"
+	testAccountID      = "123456789012"
+	testOtherAccountID = "210987654321"
+)
+
+func service(p svcapitypes.VPCEndpointServiceConfigurationParameters) *svcapitypes.VPCEndpointServiceConfiguration {
+	cr := &svcapitypes.VPCEndpointServiceConfiguration{}
+	meta.SetExternalName(cr, testServiceID)
+	cr.Spec.ForProvider = p
+	return cr
+}
+
+func described() *svcsdk.DescribeVpcEndpointServiceConfigurationsOutput {
+	return &svcsdk.DescribeVpcEndpointServiceConfigurationsOutput{
+		ServiceConfigurations: []*svcsdk.ServiceConfiguration{{
+			ServiceId:    aws.String(testServiceID),
+			ServiceState: aws.String(string(svcapitypes.ServiceState_Available)),
+		}},
+	}
+}
+
+func permissions(principals ...string) func(context.Context, *svcsdk.DescribeVpcEndpointServicePermissionsInput, ...request.Option) (*svcsdk.DescribeVpcEndpointServicePermissionsOutput, error) {
+	return func(_ context.Context, in *svcsdk.DescribeVpcEndpointServicePermissionsInput, _ ...request.Option) (*svcsdk.DescribeVpcEndpointServicePermissionsOutput, error) {
+		if aws.StringValue(in.ServiceId) != testServiceID {
+			return nil, errors.New("unexpected service")
+		}
+		out := &svcsdk.DescribeVpcEndpointServicePermissionsOutput{}
+		for _, p := range principals {
+			out.AllowedPrincipals = append(out.AllowedPrincipals, &svcsdk.AllowedPrincipal{Principal: aws.String(p)})
+		}
+		return out, nil
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		obs      managed.ExternalObservation
+		accepted []*string
+		err      error
+	}
+	cases := map[string]struct {
+		client *fake.MockVPCEndpointServiceConfigurationClient
+		cr     *svcapitypes.VPCEndpointServiceConfiguration
+		want   want
+	}{
+		"PrincipalsNotManaged": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{},
+			cr:     service(svcapitypes.VPCEndpointServiceConfigurationParameters{}),
+			want:   want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"PrincipalsUpToDate": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{
+				MockDescribeVpcEndpointServicePermissionsWithContext: permissions(testPrincipal),
+			},
+			cr: service(svcapitypes.VPCEndpointServiceConfigurationParameters{
+				CustomVPCEndpointServiceConfigurationParameters: svcapitypes.CustomVPCEndpointServiceConfigurationParameters{
+					AllowedPrincipals: []*string{aws.String(testPrincipal)},
+				},
+			}),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"PrincipalsDiffer": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{
+				MockDescribeVpcEndpointServicePermissionsWithContext: permissions(testOtherPrincipal),
+			},
+			cr: service(svcapitypes.VPCEndpointServiceConfigurationParameters{
+				CustomVPCEndpointServiceConfigurationParameters: svcapitypes.CustomVPCEndpointServiceConfigurationParameters{
+					AllowedPrincipals: []*string{aws.String(testPrincipal)},
+				},
+			}),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"DescribePermissionsFailed": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{
+				MockDescribeVpcEndpointServicePermissionsWithContext: func(context.Context, *svcsdk.DescribeVpcEndpointServicePermissionsInput, ...request.Option) (*svcsdk.DescribeVpcEndpointServicePermissionsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(svcapitypes.VPCEndpointServiceConfigurationParameters{
+				CustomVPCEndpointServiceConfigurationParameters: svcapitypes.CustomVPCEndpointServiceConfigurationParameters{
+					AllowedPrincipals: []*string{aws.String(testPrincipal)},
+				},
+			}),
+			want: want{err: aws.Wrap(errBoom, errDescribePermissions)},
+		},
+		"AcceptsAllowedConnections": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{
+				MockDescribeVpcEndpointServicePermissionsWithContext: permissions(testPrincipal),
+				MockDescribeVpcEndpointConnectionsWithContext: func(context.Context, *svcsdk.DescribeVpcEndpointConnectionsInput, ...request.Option) (*svcsdk.DescribeVpcEndpointConnectionsOutput, error) {
+					return &svcsdk.DescribeVpcEndpointConnectionsOutput{VpcEndpointConnections: []*svcsdk.VpcEndpointConnection{
+						{VpcEndpointId: aws.String("vpce-1"), VpcEndpointOwner: aws.String(testAccountID), VpcEndpointState: aws.String(endpointPendingAcceptance)},
+						{VpcEndpointId: aws.String("vpce-2"), VpcEndpointOwner: aws.String(testOtherAccountID), VpcEndpointState: aws.String(endpointPendingAcceptance)},
+						{VpcEndpointId: aws.String("vpce-3"), VpcEndpointOwner: aws.String(testAccountID), VpcEndpointState: aws.String("available")},
+					}}, nil
+				},
+			},
+			cr: service(svcapitypes.VPCEndpointServiceConfigurationParameters{
+				AcceptanceRequired: aws.Bool(true),
+				CustomVPCEndpointServiceConfigurationParameters: svcapitypes.CustomVPCEndpointServiceConfigurationParameters{
+					AllowedPrincipals:           []*string{aws.String(testPrincipal)},
+					AutoAcceptAllowedPrincipals: aws.Bool(true),
+				},
+			}),
+			want: want{
+				obs:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				accepted: []*string{aws.String("vpce-1")},
+			},
+		},
+		"AcceptanceNotRequired": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{},
+			cr: service(svcapitypes.VPCEndpointServiceConfigurationParameters{
+				AcceptanceRequired: aws.Bool(false),
+				CustomVPCEndpointServiceConfigurationParameters: svcapitypes.CustomVPCEndpointServiceConfigurationParameters{
+					AutoAcceptAllowedPrincipals: aws.Bool(true),
+				},
+			}),
+			want: want{obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var accepted []*string
+			tc.client.MockAcceptVpcEndpointConnectionsWithContext = func(_ context.Context, in *svcsdk.AcceptVpcEndpointConnectionsInput, _ ...request.Option) (*svcsdk.AcceptVpcEndpointConnectionsOutput, error) {
+				accepted = in.VpcEndpointIds
+				return &svcsdk.AcceptVpcEndpointConnectionsOutput{}, nil
+			}
+			u := &updater{client: tc.client}
+			obs, err := u.postObserve(context.Background(), tc.cr, described(), managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.accepted, accepted); diff != "" {
+				t.Errorf("r: -want accepted, +got accepted:\n%s", diff)
+			}
+			if err == nil && !tc.cr.GetCondition(xpv1.TypeReady).Equal(xpv1.Available()) {
+				t.Errorf("r: expected available condition")
+			}
+		})
+	}
+}
+
+func TestPostUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		modified *svcsdk.ModifyVpcEndpointServicePermissionsInput
+		err      error
+	}
+	cases := map[string]struct {
+		client *fake.MockVPCEndpointServiceConfigurationClient
+		cr     *svcapitypes.VPCEndpointServiceConfiguration
+		want   want
+	}{
+		"PrincipalsNotManaged": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{},
+			cr:     service(svcapitypes.VPCEndpointServiceConfigurationParameters{}),
+		},
+		"PrincipalsUpToDate": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{
+				MockDescribeVpcEndpointServicePermissionsWithContext: permissions(testPrincipal),
+			},
+			cr: service(svcapitypes.VPCEndpointServiceConfigurationParameters{
+				CustomVPCEndpointServiceConfigurationParameters: svcapitypes.CustomVPCEndpointServiceConfigurationParameters{
+					AllowedPrincipals: []*string{aws.String(testPrincipal)},
+				},
+			}),
+		},
+		"PrincipalsModified": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{
+				MockDescribeVpcEndpointServicePermissionsWithContext: permissions(testOtherPrincipal),
+			},
+			cr: service(svcapitypes.VPCEndpointServiceConfigurationParameters{
+				CustomVPCEndpointServiceConfigurationParameters: svcapitypes.CustomVPCEndpointServiceConfigurationParameters{
+					AllowedPrincipals: []*string{aws.String(testPrincipal)},
+				},
+			}),
+			want: want{
+				modified: &svcsdk.ModifyVpcEndpointServicePermissionsInput{
+					ServiceId:               aws.String(testServiceID),
+					AddAllowedPrincipals:    []*string{aws.String(testPrincipal)},
+					RemoveAllowedPrincipals: []*string{aws.String(testOtherPrincipal)},
+				},
+			},
+		},
+		"ModifyFailed": {
+			client: &fake.MockVPCEndpointServiceConfigurationClient{
+				MockDescribeVpcEndpointServicePermissionsWithContext: permissions(),
+				MockModifyVpcEndpointServicePermissionsWithContext: func(context.Context, *svcsdk.ModifyVpcEndpointServicePermissionsInput, ...request.Option) (*svcsdk.ModifyVpcEndpointServicePermissionsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(svcapitypes.VPCEndpointServiceConfigurationParameters{
+				CustomVPCEndpointServiceConfigurationParameters: svcapitypes.CustomVPCEndpointServiceConfigurationParameters{
+					AllowedPrincipals: []*string{aws.String(testPrincipal)},
+				},
+			}),
+			want: want{err: aws.Wrap(errBoom, errModifyPermissions)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modified *svcsdk.ModifyVpcEndpointServicePermissionsInput
+			if tc.client.MockModifyVpcEndpointServicePermissionsWithContext == nil {
+				tc.client.MockModifyVpcEndpointServicePermissionsWithContext = func(_ context.Context, in *svcsdk.ModifyVpcEndpointServicePermissionsInput, _ ...request.Option) (*svcsdk.ModifyVpcEndpointServicePermissionsOutput, error) {
+					modified = in
+					return &svcsdk.ModifyVpcEndpointServicePermissionsOutput{}, nil
+				}
+			}
+			u := &updater{client: tc.client}
+			_, err := u.postUpdate(context.Background(), tc.cr, &svcsdk.ModifyVpcEndpointServiceConfigurationOutput{}, managed.ExternalUpdate{}, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified, cmpopts.IgnoreUnexported(svcsdk.ModifyVpcEndpointServicePermissionsInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAllowedAccount(t *testing.T) {
+	cases := map[string]struct {
+		principals []*string
+		account    string
+		want       bool
+	}{
+		"Wildcard":     {principals: []*string{aws.String("*")}, account: testAccountID, want: true},
+		"Root":         {principals: []*string{aws.String(testPrincipal)}, account: testAccountID, want: true},
+		"Role":         {principals: []*string{aws.String("arn:aws:iam::123456789012:role/example")}, account: testAccountID, want: true},
+		"OtherAccount": {principals: []*string{aws.String(testPrincipal)}, account: testOtherAccountID, want: false},
+		"NoneListed":   {account: testAccountID, want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isAllowedAccount(tc.principals, tc.account); got != tc.want {
+				t.Errorf("isAllowedAccount(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
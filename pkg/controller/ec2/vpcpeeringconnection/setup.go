@@ -2,6 +2,7 @@ package vpcpeeringconnection
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -18,8 +19,12 @@ import (
 	"github.com/pkg/errors"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,19 +32,30 @@ import (
 )
 
 const (
-	errKubeUpdateFailed = "cannot update VPCPeeringConnection"
+	errKubeUpdateFailed          = "cannot update VPCPeeringConnection"
+	errGetAccepterProviderConfig = "cannot get accepter ProviderConfig"
+	errCreateAccepterSession     = "cannot create accepter session"
+	errAccept                    = "cannot accept VPCPeeringConnection"
+	errModifyRequesterOptions    = "cannot modify requester peering options"
+	errModifyAccepterOptions     = "cannot modify accepter peering options"
 )
 
+// reasonPendingAcceptance is the reason a peering connection is not ready
+// while it waits to be accepted by the owner of the accepter VPC.
+const reasonPendingAcceptance xpv1.ConditionReason = "PendingAcceptance"
+
 // SetupVPCPeeringConnection adds a controller that reconciles VPCPeeringConnection.
 func SetupVPCPeeringConnection(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(svcapitypes.VPCPeeringConnectionGroupKind)
 	opts := []option{
 		func(e *external) {
 			c := &custom{client: e.client, kube: e.kube}
+			c.newAccepterClient = c.accepterClient
 			e.postObserve = c.postObserve
 			e.postCreate = c.postCreate
 			e.preCreate = preCreate
 			e.isUpToDate = c.isUpToDate
+			e.update = c.update
 			e.filterList = filterList
 		},
 	}
@@ -51,7 +67,8 @@ func SetupVPCPeeringConnection(mgr ctrl.Manager, l logging.Logger, rl workqueue.
 		For(&svcapitypes.VPCPeeringConnection{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.VPCPeeringConnectionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithCreationGracePeriod(3*time.Minute),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
@@ -61,6 +78,37 @@ func SetupVPCPeeringConnection(mgr ctrl.Manager, l logging.Logger, rl workqueue.
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.EC2API
+
+	// newAccepterClient returns a client for the account and region of the
+	// accepter VPC.
+	newAccepterClient func(ctx context.Context, cr *svcapitypes.VPCPeeringConnection) (svcsdkapi.EC2API, error)
+}
+
+func (e *custom) accepterClient(ctx context.Context, cr *svcapitypes.VPCPeeringConnection) (svcsdkapi.EC2API, error) {
+	region := cr.Spec.ForProvider.Region
+	if cr.Spec.ForProvider.PeerRegion != nil {
+		region = aws.StringValue(cr.Spec.ForProvider.PeerRegion)
+	}
+	ref := cr.Spec.ForProvider.AccepterProviderConfigRef
+	if ref == nil {
+		if region == cr.Spec.ForProvider.Region {
+			return e.client, nil
+		}
+		sess, err := awsclients.GetConfigV1(ctx, e.kube, cr, region)
+		if err != nil {
+			return nil, errors.Wrap(err, errCreateAccepterSession)
+		}
+		return svcsdk.New(sess), nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetAccepterProviderConfig)
+	}
+	sess, err := awsclients.UseProviderConfigCredentialsV1(ctx, e.kube, pc, region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateAccepterSession)
+	}
+	return svcsdk.New(sess), nil
 }
 
 func filterList(cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.DescribeVpcPeeringConnectionsOutput) *svcsdk.DescribeVpcPeeringConnectionsOutput {
@@ -75,23 +123,23 @@ func filterList(cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.DescribeVpcPee
 	return resp
 }
 
-func (e *custom) postObserve(_ context.Context, cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.DescribeVpcPeeringConnectionsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.DescribeVpcPeeringConnectionsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	pc := obj.VpcPeeringConnections[0]
 
-	if awsclients.StringValue(obj.VpcPeeringConnections[0].Status.Code) == "pending-acceptance" && cr.Spec.ForProvider.AcceptRequest {
-		req := svcsdk.AcceptVpcPeeringConnectionInput{
-			VpcPeeringConnectionId: awsclients.String(*obj.VpcPeeringConnections[0].VpcPeeringConnectionId),
-		}
-		request, _ := e.client.AcceptVpcPeeringConnectionRequest(&req)
-		err := request.Send()
+	if awsclients.StringValue(pc.Status.Code) == string(svcapitypes.VPCPeeringConnectionStateReasonCode_pending_acceptance) && cr.Spec.ForProvider.AcceptRequest {
+		ac, err := e.newAccepterClient(ctx, cr)
 		if err != nil {
 			return obs, err
 		}
+		if _, err := ac.AcceptVpcPeeringConnectionWithContext(ctx, &svcsdk.AcceptVpcPeeringConnectionInput{VpcPeeringConnectionId: pc.VpcPeeringConnectionId}); err != nil {
+			return obs, errors.Wrap(err, errAccept)
+		}
 	}
 
-	available := setCondition(obj.VpcPeeringConnections[0].Status, cr)
+	available := setCondition(pc, cr)
 	if !available {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
@@ -99,11 +147,34 @@ func (e *custom) postObserve(_ context.Context, cr *svcapitypes.VPCPeeringConnec
 	return obs, nil
 }
 
-func setCondition(code *svcsdk.VpcPeeringConnectionStateReason, cr *svcapitypes.VPCPeeringConnection) bool {
-	switch aws.StringValue(code.Code) {
-	case string(svcapitypes.VPCPeeringConnectionStateReasonCode_pending_acceptance):
+// pendingAcceptance returns a condition that indicates the peering connection
+// is waiting to be accepted, until the supplied time.
+func pendingAcceptance(expires *time.Time) xpv1.Condition {
+	msg := "waiting for the owner of the accepter VPC to accept the peering connection"
+	if expires != nil {
+		msg = fmt.Sprintf("%s before %s", msg, expires.UTC().Format(time.RFC3339))
+	}
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reasonPendingAcceptance,
+		Message:            msg,
+	}
+}
+
+func setCondition(pc *svcsdk.VpcPeeringConnection, cr *svcapitypes.VPCPeeringConnection) bool {
+	switch aws.StringValue(pc.Status.Code) {
+	case string(svcapitypes.VPCPeeringConnectionStateReasonCode_initiating_request),
+		string(svcapitypes.VPCPeeringConnectionStateReasonCode_provisioning):
 		cr.SetConditions(xpv1.Creating())
 		return true
+	case string(svcapitypes.VPCPeeringConnectionStateReasonCode_pending_acceptance):
+		cr.SetConditions(pendingAcceptance(pc.ExpirationTime))
+		return true
+	case string(svcapitypes.VPCPeeringConnectionStateReasonCode_deleting):
+		cr.SetConditions(xpv1.Deleting())
+		return true
 	case string(svcapitypes.VPCPeeringConnectionStateReasonCode_deleted):
 		cr.SetConditions(xpv1.Unavailable())
 		return false
@@ -117,8 +188,57 @@ func setCondition(code *svcsdk.VpcPeeringConnectionStateReason, cr *svcapitypes.
 	return false
 }
 
+// isUpToDate returns whether the peering options are as desired. They can only
+// be modified once the peering connection is active.
 func (e *custom) isUpToDate(cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.DescribeVpcPeeringConnectionsOutput) (bool, error) {
-	return true, nil
+	pc := obj.VpcPeeringConnections[0]
+	if aws.StringValue(pc.Status.Code) != string(svcapitypes.VPCPeeringConnectionStateReasonCode_active) {
+		return true, nil
+	}
+	return optionsUpToDate(cr.Spec.ForProvider.RequesterPeeringOptions, pc.RequesterVpcInfo) &&
+		optionsUpToDate(cr.Spec.ForProvider.AccepterPeeringOptions, pc.AccepterVpcInfo), nil
+}
+
+func optionsUpToDate(o *svcapitypes.VPCPeeringConnectionOptions, info *svcsdk.VpcPeeringConnectionVpcInfo) bool {
+	if o == nil || o.AllowDNSResolutionFromRemoteVPC == nil {
+		return true
+	}
+	observed := &svcsdk.VpcPeeringConnectionOptionsDescription{}
+	if info != nil && info.PeeringOptions != nil {
+		observed = info.PeeringOptions
+	}
+	return aws.BoolValue(o.AllowDNSResolutionFromRemoteVPC) == aws.BoolValue(observed.AllowDnsResolutionFromRemoteVpc)
+}
+
+// update modifies the peering options of the requester and accepter VPCs. The
+// options of each VPC must be modified by its owner.
+func (e *custom) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := aws.String(meta.GetExternalName(cr))
+	if o := cr.Spec.ForProvider.RequesterPeeringOptions; o != nil {
+		if _, err := e.client.ModifyVpcPeeringConnectionOptionsWithContext(ctx, &svcsdk.ModifyVpcPeeringConnectionOptionsInput{
+			VpcPeeringConnectionId:            id,
+			RequesterPeeringConnectionOptions: &svcsdk.PeeringConnectionOptionsRequest{AllowDnsResolutionFromRemoteVpc: o.AllowDNSResolutionFromRemoteVPC},
+		}); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyRequesterOptions)
+		}
+	}
+	if o := cr.Spec.ForProvider.AccepterPeeringOptions; o != nil {
+		ac, err := e.newAccepterClient(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if _, err := ac.ModifyVpcPeeringConnectionOptionsWithContext(ctx, &svcsdk.ModifyVpcPeeringConnectionOptionsInput{
+			VpcPeeringConnectionId:           id,
+			AccepterPeeringConnectionOptions: &svcsdk.PeeringConnectionOptionsRequest{AllowDnsResolutionFromRemoteVpc: o.AllowDNSResolutionFromRemoteVPC},
+		}); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyAccepterOptions)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
 }
 
 func preCreate(ctx context.Context, cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.CreateVpcPeeringConnectionInput) error {
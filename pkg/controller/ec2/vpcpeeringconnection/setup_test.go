@@ -0,0 +1,320 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+const testPeeringConnectionID = "pcx-1"
+
+type peeringModifier func(*svcapitypes.VPCPeeringConnection)
+
+func withSpec(p svcapitypes.VPCPeeringConnectionParameters) peeringModifier {
+	return func(cr *svcapitypes.VPCPeeringConnection) { cr.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) peeringModifier {
+	return func(cr *svcapitypes.VPCPeeringConnection) { cr.Status.SetConditions(c...) }
+}
+
+func peering(m ...peeringModifier) *svcapitypes.VPCPeeringConnection {
+	cr := &svcapitypes.VPCPeeringConnection{}
+	meta.SetExternalName(cr, testPeeringConnectionID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func described(code string, requester, accepter *bool) *svcsdk.DescribeVpcPeeringConnectionsOutput {
+	return &svcsdk.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: []*svcsdk.VpcPeeringConnection{{
+		VpcPeeringConnectionId: aws.String(testPeeringConnectionID),
+		Status:                 &svcsdk.VpcPeeringConnectionStateReason{Code: aws.String(code)},
+		RequesterVpcInfo:       &svcsdk.VpcPeeringConnectionVpcInfo{PeeringOptions: &svcsdk.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: requester}},
+		AccepterVpcInfo:        &svcsdk.VpcPeeringConnectionVpcInfo{PeeringOptions: &svcsdk.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: accepter}},
+	}}}
+}
+
+// accepter returns a function that returns the supplied client as the
+// accepter client.
+func accepter(c svcsdkapi.EC2API) func(context.Context, *svcapitypes.VPCPeeringConnection) (svcsdkapi.EC2API, error) {
+	return func(context.Context, *svcapitypes.VPCPeeringConnection) (svcsdkapi.EC2API, error) { return c, nil }
+}
+
+func TestPostObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	expires := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		requester *fake.MockVPCPeeringConnectionClient
+		accepter  *fake.MockVPCPeeringConnectionClient
+		cr        *svcapitypes.VPCPeeringConnection
+		obj       *svcsdk.DescribeVpcPeeringConnectionsOutput
+	}
+	type want struct {
+		cr  *svcapitypes.VPCPeeringConnection
+		obs managed.ExternalObservation
+		// accepted is whether the accepter was asked to accept.
+		accepted bool
+		err      error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"AcceptedByAccepter": {
+			args: args{
+				requester: &fake.MockVPCPeeringConnectionClient{},
+				accepter: &fake.MockVPCPeeringConnectionClient{
+					MockAcceptVpcPeeringConnectionWithContext: func(_ context.Context, in *svcsdk.AcceptVpcPeeringConnectionInput, _ ...request.Option) (*svcsdk.AcceptVpcPeeringConnectionOutput, error) {
+						if aws.StringValue(in.VpcPeeringConnectionId) != testPeeringConnectionID {
+							return nil, errors.New("unexpected peering connection")
+						}
+						return &svcsdk.AcceptVpcPeeringConnectionOutput{}, nil
+					},
+				},
+				cr: peering(withSpec(svcapitypes.VPCPeeringConnectionParameters{
+					CustomVPCPeeringConnectionParameters: svcapitypes.CustomVPCPeeringConnectionParameters{AcceptRequest: true},
+				})),
+				obj: func() *svcsdk.DescribeVpcPeeringConnectionsOutput {
+					o := described("pending-acceptance", nil, nil)
+					o.VpcPeeringConnections[0].ExpirationTime = &expires
+					return o
+				}(),
+			},
+			want: want{
+				cr: peering(withSpec(svcapitypes.VPCPeeringConnectionParameters{
+					CustomVPCPeeringConnectionParameters: svcapitypes.CustomVPCPeeringConnectionParameters{AcceptRequest: true},
+				}), withConditions(pendingAcceptance(&expires))),
+				obs:      managed.ExternalObservation{ResourceExists: true},
+				accepted: true,
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				requester: &fake.MockVPCPeeringConnectionClient{},
+				accepter:  &fake.MockVPCPeeringConnectionClient{},
+				cr:        peering(),
+				obj:       described("pending-acceptance", nil, nil),
+			},
+			want: want{
+				cr:  peering(withConditions(pendingAcceptance(nil))),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"AcceptFailed": {
+			args: args{
+				requester: &fake.MockVPCPeeringConnectionClient{},
+				accepter: &fake.MockVPCPeeringConnectionClient{
+					MockAcceptVpcPeeringConnectionWithContext: func(context.Context, *svcsdk.AcceptVpcPeeringConnectionInput, ...request.Option) (*svcsdk.AcceptVpcPeeringConnectionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: peering(withSpec(svcapitypes.VPCPeeringConnectionParameters{
+					CustomVPCPeeringConnectionParameters: svcapitypes.CustomVPCPeeringConnectionParameters{AcceptRequest: true},
+				})),
+				obj: described("pending-acceptance", nil, nil),
+			},
+			want: want{
+				cr: peering(withSpec(svcapitypes.VPCPeeringConnectionParameters{
+					CustomVPCPeeringConnectionParameters: svcapitypes.CustomVPCPeeringConnectionParameters{AcceptRequest: true},
+				})),
+				obs:      managed.ExternalObservation{ResourceExists: true},
+				accepted: true,
+				err:      errors.Wrap(errBoom, errAccept),
+			},
+		},
+		"Provisioning": {
+			args: args{
+				requester: &fake.MockVPCPeeringConnectionClient{},
+				accepter:  &fake.MockVPCPeeringConnectionClient{},
+				cr:        peering(),
+				obj:       described("provisioning", nil, nil),
+			},
+			want: want{
+				cr:  peering(withConditions(xpv1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			accepted := false
+			if fn := tc.args.accepter.MockAcceptVpcPeeringConnectionWithContext; fn != nil {
+				tc.args.accepter.MockAcceptVpcPeeringConnectionWithContext = func(ctx context.Context, in *svcsdk.AcceptVpcPeeringConnectionInput, opts ...request.Option) (*svcsdk.AcceptVpcPeeringConnectionOutput, error) {
+					accepted = true
+					return fn(ctx, in, opts...)
+				}
+			}
+			e := &custom{kube: &test.MockClient{}, client: tc.args.requester, newAccepterClient: accepter(tc.args.accepter)}
+			obs, err := e.postObserve(context.Background(), tc.args.cr, tc.args.obj, managed.ExternalObservation{ResourceExists: true}, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.accepted, accepted); diff != "" {
+				t.Errorf("accepted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreTypes(xpv1.Condition{}.LastTransitionTime)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	options := func(requester, accepter *bool) svcapitypes.VPCPeeringConnectionParameters {
+		p := svcapitypes.VPCPeeringConnectionParameters{}
+		if requester != nil {
+			p.RequesterPeeringOptions = &svcapitypes.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: requester}
+		}
+		if accepter != nil {
+			p.AccepterPeeringOptions = &svcapitypes.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: accepter}
+		}
+		return p
+	}
+	cases := map[string]struct {
+		cr   *svcapitypes.VPCPeeringConnection
+		obj  *svcsdk.DescribeVpcPeeringConnectionsOutput
+		want bool
+	}{
+		"UpToDate": {
+			cr:   peering(withSpec(options(aws.Bool(true), aws.Bool(false)))),
+			obj:  described("active", aws.Bool(true), nil),
+			want: true,
+		},
+		"RequesterOptionsDiffer": {
+			cr:   peering(withSpec(options(aws.Bool(true), nil))),
+			obj:  described("active", aws.Bool(false), aws.Bool(true)),
+			want: false,
+		},
+		"AccepterOptionsDiffer": {
+			cr:   peering(withSpec(options(nil, aws.Bool(true)))),
+			obj:  described("active", aws.Bool(true), nil),
+			want: false,
+		},
+		"NotActive": {
+			cr:   peering(withSpec(options(aws.Bool(true), aws.Bool(true)))),
+			obj:  described("pending-acceptance", nil, nil),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := (&custom{}).isUpToDate(tc.cr, tc.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+	// modified records the options each client was asked to modify.
+	modified := func(requester, accepter **svcsdk.PeeringConnectionOptionsRequest, err error) func(context.Context, *svcsdk.ModifyVpcPeeringConnectionOptionsInput, ...request.Option) (*svcsdk.ModifyVpcPeeringConnectionOptionsOutput, error) {
+		return func(_ context.Context, in *svcsdk.ModifyVpcPeeringConnectionOptionsInput, _ ...request.Option) (*svcsdk.ModifyVpcPeeringConnectionOptionsOutput, error) {
+			if in.RequesterPeeringConnectionOptions != nil {
+				*requester = in.RequesterPeeringConnectionOptions
+			}
+			if in.AccepterPeeringConnectionOptions != nil {
+				*accepter = in.AccepterPeeringConnectionOptions
+			}
+			return &svcsdk.ModifyVpcPeeringConnectionOptionsOutput{}, err
+		}
+	}
+
+	type want struct {
+		requester *svcsdk.PeeringConnectionOptionsRequest
+		accepter  *svcsdk.PeeringConnectionOptionsRequest
+		err       error
+	}
+	cases := map[string]struct {
+		accepterErr error
+		want        want
+	}{
+		"Modified": {
+			want: want{
+				requester: &svcsdk.PeeringConnectionOptionsRequest{AllowDnsResolutionFromRemoteVpc: aws.Bool(true)},
+				accepter:  &svcsdk.PeeringConnectionOptionsRequest{AllowDnsResolutionFromRemoteVpc: aws.Bool(false)},
+			},
+		},
+		"AccepterFailed": {
+			accepterErr: errBoom,
+			want: want{
+				requester: &svcsdk.PeeringConnectionOptionsRequest{AllowDnsResolutionFromRemoteVpc: aws.Bool(true)},
+				accepter:  &svcsdk.PeeringConnectionOptionsRequest{AllowDnsResolutionFromRemoteVpc: aws.Bool(false)},
+				err:       errors.Wrap(errBoom, errModifyAccepterOptions),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var requester, accepterOpts, ignored *svcsdk.PeeringConnectionOptionsRequest
+			e := &custom{
+				kube: &test.MockClient{},
+				// Each side must only be modified by the owner of its VPC.
+				client: &fake.MockVPCPeeringConnectionClient{MockModifyVpcPeeringConnectionOptionsWithContext: modified(&requester, &ignored, nil)},
+				newAccepterClient: accepter(&fake.MockVPCPeeringConnectionClient{
+					MockModifyVpcPeeringConnectionOptionsWithContext: modified(&ignored, &accepterOpts, tc.accepterErr),
+				}),
+			}
+			cr := peering(withSpec(svcapitypes.VPCPeeringConnectionParameters{
+				CustomVPCPeeringConnectionParameters: svcapitypes.CustomVPCPeeringConnectionParameters{
+					RequesterPeeringOptions: &svcapitypes.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: aws.Bool(true)},
+					AccepterPeeringOptions:  &svcapitypes.VPCPeeringConnectionOptions{AllowDNSResolutionFromRemoteVPC: aws.Bool(false)},
+				},
+			}))
+			_, err := e.update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.requester, requester); diff != "" {
+				t.Errorf("requester: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.accepter, accepterOpts); diff != "" {
+				t.Errorf("accepter: -want, +got:\n%s", diff)
+			}
+			if ignored != nil {
+				t.Errorf("options modified by the owner of the other VPC: %v", ignored)
+			}
+		})
+	}
+}
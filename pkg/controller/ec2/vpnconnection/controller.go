@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpnconnection
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPNConnection resource"
+	errDescribe         = "failed to describe VPNConnection"
+	errMultipleItems    = "retrieved multiple VPNConnections for the given vpnConnectionId"
+	errCreate           = "failed to create the VPNConnection resource"
+	errCreateRoute      = "failed to create a static route of the VPNConnection"
+	errDeleteRoute      = "failed to delete a static route of the VPNConnection"
+	errModifyTunnel     = "failed to modify the tunnel options of the VPNConnection"
+	errDelete           = "failed to delete the VPNConnection resource"
+	errCreateTags       = "failed to create tags for the VPNConnection resource"
+	errDeleteTags       = "failed to delete tags for the VPNConnection resource"
+)
+
+// SetupVPNConnection adds a controller that reconciles VPNConnections.
+func SetupVPNConnection(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.VPNConnectionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.VPNConnection{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.VPNConnectionGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPNConnectionClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPNConnectionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.VPNConnection)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VPNConnectionClient
+}
+
+// describe returns the observed VPN connection, or nil if it doesn't exist
+// anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.VPNConnection) (*awsec2types.VpnConnection, error) {
+	response, err := e.client.DescribeVpnConnections(ctx, &awsec2.DescribeVpnConnectionsInput{
+		VpnConnectionIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsVPNConnectionNotFoundErr, err), errDescribe)
+	}
+	switch len(response.VpnConnections) {
+	case 0:
+		return nil, nil
+	case 1:
+		if response.VpnConnections[0].State == awsec2types.VpnStateDeleted {
+			return nil, nil
+		}
+		return &response.VpnConnections[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPNConnection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPNConnection(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateVPNConnectionObservation(*observed)
+	switch observed.State {
+	case awsec2types.VpnStateAvailable:
+		cr.SetConditions(xpv1.Available())
+	case awsec2types.VpnStatePending:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.VpnStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// Routes and tunnels can only be modified once the connection is
+	// available.
+	upToDate := observed.State != awsec2types.VpnStateAvailable ||
+		ec2.IsVPNConnectionUpToDate(cr.Spec.ForProvider, *observed)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       ec2.GetVPNConnectionDetails(*observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPNConnection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	keys, err := ec2.GetVPNTunnelPreSharedKeys(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	out, err := e.client.CreateVpnConnection(ctx, ec2.GenerateCreateVPNConnectionInput(cr.Spec.ForProvider, keys))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.VpnConnection.VpnConnectionId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*manualv1alpha1.VPNConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	add, remove := ec2.DiffVPNConnectionRoutes(cr.Spec.ForProvider, observed.Routes)
+	for _, cidr := range remove {
+		if _, err := e.client.DeleteVpnConnectionRoute(ctx, &awsec2.DeleteVpnConnectionRouteInput{
+			VpnConnectionId:      aws.String(meta.GetExternalName(cr)),
+			DestinationCidrBlock: aws.String(cidr),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteRoute)
+		}
+	}
+	for _, cidr := range add {
+		if _, err := e.client.CreateVpnConnectionRoute(ctx, &awsec2.CreateVpnConnectionRouteInput{
+			VpnConnectionId:      aws.String(meta.GetExternalName(cr)),
+			DestinationCidrBlock: aws.String(cidr),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateRoute)
+		}
+	}
+
+	// ec2 only allows one tunnel of a connection to be modified at a time,
+	// so the other one is modified once the connection is available again.
+	if modify := ec2.GenerateModifyVPNTunnelOptionsInputs(cr.Spec.ForProvider, *observed); len(modify) > 0 {
+		if _, err := e.client.ModifyVpnTunnelOptions(ctx, modify[0]); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyTunnel)
+		}
+	}
+
+	tagsAdd, tagsRemove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(tagsRemove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsRemove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(tagsAdd) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      tagsAdd,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.VPNConnection)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteVpnConnection(ctx, &awsec2.DeleteVpnConnectionInput{
+		VpnConnectionId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsVPNConnectionNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,521 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpnconnection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	connectionID      = "vpn-123"
+	customerGatewayID = "cgw-123"
+	vpnGatewayID      = "vgw-123"
+	outsideIPA        = "203.0.113.1"
+	outsideIPB        = "203.0.113.2"
+	cidrA             = "10.0.0.0/16"
+	cidrB             = "10.1.0.0/16"
+	configuration     = "<vpn_connection/>"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	kube   client.Client
+	client ec2.VPNConnectionClient
+	cr     *manualv1alpha1.VPNConnection
+}
+
+type vpnConnectionModifier func(*manualv1alpha1.VPNConnection)
+
+func withExternalName(name string) vpnConnectionModifier {
+	return func(r *manualv1alpha1.VPNConnection) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) vpnConnectionModifier {
+	return func(r *manualv1alpha1.VPNConnection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.VPNConnectionParameters) vpnConnectionModifier {
+	return func(r *manualv1alpha1.VPNConnection) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.VPNConnectionObservation) vpnConnectionModifier {
+	return func(r *manualv1alpha1.VPNConnection) { r.Status.AtProvider = s }
+}
+
+func vpnConnection(m ...vpnConnectionModifier) *manualv1alpha1.VPNConnection {
+	cr := &manualv1alpha1.VPNConnection{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// params returns the parameters of a static VPN connection once they have
+// been late-initialized from observed().
+func params(routes ...string) manualv1alpha1.VPNConnectionParameters {
+	return manualv1alpha1.VPNConnectionParameters{
+		Type:              "ipsec.1",
+		CustomerGatewayID: aws.String(customerGatewayID),
+		VPNGatewayID:      aws.String(vpnGatewayID),
+		StaticRoutesOnly:  aws.Bool(true),
+		Routes:            routes,
+		TunnelOptions: []manualv1alpha1.VPNTunnelOptions{
+			{DPDTimeoutSeconds: aws.Int32(30)},
+			{DPDTimeoutSeconds: aws.Int32(30)},
+		},
+	}
+}
+
+func observed(state awsec2types.VpnState, routes ...string) awsec2types.VpnConnection {
+	c := awsec2types.VpnConnection{
+		VpnConnectionId:              aws.String(connectionID),
+		CustomerGatewayId:            aws.String(customerGatewayID),
+		VpnGatewayId:                 aws.String(vpnGatewayID),
+		Type:                         awsec2types.GatewayTypeIpsec1,
+		State:                        state,
+		CustomerGatewayConfiguration: aws.String(configuration),
+		Options: &awsec2types.VpnConnectionOptions{
+			StaticRoutesOnly: aws.Bool(true),
+			TunnelOptions: []awsec2types.TunnelOption{
+				{OutsideIpAddress: aws.String(outsideIPB), DpdTimeoutSeconds: aws.Int32(30)},
+				{OutsideIpAddress: aws.String(outsideIPA), DpdTimeoutSeconds: aws.Int32(30)},
+			},
+		},
+		VgwTelemetry: []awsec2types.VgwTelemetry{{
+			OutsideIpAddress:   aws.String(outsideIPA),
+			Status:             awsec2types.TelemetryStatusUp,
+			AcceptedRouteCount: aws.Int32(2),
+		}},
+	}
+	for _, r := range routes {
+		c.Routes = append(c.Routes, awsec2types.VpnStaticRoute{
+			DestinationCidrBlock: aws.String(r),
+			Source:               awsec2types.VpnStaticRouteSourceStatic,
+			State:                awsec2types.VpnStateAvailable,
+		})
+	}
+	return c
+}
+
+func observation(state awsec2types.VpnState, routes ...string) manualv1alpha1.VPNConnectionObservation {
+	o := manualv1alpha1.VPNConnectionObservation{
+		VPNConnectionID: connectionID,
+		State:           string(state),
+		VGWTelemetry: []manualv1alpha1.VGWTelemetry{{
+			OutsideIPAddress:   outsideIPA,
+			Status:             string(awsec2types.TelemetryStatusUp),
+			AcceptedRouteCount: 2,
+		}},
+	}
+	for _, r := range routes {
+		o.Routes = append(o.Routes, manualv1alpha1.VPNStaticRoute{
+			DestinationCIDRBlock: r,
+			Source:               string(awsec2types.VpnStaticRouteSourceStatic),
+			State:                string(awsec2types.VpnStateAvailable),
+		})
+	}
+	return o
+}
+
+func describe(c awsec2types.VpnConnection) func(context.Context, *awsec2.DescribeVpnConnectionsInput, []func(*awsec2.Options)) (*awsec2.DescribeVpnConnectionsOutput, error) {
+	return func(context.Context, *awsec2.DescribeVpnConnectionsInput, []func(*awsec2.Options)) (*awsec2.DescribeVpnConnectionsOutput, error) {
+		return &awsec2.DescribeVpnConnectionsOutput{VpnConnections: []awsec2types.VpnConnection{c}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.VPNConnection
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDescribe: describe(observed(awsec2types.VpnStateAvailable, cidrA)),
+				},
+				cr: vpnConnection(withExternalName(connectionID), withSpec(params(cidrA))),
+			},
+			want: want{
+				cr: vpnConnection(withExternalName(connectionID), withSpec(params(cidrA)),
+					withStatus(observation(awsec2types.VpnStateAvailable, cidrA)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ec2.CustomerGatewayConfigurationKey: []byte(configuration),
+					},
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDescribe: describe(observed(awsec2types.VpnStatePending)),
+				},
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: vpnConnection(withExternalName(connectionID), withSpec(params()),
+					withStatus(observation(awsec2types.VpnStatePending)),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ec2.CustomerGatewayConfigurationKey: []byte(configuration),
+					},
+				},
+			},
+		},
+		"RouteMissing": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDescribe: describe(observed(awsec2types.VpnStateAvailable, cidrA)),
+				},
+				cr: vpnConnection(withExternalName(connectionID), withSpec(params(cidrA, cidrB))),
+			},
+			want: want{
+				cr: vpnConnection(withExternalName(connectionID), withSpec(params(cidrA, cidrB)),
+					withStatus(observation(awsec2types.VpnStateAvailable, cidrA)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						ec2.CustomerGatewayConfigurationKey: []byte(configuration),
+					},
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDescribe: describe(observed(awsec2types.VpnStateDeleted)),
+				},
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeVpnConnectionsInput, []func(*awsec2.Options)) (*awsec2.DescribeVpnConnectionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPNConnectionIDNotFound}
+					},
+				},
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeVpnConnectionsInput, []func(*awsec2.Options)) (*awsec2.DescribeVpnConnectionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr:  vpnConnection(withExternalName(connectionID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	secretRef := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "psk", Namespace: "default"},
+		Key:             "key",
+	}
+
+	type want struct {
+		cr    *manualv1alpha1.VPNConnection
+		input *awsec2.CreateVpnConnectionInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"key": []byte("secret")}
+						return nil
+					},
+				},
+				cr: vpnConnection(withSpec(manualv1alpha1.VPNConnectionParameters{
+					Type:              "ipsec.1",
+					CustomerGatewayID: aws.String(customerGatewayID),
+					VPNGatewayID:      aws.String(vpnGatewayID),
+					TunnelOptions:     []manualv1alpha1.VPNTunnelOptions{{PreSharedKeySecretRef: secretRef}},
+				})),
+			},
+			want: want{
+				cr: vpnConnection(withExternalName(connectionID), withSpec(manualv1alpha1.VPNConnectionParameters{
+					Type:              "ipsec.1",
+					CustomerGatewayID: aws.String(customerGatewayID),
+					VPNGatewayID:      aws.String(vpnGatewayID),
+					TunnelOptions:     []manualv1alpha1.VPNTunnelOptions{{PreSharedKeySecretRef: secretRef}},
+				}), withConditions(xpv1.Creating())),
+				input: &awsec2.CreateVpnConnectionInput{
+					Type:              aws.String("ipsec.1"),
+					CustomerGatewayId: aws.String(customerGatewayID),
+					VpnGatewayId:      aws.String(vpnGatewayID),
+					Options: &awsec2types.VpnConnectionOptionsSpecification{
+						TunnelOptions: []awsec2types.VpnTunnelOptionsSpecification{{PreSharedKey: aws.String("secret")}},
+					},
+				},
+			},
+		},
+		"GetSecretFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: vpnConnection(withSpec(manualv1alpha1.VPNConnectionParameters{
+					TunnelOptions: []manualv1alpha1.VPNTunnelOptions{{PreSharedKeySecretRef: secretRef}},
+				})),
+			},
+			want: want{
+				cr: vpnConnection(withSpec(manualv1alpha1.VPNConnectionParameters{
+					TunnelOptions: []manualv1alpha1.VPNTunnelOptions{{PreSharedKeySecretRef: secretRef}},
+				}), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, "cannot get the pre-shared key secret of the VPN tunnel"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsec2.CreateVpnConnectionInput
+			e := &external{kube: tc.kube, client: &fake.MockVPNConnectionClient{
+				MockCreate: func(_ context.Context, in *awsec2.CreateVpnConnectionInput, _ []func(*awsec2.Options)) (*awsec2.CreateVpnConnectionOutput, error) {
+					input = in
+					return &awsec2.CreateVpnConnectionOutput{VpnConnection: &awsec2types.VpnConnection{VpnConnectionId: aws.String(connectionID)}}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input, cmpopts.IgnoreUnexported(awsec2.CreateVpnConnectionInput{}, awsec2types.VpnConnectionOptionsSpecification{}, awsec2types.VpnTunnelOptionsSpecification{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		created  []string
+		deleted  []string
+		modified []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		observed awsec2types.VpnConnection
+		cr       *manualv1alpha1.VPNConnection
+		want
+	}{
+		"Routes": {
+			observed: observed(awsec2types.VpnStateAvailable, cidrA),
+			cr:       vpnConnection(withExternalName(connectionID), withSpec(params(cidrB))),
+			want: want{
+				created: []string{cidrB},
+				deleted: []string{cidrA},
+			},
+		},
+		"TunnelOptions": {
+			observed: observed(awsec2types.VpnStateAvailable),
+			cr: vpnConnection(withExternalName(connectionID), withSpec(manualv1alpha1.VPNConnectionParameters{
+				TunnelOptions: []manualv1alpha1.VPNTunnelOptions{
+					{DPDTimeoutSeconds: aws.Int32(40)},
+					{DPDTimeoutSeconds: aws.Int32(40)},
+				},
+			})),
+			want: want{
+				modified: []string{outsideIPA},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created, deleted, modified []string
+			e := &external{client: &fake.MockVPNConnectionClient{
+				MockDescribe: describe(tc.observed),
+				MockCreateRoute: func(_ context.Context, in *awsec2.CreateVpnConnectionRouteInput, _ []func(*awsec2.Options)) (*awsec2.CreateVpnConnectionRouteOutput, error) {
+					created = append(created, aws.ToString(in.DestinationCidrBlock))
+					return &awsec2.CreateVpnConnectionRouteOutput{}, nil
+				},
+				MockDeleteRoute: func(_ context.Context, in *awsec2.DeleteVpnConnectionRouteInput, _ []func(*awsec2.Options)) (*awsec2.DeleteVpnConnectionRouteOutput, error) {
+					deleted = append(deleted, aws.ToString(in.DestinationCidrBlock))
+					return &awsec2.DeleteVpnConnectionRouteOutput{}, nil
+				},
+				MockModifyTunnelOptions: func(_ context.Context, in *awsec2.ModifyVpnTunnelOptionsInput, _ []func(*awsec2.Options)) (*awsec2.ModifyVpnTunnelOptionsOutput, error) {
+					modified = append(modified, aws.ToString(in.VpnTunnelOutsideIpAddress))
+					return &awsec2.ModifyVpnTunnelOptionsOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified); diff != "" {
+				t.Errorf("modified: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.VPNConnection
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDelete: func(context.Context, *awsec2.DeleteVpnConnectionInput, []func(*awsec2.Options)) (*awsec2.DeleteVpnConnectionOutput, error) {
+						return &awsec2.DeleteVpnConnectionOutput{}, nil
+					},
+				},
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: vpnConnection(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDelete: func(context.Context, *awsec2.DeleteVpnConnectionInput, []func(*awsec2.Options)) (*awsec2.DeleteVpnConnectionOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPNConnectionIDNotFound}
+					},
+				},
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr: vpnConnection(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockVPNConnectionClient{
+					MockDelete: func(context.Context, *awsec2.DeleteVpnConnectionInput, []func(*awsec2.Options)) (*awsec2.DeleteVpnConnectionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpnConnection(withExternalName(connectionID)),
+			},
+			want: want{
+				cr:  vpnConnection(withExternalName(connectionID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
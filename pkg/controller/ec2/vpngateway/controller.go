@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpngateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPNGateway resource"
+	errDescribe         = "failed to describe VPNGateway"
+	errMultipleItems    = "retrieved multiple VPNGateways for the given vpnGatewayId"
+	errCreate           = "failed to create the VPNGateway resource"
+	errAttach           = "failed to attach the VPNGateway to the VPC"
+	errDetach           = "failed to detach the VPNGateway from the VPC"
+	errDelete           = "failed to delete the VPNGateway resource"
+	errCreateTags       = "failed to create tags for the VPNGateway resource"
+	errDeleteTags       = "failed to delete tags for the VPNGateway resource"
+)
+
+// SetupVPNGateway adds a controller that reconciles VPNGateways.
+func SetupVPNGateway(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.VPNGatewayGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.VPNGateway{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.VPNGatewayGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPNGatewayClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPNGatewayClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VPNGatewayClient
+}
+
+// describe returns the observed virtual private gateway, or nil if it doesn't
+// exist anymore.
+func (e *external) describe(ctx context.Context, cr *manualv1alpha1.VPNGateway) (*awsec2types.VpnGateway, error) {
+	response, err := e.client.DescribeVpnGateways(ctx, &awsec2.DescribeVpnGatewaysInput{
+		VpnGatewayIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ec2.IsVPNGatewayNotFoundErr, err), errDescribe)
+	}
+	switch len(response.VpnGateways) {
+	case 0:
+		return nil, nil
+	case 1:
+		if response.VpnGateways[0].State == awsec2types.VpnStateDeleted {
+			return nil, nil
+		}
+		return &response.VpnGateways[0], nil
+	default:
+		return nil, errors.New(errMultipleItems)
+	}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPNGateway(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateVPNGatewayObservation(*observed)
+	switch observed.State {
+	case awsec2types.VpnStateAvailable:
+		cr.SetConditions(xpv1.Available())
+	case awsec2types.VpnStatePending:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.VpnStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsVPNGatewayUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateVpnGateway(ctx, ec2.GenerateCreateVPNGatewayInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.VpnGateway.VpnGatewayId))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	attach, detach := ec2.DiffVPNGatewayAttachments(cr.Spec.ForProvider, observed.VpcAttachments)
+	if err := e.detach(ctx, cr, detach); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if attach {
+		if _, err := e.client.AttachVpnGateway(ctx, &awsec2.AttachVpnGatewayInput{
+			VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+			VpcId:        cr.Spec.ForProvider.VPCID,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAttach)
+		}
+	}
+
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// detach detaches the virtual private gateway from the supplied VPCs.
+func (e *external) detach(ctx context.Context, cr *manualv1alpha1.VPNGateway, vpcIDs []string) error {
+	for _, id := range vpcIDs {
+		if _, err := e.client.DetachVpnGateway(ctx, &awsec2.DetachVpnGatewayInput{
+			VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+			VpcId:        aws.String(id),
+		}); err != nil {
+			return awsclient.Wrap(err, errDetach)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.VPNGateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return err
+	}
+
+	// A virtual private gateway can only be deleted once it is detached from
+	// all VPCs. Detaching takes a while, so deletion is retried until then.
+	_, detach := ec2.DiffVPNGatewayAttachments(manualv1alpha1.VPNGatewayParameters{}, observed.VpcAttachments)
+	if err := e.detach(ctx, cr, detach); err != nil {
+		return err
+	}
+
+	_, err = e.client.DeleteVpnGateway(ctx, &awsec2.DeleteVpnGatewayInput{
+		VpnGatewayId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsVPNGatewayNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpngateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	gatewayID = "vgw-123"
+	asn       = int64(64512)
+	vpcA      = "vpc-a"
+	vpcB      = "vpc-b"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.VPNGatewayClient
+	cr     *manualv1alpha1.VPNGateway
+}
+
+type vpnGatewayModifier func(*manualv1alpha1.VPNGateway)
+
+func withExternalName(name string) vpnGatewayModifier {
+	return func(r *manualv1alpha1.VPNGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) vpnGatewayModifier {
+	return func(r *manualv1alpha1.VPNGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withVPC(id string) vpnGatewayModifier {
+	return func(r *manualv1alpha1.VPNGateway) { r.Spec.ForProvider.VPCID = aws.String(id) }
+}
+
+func withLateInit() vpnGatewayModifier {
+	return func(r *manualv1alpha1.VPNGateway) {
+		r.Spec.ForProvider.Type = string(awsec2types.GatewayTypeIpsec1)
+		r.Spec.ForProvider.AmazonSideASN = aws.Int64(asn)
+	}
+}
+
+func withStatus(s manualv1alpha1.VPNGatewayObservation) vpnGatewayModifier {
+	return func(r *manualv1alpha1.VPNGateway) { r.Status.AtProvider = s }
+}
+
+func vpnGateway(m ...vpnGatewayModifier) *manualv1alpha1.VPNGateway {
+	cr := &manualv1alpha1.VPNGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// attachments maps the IDs of VPCs to the state of their attachment.
+type attachments map[string]awsec2types.AttachmentStatus
+
+func describe(state awsec2types.VpnState, a attachments) func(context.Context, *awsec2.DescribeVpnGatewaysInput, []func(*awsec2.Options)) (*awsec2.DescribeVpnGatewaysOutput, error) {
+	return func(context.Context, *awsec2.DescribeVpnGatewaysInput, []func(*awsec2.Options)) (*awsec2.DescribeVpnGatewaysOutput, error) {
+		g := awsec2types.VpnGateway{
+			VpnGatewayId:  aws.String(gatewayID),
+			Type:          awsec2types.GatewayTypeIpsec1,
+			AmazonSideAsn: aws.Int64(asn),
+			State:         state,
+		}
+		for id, s := range a {
+			g.VpcAttachments = append(g.VpcAttachments, awsec2types.VpcAttachment{VpcId: aws.String(id), State: s})
+		}
+		return &awsec2.DescribeVpnGatewaysOutput{VpnGateways: []awsec2types.VpnGateway{g}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.VPNGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockVPNGatewayClient{
+					MockDescribe: describe(awsec2types.VpnStateAvailable, attachments{vpcA: awsec2types.AttachmentStatusAttached}),
+				},
+				cr: vpnGateway(withExternalName(gatewayID), withLateInit(), withVPC(vpcA)),
+			},
+			want: want{
+				cr: vpnGateway(withExternalName(gatewayID), withLateInit(), withVPC(vpcA),
+					withStatus(manualv1alpha1.VPNGatewayObservation{
+						VPNGatewayID:   gatewayID,
+						State:          string(awsec2types.VpnStateAvailable),
+						VPCAttachments: []manualv1alpha1.VPCAttachment{{VPCID: vpcA, State: string(awsec2types.AttachmentStatusAttached)}},
+					}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitializedAndNotAttached": {
+			args: args{
+				client: &fake.MockVPNGatewayClient{
+					MockDescribe: describe(awsec2types.VpnStatePending, nil),
+				},
+				cr: vpnGateway(withExternalName(gatewayID), withVPC(vpcA)),
+			},
+			want: want{
+				cr: vpnGateway(withExternalName(gatewayID), withLateInit(), withVPC(vpcA),
+					withStatus(manualv1alpha1.VPNGatewayObservation{
+						VPNGatewayID: gatewayID,
+						State:        string(awsec2types.VpnStatePending),
+					}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockVPNGatewayClient{
+					MockDescribe: describe(awsec2types.VpnStateDeleted, nil),
+				},
+				cr: vpnGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr: vpnGateway(withExternalName(gatewayID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPNGatewayClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeVpnGatewaysInput, []func(*awsec2.Options)) (*awsec2.DescribeVpnGatewaysOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPNGatewayIDNotFound}
+					},
+				},
+				cr: vpnGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr: vpnGateway(withExternalName(gatewayID)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockVPNGatewayClient{
+					MockDescribe: func(context.Context, *awsec2.DescribeVpnGatewaysInput, []func(*awsec2.Options)) (*awsec2.DescribeVpnGatewaysOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: vpnGateway(withExternalName(gatewayID)),
+			},
+			want: want{
+				cr:  vpnGateway(withExternalName(gatewayID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		attached []string
+		detached []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		attachments attachments
+		cr          *manualv1alpha1.VPNGateway
+		attachErr   error
+		want
+	}{
+		"MovesToOtherVPC": {
+			attachments: attachments{vpcA: awsec2types.AttachmentStatusAttached},
+			cr:          vpnGateway(withExternalName(gatewayID), withVPC(vpcB)),
+			want: want{
+				attached: []string{vpcB},
+				detached: []string{vpcA},
+			},
+		},
+		"IgnoresDetachingVPC": {
+			attachments: attachments{vpcA: awsec2types.AttachmentStatusDetaching},
+			cr:          vpnGateway(withExternalName(gatewayID)),
+		},
+		"AttachFailed": {
+			cr:        vpnGateway(withExternalName(gatewayID), withVPC(vpcA)),
+			attachErr: errBoom,
+			want: want{
+				attached: []string{vpcA},
+				err:      awsclient.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var attached, detached []string
+			e := &external{client: &fake.MockVPNGatewayClient{
+				MockDescribe: describe(awsec2types.VpnStateAvailable, tc.attachments),
+				MockAttach: func(_ context.Context, in *awsec2.AttachVpnGatewayInput, _ []func(*awsec2.Options)) (*awsec2.AttachVpnGatewayOutput, error) {
+					attached = append(attached, aws.ToString(in.VpcId))
+					return &awsec2.AttachVpnGatewayOutput{}, tc.attachErr
+				},
+				MockDetach: func(_ context.Context, in *awsec2.DetachVpnGatewayInput, _ []func(*awsec2.Options)) (*awsec2.DetachVpnGatewayOutput, error) {
+					detached = append(detached, aws.ToString(in.VpcId))
+					return &awsec2.DetachVpnGatewayOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.attached, attached); diff != "" {
+				t.Errorf("attached: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detached, detached); diff != "" {
+				t.Errorf("detached: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		detached []string
+		deleted  bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		attachments attachments
+		deleteErr   error
+		want
+	}{
+		"DetachesBeforeDeleting": {
+			attachments: attachments{vpcA: awsec2types.AttachmentStatusAttached},
+			want: want{
+				detached: []string{vpcA},
+				deleted:  true,
+			},
+		},
+		"AlreadyGone": {
+			deleteErr: &smithy.GenericAPIError{Code: ec2.VPNGatewayIDNotFound},
+			want: want{
+				deleted: true,
+			},
+		},
+		"DeleteFailed": {
+			deleteErr: errBoom,
+			want: want{
+				deleted: true,
+				err:     awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var detached []string
+			deleted := false
+			e := &external{client: &fake.MockVPNGatewayClient{
+				MockDescribe: describe(awsec2types.VpnStateAvailable, tc.attachments),
+				MockDetach: func(_ context.Context, in *awsec2.DetachVpnGatewayInput, _ []func(*awsec2.Options)) (*awsec2.DetachVpnGatewayOutput, error) {
+					detached = append(detached, aws.ToString(in.VpcId))
+					return &awsec2.DetachVpnGatewayOutput{}, nil
+				},
+				MockDelete: func(context.Context, *awsec2.DeleteVpnGatewayInput, []func(*awsec2.Options)) (*awsec2.DeleteVpnGatewayOutput, error) {
+					deleted = true
+					return &awsec2.DeleteVpnGatewayOutput{}, tc.deleteErr
+				},
+			}}
+			cr := vpnGateway(withExternalName(gatewayID))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detached, detached); diff != "" {
+				t.Errorf("detached: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(vpnGateway(withExternalName(gatewayID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,479 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecyclepolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
+	awsecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	repositoryName = "testRepo"
+	registryID     = "123456789012"
+	lastEvaluated  = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// remotePolicy is semantically equal to params, but ECR does not keep
+	// the formatting or key order of the submitted document.
+	remotePolicy = `{
+  "rules": [
+    {
+      "action": {"type": "expire"},
+      "selection": {"countNumber": 10, "countType": "imageCountMoreThan", "tagPrefixList": ["v"], "tagStatus": "tagged"},
+      "rulePriority": 1
+    }
+  ]
+}`
+	needUpdatePolicy = `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"tagged","tagPrefixList":["v"],"countType":"imageCountMoreThan","countNumber":20},"action":{"type":"expire"}}]}`
+
+	params = v1alpha1.LifecyclePolicyParameters{
+		RepositoryName: &repositoryName,
+		Policy: &v1alpha1.LifecyclePolicyBody{
+			Rules: []v1alpha1.LifecyclePolicyRule{
+				{
+					RulePriority: 1,
+					Selection: v1alpha1.LifecyclePolicySelection{
+						TagStatus:     "tagged",
+						TagPrefixList: []string{"v"},
+						CountType:     "imageCountMoreThan",
+						CountNumber:   10,
+					},
+					Action: v1alpha1.LifecyclePolicyAction{Type: "expire"},
+				},
+			},
+		},
+	}
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ecr ecr.LifecyclePolicyClient
+	cr  resource.Managed
+}
+
+type lifecyclePolicyModifier func(policy *v1alpha1.LifecyclePolicy)
+
+func withConditions(c ...xpv1.Condition) lifecyclePolicyModifier {
+	return func(r *v1alpha1.LifecyclePolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParams(p v1alpha1.LifecyclePolicyParameters) lifecyclePolicyModifier {
+	return func(r *v1alpha1.LifecyclePolicy) { r.Spec.ForProvider = p }
+}
+
+func withRegistryID(id string) lifecyclePolicyModifier {
+	return func(r *v1alpha1.LifecyclePolicy) { r.Spec.ForProvider.RegistryID = &id }
+}
+
+func withObservation(o v1alpha1.LifecyclePolicyObservation) lifecyclePolicyModifier {
+	return func(r *v1alpha1.LifecyclePolicy) { r.Status.AtProvider = o }
+}
+
+func lifecyclePolicy(m ...lifecyclePolicyModifier) *v1alpha1.LifecyclePolicy {
+	cr := &v1alpha1.LifecyclePolicy{
+		Spec: v1alpha1.LifecyclePolicySpec{
+			ForProvider: *params.DeepCopy(),
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	evaluated := metav1.NewTime(lastEvaluated)
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.GetLifecyclePolicyOutput, error) {
+						return &awsecr.GetLifecyclePolicyOutput{LifecyclePolicyText: &remotePolicy, LastEvaluatedAt: &lastEvaluated}, nil
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr: lifecyclePolicy(
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.LifecyclePolicyObservation{LastEvaluatedAt: &evaluated})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RawPolicyNeedUpdate": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.GetLifecyclePolicyOutput, error) {
+						return &awsecr.GetLifecyclePolicyOutput{LifecyclePolicyText: &remotePolicy}, nil
+					},
+				},
+				cr: lifecyclePolicy(withParams(v1alpha1.LifecyclePolicyParameters{
+					RepositoryName: &repositoryName,
+					RawPolicy:      &needUpdatePolicy,
+				})),
+			},
+			want: want{
+				cr: lifecyclePolicy(
+					withParams(v1alpha1.LifecyclePolicyParameters{
+						RepositoryName: &repositoryName,
+						RawPolicy:      &needUpdatePolicy,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NeedUpdate": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.GetLifecyclePolicyOutput, error) {
+						return &awsecr.GetLifecyclePolicyOutput{LifecyclePolicyText: &needUpdatePolicy}, nil
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr: lifecyclePolicy(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitRegistryID": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.GetLifecyclePolicyOutput, error) {
+						return &awsecr.GetLifecyclePolicyOutput{LifecyclePolicyText: &remotePolicy, RegistryId: &registryID}, nil
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr: lifecyclePolicy(withRegistryID(registryID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.GetLifecyclePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr:  lifecyclePolicy(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.GetLifecyclePolicyOutput, error) {
+						return nil, &awsecrtypes.LifecyclePolicyNotFoundException{}
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr: lifecyclePolicy(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockPut: func(_ context.Context, input *awsecr.PutLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.PutLifecyclePolicyOutput, error) {
+						if !awsclient.IsPolicyUpToDate(input.LifecyclePolicyText, &remotePolicy) {
+							return nil, errors.Errorf("unexpected policy %s", awsclient.StringValue(input.LifecyclePolicyText))
+						}
+						return &awsecr.PutLifecyclePolicyOutput{}, nil
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr: lifecyclePolicy(withConditions(xpv1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockPut: func(_ context.Context, _ *awsecr.PutLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.PutLifecyclePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr:  lifecyclePolicy(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockPut: func(_ context.Context, _ *awsecr.PutLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.PutLifecyclePolicyOutput, error) {
+						return &awsecr.PutLifecyclePolicyOutput{}, nil
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr: lifecyclePolicy(),
+			},
+		},
+		"NoPolicy": {
+			args: args{
+				cr: lifecyclePolicy(withParams(v1alpha1.LifecyclePolicyParameters{RepositoryName: &repositoryName})),
+			},
+			want: want{
+				cr:  lifecyclePolicy(withParams(v1alpha1.LifecyclePolicyParameters{RepositoryName: &repositoryName})),
+				err: errors.Wrap(errors.New("failed to format Lifecycle Policy, no rawPolicy or policy specified"), errUpdate),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockPut: func(_ context.Context, _ *awsecr.PutLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.PutLifecyclePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr:  lifecyclePolicy(),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockDelete: func(_ context.Context, _ *awsecr.DeleteLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.DeleteLifecyclePolicyOutput, error) {
+						return &awsecr.DeleteLifecyclePolicyOutput{}, nil
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr: lifecyclePolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockDelete: func(_ context.Context, _ *awsecr.DeleteLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.DeleteLifecyclePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr:  lifecyclePolicy(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				ecr: &fake.MockLifecyclePolicyClient{
+					MockDelete: func(_ context.Context, _ *awsecr.DeleteLifecyclePolicyInput, _ []func(*awsecr.Options)) (*awsecr.DeleteLifecyclePolicyOutput, error) {
+						return nil, &awsecrtypes.LifecyclePolicyNotFoundException{}
+					},
+				},
+				cr: lifecyclePolicy(),
+			},
+			want: want{
+				cr: lifecyclePolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
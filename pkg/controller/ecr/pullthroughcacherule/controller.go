@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullthroughcacherule
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	ecr "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a pull through cache rule resource"
+	errCreateSession    = "cannot create a new session"
+
+	errDescribe = "failed to describe pull through cache rule"
+	errCreate   = "failed to create pull through cache rule"
+	errDelete   = "failed to delete pull through cache rule"
+)
+
+// SetupPullThroughCacheRule adds a controller that reconciles ECR pull
+// through cache rules.
+func SetupPullThroughCacheRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.PullThroughCacheRuleGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.PullThroughCacheRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PullThroughCacheRuleGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ecr.NewPullThroughCacheRuleClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) ecr.PullThroughCacheRuleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PullThroughCacheRule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ecr.PullThroughCacheRuleClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.PullThroughCacheRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.DescribePullThroughCacheRulesWithContext(ctx, &awsecr.DescribePullThroughCacheRulesInput{
+		EcrRepositoryPrefixes: []*string{aws.String(meta.GetExternalName(cr))},
+		RegistryId:            cr.Spec.ForProvider.RegistryID,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ecr.IsPullThroughCacheRuleNotFoundErr, err), errDescribe)
+	}
+	if len(resp.PullThroughCacheRules) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	rule := resp.PullThroughCacheRules[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ecr.LateInitializePullThroughCacheRule(&cr.Spec.ForProvider, rule)
+
+	cr.Status.AtProvider = ecr.GeneratePullThroughCacheRuleObservation(rule)
+	cr.SetConditions(xpv1.Available())
+
+	// Pull through cache rules cannot be updated.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.PullThroughCacheRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreatePullThroughCacheRuleWithContext(ctx, ecr.GenerateCreatePullThroughCacheRuleInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.PullThroughCacheRule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeletePullThroughCacheRuleWithContext(ctx, &awsecr.DeletePullThroughCacheRuleInput{
+		EcrRepositoryPrefix: aws.String(meta.GetExternalName(cr)),
+		RegistryId:          cr.Spec.ForProvider.RegistryID,
+	})
+	return awsclient.Wrap(resource.Ignore(ecr.IsPullThroughCacheRuleNotFoundErr, err), errDelete)
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullthroughcacherule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	prefix         = "ecr-public"
+	upstream       = "public.ecr.aws"
+	registryID     = "123456789012"
+	createdAt      = time.Date(2021, 11, 29, 0, 0, 0, 0, time.UTC)
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awsecr.ErrCodePullThroughCacheRuleNotFoundException, "not found", nil)
+)
+
+type args struct {
+	ecr ecr.PullThroughCacheRuleClient
+	cr  resource.Managed
+}
+
+type ruleModifier func(*v1alpha1.PullThroughCacheRule)
+
+func withConditions(c ...xpv1.Condition) ruleModifier {
+	return func(r *v1alpha1.PullThroughCacheRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRegistryID(id string) ruleModifier {
+	return func(r *v1alpha1.PullThroughCacheRule) { r.Spec.ForProvider.RegistryID = &id }
+}
+
+func withCreatedAt(t time.Time) ruleModifier {
+	return func(r *v1alpha1.PullThroughCacheRule) {
+		mt := metav1.NewTime(t)
+		r.Status.AtProvider.CreatedAt = &mt
+	}
+}
+
+func rule(m ...ruleModifier) *v1alpha1.PullThroughCacheRule {
+	cr := &v1alpha1.PullThroughCacheRule{
+		Spec: v1alpha1.PullThroughCacheRuleSpec{
+			ForProvider: v1alpha1.PullThroughCacheRuleParameters{
+				Region:              "us-east-1",
+				UpstreamRegistryURL: upstream,
+			},
+		},
+	}
+	meta.SetExternalName(cr, prefix)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Exists": {
+			args: args{
+				ecr: &fake.MockPullThroughCacheRuleClient{
+					MockDescribe: func(_ context.Context, input *awsecr.DescribePullThroughCacheRulesInput, _ []request.Option) (*awsecr.DescribePullThroughCacheRulesOutput, error) {
+						if diff := cmp.Diff([]*string{aws.String(prefix)}, input.EcrRepositoryPrefixes); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsecr.DescribePullThroughCacheRulesOutput{PullThroughCacheRules: []*awsecr.PullThroughCacheRule{{
+							CreatedAt:           &createdAt,
+							EcrRepositoryPrefix: aws.String(prefix),
+							RegistryId:          aws.String(registryID),
+							UpstreamRegistryUrl: aws.String(upstream),
+						}}}, nil
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withRegistryID(registryID), withCreatedAt(createdAt), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				ecr: &fake.MockPullThroughCacheRuleClient{
+					MockDescribe: func(_ context.Context, _ *awsecr.DescribePullThroughCacheRulesInput, _ []request.Option) (*awsecr.DescribePullThroughCacheRulesOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockPullThroughCacheRuleClient{
+					MockDescribe: func(_ context.Context, _ *awsecr.DescribePullThroughCacheRulesInput, _ []request.Option) (*awsecr.DescribePullThroughCacheRulesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockPullThroughCacheRuleClient{
+					MockCreate: func(_ context.Context, input *awsecr.CreatePullThroughCacheRuleInput, _ []request.Option) (*awsecr.CreatePullThroughCacheRuleOutput, error) {
+						want := &awsecr.CreatePullThroughCacheRuleInput{
+							EcrRepositoryPrefix: aws.String(prefix),
+							UpstreamRegistryUrl: aws.String(upstream),
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsecr.CreatePullThroughCacheRuleOutput{}, nil
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockPullThroughCacheRuleClient{
+					MockCreate: func(_ context.Context, _ *awsecr.CreatePullThroughCacheRuleInput, _ []request.Option) (*awsecr.CreatePullThroughCacheRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockPullThroughCacheRuleClient{
+					MockDelete: func(_ context.Context, _ *awsecr.DeletePullThroughCacheRuleInput, _ []request.Option) (*awsecr.DeletePullThroughCacheRuleOutput, error) {
+						return &awsecr.DeletePullThroughCacheRuleOutput{}, nil
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				ecr: &fake.MockPullThroughCacheRuleClient{
+					MockDelete: func(_ context.Context, _ *awsecr.DeletePullThroughCacheRuleInput, _ []request.Option) (*awsecr.DeletePullThroughCacheRuleOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockPullThroughCacheRuleClient{
+					MockDelete: func(_ context.Context, _ *awsecr.DeletePullThroughCacheRuleInput, _ []request.Option) (*awsecr.DeletePullThroughCacheRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryscanningconfiguration
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	ecr "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a registry scanning configuration resource"
+	errCreateSession    = "cannot create a new session"
+
+	errGet    = "failed to get registry scanning configuration"
+	errPut    = "failed to put registry scanning configuration"
+	errDelete = "failed to reset registry scanning configuration"
+)
+
+// SetupRegistryScanningConfiguration adds a controller that reconciles the
+// scanning configuration of ECR registries.
+func SetupRegistryScanningConfiguration(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.RegistryScanningConfigurationGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.RegistryScanningConfiguration{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RegistryScanningConfigurationGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ecr.NewRegistryScanningConfigurationClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) ecr.RegistryScanningConfigurationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RegistryScanningConfiguration)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ecr.RegistryScanningConfigurationClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.RegistryScanningConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.GetRegistryScanningConfigurationWithContext(ctx, &awsecr.GetRegistryScanningConfigurationInput{})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGet)
+	}
+	cr.Status.AtProvider = v1alpha1.RegistryScanningConfigurationObservation{
+		RegistryID: aws.StringValue(resp.RegistryId),
+	}
+
+	// Every registry has a scanning configuration. We consider it to exist
+	// only while it differs from the default one, which is what it is reset
+	// to when deleted.
+	if ecr.IsRegistryScanningConfigurationDefault(resp.ScanningConfiguration) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ecr.IsRegistryScanningConfigurationUpToDate(cr.Spec.ForProvider, resp.ScanningConfiguration),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.RegistryScanningConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.PutRegistryScanningConfigurationWithContext(ctx, ecr.GeneratePutRegistryScanningConfigurationInput(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.RegistryScanningConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.PutRegistryScanningConfigurationWithContext(ctx, ecr.GeneratePutRegistryScanningConfigurationInput(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.RegistryScanningConfiguration)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.PutRegistryScanningConfigurationWithContext(ctx, &awsecr.PutRegistryScanningConfigurationInput{
+		ScanType: aws.String(v1alpha1.ScanTypeBasic),
+		Rules:    []*awsecr.RegistryScanningRule{},
+	})
+	return awsclient.Wrap(err, errDelete)
+}
@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registryscanningconfiguration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsecr "github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	registryID     = "123456789012"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ecr ecr.RegistryScanningConfigurationClient
+	cr  resource.Managed
+}
+
+type scanningConfigurationModifier func(*v1alpha1.RegistryScanningConfiguration)
+
+func withConditions(c ...xpv1.Condition) scanningConfigurationModifier {
+	return func(r *v1alpha1.RegistryScanningConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRegistryID(id string) scanningConfigurationModifier {
+	return func(r *v1alpha1.RegistryScanningConfiguration) { r.Status.AtProvider.RegistryID = id }
+}
+
+func scanningConfiguration(m ...scanningConfigurationModifier) *v1alpha1.RegistryScanningConfiguration {
+	cr := &v1alpha1.RegistryScanningConfiguration{
+		Spec: v1alpha1.RegistryScanningConfigurationSpec{
+			ForProvider: v1alpha1.RegistryScanningConfigurationParameters{
+				Region:   "us-east-1",
+				ScanType: v1alpha1.ScanTypeEnhanced,
+				Rules: []v1alpha1.RegistryScanningRule{{
+					ScanFrequency: "SCAN_ON_PUSH",
+					RepositoryFilters: []v1alpha1.ScanningRepositoryFilter{
+						{Filter: "*", FilterType: "WILDCARD"},
+					},
+				}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func remoteConfiguration(scanType, frequency string) *awsecr.RegistryScanningConfiguration {
+	return &awsecr.RegistryScanningConfiguration{
+		ScanType: aws.String(scanType),
+		Rules: []*awsecr.RegistryScanningRule{{
+			ScanFrequency: aws.String(frequency),
+			RepositoryFilters: []*awsecr.ScanningRepositoryFilter{
+				{Filter: aws.String("*"), FilterType: aws.String("WILDCARD")},
+			},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				ecr: &fake.MockRegistryScanningConfigurationClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetRegistryScanningConfigurationInput, _ []request.Option) (*awsecr.GetRegistryScanningConfigurationOutput, error) {
+						return &awsecr.GetRegistryScanningConfigurationOutput{
+							RegistryId:            &registryID,
+							ScanningConfiguration: remoteConfiguration(v1alpha1.ScanTypeEnhanced, "SCAN_ON_PUSH"),
+						}, nil
+					},
+				},
+				cr: scanningConfiguration(),
+			},
+			want: want{
+				cr: scanningConfiguration(withRegistryID(registryID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedUpdate": {
+			args: args{
+				ecr: &fake.MockRegistryScanningConfigurationClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetRegistryScanningConfigurationInput, _ []request.Option) (*awsecr.GetRegistryScanningConfigurationOutput, error) {
+						return &awsecr.GetRegistryScanningConfigurationOutput{
+							RegistryId:            &registryID,
+							ScanningConfiguration: remoteConfiguration(v1alpha1.ScanTypeBasic, "SCAN_ON_PUSH"),
+						}, nil
+					},
+				},
+				cr: scanningConfiguration(),
+			},
+			want: want{
+				cr: scanningConfiguration(withRegistryID(registryID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Default": {
+			args: args{
+				ecr: &fake.MockRegistryScanningConfigurationClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetRegistryScanningConfigurationInput, _ []request.Option) (*awsecr.GetRegistryScanningConfigurationOutput, error) {
+						return &awsecr.GetRegistryScanningConfigurationOutput{
+							RegistryId:            &registryID,
+							ScanningConfiguration: &awsecr.RegistryScanningConfiguration{ScanType: aws.String(v1alpha1.ScanTypeBasic)},
+						}, nil
+					},
+				},
+				cr: scanningConfiguration(),
+			},
+			want: want{
+				cr: scanningConfiguration(withRegistryID(registryID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockRegistryScanningConfigurationClient{
+					MockGet: func(_ context.Context, _ *awsecr.GetRegistryScanningConfigurationInput, _ []request.Option) (*awsecr.GetRegistryScanningConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scanningConfiguration(),
+			},
+			want: want{
+				cr:  scanningConfiguration(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockRegistryScanningConfigurationClient{
+					MockPut: func(_ context.Context, input *awsecr.PutRegistryScanningConfigurationInput, _ []request.Option) (*awsecr.PutRegistryScanningConfigurationOutput, error) {
+						want := &awsecr.PutRegistryScanningConfigurationInput{
+							ScanType: aws.String(v1alpha1.ScanTypeEnhanced),
+							Rules:    remoteConfiguration(v1alpha1.ScanTypeEnhanced, "SCAN_ON_PUSH").Rules,
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsecr.PutRegistryScanningConfigurationOutput{}, nil
+					},
+				},
+				cr: scanningConfiguration(),
+			},
+			want: want{
+				cr: scanningConfiguration(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockRegistryScanningConfigurationClient{
+					MockPut: func(_ context.Context, _ *awsecr.PutRegistryScanningConfigurationInput, _ []request.Option) (*awsecr.PutRegistryScanningConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scanningConfiguration(),
+			},
+			want: want{
+				cr:  scanningConfiguration(),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ResetsToDefault": {
+			args: args{
+				ecr: &fake.MockRegistryScanningConfigurationClient{
+					MockPut: func(_ context.Context, input *awsecr.PutRegistryScanningConfigurationInput, _ []request.Option) (*awsecr.PutRegistryScanningConfigurationOutput, error) {
+						if aws.StringValue(input.ScanType) != v1alpha1.ScanTypeBasic || len(input.Rules) != 0 {
+							return nil, errors.New("expected the default configuration")
+						}
+						return &awsecr.PutRegistryScanningConfigurationOutput{}, nil
+					},
+				},
+				cr: scanningConfiguration(),
+			},
+			want: want{
+				cr: scanningConfiguration(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockRegistryScanningConfigurationClient{
+					MockPut: func(_ context.Context, _ *awsecr.PutRegistryScanningConfigurationInput, _ []request.Option) (*awsecr.PutRegistryScanningConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scanningConfiguration(),
+			},
+			want: want{
+				cr:  scanningConfiguration(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
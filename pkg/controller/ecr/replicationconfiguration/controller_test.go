@@ -0,0 +1,313 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicationconfiguration
+
+import (
+	"context"
+	"testing"
+
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	registryID     = "123456789012"
+	otherRegion    = "eu-west-1"
+	anotherRegion  = "eu-central-1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ecr ecr.ReplicationConfigurationClient
+	cr  resource.Managed
+}
+
+type replicationConfigurationModifier func(*v1alpha1.ReplicationConfiguration)
+
+func withConditions(c ...xpv1.Condition) replicationConfigurationModifier {
+	return func(r *v1alpha1.ReplicationConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRegistryID(id string) replicationConfigurationModifier {
+	return func(r *v1alpha1.ReplicationConfiguration) { r.Status.AtProvider.RegistryID = id }
+}
+
+func replicationConfiguration(m ...replicationConfigurationModifier) *v1alpha1.ReplicationConfiguration {
+	cr := &v1alpha1.ReplicationConfiguration{
+		Spec: v1alpha1.ReplicationConfigurationSpec{
+			ForProvider: v1alpha1.ReplicationConfigurationParameters{
+				Region: "us-east-1",
+				Rules: []v1alpha1.ReplicationRule{
+					{
+						Destinations: []v1alpha1.ReplicationDestination{
+							{Region: otherRegion, RegistryID: registryID},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func remoteConfiguration(region string) *ecrtypes.ReplicationConfiguration {
+	return &ecrtypes.ReplicationConfiguration{
+		Rules: []ecrtypes.ReplicationRule{
+			{
+				Destinations: []ecrtypes.ReplicationDestination{
+					{Region: &region, RegistryId: &registryID},
+				},
+			},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				ecr: &fake.MockReplicationConfigurationClient{
+					MockDescribeRegistry: func(_ context.Context, _ *awsecr.DescribeRegistryInput, _ []func(*awsecr.Options)) (*awsecr.DescribeRegistryOutput, error) {
+						return &awsecr.DescribeRegistryOutput{RegistryId: &registryID, ReplicationConfiguration: remoteConfiguration(otherRegion)}, nil
+					},
+				},
+				cr: replicationConfiguration(),
+			},
+			want: want{
+				cr: replicationConfiguration(withRegistryID(registryID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedUpdate": {
+			args: args{
+				ecr: &fake.MockReplicationConfigurationClient{
+					MockDescribeRegistry: func(_ context.Context, _ *awsecr.DescribeRegistryInput, _ []func(*awsecr.Options)) (*awsecr.DescribeRegistryOutput, error) {
+						return &awsecr.DescribeRegistryOutput{RegistryId: &registryID, ReplicationConfiguration: remoteConfiguration(anotherRegion)}, nil
+					},
+				},
+				cr: replicationConfiguration(),
+			},
+			want: want{
+				cr: replicationConfiguration(withRegistryID(registryID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoRules": {
+			args: args{
+				ecr: &fake.MockReplicationConfigurationClient{
+					MockDescribeRegistry: func(_ context.Context, _ *awsecr.DescribeRegistryInput, _ []func(*awsecr.Options)) (*awsecr.DescribeRegistryOutput, error) {
+						return &awsecr.DescribeRegistryOutput{RegistryId: &registryID, ReplicationConfiguration: &ecrtypes.ReplicationConfiguration{}}, nil
+					},
+				},
+				cr: replicationConfiguration(),
+			},
+			want: want{
+				cr: replicationConfiguration(withRegistryID(registryID)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockReplicationConfigurationClient{
+					MockDescribeRegistry: func(_ context.Context, _ *awsecr.DescribeRegistryInput, _ []func(*awsecr.Options)) (*awsecr.DescribeRegistryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: replicationConfiguration(),
+			},
+			want: want{
+				cr:  replicationConfiguration(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockReplicationConfigurationClient{
+					MockPut: func(_ context.Context, input *awsecr.PutReplicationConfigurationInput, _ []func(*awsecr.Options)) (*awsecr.PutReplicationConfigurationOutput, error) {
+						if !ecr.IsReplicationConfigurationUpToDate(replicationConfiguration().Spec.ForProvider, input.ReplicationConfiguration) {
+							return nil, errors.New("unexpected replication configuration")
+						}
+						return &awsecr.PutReplicationConfigurationOutput{}, nil
+					},
+				},
+				cr: replicationConfiguration(),
+			},
+			want: want{
+				cr: replicationConfiguration(withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockReplicationConfigurationClient{
+					MockPut: func(_ context.Context, _ *awsecr.PutReplicationConfigurationInput, _ []func(*awsecr.Options)) (*awsecr.PutReplicationConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: replicationConfiguration(),
+			},
+			want: want{
+				cr:  replicationConfiguration(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RemovesAllRules": {
+			args: args{
+				ecr: &fake.MockReplicationConfigurationClient{
+					MockPut: func(_ context.Context, input *awsecr.PutReplicationConfigurationInput, _ []func(*awsecr.Options)) (*awsecr.PutReplicationConfigurationOutput, error) {
+						if len(input.ReplicationConfiguration.Rules) != 0 {
+							return nil, errors.New("expected no rules")
+						}
+						return &awsecr.PutReplicationConfigurationOutput{}, nil
+					},
+				},
+				cr: replicationConfiguration(),
+			},
+			want: want{
+				cr: replicationConfiguration(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockReplicationConfigurationClient{
+					MockPut: func(_ context.Context, _ *awsecr.PutReplicationConfigurationInput, _ []func(*awsecr.Options)) (*awsecr.PutReplicationConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: replicationConfiguration(),
+			},
+			want: want{
+				cr:  replicationConfiguration(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
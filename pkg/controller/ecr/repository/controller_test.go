@@ -169,6 +169,49 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ScanOnPushDrifted": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				repository: &fake.MockRepositoryClient{
+					MockDescribe: func(ctx context.Context, input *awsecr.DescribeRepositoriesInput, opts []func(*awsecr.Options)) (*awsecr.DescribeRepositoriesOutput, error) {
+						return &awsecr.DescribeRepositoriesOutput{
+							Repositories: []awsecrtypes.Repository{{
+								RepositoryArn:              &testARN,
+								RepositoryName:             &repoName,
+								ImageTagMutability:         awsecrtypes.ImageTagMutabilityMutable,
+								ImageScanningConfiguration: &awsImageScanConfigFalse,
+							}},
+						}, nil
+					},
+					MockListTags: func(ctx context.Context, input *awsecr.ListTagsForResourceInput, opts []func(*awsecr.Options)) (*awsecr.ListTagsForResourceOutput, error) {
+						return &awsecr.ListTagsForResourceOutput{}, nil
+					},
+				},
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					ImageScanningConfiguration: &imageScanConfigTrue,
+				}), withExternalName(repoName)),
+			},
+			want: want{
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					ImageScanningConfiguration: &imageScanConfigTrue,
+					ImageTagMutability:         aws.String(string(awsecrtypes.ImageTagMutabilityMutable)),
+				}), withStatus(v1beta1.RepositoryObservation{
+					RepositoryName: repoName,
+					RepositoryArn:  testARN,
+				}), withExternalName(repoName),
+					withConditions(xpv1.Available(), awsclient.Drifted([]awsclient.Drift{{
+						Path:     "imageScanningConfiguration.scanOnPush",
+						Desired:  "true",
+						Observed: "false",
+					}}))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"MultipleRepository": {
 			args: args{
 				kube: &test.MockClient{
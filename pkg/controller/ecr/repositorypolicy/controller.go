@@ -60,7 +60,8 @@ func SetupRepositoryPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 		For(&v1beta1.RepositoryPolicy{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RepositoryPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient()}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
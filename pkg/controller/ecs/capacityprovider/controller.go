@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityprovider
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an ECS CapacityProvider resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe the ECS capacity provider"
+	errCreate           = "failed to create the ECS capacity provider"
+	errUpdate           = "failed to update the ECS capacity provider"
+	errDelete           = "failed to delete the ECS capacity provider"
+)
+
+// SetupCapacityProvider adds a controller that reconciles ECS capacity
+// providers.
+func SetupCapacityProvider(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.CapacityProviderGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.CapacityProvider{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CapacityProviderGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ecs.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) ecs.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CapacityProvider)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ecs.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.CapacityProvider) (*awsecs.CapacityProvider, error) {
+	resp, err := e.client.DescribeCapacityProvidersWithContext(ctx, &awsecs.DescribeCapacityProvidersInput{
+		CapacityProviders: []*string{aws.String(meta.GetExternalName(cr))},
+		Include:           aws.StringSlice([]string{awsecs.CapacityProviderFieldTags}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Capacity providers that don't exist are reported as failures rather
+	// than as an error, and deleted ones remain visible as INACTIVE.
+	if len(resp.CapacityProviders) == 0 || aws.StringValue(resp.CapacityProviders[0].Status) == v1alpha1.CapacityProviderStatusInactive {
+		return nil, nil
+	}
+	return resp.CapacityProviders[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.CapacityProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ecs.GenerateCapacityProviderObservation(observed)
+	if cr.Status.AtProvider.UpdateStatus == v1alpha1.CapacityProviderUpdateStatusDeleteInProgress {
+		cr.SetConditions(xpv1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ecs.LateInitializeCapacityProvider(&cr.Spec.ForProvider, observed)
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ecs.IsCapacityProviderUpToDate(cr.Spec.ForProvider, observed) &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, ecs.TagsToMap(observed.Tags), cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.CapacityProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateCapacityProviderWithContext(ctx, ecs.GenerateCreateCapacityProviderInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.CapacityProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	// ECS rejects updates while a previous one is still in progress, so the
+	// update is retried on a later reconcile.
+	if !ecs.IsCapacityProviderUpToDate(cr.Spec.ForProvider, observed) &&
+		aws.StringValue(observed.UpdateStatus) != v1alpha1.CapacityProviderUpdateStatusUpdateInProgress {
+		if _, err := e.client.UpdateCapacityProviderWithContext(ctx, ecs.GenerateUpdateCapacityProviderInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	return managed.ExternalUpdate{}, ecs.UpdateTags(ctx, e.client, aws.StringValue(observed.CapacityProviderArn), cr.Spec.ForProvider.Tags, ecs.TagsToMap(observed.Tags))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.CapacityProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.UpdateStatus == v1alpha1.CapacityProviderUpdateStatusDeleteInProgress {
+		return nil
+	}
+
+	// A capacity provider can only be deleted once it is no longer
+	// associated with a cluster, so deletion is retried until the Cluster
+	// releases it.
+	_, err := e.client.DeleteCapacityProviderWithContext(ctx, &awsecs.DeleteCapacityProviderInput{
+		CapacityProvider: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(err, errDelete)
+}
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs/fake"
+)
+
+var (
+	capacityProviderName = "ec2"
+	capacityProviderARN  = "arn:aws:ecs:us-east-1:123456789012:capacity-provider/ec2"
+	autoScalingGroupARN  = "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:123:autoScalingGroupName/ecs"
+
+	errBoom = errors.New("boom")
+)
+
+type capacityProviderModifier func(*v1alpha1.CapacityProvider)
+
+func withConditions(c ...xpv1.Condition) capacityProviderModifier {
+	return func(r *v1alpha1.CapacityProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTargetCapacity(n int64) capacityProviderModifier {
+	return func(r *v1alpha1.CapacityProvider) {
+		r.Spec.ForProvider.AutoScalingGroupProvider.ManagedScaling.TargetCapacity = aws.Int64(n)
+	}
+}
+
+func withStatus(s v1alpha1.CapacityProviderObservation) capacityProviderModifier {
+	return func(r *v1alpha1.CapacityProvider) { r.Status.AtProvider = s }
+}
+
+func capacityProvider(m ...capacityProviderModifier) *v1alpha1.CapacityProvider {
+	cr := &v1alpha1.CapacityProvider{
+		Spec: v1alpha1.CapacityProviderSpec{
+			ForProvider: v1alpha1.CapacityProviderParameters{
+				AutoScalingGroupProvider: v1alpha1.AutoScalingGroupProvider{
+					AutoScalingGroupARN: autoScalingGroupARN,
+					ManagedScaling: &v1alpha1.ManagedScaling{
+						Status:                 aws.String(awsecs.ManagedScalingStatusEnabled),
+						TargetCapacity:         aws.Int64(100),
+						MinimumScalingStepSize: aws.Int64(1),
+						MaximumScalingStepSize: aws.Int64(10000),
+						InstanceWarmupPeriod:   aws.Int64(300),
+					},
+					ManagedTerminationProtection: aws.String(awsecs.ManagedTerminationProtectionDisabled),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, capacityProviderName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(updateStatus string) *awsecs.CapacityProvider {
+	return &awsecs.CapacityProvider{
+		CapacityProviderArn: aws.String(capacityProviderARN),
+		Name:                aws.String(capacityProviderName),
+		Status:              aws.String(v1alpha1.CapacityProviderStatusActive),
+		UpdateStatus:        aws.String(updateStatus),
+		AutoScalingGroupProvider: &awsecs.AutoScalingGroupProvider{
+			AutoScalingGroupArn: aws.String(autoScalingGroupARN),
+			ManagedScaling: &awsecs.ManagedScaling{
+				Status:                 aws.String(awsecs.ManagedScalingStatusEnabled),
+				TargetCapacity:         aws.Int64(100),
+				MinimumScalingStepSize: aws.Int64(1),
+				MaximumScalingStepSize: aws.Int64(10000),
+				InstanceWarmupPeriod:   aws.Int64(300),
+			},
+			ManagedTerminationProtection: aws.String(awsecs.ManagedTerminationProtectionDisabled),
+		},
+	}
+}
+
+func describe(c ...*awsecs.CapacityProvider) func(context.Context, *awsecs.DescribeCapacityProvidersInput, []request.Option) (*awsecs.DescribeCapacityProvidersOutput, error) {
+	return func(context.Context, *awsecs.DescribeCapacityProvidersInput, []request.Option) (*awsecs.DescribeCapacityProvidersOutput, error) {
+		return &awsecs.DescribeCapacityProvidersOutput{CapacityProviders: c}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.CapacityProvider
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.CapacityProvider
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeCapacityProvidersWithContext: describe(observed(awsecs.CapacityProviderUpdateStatusUpdateComplete)),
+			},
+			cr: capacityProvider(),
+			want: want{
+				cr: capacityProvider(withStatus(v1alpha1.CapacityProviderObservation{
+					CapacityProviderARN: capacityProviderARN,
+					Status:              v1alpha1.CapacityProviderStatusActive,
+					UpdateStatus:        awsecs.CapacityProviderUpdateStatusUpdateComplete,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TargetCapacityChanged": {
+			client: &fake.MockClient{
+				MockDescribeCapacityProvidersWithContext: describe(observed(awsecs.CapacityProviderUpdateStatusUpdateComplete)),
+			},
+			cr: capacityProvider(withTargetCapacity(80)),
+			want: want{
+				cr: capacityProvider(withTargetCapacity(80), withStatus(v1alpha1.CapacityProviderObservation{
+					CapacityProviderARN: capacityProviderARN,
+					Status:              v1alpha1.CapacityProviderStatusActive,
+					UpdateStatus:        awsecs.CapacityProviderUpdateStatusUpdateComplete,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DeleteInProgress": {
+			client: &fake.MockClient{
+				MockDescribeCapacityProvidersWithContext: describe(observed(v1alpha1.CapacityProviderUpdateStatusDeleteInProgress)),
+			},
+			cr: capacityProvider(),
+			want: want{
+				cr: capacityProvider(withStatus(v1alpha1.CapacityProviderObservation{
+					CapacityProviderARN: capacityProviderARN,
+					Status:              v1alpha1.CapacityProviderStatusActive,
+					UpdateStatus:        v1alpha1.CapacityProviderUpdateStatusDeleteInProgress,
+				}), withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Missing": {
+			client: &fake.MockClient{
+				MockDescribeCapacityProvidersWithContext: describe(),
+			},
+			cr: capacityProvider(),
+			want: want{
+				cr: capacityProvider(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeCapacityProvidersWithContext: func(context.Context, *awsecs.DescribeCapacityProvidersInput, []request.Option) (*awsecs.DescribeCapacityProvidersOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: capacityProvider(),
+			want: want{
+				cr:  capacityProvider(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		updated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr           *v1alpha1.CapacityProvider
+		updateStatus string
+		updateErr    error
+		want
+	}{
+		"TargetCapacityChanged": {
+			cr:           capacityProvider(withTargetCapacity(80)),
+			updateStatus: awsecs.CapacityProviderUpdateStatusUpdateComplete,
+			want: want{
+				updated: true,
+			},
+		},
+		"UpdateInProgress": {
+			cr:           capacityProvider(withTargetCapacity(80)),
+			updateStatus: v1alpha1.CapacityProviderUpdateStatusUpdateInProgress,
+			want:         want{},
+		},
+		"UpdateFailed": {
+			cr:           capacityProvider(withTargetCapacity(80)),
+			updateStatus: awsecs.CapacityProviderUpdateStatusUpdateComplete,
+			updateErr:    errBoom,
+			want: want{
+				updated: true,
+				err:     awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			e := &external{client: &fake.MockClient{
+				MockDescribeCapacityProvidersWithContext: describe(observed(tc.updateStatus)),
+				MockUpdateCapacityProviderWithContext: func(_ context.Context, input *awsecs.UpdateCapacityProviderInput, _ []request.Option) (*awsecs.UpdateCapacityProviderOutput, error) {
+					updated = aws.Int64Value(input.AutoScalingGroupProvider.ManagedScaling.TargetCapacity) == 80
+					return &awsecs.UpdateCapacityProviderOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("updated: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.CapacityProvider
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.CapacityProvider
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteCapacityProviderWithContext: func(_ context.Context, input *awsecs.DeleteCapacityProviderInput, _ []request.Option) (*awsecs.DeleteCapacityProviderOutput, error) {
+					if aws.StringValue(input.CapacityProvider) != capacityProviderName {
+						return nil, errors.New("unexpected capacity provider")
+					}
+					return &awsecs.DeleteCapacityProviderOutput{}, nil
+				},
+			},
+			cr: capacityProvider(),
+			want: want{
+				cr: capacityProvider(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteInProgress": {
+			client: &fake.MockClient{},
+			cr:     capacityProvider(withStatus(v1alpha1.CapacityProviderObservation{UpdateStatus: v1alpha1.CapacityProviderUpdateStatusDeleteInProgress})),
+			want: want{
+				cr: capacityProvider(withStatus(v1alpha1.CapacityProviderObservation{UpdateStatus: v1alpha1.CapacityProviderUpdateStatusDeleteInProgress}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteCapacityProviderWithContext: func(context.Context, *awsecs.DeleteCapacityProviderInput, []request.Option) (*awsecs.DeleteCapacityProviderOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: capacityProvider(),
+			want: want{
+				cr:  capacityProvider(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+const (
+	errUnexpectedObject    = "managed resource is not an ECS Cluster resource"
+	errCreateSession       = "cannot create a new session"
+	errDescribe            = "failed to describe the ECS cluster"
+	errCreate              = "failed to create the ECS cluster"
+	errUpdateSettings      = "failed to update the settings of the ECS cluster"
+	errUpdateConfiguration = "failed to update the configuration of the ECS cluster"
+	errUpdateCapacity      = "failed to update the capacity providers of the ECS cluster"
+	errDelete              = "failed to delete the ECS cluster"
+)
+
+// SetupCluster adds a controller that reconciles ECS clusters.
+func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ClusterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Cluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ecs.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) ecs.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ecs.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Cluster) (*awsecs.Cluster, error) {
+	resp, err := e.client.DescribeClustersWithContext(ctx, &awsecs.DescribeClustersInput{
+		Clusters: []*string{aws.String(meta.GetExternalName(cr))},
+		Include:  aws.StringSlice([]string{awsecs.ClusterFieldConfigurations, awsecs.ClusterFieldSettings, awsecs.ClusterFieldTags}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Clusters that don't exist are reported as failures rather than as an
+	// error, and deleted clusters remain visible as INACTIVE for a while.
+	if len(resp.Clusters) == 0 || aws.StringValue(resp.Clusters[0].Status) == v1alpha1.ClusterStatusInactive {
+		return nil, nil
+	}
+	return resp.Clusters[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ecs.IsClusterNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ecs.LateInitializeCluster(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ecs.GenerateClusterObservation(observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.ClusterStatusActive:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.ClusterStatusProvisioning:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.ClusterStatusDeprovisioning:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ecs.IsClusterUpToDate(cr.Spec.ForProvider, observed) &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, ecs.TagsToMap(observed.Tags), cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateClusterWithContext(ctx, ecs.GenerateCreateClusterInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	if !ecs.IsClusterSettingsUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.UpdateClusterSettingsWithContext(ctx, ecs.GenerateUpdateClusterSettingsInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateSettings)
+		}
+	}
+	if !ecs.IsClusterConfigurationUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.UpdateClusterWithContext(ctx, ecs.GenerateUpdateClusterInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateConfiguration)
+		}
+	}
+	if !ecs.IsClusterCapacityProvidersUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.PutClusterCapacityProvidersWithContext(ctx, ecs.GeneratePutClusterCapacityProvidersInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateCapacity)
+		}
+	}
+
+	return managed.ExternalUpdate{}, ecs.UpdateTags(ctx, e.client, aws.StringValue(observed.ClusterArn), cr.Spec.ForProvider.Tags, ecs.TagsToMap(observed.Tags))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Cluster)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.ClusterStatusDeprovisioning {
+		return nil
+	}
+
+	_, err := e.client.DeleteClusterWithContext(ctx, &awsecs.DeleteClusterInput{
+		Cluster: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ecs.IsClusterNotFound, err), errDelete)
+}
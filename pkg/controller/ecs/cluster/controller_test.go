@@ -0,0 +1,641 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs/fake"
+)
+
+var (
+	clusterName = "web"
+	clusterARN  = "arn:aws:ecs:us-east-1:123456789012:cluster/web"
+
+	errBoom = errors.New("boom")
+)
+
+type clusterModifier func(*v1alpha1.Cluster)
+
+func withConditions(c ...xpv1.Condition) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSettings(s ...v1alpha1.ClusterSetting) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.Settings = s }
+}
+
+func withLogging(l string) clusterModifier {
+	return func(r *v1alpha1.Cluster) {
+		r.Spec.ForProvider.Configuration = &v1alpha1.ClusterConfiguration{
+			ExecuteCommandConfiguration: &v1alpha1.ExecuteCommandConfiguration{Logging: aws.String(l)},
+		}
+	}
+}
+
+func withCapacityProviders(p ...string) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.CapacityProviders = p }
+}
+
+func withDefaultCapacityProviderStrategy(s ...v1alpha1.CapacityProviderStrategyItem) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.DefaultCapacityProviderStrategy = s }
+}
+
+func withTags(t map[string]string) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.Tags = t }
+}
+
+func withStatus(s v1alpha1.ClusterObservation) clusterModifier {
+	return func(r *v1alpha1.Cluster) { r.Status.AtProvider = s }
+}
+
+func cluster(m ...clusterModifier) *v1alpha1.Cluster {
+	cr := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			ForProvider: v1alpha1.ClusterParameters{
+				Region:            "us-east-1",
+				Settings:          []v1alpha1.ClusterSetting{{Name: "containerInsights", Value: "enabled"}},
+				CapacityProviders: []string{"FARGATE", "FARGATE_SPOT"},
+				DefaultCapacityProviderStrategy: []v1alpha1.CapacityProviderStrategyItem{
+					{CapacityProvider: "FARGATE", Base: aws.Int64(1), Weight: aws.Int64(1)},
+				},
+				Tags: map[string]string{"team": "platform"},
+			},
+		},
+	}
+	meta.SetExternalName(cr, clusterName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status string) *awsecs.Cluster {
+	return &awsecs.Cluster{
+		ClusterArn:        aws.String(clusterARN),
+		ClusterName:       aws.String(clusterName),
+		Status:            aws.String(status),
+		RunningTasksCount: aws.Int64(3),
+		Settings: []*awsecs.ClusterSetting{
+			{Name: aws.String("containerInsights"), Value: aws.String("enabled")},
+		},
+		CapacityProviders: aws.StringSlice([]string{"FARGATE_SPOT", "FARGATE"}),
+		DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+			{CapacityProvider: aws.String("FARGATE"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+		},
+		Tags: []*awsecs.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+	}
+}
+
+func observation(status string) v1alpha1.ClusterObservation {
+	return v1alpha1.ClusterObservation{
+		ClusterARN:        clusterARN,
+		Status:            status,
+		RunningTasksCount: 3,
+	}
+}
+
+func describe(c ...*awsecs.Cluster) func(context.Context, *awsecs.DescribeClustersInput, []request.Option) (*awsecs.DescribeClustersOutput, error) {
+	return func(_ context.Context, input *awsecs.DescribeClustersInput, _ []request.Option) (*awsecs.DescribeClustersOutput, error) {
+		if len(input.Clusters) != 1 || aws.StringValue(input.Clusters[0]) != clusterName {
+			return nil, errors.New("unexpected cluster")
+		}
+		return &awsecs.DescribeClustersOutput{Clusters: c}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Cluster
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Cluster
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusActive)),
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withStatus(observation(v1alpha1.ClusterStatusActive)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusActive)),
+			},
+			cr: cluster(withSettings(), withCapacityProviders(), withDefaultCapacityProviderStrategy()),
+			want: want{
+				cr: cluster(withCapacityProviders("FARGATE_SPOT", "FARGATE"),
+					withStatus(observation(v1alpha1.ClusterStatusActive)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Provisioning": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusProvisioning)),
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withStatus(observation(v1alpha1.ClusterStatusProvisioning)), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deprovisioning": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusDeprovisioning)),
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withStatus(observation(v1alpha1.ClusterStatusDeprovisioning)), withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Failed": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusFailed)),
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withStatus(observation(v1alpha1.ClusterStatusFailed)), withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SettingsChanged": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusActive)),
+			},
+			cr: cluster(withSettings(v1alpha1.ClusterSetting{Name: "containerInsights", Value: "disabled"})),
+			want: want{
+				cr: cluster(withSettings(v1alpha1.ClusterSetting{Name: "containerInsights", Value: "disabled"}),
+					withStatus(observation(v1alpha1.ClusterStatusActive)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ConfigurationChanged": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusActive)),
+			},
+			cr: cluster(withLogging(awsecs.ExecuteCommandLoggingDefault)),
+			want: want{
+				cr: cluster(withLogging(awsecs.ExecuteCommandLoggingDefault),
+					withStatus(observation(v1alpha1.ClusterStatusActive)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"StrategyChanged": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusActive)),
+			},
+			cr: cluster(withDefaultCapacityProviderStrategy(v1alpha1.CapacityProviderStrategyItem{CapacityProvider: "FARGATE_SPOT", Weight: aws.Int64(1)})),
+			want: want{
+				cr: cluster(withDefaultCapacityProviderStrategy(v1alpha1.CapacityProviderStrategyItem{CapacityProvider: "FARGATE_SPOT", Weight: aws.Int64(1)}),
+					withStatus(observation(v1alpha1.ClusterStatusActive)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusActive)),
+			},
+			cr: cluster(withTags(map[string]string{"team": "web"})),
+			want: want{
+				cr: cluster(withTags(map[string]string{"team": "web"}),
+					withStatus(observation(v1alpha1.ClusterStatusActive)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Inactive": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(observed(v1alpha1.ClusterStatusInactive)),
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"Missing": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: describe(),
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: func(context.Context, *awsecs.DescribeClustersInput, []request.Option) (*awsecs.DescribeClustersOutput, error) {
+					return nil, awserr.New(awsecs.ErrCodeClusterNotFoundException, "not found", nil)
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeClustersWithContext: func(context.Context, *awsecs.DescribeClustersInput, []request.Option) (*awsecs.DescribeClustersOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr:  cluster(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.Cluster
+		input *awsecs.CreateClusterInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Cluster
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: cluster(withLogging(awsecs.ExecuteCommandLoggingDefault)),
+			want: want{
+				cr: cluster(withLogging(awsecs.ExecuteCommandLoggingDefault), withConditions(xpv1.Creating())),
+				input: &awsecs.CreateClusterInput{
+					ClusterName: aws.String(clusterName),
+					Settings: []*awsecs.ClusterSetting{
+						{Name: aws.String("containerInsights"), Value: aws.String("enabled")},
+					},
+					Configuration: &awsecs.ClusterConfiguration{
+						ExecuteCommandConfiguration: &awsecs.ExecuteCommandConfiguration{Logging: aws.String(awsecs.ExecuteCommandLoggingDefault)},
+					},
+					Tags:              []*awsecs.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+					CapacityProviders: aws.StringSlice([]string{"FARGATE", "FARGATE_SPOT"}),
+					DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+						{CapacityProvider: aws.String("FARGATE"), Base: aws.Int64(1), Weight: aws.Int64(1)},
+					},
+				},
+			},
+		},
+		"CreateFailed": {
+			cr:        cluster(withSettings(), withCapacityProviders(), withDefaultCapacityProviderStrategy(), withTags(nil)),
+			createErr: errBoom,
+			want: want{
+				cr:    cluster(withSettings(), withCapacityProviders(), withDefaultCapacityProviderStrategy(), withTags(nil), withConditions(xpv1.Creating())),
+				input: &awsecs.CreateClusterInput{ClusterName: aws.String(clusterName)},
+				err:   awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsecs.CreateClusterInput
+			e := &external{client: &fake.MockClient{
+				MockCreateClusterWithContext: func(_ context.Context, in *awsecs.CreateClusterInput, _ []request.Option) (*awsecs.CreateClusterOutput, error) {
+					input = in
+					return &awsecs.CreateClusterOutput{}, tc.createErr
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		settings *awsecs.UpdateClusterSettingsInput
+		config   *awsecs.UpdateClusterInput
+		capacity *awsecs.PutClusterCapacityProvidersInput
+		tag      *awsecs.TagResourceInput
+		untag    *awsecs.UntagResourceInput
+		err      error
+	}
+
+	cases := map[string]struct {
+		cr          *v1alpha1.Cluster
+		describeErr error
+		settingsErr error
+		configErr   error
+		capacityErr error
+		want
+	}{
+		"UpToDate": {
+			cr:   cluster(),
+			want: want{},
+		},
+		"SettingsChanged": {
+			cr: cluster(withSettings(v1alpha1.ClusterSetting{Name: "containerInsights", Value: "disabled"})),
+			want: want{
+				settings: &awsecs.UpdateClusterSettingsInput{
+					Cluster: aws.String(clusterName),
+					Settings: []*awsecs.ClusterSetting{
+						{Name: aws.String("containerInsights"), Value: aws.String("disabled")},
+					},
+				},
+			},
+		},
+		"ConfigurationChanged": {
+			cr: cluster(withLogging(awsecs.ExecuteCommandLoggingNone)),
+			want: want{
+				config: &awsecs.UpdateClusterInput{
+					Cluster: aws.String(clusterName),
+					Configuration: &awsecs.ClusterConfiguration{
+						ExecuteCommandConfiguration: &awsecs.ExecuteCommandConfiguration{Logging: aws.String(awsecs.ExecuteCommandLoggingNone)},
+					},
+				},
+			},
+		},
+		"StrategyRemoved": {
+			cr: cluster(withCapacityProviders("FARGATE"), withDefaultCapacityProviderStrategy()),
+			want: want{
+				capacity: &awsecs.PutClusterCapacityProvidersInput{
+					Cluster:                         aws.String(clusterName),
+					CapacityProviders:               aws.StringSlice([]string{"FARGATE"}),
+					DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{},
+				},
+			},
+		},
+		"TagsChanged": {
+			cr: cluster(withTags(map[string]string{"env": "prod"})),
+			want: want{
+				tag: &awsecs.TagResourceInput{
+					ResourceArn: aws.String(clusterARN),
+					Tags:        []*awsecs.Tag{{Key: aws.String("env"), Value: aws.String("prod")}},
+				},
+				untag: &awsecs.UntagResourceInput{
+					ResourceArn: aws.String(clusterARN),
+					TagKeys:     aws.StringSlice([]string{"team"}),
+				},
+			},
+		},
+		"DescribeFailed": {
+			cr:          cluster(),
+			describeErr: errBoom,
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpdateSettingsFailed": {
+			cr:          cluster(withSettings(v1alpha1.ClusterSetting{Name: "containerInsights", Value: "disabled"})),
+			settingsErr: errBoom,
+			want: want{
+				settings: &awsecs.UpdateClusterSettingsInput{
+					Cluster: aws.String(clusterName),
+					Settings: []*awsecs.ClusterSetting{
+						{Name: aws.String("containerInsights"), Value: aws.String("disabled")},
+					},
+				},
+				err: awsclient.Wrap(errBoom, errUpdateSettings),
+			},
+		},
+		"UpdateConfigurationFailed": {
+			cr:        cluster(withLogging(awsecs.ExecuteCommandLoggingNone)),
+			configErr: errBoom,
+			want: want{
+				config: &awsecs.UpdateClusterInput{
+					Cluster: aws.String(clusterName),
+					Configuration: &awsecs.ClusterConfiguration{
+						ExecuteCommandConfiguration: &awsecs.ExecuteCommandConfiguration{Logging: aws.String(awsecs.ExecuteCommandLoggingNone)},
+					},
+				},
+				err: awsclient.Wrap(errBoom, errUpdateConfiguration),
+			},
+		},
+		"UpdateCapacityFailed": {
+			cr:          cluster(withCapacityProviders("FARGATE"), withDefaultCapacityProviderStrategy()),
+			capacityErr: errBoom,
+			want: want{
+				capacity: &awsecs.PutClusterCapacityProvidersInput{
+					Cluster:                         aws.String(clusterName),
+					CapacityProviders:               aws.StringSlice([]string{"FARGATE"}),
+					DefaultCapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{},
+				},
+				err: awsclient.Wrap(errBoom, errUpdateCapacity),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			e := &external{client: &fake.MockClient{
+				MockDescribeClustersWithContext: func(ctx context.Context, input *awsecs.DescribeClustersInput, opts []request.Option) (*awsecs.DescribeClustersOutput, error) {
+					if tc.describeErr != nil {
+						return nil, tc.describeErr
+					}
+					return describe(observed(v1alpha1.ClusterStatusActive))(ctx, input, opts)
+				},
+				MockUpdateClusterSettingsWithContext: func(_ context.Context, in *awsecs.UpdateClusterSettingsInput, _ []request.Option) (*awsecs.UpdateClusterSettingsOutput, error) {
+					got.settings = in
+					return &awsecs.UpdateClusterSettingsOutput{}, tc.settingsErr
+				},
+				MockUpdateClusterWithContext: func(_ context.Context, in *awsecs.UpdateClusterInput, _ []request.Option) (*awsecs.UpdateClusterOutput, error) {
+					got.config = in
+					return &awsecs.UpdateClusterOutput{}, tc.configErr
+				},
+				MockPutClusterCapacityProvidersWithContext: func(_ context.Context, in *awsecs.PutClusterCapacityProvidersInput, _ []request.Option) (*awsecs.PutClusterCapacityProvidersOutput, error) {
+					got.capacity = in
+					return &awsecs.PutClusterCapacityProvidersOutput{}, tc.capacityErr
+				},
+				MockTagResourceWithContext: func(_ context.Context, in *awsecs.TagResourceInput, _ []request.Option) (*awsecs.TagResourceOutput, error) {
+					got.tag = in
+					return &awsecs.TagResourceOutput{}, nil
+				},
+				MockUntagResourceWithContext: func(_ context.Context, in *awsecs.UntagResourceInput, _ []request.Option) (*awsecs.UntagResourceOutput, error) {
+					got.untag = in
+					return &awsecs.UntagResourceOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.settings, got.settings); diff != "" {
+				t.Errorf("settings: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.config, got.config); diff != "" {
+				t.Errorf("configuration: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.capacity, got.capacity); diff != "" {
+				t.Errorf("capacity providers: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tag, got.tag); diff != "" {
+				t.Errorf("tag: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.untag, got.untag); diff != "" {
+				t.Errorf("untag: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Cluster
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Cluster
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteClusterWithContext: func(_ context.Context, input *awsecs.DeleteClusterInput, _ []request.Option) (*awsecs.DeleteClusterOutput, error) {
+					if aws.StringValue(input.Cluster) != clusterName {
+						return nil, errors.New("unexpected cluster")
+					}
+					return &awsecs.DeleteClusterOutput{}, nil
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Deprovisioning": {
+			client: &fake.MockClient{},
+			cr:     cluster(withStatus(v1alpha1.ClusterObservation{Status: v1alpha1.ClusterStatusDeprovisioning})),
+			want: want{
+				cr: cluster(withStatus(v1alpha1.ClusterObservation{Status: v1alpha1.ClusterStatusDeprovisioning}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteClusterWithContext: func(context.Context, *awsecs.DeleteClusterInput, []request.Option) (*awsecs.DeleteClusterOutput, error) {
+					return nil, awserr.New(awsecs.ErrCodeClusterNotFoundException, "not found", nil)
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr: cluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteClusterWithContext: func(context.Context, *awsecs.DeleteClusterInput, []request.Option) (*awsecs.DeleteClusterOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: cluster(),
+			want: want{
+				cr:  cluster(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+const (
+	errUnexpectedObject       = "managed resource is not an ECS Service resource"
+	errCreateSession          = "cannot create a new session"
+	errDescribe               = "failed to describe the ECS service"
+	errDescribeTaskDefinition = "failed to describe the task definition of the ECS service"
+	errCreate                 = "failed to create the ECS service"
+	errUpdate                 = "failed to update the ECS service"
+	errDelete                 = "failed to delete the ECS service"
+
+	errDescribeScalableTarget   = "failed to describe the scalable target of the ECS service"
+	errDescribeScalingPolicies  = "failed to describe the scaling policies of the ECS service"
+	errRegisterScalableTarget   = "failed to register the scalable target of the ECS service"
+	errPutScalingPolicy         = "failed to put a scaling policy of the ECS service"
+	errDeleteScalingPolicy      = "failed to delete a scaling policy of the ECS service"
+	errDeregisterScalableTarget = "failed to deregister the scalable target of the ECS service"
+)
+
+// SetupService adds a controller that reconciles ECS services.
+func SetupService(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ServiceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Service{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ecs.NewClient, newAutoScalingClientFn: ecs.NewAutoScalingClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube                   client.Client
+	newClientFn            func(sess *session.Session) ecs.Client
+	newAutoScalingClientFn func(sess *session.Session) ecs.AutoScalingClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), autoScaling: c.newAutoScalingClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube        client.Client
+	client      ecs.Client
+	autoScaling ecs.AutoScalingClient
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Service) (*awsecs.Service, error) {
+	resp, err := e.client.DescribeServicesWithContext(ctx, &awsecs.DescribeServicesInput{
+		Cluster:  cr.Spec.ForProvider.Cluster,
+		Services: []*string{aws.String(meta.GetExternalName(cr))},
+		Include:  aws.StringSlice([]string{awsecs.ServiceFieldTags}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Services that don't exist are reported as failures rather than as an
+	// error, and deleted services remain visible as INACTIVE for a while.
+	if len(resp.Services) == 0 || aws.StringValue(resp.Services[0].Status) == v1alpha1.ServiceStatusInactive {
+		return nil, nil
+	}
+	return resp.Services[0], nil
+}
+
+// taskDefinition returns the ARN of the task definition revision the service
+// should run. A family resolves to its latest ACTIVE revision, so that the
+// service is rolled out whenever a new revision is registered.
+func (e *external) taskDefinition(ctx context.Context, cr *v1alpha1.Service) (string, error) {
+	if cr.Spec.ForProvider.TaskDefinition == nil {
+		return "", nil
+	}
+	resp, err := e.client.DescribeTaskDefinitionWithContext(ctx, &awsecs.DescribeTaskDefinitionInput{
+		TaskDefinition: cr.Spec.ForProvider.TaskDefinition,
+	})
+	if err != nil || resp.TaskDefinition == nil {
+		return "", err
+	}
+	return aws.StringValue(resp.TaskDefinition.TaskDefinitionArn), nil
+}
+
+// scalableTarget returns the scalable target of the service with the given
+// Application Auto Scaling resource ID, or nil if it isn't registered.
+func (e *external) scalableTarget(ctx context.Context, resourceID string) (*applicationautoscaling.ScalableTarget, error) {
+	resp, err := e.autoScaling.DescribeScalableTargetsWithContext(ctx, &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceEcs),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+		ResourceIds:       aws.StringSlice([]string{resourceID}),
+	})
+	if err != nil || len(resp.ScalableTargets) == 0 {
+		return nil, awsclient.Wrap(err, errDescribeScalableTarget)
+	}
+	return resp.ScalableTargets[0], nil
+}
+
+// scalingPolicies returns the scaling policies of the service with the given
+// Application Auto Scaling resource ID.
+func (e *external) scalingPolicies(ctx context.Context, resourceID string) ([]*applicationautoscaling.ScalingPolicy, error) {
+	var res []*applicationautoscaling.ScalingPolicy
+	input := &applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceEcs),
+		ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+		ResourceId:        aws.String(resourceID),
+	}
+	for {
+		resp, err := e.autoScaling.DescribeScalingPoliciesWithContext(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errDescribeScalingPolicies)
+		}
+		res = append(res, resp.ScalingPolicies...)
+		if resp.NextToken == nil {
+			return res, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+// isAutoScalingUpToDate checks whether the scalable target and scaling
+// policies of the service are up to date, and records the ARNs of the
+// policies.
+func (e *external) isAutoScalingUpToDate(ctx context.Context, cr *v1alpha1.Service, observed *awsecs.Service) (bool, error) {
+	a := cr.Spec.ForProvider.AutoScaling
+	if a == nil {
+		return true, nil
+	}
+	resourceID := ecs.ServiceResourceID(aws.StringValue(observed.ClusterArn), meta.GetExternalName(cr))
+	target, err := e.scalableTarget(ctx, resourceID)
+	if err != nil {
+		return false, err
+	}
+	policies, err := e.scalingPolicies(ctx, resourceID)
+	if err != nil {
+		return false, err
+	}
+	cr.Status.AtProvider.ScalingPolicyARNs = nil
+	for _, p := range policies {
+		cr.Status.AtProvider.ScalingPolicyARNs = append(cr.Status.AtProvider.ScalingPolicyARNs, aws.StringValue(p.PolicyARN))
+	}
+	put, remove := ecs.DiffScalingPolicies(a.TargetTrackingPolicies, policies)
+	return ecs.IsScalableTargetUpToDate(a, target) && len(put) == 0 && len(remove) == 0, nil
+}
+
+// updateAutoScaling registers the scalable target of the service and makes
+// its scaling policies match the desired ones.
+func (e *external) updateAutoScaling(ctx context.Context, cr *v1alpha1.Service, observed *awsecs.Service) error {
+	a := cr.Spec.ForProvider.AutoScaling
+	if a == nil {
+		return nil
+	}
+	resourceID := ecs.ServiceResourceID(aws.StringValue(observed.ClusterArn), meta.GetExternalName(cr))
+	target, err := e.scalableTarget(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+	if !ecs.IsScalableTargetUpToDate(a, target) {
+		if _, err := e.autoScaling.RegisterScalableTargetWithContext(ctx, ecs.GenerateRegisterScalableTargetInput(resourceID, a)); err != nil {
+			return awsclient.Wrap(err, errRegisterScalableTarget)
+		}
+	}
+	policies, err := e.scalingPolicies(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+	put, remove := ecs.DiffScalingPolicies(a.TargetTrackingPolicies, policies)
+	for _, p := range put {
+		if _, err := e.autoScaling.PutScalingPolicyWithContext(ctx, ecs.GeneratePutScalingPolicyInput(resourceID, p)); err != nil {
+			return awsclient.Wrap(err, errPutScalingPolicy)
+		}
+	}
+	for _, name := range remove {
+		_, err := e.autoScaling.DeleteScalingPolicyWithContext(ctx, &applicationautoscaling.DeleteScalingPolicyInput{
+			ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceEcs),
+			ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+			ResourceId:        aws.String(resourceID),
+			PolicyName:        aws.String(name),
+		})
+		if resource.Ignore(ecs.IsScalableTargetNotFound, err) != nil {
+			return awsclient.Wrap(err, errDeleteScalingPolicy)
+		}
+	}
+	return nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ecs.IsClusterNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ecs.GenerateServiceObservation(observed)
+	// A deleted service drains its tasks before it becomes INACTIVE.
+	if cr.Status.AtProvider.Status == v1alpha1.ServiceStatusDraining {
+		cr.SetConditions(xpv1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	taskDefinition, err := e.taskDefinition(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeTaskDefinition)
+	}
+
+	autoScalingUpToDate, err := e.isAutoScalingUpToDate(ctx, cr, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ecs.LateInitializeService(&cr.Spec.ForProvider, observed)
+
+	cr.SetConditions(xpv1.Available())
+	if cr.Status.AtProvider.RolloutState != "" {
+		cr.SetConditions(ecs.RolloutCondition(cr.Status.AtProvider.RolloutState, cr.Status.AtProvider.RolloutStateReason))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ecs.IsServiceUpToDate(cr.Spec.ForProvider, taskDefinition, observed) &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, ecs.TagsToMap(observed.Tags), cmpopts.EquateEmpty()) &&
+			autoScalingUpToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateServiceWithContext(ctx, ecs.GenerateCreateServiceInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	taskDefinition, err := e.taskDefinition(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeTaskDefinition)
+	}
+
+	if !ecs.IsServiceUpToDate(cr.Spec.ForProvider, taskDefinition, observed) {
+		if _, err := e.client.UpdateServiceWithContext(ctx, ecs.GenerateUpdateServiceInput(meta.GetExternalName(cr), taskDefinition, cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+	if err := e.updateAutoScaling(ctx, cr, observed); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, ecs.UpdateTags(ctx, e.client, aws.StringValue(observed.ServiceArn), cr.Spec.ForProvider.Tags, ecs.TagsToMap(observed.Tags))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Service)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.ServiceStatusDraining {
+		return nil
+	}
+
+	// The scalable target outlives the service, and deregistering it deletes
+	// its scaling policies.
+	if cr.Spec.ForProvider.AutoScaling != nil {
+		observed, err := e.describe(ctx, cr)
+		if err != nil {
+			return awsclient.Wrap(resource.Ignore(ecs.IsClusterNotFound, err), errDescribe)
+		}
+		if observed != nil {
+			_, err := e.autoScaling.DeregisterScalableTargetWithContext(ctx, &applicationautoscaling.DeregisterScalableTargetInput{
+				ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceEcs),
+				ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+				ResourceId:        aws.String(ecs.ServiceResourceID(aws.StringValue(observed.ClusterArn), meta.GetExternalName(cr))),
+			})
+			if resource.Ignore(ecs.IsScalableTargetNotFound, err) != nil {
+				return awsclient.Wrap(err, errDeregisterScalableTarget)
+			}
+		}
+	}
+
+	// Force deletes the service without scaling it to zero tasks first.
+	_, err := e.client.DeleteServiceWithContext(ctx, &awsecs.DeleteServiceInput{
+		Cluster: cr.Spec.ForProvider.Cluster,
+		Service: aws.String(meta.GetExternalName(cr)),
+		Force:   aws.Bool(true),
+	})
+	return awsclient.Wrap(resource.Ignore(ecs.IsServiceNotFound, err), errDelete)
+}
@@ -0,0 +1,709 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs/fake"
+)
+
+var (
+	serviceName = "web"
+	serviceARN  = "arn:aws:ecs:us-east-1:123456789012:service/default/web"
+	clusterARN  = "arn:aws:ecs:us-east-1:123456789012:cluster/default"
+	resourceID  = "service/default/web"
+	policyARN   = "arn:aws:autoscaling:us-east-1:123456789012:scalingPolicy:1:resource/ecs/service/default/web:policyName/cpu"
+	revision1   = "arn:aws:ecs:us-east-1:123456789012:task-definition/web:1"
+	revision2   = "arn:aws:ecs:us-east-1:123456789012:task-definition/web:2"
+
+	errBoom = errors.New("boom")
+)
+
+type serviceModifier func(*v1alpha1.Service)
+
+func withConditions(c ...xpv1.Condition) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDesiredCount(n int64) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Spec.ForProvider.DesiredCount = aws.Int64(n) }
+}
+
+func withAutoScaling(a *v1alpha1.ServiceAutoScaling) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Spec.ForProvider.AutoScaling = a }
+}
+
+func withStatus(s v1alpha1.ServiceObservation) serviceModifier {
+	return func(r *v1alpha1.Service) { r.Status.AtProvider = s }
+}
+
+func service(m ...serviceModifier) *v1alpha1.Service {
+	cr := &v1alpha1.Service{
+		Spec: v1alpha1.ServiceSpec{
+			ForProvider: v1alpha1.ServiceParameters{
+				Cluster:            aws.String("default"),
+				TaskDefinition:     aws.String("web"),
+				DesiredCount:       aws.Int64(2),
+				LaunchType:         aws.String(awsecs.LaunchTypeFargate),
+				SchedulingStrategy: aws.String(awsecs.SchedulingStrategyReplica),
+				PropagateTags:      aws.String(awsecs.PropagateTagsNone),
+				DeploymentConfiguration: &v1alpha1.DeploymentConfiguration{
+					MaximumPercent:        aws.Int64(200),
+					MinimumHealthyPercent: aws.Int64(100),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, serviceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status, taskDefinition string) *awsecs.Service {
+	return &awsecs.Service{
+		ServiceArn:         aws.String(serviceARN),
+		ServiceName:        aws.String(serviceName),
+		ClusterArn:         aws.String(clusterARN),
+		Status:             aws.String(status),
+		TaskDefinition:     aws.String(taskDefinition),
+		DesiredCount:       aws.Int64(2),
+		RunningCount:       aws.Int64(2),
+		LaunchType:         aws.String(awsecs.LaunchTypeFargate),
+		SchedulingStrategy: aws.String(awsecs.SchedulingStrategyReplica),
+		PropagateTags:      aws.String(awsecs.PropagateTagsNone),
+		DeploymentConfiguration: &awsecs.DeploymentConfiguration{
+			MaximumPercent:        aws.Int64(200),
+			MinimumHealthyPercent: aws.Int64(100),
+		},
+	}
+}
+
+func describeServices(s ...*awsecs.Service) func(context.Context, *awsecs.DescribeServicesInput, []request.Option) (*awsecs.DescribeServicesOutput, error) {
+	return func(context.Context, *awsecs.DescribeServicesInput, []request.Option) (*awsecs.DescribeServicesOutput, error) {
+		return &awsecs.DescribeServicesOutput{Services: s}, nil
+	}
+}
+
+func describeTaskDefinition(arn string) func(context.Context, *awsecs.DescribeTaskDefinitionInput, []request.Option) (*awsecs.DescribeTaskDefinitionOutput, error) {
+	return func(context.Context, *awsecs.DescribeTaskDefinitionInput, []request.Option) (*awsecs.DescribeTaskDefinitionOutput, error) {
+		return &awsecs.DescribeTaskDefinitionOutput{TaskDefinition: &awsecs.TaskDefinition{TaskDefinitionArn: aws.String(arn)}}, nil
+	}
+}
+
+func describeScalableTargets(t ...*applicationautoscaling.ScalableTarget) func(context.Context, *applicationautoscaling.DescribeScalableTargetsInput, []request.Option) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	return func(_ context.Context, input *applicationautoscaling.DescribeScalableTargetsInput, _ []request.Option) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+		if aws.StringValue(input.ResourceIds[0]) != resourceID {
+			return nil, errors.New("unexpected resource ID")
+		}
+		return &applicationautoscaling.DescribeScalableTargetsOutput{ScalableTargets: t}, nil
+	}
+}
+
+func describeScalingPolicies(p ...*applicationautoscaling.ScalingPolicy) func(context.Context, *applicationautoscaling.DescribeScalingPoliciesInput, []request.Option) (*applicationautoscaling.DescribeScalingPoliciesOutput, error) {
+	return func(context.Context, *applicationautoscaling.DescribeScalingPoliciesInput, []request.Option) (*applicationautoscaling.DescribeScalingPoliciesOutput, error) {
+		return &applicationautoscaling.DescribeScalingPoliciesOutput{ScalingPolicies: p}, nil
+	}
+}
+
+var cpuPolicy = v1alpha1.TargetTrackingPolicy{
+	PolicyName:           "cpu",
+	PredefinedMetricType: v1alpha1.MetricTypeCPUUtilization,
+	TargetValue:          50,
+}
+
+var observedCPUPolicy = &applicationautoscaling.ScalingPolicy{
+	PolicyARN:  aws.String(policyARN),
+	PolicyName: aws.String("cpu"),
+	PolicyType: aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+	TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+		PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String(v1alpha1.MetricTypeCPUUtilization),
+		},
+		TargetValue: aws.Float64(50),
+	},
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Service
+		result managed.ExternalObservation
+		err    error
+	}
+
+	activeObservation := v1alpha1.ServiceObservation{
+		ServiceARN:     serviceARN,
+		Status:         v1alpha1.ServiceStatusActive,
+		TaskDefinition: revision1,
+		RunningCount:   2,
+		DesiredCount:   2,
+	}
+
+	cases := map[string]struct {
+		client      *fake.MockClient
+		autoScaling *fake.MockAutoScalingClient
+		cr          *v1alpha1.Service
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext:       describeServices(observed(v1alpha1.ServiceStatusActive, revision1)),
+				MockDescribeTaskDefinitionWithContext: describeTaskDefinition(revision1),
+			},
+			cr: service(),
+			want: want{
+				cr: service(withStatus(activeObservation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RolloutFailed": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: describeServices(func() *awsecs.Service {
+					s := observed(v1alpha1.ServiceStatusActive, revision1)
+					s.Deployments = []*awsecs.Deployment{{
+						Status:             aws.String("PRIMARY"),
+						RolloutState:       aws.String(awsecs.DeploymentRolloutStateFailed),
+						RolloutStateReason: aws.String("circuit breaker triggered"),
+					}}
+					return s
+				}()),
+				MockDescribeTaskDefinitionWithContext: describeTaskDefinition(revision1),
+			},
+			cr: service(),
+			want: want{
+				cr: service(withStatus(v1alpha1.ServiceObservation{
+					ServiceARN:         serviceARN,
+					Status:             v1alpha1.ServiceStatusActive,
+					TaskDefinition:     revision1,
+					RunningCount:       2,
+					DesiredCount:       2,
+					RolloutState:       awsecs.DeploymentRolloutStateFailed,
+					RolloutStateReason: "circuit breaker triggered",
+				}), withConditions(xpv1.Available(), ecs.RolloutCondition(awsecs.DeploymentRolloutStateFailed, "circuit breaker triggered"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AutoScalingUpToDate": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: describeServices(func() *awsecs.Service {
+					s := observed(v1alpha1.ServiceStatusActive, revision1)
+					s.DesiredCount = aws.Int64(3)
+					return s
+				}()),
+				MockDescribeTaskDefinitionWithContext: describeTaskDefinition(revision1),
+			},
+			autoScaling: &fake.MockAutoScalingClient{
+				MockDescribeScalableTargetsWithContext: describeScalableTargets(&applicationautoscaling.ScalableTarget{MinCapacity: aws.Int64(1), MaxCapacity: aws.Int64(4)}),
+				MockDescribeScalingPoliciesWithContext: describeScalingPolicies(observedCPUPolicy),
+			},
+			cr: service(withAutoScaling(&v1alpha1.ServiceAutoScaling{MinCapacity: 1, MaxCapacity: 4, TargetTrackingPolicies: []v1alpha1.TargetTrackingPolicy{cpuPolicy}})),
+			want: want{
+				cr: service(withAutoScaling(&v1alpha1.ServiceAutoScaling{MinCapacity: 1, MaxCapacity: 4, TargetTrackingPolicies: []v1alpha1.TargetTrackingPolicy{cpuPolicy}}), withStatus(v1alpha1.ServiceObservation{
+					ServiceARN:        serviceARN,
+					Status:            v1alpha1.ServiceStatusActive,
+					TaskDefinition:    revision1,
+					RunningCount:      2,
+					DesiredCount:      3,
+					ScalingPolicyARNs: []string{policyARN},
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ScalableTargetNotRegistered": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext:       describeServices(observed(v1alpha1.ServiceStatusActive, revision1)),
+				MockDescribeTaskDefinitionWithContext: describeTaskDefinition(revision1),
+			},
+			autoScaling: &fake.MockAutoScalingClient{
+				MockDescribeScalableTargetsWithContext: describeScalableTargets(),
+				MockDescribeScalingPoliciesWithContext: describeScalingPolicies(),
+			},
+			cr: service(withAutoScaling(&v1alpha1.ServiceAutoScaling{MinCapacity: 1, MaxCapacity: 4})),
+			want: want{
+				cr: service(withAutoScaling(&v1alpha1.ServiceAutoScaling{MinCapacity: 1, MaxCapacity: 4}), withStatus(activeObservation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NewTaskDefinitionRevision": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext:       describeServices(observed(v1alpha1.ServiceStatusActive, revision1)),
+				MockDescribeTaskDefinitionWithContext: describeTaskDefinition(revision2),
+			},
+			cr: service(),
+			want: want{
+				cr: service(withStatus(activeObservation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Draining": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: describeServices(observed(v1alpha1.ServiceStatusDraining, revision1)),
+			},
+			cr: service(),
+			want: want{
+				cr: service(withStatus(v1alpha1.ServiceObservation{
+					ServiceARN:     serviceARN,
+					Status:         v1alpha1.ServiceStatusDraining,
+					TaskDefinition: revision1,
+					RunningCount:   2,
+					DesiredCount:   2,
+				}), withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Inactive": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: describeServices(observed(v1alpha1.ServiceStatusInactive, revision1)),
+			},
+			cr: service(),
+			want: want{
+				cr: service(),
+			},
+		},
+		"Missing": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: describeServices(),
+			},
+			cr: service(),
+			want: want{
+				cr: service(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: func(context.Context, *awsecs.DescribeServicesInput, []request.Option) (*awsecs.DescribeServicesOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(),
+			want: want{
+				cr:  service(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DescribeTaskDefinitionFailed": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: describeServices(observed(v1alpha1.ServiceStatusActive, revision1)),
+				MockDescribeTaskDefinitionWithContext: func(context.Context, *awsecs.DescribeTaskDefinitionInput, []request.Option) (*awsecs.DescribeTaskDefinitionOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(),
+			want: want{
+				cr:  service(withStatus(activeObservation)),
+				err: awsclient.Wrap(errBoom, errDescribeTaskDefinition),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, autoScaling: tc.autoScaling}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Service
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Service
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockCreateServiceWithContext: func(_ context.Context, input *awsecs.CreateServiceInput, _ []request.Option) (*awsecs.CreateServiceOutput, error) {
+					if aws.StringValue(input.ServiceName) != serviceName {
+						return nil, errors.New("unexpected service name")
+					}
+					return &awsecs.CreateServiceOutput{}, nil
+				},
+			},
+			cr: service(),
+			want: want{
+				cr: service(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockClient{
+				MockCreateServiceWithContext: func(context.Context, *awsecs.CreateServiceInput, []request.Option) (*awsecs.CreateServiceOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(),
+			want: want{
+				cr:  service(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awsecs.UpdateServiceInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr             *v1alpha1.Service
+		taskDefinition string
+		updateErr      error
+		want
+	}{
+		"NewTaskDefinitionRevision": {
+			cr:             service(),
+			taskDefinition: revision2,
+			want: want{
+				input: &awsecs.UpdateServiceInput{
+					Cluster:        aws.String("default"),
+					Service:        aws.String(serviceName),
+					TaskDefinition: aws.String(revision2),
+					DesiredCount:   aws.Int64(2),
+					DeploymentConfiguration: &awsecs.DeploymentConfiguration{
+						MaximumPercent:        aws.Int64(200),
+						MinimumHealthyPercent: aws.Int64(100),
+					},
+					PropagateTags: aws.String(awsecs.PropagateTagsNone),
+				},
+			},
+		},
+		"DesiredCountChanged": {
+			cr:             service(withDesiredCount(4)),
+			taskDefinition: revision1,
+			want: want{
+				input: &awsecs.UpdateServiceInput{
+					Cluster:        aws.String("default"),
+					Service:        aws.String(serviceName),
+					TaskDefinition: aws.String(revision1),
+					DesiredCount:   aws.Int64(4),
+					DeploymentConfiguration: &awsecs.DeploymentConfiguration{
+						MaximumPercent:        aws.Int64(200),
+						MinimumHealthyPercent: aws.Int64(100),
+					},
+					PropagateTags: aws.String(awsecs.PropagateTagsNone),
+				},
+			},
+		},
+		"UpToDate": {
+			cr:             service(),
+			taskDefinition: revision1,
+			want:           want{},
+		},
+		"UpdateFailed": {
+			cr:             service(withDesiredCount(4)),
+			taskDefinition: revision1,
+			updateErr:      errBoom,
+			want: want{
+				input: &awsecs.UpdateServiceInput{
+					Cluster:        aws.String("default"),
+					Service:        aws.String(serviceName),
+					TaskDefinition: aws.String(revision1),
+					DesiredCount:   aws.Int64(4),
+					DeploymentConfiguration: &awsecs.DeploymentConfiguration{
+						MaximumPercent:        aws.Int64(200),
+						MinimumHealthyPercent: aws.Int64(100),
+					},
+					PropagateTags: aws.String(awsecs.PropagateTagsNone),
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsecs.UpdateServiceInput
+			e := &external{client: &fake.MockClient{
+				MockDescribeServicesWithContext:       describeServices(observed(v1alpha1.ServiceStatusActive, revision1)),
+				MockDescribeTaskDefinitionWithContext: describeTaskDefinition(tc.taskDefinition),
+				MockUpdateServiceWithContext: func(_ context.Context, in *awsecs.UpdateServiceInput, _ []request.Option) (*awsecs.UpdateServiceOutput, error) {
+					input = in
+					return &awsecs.UpdateServiceOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateAutoScaling(t *testing.T) {
+	type want struct {
+		desiredCount *int64
+		register     *applicationautoscaling.RegisterScalableTargetInput
+		put          []string
+		deleted      []string
+		err          error
+	}
+
+	autoScaling := &v1alpha1.ServiceAutoScaling{MinCapacity: 1, MaxCapacity: 4, TargetTrackingPolicies: []v1alpha1.TargetTrackingPolicy{cpuPolicy}}
+
+	cases := map[string]struct {
+		cr       *v1alpha1.Service
+		target   *applicationautoscaling.ScalableTarget
+		policies []*applicationautoscaling.ScalingPolicy
+		putErr   error
+		want
+	}{
+		"RegistersScalableTarget": {
+			cr: service(withDesiredCount(4), withAutoScaling(autoScaling)),
+			want: want{
+				register: &applicationautoscaling.RegisterScalableTargetInput{
+					ServiceNamespace:  aws.String(applicationautoscaling.ServiceNamespaceEcs),
+					ScalableDimension: aws.String(applicationautoscaling.ScalableDimensionEcsServiceDesiredCount),
+					ResourceId:        aws.String(resourceID),
+					MinCapacity:       aws.Int64(1),
+					MaxCapacity:       aws.Int64(4),
+				},
+				put: []string{"cpu"},
+			},
+		},
+		"DeletesUndesiredPolicies": {
+			cr:     service(withAutoScaling(autoScaling)),
+			target: &applicationautoscaling.ScalableTarget{MinCapacity: aws.Int64(1), MaxCapacity: aws.Int64(4)},
+			policies: []*applicationautoscaling.ScalingPolicy{observedCPUPolicy, {
+				PolicyName: aws.String("memory"),
+				PolicyType: aws.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+			}},
+			want: want{
+				deleted: []string{"memory"},
+			},
+		},
+		"PutFailed": {
+			cr:     service(withAutoScaling(autoScaling)),
+			target: &applicationautoscaling.ScalableTarget{MinCapacity: aws.Int64(1), MaxCapacity: aws.Int64(4)},
+			putErr: errBoom,
+			want: want{
+				put: []string{"cpu"},
+				err: awsclient.Wrap(errBoom, errPutScalingPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			targets := []*applicationautoscaling.ScalableTarget{}
+			if tc.target != nil {
+				targets = append(targets, tc.target)
+			}
+			e := &external{
+				client: &fake.MockClient{
+					MockDescribeServicesWithContext:       describeServices(observed(v1alpha1.ServiceStatusActive, revision1)),
+					MockDescribeTaskDefinitionWithContext: describeTaskDefinition(revision1),
+					MockUpdateServiceWithContext: func(_ context.Context, in *awsecs.UpdateServiceInput, _ []request.Option) (*awsecs.UpdateServiceOutput, error) {
+						got.desiredCount = in.DesiredCount
+						return &awsecs.UpdateServiceOutput{}, nil
+					},
+				},
+				autoScaling: &fake.MockAutoScalingClient{
+					MockDescribeScalableTargetsWithContext: describeScalableTargets(targets...),
+					MockDescribeScalingPoliciesWithContext: describeScalingPolicies(tc.policies...),
+					MockRegisterScalableTargetWithContext: func(_ context.Context, in *applicationautoscaling.RegisterScalableTargetInput, _ []request.Option) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+						got.register = in
+						return &applicationautoscaling.RegisterScalableTargetOutput{}, nil
+					},
+					MockPutScalingPolicyWithContext: func(_ context.Context, in *applicationautoscaling.PutScalingPolicyInput, _ []request.Option) (*applicationautoscaling.PutScalingPolicyOutput, error) {
+						got.put = append(got.put, aws.StringValue(in.PolicyName))
+						return &applicationautoscaling.PutScalingPolicyOutput{}, tc.putErr
+					},
+					MockDeleteScalingPolicyWithContext: func(_ context.Context, in *applicationautoscaling.DeleteScalingPolicyInput, _ []request.Option) (*applicationautoscaling.DeleteScalingPolicyOutput, error) {
+						got.deleted = append(got.deleted, aws.StringValue(in.PolicyName))
+						return &applicationautoscaling.DeleteScalingPolicyOutput{}, nil
+					},
+				},
+			}
+			_, got.err = e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Service
+		err error
+	}
+
+	cases := map[string]struct {
+		client      *fake.MockClient
+		autoScaling *fake.MockAutoScalingClient
+		cr          *v1alpha1.Service
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteServiceWithContext: func(_ context.Context, input *awsecs.DeleteServiceInput, _ []request.Option) (*awsecs.DeleteServiceOutput, error) {
+					if !aws.BoolValue(input.Force) {
+						return nil, errors.New("service must be force deleted")
+					}
+					return &awsecs.DeleteServiceOutput{}, nil
+				},
+			},
+			cr: service(),
+			want: want{
+				cr: service(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeregistersScalableTarget": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: describeServices(observed(v1alpha1.ServiceStatusActive, revision1)),
+				MockDeleteServiceWithContext: func(context.Context, *awsecs.DeleteServiceInput, []request.Option) (*awsecs.DeleteServiceOutput, error) {
+					return &awsecs.DeleteServiceOutput{}, nil
+				},
+			},
+			autoScaling: &fake.MockAutoScalingClient{
+				MockDeregisterScalableTargetWithContext: func(_ context.Context, input *applicationautoscaling.DeregisterScalableTargetInput, _ []request.Option) (*applicationautoscaling.DeregisterScalableTargetOutput, error) {
+					if aws.StringValue(input.ResourceId) != resourceID {
+						return nil, errors.New("unexpected resource ID")
+					}
+					return &applicationautoscaling.DeregisterScalableTargetOutput{}, nil
+				},
+			},
+			cr: service(withAutoScaling(&v1alpha1.ServiceAutoScaling{MaxCapacity: 4})),
+			want: want{
+				cr: service(withAutoScaling(&v1alpha1.ServiceAutoScaling{MaxCapacity: 4}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeregisterFailed": {
+			client: &fake.MockClient{
+				MockDescribeServicesWithContext: describeServices(observed(v1alpha1.ServiceStatusActive, revision1)),
+			},
+			autoScaling: &fake.MockAutoScalingClient{
+				MockDeregisterScalableTargetWithContext: func(context.Context, *applicationautoscaling.DeregisterScalableTargetInput, []request.Option) (*applicationautoscaling.DeregisterScalableTargetOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(withAutoScaling(&v1alpha1.ServiceAutoScaling{MaxCapacity: 4})),
+			want: want{
+				cr:  service(withAutoScaling(&v1alpha1.ServiceAutoScaling{MaxCapacity: 4}), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeregisterScalableTarget),
+			},
+		},
+		"AlreadyDraining": {
+			client: &fake.MockClient{},
+			cr:     service(withStatus(v1alpha1.ServiceObservation{Status: v1alpha1.ServiceStatusDraining})),
+			want: want{
+				cr: service(withStatus(v1alpha1.ServiceObservation{Status: v1alpha1.ServiceStatusDraining}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteServiceWithContext: func(context.Context, *awsecs.DeleteServiceInput, []request.Option) (*awsecs.DeleteServiceOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: service(),
+			want: want{
+				cr:  service(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, autoScaling: tc.autoScaling}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
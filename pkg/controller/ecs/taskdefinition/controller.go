@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskdefinition
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an ECS TaskDefinition resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe the ECS task definition"
+	errRegister         = "failed to register the ECS task definition"
+	errDeregister       = "failed to deregister the ECS task definition"
+	errKubeUpdateFailed = "cannot update the ECS TaskDefinition custom resource"
+)
+
+// SetupTaskDefinition adds a controller that reconciles ECS task definitions.
+func SetupTaskDefinition(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.TaskDefinitionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.TaskDefinition{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TaskDefinitionGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: ecs.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) ecs.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TaskDefinition)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ecs.Client
+}
+
+func (e *external) describe(ctx context.Context, arn string) (*awsecs.DescribeTaskDefinitionOutput, error) {
+	resp, err := e.client.DescribeTaskDefinitionWithContext(ctx, &awsecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(arn),
+		Include:        aws.StringSlice([]string{awsecs.TaskDefinitionFieldTags}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Deregistered revisions remain visible as INACTIVE, but can no longer
+	// be used to start tasks.
+	if resp.TaskDefinition == nil || aws.StringValue(resp.TaskDefinition.Status) == v1alpha1.TaskDefinitionStatusInactive {
+		return nil, nil
+	}
+	return resp, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.TaskDefinition)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the ARN of the revision that is registered last.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ecs.IsClientException, err), errDescribe)
+	}
+	if resp == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	observed := resp.TaskDefinition
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ecs.LateInitializeTaskDefinition(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ecs.GenerateTaskDefinitionObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ecs.IsTaskDefinitionUpToDate(cr.Spec.ForProvider, observed) &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, ecs.TagsToMap(resp.Tags), cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.TaskDefinition)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	out, err := e.client.RegisterTaskDefinitionWithContext(ctx, ecs.GenerateRegisterTaskDefinitionInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errRegister)
+	}
+	meta.SetExternalName(cr, aws.StringValue(out.TaskDefinition.TaskDefinitionArn))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.TaskDefinition)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	previous := meta.GetExternalName(cr)
+	resp, err := e.describe(ctx, previous)
+	if err != nil || resp == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	if ecs.IsTaskDefinitionUpToDate(cr.Spec.ForProvider, resp.TaskDefinition) {
+		return managed.ExternalUpdate{}, ecs.UpdateTags(ctx, e.client, previous, cr.Spec.ForProvider.Tags, ecs.TagsToMap(resp.Tags))
+	}
+
+	// Revisions are immutable, so a changed task definition is registered as
+	// a new revision that replaces the previous one. The new ARN is stored
+	// right away, so that the revision isn't orphaned if the deregistration
+	// of the previous one fails.
+	out, err := e.client.RegisterTaskDefinitionWithContext(ctx, ecs.GenerateRegisterTaskDefinitionInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errRegister)
+	}
+	meta.SetExternalName(cr, aws.StringValue(out.TaskDefinition.TaskDefinitionArn))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateFailed)
+	}
+
+	_, err = e.client.DeregisterTaskDefinitionWithContext(ctx, &awsecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: aws.String(previous),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeregister)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.TaskDefinition)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeregisterTaskDefinitionWithContext(ctx, &awsecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ecs.IsClientException, err), errDeregister)
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskdefinition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecs/fake"
+)
+
+var (
+	revision1 = "arn:aws:ecs:us-east-1:123456789012:task-definition/web:1"
+	revision2 = "arn:aws:ecs:us-east-1:123456789012:task-definition/web:2"
+
+	errBoom = errors.New("boom")
+)
+
+type taskDefinitionModifier func(*v1alpha1.TaskDefinition)
+
+func withExternalName(name string) taskDefinitionModifier {
+	return func(r *v1alpha1.TaskDefinition) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) taskDefinitionModifier {
+	return func(r *v1alpha1.TaskDefinition) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withImage(image string) taskDefinitionModifier {
+	return func(r *v1alpha1.TaskDefinition) { r.Spec.ForProvider.ContainerDefinitions[0].Image = image }
+}
+
+func withTags(tags map[string]string) taskDefinitionModifier {
+	return func(r *v1alpha1.TaskDefinition) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withStatus(s v1alpha1.TaskDefinitionObservation) taskDefinitionModifier {
+	return func(r *v1alpha1.TaskDefinition) { r.Status.AtProvider = s }
+}
+
+func taskDefinition(m ...taskDefinitionModifier) *v1alpha1.TaskDefinition {
+	cr := &v1alpha1.TaskDefinition{
+		Spec: v1alpha1.TaskDefinitionSpec{
+			ForProvider: v1alpha1.TaskDefinitionParameters{
+				Family:      "web",
+				NetworkMode: aws.String(awsecs.NetworkModeBridge),
+				ContainerDefinitions: []v1alpha1.ContainerDefinition{{
+					Name:   "web",
+					Image:  "nginx:1.23",
+					Memory: aws.Int64(128),
+				}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(arn, status string) *awsecs.TaskDefinition {
+	return &awsecs.TaskDefinition{
+		TaskDefinitionArn: aws.String(arn),
+		Family:            aws.String("web"),
+		Revision:          aws.Int64(1),
+		Status:            aws.String(status),
+		NetworkMode:       aws.String(awsecs.NetworkModeBridge),
+		ContainerDefinitions: []*awsecs.ContainerDefinition{{
+			Name:      aws.String("web"),
+			Image:     aws.String("nginx:1.23"),
+			Cpu:       aws.Int64(0),
+			Memory:    aws.Int64(128),
+			Essential: aws.Bool(true),
+		}},
+	}
+}
+
+func describe(td *awsecs.TaskDefinition, tags ...*awsecs.Tag) func(context.Context, *awsecs.DescribeTaskDefinitionInput, []request.Option) (*awsecs.DescribeTaskDefinitionOutput, error) {
+	return func(_ context.Context, input *awsecs.DescribeTaskDefinitionInput, _ []request.Option) (*awsecs.DescribeTaskDefinitionOutput, error) {
+		if aws.StringValue(input.TaskDefinition) != aws.StringValue(td.TaskDefinitionArn) {
+			return nil, errors.New("unexpected task definition")
+		}
+		return &awsecs.DescribeTaskDefinitionOutput{TaskDefinition: td, Tags: tags}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TaskDefinition
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.TaskDefinition
+		want
+	}{
+		"NotRegistered": {
+			client: &fake.MockClient{},
+			cr:     taskDefinition(),
+			want: want{
+				cr: taskDefinition(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeTaskDefinitionWithContext: describe(observed(revision1, v1alpha1.TaskDefinitionStatusActive)),
+			},
+			cr: taskDefinition(withExternalName(revision1)),
+			want: want{
+				cr: taskDefinition(withExternalName(revision1),
+					withStatus(v1alpha1.TaskDefinitionObservation{TaskDefinitionARN: revision1, Revision: 1, Status: v1alpha1.TaskDefinitionStatusActive}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ImageChanged": {
+			client: &fake.MockClient{
+				MockDescribeTaskDefinitionWithContext: describe(observed(revision1, v1alpha1.TaskDefinitionStatusActive)),
+			},
+			cr: taskDefinition(withExternalName(revision1), withImage("nginx:1.24")),
+			want: want{
+				cr: taskDefinition(withExternalName(revision1), withImage("nginx:1.24"),
+					withStatus(v1alpha1.TaskDefinitionObservation{TaskDefinitionARN: revision1, Revision: 1, Status: v1alpha1.TaskDefinitionStatusActive}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deregistered": {
+			client: &fake.MockClient{
+				MockDescribeTaskDefinitionWithContext: describe(observed(revision1, v1alpha1.TaskDefinitionStatusInactive)),
+			},
+			cr: taskDefinition(withExternalName(revision1)),
+			want: want{
+				cr: taskDefinition(withExternalName(revision1)),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeTaskDefinitionWithContext: func(context.Context, *awsecs.DescribeTaskDefinitionInput, []request.Option) (*awsecs.DescribeTaskDefinitionOutput, error) {
+					return nil, awserr.New(awsecs.ErrCodeClientException, "Unable to describe task definition.", nil)
+				},
+			},
+			cr: taskDefinition(withExternalName(revision1)),
+			want: want{
+				cr: taskDefinition(withExternalName(revision1)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeTaskDefinitionWithContext: func(context.Context, *awsecs.DescribeTaskDefinitionInput, []request.Option) (*awsecs.DescribeTaskDefinitionOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: taskDefinition(withExternalName(revision1)),
+			want: want{
+				cr:  taskDefinition(withExternalName(revision1)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TaskDefinition
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.TaskDefinition
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockRegisterTaskDefinitionWithContext: func(context.Context, *awsecs.RegisterTaskDefinitionInput, []request.Option) (*awsecs.RegisterTaskDefinitionOutput, error) {
+					return &awsecs.RegisterTaskDefinitionOutput{TaskDefinition: &awsecs.TaskDefinition{TaskDefinitionArn: aws.String(revision1)}}, nil
+				},
+			},
+			cr: taskDefinition(),
+			want: want{
+				cr: taskDefinition(withExternalName(revision1), withConditions(xpv1.Creating())),
+			},
+		},
+		"RegisterFailed": {
+			client: &fake.MockClient{
+				MockRegisterTaskDefinitionWithContext: func(context.Context, *awsecs.RegisterTaskDefinitionInput, []request.Option) (*awsecs.RegisterTaskDefinitionOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: taskDefinition(),
+			want: want{
+				cr:  taskDefinition(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errRegister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr           *v1alpha1.TaskDefinition
+		registered   bool
+		deregistered string
+		tagged       bool
+		err          error
+	}
+
+	cases := map[string]struct {
+		cr            *v1alpha1.TaskDefinition
+		kubeErr       error
+		deregisterErr error
+		want
+	}{
+		"NewRevision": {
+			cr: taskDefinition(withExternalName(revision1), withImage("nginx:1.24")),
+			want: want{
+				cr:           taskDefinition(withExternalName(revision2), withImage("nginx:1.24")),
+				registered:   true,
+				deregistered: revision1,
+			},
+		},
+		"OnlyTagsChanged": {
+			cr: taskDefinition(withExternalName(revision1), withTags(map[string]string{"k": "v"})),
+			want: want{
+				cr:     taskDefinition(withExternalName(revision1), withTags(map[string]string{"k": "v"})),
+				tagged: true,
+			},
+		},
+		"KubeUpdateFailed": {
+			cr:      taskDefinition(withExternalName(revision1), withImage("nginx:1.24")),
+			kubeErr: errBoom,
+			want: want{
+				cr:         taskDefinition(withExternalName(revision2), withImage("nginx:1.24")),
+				registered: true,
+				err:        errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"DeregisterFailed": {
+			cr:            taskDefinition(withExternalName(revision1), withImage("nginx:1.24")),
+			deregisterErr: errBoom,
+			want: want{
+				cr:           taskDefinition(withExternalName(revision2), withImage("nginx:1.24")),
+				registered:   true,
+				deregistered: revision1,
+				err:          awsclient.Wrap(errBoom, errDeregister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			registered, tagged, deregistered := false, false, ""
+			e := &external{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(tc.kubeErr)},
+				client: &fake.MockClient{
+					MockDescribeTaskDefinitionWithContext: describe(observed(revision1, v1alpha1.TaskDefinitionStatusActive)),
+					MockRegisterTaskDefinitionWithContext: func(_ context.Context, input *awsecs.RegisterTaskDefinitionInput, _ []request.Option) (*awsecs.RegisterTaskDefinitionOutput, error) {
+						registered = aws.StringValue(input.ContainerDefinitions[0].Image) == "nginx:1.24"
+						return &awsecs.RegisterTaskDefinitionOutput{TaskDefinition: &awsecs.TaskDefinition{TaskDefinitionArn: aws.String(revision2)}}, nil
+					},
+					MockDeregisterTaskDefinitionWithContext: func(_ context.Context, input *awsecs.DeregisterTaskDefinitionInput, _ []request.Option) (*awsecs.DeregisterTaskDefinitionOutput, error) {
+						deregistered = aws.StringValue(input.TaskDefinition)
+						return &awsecs.DeregisterTaskDefinitionOutput{}, tc.deregisterErr
+					},
+					MockTagResourceWithContext: func(_ context.Context, input *awsecs.TagResourceInput, _ []request.Option) (*awsecs.TagResourceOutput, error) {
+						tagged = aws.StringValue(input.ResourceArn) == revision1
+						return &awsecs.TagResourceOutput{}, nil
+					},
+				},
+			}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.registered, registered); diff != "" {
+				t.Errorf("registered: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deregistered, deregistered); diff != "" {
+				t.Errorf("deregistered: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("tagged: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TaskDefinition
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.TaskDefinition
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeregisterTaskDefinitionWithContext: func(_ context.Context, input *awsecs.DeregisterTaskDefinitionInput, _ []request.Option) (*awsecs.DeregisterTaskDefinitionOutput, error) {
+					if aws.StringValue(input.TaskDefinition) != revision1 {
+						return nil, errors.New("unexpected task definition")
+					}
+					return &awsecs.DeregisterTaskDefinitionOutput{}, nil
+				},
+			},
+			cr: taskDefinition(withExternalName(revision1)),
+			want: want{
+				cr: taskDefinition(withExternalName(revision1), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			client: &fake.MockClient{
+				MockDeregisterTaskDefinitionWithContext: func(context.Context, *awsecs.DeregisterTaskDefinitionInput, []request.Option) (*awsecs.DeregisterTaskDefinitionOutput, error) {
+					return nil, awserr.New(awsecs.ErrCodeClientException, "The specified task definition does not exist.", nil)
+				},
+			},
+			cr: taskDefinition(withExternalName(revision1)),
+			want: want{
+				cr: taskDefinition(withExternalName(revision1), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeregisterFailed": {
+			client: &fake.MockClient{
+				MockDeregisterTaskDefinitionWithContext: func(context.Context, *awsecs.DeregisterTaskDefinitionInput, []request.Option) (*awsecs.DeregisterTaskDefinitionOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: taskDefinition(withExternalName(revision1)),
+			want: want{
+				cr:  taskDefinition(withExternalName(revision1), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeregister),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accessentry
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+const (
+	errNotEKSAccessEntry = "managed resource is not an EKS access entry custom resource"
+	errKubeUpdateFailed  = "cannot update EKS access entry custom resource"
+
+	errCreateFailed     = "cannot create EKS access entry"
+	errUpdateFailed     = "cannot update EKS access entry"
+	errDeleteFailed     = "cannot delete EKS access entry"
+	errDescribeFailed   = "cannot describe EKS access entry"
+	errAddTagsFailed    = "cannot add tags to EKS access entry"
+	errRemoveTagsFailed = "cannot remove tags from EKS access entry"
+)
+
+// SetupAccessEntry adds a controller that reconciles AccessEntries.
+func SetupAccessEntry(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.AccessEntryKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.AccessEntry{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.AccessEntryGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube           client.Client
+	newEKSClientFn func(config aws.Config) eks.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessEntry)
+	if !ok {
+		return nil, errors.New(errNotEKSAccessEntry)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newEKSClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client eks.Client
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessEntry)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEKSAccessEntry)
+	}
+	// The external name is the principal ARN, which is set once the access
+	// entry is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	rsp, err := e.client.DescribeAccessEntry(ctx, &awseks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(cr.Spec.ForProvider.ClusterName),
+		PrincipalArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDescribeFailed)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	eks.LateInitializeAccessEntry(&cr.Spec.ForProvider, rsp.AccessEntry)
+
+	cr.Status.AtProvider = eks.GenerateAccessEntryObservation(rsp.AccessEntry)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        eks.IsAccessEntryUpToDate(cr.Spec.ForProvider, rsp.AccessEntry),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessEntry)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEKSAccessEntry)
+	}
+	cr.SetConditions(xpv1.Creating())
+	rsp, err := e.client.CreateAccessEntry(ctx, eks.GenerateCreateAccessEntryInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	principalARN := cr.Spec.ForProvider.PrincipalARN
+	if rsp.AccessEntry != nil && rsp.AccessEntry.PrincipalArn != nil {
+		principalARN = aws.ToString(rsp.AccessEntry.PrincipalArn)
+	}
+	meta.SetExternalName(cr, principalARN)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessEntry)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEKSAccessEntry)
+	}
+
+	rsp, err := e.client.DescribeAccessEntry(ctx, &awseks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(cr.Spec.ForProvider.ClusterName),
+		PrincipalArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil || rsp.AccessEntry == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeFailed)
+	}
+
+	if eks.NeedsAccessEntryUpdate(cr.Spec.ForProvider, rsp.AccessEntry) {
+		if _, err := e.client.UpdateAccessEntry(ctx, eks.GenerateUpdateAccessEntryInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
+		}
+	}
+
+	add, remove := awsclient.DiffTags(cr.Spec.ForProvider.Tags, rsp.AccessEntry.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResource(ctx, &awseks.UntagResourceInput{ResourceArn: rsp.AccessEntry.AccessEntryArn, TagKeys: remove}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTagsFailed)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResource(ctx, &awseks.TagResourceInput{ResourceArn: rsp.AccessEntry.AccessEntryArn, Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTagsFailed)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.AccessEntry)
+	if !ok {
+		return errors.New(errNotEKSAccessEntry)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteAccessEntry(ctx, &awseks.DeleteAccessEntryInput{
+		ClusterName:  aws.String(cr.Spec.ForProvider.ClusterName),
+		PrincipalArn: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDeleteFailed)
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.AccessEntry)
+	if !ok {
+		return errors.New(errNotEKSAccessEntry)
+	}
+	changed := false
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]string{}
+	}
+	for k, v := range resource.GetExternalTags(mg) {
+		if cr.Spec.ForProvider.Tags[k] != v {
+			cr.Spec.ForProvider.Tags[k] = v
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
@@ -0,0 +1,452 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accessentry
+
+import (
+	"context"
+	"testing"
+
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+)
+
+var (
+	clusterName  = "my-cluster"
+	principalARN = "arn:aws:iam::123456789012:role/admin"
+	entryARN     = "arn:aws:eks:us-east-1:123456789012:access-entry/my-cluster/role/123456789012/admin/xyz"
+	errBoom      = errors.New("boom")
+)
+
+type args struct {
+	eks eks.Client
+	cr  *manualv1alpha1.AccessEntry
+}
+
+type accessEntryModifier func(*manualv1alpha1.AccessEntry)
+
+func withExternalName(n string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p manualv1alpha1.AccessEntryParameters) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider = p }
+}
+
+func withObservation(o manualv1alpha1.AccessEntryObservation) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Status.AtProvider = o }
+}
+
+func accessEntry(m ...accessEntryModifier) *manualv1alpha1.AccessEntry {
+	cr := &manualv1alpha1.AccessEntry{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessEntry
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: accessEntry(),
+			},
+			want: want{
+				cr: accessEntry(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn:   &entryARN,
+								KubernetesGroups: []string{"admins"},
+								Type:             awsclient.String("STANDARD"),
+								Username:         awsclient.String("admin"),
+							},
+						}, nil
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN), withSpec(manualv1alpha1.AccessEntryParameters{
+					KubernetesGroups: []string{"admins"},
+					Type:             awsclient.String("STANDARD"),
+					Username:         awsclient.String("admin"),
+				})),
+			},
+			want: want{
+				cr: accessEntry(withExternalName(principalARN),
+					withSpec(manualv1alpha1.AccessEntryParameters{
+						KubernetesGroups: []string{"admins"},
+						Type:             awsclient.String("STANDARD"),
+						Username:         awsclient.String("admin"),
+					}),
+					withObservation(manualv1alpha1.AccessEntryObservation{AccessEntryARN: entryARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"GroupsDrifted": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn:   &entryARN,
+								KubernetesGroups: []string{"admins", "viewers"},
+								Type:             awsclient.String("STANDARD"),
+								Username:         awsclient.String("admin"),
+							},
+						}, nil
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN), withSpec(manualv1alpha1.AccessEntryParameters{
+					KubernetesGroups: []string{"admins"},
+					Type:             awsclient.String("STANDARD"),
+					Username:         awsclient.String("admin"),
+				})),
+			},
+			want: want{
+				cr: accessEntry(withExternalName(principalARN),
+					withSpec(manualv1alpha1.AccessEntryParameters{
+						KubernetesGroups: []string{"admins"},
+						Type:             awsclient.String("STANDARD"),
+						Username:         awsclient.String("admin"),
+					}),
+					withObservation(manualv1alpha1.AccessEntryObservation{AccessEntryARN: entryARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr:  accessEntry(withExternalName(principalARN)),
+				err: awsclient.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessEntry
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockCreateAccessEntry: func(ctx context.Context, input *awseks.CreateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.CreateAccessEntryOutput, error) {
+						return &awseks.CreateAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{PrincipalArn: input.PrincipalArn},
+						}, nil
+					},
+				},
+				cr: accessEntry(withSpec(manualv1alpha1.AccessEntryParameters{ClusterName: clusterName, PrincipalARN: principalARN})),
+			},
+			want: want{
+				cr: accessEntry(withSpec(manualv1alpha1.AccessEntryParameters{ClusterName: clusterName, PrincipalARN: principalARN}),
+					withExternalName(principalARN),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockCreateAccessEntry: func(ctx context.Context, input *awseks.CreateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.CreateAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(),
+			},
+			want: want{
+				cr:  accessEntry(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		update *awseks.UpdateAccessEntryInput
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateGroupsAndTags": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn:   &entryARN,
+								KubernetesGroups: []string{"viewers"},
+							},
+						}, nil
+					},
+					MockTagResource: func(ctx context.Context, input *awseks.TagResourceInput, opts []func(*awseks.Options)) (*awseks.TagResourceOutput, error) {
+						return &awseks.TagResourceOutput{}, nil
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN), withSpec(manualv1alpha1.AccessEntryParameters{
+					ClusterName:      clusterName,
+					KubernetesGroups: []string{"admins"},
+					Tags:             map[string]string{"k": "v"},
+				})),
+			},
+			want: want{
+				update: &awseks.UpdateAccessEntryInput{
+					ClusterName:      &clusterName,
+					PrincipalArn:     &principalARN,
+					KubernetesGroups: []string{"admins"},
+				},
+			},
+		},
+		"OnlyTags": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn: &entryARN,
+								Tags:           map[string]string{"k": "v"},
+							},
+						}, nil
+					},
+					MockUntagResource: func(ctx context.Context, input *awseks.UntagResourceInput, opts []func(*awseks.Options)) (*awseks.UntagResourceOutput, error) {
+						return &awseks.UntagResourceOutput{}, nil
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN), withSpec(manualv1alpha1.AccessEntryParameters{ClusterName: clusterName})),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{Username: awsclient.String("admin")},
+						}, nil
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN), withSpec(manualv1alpha1.AccessEntryParameters{
+					ClusterName: clusterName,
+					Username:    awsclient.String("viewer"),
+				})),
+			},
+			want: want{
+				update: &awseks.UpdateAccessEntryInput{
+					ClusterName:      &clusterName,
+					PrincipalArn:     &principalARN,
+					KubernetesGroups: []string{},
+					Username:         awsclient.String("viewer"),
+				},
+				err: awsclient.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var update *awseks.UpdateAccessEntryInput
+			c := tc.eks.(*fake.MockClient)
+			c.MockUpdateAccessEntry = func(ctx context.Context, input *awseks.UpdateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.UpdateAccessEntryOutput, error) {
+				update = input
+				if tc.want.err != nil {
+					return nil, errBoom
+				}
+				return &awseks.UpdateAccessEntryOutput{}, nil
+			}
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update, cmpopts.IgnoreUnexported(awseks.UpdateAccessEntryInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return &awseks.DeleteAccessEntryOutput{}, nil
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withExternalName(principalARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withExternalName(principalARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withExternalName(principalARN)),
+			},
+			want: want{
+				cr:  accessEntry(withExternalName(principalARN), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
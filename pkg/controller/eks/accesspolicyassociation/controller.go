@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspolicyassociation
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+const (
+	errNotEKSAccessPolicyAssociation = "managed resource is not an EKS access policy association custom resource"
+
+	errAssociateFailed    = "cannot associate EKS access policy"
+	errDisassociateFailed = "cannot disassociate EKS access policy"
+	errListFailed         = "cannot list associated EKS access policies"
+)
+
+// SetupAccessPolicyAssociation adds a controller that reconciles
+// AccessPolicyAssociations.
+func SetupAccessPolicyAssociation(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.AccessPolicyAssociationKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.AccessPolicyAssociation{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.AccessPolicyAssociationGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube           client.Client
+	newEKSClientFn func(config aws.Config) eks.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return nil, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newEKSClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client eks.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+
+	ap, err := e.findAssociation(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errListFailed)
+	}
+	if ap == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = eks.GenerateAccessPolicyAssociationObservation(ap)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: eks.IsAccessPolicyAssociationUpToDate(cr.Spec.ForProvider, ap),
+	}, nil
+}
+
+// findAssociation returns the association of the desired access policy with
+// the access entry, or nil if there is none.
+func (e *external) findAssociation(ctx context.Context, p manualv1alpha1.AccessPolicyAssociationParameters) (*types.AssociatedAccessPolicy, error) {
+	input := &awseks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(p.ClusterName),
+		PrincipalArn: aws.String(p.PrincipalARN),
+	}
+	for {
+		rsp, err := e.client.ListAssociatedAccessPolicies(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if ap := eks.FindAssociatedAccessPolicy(rsp.AssociatedAccessPolicies, p.PolicyARN); ap != nil {
+			return ap, nil
+		}
+		if rsp.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = rsp.NextToken
+	}
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.AssociateAccessPolicy(ctx, eks.GenerateAssociateAccessPolicyInput(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errAssociateFailed)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+	// Associating an already associated access policy replaces its scope.
+	_, err := e.client.AssociateAccessPolicy(ctx, eks.GenerateAssociateAccessPolicyInput(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errAssociateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return errors.New(errNotEKSAccessPolicyAssociation)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DisassociateAccessPolicy(ctx, &awseks.DisassociateAccessPolicyInput{
+		ClusterName:  aws.String(cr.Spec.ForProvider.ClusterName),
+		PrincipalArn: aws.String(cr.Spec.ForProvider.PrincipalARN),
+		PolicyArn:    aws.String(cr.Spec.ForProvider.PolicyARN),
+	})
+	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDisassociateFailed)
+}
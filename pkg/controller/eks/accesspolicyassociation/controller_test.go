@@ -0,0 +1,373 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspolicyassociation
+
+import (
+	"context"
+	"testing"
+
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+)
+
+var (
+	clusterName  = "my-cluster"
+	principalARN = "arn:aws:iam::123456789012:role/admin"
+	policyARN    = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+	otherARN     = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSAdminPolicy"
+	errBoom      = errors.New("boom")
+)
+
+type args struct {
+	eks eks.Client
+	cr  *manualv1alpha1.AccessPolicyAssociation
+}
+
+type associationModifier func(*manualv1alpha1.AccessPolicyAssociation)
+
+func withConditions(c ...xpv1.Condition) associationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withScope(t string, ns ...string) associationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) {
+		r.Spec.ForProvider.AccessScope = manualv1alpha1.AccessScope{Type: t, Namespaces: ns}
+	}
+}
+
+func association(m ...associationModifier) *manualv1alpha1.AccessPolicyAssociation {
+	cr := &manualv1alpha1.AccessPolicyAssociation{
+		Spec: manualv1alpha1.AccessPolicyAssociationSpec{
+			ForProvider: manualv1alpha1.AccessPolicyAssociationParameters{
+				ClusterName:  clusterName,
+				PrincipalARN: principalARN,
+				PolicyARN:    policyARN,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessPolicyAssociation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDateOnSecondPage": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						if input.NextToken == nil {
+							return &awseks.ListAssociatedAccessPoliciesOutput{
+								AssociatedAccessPolicies: []awsekstypes.AssociatedAccessPolicy{{PolicyArn: &otherARN}},
+								NextToken:                awsclient.String("next"),
+							}, nil
+						}
+						return &awseks.ListAssociatedAccessPoliciesOutput{
+							AssociatedAccessPolicies: []awsekstypes.AssociatedAccessPolicy{{
+								PolicyArn: &policyARN,
+								AccessScope: &awsekstypes.AccessScope{
+									Type:       awsekstypes.AccessScopeTypeNamespace,
+									Namespaces: []string{"b", "a"},
+								},
+							}},
+						}, nil
+					},
+				},
+				cr: association(withScope("namespace", "a", "b")),
+			},
+			want: want{
+				cr: association(withScope("namespace", "a", "b"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ScopeDrifted": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return &awseks.ListAssociatedAccessPoliciesOutput{
+							AssociatedAccessPolicies: []awsekstypes.AssociatedAccessPolicy{{
+								PolicyArn:   &policyARN,
+								AccessScope: &awsekstypes.AccessScope{Type: awsekstypes.AccessScopeTypeCluster},
+							}},
+						}, nil
+					},
+				},
+				cr: association(withScope("namespace", "a")),
+			},
+			want: want{
+				cr: association(withScope("namespace", "a"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return &awseks.ListAssociatedAccessPoliciesOutput{
+							AssociatedAccessPolicies: []awsekstypes.AssociatedAccessPolicy{{PolicyArn: &otherARN}},
+						}, nil
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"AccessEntryNotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"FailedList": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(),
+				err: awsclient.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessPolicyAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return &awseks.AssociateAccessPolicyOutput{}, nil
+					},
+				},
+				cr: association(withScope("cluster")),
+			},
+			want: want{
+				cr: association(withScope("cluster"), withConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withScope("cluster")),
+			},
+			want: want{
+				cr:  association(withScope("cluster"), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAssociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return &awseks.AssociateAccessPolicyOutput{}, nil
+					},
+				},
+				cr: association(withScope("namespace", "a")),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withScope("namespace", "a")),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errAssociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessPolicyAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return &awseks.DisassociateAccessPolicyOutput{}, nil
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDisassociated": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDisassociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,11 +22,13 @@ import (
 
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/yaml"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -62,7 +64,8 @@ func SetupAddon(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 		For(&v1alpha1.Addon{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.AddonGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(awsclients.WrapExternal(mgr.GetClient(), awsclients.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclients.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclients.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -126,7 +129,8 @@ func (h *hooks) isUpToDate(cr *v1alpha1.Addon, resp *awseks.DescribeAddonOutput)
 	switch {
 	case resp.Addon == nil,
 		cr.Spec.ForProvider.AddonVersion != nil && awsclients.StringValue(cr.Spec.ForProvider.AddonVersion) != awsclients.StringValue(resp.Addon.AddonVersion),
-		cr.Spec.ForProvider.ServiceAccountRoleARN != nil && awsclients.StringValue(cr.Spec.ForProvider.ServiceAccountRoleARN) != awsclients.StringValue(resp.Addon.ServiceAccountRoleArn):
+		cr.Spec.ForProvider.ServiceAccountRoleARN != nil && awsclients.StringValue(cr.Spec.ForProvider.ServiceAccountRoleARN) != awsclients.StringValue(resp.Addon.ServiceAccountRoleArn),
+		!isConfigurationValuesUpToDate(cr.Spec.ForProvider.ConfigurationValues, resp.Addon.ConfigurationValues):
 		return false, nil
 	}
 
@@ -134,8 +138,27 @@ func (h *hooks) isUpToDate(cr *v1alpha1.Addon, resp *awseks.DescribeAddonOutput)
 	return len(add) == 0 && len(remove) == 0, nil
 }
 
+// isConfigurationValuesUpToDate compares the desired configuration values with
+// the observed ones. Both are parsed as YAML, which is a superset of JSON, so
+// that differences in formatting or key order are not reported as drift.
+func isConfigurationValuesUpToDate(desired, observed *string) bool {
+	if desired == nil {
+		return true
+	}
+	var d, o interface{}
+	if err := yaml.Unmarshal([]byte(awsclients.StringValue(desired)), &d); err != nil {
+		return awsclients.StringValue(desired) == awsclients.StringValue(observed)
+	}
+	if err := yaml.Unmarshal([]byte(awsclients.StringValue(observed)), &o); err != nil {
+		return false
+	}
+	return cmp.Equal(d, o)
+}
+
 func preUpdate(_ context.Context, cr *v1alpha1.Addon, obj *awseks.UpdateAddonInput) error {
 	obj.ClusterName = cr.Spec.ForProvider.ClusterName
+	obj.ServiceAccountRoleArn = cr.Spec.ForProvider.ServiceAccountRoleARN
+	obj.ConfigurationValues = cr.Spec.ForProvider.ConfigurationValues
 	return nil
 }
 
@@ -178,6 +201,8 @@ func (h *hooks) postUpdate(ctx context.Context, cr *v1alpha1.Addon, resp *awseks
 
 func preCreate(_ context.Context, cr *v1alpha1.Addon, obj *awseks.CreateAddonInput) error {
 	obj.ClusterName = cr.Spec.ForProvider.ClusterName
+	obj.ServiceAccountRoleArn = cr.Spec.ForProvider.ServiceAccountRoleARN
+	obj.ConfigurationValues = cr.Spec.ForProvider.ConfigurationValues
 	return nil
 }
 
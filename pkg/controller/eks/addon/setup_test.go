@@ -184,7 +184,9 @@ func TestObserve(t *testing.T) {
 					withConditions(xpv1.Available()),
 					withSpec(
 						v1alpha1.AddonParameters{
-							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							CustomAddonParameters: v1alpha1.CustomAddonParameters{
+								ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							},
 						},
 					),
 					withStatus(v1alpha1.AddonObservation{
@@ -254,12 +256,12 @@ func TestCreate(t *testing.T) {
 				}),
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -268,12 +270,12 @@ func TestCreate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 					withStatus(
@@ -302,12 +304,12 @@ func TestCreate(t *testing.T) {
 				}),
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -315,12 +317,12 @@ func TestCreate(t *testing.T) {
 			want: want{
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 					withConditions(xpv1.Creating()),
@@ -411,12 +413,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -431,12 +433,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -468,12 +470,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -482,12 +484,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -522,12 +524,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -536,12 +538,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -592,12 +594,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -612,12 +614,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -682,12 +684,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -702,12 +704,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -835,3 +837,65 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestIsConfigurationValuesUpToDate(t *testing.T) {
+	type args struct {
+		desired  *string
+		observed *string
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{
+				observed: awsclient.String(`{"env":{"WARM_IP_TARGET":"5"}}`),
+			},
+			want: true,
+		},
+		"SameJSONDifferentFormatting": {
+			args: args{
+				desired:  awsclient.String("{\n  \"replicaCount\": 3,\n  \"env\": {\"WARM_IP_TARGET\": \"5\"}\n}"),
+				observed: awsclient.String(`{"env":{"WARM_IP_TARGET":"5"},"replicaCount":3}`),
+			},
+			want: true,
+		},
+		"YAMLMatchingJSON": {
+			args: args{
+				desired:  awsclient.String("replicaCount: 3\n"),
+				observed: awsclient.String(`{"replicaCount":3}`),
+			},
+			want: true,
+		},
+		"DifferentValue": {
+			args: args{
+				desired:  awsclient.String(`{"replicaCount":3}`),
+				observed: awsclient.String(`{"replicaCount":2}`),
+			},
+			want: false,
+		},
+		"RemovedValues": {
+			args: args{
+				desired:  new(string),
+				observed: awsclient.String(`{"replicaCount":2}`),
+			},
+			want: false,
+		},
+		"BothEmpty": {
+			args: args{
+				desired: new(string),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isConfigurationValuesUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -104,11 +104,6 @@ func GenerateAddon(resp *svcsdk.DescribeAddonOutput) *svcapitypes.Addon {
 	} else {
 		cr.Status.AtProvider.ModifiedAt = nil
 	}
-	if resp.Addon.ServiceAccountRoleArn != nil {
-		cr.Spec.ForProvider.ServiceAccountRoleARN = resp.Addon.ServiceAccountRoleArn
-	} else {
-		cr.Spec.ForProvider.ServiceAccountRoleARN = nil
-	}
 	if resp.Addon.Status != nil {
 		cr.Status.AtProvider.Status = resp.Addon.Status
 	} else {
@@ -142,9 +137,6 @@ func GenerateCreateAddonInput(cr *svcapitypes.Addon) *svcsdk.CreateAddonInput {
 	if cr.Spec.ForProvider.ResolveConflicts != nil {
 		res.SetResolveConflicts(*cr.Spec.ForProvider.ResolveConflicts)
 	}
-	if cr.Spec.ForProvider.ServiceAccountRoleARN != nil {
-		res.SetServiceAccountRoleArn(*cr.Spec.ForProvider.ServiceAccountRoleARN)
-	}
 	if cr.Spec.ForProvider.Tags != nil {
 		f4 := map[string]*string{}
 		for f4key, f4valiter := range cr.Spec.ForProvider.Tags {
@@ -171,9 +163,6 @@ func GenerateUpdateAddonInput(cr *svcapitypes.Addon) *svcsdk.UpdateAddonInput {
 	if cr.Spec.ForProvider.ResolveConflicts != nil {
 		res.SetResolveConflicts(*cr.Spec.ForProvider.ResolveConflicts)
 	}
-	if cr.Spec.ForProvider.ServiceAccountRoleARN != nil {
-		res.SetServiceAccountRoleArn(*cr.Spec.ForProvider.ServiceAccountRoleARN)
-	}
 
 	return res
 }
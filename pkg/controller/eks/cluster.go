@@ -19,10 +19,12 @@ package eks
 import (
 	"context"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -40,26 +42,45 @@ import (
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
 )
 
 const (
 	errNotEKSCluster    = "managed resource is not an EKS cluster custom resource"
 	errKubeUpdateFailed = "cannot update EKS cluster custom resource"
 
-	errCreateFailed        = "cannot create EKS cluster"
-	errUpdateConfigFailed  = "cannot update EKS cluster configuration"
-	errUpdateVersionFailed = "cannot update EKS cluster version"
-	errAddTagsFailed       = "cannot add tags to EKS cluster"
-	errDeleteFailed        = "cannot delete EKS cluster"
-	errDescribeFailed      = "cannot describe EKS cluster"
-	errPatchCreationFailed = "cannot create a patch object"
-	errUpToDateFailed      = "cannot check whether object is up-to-date"
+	errCreateFailed              = "cannot create EKS cluster"
+	errUpdateConfigFailed        = "cannot update EKS cluster configuration"
+	errUpdateVersionFailed       = "cannot update EKS cluster version"
+	errUpdateLoggingFailed       = "cannot update EKS cluster logging"
+	errAssociateEncryptionFailed = "cannot enable encryption of EKS cluster"
+	errAddTagsFailed             = "cannot add tags to EKS cluster"
+	errDeleteFailed              = "cannot delete EKS cluster"
+	errListFargateFailed         = "cannot list fargate profiles of EKS cluster"
+	errFargateProfiles           = "cannot delete EKS cluster until its fargate profiles are deleted: %s"
+	errDescribeFailed            = "cannot describe EKS cluster"
+	errPatchCreationFailed       = "cannot create a patch object"
+	errUpToDateFailed            = "cannot check whether object is up-to-date"
+
+	errListOIDCProvidersFailed  = "cannot list IAM OpenID Connect providers"
+	errGetOIDCProviderFailed    = "cannot get IAM OpenID Connect provider of EKS cluster"
+	errCreateOIDCProviderFailed = "cannot create IAM OpenID Connect provider of EKS cluster"
+	errUpdateOIDCProviderFailed = "cannot update client IDs of IAM OpenID Connect provider of EKS cluster"
+	errDeleteOIDCProviderFailed = "cannot delete IAM OpenID Connect provider of EKS cluster"
+	errThumbprintFailed         = "cannot get thumbprint of EKS cluster OpenID Connect issuer"
 )
 
 // SetupCluster adds a controller that reconciles Clusters.
 func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(v1beta1.ClusterGroupKind)
 
+	// The token in the kubeconfig published with the connection details of a
+	// cluster is refreshed whenever the cluster is observed, which must happen
+	// before the token expires.
+	if poll > eks.TokenRefreshInterval {
+		poll = eks.TokenRefreshInterval
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
@@ -68,7 +89,8 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 		For(&v1beta1.Cluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient, newIAMClientFn: iam.NewOpenIDConnectProviderClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -80,6 +102,7 @@ type connector struct {
 	kube           client.Client
 	newClientFn    func(config aws.Config) eks.Client
 	newSTSClientFn func(config aws.Config) eks.STSClient
+	newIAMClientFn func(config aws.Config) iam.OpenIDConnectProviderClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -91,13 +114,15 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), iam: c.newIAMClientFn(*cfg), thumbprint: iam.GetThumbprint, kube: c.kube}, nil
 }
 
 type external struct {
-	client eks.Client
-	sts    eks.STSClient
-	kube   client.Client
+	client     eks.Client
+	sts        eks.STSClient
+	iam        iam.OpenIDConnectProviderClient
+	thumbprint func(ctx context.Context, issuerURL string) (string, error)
+	kube       client.Client
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -134,11 +159,20 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	if upToDate && cr.Spec.ForProvider.OpenIDConnectProvider != nil && cr.Status.AtProvider.Identity.OIDC.Issuer != "" {
+		upToDate, err = e.observeOIDCProvider(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	if upToDate && cr.Status.AtProvider.Status == v1beta1.ClusterStatusActive {
+		awsclient.CompleteAsyncOperation(cr)
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  upToDate,
-		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts),
+		ConnectionDetails: eks.GetConnectionDetails(ctx, &cr.Spec.ForProvider, rsp.Cluster, e.sts),
 	}, nil
 }
 
@@ -152,7 +186,10 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, nil
 	}
 	_, err := e.client.CreateCluster(ctx, eks.GenerateCreateClusterInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
-	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	return managed.ExternalCreation{}, awsclient.PersistAsyncInProgress(ctx, e.kube, cr, "CreateCluster")
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
@@ -171,6 +208,15 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil || rsp.Cluster == nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeFailed)
 	}
+	upToDate, err := eks.IsUpToDate(&cr.Spec.ForProvider, rsp.Cluster)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpToDateFailed)
+	}
+	// NOTE: the OpenID Connect provider is only observed once the cluster
+	// itself is up to date, so there are no cluster changes left to apply.
+	if upToDate && cr.Spec.ForProvider.OpenIDConnectProvider != nil {
+		return managed.ExternalUpdate{}, e.updateOIDCProvider(ctx, cr)
+	}
 	add, remove := awsclient.DiffTags(cr.Spec.ForProvider.Tags, rsp.Cluster.Tags)
 	if len(remove) != 0 {
 		if _, err := e.client.UntagResource(ctx, &awseks.UntagResourceInput{ResourceArn: rsp.Cluster.Arn, TagKeys: remove}); err != nil {
@@ -187,10 +233,32 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPatchCreationFailed)
 	}
 	if patch.Version != nil {
-		_, err := e.client.UpdateClusterVersion(ctx, &awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: patch.Version})
+		o, err := e.client.UpdateClusterVersion(ctx, &awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: patch.Version})
+		if err == nil && o.Update != nil {
+			awsclient.SetAsyncInProgress(cr, "UpdateClusterVersion "+aws.ToString(o.Update.Id))
+		}
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
 	}
-	_, err = e.client.UpdateClusterConfig(ctx, eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch))
+	// NOTE: EKS accepts only one kind of update at a time, so encryption,
+	// logging and VPC configuration are updated in subsequent reconciles.
+	if eks.NeedsEncryptionConfig(&cr.Spec.ForProvider, rsp.Cluster) {
+		o, err := e.client.AssociateEncryptionConfig(ctx, eks.GenerateAssociateEncryptionConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+		if err == nil && o.Update != nil {
+			awsclient.SetAsyncInProgress(cr, "AssociateEncryptionConfig "+aws.ToString(o.Update.Id))
+		}
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errAssociateEncryptionFailed)
+	}
+	if !eks.IsLoggingUpToDate(cr.Spec.ForProvider.Logging, rsp.Cluster.Logging) {
+		o, err := e.client.UpdateClusterConfig(ctx, eks.GenerateUpdateClusterLoggingInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+		if err == nil && o.Update != nil {
+			awsclient.SetAsyncInProgress(cr, "UpdateClusterConfig "+aws.ToString(o.Update.Id))
+		}
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateLoggingFailed)
+	}
+	o, err := e.client.UpdateClusterConfig(ctx, eks.GenerateUpdateClusterConfigInput(meta.GetExternalName(cr), patch))
+	if err == nil && o.Update != nil {
+		awsclient.SetAsyncInProgress(cr, "UpdateClusterConfig "+aws.ToString(o.Update.Id))
+	}
 	return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
 }
 
@@ -203,10 +271,97 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if cr.Status.AtProvider.Status == v1beta1.ClusterStatusDeleting {
 		return nil
 	}
-	_, err := e.client.DeleteCluster(ctx, &awseks.DeleteClusterInput{Name: awsclient.String(meta.GetExternalName(cr))})
+	// EKS refuses to delete a cluster that still has fargate profiles. These
+	// are deleted one at a time, so we wait for all of them to be gone.
+	fps, err := e.client.ListFargateProfiles(ctx, &awseks.ListFargateProfilesInput{ClusterName: awsclient.String(meta.GetExternalName(cr))})
+	if eks.IsErrorNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return awsclient.Wrap(err, errListFargateFailed)
+	}
+	if len(fps.FargateProfileNames) > 0 {
+		return errors.Errorf(errFargateProfiles, strings.Join(fps.FargateProfileNames, ", "))
+	}
+	if cr.Spec.ForProvider.OpenIDConnectProvider != nil {
+		if err := e.deleteOIDCProvider(ctx, cr); err != nil {
+			return err
+		}
+	}
+	_, err = e.client.DeleteCluster(ctx, &awseks.DeleteClusterInput{Name: awsclient.String(meta.GetExternalName(cr))})
 	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDeleteFailed)
 }
 
+// findOIDCProvider returns the ARN of the IAM OpenID Connect provider of the
+// OIDC issuer of the cluster, or an empty string if there is none.
+func (e *external) findOIDCProvider(ctx context.Context, cr *v1beta1.Cluster) (string, error) {
+	rsp, err := e.iam.ListOpenIDConnectProviders(ctx, &awsiam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return "", awsclient.Wrap(err, errListOIDCProvidersFailed)
+	}
+	return eks.FindOIDCProviderARN(rsp.OpenIDConnectProviderList, cr.Status.AtProvider.Identity.OIDC.Issuer), nil
+}
+
+func (e *external) observeOIDCProvider(ctx context.Context, cr *v1beta1.Cluster) (bool, error) {
+	arn, err := e.findOIDCProvider(ctx, cr)
+	if err != nil || arn == "" {
+		return false, err
+	}
+	rsp, err := e.iam.GetOpenIDConnectProvider(ctx, &awsiam.GetOpenIDConnectProviderInput{OpenIDConnectProviderArn: &arn})
+	if err != nil {
+		return false, awsclient.Wrap(err, errGetOIDCProviderFailed)
+	}
+	cr.Status.AtProvider.Identity.OIDC.OpenIDConnectProviderArn = arn
+	return eks.IsOIDCProviderUpToDate(cr.Spec.ForProvider.OpenIDConnectProvider, rsp), nil
+}
+
+func (e *external) updateOIDCProvider(ctx context.Context, cr *v1beta1.Cluster) error {
+	issuer := cr.Status.AtProvider.Identity.OIDC.Issuer
+	if issuer == "" {
+		return nil
+	}
+	arn, err := e.findOIDCProvider(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if arn == "" {
+		thumbprint, err := e.thumbprint(ctx, issuer)
+		if err != nil {
+			return errors.Wrap(err, errThumbprintFailed)
+		}
+		_, err = e.iam.CreateOpenIDConnectProvider(ctx, eks.GenerateCreateOIDCProviderInput(issuer, thumbprint, &cr.Spec.ForProvider))
+		return awsclient.Wrap(err, errCreateOIDCProviderFailed)
+	}
+	rsp, err := e.iam.GetOpenIDConnectProvider(ctx, &awsiam.GetOpenIDConnectProviderInput{OpenIDConnectProviderArn: &arn})
+	if err != nil {
+		return awsclient.Wrap(err, errGetOIDCProviderFailed)
+	}
+	add, remove := iam.SliceDifference(rsp.ClientIDList, cr.Spec.ForProvider.OpenIDConnectProvider.ClientIDList)
+	for i := range add {
+		if _, err := e.iam.AddClientIDToOpenIDConnectProvider(ctx, &awsiam.AddClientIDToOpenIDConnectProviderInput{OpenIDConnectProviderArn: &arn, ClientID: &add[i]}); err != nil {
+			return awsclient.Wrap(err, errUpdateOIDCProviderFailed)
+		}
+	}
+	for i := range remove {
+		if _, err := e.iam.RemoveClientIDFromOpenIDConnectProvider(ctx, &awsiam.RemoveClientIDFromOpenIDConnectProviderInput{OpenIDConnectProviderArn: &arn, ClientID: &remove[i]}); err != nil {
+			return awsclient.Wrap(err, errUpdateOIDCProviderFailed)
+		}
+	}
+	return nil
+}
+
+func (e *external) deleteOIDCProvider(ctx context.Context, cr *v1beta1.Cluster) error {
+	if cr.Status.AtProvider.Identity.OIDC.Issuer == "" {
+		return nil
+	}
+	arn, err := e.findOIDCProvider(ctx, cr)
+	if err != nil || arn == "" {
+		return err
+	}
+	_, err = e.iam.DeleteOpenIDConnectProvider(ctx, &awsiam.DeleteOpenIDConnectProviderInput{OpenIDConnectProviderArn: &arn})
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDeleteOIDCProviderFailed)
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -22,7 +22,10 @@ import (
 
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -35,16 +38,22 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
 	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	iamfake "github.com/crossplane/provider-aws/pkg/clients/iam/fake"
 )
 
 var (
 	version = "1.16"
 
+	issuer          = "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	oidcProviderARN = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+
 	errBoom = errors.New("boom")
 )
 
 type args struct {
 	eks  eks.Client
+	iam  iam.OpenIDConnectProviderClient
 	kube client.Client
 	cr   *v1beta1.Cluster
 }
@@ -77,6 +86,33 @@ func withConfig(c v1beta1.VpcConfigRequest) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.ResourcesVpcConfig = c }
 }
 
+func withEncryptionConfig(keyArn string) clusterModifier {
+	return func(r *v1beta1.Cluster) {
+		r.Spec.ForProvider.EncryptionConfig = []v1beta1.EncryptionConfig{{
+			Provider:  v1beta1.Provider{KeyArn: keyArn},
+			Resources: []string{"secrets"},
+		}}
+	}
+}
+
+func withLogging(types ...v1beta1.LogType) clusterModifier {
+	return func(r *v1beta1.Cluster) {
+		r.Spec.ForProvider.Logging = &v1beta1.Logging{
+			ClusterLogging: []v1beta1.LogSetup{{Enabled: awsclient.Bool(true), Types: types}},
+		}
+	}
+}
+
+func withOIDCProvider(clientIDs ...string) clusterModifier {
+	return func(r *v1beta1.Cluster) {
+		r.Spec.ForProvider.OpenIDConnectProvider = &v1beta1.OpenIDConnectProviderConfig{ClientIDList: clientIDs}
+	}
+}
+
+func withOIDC(o v1beta1.OIDC) clusterModifier {
+	return func(r *v1beta1.Cluster) { r.Status.AtProvider.Identity.OIDC = o }
+}
+
 func cluster(m ...clusterModifier) *v1beta1.Cluster {
 	cr := &v1beta1.Cluster{}
 	for _, f := range m {
@@ -119,7 +155,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &v1beta1.ClusterParameters{}, &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
 				},
 			},
 		},
@@ -143,7 +179,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &v1beta1.ClusterParameters{}, &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
 				},
 			},
 		},
@@ -167,7 +203,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &v1beta1.ClusterParameters{}, &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
 				},
 			},
 		},
@@ -224,7 +260,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &v1beta1.ClusterParameters{}, &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
 				},
 			},
 		},
@@ -250,11 +286,110 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errKubeUpdateFailed),
 			},
 		},
+		"OIDCProviderMissing": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{
+								Status:   awsekstypes.ClusterStatusActive,
+								Identity: &awsekstypes.Identity{Oidc: &awsekstypes.OIDC{Issuer: &issuer}},
+							},
+						}, nil
+					},
+				},
+				iam: &iamfake.MockOpenIDConnectProviderClient{
+					MockListOpenIDConnectProviders: func(ctx context.Context, input *awsiam.ListOpenIDConnectProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+						return &awsiam.ListOpenIDConnectProvidersOutput{
+							OpenIDConnectProviderList: []awsiamtypes.OpenIDConnectProviderListEntry{{Arn: awsclient.String("arn:aws:iam::123456789012:oidc-provider/example.com")}},
+						}, nil
+					},
+				},
+				cr: cluster(withOIDCProvider("sts.amazonaws.com")),
+			},
+			want: want{
+				cr: cluster(
+					withOIDCProvider("sts.amazonaws.com"),
+					withOIDC(v1beta1.OIDC{Issuer: issuer}),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &v1beta1.ClusterParameters{}, &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+				},
+			},
+		},
+		"OIDCProviderUpToDate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{
+								Status:   awsekstypes.ClusterStatusActive,
+								Identity: &awsekstypes.Identity{Oidc: &awsekstypes.OIDC{Issuer: &issuer}},
+							},
+						}, nil
+					},
+				},
+				iam: &iamfake.MockOpenIDConnectProviderClient{
+					MockListOpenIDConnectProviders: func(ctx context.Context, input *awsiam.ListOpenIDConnectProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+						return &awsiam.ListOpenIDConnectProvidersOutput{
+							OpenIDConnectProviderList: []awsiamtypes.OpenIDConnectProviderListEntry{{Arn: awsclient.String(oidcProviderARN)}},
+						}, nil
+					},
+					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
+						return &awsiam.GetOpenIDConnectProviderOutput{ClientIDList: []string{"sts.amazonaws.com"}}, nil
+					},
+				},
+				cr: cluster(withOIDCProvider("sts.amazonaws.com")),
+			},
+			want: want{
+				cr: cluster(
+					withOIDCProvider("sts.amazonaws.com"),
+					withOIDC(v1beta1.OIDC{Issuer: issuer, OpenIDConnectProviderArn: oidcProviderARN}),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &v1beta1.ClusterParameters{}, &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+				},
+			},
+		},
+		"FailedListOIDCProviders": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{
+								Status:   awsekstypes.ClusterStatusActive,
+								Identity: &awsekstypes.Identity{Oidc: &awsekstypes.OIDC{Issuer: &issuer}},
+							},
+						}, nil
+					},
+				},
+				iam: &iamfake.MockOpenIDConnectProviderClient{
+					MockListOpenIDConnectProviders: func(ctx context.Context, input *awsiam.ListOpenIDConnectProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withOIDCProvider("sts.amazonaws.com")),
+			},
+			want: want{
+				cr: cluster(
+					withOIDCProvider("sts.amazonaws.com"),
+					withOIDC(v1beta1.OIDC{Issuer: issuer}),
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive)),
+				err: awsclient.Wrap(errBoom, errListOIDCProvidersFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, iam: tc.iam}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -283,6 +418,9 @@ func TestCreate(t *testing.T) {
 	}{
 		"Successful": {
 			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+				},
 				eks: &fake.MockClient{
 					MockCreateCluster: func(ctx context.Context, input *awseks.CreateClusterInput, opts []func(*awseks.Options)) (*awseks.CreateClusterOutput, error) {
 						return &awseks.CreateClusterOutput{}, nil
@@ -291,10 +429,27 @@ func TestCreate(t *testing.T) {
 				cr: cluster(),
 			},
 			want: want{
-				cr:     cluster(withConditions(xpv1.Creating())),
+				cr:     cluster(withConditions(xpv1.Creating(), awsclient.AsyncInProgress("CreateCluster"))),
 				result: managed.ExternalCreation{},
 			},
 		},
+		"FailedStatusUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+				},
+				eks: &fake.MockClient{
+					MockCreateCluster: func(ctx context.Context, input *awseks.CreateClusterInput, opts []func(*awseks.Options)) (*awseks.CreateClusterOutput, error) {
+						return &awseks.CreateClusterOutput{}, nil
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(xpv1.Creating(), awsclient.AsyncInProgress("CreateCluster"))),
+				err: errors.Wrap(errBoom, "cannot update status with the async operation in progress"),
+			},
+		},
 		"SuccessfulNoNeedForCreate": {
 			args: args{
 				cr: cluster(withStatus(v1beta1.ClusterStatusCreating)),
@@ -323,7 +478,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, iam: tc.iam}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -400,7 +555,7 @@ func TestUpdate(t *testing.T) {
 			args: args{
 				eks: &fake.MockClient{
 					MockUpdateClusterVersion: func(ctx context.Context, input *awseks.UpdateClusterVersionInput, opts []func(*awseks.Options)) (*awseks.UpdateClusterVersionOutput, error) {
-						return &awseks.UpdateClusterVersionOutput{}, nil
+						return &awseks.UpdateClusterVersionOutput{Update: &awsekstypes.Update{Id: awsclient.String("update-id")}}, nil
 					},
 					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
 						return &awseks.DescribeClusterOutput{
@@ -411,7 +566,8 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withVersion(&version)),
 			},
 			want: want{
-				cr: cluster(withVersion(&version)),
+				cr: cluster(withVersion(&version),
+					withConditions(awsclient.AsyncInProgress("UpdateClusterVersion update-id"))),
 			},
 		},
 		"SuccessfulUpdateCluster": {
@@ -432,6 +588,85 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withConfig(v1beta1.VpcConfigRequest{SubnetIDs: []string{"subnet"}})),
 			},
 		},
+		"SuccessfulAssociateEncryption": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateEncryptionConfig: func(ctx context.Context, input *awseks.AssociateEncryptionConfigInput, opts []func(*awseks.Options)) (*awseks.AssociateEncryptionConfigOutput, error) {
+						return &awseks.AssociateEncryptionConfigOutput{Update: &awsekstypes.Update{Id: awsclient.String("update-id")}}, nil
+					},
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+				},
+				cr: cluster(withEncryptionConfig("key")),
+			},
+			want: want{
+				cr: cluster(withEncryptionConfig("key"),
+					withConditions(awsclient.AsyncInProgress("AssociateEncryptionConfig update-id"))),
+			},
+		},
+		"SuccessfulUpdateLogging": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateClusterConfig: func(ctx context.Context, input *awseks.UpdateClusterConfigInput, opts []func(*awseks.Options)) (*awseks.UpdateClusterConfigOutput, error) {
+						if input.Logging == nil || input.ResourcesVpcConfig != nil {
+							return nil, errBoom
+						}
+						return &awseks.UpdateClusterConfigOutput{Update: &awsekstypes.Update{Id: awsclient.String("update-id")}}, nil
+					},
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+				},
+				cr: cluster(withLogging(v1beta1.LogTypeAPI)),
+			},
+			want: want{
+				cr: cluster(withLogging(v1beta1.LogTypeAPI),
+					withConditions(awsclient.AsyncInProgress("UpdateClusterConfig update-id"))),
+			},
+		},
+		"FailedAssociateEncryption": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateEncryptionConfig: func(ctx context.Context, input *awseks.AssociateEncryptionConfigInput, opts []func(*awseks.Options)) (*awseks.AssociateEncryptionConfigOutput, error) {
+						return nil, errBoom
+					},
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+				},
+				cr: cluster(withEncryptionConfig("key")),
+			},
+			want: want{
+				cr:  cluster(withEncryptionConfig("key")),
+				err: awsclient.Wrap(errBoom, errAssociateEncryptionFailed),
+			},
+		},
+		"FailedUpdateLogging": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateClusterConfig: func(ctx context.Context, input *awseks.UpdateClusterConfigInput, opts []func(*awseks.Options)) (*awseks.UpdateClusterConfigOutput, error) {
+						return nil, errBoom
+					},
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+				},
+				cr: cluster(withLogging(v1beta1.LogTypeAPI)),
+			},
+			want: want{
+				cr:  cluster(withLogging(v1beta1.LogTypeAPI)),
+				err: awsclient.Wrap(errBoom, errUpdateLoggingFailed),
+			},
+		},
 		"AlreadyModifying": {
 			args: args{
 				cr: cluster(withStatus(v1beta1.ClusterStatusUpdating)),
@@ -532,11 +767,100 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errAddTagsFailed),
 			},
 		},
+		"SuccessfulCreateOIDCProvider": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+				},
+				iam: &iamfake.MockOpenIDConnectProviderClient{
+					MockListOpenIDConnectProviders: func(ctx context.Context, input *awsiam.ListOpenIDConnectProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+						return &awsiam.ListOpenIDConnectProvidersOutput{
+							OpenIDConnectProviderList: []awsiamtypes.OpenIDConnectProviderListEntry{{Arn: awsclient.String("arn:aws:iam::123456789012:oidc-provider/example.com")}},
+						}, nil
+					},
+					MockCreateOpenIDConnectProvider: func(ctx context.Context, input *awsiam.CreateOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateOpenIDConnectProviderOutput, error) {
+						if diff := cmp.Diff(eks.GenerateCreateOIDCProviderInput(issuer, "thumbprint", &v1beta1.ClusterParameters{
+							OpenIDConnectProvider: &v1beta1.OpenIDConnectProviderConfig{ClientIDList: []string{"sts.amazonaws.com"}},
+						}), input, cmpopts.IgnoreUnexported(awsiam.CreateOpenIDConnectProviderInput{})); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsiam.CreateOpenIDConnectProviderOutput{}, nil
+					},
+				},
+				cr: cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer})),
+			},
+			want: want{
+				cr: cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer})),
+			},
+		},
+		"SuccessfulUpdateOIDCClientIDs": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+				},
+				iam: &iamfake.MockOpenIDConnectProviderClient{
+					MockListOpenIDConnectProviders: func(ctx context.Context, input *awsiam.ListOpenIDConnectProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+						return &awsiam.ListOpenIDConnectProvidersOutput{
+							OpenIDConnectProviderList: []awsiamtypes.OpenIDConnectProviderListEntry{{Arn: awsclient.String(oidcProviderARN)}},
+						}, nil
+					},
+					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
+						return &awsiam.GetOpenIDConnectProviderOutput{ClientIDList: []string{"old"}}, nil
+					},
+					MockAddClientIDToOpenIDConnectProvider: func(ctx context.Context, input *awsiam.AddClientIDToOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.AddClientIDToOpenIDConnectProviderOutput, error) {
+						return &awsiam.AddClientIDToOpenIDConnectProviderOutput{}, nil
+					},
+					MockRemoveClientIDFromOpenIDConnectProvider: func(ctx context.Context, input *awsiam.RemoveClientIDFromOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.RemoveClientIDFromOpenIDConnectProviderOutput, error) {
+						return &awsiam.RemoveClientIDFromOpenIDConnectProviderOutput{}, nil
+					},
+				},
+				cr: cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer})),
+			},
+			want: want{
+				cr: cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer})),
+			},
+		},
+		"FailedCreateOIDCProvider": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{},
+						}, nil
+					},
+				},
+				iam: &iamfake.MockOpenIDConnectProviderClient{
+					MockListOpenIDConnectProviders: func(ctx context.Context, input *awsiam.ListOpenIDConnectProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+						return &awsiam.ListOpenIDConnectProvidersOutput{
+							OpenIDConnectProviderList: []awsiamtypes.OpenIDConnectProviderListEntry{{Arn: awsclient.String("arn:aws:iam::123456789012:oidc-provider/example.com")}},
+						}, nil
+					},
+					MockCreateOpenIDConnectProvider: func(ctx context.Context, input *awsiam.CreateOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateOpenIDConnectProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer})),
+			},
+			want: want{
+				cr:  cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer})),
+				err: awsclient.Wrap(errBoom, errCreateOIDCProviderFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, iam: tc.iam, thumbprint: func(context.Context, string) (string, error) {
+				return "thumbprint", nil
+			}}
 			u, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -565,6 +889,9 @@ func TestDelete(t *testing.T) {
 		"Successful": {
 			args: args{
 				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return &awseks.ListFargateProfilesOutput{}, nil
+					},
 					MockDeleteCluster: func(ctx context.Context, input *awseks.DeleteClusterInput, opts []func(*awseks.Options)) (*awseks.DeleteClusterOutput, error) {
 						return &awseks.DeleteClusterOutput{}, nil
 					},
@@ -587,6 +914,9 @@ func TestDelete(t *testing.T) {
 		"AlreadyDeleted": {
 			args: args{
 				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return &awseks.ListFargateProfilesOutput{}, nil
+					},
 					MockDeleteCluster: func(ctx context.Context, input *awseks.DeleteClusterInput, opts []func(*awseks.Options)) (*awseks.DeleteClusterOutput, error) {
 						return nil, &awsekstypes.ResourceNotFoundException{}
 					},
@@ -597,9 +927,53 @@ func TestDelete(t *testing.T) {
 				cr: cluster(withConditions(xpv1.Deleting())),
 			},
 		},
+		"FargateProfilesExist": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return &awseks.ListFargateProfilesOutput{FargateProfileNames: []string{"default", "kube-system"}}, nil
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(xpv1.Deleting())),
+				err: errors.Errorf(errFargateProfiles, "default, kube-system"),
+			},
+		},
+		"ClusterNotFoundWhenListingFargateProfiles": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedListFargateProfiles": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errListFargateFailed),
+			},
+		},
 		"Failed": {
 			args: args{
 				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return &awseks.ListFargateProfilesOutput{}, nil
+					},
 					MockDeleteCluster: func(ctx context.Context, input *awseks.DeleteClusterInput, opts []func(*awseks.Options)) (*awseks.DeleteClusterOutput, error) {
 						return nil, errBoom
 					},
@@ -611,11 +985,61 @@ func TestDelete(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errDeleteFailed),
 			},
 		},
+		"SuccessfulDeleteOIDCProvider": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return &awseks.ListFargateProfilesOutput{}, nil
+					},
+					MockDeleteCluster: func(ctx context.Context, input *awseks.DeleteClusterInput, opts []func(*awseks.Options)) (*awseks.DeleteClusterOutput, error) {
+						return &awseks.DeleteClusterOutput{}, nil
+					},
+				},
+				iam: &iamfake.MockOpenIDConnectProviderClient{
+					MockListOpenIDConnectProviders: func(ctx context.Context, input *awsiam.ListOpenIDConnectProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+						return &awsiam.ListOpenIDConnectProvidersOutput{
+							OpenIDConnectProviderList: []awsiamtypes.OpenIDConnectProviderListEntry{{Arn: awsclient.String(oidcProviderARN)}},
+						}, nil
+					},
+					MockDeleteOpenIDConnectProvider: func(ctx context.Context, input *awsiam.DeleteOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteOpenIDConnectProviderOutput, error) {
+						return &awsiam.DeleteOpenIDConnectProviderOutput{}, nil
+					},
+				},
+				cr: cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer})),
+			},
+			want: want{
+				cr: cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDeleteOIDCProvider": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return &awseks.ListFargateProfilesOutput{}, nil
+					},
+				},
+				iam: &iamfake.MockOpenIDConnectProviderClient{
+					MockListOpenIDConnectProviders: func(ctx context.Context, input *awsiam.ListOpenIDConnectProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListOpenIDConnectProvidersOutput, error) {
+						return &awsiam.ListOpenIDConnectProvidersOutput{
+							OpenIDConnectProviderList: []awsiamtypes.OpenIDConnectProviderListEntry{{Arn: awsclient.String(oidcProviderARN)}},
+						}, nil
+					},
+					MockDeleteOpenIDConnectProvider: func(ctx context.Context, input *awsiam.DeleteOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteOpenIDConnectProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer})),
+			},
+			want: want{
+				cr:  cluster(withOIDCProvider("sts.amazonaws.com"), withOIDC(v1beta1.OIDC{Issuer: issuer}), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteOIDCProviderFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, iam: tc.iam}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -18,6 +18,7 @@ package fargateprofile
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -49,6 +50,7 @@ const (
 	errAddTagsFailed        = "cannot add tags to EKS fargate profile"
 	errDeleteFailed         = "cannot delete EKS fargate profile"
 	errDescribeFailed       = "cannot describe EKS fargate profile"
+	errImmutableFields      = "cannot change immutable fields of EKS fargate profile, it has to be recreated: %s"
 )
 
 // SetupFargateProfile adds a controller that reconciles FargateProfiles.
@@ -63,7 +65,8 @@ func SetupFargateProfile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLi
 		For(&v1beta1.FargateProfile{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.FargateProfileGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -164,6 +167,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errAddTagsFailed)
 		}
 	}
+	if changed := eks.GetFargateProfileImmutableFieldChanges(cr.Spec.ForProvider, rsp.FargateProfile); len(changed) > 0 {
+		return managed.ExternalUpdate{}, errors.Errorf(errImmutableFields, strings.Join(changed, ", "))
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -177,7 +183,10 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return nil
 	}
 	_, err := e.client.DeleteFargateProfile(ctx, &awseks.DeleteFargateProfileInput{FargateProfileName: awsclient.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName})
-	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDeleteFailed)
+	// NOTE: EKS deletes only one fargate profile of a cluster at a time and
+	// rejects the request while another one is being deleted or this one is
+	// still being created, so we just try again on the next reconcile.
+	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, resource.Ignore(eks.IsErrorInUse, err)), errDeleteFailed)
 }
 
 type tagger struct {
@@ -348,6 +348,24 @@ func TestUpdate(t *testing.T) {
 				cr: fargateProfile(),
 			},
 		},
+		"ImmutableFieldsChanged": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
+						return &awseks.DescribeFargateProfileOutput{
+							FargateProfile: &awsekstypes.FargateProfile{
+								Subnets: []string{"subnet1"},
+							},
+						}, nil
+					},
+				},
+				cr: fargateProfile(withSubnets(subnets)),
+			},
+			want: want{
+				cr:  fargateProfile(withSubnets(subnets)),
+				err: errors.Errorf(errImmutableFields, "subnets"),
+			},
+		},
 		"FailedRemoveTags": {
 			args: args{
 				eks: &fake.MockClient{
@@ -453,6 +471,19 @@ func TestDelete(t *testing.T) {
 				cr: fargateProfile(withConditions(xpv1.Deleting())),
 			},
 		},
+		"AnotherProfileDeleting": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteFargateProfile: func(ctx context.Context, input *awseks.DeleteFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DeleteFargateProfileOutput, error) {
+						return nil, &awsekstypes.ResourceInUseException{}
+					},
+				},
+				cr: fargateProfile(),
+			},
+			want: want{
+				cr: fargateProfile(withConditions(xpv1.Deleting())),
+			},
+		},
 		"Failed": {
 			args: args{
 				eks: &fake.MockClient{
@@ -50,6 +50,7 @@ const (
 	errCreateFailed   = "cannot associate EKS identity provider config"
 	errDeleteFailed   = "cannot disassociate EKS identity provider config"
 	errDescribeFailed = "cannot describe EKS identity provider config"
+	errDescribeUpdate = "cannot describe EKS identity provider config association"
 	errAddTagsFailed  = "cannot add tags to EKS identity provider config"
 )
 
@@ -65,7 +66,8 @@ func SetupIdentityProviderConfig(mgr ctrl.Manager, l logging.Logger, rl workqueu
 		For(&manualv1alpha1.IdentityProviderConfig{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(manualv1alpha1.IdentityProviderConfigGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -114,8 +116,27 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	current := cr.Spec.ForProvider.DeepCopy()
 	eks.LateInitializeIdentityProviderConfig(&cr.Spec.ForProvider, rsp.IdentityProviderConfig)
 
-	// Generate observation
-	cr.Status.AtProvider = eks.GenerateIdentityProviderConfigObservation(rsp.IdentityProviderConfig)
+	// Generate observation, keeping track of the association which is only
+	// known from the response of the AssociateIdentityProviderConfig call.
+	obs := eks.GenerateIdentityProviderConfigObservation(rsp.IdentityProviderConfig)
+	obs.AssociationUpdateID = cr.Status.AtProvider.AssociationUpdateID
+	obs.AssociationUpdateStatus = cr.Status.AtProvider.AssociationUpdateStatus
+	cr.Status.AtProvider = obs
+
+	var associationErr string
+	if obs.AssociationUpdateID != "" && obs.AssociationUpdateStatus != string(types.UpdateStatusSuccessful) {
+		u, err := e.client.DescribeUpdate(ctx, &awseks.DescribeUpdateInput{
+			Name:     &cr.Spec.ForProvider.ClusterName,
+			UpdateId: aws.String(obs.AssociationUpdateID),
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeUpdate)
+		}
+		if u.Update != nil {
+			cr.Status.AtProvider.AssociationUpdateStatus = string(u.Update.Status)
+			associationErr = eks.GenerateUpdateErrorMessage(u.Update)
+		}
+	}
 
 	// Any of the statuses we don't explicitly address should be considered as
 	// the identity provider config being unavailable.
@@ -129,6 +150,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	switch types.UpdateStatus(cr.Status.AtProvider.AssociationUpdateStatus) { // nolint:exhaustive
+	case types.UpdateStatusFailed, types.UpdateStatusCancelled:
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(associationErr))
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -146,8 +171,15 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.Status == manualv1alpha1.IdentityProviderConfigStatusCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	_, err := e.client.AssociateIdentityProviderConfig(ctx, eks.GenerateAssociateIdentityProviderConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
-	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	rsp, err := e.client.AssociateIdentityProviderConfig(ctx, eks.GenerateAssociateIdentityProviderConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
+	}
+	if rsp.Update != nil {
+		cr.Status.AtProvider.AssociationUpdateID = aws.ToString(rsp.Update.Id)
+		cr.Status.AtProvider.AssociationUpdateStatus = string(rsp.Update.Status)
+	}
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -68,6 +68,13 @@ func withStatus(s manualv1alpha1.IdentityProviderConfigStatusType) identityProvi
 	return func(r *manualv1alpha1.IdentityProviderConfig) { r.Status.AtProvider.Status = s }
 }
 
+func withAssociation(id, status string) identityProviderConfigModifier {
+	return func(r *manualv1alpha1.IdentityProviderConfig) {
+		r.Status.AtProvider.AssociationUpdateID = id
+		r.Status.AtProvider.AssociationUpdateStatus = status
+	}
+}
+
 func identityProviderConfig(m ...identityProviderConfigModifier) *manualv1alpha1.IdentityProviderConfig {
 	cr := &manualv1alpha1.IdentityProviderConfig{}
 	for _, f := range m {
@@ -117,6 +124,99 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AssociationSucceeded": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeIdentityProviderConfig: func(ctx context.Context, input *awseks.DescribeIdentityProviderConfigInput, opts []func(*awseks.Options)) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return &awseks.DescribeIdentityProviderConfigOutput{
+							IdentityProviderConfig: &awsekstypes.IdentityProviderConfigResponse{
+								Oidc: &awsekstypes.OidcIdentityProviderConfig{
+									Status: awsekstypes.ConfigStatusActive,
+								},
+							},
+						}, nil
+					},
+					MockDescribeUpdate: func(ctx context.Context, input *awseks.DescribeUpdateInput, opts []func(*awseks.Options)) (*awseks.DescribeUpdateOutput, error) {
+						return &awseks.DescribeUpdateOutput{
+							Update: &awsekstypes.Update{Status: awsekstypes.UpdateStatusSuccessful},
+						}, nil
+					},
+				},
+				cr: identityProviderConfig(withAssociation("update-id", string(awsekstypes.UpdateStatusInProgress))),
+			},
+			want: want{
+				cr: identityProviderConfig(
+					withConditions(xpv1.Available()),
+					withStatus(manualv1alpha1.IdentityProviderConfigStatusActive),
+					withAssociation("update-id", string(awsekstypes.UpdateStatusSuccessful))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AssociationFailed": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeIdentityProviderConfig: func(ctx context.Context, input *awseks.DescribeIdentityProviderConfigInput, opts []func(*awseks.Options)) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return &awseks.DescribeIdentityProviderConfigOutput{
+							IdentityProviderConfig: &awsekstypes.IdentityProviderConfigResponse{
+								Oidc: &awsekstypes.OidcIdentityProviderConfig{
+									Status: awsekstypes.ConfigStatusCreating,
+								},
+							},
+						}, nil
+					},
+					MockDescribeUpdate: func(ctx context.Context, input *awseks.DescribeUpdateInput, opts []func(*awseks.Options)) (*awseks.DescribeUpdateOutput, error) {
+						return &awseks.DescribeUpdateOutput{
+							Update: &awsekstypes.Update{
+								Status: awsekstypes.UpdateStatusFailed,
+								Errors: []awsekstypes.ErrorDetail{{
+									ErrorCode:    awsekstypes.ErrorCodeUnknown,
+									ErrorMessage: awsclient.String("issuer unreachable"),
+								}},
+							},
+						}, nil
+					},
+				},
+				cr: identityProviderConfig(withAssociation("update-id", string(awsekstypes.UpdateStatusInProgress))),
+			},
+			want: want{
+				cr: identityProviderConfig(
+					withConditions(xpv1.Unavailable().WithMessage("Unknown: issuer unreachable")),
+					withStatus(manualv1alpha1.IdentityProviderConfigStatusCreating),
+					withAssociation("update-id", string(awsekstypes.UpdateStatusFailed))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailedDescribeUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeIdentityProviderConfig: func(ctx context.Context, input *awseks.DescribeIdentityProviderConfigInput, opts []func(*awseks.Options)) (*awseks.DescribeIdentityProviderConfigOutput, error) {
+						return &awseks.DescribeIdentityProviderConfigOutput{
+							IdentityProviderConfig: &awsekstypes.IdentityProviderConfigResponse{
+								Oidc: &awsekstypes.OidcIdentityProviderConfig{
+									Status: awsekstypes.ConfigStatusCreating,
+								},
+							},
+						}, nil
+					},
+					MockDescribeUpdate: func(ctx context.Context, input *awseks.DescribeUpdateInput, opts []func(*awseks.Options)) (*awseks.DescribeUpdateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identityProviderConfig(withAssociation("update-id", string(awsekstypes.UpdateStatusInProgress))),
+			},
+			want: want{
+				cr: identityProviderConfig(
+					withStatus(manualv1alpha1.IdentityProviderConfigStatusCreating),
+					withAssociation("update-id", string(awsekstypes.UpdateStatusInProgress))),
+				err: awsclient.Wrap(errBoom, errDescribeUpdate),
+			},
+		},
 		"DeletingState": {
 			args: args{
 				eks: &fake.MockClient{
@@ -248,6 +348,26 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"SuccessfulTracksAssociation": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateIdentityProviderConfig: func(ctx context.Context, input *awseks.AssociateIdentityProviderConfigInput, opts []func(*awseks.Options)) (*awseks.AssociateIdentityProviderConfigOutput, error) {
+						return &awseks.AssociateIdentityProviderConfigOutput{
+							Update: &awsekstypes.Update{
+								Id:     awsclient.String("update-id"),
+								Status: awsekstypes.UpdateStatusInProgress,
+							},
+						}, nil
+					},
+				},
+				cr: identityProviderConfig(),
+			},
+			want: want{
+				cr: identityProviderConfig(
+					withConditions(xpv1.Creating()),
+					withAssociation("update-id", string(awsekstypes.UpdateStatusInProgress))),
+			},
+		},
 		"SuccessfulNoNeedForCreate": {
 			args: args{
 				cr: identityProviderConfig(withStatus(manualv1alpha1.IdentityProviderConfigStatusCreating)),
@@ -52,6 +52,8 @@ const (
 	errAddTagsFailed       = "cannot add tags to EKS node group"
 	errDeleteFailed        = "cannot delete EKS node group"
 	errDescribeFailed      = "cannot describe EKS node group"
+
+	msgUpdating = "EKS node group update is in progress"
 )
 
 // SetupNodeGroup adds a controller that reconciles NodeGroups.
@@ -66,7 +68,8 @@ func SetupNodeGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 		For(&manualv1alpha1.NodeGroup{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(manualv1alpha1.NodeGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -123,6 +126,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		cr.Status.SetConditions(xpv1.Available())
 	case manualv1alpha1.NodeGroupStatusCreating:
 		cr.Status.SetConditions(xpv1.Creating())
+	case manualv1alpha1.NodeGroupStatusUpdating:
+		cr.Status.SetConditions(xpv1.Available().WithMessage(msgUpdating))
 	case manualv1alpha1.NodeGroupStatusDeleting:
 		cr.Status.SetConditions(xpv1.Deleting())
 	default:
@@ -175,11 +180,11 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errAddTagsFailed)
 		}
 	}
-	if !reflect.DeepEqual(rsp.Nodegroup.Version, cr.Spec.ForProvider.Version) {
-		_, err := e.client.UpdateNodegroupVersion(ctx, &awseks.UpdateNodegroupVersionInput{
-			ClusterName:   &cr.Spec.ForProvider.ClusterName,
-			NodegroupName: awsclient.String(meta.GetExternalName(cr)),
-			Version:       cr.Spec.ForProvider.Version})
+	// NOTE: version and configuration updates cannot run at the same time, so
+	// the configuration is updated in a later reconcile once the nodes have
+	// been rolled.
+	if !eks.IsNodeGroupVersionUpToDate(&cr.Spec.ForProvider, rsp.Nodegroup) {
+		_, err := e.client.UpdateNodegroupVersion(ctx, eks.GenerateUpdateNodeGroupVersionInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, rsp.Nodegroup))
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
 	}
 	_, err = e.client.UpdateNodegroupConfig(ctx, eks.GenerateUpdateNodeGroupConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, rsp.Nodegroup))
@@ -78,6 +78,10 @@ func withScalingConfig(c *manualv1alpha1.NodeGroupScalingConfig) nodeGroupModifi
 	return func(r *manualv1alpha1.NodeGroup) { r.Spec.ForProvider.ScalingConfig = c }
 }
 
+func withLaunchTemplate(lt *manualv1alpha1.LaunchTemplateSpecification) nodeGroupModifier {
+	return func(r *manualv1alpha1.NodeGroup) { r.Spec.ForProvider.LaunchTemplate = lt }
+}
+
 func nodeGroup(m ...nodeGroupModifier) *manualv1alpha1.NodeGroup {
 	cr := &manualv1alpha1.NodeGroup{}
 	for _, f := range m {
@@ -146,6 +150,29 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"UpdatingState": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeNodegroup: func(tx context.Context, input *awseks.DescribeNodegroupInput, opts []func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awsekstypes.Nodegroup{
+								Status: awsekstypes.NodegroupStatusUpdating,
+							},
+						}, nil
+					},
+				},
+				cr: nodeGroup(),
+			},
+			want: want{
+				cr: nodeGroup(
+					withConditions(xpv1.Available().WithMessage(msgUpdating)),
+					withStatus(manualv1alpha1.NodeGroupStatusUpdating)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"FailedState": {
 			args: args{
 				eks: &fake.MockClient{
@@ -409,6 +436,32 @@ func TestUpdate(t *testing.T) {
 				cr: nodeGroup(withVersion(&version)),
 			},
 		},
+		"SuccessfulUpdateLaunchTemplateVersion": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateNodegroupVersion: func(tx context.Context, input *awseks.UpdateNodegroupVersionInput, opts []func(*awseks.Options)) (*awseks.UpdateNodegroupVersionOutput, error) {
+						if awsclient.StringValue(input.LaunchTemplate.Version) != "2" {
+							return nil, errBoom
+						}
+						return &awseks.UpdateNodegroupVersionOutput{}, nil
+					},
+					MockUpdateNodegroupConfig: func(tx context.Context, input *awseks.UpdateNodegroupConfigInput, opts []func(*awseks.Options)) (*awseks.UpdateNodegroupConfigOutput, error) {
+						return nil, errBoom
+					},
+					MockDescribeNodegroup: func(tx context.Context, input *awseks.DescribeNodegroupInput, opts []func(*awseks.Options)) (*awseks.DescribeNodegroupOutput, error) {
+						return &awseks.DescribeNodegroupOutput{
+							Nodegroup: &awsekstypes.Nodegroup{
+								LaunchTemplate: &awsekstypes.LaunchTemplateSpecification{Version: awsclient.String("1")},
+							},
+						}, nil
+					},
+				},
+				cr: nodeGroup(withLaunchTemplate(&manualv1alpha1.LaunchTemplateSpecification{Version: awsclient.String("2")})),
+			},
+			want: want{
+				cr: nodeGroup(withLaunchTemplate(&manualv1alpha1.LaunchTemplateSpecification{Version: awsclient.String("2")})),
+			},
+		},
 		"SuccessfulUpdateNodeGroup": {
 			args: args{
 				eks: &fake.MockClient{
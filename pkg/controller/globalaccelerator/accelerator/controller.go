@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accelerator
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsglobalaccelerator "github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/globalaccelerator"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Global Accelerator Accelerator resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe the accelerator"
+	errCreate           = "failed to create the accelerator"
+	errUpdate           = "failed to update the accelerator"
+	errDisable          = "failed to disable the accelerator"
+	errDelete           = "failed to delete the accelerator"
+
+	msgDeploymentInProgress = "changes to the accelerator are being deployed"
+)
+
+// SetupAccelerator adds a controller that reconciles Global Accelerator
+// accelerators.
+func SetupAccelerator(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.AcceleratorGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.Accelerator{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AcceleratorGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: globalaccelerator.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) globalaccelerator.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, globalaccelerator.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client globalaccelerator.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.Accelerator) (*awsglobalaccelerator.Accelerator, error) {
+	resp, err := e.client.DescribeAcceleratorWithContext(ctx, &awsglobalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Accelerator, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Accelerator)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The external name is the ARN of the accelerator, which is only known
+	// once the accelerator is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(globalaccelerator.IsNotFound, err), errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	globalaccelerator.LateInitializeAccelerator(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = globalaccelerator.GenerateAcceleratorObservation(observed)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.AcceleratorStatusDeployed:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.AcceleratorStatusInProgress:
+		// An accelerator keeps routing traffic while changes are deployed,
+		// so it only becomes unavailable while it is being created.
+		if cr.GetCondition(xpv1.TypeReady).Reason == xpv1.ReasonAvailable {
+			cr.SetConditions(xpv1.Available().WithMessage(msgDeploymentInProgress))
+		} else {
+			cr.SetConditions(xpv1.Creating())
+		}
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	tags, err := globalaccelerator.ListTags(ctx, e.client, aws.StringValue(observed.AcceleratorArn))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: globalaccelerator.IsAcceleratorUpToDate(cr.Spec.ForProvider, observed) &&
+			cmp.Equal(cr.Spec.ForProvider.Tags, tags, cmpopts.EquateEmpty()),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.DNSName),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Accelerator)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateAcceleratorWithContext(ctx, globalaccelerator.GenerateCreateAcceleratorInput(string(cr.UID), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(out.Accelerator.AcceleratorArn))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Accelerator)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	if !globalaccelerator.IsAcceleratorUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.UpdateAcceleratorWithContext(ctx, globalaccelerator.GenerateUpdateAcceleratorInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	tags, err := globalaccelerator.ListTags(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, globalaccelerator.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, tags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Accelerator)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	observed, err := e.describe(ctx, cr)
+	if err != nil || observed == nil {
+		return awsclient.Wrap(resource.Ignore(globalaccelerator.IsNotFound, err), errDescribe)
+	}
+
+	// An accelerator has to be disabled, and the change deployed, before it
+	// can be deleted. The deletion is retried until then.
+	if aws.BoolValue(observed.Enabled) {
+		_, err := e.client.UpdateAcceleratorWithContext(ctx, &awsglobalaccelerator.UpdateAcceleratorInput{
+			AcceleratorArn: aws.String(meta.GetExternalName(cr)),
+			Enabled:        aws.Bool(false),
+		})
+		return awsclient.Wrap(err, errDisable)
+	}
+	if aws.StringValue(observed.Status) == v1alpha1.AcceleratorStatusInProgress {
+		return nil
+	}
+
+	_, err = e.client.DeleteAcceleratorWithContext(ctx, &awsglobalaccelerator.DeleteAcceleratorInput{
+		AcceleratorArn: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(globalaccelerator.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,423 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accelerator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsglobalaccelerator "github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/globalaccelerator/fake"
+)
+
+var (
+	acceleratorARN = "arn:aws:globalaccelerator::123456789012:accelerator/00000000-0000-0000-0000-000000000001"
+	dnsName        = "a0123456789abcdef.awsglobalaccelerator.com"
+
+	errBoom = errors.New("boom")
+)
+
+type acceleratorModifier func(*v1alpha1.Accelerator)
+
+func withExternalName(name string) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withEnabled(e bool) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { r.Spec.ForProvider.Enabled = aws.Bool(e) }
+}
+
+func withStatus(s v1alpha1.AcceleratorObservation) acceleratorModifier {
+	return func(r *v1alpha1.Accelerator) { r.Status.AtProvider = s }
+}
+
+func accelerator(m ...acceleratorModifier) *v1alpha1.Accelerator {
+	cr := &v1alpha1.Accelerator{
+		Spec: v1alpha1.AcceleratorSpec{
+			ForProvider: v1alpha1.AcceleratorParameters{
+				Name:          "web",
+				IPAddressType: aws.String(awsglobalaccelerator.IpAddressTypeIpv4),
+				Enabled:       aws.Bool(true),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status string, enabled bool) *awsglobalaccelerator.Accelerator {
+	return &awsglobalaccelerator.Accelerator{
+		AcceleratorArn: aws.String(acceleratorARN),
+		Name:           aws.String("web"),
+		DnsName:        aws.String(dnsName),
+		IpAddressType:  aws.String(awsglobalaccelerator.IpAddressTypeIpv4),
+		Enabled:        aws.Bool(enabled),
+		Status:         aws.String(status),
+	}
+}
+
+func describe(a *awsglobalaccelerator.Accelerator) func(context.Context, *awsglobalaccelerator.DescribeAcceleratorInput, []request.Option) (*awsglobalaccelerator.DescribeAcceleratorOutput, error) {
+	return func(_ context.Context, input *awsglobalaccelerator.DescribeAcceleratorInput, _ []request.Option) (*awsglobalaccelerator.DescribeAcceleratorOutput, error) {
+		if aws.StringValue(input.AcceleratorArn) != acceleratorARN {
+			return nil, errors.New("unexpected accelerator")
+		}
+		return &awsglobalaccelerator.DescribeAcceleratorOutput{Accelerator: a}, nil
+	}
+}
+
+func listTags(context.Context, *awsglobalaccelerator.ListTagsForResourceInput, []request.Option) (*awsglobalaccelerator.ListTagsForResourceOutput, error) {
+	return &awsglobalaccelerator.ListTagsForResourceOutput{}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Accelerator
+		result managed.ExternalObservation
+		err    error
+	}
+
+	deployed := v1alpha1.AcceleratorObservation{
+		AcceleratorARN: acceleratorARN,
+		DNSName:        dnsName,
+		Status:         v1alpha1.AcceleratorStatusDeployed,
+	}
+	inProgress := deployed
+	inProgress.Status = v1alpha1.AcceleratorStatusInProgress
+	connection := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretEndpointKey: []byte(dnsName)}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Accelerator
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     accelerator(),
+			want: want{
+				cr: accelerator(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeAcceleratorWithContext: describe(observed(v1alpha1.AcceleratorStatusDeployed, true)),
+				MockListTagsForResourceWithContext: listTags,
+			},
+			cr: accelerator(withExternalName(acceleratorARN)),
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withStatus(deployed), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"Disabled": {
+			client: &fake.MockClient{
+				MockDescribeAcceleratorWithContext: describe(observed(v1alpha1.AcceleratorStatusDeployed, true)),
+				MockListTagsForResourceWithContext: listTags,
+			},
+			cr: accelerator(withExternalName(acceleratorARN), withEnabled(false)),
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withEnabled(false), withStatus(deployed), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"BeingCreated": {
+			client: &fake.MockClient{
+				MockDescribeAcceleratorWithContext: describe(observed(v1alpha1.AcceleratorStatusInProgress, true)),
+				MockListTagsForResourceWithContext: listTags,
+			},
+			cr: accelerator(withExternalName(acceleratorARN)),
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withStatus(inProgress), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"BeingDeployed": {
+			client: &fake.MockClient{
+				MockDescribeAcceleratorWithContext: describe(observed(v1alpha1.AcceleratorStatusInProgress, true)),
+				MockListTagsForResourceWithContext: listTags,
+			},
+			cr: accelerator(withExternalName(acceleratorARN), withConditions(xpv1.Available())),
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withStatus(inProgress), withConditions(xpv1.Available().WithMessage(msgDeploymentInProgress))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connection,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeAcceleratorWithContext: func(context.Context, *awsglobalaccelerator.DescribeAcceleratorInput, []request.Option) (*awsglobalaccelerator.DescribeAcceleratorOutput, error) {
+					return nil, awserr.New(awsglobalaccelerator.ErrCodeAcceleratorNotFoundException, "not found", nil)
+				},
+			},
+			cr: accelerator(withExternalName(acceleratorARN)),
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeAcceleratorWithContext: func(context.Context, *awsglobalaccelerator.DescribeAcceleratorInput, []request.Option) (*awsglobalaccelerator.DescribeAcceleratorOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: accelerator(withExternalName(acceleratorARN)),
+			want: want{
+				cr:  accelerator(withExternalName(acceleratorARN)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Accelerator
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Accelerator
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockCreateAcceleratorWithContext: func(_ context.Context, input *awsglobalaccelerator.CreateAcceleratorInput, _ []request.Option) (*awsglobalaccelerator.CreateAcceleratorOutput, error) {
+					if aws.StringValue(input.Name) != "web" {
+						return nil, errors.New("unexpected name")
+					}
+					return &awsglobalaccelerator.CreateAcceleratorOutput{Accelerator: &awsglobalaccelerator.Accelerator{AcceleratorArn: aws.String(acceleratorARN)}}, nil
+				},
+			},
+			cr: accelerator(),
+			want: want{
+				cr: accelerator(withExternalName(acceleratorARN), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockClient{
+				MockCreateAcceleratorWithContext: func(context.Context, *awsglobalaccelerator.CreateAcceleratorInput, []request.Option) (*awsglobalaccelerator.CreateAcceleratorOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: accelerator(),
+			want: want{
+				cr:  accelerator(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		enabled *bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Accelerator
+		updateErr error
+		want
+	}{
+		"Disable": {
+			cr: accelerator(withExternalName(acceleratorARN), withEnabled(false)),
+			want: want{
+				enabled: aws.Bool(false),
+			},
+		},
+		"UpToDate": {
+			cr:   accelerator(withExternalName(acceleratorARN)),
+			want: want{},
+		},
+		"UpdateFailed": {
+			cr:        accelerator(withExternalName(acceleratorARN), withEnabled(false)),
+			updateErr: errBoom,
+			want: want{
+				enabled: aws.Bool(false),
+				err:     awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var enabled *bool
+			e := &external{client: &fake.MockClient{
+				MockDescribeAcceleratorWithContext: describe(observed(v1alpha1.AcceleratorStatusDeployed, true)),
+				MockListTagsForResourceWithContext: listTags,
+				MockUpdateAcceleratorWithContext: func(_ context.Context, input *awsglobalaccelerator.UpdateAcceleratorInput, _ []request.Option) (*awsglobalaccelerator.UpdateAcceleratorOutput, error) {
+					enabled = input.Enabled
+					return &awsglobalaccelerator.UpdateAcceleratorOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.enabled, enabled); diff != "" {
+				t.Errorf("enabled: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		disabled bool
+		deleted  bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		observed  *awsglobalaccelerator.Accelerator
+		deleteErr error
+		want
+	}{
+		"Enabled": {
+			observed: observed(v1alpha1.AcceleratorStatusDeployed, true),
+			want: want{
+				disabled: true,
+			},
+		},
+		"DisableInProgress": {
+			observed: observed(v1alpha1.AcceleratorStatusInProgress, false),
+			want:     want{},
+		},
+		"Successful": {
+			observed: observed(v1alpha1.AcceleratorStatusDeployed, false),
+			want: want{
+				deleted: true,
+			},
+		},
+		"AlreadyDeleted": {
+			observed:  observed(v1alpha1.AcceleratorStatusDeployed, false),
+			deleteErr: awserr.New(awsglobalaccelerator.ErrCodeAcceleratorNotFoundException, "not found", nil),
+			want: want{
+				deleted: true,
+			},
+		},
+		"DeleteFailed": {
+			observed:  observed(v1alpha1.AcceleratorStatusDeployed, false),
+			deleteErr: errBoom,
+			want: want{
+				deleted: true,
+				err:     awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			disabled, deleted := false, false
+			e := &external{client: &fake.MockClient{
+				MockDescribeAcceleratorWithContext: describe(tc.observed),
+				MockUpdateAcceleratorWithContext: func(_ context.Context, input *awsglobalaccelerator.UpdateAcceleratorInput, _ []request.Option) (*awsglobalaccelerator.UpdateAcceleratorOutput, error) {
+					disabled = !aws.BoolValue(input.Enabled)
+					return &awsglobalaccelerator.UpdateAcceleratorOutput{}, nil
+				},
+				MockDeleteAcceleratorWithContext: func(context.Context, *awsglobalaccelerator.DeleteAcceleratorInput, []request.Option) (*awsglobalaccelerator.DeleteAcceleratorOutput, error) {
+					deleted = true
+					return &awsglobalaccelerator.DeleteAcceleratorOutput{}, tc.deleteErr
+				},
+			}}
+			cr := accelerator(withExternalName(acceleratorARN))
+			err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.disabled, disabled); diff != "" {
+				t.Errorf("disabled: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
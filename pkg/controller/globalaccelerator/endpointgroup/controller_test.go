@@ -0,0 +1,573 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpointgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsglobalaccelerator "github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/globalaccelerator/fake"
+)
+
+var (
+	listenerARN      = "arn:aws:globalaccelerator::123456789012:accelerator/00000000-0000-0000-0000-000000000001/listener/0123abcd"
+	endpointGroupARN = listenerARN + "/endpoint-group/4567efab"
+	loadBalancerARN  = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/50dc6c495c0c9188"
+	allocationID     = "eipalloc-0123456789abcdef0"
+	uid              = types.UID("7a8b9c")
+
+	errBoom = errors.New("boom")
+)
+
+type endpointGroupModifier func(*v1alpha1.EndpointGroup)
+
+func withExternalName(name string) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withEndpoints(c ...v1alpha1.EndpointConfiguration) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { r.Spec.ForProvider.EndpointConfigurations = c }
+}
+
+func withPortOverrides(o ...v1alpha1.PortOverride) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { r.Spec.ForProvider.PortOverrides = o }
+}
+
+func withTrafficDial(p float64) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { r.Spec.ForProvider.TrafficDialPercentage = aws.Float64(p) }
+}
+
+// withDefaults sets the fields Global Accelerator defaults, as they are late
+// initialized from the endpoint group returned by describeEndpointGroup.
+func withDefaults() endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) {
+		p := &r.Spec.ForProvider
+		p.TrafficDialPercentage = aws.Float64(100)
+		p.HealthCheckPort = aws.Int64(80)
+		p.HealthCheckProtocol = aws.String(awsglobalaccelerator.HealthCheckProtocolTcp)
+		p.HealthCheckIntervalSeconds = aws.Int64(30)
+		p.ThresholdCount = aws.Int64(3)
+		for i := range p.EndpointConfigurations {
+			p.EndpointConfigurations[i].Weight = aws.Int64(128)
+			p.EndpointConfigurations[i].ClientIPPreservationEnabled = aws.Bool(true)
+		}
+	}
+}
+
+func withStatus(s v1alpha1.EndpointGroupObservation) endpointGroupModifier {
+	return func(r *v1alpha1.EndpointGroup) { r.Status.AtProvider = s }
+}
+
+func endpointGroup(m ...endpointGroupModifier) *v1alpha1.EndpointGroup {
+	cr := &v1alpha1.EndpointGroup{
+		Spec: v1alpha1.EndpointGroupSpec{
+			ForProvider: v1alpha1.EndpointGroupParameters{
+				ListenerARN:            aws.String(listenerARN),
+				EndpointGroupRegion:    "us-east-1",
+				EndpointConfigurations: []v1alpha1.EndpointConfiguration{{EndpointID: aws.String(loadBalancerARN)}},
+			},
+		},
+	}
+	cr.SetUID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var observation = v1alpha1.EndpointGroupObservation{
+	EndpointGroupARN: endpointGroupARN,
+	EndpointDescriptions: []v1alpha1.EndpointDescription{{
+		EndpointID:  loadBalancerARN,
+		HealthState: awsglobalaccelerator.HealthStateHealthy,
+	}},
+}
+
+func describeEndpointGroup(_ context.Context, input *awsglobalaccelerator.DescribeEndpointGroupInput, _ []request.Option) (*awsglobalaccelerator.DescribeEndpointGroupOutput, error) {
+	if aws.StringValue(input.EndpointGroupArn) != endpointGroupARN {
+		return nil, errors.New("unexpected endpoint group")
+	}
+	return &awsglobalaccelerator.DescribeEndpointGroupOutput{EndpointGroup: &awsglobalaccelerator.EndpointGroup{
+		EndpointGroupArn:           aws.String(endpointGroupARN),
+		EndpointGroupRegion:        aws.String("us-east-1"),
+		TrafficDialPercentage:      aws.Float64(100),
+		HealthCheckPort:            aws.Int64(80),
+		HealthCheckProtocol:        aws.String(awsglobalaccelerator.HealthCheckProtocolTcp),
+		HealthCheckIntervalSeconds: aws.Int64(30),
+		ThresholdCount:             aws.Int64(3),
+		EndpointDescriptions: []*awsglobalaccelerator.EndpointDescription{{
+			EndpointId:                  aws.String(loadBalancerARN),
+			Weight:                      aws.Int64(128),
+			ClientIPPreservationEnabled: aws.Bool(true),
+			HealthState:                 aws.String(awsglobalaccelerator.HealthStateHealthy),
+		}},
+	}}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestResolveReferences(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.EndpointGroup
+		err error
+	}
+
+	// getReferenced returns the external names of the referenced listener,
+	// load balancer and Elastic IP address.
+	getReferenced := func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *v1alpha1.Listener:
+			meta.SetExternalName(o, listenerARN)
+		case *elbv2v1alpha1.LoadBalancer:
+			meta.SetExternalName(o, loadBalancerARN)
+		case *ec2v1beta1.Address:
+			meta.SetExternalName(o, allocationID)
+		default:
+			return errBoom
+		}
+		return nil
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		cr   *v1alpha1.EndpointGroup
+		want
+	}{
+		"LoadBalancer": {
+			kube: &test.MockClient{MockGet: getReferenced},
+			cr: endpointGroup(func(r *v1alpha1.EndpointGroup) {
+				r.Spec.ForProvider.ListenerARN = nil
+				r.Spec.ForProvider.ListenerARNRef = &xpv1.Reference{Name: "web"}
+			}, withEndpoints(v1alpha1.EndpointConfiguration{LoadBalancerRef: &xpv1.Reference{Name: "web"}})),
+			want: want{
+				cr: endpointGroup(func(r *v1alpha1.EndpointGroup) {
+					r.Spec.ForProvider.ListenerARNRef = &xpv1.Reference{Name: "web"}
+				}, withEndpoints(v1alpha1.EndpointConfiguration{
+					EndpointID:      aws.String(loadBalancerARN),
+					LoadBalancerRef: &xpv1.Reference{Name: "web"},
+				})),
+			},
+		},
+		"Address": {
+			kube: &test.MockClient{MockGet: getReferenced},
+			cr:   endpointGroup(withEndpoints(v1alpha1.EndpointConfiguration{AddressRef: &xpv1.Reference{Name: "ip"}})),
+			want: want{
+				cr: endpointGroup(withEndpoints(v1alpha1.EndpointConfiguration{
+					EndpointID: aws.String(allocationID),
+					AddressRef: &xpv1.Reference{Name: "ip"},
+				})),
+			},
+		},
+		"LoadBalancerAndAddress": {
+			kube: &test.MockClient{MockGet: getReferenced},
+			cr: endpointGroup(withEndpoints(
+				v1alpha1.EndpointConfiguration{LoadBalancerRef: &xpv1.Reference{Name: "web"}, Weight: aws.Int64(200)},
+				v1alpha1.EndpointConfiguration{AddressRef: &xpv1.Reference{Name: "ip"}, Weight: aws.Int64(55)},
+			)),
+			want: want{
+				cr: endpointGroup(withEndpoints(
+					v1alpha1.EndpointConfiguration{EndpointID: aws.String(loadBalancerARN), LoadBalancerRef: &xpv1.Reference{Name: "web"}, Weight: aws.Int64(200)},
+					v1alpha1.EndpointConfiguration{EndpointID: aws.String(allocationID), AddressRef: &xpv1.Reference{Name: "ip"}, Weight: aws.Int64(55)},
+				)),
+			},
+		},
+		"EndpointIDSet": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			cr:   endpointGroup(),
+			want: want{
+				cr: endpointGroup(),
+			},
+		},
+		"GetLoadBalancerFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			cr:   endpointGroup(withEndpoints(v1alpha1.EndpointConfiguration{LoadBalancerRef: &xpv1.Reference{Name: "web"}})),
+			want: want{
+				cr:  endpointGroup(withEndpoints(v1alpha1.EndpointConfiguration{LoadBalancerRef: &xpv1.Reference{Name: "web"}})),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "spec.forProvider.endpointConfigurations[0].endpointId"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cr.ResolveReferences(context.Background(), tc.kube)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ResolveReferences(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("ResolveReferences(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EndpointGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.EndpointGroup
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     endpointGroup(),
+			want: want{
+				cr: endpointGroup(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockDescribeEndpointGroupWithContext: describeEndpointGroup},
+			cr:     endpointGroup(withExternalName(endpointGroupARN), withDefaults()),
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withDefaults(),
+					withStatus(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockDescribeEndpointGroupWithContext: describeEndpointGroup},
+			cr:     endpointGroup(withExternalName(endpointGroupARN)),
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withDefaults(),
+					withStatus(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"EndpointAdded": {
+			client: &fake.MockClient{MockDescribeEndpointGroupWithContext: describeEndpointGroup},
+			cr: endpointGroup(withExternalName(endpointGroupARN), withEndpoints(
+				v1alpha1.EndpointConfiguration{EndpointID: aws.String(loadBalancerARN)},
+				v1alpha1.EndpointConfiguration{EndpointID: aws.String(allocationID)},
+			), withDefaults()),
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withEndpoints(
+					v1alpha1.EndpointConfiguration{EndpointID: aws.String(loadBalancerARN)},
+					v1alpha1.EndpointConfiguration{EndpointID: aws.String(allocationID)},
+				), withDefaults(), withStatus(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TrafficDialChanged": {
+			client: &fake.MockClient{MockDescribeEndpointGroupWithContext: describeEndpointGroup},
+			cr:     endpointGroup(withExternalName(endpointGroupARN), withDefaults(), withTrafficDial(50)),
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withDefaults(), withTrafficDial(50),
+					withStatus(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PortOverrideAdded": {
+			client: &fake.MockClient{MockDescribeEndpointGroupWithContext: describeEndpointGroup},
+			cr: endpointGroup(withExternalName(endpointGroupARN), withDefaults(),
+				withPortOverrides(v1alpha1.PortOverride{ListenerPort: 80, EndpointPort: 8080})),
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withDefaults(),
+					withPortOverrides(v1alpha1.PortOverride{ListenerPort: 80, EndpointPort: 8080}),
+					withStatus(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeEndpointGroupWithContext: func(context.Context, *awsglobalaccelerator.DescribeEndpointGroupInput, []request.Option) (*awsglobalaccelerator.DescribeEndpointGroupOutput, error) {
+					return nil, awserr.New(awsglobalaccelerator.ErrCodeEndpointGroupNotFoundException, "not found", nil)
+				},
+			},
+			cr: endpointGroup(withExternalName(endpointGroupARN)),
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeEndpointGroupWithContext: func(context.Context, *awsglobalaccelerator.DescribeEndpointGroupInput, []request.Option) (*awsglobalaccelerator.DescribeEndpointGroupOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: endpointGroup(withExternalName(endpointGroupARN)),
+			want: want{
+				cr:  endpointGroup(withExternalName(endpointGroupARN)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.EndpointGroup
+		input *awsglobalaccelerator.CreateEndpointGroupInput
+		err   error
+	}
+
+	input := &awsglobalaccelerator.CreateEndpointGroupInput{
+		IdempotencyToken:       aws.String(string(uid)),
+		ListenerArn:            aws.String(listenerARN),
+		EndpointGroupRegion:    aws.String("us-east-1"),
+		EndpointConfigurations: []*awsglobalaccelerator.EndpointConfiguration{{EndpointId: aws.String(loadBalancerARN)}},
+		PortOverrides:          []*awsglobalaccelerator.PortOverride{},
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.EndpointGroup
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: endpointGroup(),
+			want: want{
+				cr:    endpointGroup(withExternalName(endpointGroupARN), withConditions(xpv1.Creating())),
+				input: input,
+			},
+		},
+		"CreateFailed": {
+			cr:        endpointGroup(),
+			createErr: errBoom,
+			want: want{
+				cr:    endpointGroup(withConditions(xpv1.Creating())),
+				input: input,
+				err:   awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsglobalaccelerator.CreateEndpointGroupInput
+			e := &external{client: &fake.MockClient{
+				MockCreateEndpointGroupWithContext: func(_ context.Context, in *awsglobalaccelerator.CreateEndpointGroupInput, _ []request.Option) (*awsglobalaccelerator.CreateEndpointGroupOutput, error) {
+					input = in
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return &awsglobalaccelerator.CreateEndpointGroupOutput{EndpointGroup: &awsglobalaccelerator.EndpointGroup{EndpointGroupArn: aws.String(endpointGroupARN)}}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awsglobalaccelerator.UpdateEndpointGroupInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.EndpointGroup
+		updateErr error
+		want
+	}{
+		"EndpointsReplaced": {
+			cr: endpointGroup(withExternalName(endpointGroupARN), withTrafficDial(50), withEndpoints(
+				v1alpha1.EndpointConfiguration{EndpointID: aws.String(allocationID), Weight: aws.Int64(10)},
+			), withPortOverrides(v1alpha1.PortOverride{ListenerPort: 80, EndpointPort: 8080})),
+			want: want{
+				input: &awsglobalaccelerator.UpdateEndpointGroupInput{
+					EndpointGroupArn:      aws.String(endpointGroupARN),
+					TrafficDialPercentage: aws.Float64(50),
+					EndpointConfigurations: []*awsglobalaccelerator.EndpointConfiguration{
+						{EndpointId: aws.String(allocationID), Weight: aws.Int64(10)},
+					},
+					PortOverrides: []*awsglobalaccelerator.PortOverride{
+						{ListenerPort: aws.Int64(80), EndpointPort: aws.Int64(8080)},
+					},
+				},
+			},
+		},
+		"EndpointsRemoved": {
+			cr: endpointGroup(withExternalName(endpointGroupARN), withEndpoints()),
+			want: want{
+				input: &awsglobalaccelerator.UpdateEndpointGroupInput{
+					EndpointGroupArn:       aws.String(endpointGroupARN),
+					EndpointConfigurations: []*awsglobalaccelerator.EndpointConfiguration{},
+					PortOverrides:          []*awsglobalaccelerator.PortOverride{},
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        endpointGroup(withExternalName(endpointGroupARN)),
+			updateErr: errBoom,
+			want: want{
+				input: &awsglobalaccelerator.UpdateEndpointGroupInput{
+					EndpointGroupArn:       aws.String(endpointGroupARN),
+					EndpointConfigurations: []*awsglobalaccelerator.EndpointConfiguration{{EndpointId: aws.String(loadBalancerARN)}},
+					PortOverrides:          []*awsglobalaccelerator.PortOverride{},
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsglobalaccelerator.UpdateEndpointGroupInput
+			e := &external{client: &fake.MockClient{
+				MockUpdateEndpointGroupWithContext: func(_ context.Context, in *awsglobalaccelerator.UpdateEndpointGroupInput, _ []request.Option) (*awsglobalaccelerator.UpdateEndpointGroupOutput, error) {
+					input = in
+					return &awsglobalaccelerator.UpdateEndpointGroupOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.EndpointGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.EndpointGroup
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteEndpointGroupWithContext: func(_ context.Context, input *awsglobalaccelerator.DeleteEndpointGroupInput, _ []request.Option) (*awsglobalaccelerator.DeleteEndpointGroupOutput, error) {
+					if aws.StringValue(input.EndpointGroupArn) != endpointGroupARN {
+						return nil, errors.New("unexpected endpoint group")
+					}
+					return &awsglobalaccelerator.DeleteEndpointGroupOutput{}, nil
+				},
+			},
+			cr: endpointGroup(withExternalName(endpointGroupARN)),
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteEndpointGroupWithContext: func(context.Context, *awsglobalaccelerator.DeleteEndpointGroupInput, []request.Option) (*awsglobalaccelerator.DeleteEndpointGroupOutput, error) {
+					return nil, awserr.New(awsglobalaccelerator.ErrCodeEndpointGroupNotFoundException, "not found", nil)
+				},
+			},
+			cr: endpointGroup(withExternalName(endpointGroupARN)),
+			want: want{
+				cr: endpointGroup(withExternalName(endpointGroupARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteEndpointGroupWithContext: func(context.Context, *awsglobalaccelerator.DeleteEndpointGroupInput, []request.Option) (*awsglobalaccelerator.DeleteEndpointGroupOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: endpointGroup(withExternalName(endpointGroupARN)),
+			want: want{
+				cr:  endpointGroup(withExternalName(endpointGroupARN), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listener
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsglobalaccelerator "github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/globalaccelerator/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/globalaccelerator/fake"
+)
+
+var (
+	acceleratorARN = "arn:aws:globalaccelerator::123456789012:accelerator/00000000-0000-0000-0000-000000000001"
+	listenerARN    = acceleratorARN + "/listener/0123abcd"
+	uid            = types.UID("4d5e6f")
+
+	errBoom = errors.New("boom")
+)
+
+type listenerModifier func(*v1alpha1.Listener)
+
+func withExternalName(name string) listenerModifier {
+	return func(r *v1alpha1.Listener) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPortRanges(r ...v1alpha1.PortRange) listenerModifier {
+	return func(l *v1alpha1.Listener) { l.Spec.ForProvider.PortRanges = r }
+}
+
+func withClientAffinity(a string) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Spec.ForProvider.ClientAffinity = aws.String(a) }
+}
+
+func withStatus(s v1alpha1.ListenerObservation) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.AtProvider = s }
+}
+
+func listener(m ...listenerModifier) *v1alpha1.Listener {
+	cr := &v1alpha1.Listener{
+		Spec: v1alpha1.ListenerSpec{
+			ForProvider: v1alpha1.ListenerParameters{
+				AcceleratorARN: aws.String(acceleratorARN),
+				PortRanges:     []v1alpha1.PortRange{{FromPort: 80, ToPort: 80}, {FromPort: 443, ToPort: 443}},
+				Protocol:       awsglobalaccelerator.ProtocolTcp,
+			},
+		},
+	}
+	cr.SetUID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeListener(_ context.Context, input *awsglobalaccelerator.DescribeListenerInput, _ []request.Option) (*awsglobalaccelerator.DescribeListenerOutput, error) {
+	if aws.StringValue(input.ListenerArn) != listenerARN {
+		return nil, errors.New("unexpected listener")
+	}
+	return &awsglobalaccelerator.DescribeListenerOutput{Listener: &awsglobalaccelerator.Listener{
+		ListenerArn:    aws.String(listenerARN),
+		Protocol:       aws.String(awsglobalaccelerator.ProtocolTcp),
+		ClientAffinity: aws.String(awsglobalaccelerator.ClientAffinityNone),
+		PortRanges: []*awsglobalaccelerator.PortRange{
+			{FromPort: aws.Int64(443), ToPort: aws.Int64(443)},
+			{FromPort: aws.Int64(80), ToPort: aws.Int64(80)},
+		},
+	}}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Listener
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Listener
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     listener(),
+			want: want{
+				cr: listener(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockDescribeListenerWithContext: describeListener},
+			cr:     listener(withExternalName(listenerARN), withClientAffinity(awsglobalaccelerator.ClientAffinityNone)),
+			want: want{
+				cr: listener(withExternalName(listenerARN), withClientAffinity(awsglobalaccelerator.ClientAffinityNone),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			client: &fake.MockClient{MockDescribeListenerWithContext: describeListener},
+			cr:     listener(withExternalName(listenerARN)),
+			want: want{
+				cr: listener(withExternalName(listenerARN), withClientAffinity(awsglobalaccelerator.ClientAffinityNone),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PortRangesChanged": {
+			client: &fake.MockClient{MockDescribeListenerWithContext: describeListener},
+			cr: listener(withExternalName(listenerARN), withClientAffinity(awsglobalaccelerator.ClientAffinityNone),
+				withPortRanges(v1alpha1.PortRange{FromPort: 443, ToPort: 443})),
+			want: want{
+				cr: listener(withExternalName(listenerARN), withClientAffinity(awsglobalaccelerator.ClientAffinityNone),
+					withPortRanges(v1alpha1.PortRange{FromPort: 443, ToPort: 443}),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ClientAffinityChanged": {
+			client: &fake.MockClient{MockDescribeListenerWithContext: describeListener},
+			cr:     listener(withExternalName(listenerARN), withClientAffinity(awsglobalaccelerator.ClientAffinitySourceIp)),
+			want: want{
+				cr: listener(withExternalName(listenerARN), withClientAffinity(awsglobalaccelerator.ClientAffinitySourceIp),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockDescribeListenerWithContext: func(context.Context, *awsglobalaccelerator.DescribeListenerInput, []request.Option) (*awsglobalaccelerator.DescribeListenerOutput, error) {
+					return nil, awserr.New(awsglobalaccelerator.ErrCodeListenerNotFoundException, "not found", nil)
+				},
+			},
+			cr: listener(withExternalName(listenerARN)),
+			want: want{
+				cr: listener(withExternalName(listenerARN)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeListenerWithContext: func(context.Context, *awsglobalaccelerator.DescribeListenerInput, []request.Option) (*awsglobalaccelerator.DescribeListenerOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: listener(withExternalName(listenerARN)),
+			want: want{
+				cr:  listener(withExternalName(listenerARN)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.Listener
+		input *awsglobalaccelerator.CreateListenerInput
+		err   error
+	}
+
+	input := &awsglobalaccelerator.CreateListenerInput{
+		IdempotencyToken: aws.String(string(uid)),
+		AcceleratorArn:   aws.String(acceleratorARN),
+		Protocol:         aws.String(awsglobalaccelerator.ProtocolTcp),
+		PortRanges: []*awsglobalaccelerator.PortRange{
+			{FromPort: aws.Int64(80), ToPort: aws.Int64(80)},
+			{FromPort: aws.Int64(443), ToPort: aws.Int64(443)},
+		},
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Listener
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: listener(),
+			want: want{
+				cr:    listener(withExternalName(listenerARN), withConditions(xpv1.Creating())),
+				input: input,
+			},
+		},
+		"CreateFailed": {
+			cr:        listener(),
+			createErr: errBoom,
+			want: want{
+				cr:    listener(withConditions(xpv1.Creating())),
+				input: input,
+				err:   awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsglobalaccelerator.CreateListenerInput
+			e := &external{client: &fake.MockClient{
+				MockCreateListenerWithContext: func(_ context.Context, in *awsglobalaccelerator.CreateListenerInput, _ []request.Option) (*awsglobalaccelerator.CreateListenerOutput, error) {
+					input = in
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return &awsglobalaccelerator.CreateListenerOutput{Listener: &awsglobalaccelerator.Listener{ListenerArn: aws.String(listenerARN)}}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awsglobalaccelerator.UpdateListenerInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Listener
+		updateErr error
+		want
+	}{
+		"Successful": {
+			cr: listener(withExternalName(listenerARN), withClientAffinity(awsglobalaccelerator.ClientAffinitySourceIp),
+				withPortRanges(v1alpha1.PortRange{FromPort: 8000, ToPort: 8080})),
+			want: want{
+				input: &awsglobalaccelerator.UpdateListenerInput{
+					ListenerArn:    aws.String(listenerARN),
+					Protocol:       aws.String(awsglobalaccelerator.ProtocolTcp),
+					ClientAffinity: aws.String(awsglobalaccelerator.ClientAffinitySourceIp),
+					PortRanges:     []*awsglobalaccelerator.PortRange{{FromPort: aws.Int64(8000), ToPort: aws.Int64(8080)}},
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        listener(withExternalName(listenerARN), withPortRanges(v1alpha1.PortRange{FromPort: 8000, ToPort: 8080})),
+			updateErr: errBoom,
+			want: want{
+				input: &awsglobalaccelerator.UpdateListenerInput{
+					ListenerArn: aws.String(listenerARN),
+					Protocol:    aws.String(awsglobalaccelerator.ProtocolTcp),
+					PortRanges:  []*awsglobalaccelerator.PortRange{{FromPort: aws.Int64(8000), ToPort: aws.Int64(8080)}},
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsglobalaccelerator.UpdateListenerInput
+			e := &external{client: &fake.MockClient{
+				MockUpdateListenerWithContext: func(_ context.Context, in *awsglobalaccelerator.UpdateListenerInput, _ []request.Option) (*awsglobalaccelerator.UpdateListenerOutput, error) {
+					input = in
+					return &awsglobalaccelerator.UpdateListenerOutput{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Listener
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Listener
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteListenerWithContext: func(_ context.Context, input *awsglobalaccelerator.DeleteListenerInput, _ []request.Option) (*awsglobalaccelerator.DeleteListenerOutput, error) {
+					if aws.StringValue(input.ListenerArn) != listenerARN {
+						return nil, errors.New("unexpected listener")
+					}
+					return &awsglobalaccelerator.DeleteListenerOutput{}, nil
+				},
+			},
+			cr: listener(withExternalName(listenerARN)),
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteListenerWithContext: func(context.Context, *awsglobalaccelerator.DeleteListenerInput, []request.Option) (*awsglobalaccelerator.DeleteListenerOutput, error) {
+					return nil, awserr.New(awsglobalaccelerator.ErrCodeListenerNotFoundException, "not found", nil)
+				},
+			},
+			cr: listener(withExternalName(listenerARN)),
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteListenerWithContext: func(context.Context, *awsglobalaccelerator.DeleteListenerInput, []request.Option) (*awsglobalaccelerator.DeleteListenerOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: listener(withExternalName(listenerARN)),
+			want: want{
+				cr:  listener(withExternalName(listenerARN), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -64,7 +64,8 @@ func SetupGroupUserMembership(mgr ctrl.Manager, l logging.Logger, rl workqueue.R
 		For(&v1beta1.GroupUserMembership{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.GroupUserMembershipGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupUserMembershipClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupUserMembershipClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
@@ -108,18 +109,22 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 	groupName, userName := nn[0], nn[1]
 
-	observed, err := e.client.ListGroupsForUser(ctx, &awsiam.ListGroupsForUserInput{
+	// Memberships that were removed outside of Crossplane are reported as
+	// missing so that they are added again.
+	var attachedGroupObject *awsiamtypes.Group
+	pages := awsiam.NewListGroupsForUserPaginator(e.client, &awsiam.ListGroupsForUserInput{
 		UserName: &userName,
 	})
-	if err != nil {
-		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
-	}
-
-	var attachedGroupObject *awsiamtypes.Group
-	for i, group := range observed.Groups {
-		if groupName == aws.ToString(group.GroupName) {
-			attachedGroupObject = &observed.Groups[i]
-			break
+	for attachedGroupObject == nil && pages.HasMorePages() {
+		observed, err := pages.NextPage(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+		}
+		for i, group := range observed.Groups {
+			if groupName == aws.ToString(group.GroupName) {
+				attachedGroupObject = &observed.Groups[i]
+				break
+			}
 		}
 	}
 
@@ -129,6 +134,17 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}, nil
 	}
 
+	// Imported memberships may only specify their external name.
+	lateInitialized := false
+	if cr.Spec.ForProvider.GroupName == "" {
+		cr.Spec.ForProvider.GroupName = groupName
+		lateInitialized = true
+	}
+	if cr.Spec.ForProvider.UserName == "" {
+		cr.Spec.ForProvider.UserName = userName
+		lateInitialized = true
+	}
+
 	cr.Status.AtProvider = v1beta1.GroupUserMembershipObservation{
 		AttachedGroupARN: aws.ToString(attachedGroupObject.Arn),
 	}
@@ -136,8 +152,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	cr.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialized,
 	}, nil
 }
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
@@ -94,6 +95,37 @@ func TestObserve(t *testing.T) {
 		want
 	}{
 		"ValidInput": {
+			args: args{
+				iam: &fake.MockGroupUserMembershipClient{
+					MockListGroupsForUser: func(ctx context.Context, input *awsiam.ListGroupsForUserInput, opts []func(*awsiam.Options)) (*awsiam.ListGroupsForUserOutput, error) {
+						return &awsiam.ListGroupsForUserOutput{
+							Groups: []awsiamtypes.Group{
+								{
+									Arn:       &groupArn,
+									GroupName: &groupName,
+								},
+							},
+						}, nil
+					},
+				},
+				cr: userGroup(withExternalName(groupName+"/"+userName),
+					withSpecGroupName(groupName),
+					withSpecUserName(userName)),
+			},
+			want: want{
+				cr: userGroup(
+					withExternalName(groupName+"/"+userName),
+					withSpecGroupName(groupName),
+					withSpecUserName(userName),
+					withConditions(xpv1.Available()),
+					withStatusGroupArn(groupArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Imported": {
 			args: args{
 				iam: &fake.MockGroupUserMembershipClient{
 					MockListGroupsForUser: func(ctx context.Context, input *awsiam.ListGroupsForUserInput, opts []func(*awsiam.Options)) (*awsiam.ListGroupsForUserOutput, error) {
@@ -112,6 +144,47 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: userGroup(
 					withExternalName(groupName+"/"+userName),
+					withSpecGroupName(groupName),
+					withSpecUserName(userName),
+					withConditions(xpv1.Available()),
+					withStatusGroupArn(groupArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"AttachedGroupOnLaterPage": {
+			args: args{
+				iam: &fake.MockGroupUserMembershipClient{
+					MockListGroupsForUser: func(ctx context.Context, input *awsiam.ListGroupsForUserInput, opts []func(*awsiam.Options)) (*awsiam.ListGroupsForUserOutput, error) {
+						if input.Marker == nil {
+							return &awsiam.ListGroupsForUserOutput{
+								Groups:      []awsiamtypes.Group{{GroupName: aws.String("other group")}},
+								IsTruncated: true,
+								Marker:      aws.String("next"),
+							}, nil
+						}
+						return &awsiam.ListGroupsForUserOutput{
+							Groups: []awsiamtypes.Group{
+								{
+									Arn:       &groupArn,
+									GroupName: &groupName,
+								},
+							},
+						}, nil
+					},
+				},
+				cr: userGroup(withExternalName(groupName+"/"+userName),
+					withSpecGroupName(groupName),
+					withSpecUserName(userName)),
+			},
+			want: want{
+				cr: userGroup(
+					withExternalName(groupName+"/"+userName),
+					withSpecGroupName(groupName),
+					withSpecUserName(userName),
 					withConditions(xpv1.Available()),
 					withStatusGroupArn(groupArn)),
 				result: managed.ExternalObservation{
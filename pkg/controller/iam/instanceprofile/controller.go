@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceprofile
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an InstanceProfile resource"
+	errGet              = "failed to get the InstanceProfile resource"
+	errCreate           = "failed to create the InstanceProfile resource"
+	errDelete           = "failed to delete the InstanceProfile resource"
+	errAddRole          = "failed to add the role to the InstanceProfile resource"
+	errRemoveRole       = "failed to remove the role from the InstanceProfile resource"
+)
+
+// SetupInstanceProfile adds a controller that reconciles InstanceProfiles.
+func SetupInstanceProfile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.InstanceProfileGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.InstanceProfile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceProfileGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: iam.NewInstanceProfileClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.InstanceProfileClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.InstanceProfileClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.client.GetInstanceProfile(ctx, &awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = iam.GenerateInstanceProfileObservation(*observed.InstanceProfile)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsInstanceProfileUpToDate(cr.Spec.ForProvider, *observed.InstanceProfile),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	// The role is added by the update that follows the creation.
+	_, err := e.client.CreateInstanceProfile(ctx, &awsiam.CreateInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+		Path:                cr.Spec.ForProvider.Path,
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.client.GetInstanceProfile(ctx, &awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+
+	// An instance profile can hold only one role, so the roles that are not
+	// desired are removed before the desired one is added.
+	add, remove := iam.DiffInstanceProfileRoles(cr.Spec.ForProvider, *observed.InstanceProfile)
+	for _, r := range remove {
+		if _, err := e.client.RemoveRoleFromInstanceProfile(ctx, &awsiam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(r),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveRole)
+		}
+	}
+	if add != nil {
+		if _, err := e.client.AddRoleToInstanceProfile(ctx, &awsiam.AddRoleToInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddRole)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.InstanceProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	// IAM refuses to delete an instance profile that still holds a role.
+	for _, r := range cr.Status.AtProvider.RoleNames {
+		_, err := e.client.RemoveRoleFromInstanceProfile(ctx, &awsiam.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(r),
+		})
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return awsclient.Wrap(err, errRemoveRole)
+		}
+	}
+
+	_, err := e.client.DeleteInstanceProfile(ctx, &awsiam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
@@ -0,0 +1,413 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	profileName    = "some-profile"
+	profileArn     = "arn:aws:iam::123456789012:instance-profile/some-profile"
+	roleName       = "some-role"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.InstanceProfileClient
+	cr  resource.Managed
+}
+
+type instanceProfileModifier func(*v1alpha1.InstanceProfile)
+
+func withConditions(c ...xpv1.Condition) instanceProfileModifier {
+	return func(r *v1alpha1.InstanceProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRoleName(n string) instanceProfileModifier {
+	return func(r *v1alpha1.InstanceProfile) { r.Spec.ForProvider.RoleName = aws.String(n) }
+}
+
+func withAtProvider(o v1alpha1.InstanceProfileObservation) instanceProfileModifier {
+	return func(r *v1alpha1.InstanceProfile) { r.Status.AtProvider = o }
+}
+
+func instanceProfile(m ...instanceProfileModifier) *v1alpha1.InstanceProfile {
+	cr := &v1alpha1.InstanceProfile{}
+	meta.SetExternalName(cr, profileName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getInstanceProfile(roles ...string) func(context.Context, *awsiam.GetInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+	return func(_ context.Context, input *awsiam.GetInstanceProfileInput, _ []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+		if aws.ToString(input.InstanceProfileName) != profileName {
+			return nil, errors.New("unexpected instance profile name")
+		}
+		p := &awsiamtypes.InstanceProfile{
+			Arn:                 aws.String(profileArn),
+			InstanceProfileName: input.InstanceProfileName,
+		}
+		for _, r := range roles {
+			p.Roles = append(p.Roles, awsiamtypes.Role{RoleName: aws.String(r)})
+		}
+		return &awsiam.GetInstanceProfileOutput{InstanceProfile: p}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{MockGetInstanceProfile: getInstanceProfile(roleName)},
+				cr:  instanceProfile(withRoleName(roleName)),
+			},
+			want: want{
+				cr: instanceProfile(withRoleName(roleName),
+					withAtProvider(v1alpha1.InstanceProfileObservation{ARN: profileArn, RoleNames: []string{roleName}}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RoleMissing": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{MockGetInstanceProfile: getInstanceProfile()},
+				cr:  instanceProfile(withRoleName(roleName)),
+			},
+			want: want{
+				cr: instanceProfile(withRoleName(roleName),
+					withAtProvider(v1alpha1.InstanceProfileObservation{ARN: profileArn}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(context.Context, *awsiam.GetInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr: instanceProfile(),
+			},
+		},
+		"GetError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(context.Context, *awsiam.GetInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr:  instanceProfile(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfile: func(_ context.Context, input *awsiam.CreateInstanceProfileInput, _ []func(*awsiam.Options)) (*awsiam.CreateInstanceProfileOutput, error) {
+						if aws.ToString(input.InstanceProfileName) != profileName {
+							return nil, errors.New("unexpected instance profile name")
+						}
+						return &awsiam.CreateInstanceProfileOutput{}, nil
+					},
+				},
+				cr: instanceProfile(withRoleName(roleName)),
+			},
+			want: want{
+				cr: instanceProfile(withRoleName(roleName)),
+			},
+		},
+		"CreateError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockCreateInstanceProfile: func(context.Context, *awsiam.CreateInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.CreateInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				cr:  instanceProfile(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		added   []string
+		removed []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AddsRole": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{MockGetInstanceProfile: getInstanceProfile()},
+				cr:  instanceProfile(withRoleName(roleName)),
+			},
+			want: want{
+				cr:    instanceProfile(withRoleName(roleName)),
+				added: []string{roleName},
+			},
+		},
+		"ReplacesRole": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{MockGetInstanceProfile: getInstanceProfile("old-role")},
+				cr:  instanceProfile(withRoleName(roleName)),
+			},
+			want: want{
+				cr:      instanceProfile(withRoleName(roleName)),
+				added:   []string{roleName},
+				removed: []string{"old-role"},
+			},
+		},
+		"RemovesRole": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{MockGetInstanceProfile: getInstanceProfile(roleName)},
+				cr:  instanceProfile(),
+			},
+			want: want{
+				cr:      instanceProfile(),
+				removed: []string{roleName},
+			},
+		},
+		"GetError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockGetInstanceProfile: func(context.Context, *awsiam.GetInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.GetInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instanceProfile(withRoleName(roleName)),
+			},
+			want: want{
+				cr:  instanceProfile(withRoleName(roleName)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var added, removed []string
+			client := tc.iam.(*fake.MockInstanceProfileClient)
+			client.MockAddRoleToInstanceProfile = func(_ context.Context, input *awsiam.AddRoleToInstanceProfileInput, _ []func(*awsiam.Options)) (*awsiam.AddRoleToInstanceProfileOutput, error) {
+				added = append(added, aws.ToString(input.RoleName))
+				return &awsiam.AddRoleToInstanceProfileOutput{}, nil
+			}
+			client.MockRemoveRoleFromInstanceProfile = func(_ context.Context, input *awsiam.RemoveRoleFromInstanceProfileInput, _ []func(*awsiam.Options)) (*awsiam.RemoveRoleFromInstanceProfileOutput, error) {
+				removed = append(removed, aws.ToString(input.RoleName))
+				return &awsiam.RemoveRoleFromInstanceProfileOutput{}, nil
+			}
+			e := &external{client: tc.iam}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.added, added); diff != "" {
+				t.Errorf("added: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RemovesRolesFirst": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockRemoveRoleFromInstanceProfile: func(_ context.Context, input *awsiam.RemoveRoleFromInstanceProfileInput, _ []func(*awsiam.Options)) (*awsiam.RemoveRoleFromInstanceProfileOutput, error) {
+						return &awsiam.RemoveRoleFromInstanceProfileOutput{}, nil
+					},
+					MockDeleteInstanceProfile: func(context.Context, *awsiam.DeleteInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.DeleteInstanceProfileOutput, error) {
+						return &awsiam.DeleteInstanceProfileOutput{}, nil
+					},
+				},
+				cr: instanceProfile(withAtProvider(v1alpha1.InstanceProfileObservation{RoleNames: []string{roleName}})),
+			},
+		},
+		"RemoveRoleError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockRemoveRoleFromInstanceProfile: func(context.Context, *awsiam.RemoveRoleFromInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.RemoveRoleFromInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instanceProfile(withAtProvider(v1alpha1.InstanceProfileObservation{RoleNames: []string{roleName}})),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errRemoveRole),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockDeleteInstanceProfile: func(context.Context, *awsiam.DeleteInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.DeleteInstanceProfileOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: instanceProfile(),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				iam: &fake.MockInstanceProfileClient{
+					MockDeleteInstanceProfile: func(context.Context, *awsiam.DeleteInstanceProfileInput, []func(*awsiam.Options)) (*awsiam.DeleteInstanceProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instanceProfile(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
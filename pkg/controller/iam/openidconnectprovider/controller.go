@@ -50,6 +50,7 @@ const (
 	errGet              = "cannot get OpenIDConnectProvider in AWS"
 	errCreate           = "cannot create OpenIDConnectProvider in AWS"
 	errUpdateThumbprint = "cannot update OpenIDConnectProvider thumbprint list in AWS"
+	errThumbprint       = "cannot get thumbprint of OpenIDConnectProvider URL"
 	errAddClientID      = "cannot add clientID to OpenIDConnectProvider in AWS"
 	errRemoveClientID   = "cannot remove clientID to OpenIDConnectProvider in AWS"
 	errDelete           = "failed to delete OpenIDConnectProvider"
@@ -67,7 +68,8 @@ func SetupOpenIDConnectProvider(mgr ctrl.Manager, l logging.Logger, rl workqueue
 		For(&v1beta1.OpenIDConnectProvider{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.OpenIDConnectProviderGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewOpenIDConnectProviderClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: iam.NewOpenIDConnectProviderClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -85,14 +87,31 @@ func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed
 		return nil, err
 	}
 	return &external{
-		kube:   c.kube,
-		client: c.newClientFn(*cfg),
+		kube:       c.kube,
+		client:     c.newClientFn(*cfg),
+		thumbprint: iam.GetThumbprint,
 	}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client iam.OpenIDConnectProviderClient
+	kube       client.Client
+	client     iam.OpenIDConnectProviderClient
+	thumbprint func(ctx context.Context, issuerURL string) (string, error)
+}
+
+// thumbprints returns the thumbprint list the OpenIDConnectProvider should
+// have. The list from the spec is used as is when it's given; otherwise it's
+// computed from the certificate chain the provider URL currently serves, so
+// that a certificate rotation is picked up on the next observation.
+func (e *external) thumbprints(ctx context.Context, cr *v1beta1.OpenIDConnectProvider) ([]string, error) {
+	if len(cr.Spec.ForProvider.ThumbprintList) > 0 {
+		return cr.Spec.ForProvider.ThumbprintList, nil
+	}
+	t, err := e.thumbprint(ctx, cr.Spec.ForProvider.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, errThumbprint)
+	}
+	return []string{t}, nil
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -122,9 +141,16 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	cr.SetConditions(xpv1.Available())
 	cr.Status.AtProvider = iam.GenerateOIDCProviderObservation(*observedProvider)
 
+	thumbprints, err := e.thumbprints(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	desired := *cr.Spec.ForProvider.DeepCopy()
+	desired.ThumbprintList = thumbprints
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: iam.IsOIDCProviderUpToDate(cr.Spec.ForProvider, *observedProvider),
+		ResourceUpToDate: iam.IsOIDCProviderUpToDate(desired, *observedProvider),
 	}, nil
 }
 
@@ -133,9 +159,13 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
+	thumbprints, err := e.thumbprints(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	observed, err := e.client.CreateOpenIDConnectProvider(ctx, &awsiam.CreateOpenIDConnectProviderInput{
 		ClientIDList:   cr.Spec.ForProvider.ClientIDList,
-		ThumbprintList: cr.Spec.ForProvider.ThumbprintList,
+		ThumbprintList: thumbprints,
 		Url:            aws.String(cr.Spec.ForProvider.URL),
 	})
 
@@ -163,13 +193,17 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errSDK)
 	}
 
-	if !cmp.Equal(cr.Spec.ForProvider.ThumbprintList, observedProvider.ThumbprintList, cmpopts.EquateEmpty(),
+	thumbprints, err := e.thumbprints(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !cmp.Equal(thumbprints, observedProvider.ThumbprintList, cmpopts.EquateEmpty(),
 		cmpopts.SortSlices(func(x, y string) bool {
 			return x < y
 		})) {
 		if _, err := e.client.UpdateOpenIDConnectProviderThumbprint(ctx, &awsiam.UpdateOpenIDConnectProviderThumbprintInput{
 			OpenIDConnectProviderArn: aws.String(meta.GetExternalName(cr)),
-			ThumbprintList:           cr.Spec.ForProvider.ThumbprintList,
+			ThumbprintList:           thumbprints,
 		}); err != nil {
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateThumbprint)
 		}
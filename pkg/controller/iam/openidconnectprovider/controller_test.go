@@ -45,13 +45,21 @@ var (
 	unexpectedItem resource.Managed
 	providerArn    = "arn:123"
 	url            = "https://example.com"
+	thumbprint     = "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"
 
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	iam iam.OpenIDConnectProviderClient
-	cr  resource.Managed
+	iam        iam.OpenIDConnectProviderClient
+	thumbprint func(ctx context.Context, issuerURL string) (string, error)
+	cr         resource.Managed
+}
+
+func withThumbprint(t string, err error) func(ctx context.Context, issuerURL string) (string, error) {
+	return func(ctx context.Context, issuerURL string) (string, error) {
+		return t, err
+	}
 }
 
 type oidcProviderModifier func(provider *svcapitypes.OpenIDConnectProvider)
@@ -63,6 +71,10 @@ func withConditions(c ...xpv1.Condition) oidcProviderModifier {
 func withURL(s string) oidcProviderModifier {
 	return func(r *svcapitypes.OpenIDConnectProvider) { r.Spec.ForProvider.URL = s }
 }
+func withThumbprintList(t ...string) oidcProviderModifier {
+	return func(r *svcapitypes.OpenIDConnectProvider) { r.Spec.ForProvider.ThumbprintList = t }
+}
+
 func withExternalName(name string) oidcProviderModifier {
 	return func(r *svcapitypes.OpenIDConnectProvider) { meta.SetExternalName(r, name) }
 }
@@ -150,10 +162,12 @@ func TestObserve(t *testing.T) {
 				iam: &fake.MockOpenIDConnectProviderClient{
 					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
 						return &awsiam.GetOpenIDConnectProviderOutput{
-							CreateDate: &now.Time,
+							CreateDate:     &now.Time,
+							ThumbprintList: []string{thumbprint},
 						}, nil
 					},
 				},
+				thumbprint: withThumbprint(thumbprint, nil),
 				cr: oidcProvider(withURL(url),
 					withExternalName(providerArn)),
 			},
@@ -161,7 +175,8 @@ func TestObserve(t *testing.T) {
 				cr: oidcProvider(withURL(url),
 					withExternalName(providerArn),
 					withAtProvider(svcapitypes.OpenIDConnectProviderObservation{
-						CreateDate: &now,
+						CreateDate:     &now,
+						ThumbprintList: []string{thumbprint},
 					}),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
@@ -170,11 +185,83 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ThumbprintRotated": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
+						return &awsiam.GetOpenIDConnectProviderOutput{
+							ThumbprintList: []string{"old"},
+						}, nil
+					},
+				},
+				thumbprint: withThumbprint(thumbprint, nil),
+				cr: oidcProvider(withURL(url),
+					withExternalName(providerArn)),
+			},
+			want: want{
+				cr: oidcProvider(withURL(url),
+					withExternalName(providerArn),
+					withAtProvider(svcapitypes.OpenIDConnectProviderObservation{
+						ThumbprintList: []string{"old"},
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ExplicitThumbprint": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
+						return &awsiam.GetOpenIDConnectProviderOutput{
+							ThumbprintList: []string{"a"},
+						}, nil
+					},
+				},
+				thumbprint: withThumbprint("", errBoom),
+				cr: oidcProvider(withURL(url),
+					withExternalName(providerArn),
+					withThumbprintList("a")),
+			},
+			want: want{
+				cr: oidcProvider(withURL(url),
+					withExternalName(providerArn),
+					withThumbprintList("a"),
+					withAtProvider(svcapitypes.OpenIDConnectProviderObservation{
+						ThumbprintList: []string{"a"},
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ThumbprintError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
+						return &awsiam.GetOpenIDConnectProviderOutput{}, nil
+					},
+				},
+				thumbprint: withThumbprint("", errBoom),
+				cr: oidcProvider(withURL(url),
+					withExternalName(providerArn)),
+			},
+			want: want{
+				cr: oidcProvider(withURL(url),
+					withExternalName(providerArn),
+					withConditions(xpv1.Available())),
+				err: errors.Wrap(errBoom, errThumbprint),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, thumbprint: tc.thumbprint}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -217,7 +304,8 @@ func TestCreate(t *testing.T) {
 						return &awsiam.CreateOpenIDConnectProviderOutput{}, errBoom
 					},
 				},
-				cr: oidcProvider(withURL(url)),
+				thumbprint: withThumbprint(thumbprint, nil),
+				cr:         oidcProvider(withURL(url)),
 			},
 			want: want{
 				cr:  oidcProvider(withURL(url)),
@@ -228,10 +316,14 @@ func TestCreate(t *testing.T) {
 			args: args{
 				iam: &fake.MockOpenIDConnectProviderClient{
 					MockCreateOpenIDConnectProvider: func(ctx context.Context, input *awsiam.CreateOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateOpenIDConnectProviderOutput, error) {
+						if diff := cmp.Diff([]string{thumbprint}, input.ThumbprintList); diff != "" {
+							return nil, errors.New(diff)
+						}
 						return &awsiam.CreateOpenIDConnectProviderOutput{OpenIDConnectProviderArn: aws.String(providerArn)}, nil
 					},
 				},
-				cr: oidcProvider(withURL(url)),
+				thumbprint: withThumbprint(thumbprint, nil),
+				cr:         oidcProvider(withURL(url)),
 			},
 			want: want{
 				cr: oidcProvider(withURL(url), func(provider *svcapitypes.OpenIDConnectProvider) {
@@ -240,11 +332,21 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"ThumbprintError": {
+			args: args{
+				thumbprint: withThumbprint("", errBoom),
+				cr:         oidcProvider(withURL(url)),
+			},
+			want: want{
+				cr:  oidcProvider(withURL(url)),
+				err: errors.Wrap(errBoom, errThumbprint),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, thumbprint: tc.thumbprint}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -310,12 +412,15 @@ func TestUpdate(t *testing.T) {
 			args: args{
 				iam: &fake.MockOpenIDConnectProviderClient{
 					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
-						return &awsiam.GetOpenIDConnectProviderOutput{}, nil
+						return &awsiam.GetOpenIDConnectProviderOutput{
+							ThumbprintList: []string{thumbprint},
+						}, nil
 					},
 					MockAddClientIDToOpenIDConnectProvider: func(ctx context.Context, input *awsiam.AddClientIDToOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.AddClientIDToOpenIDConnectProviderOutput, error) {
 						return &awsiam.AddClientIDToOpenIDConnectProviderOutput{}, errBoom
 					},
 				},
+				thumbprint: withThumbprint(thumbprint, nil),
 				cr: oidcProvider(withURL(url),
 					func(provider *svcapitypes.OpenIDConnectProvider) {
 						provider.Spec.ForProvider.ClientIDList = []string{"a", "b"}
@@ -335,13 +440,15 @@ func TestUpdate(t *testing.T) {
 				iam: &fake.MockOpenIDConnectProviderClient{
 					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
 						return &awsiam.GetOpenIDConnectProviderOutput{
-							ClientIDList: []string{"a", "b"},
+							ClientIDList:   []string{"a", "b"},
+							ThumbprintList: []string{thumbprint},
 						}, nil
 					},
 					MockRemoveClientIDFromOpenIDConnectProvider: func(ctx context.Context, input *awsiam.RemoveClientIDFromOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.RemoveClientIDFromOpenIDConnectProviderOutput, error) {
 						return &awsiam.RemoveClientIDFromOpenIDConnectProviderOutput{}, errBoom
 					},
 				},
+				thumbprint: withThumbprint(thumbprint, nil),
 				cr: oidcProvider(withURL(url),
 					func(provider *svcapitypes.OpenIDConnectProvider) {
 						provider.Spec.ForProvider.ClientIDList = []string{"a"}
@@ -356,6 +463,28 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errRemoveClientID),
 			},
 		},
+		"RefreshThumbprint": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProvider: func(ctx context.Context, input *awsiam.GetOpenIDConnectProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetOpenIDConnectProviderOutput, error) {
+						return &awsiam.GetOpenIDConnectProviderOutput{
+							ThumbprintList: []string{"old"},
+						}, nil
+					},
+					MockUpdateOpenIDConnectProviderThumbprint: func(ctx context.Context, input *awsiam.UpdateOpenIDConnectProviderThumbprintInput, opts []func(*awsiam.Options)) (*awsiam.UpdateOpenIDConnectProviderThumbprintOutput, error) {
+						if diff := cmp.Diff([]string{thumbprint}, input.ThumbprintList); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsiam.UpdateOpenIDConnectProviderThumbprintOutput{}, nil
+					},
+				},
+				thumbprint: withThumbprint(thumbprint, nil),
+				cr:         oidcProvider(withURL(url)),
+			},
+			want: want{
+				cr: oidcProvider(withURL(url)),
+			},
+		},
 		"SuccessfulUpdate": {
 			args: args{
 				iam: &fake.MockOpenIDConnectProviderClient{
@@ -396,7 +525,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, thumbprint: tc.thumbprint}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -462,7 +591,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, thumbprint: tc.thumbprint}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
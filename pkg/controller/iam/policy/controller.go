@@ -69,8 +69,9 @@ func SetupPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 		For(&v1beta1.Policy{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.PolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient}),
-			managed.WithInitializers(),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -147,10 +148,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, awsclient.Wrap(err, errPolicyVersion)
 	}
 
-	update, err := iam.IsPolicyUpToDate(cr.Spec.ForProvider, *versionRsp.PolicyVersion)
-
-	if err != nil {
-		return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDate)
+	upToDate := false
+	if cr.Spec.ForProvider.Document != "" {
+		drift, err := iam.PolicyDocumentDrift(cr.Spec.ForProvider.Document, aws.ToString(versionRsp.PolicyVersion.Document))
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDate)
+		}
+		awsclient.RecordDrift(cr, drift)
+		upToDate = len(drift) == 0
 	}
 
 	crTagMap := make(map[string]string, len(cr.Spec.ForProvider.Tags))
@@ -161,7 +166,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: update && areRolesUpdated,
+		ResourceUpToDate: upToDate && areRolesUpdated,
 	}, nil
 }
 
@@ -203,31 +208,45 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	// An update to AWS Policy is a new version of that policy.
-	// A maximum of 5 versions are allowed. Below, the oldest version is deleted
-	// for an update request when 5 versions already exist.
-	// The new version is set as default.
-
-	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
-	}
-
-	_, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
-		PolicyArn:      aws.String(meta.GetExternalName(cr)),
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
-		SetAsDefault:   true,
+	observed, err := e.client.GetPolicy(ctx, &awsiam.GetPolicyInput{
+		PolicyArn: aws.String(meta.GetExternalName(cr)),
 	})
-
 	if err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if observed.Policy == nil {
+		return managed.ExternalUpdate{}, errors.New(errEmptyPolicy)
 	}
 
-	observed, err := e.client.GetPolicy(ctx, &awsiam.GetPolicyInput{
+	versionRsp, err := e.client.GetPolicyVersion(ctx, &awsiam.GetPolicyVersionInput{
 		PolicyArn: aws.String(meta.GetExternalName(cr)),
+		VersionId: observed.Policy.DefaultVersionId,
 	})
-
+	if err != nil || versionRsp.PolicyVersion == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPolicyVersion)
+	}
+	upToDate, err := iam.IsPolicyUpToDate(cr.Spec.ForProvider, *versionRsp.PolicyVersion)
 	if err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpToDate)
+	}
+
+	// An update to AWS Policy is a new version of that policy, which is only
+	// created when the document changed so that tag updates don't use up
+	// versions. A maximum of 5 versions are allowed. Below, the oldest
+	// version is deleted for an update request when 5 versions already
+	// exist. The new version is set as default.
+	if !upToDate {
+		if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+
+		if _, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
+			PolicyArn:      aws.String(meta.GetExternalName(cr)),
+			PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+			SetAsDefault:   true,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
 	}
 
 	crTagMap := make(map[string]string, len(cr.Spec.ForProvider.Tags))
@@ -18,10 +18,14 @@ package policy
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
@@ -57,7 +61,11 @@ var (
 		  }
 		]
 	  }`
-	boolFalse = false
+	// equivalentDocument only differs from document in formatting, key order
+	// and single values written as lists of one.
+	equivalentDocument = `{"Statement":{"Resource":["*"],"Action":["elastic-inference:Connect"],"Effect":"Allow","Sid":"VisualEditor0"},"Version":"2012-10-17"}`
+	otherDocument      = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"elastic-inference:Connect","Resource":"*"}]}`
+	boolFalse          = false
 
 	errBoom = errors.New("boom")
 
@@ -92,6 +100,10 @@ func withSpec(spec v1beta1.PolicyParameters) policyModifier {
 	}
 }
 
+func withDocument(doc string) policyModifier {
+	return func(r *v1beta1.Policy) { r.Spec.ForProvider.Document = doc }
+}
+
 func withPath(path string) policyModifier {
 	return func(r *v1beta1.Policy) {
 		r.Spec.ForProvider.Path = awsclient.String(path)
@@ -396,6 +408,22 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func getPolicy(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+	return &awsiam.GetPolicyOutput{
+		Policy: &awsiamtypes.Policy{DefaultVersionId: awsclient.String("v1")},
+	}, nil
+}
+
+func getPolicyVersion(doc string) func(context.Context, *awsiam.GetPolicyVersionInput, []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+	return func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+		return &awsiam.GetPolicyVersionOutput{
+			PolicyVersion: &awsiamtypes.PolicyVersion{
+				Document: awsclient.String(url.QueryEscape(doc)),
+			},
+		}, nil
+	}
+}
+
 func TestUpdate(t *testing.T) {
 
 	type want struct {
@@ -420,16 +448,62 @@ func TestUpdate(t *testing.T) {
 					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
 						return &awsiam.CreatePolicyVersionOutput{}, nil
 					},
-					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
-						return &awsiam.GetPolicyOutput{
-							Policy: &awsiamtypes.Policy{},
-						}, nil
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(document),
+				},
+				cr: policy(withExternalName(policyArn), withDocument(otherDocument)),
+			},
+			want: want{
+				cr: policy(withExternalName(policyArn), withDocument(otherDocument)),
+			},
+		},
+		"DocumentUpToDate": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+						return nil, errors.New("policy version must not be created")
 					},
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(equivalentDocument),
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withExternalName(policyArn), withDocument(document)),
 			},
 			want: want{
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withExternalName(policyArn), withDocument(document)),
+			},
+		},
+		"PrunesOldestVersion": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						versions := make([]awsiamtypes.PolicyVersion, 5)
+						for i := range versions {
+							versions[i] = awsiamtypes.PolicyVersion{
+								VersionId:  awsclient.String(fmt.Sprintf("v%d", i+1)),
+								CreateDate: aws.Time(time.Date(2021, 1, i+1, 0, 0, 0, 0, time.UTC)),
+							}
+						}
+						// The oldest version is the default one, which
+						// can't be deleted.
+						versions[0].IsDefaultVersion = true
+						return &awsiam.ListPolicyVersionsOutput{Versions: versions}, nil
+					},
+					MockDeletePolicyVersion: func(ctx context.Context, input *awsiam.DeletePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.DeletePolicyVersionOutput, error) {
+						if aws.ToString(input.VersionId) != "v2" {
+							return nil, errors.New("unexpected version deleted")
+						}
+						return &awsiam.DeletePolicyVersionOutput{}, nil
+					},
+					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+						return &awsiam.CreatePolicyVersionOutput{}, nil
+					},
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(document),
+				},
+				cr: policy(withExternalName(policyArn), withDocument(otherDocument)),
+			},
+			want: want{
+				cr: policy(withExternalName(policyArn), withDocument(otherDocument)),
 			},
 		},
 		"InValidInput": {
@@ -447,11 +521,13 @@ func TestUpdate(t *testing.T) {
 					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
 						return nil, errBoom
 					},
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(document),
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withExternalName(policyArn), withDocument(otherDocument)),
 			},
 			want: want{
-				cr:  policy(withExternalName(policyArn)),
+				cr:  policy(withExternalName(policyArn), withDocument(otherDocument)),
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
@@ -467,14 +543,31 @@ func TestUpdate(t *testing.T) {
 					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
 						return nil, errBoom
 					},
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(document),
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withExternalName(policyArn), withDocument(otherDocument)),
 			},
 			want: want{
-				cr:  policy(withExternalName(policyArn)),
+				cr:  policy(withExternalName(policyArn), withDocument(otherDocument)),
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"GetPolicyVersionError": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: getPolicy,
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(withExternalName(policyArn), withDocument(otherDocument)),
+			},
+			want: want{
+				cr:  policy(withExternalName(policyArn), withDocument(otherDocument)),
+				err: awsclient.Wrap(errBoom, errPolicyVersion),
+			},
+		},
 	}
 
 	for name, tc := range cases {
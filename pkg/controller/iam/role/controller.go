@@ -49,10 +49,10 @@ const (
 	errDelete           = "failed to delete the Role resource"
 	errUpdate           = "failed to update the Role resource"
 	errSDK              = "empty Role received from IAM API"
-	errCreatePatch      = "failed to create patch object for comparison"
 
 	errKubeUpdateFailed = "cannot late initialize Role"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
+	errBoundary         = "failed to update the permissions boundary of the Role resource"
 )
 
 // SetupRole adds a controller that reconciles Roles.
@@ -67,7 +67,8 @@ func SetupRole(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, pol
 		For(&v1beta1.Role{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RoleGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
@@ -189,12 +190,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	patch, err := iam.CreatePatch(observed.Role, &cr.Spec.ForProvider)
-	if err != nil {
-		return managed.ExternalUpdate{}, errors.Wrap(err, errCreatePatch)
-	}
-
-	if patch.Description != nil || patch.MaxSessionDuration != nil {
+	if !iam.IsRoleSettingsUpToDate(cr.Spec.ForProvider, *observed.Role) {
 		_, err = e.client.UpdateRole(ctx, &awsiam.UpdateRoleInput{
 			RoleName:           aws.String(meta.GetExternalName(cr)),
 			Description:        cr.Spec.ForProvider.Description,
@@ -206,7 +202,11 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if patch.AssumeRolePolicyDocument != "" {
+	policyUpToDate, err := iam.IsAssumeRolePolicyUpToDate(cr.Spec.ForProvider, *observed.Role)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpToDateFailed)
+	}
+	if !policyUpToDate {
 		_, err = e.client.UpdateAssumeRolePolicy(ctx, &awsiam.UpdateAssumeRolePolicyInput{
 			PolicyDocument: &cr.Spec.ForProvider.AssumeRolePolicyDocument,
 			RoleName:       aws.String(meta.GetExternalName(cr)),
@@ -215,6 +215,22 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 		}
 	}
+
+	if !iam.IsPermissionsBoundaryUpToDate(cr.Spec.ForProvider.PermissionsBoundary, observed.Role.PermissionsBoundary) {
+		if aws.ToString(cr.Spec.ForProvider.PermissionsBoundary) != "" {
+			_, err = e.client.PutRolePermissionsBoundary(ctx, &awsiam.PutRolePermissionsBoundaryInput{
+				RoleName:            aws.String(meta.GetExternalName(cr)),
+				PermissionsBoundary: cr.Spec.ForProvider.PermissionsBoundary,
+			})
+		} else {
+			_, err = e.client.DeleteRolePermissionsBoundary(ctx, &awsiam.DeleteRolePermissionsBoundaryInput{
+				RoleName: aws.String(meta.GetExternalName(cr)),
+			})
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errBoundary)
+		}
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -78,11 +78,7 @@ func withRoleName(s *string) roleModifier {
 
 func withPolicy() roleModifier {
 	return func(r *v1beta1.Role) {
-		p, err := awsclient.CompactAndEscapeJSON(policy)
-		if err != nil {
-			return
-		}
-		r.Spec.ForProvider.AssumeRolePolicyDocument = p
+		r.Spec.ForProvider.AssumeRolePolicyDocument = policy
 	}
 }
 
@@ -104,6 +100,10 @@ func withTags(tagMaps ...map[string]string) roleModifier {
 	}
 }
 
+func withPermissionsBoundary(arn string) roleModifier {
+	return func(r *v1beta1.Role) { r.Spec.ForProvider.PermissionsBoundary = &arn }
+}
+
 func withGroupVersionKind() roleModifier {
 	return func(iamRole *v1beta1.Role) {
 		iamRole.TypeMeta.SetGroupVersionKind(v1beta1.RoleGroupVersionKind)
@@ -308,6 +308,29 @@ func TestUpdate(t *testing.T) {
 				cr: role(withRoleName(&roleName)),
 			},
 		},
+		"PermissionsBoundaryChanged": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								PermissionsBoundary: &awsiamtypes.AttachedPermissionsBoundary{
+									PermissionsBoundaryArn: aws.String("arn:aws:iam::123456789012:policy/old"),
+								},
+							},
+						}, nil
+					},
+					MockPutRolePermissionsBoundary: func(ctx context.Context, input *awsiam.PutRolePermissionsBoundaryInput, opts []func(*awsiam.Options)) (*awsiam.PutRolePermissionsBoundaryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withRoleName(&roleName), withPermissionsBoundary("arn:aws:iam::123456789012:policy/new")),
+			},
+			want: want{
+				cr:  role(withRoleName(&roleName), withPermissionsBoundary("arn:aws:iam::123456789012:policy/new")),
+				err: awsclient.Wrap(errBoom, errBoundary),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -358,6 +381,25 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"PolicyAndSettingsUpToDate": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						p, _ := awsclient.CompactAndEscapeJSON(policy)
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								AssumeRolePolicyDocument: &p,
+								Description:              aws.String(description),
+							},
+						}, nil
+					},
+				},
+				cr: role(withPolicy(), withDescription()),
+			},
+			want: want{
+				cr: role(withPolicy(), withDescription()),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,299 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolepolicy
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	roleName       = "some-role"
+	policyName     = "some-policy"
+	document       = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"}]}`
+	// IAM returns the document URL encoded and in its own formatting.
+	observedDocument = url.QueryEscape(`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": ["s3:ListBucket", "s3:GetObject"], "Resource": "*"}]
+}`)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.RolePolicyClient
+	cr  resource.Managed
+}
+
+type rolePolicyModifier func(*v1alpha1.RolePolicy)
+
+func withConditions(c ...xpv1.Condition) rolePolicyModifier {
+	return func(r *v1alpha1.RolePolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDocument(d string) rolePolicyModifier {
+	return func(r *v1alpha1.RolePolicy) { r.Spec.ForProvider.Document = d }
+}
+
+func rolePolicy(m ...rolePolicyModifier) *v1alpha1.RolePolicy {
+	cr := &v1alpha1.RolePolicy{
+		Spec: v1alpha1.RolePolicySpec{
+			ForProvider: v1alpha1.RolePolicyParameters{
+				RoleName: roleName,
+				Document: document,
+			},
+		},
+	}
+	meta.SetExternalName(cr, policyName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getRolePolicy(doc string) func(context.Context, *awsiam.GetRolePolicyInput, []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+	return func(_ context.Context, input *awsiam.GetRolePolicyInput, _ []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+		if aws.ToString(input.RoleName) != roleName || aws.ToString(input.PolicyName) != policyName {
+			return nil, errors.New("unexpected role or policy name")
+		}
+		return &awsiam.GetRolePolicyOutput{
+			RoleName:       input.RoleName,
+			PolicyName:     input.PolicyName,
+			PolicyDocument: aws.String(doc),
+		}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockRolePolicyClient{MockGetRolePolicy: getRolePolicy(observedDocument)},
+				cr:  rolePolicy(),
+			},
+			want: want{
+				cr: rolePolicy(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DocumentChanged": {
+			args: args{
+				iam: &fake.MockRolePolicyClient{MockGetRolePolicy: getRolePolicy(observedDocument)},
+				cr:  rolePolicy(withDocument(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*"}]}`)),
+			},
+			want: want{
+				cr: rolePolicy(withDocument(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*"}]}`), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockRolePolicyClient{
+					MockGetRolePolicy: func(context.Context, *awsiam.GetRolePolicyInput, []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: rolePolicy(),
+			},
+			want: want{
+				cr: rolePolicy(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockRolePolicyClient{
+					MockGetRolePolicy: func(context.Context, *awsiam.GetRolePolicyInput, []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rolePolicy(),
+			},
+			want: want{
+				cr:  rolePolicy(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		input *awsiam.PutRolePolicyInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr     resource.Managed
+		putErr error
+		want
+	}{
+		"Successful": {
+			cr: rolePolicy(),
+			want: want{
+				input: &awsiam.PutRolePolicyInput{
+					RoleName:       aws.String(roleName),
+					PolicyName:     aws.String(policyName),
+					PolicyDocument: aws.String(document),
+				},
+			},
+		},
+		"PutFailed": {
+			cr:     rolePolicy(),
+			putErr: errBoom,
+			want: want{
+				input: &awsiam.PutRolePolicyInput{
+					RoleName:       aws.String(roleName),
+					PolicyName:     aws.String(policyName),
+					PolicyDocument: aws.String(document),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+		"InValidInput": {
+			cr: unexpectedItem,
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsiam.PutRolePolicyInput
+			e := &external{client: &fake.MockRolePolicyClient{
+				MockPutRolePolicy: func(_ context.Context, in *awsiam.PutRolePolicyInput, _ []func(*awsiam.Options)) (*awsiam.PutRolePolicyOutput, error) {
+					input = in
+					return &awsiam.PutRolePolicyOutput{}, tc.putErr
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input, cmp.AllowUnexported(awsiam.PutRolePolicyInput{})); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		iam iam.RolePolicyClient
+		cr  resource.Managed
+		err error
+	}{
+		"Successful": {
+			iam: &fake.MockRolePolicyClient{
+				MockDeleteRolePolicy: func(context.Context, *awsiam.DeleteRolePolicyInput, []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+					return &awsiam.DeleteRolePolicyOutput{}, nil
+				},
+			},
+			cr: rolePolicy(),
+		},
+		"AlreadyDeleted": {
+			iam: &fake.MockRolePolicyClient{
+				MockDeleteRolePolicy: func(context.Context, *awsiam.DeleteRolePolicyInput, []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+					return nil, &awsiamtypes.NoSuchEntityException{}
+				},
+			},
+			cr: rolePolicy(),
+		},
+		"DeleteFailed": {
+			iam: &fake.MockRolePolicyClient{
+				MockDeleteRolePolicy: func(context.Context, *awsiam.DeleteRolePolicyInput, []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr:  rolePolicy(),
+			err: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
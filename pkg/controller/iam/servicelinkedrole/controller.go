@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicelinkedrole
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errUnexpectedObject  = "The managed resource is not a ServiceLinkedRole resource"
+	errGet               = "failed to get the ServiceLinkedRole resource"
+	errCreate            = "failed to create the ServiceLinkedRole resource"
+	errUpdate            = "failed to update the ServiceLinkedRole resource"
+	errDelete            = "failed to delete the ServiceLinkedRole resource"
+	errGetDeletionStatus = "failed to get the deletion status of the ServiceLinkedRole resource"
+	errDeletionFailed    = "IAM failed to delete the ServiceLinkedRole resource"
+)
+
+// SetupServiceLinkedRole adds a controller that reconciles ServiceLinkedRoles.
+func SetupServiceLinkedRole(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ServiceLinkedRoleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.ServiceLinkedRole{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceLinkedRoleGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: iam.NewServiceLinkedRoleClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.ServiceLinkedRoleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.ServiceLinkedRoleClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ServiceLinkedRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The name of the role is assigned by AWS when it is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.client.GetRole(ctx, &awsiam.GetRoleInput{
+		RoleName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	role := *observed.Role
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	iam.LateInitializeServiceLinkedRole(&cr.Spec.ForProvider, role)
+
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = iam.GenerateServiceLinkedRoleObservation(role, cr.Status.AtProvider.DeletionTaskID)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        iam.IsServiceLinkedRoleUpToDate(cr.Spec.ForProvider, role),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ServiceLinkedRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	out, err := e.client.CreateServiceLinkedRole(ctx, &awsiam.CreateServiceLinkedRoleInput{
+		AWSServiceName: aws.String(cr.Spec.ForProvider.AWSServiceName),
+		CustomSuffix:   cr.Spec.ForProvider.CustomSuffix,
+		Description:    cr.Spec.ForProvider.Description,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(out.Role.RoleName))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ServiceLinkedRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.UpdateRole(ctx, &awsiam.UpdateRoleInput{
+		RoleName:    aws.String(meta.GetExternalName(cr)),
+		Description: cr.Spec.ForProvider.Description,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ServiceLinkedRole)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	// IAM deletes service-linked roles asynchronously, once the linked
+	// service confirms that the role is no longer in use. A pending deletion
+	// is awaited rather than requested again, and a failed one is reported
+	// before it is requested again on the next reconciliation.
+	if id := cr.Status.AtProvider.DeletionTaskID; id != "" {
+		status, err := e.client.GetServiceLinkedRoleDeletionStatus(ctx, &awsiam.GetServiceLinkedRoleDeletionStatusInput{
+			DeletionTaskId: aws.String(id),
+		})
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return awsclient.Wrap(err, errGetDeletionStatus)
+		}
+		if err == nil {
+			if status.Status != awsiamtypes.DeletionTaskStatusTypeFailed {
+				return nil
+			}
+			cr.Status.AtProvider.DeletionTaskID = ""
+			return errors.New(deletionFailure(status.Reason))
+		}
+	}
+
+	out, err := e.client.DeleteServiceLinkedRole(ctx, &awsiam.DeleteServiceLinkedRoleInput{
+		RoleName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+	}
+	cr.Status.AtProvider.DeletionTaskID = aws.ToString(out.DeletionTaskId)
+	return nil
+}
+
+// deletionFailure describes why IAM failed to delete a service-linked role,
+// including the resources that still use it.
+func deletionFailure(r *awsiamtypes.DeletionTaskFailureReasonType) string {
+	msg := errDeletionFailed
+	if r == nil {
+		return msg
+	}
+	if r.Reason != nil {
+		msg += ": " + aws.ToString(r.Reason)
+	}
+	for _, u := range r.RoleUsageList {
+		if len(u.Resources) != 0 {
+			msg += "; in use in " + aws.ToString(u.Region) + " by " + strings.Join(u.Resources, ", ")
+		}
+	}
+	return msg
+}
@@ -0,0 +1,450 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicelinkedrole
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	serviceName    = "autoscaling.amazonaws.com"
+	roleName       = "AWSServiceRoleForAutoScaling"
+	roleArn        = "arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"
+	description    = "some description"
+	taskID         = "task/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling/some-id"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam iam.ServiceLinkedRoleClient
+	cr  resource.Managed
+}
+
+type serviceLinkedRoleModifier func(*v1alpha1.ServiceLinkedRole)
+
+func withConditions(c ...xpv1.Condition) serviceLinkedRoleModifier {
+	return func(r *v1alpha1.ServiceLinkedRole) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) serviceLinkedRoleModifier {
+	return func(r *v1alpha1.ServiceLinkedRole) { meta.SetExternalName(r, n) }
+}
+
+func withDescription(d string) serviceLinkedRoleModifier {
+	return func(r *v1alpha1.ServiceLinkedRole) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withAtProvider(o v1alpha1.ServiceLinkedRoleObservation) serviceLinkedRoleModifier {
+	return func(r *v1alpha1.ServiceLinkedRole) { r.Status.AtProvider = o }
+}
+
+func serviceLinkedRole(m ...serviceLinkedRoleModifier) *v1alpha1.ServiceLinkedRole {
+	cr := &v1alpha1.ServiceLinkedRole{}
+	cr.Spec.ForProvider.AWSServiceName = serviceName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getRole(desc *string) func(context.Context, *awsiam.GetRoleInput, []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+	return func(_ context.Context, input *awsiam.GetRoleInput, _ []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+		if aws.ToString(input.RoleName) != roleName {
+			return nil, errors.New("unexpected role name")
+		}
+		return &awsiam.GetRoleOutput{Role: &awsiamtypes.Role{
+			Arn:         aws.String(roleArn),
+			RoleName:    input.RoleName,
+			Description: desc,
+		}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotCreated": {
+			args: args{
+				cr: serviceLinkedRole(),
+			},
+			want: want{
+				cr: serviceLinkedRole(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{MockGetRole: getRole(aws.String(description))},
+				cr:  serviceLinkedRole(withExternalName(roleName), withDescription(description)),
+			},
+			want: want{
+				cr: serviceLinkedRole(withExternalName(roleName), withDescription(description),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{ARN: roleArn}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitDescription": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{MockGetRole: getRole(aws.String(description))},
+				cr:  serviceLinkedRole(withExternalName(roleName)),
+			},
+			want: want{
+				cr: serviceLinkedRole(withExternalName(roleName), withDescription(description),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{ARN: roleArn}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{MockGetRole: getRole(aws.String("old"))},
+				cr:  serviceLinkedRole(withExternalName(roleName), withDescription(description)),
+			},
+			want: want{
+				cr: serviceLinkedRole(withExternalName(roleName), withDescription(description),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{ARN: roleArn}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"DeletionPending": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{MockGetRole: getRole(aws.String(description))},
+				cr: serviceLinkedRole(withExternalName(roleName), withDescription(description),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{DeletionTaskID: taskID})),
+			},
+			want: want{
+				cr: serviceLinkedRole(withExternalName(roleName), withDescription(description),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{ARN: roleArn, DeletionTaskID: taskID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockGetRole: func(context.Context, *awsiam.GetRoleInput, []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName)),
+			},
+			want: want{
+				cr: serviceLinkedRole(withExternalName(roleName)),
+			},
+		},
+		"GetError": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockGetRole: func(context.Context, *awsiam.GetRoleInput, []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName)),
+			},
+			want: want{
+				cr:  serviceLinkedRole(withExternalName(roleName)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockCreateServiceLinkedRole: func(_ context.Context, input *awsiam.CreateServiceLinkedRoleInput, _ []func(*awsiam.Options)) (*awsiam.CreateServiceLinkedRoleOutput, error) {
+						if aws.ToString(input.AWSServiceName) != serviceName {
+							return nil, errors.New("unexpected service name")
+						}
+						return &awsiam.CreateServiceLinkedRoleOutput{Role: &awsiamtypes.Role{RoleName: aws.String(roleName)}}, nil
+					},
+				},
+				cr: serviceLinkedRole(),
+			},
+			want: want{
+				cr:     serviceLinkedRole(withExternalName(roleName)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockCreateServiceLinkedRole: func(context.Context, *awsiam.CreateServiceLinkedRoleInput, []func(*awsiam.Options)) (*awsiam.CreateServiceLinkedRoleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: serviceLinkedRole(),
+			},
+			want: want{
+				cr:  serviceLinkedRole(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdatesDescription": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockUpdateRole: func(_ context.Context, input *awsiam.UpdateRoleInput, _ []func(*awsiam.Options)) (*awsiam.UpdateRoleOutput, error) {
+						if aws.ToString(input.Description) != description {
+							return nil, errors.New("unexpected description")
+						}
+						return &awsiam.UpdateRoleOutput{}, nil
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName), withDescription(description)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockUpdateRole: func(context.Context, *awsiam.UpdateRoleInput, []func(*awsiam.Options)) (*awsiam.UpdateRoleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RequestsDeletion": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockDeleteServiceLinkedRole: func(context.Context, *awsiam.DeleteServiceLinkedRoleInput, []func(*awsiam.Options)) (*awsiam.DeleteServiceLinkedRoleOutput, error) {
+						return &awsiam.DeleteServiceLinkedRoleOutput{DeletionTaskId: aws.String(taskID)}, nil
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName)),
+			},
+			want: want{
+				cr: serviceLinkedRole(withExternalName(roleName),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{DeletionTaskID: taskID})),
+			},
+		},
+		"AwaitsPendingDeletion": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockGetServiceLinkedRoleDeletionStatus: func(context.Context, *awsiam.GetServiceLinkedRoleDeletionStatusInput, []func(*awsiam.Options)) (*awsiam.GetServiceLinkedRoleDeletionStatusOutput, error) {
+						return &awsiam.GetServiceLinkedRoleDeletionStatusOutput{Status: awsiamtypes.DeletionTaskStatusTypeInProgress}, nil
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{DeletionTaskID: taskID})),
+			},
+			want: want{
+				cr: serviceLinkedRole(withExternalName(roleName),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{DeletionTaskID: taskID})),
+			},
+		},
+		"ReportsFailedDeletion": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockGetServiceLinkedRoleDeletionStatus: func(context.Context, *awsiam.GetServiceLinkedRoleDeletionStatusInput, []func(*awsiam.Options)) (*awsiam.GetServiceLinkedRoleDeletionStatusOutput, error) {
+						return &awsiam.GetServiceLinkedRoleDeletionStatusOutput{
+							Status: awsiamtypes.DeletionTaskStatusTypeFailed,
+							Reason: &awsiamtypes.DeletionTaskFailureReasonType{
+								Reason: aws.String("role in use"),
+								RoleUsageList: []awsiamtypes.RoleUsageType{{
+									Region:    aws.String("us-east-1"),
+									Resources: []string{"some-group"},
+								}},
+							},
+						}, nil
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName),
+					withAtProvider(v1alpha1.ServiceLinkedRoleObservation{DeletionTaskID: taskID})),
+			},
+			want: want{
+				cr:  serviceLinkedRole(withExternalName(roleName)),
+				err: errors.New(errDeletionFailed + ": role in use; in use in us-east-1 by some-group"),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockDeleteServiceLinkedRole: func(context.Context, *awsiam.DeleteServiceLinkedRoleInput, []func(*awsiam.Options)) (*awsiam.DeleteServiceLinkedRoleOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName)),
+			},
+			want: want{
+				cr: serviceLinkedRole(withExternalName(roleName)),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				iam: &fake.MockServiceLinkedRoleClient{
+					MockDeleteServiceLinkedRole: func(context.Context, *awsiam.DeleteServiceLinkedRoleInput, []func(*awsiam.Options)) (*awsiam.DeleteServiceLinkedRoleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: serviceLinkedRole(withExternalName(roleName)),
+			},
+			want: want{
+				cr:  serviceLinkedRole(withExternalName(roleName)),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -51,6 +51,8 @@ const (
 	errUpdate = "cannot update the IAM User resource"
 	errSDK    = "empty IAM User received from IAM API"
 
+	errBoundary = "cannot update the permissions boundary of the IAM User resource"
+
 	errKubeUpdateFailed = "cannot late initialize IAM User"
 )
 
@@ -66,7 +68,10 @@ func SetupUser(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, pol
 		For(&v1beta1.User{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.UserGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserClient}),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: iam.NewUserClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), awsclient.NewTagger(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -125,9 +130,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		UserID: aws.ToString(user.UserId),
 	}
 
+	upToDate := aws.ToString(cr.Spec.ForProvider.Path) == aws.ToString(user.Path) &&
+		iam.IsPermissionsBoundaryUpToDate(cr.Spec.ForProvider.PermissionsBoundary, user.PermissionsBoundary)
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: aws.ToString(cr.Spec.ForProvider.Path) == aws.ToString(user.Path),
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
@@ -154,12 +162,39 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	_, err := e.client.UpdateUser(ctx, &awsiam.UpdateUserInput{
-		NewPath:  cr.Spec.ForProvider.Path,
+	observed, err := e.client.GetUser(ctx, &awsiam.GetUserInput{
 		UserName: aws.String(meta.GetExternalName(cr)),
 	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if observed.User == nil {
+		return managed.ExternalUpdate{}, errors.New(errSDK)
+	}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	if _, err := e.client.UpdateUser(ctx, &awsiam.UpdateUserInput{
+		NewPath:  cr.Spec.ForProvider.Path,
+		UserName: aws.String(meta.GetExternalName(cr)),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+
+	if !iam.IsPermissionsBoundaryUpToDate(cr.Spec.ForProvider.PermissionsBoundary, observed.User.PermissionsBoundary) {
+		if aws.ToString(cr.Spec.ForProvider.PermissionsBoundary) != "" {
+			_, err = e.client.PutUserPermissionsBoundary(ctx, &awsiam.PutUserPermissionsBoundaryInput{
+				UserName:            aws.String(meta.GetExternalName(cr)),
+				PermissionsBoundary: cr.Spec.ForProvider.PermissionsBoundary,
+			})
+		} else {
+			_, err = e.client.DeleteUserPermissionsBoundary(ctx, &awsiam.DeleteUserPermissionsBoundaryInput{
+				UserName: aws.String(meta.GetExternalName(cr)),
+			})
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errBoundary)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
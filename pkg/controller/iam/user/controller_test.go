@@ -22,6 +22,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
@@ -42,6 +43,7 @@ import (
 var (
 	unexpectedItem resource.Managed
 	userName       = "some user"
+	boundaryArn    = "arn:aws:iam::123456789012:policy/boundary"
 
 	errBoom = errors.New("boom")
 )
@@ -57,6 +59,16 @@ func withConditions(c ...xpv1.Condition) userModifier {
 	return func(r *v1beta1.User) { r.Status.ConditionedStatus.Conditions = c }
 }
 
+func withPermissionsBoundary(arn string) userModifier {
+	return func(r *v1beta1.User) { r.Spec.ForProvider.PermissionsBoundary = &arn }
+}
+
+func getUser(boundary *awsiamtypes.AttachedPermissionsBoundary) func(context.Context, *awsiam.GetUserInput, []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+	return func(context.Context, *awsiam.GetUserInput, []func(*awsiam.Options)) (*awsiam.GetUserOutput, error) {
+		return &awsiam.GetUserOutput{User: &awsiamtypes.User{PermissionsBoundary: boundary}}, nil
+	}
+}
+
 func withExternalName(name string) userModifier {
 	return func(r *v1beta1.User) { meta.SetExternalName(r, name) }
 }
@@ -229,6 +241,7 @@ func TestUpdate(t *testing.T) {
 		"VaildInput": {
 			args: args{
 				iam: &fake.MockUserClient{
+					MockGetUser: getUser(nil),
 					MockUpdateUser: func(ctx context.Context, input *awsiam.UpdateUserInput, opts []func(*awsiam.Options)) (*awsiam.UpdateUserOutput, error) {
 						return &awsiam.UpdateUserOutput{}, nil
 					},
@@ -239,6 +252,44 @@ func TestUpdate(t *testing.T) {
 				cr: user(withExternalName(userName)),
 			},
 		},
+		"PutsPermissionsBoundary": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: getUser(nil),
+					MockUpdateUser: func(ctx context.Context, input *awsiam.UpdateUserInput, opts []func(*awsiam.Options)) (*awsiam.UpdateUserOutput, error) {
+						return &awsiam.UpdateUserOutput{}, nil
+					},
+					MockPutUserPermissionsBoundary: func(ctx context.Context, input *awsiam.PutUserPermissionsBoundaryInput, opts []func(*awsiam.Options)) (*awsiam.PutUserPermissionsBoundaryOutput, error) {
+						if aws.ToString(input.PermissionsBoundary) != boundaryArn {
+							return nil, errors.New("unexpected permissions boundary")
+						}
+						return &awsiam.PutUserPermissionsBoundaryOutput{}, nil
+					},
+				},
+				cr: user(withExternalName(userName), withPermissionsBoundary(boundaryArn)),
+			},
+			want: want{
+				cr: user(withExternalName(userName), withPermissionsBoundary(boundaryArn)),
+			},
+		},
+		"DeletesPermissionsBoundary": {
+			args: args{
+				iam: &fake.MockUserClient{
+					MockGetUser: getUser(&awsiamtypes.AttachedPermissionsBoundary{PermissionsBoundaryArn: aws.String(boundaryArn)}),
+					MockUpdateUser: func(ctx context.Context, input *awsiam.UpdateUserInput, opts []func(*awsiam.Options)) (*awsiam.UpdateUserOutput, error) {
+						return &awsiam.UpdateUserOutput{}, nil
+					},
+					MockDeleteUserPermissionsBoundary: func(ctx context.Context, input *awsiam.DeleteUserPermissionsBoundaryInput, opts []func(*awsiam.Options)) (*awsiam.DeleteUserPermissionsBoundaryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: user(withExternalName(userName)),
+			},
+			want: want{
+				cr:  user(withExternalName(userName)),
+				err: awsclient.Wrap(errBoom, errBoundary),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -0,0 +1,385 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsimagebuilder "github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	awsimagebuildertypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/imagebuilder/fake"
+)
+
+var (
+	componentARN = "arn:aws:imagebuilder:us-east-1:123456789012:component/sample/1.0.0/1"
+	uid          = "2ab0e2a4-b1c6-4d0b-8bbc-3e3d1f3a8a8f"
+
+	errBoom = errors.New("boom")
+)
+
+type componentModifier func(*v1alpha1.Component)
+
+func withExternalName(name string) componentModifier {
+	return func(r *v1alpha1.Component) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) componentModifier {
+	return func(r *v1alpha1.Component) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ComponentParameters) componentModifier {
+	return func(r *v1alpha1.Component) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ComponentObservation) componentModifier {
+	return func(r *v1alpha1.Component) { r.Status.AtProvider = s }
+}
+
+func component(m ...componentModifier) *v1alpha1.Component {
+	cr := &v1alpha1.Component{}
+	cr.UID = types.UID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(tags map[string]string) v1alpha1.ComponentParameters {
+	return v1alpha1.ComponentParameters{
+		Name:                "sample",
+		SemanticVersion:     "1.0.0",
+		Platform:            "Linux",
+		Data:                aws.String("name: sample"),
+		SupportedOSVersions: []string{"Amazon Linux 2"},
+		Tags:                tags,
+	}
+}
+
+func observed(tags map[string]string) *awsimagebuildertypes.Component {
+	return &awsimagebuildertypes.Component{
+		Arn:                 aws.String(componentARN),
+		Name:                aws.String("sample"),
+		Version:             aws.String("1.0.0"),
+		Platform:            awsimagebuildertypes.PlatformLinux,
+		Owner:               aws.String("123456789012"),
+		Type:                awsimagebuildertypes.ComponentTypeBuild,
+		Encrypted:           aws.Bool(true),
+		SupportedOsVersions: []string{"Amazon Linux 2"},
+		Tags:                tags,
+	}
+}
+
+func observation() v1alpha1.ComponentObservation {
+	return v1alpha1.ComponentObservation{
+		ARN:       componentARN,
+		Owner:     "123456789012",
+		Type:      string(awsimagebuildertypes.ComponentTypeBuild),
+		Encrypted: true,
+	}
+}
+
+func get(c *awsimagebuildertypes.Component) func(context.Context, *awsimagebuilder.GetComponentInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetComponentOutput, error) {
+	return func(_ context.Context, input *awsimagebuilder.GetComponentInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetComponentOutput, error) {
+		if aws.ToString(input.ComponentBuildVersionArn) != componentARN {
+			return nil, errors.New("unexpected component")
+		}
+		return &awsimagebuilder.GetComponentOutput{Component: c}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Component
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockComponentClient
+		cr     *v1alpha1.Component
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockComponentClient{
+				MockGet: get(observed(map[string]string{"k": "v"})),
+			},
+			cr: component(withExternalName(componentARN), withSpec(spec(map[string]string{"k": "v"}))),
+			want: want{
+				cr: component(withExternalName(componentARN), withSpec(spec(map[string]string{"k": "v"})),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			client: &fake.MockComponentClient{
+				MockGet: get(observed(map[string]string{"k": "v"})),
+			},
+			cr: component(withExternalName(componentARN), withSpec(spec(map[string]string{"k": "v2"}))),
+			want: want{
+				cr: component(withExternalName(componentARN), withSpec(spec(map[string]string{"k": "v2"})),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitialize": {
+			client: &fake.MockComponentClient{
+				MockGet: get(observed(nil)),
+			},
+			cr: component(withExternalName(componentARN), withSpec(func() v1alpha1.ComponentParameters {
+				p := spec(nil)
+				p.SupportedOSVersions = nil
+				return p
+			}())),
+			want: want{
+				cr: component(withExternalName(componentARN), withSpec(spec(nil)),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			client: &fake.MockComponentClient{},
+			cr:     component(withSpec(spec(nil))),
+			want: want{
+				cr: component(withSpec(spec(nil))),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockComponentClient{
+				MockGet: func(context.Context, *awsimagebuilder.GetComponentInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetComponentOutput, error) {
+					return nil, &awsimagebuildertypes.ResourceNotFoundException{}
+				},
+			},
+			cr: component(withExternalName(componentARN), withSpec(spec(nil))),
+			want: want{
+				cr: component(withExternalName(componentARN), withSpec(spec(nil))),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockComponentClient{
+				MockGet: func(context.Context, *awsimagebuilder.GetComponentInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetComponentOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: component(withExternalName(componentARN), withSpec(spec(nil))),
+			want: want{
+				cr:  component(withExternalName(componentARN), withSpec(spec(nil))),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Component
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockComponentClient
+		cr     *v1alpha1.Component
+		want
+	}{
+		"Successful": {
+			client: &fake.MockComponentClient{
+				MockCreate: func(_ context.Context, input *awsimagebuilder.CreateComponentInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.CreateComponentOutput, error) {
+					if aws.ToString(input.ClientToken) != uid {
+						return nil, errors.New("unexpected client token")
+					}
+					return &awsimagebuilder.CreateComponentOutput{ComponentBuildVersionArn: aws.String(componentARN)}, nil
+				},
+			},
+			cr: component(withSpec(spec(nil))),
+			want: want{
+				cr: component(withExternalName(componentARN), withSpec(spec(nil)),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockComponentClient{
+				MockCreate: func(context.Context, *awsimagebuilder.CreateComponentInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.CreateComponentOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: component(withSpec(spec(nil))),
+			want: want{
+				cr: component(withSpec(spec(nil)),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		tagged   map[string]string
+		untagged []string
+	}
+
+	cases := map[string]struct {
+		observed map[string]string
+		desired  map[string]string
+		want
+	}{
+		"AddTag": {
+			observed: map[string]string{"k": "v"},
+			desired:  map[string]string{"k": "v", "k2": "v2"},
+			want: want{
+				tagged: map[string]string{"k2": "v2"},
+			},
+		},
+		"RemoveTag": {
+			observed: map[string]string{"k": "v", "k2": "v2"},
+			desired:  map[string]string{"k": "v"},
+			want: want{
+				untagged: []string{"k2"},
+			},
+		},
+		"ChangeTag": {
+			observed: map[string]string{"k": "v"},
+			desired:  map[string]string{"k": "v2"},
+			want: want{
+				tagged:   map[string]string{"k": "v2"},
+				untagged: []string{"k"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var tagged map[string]string
+			var untagged []string
+			e := &external{client: &fake.MockComponentClient{
+				MockGet: get(observed(tc.observed)),
+				MockTagResource: func(_ context.Context, input *awsimagebuilder.TagResourceInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.TagResourceOutput, error) {
+					tagged = input.Tags
+					return &awsimagebuilder.TagResourceOutput{}, nil
+				},
+				MockUntagResource: func(_ context.Context, input *awsimagebuilder.UntagResourceInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.UntagResourceOutput, error) {
+					untagged = input.TagKeys
+					return &awsimagebuilder.UntagResourceOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), component(withExternalName(componentARN), withSpec(spec(tc.desired))))
+
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("tagged: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.untagged, untagged); diff != "" {
+				t.Errorf("untagged: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		deleteErr error
+		err       error
+	}{
+		"Successful": {},
+		"AlreadyGone": {
+			deleteErr: &awsimagebuildertypes.ResourceNotFoundException{},
+		},
+		"DeleteFailed": {
+			deleteErr: errBoom,
+			err:       awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockComponentClient{
+				MockDelete: func(_ context.Context, input *awsimagebuilder.DeleteComponentInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.DeleteComponentOutput, error) {
+					if aws.ToString(input.ComponentBuildVersionArn) != componentARN {
+						return nil, errors.New("unexpected component")
+					}
+					return &awsimagebuilder.DeleteComponentOutput{}, tc.deleteErr
+				},
+			}}
+			err := e.Delete(context.Background(), component(withExternalName(componentARN)))
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagepipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsimagebuilder "github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	awsimagebuildertypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/imagebuilder/fake"
+)
+
+var (
+	pipelineARN = "arn:aws:imagebuilder:us-east-1:123456789012:image-pipeline/sample"
+	recipeARN   = "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/sample/1.0.0"
+	infraARN    = "arn:aws:imagebuilder:us-east-1:123456789012:infrastructure-configuration/sample"
+	daily       = "cron(0 0 * * ? *)"
+	weekly      = "cron(0 0 ? * sun *)"
+	enabled     = string(awsimagebuildertypes.PipelineStatusEnabled)
+
+	errBoom = errors.New("boom")
+)
+
+type pipelineModifier func(*v1alpha1.ImagePipeline)
+
+func withExternalName(name string) pipelineModifier {
+	return func(r *v1alpha1.ImagePipeline) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) pipelineModifier {
+	return func(r *v1alpha1.ImagePipeline) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ImagePipelineParameters) pipelineModifier {
+	return func(r *v1alpha1.ImagePipeline) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ImagePipelineObservation) pipelineModifier {
+	return func(r *v1alpha1.ImagePipeline) { r.Status.AtProvider = s }
+}
+
+func pipeline(m ...pipelineModifier) *v1alpha1.ImagePipeline {
+	cr := &v1alpha1.ImagePipeline{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(schedule string) v1alpha1.ImagePipelineParameters {
+	return v1alpha1.ImagePipelineParameters{
+		Name:                           "sample",
+		ImageRecipeARN:                 aws.String(recipeARN),
+		InfrastructureConfigurationARN: aws.String(infraARN),
+		EnhancedImageMetadataEnabled:   aws.Bool(true),
+		Schedule: &v1alpha1.Schedule{
+			ScheduleExpression: aws.String(schedule),
+		},
+		Status: &enabled,
+	}
+}
+
+func observed(schedule string) *awsimagebuildertypes.ImagePipeline {
+	return &awsimagebuildertypes.ImagePipeline{
+		Arn:                            aws.String(pipelineARN),
+		Name:                           aws.String("sample"),
+		ImageRecipeArn:                 aws.String(recipeARN),
+		InfrastructureConfigurationArn: aws.String(infraARN),
+		EnhancedImageMetadataEnabled:   aws.Bool(true),
+		Platform:                       awsimagebuildertypes.PlatformLinux,
+		Schedule: &awsimagebuildertypes.Schedule{
+			ScheduleExpression:              aws.String(schedule),
+			PipelineExecutionStartCondition: awsimagebuildertypes.PipelineExecutionStartConditionExpressionMatchAndDependencyUpdatesAvailable,
+			Timezone:                        aws.String("UTC"),
+		},
+		Status: awsimagebuildertypes.PipelineStatusEnabled,
+	}
+}
+
+func lateInitialized(p v1alpha1.ImagePipelineParameters) v1alpha1.ImagePipelineParameters {
+	p.Schedule.PipelineExecutionStartCondition = aws.String(string(awsimagebuildertypes.PipelineExecutionStartConditionExpressionMatchAndDependencyUpdatesAvailable))
+	return p
+}
+
+func observation() v1alpha1.ImagePipelineObservation {
+	return v1alpha1.ImagePipelineObservation{
+		ARN:      pipelineARN,
+		Platform: string(awsimagebuildertypes.PlatformLinux),
+	}
+}
+
+func get(p *awsimagebuildertypes.ImagePipeline) func(context.Context, *awsimagebuilder.GetImagePipelineInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetImagePipelineOutput, error) {
+	return func(_ context.Context, input *awsimagebuilder.GetImagePipelineInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetImagePipelineOutput, error) {
+		if aws.ToString(input.ImagePipelineArn) != pipelineARN {
+			return nil, errors.New("unexpected image pipeline")
+		}
+		return &awsimagebuilder.GetImagePipelineOutput{ImagePipeline: p}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ImagePipeline
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockImagePipelineClient
+		cr     *v1alpha1.ImagePipeline
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockImagePipelineClient{
+				MockGet: get(observed(daily)),
+			},
+			cr: pipeline(withExternalName(pipelineARN), withSpec(lateInitialized(spec(daily)))),
+			want: want{
+				cr: pipeline(withExternalName(pipelineARN), withSpec(lateInitialized(spec(daily))),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			client: &fake.MockImagePipelineClient{
+				MockGet: get(observed(daily)),
+			},
+			cr: pipeline(withExternalName(pipelineARN), withSpec(spec(daily))),
+			want: want{
+				cr: pipeline(withExternalName(pipelineARN), withSpec(lateInitialized(spec(daily))),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ScheduleChanged": {
+			client: &fake.MockImagePipelineClient{
+				MockGet: get(observed(daily)),
+			},
+			cr: pipeline(withExternalName(pipelineARN), withSpec(lateInitialized(spec(weekly)))),
+			want: want{
+				cr: pipeline(withExternalName(pipelineARN), withSpec(lateInitialized(spec(weekly))),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockImagePipelineClient{
+				MockGet: func(context.Context, *awsimagebuilder.GetImagePipelineInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetImagePipelineOutput, error) {
+					return nil, &awsimagebuildertypes.ResourceNotFoundException{}
+				},
+			},
+			cr: pipeline(withExternalName(pipelineARN), withSpec(spec(daily))),
+			want: want{
+				cr: pipeline(withExternalName(pipelineARN), withSpec(spec(daily))),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockImagePipelineClient{
+				MockGet: func(context.Context, *awsimagebuilder.GetImagePipelineInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetImagePipelineOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: pipeline(withExternalName(pipelineARN), withSpec(spec(daily))),
+			want: want{
+				cr:  pipeline(withExternalName(pipelineARN), withSpec(spec(daily))),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		updated bool
+		tagged  bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.ImagePipeline
+		updateErr error
+		want
+	}{
+		"ScheduleChanged": {
+			cr: pipeline(withExternalName(pipelineARN), withSpec(spec(weekly))),
+			want: want{
+				updated: true,
+			},
+		},
+		"OnlyTagsChanged": {
+			cr: pipeline(withExternalName(pipelineARN), withSpec(func() v1alpha1.ImagePipelineParameters {
+				p := spec(daily)
+				p.Tags = map[string]string{"k": "v"}
+				return p
+			}())),
+			want: want{
+				tagged: true,
+			},
+		},
+		"UpdateFailed": {
+			cr:        pipeline(withExternalName(pipelineARN), withSpec(spec(weekly))),
+			updateErr: errBoom,
+			want: want{
+				updated: true,
+				err:     awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated, tagged := false, false
+			e := &external{client: &fake.MockImagePipelineClient{
+				MockGet: get(observed(daily)),
+				MockUpdate: func(_ context.Context, input *awsimagebuilder.UpdateImagePipelineInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.UpdateImagePipelineOutput, error) {
+					updated = aws.ToString(input.ImagePipelineArn) == pipelineARN && aws.ToString(input.Schedule.ScheduleExpression) == weekly
+					return &awsimagebuilder.UpdateImagePipelineOutput{}, tc.updateErr
+				},
+				MockTagResource: func(_ context.Context, input *awsimagebuilder.TagResourceInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.TagResourceOutput, error) {
+					tagged = aws.ToString(input.ResourceArn) == pipelineARN
+					return &awsimagebuilder.TagResourceOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("updated: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("tagged: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagerecipe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsimagebuilder "github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	awsimagebuildertypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/imagebuilder/fake"
+)
+
+var (
+	imageRecipeARN = "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/sample/1.0.0"
+	componentARN   = "arn:aws:imagebuilder:us-east-1:123456789012:component/sample/1.0.0/1"
+	parentImage    = "arn:aws:imagebuilder:us-east-1:aws:image/amazon-linux-2-x86/x.x.x"
+	uid            = "7d1b4c2e-4f0a-4a53-9c1e-5b2f0f6f1c3a"
+
+	errBoom = errors.New("boom")
+)
+
+type imageRecipeModifier func(*v1alpha1.ImageRecipe)
+
+func withExternalName(name string) imageRecipeModifier {
+	return func(r *v1alpha1.ImageRecipe) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) imageRecipeModifier {
+	return func(r *v1alpha1.ImageRecipe) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ImageRecipeParameters) imageRecipeModifier {
+	return func(r *v1alpha1.ImageRecipe) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ImageRecipeObservation) imageRecipeModifier {
+	return func(r *v1alpha1.ImageRecipe) { r.Status.AtProvider = s }
+}
+
+func imageRecipe(m ...imageRecipeModifier) *v1alpha1.ImageRecipe {
+	cr := &v1alpha1.ImageRecipe{}
+	cr.UID = types.UID(uid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(tags map[string]string) v1alpha1.ImageRecipeParameters {
+	return v1alpha1.ImageRecipeParameters{
+		Name:            "sample",
+		SemanticVersion: "1.0.0",
+		ParentImage:     parentImage,
+		Components: []v1alpha1.ComponentConfiguration{{
+			ComponentARN: aws.String(componentARN),
+			Parameters:   []v1alpha1.ComponentParameter{{Name: "greeting", Value: []string{"hello"}}},
+		}},
+		Tags: tags,
+	}
+}
+
+func observed(tags map[string]string) *awsimagebuildertypes.ImageRecipe {
+	return &awsimagebuildertypes.ImageRecipe{
+		Arn:         aws.String(imageRecipeARN),
+		Name:        aws.String("sample"),
+		Version:     aws.String("1.0.0"),
+		ParentImage: aws.String(parentImage),
+		Owner:       aws.String("123456789012"),
+		Platform:    awsimagebuildertypes.PlatformLinux,
+		DateCreated: aws.String("2021-06-01T12:00:00Z"),
+		Components: []awsimagebuildertypes.ComponentConfiguration{{
+			ComponentArn: aws.String(componentARN),
+		}},
+		Tags: tags,
+	}
+}
+
+func observation() v1alpha1.ImageRecipeObservation {
+	return v1alpha1.ImageRecipeObservation{
+		ARN:         imageRecipeARN,
+		Owner:       "123456789012",
+		Platform:    string(awsimagebuildertypes.PlatformLinux),
+		DateCreated: "2021-06-01T12:00:00Z",
+	}
+}
+
+func get(r *awsimagebuildertypes.ImageRecipe) func(context.Context, *awsimagebuilder.GetImageRecipeInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetImageRecipeOutput, error) {
+	return func(_ context.Context, input *awsimagebuilder.GetImageRecipeInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetImageRecipeOutput, error) {
+		if aws.ToString(input.ImageRecipeArn) != imageRecipeARN {
+			return nil, errors.New("unexpected image recipe")
+		}
+		return &awsimagebuilder.GetImageRecipeOutput{ImageRecipe: r}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ImageRecipe
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockImageRecipeClient
+		cr     *v1alpha1.ImageRecipe
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockImageRecipeClient{
+				MockGet: get(observed(map[string]string{"k": "v"})),
+			},
+			cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(map[string]string{"k": "v"}))),
+			want: want{
+				cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(map[string]string{"k": "v"})),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			client: &fake.MockImageRecipeClient{
+				MockGet: get(observed(map[string]string{"k": "v"})),
+			},
+			cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(map[string]string{"k": "v2"}))),
+			want: want{
+				cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(map[string]string{"k": "v2"})),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			client: &fake.MockImageRecipeClient{},
+			cr:     imageRecipe(withSpec(spec(nil))),
+			want: want{
+				cr: imageRecipe(withSpec(spec(nil))),
+			},
+		},
+		"Missing": {
+			client: &fake.MockImageRecipeClient{
+				MockGet: get(nil),
+			},
+			cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(nil))),
+			want: want{
+				cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(nil))),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockImageRecipeClient{
+				MockGet: func(context.Context, *awsimagebuilder.GetImageRecipeInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetImageRecipeOutput, error) {
+					return nil, &awsimagebuildertypes.ResourceNotFoundException{}
+				},
+			},
+			cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(nil))),
+			want: want{
+				cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(nil))),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockImageRecipeClient{
+				MockGet: func(context.Context, *awsimagebuilder.GetImageRecipeInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetImageRecipeOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(nil))),
+			want: want{
+				cr:  imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(nil))),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ImageRecipe
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockImageRecipeClient
+		cr     *v1alpha1.ImageRecipe
+		want
+	}{
+		"Successful": {
+			client: &fake.MockImageRecipeClient{
+				MockCreate: func(_ context.Context, input *awsimagebuilder.CreateImageRecipeInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.CreateImageRecipeOutput, error) {
+					if aws.ToString(input.ClientToken) != uid {
+						return nil, errors.New("unexpected client token")
+					}
+					if len(input.Components) != 1 || aws.ToString(input.Components[0].ComponentArn) != componentARN {
+						return nil, errors.New("unexpected components")
+					}
+					return &awsimagebuilder.CreateImageRecipeOutput{ImageRecipeArn: aws.String(imageRecipeARN)}, nil
+				},
+			},
+			cr: imageRecipe(withSpec(spec(nil))),
+			want: want{
+				cr: imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(nil)),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockImageRecipeClient{
+				MockCreate: func(context.Context, *awsimagebuilder.CreateImageRecipeInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.CreateImageRecipeOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: imageRecipe(withSpec(spec(nil))),
+			want: want{
+				cr: imageRecipe(withSpec(spec(nil)),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		tagged   map[string]string
+		untagged []string
+	}
+
+	cases := map[string]struct {
+		observed map[string]string
+		desired  map[string]string
+		want
+	}{
+		"AddTag": {
+			observed: map[string]string{"k": "v"},
+			desired:  map[string]string{"k": "v", "k2": "v2"},
+			want: want{
+				tagged: map[string]string{"k2": "v2"},
+			},
+		},
+		"RemoveTag": {
+			observed: map[string]string{"k": "v", "k2": "v2"},
+			desired:  map[string]string{"k": "v"},
+			want: want{
+				untagged: []string{"k2"},
+			},
+		},
+		"ChangeTag": {
+			observed: map[string]string{"k": "v"},
+			desired:  map[string]string{"k": "v2"},
+			want: want{
+				tagged:   map[string]string{"k": "v2"},
+				untagged: []string{"k"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var tagged map[string]string
+			var untagged []string
+			e := &external{client: &fake.MockImageRecipeClient{
+				MockGet: get(observed(tc.observed)),
+				MockTagResource: func(_ context.Context, input *awsimagebuilder.TagResourceInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.TagResourceOutput, error) {
+					tagged = input.Tags
+					return &awsimagebuilder.TagResourceOutput{}, nil
+				},
+				MockUntagResource: func(_ context.Context, input *awsimagebuilder.UntagResourceInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.UntagResourceOutput, error) {
+					untagged = input.TagKeys
+					return &awsimagebuilder.UntagResourceOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), imageRecipe(withExternalName(imageRecipeARN), withSpec(spec(tc.desired))))
+
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("tagged: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.untagged, untagged); diff != "" {
+				t.Errorf("untagged: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		deleteErr error
+		err       error
+	}{
+		"Successful": {},
+		"AlreadyGone": {
+			deleteErr: &awsimagebuildertypes.ResourceNotFoundException{},
+		},
+		"DeleteFailed": {
+			deleteErr: errBoom,
+			err:       awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockImageRecipeClient{
+				MockDelete: func(_ context.Context, input *awsimagebuilder.DeleteImageRecipeInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.DeleteImageRecipeOutput, error) {
+					if aws.ToString(input.ImageRecipeArn) != imageRecipeARN {
+						return nil, errors.New("unexpected image recipe")
+					}
+					return &awsimagebuilder.DeleteImageRecipeOutput{}, tc.deleteErr
+				},
+			}}
+			err := e.Delete(context.Background(), imageRecipe(withExternalName(imageRecipeARN)))
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
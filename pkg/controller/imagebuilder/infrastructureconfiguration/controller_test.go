@@ -0,0 +1,448 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package infrastructureconfiguration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsimagebuilder "github.com/aws/aws-sdk-go-v2/service/imagebuilder"
+	awsimagebuildertypes "github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/imagebuilder/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/imagebuilder/fake"
+)
+
+var (
+	infrastructureConfigurationARN = "arn:aws:imagebuilder:us-east-1:123456789012:infrastructure-configuration/sample"
+	uid                            = "0c6e2f5a-8d3b-4f7e-a1c9-2b4d6e8f0a1b"
+	resourceVersion                = "42"
+
+	errBoom = errors.New("boom")
+)
+
+type infrastructureConfigurationModifier func(*v1alpha1.InfrastructureConfiguration)
+
+func withExternalName(name string) infrastructureConfigurationModifier {
+	return func(r *v1alpha1.InfrastructureConfiguration) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) infrastructureConfigurationModifier {
+	return func(r *v1alpha1.InfrastructureConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.InfrastructureConfigurationParameters) infrastructureConfigurationModifier {
+	return func(r *v1alpha1.InfrastructureConfiguration) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.InfrastructureConfigurationObservation) infrastructureConfigurationModifier {
+	return func(r *v1alpha1.InfrastructureConfiguration) { r.Status.AtProvider = s }
+}
+
+func infrastructureConfiguration(m ...infrastructureConfigurationModifier) *v1alpha1.InfrastructureConfiguration {
+	cr := &v1alpha1.InfrastructureConfiguration{}
+	cr.UID = types.UID(uid)
+	cr.ResourceVersion = resourceVersion
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(tags map[string]string) v1alpha1.InfrastructureConfigurationParameters {
+	return v1alpha1.InfrastructureConfigurationParameters{
+		Name:                       "sample",
+		InstanceProfileName:        "imagebuilder",
+		InstanceTypes:              []string{"m5.large", "c5.large"},
+		SubnetID:                   aws.String("subnet-1"),
+		SecurityGroupIDs:           []string{"sg-1"},
+		TerminateInstanceOnFailure: aws.Bool(true),
+		InstanceMetadataOptions: &v1alpha1.InstanceMetadataOptions{
+			HTTPPutResponseHopLimit: aws.Int32(1),
+			HTTPTokens:              aws.String("required"),
+		},
+		Tags: tags,
+	}
+}
+
+func observed(tags map[string]string) *awsimagebuildertypes.InfrastructureConfiguration {
+	return &awsimagebuildertypes.InfrastructureConfiguration{
+		Arn:                        aws.String(infrastructureConfigurationARN),
+		Name:                       aws.String("sample"),
+		InstanceProfileName:        aws.String("imagebuilder"),
+		InstanceTypes:              []string{"c5.large", "m5.large"},
+		SubnetId:                   aws.String("subnet-1"),
+		SecurityGroupIds:           []string{"sg-1"},
+		TerminateInstanceOnFailure: aws.Bool(true),
+		InstanceMetadataOptions: &awsimagebuildertypes.InstanceMetadataOptions{
+			HttpPutResponseHopLimit: aws.Int32(1),
+			HttpTokens:              aws.String("required"),
+		},
+		DateCreated: aws.String("2021-06-01T12:00:00Z"),
+		DateUpdated: aws.String("2021-06-02T12:00:00Z"),
+		Tags:        tags,
+	}
+}
+
+func observation() v1alpha1.InfrastructureConfigurationObservation {
+	return v1alpha1.InfrastructureConfigurationObservation{
+		ARN:         infrastructureConfigurationARN,
+		DateCreated: "2021-06-01T12:00:00Z",
+		DateUpdated: "2021-06-02T12:00:00Z",
+	}
+}
+
+func get(c *awsimagebuildertypes.InfrastructureConfiguration) func(context.Context, *awsimagebuilder.GetInfrastructureConfigurationInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetInfrastructureConfigurationOutput, error) {
+	return func(_ context.Context, input *awsimagebuilder.GetInfrastructureConfigurationInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetInfrastructureConfigurationOutput, error) {
+		if aws.ToString(input.InfrastructureConfigurationArn) != infrastructureConfigurationARN {
+			return nil, errors.New("unexpected infrastructure configuration")
+		}
+		return &awsimagebuilder.GetInfrastructureConfigurationOutput{InfrastructureConfiguration: c}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.InfrastructureConfiguration
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockInfrastructureConfigurationClient
+		cr     *v1alpha1.InfrastructureConfiguration
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockInfrastructureConfigurationClient{
+				MockGet: get(observed(map[string]string{"k": "v"})),
+			},
+			cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(map[string]string{"k": "v"}))),
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(map[string]string{"k": "v"})),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			client: &fake.MockInfrastructureConfigurationClient{
+				MockGet: get(observed(map[string]string{"k": "v"})),
+			},
+			cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(map[string]string{"k": "v2"}))),
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(map[string]string{"k": "v2"})),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"SecurityGroupsChanged": {
+			client: &fake.MockInfrastructureConfigurationClient{
+				MockGet: get(observed(nil)),
+			},
+			cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(func() v1alpha1.InfrastructureConfigurationParameters {
+				p := spec(nil)
+				p.SecurityGroupIDs = []string{"sg-1", "sg-2"}
+				return p
+			}())),
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(func() v1alpha1.InfrastructureConfigurationParameters {
+					p := spec(nil)
+					p.SecurityGroupIDs = []string{"sg-1", "sg-2"}
+					return p
+				}()),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LateInitialize": {
+			client: &fake.MockInfrastructureConfigurationClient{
+				MockGet: get(observed(nil)),
+			},
+			cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(func() v1alpha1.InfrastructureConfigurationParameters {
+				p := spec(nil)
+				p.TerminateInstanceOnFailure = nil
+				p.InstanceMetadataOptions = nil
+				return p
+			}())),
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(nil)),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			client: &fake.MockInfrastructureConfigurationClient{},
+			cr:     infrastructureConfiguration(withSpec(spec(nil))),
+			want: want{
+				cr: infrastructureConfiguration(withSpec(spec(nil))),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockInfrastructureConfigurationClient{
+				MockGet: func(context.Context, *awsimagebuilder.GetInfrastructureConfigurationInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetInfrastructureConfigurationOutput, error) {
+					return nil, &awsimagebuildertypes.ResourceNotFoundException{}
+				},
+			},
+			cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(nil))),
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(nil))),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockInfrastructureConfigurationClient{
+				MockGet: func(context.Context, *awsimagebuilder.GetInfrastructureConfigurationInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.GetInfrastructureConfigurationOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(nil))),
+			want: want{
+				cr:  infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(nil))),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.InfrastructureConfiguration
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockInfrastructureConfigurationClient
+		cr     *v1alpha1.InfrastructureConfiguration
+		want
+	}{
+		"Successful": {
+			client: &fake.MockInfrastructureConfigurationClient{
+				MockCreate: func(_ context.Context, input *awsimagebuilder.CreateInfrastructureConfigurationInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.CreateInfrastructureConfigurationOutput, error) {
+					if aws.ToString(input.ClientToken) != uid {
+						return nil, errors.New("unexpected client token")
+					}
+					return &awsimagebuilder.CreateInfrastructureConfigurationOutput{InfrastructureConfigurationArn: aws.String(infrastructureConfigurationARN)}, nil
+				},
+			},
+			cr: infrastructureConfiguration(withSpec(spec(nil))),
+			want: want{
+				cr: infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(spec(nil)),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockInfrastructureConfigurationClient{
+				MockCreate: func(context.Context, *awsimagebuilder.CreateInfrastructureConfigurationInput, []func(*awsimagebuilder.Options)) (*awsimagebuilder.CreateInfrastructureConfigurationOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: infrastructureConfiguration(withSpec(spec(nil))),
+			want: want{
+				cr: infrastructureConfiguration(withSpec(spec(nil)),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		updated  bool
+		tagged   map[string]string
+		untagged []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		observed  map[string]string
+		desired   v1alpha1.InfrastructureConfigurationParameters
+		updateErr error
+		want
+	}{
+		"AddTag": {
+			observed: map[string]string{"k": "v"},
+			desired:  spec(map[string]string{"k": "v", "k2": "v2"}),
+			want: want{
+				tagged: map[string]string{"k2": "v2"},
+			},
+		},
+		"RemoveTag": {
+			observed: map[string]string{"k": "v", "k2": "v2"},
+			desired:  spec(map[string]string{"k": "v"}),
+			want: want{
+				untagged: []string{"k2"},
+			},
+		},
+		"InstanceProfileChanged": {
+			desired: func() v1alpha1.InfrastructureConfigurationParameters {
+				p := spec(nil)
+				p.InstanceProfileName = "imagebuilder-v2"
+				return p
+			}(),
+			want: want{
+				updated: true,
+			},
+		},
+		"UpdateFailed": {
+			desired: func() v1alpha1.InfrastructureConfigurationParameters {
+				p := spec(nil)
+				p.InstanceProfileName = "imagebuilder-v2"
+				return p
+			}(),
+			updateErr: errBoom,
+			want: want{
+				updated: true,
+				err:     awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			var tagged map[string]string
+			var untagged []string
+			e := &external{client: &fake.MockInfrastructureConfigurationClient{
+				MockGet: get(observed(tc.observed)),
+				MockUpdate: func(_ context.Context, input *awsimagebuilder.UpdateInfrastructureConfigurationInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.UpdateInfrastructureConfigurationOutput, error) {
+					if aws.ToString(input.ClientToken) != resourceVersion {
+						return nil, errors.New("unexpected client token")
+					}
+					updated = aws.ToString(input.InstanceProfileName) == "imagebuilder-v2"
+					return &awsimagebuilder.UpdateInfrastructureConfigurationOutput{}, tc.updateErr
+				},
+				MockTagResource: func(_ context.Context, input *awsimagebuilder.TagResourceInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.TagResourceOutput, error) {
+					tagged = input.Tags
+					return &awsimagebuilder.TagResourceOutput{}, nil
+				},
+				MockUntagResource: func(_ context.Context, input *awsimagebuilder.UntagResourceInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.UntagResourceOutput, error) {
+					untagged = input.TagKeys
+					return &awsimagebuilder.UntagResourceOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), infrastructureConfiguration(withExternalName(infrastructureConfigurationARN), withSpec(tc.desired)))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("updated: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged); diff != "" {
+				t.Errorf("tagged: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.untagged, untagged); diff != "" {
+				t.Errorf("untagged: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		deleteErr error
+		err       error
+	}{
+		"Successful": {},
+		"AlreadyGone": {
+			deleteErr: &awsimagebuildertypes.ResourceNotFoundException{},
+		},
+		"DeleteFailed": {
+			deleteErr: errBoom,
+			err:       awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockInfrastructureConfigurationClient{
+				MockDelete: func(_ context.Context, input *awsimagebuilder.DeleteInfrastructureConfigurationInput, _ []func(*awsimagebuilder.Options)) (*awsimagebuilder.DeleteInfrastructureConfigurationOutput, error) {
+					if aws.ToString(input.InfrastructureConfigurationArn) != infrastructureConfigurationARN {
+						return nil, errors.New("unexpected infrastructure configuration")
+					}
+					return &awsimagebuilder.DeleteInfrastructureConfigurationOutput{}, tc.deleteErr
+				},
+			}}
+			err := e.Delete(context.Background(), infrastructureConfiguration(withExternalName(infrastructureConfigurationARN)))
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
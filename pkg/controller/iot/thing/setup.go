@@ -63,7 +63,8 @@ func SetupThing(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 		For(&iottypes.Thing{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(iottypes.ThingGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws2.WrapExternal(mgr.GetClient(), aws2.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws2.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws2.NewCriticalAnnotationApplier(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
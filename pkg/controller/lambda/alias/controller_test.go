@@ -0,0 +1,346 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alias
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	aliasName    = "live"
+	functionName = "orders"
+	aliasARN     = "arn:aws:lambda:us-east-1:123456789012:function:orders:live"
+	revisionID   = "2f1a4b3c"
+
+	errBoom = errors.New("boom")
+)
+
+type aliasModifier func(*v1alpha1.Alias)
+
+func withConditions(c ...xpv1.Condition) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withFunctionVersion(v string) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Spec.ForProvider.FunctionVersion = aws.String(v) }
+}
+
+func withWeights(w map[string]float64) aliasModifier {
+	return func(r *v1alpha1.Alias) {
+		r.Spec.ForProvider.RoutingConfig = &v1alpha1.AliasRoutingConfiguration{AdditionalVersionWeights: w}
+	}
+}
+
+func withStatus(s v1alpha1.AliasObservation) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Status.AtProvider = s }
+}
+
+func alias(m ...aliasModifier) *v1alpha1.Alias {
+	cr := &v1alpha1.Alias{
+		Spec: v1alpha1.AliasSpec{
+			ForProvider: v1alpha1.AliasParameters{
+				Region:          "us-east-1",
+				FunctionName:    aws.String(functionName),
+				FunctionVersion: aws.String("3"),
+				Description:     aws.String("production traffic"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, aliasName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(version string, weights map[string]float64) *awslambda.AliasConfiguration {
+	a := &awslambda.AliasConfiguration{
+		Name:            aws.String(aliasName),
+		AliasArn:        aws.String(aliasARN),
+		FunctionVersion: aws.String(version),
+		Description:     aws.String("production traffic"),
+		RevisionId:      aws.String(revisionID),
+	}
+	if weights != nil {
+		a.RoutingConfig = &awslambda.AliasRoutingConfiguration{AdditionalVersionWeights: aws.Float64Map(weights)}
+	}
+	return a
+}
+
+func getAlias(a *awslambda.AliasConfiguration) func(context.Context, *awslambda.GetAliasInput, []request.Option) (*awslambda.AliasConfiguration, error) {
+	return func(_ context.Context, input *awslambda.GetAliasInput, _ []request.Option) (*awslambda.AliasConfiguration, error) {
+		if aws.StringValue(input.FunctionName) != functionName || aws.StringValue(input.Name) != aliasName {
+			return nil, errors.New("unexpected alias")
+		}
+		return a, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Alias
+		result managed.ExternalObservation
+		err    error
+	}
+
+	status := v1alpha1.AliasObservation{AliasARN: aliasARN, RevisionID: revisionID}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Alias
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockGetAliasWithContext: getAlias(observed("3", map[string]float64{"4": 0.1})),
+			},
+			cr: alias(withWeights(map[string]float64{"4": 0.1})),
+			want: want{
+				cr: alias(withWeights(map[string]float64{"4": 0.1}), withStatus(status), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NewVersion": {
+			client: &fake.MockClient{
+				MockGetAliasWithContext: getAlias(observed("3", nil)),
+			},
+			cr: alias(withFunctionVersion("4")),
+			want: want{
+				cr: alias(withFunctionVersion("4"), withStatus(status), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"WeightsRemoved": {
+			client: &fake.MockClient{
+				MockGetAliasWithContext: getAlias(observed("3", map[string]float64{"4": 0.1})),
+			},
+			cr: alias(),
+			want: want{
+				cr: alias(withStatus(status), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetAliasWithContext: func(context.Context, *awslambda.GetAliasInput, []request.Option) (*awslambda.AliasConfiguration, error) {
+					return nil, awserr.New(awslambda.ErrCodeResourceNotFoundException, "not found", nil)
+				},
+			},
+			cr: alias(),
+			want: want{
+				cr: alias(),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetAliasWithContext: func(context.Context, *awslambda.GetAliasInput, []request.Option) (*awslambda.AliasConfiguration, error) {
+					return nil, errBoom
+				},
+			},
+			cr: alias(),
+			want: want{
+				cr:  alias(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awslambda.UpdateAliasInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.Alias
+		updateErr error
+		want
+	}{
+		"ShiftTraffic": {
+			cr: alias(withWeights(map[string]float64{"4": 0.25}), withStatus(v1alpha1.AliasObservation{RevisionID: revisionID})),
+			want: want{
+				input: &awslambda.UpdateAliasInput{
+					Name:            aws.String(aliasName),
+					FunctionName:    aws.String(functionName),
+					FunctionVersion: aws.String("3"),
+					Description:     aws.String("production traffic"),
+					RoutingConfig:   &awslambda.AliasRoutingConfiguration{AdditionalVersionWeights: map[string]*float64{"4": aws.Float64(0.25)}},
+					RevisionId:      aws.String(revisionID),
+				},
+			},
+		},
+		"CompleteShift": {
+			cr: alias(withFunctionVersion("4"), withStatus(v1alpha1.AliasObservation{RevisionID: revisionID})),
+			want: want{
+				input: &awslambda.UpdateAliasInput{
+					Name:            aws.String(aliasName),
+					FunctionName:    aws.String(functionName),
+					FunctionVersion: aws.String("4"),
+					Description:     aws.String("production traffic"),
+					RoutingConfig:   &awslambda.AliasRoutingConfiguration{AdditionalVersionWeights: map[string]*float64{}},
+					RevisionId:      aws.String(revisionID),
+				},
+			},
+		},
+		"UpdateFailed": {
+			cr:        alias(),
+			updateErr: errBoom,
+			want: want{
+				input: &awslambda.UpdateAliasInput{
+					Name:            aws.String(aliasName),
+					FunctionName:    aws.String(functionName),
+					FunctionVersion: aws.String("3"),
+					Description:     aws.String("production traffic"),
+					RoutingConfig:   &awslambda.AliasRoutingConfiguration{AdditionalVersionWeights: map[string]*float64{}},
+				},
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awslambda.UpdateAliasInput
+			e := &external{client: &fake.MockClient{
+				MockUpdateAliasWithContext: func(_ context.Context, in *awslambda.UpdateAliasInput, _ []request.Option) (*awslambda.AliasConfiguration, error) {
+					input = in
+					return &awslambda.AliasConfiguration{}, tc.updateErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Alias
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Alias
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteAliasWithContext: func(_ context.Context, input *awslambda.DeleteAliasInput, _ []request.Option) (*awslambda.DeleteAliasOutput, error) {
+					if aws.StringValue(input.FunctionName) != functionName || aws.StringValue(input.Name) != aliasName {
+						return nil, errors.New("unexpected alias")
+					}
+					return &awslambda.DeleteAliasOutput{}, nil
+				},
+			},
+			cr: alias(),
+			want: want{
+				cr: alias(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteAliasWithContext: func(context.Context, *awslambda.DeleteAliasInput, []request.Option) (*awslambda.DeleteAliasOutput, error) {
+					return nil, awserr.New(awslambda.ErrCodeResourceNotFoundException, "not found", nil)
+				},
+			},
+			cr: alias(),
+			want: want{
+				cr: alias(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteAliasWithContext: func(context.Context, *awslambda.DeleteAliasInput, []request.Option) (*awslambda.DeleteAliasOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: alias(),
+			want: want{
+				cr:  alias(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsourcemapping
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	uuid        = "a1b2c3d4-5678-90ab-cdef-11111EXAMPLE"
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:orders"
+	queueARN    = "arn:aws:sqs:us-east-1:123456789012:orders"
+
+	errBoom = errors.New("boom")
+)
+
+type mappingModifier func(*v1alpha1.EventSourceMapping)
+
+func withConditions(c ...xpv1.Condition) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { meta.SetExternalName(r, n) }
+}
+
+func withEnabled(e bool) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Spec.ForProvider.Enabled = aws.Bool(e) }
+}
+
+func withStatus(s v1alpha1.EventSourceMappingObservation) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Status.AtProvider = s }
+}
+
+func mapping(m ...mappingModifier) *v1alpha1.EventSourceMapping {
+	cr := &v1alpha1.EventSourceMapping{
+		Spec: v1alpha1.EventSourceMappingSpec{
+			ForProvider: v1alpha1.EventSourceMappingParameters{
+				Region:                         "us-east-1",
+				FunctionName:                   aws.String("orders"),
+				EventSourceARN:                 aws.String(queueARN),
+				BatchSize:                      aws.Int64(10),
+				MaximumBatchingWindowInSeconds: aws.Int64(0),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(state string) *awslambda.EventSourceMappingConfiguration {
+	return &awslambda.EventSourceMappingConfiguration{
+		UUID:                           aws.String(uuid),
+		FunctionArn:                    aws.String(functionARN),
+		EventSourceArn:                 aws.String(queueARN),
+		State:                          aws.String(state),
+		BatchSize:                      aws.Int64(10),
+		MaximumBatchingWindowInSeconds: aws.Int64(0),
+	}
+}
+
+func getMapping(c *awslambda.EventSourceMappingConfiguration) func(context.Context, *awslambda.GetEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+	return func(_ context.Context, input *awslambda.GetEventSourceMappingInput, _ []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+		if aws.StringValue(input.UUID) != uuid {
+			return nil, errors.New("unexpected event source mapping")
+		}
+		return c, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EventSourceMapping
+		result managed.ExternalObservation
+		err    error
+	}
+
+	status := func(state string) v1alpha1.EventSourceMappingObservation {
+		return v1alpha1.EventSourceMappingObservation{UUID: uuid, FunctionARN: functionARN, State: state}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.EventSourceMapping
+		want
+	}{
+		"NotCreated": {
+			client: &fake.MockClient{},
+			cr:     mapping(),
+			want: want{
+				cr: mapping(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockGetEventSourceMappingWithContext: getMapping(observed(v1alpha1.EventSourceMappingStateEnabled)),
+			},
+			cr: mapping(withExternalName(uuid)),
+			want: want{
+				cr: mapping(withExternalName(uuid), withStatus(status(v1alpha1.EventSourceMappingStateEnabled)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Disable": {
+			client: &fake.MockClient{
+				MockGetEventSourceMappingWithContext: getMapping(observed(v1alpha1.EventSourceMappingStateEnabled)),
+			},
+			cr: mapping(withExternalName(uuid), withEnabled(false)),
+			want: want{
+				cr: mapping(withExternalName(uuid), withEnabled(false), withStatus(status(v1alpha1.EventSourceMappingStateEnabled)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"BeingDisabled": {
+			client: &fake.MockClient{
+				MockGetEventSourceMappingWithContext: getMapping(observed(v1alpha1.EventSourceMappingStateDisabling)),
+			},
+			cr: mapping(withExternalName(uuid)),
+			want: want{
+				cr: mapping(withExternalName(uuid), withStatus(status(v1alpha1.EventSourceMappingStateDisabling)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetEventSourceMappingWithContext: func(context.Context, *awslambda.GetEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+					return nil, awserr.New(awslambda.ErrCodeResourceNotFoundException, "not found", nil)
+				},
+			},
+			cr: mapping(withExternalName(uuid)),
+			want: want{
+				cr: mapping(withExternalName(uuid)),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetEventSourceMappingWithContext: func(context.Context, *awslambda.GetEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+					return nil, errBoom
+				},
+			},
+			cr: mapping(withExternalName(uuid)),
+			want: want{
+				cr:  mapping(withExternalName(uuid)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EventSourceMapping
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.EventSourceMapping
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockCreateEventSourceMappingWithContext: func(context.Context, *awslambda.CreateEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+					return observed(v1alpha1.EventSourceMappingStateCreating), nil
+				},
+			},
+			cr: mapping(),
+			want: want{
+				cr:     mapping(withExternalName(uuid), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockClient{
+				MockCreateEventSourceMappingWithContext: func(context.Context, *awslambda.CreateEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+					return nil, errBoom
+				},
+			},
+			cr: mapping(),
+			want: want{
+				cr:  mapping(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.EventSourceMapping
+		err error
+	}
+
+	deleting := v1alpha1.EventSourceMappingObservation{State: v1alpha1.EventSourceMappingStateDeleting}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.EventSourceMapping
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteEventSourceMappingWithContext: func(_ context.Context, input *awslambda.DeleteEventSourceMappingInput, _ []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+					if aws.StringValue(input.UUID) != uuid {
+						return nil, errors.New("unexpected event source mapping")
+					}
+					return observed(v1alpha1.EventSourceMappingStateDeleting), nil
+				},
+			},
+			cr: mapping(withExternalName(uuid)),
+			want: want{
+				cr: mapping(withExternalName(uuid), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			client: &fake.MockClient{},
+			cr:     mapping(withExternalName(uuid), withStatus(deleting)),
+			want: want{
+				cr: mapping(withExternalName(uuid), withStatus(deleting), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteEventSourceMappingWithContext: func(context.Context, *awslambda.DeleteEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+					return nil, errBoom
+				},
+			},
+			cr: mapping(withExternalName(uuid)),
+			want: want{
+				cr:  mapping(withExternalName(uuid), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
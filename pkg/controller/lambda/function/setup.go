@@ -2,6 +2,10 @@ package function
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
 	"time"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/lambda"
@@ -11,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -26,18 +31,33 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errKubeUpdateFailed = "cannot update Function custom resource"
+	errListVersions     = "cannot list the versions of the Function"
+	errPublish          = "cannot publish a version of the Function"
+
+	errFmtGetEnvironmentSecret = "cannot get the Secret of environment variable %s"
+)
+
+// AnnotationKeyCodeSource is added to Functions whose code is deployed from
+// S3. Its value is the S3 object the deployed code was read from, since
+// Lambda does not report it. The code of Functions without it is deployed
+// once more to record it.
+const AnnotationKeyCodeSource = "lambda.aws.crossplane.io/code-source"
+
 // SetupFunction adds a controller that reconciles Function.
 func SetupFunction(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(v1alpha1.FunctionGroupKind)
 	opts := []option{
 		func(e *external) {
 			e.preObserve = preObserve
-			e.postObserve = postObserve
 			e.preDelete = preDelete
-			e.preCreate = preCreate
 			e.isUpToDate = isUpToDate
 			e.lateInitialize = LateInitialize
-			u := &updater{client: e.client}
+			u := &updater{client: e.client, kube: e.kube}
+			e.preCreate = u.preCreate
+			e.postObserve = u.postObserve
+			e.postCreate = u.postCreate
 			e.update = u.update
 		},
 	}
@@ -49,7 +69,9 @@ func SetupFunction(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 		For(&v1alpha1.Function{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.FunctionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), aws.NewTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -63,10 +85,13 @@ func LateInitialize(cr *svcapitypes.FunctionParameters, resp *svcsdk.GetFunction
 	if cr.TracingConfig == nil {
 		cr.TracingConfig = &svcapitypes.TracingConfig{Mode: resp.Configuration.TracingConfig.Mode}
 	}
+	if cr.EphemeralStorage == nil && resp.Configuration.EphemeralStorage != nil {
+		cr.EphemeralStorage = &svcapitypes.FunctionEphemeralStorage{Size: resp.Configuration.EphemeralStorage.Size}
+	}
 	return nil
 }
 
-func preCreate(_ context.Context, cr *svcapitypes.Function, obj *svcsdk.CreateFunctionInput) error {
+func (u *updater) preCreate(ctx context.Context, cr *svcapitypes.Function, obj *svcsdk.CreateFunctionInput) error {
 	obj.FunctionName = aws.String(meta.GetExternalName(cr))
 	obj.Role = cr.Spec.ForProvider.Role
 	obj.Code = &svcsdk.FunctionCode{
@@ -82,6 +107,17 @@ func preCreate(_ context.Context, cr *svcapitypes.Function, obj *svcsdk.CreateFu
 		}
 	}
 	obj.Layers = cr.Spec.ForProvider.Layers
+	if cr.Spec.ForProvider.SnapStart != nil {
+		obj.SnapStart = &svcsdk.SnapStart{ApplyOn: cr.Spec.ForProvider.SnapStart.ApplyOn}
+	}
+	if cr.Spec.ForProvider.EphemeralStorage != nil {
+		obj.EphemeralStorage = &svcsdk.EphemeralStorage{Size: cr.Spec.ForProvider.EphemeralStorage.Size}
+	}
+	secret, err := u.secretEnvironment(ctx, cr)
+	if err != nil {
+		return err
+	}
+	obj.Environment = withSecretEnvironment(obj.Environment, secret)
 	return nil
 }
 
@@ -90,10 +126,11 @@ func preObserve(_ context.Context, cr *svcapitypes.Function, obj *svcsdk.GetFunc
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Function, resp *svcsdk.GetFunctionOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (u *updater) postObserve(ctx context.Context, cr *svcapitypes.Function, resp *svcsdk.GetFunctionOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	cr.Status.AtProvider.CodeSHA256 = resp.Configuration.CodeSha256
 	switch aws.StringValue(resp.Configuration.State) {
 	case string(svcapitypes.State_Active):
 		cr.SetConditions(xpv1.Available())
@@ -102,6 +139,37 @@ func postObserve(_ context.Context, cr *svcapitypes.Function, resp *svcsdk.GetFu
 	case string(svcapitypes.State_Failed), string(svcapitypes.State_Inactive):
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	// Nothing is updated once the function is being deleted, and the Secrets
+	// holding its environment may be deleted alongside it.
+	if meta.WasDeleted(cr) {
+		return obs, nil
+	}
+	secret, err := u.secretEnvironment(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !isUpToDateSecretEnvironment(secret, resp.Configuration) {
+		obs.ResourceUpToDate = false
+	}
+	if !aws.BoolValue(cr.Spec.ForProvider.Publish) {
+		return obs, nil
+	}
+
+	// The status reports the version that was published last, so that
+	// aliases can refer to it. A new version is published when the last one
+	// no longer matches the code and configuration of the function.
+	latest, err := u.latestVersion(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, aws.Wrap(err, errListVersions)
+	}
+	if latest == nil {
+		obs.ResourceUpToDate = false
+		return obs, nil
+	}
+	cr.Status.AtProvider.Version = latest.Version
+	if !isUpToDateVersion(cr, resp, latest) || !isUpToDateSecretEnvironment(secret, latest) {
+		obs.ResourceUpToDate = false
+	}
 	return obs, nil
 }
 
@@ -110,37 +178,45 @@ func preDelete(_ context.Context, cr *svcapitypes.Function, obj *svcsdk.DeleteFu
 	return false, nil
 }
 
-// nolint:gocyclo
 func isUpToDate(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) (bool, error) {
+	if !isUpToDateCode(cr, obj) || !isUpToDateConfiguration(cr, obj) {
+		return false, nil
+	}
 
-	// Compare CODE
-	// GetFunctionOutput returns
-	// Code *FunctionCodeLocation `type:"structure"`
-	// which does not map to
-	// Code *FunctionCode `type:"structure" required:"true"`
-	// which is used when creating the function.
-	// We can't currently properly implement a comparison
+	addTags, removeTags := aws.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, obj.Tags)
+	return len(addTags) == 0 && len(removeTags) == 0, nil
+}
 
-	// Compare CONFIGURATION
+// isUpToDateVersion checks if the given published version runs the deployed
+// code with the desired configuration.
+func isUpToDateVersion(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput, version *svcsdk.FunctionConfiguration) bool {
+	if aws.StringValue(version.CodeSha256) != aws.StringValue(obj.Configuration.CodeSha256) {
+		return false
+	}
+	return isUpToDateConfiguration(cr, &svcsdk.GetFunctionOutput{Configuration: version})
+}
+
+// nolint:gocyclo
+func isUpToDateConfiguration(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
 	if aws.StringValue(cr.Spec.ForProvider.Description) != aws.StringValue(obj.Configuration.Description) {
-		return false, nil
+		return false
 	}
 
 	if !isUpToDateEnvironment(cr, obj) {
-		return false, nil
+		return false
 	}
 
 	// Connection settings for an Amazon EFS file system.
 	if !isUpToDateFileSystemConfigs(cr, obj) {
-		return false, nil
+		return false
 	}
 
 	if aws.StringValue(cr.Spec.ForProvider.Handler) != aws.StringValue(obj.Configuration.Handler) {
-		return false, nil
+		return false
 	}
 
 	if aws.StringValue(cr.Spec.ForProvider.KMSKeyARN) != aws.StringValue(obj.Configuration.KMSKeyArn) {
-		return false, nil
+		return false
 	}
 
 	// The function's layers (https://docs.aws.amazon.com/lambda/latest/dg/configuration-layers.html).
@@ -151,47 +227,75 @@ func isUpToDate(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) (bool,
 
 	// set default
 	if aws.Int64Value(cr.Spec.ForProvider.MemorySize) != aws.Int64Value(obj.Configuration.MemorySize) {
-		return false, nil
+		return false
 	}
 
 	if aws.StringValue(cr.Spec.ForProvider.Role) != aws.StringValue(obj.Configuration.Role) {
-		return false, nil
+		return false
 	}
 
 	if aws.StringValue(cr.Spec.ForProvider.Runtime) != aws.StringValue(obj.Configuration.Runtime) {
-		return false, nil
+		return false
 	}
 
 	if aws.Int64Value(cr.Spec.ForProvider.Timeout) != aws.Int64Value(obj.Configuration.Timeout) {
-		return false, nil
+		return false
 	}
 
 	// This should never be nil.  We set this in LateInit as aws will initialize a default value
 	if aws.StringValue(cr.Spec.ForProvider.TracingConfig.Mode) != aws.StringValue(obj.Configuration.TracingConfig.Mode) {
-		return false, nil
+		return false
 	}
 
-	if !isUpToDateSecurityGroupIDs(cr, obj) {
-		return false, nil
+	if !isUpToDateSnapStart(cr, obj) || !isUpToDateEphemeralStorage(cr, obj) {
+		return false
 	}
 
-	addTags, removeTags := aws.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, obj.Tags)
-	return len(addTags) == 0 && len(removeTags) == 0, nil
+	return isUpToDateSecurityGroupIDs(cr, obj) && isUpToDateSubnetIDs(cr, obj)
+}
 
+// codeSource returns the S3 object the code of the function is read from, or
+// an empty string if the function is deployed from an image.
+func codeSource(p svcapitypes.CustomFunctionCodeParameters) string {
+	if p.ImageURI != nil || p.S3Bucket == nil {
+		return ""
+	}
+	src := "s3://" + aws.StringValue(p.S3Bucket) + "/" + aws.StringValue(p.S3Key)
+	if p.S3ObjectVersion != nil {
+		src += "?versionId=" + aws.StringValue(p.S3ObjectVersion)
+	}
+	return src
 }
 
-// isUpToDateEnvironment checks if FunctionConfiguration EnvironmentResponse Variables are up to date
+// isUpToDateCode checks if the deployed code of the function is read from
+// the desired image or S3 object.
+func isUpToDateCode(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
+	p := cr.Spec.ForProvider.CustomFunctionCodeParameters
+	if p.ImageURI != nil {
+		return obj.Code != nil && aws.StringValue(p.ImageURI) == aws.StringValue(obj.Code.ImageUri)
+	}
+	return cr.GetAnnotations()[AnnotationKeyCodeSource] == codeSource(p)
+}
+
+// isUpToDateEnvironment checks if FunctionConfiguration EnvironmentResponse Variables are up to date.
+// Variables that are read from Secrets are checked by isUpToDateSecretEnvironment.
 func isUpToDateEnvironment(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
 	// Handle nil pointer refs
 	envVars := map[string]*string{}
 	awsVars := map[string]*string{}
-	if cr.Spec.ForProvider.Environment != nil &&
-		cr.Spec.ForProvider.Environment.Variables != nil {
-		envVars = cr.Spec.ForProvider.Environment.Variables
+	if cr.Spec.ForProvider.Environment != nil {
+		for k, v := range cr.Spec.ForProvider.Environment.Variables {
+			envVars[k] = v
+		}
+	}
+	if obj.Configuration.Environment != nil {
+		for k, v := range obj.Configuration.Environment.Variables {
+			awsVars[k] = v
+		}
 	}
-	if obj.Configuration.Environment != nil &&
-		obj.Configuration.Environment.Variables != nil {
-		awsVars = obj.Configuration.Environment.Variables
+	for _, v := range cr.Spec.ForProvider.SecretEnvironment {
+		delete(envVars, v.Name)
+		delete(awsVars, v.Name)
 	}
 
 	// Compare whether the maps are equal, ignore ordering
@@ -202,6 +306,68 @@ func isUpToDateEnvironment(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutp
 	return cmp.Equal(envVars, awsVars, sortCmp, cmpopts.EquateEmpty())
 }
 
+// secretEnvironment returns the values of the environment variables that are
+// read from Secrets.
+func (u *updater) secretEnvironment(ctx context.Context, cr *svcapitypes.Function) (map[string]*string, error) {
+	res := map[string]*string{}
+	for _, v := range cr.Spec.ForProvider.SecretEnvironment {
+		val, err := aws.GetSecretData(ctx, u.kube, v.SecretKeyRef)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtGetEnvironmentSecret, v.Name)
+		}
+		res[v.Name] = aws.String(string(val), aws.FieldRequired)
+	}
+	return res, nil
+}
+
+// withSecretEnvironment adds the environment variables that are read from
+// Secrets to the given environment.
+func withSecretEnvironment(env *svcsdk.Environment, secret map[string]*string) *svcsdk.Environment {
+	if len(secret) == 0 {
+		return env
+	}
+	if env == nil {
+		env = &svcsdk.Environment{}
+	}
+	if env.Variables == nil {
+		env.Variables = map[string]*string{}
+	}
+	for k, v := range secret {
+		env.Variables[k] = v
+	}
+	return env
+}
+
+// hashEnvironment returns the hex-encoded SHA-256 hash of the given
+// environment variables.
+func hashEnvironment(vars map[string]*string) string {
+	names := make([]string, 0, len(vars))
+	for k := range vars {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, k := range names {
+		h.Write([]byte(k + "=" + aws.StringValue(vars[k]) + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// isUpToDateSecretEnvironment checks if the environment variables that are
+// read from Secrets have the desired values. Only the hashes of the values
+// are compared, so that they never end up in a diff.
+func isUpToDateSecretEnvironment(secret map[string]*string, obj *svcsdk.FunctionConfiguration) bool {
+	observed := map[string]*string{}
+	if obj.Environment != nil {
+		for k := range secret {
+			if v, ok := obj.Environment.Variables[k]; ok {
+				observed[k] = v
+			}
+		}
+	}
+	return hashEnvironment(secret) == hashEnvironment(observed)
+}
+
 func isUpToDateFileSystemConfigs(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
 	// Handle nil pointer refs
 	fileSystemConfigs := make([]*svcsdk.FileSystemConfig, 0)
@@ -263,8 +429,98 @@ func isUpToDateSecurityGroupIDs(cr *svcapitypes.Function, obj *svcsdk.GetFunctio
 	return cmp.Equal(securityGroupIDs, awsSecurityGroupIDs, sortCmp, cmpopts.EquateEmpty())
 }
 
+func isUpToDateSubnetIDs(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
+	// Handle nil pointer refs
+	var subnetIDs []*string
+	var awsSubnetIDs []*string
+	if cr.Spec.ForProvider.CustomFunctionVPCConfigParameters != nil {
+		subnetIDs = cr.Spec.ForProvider.CustomFunctionVPCConfigParameters.SubnetIDs
+	}
+	if obj.Configuration.VpcConfig != nil {
+		awsSubnetIDs = obj.Configuration.VpcConfig.SubnetIds
+	}
+
+	// Compare whether the slices are equal, ignore ordering
+	sortCmp := cmpopts.SortSlices(func(i, j *string) bool {
+		return aws.StringValue(i) < aws.StringValue(j)
+	})
+
+	return cmp.Equal(subnetIDs, awsSubnetIDs, sortCmp, cmpopts.EquateEmpty())
+}
+
+// isUpToDateSnapStart checks if SnapStart is applied as desired. It is not
+// applied unless it is configured.
+func isUpToDateSnapStart(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
+	applyOn := svcsdk.SnapStartApplyOnNone
+	if cr.Spec.ForProvider.SnapStart != nil && cr.Spec.ForProvider.SnapStart.ApplyOn != nil {
+		applyOn = *cr.Spec.ForProvider.SnapStart.ApplyOn
+	}
+	awsApplyOn := svcsdk.SnapStartApplyOnNone
+	if obj.Configuration.SnapStart != nil && obj.Configuration.SnapStart.ApplyOn != nil {
+		awsApplyOn = *obj.Configuration.SnapStart.ApplyOn
+	}
+	return applyOn == awsApplyOn
+}
+
+// isUpToDateEphemeralStorage checks if the /tmp directory has the desired
+// size. The size is late initialized, since Lambda defaults it.
+func isUpToDateEphemeralStorage(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
+	if cr.Spec.ForProvider.EphemeralStorage == nil {
+		return true
+	}
+	return obj.Configuration.EphemeralStorage != nil &&
+		aws.Int64Value(cr.Spec.ForProvider.EphemeralStorage.Size) == aws.Int64Value(obj.Configuration.EphemeralStorage.Size)
+}
+
 type updater struct {
 	client svcsdkapi.LambdaAPI
+	kube   client.Client
+}
+
+// recordCodeSource records the S3 object the deployed code was read from.
+// The status is kept, since the update returns the status that is stored.
+func (u *updater) recordCodeSource(ctx context.Context, cr *svcapitypes.Function) error {
+	src := codeSource(cr.Spec.ForProvider.CustomFunctionCodeParameters)
+	if src == "" || cr.GetAnnotations()[AnnotationKeyCodeSource] == src {
+		return nil
+	}
+	meta.AddAnnotations(cr, map[string]string{AnnotationKeyCodeSource: src})
+	status := cr.Status.DeepCopy()
+	err := u.kube.Update(ctx, cr)
+	cr.Status = *status
+	return errors.Wrap(err, errKubeUpdateFailed)
+}
+
+// latestVersion returns the version of the function that was published last,
+// or nil if none was published.
+func (u *updater) latestVersion(ctx context.Context, cr *svcapitypes.Function) (*svcsdk.FunctionConfiguration, error) {
+	var latest *svcsdk.FunctionConfiguration
+	latestNumber := 0
+	input := &svcsdk.ListVersionsByFunctionInput{FunctionName: aws.String(meta.GetExternalName(cr))}
+	for {
+		resp, err := u.client.ListVersionsByFunctionWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range resp.Versions {
+			// $LATEST is listed too, but it is not a published version.
+			n, err := strconv.Atoi(aws.StringValue(v.Version))
+			if err == nil && n > latestNumber {
+				latest, latestNumber = v, n
+			}
+		}
+		if aws.StringValue(resp.NextMarker) == "" {
+			return latest, nil
+		}
+		input.Marker = resp.NextMarker
+	}
+}
+
+func (u *updater) postCreate(ctx context.Context, cr *svcapitypes.Function, _ *svcsdk.FunctionConfiguration, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return cre, u.recordCodeSource(ctx, cr)
 }
 
 func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -273,28 +529,67 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	// https://docs.aws.amazon.com/sdk-for-go/api/service/lambda/#Lambda.UpdateFunctionCode
-	updateFunctionCodeInput := GenerateUpdateFunctionCodeInput(cr)
-	if _, err := u.client.UpdateFunctionCodeWithContext(ctx, updateFunctionCodeInput); err != nil {
+	fn, err := u.client.GetFunctionWithContext(ctx, &svcsdk.GetFunctionInput{
+		FunctionName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
 		return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
 	}
 
-	updateFunctionConfigurationInput := GenerateUpdateFunctionConfigurationInput(cr)
-	if _, err := u.client.UpdateFunctionConfigurationWithContext(ctx, updateFunctionConfigurationInput); err != nil {
-		return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
+	// Lambda rejects changes to a function while a previous change is being
+	// applied, so the remaining changes are made by the next reconciliation.
+	if aws.StringValue(fn.Configuration.LastUpdateStatus) == svcsdk.LastUpdateStatusInProgress {
+		return managed.ExternalUpdate{}, nil
 	}
 
-	// Should store the ARN somewhere else?
-	functionConfiguration, err := u.client.GetFunctionConfigurationWithContext(ctx, &svcsdk.GetFunctionConfigurationInput{
-		FunctionName: aws.String(meta.GetExternalName(cr)),
-	})
+	// https://docs.aws.amazon.com/sdk-for-go/api/service/lambda/#Lambda.UpdateFunctionCode
+	if !isUpToDateCode(cr, fn) {
+		out, err := u.client.UpdateFunctionCodeWithContext(ctx, GenerateUpdateFunctionCodeInput(cr))
+		if err != nil {
+			return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
+		}
+		if err := u.recordCodeSource(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		cr.Status.AtProvider.CodeSHA256 = out.CodeSha256
+		cr.Status.AtProvider.Version = out.Version
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// https://docs.aws.amazon.com/sdk-for-go/api/service/lambda/#Lambda.UpdateFunctionConfiguration
+	// The configuration is published by the next reconciliation, once the
+	// update is applied.
+	secret, err := u.secretEnvironment(ctx, cr)
 	if err != nil {
-		return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
+		return managed.ExternalUpdate{}, err
+	}
+	switch {
+	case !isUpToDateConfiguration(cr, fn) || !isUpToDateSecretEnvironment(secret, fn.Configuration):
+		updateFunctionConfigurationInput := GenerateUpdateFunctionConfigurationInput(cr)
+		updateFunctionConfigurationInput.Environment = withSecretEnvironment(updateFunctionConfigurationInput.Environment, secret)
+		if updateFunctionConfigurationInput.SnapStart == nil && !isUpToDateSnapStart(cr, fn) {
+			updateFunctionConfigurationInput.SnapStart = &svcsdk.SnapStart{ApplyOn: aws.String(svcsdk.SnapStartApplyOnNone)}
+		}
+		if _, err := u.client.UpdateFunctionConfigurationWithContext(ctx, updateFunctionConfigurationInput); err != nil {
+			return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
+		}
+	case aws.BoolValue(cr.Spec.ForProvider.Publish):
+		// The code hash guards against publishing code that was deployed
+		// since the function was read.
+		out, err := u.client.PublishVersionWithContext(ctx, &svcsdk.PublishVersionInput{
+			FunctionName: aws.String(meta.GetExternalName(cr)),
+			CodeSha256:   fn.Configuration.CodeSha256,
+			RevisionId:   fn.Configuration.RevisionId,
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, aws.Wrap(err, errPublish)
+		}
+		cr.Status.AtProvider.Version = out.Version
 	}
 
 	// Tags
 	tags, err := u.client.ListTagsWithContext(ctx, &svcsdk.ListTagsInput{
-		Resource: functionConfiguration.FunctionArn,
+		Resource: fn.Configuration.FunctionArn,
 	})
 	if err != nil {
 		return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
@@ -304,7 +599,7 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 	// Remove old tags before adding new tags in case values change for keys
 	if len(removeTags) > 0 {
 		if _, err := u.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
-			Resource: functionConfiguration.FunctionArn,
+			Resource: fn.Configuration.FunctionArn,
 			TagKeys:  removeTags,
 		}); err != nil {
 			return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
@@ -312,7 +607,7 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 	}
 	if len(addTags) > 0 {
 		if _, err := u.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
-			Resource: functionConfiguration.FunctionArn,
+			Resource: fn.Configuration.FunctionArn,
 			Tags:     addTags,
 		}); err != nil {
 			return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
@@ -346,6 +641,9 @@ func GenerateUpdateFunctionCodeInput(cr *svcapitypes.Function) *svcsdk.UpdateFun
 	if cr.Spec.ForProvider.CustomFunctionCodeParameters.S3ObjectVersion != nil {
 		f0.SetS3ObjectVersion(*cr.Spec.ForProvider.CustomFunctionCodeParameters.S3ObjectVersion)
 	}
+	if cr.Spec.ForProvider.Publish != nil {
+		f0.SetPublish(*cr.Spec.ForProvider.Publish)
+	}
 	return f0
 }
 
@@ -468,5 +766,19 @@ func GenerateUpdateFunctionConfigurationInput(cr *svcapitypes.Function) *svcsdk.
 		}
 		res.SetVpcConfig(f19)
 	}
+	if cr.Spec.ForProvider.SnapStart != nil {
+		f20 := &svcsdk.SnapStart{}
+		if cr.Spec.ForProvider.SnapStart.ApplyOn != nil {
+			f20.SetApplyOn(*cr.Spec.ForProvider.SnapStart.ApplyOn)
+		}
+		res.SetSnapStart(f20)
+	}
+	if cr.Spec.ForProvider.EphemeralStorage != nil {
+		f21 := &svcsdk.EphemeralStorage{}
+		if cr.Spec.ForProvider.EphemeralStorage.Size != nil {
+			f21.SetSize(*cr.Spec.ForProvider.EphemeralStorage.Size)
+		}
+		res.SetEphemeralStorage(f21)
+	}
 	return res
 }
@@ -1,13 +1,18 @@
 package function
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/lambda"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
 )
@@ -23,6 +28,10 @@ func withSpec(p v1alpha1.FunctionParameters) functionModifier {
 	return func(r *v1alpha1.Function) { r.Spec.ForProvider = p }
 }
 
+func withAnnotations(a map[string]string) functionModifier {
+	return func(r *v1alpha1.Function) { r.SetAnnotations(a) }
+}
+
 func function(m ...functionModifier) *v1alpha1.Function {
 	cr := &v1alpha1.Function{}
 	cr.Name = "test-function-name"
@@ -144,6 +153,23 @@ func TestIsUpToDateEnvironment(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"SecretVariablesIgnored": {
+			args: args{
+				cr: function(withSpec(v1alpha1.FunctionParameters{
+					Environment: &v1alpha1.Environment{
+						Variables: map[string]*string{"tagKey1": aws.String("tagValue1"), "token": aws.String("placeholder")},
+					},
+					CustomFunctionParameters: v1alpha1.CustomFunctionParameters{
+						SecretEnvironment: []v1alpha1.SecretEnvironmentVariable{{Name: "token"}},
+					}})),
+				obj: &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{Environment: &svcsdk.EnvironmentResponse{
+					Variables: map[string]*string{"tagKey1": aws.String("tagValue1"), "token": aws.String("s3cr3t")}}}},
+			},
+			want: want{
+				result: true,
+				err:    nil,
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -521,6 +547,332 @@ func TestIsUpToDateSecurityGroupIDs(t *testing.T) {
 	}
 }
 
+func TestIsUpToDateSubnetIDs(t *testing.T) {
+	vpc := func(ids ...string) *v1alpha1.Function {
+		return function(withSpec(v1alpha1.FunctionParameters{
+			CustomFunctionParameters: v1alpha1.CustomFunctionParameters{
+				CustomFunctionVPCConfigParameters: &v1alpha1.CustomFunctionVPCConfigParameters{
+					SubnetIDs: aws.StringSlice(ids),
+				},
+			}}))
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.Function
+		obj  *svcsdk.GetFunctionOutput
+		want bool
+	}{
+		"NoVPC": {
+			cr:   function(withSpec(v1alpha1.FunctionParameters{})),
+			obj:  &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{}},
+			want: true,
+		},
+		"SameSubnetsOutOfOrder": {
+			cr: vpc("subnet-1", "subnet-2"),
+			obj: &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{
+				VpcConfig: &svcsdk.VpcConfigResponse{SubnetIds: aws.StringSlice([]string{"subnet-2", "subnet-1"})}}},
+			want: true,
+		},
+		"NewSubnet": {
+			cr: vpc("subnet-1", "subnet-3"),
+			obj: &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{
+				VpcConfig: &svcsdk.VpcConfigResponse{SubnetIds: aws.StringSlice([]string{"subnet-2", "subnet-1"})}}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			result := isUpToDateSubnetIDs(tc.cr, tc.obj)
+
+			// Assert
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDateSnapStart(t *testing.T) {
+	snapStart := func(applyOn *string) *v1alpha1.Function {
+		return function(withSpec(v1alpha1.FunctionParameters{
+			CustomFunctionParameters: v1alpha1.CustomFunctionParameters{
+				SnapStart: &v1alpha1.FunctionSnapStart{ApplyOn: applyOn},
+			}}))
+	}
+	observed := func(applyOn string) *svcsdk.GetFunctionOutput {
+		return &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{
+			SnapStart: &svcsdk.SnapStartResponse{ApplyOn: aws.String(applyOn)}}}
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.Function
+		obj  *svcsdk.GetFunctionOutput
+		want bool
+	}{
+		"NotConfigured": {
+			cr:   function(withSpec(v1alpha1.FunctionParameters{})),
+			obj:  observed(svcsdk.SnapStartApplyOnNone),
+			want: true,
+		},
+		"NotReported": {
+			cr:   snapStart(aws.String(svcsdk.SnapStartApplyOnNone)),
+			obj:  &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{}},
+			want: true,
+		},
+		"Applied": {
+			cr:   snapStart(aws.String(svcsdk.SnapStartApplyOnPublishedVersions)),
+			obj:  observed(svcsdk.SnapStartApplyOnPublishedVersions),
+			want: true,
+		},
+		"Enabled": {
+			cr:   snapStart(aws.String(svcsdk.SnapStartApplyOnPublishedVersions)),
+			obj:  observed(svcsdk.SnapStartApplyOnNone),
+			want: false,
+		},
+		"Removed": {
+			cr:   function(withSpec(v1alpha1.FunctionParameters{})),
+			obj:  observed(svcsdk.SnapStartApplyOnPublishedVersions),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			result := isUpToDateSnapStart(tc.cr, tc.obj)
+
+			// Assert
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDateCode(t *testing.T) {
+	s3Code := func(version string) v1alpha1.FunctionParameters {
+		return v1alpha1.FunctionParameters{
+			CustomFunctionParameters: v1alpha1.CustomFunctionParameters{
+				CustomFunctionCodeParameters: v1alpha1.CustomFunctionCodeParameters{
+					S3Bucket:        aws.String("test_bucket"),
+					S3Key:           aws.String("test_key.zip"),
+					S3ObjectVersion: aws.String(version),
+				},
+			},
+		}
+	}
+	imageCode := v1alpha1.FunctionParameters{
+		CustomFunctionParameters: v1alpha1.CustomFunctionParameters{
+			CustomFunctionCodeParameters: v1alpha1.CustomFunctionCodeParameters{
+				ImageURI: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/test:v2"),
+			},
+		},
+	}
+	deployed := map[string]string{AnnotationKeyCodeSource: "s3://test_bucket/test_key.zip?versionId=v1"}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"SameS3ObjectVersion": {
+			args: args{
+				cr:  function(withSpec(s3Code("v1")), withAnnotations(deployed)),
+				obj: &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{}},
+			},
+			want: true,
+		},
+		"NewS3ObjectVersion": {
+			args: args{
+				cr:  function(withSpec(s3Code("v2")), withAnnotations(deployed)),
+				obj: &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{}},
+			},
+			want: false,
+		},
+		"S3SourceNotRecorded": {
+			args: args{
+				cr:  function(withSpec(s3Code("v1"))),
+				obj: &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{}},
+			},
+			want: false,
+		},
+		"SameImage": {
+			args: args{
+				cr: function(withSpec(imageCode)),
+				obj: &svcsdk.GetFunctionOutput{
+					Configuration: &svcsdk.FunctionConfiguration{},
+					Code:          &svcsdk.FunctionCodeLocation{ImageUri: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/test:v2")},
+				},
+			},
+			want: true,
+		},
+		"NewImage": {
+			args: args{
+				cr: function(withSpec(imageCode)),
+				obj: &svcsdk.GetFunctionOutput{
+					Configuration: &svcsdk.FunctionConfiguration{},
+					Code:          &svcsdk.FunctionCodeLocation{ImageUri: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/test:v1")},
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			result := isUpToDateCode(tc.args.cr, tc.args.obj)
+
+			// Assert
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDateVersion(t *testing.T) {
+	spec := v1alpha1.FunctionParameters{
+		MemorySize:    aws.Int64(256),
+		TracingConfig: &v1alpha1.TracingConfig{Mode: aws.String(svcsdk.TracingModePassThrough)},
+	}
+	deployed := &svcsdk.GetFunctionOutput{
+		Configuration: &svcsdk.FunctionConfiguration{CodeSha256: aws.String("sha-2")},
+	}
+	version := func(sha string, memory int64) *svcsdk.FunctionConfiguration {
+		return &svcsdk.FunctionConfiguration{
+			Version:       aws.String("7"),
+			CodeSha256:    aws.String(sha),
+			MemorySize:    aws.Int64(memory),
+			TracingConfig: &svcsdk.TracingConfigResponse{Mode: aws.String(svcsdk.TracingModePassThrough)},
+		}
+	}
+
+	cases := map[string]struct {
+		version *svcsdk.FunctionConfiguration
+		want    bool
+	}{
+		"Published": {
+			version: version("sha-2", 256),
+			want:    true,
+		},
+		"NewCode": {
+			version: version("sha-1", 256),
+			want:    false,
+		},
+		"NewConfiguration": {
+			version: version("sha-2", 128),
+			want:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			result := isUpToDateVersion(function(withSpec(spec)), deployed, tc.version)
+
+			// Assert
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDateSecretEnvironment(t *testing.T) {
+	configuration := func(vars map[string]*string) *svcsdk.FunctionConfiguration {
+		return &svcsdk.FunctionConfiguration{Environment: &svcsdk.EnvironmentResponse{Variables: vars}}
+	}
+
+	cases := map[string]struct {
+		secret map[string]*string
+		obj    *svcsdk.FunctionConfiguration
+		want   bool
+	}{
+		"NoSecretVariables": {
+			secret: map[string]*string{},
+			obj:    configuration(map[string]*string{"LOG_LEVEL": aws.String("debug")}),
+			want:   true,
+		},
+		"SameValues": {
+			secret: map[string]*string{"TOKEN": aws.String("s3cr3t"), "PASSWORD": aws.String("hunter2")},
+			obj:    configuration(map[string]*string{"LOG_LEVEL": aws.String("debug"), "PASSWORD": aws.String("hunter2"), "TOKEN": aws.String("s3cr3t")}),
+			want:   true,
+		},
+		"RotatedValue": {
+			secret: map[string]*string{"TOKEN": aws.String("rotated")},
+			obj:    configuration(map[string]*string{"TOKEN": aws.String("s3cr3t")}),
+			want:   false,
+		},
+		"MissingVariable": {
+			secret: map[string]*string{"TOKEN": aws.String("")},
+			obj:    &svcsdk.FunctionConfiguration{},
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			result := isUpToDateSecretEnvironment(tc.secret, tc.obj)
+
+			// Assert
+			if diff := cmp.Diff(tc.want, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostObserveSecretEnvironment(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+
+	errBoom := errors.New("boom")
+	secretEnvironment := func(r *v1alpha1.Function) {
+		r.Spec.ForProvider.SecretEnvironment = []v1alpha1.SecretEnvironmentVariable{{
+			Name:         "TOKEN",
+			SecretKeyRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "token", Namespace: "default"}, Key: "token"},
+		}}
+	}
+	deleted := func(r *v1alpha1.Function) {
+		ts := metav1.NewTime(time.Unix(1, 0))
+		r.SetDeletionTimestamp(&ts)
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.Function
+		want want
+	}{
+		"SecretError": {
+			cr: function(secretEnvironment),
+			want: want{
+				err: errors.Wrapf(errBoom, errFmtGetEnvironmentSecret, "TOKEN"),
+			},
+		},
+		"DeletedWithoutSecret": {
+			cr: function(secretEnvironment, deleted),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			u := &updater{kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}}
+			resp := &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{}}
+			obs, err := u.postObserve(context.Background(), tc.cr, resp, managed.ExternalObservation{ResourceExists: true}, nil)
+
+			// Assert
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateUpdateFunctionCodeInput(t *testing.T) {
 	type args struct {
 		cr *v1alpha1.Function
@@ -597,6 +949,8 @@ func TestGenerateUpdateFunctionConfigurationInput(t *testing.T) {
 						CustomFunctionVPCConfigParameters: &v1alpha1.CustomFunctionVPCConfigParameters{
 							SecurityGroupIDs: []*string{aws.String("id1")},
 						},
+						SnapStart:        &v1alpha1.FunctionSnapStart{ApplyOn: aws.String(svcsdk.SnapStartApplyOnPublishedVersions)},
+						EphemeralStorage: &v1alpha1.FunctionEphemeralStorage{Size: aws.Int64(1024)},
 					},
 				},
 				))},
@@ -617,6 +971,8 @@ func TestGenerateUpdateFunctionConfigurationInput(t *testing.T) {
 					Timeout:           aws.Int64(128),
 					TracingConfig:     &svcsdk.TracingConfig{Mode: aws.String(svcsdk.TracingModeActive)},
 					VpcConfig:         &svcsdk.VpcConfig{SecurityGroupIds: []*string{aws.String("id1")}},
+					SnapStart:         &svcsdk.SnapStart{ApplyOn: aws.String(svcsdk.SnapStartApplyOnPublishedVersions)},
+					EphemeralStorage:  &svcsdk.EphemeralStorage{Size: aws.Int64(1024)},
 				},
 			},
 		},
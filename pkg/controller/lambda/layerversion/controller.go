@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package layerversion
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Lambda LayerVersion resource"
+	errCreateSession    = "cannot create a new session"
+	errList             = "failed to list the versions of the Lambda layer"
+	errGet              = "failed to get the Lambda layer version"
+	errPublish          = "failed to publish a version of the Lambda layer"
+	errDelete           = "failed to delete the Lambda layer version"
+	errKubeUpdateFailed = "cannot update LayerVersion custom resource"
+)
+
+// AnnotationKeyContentSource is added to LayerVersions once a version is
+// published. Its value is the S3 object the version was read from, since
+// Lambda does not report it. A version is published once more for
+// LayerVersions without it to record it.
+const AnnotationKeyContentSource = "lambda.aws.crossplane.io/content-source"
+
+// SetupLayerVersion adds a controller that reconciles Lambda layer versions.
+func SetupLayerVersion(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.LayerVersionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.LayerVersion{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LayerVersionGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: lambda.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) lambda.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LayerVersion)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client lambda.Client
+}
+
+// versions returns the numbers of all versions of the given layer, the
+// highest number first.
+func (e *external) versions(ctx context.Context, name string) ([]int64, error) {
+	var res []int64
+	input := &awslambda.ListLayerVersionsInput{LayerName: aws.String(name)}
+	for {
+		resp, err := e.client.ListLayerVersionsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range resp.LayerVersions {
+			res = append(res, aws.Int64Value(v.Version))
+		}
+		if aws.StringValue(resp.NextMarker) == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] > res[j] })
+	return res, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LayerVersion)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	versions, err := e.versions(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(lambda.IsNotFound, err), errList)
+	}
+	if len(versions) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := e.client.GetLayerVersionWithContext(ctx, &awslambda.GetLayerVersionInput{
+		LayerName:     aws.String(meta.GetExternalName(cr)),
+		VersionNumber: aws.Int64(versions[0]),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGet)
+	}
+
+	cr.Status.AtProvider = lambda.GenerateLayerVersionObservation(resp)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: lambda.IsLayerVersionUpToDate(cr.Spec.ForProvider, resp) &&
+			cr.GetAnnotations()[AnnotationKeyContentSource] == lambda.LayerVersionContentSource(cr.Spec.ForProvider.Content),
+	}, nil
+}
+
+// publish publishes a version of the layer and records the S3 object it was
+// read from. The status is kept, since the update returns the status that is
+// stored.
+func (e *external) publish(ctx context.Context, cr *v1alpha1.LayerVersion) error {
+	resp, err := e.client.PublishLayerVersionWithContext(ctx, lambda.GeneratePublishLayerVersionInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return awsclient.Wrap(err, errPublish)
+	}
+	cr.Status.AtProvider.LayerARN = aws.StringValue(resp.LayerArn)
+	cr.Status.AtProvider.LayerVersionARN = aws.StringValue(resp.LayerVersionArn)
+	cr.Status.AtProvider.Version = aws.Int64Value(resp.Version)
+
+	meta.AddAnnotations(cr, map[string]string{AnnotationKeyContentSource: lambda.LayerVersionContentSource(cr.Spec.ForProvider.Content)})
+	status := cr.Status.DeepCopy()
+	err = e.kube.Update(ctx, cr)
+	cr.Status = *status
+	return errors.Wrap(err, errKubeUpdateFailed)
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LayerVersion)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, e.publish(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.LayerVersion)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// Versions of a layer cannot be changed, so a new one is published.
+	return managed.ExternalUpdate{}, e.publish(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LayerVersion)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// Functions keep using the versions they were configured with after
+	// the versions are deleted.
+	versions, err := e.versions(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(lambda.IsNotFound, err), errList)
+	}
+	for _, v := range versions {
+		_, err := e.client.DeleteLayerVersionWithContext(ctx, &awslambda.DeleteLayerVersionInput{
+			LayerName:     aws.String(meta.GetExternalName(cr)),
+			VersionNumber: aws.Int64(v),
+		})
+		if resource.Ignore(lambda.IsNotFound, err) != nil {
+			return awsclient.Wrap(err, errDelete)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package layerversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	layerName  = "deps"
+	layerARN   = "arn:aws:lambda:us-east-1:123456789012:layer:deps"
+	source     = "s3://artifacts/layers/deps.zip"
+	newSource  = "s3://artifacts/layers/deps.zip?versionId=2"
+	runtime    = "python3.9"
+	errBoom    = errors.New("boom")
+	versionARN = func(v string) string { return layerARN + ":" + v }
+)
+
+type layerVersionModifier func(*v1alpha1.LayerVersion)
+
+func withConditions(c ...xpv1.Condition) layerVersionModifier {
+	return func(r *v1alpha1.LayerVersion) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSource(s string) layerVersionModifier {
+	return func(r *v1alpha1.LayerVersion) {
+		meta.AddAnnotations(r, map[string]string{AnnotationKeyContentSource: s})
+	}
+}
+
+func withObjectVersion(v string) layerVersionModifier {
+	return func(r *v1alpha1.LayerVersion) { r.Spec.ForProvider.Content.S3ObjectVersion = aws.String(v) }
+}
+
+func withObservation(o v1alpha1.LayerVersionObservation) layerVersionModifier {
+	return func(r *v1alpha1.LayerVersion) { r.Status.AtProvider = o }
+}
+
+func layerVersion(m ...layerVersionModifier) *v1alpha1.LayerVersion {
+	cr := &v1alpha1.LayerVersion{
+		Spec: v1alpha1.LayerVersionSpec{
+			ForProvider: v1alpha1.LayerVersionParameters{
+				Region: "us-east-1",
+				Content: v1alpha1.LayerVersionContent{
+					S3Bucket: aws.String("artifacts"),
+					S3Key:    aws.String("layers/deps.zip"),
+				},
+				CompatibleRuntimes: []*string{aws.String(runtime)},
+			},
+		},
+	}
+	meta.SetExternalName(cr, layerName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// listVersions lists the given versions of the layer, one per page.
+func listVersions(versions ...int64) func(context.Context, *awslambda.ListLayerVersionsInput, []request.Option) (*awslambda.ListLayerVersionsOutput, error) {
+	return func(_ context.Context, input *awslambda.ListLayerVersionsInput, _ []request.Option) (*awslambda.ListLayerVersionsOutput, error) {
+		if len(versions) == 0 {
+			return &awslambda.ListLayerVersionsOutput{}, nil
+		}
+		page := 0
+		if input.Marker != nil {
+			page = len(aws.StringValue(input.Marker))
+		}
+		resp := &awslambda.ListLayerVersionsOutput{
+			LayerVersions: []*awslambda.LayerVersionsListItem{{Version: aws.Int64(versions[page])}},
+		}
+		if page < len(versions)-1 {
+			resp.NextMarker = aws.String(aws.StringValue(input.Marker) + "x")
+		}
+		return resp, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LayerVersion
+		result managed.ExternalObservation
+		err    error
+	}
+
+	getLayerVersion := func(_ context.Context, input *awslambda.GetLayerVersionInput, _ []request.Option) (*awslambda.GetLayerVersionOutput, error) {
+		if aws.Int64Value(input.VersionNumber) != 3 {
+			return nil, errors.New("unexpected version")
+		}
+		return &awslambda.GetLayerVersionOutput{
+			LayerArn:           aws.String(layerARN),
+			LayerVersionArn:    aws.String(versionARN("3")),
+			Version:            aws.Int64(3),
+			CompatibleRuntimes: []*string{aws.String(runtime)},
+			Content:            &awslambda.LayerVersionContentOutput{CodeSha256: aws.String("sha"), CodeSize: aws.Int64(42)},
+		}, nil
+	}
+	observation := v1alpha1.LayerVersionObservation{
+		LayerARN:        layerARN,
+		LayerVersionARN: versionARN("3"),
+		Version:         3,
+		CodeSHA256:      "sha",
+		CodeSize:        42,
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.LayerVersion
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockListLayerVersionsWithContext: listVersions(2, 3, 1),
+				MockGetLayerVersionWithContext:   getLayerVersion,
+			},
+			cr: layerVersion(withSource(source)),
+			want: want{
+				cr: layerVersion(withSource(source), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NewObjectVersion": {
+			client: &fake.MockClient{
+				MockListLayerVersionsWithContext: listVersions(3),
+				MockGetLayerVersionWithContext:   getLayerVersion,
+			},
+			cr: layerVersion(withSource(source), withObjectVersion("2")),
+			want: want{
+				cr: layerVersion(withSource(source), withObjectVersion("2"), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotPublished": {
+			client: &fake.MockClient{
+				MockListLayerVersionsWithContext: listVersions(),
+			},
+			cr: layerVersion(),
+			want: want{
+				cr: layerVersion(),
+			},
+		},
+		"ListFailed": {
+			client: &fake.MockClient{
+				MockListLayerVersionsWithContext: func(context.Context, *awslambda.ListLayerVersionsInput, []request.Option) (*awslambda.ListLayerVersionsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: layerVersion(),
+			want: want{
+				cr:  layerVersion(),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LayerVersion
+		err error
+	}
+
+	published := v1alpha1.LayerVersionObservation{
+		LayerARN:        layerARN,
+		LayerVersionARN: versionARN("4"),
+		Version:         4,
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		kube   client.Client
+		cr     *v1alpha1.LayerVersion
+		want
+	}{
+		"Published": {
+			client: &fake.MockClient{
+				MockPublishLayerVersionWithContext: func(_ context.Context, input *awslambda.PublishLayerVersionInput, _ []request.Option) (*awslambda.PublishLayerVersionOutput, error) {
+					if aws.StringValue(input.LayerName) != layerName || aws.StringValue(input.Content.S3ObjectVersion) != "2" {
+						return nil, errors.New("unexpected input")
+					}
+					return &awslambda.PublishLayerVersionOutput{
+						LayerArn:        aws.String(layerARN),
+						LayerVersionArn: aws.String(versionARN("4")),
+						Version:         aws.Int64(4),
+					}, nil
+				},
+			},
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:   layerVersion(withSource(source), withObjectVersion("2")),
+			want: want{
+				cr: layerVersion(withSource(newSource), withObjectVersion("2"), withObservation(published)),
+			},
+		},
+		"PublishFailed": {
+			client: &fake.MockClient{
+				MockPublishLayerVersionWithContext: func(context.Context, *awslambda.PublishLayerVersionInput, []request.Option) (*awslambda.PublishLayerVersionOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: layerVersion(withSource(source), withObjectVersion("2")),
+			want: want{
+				cr:  layerVersion(withSource(source), withObjectVersion("2")),
+				err: awsclient.Wrap(errBoom, errPublish),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	var deleted []int64
+	e := &external{client: &fake.MockClient{
+		MockListLayerVersionsWithContext: listVersions(1, 2),
+		MockDeleteLayerVersionWithContext: func(_ context.Context, input *awslambda.DeleteLayerVersionInput, _ []request.Option) (*awslambda.DeleteLayerVersionOutput, error) {
+			deleted = append(deleted, aws.Int64Value(input.VersionNumber))
+			return &awslambda.DeleteLayerVersionOutput{}, nil
+		},
+	}}
+	if err := e.Delete(context.Background(), layerVersion()); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]int64{2, 1}, deleted); diff != "" {
+		t.Errorf("deleted: -want, +got:\n%s", diff)
+	}
+}
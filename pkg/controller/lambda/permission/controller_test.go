@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	statementID = "s3"
+	statement   = `{"Sid":"s3","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction",` +
+		`"Resource":"arn:aws:lambda:us-east-1:123456789012:function:orders","Condition":{"ArnLike":{"AWS:SourceArn":"arn:aws:s3:::uploads"}}}`
+	policy = `{"Version":"2012-10-17","Id":"default","Statement":[` + statement + `]}`
+
+	errBoom = errors.New("boom")
+)
+
+type permissionModifier func(*v1alpha1.Permission)
+
+func withConditions(c ...xpv1.Condition) permissionModifier {
+	return func(r *v1alpha1.Permission) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSourceARN(a string) permissionModifier {
+	return func(r *v1alpha1.Permission) { r.Spec.ForProvider.SourceARN = aws.String(a) }
+}
+
+func withStatement(s string) permissionModifier {
+	return func(r *v1alpha1.Permission) { r.Status.AtProvider.Statement = s }
+}
+
+func permission(m ...permissionModifier) *v1alpha1.Permission {
+	cr := &v1alpha1.Permission{
+		Spec: v1alpha1.PermissionSpec{
+			ForProvider: v1alpha1.PermissionParameters{
+				Region:       "us-east-1",
+				FunctionName: aws.String("orders"),
+				Action:       "lambda:InvokeFunction",
+				Principal:    "s3.amazonaws.com",
+				SourceARN:    aws.String("arn:aws:s3:::uploads"),
+			},
+		},
+	}
+	meta.SetExternalName(cr, statementID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getPolicy(doc string) func(context.Context, *awslambda.GetPolicyInput, []request.Option) (*awslambda.GetPolicyOutput, error) {
+	return func(_ context.Context, input *awslambda.GetPolicyInput, _ []request.Option) (*awslambda.GetPolicyOutput, error) {
+		if aws.StringValue(input.FunctionName) != "orders" {
+			return nil, errors.New("unexpected function")
+		}
+		return &awslambda.GetPolicyOutput{Policy: aws.String(doc)}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Permission
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.Permission
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockClient{MockGetPolicyWithContext: getPolicy(policy)},
+			cr:     permission(),
+			want: want{
+				cr: permission(withStatement(statement), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NewSourceARN": {
+			client: &fake.MockClient{MockGetPolicyWithContext: getPolicy(policy)},
+			cr:     permission(withSourceARN("arn:aws:s3:::downloads")),
+			want: want{
+				cr: permission(withSourceARN("arn:aws:s3:::downloads"), withStatement(statement), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"OtherStatements": {
+			client: &fake.MockClient{MockGetPolicyWithContext: getPolicy(`{"Version":"2012-10-17","Statement":[]}`)},
+			cr:     permission(),
+			want: want{
+				cr: permission(),
+			},
+		},
+		"NoPolicy": {
+			client: &fake.MockClient{
+				MockGetPolicyWithContext: func(context.Context, *awslambda.GetPolicyInput, []request.Option) (*awslambda.GetPolicyOutput, error) {
+					return nil, awserr.New(awslambda.ErrCodeResourceNotFoundException, "not found", nil)
+				},
+			},
+			cr: permission(),
+			want: want{
+				cr: permission(),
+			},
+		},
+		"GetPolicyFailed": {
+			client: &fake.MockClient{
+				MockGetPolicyWithContext: func(context.Context, *awslambda.GetPolicyInput, []request.Option) (*awslambda.GetPolicyOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: permission(),
+			want: want{
+				cr:  permission(),
+				err: awsclient.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		removeErr error
+		want
+	}{
+		"Replaced": {
+			want: want{
+				calls: []string{"RemovePermission", "AddPermission"},
+			},
+		},
+		"AlreadyRemoved": {
+			removeErr: awserr.New(awslambda.ErrCodeResourceNotFoundException, "not found", nil),
+			want: want{
+				calls: []string{"RemovePermission", "AddPermission"},
+			},
+		},
+		"RemoveFailed": {
+			removeErr: errBoom,
+			want: want{
+				calls: []string{"RemovePermission"},
+				err:   awsclient.Wrap(errBoom, errRemove),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockClient{
+				MockRemovePermissionWithContext: func(_ context.Context, input *awslambda.RemovePermissionInput, _ []request.Option) (*awslambda.RemovePermissionOutput, error) {
+					calls = append(calls, "RemovePermission")
+					return &awslambda.RemovePermissionOutput{}, tc.removeErr
+				},
+				MockAddPermissionWithContext: func(_ context.Context, input *awslambda.AddPermissionInput, _ []request.Option) (*awslambda.AddPermissionOutput, error) {
+					calls = append(calls, "AddPermission")
+					if aws.StringValue(input.StatementId) != statementID {
+						return nil, errors.New("unexpected statement")
+					}
+					return &awslambda.AddPermissionOutput{}, nil
+				},
+			}}
+			_, err := e.Update(context.Background(), permission(withSourceARN("arn:aws:s3:::downloads")))
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
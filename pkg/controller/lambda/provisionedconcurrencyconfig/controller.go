@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisionedconcurrencyconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Lambda ProvisionedConcurrencyConfig resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the Lambda provisioned concurrency"
+	errPut              = "failed to put the Lambda provisioned concurrency"
+	errDelete           = "failed to delete the Lambda provisioned concurrency"
+)
+
+// SetupProvisionedConcurrencyConfig adds a controller that reconciles the
+// provisioned concurrency of Lambda functions.
+func SetupProvisionedConcurrencyConfig(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.ProvisionedConcurrencyConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.ProvisionedConcurrencyConfig{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProvisionedConcurrencyConfigGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: lambda.NewClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) lambda.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProvisionedConcurrencyConfig)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client lambda.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.ProvisionedConcurrencyConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.GetProvisionedConcurrencyConfigWithContext(ctx, &awslambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: cr.Spec.ForProvider.FunctionName,
+		Qualifier:    cr.Spec.ForProvider.Qualifier,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(lambda.IsNotFound, err), errGet)
+	}
+
+	cr.Status.AtProvider = lambda.GenerateProvisionedConcurrencyConfigObservation(resp)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.ProvisionedConcurrencyStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.ProvisionedConcurrencyStatusInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.ProvisionedConcurrencyStatusFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(cr.Status.AtProvider.StatusReason))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: lambda.IsProvisionedConcurrencyConfigUpToDate(cr.Spec.ForProvider, resp),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.ProvisionedConcurrencyConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	_, err := e.client.PutProvisionedConcurrencyConfigWithContext(ctx, lambda.GeneratePutProvisionedConcurrencyConfigInput(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.ProvisionedConcurrencyConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.PutProvisionedConcurrencyConfigWithContext(ctx, lambda.GeneratePutProvisionedConcurrencyConfigInput(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.ProvisionedConcurrencyConfig)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteProvisionedConcurrencyConfigWithContext(ctx, &awslambda.DeleteProvisionedConcurrencyConfigInput{
+		FunctionName: cr.Spec.ForProvider.FunctionName,
+		Qualifier:    cr.Spec.ForProvider.Qualifier,
+	})
+	return awsclient.Wrap(resource.Ignore(lambda.IsNotFound, err), errDelete)
+}
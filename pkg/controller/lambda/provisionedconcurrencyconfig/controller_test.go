@@ -0,0 +1,422 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisionedconcurrencyconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	functionName = "orders"
+	qualifier    = "live"
+
+	errBoom = errors.New("boom")
+)
+
+type configModifier func(*v1alpha1.ProvisionedConcurrencyConfig)
+
+func withConditions(c ...xpv1.Condition) configModifier {
+	return func(r *v1alpha1.ProvisionedConcurrencyConfig) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExecutions(n int64) configModifier {
+	return func(r *v1alpha1.ProvisionedConcurrencyConfig) { r.Spec.ForProvider.ProvisionedConcurrentExecutions = n }
+}
+
+func withStatus(s v1alpha1.ProvisionedConcurrencyConfigObservation) configModifier {
+	return func(r *v1alpha1.ProvisionedConcurrencyConfig) { r.Status.AtProvider = s }
+}
+
+func config(m ...configModifier) *v1alpha1.ProvisionedConcurrencyConfig {
+	cr := &v1alpha1.ProvisionedConcurrencyConfig{
+		Spec: v1alpha1.ProvisionedConcurrencyConfigSpec{
+			ForProvider: v1alpha1.ProvisionedConcurrencyConfigParameters{
+				Region:                          "us-east-1",
+				FunctionName:                    aws.String(functionName),
+				Qualifier:                       aws.String(qualifier),
+				ProvisionedConcurrentExecutions: 10,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed(status string, requested, allocated int64) *awslambda.GetProvisionedConcurrencyConfigOutput {
+	o := &awslambda.GetProvisionedConcurrencyConfigOutput{
+		Status:                                   aws.String(status),
+		RequestedProvisionedConcurrentExecutions: aws.Int64(requested),
+		AllocatedProvisionedConcurrentExecutions: aws.Int64(allocated),
+		AvailableProvisionedConcurrentExecutions: aws.Int64(allocated),
+	}
+	if status == v1alpha1.ProvisionedConcurrencyStatusFailed {
+		o.StatusReason = aws.String("FUNCTION_ERROR_INIT_FAILURE")
+	}
+	return o
+}
+
+func getConfig(o *awslambda.GetProvisionedConcurrencyConfigOutput) func(context.Context, *awslambda.GetProvisionedConcurrencyConfigInput, []request.Option) (*awslambda.GetProvisionedConcurrencyConfigOutput, error) {
+	return func(_ context.Context, input *awslambda.GetProvisionedConcurrencyConfigInput, _ []request.Option) (*awslambda.GetProvisionedConcurrencyConfigOutput, error) {
+		if aws.StringValue(input.FunctionName) != functionName || aws.StringValue(input.Qualifier) != qualifier {
+			return nil, errors.New("unexpected provisioned concurrency")
+		}
+		return o, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ProvisionedConcurrencyConfig
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ProvisionedConcurrencyConfig
+		want
+	}{
+		"Ready": {
+			client: &fake.MockClient{
+				MockGetProvisionedConcurrencyConfigWithContext: getConfig(observed(v1alpha1.ProvisionedConcurrencyStatusReady, 10, 10)),
+			},
+			cr: config(),
+			want: want{
+				cr: config(withStatus(v1alpha1.ProvisionedConcurrencyConfigObservation{
+					Status:                                   v1alpha1.ProvisionedConcurrencyStatusReady,
+					AllocatedProvisionedConcurrentExecutions: 10,
+					AvailableProvisionedConcurrentExecutions: 10,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InProgress": {
+			client: &fake.MockClient{
+				MockGetProvisionedConcurrencyConfigWithContext: getConfig(observed(v1alpha1.ProvisionedConcurrencyStatusInProgress, 10, 4)),
+			},
+			cr: config(),
+			want: want{
+				cr: config(withStatus(v1alpha1.ProvisionedConcurrencyConfigObservation{
+					Status:                                   v1alpha1.ProvisionedConcurrencyStatusInProgress,
+					AllocatedProvisionedConcurrentExecutions: 4,
+					AvailableProvisionedConcurrentExecutions: 4,
+				}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InProgressWithOldRequest": {
+			client: &fake.MockClient{
+				MockGetProvisionedConcurrencyConfigWithContext: getConfig(observed(v1alpha1.ProvisionedConcurrencyStatusInProgress, 5, 5)),
+			},
+			cr: config(),
+			want: want{
+				cr: config(withStatus(v1alpha1.ProvisionedConcurrencyConfigObservation{
+					Status:                                   v1alpha1.ProvisionedConcurrencyStatusInProgress,
+					AllocatedProvisionedConcurrentExecutions: 5,
+					AvailableProvisionedConcurrentExecutions: 5,
+				}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Failed": {
+			client: &fake.MockClient{
+				MockGetProvisionedConcurrencyConfigWithContext: getConfig(observed(v1alpha1.ProvisionedConcurrencyStatusFailed, 10, 0)),
+			},
+			cr: config(),
+			want: want{
+				cr: config(withStatus(v1alpha1.ProvisionedConcurrencyConfigObservation{
+					Status:       v1alpha1.ProvisionedConcurrencyStatusFailed,
+					StatusReason: "FUNCTION_ERROR_INIT_FAILURE",
+				}), withConditions(xpv1.Unavailable().WithMessage("FUNCTION_ERROR_INIT_FAILURE"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ExecutionsChanged": {
+			client: &fake.MockClient{
+				MockGetProvisionedConcurrencyConfigWithContext: getConfig(observed(v1alpha1.ProvisionedConcurrencyStatusReady, 10, 10)),
+			},
+			cr: config(withExecutions(20)),
+			want: want{
+				cr: config(withExecutions(20), withStatus(v1alpha1.ProvisionedConcurrencyConfigObservation{
+					Status:                                   v1alpha1.ProvisionedConcurrencyStatusReady,
+					AllocatedProvisionedConcurrentExecutions: 10,
+					AvailableProvisionedConcurrentExecutions: 10,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{
+				MockGetProvisionedConcurrencyConfigWithContext: func(context.Context, *awslambda.GetProvisionedConcurrencyConfigInput, []request.Option) (*awslambda.GetProvisionedConcurrencyConfigOutput, error) {
+					return nil, awserr.New(awslambda.ErrCodeProvisionedConcurrencyConfigNotFoundException, "not found", nil)
+				},
+			},
+			cr: config(),
+			want: want{
+				cr: config(),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockClient{
+				MockGetProvisionedConcurrencyConfigWithContext: func(context.Context, *awslambda.GetProvisionedConcurrencyConfigInput, []request.Option) (*awslambda.GetProvisionedConcurrencyConfigOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: config(),
+			want: want{
+				cr:  config(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    *v1alpha1.ProvisionedConcurrencyConfig
+		input *awslambda.PutProvisionedConcurrencyConfigInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr     *v1alpha1.ProvisionedConcurrencyConfig
+		putErr error
+		want
+	}{
+		"Successful": {
+			cr: config(),
+			want: want{
+				cr: config(withConditions(xpv1.Creating())),
+				input: &awslambda.PutProvisionedConcurrencyConfigInput{
+					FunctionName:                    aws.String(functionName),
+					Qualifier:                       aws.String(qualifier),
+					ProvisionedConcurrentExecutions: aws.Int64(10),
+				},
+			},
+		},
+		"PutFailed": {
+			cr:     config(),
+			putErr: errBoom,
+			want: want{
+				cr: config(withConditions(xpv1.Creating())),
+				input: &awslambda.PutProvisionedConcurrencyConfigInput{
+					FunctionName:                    aws.String(functionName),
+					Qualifier:                       aws.String(qualifier),
+					ProvisionedConcurrentExecutions: aws.Int64(10),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awslambda.PutProvisionedConcurrencyConfigInput
+			e := &external{client: &fake.MockClient{
+				MockPutProvisionedConcurrencyConfigWithContext: func(_ context.Context, in *awslambda.PutProvisionedConcurrencyConfigInput, _ []request.Option) (*awslambda.PutProvisionedConcurrencyConfigOutput, error) {
+					input = in
+					return &awslambda.PutProvisionedConcurrencyConfigOutput{}, tc.putErr
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *awslambda.PutProvisionedConcurrencyConfigInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr     *v1alpha1.ProvisionedConcurrencyConfig
+		putErr error
+		want
+	}{
+		"ScaleUp": {
+			cr: config(withExecutions(20)),
+			want: want{
+				input: &awslambda.PutProvisionedConcurrencyConfigInput{
+					FunctionName:                    aws.String(functionName),
+					Qualifier:                       aws.String(qualifier),
+					ProvisionedConcurrentExecutions: aws.Int64(20),
+				},
+			},
+		},
+		"PutFailed": {
+			cr:     config(withExecutions(20)),
+			putErr: errBoom,
+			want: want{
+				input: &awslambda.PutProvisionedConcurrencyConfigInput{
+					FunctionName:                    aws.String(functionName),
+					Qualifier:                       aws.String(qualifier),
+					ProvisionedConcurrentExecutions: aws.Int64(20),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awslambda.PutProvisionedConcurrencyConfigInput
+			e := &external{client: &fake.MockClient{
+				MockPutProvisionedConcurrencyConfigWithContext: func(_ context.Context, in *awslambda.PutProvisionedConcurrencyConfigInput, _ []request.Option) (*awslambda.PutProvisionedConcurrencyConfigOutput, error) {
+					input = in
+					return &awslambda.PutProvisionedConcurrencyConfigOutput{}, tc.putErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ProvisionedConcurrencyConfig
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     *v1alpha1.ProvisionedConcurrencyConfig
+		want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockDeleteProvisionedConcurrencyConfigWithContext: func(_ context.Context, input *awslambda.DeleteProvisionedConcurrencyConfigInput, _ []request.Option) (*awslambda.DeleteProvisionedConcurrencyConfigOutput, error) {
+					if aws.StringValue(input.FunctionName) != functionName || aws.StringValue(input.Qualifier) != qualifier {
+						return nil, errors.New("unexpected provisioned concurrency")
+					}
+					return &awslambda.DeleteProvisionedConcurrencyConfigOutput{}, nil
+				},
+			},
+			cr: config(),
+			want: want{
+				cr: config(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockClient{
+				MockDeleteProvisionedConcurrencyConfigWithContext: func(context.Context, *awslambda.DeleteProvisionedConcurrencyConfigInput, []request.Option) (*awslambda.DeleteProvisionedConcurrencyConfigOutput, error) {
+					return nil, awserr.New(awslambda.ErrCodeResourceNotFoundException, "not found", nil)
+				},
+			},
+			cr: config(),
+			want: want{
+				cr: config(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockClient{
+				MockDeleteProvisionedConcurrencyConfigWithContext: func(context.Context, *awslambda.DeleteProvisionedConcurrencyConfigInput, []request.Option) (*awslambda.DeleteProvisionedConcurrencyConfigOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: config(),
+			want: want{
+				cr:  config(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
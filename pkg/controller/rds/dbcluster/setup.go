@@ -2,6 +2,7 @@ package dbcluster
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
@@ -33,6 +34,8 @@ const (
 	errGetSecretFailed    = "failed to get Kubernetes secret"
 	errUpdateSecretFailed = "failed to update Kubernetes secret"
 	errSaveSecretFailed   = "failed to save generated password to Kubernetes secret"
+	errNotDBCluster       = "managed resource is not a DBCluster custom resource"
+	errKubeUpdateFailed   = "cannot update DBCluster custom resource"
 )
 
 // SetupDBCluster adds a controller that reconciles DbCluster.
@@ -40,14 +43,16 @@ func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 	name := managed.ControllerName(svcapitypes.DBClusterGroupKind)
 	opts := []option{
 		func(e *external) {
-			e.preObserve = preObserve
-			e.postObserve = postObserve
 			c := &custom{client: e.client, kube: e.kube}
+			e.preObserve = preObserve
+			e.postObserve = c.postObserve
 			e.isUpToDate = isUpToDate
 			e.preUpdate = preUpdate
+			e.postUpdate = postUpdate
 			e.preCreate = c.preCreate
 			e.postCreate = c.postCreate
 			e.preDelete = preDelete
+			e.postDelete = c.postDelete
 			e.filterList = filterList
 		},
 	}
@@ -59,7 +64,9 @@ func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 		For(&svcapitypes.DBCluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(&defaulter{kube: mgr.GetClient()}, managed.NewNameAsExternalName(mgr.GetClient()), aws.NewTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -74,12 +81,20 @@ func preObserve(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.Descri
 // described here https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/Aurora.Status.html
 // Need to get help from community on how to deal with this. Ideally the status should reflect
 // the true status value as described by the provider.
-func postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 	switch aws.StringValue(resp.DBClusters[0].Status) {
-	case "available", "modifying":
+	case "available":
+		cr.SetConditions(xpv1.Available())
+		if obs.ResourceUpToDate {
+			aws.CompleteAsyncOperation(cr)
+		}
+		if err := e.publishReaderConnectionDetails(ctx, cr, resp.DBClusters[0]); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	case "modifying":
 		cr.SetConditions(xpv1.Available())
 	case "deleting", "stopped", "stopping":
 		cr.SetConditions(xpv1.Unavailable())
@@ -89,6 +104,35 @@ func postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.Desc
 	return obs, nil
 }
 
+// defaulter fills in the port and parameter group of DBClusters from their
+// engine so that they don't have to be specified. It leaves DBClusters that
+// already have an external name alone, since those may be adopting an existing
+// cluster whose settings differ from the defaults.
+type defaulter struct {
+	kube client.Client
+}
+
+func (d *defaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.DBCluster)
+	if !ok {
+		return errors.New(errNotDBCluster)
+	}
+	if meta.GetExternalName(cr) != "" {
+		return nil
+	}
+	p := &cr.Spec.ForProvider
+	engine := aws.StringValue(p.Engine)
+	if p.Port == nil {
+		p.Port = rds.DefaultPort(engine)
+	}
+	if p.DBClusterParameterGroupName == nil && p.DBClusterParameterGroupNameRef == nil && p.DBClusterParameterGroupNameSelector == nil {
+		if n := rds.DefaultParameterGroupName(engine, aws.StringValue(p.EngineVersion)); n != "" {
+			p.DBClusterParameterGroupName = aws.String(n)
+		}
+	}
+	return errors.Wrap(d.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.RDSAPI
@@ -134,11 +178,38 @@ func (e *custom) postCreate(ctx context.Context, cr *svcapitypes.DBCluster, out
 	} else {
 		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(*out.DBCluster.PendingModifiedValues.MasterUserPassword)
 	}
+	if err := aws.PersistAsyncInProgress(ctx, e.kube, cr, "CreateDBCluster"); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	return managed.ExternalCreation{
 		ConnectionDetails: conn,
 	}, nil
 }
 
+// publishReaderConnectionDetails writes the connection details of the reader
+// endpoint of the supplied cluster to the reader connection secret, if any.
+func (e *custom) publishReaderConnectionDetails(ctx context.Context, cr *svcapitypes.DBCluster, cluster *svcsdk.DBCluster) error {
+	ref := cr.Spec.ForProvider.ReaderConnectionSecretRef
+	if ref == nil || aws.StringValue(cluster.ReaderEndpoint) == "" {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(cluster.ReaderEndpoint)),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(cluster.MasterUsername)),
+	}
+	if cluster.Port != nil {
+		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.FormatInt(aws.Int64Value(cluster.Port), 10))
+	}
+	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, nil)
+	if resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, "cannot get password from the given secret")
+	}
+	if pw != "" {
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+	}
+	return rds.PublishConnectionDetails(ctx, e.kube, ref, cr, svcapitypes.DBClusterGroupVersionKind, conn)
+}
+
 func isUpToDate(cr *svcapitypes.DBCluster, out *svcsdk.DescribeDBClustersOutput) (bool, error) {
 	status := aws.StringValue(out.DBClusters[0].Status)
 	if status == "modifying" || status == "upgrading" || status == "configuring-iam-database-auth" {
@@ -159,6 +230,14 @@ func preUpdate(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.ModifyD
 	return nil
 }
 
+func postUpdate(_ context.Context, cr *svcapitypes.DBCluster, _ *svcsdk.ModifyDBClusterOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	aws.SetAsyncInProgress(cr, "ModifyDBCluster")
+	return upd, nil
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.DeleteDBClusterInput) (bool, error) {
 	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
 	obj.FinalDBSnapshotIdentifier = aws.String(cr.Spec.ForProvider.FinalDBSnapshotIdentifier)
@@ -178,6 +257,13 @@ func filterList(cr *svcapitypes.DBCluster, obj *svcsdk.DescribeDBClustersOutput)
 	return resp
 }
 
+func (e *custom) postDelete(ctx context.Context, cr *svcapitypes.DBCluster, _ *svcsdk.DeleteDBClusterOutput, err error) error {
+	if err != nil || !aws.BoolValue(cr.Spec.ForProvider.DeleteGeneratedPasswordSecret) {
+		return err
+	}
+	return rds.DeleteGeneratedPasswordSecret(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr)
+}
+
 func (e *custom) savePasswordSecret(ctx context.Context, cr *svcapitypes.DBCluster, pw string) error {
 	if cr.Spec.ForProvider.MasterUserPasswordSecretRef == nil {
 		return errors.New("no MasterUserPasswordSecretRef given, unable to save password")
@@ -207,6 +293,9 @@ func (e *custom) savePasswordSecret(ctx context.Context, cr *svcapitypes.DBClust
 		ref.Key: pw,
 	}
 	if create {
+		// Mark the secret as ours so that it can be deleted along with the
+		// DBCluster if requested.
+		meta.AddAnnotations(sc, map[string]string{rds.AnnotationKeyPasswordGeneratedFor: string(cr.GetUID())})
 		err = e.kube.Create(ctx, sc, &client.CreateOptions{})
 	} else {
 		err = e.kube.Update(ctx, sc, &client.UpdateOptions{})
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,13 +32,22 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
 )
 
 // error constants
 const (
 	errSaveSecretFailed = "failed to save generated password to Kubernetes secret"
+	errQuota            = "cannot check the DB instances service quota"
+	errDescribeQuotas   = "cannot describe RDS account quotas"
+	errNotDBInstance    = "managed resource is not a DBInstance custom resource"
+	errKubeUpdateFailed = "cannot update DBInstance custom resource"
 )
 
+// accountQuotaDBInstances is the name of the RDS account quota that counts DB
+// instances.
+const accountQuotaDBInstances = "DBInstances"
+
 // time formats
 const (
 	maintenanceWindowFormat = "Mon:15:04"
@@ -57,6 +67,7 @@ func SetupDBInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			e.preCreate = c.preCreate
 			e.postCreate = c.postCreate
 			e.preDelete = c.preDelete
+			e.postDelete = c.postDelete
 			e.filterList = filterList
 			e.preUpdate = c.preUpdate
 			e.postUpdate = c.postUpdate
@@ -70,12 +81,46 @@ func SetupDBInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 		For(&svcapitypes.DBInstance{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(aws.WrapExternal(mgr.GetClient(), aws.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), aws.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(aws.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(&defaulter{kube: mgr.GetClient()}, managed.NewNameAsExternalName(mgr.GetClient()), aws.NewTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// defaulter fills in the port and parameter group of DBInstances from their
+// engine and makes them private unless told otherwise. DBInstances that
+// already have an external name are left alone, since they may be adopting an
+// existing instance. The port of Aurora instances is that of their cluster.
+type defaulter struct {
+	kube client.Client
+}
+
+func (d *defaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.DBInstance)
+	if !ok {
+		return errors.New(errNotDBInstance)
+	}
+	if meta.GetExternalName(cr) != "" {
+		return nil
+	}
+	p := &cr.Spec.ForProvider
+	engine := aws.StringValue(p.Engine)
+	if p.Port == nil && !rds.IsAuroraEngine(engine) {
+		p.Port = rds.DefaultPort(engine)
+	}
+	if p.DBParameterGroupName == nil && p.DBParameterGroupNameRef == nil && p.DBParameterGroupNameSelector == nil {
+		if n := rds.DefaultParameterGroupName(engine, aws.StringValue(p.EngineVersion)); n != "" {
+			p.DBParameterGroupName = aws.String(n)
+		}
+	}
+	if p.PubliclyAccessible == nil {
+		p.PubliclyAccessible = aws.Bool(false, aws.FieldRequired)
+	}
+	return errors.Wrap(d.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
 type custom struct {
 	kube     client.Client
 	client   svcsdkapi.RDSAPI
@@ -88,6 +133,9 @@ func preObserve(_ context.Context, cr *svcapitypes.DBInstance, obj *svcsdk.Descr
 }
 
 func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.DBInstance, obj *svcsdk.CreateDBInstanceInput) error {
+	if err := e.checkQuota(ctx, cr); err != nil {
+		return errors.Wrap(err, errQuota)
+	}
 	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if resource.IgnoreNotFound(err) != nil {
 		return errors.Wrap(err, "cannot get password from the given secret")
@@ -118,6 +166,34 @@ func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.DBInstance, obj
 	return nil
 }
 
+func (e *custom) checkQuota(ctx context.Context, cr *svcapitypes.DBInstance) error {
+	enabled, err := servicequotas.Enabled(ctx, e.kube, cr)
+	if err != nil || !enabled {
+		return err
+	}
+	cfg, err := aws.GetConfig(ctx, e.kube, cr, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return err
+	}
+	qc := servicequotas.NewChecker(e.kube, servicequotas.NewClient(*cfg))
+	return qc.Check(ctx, cr, servicequotas.QuotaDBInstances, e.countDBInstances)
+}
+
+// countDBInstances returns the number of DB instances in the region as
+// reported by the RDS account attributes.
+func (e *custom) countDBInstances(ctx context.Context) (int, error) {
+	o, err := e.client.DescribeAccountAttributesWithContext(ctx, &svcsdk.DescribeAccountAttributesInput{})
+	if err != nil {
+		return 0, aws.Wrap(err, errDescribeQuotas)
+	}
+	for _, q := range o.AccountQuotas {
+		if aws.StringValue(q.AccountQuotaName) == accountQuotaDBInstances {
+			return int(aws.Int64Value(q.Used)), nil
+		}
+	}
+	return 0, nil
+}
+
 func (e *custom) assembleConnectionDetails(ctx context.Context, cr *svcapitypes.DBInstance) (managed.ConnectionDetails, error) {
 	conn := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretUserKey: []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername)),
@@ -402,12 +478,27 @@ func (e *custom) savePasswordSecret(ctx context.Context, cr *svcapitypes.DBInsta
 	ref := cr.Spec.ForProvider.MasterUserPasswordSecretRef
 	sc := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      ref.Name,
-			Namespace: ref.Namespace,
+			Name:        ref.Name,
+			Namespace:   ref.Namespace,
+			Annotations: map[string]string{rds.AnnotationKeyPasswordGeneratedFor: string(cr.GetUID())},
 		},
 		Data: map[string][]byte{
 			ref.Key: []byte(pw),
 		},
 	}
-	return patcher.Apply(ctx, sc)
+	// Only a secret created here is marked as ours, so that one that existed
+	// before is never deleted along with the DBInstance.
+	return patcher.Apply(ctx, sc, func(_ context.Context, _, desired runtime.Object) error {
+		if d, ok := desired.(metav1.Object); ok {
+			meta.RemoveAnnotations(d, rds.AnnotationKeyPasswordGeneratedFor)
+		}
+		return nil
+	})
+}
+
+func (e *custom) postDelete(ctx context.Context, cr *svcapitypes.DBInstance, _ *svcsdk.DeleteDBInstanceOutput, err error) error {
+	if err != nil || !cr.Spec.ForProvider.DeleteGeneratedPasswordSecret {
+		return err
+	}
+	return rds.DeleteGeneratedPasswordSecret(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr)
 }
@@ -0,0 +1,435 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	awsroute53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/healthcheck"
+	"github.com/crossplane/provider-aws/pkg/clients/healthcheck/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	errBoom              = errors.New("Some random error")
+	id                   = "abcdef11-2222-3333-4444-555555fedcba"
+	version        int64 = 1
+	ip                   = "192.0.2.44"
+	port           int32 = 80
+)
+
+type healthCheckModifier func(*v1alpha1.HealthCheck)
+
+type args struct {
+	route53 healthcheck.Client
+	cr      resource.Managed
+}
+
+func withExternalName(s string) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { meta.SetExternalName(r, s) }
+}
+
+func withConditions(c ...xpv1.Condition) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withVersion(v int64) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { r.Status.AtProvider.HealthCheckVersion = v }
+}
+
+func withFailureThreshold(t int32) healthCheckModifier {
+	return func(r *v1alpha1.HealthCheck) { r.Spec.ForProvider.FailureThreshold = &t }
+}
+
+func instance(m ...healthCheckModifier) *v1alpha1.HealthCheck {
+	cr := &v1alpha1.HealthCheck{
+		Spec: v1alpha1.HealthCheckSpec{
+			ForProvider: v1alpha1.HealthCheckParameters{
+				Type:      "HTTP",
+				IPAddress: &ip,
+				Port:      &port,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func healthCheck(threshold int32) *awsroute53types.HealthCheck {
+	return &awsroute53types.HealthCheck{
+		Id:                 &id,
+		HealthCheckVersion: &version,
+		HealthCheckConfig: &awsroute53types.HealthCheckConfig{
+			Type:             awsroute53types.HealthCheckTypeHttp,
+			IPAddress:        &ip,
+			Port:             &port,
+			FailureThreshold: &threshold,
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheck: func(ctx context.Context, input *awsroute53.GetHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.GetHealthCheckOutput, error) {
+						return &awsroute53.GetHealthCheckOutput{HealthCheck: healthCheck(3)}, nil
+					},
+				},
+				cr: instance(withExternalName(id), withFailureThreshold(3)),
+			},
+			want: want{
+				cr: instance(withExternalName(id), withFailureThreshold(3), withVersion(version),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheck: func(ctx context.Context, input *awsroute53.GetHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.GetHealthCheckOutput, error) {
+						return &awsroute53.GetHealthCheckOutput{HealthCheck: healthCheck(3)}, nil
+					},
+				},
+				cr: instance(withExternalName(id)),
+			},
+			want: want{
+				cr: instance(withExternalName(id), withFailureThreshold(3), withVersion(version),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheck: func(ctx context.Context, input *awsroute53.GetHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.GetHealthCheckOutput, error) {
+						return &awsroute53.GetHealthCheckOutput{HealthCheck: healthCheck(3)}, nil
+					},
+				},
+				cr: instance(withExternalName(id), withFailureThreshold(5)),
+			},
+			want: want{
+				cr: instance(withExternalName(id), withFailureThreshold(5), withVersion(version),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: instance(),
+			},
+			want: want{
+				cr:     instance(),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheck: func(ctx context.Context, input *awsroute53.GetHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.GetHealthCheckOutput, error) {
+						return nil, &awsroute53types.NoSuchHealthCheck{}
+					},
+				},
+				cr: instance(withExternalName(id)),
+			},
+			want: want{
+				cr:     instance(withExternalName(id)),
+				result: managed.ExternalObservation{},
+			},
+		},
+		"ClientError": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockGetHealthCheck: func(ctx context.Context, input *awsroute53.GetHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.GetHealthCheckOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withExternalName(id)),
+			},
+			want: want{
+				cr:  instance(withExternalName(id)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: test.NewMockClient(), client: tc.route53}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockCreateHealthCheck: func(ctx context.Context, input *awsroute53.CreateHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.CreateHealthCheckOutput, error) {
+						return &awsroute53.CreateHealthCheckOutput{HealthCheck: healthCheck(3)}, nil
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:     instance(withExternalName(id)),
+				result: managed.ExternalCreation{},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockCreateHealthCheck: func(ctx context.Context, input *awsroute53.CreateHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.CreateHealthCheckOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: test.NewMockClient(), client: tc.route53}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockUpdateHealthCheck: func(ctx context.Context, input *awsroute53.UpdateHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.UpdateHealthCheckOutput, error) {
+						if aws.ToInt64(input.HealthCheckVersion) != version {
+							return nil, errBoom
+						}
+						return &awsroute53.UpdateHealthCheckOutput{HealthCheck: healthCheck(5)}, nil
+					},
+				},
+				cr: instance(withExternalName(id), withFailureThreshold(5), withVersion(version)),
+			},
+			want: want{
+				cr: instance(withExternalName(id), withFailureThreshold(5), withVersion(version)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockUpdateHealthCheck: func(ctx context.Context, input *awsroute53.UpdateHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.UpdateHealthCheckOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withExternalName(id)),
+			},
+			want: want{
+				cr:  instance(withExternalName(id)),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.route53}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockDeleteHealthCheck: func(ctx context.Context, input *awsroute53.DeleteHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.DeleteHealthCheckOutput, error) {
+						return &awsroute53.DeleteHealthCheckOutput{}, nil
+					},
+				},
+				cr: instance(withExternalName(id)),
+			},
+			want: want{
+				cr: instance(withExternalName(id), withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				route53: &fake.MockHealthCheckClient{
+					MockDeleteHealthCheck: func(ctx context.Context, input *awsroute53.DeleteHealthCheckInput, opts []func(*awsroute53.Options)) (*awsroute53.DeleteHealthCheckOutput, error) {
+						return nil, &awsroute53types.NoSuchHealthCheck{}
+					},
+				},
+				cr: instance(withExternalName(id)),
+			},
+			want: want{
+				cr: instance(withExternalName(id), withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.route53}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
 	svcapitypes "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 // SetupResolverEndpoint adds a controller that reconciles ResolverEndpoints
@@ -45,8 +46,9 @@ func SetupResolverEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 		For(&v1alpha1.ResolverEndpoint{}).
 		Complete(managed.NewReconciler(mgr,
 			cpresource.ManagedKind(v1alpha1.ResolverEndpointGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithInitializers(),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), opts: opts}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithInitializers(awsclient.NewTagger(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	analyticsListFailed   = "cannot list Bucket analytics configurations"
+	analyticsPutFailed    = "cannot put Bucket analytics configuration"
+	analyticsDeleteFailed = "cannot delete Bucket analytics configuration"
+)
+
+// AnalyticsConfigurationClient is the client for API methods and reconciling
+// the AnalyticsConfigurations
+type AnalyticsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewAnalyticsConfigurationClient creates the client for Analytics
+// Configurations
+func NewAnalyticsConfigurationClient(client s3.BucketClient) *AnalyticsConfigurationClient {
+	return &AnalyticsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *AnalyticsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, analyticsListFailed)
+	}
+	local := bucket.Spec.ForProvider.AnalyticsConfigurations
+	switch {
+	case len(external) == 0 && len(local) == 0:
+		return Updated, nil
+	case len(external) != 0 && len(local) == 0:
+		return NeedsDeletion, nil
+	case cmp.Equal(sortAnalyticsConfigurations(external), sortAnalyticsConfigurations(GenerateAnalyticsConfigurations(local)),
+		cmpopts.IgnoreTypes(document.NoSerde{})):
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS. The
+// configurations that are no longer specified are deleted.
+func (in *AnalyticsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.AnalyticsConfigurations == nil {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+	desired := map[string]bool{}
+	configs := GenerateAnalyticsConfigurations(bucket.Spec.ForProvider.AnalyticsConfigurations)
+	for i := range configs {
+		desired[aws.ToString(configs[i].Id)] = true
+		if _, err := in.client.PutBucketAnalyticsConfiguration(ctx, &awss3.PutBucketAnalyticsConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     configs[i].Id,
+			AnalyticsConfiguration: &configs[i],
+		}); err != nil {
+			return awsclient.Wrap(err, analyticsPutFailed)
+		}
+	}
+	for _, c := range external {
+		if desired[aws.ToString(c.Id)] {
+			continue
+		}
+		if err := in.delete(ctx, bucket, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *AnalyticsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+	for _, c := range external {
+		if err := in.delete(ctx, bucket, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LateInitialize does nothing because the AnalyticsConfigurations might have
+// been deleted by the user.
+func (in *AnalyticsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, analyticsListFailed)
+	}
+
+	// We need the second check here because by default there are no
+	// configurations.
+	if len(external) == 0 {
+		return nil
+	}
+
+	fp := &bucket.Spec.ForProvider
+	if fp.AnalyticsConfigurations == nil {
+		fp.AnalyticsConfigurations = GenerateLocalAnalyticsConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *AnalyticsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0
+}
+
+func (in *AnalyticsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.AnalyticsConfiguration, error) {
+	var result []types.AnalyticsConfiguration
+	input := &awss3.ListBucketAnalyticsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketAnalyticsConfigurations(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, out.AnalyticsConfigurationList...)
+		if !out.IsTruncated || out.NextContinuationToken == nil {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *AnalyticsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, id *string) error {
+	_, err := in.client.DeleteBucketAnalyticsConfiguration(ctx, &awss3.DeleteBucketAnalyticsConfigurationInput{
+		Bucket: awsclient.String(meta.GetExternalName(bucket)),
+		Id:     id,
+	})
+	return awsclient.Wrap(err, analyticsDeleteFailed)
+}
+
+// GenerateAnalyticsConfigurations creates the list of AnalyticsConfigurations
+// for the AWS SDK
+func GenerateAnalyticsConfigurations(in []v1beta1.AnalyticsConfiguration) []types.AnalyticsConfiguration {
+	// NOTE: prealloc is disabled due to AWS requiring nil instead of 0-length
+	// for empty slices.
+	var result []types.AnalyticsConfiguration // nolint:prealloc
+	for _, local := range in {
+		config := types.AnalyticsConfiguration{
+			Id:                   awsclient.String(local.ID),
+			StorageClassAnalysis: &types.StorageClassAnalysis{},
+		}
+		if local.Filter != nil {
+			if local.Filter.Prefix != nil {
+				config.Filter = &types.AnalyticsFilterMemberPrefix{Value: *local.Filter.Prefix}
+			}
+			if local.Filter.Tag != nil {
+				config.Filter = &types.AnalyticsFilterMemberTag{Value: types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}}
+			}
+			if local.Filter.And != nil {
+				andOperator := types.AnalyticsAndOperator{Prefix: local.Filter.And.Prefix}
+				if local.Filter.And.Tags != nil {
+					andOperator.Tags = s3.SortS3TagSet(s3.CopyTags(local.Filter.And.Tags))
+				}
+				config.Filter = &types.AnalyticsFilterMemberAnd{Value: andOperator}
+			}
+		}
+		if de := local.StorageClassAnalysis.DataExport; de != nil {
+			dst := de.Destination.S3BucketDestination
+			config.StorageClassAnalysis.DataExport = &types.StorageClassAnalysisDataExport{
+				OutputSchemaVersion: types.StorageClassAnalysisSchemaVersion(de.OutputSchemaVersion),
+				Destination: &types.AnalyticsExportDestination{
+					S3BucketDestination: &types.AnalyticsS3BucketDestination{
+						Bucket:          dst.Bucket,
+						Format:          types.AnalyticsS3ExportFileFormat(dst.Format),
+						BucketAccountId: dst.BucketAccountID,
+						Prefix:          dst.Prefix,
+					},
+				},
+			}
+		}
+		result = append(result, config)
+	}
+	return result
+}
+
+// GenerateLocalAnalyticsConfigurations creates the list of
+// v1beta1.AnalyticsConfigurations from the AWS SDK configurations
+func GenerateLocalAnalyticsConfigurations(external []types.AnalyticsConfiguration) []v1beta1.AnalyticsConfiguration {
+	result := make([]v1beta1.AnalyticsConfiguration, len(external))
+	for i, c := range external {
+		result[i] = v1beta1.AnalyticsConfiguration{ID: aws.ToString(c.Id)}
+		// https://pkg.go.dev/github.com/aws/aws-sdk-go-v2/service/s3/types#AnalyticsFilter
+		switch v := c.Filter.(type) {
+		case *types.AnalyticsFilterMemberAnd:
+			result[i].Filter = &v1beta1.AnalyticsFilter{And: &v1beta1.AnalyticsAndOperator{
+				Prefix: v.Value.Prefix,
+				Tags:   GenerateLocalTagging(v.Value.Tags).TagSet,
+			}}
+		case *types.AnalyticsFilterMemberPrefix:
+			result[i].Filter = &v1beta1.AnalyticsFilter{Prefix: aws.String(v.Value)}
+		case *types.AnalyticsFilterMemberTag:
+			result[i].Filter = &v1beta1.AnalyticsFilter{Tag: &v1beta1.Tag{Key: aws.ToString(v.Value.Key), Value: aws.ToString(v.Value.Value)}}
+		}
+		if c.StorageClassAnalysis == nil || c.StorageClassAnalysis.DataExport == nil {
+			continue
+		}
+		de := c.StorageClassAnalysis.DataExport
+		result[i].StorageClassAnalysis.DataExport = &v1beta1.StorageClassAnalysisDataExport{
+			OutputSchemaVersion: string(de.OutputSchemaVersion),
+		}
+		if de.Destination != nil && de.Destination.S3BucketDestination != nil {
+			dst := de.Destination.S3BucketDestination
+			result[i].StorageClassAnalysis.DataExport.Destination.S3BucketDestination = v1beta1.AnalyticsS3BucketDestination{
+				Bucket:          dst.Bucket,
+				Format:          string(dst.Format),
+				BucketAccountID: dst.BucketAccountId,
+				Prefix:          dst.Prefix,
+			}
+		}
+	}
+	return result
+}
+
+// sortAnalyticsConfigurations sorts the configurations by their IDs, and
+// their filter tags so that they can be compared.
+func sortAnalyticsConfigurations(configs []types.AnalyticsConfiguration) []types.AnalyticsConfiguration {
+	out := make([]types.AnalyticsConfiguration, len(configs))
+	copy(out, configs)
+	sort.SliceStable(out, func(i, j int) bool {
+		return aws.ToString(out[i].Id) < aws.ToString(out[j].Id)
+	})
+	for i := range out {
+		if and, ok := out[i].Filter.(*types.AnalyticsFilterMemberAnd); ok {
+			sorted := *and
+			sorted.Value.Tags = s3.SortS3TagSet(and.Value.Tags)
+			out[i].Filter = &sorted
+		}
+	}
+	return out
+}
@@ -0,0 +1,418 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &AnalyticsConfigurationClient{}
+
+var analyticsDestination = "arn:aws:s3:::analytics-destination"
+
+func generateAnalyticsConfigs() []v1beta1.AnalyticsConfiguration {
+	return []v1beta1.AnalyticsConfiguration{
+		{
+			ID: id,
+			Filter: &v1beta1.AnalyticsFilter{
+				And: &v1beta1.AnalyticsAndOperator{
+					Prefix: &prefix,
+					Tags:   tags,
+				},
+			},
+			StorageClassAnalysis: v1beta1.StorageClassAnalysis{
+				DataExport: &v1beta1.StorageClassAnalysisDataExport{
+					Destination: v1beta1.AnalyticsExportDestination{
+						S3BucketDestination: v1beta1.AnalyticsS3BucketDestination{
+							Bucket: &analyticsDestination,
+							Format: "CSV",
+						},
+					},
+					OutputSchemaVersion: "V_1",
+				},
+			},
+		},
+	}
+}
+
+func generateAWSAnalyticsConfigs(id string) []s3types.AnalyticsConfiguration {
+	return []s3types.AnalyticsConfiguration{
+		{
+			Id: awsclient.String(id),
+			Filter: &s3types.AnalyticsFilterMemberAnd{
+				Value: s3types.AnalyticsAndOperator{
+					Prefix: &prefix,
+					Tags:   awsTags,
+				},
+			},
+			StorageClassAnalysis: &s3types.StorageClassAnalysis{
+				DataExport: &s3types.StorageClassAnalysisDataExport{
+					Destination: &s3types.AnalyticsExportDestination{
+						S3BucketDestination: &s3types.AnalyticsS3BucketDestination{
+							Bucket: &analyticsDestination,
+							Format: s3types.AnalyticsS3ExportFileFormatCsv,
+						},
+					},
+					OutputSchemaVersion: s3types.StorageClassAnalysisSchemaVersionV1,
+				},
+			},
+		},
+	}
+}
+
+func listAnalyticsConfigs(configs []s3types.AnalyticsConfiguration, err error) func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+		return &s3.ListBucketAnalyticsConfigurationsOutput{AnalyticsConfigurationList: configs}, err
+	}
+}
+
+func TestAnalyticsObserve(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(nil, errBoom),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, analyticsListFailed),
+			},
+		},
+		"UpdateNeeded": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"UpdateNeededDifferentID": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(generateAWSAnalyticsConfigs("other"), nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(nil)),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(generateAWSAnalyticsConfigs(id), nil),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NoUpdateNotExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(nil)),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(generateAWSAnalyticsConfigs(id), nil),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		deleted []string
+	}
+
+	var deleted []string
+	deleteFn := func(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+		deleted = append(deleted, awsclient.StringValue(input.Id))
+		return &s3.DeleteBucketAnalyticsConfigurationOutput{}, nil
+	}
+	putFn := func(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error) {
+		return &s3.PutBucketAnalyticsConfigurationOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(nil, nil),
+					MockPutBucketAnalyticsConfiguration: func(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsPutFailed),
+			},
+		},
+		"InvalidConfig": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(nil)),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"SuccessfulCreate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations:  listAnalyticsConfigs(nil, nil),
+					MockPutBucketAnalyticsConfiguration:    putFn,
+					MockDeleteBucketAnalyticsConfiguration: deleteFn,
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"DeleteRemovedConfig": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations:  listAnalyticsConfigs(append(generateAWSAnalyticsConfigs(id), generateAWSAnalyticsConfigs("other")...), nil),
+					MockPutBucketAnalyticsConfiguration:    putFn,
+					MockDeleteBucketAnalyticsConfiguration: deleteFn,
+				}),
+			},
+			want: want{
+				err:     nil,
+				deleted: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted = nil
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsDelete(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(generateAWSAnalyticsConfigs(id), nil),
+					MockDeleteBucketAnalyticsConfiguration: func(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsDeleteFailed),
+			},
+		},
+		"SuccessfulDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(generateAWSAnalyticsConfigs(id), nil),
+					MockDeleteBucketAnalyticsConfiguration: func(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+						return &s3.DeleteBucketAnalyticsConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsLateInit(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(nil, errBoom),
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"NoLateInitEmpty": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs([]s3types.AnalyticsConfiguration{{
+						Id:                   awsclient.String(id),
+						Filter:               &s3types.AnalyticsFilterMemberPrefix{Value: prefix},
+						StorageClassAnalysis: &s3types.StorageClassAnalysis{},
+					}}, nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr: s3testing.Bucket(s3testing.WithAnalyticsConfigs([]v1beta1.AnalyticsConfiguration{{
+					ID:     id,
+					Filter: &v1beta1.AnalyticsFilter{Prefix: &prefix},
+				}})),
+			},
+		},
+		"NoOpLateInit": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalyticsConfigs(generateAWSAnalyticsConfigs("other"), nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	intelligentTieringListFailed   = "cannot list Bucket intelligent tiering configurations"
+	intelligentTieringPutFailed    = "cannot put Bucket intelligent tiering configuration"
+	intelligentTieringDeleteFailed = "cannot delete Bucket intelligent tiering configuration"
+)
+
+// IntelligentTieringConfigurationClient is the client for API methods and
+// reconciling the IntelligentTieringConfigurations
+type IntelligentTieringConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewIntelligentTieringConfigurationClient creates the client for
+// Intelligent-Tiering Configurations
+func NewIntelligentTieringConfigurationClient(client s3.BucketClient) *IntelligentTieringConfigurationClient {
+	return &IntelligentTieringConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *IntelligentTieringConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	local := bucket.Spec.ForProvider.IntelligentTieringConfigurations
+	switch {
+	case len(external) == 0 && len(local) == 0:
+		return Updated, nil
+	case len(external) != 0 && len(local) == 0:
+		return NeedsDeletion, nil
+	case cmp.Equal(sortIntelligentTieringConfigurations(external), sortIntelligentTieringConfigurations(GenerateIntelligentTieringConfigurations(local)),
+		cmpopts.IgnoreTypes(document.NoSerde{})):
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS. The
+// configurations that are no longer specified are deleted.
+func (in *IntelligentTieringConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.IntelligentTieringConfigurations == nil {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	desired := map[string]bool{}
+	configs := GenerateIntelligentTieringConfigurations(bucket.Spec.ForProvider.IntelligentTieringConfigurations)
+	for i := range configs {
+		desired[aws.ToString(configs[i].Id)] = true
+		if _, err := in.client.PutBucketIntelligentTieringConfiguration(ctx, &awss3.PutBucketIntelligentTieringConfigurationInput{
+			Bucket:                          awsclient.String(meta.GetExternalName(bucket)),
+			Id:                              configs[i].Id,
+			IntelligentTieringConfiguration: &configs[i],
+		}); err != nil {
+			return awsclient.Wrap(err, intelligentTieringPutFailed)
+		}
+	}
+	for _, c := range external {
+		if desired[aws.ToString(c.Id)] {
+			continue
+		}
+		if err := in.delete(ctx, bucket, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *IntelligentTieringConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+	for _, c := range external {
+		if err := in.delete(ctx, bucket, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LateInitialize does nothing because the IntelligentTieringConfigurations
+// might have been deleted by the user.
+func (in *IntelligentTieringConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, intelligentTieringListFailed)
+	}
+
+	// We need the second check here because by default there are no
+	// configurations.
+	if len(external) == 0 {
+		return nil
+	}
+
+	fp := &bucket.Spec.ForProvider
+	if fp.IntelligentTieringConfigurations == nil {
+		fp.IntelligentTieringConfigurations = GenerateLocalIntelligentTieringConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *IntelligentTieringConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0
+}
+
+func (in *IntelligentTieringConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.IntelligentTieringConfiguration, error) {
+	var result []types.IntelligentTieringConfiguration
+	input := &awss3.ListBucketIntelligentTieringConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketIntelligentTieringConfigurations(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, out.IntelligentTieringConfigurationList...)
+		if !out.IsTruncated || out.NextContinuationToken == nil {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *IntelligentTieringConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, id *string) error {
+	_, err := in.client.DeleteBucketIntelligentTieringConfiguration(ctx, &awss3.DeleteBucketIntelligentTieringConfigurationInput{
+		Bucket: awsclient.String(meta.GetExternalName(bucket)),
+		Id:     id,
+	})
+	return awsclient.Wrap(err, intelligentTieringDeleteFailed)
+}
+
+// GenerateIntelligentTieringConfigurations creates the list of
+// IntelligentTieringConfigurations for the AWS SDK
+func GenerateIntelligentTieringConfigurations(in []v1beta1.IntelligentTieringConfiguration) []types.IntelligentTieringConfiguration {
+	// NOTE: prealloc is disabled due to AWS requiring nil instead of 0-length
+	// for empty slices.
+	var result []types.IntelligentTieringConfiguration // nolint:prealloc
+	for _, local := range in {
+		config := types.IntelligentTieringConfiguration{
+			Id:     awsclient.String(local.ID),
+			Status: types.IntelligentTieringStatus(local.Status),
+		}
+		if local.Filter != nil {
+			config.Filter = &types.IntelligentTieringFilter{Prefix: local.Filter.Prefix}
+			if local.Filter.Tag != nil {
+				config.Filter.Tag = &types.Tag{Key: awsclient.String(local.Filter.Tag.Key), Value: awsclient.String(local.Filter.Tag.Value)}
+			}
+			if local.Filter.And != nil {
+				config.Filter.And = &types.IntelligentTieringAndOperator{Prefix: local.Filter.And.Prefix}
+				if local.Filter.And.Tags != nil {
+					config.Filter.And.Tags = s3.SortS3TagSet(s3.CopyTags(local.Filter.And.Tags))
+				}
+			}
+		}
+		for _, t := range local.Tierings {
+			config.Tierings = append(config.Tierings, types.Tiering{
+				AccessTier: types.IntelligentTieringAccessTier(t.AccessTier),
+				Days:       t.Days,
+			})
+		}
+		result = append(result, config)
+	}
+	return result
+}
+
+// GenerateLocalIntelligentTieringConfigurations creates the list of
+// v1beta1.IntelligentTieringConfigurations from the AWS SDK configurations
+func GenerateLocalIntelligentTieringConfigurations(external []types.IntelligentTieringConfiguration) []v1beta1.IntelligentTieringConfiguration {
+	result := make([]v1beta1.IntelligentTieringConfiguration, len(external))
+	for i, c := range external {
+		result[i] = v1beta1.IntelligentTieringConfiguration{
+			ID:     aws.ToString(c.Id),
+			Status: string(c.Status),
+		}
+		if c.Filter != nil {
+			result[i].Filter = &v1beta1.IntelligentTieringFilter{Prefix: c.Filter.Prefix}
+			if c.Filter.Tag != nil {
+				result[i].Filter.Tag = &v1beta1.Tag{Key: aws.ToString(c.Filter.Tag.Key), Value: aws.ToString(c.Filter.Tag.Value)}
+			}
+			if c.Filter.And != nil {
+				result[i].Filter.And = &v1beta1.IntelligentTieringAndOperator{
+					Prefix: c.Filter.And.Prefix,
+					Tags:   GenerateLocalTagging(c.Filter.And.Tags).TagSet,
+				}
+			}
+		}
+		result[i].Tierings = make([]v1beta1.Tiering, len(c.Tierings))
+		for j, t := range c.Tierings {
+			result[i].Tierings[j] = v1beta1.Tiering{AccessTier: string(t.AccessTier), Days: t.Days}
+		}
+	}
+	return result
+}
+
+// sortIntelligentTieringConfigurations sorts the configurations by their IDs,
+// and their tierings and filter tags so that they can be compared.
+func sortIntelligentTieringConfigurations(configs []types.IntelligentTieringConfiguration) []types.IntelligentTieringConfiguration {
+	out := make([]types.IntelligentTieringConfiguration, len(configs))
+	copy(out, configs)
+	sort.SliceStable(out, func(i, j int) bool {
+		return aws.ToString(out[i].Id) < aws.ToString(out[j].Id)
+	})
+	for i := range out {
+		tierings := make([]types.Tiering, len(out[i].Tierings))
+		copy(tierings, out[i].Tierings)
+		sort.SliceStable(tierings, func(a, b int) bool {
+			return tierings[a].AccessTier < tierings[b].AccessTier
+		})
+		out[i].Tierings = tierings
+		if out[i].Filter != nil && out[i].Filter.And != nil {
+			and := *out[i].Filter.And
+			and.Tags = s3.SortS3TagSet(and.Tags)
+			filter := *out[i].Filter
+			filter.And = &and
+			out[i].Filter = &filter
+		}
+	}
+	return out
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &IntelligentTieringConfigurationClient{}
+
+func generateIntelligentTieringConfigs() []v1beta1.IntelligentTieringConfiguration {
+	return []v1beta1.IntelligentTieringConfiguration{
+		{
+			ID:     id,
+			Status: enabled,
+			Filter: &v1beta1.IntelligentTieringFilter{
+				And: &v1beta1.IntelligentTieringAndOperator{
+					Prefix: &prefix,
+					Tags:   tags,
+				},
+			},
+			Tierings: []v1beta1.Tiering{
+				{AccessTier: "DEEP_ARCHIVE_ACCESS", Days: 180},
+				{AccessTier: "ARCHIVE_ACCESS", Days: 90},
+			},
+		},
+	}
+}
+
+func generateAWSIntelligentTieringConfigs(id string) []s3types.IntelligentTieringConfiguration {
+	return []s3types.IntelligentTieringConfiguration{
+		{
+			Id:     awsclient.String(id),
+			Status: s3types.IntelligentTieringStatusEnabled,
+			Filter: &s3types.IntelligentTieringFilter{
+				And: &s3types.IntelligentTieringAndOperator{
+					Prefix: &prefix,
+					Tags:   awsTags,
+				},
+			},
+			Tierings: []s3types.Tiering{
+				{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: 90},
+				{AccessTier: s3types.IntelligentTieringAccessTierDeepArchiveAccess, Days: 180},
+			},
+		},
+	}
+}
+
+func listIntelligentTieringConfigs(configs []s3types.IntelligentTieringConfiguration, err error) func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+		return &s3.ListBucketIntelligentTieringConfigurationsOutput{IntelligentTieringConfigurationList: configs}, err
+	}
+}
+
+func TestIntelligentTieringObserve(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(nil, errBoom),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, intelligentTieringListFailed),
+			},
+		},
+		"UpdateNeeded": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"UpdateNeededDifferentID": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(generateAWSIntelligentTieringConfigs("other"), nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(nil)),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(generateAWSIntelligentTieringConfigs(id), nil),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NoUpdateNotExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(nil)),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(generateAWSIntelligentTieringConfigs(id), nil),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		deleted []string
+	}
+
+	var deleted []string
+	deleteFn := func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+		deleted = append(deleted, awsclient.StringValue(input.Id))
+		return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+	}
+	putFn := func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+		return &s3.PutBucketIntelligentTieringConfigurationOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(nil, nil),
+					MockPutBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringPutFailed),
+			},
+		},
+		"InvalidConfig": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(nil)),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"SuccessfulCreate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations:  listIntelligentTieringConfigs(nil, nil),
+					MockPutBucketIntelligentTieringConfiguration:    putFn,
+					MockDeleteBucketIntelligentTieringConfiguration: deleteFn,
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"DeleteRemovedConfig": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations:  listIntelligentTieringConfigs(append(generateAWSIntelligentTieringConfigs(id), generateAWSIntelligentTieringConfigs("other")...), nil),
+					MockPutBucketIntelligentTieringConfiguration:    putFn,
+					MockDeleteBucketIntelligentTieringConfiguration: deleteFn,
+				}),
+			},
+			want: want{
+				err:     nil,
+				deleted: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted = nil
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringDelete(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(generateAWSIntelligentTieringConfigs(id), nil),
+					MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringDeleteFailed),
+			},
+		},
+		"SuccessfulDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(generateAWSIntelligentTieringConfigs(id), nil),
+					MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+						return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringLateInit(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(nil, errBoom),
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"NoLateInitEmpty": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs([]s3types.IntelligentTieringConfiguration{{
+						Id:       awsclient.String(id),
+						Status:   s3types.IntelligentTieringStatusEnabled,
+						Filter:   &s3types.IntelligentTieringFilter{Prefix: &prefix},
+						Tierings: []s3types.Tiering{{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: 90}},
+					}}, nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs([]v1beta1.IntelligentTieringConfiguration{{
+					ID:       id,
+					Status:   enabled,
+					Filter:   &v1beta1.IntelligentTieringFilter{Prefix: &prefix},
+					Tierings: []v1beta1.Tiering{{AccessTier: "ARCHIVE_ACCESS", Days: 90}},
+				}})),
+			},
+		},
+		"NoOpLateInit": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTieringConfigs(generateAWSIntelligentTieringConfigs("other"), nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
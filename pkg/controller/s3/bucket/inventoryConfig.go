@@ -0,0 +1,276 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	inventoryListFailed   = "cannot list Bucket inventory configurations"
+	inventoryPutFailed    = "cannot put Bucket inventory configuration"
+	inventoryDeleteFailed = "cannot delete Bucket inventory configuration"
+)
+
+// InventoryConfigurationClient is the client for API methods and reconciling
+// the InventoryConfigurations
+type InventoryConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewInventoryConfigurationClient creates the client for Inventory
+// Configurations
+func NewInventoryConfigurationClient(client s3.BucketClient) *InventoryConfigurationClient {
+	return &InventoryConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *InventoryConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, inventoryListFailed)
+	}
+	local := bucket.Spec.ForProvider.InventoryConfigurations
+	switch {
+	case len(external) == 0 && len(local) == 0:
+		return Updated, nil
+	case len(external) != 0 && len(local) == 0:
+		return NeedsDeletion, nil
+	case cmp.Equal(sortInventoryConfigurations(external), sortInventoryConfigurations(GenerateInventoryConfigurations(local)),
+		cmpopts.IgnoreTypes(document.NoSerde{})):
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS. The
+// configurations that are no longer specified are deleted.
+func (in *InventoryConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.InventoryConfigurations == nil {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+	desired := map[string]bool{}
+	configs := GenerateInventoryConfigurations(bucket.Spec.ForProvider.InventoryConfigurations)
+	for i := range configs {
+		desired[aws.ToString(configs[i].Id)] = true
+		if _, err := in.client.PutBucketInventoryConfiguration(ctx, &awss3.PutBucketInventoryConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     configs[i].Id,
+			InventoryConfiguration: &configs[i],
+		}); err != nil {
+			return awsclient.Wrap(err, inventoryPutFailed)
+		}
+	}
+	for _, c := range external {
+		if desired[aws.ToString(c.Id)] {
+			continue
+		}
+		if err := in.delete(ctx, bucket, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *InventoryConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+	for _, c := range external {
+		if err := in.delete(ctx, bucket, c.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LateInitialize does nothing because the InventoryConfigurations might have
+// been deleted by the user.
+func (in *InventoryConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return awsclient.Wrap(err, inventoryListFailed)
+	}
+
+	// We need the second check here because by default there are no
+	// configurations.
+	if len(external) == 0 {
+		return nil
+	}
+
+	fp := &bucket.Spec.ForProvider
+	if fp.InventoryConfigurations == nil {
+		fp.InventoryConfigurations = GenerateLocalInventoryConfigurations(external)
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *InventoryConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.InventoryConfigurations) != 0
+}
+
+func (in *InventoryConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.InventoryConfiguration, error) {
+	var result []types.InventoryConfiguration
+	input := &awss3.ListBucketInventoryConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketInventoryConfigurations(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, out.InventoryConfigurationList...)
+		if !out.IsTruncated || out.NextContinuationToken == nil {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *InventoryConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, id *string) error {
+	_, err := in.client.DeleteBucketInventoryConfiguration(ctx, &awss3.DeleteBucketInventoryConfigurationInput{
+		Bucket: awsclient.String(meta.GetExternalName(bucket)),
+		Id:     id,
+	})
+	return awsclient.Wrap(err, inventoryDeleteFailed)
+}
+
+// GenerateInventoryConfigurations creates the list of InventoryConfigurations
+// for the AWS SDK
+func GenerateInventoryConfigurations(in []v1beta1.InventoryConfiguration) []types.InventoryConfiguration {
+	// NOTE: prealloc is disabled due to AWS requiring nil instead of 0-length
+	// for empty slices.
+	var result []types.InventoryConfiguration // nolint:prealloc
+	for _, local := range in {
+		dst := local.Destination.S3BucketDestination
+		config := types.InventoryConfiguration{
+			Id:                     awsclient.String(local.ID),
+			IsEnabled:              local.IsEnabled,
+			IncludedObjectVersions: types.InventoryIncludedObjectVersions(local.IncludedObjectVersions),
+			Schedule:               &types.InventorySchedule{Frequency: types.InventoryFrequency(local.Schedule.Frequency)},
+			Destination: &types.InventoryDestination{
+				S3BucketDestination: &types.InventoryS3BucketDestination{
+					Bucket:    dst.Bucket,
+					Format:    types.InventoryFormat(dst.Format),
+					AccountId: dst.AccountID,
+					Prefix:    dst.Prefix,
+				},
+			},
+		}
+		if dst.Encryption != nil {
+			enc := &types.InventoryEncryption{}
+			if dst.Encryption.SSEKMS != nil {
+				enc.SSEKMS = &types.SSEKMS{KeyId: awsclient.String(dst.Encryption.SSEKMS.KeyID)}
+			}
+			if dst.Encryption.SSES3 != nil {
+				enc.SSES3 = &types.SSES3{}
+			}
+			config.Destination.S3BucketDestination.Encryption = enc
+		}
+		if local.Filter != nil {
+			config.Filter = &types.InventoryFilter{Prefix: awsclient.String(local.Filter.Prefix)}
+		}
+		for _, f := range local.OptionalFields {
+			config.OptionalFields = append(config.OptionalFields, types.InventoryOptionalField(f))
+		}
+		result = append(result, config)
+	}
+	return result
+}
+
+// GenerateLocalInventoryConfigurations creates the list of
+// v1beta1.InventoryConfigurations from the AWS SDK configurations
+func GenerateLocalInventoryConfigurations(external []types.InventoryConfiguration) []v1beta1.InventoryConfiguration {
+	result := make([]v1beta1.InventoryConfiguration, len(external))
+	for i, c := range external {
+		result[i] = v1beta1.InventoryConfiguration{
+			ID:                     aws.ToString(c.Id),
+			IsEnabled:              c.IsEnabled,
+			IncludedObjectVersions: string(c.IncludedObjectVersions),
+		}
+		if c.Schedule != nil {
+			result[i].Schedule.Frequency = string(c.Schedule.Frequency)
+		}
+		if c.Destination != nil && c.Destination.S3BucketDestination != nil {
+			dst := c.Destination.S3BucketDestination
+			result[i].Destination.S3BucketDestination = v1beta1.InventoryS3BucketDestination{
+				Bucket:    dst.Bucket,
+				Format:    string(dst.Format),
+				AccountID: dst.AccountId,
+				Prefix:    dst.Prefix,
+			}
+			if dst.Encryption != nil {
+				enc := &v1beta1.InventoryEncryption{}
+				if dst.Encryption.SSEKMS != nil {
+					enc.SSEKMS = &v1beta1.SSEKMS{KeyID: aws.ToString(dst.Encryption.SSEKMS.KeyId)}
+				}
+				if dst.Encryption.SSES3 != nil {
+					enc.SSES3 = &v1beta1.SSES3{}
+				}
+				result[i].Destination.S3BucketDestination.Encryption = enc
+			}
+		}
+		if c.Filter != nil {
+			result[i].Filter = &v1beta1.InventoryFilter{Prefix: aws.ToString(c.Filter.Prefix)}
+		}
+		for _, f := range c.OptionalFields {
+			result[i].OptionalFields = append(result[i].OptionalFields, string(f))
+		}
+	}
+	return result
+}
+
+// sortInventoryConfigurations sorts the configurations by their IDs, and
+// their optional fields so that they can be compared.
+func sortInventoryConfigurations(configs []types.InventoryConfiguration) []types.InventoryConfiguration {
+	out := make([]types.InventoryConfiguration, len(configs))
+	copy(out, configs)
+	sort.SliceStable(out, func(i, j int) bool {
+		return aws.ToString(out[i].Id) < aws.ToString(out[j].Id)
+	})
+	for i := range out {
+		if len(out[i].OptionalFields) == 0 {
+			continue
+		}
+		fields := make([]types.InventoryOptionalField, len(out[i].OptionalFields))
+		copy(fields, out[i].OptionalFields)
+		sort.SliceStable(fields, func(a, b int) bool {
+			return fields[a] < fields[b]
+		})
+		out[i].OptionalFields = fields
+	}
+	return out
+}
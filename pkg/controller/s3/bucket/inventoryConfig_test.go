@@ -0,0 +1,423 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &InventoryConfigurationClient{}
+
+var inventoryDestination = "arn:aws:s3:::inventory-destination"
+
+func generateInventoryConfigs() []v1beta1.InventoryConfiguration {
+	return []v1beta1.InventoryConfiguration{
+		{
+			ID:        id,
+			IsEnabled: true,
+			Destination: v1beta1.InventoryDestination{
+				S3BucketDestination: v1beta1.InventoryS3BucketDestination{
+					Bucket:     &inventoryDestination,
+					Format:     "CSV",
+					Encryption: &v1beta1.InventoryEncryption{SSES3: &v1beta1.SSES3{}},
+					Prefix:     &prefix,
+				},
+			},
+			Filter:                 &v1beta1.InventoryFilter{Prefix: prefix},
+			IncludedObjectVersions: "Current",
+			OptionalFields:         []string{"Size", "ETag"},
+			Schedule:               v1beta1.InventorySchedule{Frequency: "Daily"},
+		},
+	}
+}
+
+func generateAWSInventoryConfigs(id string) []s3types.InventoryConfiguration {
+	return []s3types.InventoryConfiguration{
+		{
+			Id:        awsclient.String(id),
+			IsEnabled: true,
+			Destination: &s3types.InventoryDestination{
+				S3BucketDestination: &s3types.InventoryS3BucketDestination{
+					Bucket:     &inventoryDestination,
+					Format:     s3types.InventoryFormatCsv,
+					Encryption: &s3types.InventoryEncryption{SSES3: &s3types.SSES3{}},
+					Prefix:     &prefix,
+				},
+			},
+			Filter:                 &s3types.InventoryFilter{Prefix: &prefix},
+			IncludedObjectVersions: s3types.InventoryIncludedObjectVersionsCurrent,
+			OptionalFields:         []s3types.InventoryOptionalField{s3types.InventoryOptionalFieldETag, s3types.InventoryOptionalFieldSize},
+			Schedule:               &s3types.InventorySchedule{Frequency: s3types.InventoryFrequencyDaily},
+		},
+	}
+}
+
+func listInventoryConfigs(configs []s3types.InventoryConfiguration, err error) func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+		return &s3.ListBucketInventoryConfigurationsOutput{InventoryConfigurationList: configs}, err
+	}
+}
+
+func TestInventoryObserve(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(nil, errBoom),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, inventoryListFailed),
+			},
+		},
+		"UpdateNeeded": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"UpdateNeededDifferentID": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(generateAWSInventoryConfigs("other"), nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(nil)),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(generateAWSInventoryConfigs(id), nil),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NoUpdateNotExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(nil)),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(generateAWSInventoryConfigs(id), nil),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err     error
+		deleted []string
+	}
+
+	var deleted []string
+	deleteFn := func(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+		deleted = append(deleted, awsclient.StringValue(input.Id))
+		return &s3.DeleteBucketInventoryConfigurationOutput{}, nil
+	}
+	putFn := func(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+		return &s3.PutBucketInventoryConfigurationOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(nil, nil),
+					MockPutBucketInventoryConfiguration: func(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryPutFailed),
+			},
+		},
+		"InvalidConfig": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithInventoryConfigs(nil)),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"SuccessfulCreate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations:  listInventoryConfigs(nil, nil),
+					MockPutBucketInventoryConfiguration:    putFn,
+					MockDeleteBucketInventoryConfiguration: deleteFn,
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"DeleteRemovedConfig": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations:  listInventoryConfigs(append(generateAWSInventoryConfigs(id), generateAWSInventoryConfigs("other")...), nil),
+					MockPutBucketInventoryConfiguration:    putFn,
+					MockDeleteBucketInventoryConfiguration: deleteFn,
+				}),
+			},
+			want: want{
+				err:     nil,
+				deleted: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted = nil
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryDelete(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(generateAWSInventoryConfigs(id), nil),
+					MockDeleteBucketInventoryConfiguration: func(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryDeleteFailed),
+			},
+		},
+		"SuccessfulDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(generateAWSInventoryConfigs(id), nil),
+					MockDeleteBucketInventoryConfiguration: func(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+						return &s3.DeleteBucketInventoryConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryLateInit(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(nil, errBoom),
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"NoLateInitEmpty": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(nil, nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs([]s3types.InventoryConfiguration{{
+						Id: awsclient.String(id),
+						Destination: &s3types.InventoryDestination{
+							S3BucketDestination: &s3types.InventoryS3BucketDestination{
+								Bucket: &inventoryDestination,
+								Format: s3types.InventoryFormatParquet,
+							},
+						},
+						IncludedObjectVersions: s3types.InventoryIncludedObjectVersionsAll,
+						Schedule:               &s3types.InventorySchedule{Frequency: s3types.InventoryFrequencyWeekly},
+					}}, nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr: s3testing.Bucket(s3testing.WithInventoryConfigs([]v1beta1.InventoryConfiguration{{
+					ID: id,
+					Destination: v1beta1.InventoryDestination{
+						S3BucketDestination: v1beta1.InventoryS3BucketDestination{
+							Bucket: &inventoryDestination,
+							Format: "Parquet",
+						},
+					},
+					IncludedObjectVersions: "All",
+					Schedule:               v1beta1.InventorySchedule{Frequency: "Weekly"},
+				}})),
+			},
+		},
+		"NoOpLateInit": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventoryConfigs(generateAWSInventoryConfigs("other"), nil),
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -35,8 +35,9 @@ import (
 )
 
 const (
-	notificationGetFailed = "cannot get Bucket notification"
-	notificationPutFailed = "cannot put Bucket notification"
+	notificationGetFailed         = "cannot get Bucket notification"
+	notificationPutFailed         = "cannot put Bucket notification"
+	notificationDestinationFailed = "cannot put Bucket notification, the destination policies or permissions do not allow Amazon S3 to publish to them yet"
 )
 
 // NotificationConfigurationClient is the client for API methods and reconciling the LifecycleConfiguration
@@ -247,6 +248,9 @@ func (in *NotificationConfigurationClient) CreateOrUpdate(ctx context.Context, b
 	}
 	input := GenerateNotificationConfigurationInput(meta.GetExternalName(bucket), bucket.Spec.ForProvider.NotificationConfiguration)
 	_, err := in.client.PutBucketNotificationConfiguration(ctx, input)
+	if s3.NotificationDestinationNotValidated(err) {
+		return awsclient.Wrap(err, notificationDestinationFailed)
+	}
 	return awsclient.Wrap(err, notificationPutFailed)
 }
 
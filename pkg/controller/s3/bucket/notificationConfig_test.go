@@ -22,11 +22,13 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
 	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
 	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
 )
@@ -38,7 +40,9 @@ var (
 	lambdaArn                         = "lambda::123"
 	queueArn                          = "queue::123"
 	topicArn                          = "topic::123"
-	lostEvent                         = s3types.Event("s3:ReducedRedundancyLostObject")
+
+	errDestinationNotValidated = &smithy.GenericAPIError{Code: clients3.InvalidArgument, Message: "Unable to validate the following destination configurations"}
+	lostEvent                  = s3types.Event("s3:ReducedRedundancyLostObject")
 )
 
 func generateNotificationEvents() []string {
@@ -251,6 +255,19 @@ func TestNotificationCreateOrUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, notificationPutFailed),
 			},
 		},
+		"DestinationNotValidated": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithNotificationConfig(generateNotificationConfig())),
+				cl: NewNotificationConfigurationClient(fake.MockBucketClient{
+					MockPutBucketNotificationConfiguration: func(ctx context.Context, input *s3.PutBucketNotificationConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+						return nil, errDestinationNotValidated
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errDestinationNotValidated, notificationDestinationFailed),
+			},
+		},
 		"InvalidConfig": {
 			args: args{
 				b: s3testing.Bucket(s3testing.WithNotificationConfig(generateNotificationConfig())),
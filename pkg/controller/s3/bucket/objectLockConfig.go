@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	objectLockGetFailed    = "cannot get Bucket object lock configuration"
+	objectLockPutFailed    = "cannot put Bucket object lock configuration"
+	objectLockDeleteFailed = "cannot delete Bucket object lock default retention"
+)
+
+// ObjectLockConfigurationClient is the client for API methods and reconciling the ObjectLockConfiguration
+type ObjectLockConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewObjectLockConfigurationClient creates the client for Object Lock Configuration
+func NewObjectLockConfigurationClient(client s3.BucketClient) *ObjectLockConfigurationClient {
+	return &ObjectLockConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *ObjectLockConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	config := bucket.Spec.ForProvider.ObjectLockConfiguration
+	external, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		if s3.ObjectLockConfigurationNotFound(err) && config == nil {
+			return Updated, nil
+		}
+		return NeedsUpdate, awsclient.Wrap(resource.Ignore(s3.ObjectLockConfigurationNotFound, err), objectLockGetFailed)
+	}
+
+	var rule *types.ObjectLockRule
+	if external.ObjectLockConfiguration != nil {
+		rule = external.ObjectLockConfiguration.Rule
+	}
+	switch {
+	// NOTE: Object Lock cannot be disabled once it is enabled, so only the
+	// default retention is removed.
+	case config == nil && rule == nil:
+		return Updated, nil
+	case config == nil && rule != nil:
+		return NeedsDeletion, nil
+	case external.ObjectLockConfiguration == nil || external.ObjectLockConfiguration.ObjectLockEnabled != types.ObjectLockEnabledEnabled:
+		return NeedsUpdate, nil
+	case IsObjectLockRuleUpToDate(config.Rule, rule):
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *ObjectLockConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		return nil
+	}
+	input := GeneratePutObjectLockConfigurationInput(meta.GetExternalName(bucket), bucket.Spec.ForProvider.ObjectLockConfiguration)
+	_, err := in.client.PutObjectLockConfiguration(ctx, input)
+	return awsclient.Wrap(err, objectLockPutFailed)
+}
+
+// Delete removes the default retention of the bucket. Object Lock itself
+// cannot be disabled.
+func (in *ObjectLockConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	_, err := in.client.PutObjectLockConfiguration(ctx, GeneratePutObjectLockConfigurationInput(meta.GetExternalName(bucket), &v1beta1.ObjectLockConfiguration{}))
+	return awsclient.Wrap(err, objectLockDeleteFailed)
+}
+
+// LateInitialize does nothing because the default retention might have been
+// deleted by the user.
+func (in *ObjectLockConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.ObjectLockConfigurationNotFound, err), objectLockGetFailed)
+	}
+
+	// We need the second check here because by default Object Lock is not
+	// enabled.
+	if external == nil || external.ObjectLockConfiguration == nil || external.ObjectLockConfiguration.ObjectLockEnabled != types.ObjectLockEnabledEnabled {
+		return nil
+	}
+
+	fp := &bucket.Spec.ForProvider
+	if fp.ObjectLockConfiguration == nil {
+		fp.ObjectLockConfiguration = &v1beta1.ObjectLockConfiguration{}
+	}
+
+	if fp.ObjectLockConfiguration.Rule == nil {
+		fp.ObjectLockConfiguration.Rule = GenerateLocalObjectLockRule(external.ObjectLockConfiguration.Rule)
+	}
+
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *ObjectLockConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.ObjectLockConfiguration != nil
+}
+
+// GeneratePutObjectLockConfigurationInput creates the input for the
+// PutObjectLockConfiguration request for the S3 Client
+func GeneratePutObjectLockConfigurationInput(name string, config *v1beta1.ObjectLockConfiguration) *awss3.PutObjectLockConfigurationInput {
+	input := &awss3.PutObjectLockConfigurationInput{
+		Bucket: awsclient.String(name),
+		ObjectLockConfiguration: &types.ObjectLockConfiguration{
+			ObjectLockEnabled: types.ObjectLockEnabledEnabled,
+		},
+	}
+	if config.Rule != nil {
+		input.ObjectLockConfiguration.Rule = &types.ObjectLockRule{
+			DefaultRetention: &types.DefaultRetention{
+				Mode:  types.ObjectLockRetentionMode(config.Rule.DefaultRetention.Mode),
+				Days:  config.Rule.DefaultRetention.Days,
+				Years: config.Rule.DefaultRetention.Years,
+			},
+		}
+	}
+	return input
+}
+
+// GenerateLocalObjectLockRule creates the local ObjectLockRule from the S3
+// Client response
+func GenerateLocalObjectLockRule(rule *types.ObjectLockRule) *v1beta1.ObjectLockRule {
+	if rule == nil || rule.DefaultRetention == nil {
+		return nil
+	}
+	return &v1beta1.ObjectLockRule{
+		DefaultRetention: v1beta1.DefaultRetention{
+			Mode:  string(rule.DefaultRetention.Mode),
+			Days:  rule.DefaultRetention.Days,
+			Years: rule.DefaultRetention.Years,
+		},
+	}
+}
+
+// IsObjectLockRuleUpToDate checks whether the default retention of the bucket
+// is the desired one.
+func IsObjectLockRuleUpToDate(local *v1beta1.ObjectLockRule, external *types.ObjectLockRule) bool {
+	observed := GenerateLocalObjectLockRule(external)
+	if local == nil || observed == nil {
+		return local == nil && observed == nil
+	}
+	return *local == *observed
+}
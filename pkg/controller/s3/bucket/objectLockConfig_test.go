@@ -0,0 +1,382 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &ObjectLockConfigurationClient{}
+
+func generateObjectLockConfig() *v1beta1.ObjectLockConfiguration {
+	return &v1beta1.ObjectLockConfiguration{
+		Rule: &v1beta1.ObjectLockRule{
+			DefaultRetention: v1beta1.DefaultRetention{Mode: "COMPLIANCE", Years: 7},
+		},
+	}
+}
+
+func generateAWSObjectLockConfig(mode s3types.ObjectLockRetentionMode, years int32) *s3types.ObjectLockConfiguration {
+	return &s3types.ObjectLockConfiguration{
+		ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+		Rule: &s3types.ObjectLockRule{
+			DefaultRetention: &s3types.DefaultRetention{Mode: mode, Years: years},
+		},
+	}
+}
+
+func TestObjectLockObserve(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, objectLockGetFailed),
+			},
+		},
+		"UpdateNeededNotEnabled": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"UpdateNeededRetention": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: generateAWSObjectLockConfig(s3types.ObjectLockRetentionModeGovernance, 7)}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDelete": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(nil)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: generateAWSObjectLockConfig(s3types.ObjectLockRetentionModeCompliance, 7)}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+		"NoUpdateNotExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(nil)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NoUpdateEnabledWithoutRetention": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(nil)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: &s3types.ObjectLockConfiguration{ObjectLockEnabled: s3types.ObjectLockEnabledEnabled}}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: generateAWSObjectLockConfig(s3types.ObjectLockRetentionModeCompliance, 7)}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockPutFailed),
+			},
+		},
+		"InvalidConfig": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithObjectLockConfig(nil)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"SuccessfulCreate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+						if diff := cmp.Diff(generateAWSObjectLockConfig(s3types.ObjectLockRetentionModeCompliance, 7), input.ObjectLockConfiguration, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &s3.PutObjectLockConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockDelete(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockDeleteFailed),
+			},
+		},
+		"SuccessfulDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+						if input.ObjectLockConfiguration.Rule != nil {
+							t.Errorf("r: expected the default retention to be removed")
+						}
+						return &s3.PutObjectLockConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockLateInit(t *testing.T) {
+	type args struct {
+		cl SubresourceClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockGetFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"ErrorObjectLockConfigurationNotFound": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: generateAWSObjectLockConfig(s3types.ObjectLockRetentionModeCompliance, 7)}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+			},
+		},
+		"NoOpLateInit": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: generateAWSObjectLockConfig(s3types.ObjectLockRetentionModeGovernance, 1)}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+				cr:  s3testing.Bucket(s3testing.WithObjectLockConfig(generateObjectLockConfig())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
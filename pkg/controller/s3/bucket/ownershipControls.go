@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	ownershipControlsGetFailed    = "cannot get Bucket ownership controls"
+	ownershipControlsPutFailed    = "cannot put Bucket ownership controls"
+	ownershipControlsDeleteFailed = "cannot delete Bucket ownership controls"
+)
+
+// OwnershipControlsClient is the client for API methods and reconciling the ObjectOwnership
+type OwnershipControlsClient struct {
+	client s3.BucketClient
+}
+
+// NewOwnershipControlsClient creates the client for Ownership Controls
+func NewOwnershipControlsClient(client s3.BucketClient) *OwnershipControlsClient {
+	return &OwnershipControlsClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration.
+// The ownership controls are not managed when no object ownership is set, since
+// deleting them would enable the ACLs of buckets that were created before the
+// object ownership could be set.
+func (in *OwnershipControlsClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	ownership := bucket.Spec.ForProvider.ObjectOwnership
+	if ownership == nil {
+		return Updated, nil
+	}
+	external, err := in.client.GetBucketOwnershipControls(ctx, &awss3.GetBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsGetFailed)
+	}
+	if GenerateLocalObjectOwnership(external.OwnershipControls) == awsclient.StringValue(ownership) {
+		return Updated, nil
+	}
+	return NeedsUpdate, nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *OwnershipControlsClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.ObjectOwnership == nil {
+		return nil
+	}
+	input := &awss3.PutBucketOwnershipControlsInput{
+		Bucket: awsclient.String(meta.GetExternalName(bucket)),
+		OwnershipControls: &types.OwnershipControls{
+			Rules: []types.OwnershipControlsRule{{ObjectOwnership: types.ObjectOwnership(awsclient.StringValue(bucket.Spec.ForProvider.ObjectOwnership))}},
+		},
+	}
+	_, err := in.client.PutBucketOwnershipControls(ctx, input)
+	return awsclient.Wrap(err, ownershipControlsPutFailed)
+}
+
+// Delete removes the ownership controls of the bucket.
+func (in *OwnershipControlsClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	_, err := in.client.DeleteBucketOwnershipControls(ctx, &awss3.DeleteBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	return awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsDeleteFailed)
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *OwnershipControlsClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.client.GetBucketOwnershipControls(ctx, &awss3.GetBucketOwnershipControlsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.OwnershipControlsNotFound, err), ownershipControlsGetFailed)
+	}
+	bucket.Spec.ForProvider.ObjectOwnership = awsclient.LateInitializeStringPtr(bucket.Spec.ForProvider.ObjectOwnership, awsclient.String(GenerateLocalObjectOwnership(external.OwnershipControls)))
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *OwnershipControlsClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.ObjectOwnership != nil
+}
+
+// GenerateLocalObjectOwnership returns the object ownership of the given
+// ownership controls, which contain a single rule.
+func GenerateLocalObjectOwnership(controls *types.OwnershipControls) string {
+	if controls == nil || len(controls.Rules) == 0 {
+		return ""
+	}
+	return string(controls.Rules[0].ObjectOwnership)
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	ownershipEnforced  = clients3.ObjectOwnershipBucketOwnerEnforced
+	ownershipPreferred = string(s3types.ObjectOwnershipBucketOwnerPreferred)
+)
+
+func ownershipBucket(ownership *string) *v1beta1.Bucket {
+	return &v1beta1.Bucket{
+		Spec: v1beta1.BucketSpec{
+			ForProvider: v1beta1.BucketParameters{
+				ObjectOwnership: ownership,
+			},
+		},
+	}
+}
+
+func ownershipControls(ownership string) *s3.GetBucketOwnershipControlsOutput {
+	return &s3.GetBucketOwnershipControlsOutput{
+		OwnershipControls: &s3types.OwnershipControls{
+			Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnership(ownership)}},
+		},
+	}
+}
+
+func TestOwnershipControlsClient_Observe(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: ownershipBucket(&ownershipEnforced),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, ownershipControlsGetFailed),
+			},
+		},
+		"NotManaged": {
+			args: args{
+				cr: ownershipBucket(nil),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr: ownershipBucket(&ownershipEnforced),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: ownershipBucket(&ownershipEnforced),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return ownershipControls(ownershipPreferred), nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: ownershipBucket(&ownershipEnforced),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return ownershipControls(ownershipEnforced), nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsClient_CreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Skip": {
+			args: args{
+				cr: ownershipBucket(nil),
+			},
+			want: want{},
+		},
+		"Error": {
+			args: args{
+				cr: ownershipBucket(&ownershipEnforced),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockPutBucketOwnershipControls: func(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipControlsPutFailed),
+			},
+		},
+		"Success": {
+			args: args{
+				cr: ownershipBucket(&ownershipEnforced),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockPutBucketOwnershipControls: func(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+						if diff := cmp.Diff(ownershipControls(ownershipEnforced).OwnershipControls, input.OwnershipControls, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+							return nil, errBoom
+						}
+						return &s3.PutBucketOwnershipControlsOutput{}, nil
+					},
+				}),
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsClient_Delete(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: ownershipBucket(nil),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockDeleteBucketOwnershipControls: func(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipControlsDeleteFailed),
+			},
+		},
+		"GoneAlready": {
+			args: args{
+				cr: ownershipBucket(nil),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockDeleteBucketOwnershipControls: func(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsClient_LateInitialize(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: ownershipBucket(nil),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipControlsGetFailed),
+				cr:  ownershipBucket(nil),
+			},
+		},
+		"NotFoundSkip": {
+			args: args{
+				cr: ownershipBucket(nil),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				cr: ownershipBucket(nil),
+			},
+		},
+		"Success": {
+			args: args{
+				cr: ownershipBucket(nil),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return ownershipControls(ownershipEnforced), nil
+					},
+				}),
+			},
+			want: want{
+				cr: ownershipBucket(&ownershipEnforced),
+			},
+		},
+		"NoOverride": {
+			args: args{
+				cr: ownershipBucket(&ownershipPreferred),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return ownershipControls(ownershipEnforced), nil
+					},
+				}),
+			},
+			want: want{
+				cr: ownershipBucket(&ownershipPreferred),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -47,7 +47,9 @@ func NewPublicAccessBlockClient(client s3.BucketClient) *PublicAccessBlockClient
 	return &PublicAccessBlockClient{client: client}
 }
 
-// Observe checks if the resource exists and if it matches the local configuration
+// Observe checks if the resource exists and if it matches the local configuration.
+// Settings that were loosened or removed outside of Crossplane, e.g. from the
+// console, are reported as NeedsUpdate so that they are put again.
 func (in *PublicAccessBlockClient) Observe(ctx context.Context, cr *v1beta1.Bucket) (ResourceStatus, error) {
 	external, err := in.client.GetPublicAccessBlock(ctx, &awss3.GetPublicAccessBlockInput{Bucket: awsclient.String(meta.GetExternalName(cr))})
 	if s3.PublicAccessBlockConfigurationNotFound(err) && cr.Spec.ForProvider.PublicAccessBlockConfiguration == nil {
@@ -57,14 +59,18 @@ func (in *PublicAccessBlockClient) Observe(ctx context.Context, cr *v1beta1.Buck
 		return NeedsUpdate, awsclient.Wrap(resource.Ignore(s3.PublicAccessBlockConfigurationNotFound, err), publicAccessBlockGetFailed)
 	}
 	if cr.Spec.ForProvider.PublicAccessBlockConfiguration != nil {
+		current := awss3types.PublicAccessBlockConfiguration{}
+		if external.PublicAccessBlockConfiguration != nil {
+			current = *external.PublicAccessBlockConfiguration
+		}
 		switch {
-		case awsclient.BoolValue(cr.Spec.ForProvider.PublicAccessBlockConfiguration.BlockPublicAcls) != external.PublicAccessBlockConfiguration.BlockPublicAcls:
+		case awsclient.BoolValue(cr.Spec.ForProvider.PublicAccessBlockConfiguration.BlockPublicAcls) != current.BlockPublicAcls:
 			return NeedsUpdate, nil
-		case awsclient.BoolValue(cr.Spec.ForProvider.PublicAccessBlockConfiguration.BlockPublicPolicy) != external.PublicAccessBlockConfiguration.BlockPublicPolicy:
+		case awsclient.BoolValue(cr.Spec.ForProvider.PublicAccessBlockConfiguration.BlockPublicPolicy) != current.BlockPublicPolicy:
 			return NeedsUpdate, nil
-		case awsclient.BoolValue(cr.Spec.ForProvider.PublicAccessBlockConfiguration.RestrictPublicBuckets) != external.PublicAccessBlockConfiguration.RestrictPublicBuckets:
+		case awsclient.BoolValue(cr.Spec.ForProvider.PublicAccessBlockConfiguration.RestrictPublicBuckets) != current.RestrictPublicBuckets:
 			return NeedsUpdate, nil
-		case awsclient.BoolValue(cr.Spec.ForProvider.PublicAccessBlockConfiguration.IgnorePublicAcls) != external.PublicAccessBlockConfiguration.IgnorePublicAcls:
+		case awsclient.BoolValue(cr.Spec.ForProvider.PublicAccessBlockConfiguration.IgnorePublicAcls) != current.IgnorePublicAcls:
 			return NeedsUpdate, nil
 		}
 	}
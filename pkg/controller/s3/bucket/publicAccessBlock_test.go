@@ -110,6 +110,48 @@ func TestPublicAccessBlockClient_Observe(t *testing.T) {
 				status: NeedsUpdate,
 			},
 		},
+		"NeedsUpdateRemoved": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							PublicAccessBlockConfiguration: &v1beta1.PublicAccessBlockConfiguration{
+								BlockPublicPolicy: awsclient.Bool(true),
+							},
+						},
+					},
+				},
+				cl: NewPublicAccessBlockClient(fake.MockBucketClient{
+					MockGetPublicAccessBlock: func(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts []func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+						return &s3.GetPublicAccessBlockOutput{}, &smithy.GenericAPIError{Code: clients3.PublicAccessBlockNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdateNoConfiguration": {
+			args: args{
+				cr: &v1beta1.Bucket{
+					Spec: v1beta1.BucketSpec{
+						ForProvider: v1beta1.BucketParameters{
+							PublicAccessBlockConfiguration: &v1beta1.PublicAccessBlockConfiguration{
+								RestrictPublicBuckets: awsclient.Bool(true),
+							},
+						},
+					},
+				},
+				cl: NewPublicAccessBlockClient(fake.MockBucketClient{
+					MockGetPublicAccessBlock: func(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts []func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+						return &s3.GetPublicAccessBlockOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
 		"Updated": {
 			args: args{
 				cr: &v1beta1.Bucket{
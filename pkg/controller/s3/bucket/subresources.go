@@ -35,20 +35,32 @@ type SubresourceClient interface {
 // NewSubresourceClients creates the array of all clients for a given BucketProvider
 func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 	return []SubresourceClient{
+		// Note: OwnershipControlsClient and PublicAccessBlockClient come first, so
+		// that access loosened outside of Crossplane is restricted again even if
+		// one of the other configurations keeps failing to be put.
+		NewOwnershipControlsClient(client),
+		NewPublicAccessBlockClient(client),
 		// Note: Moved VersioningClient up, since ReplicationConfiguration may be blocked
 		// by an invalid VersioningConfig, see https://github.com/crossplane/provider-aws/issues/553
 		NewVersioningConfigurationClient(client),
 		NewAccelerateConfigurationClient(client),
 		NewCORSConfigurationClient(client),
 		NewLifecycleConfigurationClient(client),
+		NewIntelligentTieringConfigurationClient(client),
+		NewInventoryConfigurationClient(client),
+		NewAnalyticsConfigurationClient(client),
 		NewLoggingConfigurationClient(client),
-		NewNotificationConfigurationClient(client),
 		NewReplicationConfigurationClient(client),
 		NewRequestPaymentConfigurationClient(client),
 		NewSSEConfigurationClient(client),
 		NewTaggingConfigurationClient(client),
 		NewWebsiteConfigurationClient(client),
-		NewPublicAccessBlockClient(client),
+		NewObjectLockConfigurationClient(client),
+		// Note: NotificationConfigurationClient is kept last, since S3 validates
+		// the destinations and rejects the configuration until their policies or
+		// permissions allow it to publish to them, which should not block the
+		// other configurations.
+		NewNotificationConfigurationClient(client),
 	}
 }
 
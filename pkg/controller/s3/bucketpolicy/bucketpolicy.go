@@ -23,7 +23,6 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -47,6 +46,7 @@ const (
 	errAttach           = "failed to attach the policy to bucket"
 	errDelete           = "failed to delete the policy for bucket"
 	errGet              = "failed to get BucketPolicy for bucket with name"
+	errCompare          = "failed to compare the policy of the bucket"
 	errUpdate           = "failed to update the policy for bucket"
 	errNotSpecified     = "failed to format bucketPolicy, no rawPolicy or policy specified"
 )
@@ -64,8 +64,9 @@ func SetupBucketPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 		For(&v1alpha3.BucketPolicy{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha3.BucketPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(),
-				newClientFn: s3.NewBucketPolicyClient}),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewBucketPolicyClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -116,12 +117,18 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3.IsErrorPolicyNotFound, err), errGet)
 	}
 
+	// S3 does not return the policy exactly as it was put, so the documents
+	// are compared semantically rather than as strings.
+	upToDate, err := awsclient.IsPolicyDocumentUpToDate(aws.ToString(policyData), aws.ToString(resp.Policy))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
 	cr.SetConditions(xpv1.Available())
 
-	// If our version and the external version are the same, we return ResourceUpToDate: true
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: cmp.Equal(*policyData, *resp.Policy),
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
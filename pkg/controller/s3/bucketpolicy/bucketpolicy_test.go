@@ -124,6 +124,66 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ReformattedPolicy": {
+			args: args{
+				s3: &fake.MockBucketPolicyClient{
+					MockGetBucketPolicy: func(ctx context.Context, input *awss3.GetBucketPolicyInput, opts []func(*awss3.Options)) (*awss3.GetBucketPolicyOutput, error) {
+						return &awss3.GetBucketPolicyOutput{
+							Policy: awsclient.String(`{"Version": "2012-10-17", "Statement": [{"Resource": "arn:aws:s3:::test.s3.crossplane.com", "Principal": "*", "Effect": "Allow", "Action": "s3:ListBucket"}]}`),
+						}, nil
+					},
+				},
+				cr: bucketPolicy(withPolicy(&params)),
+			},
+			want: want{
+				cr: bucketPolicy(withPolicy(&params),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SingleValueLists": {
+			args: args{
+				s3: &fake.MockBucketPolicyClient{
+					MockGetBucketPolicy: func(ctx context.Context, input *awss3.GetBucketPolicyInput, opts []func(*awss3.Options)) (*awss3.GetBucketPolicyOutput, error) {
+						return &awss3.GetBucketPolicyOutput{
+							Policy: awsclient.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::test.s3.crossplane.com"]}]}`),
+						}, nil
+					},
+				},
+				cr: bucketPolicy(withPolicy(&params)),
+			},
+			want: want{
+				cr: bucketPolicy(withPolicy(&params),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"OutdatedPolicy": {
+			args: args{
+				s3: &fake.MockBucketPolicyClient{
+					MockGetBucketPolicy: func(ctx context.Context, input *awss3.GetBucketPolicyInput, opts []func(*awss3.Options)) (*awss3.GetBucketPolicyOutput, error) {
+						return &awss3.GetBucketPolicyOutput{
+							Policy: awsclient.String(`{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"arn:aws:s3:::test.s3.crossplane.com"}],"Version":"2012-10-17"}`),
+						}, nil
+					},
+				},
+				cr: bucketPolicy(withPolicy(&params)),
+			},
+			want: want{
+				cr: bucketPolicy(withPolicy(&params),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Object resource"
+	errGet              = "failed to get the head of the object"
+	errGetContent       = "failed to get the content of the object"
+	errPut              = "failed to put the object"
+	errDelete           = "failed to delete the object"
+)
+
+// SetupObject adds a controller that reconciles Objects.
+func SetupObject(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha3.ObjectGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha3.Object{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.ObjectGroupVersionKind),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewObjectClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.ObjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client s3.ObjectClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3.IsErrorObjectNotFound, err), errGet)
+	}
+
+	// An Object that is being deleted only has to be known to exist. Its
+	// content may be read from a ConfigMap or Secret that is gone already.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	content, err := s3.GetObjectContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetContent)
+	}
+
+	cr.Status.AtProvider = s3.GenerateObjectObservation(resp)
+	cr.SetConditions(xpv1.Available())
+
+	// The content of the object is not downloaded; it is compared with the
+	// hash that was stored in its metadata when it was uploaded, so that an
+	// object replaced outside of Crossplane is uploaded again.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3.IsObjectUpToDate(cr.Spec.ForProvider, content, resp),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	return managed.ExternalUpdate{}, e.put(ctx, cr)
+}
+
+// put uploads the whole object, since S3 objects cannot be modified in place.
+func (e *external) put(ctx context.Context, cr *v1alpha3.Object) error {
+	content, err := s3.GetObjectContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errGetContent)
+	}
+	_, err = e.client.PutObject(ctx, s3.GeneratePutObjectInput(cr.Spec.ForProvider, content))
+	return awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.Object)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	return awsclient.Wrap(resource.Ignore(s3.IsErrorObjectNotFound, err), errDelete)
+}
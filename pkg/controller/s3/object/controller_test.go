@@ -0,0 +1,460 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	bucketName     = "test.s3.crossplane.com"
+	key            = "bootstrap/init.sh"
+	content        = "#!/bin/sh\necho hi\n"
+	eTag           = `"etag"`
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.ObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type objectModifier func(*v1alpha3.Object)
+
+func withConditions(c ...xpv1.Condition) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withConfigMapRef() objectModifier {
+	return func(r *v1alpha3.Object) {
+		r.Spec.ForProvider.Content = nil
+		r.Spec.ForProvider.ContentConfigMapRef = &awsv1beta1.ConfigMapKeySelector{Name: "bootstrap", Namespace: "default", Key: "init.sh"}
+	}
+}
+
+func withDeletionTimestamp() objectModifier {
+	return func(r *v1alpha3.Object) {
+		t := metav1.NewTime(time.Unix(1, 0))
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func withObservation(o v1alpha3.ObjectObservation) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.AtProvider = o }
+}
+
+func object(m ...objectModifier) *v1alpha3.Object {
+	cr := &v1alpha3.Object{
+		Spec: v1alpha3.ObjectSpec{
+			ForProvider: v1alpha3.ObjectParameters{
+				BucketName: &bucketName,
+				Key:        key,
+				Content:    &content,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	hash := s3.ContentHash([]byte(content))
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{
+							ETag:     &eTag,
+							Metadata: map[string]string{s3.ObjectContentSHA256MetadataKey: hash},
+						}, nil
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(
+					withObservation(v1alpha3.ObjectObservation{ETag: eTag, ContentSHA256: hash}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{
+							ETag:     &eTag,
+							Metadata: map[string]string{s3.ObjectContentSHA256MetadataKey: hash},
+						}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"init.sh": "echo bye"}
+						return nil
+					},
+				},
+				cr: object(withConfigMapRef()),
+			},
+			want: want{
+				cr: object(
+					withConfigMapRef(),
+					withObservation(v1alpha3.ObjectObservation{ETag: eTag, ContentSHA256: hash}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ContentError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: object(withConfigMapRef()),
+			},
+			want: want{
+				cr:  object(withConfigMapRef()),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get the content ConfigMap"), errGetContent),
+			},
+		},
+		"DeletedWithoutContentConfigMap": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{ETag: &eTag}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: object(withConfigMapRef(), withDeletionTimestamp()),
+			},
+			want: want{
+				cr:     object(withConfigMapRef(), withDeletionTimestamp()),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: "NotFound"}
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						body, _ := ioutil.ReadAll(input.Body)
+						if string(body) != content || input.Metadata[s3.ObjectContentSHA256MetadataKey] != s3.ContentHash(body) {
+							return nil, errBoom
+						}
+						return &awss3.PutObjectOutput{}, nil
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withConditions(xpv1.Creating())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return &awss3.PutObjectOutput{}, nil
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(),
+			},
+		},
+		"ContentError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: object(withConfigMapRef()),
+			},
+			want: want{
+				cr:  object(withConfigMapRef()),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get the content ConfigMap"), errGetContent),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VaildInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return &awss3.DeleteObjectOutput{}, nil
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+		"BucketGone": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: "NoSuchBucket"}
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -30,6 +30,18 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 		MockGetBucketLifecycleConfiguration: func(ctx context.Context, input *awss3.GetBucketLifecycleConfigurationInput, opts []func(*awss3.Options)) (*awss3.GetBucketLifecycleConfigurationOutput, error) {
 			return &awss3.GetBucketLifecycleConfigurationOutput{}, &smithy.GenericAPIError{Code: clients3.LifecycleNotFoundErrCode}
 		},
+		MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *awss3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+			return &awss3.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+		},
+		MockListBucketInventoryConfigurations: func(ctx context.Context, input *awss3.ListBucketInventoryConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketInventoryConfigurationsOutput, error) {
+			return &awss3.ListBucketInventoryConfigurationsOutput{}, nil
+		},
+		MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *awss3.ListBucketAnalyticsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketAnalyticsConfigurationsOutput, error) {
+			return &awss3.ListBucketAnalyticsConfigurationsOutput{}, nil
+		},
+		MockGetObjectLockConfiguration: func(ctx context.Context, input *awss3.GetObjectLockConfigurationInput, opts []func(*awss3.Options)) (*awss3.GetObjectLockConfigurationOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+		},
 		MockGetBucketLogging: func(ctx context.Context, input *awss3.GetBucketLoggingInput, opts []func(*awss3.Options)) (*awss3.GetBucketLoggingOutput, error) {
 			return &awss3.GetBucketLoggingOutput{}, nil
 		},
@@ -66,6 +78,9 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 		MockDeletePublicAccessBlock: func(ctx context.Context, input *awss3.DeletePublicAccessBlockInput, opts []func(*awss3.Options)) (*awss3.DeletePublicAccessBlockOutput, error) {
 			return &awss3.DeletePublicAccessBlockOutput{}, nil
 		},
+		MockGetBucketOwnershipControls: func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+		},
 	}
 	for _, v := range m {
 		v(client)
@@ -103,6 +103,26 @@ func WithLifecycleConfig(s *v1beta1.BucketLifecycleConfiguration) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.LifecycleConfiguration = s }
 }
 
+// WithIntelligentTieringConfigs sets the IntelligentTieringConfigurations for an S3 Bucket
+func WithIntelligentTieringConfigs(s []v1beta1.IntelligentTieringConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.IntelligentTieringConfigurations = s }
+}
+
+// WithInventoryConfigs sets the InventoryConfigurations for an S3 Bucket
+func WithInventoryConfigs(s []v1beta1.InventoryConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.InventoryConfigurations = s }
+}
+
+// WithAnalyticsConfigs sets the AnalyticsConfigurations for an S3 Bucket
+func WithAnalyticsConfigs(s []v1beta1.AnalyticsConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.AnalyticsConfigurations = s }
+}
+
+// WithObjectLockConfig sets the ObjectLockConfiguration for an S3 Bucket
+func WithObjectLockConfig(s *v1beta1.ObjectLockConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ObjectLockConfiguration = s }
+}
+
 // WithNotificationConfig sets the NotificationConfiguration for an S3 Bucket
 func WithNotificationConfig(s *v1beta1.NotificationConfiguration) BucketModifier { //nolint
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.NotificationConfiguration = s }
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awss3control "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an access point resource"
+	errCreateSession    = "cannot create a new session"
+
+	errGet          = "failed to get access point"
+	errCreate       = "failed to create access point"
+	errDelete       = "failed to delete access point"
+	errGetPolicy    = "failed to get access point policy"
+	errPutPolicy    = "failed to put access point policy"
+	errDeletePolicy = "failed to delete access point policy"
+)
+
+// SetupAccessPoint adds a controller that reconciles S3 access points.
+func SetupAccessPoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.AccessPointGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.AccessPoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AccessPointGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: s3control.NewAccessPointClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) s3control.AccessPointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client s3control.AccessPointClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.GetAccessPointWithContext(ctx, &awss3control.GetAccessPointInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3control.IsAccessPointNotFoundErr, err), errGet)
+	}
+
+	policy, err := e.client.GetAccessPointPolicyWithContext(ctx, &awss3control.GetAccessPointPolicyInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil && !s3control.IsAccessPointPolicyNotFoundErr(err) {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetPolicy)
+	}
+	var current *string
+	if policy != nil {
+		current = policy.Policy
+	}
+
+	before := cr.Spec.ForProvider.DeepCopy()
+	s3control.LateInitializeAccessPoint(&cr.Spec.ForProvider, resp)
+
+	cr.Status.AtProvider = s3control.GenerateAccessPointObservation(resp)
+	cr.SetConditions(xpv1.Available())
+
+	// Only the policy of an access point can be updated.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isPolicyUpToDate(cr.Spec.ForProvider.Policy, current),
+		ResourceLateInitialized: !cmp.Equal(before, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	// The policy is put by the first update after the creation.
+	_, err := e.client.CreateAccessPointWithContext(ctx, s3control.GenerateCreateAccessPointInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	if awsclient.StringValue(cr.Spec.ForProvider.Policy) == "" {
+		_, err := e.client.DeleteAccessPointPolicyWithContext(ctx, &awss3control.DeleteAccessPointPolicyInput{
+			AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+			Name:      awsclient.String(meta.GetExternalName(cr)),
+		})
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(s3control.IsAccessPointPolicyNotFoundErr, err), errDeletePolicy)
+	}
+	_, err := e.client.PutAccessPointPolicyWithContext(ctx, &awss3control.PutAccessPointPolicyInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+		Policy:    cr.Spec.ForProvider.Policy,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutPolicy)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.AccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteAccessPointWithContext(ctx, &awss3control.DeleteAccessPointInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(s3control.IsAccessPointNotFoundErr, err), errDelete)
+}
+
+func isPolicyUpToDate(desired, current *string) bool {
+	if awsclient.StringValue(desired) == "" || awsclient.StringValue(current) == "" {
+		return awsclient.StringValue(desired) == awsclient.StringValue(current)
+	}
+	return awsclient.IsPolicyUpToDate(desired, current)
+}
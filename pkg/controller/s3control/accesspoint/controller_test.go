@@ -0,0 +1,514 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awss3control "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	name           = "analytics"
+	accountID      = "123456789012"
+	bucket         = "data-lake"
+	vpcID          = "vpc-1234"
+	arn            = "arn:aws:s3:us-east-1:123456789012:accesspoint/analytics"
+	alias          = "analytics-abcdefghijklmnop-s3alias"
+	policy         = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/analytics"},"Action":["s3:GetObject","s3:ListBucket"],"Resource":"arn:aws:s3:us-east-1:123456789012:accesspoint/analytics/object/*"}]}`
+	// the same policy as returned by AWS, with different ordering and
+	// formatting
+	remotePolicy = `{"Statement":[{"Resource":"arn:aws:s3:us-east-1:123456789012:accesspoint/analytics/object/*","Action":["s3:ListBucket","s3:GetObject"],"Principal":{"AWS":"arn:aws:iam::123456789012:role/analytics"},"Effect":"Allow"}], "Version":"2012-10-17"}`
+
+	errBoom           = errors.New("boom")
+	errNotFound       = awserr.New(s3control.AccessPointNotFoundErrCode, "not found", nil)
+	errPolicyNotFound = awserr.New(s3control.AccessPointPolicyNotFoundErrCode, "not found", nil)
+)
+
+type args struct {
+	s3control s3control.AccessPointClient
+	cr        resource.Managed
+}
+
+type accessPointModifier func(*v1alpha1.AccessPoint)
+
+func withConditions(c ...xpv1.Condition) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicy(p string) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Spec.ForProvider.Policy = &p }
+}
+
+func withVPCID(id string) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) {
+		r.Spec.ForProvider.VPCConfiguration = &v1alpha1.VPCConfiguration{VPCID: &id}
+	}
+}
+
+func withBlockAll() accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) {
+		r.Spec.ForProvider.PublicAccessBlockConfiguration = &v1alpha1.PublicAccessBlockConfiguration{
+			BlockPublicACLs:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicACLs:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		}
+	}
+}
+
+func withObservation(o v1alpha1.AccessPointObservation) accessPointModifier {
+	return func(r *v1alpha1.AccessPoint) { r.Status.AtProvider = o }
+}
+
+func accessPoint(m ...accessPointModifier) *v1alpha1.AccessPoint {
+	cr := &v1alpha1.AccessPoint{
+		Spec: v1alpha1.AccessPointSpec{
+			ForProvider: v1alpha1.AccessPointParameters{
+				Region:    "us-east-1",
+				AccountID: accountID,
+				Bucket:    aws.String(bucket),
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getAccessPointOutput() *awss3control.GetAccessPointOutput {
+	return &awss3control.GetAccessPointOutput{
+		AccessPointArn: aws.String(arn),
+		Alias:          aws.String(alias),
+		Bucket:         aws.String(bucket),
+		Name:           aws.String(name),
+		NetworkOrigin:  aws.String(awss3control.NetworkOriginVpc),
+		PublicAccessBlockConfiguration: &awss3control.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+		VpcConfiguration: &awss3control.VpcConfiguration{VpcId: aws.String(vpcID)},
+	}
+}
+
+var observation = v1alpha1.AccessPointObservation{
+	ARN:           arn,
+	Alias:         alias,
+	NetworkOrigin: awss3control.NetworkOriginVpc,
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockGet: func(_ context.Context, input *awss3control.GetAccessPointInput, _ []request.Option) (*awss3control.GetAccessPointOutput, error) {
+						if diff := cmp.Diff(&awss3control.GetAccessPointInput{AccountId: aws.String(accountID), Name: aws.String(name)}, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return getAccessPointOutput(), nil
+					},
+					MockGetPolicy: func(_ context.Context, _ *awss3control.GetAccessPointPolicyInput, _ []request.Option) (*awss3control.GetAccessPointPolicyOutput, error) {
+						return &awss3control.GetAccessPointPolicyOutput{Policy: aws.String(remotePolicy)}, nil
+					},
+				},
+				cr: accessPoint(withPolicy(policy), withVPCID(vpcID), withBlockAll()),
+			},
+			want: want{
+				cr: accessPoint(withPolicy(policy), withVPCID(vpcID), withBlockAll(), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetAccessPointInput, _ []request.Option) (*awss3control.GetAccessPointOutput, error) {
+						return getAccessPointOutput(), nil
+					},
+					MockGetPolicy: func(_ context.Context, _ *awss3control.GetAccessPointPolicyInput, _ []request.Option) (*awss3control.GetAccessPointPolicyOutput, error) {
+						return nil, errPolicyNotFound
+					},
+				},
+				cr: accessPoint(withVPCID(vpcID)),
+			},
+			want: want{
+				cr: accessPoint(withVPCID(vpcID), withBlockAll(), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PolicyMissing": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetAccessPointInput, _ []request.Option) (*awss3control.GetAccessPointOutput, error) {
+						return getAccessPointOutput(), nil
+					},
+					MockGetPolicy: func(_ context.Context, _ *awss3control.GetAccessPointPolicyInput, _ []request.Option) (*awss3control.GetAccessPointPolicyOutput, error) {
+						return nil, errPolicyNotFound
+					},
+				},
+				cr: accessPoint(withPolicy(policy), withVPCID(vpcID), withBlockAll()),
+			},
+			want: want{
+				cr: accessPoint(withPolicy(policy), withVPCID(vpcID), withBlockAll(), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PolicyNotDesired": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetAccessPointInput, _ []request.Option) (*awss3control.GetAccessPointOutput, error) {
+						return getAccessPointOutput(), nil
+					},
+					MockGetPolicy: func(_ context.Context, _ *awss3control.GetAccessPointPolicyInput, _ []request.Option) (*awss3control.GetAccessPointPolicyOutput, error) {
+						return &awss3control.GetAccessPointPolicyOutput{Policy: aws.String(remotePolicy)}, nil
+					},
+				},
+				cr: accessPoint(withVPCID(vpcID), withBlockAll()),
+			},
+			want: want{
+				cr: accessPoint(withVPCID(vpcID), withBlockAll(), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetAccessPointInput, _ []request.Option) (*awss3control.GetAccessPointOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetAccessPointInput, _ []request.Option) (*awss3control.GetAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"PolicyClientError": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetAccessPointInput, _ []request.Option) (*awss3control.GetAccessPointOutput, error) {
+						return getAccessPointOutput(), nil
+					},
+					MockGetPolicy: func(_ context.Context, _ *awss3control.GetAccessPointPolicyInput, _ []request.Option) (*awss3control.GetAccessPointPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: awsclient.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockCreate: func(_ context.Context, input *awss3control.CreateAccessPointInput, _ []request.Option) (*awss3control.CreateAccessPointOutput, error) {
+						want := &awss3control.CreateAccessPointInput{
+							AccountId:        aws.String(accountID),
+							Bucket:           aws.String(bucket),
+							Name:             aws.String(name),
+							VpcConfiguration: &awss3control.VpcConfiguration{VpcId: aws.String(vpcID)},
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.CreateAccessPointOutput{}, nil
+					},
+				},
+				cr: accessPoint(withVPCID(vpcID)),
+			},
+			want: want{
+				cr: accessPoint(withVPCID(vpcID), withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockCreate: func(_ context.Context, _ *awss3control.CreateAccessPointInput, _ []request.Option) (*awss3control.CreateAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutPolicy": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockPutPolicy: func(_ context.Context, input *awss3control.PutAccessPointPolicyInput, _ []request.Option) (*awss3control.PutAccessPointPolicyOutput, error) {
+						want := &awss3control.PutAccessPointPolicyInput{
+							AccountId: aws.String(accountID),
+							Name:      aws.String(name),
+							Policy:    aws.String(policy),
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.PutAccessPointPolicyOutput{}, nil
+					},
+				},
+				cr: accessPoint(withPolicy(policy)),
+			},
+		},
+		"DeletePolicy": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockDeletePolicy: func(_ context.Context, _ *awss3control.DeleteAccessPointPolicyInput, _ []request.Option) (*awss3control.DeleteAccessPointPolicyOutput, error) {
+						return &awss3control.DeleteAccessPointPolicyOutput{}, nil
+					},
+				},
+				cr: accessPoint(),
+			},
+		},
+		"PutPolicyClientError": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockPutPolicy: func(_ context.Context, _ *awss3control.PutAccessPointPolicyInput, _ []request.Option) (*awss3control.PutAccessPointPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(withPolicy(policy)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errPutPolicy),
+			},
+		},
+		"DeletePolicyClientError": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockDeletePolicy: func(_ context.Context, _ *awss3control.DeleteAccessPointPolicyInput, _ []request.Option) (*awss3control.DeleteAccessPointPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDeletePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockDelete: func(_ context.Context, _ *awss3control.DeleteAccessPointInput, _ []request.Option) (*awss3control.DeleteAccessPointOutput, error) {
+						return &awss3control.DeleteAccessPointOutput{}, nil
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockDelete: func(_ context.Context, _ *awss3control.DeleteAccessPointInput, _ []request.Option) (*awss3control.DeleteAccessPointOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockAccessPointClient{
+					MockDelete: func(_ context.Context, _ *awss3control.DeleteAccessPointInput, _ []request.Option) (*awss3control.DeleteAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
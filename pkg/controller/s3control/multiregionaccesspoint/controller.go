@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awss3control "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a multi-region access point resource"
+	errCreateSession    = "cannot create a new session"
+
+	errGet       = "failed to get multi-region access point"
+	errCreate    = "failed to create multi-region access point"
+	errDelete    = "failed to delete multi-region access point"
+	errGetPolicy = "failed to get multi-region access point policy"
+	errPutPolicy = "failed to put multi-region access point policy"
+)
+
+// SetupMultiRegionAccessPoint adds a controller that reconciles S3
+// Multi-Region Access Points.
+func SetupMultiRegionAccessPoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.MultiRegionAccessPointGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.MultiRegionAccessPoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.MultiRegionAccessPointGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: s3control.NewMultiRegionAccessPointClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) s3control.MultiRegionAccessPointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.MultiRegionAccessPoint); !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	// Multi-Region Access Points are global, but all requests managing them
+	// have to be sent to a single region.
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, s3control.MultiRegionAccessPointRegion)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client s3control.MultiRegionAccessPointClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.GetMultiRegionAccessPointWithContext(ctx, &awss3control.GetMultiRegionAccessPointInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3control.IsMultiRegionAccessPointNotFoundErr, err), errGet)
+	}
+	if resp.AccessPoint == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = s3control.GenerateMultiRegionAccessPointObservation(cr.Spec.ForProvider.AccountID, resp.AccessPoint)
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.MultiRegionAccessPointStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.MultiRegionAccessPointStatusCreating, v1alpha1.MultiRegionAccessPointStatusPartiallyCreated:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.MultiRegionAccessPointStatusDeleting, v1alpha1.MultiRegionAccessPointStatusPartiallyDeleted:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// Only the policy of a Multi-Region Access Point can be updated, and only
+	// once it is ready.
+	if cr.Status.AtProvider.Status != v1alpha1.MultiRegionAccessPointStatusReady {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	policy, err := e.client.GetMultiRegionAccessPointPolicyWithContext(ctx, &awss3control.GetMultiRegionAccessPointPolicyInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		Name:      awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetPolicy)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3control.IsMultiRegionAccessPointPolicyUpToDate(cr.Spec.ForProvider.Policy, policy.Policy),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateMultiRegionAccessPointWithContext(ctx, s3control.GenerateCreateMultiRegionAccessPointInput(meta.GetExternalName(cr), string(cr.GetUID()), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.PutMultiRegionAccessPointPolicyWithContext(ctx, &awss3control.PutMultiRegionAccessPointPolicyInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		Details: &awss3control.PutMultiRegionAccessPointPolicyInput_{
+			Name:   awsclient.String(meta.GetExternalName(cr)),
+			Policy: cr.Spec.ForProvider.Policy,
+		},
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutPolicy)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.MultiRegionAccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1alpha1.MultiRegionAccessPointStatusDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteMultiRegionAccessPointWithContext(ctx, &awss3control.DeleteMultiRegionAccessPointInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		Details: &awss3control.DeleteMultiRegionAccessPointInput_{
+			Name: awsclient.String(meta.GetExternalName(cr)),
+		},
+	})
+	return awsclient.Wrap(resource.Ignore(s3control.IsMultiRegionAccessPointNotFoundErr, err), errDelete)
+}
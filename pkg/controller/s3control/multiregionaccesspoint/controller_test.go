@@ -0,0 +1,472 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awss3control "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	name           = "data-lake"
+	uid            = types.UID("5f3f7a3c-3f5e-4a52-9d3c-1f2b1c6ab123")
+	accountID      = "123456789012"
+	alias          = "mfzwi23gnjvgw.mrap"
+	arn            = "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap"
+	bucketEast     = "data-lake-us-east-1"
+	bucketWest     = "data-lake-eu-west-1"
+	policy         = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap/object/*"}]}`
+	// the same policy as returned by AWS, with different ordering
+	remotePolicy = `{"Statement":[{"Resource":"arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap/object/*","Action":"s3:GetObject","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Effect":"Allow"}],"Version":"2012-10-17"}`
+	otherPolicy  = `{"Version":"2012-10-17","Statement":[]}`
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(s3control.MultiRegionAccessPointNotFoundErrCode, "not found", nil)
+)
+
+type args struct {
+	s3control s3control.MultiRegionAccessPointClient
+	cr        resource.Managed
+}
+
+type mrapModifier func(*v1alpha1.MultiRegionAccessPoint)
+
+func withConditions(c ...xpv1.Condition) mrapModifier {
+	return func(r *v1alpha1.MultiRegionAccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicy(p string) mrapModifier {
+	return func(r *v1alpha1.MultiRegionAccessPoint) { r.Spec.ForProvider.Policy = &p }
+}
+
+func withStatus(s string) mrapModifier {
+	return func(r *v1alpha1.MultiRegionAccessPoint) {
+		r.Status.AtProvider = v1alpha1.MultiRegionAccessPointObservation{ARN: arn, Alias: alias, Status: s}
+	}
+}
+
+func mrap(m ...mrapModifier) *v1alpha1.MultiRegionAccessPoint {
+	cr := &v1alpha1.MultiRegionAccessPoint{
+		Spec: v1alpha1.MultiRegionAccessPointSpec{
+			ForProvider: v1alpha1.MultiRegionAccessPointParameters{
+				AccountID: accountID,
+				Regions: []v1alpha1.MultiRegionAccessPointRegion{
+					{Bucket: aws.String(bucketEast)},
+					{Bucket: aws.String(bucketWest)},
+				},
+			},
+		},
+	}
+	cr.SetUID(uid)
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getOutput(status string) *awss3control.GetMultiRegionAccessPointOutput {
+	return &awss3control.GetMultiRegionAccessPointOutput{
+		AccessPoint: &awss3control.MultiRegionAccessPointReport{
+			Alias:  aws.String(alias),
+			Name:   aws.String(name),
+			Status: aws.String(status),
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Ready": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockGet: func(_ context.Context, input *awss3control.GetMultiRegionAccessPointInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointOutput, error) {
+						if diff := cmp.Diff(&awss3control.GetMultiRegionAccessPointInput{AccountId: aws.String(accountID), Name: aws.String(name)}, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return getOutput(v1alpha1.MultiRegionAccessPointStatusReady), nil
+					},
+					MockGetPolicy: func(_ context.Context, _ *awss3control.GetMultiRegionAccessPointPolicyInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointPolicyOutput, error) {
+						return &awss3control.GetMultiRegionAccessPointPolicyOutput{Policy: &awss3control.MultiRegionAccessPointPolicyDocument{
+							Established: &awss3control.EstablishedMultiRegionAccessPointPolicy{Policy: aws.String(remotePolicy)},
+						}}, nil
+					},
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withStatus(v1alpha1.MultiRegionAccessPointStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PolicyProposed": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetMultiRegionAccessPointInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointOutput, error) {
+						return getOutput(v1alpha1.MultiRegionAccessPointStatusReady), nil
+					},
+					MockGetPolicy: func(_ context.Context, _ *awss3control.GetMultiRegionAccessPointPolicyInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointPolicyOutput, error) {
+						return &awss3control.GetMultiRegionAccessPointPolicyOutput{Policy: &awss3control.MultiRegionAccessPointPolicyDocument{
+							Established: &awss3control.EstablishedMultiRegionAccessPointPolicy{Policy: aws.String(otherPolicy)},
+							Proposed:    &awss3control.ProposedMultiRegionAccessPointPolicy{Policy: aws.String(remotePolicy)},
+						}}, nil
+					},
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withStatus(v1alpha1.MultiRegionAccessPointStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PolicyOutdated": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetMultiRegionAccessPointInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointOutput, error) {
+						return getOutput(v1alpha1.MultiRegionAccessPointStatusReady), nil
+					},
+					MockGetPolicy: func(_ context.Context, _ *awss3control.GetMultiRegionAccessPointPolicyInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointPolicyOutput, error) {
+						return &awss3control.GetMultiRegionAccessPointPolicyOutput{Policy: &awss3control.MultiRegionAccessPointPolicyDocument{
+							Established: &awss3control.EstablishedMultiRegionAccessPointPolicy{Policy: aws.String(otherPolicy)},
+						}}, nil
+					},
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withStatus(v1alpha1.MultiRegionAccessPointStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetMultiRegionAccessPointInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointOutput, error) {
+						return getOutput(v1alpha1.MultiRegionAccessPointStatusCreating), nil
+					},
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				cr: mrap(withPolicy(policy), withStatus(v1alpha1.MultiRegionAccessPointStatusCreating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetMultiRegionAccessPointInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetMultiRegionAccessPointInput, _ []request.Option) (*awss3control.GetMultiRegionAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockCreate: func(_ context.Context, input *awss3control.CreateMultiRegionAccessPointInput, _ []request.Option) (*awss3control.CreateMultiRegionAccessPointOutput, error) {
+						want := &awss3control.CreateMultiRegionAccessPointInput{
+							AccountId:   aws.String(accountID),
+							ClientToken: aws.String(string(uid)),
+							Details: &awss3control.CreateMultiRegionAccessPointInput_{
+								Name: aws.String(name),
+								Regions: []*awss3control.Region{
+									{Bucket: aws.String(bucketEast)},
+									{Bucket: aws.String(bucketWest)},
+								},
+							},
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.CreateMultiRegionAccessPointOutput{}, nil
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockCreate: func(_ context.Context, _ *awss3control.CreateMultiRegionAccessPointInput, _ []request.Option) (*awss3control.CreateMultiRegionAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockPutPolicy: func(_ context.Context, input *awss3control.PutMultiRegionAccessPointPolicyInput, _ []request.Option) (*awss3control.PutMultiRegionAccessPointPolicyOutput, error) {
+						want := &awss3control.PutMultiRegionAccessPointPolicyInput{
+							AccountId: aws.String(accountID),
+							Details: &awss3control.PutMultiRegionAccessPointPolicyInput_{
+								Name:   aws.String(name),
+								Policy: aws.String(policy),
+							},
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.PutMultiRegionAccessPointPolicyOutput{}, nil
+					},
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockPutPolicy: func(_ context.Context, _ *awss3control.PutMultiRegionAccessPointPolicyInput, _ []request.Option) (*awss3control.PutMultiRegionAccessPointPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(withPolicy(policy)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errPutPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockDelete: func(_ context.Context, input *awss3control.DeleteMultiRegionAccessPointInput, _ []request.Option) (*awss3control.DeleteMultiRegionAccessPointOutput, error) {
+						if diff := cmp.Diff(aws.String(name), input.Details.Name); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.DeleteMultiRegionAccessPointOutput{}, nil
+					},
+				},
+				cr: mrap(withStatus(v1alpha1.MultiRegionAccessPointStatusReady)),
+			},
+			want: want{
+				cr: mrap(withStatus(v1alpha1.MultiRegionAccessPointStatusReady), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{},
+				cr:        mrap(withStatus(v1alpha1.MultiRegionAccessPointStatusDeleting)),
+			},
+			want: want{
+				cr: mrap(withStatus(v1alpha1.MultiRegionAccessPointStatusDeleting), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockDelete: func(_ context.Context, _ *awss3control.DeleteMultiRegionAccessPointInput, _ []request.Option) (*awss3control.DeleteMultiRegionAccessPointOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockMultiRegionAccessPointClient{
+					MockDelete: func(_ context.Context, _ *awss3control.DeleteMultiRegionAccessPointInput, _ []request.Option) (*awss3control.DeleteMultiRegionAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagelensconfiguration
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awss3control "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a storage lens configuration resource"
+	errCreateSession    = "cannot create a new session"
+
+	errGet        = "failed to get storage lens configuration"
+	errPut        = "failed to put storage lens configuration"
+	errDelete     = "failed to delete storage lens configuration"
+	errGetTagging = "failed to get storage lens configuration tags"
+	errPutTagging = "failed to put storage lens configuration tags"
+	errDelTagging = "failed to delete storage lens configuration tags"
+)
+
+// SetupStorageLensConfiguration adds a controller that reconciles S3 Storage
+// Lens configurations.
+func SetupStorageLensConfiguration(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha1.StorageLensConfigurationGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha1.StorageLensConfiguration{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.StorageLensConfigurationGroupVersionKind),
+			managed.WithExternalConnecter(awsclient.WrapExternal(mgr.GetClient(), awsclient.DeferDeletion(mgr.GetClient(), &connector{kube: mgr.GetClient(), newClientFn: s3control.NewStorageLensConfigurationClient}), awsclient.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithCriticalAnnotationUpdater(awsclient.NewCriticalAnnotationApplier(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) s3control.StorageLensConfigurationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.StorageLensConfiguration)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client s3control.StorageLensConfigurationClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.StorageLensConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.GetStorageLensConfigurationWithContext(ctx, &awss3control.GetStorageLensConfigurationInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		ConfigId:  awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3control.IsStorageLensConfigurationNotFoundErr, err), errGet)
+	}
+	if resp.StorageLensConfiguration == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	tagging, err := e.client.GetStorageLensConfigurationTaggingWithContext(ctx, &awss3control.GetStorageLensConfigurationTaggingInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		ConfigId:  awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetTagging)
+	}
+
+	cr.Status.AtProvider = s3control.GenerateStorageLensConfigurationObservation(resp.StorageLensConfiguration)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3control.IsStorageLensConfigurationUpToDate(cr.Spec.ForProvider, resp.StorageLensConfiguration, tagging.Tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.StorageLensConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.PutStorageLensConfigurationWithContext(ctx, s3control.GeneratePutStorageLensConfigurationInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.StorageLensConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// Putting a configuration replaces it, but the tags of an existing
+	// configuration are only replaced by the tagging API.
+	input := s3control.GeneratePutStorageLensConfigurationInput(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	input.Tags = nil
+	if _, err := e.client.PutStorageLensConfigurationWithContext(ctx, input); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPut)
+	}
+
+	tags := s3control.GenerateStorageLensTags(cr.Spec.ForProvider)
+	if len(tags) == 0 {
+		_, err := e.client.DeleteStorageLensConfigurationTaggingWithContext(ctx, &awss3control.DeleteStorageLensConfigurationTaggingInput{
+			AccountId: input.AccountId,
+			ConfigId:  input.ConfigId,
+		})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDelTagging)
+	}
+	_, err := e.client.PutStorageLensConfigurationTaggingWithContext(ctx, &awss3control.PutStorageLensConfigurationTaggingInput{
+		AccountId: input.AccountId,
+		ConfigId:  input.ConfigId,
+		Tags:      tags,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPutTagging)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.StorageLensConfiguration)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteStorageLensConfigurationWithContext(ctx, &awss3control.DeleteStorageLensConfigurationInput{
+		AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+		ConfigId:  awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(s3control.IsStorageLensConfigurationNotFoundErr, err), errDelete)
+}
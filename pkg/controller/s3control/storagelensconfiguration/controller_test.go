@@ -0,0 +1,517 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagelensconfiguration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awss3control "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3control/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane/provider-aws/pkg/clients/s3control/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	id             = "organization-dashboard"
+	accountID      = "123456789012"
+	arn            = "arn:aws:s3:us-east-1:123456789012:storage-lens/organization-dashboard"
+	bucketARN      = "arn:aws:s3:::storage-lens-exports"
+	exportFormat   = "CSV"
+	schemaVersion  = "V_1"
+	tagKey         = "team"
+	tagValue       = "storage"
+
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(s3control.StorageLensConfigurationNotFoundErrCode, "not found", nil)
+)
+
+type args struct {
+	s3control s3control.StorageLensConfigurationClient
+	cr        resource.Managed
+}
+
+type slcModifier func(*v1alpha1.StorageLensConfiguration)
+
+func withConditions(c ...xpv1.Condition) slcModifier {
+	return func(r *v1alpha1.StorageLensConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withARN() slcModifier {
+	return func(r *v1alpha1.StorageLensConfiguration) {
+		r.Status.AtProvider = v1alpha1.StorageLensConfigurationObservation{ARN: arn}
+	}
+}
+
+func withTags() slcModifier {
+	return func(r *v1alpha1.StorageLensConfiguration) {
+		r.Spec.ForProvider.Tags = []v1alpha1.StorageLensTag{{Key: tagKey, Value: tagValue}}
+	}
+}
+
+func slc(m ...slcModifier) *v1alpha1.StorageLensConfiguration {
+	cr := &v1alpha1.StorageLensConfiguration{
+		Spec: v1alpha1.StorageLensConfigurationSpec{
+			ForProvider: v1alpha1.StorageLensConfigurationParameters{
+				Region:    "us-east-1",
+				AccountID: accountID,
+				IsEnabled: true,
+				AccountLevel: v1alpha1.AccountLevel{
+					ActivityMetrics: &v1alpha1.StorageLensMetrics{IsEnabled: true},
+				},
+				DataExport: &v1alpha1.StorageLensDataExport{
+					S3BucketDestination: &v1alpha1.StorageLensS3BucketDestination{
+						AccountID:           accountID,
+						Bucket:              aws.String(bucketARN),
+						Format:              exportFormat,
+						OutputSchemaVersion: schemaVersion,
+						Encryption: &v1alpha1.StorageLensDataExportEncryption{
+							SSES3: &v1alpha1.SSES3{},
+						},
+					},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, id)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// configuration returns the configuration of slc() as returned by AWS, with
+// the bucket-level metrics that were not set reported as disabled.
+func configuration() *awss3control.StorageLensConfiguration {
+	return &awss3control.StorageLensConfiguration{
+		Id:             aws.String(id),
+		IsEnabled:      aws.Bool(true),
+		StorageLensArn: aws.String(arn),
+		AccountLevel: &awss3control.AccountLevel{
+			ActivityMetrics: &awss3control.ActivityMetrics{IsEnabled: aws.Bool(true)},
+			BucketLevel: &awss3control.BucketLevel{
+				ActivityMetrics: &awss3control.ActivityMetrics{IsEnabled: aws.Bool(false)},
+			},
+		},
+		DataExport: &awss3control.StorageLensDataExport{
+			S3BucketDestination: &awss3control.S3BucketDestination{
+				AccountId:           aws.String(accountID),
+				Arn:                 aws.String(bucketARN),
+				Format:              aws.String(exportFormat),
+				OutputSchemaVersion: aws.String(schemaVersion),
+				Encryption: &awss3control.StorageLensDataExportEncryption{
+					SSES3: &awss3control.SSES3{},
+				},
+			},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockGet: func(_ context.Context, input *awss3control.GetStorageLensConfigurationInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationOutput, error) {
+						if diff := cmp.Diff(&awss3control.GetStorageLensConfigurationInput{AccountId: aws.String(accountID), ConfigId: aws.String(id)}, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.GetStorageLensConfigurationOutput{StorageLensConfiguration: configuration()}, nil
+					},
+					MockGetTagging: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationTaggingInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationTaggingOutput, error) {
+						return &awss3control.GetStorageLensConfigurationTaggingOutput{
+							Tags: []*awss3control.StorageLensTag{{Key: aws.String(tagKey), Value: aws.String(tagValue)}},
+						}, nil
+					},
+				},
+				cr: slc(withTags()),
+			},
+			want: want{
+				cr: slc(withTags(), withARN(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"MetricsOutdated": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationOutput, error) {
+						c := configuration()
+						c.AccountLevel.ActivityMetrics.IsEnabled = aws.Bool(false)
+						return &awss3control.GetStorageLensConfigurationOutput{StorageLensConfiguration: c}, nil
+					},
+					MockGetTagging: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationTaggingInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationTaggingOutput, error) {
+						return &awss3control.GetStorageLensConfigurationTaggingOutput{}, nil
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				cr: slc(withARN(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsOutdated": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationOutput, error) {
+						return &awss3control.GetStorageLensConfigurationOutput{StorageLensConfiguration: configuration()}, nil
+					},
+					MockGetTagging: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationTaggingInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationTaggingOutput, error) {
+						return &awss3control.GetStorageLensConfigurationTaggingOutput{}, nil
+					},
+				},
+				cr: slc(withTags()),
+			},
+			want: want{
+				cr: slc(withTags(), withARN(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				cr: slc(),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				cr:  slc(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"TaggingClientError": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockGet: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationOutput, error) {
+						return &awss3control.GetStorageLensConfigurationOutput{StorageLensConfiguration: configuration()}, nil
+					},
+					MockGetTagging: func(_ context.Context, _ *awss3control.GetStorageLensConfigurationTaggingInput, _ []request.Option) (*awss3control.GetStorageLensConfigurationTaggingOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				cr:  slc(),
+				err: awsclient.Wrap(errBoom, errGetTagging),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockPut: func(_ context.Context, input *awss3control.PutStorageLensConfigurationInput, _ []request.Option) (*awss3control.PutStorageLensConfigurationOutput, error) {
+						c := configuration()
+						c.StorageLensArn = nil
+						c.AccountLevel.BucketLevel.ActivityMetrics = nil
+						want := &awss3control.PutStorageLensConfigurationInput{
+							AccountId:                aws.String(accountID),
+							ConfigId:                 aws.String(id),
+							StorageLensConfiguration: c,
+							Tags:                     []*awss3control.StorageLensTag{{Key: aws.String(tagKey), Value: aws.String(tagValue)}},
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.PutStorageLensConfigurationOutput{}, nil
+					},
+				},
+				cr: slc(withTags()),
+			},
+			want: want{
+				cr: slc(withTags(), withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockPut: func(_ context.Context, _ *awss3control.PutStorageLensConfigurationInput, _ []request.Option) (*awss3control.PutStorageLensConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				cr:  slc(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutTags": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockPut: func(_ context.Context, input *awss3control.PutStorageLensConfigurationInput, _ []request.Option) (*awss3control.PutStorageLensConfigurationOutput, error) {
+						if input.Tags != nil {
+							return nil, errors.New("tags must not be put with the configuration")
+						}
+						return &awss3control.PutStorageLensConfigurationOutput{}, nil
+					},
+					MockPutTagging: func(_ context.Context, input *awss3control.PutStorageLensConfigurationTaggingInput, _ []request.Option) (*awss3control.PutStorageLensConfigurationTaggingOutput, error) {
+						want := &awss3control.PutStorageLensConfigurationTaggingInput{
+							AccountId: aws.String(accountID),
+							ConfigId:  aws.String(id),
+							Tags:      []*awss3control.StorageLensTag{{Key: aws.String(tagKey), Value: aws.String(tagValue)}},
+						}
+						if diff := cmp.Diff(want, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.PutStorageLensConfigurationTaggingOutput{}, nil
+					},
+				},
+				cr: slc(withTags()),
+			},
+		},
+		"DeleteTags": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockPut: func(_ context.Context, _ *awss3control.PutStorageLensConfigurationInput, _ []request.Option) (*awss3control.PutStorageLensConfigurationOutput, error) {
+						return &awss3control.PutStorageLensConfigurationOutput{}, nil
+					},
+					MockDeleteTagging: func(_ context.Context, _ *awss3control.DeleteStorageLensConfigurationTaggingInput, _ []request.Option) (*awss3control.DeleteStorageLensConfigurationTaggingOutput, error) {
+						return &awss3control.DeleteStorageLensConfigurationTaggingOutput{}, nil
+					},
+				},
+				cr: slc(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockPut: func(_ context.Context, _ *awss3control.PutStorageLensConfigurationInput, _ []request.Option) (*awss3control.PutStorageLensConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+		"TaggingClientError": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockPut: func(_ context.Context, _ *awss3control.PutStorageLensConfigurationInput, _ []request.Option) (*awss3control.PutStorageLensConfigurationOutput, error) {
+						return &awss3control.PutStorageLensConfigurationOutput{}, nil
+					},
+					MockPutTagging: func(_ context.Context, _ *awss3control.PutStorageLensConfigurationTaggingInput, _ []request.Option) (*awss3control.PutStorageLensConfigurationTaggingOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: slc(withTags()),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errPutTagging),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockDelete: func(_ context.Context, input *awss3control.DeleteStorageLensConfigurationInput, _ []request.Option) (*awss3control.DeleteStorageLensConfigurationOutput, error) {
+						if diff := cmp.Diff(&awss3control.DeleteStorageLensConfigurationInput{AccountId: aws.String(accountID), ConfigId: aws.String(id)}, input); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awss3control.DeleteStorageLensConfigurationOutput{}, nil
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				cr: slc(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockDelete: func(_ context.Context, _ *awss3control.DeleteStorageLensConfigurationInput, _ []request.Option) (*awss3control.DeleteStorageLensConfigurationOutput, error) {
+						return nil, errNotFound
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				cr: slc(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3control: &fake.MockStorageLensConfigurationClient{
+					MockDelete: func(_ context.Context, _ *awss3control.DeleteStorageLensConfigurationInput, _ []request.Option) (*awss3control.DeleteStorageLensConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: slc(),
+			},
+			want: want{
+				cr:  slc(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3control}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
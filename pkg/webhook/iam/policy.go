@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iam contains admission webhooks for IAM resources.
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	iamv1alpha1 "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	apisv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// PolicyValidationPath is the path the policy validation webhook is served
+// on.
+const PolicyValidationPath = "/validate-iam-policy-documents"
+
+const (
+	errGetPC          = "cannot get ProviderConfig"
+	errGetConfig      = "cannot get AWS config for ProviderConfig"
+	errDecode         = "cannot decode object"
+	errValidatePolicy = "cannot validate policy document with Access Analyzer"
+	errFindingsFmt    = "policy document has %d Access Analyzer finding(s): %s"
+	warnNotValidated  = "policy document was not validated"
+)
+
+// A Validator validates IAM policy documents.
+type Validator interface {
+	ValidatePolicyPagesWithContext(ctx awsv1.Context, input *accessanalyzer.ValidatePolicyInput, fn func(*accessanalyzer.ValidatePolicyOutput, bool) bool, opts ...request.Option) error
+}
+
+// NewValidator returns an Access Analyzer client for the supplied session.
+func NewValidator(sess *session.Session) Validator {
+	return accessanalyzer.New(sess)
+}
+
+// SetupPolicyValidation registers a validating webhook that runs the documents
+// of Policies and RolePolicies through the Access Analyzer policy checks, using
+// the credentials of the named ProviderConfig. Documents with errors or
+// security warnings are rejected, other findings are returned as warnings.
+func SetupPolicyValidation(mgr ctrl.Manager, l logging.Logger, providerConfig, region string) error {
+	mgr.GetWebhookServer().Register(PolicyValidationPath, &webhook.Admission{Handler: &policyValidator{
+		kube:           mgr.GetClient(),
+		log:            l.WithValues("webhook", PolicyValidationPath, "providerconfig", providerConfig),
+		providerConfig: providerConfig,
+		region:         region,
+		newClientFn:    NewValidator,
+	}})
+	return nil
+}
+
+type policyValidator struct {
+	kube           client.Client
+	log            logging.Logger
+	providerConfig string
+	region         string
+	newClientFn    func(*session.Session) Validator
+}
+
+// Handle satisfies admission.Handler.
+func (v *policyValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Delete {
+		return admission.Allowed("")
+	}
+	doc, err := policyDocument(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if doc == "" {
+		return admission.Allowed("")
+	}
+
+	// The documents are still validated by IAM when they are applied, so
+	// failing to reach Access Analyzer does not block the request.
+	findings, err := v.validate(ctx, doc)
+	if err != nil {
+		v.log.Info(errValidatePolicy, "error", err)
+		return admission.Allowed("").WithWarnings(warnNotValidated + ": " + err.Error())
+	}
+
+	var rejected, warnings []string
+	for _, f := range findings {
+		msg := fmt.Sprintf("%s %s: %s (%s)", awsv1.StringValue(f.FindingType), awsv1.StringValue(f.IssueCode), awsv1.StringValue(f.FindingDetails), awsv1.StringValue(f.LearnMoreLink))
+		switch awsv1.StringValue(f.FindingType) {
+		case accessanalyzer.ValidatePolicyFindingTypeError, accessanalyzer.ValidatePolicyFindingTypeSecurityWarning:
+			rejected = append(rejected, msg)
+		default:
+			warnings = append(warnings, msg)
+		}
+	}
+	if len(rejected) != 0 {
+		return admission.Denied(fmt.Sprintf(errFindingsFmt, len(rejected), strings.Join(rejected, "; "))).WithWarnings(warnings...)
+	}
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+func (v *policyValidator) validate(ctx context.Context, doc string) ([]*accessanalyzer.ValidatePolicyFinding, error) {
+	pc := &apisv1beta1.ProviderConfig{}
+	if err := v.kube.Get(ctx, types.NamespacedName{Name: v.providerConfig}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	sess, err := awsclient.UseProviderConfigCredentialsV1(ctx, v.kube, pc, v.region)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetConfig)
+	}
+
+	var findings []*accessanalyzer.ValidatePolicyFinding
+	err = v.newClientFn(sess).ValidatePolicyPagesWithContext(ctx, &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: awsv1.String(doc),
+		PolicyType:     awsv1.String(accessanalyzer.PolicyTypeIdentityPolicy),
+	}, func(page *accessanalyzer.ValidatePolicyOutput, _ bool) bool {
+		findings = append(findings, page.Findings...)
+		return true
+	})
+	return findings, errors.Wrap(err, errValidatePolicy)
+}
+
+// policyDocument returns the policy document of the Policy or RolePolicy in
+// the supplied request.
+func policyDocument(req admission.Request) (string, error) {
+	switch req.Kind.Kind {
+	case v1beta1.PolicyKind:
+		p := &v1beta1.Policy{}
+		if err := json.Unmarshal(req.Object.Raw, p); err != nil {
+			return "", errors.Wrap(err, errDecode)
+		}
+		return p.Spec.ForProvider.Document, nil
+	case iamv1alpha1.RolePolicyKind:
+		p := &iamv1alpha1.RolePolicy{}
+		if err := json.Unmarshal(req.Object.Raw, p); err != nil {
+			return "", errors.Wrap(err, errDecode)
+		}
+		return p.Spec.ForProvider.Document, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	iamv1alpha1 "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	apisv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+var (
+	credentials = []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n")
+	document    = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"iam:PassRole","Resource":"*"}]}`
+
+	errBoom = errors.New("boom")
+)
+
+type mockValidator struct {
+	findings []*accessanalyzer.ValidatePolicyFinding
+	err      error
+}
+
+func (m *mockValidator) ValidatePolicyPagesWithContext(_ awsv1.Context, _ *accessanalyzer.ValidatePolicyInput, fn func(*accessanalyzer.ValidatePolicyOutput, bool) bool, _ ...request.Option) error {
+	if m.err != nil {
+		return m.err
+	}
+	fn(&accessanalyzer.ValidatePolicyOutput{Findings: m.findings}, true)
+	return nil
+}
+
+func finding(t, code string) *accessanalyzer.ValidatePolicyFinding {
+	return &accessanalyzer.ValidatePolicyFinding{
+		FindingType:    awsv1.String(t),
+		IssueCode:      awsv1.String(code),
+		FindingDetails: awsv1.String("details"),
+		LearnMoreLink:  awsv1.String("link"),
+	}
+}
+
+// getProviderConfig finds a ProviderConfig that reads its credentials from a
+// Secret, and that Secret.
+func getProviderConfig(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	switch o := obj.(type) {
+	case *apisv1beta1.ProviderConfig:
+		o.SetName("default")
+		o.Spec.Credentials = apisv1beta1.ProviderCredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				SecretRef: &xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "creds", Namespace: "crossplane-system"},
+					Key:             "credentials",
+				},
+			},
+		}
+		return nil
+	case *corev1.Secret:
+		o.Data = map[string][]byte{"credentials": credentials}
+		return nil
+	}
+	return errBoom
+}
+
+func admissionRequest(kind string, obj runtime.Object) admission.Request {
+	raw, _ := json.Marshal(obj)
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Kind:      metav1.GroupVersionKind{Group: v1beta1.CRDGroup, Version: v1beta1.CRDVersion, Kind: kind},
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+}
+
+func policy(doc string) *v1beta1.Policy {
+	return &v1beta1.Policy{Spec: v1beta1.PolicySpec{ForProvider: v1beta1.PolicyParameters{Document: doc}}}
+}
+
+func TestHandle(t *testing.T) {
+	type args struct {
+		get       test.MockGetFn
+		validator Validator
+		req       admission.Request
+	}
+	type want struct {
+		allowed  bool
+		warnings int
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoFindings": {
+			args: args{
+				get:       getProviderConfig,
+				validator: &mockValidator{},
+				req:       admissionRequest(v1beta1.PolicyKind, policy(document)),
+			},
+			want: want{allowed: true},
+		},
+		"SecurityWarning": {
+			args: args{
+				get:       getProviderConfig,
+				validator: &mockValidator{findings: []*accessanalyzer.ValidatePolicyFinding{finding(accessanalyzer.ValidatePolicyFindingTypeSecurityWarning, "PASS_ROLE_WITH_STAR_IN_RESOURCE")}},
+				req:       admissionRequest(v1beta1.PolicyKind, policy(document)),
+			},
+			want: want{allowed: false},
+		},
+		"ErrorInRolePolicy": {
+			args: args{
+				get:       getProviderConfig,
+				validator: &mockValidator{findings: []*accessanalyzer.ValidatePolicyFinding{finding(accessanalyzer.ValidatePolicyFindingTypeError, "JSON_SYNTAX_ERROR")}},
+				req: admissionRequest(iamv1alpha1.RolePolicyKind, &iamv1alpha1.RolePolicy{
+					Spec: iamv1alpha1.RolePolicySpec{ForProvider: iamv1alpha1.RolePolicyParameters{Document: "{"}},
+				}),
+			},
+			want: want{allowed: false},
+		},
+		"Suggestion": {
+			args: args{
+				get:       getProviderConfig,
+				validator: &mockValidator{findings: []*accessanalyzer.ValidatePolicyFinding{finding(accessanalyzer.ValidatePolicyFindingTypeSuggestion, "EMPTY_ARRAY_ACTION")}},
+				req:       admissionRequest(v1beta1.PolicyKind, policy(document)),
+			},
+			want: want{allowed: true, warnings: 1},
+		},
+		"ValidationUnavailable": {
+			args: args{
+				get:       getProviderConfig,
+				validator: &mockValidator{err: errBoom},
+				req:       admissionRequest(v1beta1.PolicyKind, policy(document)),
+			},
+			want: want{allowed: true, warnings: 1},
+		},
+		"NoProviderConfig": {
+			args: args{
+				get: test.NewMockGetFn(errBoom),
+				req: admissionRequest(v1beta1.PolicyKind, policy(document)),
+			},
+			want: want{allowed: true, warnings: 1},
+		},
+		"OtherKind": {
+			args: args{
+				req: admissionRequest(v1beta1.RoleKind, &v1beta1.Role{}),
+			},
+			want: want{allowed: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &policyValidator{
+				kube:           &test.MockClient{MockGet: tc.args.get},
+				log:            logging.NewNopLogger(),
+				providerConfig: "default",
+				region:         "us-east-1",
+				newClientFn:    func(*session.Session) Validator { return tc.args.validator },
+			}
+			resp := v.Handle(context.Background(), tc.args.req)
+			if diff := cmp.Diff(tc.want.allowed, resp.Allowed); diff != "" {
+				t.Errorf("Handle(...): -want allowed, +got allowed:\n%s\n%v", diff, resp.Result)
+			}
+			if diff := cmp.Diff(tc.want.warnings, len(resp.Warnings)); diff != "" {
+				t.Errorf("Handle(...): -want warnings, +got warnings:\n%s", diff)
+			}
+		})
+	}
+}